@@ -3,9 +3,12 @@ package auth
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -24,8 +27,11 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -64,6 +70,7 @@ type APIAuthInterceptor struct {
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
 	profile        *config.Profile
+	webhookManager *webhook.Manager
 }
 
 // New returns a new API auth interceptor.
@@ -74,6 +81,7 @@ func New(
 	licenseService enterprise.LicenseService,
 	stateCfg *state.State,
 	profile *config.Profile,
+	webhookManager *webhook.Manager,
 ) *APIAuthInterceptor {
 	return &APIAuthInterceptor{
 		store:          store,
@@ -82,6 +90,7 @@ func New(
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
 		profile:        profile,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -96,21 +105,33 @@ func (in *APIAuthInterceptor) AuthenticationInterceptor(ctx context.Context, req
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
 	}
 
-	authContext, err := getAuthContext(serverInfo.FullMethod)
+	authContext, err := GetAuthContext(serverInfo.FullMethod)
 	if err != nil {
 		return nil, err
 	}
 	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
+	if err := in.checkSignInIP(ctx, serverInfo.FullMethod); err != nil {
+		return nil, err
+	}
 
-	principalID, err := in.getPrincipalID(ctx, accessTokenStr)
+	result, err := in.getPrincipalID(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod, authContext) {
 			return handler(ctx, request)
 		}
 		return nil, err
 	}
+	if err := in.checkReauth(ctx, serverInfo.FullMethod, result.signInTime); err != nil {
+		return nil, err
+	}
+	if result.tokenPermissions != nil {
+		if err := checkTokenPermission(serverInfo.FullMethod, authContext, result.tokenPermissions); err != nil {
+			return nil, err
+		}
+		ctx = context.WithValue(ctx, common.TokenPermissionsContextKey, result.tokenPermissions)
+	}
 
-	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, result.principalID)
 	return handler(ctx, request)
 }
 
@@ -126,21 +147,33 @@ func (in *APIAuthInterceptor) AuthenticationStreamInterceptor(request any, ss gr
 		return status.Errorf(codes.Unauthenticated, err.Error())
 	}
 
-	authContext, err := getAuthContext(serverInfo.FullMethod)
+	authContext, err := GetAuthContext(serverInfo.FullMethod)
 	if err != nil {
 		return err
 	}
 	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
+	if err := in.checkSignInIP(ctx, serverInfo.FullMethod); err != nil {
+		return err
+	}
 
-	principalID, err := in.getPrincipalID(ctx, accessTokenStr)
+	result, err := in.getPrincipalID(ctx, accessTokenStr)
 	if err != nil {
 		if IsAuthenticationAllowed(serverInfo.FullMethod, authContext) {
 			return handler(request, ss)
 		}
 		return err
 	}
+	if err := in.checkReauth(ctx, serverInfo.FullMethod, result.signInTime); err != nil {
+		return err
+	}
+	if result.tokenPermissions != nil {
+		if err := checkTokenPermission(serverInfo.FullMethod, authContext, result.tokenPermissions); err != nil {
+			return err
+		}
+		ctx = context.WithValue(ctx, common.TokenPermissionsContextKey, result.tokenPermissions)
+	}
 
-	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, principalID)
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, result.principalID)
 	sss := overrideStream{ServerStream: ss, childCtx: ctx}
 	return handler(request, sss)
 }
@@ -154,12 +187,12 @@ func (s overrideStream) Context() context.Context {
 	return s.childCtx
 }
 
-func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr string) (int, error) {
+func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr string) (*authResult, error) {
 	if accessTokenStr == "" {
-		return 0, status.Errorf(codes.Unauthenticated, "access token not found")
+		return nil, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
 	if _, ok := in.stateCfg.ExpireCache.Get(accessTokenStr); ok {
-		return 0, status.Errorf(codes.Unauthenticated, "access token expired")
+		return nil, status.Errorf(codes.Unauthenticated, "access token expired")
 	}
 	claims := &claimsMessage{}
 	if _, err := jwt.ParseWithClaims(accessTokenStr, claims, func(t *jwt.Token) (any, error) {
@@ -174,12 +207,12 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
 	}); err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return 0, status.Errorf(codes.Unauthenticated, "access token expired")
+			return nil, status.Errorf(codes.Unauthenticated, "access token expired")
 		}
-		return 0, status.Errorf(codes.Unauthenticated, "failed to parse claim")
+		return nil, status.Errorf(codes.Unauthenticated, "failed to parse claim")
 	}
 	if !audienceContains(claims.Audience, fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode)) {
-		return 0, status.Errorf(codes.Unauthenticated,
+		return nil, status.Errorf(codes.Unauthenticated,
 			"invalid access token, audience mismatch, got %q, expected %q. you may send request to the wrong environment",
 			claims.Audience,
 			fmt.Sprintf(AccessTokenAudienceFmt, in.profile.Mode),
@@ -188,31 +221,153 @@ func (in *APIAuthInterceptor) authenticate(ctx context.Context, accessTokenStr s
 
 	principalID, err := strconv.Atoi(claims.Subject)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
+		return nil, status.Errorf(codes.Unauthenticated, "malformed ID %q in the access token", claims.Subject)
 	}
 	user, err := in.store.GetUserByID(ctx, principalID)
 	if err != nil {
-		return 0, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", principalID)
+		return nil, status.Errorf(codes.Unauthenticated, "failed to find user ID %q in the access token", principalID)
 	}
 	if user == nil {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", principalID)
+		return nil, status.Errorf(codes.Unauthenticated, "user ID %q not exists in the access token", principalID)
 	}
 	if user.MemberDeleted {
-		return 0, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
+		return nil, status.Errorf(codes.Unauthenticated, "user ID %q has been deactivated by administrators", principalID)
+	}
+	// The access tokens signed before the sessions were introduced don't have the session ID.
+	if claims.ID != "" {
+		session, err := in.store.GetSession(ctx, claims.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find the session of the access token")
+		}
+		if session == nil || session.Revoked {
+			return nil, status.Errorf(codes.Unauthenticated, "the session has been signed out")
+		}
 	}
 
-	return principalID, nil
+	result := &authResult{principalID: principalID}
+	if claims.IssuedAt != nil {
+		result.signInTime = claims.IssuedAt.Time
+	}
+	return result, nil
 }
 
-func (in *APIAuthInterceptor) getPrincipalID(ctx context.Context, accessTokenStr string) (int, error) {
-	principalID, err := in.authenticate(ctx, accessTokenStr)
+// authenticateServiceAccountToken authenticates the scoped service account token and returns the permissions granted by the scopes.
+func (in *APIAuthInterceptor) authenticateServiceAccountToken(ctx context.Context, tokenStr string) (int, map[string]bool, error) {
+	tokenHash := HashServiceAccountToken(tokenStr)
+	token, err := in.store.GetServiceAccountToken(ctx, &store.FindServiceAccountTokenMessage{
+		TokenHash: &tokenHash,
+	})
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to find service account token")
+	}
+	if token == nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "invalid service account token")
+	}
+	if expireTime := token.Payload.GetExpireTime(); expireTime != nil && time.Now().After(expireTime.AsTime()) {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "service account token expired")
+	}
+	user, err := in.store.GetUserByID(ctx, token.PrincipalUID)
+	if err != nil {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "failed to find user ID %q of the service account token", token.PrincipalUID)
+	}
+	if user == nil || user.Type != api.ServiceAccount {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "service account ID %q not exists", token.PrincipalUID)
+	}
+	if user.MemberDeleted {
+		return 0, nil, status.Errorf(codes.Unauthenticated, "service account ID %q has been deactivated by administrators", token.PrincipalUID)
+	}
+	permissions, err := iam.GetScopePermissions(token.Payload.GetScopes())
 	if err != nil {
-		return 0, err
+		return 0, nil, status.Errorf(codes.Unauthenticated, "invalid scopes of the service account token: %v", err)
+	}
+	if err := in.recordServiceAccountTokenIP(ctx, user, token); err != nil {
+		return 0, nil, status.Errorf(codes.Internal, "failed to record the IP address of the service account token: %v", err)
+	}
+	return user.ID, permissions, nil
+}
+
+// maxServiceAccountTokenKnownIPs is the maximum number of IP addresses remembered for a service account token.
+const maxServiceAccountTokenKnownIPs = 20
+
+// recordServiceAccountTokenIP remembers the client IP address of the service account token and
+// alerts if the token is used from a new IP address, which may indicate the token is leaked.
+func (in *APIAuthInterceptor) recordServiceAccountTokenIP(ctx context.Context, user *store.UserMessage, token *store.ServiceAccountTokenMessage) error {
+	ip := GetClientIP(ctx)
+	if ip == nil {
+		return nil
+	}
+	knownIPs := token.Payload.GetKnownIps()
+	if slices.Contains(knownIPs, ip.String()) {
+		return nil
+	}
+	// The first IP address is remembered silently.
+	if len(knownIPs) > 0 {
+		in.webhookManager.CreateSecurityEvent(ctx, &webhook.SecurityEvent{
+			Type:        api.ActivitySecurityTokenNewIP,
+			Email:       user.Email,
+			IP:          ip.String(),
+			UserAgent:   GetClientUserAgent(ctx),
+			Description: fmt.Sprintf("The service account token %q is used from a new IP address.", token.Title),
+		})
+	}
+	knownIPs = append(knownIPs, ip.String())
+	if len(knownIPs) > maxServiceAccountTokenKnownIPs {
+		knownIPs = knownIPs[len(knownIPs)-maxServiceAccountTokenKnownIPs:]
+	}
+	token.Payload.KnownIps = knownIPs
+	return in.store.UpdateServiceAccountTokenPayload(ctx, token.ID, token.Payload)
+}
+
+// authResult is the result of the authentication.
+type authResult struct {
+	principalID int
+	// signInTime is the time when the access token is signed, it's empty for the scoped service account token.
+	signInTime time.Time
+	// tokenPermissions are the permissions granted by the scoped service account token.
+	tokenPermissions map[string]bool
+}
+
+func (in *APIAuthInterceptor) getPrincipalID(ctx context.Context, accessTokenStr string) (*authResult, error) {
+	var result *authResult
+	if strings.HasPrefix(accessTokenStr, api.ServiceAccountTokenPrefix) {
+		principalID, permissions, err := in.authenticateServiceAccountToken(ctx, accessTokenStr)
+		if err != nil {
+			return nil, err
+		}
+		result = &authResult{principalID: principalID, tokenPermissions: permissions}
+	} else {
+		r, err := in.authenticate(ctx, accessTokenStr)
+		if err != nil {
+			return nil, err
+		}
+		result = r
 	}
 
 	// Only update for authorized request.
 	in.profile.LastActiveTs = time.Now().Unix()
-	return principalID, nil
+	return result, nil
+}
+
+// checkTokenPermission checks if the method is allowed by the scopes of the service account token.
+// The methods without the permission annotation check the permissions in the handler, which are
+// not covered by the scopes, so they are denied for the scoped token.
+func checkTokenPermission(fullMethod string, authContext *common.AuthContext, tokenPermissions map[string]bool) error {
+	if IsAuthenticationAllowed(fullMethod, authContext) {
+		return nil
+	}
+	if authContext.Permission == "" {
+		return status.Errorf(codes.PermissionDenied, "method %q is not allowed for the scoped service account token", fullMethod)
+	}
+	if !tokenPermissions[authContext.Permission] {
+		return status.Errorf(codes.PermissionDenied, "permission %q is not in the scopes of the service account token", authContext.Permission)
+	}
+	return nil
+}
+
+// HashServiceAccountToken returns the hash of the scoped service account token stored in the database.
+func HashServiceAccountToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
 }
 
 // GetUserIDFromMFATempToken returns the user ID from the MFA temp token.
@@ -281,27 +436,42 @@ type claimsMessage struct {
 // GenerateAPIToken generates an API token.
 func GenerateAPIToken(userName string, userID int, mode common.ReleaseMode, secret string) (string, error) {
 	expirationTime := time.Now().Add(apiTokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
+	return generateToken(userName, userID, "" /* sessionID */, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
 // GenerateAccessToken generates an access token for web.
-func GenerateAccessToken(userName string, userID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
-	expirationTime := time.Now().Add(tokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
+// The sessionID is the ID of the sign-in session, the token cannot be used after the session is revoked.
+func GenerateAccessToken(userName string, userID int, sessionID string, mode common.ReleaseMode, secret string, expirationTime time.Time) (string, error) {
+	return generateToken(userName, userID, sessionID, fmt.Sprintf(AccessTokenAudienceFmt, mode), expirationTime, []byte(secret))
 }
 
 // GenerateMFATempToken generates a temporary token for MFA.
 func GenerateMFATempToken(userName string, userID int, mode common.ReleaseMode, secret string, tokenDuration time.Duration) (string, error) {
 	expirationTime := time.Now().Add(tokenDuration)
-	return generateToken(userName, userID, fmt.Sprintf(MFATempTokenAudienceFmt, mode), expirationTime, []byte(secret))
+	return generateToken(userName, userID, "" /* sessionID */, fmt.Sprintf(MFATempTokenAudienceFmt, mode), expirationTime, []byte(secret))
+}
+
+// GetSessionIDFromAccessToken returns the session ID of the access token.
+func GetSessionIDFromAccessToken(token string, secret string) (string, error) {
+	claims := &claimsMessage{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
+			return nil, errs.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		}
+		return []byte(secret), nil
+	}); err != nil {
+		return "", err
+	}
+	return claims.ID, nil
 }
 
 // Pay attention to this function. It holds the main JWT token generation logic.
-func generateToken(userName string, userID int, aud string, expirationTime time.Time, secret []byte) (string, error) {
+func generateToken(userName string, userID int, sessionID string, aud string, expirationTime time.Time, secret []byte) (string, error) {
 	// Create the JWT claims, which includes the username and expiry time.
 	claims := &claimsMessage{
 		Name: userName,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       sessionID,
 			Audience: jwt.ClaimStrings{aud},
 			// In JWT, the expiry time is expressed as unix milliseconds.
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
@@ -324,7 +494,8 @@ func generateToken(userName string, userID int, aud string, expirationTime time.
 	return tokenString, nil
 }
 
-func getAuthContext(fullMethod string) (*common.AuthContext, error) {
+// GetAuthContext gets the auth context of the method from the method options.
+func GetAuthContext(fullMethod string) (*common.AuthContext, error) {
 	methodTokens := strings.Split(fullMethod, "/")
 	if len(methodTokens) != 3 {
 		return nil, errs.Errorf("invalid full method name %q", fullMethod)
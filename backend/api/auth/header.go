@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -29,12 +30,31 @@ func (m *GatewayResponseModifier) Modify(ctx context.Context, response http.Resp
 			isHTTPS = true
 		}
 	}
-	m.processMetadata(md, GatewayMetadataAccessTokenKey, AccessTokenCookieName, true /* httpOnly */, isHTTPS, response)
-	m.processMetadata(md, GatewayMetadataUserIDKey, UserIDCookieName, false /* httpOnly */, isHTTPS, response)
+	// The session lifetime could be changed in the workspace setting, so the cookies follow the expiration of the access token.
+	expireTime := time.Now().Add(m.TokenDuration)
+	if values := md.HeaderMD.Get(GatewayMetadataAccessTokenKey); len(values) > 0 && values[0] != "" {
+		if t, err := getTokenExpireTime(values[0]); err == nil {
+			expireTime = t
+		}
+	}
+	m.processMetadata(md, GatewayMetadataAccessTokenKey, AccessTokenCookieName, true /* httpOnly */, isHTTPS, expireTime, response)
+	m.processMetadata(md, GatewayMetadataUserIDKey, UserIDCookieName, false /* httpOnly */, isHTTPS, expireTime, response)
 	return nil
 }
 
-func (m *GatewayResponseModifier) processMetadata(md runtime.ServerMetadata, metadataKey, cookieName string, httpOnly, isHTTPS bool, response http.ResponseWriter) {
+// getTokenExpireTime returns the expiration time of the token signed by ourselves.
+func getTokenExpireTime(token string) (time.Time, error) {
+	claims := &claimsMessage{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.ExpiresAt == nil {
+		return time.Time{}, errors.Errorf("expiration time not found in the token")
+	}
+	return claims.ExpiresAt.Time, nil
+}
+
+func (*GatewayResponseModifier) processMetadata(md runtime.ServerMetadata, metadataKey, cookieName string, httpOnly, isHTTPS bool, expireTime time.Time, response http.ResponseWriter) {
 	values := md.HeaderMD.Get(metadataKey)
 	if len(values) == 0 {
 		return
@@ -62,7 +82,7 @@ func (m *GatewayResponseModifier) processMetadata(md runtime.ServerMetadata, met
 			// Suppose we have a valid refresh token, we will refresh the token in 2 cases:
 			// 1. The access token is about to expire in <<refreshThresholdDuration>>
 			// 2. The access token has already expired, we refresh the token so that the ongoing request can pass through.
-			Expires: time.Now().Add(m.TokenDuration - 1*time.Second),
+			Expires: expireTime.Add(-1 * time.Second),
 			Path:    "/",
 			// Http-only helps mitigate the risk of client side script accessing the protected cookie.
 			HttpOnly: httpOnly,
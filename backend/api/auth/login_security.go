@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"net"
+	"strings"
+	"time"
+
+	errs "github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	// signInMethods are only allowed from the IP addresses permitted by the login security.
+	signInMethods = map[string]bool{
+		"/bytebase.v1.AuthService/Login": true,
+	}
+	// reauthMethods require the user to sign in again if the user signed in earlier than the reauth interval.
+	reauthMethods = map[string]bool{
+		"/bytebase.v1.IssueService/ApproveIssue": true,
+		"/bytebase.v1.SQLService/AdminExecute":   true,
+	}
+)
+
+func (in *APIAuthInterceptor) checkSignInIP(ctx context.Context, fullMethod string) error {
+	if !signInMethods[fullMethod] {
+		return nil
+	}
+	setting, err := in.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	loginSecurity := setting.GetLoginSecurity()
+	if len(loginSecurity.GetIpAllowlist()) == 0 && len(loginSecurity.GetIpDenylist()) == 0 {
+		return nil
+	}
+	ip := GetClientIP(ctx)
+	if ip == nil {
+		return status.Errorf(codes.PermissionDenied, "failed to get the client IP address")
+	}
+	allowed, err := IsIPAllowed(ip, loginSecurity.GetIpAllowlist(), loginSecurity.GetIpDenylist())
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check the client IP address: %v", err)
+	}
+	if !allowed {
+		return status.Errorf(codes.PermissionDenied, "sign in from IP address %s is not allowed", ip.String())
+	}
+	return nil
+}
+
+func (in *APIAuthInterceptor) checkReauth(ctx context.Context, fullMethod string, signInTime time.Time) error {
+	if !reauthMethods[fullMethod] || signInTime.IsZero() {
+		return nil
+	}
+	setting, err := in.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	loginSecurity := setting.GetLoginSecurity()
+	if !IsReauthRequired(fullMethod, loginSecurity) {
+		return nil
+	}
+	if time.Since(signInTime) > loginSecurity.GetReauthInterval().AsDuration() {
+		return status.Errorf(codes.Unauthenticated, "re-authentication is required, please sign in again")
+	}
+	return nil
+}
+
+// IsReauthRequired returns true if the method requires the user to sign in again after the reauth interval of the login security.
+func IsReauthRequired(fullMethod string, loginSecurity *storepb.LoginSecurity) bool {
+	if !reauthMethods[fullMethod] {
+		return false
+	}
+	reauthInterval := loginSecurity.GetReauthInterval()
+	return reauthInterval != nil && reauthInterval.AsDuration() > 0
+}
+
+// IsIPAllowed returns true if the IP is in the allowlist and not in the denylist.
+// An empty allowlist allows all IP addresses.
+func IsIPAllowed(ip net.IP, allowlist, denylist []string) (bool, error) {
+	denied, err := matchIPRanges(ip, denylist)
+	if err != nil {
+		return false, err
+	}
+	if denied {
+		return false, nil
+	}
+	if len(allowlist) == 0 {
+		return true, nil
+	}
+	return matchIPRanges(ip, allowlist)
+}
+
+// ValidateIPRanges validates the IP addresses or CIDR ranges.
+func ValidateIPRanges(ranges []string) error {
+	for _, r := range ranges {
+		if _, err := parseIPRange(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func matchIPRanges(ip net.IP, ranges []string) (bool, error) {
+	for _, r := range ranges {
+		ipNet, err := parseIPRange(r)
+		if err != nil {
+			return false, err
+		}
+		if ipNet.Contains(ip) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseIPRange parses an IP address or a CIDR range, the IP address is treated as a single-host range.
+func parseIPRange(r string) (*net.IPNet, error) {
+	if strings.Contains(r, "/") {
+		_, ipNet, err := net.ParseCIDR(r)
+		if err != nil {
+			return nil, errs.Wrapf(err, "invalid CIDR %q", r)
+		}
+		return ipNet, nil
+	}
+	ip := net.ParseIP(r)
+	if ip == nil {
+		return nil, errs.Errorf("invalid IP address %q", r)
+	}
+	bits := 8 * net.IPv4len
+	if ip.To4() == nil {
+		bits = 8 * net.IPv6len
+	} else {
+		ip = ip.To4()
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// GetClientIP returns the client IP address of the request.
+// The X-Forwarded-For header is expected to be set by the trusted reverse proxy, the left-most
+// address is the client. Otherwise, the peer address of the connection is used.
+func GetClientIP(ctx context.Context) net.IP {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, v := range md.Get("x-forwarded-for") {
+			first, _, _ := strings.Cut(v, ",")
+			if ip := net.ParseIP(strings.TrimSpace(first)); ip != nil {
+				return ip
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	return net.ParseIP(host)
+}
+
+// GetClientUserAgent returns the user agent of the request.
+func GetClientUserAgent(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	// The grpc-gateway forwards the HTTP user agent with the prefix.
+	for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
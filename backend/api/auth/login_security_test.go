@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestIsIPAllowed(t *testing.T) {
+	tests := []struct {
+		ip        string
+		allowlist []string
+		denylist  []string
+		want      bool
+	}{
+		{ip: "10.1.2.3", want: true},
+		{ip: "10.1.2.3", allowlist: []string{"10.0.0.0/8"}, want: true},
+		{ip: "192.168.1.1", allowlist: []string{"10.0.0.0/8"}, want: false},
+		{ip: "192.168.1.1", allowlist: []string{"10.0.0.0/8", "192.168.1.1"}, want: true},
+		{ip: "10.1.2.3", allowlist: []string{"10.0.0.0/8"}, denylist: []string{"10.1.0.0/16"}, want: false},
+		{ip: "10.2.0.1", denylist: []string{"10.1.0.0/16"}, want: true},
+		{ip: "2001:db8::1", allowlist: []string{"2001:db8::/32"}, want: true},
+		{ip: "2001:db9::1", allowlist: []string{"2001:db8::/32"}, want: false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := IsIPAllowed(net.ParseIP(test.ip), test.allowlist, test.denylist)
+		a.NoError(err)
+		a.Equal(test.want, got, test)
+	}
+
+	a.NoError(ValidateIPRanges([]string{"10.0.0.0/8", "127.0.0.1", "::1"}))
+	a.Error(ValidateIPRanges([]string{"10.0.0.0/33"}))
+	a.Error(ValidateIPRanges([]string{"localhost"}))
+}
+
+func TestIsReauthRequired(t *testing.T) {
+	a := require.New(t)
+
+	loginSecurity := &storepb.LoginSecurity{ReauthInterval: durationpb.New(time.Hour)}
+	a.True(IsReauthRequired("/bytebase.v1.IssueService/ApproveIssue", loginSecurity))
+	a.False(IsReauthRequired("/bytebase.v1.IssueService/GetIssue", loginSecurity))
+	a.False(IsReauthRequired("/bytebase.v1.IssueService/ApproveIssue", &storepb.LoginSecurity{}))
+	a.False(IsReauthRequired("/bytebase.v1.IssueService/ApproveIssue", nil))
+}
@@ -0,0 +1,328 @@
+// Package chatops is the package for the ChatOps commands sent from Slack and Teams, e.g. /bytebase approve issue-123.
+//
+// The chat user is mapped to the Bytebase user by the email, and the commands execute the v1 APIs
+// through the same ACL and audit interceptors as the API requests of the user.
+package chatops
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gosimple/slug"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const usage = "Usage:\n" +
+	"/bytebase approve issue-123\n" +
+	"/bytebase status issue-123\n" +
+	"/bytebase rollout issue-123 stage prod"
+
+// Service is the API endpoint for handling the ChatOps commands.
+type Service struct {
+	store          *store.Store
+	aclProvider    *apiv1.ACLInterceptor
+	auditProvider  *apiv1.AuditInterceptor
+	issueService   *apiv1.IssueService
+	rolloutService *apiv1.RolloutService
+}
+
+// NewService creates a ChatOps service.
+func NewService(store *store.Store, aclProvider *apiv1.ACLInterceptor, auditProvider *apiv1.AuditInterceptor, issueService *apiv1.IssueService, rolloutService *apiv1.RolloutService) *Service {
+	return &Service{
+		store:          store,
+		aclProvider:    aclProvider,
+		auditProvider:  auditProvider,
+		issueService:   issueService,
+		rolloutService: rolloutService,
+	}
+}
+
+// RegisterChatOpsRoutes registers the ChatOps routes.
+func (s *Service) RegisterChatOpsRoutes(g *echo.Group) {
+	g.POST("/chatops/slack", s.handleSlack)
+	g.POST("/chatops/teams", s.handleTeams)
+}
+
+type command struct {
+	action   string
+	issueUID int
+	stage    string
+}
+
+// parseCommand parses the command text, e.g. "rollout issue-123 stage prod".
+func parseCommand(text string) (*command, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return nil, errors.New("command is required")
+	}
+	cmd := &command{action: strings.ToLower(fields[0])}
+	for i := 1; i < len(fields); i++ {
+		field := fields[i]
+		if strings.EqualFold(field, "stage") {
+			if i+1 >= len(fields) {
+				return nil, errors.New("stage is required after \"stage\"")
+			}
+			cmd.stage = fields[i+1]
+			i++
+			continue
+		}
+		if len(field) > len("issue-") && strings.EqualFold(field[:len("issue-")], "issue-") {
+			uid, err := strconv.Atoi(field[len("issue-"):])
+			if err != nil || uid <= 0 {
+				return nil, errors.Errorf("invalid issue %q", field)
+			}
+			cmd.issueUID = uid
+			continue
+		}
+		return nil, errors.Errorf("unexpected argument %q", field)
+	}
+	switch cmd.action {
+	case "approve", "status":
+	case "rollout":
+		if cmd.stage == "" {
+			return nil, errors.New("stage is required to rollout")
+		}
+	default:
+		return nil, errors.Errorf("unknown command %q", cmd.action)
+	}
+	if cmd.issueUID == 0 {
+		return nil, errors.New("issue is required, e.g. issue-123")
+	}
+	return cmd, nil
+}
+
+// run runs the command text for the user and returns the reply.
+func (s *Service) run(ctx context.Context, user *store.UserMessage, text string) string {
+	cmd, err := parseCommand(text)
+	if err != nil {
+		return fmt.Sprintf("%s.\n%s", err.Error(), usage)
+	}
+	reply, err := s.execute(ctx, user, cmd)
+	if err != nil {
+		// Show the gRPC status message without the code.
+		return fmt.Sprintf("Failed to %s issue-%d: %s", cmd.action, cmd.issueUID, status.Convert(err).Message())
+	}
+	return reply
+}
+
+func (s *Service) execute(ctx context.Context, user *store.UserMessage, cmd *command) (string, error) {
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &cmd.issueUID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get issue")
+	}
+	if issue == nil {
+		return "", errors.Errorf("issue-%d not found", cmd.issueUID)
+	}
+	issueName := common.FormatIssue(issue.Project.ResourceID, issue.UID)
+
+	switch cmd.action {
+	case "approve":
+		if _, err := s.invoke(ctx, user, v1pb.IssueService_ApproveIssue_FullMethodName, &v1pb.ApproveIssueRequest{Name: issueName}, func(ctx context.Context, request any) (any, error) {
+			return s.issueService.ApproveIssue(ctx, request.(*v1pb.ApproveIssueRequest))
+		}); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Approved issue-%d %q.\n%s", issue.UID, issue.Title, s.getIssueLink(ctx, issue)), nil
+	case "status":
+		return s.getStatus(ctx, user, issue, issueName)
+	case "rollout":
+		return s.rollout(ctx, user, issue, cmd.stage)
+	default:
+		return "", errors.Errorf("unknown command %q", cmd.action)
+	}
+}
+
+func (s *Service) getStatus(ctx context.Context, user *store.UserMessage, issue *store.IssueMessage, issueName string) (string, error) {
+	response, err := s.invoke(ctx, user, v1pb.IssueService_GetIssue_FullMethodName, &v1pb.GetIssueRequest{Name: issueName}, func(ctx context.Context, request any) (any, error) {
+		return s.issueService.GetIssue(ctx, request.(*v1pb.GetIssueRequest))
+	})
+	if err != nil {
+		return "", err
+	}
+	v1Issue := response.(*v1pb.Issue)
+	lines := []string{
+		fmt.Sprintf("issue-%d %q", issue.UID, v1Issue.Title),
+		fmt.Sprintf("Status: %s", v1Issue.Status),
+		fmt.Sprintf("Approval: %s", getApprovalStatus(v1Issue)),
+	}
+	if v1Issue.Rollout != "" {
+		response, err := s.invoke(ctx, user, v1pb.RolloutService_GetRollout_FullMethodName, &v1pb.GetRolloutRequest{Name: v1Issue.Rollout}, func(ctx context.Context, request any) (any, error) {
+			return s.rolloutService.GetRollout(ctx, request.(*v1pb.GetRolloutRequest))
+		})
+		if err != nil {
+			return "", err
+		}
+		for _, stage := range response.(*v1pb.Rollout).Stages {
+			lines = append(lines, fmt.Sprintf("Stage %s: %s", stage.Title, getStageStatus(stage)))
+		}
+	}
+	lines = append(lines, s.getIssueLink(ctx, issue))
+	return strings.Join(lines, "\n"), nil
+}
+
+func (s *Service) rollout(ctx context.Context, user *store.UserMessage, issue *store.IssueMessage, stageArg string) (string, error) {
+	if issue.PipelineUID == nil {
+		return "", errors.Errorf("issue-%d has no rollout", issue.UID)
+	}
+	stages, err := s.store.ListStageV2(ctx, *issue.PipelineUID)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list stages")
+	}
+	var stageToRun *store.StageMessage
+	for _, stage := range stages {
+		environmentID := stage.EnvironmentID
+		environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{UID: &environmentID})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get environment")
+		}
+		// The stage is matched by the environment id, the environment title or the stage name.
+		if strings.EqualFold(stage.Name, stageArg) || (environment != nil && (strings.EqualFold(environment.ResourceID, stageArg) || strings.EqualFold(environment.Title, stageArg))) {
+			stageToRun = stage
+			break
+		}
+	}
+	if stageToRun == nil {
+		return "", errors.Errorf("stage %q not found", stageArg)
+	}
+
+	tasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: issue.PipelineUID, StageID: &stageToRun.ID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list tasks")
+	}
+	var taskNames []string
+	for _, task := range tasks {
+		skipped, err := utils.GetTaskSkipped(task)
+		if err != nil {
+			return "", err
+		}
+		if skipped {
+			continue
+		}
+		switch task.LatestTaskRunStatus {
+		case api.TaskRunNotStarted, api.TaskRunFailed, api.TaskRunCanceled:
+			taskNames = append(taskNames, common.FormatTask(issue.Project.ResourceID, task.PipelineID, task.StageID, task.ID))
+		default:
+		}
+	}
+	if len(taskNames) == 0 {
+		return fmt.Sprintf("No task to run in stage %s of issue-%d.", stageToRun.Name, issue.UID), nil
+	}
+
+	request := &v1pb.BatchRunTasksRequest{
+		Parent: fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.RolloutPrefix, *issue.PipelineUID, common.StagePrefix, stageToRun.ID),
+		Tasks:  taskNames,
+		Reason: "Rolled out by the ChatOps command.",
+	}
+	if _, err := s.invoke(ctx, user, v1pb.RolloutService_BatchRunTasks_FullMethodName, request, func(ctx context.Context, request any) (any, error) {
+		return s.rolloutService.BatchRunTasks(ctx, request.(*v1pb.BatchRunTasksRequest))
+	}); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Started %d task(s) in stage %s of issue-%d %q.\n%s", len(taskNames), stageToRun.Name, issue.UID, issue.Title, s.getIssueLink(ctx, issue)), nil
+}
+
+// invoke invokes the v1 API handler as the user with the same permission checks and audit logs of the API interceptors.
+func (s *Service) invoke(ctx context.Context, user *store.UserMessage, fullMethod string, request any, handler grpc.UnaryHandler) (any, error) {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get workspace setting")
+	}
+	if err := checkReauth(fullMethod, setting.GetLoginSecurity()); err != nil {
+		return nil, err
+	}
+	authContext, err := auth.GetAuthContext(fullMethod)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, common.AuthContextKey, authContext)
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, user.ID)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: fullMethod}
+	return s.aclProvider.ACLInterceptor(ctx, request, serverInfo, func(ctx context.Context, request any) (any, error) {
+		return s.auditProvider.AuditInterceptor(ctx, request, serverInfo, handler)
+	})
+}
+
+// checkReauth refuses the methods requiring re-authentication, because the chat user has no sign-in session to re-authenticate.
+func checkReauth(fullMethod string, loginSecurity *storepb.LoginSecurity) error {
+	if auth.IsReauthRequired(fullMethod, loginSecurity) {
+		return status.Errorf(codes.Unauthenticated, "re-authentication is required by the workspace, please do it in Bytebase")
+	}
+	return nil
+}
+
+// getUser gets the active Bytebase user by the email of the chat user.
+func (s *Service) getUser(ctx context.Context, email string) (*store.UserMessage, error) {
+	user, err := s.store.GetUserByEmail(ctx, strings.ToLower(email))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user")
+	}
+	if user == nil {
+		return nil, errors.Errorf("no Bytebase user with email %s", email)
+	}
+	if user.MemberDeleted {
+		return nil, errors.Errorf("the Bytebase user %s has been deactivated", email)
+	}
+	if user.Type != api.EndUser {
+		return nil, errors.Errorf("the Bytebase user %s is not an end user", email)
+	}
+	return user, nil
+}
+
+func (s *Service) getIssueLink(ctx context.Context, issue *store.IssueMessage) string {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil || setting.ExternalUrl == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/projects/%s/issues/%s-%d", setting.ExternalUrl, issue.Project.ResourceID, slug.Make(issue.Title), issue.UID)
+}
+
+func getApprovalStatus(issue *v1pb.Issue) string {
+	if !issue.ApprovalFindingDone {
+		return "PENDING"
+	}
+	if issue.ApprovalFindingError != "" {
+		return "ERROR"
+	}
+	for _, approver := range issue.Approvers {
+		if approver.Status == v1pb.Issue_Approver_REJECTED {
+			return "REJECTED"
+		}
+	}
+	approvalSteps := 0
+	for _, template := range issue.ApprovalTemplates {
+		approvalSteps += len(template.GetFlow().GetSteps())
+	}
+	if len(issue.Approvers) >= approvalSteps {
+		return "APPROVED"
+	}
+	return fmt.Sprintf("PENDING (%d/%d)", len(issue.Approvers), approvalSteps)
+}
+
+func getStageStatus(stage *v1pb.Stage) string {
+	counts := make(map[v1pb.Task_Status]int)
+	for _, task := range stage.Tasks {
+		counts[task.Status]++
+	}
+	var parts []string
+	for _, s := range []v1pb.Task_Status{v1pb.Task_DONE, v1pb.Task_RUNNING, v1pb.Task_PENDING, v1pb.Task_FAILED, v1pb.Task_CANCELED, v1pb.Task_SKIPPED, v1pb.Task_NOT_STARTED} {
+		if counts[s] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[s], s))
+		}
+	}
+	return fmt.Sprintf("%d task(s), %s", len(stage.Tasks), strings.Join(parts, ", "))
+}
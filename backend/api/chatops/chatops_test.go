@@ -0,0 +1,58 @@
+package chatops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    *command
+		wantErr bool
+	}{
+		{text: "approve issue-123", want: &command{action: "approve", issueUID: 123}},
+		{text: "Status ISSUE-7", want: &command{action: "status", issueUID: 7}},
+		{text: "rollout issue-123 stage prod", want: &command{action: "rollout", issueUID: 123, stage: "prod"}},
+		{text: "rollout stage prod issue-123", want: &command{action: "rollout", issueUID: 123, stage: "prod"}},
+		{text: "", wantErr: true},
+		{text: "approve", wantErr: true},
+		{text: "approve issue-abc", wantErr: true},
+		{text: "rollout issue-123", wantErr: true},
+		{text: "rollout issue-123 stage", wantErr: true},
+		{text: "merge issue-123", wantErr: true},
+		{text: "status issue-123 now", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := parseCommand(test.text)
+		if test.wantErr {
+			require.Error(t, err, test.text)
+			continue
+		}
+		require.NoError(t, err, test.text)
+		require.Equal(t, test.want, got, test.text)
+	}
+}
+
+func TestGetTeamsCommandText(t *testing.T) {
+	require.Equal(t, "status issue-123", getTeamsCommandText("<at>Bytebase</at> status issue-123"))
+	require.Equal(t, "approve issue-123", getTeamsCommandText("<at>Bytebase</at>&nbsp;/bytebase approve issue-123\n"))
+	require.Equal(t, "rollout issue-1 stage prod", getTeamsCommandText("<p><at>Bytebase</at> rollout issue-1 stage prod</p>"))
+}
+
+func TestCheckReauth(t *testing.T) {
+	a := require.New(t)
+
+	loginSecurity := &storepb.LoginSecurity{ReauthInterval: durationpb.New(time.Hour)}
+	// The chat user cannot re-authenticate, so the approval is refused.
+	a.Error(checkReauth(v1pb.IssueService_ApproveIssue_FullMethodName, loginSecurity))
+	a.NoError(checkReauth(v1pb.IssueService_GetIssue_FullMethodName, loginSecurity))
+	a.NoError(checkReauth(v1pb.RolloutService_BatchRunTasks_FullMethodName, loginSecurity))
+	a.NoError(checkReauth(v1pb.IssueService_ApproveIssue_FullMethodName, &storepb.LoginSecurity{}))
+}
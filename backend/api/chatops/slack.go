@@ -0,0 +1,58 @@
+package chatops
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/slack"
+)
+
+// slackResponse is the response of the slash command, which is only visible to the user.
+// https://api.slack.com/interactivity/slash-commands#responding_to_commands
+type slackResponse struct {
+	ResponseType string `json:"response_type"`
+	Text         string `json:"text"`
+}
+
+func (s *Service) handleSlack(c echo.Context) error {
+	ctx := c.Request().Context()
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to read request body")
+	}
+	setting, err := s.store.GetAppIMSetting(ctx)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to get app im setting")
+	}
+	slackSetting := setting.GetSlack()
+	if !slackSetting.GetEnabled() || slackSetting.GetSigningSecret() == "" {
+		return c.String(http.StatusForbidden, "Slack ChatOps is not enabled")
+	}
+	if err := slack.VerifySignature(slackSetting.SigningSecret, c.Request().Header.Get("X-Slack-Request-Timestamp"), c.Request().Header.Get("X-Slack-Signature"), body, time.Now()); err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return c.String(http.StatusBadRequest, "invalid slash command")
+	}
+
+	reply := func(text string) error {
+		return c.JSON(http.StatusOK, &slackResponse{ResponseType: "ephemeral", Text: text})
+	}
+	email, err := slack.GetUserEmail(ctx, slackSetting.Token, values.Get("user_id"))
+	if err != nil {
+		slog.Warn("failed to get the email of the Slack user", slog.String("user", values.Get("user_id")), log.BBError(err))
+		return reply("Failed to get your email from Slack.")
+	}
+	user, err := s.getUser(ctx, email)
+	if err != nil {
+		return reply(err.Error())
+	}
+	return reply(s.run(ctx, user, values.Get("text")))
+}
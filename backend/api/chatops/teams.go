@@ -0,0 +1,85 @@
+package chatops
+
+import (
+	"encoding/json"
+	"html"
+	"io"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook/teams"
+)
+
+// teamsActivity is the message activity sent by the Teams outgoing webhook.
+// https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/add-outgoing-webhook
+type teamsActivity struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+	From struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		AADObjectID string `json:"aadObjectId"`
+	} `json:"from"`
+}
+
+// teamsResponse is the reply message of the outgoing webhook.
+type teamsResponse struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+var (
+	teamsMentionRegex = regexp.MustCompile(`<at>[^<]*</at>`)
+	teamsTagRegex     = regexp.MustCompile(`<[^>]*>`)
+)
+
+// getTeamsCommandText gets the command text from the message, e.g. "<at>Bytebase</at> status issue-123" is "status issue-123".
+func getTeamsCommandText(text string) string {
+	text = teamsMentionRegex.ReplaceAllString(text, " ")
+	text = teamsTagRegex.ReplaceAllString(text, " ")
+	text = strings.TrimSpace(html.UnescapeString(text))
+	// The slash command is optional so that "@Bytebase /bytebase approve issue-123" works as well.
+	return strings.TrimSpace(strings.TrimPrefix(text, "/bytebase"))
+}
+
+func (s *Service) handleTeams(c echo.Context) error {
+	ctx := c.Request().Context()
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return c.String(http.StatusBadRequest, "failed to read request body")
+	}
+	setting, err := s.store.GetAppIMSetting(ctx)
+	if err != nil {
+		return c.String(http.StatusInternalServerError, "failed to get app im setting")
+	}
+	teamsSetting := setting.GetTeams()
+	if !teamsSetting.GetEnabled() || teamsSetting.GetSecurityToken() == "" {
+		return c.String(http.StatusForbidden, "Teams ChatOps is not enabled")
+	}
+	if err := teams.VerifySignature(teamsSetting.SecurityToken, c.Request().Header.Get("Authorization"), body); err != nil {
+		return c.String(http.StatusUnauthorized, err.Error())
+	}
+	activity := &teamsActivity{}
+	if err := json.Unmarshal(body, activity); err != nil {
+		return c.String(http.StatusBadRequest, "invalid message")
+	}
+
+	reply := func(text string) error {
+		return c.JSON(http.StatusOK, &teamsResponse{Type: "message", Text: text})
+	}
+	email, err := teams.GetUserEmail(ctx, teamsSetting.TenantId, teamsSetting.ClientId, teamsSetting.ClientSecret, activity.From.AADObjectID)
+	if err != nil {
+		slog.Warn("failed to get the email of the Teams user", slog.String("user", activity.From.AADObjectID), log.BBError(err))
+		return reply("Failed to get your email from Microsoft Entra ID.")
+	}
+	user, err := s.getUser(ctx, email)
+	if err != nil {
+		return reply(err.Error())
+	}
+	return reply(s.run(ctx, user, getTeamsCommandText(activity.Text)))
+}
@@ -0,0 +1,74 @@
+// Package directorysync implements the SCIM 2.0 APIs for provisioning users and groups from the identity providers.
+//
+// ref: https://datatracker.ietf.org/doc/html/rfc7644
+package directorysync
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bytebase/bytebase/backend/component/iam"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// Service is the API endpoint for handling SCIM requests.
+type Service struct {
+	store          *store.Store
+	licenseService enterprise.LicenseService
+	iamManager     *iam.Manager
+}
+
+// NewService creates a directory sync service.
+func NewService(store *store.Store, licenseService enterprise.LicenseService, iamManager *iam.Manager) *Service {
+	return &Service{
+		store:          store,
+		licenseService: licenseService,
+		iamManager:     iamManager,
+	}
+}
+
+// RegisterDirectorySyncRoutes registers the SCIM routes.
+func (s *Service) RegisterDirectorySyncRoutes(g *echo.Group) {
+	g.Use(renderError, s.authenticate)
+
+	g.GET("/Users", s.listUsers)
+	g.GET("/Users/:id", s.getUser)
+	g.POST("/Users", s.createUser)
+	g.PUT("/Users/:id", s.replaceUser)
+	g.PATCH("/Users/:id", s.patchUser)
+	g.DELETE("/Users/:id", s.deleteUser)
+
+	g.GET("/Groups", s.listGroups)
+	g.GET("/Groups/:id", s.getGroup)
+	g.POST("/Groups", s.createGroup)
+	g.PUT("/Groups/:id", s.replaceGroup)
+	g.PATCH("/Groups/:id", s.patchGroup)
+	g.DELETE("/Groups/:id", s.deleteGroup)
+}
+
+// authenticate validates the bearer token against the directory sync token in the workspace profile.
+func (s *Service) authenticate(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+			return newError(http.StatusForbidden, err.Error())
+		}
+		setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return newError(http.StatusInternalServerError, "failed to get workspace setting")
+		}
+		if setting.DirectorySyncToken == "" {
+			return newError(http.StatusUnauthorized, "directory sync is not enabled")
+		}
+		token, ok := strings.CutPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(setting.DirectorySyncToken)) != 1 {
+			return newError(http.StatusUnauthorized, "invalid bearer token")
+		}
+		return next(c)
+	}
+}
@@ -0,0 +1,329 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// The group id in SCIM is the group email in Bytebase.
+func (s *Service) listGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	groups, err := s.store.ListGroups(ctx, &store.FindGroupMessage{})
+	if err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to list groups, error: %v", err))
+	}
+	if filter := c.QueryParam("filter"); filter != "" {
+		attribute, value, err := parseEqualFilter(filter)
+		if err != nil {
+			return newError(http.StatusBadRequest, err.Error())
+		}
+		if !strings.EqualFold(attribute, "displayName") {
+			return newError(http.StatusBadRequest, fmt.Sprintf("unsupported filter attribute %q", attribute))
+		}
+		groups = slices.DeleteFunc(groups, func(g *store.GroupMessage) bool {
+			return g.Title != value
+		})
+	}
+	// Don't return the members in the list, it could be expensive for the large groups.
+	excludeMembers := c.QueryParam("excludedAttributes") == "members"
+	var resources []*group
+	for _, g := range groups {
+		resources = append(resources, convertToSCIMGroup(g, excludeMembers))
+	}
+	return c.JSON(http.StatusOK, newListResponse(c, resources))
+}
+
+func (s *Service) getGroup(c echo.Context) error {
+	g, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToSCIMGroup(g, false /* excludeMembers */))
+}
+
+func (s *Service) createGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	var create group
+	if err := json.NewDecoder(c.Request().Body).Decode(&create); err != nil {
+		return newError(http.StatusBadRequest, fmt.Sprintf("failed to decode group, error: %v", err))
+	}
+	if create.DisplayName == "" {
+		return newError(http.StatusBadRequest, "displayName is required")
+	}
+	groupEmail, err := s.getGroupEmail(c, &create)
+	if err != nil {
+		return err
+	}
+	existingGroup, err := s.store.GetGroup(ctx, groupEmail)
+	if err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to get group, error: %v", err))
+	}
+	if existingGroup != nil {
+		return newError(http.StatusConflict, fmt.Sprintf("group %q already exists", groupEmail))
+	}
+	members, err := s.convertToGroupMembers(c, create.Members)
+	if err != nil {
+		return err
+	}
+
+	g, err := s.store.CreateGroup(ctx, &store.GroupMessage{
+		Email: groupEmail,
+		Title: create.DisplayName,
+		Payload: &storepb.GroupPayload{
+			Members: members,
+			Source:  scimSource,
+		},
+	}, api.SystemBotID)
+	if err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to create group, error: %v", err))
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusCreated, convertToSCIMGroup(g, false /* excludeMembers */))
+}
+
+func (s *Service) replaceGroup(c echo.Context) error {
+	g, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	var replace group
+	if err := json.NewDecoder(c.Request().Body).Decode(&replace); err != nil {
+		return newError(http.StatusBadRequest, fmt.Sprintf("failed to decode group, error: %v", err))
+	}
+	members, err := s.convertToGroupMembers(c, replace.Members)
+	if err != nil {
+		return err
+	}
+	patch := &store.UpdateGroupMessage{
+		Payload: &storepb.GroupPayload{
+			Members: members,
+			Source:  scimSource,
+		},
+	}
+	if replace.DisplayName != "" {
+		patch.Title = &replace.DisplayName
+	}
+	g, err = s.updateGroup(c, g.Email, patch)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToSCIMGroup(g, false /* excludeMembers */))
+}
+
+func (s *Service) patchGroup(c echo.Context) error {
+	g, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	var request patchRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&request); err != nil {
+		return newError(http.StatusBadRequest, fmt.Sprintf("failed to decode patch request, error: %v", err))
+	}
+
+	patch := &store.UpdateGroupMessage{}
+	members := g.Payload.GetMembers()
+	for _, operation := range request.Operations {
+		op := strings.ToLower(operation.Op)
+		switch {
+		case operation.Path == "displayName" && op == "replace":
+			var title string
+			if err := json.Unmarshal(operation.Value, &title); err != nil {
+				return newError(http.StatusBadRequest, fmt.Sprintf("invalid displayName, error: %v", err))
+			}
+			patch.Title = &title
+		case operation.Path == "" && op == "replace":
+			// The value is a partial group, e.g. {"displayName": "dba"}.
+			var value group
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return newError(http.StatusBadRequest, fmt.Sprintf("invalid value, error: %v", err))
+			}
+			if value.DisplayName != "" {
+				patch.Title = &value.DisplayName
+			}
+			if value.Members != nil {
+				if members, err = s.convertToGroupMembers(c, value.Members); err != nil {
+					return err
+				}
+			}
+		case operation.Path == "members":
+			var values []groupMember
+			if len(operation.Value) > 0 {
+				if err := json.Unmarshal(operation.Value, &values); err != nil {
+					return newError(http.StatusBadRequest, fmt.Sprintf("invalid members, error: %v", err))
+				}
+			}
+			changed, err := s.convertToGroupMembers(c, values)
+			if err != nil {
+				return err
+			}
+			switch op {
+			case "add":
+				members = addGroupMembers(members, changed)
+			case "remove":
+				if len(operation.Value) == 0 {
+					// Remove all members if no value is specified.
+					members = nil
+				} else {
+					members = removeGroupMembers(members, changed)
+				}
+			case "replace":
+				members = changed
+			default:
+				return newError(http.StatusBadRequest, fmt.Sprintf("unsupported operation %q", operation.Op))
+			}
+		default:
+			// Azure AD removes the member with the path members[value eq "{id}"].
+			id, ok := parseMemberPath(operation.Path)
+			if !ok || op != "remove" {
+				return newError(http.StatusBadRequest, fmt.Sprintf("unsupported operation %q on path %q", operation.Op, operation.Path))
+			}
+			changed, err := s.convertToGroupMembers(c, []groupMember{{Value: id}})
+			if err != nil {
+				return err
+			}
+			members = removeGroupMembers(members, changed)
+		}
+	}
+	patch.Payload = &storepb.GroupPayload{
+		Members: members,
+		Source:  scimSource,
+	}
+	g, err = s.updateGroup(c, g.Email, patch)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToSCIMGroup(g, false /* excludeMembers */))
+}
+
+func (s *Service) deleteGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	g, err := s.findGroup(c)
+	if err != nil {
+		return err
+	}
+	if err := s.store.DeleteGroup(ctx, g.Email); err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to delete group, error: %v", err))
+	}
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Service) updateGroup(c echo.Context, groupEmail string, patch *store.UpdateGroupMessage) (*store.GroupMessage, error) {
+	ctx := c.Request().Context()
+	g, err := s.store.UpdateGroup(ctx, groupEmail, patch, api.SystemBotID)
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, fmt.Sprintf("failed to update group, error: %v", err))
+	}
+	// The group members are used in the IAM policy check.
+	if err := s.iamManager.ReloadCache(ctx); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+func (s *Service) findGroup(c echo.Context) (*store.GroupMessage, error) {
+	g, err := s.store.GetGroup(c.Request().Context(), c.Param("id"))
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, fmt.Sprintf("failed to get group, error: %v", err))
+	}
+	if g == nil {
+		return nil, newError(http.StatusNotFound, fmt.Sprintf("group %q not found", c.Param("id")))
+	}
+	return g, nil
+}
+
+var invalidGroupNameRegexp = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// getGroupEmail returns the email for the group. The display name is used if it is an email,
+// otherwise the email is generated from the display name and the first workspace domain.
+func (s *Service) getGroupEmail(c echo.Context, g *group) (string, error) {
+	if strings.Contains(g.DisplayName, "@") {
+		return strings.ToLower(g.DisplayName), nil
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(c.Request().Context())
+	if err != nil {
+		return "", newError(http.StatusInternalServerError, "failed to get workspace setting")
+	}
+	if len(setting.Domains) == 0 {
+		return "", newError(http.StatusBadRequest, "workspace domain is required to provision group without email")
+	}
+	name := strings.Trim(invalidGroupNameRegexp.ReplaceAllString(strings.ToLower(g.DisplayName), "-"), "-")
+	if name == "" {
+		return "", newError(http.StatusBadRequest, fmt.Sprintf("invalid group displayName %q", g.DisplayName))
+	}
+	return fmt.Sprintf("%s@%s", name, setting.Domains[0]), nil
+}
+
+// convertToGroupMembers converts the SCIM members to the Bytebase group members, the member value is the user id.
+func (s *Service) convertToGroupMembers(c echo.Context, members []groupMember) ([]*storepb.GroupMember, error) {
+	var result []*storepb.GroupMember
+	for _, member := range members {
+		id, err := strconv.Atoi(member.Value)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, fmt.Sprintf("invalid member %q", member.Value))
+		}
+		u, err := s.store.GetUserByID(c.Request().Context(), id)
+		if err != nil {
+			return nil, newError(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error: %v", err))
+		}
+		if u == nil {
+			return nil, newError(http.StatusBadRequest, fmt.Sprintf("member %q not found", member.Value))
+		}
+		result = append(result, &storepb.GroupMember{
+			Member: common.FormatUserUID(u.ID),
+			Role:   storepb.GroupMember_MEMBER,
+		})
+	}
+	return result, nil
+}
+
+func addGroupMembers(members, added []*storepb.GroupMember) []*storepb.GroupMember {
+	for _, member := range added {
+		if !slices.ContainsFunc(members, func(m *storepb.GroupMember) bool { return m.Member == member.Member }) {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+func removeGroupMembers(members, removed []*storepb.GroupMember) []*storepb.GroupMember {
+	return slices.DeleteFunc(slices.Clone(members), func(m *storepb.GroupMember) bool {
+		return slices.ContainsFunc(removed, func(r *storepb.GroupMember) bool { return m.Member == r.Member })
+	})
+}
+
+func convertToSCIMGroup(g *store.GroupMessage, excludeMembers bool) *group {
+	result := &group{
+		Schemas:     []string{groupSchema},
+		ID:          g.Email,
+		DisplayName: g.Title,
+		Meta:        &meta{ResourceType: "Group"},
+	}
+	if excludeMembers {
+		return result
+	}
+	for _, member := range g.Payload.GetMembers() {
+		id, err := common.GetUserID(member.Member)
+		if err != nil {
+			continue
+		}
+		result.Members = append(result.Members, groupMember{Value: strconv.Itoa(id)})
+	}
+	return result
+}
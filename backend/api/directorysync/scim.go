@@ -0,0 +1,208 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	userSchema         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	groupSchema        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	listResponseSchema = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	errorSchema        = "urn:ietf:params:scim:api:messages:2.0:Error"
+
+	// scimSource is the group source for the groups provisioned by SCIM.
+	scimSource = "SCIM"
+)
+
+type meta struct {
+	ResourceType string `json:"resourceType"`
+}
+
+type userName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type email struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type user struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	ExternalID  string    `json:"externalId,omitempty"`
+	UserName    string    `json:"userName"`
+	Name        *userName `json:"name,omitempty"`
+	DisplayName string    `json:"displayName,omitempty"`
+	Emails      []email   `json:"emails,omitempty"`
+	Active      *bool     `json:"active,omitempty"`
+	Meta        *meta     `json:"meta,omitempty"`
+}
+
+// getEmail returns the email of the user, the userName is the email for the Bytebase users.
+func (u *user) getEmail() string {
+	if strings.Contains(u.UserName, "@") {
+		return strings.ToLower(u.UserName)
+	}
+	for _, e := range u.Emails {
+		if e.Primary {
+			return strings.ToLower(e.Value)
+		}
+	}
+	if len(u.Emails) > 0 {
+		return strings.ToLower(u.Emails[0].Value)
+	}
+	return strings.ToLower(u.UserName)
+}
+
+func (u *user) getTitle() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name != nil {
+		if u.Name.Formatted != "" {
+			return u.Name.Formatted
+		}
+		if name := strings.TrimSpace(fmt.Sprintf("%s %s", u.Name.GivenName, u.Name.FamilyName)); name != "" {
+			return name
+		}
+	}
+	return u.getEmail()
+}
+
+type groupMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type group struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []groupMember `json:"members,omitempty"`
+	Meta        *meta         `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type patchRequest struct {
+	Schemas    []string         `json:"schemas"`
+	Operations []patchOperation `json:"Operations"`
+}
+
+type errorMessage struct {
+	Schemas []string `json:"schemas"`
+	Status  string   `json:"status"`
+	Detail  string   `json:"detail"`
+}
+
+// scimError is the error returned by the handlers, it is rendered as the SCIM error response.
+type scimError struct {
+	status int
+	detail string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func newError(status int, detail string) error {
+	return &scimError{status: status, detail: detail}
+}
+
+// renderError renders the scimError returned by the handlers.
+func renderError(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		err := next(c)
+		var e *scimError
+		if errors.As(err, &e) {
+			return c.JSON(e.status, &errorMessage{
+				Schemas: []string{errorSchema},
+				Status:  strconv.Itoa(e.status),
+				Detail:  e.detail,
+			})
+		}
+		return err
+	}
+}
+
+// newListResponse paginates the resources with the 1-based startIndex and count query parameters.
+func newListResponse[T any](c echo.Context, resources []T) *listResponse {
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil || count < 0 {
+		count = len(resources)
+	}
+	response := &listResponse{
+		Schemas:      []string{listResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		Resources:    []any{},
+	}
+	for i := startIndex - 1; i < len(resources) && len(response.Resources) < count; i++ {
+		response.Resources = append(response.Resources, resources[i])
+	}
+	response.ItemsPerPage = len(response.Resources)
+	return response
+}
+
+var equalFilterRegexp = regexp.MustCompile(`^\s*(\w+)\s+eq\s+"(.*)"\s*$`)
+
+// parseEqualFilter parses the filter like `userName eq "bob@example.com"`, which is the only filter used by the identity providers for provisioning.
+func parseEqualFilter(filter string) (string, string, error) {
+	matches := equalFilterRegexp.FindStringSubmatch(filter)
+	if len(matches) != 3 {
+		return "", "", errors.Errorf("unsupported filter %q", filter)
+	}
+	return matches[1], matches[2], nil
+}
+
+var memberPathRegexp = regexp.MustCompile(`^members\[value eq "(.*)"\]$`)
+
+// parseMemberPath parses the path like `members[value eq "101"]` and returns the member id.
+func parseMemberPath(path string) (string, bool) {
+	matches := memberPathRegexp.FindStringSubmatch(path)
+	if len(matches) != 2 {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// parseBool parses the boolean value, Azure AD sends the boolean as the string "True" or "False".
+func parseBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return false, errors.Errorf("invalid boolean value %s", string(raw))
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
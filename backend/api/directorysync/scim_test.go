@@ -0,0 +1,62 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseEqualFilter(t *testing.T) {
+	a := require.New(t)
+
+	attribute, value, err := parseEqualFilter(`userName eq "bob@example.com"`)
+	a.NoError(err)
+	a.Equal("userName", attribute)
+	a.Equal("bob@example.com", value)
+
+	_, _, err = parseEqualFilter(`userName sw "bob"`)
+	a.Error(err)
+}
+
+func TestParseMemberPath(t *testing.T) {
+	a := require.New(t)
+
+	id, ok := parseMemberPath(`members[value eq "101"]`)
+	a.True(ok)
+	a.Equal("101", id)
+
+	_, ok = parseMemberPath("members")
+	a.False(ok)
+}
+
+func TestParseBool(t *testing.T) {
+	a := require.New(t)
+
+	for raw, want := range map[string]bool{
+		`true`:    true,
+		`false`:   false,
+		`"True"`:  true,
+		`"False"`: false,
+	} {
+		got, err := parseBool(json.RawMessage(raw))
+		a.NoError(err)
+		a.Equal(want, got, raw)
+	}
+
+	_, err := parseBool(json.RawMessage(`"yes"`))
+	a.Error(err)
+}
+
+func TestGroupMembers(t *testing.T) {
+	a := require.New(t)
+
+	members := []*storepb.GroupMember{{Member: "users/101"}, {Member: "users/102"}}
+	members = addGroupMembers(members, []*storepb.GroupMember{{Member: "users/102"}, {Member: "users/103"}})
+	a.Len(members, 3)
+
+	members = removeGroupMembers(members, []*storepb.GroupMember{{Member: "users/101"}})
+	a.Equal([]*storepb.GroupMember{{Member: "users/102"}, {Member: "users/103"}}, members)
+}
@@ -0,0 +1,310 @@
+package directorysync
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func (s *Service) listUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	endUser := api.EndUser
+	find := &store.FindUserMessage{Type: &endUser, ShowDeleted: true}
+	if filter := c.QueryParam("filter"); filter != "" {
+		attribute, value, err := parseEqualFilter(filter)
+		if err != nil {
+			return newError(http.StatusBadRequest, err.Error())
+		}
+		if !strings.EqualFold(attribute, "userName") {
+			return newError(http.StatusBadRequest, fmt.Sprintf("unsupported filter attribute %q", attribute))
+		}
+		email := strings.ToLower(value)
+		find.Email = &email
+	}
+	users, err := s.store.ListUsers(ctx, find)
+	if err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to list users, error: %v", err))
+	}
+	var resources []*user
+	for _, u := range users {
+		resources = append(resources, convertToSCIMUser(u))
+	}
+	return c.JSON(http.StatusOK, newListResponse(c, resources))
+}
+
+func (s *Service) getUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToSCIMUser(u))
+}
+
+func (s *Service) createUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	var create user
+	if err := json.NewDecoder(c.Request().Body).Decode(&create); err != nil {
+		return newError(http.StatusBadRequest, fmt.Sprintf("failed to decode user, error: %v", err))
+	}
+	email := create.getEmail()
+	if err := s.validateEmail(c, email); err != nil {
+		return err
+	}
+
+	existingUser, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to find user, error: %v", err))
+	}
+	if existingUser != nil {
+		if !existingUser.MemberDeleted {
+			return newError(http.StatusConflict, fmt.Sprintf("user %q already exists", email))
+		}
+		// Re-provision the deactivated user.
+		if create.Active == nil {
+			active := true
+			create.Active = &active
+		}
+		u, err := s.updateUser(c, existingUser, &create)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusCreated, convertToSCIMUser(u))
+	}
+	if err := s.userCountGuard(c); err != nil {
+		return err
+	}
+
+	// The provisioned users sign in with SSO, so the password is random.
+	password, err := common.RandomString(20)
+	if err != nil {
+		return newError(http.StatusInternalServerError, "failed to generate password")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return newError(http.StatusInternalServerError, "failed to generate password hash")
+	}
+	u, err := s.store.CreateUser(ctx, &store.UserMessage{
+		Email:        email,
+		Name:         create.getTitle(),
+		Type:         api.EndUser,
+		PasswordHash: string(passwordHash),
+	}, api.SystemBotID)
+	if err != nil {
+		return newError(http.StatusInternalServerError, fmt.Sprintf("failed to create user, error: %v", err))
+	}
+	if create.Active != nil && !*create.Active {
+		u, err = s.updateUser(c, u, &user{Active: create.Active})
+		if err != nil {
+			return err
+		}
+	}
+	return c.JSON(http.StatusCreated, convertToSCIMUser(u))
+}
+
+func (s *Service) replaceUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	var replace user
+	if err := json.NewDecoder(c.Request().Body).Decode(&replace); err != nil {
+		return newError(http.StatusBadRequest, fmt.Sprintf("failed to decode user, error: %v", err))
+	}
+	u, err = s.updateUser(c, u, &replace)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToSCIMUser(u))
+}
+
+func (s *Service) patchUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	var request patchRequest
+	if err := json.NewDecoder(c.Request().Body).Decode(&request); err != nil {
+		return newError(http.StatusBadRequest, fmt.Sprintf("failed to decode patch request, error: %v", err))
+	}
+
+	patch := &user{}
+	for _, operation := range request.Operations {
+		if !strings.EqualFold(operation.Op, "replace") && !strings.EqualFold(operation.Op, "add") {
+			return newError(http.StatusBadRequest, fmt.Sprintf("unsupported operation %q", operation.Op))
+		}
+		if operation.Path == "" {
+			// The value is a partial user, e.g. {"active": false}.
+			if err := json.Unmarshal(operation.Value, patch); err != nil {
+				return newError(http.StatusBadRequest, fmt.Sprintf("invalid value, error: %v", err))
+			}
+			continue
+		}
+		if err := applyUserPatch(patch, operation); err != nil {
+			return newError(http.StatusBadRequest, err.Error())
+		}
+	}
+	u, err = s.updateUser(c, u, patch)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, convertToSCIMUser(u))
+}
+
+// deleteUser deactivates the user, the user is kept for the audit and history.
+func (s *Service) deleteUser(c echo.Context) error {
+	u, err := s.findUser(c)
+	if err != nil {
+		return err
+	}
+	active := false
+	if _, err := s.updateUser(c, u, &user{Active: &active}); err != nil {
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func applyUserPatch(patch *user, operation patchOperation) error {
+	var value string
+	switch operation.Path {
+	case "active":
+		active, err := parseBool(operation.Value)
+		if err != nil {
+			return err
+		}
+		patch.Active = &active
+		return nil
+	case "userName", "displayName", "name.formatted", "name.givenName", "name.familyName", `emails[type eq "work"].value`:
+		if err := json.Unmarshal(operation.Value, &value); err != nil {
+			return errors.Errorf("invalid value for %q", operation.Path)
+		}
+	default:
+		// Ignore the attributes that Bytebase doesn't store, e.g. title and locale.
+		return nil
+	}
+	if patch.Name == nil {
+		patch.Name = &userName{}
+	}
+	switch operation.Path {
+	case "userName":
+		patch.UserName = value
+	case "displayName":
+		patch.DisplayName = value
+	case "name.formatted":
+		patch.Name.Formatted = value
+	case "name.givenName":
+		patch.Name.GivenName = value
+	case "name.familyName":
+		patch.Name.FamilyName = value
+	case `emails[type eq "work"].value`:
+		patch.Emails = []email{{Value: value, Type: "work", Primary: true}}
+	}
+	return nil
+}
+
+// updateUser applies the SCIM user to the Bytebase user.
+func (s *Service) updateUser(c echo.Context, u *store.UserMessage, update *user) (*store.UserMessage, error) {
+	ctx := c.Request().Context()
+	patch := &store.UpdateUserMessage{}
+	if update.UserName != "" || len(update.Emails) > 0 {
+		if email := update.getEmail(); email != u.Email {
+			if err := s.validateEmail(c, email); err != nil {
+				return nil, err
+			}
+			patch.Email = &email
+		}
+	}
+	if update.DisplayName != "" || update.Name != nil {
+		if title := update.getTitle(); title != "" && title != u.Name && title != update.getEmail() {
+			patch.Name = &title
+		}
+	}
+	if update.Active != nil && *update.Active == u.MemberDeleted {
+		if *update.Active {
+			if err := s.userCountGuard(c); err != nil {
+				return nil, err
+			}
+		}
+		deleted := !*update.Active
+		patch.Delete = &deleted
+	}
+	if patch.Email == nil && patch.Name == nil && patch.Delete == nil {
+		return u, nil
+	}
+	updated, err := s.store.UpdateUser(ctx, u, patch, api.SystemBotID)
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, fmt.Sprintf("failed to update user, error: %v", err))
+	}
+	return updated, nil
+}
+
+// findUser finds the end user by the id path parameter.
+func (s *Service) findUser(c echo.Context) (*store.UserMessage, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return nil, newError(http.StatusNotFound, fmt.Sprintf("user %q not found", c.Param("id")))
+	}
+	u, err := s.store.GetUserByID(c.Request().Context(), id)
+	if err != nil {
+		return nil, newError(http.StatusInternalServerError, fmt.Sprintf("failed to get user, error: %v", err))
+	}
+	if u == nil || u.Type != api.EndUser {
+		return nil, newError(http.StatusNotFound, fmt.Sprintf("user %q not found", c.Param("id")))
+	}
+	return u, nil
+}
+
+func (s *Service) validateEmail(c echo.Context, email string) error {
+	if !strings.Contains(email, "@") {
+		return newError(http.StatusBadRequest, fmt.Sprintf("invalid email %q", email))
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(c.Request().Context())
+	if err != nil {
+		return newError(http.StatusInternalServerError, "failed to get workspace setting")
+	}
+	if setting.EnforceIdentityDomain {
+		domain := email[strings.LastIndex(email, "@")+1:]
+		if !slices.Contains(setting.Domains, domain) {
+			return newError(http.StatusBadRequest, fmt.Sprintf("email %q does not belong to the workspace domains", email))
+		}
+	}
+	return nil
+}
+
+func (s *Service) userCountGuard(c echo.Context) error {
+	ctx := c.Request().Context()
+	userLimit := s.licenseService.GetPlanLimitValue(ctx, enterprise.PlanLimitMaximumUser)
+	count, err := s.store.CountActiveUsers(ctx)
+	if err != nil {
+		return newError(http.StatusInternalServerError, err.Error())
+	}
+	if int64(count) >= userLimit {
+		return newError(http.StatusForbidden, fmt.Sprintf("reached the maximum user count %d", userLimit))
+	}
+	return nil
+}
+
+func convertToSCIMUser(u *store.UserMessage) *user {
+	active := !u.MemberDeleted
+	return &user{
+		Schemas:     []string{userSchema},
+		ID:          strconv.Itoa(u.ID),
+		UserName:    u.Email,
+		Name:        &userName{Formatted: u.Name},
+		DisplayName: u.Name,
+		Emails:      []email{{Value: u.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta:        &meta{ResourceType: "User"},
+	}
+}
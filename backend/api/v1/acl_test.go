@@ -114,6 +114,27 @@ func TestGetResourceFromRequest(t *testing.T) {
 				{Name: "idps/hello"},
 			},
 		},
+		{
+			request: &v1pb.GetEnvironmentPipelineRequest{Name: "environmentPipeline"},
+			method:  "/bytebase.v1.EnvironmentService/GetEnvironmentPipeline",
+			want: []*common.Resource{
+				{Name: "environmentPipeline"},
+			},
+		},
+		{
+			request: &v1pb.VerifyMetadataBackupRequest{Name: "latest"},
+			method:  "/bytebase.v1.ActuatorService/VerifyMetadataBackup",
+			want: []*common.Resource{
+				{Name: "latest"},
+			},
+		},
+		{
+			request: &v1pb.GenerateSQLRequest{Name: "instances/hello/databases/hello", Prompt: "List the users"},
+			method:  "/bytebase.v1.SQLService/GenerateSQL",
+			want: []*common.Resource{
+				{Name: "instances/hello/databases/hello"},
+			},
+		},
 		{
 			request: &v1pb.ListReviewConfigsRequest{},
 			method:  "/bytebase.v1.ReviewConfigService/ListReviewConfigs",
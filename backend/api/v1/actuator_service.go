@@ -13,10 +13,15 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/masterkey"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/backup"
 	"github.com/bytebase/bytebase/backend/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -24,17 +29,24 @@ import (
 // ActuatorService implements the actuator service.
 type ActuatorService struct {
 	v1pb.UnimplementedActuatorServiceServer
-	store          *store.Store
-	profile        *config.Profile
-	licenseService enterprise.LicenseService
+	store            *store.Store
+	profile          *config.Profile
+	licenseService   enterprise.LicenseService
+	masterKeyManager *masterkey.Manager
+	iamManager       *iam.Manager
+	// metadataBackupRunner is nil if the metadata backup is not configured.
+	metadataBackupRunner *backup.MetadataBackupRunner
 }
 
 // NewActuatorService creates a new ActuatorService.
-func NewActuatorService(store *store.Store, profile *config.Profile, licenseService enterprise.LicenseService) *ActuatorService {
+func NewActuatorService(store *store.Store, profile *config.Profile, licenseService enterprise.LicenseService, masterKeyManager *masterkey.Manager, iamManager *iam.Manager, metadataBackupRunner *backup.MetadataBackupRunner) *ActuatorService {
 	return &ActuatorService{
-		store:          store,
-		profile:        profile,
-		licenseService: licenseService,
+		store:                store,
+		profile:              profile,
+		licenseService:       licenseService,
+		masterKeyManager:     masterKeyManager,
+		iamManager:           iamManager,
+		metadataBackupRunner: metadataBackupRunner,
 	}
 }
 
@@ -67,6 +79,123 @@ func (s *ActuatorService) DeleteCache(_ context.Context, _ *v1pb.DeleteCacheRequ
 	return &emptypb.Empty{}, nil
 }
 
+// GetEncryptionKey gets the status of the envelope encryption for secrets at rest.
+func (s *ActuatorService) GetEncryptionKey(ctx context.Context, _ *v1pb.GetEncryptionKeyRequest) (*v1pb.EncryptionKey, error) {
+	if err := s.checkWorkspacePermission(ctx, iam.PermissionSettingsGet); err != nil {
+		return nil, err
+	}
+	return s.getEncryptionKey(), nil
+}
+
+// RotateEncryptionKey rotates the data encryption key and re-encrypts the data source credentials.
+func (s *ActuatorService) RotateEncryptionKey(ctx context.Context, _ *v1pb.RotateEncryptionKeyRequest) (*v1pb.EncryptionKey, error) {
+	if err := s.checkWorkspacePermission(ctx, iam.PermissionSettingsSet); err != nil {
+		return nil, err
+	}
+	if s.masterKeyManager == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "encryption at rest is not enabled, please start Bytebase with --master-key-uri")
+	}
+	if s.profile.Readonly {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot rotate encryption key in readonly mode")
+	}
+	if err := s.masterKeyManager.Rotate(ctx); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate encryption key: %v", err)
+	}
+	return s.getEncryptionKey(), nil
+}
+
+// checkWorkspacePermission checks the workspace permission of the caller.
+// The encryption key methods have no resource for the IAM auth method to check against.
+func (s *ActuatorService) checkWorkspacePermission(ctx context.Context, permission iam.Permission) error {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, permission, user)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "user does not have permission %q", permission)
+	}
+	return nil
+}
+
+// CreateMetadataBackup takes a backup of the metadata database.
+func (s *ActuatorService) CreateMetadataBackup(ctx context.Context, _ *v1pb.CreateMetadataBackupRequest) (*v1pb.MetadataBackup, error) {
+	if s.metadataBackupRunner == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "metadata backup is not enabled, please start Bytebase with --metadata-backup-uri")
+	}
+	metadataBackup, err := s.metadataBackupRunner.Backup(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to back up metadata database: %v", err)
+	}
+	return s.convertToMetadataBackup(metadataBackup), nil
+}
+
+// ListMetadataBackups lists the metadata backups.
+func (s *ActuatorService) ListMetadataBackups(ctx context.Context, _ *v1pb.ListMetadataBackupsRequest) (*v1pb.ListMetadataBackupsResponse, error) {
+	if s.metadataBackupRunner == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "metadata backup is not enabled, please start Bytebase with --metadata-backup-uri")
+	}
+	metadataBackups, err := s.metadataBackupRunner.List(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list metadata backups: %v", err)
+	}
+	response := &v1pb.ListMetadataBackupsResponse{}
+	for _, metadataBackup := range metadataBackups {
+		response.MetadataBackups = append(response.MetadataBackups, s.convertToMetadataBackup(metadataBackup))
+	}
+	return response, nil
+}
+
+// VerifyMetadataBackup verifies the checksum and the integrity of the metadata backup.
+func (s *ActuatorService) VerifyMetadataBackup(ctx context.Context, request *v1pb.VerifyMetadataBackupRequest) (*v1pb.MetadataBackup, error) {
+	if s.metadataBackupRunner == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "metadata backup is not enabled, please start Bytebase with --metadata-backup-uri")
+	}
+	if request.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "name is required")
+	}
+	metadataBackup, err := s.metadataBackupRunner.Get(ctx, request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "failed to get metadata backup %q: %v", request.Name, err)
+	}
+	if err := s.metadataBackupRunner.Verify(ctx, metadataBackup); err != nil {
+		return nil, status.Errorf(codes.DataLoss, "failed to verify metadata backup %q: %v", metadataBackup.Name, err)
+	}
+	return s.convertToMetadataBackup(metadataBackup), nil
+}
+
+func (s *ActuatorService) convertToMetadataBackup(metadataBackup *backup.MetadataBackup) *v1pb.MetadataBackup {
+	storage, _ := objectstorage.ParseObjectURI(s.profile.MetadataBackupURI)
+	return &v1pb.MetadataBackup{
+		Name:       metadataBackup.Name,
+		Uri:        objectstorage.GetObjectURI(storage, metadataBackup.Path),
+		CreateTime: timestamppb.New(metadataBackup.CreateTime),
+		Size:       metadataBackup.Size,
+		Sha256:     metadataBackup.SHA256,
+		Encrypted:  metadataBackup.Encrypted,
+		Version:    metadataBackup.Version,
+	}
+}
+
+func (s *ActuatorService) getEncryptionKey() *v1pb.EncryptionKey {
+	if s.masterKeyManager == nil {
+		return &v1pb.EncryptionKey{}
+	}
+	setting := s.masterKeyManager.GetSetting()
+	encryptionKey := &v1pb.EncryptionKey{
+		MasterKeyUri: s.masterKeyManager.MasterKeyURI(),
+		ActiveKeyId:  setting.ActiveKeyId,
+		KeyCount:     int32(len(setting.Keys)),
+	}
+	if activeKey := masterkey.GetActiveKey(setting); activeKey != nil {
+		encryptionKey.RotateTime = activeKey.CreateTime
+	}
+	return encryptionKey
+}
+
 // GetResourcePackage gets the theme resources.
 func (s *ActuatorService) GetResourcePackage(ctx context.Context, _ *v1pb.GetResourcePackageRequest) (*v1pb.ResourcePackage, error) {
 	settingName := api.SettingBrandingLogo
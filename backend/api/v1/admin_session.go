@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ListAdminSessions lists the recorded admin execute sessions of the instance.
+func (s *SQLService) ListAdminSessions(ctx context.Context, request *v1pb.ListAdminSessionsRequest) (*v1pb.ListAdminSessionsResponse, error) {
+	instanceID, err := common.GetInstanceID(request.Parent)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+
+	limit, offset, err := parseLimitAndOffset(request.PageToken, int(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := limit + 1
+	sessions, err := s.store.ListAdminSessions(ctx, &store.FindAdminSessionMessage{
+		InstanceUID: &instance.UID,
+		Limit:       &limitPlusOne,
+		Offset:      &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list admin sessions, error: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(sessions) == limitPlusOne {
+		sessions = sessions[:limit]
+		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
+			Limit:  int32(limit),
+			Offset: int32(limit + offset),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListAdminSessionsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, session := range sessions {
+		v1Session, err := s.convertToV1AdminSession(ctx, instance, session)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert admin session, error: %v", err)
+		}
+		response.Sessions = append(response.Sessions, v1Session)
+	}
+	return response, nil
+}
+
+// startAdminSession records the admin execute session started by the first request of the stream.
+func (s *SQLService) startAdminSession(ctx context.Context, request *v1pb.AdminExecuteRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, user *store.UserMessage) (*store.AdminSessionMessage, error) {
+	justification := strings.TrimSpace(request.Justification)
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, error: %v", err)
+	}
+	if setting.GetRequireAdminExecuteJustification() && justification == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "justification is required to start the admin execute session")
+	}
+
+	session, err := s.store.CreateAdminSession(ctx, &store.AdminSessionMessage{
+		InstanceUID:   instance.UID,
+		DatabaseName:  database.DatabaseName,
+		CreatorID:     user.ID,
+		Justification: justification,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create admin session, error: %v", err)
+	}
+	return session, nil
+}
+
+// recordAdminSessionStatement appends the executed statement to the admin session.
+func (s *SQLService) recordAdminSessionStatement(ctx context.Context, session *store.AdminSessionMessage, statement string, durationNs int64, results []*v1pb.QueryResult, queryErr error) error {
+	payload := &storepb.AdminSessionStatementPayload{
+		Duration: durationpb.New(time.Duration(durationNs)),
+	}
+	payload.AffectedRows, payload.RowCount = countAdminSessionRows(results)
+	if queryErr != nil {
+		payload.Error = queryErr.Error()
+	}
+	if _, err := s.store.CreateAdminSessionStatement(ctx, &store.AdminSessionStatementMessage{
+		SessionUID: session.UID,
+		Statement:  statement,
+		Payload:    payload,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to record admin session statement")
+	}
+	return nil
+}
+
+// countAdminSessionRows returns the affected rows of the DML and DDL statements and the returned rows of the queries.
+func countAdminSessionRows(results []*v1pb.QueryResult) (int64, int64) {
+	var affectedRows, rowCount int64
+	for _, result := range results {
+		if len(result.ColumnNames) == 1 && result.ColumnNames[0] == "Affected Rows" && len(result.Rows) == 1 && len(result.Rows[0].Values) == 1 {
+			affectedRows += result.Rows[0].Values[0].GetInt64Value()
+			continue
+		}
+		rowCount += int64(len(result.Rows))
+	}
+	return affectedRows, rowCount
+}
+
+func (s *SQLService) convertToV1AdminSession(ctx context.Context, instance *store.InstanceMessage, session *store.AdminSessionMessage) (*v1pb.AdminSession, error) {
+	creator, err := s.store.GetUserByID(ctx, session.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+	if creator == nil {
+		return nil, errors.Errorf("cannot found user with id %d", session.CreatorID)
+	}
+
+	v1Session := &v1pb.AdminSession{
+		Name:          fmt.Sprintf("%s%s/%s%d", common.InstanceNamePrefix, instance.ResourceID, common.AdminSessionPrefix, session.UID),
+		Database:      session.DatabaseName,
+		Creator:       common.FormatUserEmail(creator.Email),
+		CreateTime:    timestamppb.New(session.CreatedTime),
+		Justification: session.Justification,
+	}
+	for _, statement := range session.Statements {
+		v1Session.Statements = append(v1Session.Statements, &v1pb.AdminSession_Statement{
+			Statement:    statement.Statement,
+			CreateTime:   timestamppb.New(statement.CreatedTime),
+			Duration:     statement.Payload.GetDuration(),
+			AffectedRows: statement.Payload.GetAffectedRows(),
+			RowCount:     statement.Payload.GetRowCount(),
+			Error:        statement.Payload.GetError(),
+		})
+	}
+	return v1Session, nil
+}
@@ -24,10 +24,14 @@ const (
 )
 
 var typesMap = map[string]api.AnomalyType{
-	"INSTANCE_CONNECTION":   api.AnomalyInstanceConnection,
-	"MIGRATION_SCHEMA":      api.AnomalyInstanceMigrationSchema,
-	"DATABASE_CONNECTION":   api.AnomalyDatabaseConnection,
-	"DATABASE_SCHEMA_DRIFT": api.AnomalyDatabaseSchemaDrift,
+	"INSTANCE_CONNECTION":         api.AnomalyInstanceConnection,
+	"MIGRATION_SCHEMA":            api.AnomalyInstanceMigrationSchema,
+	"INSTANCE_CONNECTION_BUDGET":  api.AnomalyInstanceConnectionBudget,
+	"INSTANCE_LONG_RUNNING_QUERY": api.AnomalyInstanceLongRunningQuery,
+	"INSTANCE_FAILOVER":           api.AnomalyInstanceFailover,
+	"DATABASE_CONNECTION":         api.AnomalyDatabaseConnection,
+	"DATABASE_SCHEMA_DRIFT":       api.AnomalyDatabaseSchemaDrift,
+	"DATABASE_BACKUP_FAILED":      api.AnomalyDatabaseBackupFailed,
 }
 
 // AnomalyService implements the anomaly service.
@@ -159,6 +163,55 @@ func (s *AnomalyService) convertToAnomaly(ctx context.Context, anomaly *store.An
 				Detail: detail.Detail,
 			},
 		}
+	case api.AnomalyInstanceConnectionBudget:
+		detail := &storepb.AnomalyConnectionBudgetPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(anomaly.Payload), detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal instance connection budget anomaly payload")
+		}
+		pbAnomaly.Type = v1pb.Anomaly_INSTANCE_CONNECTION_BUDGET
+		pbAnomaly.Detail = &v1pb.Anomaly_InstanceConnectionBudgetDetail_{
+			InstanceConnectionBudgetDetail: &v1pb.Anomaly_InstanceConnectionBudgetDetail{
+				MaximumConnections: detail.MaximumConnections,
+				RejectedCount:      detail.RejectedCount,
+			},
+		}
+	case api.AnomalyInstanceLongRunningQuery:
+		detail := &storepb.AnomalyLongRunningQueryPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(anomaly.Payload), detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal instance long-running query anomaly payload")
+		}
+		pbDetail := &v1pb.Anomaly_InstanceLongRunningQueryDetail{
+			Threshold: detail.Threshold,
+		}
+		for _, query := range detail.Queries {
+			pbDetail.Queries = append(pbDetail.Queries, &v1pb.Anomaly_InstanceLongRunningQueryDetail_Query{
+				ConnectionId:  query.ConnectionId,
+				Database:      query.Database,
+				User:          query.User,
+				State:         query.State,
+				Statement:     query.Statement,
+				Duration:      query.Duration,
+				BlockedBy:     query.BlockedBy,
+				BlockingCount: query.BlockingCount,
+			})
+		}
+		pbAnomaly.Type = v1pb.Anomaly_INSTANCE_LONG_RUNNING_QUERY
+		pbAnomaly.Detail = &v1pb.Anomaly_InstanceLongRunningQueryDetail_{
+			InstanceLongRunningQueryDetail: pbDetail,
+		}
+	case api.AnomalyInstanceFailover:
+		detail := &storepb.AnomalyFailoverPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(anomaly.Payload), detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal instance failover anomaly payload")
+		}
+		pbAnomaly.Type = v1pb.Anomaly_INSTANCE_FAILOVER
+		pbAnomaly.Detail = &v1pb.Anomaly_InstanceFailoverDetail_{
+			InstanceFailoverDetail: &v1pb.Anomaly_InstanceFailoverDetail{
+				AvailabilityGroup: detail.AvailabilityGroup,
+				PreviousPrimary:   detail.PreviousPrimary,
+				CurrentPrimary:    detail.CurrentPrimary,
+			},
+		}
 	case api.AnomalyDatabaseConnection:
 		detail := &storepb.AnomalyConnectionPayload{}
 		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(anomaly.Payload), detail); err != nil {
@@ -181,6 +234,19 @@ func (s *AnomalyService) convertToAnomaly(ctx context.Context, anomaly *store.An
 				RecordVersion:  detail.Version,
 				ExpectedSchema: detail.Expect,
 				ActualSchema:   detail.Actual,
+				Diff:           detail.Diff,
+			},
+		}
+	case api.AnomalyDatabaseBackupFailed:
+		detail := &storepb.AnomalyDatabaseBackupFailedPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(anomaly.Payload), detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal database backup failed anomaly payload")
+		}
+		pbAnomaly.Type = v1pb.Anomaly_DATABASE_BACKUP_FAILED
+		pbAnomaly.Detail = &v1pb.Anomaly_DatabaseBackupFailedDetail_{
+			DatabaseBackupFailedDetail: &v1pb.Anomaly_DatabaseBackupFailedDetail{
+				BackupRun: fmt.Sprintf("%s/%s%d", pbAnomaly.Resource, common.BackupRunPrefix, detail.BackupRunUid),
+				Error:     detail.Error,
 			},
 		}
 	}
@@ -190,8 +256,10 @@ func (s *AnomalyService) convertToAnomaly(ctx context.Context, anomaly *store.An
 
 func getSeverityFromAnomalyType(tp v1pb.Anomaly_AnomalyType) v1pb.Anomaly_AnomalySeverity {
 	switch tp {
-	case v1pb.Anomaly_INSTANCE_CONNECTION, v1pb.Anomaly_MIGRATION_SCHEMA, v1pb.Anomaly_DATABASE_CONNECTION, v1pb.Anomaly_DATABASE_SCHEMA_DRIFT:
+	case v1pb.Anomaly_INSTANCE_CONNECTION, v1pb.Anomaly_MIGRATION_SCHEMA, v1pb.Anomaly_DATABASE_CONNECTION, v1pb.Anomaly_DATABASE_SCHEMA_DRIFT, v1pb.Anomaly_DATABASE_BACKUP_FAILED:
 		return v1pb.Anomaly_CRITICAL
+	case v1pb.Anomaly_INSTANCE_CONNECTION_BUDGET, v1pb.Anomaly_INSTANCE_LONG_RUNNING_QUERY, v1pb.Anomaly_INSTANCE_FAILOVER:
+		return v1pb.Anomaly_HIGH
 	}
 	return v1pb.Anomaly_ANOMALY_SEVERITY_UNSPECIFIED
 }
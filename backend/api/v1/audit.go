@@ -3,7 +3,9 @@ package v1
 import (
 	"context"
 	"log/slog"
+	"math/rand"
 	"reflect"
+	"slices"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -27,6 +29,15 @@ var (
 	maskedString string
 )
 
+// defaultReadAuditMethods are the read methods audited for the sensitive projects if the read audit setting doesn't specify the methods.
+var defaultReadAuditMethods = []string{
+	"/bytebase.v1.IssueService/GetIssue",
+	"/bytebase.v1.IssueService/ListIssues",
+	"/bytebase.v1.IssueService/SearchIssues",
+	"/bytebase.v1.SQLService/Query",
+	"/bytebase.v1.SQLService/Export",
+}
+
 // ACLInterceptor is the v1 ACL interceptor for gRPC server.
 type AuditInterceptor struct {
 	store *store.Store
@@ -108,11 +119,101 @@ func (in *AuditInterceptor) AuditInterceptor(ctx context.Context, request any, s
 		if err := createAuditLog(ctx, request, response, serverInfo.FullMethod, in.store, serviceData, rerr); err != nil {
 			slog.Warn("audit interceptor: failed to create audit log", log.BBError(err))
 		}
+	} else if parents := in.getReadAuditParents(ctx, request, serverInfo.FullMethod); len(parents) > 0 {
+		if err := createReadAuditLog(ctx, request, serverInfo.FullMethod, in.store, parents, rerr); err != nil {
+			slog.Warn("audit interceptor: failed to create read audit log", log.BBError(err))
+		}
 	}
 
 	return response, rerr
 }
 
+// getReadAuditParents gets the sensitive projects of the read request to audit, the requests are sampled by the read audit setting.
+func (in *AuditInterceptor) getReadAuditParents(ctx context.Context, request any, method string) []string {
+	setting, err := in.store.GetReadAuditSetting(ctx)
+	if err != nil {
+		slog.Warn("audit interceptor: failed to get read audit setting", log.BBError(err))
+		return nil
+	}
+	if len(setting.Projects) == 0 {
+		return nil
+	}
+	methods := setting.Methods
+	if len(methods) == 0 {
+		methods = defaultReadAuditMethods
+	}
+	if !slices.Contains(methods, method) {
+		return nil
+	}
+
+	var projectIDs []string
+	if authContext, ok := common.GetAuthContextFromContext(ctx); ok {
+		projectIDs = authContext.GetProjectResources()
+	}
+	// The SQL methods authorize the requests by themselves, so the project of the database is not in the auth context.
+	if len(projectIDs) == 0 {
+		var name string
+		switch r := request.(type) {
+		case *v1pb.QueryRequest:
+			name = r.Name
+		case *v1pb.ExportRequest:
+			name = r.Name
+		}
+		if match := databaseRegex.FindString(name); match != "" {
+			database, err := getDatabaseMessage(ctx, in.store, match)
+			if err != nil {
+				slog.Warn("audit interceptor: failed to get database of the read request", slog.String("database", match), log.BBError(err))
+				return nil
+			}
+			projectIDs = append(projectIDs, database.ProjectID)
+		}
+	}
+
+	var parents []string
+	for _, projectID := range projectIDs {
+		if parent := common.FormatProject(projectID); slices.Contains(setting.Projects, parent) {
+			parents = append(parents, parent)
+		}
+	}
+	if len(parents) == 0 || rand.Float64() >= setting.SampleRate {
+		return nil
+	}
+	return parents
+}
+
+// createReadAuditLog creates the audit logs of the read request in the sensitive projects.
+// The response is not logged to limit the volume.
+func createReadAuditLog(ctx context.Context, request any, method string, storage *store.Store, parents []string, rerr error) error {
+	requestString, err := getRequestString(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get request string")
+	}
+
+	var user string
+	if u, ok := ctx.Value(common.UserContextKey).(*store.UserMessage); ok {
+		user = common.FormatUserUID(u.ID)
+	}
+
+	st, _ := status.FromError(rerr)
+
+	createAuditLogCtx := context.WithoutCancel(ctx)
+	for _, parent := range parents {
+		p := &storepb.AuditLog{
+			Parent:   parent,
+			Method:   method,
+			Resource: getRequestResource(request),
+			Severity: storepb.AuditLog_INFO,
+			User:     user,
+			Request:  requestString,
+			Status:   st.Proto(),
+		}
+		if err := storage.CreateAuditLog(createAuditLogCtx, p); err != nil {
+			return errors.Wrapf(err, "failed to create audit log")
+		}
+	}
+	return nil
+}
+
 type auditStream struct {
 	grpc.ServerStream
 	needAudit  bool
@@ -175,6 +276,12 @@ func getRequestResource(request any) string {
 		return ""
 	}
 	switch r := request.(type) {
+	case *v1pb.GetIssueRequest:
+		return r.Name
+	case *v1pb.ListIssuesRequest:
+		return r.Parent
+	case *v1pb.SearchIssuesRequest:
+		return r.Parent
 	case *v1pb.QueryRequest:
 		return r.Name
 	case *v1pb.ExecuteRequest:
@@ -183,22 +290,40 @@ func getRequestResource(request any) string {
 		return r.Name
 	case *v1pb.ExportRequest:
 		return r.Name
+	case *v1pb.GenerateSQLRequest:
+		return r.Name
+	case *v1pb.DeleteIssueCommentRequest:
+		return r.Name
 	case *v1pb.UpdateDatabaseRequest:
 		return r.Database.Name
 	case *v1pb.BatchUpdateDatabasesRequest:
 		return r.Parent
 	case *v1pb.UpdateDatabaseMetadataRequest:
 		return r.GetDatabaseMetadata().GetName()
+	case *v1pb.ImportColumnClassificationsRequest:
+		return r.Name
+	case *v1pb.AcceptClassificationSuggestionRequest:
+		return r.Name
 	case *v1pb.UpdateSecretRequest:
 		return r.GetSecret().GetName()
 	case *v1pb.DeleteSecretRequest:
 		return r.GetName()
+	case *v1pb.UpdateBackupSettingRequest:
+		return r.GetBackupSetting().GetName()
+	case *v1pb.CreateBackupRunRequest:
+		return r.Parent
 	case *v1pb.SetIamPolicyRequest:
 		return r.Resource
+	case *v1pb.AddProjectMemberRequest:
+		return r.Project
 	case *v1pb.CreateUserRequest:
 		return r.GetUser().GetName()
 	case *v1pb.UpdateUserRequest:
 		return r.GetUser().GetName()
+	case *v1pb.CreateServiceAccountTokenRequest:
+		return r.GetParent()
+	case *v1pb.DeleteServiceAccountTokenRequest:
+		return r.GetName()
 	case *v1pb.LoginRequest:
 		return r.GetEmail()
 	case *v1pb.CreateRiskRequest:
@@ -229,6 +354,8 @@ func getRequestResource(request any) string {
 		return r.GetName()
 	case *v1pb.UpdateDataSourceRequest:
 		return r.GetName()
+	case *v1pb.KillQueryRequest:
+		return r.GetName()
 	case *v1pb.UpdateSettingRequest:
 		return r.GetSetting().GetName()
 	default:
@@ -274,6 +401,12 @@ func getRequestString(request any) (string, error) {
 		case *v1pb.UpdateSecretRequest:
 			r.Secret = redactSecret(r.Secret)
 			return r
+		case *v1pb.UpdateBackupSettingRequest:
+			if r, ok := proto.Clone(r).(*v1pb.UpdateBackupSettingRequest); ok {
+				r.BackupSetting = redactBackupSetting(r.BackupSetting)
+				return r
+			}
+			return nil
 		default:
 			if p, ok := r.(protoreflect.ProtoMessage); ok {
 				return p
@@ -307,6 +440,12 @@ func getResponseString(response any) (string, error) {
 			return nil
 		case *v1pb.User:
 			return redactUser(r)
+		case *v1pb.ServiceAccountToken:
+			if r, ok := proto.Clone(r).(*v1pb.ServiceAccountToken); ok {
+				r.Token = maskedString
+				return r
+			}
+			return nil
 		case *v1pb.Instance:
 			return redactInstance(r)
 		case *v1pb.Secret:
@@ -491,6 +630,19 @@ func redactSecret(s *v1pb.Secret) *v1pb.Secret {
 	return s
 }
 
+func redactBackupSetting(s *v1pb.BackupSetting) *v1pb.BackupSetting {
+	if s == nil {
+		return nil
+	}
+	if s.EncryptionKey != "" {
+		s.EncryptionKey = maskedString
+	}
+	if s.Storage != nil && s.Storage.SecretAccessKey != "" {
+		s.Storage.SecretAccessKey = maskedString
+	}
+	return s
+}
+
 func needAudit(ctx context.Context) bool {
 	authCtx, ok := common.GetAuthContextFromContext(ctx)
 	if !ok {
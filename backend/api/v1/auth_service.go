@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/pquerna/otp/totp"
@@ -18,12 +19,14 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	metricapi "github.com/bytebase/bytebase/backend/metric"
@@ -54,11 +57,12 @@ type AuthService struct {
 	profile        *config.Profile
 	stateCfg       *state.State
 	iamManager     *iam.Manager
+	webhookManager *webhook.Manager
 	postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error
 }
 
 // NewAuthService creates a new AuthService.
-func NewAuthService(store *store.Store, secret string, tokenDuration time.Duration, licenseService enterprise.LicenseService, metricReporter *metricreport.Reporter, profile *config.Profile, stateCfg *state.State, iamManager *iam.Manager, postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error) (*AuthService, error) {
+func NewAuthService(store *store.Store, secret string, tokenDuration time.Duration, licenseService enterprise.LicenseService, metricReporter *metricreport.Reporter, profile *config.Profile, stateCfg *state.State, iamManager *iam.Manager, webhookManager *webhook.Manager, postCreateUser func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error) (*AuthService, error) {
 	return &AuthService{
 		store:          store,
 		secret:         secret,
@@ -68,6 +72,7 @@ func NewAuthService(store *store.Store, secret string, tokenDuration time.Durati
 		profile:        profile,
 		stateCfg:       stateCfg,
 		iamManager:     iamManager,
+		webhookManager: webhookManager,
 		postCreateUser: postCreateUser,
 	}, nil
 }
@@ -245,6 +250,8 @@ func (s *AuthService) CreateUser(ctx context.Context, request *v1pb.CreateUserRe
 		},
 	})
 
+	s.webhookManager.PublishEvent(ctx, newWorkspaceEvent(ctx, v1pb.WorkspaceEvent_USER_CREATED, common.FormatUserEmail(user.Email), user.Name))
+
 	userResponse := convertToUser(user)
 	if request.User.UserType == v1pb.UserType_SERVICE_ACCOUNT {
 		userResponse.ServiceKey = password
@@ -465,6 +472,7 @@ func (s *AuthService) DeleteUser(ctx context.Context, request *v1pb.DeleteUserRe
 	if _, err := s.store.UpdateUser(ctx, user, &store.UpdateUserMessage{Delete: &deletePatch}, callerUser.ID); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	s.webhookManager.PublishEvent(ctx, newWorkspaceEvent(ctx, v1pb.WorkspaceEvent_USER_DELETED, common.FormatUserEmail(user.Email), user.Name))
 	return &emptypb.Empty{}, nil
 }
 
@@ -522,6 +530,7 @@ func (s *AuthService) UndeleteUser(ctx context.Context, request *v1pb.UndeleteUs
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
+	s.webhookManager.PublishEvent(ctx, newWorkspaceEvent(ctx, v1pb.WorkspaceEvent_USER_UNDELETED, common.FormatUserEmail(user.Email), user.Name))
 	return convertToUser(user), nil
 }
 
@@ -632,13 +641,21 @@ func (s *AuthService) Login(ctx context.Context, request *v1pb.LoginRequest) (*v
 		}, nil
 	}
 
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+
 	var accessToken string
 	if loginUser.Type == api.EndUser {
-		token, err := auth.GenerateAccessToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret, s.tokenDuration)
+		token, err := s.createSession(ctx, loginUser, setting)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to generate API access token")
+			return nil, err
 		}
 		accessToken = token
+		if err := s.recordSignInDevice(ctx, loginUser); err != nil {
+			return nil, err
+		}
 	} else if loginUser.Type == api.ServiceAccount {
 		token, err := auth.GenerateAPIToken(loginUser.Name, loginUser.ID, s.profile.Mode, s.secret)
 		if err != nil {
@@ -648,11 +665,6 @@ func (s *AuthService) Login(ctx context.Context, request *v1pb.LoginRequest) (*v
 	} else {
 		return nil, status.Errorf(codes.Unauthenticated, fmt.Sprintf("user type %s cannot login", loginUser.Type))
 	}
-
-	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
-	}
 	var allowedDomains []string
 	if setting.EnforceIdentityDomain {
 		allowedDomains = setting.Domains
@@ -703,6 +715,11 @@ func (s *AuthService) Logout(ctx context.Context, _ *v1pb.LogoutRequest) (*empty
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
 	}
 	s.stateCfg.ExpireCache.Add(accessTokenStr, true)
+	if sessionID, err := auth.GetSessionIDFromAccessToken(accessTokenStr, s.secret); err == nil && sessionID != "" {
+		if err := s.store.RevokeSessions(ctx, []string{sessionID}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to revoke session, error: %v", err)
+		}
+	}
 
 	if err := grpc.SetHeader(ctx, metadata.New(map[string]string{
 		auth.GatewayMetadataAccessTokenKey: "",
@@ -713,22 +730,294 @@ func (s *AuthService) Logout(ctx context.Context, _ *v1pb.LogoutRequest) (*empty
 	return &emptypb.Empty{}, nil
 }
 
+// CreateServiceAccountToken creates a scoped token for the service account.
+func (s *AuthService) CreateServiceAccountToken(ctx context.Context, request *v1pb.CreateServiceAccountTokenRequest) (*v1pb.ServiceAccountToken, error) {
+	user, err := s.getServiceAccount(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.Token == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "token must be set")
+	}
+	if len(request.Token.Scopes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "scopes are required")
+	}
+	if _, err := iam.GetScopePermissions(request.Token.Scopes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	// The request authenticated by a scoped token cannot create a token with more permissions than its own scopes.
+	if tokenPermissions, ok := common.GetTokenPermissionsFromContext(ctx); ok {
+		exceeded, err := iam.GetExceededScopePermissions(request.Token.Scopes, tokenPermissions)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if len(exceeded) > 0 {
+			return nil, status.Errorf(codes.PermissionDenied, "permissions %v are not in the scopes of the current service account token", exceeded)
+		}
+	}
+	payload := &storepb.ServiceAccountTokenPayload{
+		Scopes: request.Token.Scopes,
+	}
+	if request.Token.ExpireTime != nil {
+		if err := request.Token.ExpireTime.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expire time: %v", err)
+		}
+		if !request.Token.ExpireTime.AsTime().After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		payload.ExpireTime = request.Token.ExpireTime
+	}
+
+	random, err := common.RandomString(40)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate service account token")
+	}
+	tokenStr := fmt.Sprintf("%s%s", api.ServiceAccountTokenPrefix, random)
+	token, err := s.store.CreateServiceAccountToken(ctx, &store.ServiceAccountTokenMessage{
+		PrincipalUID: user.ID,
+		Title:        request.Token.Title,
+		TokenHash:    auth.HashServiceAccountToken(tokenStr),
+		Payload:      payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create service account token, error: %v", err)
+	}
+	v1Token := convertToServiceAccountToken(user, token)
+	v1Token.Token = tokenStr
+	return v1Token, nil
+}
+
+// ListServiceAccountTokens lists the scoped tokens of the service account.
+func (s *AuthService) ListServiceAccountTokens(ctx context.Context, request *v1pb.ListServiceAccountTokensRequest) (*v1pb.ListServiceAccountTokensResponse, error) {
+	user, err := s.getServiceAccount(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := s.store.ListServiceAccountTokens(ctx, &store.FindServiceAccountTokenMessage{
+		PrincipalUID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list service account tokens, error: %v", err)
+	}
+	response := &v1pb.ListServiceAccountTokensResponse{}
+	for _, token := range tokens {
+		response.Tokens = append(response.Tokens, convertToServiceAccountToken(user, token))
+	}
+	return response, nil
+}
+
+// DeleteServiceAccountToken revokes a scoped token of the service account.
+func (s *AuthService) DeleteServiceAccountToken(ctx context.Context, request *v1pb.DeleteServiceAccountTokenRequest) (*emptypb.Empty, error) {
+	email, tokenID, err := common.GetUserEmailTokenID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.getServiceAccount(ctx, common.FormatUserEmail(email))
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.store.GetServiceAccountToken(ctx, &store.FindServiceAccountTokenMessage{
+		ID:           &tokenID,
+		PrincipalUID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get service account token, error: %v", err)
+	}
+	if token == nil {
+		return nil, status.Errorf(codes.NotFound, "service account token %q not found", request.Name)
+	}
+	if err := s.store.DeleteServiceAccountToken(ctx, token.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete service account token, error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getServiceAccount gets the service account by the resource name in users/{email} format.
+func (s *AuthService) getServiceAccount(ctx context.Context, name string) (*store.UserMessage, error) {
+	email, err := common.GetUserEmail(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	user, err := s.store.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, status.Errorf(codes.NotFound, "user %q not found", name)
+	}
+	if user.Type != api.ServiceAccount {
+		return nil, status.Errorf(codes.InvalidArgument, "user %q is not a service account", name)
+	}
+	return user, nil
+}
+
+func convertToServiceAccountToken(user *store.UserMessage, token *store.ServiceAccountTokenMessage) *v1pb.ServiceAccountToken {
+	return &v1pb.ServiceAccountToken{
+		Name:       fmt.Sprintf("%s%s/%s%d", common.UserNamePrefix, user.Email, common.TokenNamePrefix, token.ID),
+		Title:      token.Title,
+		Scopes:     token.Payload.GetScopes(),
+		ExpireTime: token.Payload.GetExpireTime(),
+		CreateTime: timestamppb.New(token.CreatedTime),
+	}
+}
+
+// createSession creates a sign-in session for the user and returns the access token of the session.
+// The oldest sessions are revoked if the user exceeds the concurrent session limit.
+func (s *AuthService) createSession(ctx context.Context, user *store.UserMessage, setting *storepb.WorkspaceProfileSetting) (string, error) {
+	tokenDuration := s.tokenDuration
+	if d := setting.GetTokenDuration(); d != nil && d.Seconds > 0 {
+		tokenDuration = d.AsDuration()
+	}
+	session, err := s.store.CreateSession(ctx, &store.SessionMessage{
+		ID:           uuid.NewString(),
+		PrincipalUID: user.ID,
+		ExpireTime:   time.Now().Add(tokenDuration),
+	})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to create session, error: %v", err)
+	}
+	token, err := auth.GenerateAccessToken(user.Name, user.ID, session.ID, s.profile.Mode, s.secret, session.ExpireTime)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to generate API access token")
+	}
+
+	if maxSessions := int(setting.GetLoginSecurity().GetMaxConcurrentSessions()); maxSessions > 0 {
+		sessions, err := s.store.ListSessions(ctx, &store.FindSessionMessage{
+			PrincipalUID: &user.ID,
+			Active:       true,
+		})
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to list sessions, error: %v", err)
+		}
+		if len(sessions) > maxSessions {
+			var revokedIDs []string
+			// The sessions are ordered by the created time ascending, keep the latest ones.
+			for _, revoked := range sessions[:len(sessions)-maxSessions] {
+				revokedIDs = append(revokedIDs, revoked.ID)
+			}
+			if err := s.store.RevokeSessions(ctx, revokedIDs); err != nil {
+				return "", status.Errorf(codes.Internal, "failed to revoke sessions, error: %v", err)
+			}
+		}
+	}
+	return token, nil
+}
+
 func (s *AuthService) getAndVerifyUser(ctx context.Context, request *v1pb.LoginRequest) (*store.UserMessage, error) {
 	user, err := s.store.GetUserByEmail(ctx, request.Email)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user by email %q: %v", request.Email, err)
 	}
 	if user == nil {
+		s.createSignInSecurityEvent(ctx, api.ActivitySecuritySignInFailed, request.Email, "The user does not exist.")
 		return nil, invalidUserOrPasswordError
 	}
+	signInState, err := s.store.GetSignInState(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sign-in state: %v", err)
+	}
+	if lockedUntil := signInState.GetLockedUntil(); lockedUntil != nil && time.Now().Before(lockedUntil.AsTime()) {
+		return nil, status.Errorf(codes.PermissionDenied, "the user is locked until %s due to too many failed sign-in attempts", lockedUntil.AsTime().Format(time.RFC3339))
+	}
 	// Compare the stored hashed password, with the hashed version of the password that was received.
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+		if err := s.recordFailedSignIn(ctx, user, signInState); err != nil {
+			return nil, err
+		}
 		// If the two passwords don't match, return a 401 status.
 		return nil, invalidUserOrPasswordError
 	}
+	if signInState.FailedAttempts > 0 || signInState.LockedUntil != nil {
+		signInState.FailedAttempts = 0
+		signInState.LockedUntil = nil
+		if err := s.store.UpsertSignInState(ctx, user.ID, signInState); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update sign-in state: %v", err)
+		}
+	}
 	return user, nil
 }
 
+// defaultLockoutDuration is the lockout duration if the login security doesn't specify one.
+const defaultLockoutDuration = 30 * time.Minute
+
+// recordFailedSignIn alerts the failed sign-in attempt, and locks out the user if the user exceeds
+// the maximum failed attempts of the login security.
+func (s *AuthService) recordFailedSignIn(ctx context.Context, user *store.UserMessage, signInState *storepb.SignInState) error {
+	s.createSignInSecurityEvent(ctx, api.ActivitySecuritySignInFailed, user.Email, "The password is not valid.")
+
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	loginSecurity := setting.GetLoginSecurity()
+	signInState.FailedAttempts++
+	if maxFailedAttempts := loginSecurity.GetMaxFailedAttempts(); maxFailedAttempts > 0 && signInState.FailedAttempts >= maxFailedAttempts {
+		lockoutDuration := defaultLockoutDuration
+		if d := loginSecurity.GetLockoutDuration(); d != nil && d.AsDuration() > 0 {
+			lockoutDuration = d.AsDuration()
+		}
+		signInState.FailedAttempts = 0
+		signInState.LockedUntil = timestamppb.New(time.Now().Add(lockoutDuration))
+		s.createSignInSecurityEvent(ctx, api.ActivitySecurityUserLocked, user.Email, fmt.Sprintf("The user is locked for %s after %d failed sign-in attempts.", lockoutDuration, maxFailedAttempts))
+	}
+	if err := s.store.UpsertSignInState(ctx, user.ID, signInState); err != nil {
+		return status.Errorf(codes.Internal, "failed to update sign-in state: %v", err)
+	}
+	return nil
+}
+
+// maxSignInDevices is the maximum number of devices remembered for a user.
+const maxSignInDevices = 20
+
+// recordSignInDevice remembers the device of the sign-in and alerts if the user signs in from a new device.
+func (s *AuthService) recordSignInDevice(ctx context.Context, user *store.UserMessage) error {
+	userAgent := auth.GetClientUserAgent(ctx)
+	var ip string
+	if clientIP := auth.GetClientIP(ctx); clientIP != nil {
+		ip = clientIP.String()
+	}
+	signInState, err := s.store.GetSignInState(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get sign-in state: %v", err)
+	}
+	index := slices.IndexFunc(signInState.Devices, func(device *storepb.SignInDevice) bool {
+		return device.UserAgent == userAgent
+	})
+	if index >= 0 {
+		signInState.Devices = slices.Delete(signInState.Devices, index, index+1)
+	} else if len(signInState.Devices) > 0 {
+		// The first device is remembered silently.
+		s.createSignInSecurityEvent(ctx, api.ActivitySecurityNewDeviceSignIn, user.Email, "The user signed in from a new device.")
+	}
+	// The devices are ordered by the last sign-in time ascending.
+	signInState.Devices = append(signInState.Devices, &storepb.SignInDevice{
+		UserAgent:      userAgent,
+		Ip:             ip,
+		LastSignInTime: timestamppb.Now(),
+	})
+	if len(signInState.Devices) > maxSignInDevices {
+		signInState.Devices = signInState.Devices[len(signInState.Devices)-maxSignInDevices:]
+	}
+	if err := s.store.UpsertSignInState(ctx, user.ID, signInState); err != nil {
+		return status.Errorf(codes.Internal, "failed to update sign-in state: %v", err)
+	}
+	return nil
+}
+
+func (s *AuthService) createSignInSecurityEvent(ctx context.Context, activityType api.ActivityType, email, description string) {
+	var ip string
+	if clientIP := auth.GetClientIP(ctx); clientIP != nil {
+		ip = clientIP.String()
+	}
+	s.webhookManager.CreateSecurityEvent(ctx, &webhook.SecurityEvent{
+		Type:        activityType,
+		Email:       email,
+		IP:          ip,
+		UserAgent:   auth.GetClientUserAgent(ctx),
+		Description: description,
+	})
+}
+
 func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.LoginRequest) (*store.UserMessage, error) {
 	idpID, err := common.GetIdentityProviderID(request.IdpName)
 	if err != nil {
@@ -813,6 +1102,9 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 				UserFilter:       idpConfig.UserFilter,
 				SecurityProtocol: ldap.SecurityProtocol(idpConfig.SecurityProtocol),
 				FieldMapping:     idpConfig.FieldMapping,
+				GroupBaseDN:      idpConfig.GroupBaseDn,
+				GroupFilter:      idpConfig.GroupFilter,
+				NestedGroup:      idpConfig.NestedGroup,
 			},
 		)
 		if err != nil {
@@ -853,6 +1145,9 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		return nil, status.Errorf(codes.Internal, "failed to list users by email %s: %v", email, err)
 	}
 	if user != nil {
+		if err := s.syncLDAPGroupRoles(ctx, idp, user, userInfo.Groups); err != nil {
+			return nil, err
+		}
 		return user, nil
 	}
 
@@ -878,9 +1173,65 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user, error: %v", err)
 	}
+	if err := s.syncLDAPGroupRoles(ctx, idp, newUser, userInfo.Groups); err != nil {
+		return nil, err
+	}
 	return newUser, nil
 }
 
+// syncLDAPGroupRoles grants the workspace roles mapped from the LDAP groups of the user on every sign-in.
+// Only the roles in the group mappings are managed, the other roles of the user are kept as is.
+func (s *AuthService) syncLDAPGroupRoles(ctx context.Context, idp *store.IdentityProviderMessage, user *store.UserMessage, groups []string) error {
+	groupMappings := idp.Config.GetLdapConfig().GetGroupMappings()
+	if len(groupMappings) == 0 {
+		return nil
+	}
+
+	managedRoles := map[string]bool{}
+	grantedRoles := map[string]bool{}
+	for _, mapping := range groupMappings {
+		managedRoles[mapping.Role] = true
+		if slices.ContainsFunc(groups, func(group string) bool { return strings.EqualFold(group, mapping.GroupDn) }) {
+			grantedRoles[mapping.Role] = true
+		}
+	}
+
+	policy, err := s.store.GetWorkspaceIamPolicy(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace iam policy, error: %v", err)
+	}
+	member := common.FormatUserUID(user.ID)
+	var roles []string
+	changed := false
+	for _, binding := range policy.Policy.Bindings {
+		if !slices.Contains(binding.Members, member) {
+			continue
+		}
+		if managedRoles[binding.Role] && !grantedRoles[binding.Role] {
+			changed = true
+			continue
+		}
+		roles = append(roles, binding.Role)
+		delete(grantedRoles, binding.Role)
+	}
+	for role := range grantedRoles {
+		roles = append(roles, role)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	if _, err := s.store.PatchWorkspaceIamPolicy(ctx, &store.PatchIamPolicyMessage{
+		Member:     member,
+		Roles:      roles,
+		UpdaterUID: api.SystemBotID,
+	}); err != nil {
+		return status.Errorf(codes.Internal, "failed to update workspace iam policy, error: %v", err)
+	}
+	return nil
+}
+
 func challengeMFACode(user *store.UserMessage, mfaCode string) error {
 	if !validateWithCodeAndSecret(mfaCode, user.MFAConfig.OtpSecret) {
 		return status.Errorf(codes.Unauthenticated, "invalid MFA code")
@@ -19,11 +19,14 @@ import (
 
 	"github.com/epiclabs-io/diff3"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	mysqldb "github.com/bytebase/bytebase/backend/plugin/db/mysql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
@@ -40,18 +43,26 @@ import (
 type BranchService struct {
 	v1pb.UnimplementedBranchServiceServer
 	store          *store.Store
+	sheetManager   *sheet.Manager
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	planService    *PlanService
+	issueService   *IssueService
+	rolloutService *RolloutService
 }
 
 // NewBranchService creates a new BranchService.
-func NewBranchService(store *store.Store, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *BranchService {
+func NewBranchService(store *store.Store, sheetManager *sheet.Manager, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, planService *PlanService, issueService *IssueService, rolloutService *RolloutService) *BranchService {
 	return &BranchService{
 		store:          store,
+		sheetManager:   sheetManager,
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		planService:    planService,
+		issueService:   issueService,
+		rolloutService: rolloutService,
 	}
 }
 
@@ -598,25 +609,10 @@ func (s *BranchService) RebaseBranch(ctx context.Context, request *v1pb.RebaseBr
 		newHeadMetadata, err = tryMerge(baseBranch.Base.Metadata, baseBranch.Head.Metadata, filteredNewBaseMetadata, baseBranch.Engine)
 		if err != nil {
 			slog.Info("cannot rebase branches", log.BBError(err))
-			conflictSchema, err := diff3.Merge(
-				strings.NewReader(newBaseSchema),
-				bytes.NewReader(baseBranch.BaseSchema),
-				bytes.NewReader(baseBranch.HeadSchema),
-				true,
-				"HEAD",
-				baseBranch.ResourceID,
-			)
+			conflictSchemaString, err := getConflictSchema(newBaseSchema, baseBranch)
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to compute conflict schema, %v", err)
+				return nil, err
 			}
-			sb, err := io.ReadAll(conflictSchema.Result)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to read conflict schema, %v", err)
-			}
-			if strings.HasSuffix(newBaseSchema, "\n") && bytes.HasSuffix(baseBranch.BaseSchema, []byte("\n")) && bytes.HasSuffix(baseBranch.HeadSchema, []byte("\n")) {
-				sb = append(sb, []byte("\n")...)
-			}
-			conflictSchemaString := string(sb)
 			return &v1pb.RebaseBranchResponse{Result: &v1pb.RebaseBranchResponse_ConflictSchema{ConflictSchema: conflictSchemaString}}, nil
 		}
 		if newHeadMetadata == nil {
@@ -680,6 +676,29 @@ func (s *BranchService) RebaseBranch(ctx context.Context, request *v1pb.RebaseBr
 	return &v1pb.RebaseBranchResponse{Result: &v1pb.RebaseBranchResponse_Branch{Branch: v1Branch}}, nil
 }
 
+// getConflictSchema returns the schema with the conflict sections of applying the changes of the branch to the new base schema.
+func getConflictSchema(newBaseSchema string, branch *store.BranchMessage) (string, error) {
+	conflictSchema, err := diff3.Merge(
+		strings.NewReader(newBaseSchema),
+		bytes.NewReader(branch.BaseSchema),
+		bytes.NewReader(branch.HeadSchema),
+		true,
+		"HEAD",
+		branch.ResourceID,
+	)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to compute conflict schema, %v", err)
+	}
+	sb, err := io.ReadAll(conflictSchema.Result)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to read conflict schema, %v", err)
+	}
+	if strings.HasSuffix(newBaseSchema, "\n") && bytes.HasSuffix(branch.BaseSchema, []byte("\n")) && bytes.HasSuffix(branch.HeadSchema, []byte("\n")) {
+		sb = append(sb, []byte("\n")...)
+	}
+	return string(sb), nil
+}
+
 func (s *BranchService) getFilteredNewBaseFromRebaseRequest(ctx context.Context, request *v1pb.RebaseBranchRequest) (*storepb.DatabaseSchemaMetadata, string, *storepb.DatabaseConfig, error) {
 	if request.SourceDatabase != "" {
 		instanceID, databaseName, err := common.GetInstanceDatabaseID(request.SourceDatabase)
@@ -807,6 +826,242 @@ func (s *BranchService) DeleteBranch(ctx context.Context, request *v1pb.DeleteBr
 	return &emptypb.Empty{}, nil
 }
 
+// DiffDatabase merges the branch to the database in three ways and returns the diff DDLs or the conflicts.
+func (s *BranchService) DiffDatabase(ctx context.Context, request *v1pb.DiffDatabaseRequest) (*v1pb.DiffDatabaseResponse, error) {
+	_, branch, err := s.getBranchToMergeToDatabase(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.mergeBranchToDatabase(ctx, branch, request.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &v1pb.DiffDatabaseResponse{
+		Diff:      result.diff,
+		Conflicts: convertToMergeConflicts(result.conflicts),
+	}
+	if len(result.conflicts) > 0 {
+		response.Result = &v1pb.DiffDatabaseResponse_ConflictSchema{ConflictSchema: result.conflictSchema}
+		return response, nil
+	}
+	response.Result = &v1pb.DiffDatabaseResponse_Schema{Schema: result.mergedSchema}
+	mergedMetadata, err := convertStoreDatabaseMetadata(ctx, result.mergedMetadata, nil /* config */, nil /* filter */, nil /* optionalStores */)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert merged metadata, error: %v", err)
+	}
+	response.MergedMetadata = mergedMetadata
+	return response, nil
+}
+
+// MergeBranchToDatabase merges the branch to the database and opens the issue applying the merged schema.
+func (s *BranchService) MergeBranchToDatabase(ctx context.Context, request *v1pb.MergeBranchToDatabaseRequest) (*v1pb.MergeBranchToDatabaseResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	project, branch, err := s.getBranchToMergeToDatabase(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.mergeBranchToDatabase(ctx, branch, request.Database)
+	if err != nil {
+		return nil, err
+	}
+	response := &v1pb.MergeBranchToDatabaseResponse{
+		Diff:      result.diff,
+		Conflicts: convertToMergeConflicts(result.conflicts),
+	}
+	if len(result.conflicts) > 0 || result.diff == "" {
+		return response, nil
+	}
+
+	title := request.Title
+	if title == "" {
+		title = fmt.Sprintf("Merge branch %q to database %q", branch.ResourceID, result.database.DatabaseName)
+	}
+	mergeSheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  user.ID,
+		ProjectUID: project.UID,
+		Title:      title,
+		Statement:  result.diff,
+		Payload: &storepb.SheetPayload{
+			Engine: branch.Engine,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+	}
+	plan, err := s.planService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Plan: &v1pb.Plan{
+			Title: title,
+			Steps: []*v1pb.Plan_Step{
+				{
+					Specs: []*v1pb.Plan_Spec{
+						{
+							Id: uuid.NewString(),
+							Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+								ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+									Target: common.FormatDatabase(result.database.InstanceID, result.database.DatabaseName),
+									Sheet:  common.FormatSheet(project.ResourceID, mergeSheet.UID),
+									Type:   v1pb.Plan_ChangeDatabaseConfig_MIGRATE,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create plan")
+	}
+	issue, err := s.issueService.CreateIssue(ctx, &v1pb.CreateIssueRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Issue: &v1pb.Issue{
+			Title:       title,
+			Description: fmt.Sprintf("Merged from branch %s.", common.FormatBranchResourceID(project.ResourceID, branch.ResourceID)),
+			Type:        v1pb.Issue_DATABASE_CHANGE,
+			Plan:        plan.Name,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create issue")
+	}
+	if _, err := s.rolloutService.CreateRollout(ctx, &v1pb.CreateRolloutRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Rollout: &v1pb.Rollout{
+			Plan: plan.Name,
+		},
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to create rollout")
+	}
+	response.Issue = issue
+	return response, nil
+}
+
+func (s *BranchService) getBranchToMergeToDatabase(ctx context.Context, name string) (*store.ProjectMessage, *store.BranchMessage, error) {
+	projectID, branchID, err := common.GetProjectAndBranchID(name)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.getProject(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	branch, err := s.store.GetBranch(ctx, &store.FindBranchMessage{ProjectID: &project.ResourceID, ResourceID: &branchID, LoadFull: true})
+	if err != nil {
+		return nil, nil, err
+	}
+	if branch == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "branch %q not found", branchID)
+	}
+	return project, branch, nil
+}
+
+// branchDatabaseMergeResult is the result of merging a branch to a database.
+type branchDatabaseMergeResult struct {
+	database *store.DatabaseMessage
+	// conflicts and conflictSchema are set if the merge has conflicts.
+	conflicts      []*mergeConflict
+	conflictSchema string
+	// mergedMetadata, mergedSchema and diff are set if the merge has no conflict.
+	mergedMetadata *storepb.DatabaseSchemaMetadata
+	mergedSchema   string
+	// diff is the DDLs from the database schema to the merged schema.
+	diff string
+}
+
+// mergeBranchToDatabase merges the branch to the database in three ways.
+// The baseline of the branch is the ancestor, the head of the branch and the live schema of the database are the two sides.
+func (s *BranchService) mergeBranchToDatabase(ctx context.Context, branch *store.BranchMessage, databaseName string) (*branchDatabaseMergeResult, error) {
+	instanceID, name, err := common.GetInstanceDatabaseID(databaseName)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found or had been deleted", instanceID)
+	}
+	if instance.Engine != branch.Engine {
+		return nil, status.Errorf(codes.InvalidArgument, "database engine %v does not match branch engine %v", instance.Engine, branch.Engine)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &name,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found or had been archive", name)
+	}
+	databaseMetadata, databaseSchema, _, err := s.getFilteredNewBaseFromRebaseRequest(ctx, &v1pb.RebaseBranchRequest{SourceDatabase: databaseName})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &branchDatabaseMergeResult{database: database}
+	conflicts, err := findMergeConflicts(branch.Base.Metadata, branch.Head.Metadata, databaseMetadata, branch.Engine)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find merge conflicts, error: %v", err)
+	}
+	if len(conflicts) > 0 {
+		conflictSchema, err := getConflictSchema(databaseSchema, branch)
+		if err != nil {
+			return nil, err
+		}
+		result.conflicts = conflicts
+		result.conflictSchema = conflictSchema
+		return result, nil
+	}
+
+	mergedMetadata, err := tryMerge(branch.Base.Metadata, branch.Head.Metadata, databaseMetadata, branch.Engine)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to merge branch to database, error: %v", err)
+	}
+	reconcileMetadata(mergedMetadata, branch.Engine)
+	filteredMergedMetadata := filterDatabaseMetadataByEngine(mergedMetadata, branch.Engine)
+	defaultSchema := extractDefaultSchemaForOracleBranch(branch.Engine, filteredMergedMetadata)
+	mergedSchema, err := schema.GetDesignSchema(branch.Engine, defaultSchema, "" /* baseline */, filteredMergedMetadata)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert merged metadata to schema string, %v", err)
+	}
+	strictMode := true
+	if branch.Engine == storepb.Engine_ORACLE {
+		strictMode = false
+	}
+	diff, err := base.SchemaDiff(branch.Engine, base.DiffContext{
+		IgnoreCaseSensitive: false,
+		StrictMode:          strictMode,
+	}, databaseSchema, mergedSchema)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute diff between database and merged schemas, error: %v", err)
+	}
+	result.mergedMetadata = filteredMergedMetadata
+	result.mergedSchema = mergedSchema
+	result.diff = diff
+	return result, nil
+}
+
+func convertToMergeConflicts(conflicts []*mergeConflict) []*v1pb.MergeConflict {
+	var result []*v1pb.MergeConflict
+	for _, conflict := range conflicts {
+		result = append(result, &v1pb.MergeConflict{
+			Schema:      conflict.schema,
+			Table:       conflict.table,
+			Column:      conflict.column,
+			Description: conflict.message,
+		})
+	}
+	return result
+}
+
 func (*BranchService) DiffMetadata(ctx context.Context, request *v1pb.DiffMetadataRequest) (*v1pb.DiffMetadataResponse, error) {
 	switch request.Engine {
 	case v1pb.Engine_MYSQL, v1pb.Engine_POSTGRES, v1pb.Engine_TIDB, v1pb.Engine_ORACLE:
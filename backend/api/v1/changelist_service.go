@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -12,6 +14,8 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -20,17 +24,23 @@ import (
 // ChangelistService implements the changelist service.
 type ChangelistService struct {
 	v1pb.UnimplementedChangelistServiceServer
-	store      *store.Store
-	profile    *config.Profile
-	iamManager *iam.Manager
+	store          *store.Store
+	profile        *config.Profile
+	iamManager     *iam.Manager
+	planService    *PlanService
+	issueService   *IssueService
+	rolloutService *RolloutService
 }
 
 // NewChangelistService creates a new ChangelistService.
-func NewChangelistService(store *store.Store, profile *config.Profile, iamManager *iam.Manager) *ChangelistService {
+func NewChangelistService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, planService *PlanService, issueService *IssueService, rolloutService *RolloutService) *ChangelistService {
 	return &ChangelistService{
-		store:      store,
-		profile:    profile,
-		iamManager: iamManager,
+		store:          store,
+		profile:        profile,
+		iamManager:     iamManager,
+		planService:    planService,
+		issueService:   issueService,
+		rolloutService: rolloutService,
 	}
 }
 
@@ -249,6 +259,309 @@ func (s *ChangelistService) DeleteChangelist(ctx context.Context, request *v1pb.
 	return &emptypb.Empty{}, nil
 }
 
+// PromoteChangelist promotes a changelist rolled out by the source issue to the targets.
+func (s *ChangelistService) PromoteChangelist(ctx context.Context, request *v1pb.PromoteChangelistRequest) (*v1pb.PromoteChangelistResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	if len(request.Targets) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "targets must be set")
+	}
+	projectID, changelistID, err := common.GetProjectIDChangelistID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	changelist, err := s.store.GetChangelist(ctx, &store.FindChangelistMessage{ProjectID: &project.ResourceID, ResourceID: &changelistID})
+	if err != nil {
+		return nil, err
+	}
+	if changelist == nil {
+		return nil, status.Errorf(codes.NotFound, "changelist %q not found", changelistID)
+	}
+	if len(changelist.Payload.Changes) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "changelist %q has no changes", changelistID)
+	}
+	for _, target := range request.Targets {
+		if _, _, err := common.GetInstanceDatabaseID(target); err == nil {
+			continue
+		}
+		if _, _, err := common.GetProjectIDDatabaseGroupID(target); err == nil {
+			continue
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target %q", target)
+	}
+
+	sourceProjectID, sourceIssueUID, err := common.GetProjectIDIssueUID(request.SourceIssue)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if sourceProjectID != project.ResourceID {
+		return nil, status.Errorf(codes.InvalidArgument, "source issue %q is not in project %q", request.SourceIssue, project.ResourceID)
+	}
+	sourceIssue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &sourceIssueUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get issue, error: %v", err)
+	}
+	if sourceIssue == nil {
+		return nil, status.Errorf(codes.NotFound, "issue %q not found", request.SourceIssue)
+	}
+	if sourceIssue.PlanUID == nil || sourceIssue.PipelineUID == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "issue %q has not been rolled out", request.SourceIssue)
+	}
+	sourcePlan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: sourceIssue.PlanUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
+	}
+	if sourcePlan == nil {
+		return nil, status.Errorf(codes.NotFound, "plan %d not found", *sourceIssue.PlanUID)
+	}
+	sourceTasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: sourceIssue.PipelineUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks, error: %v", err)
+	}
+	if len(sourceTasks) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "issue %q has no tasks", request.SourceIssue)
+	}
+	for _, task := range sourceTasks {
+		if task.LatestTaskRunStatus != api.TaskRunDone && task.LatestTaskRunStatus != api.TaskRunSkipped {
+			return nil, status.Errorf(codes.FailedPrecondition, "task %q of issue %q has not been rolled out successfully", task.Name, request.SourceIssue)
+		}
+	}
+
+	specs, err := getPromotedChangelistSpecs(changelist.Payload, sourcePlan.Config, request.Targets)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+
+	baselineDiffs, err := s.getPromotedChangelistBaselineDiffs(ctx, sourceTasks, request.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	title := request.Title
+	if title == "" {
+		title = fmt.Sprintf("Promote changelist %q", changelistID)
+	}
+	description := fmt.Sprintf("Promoted from %s.", request.SourceIssue)
+	if changelist.Payload.Description != "" {
+		description = fmt.Sprintf("%s\n\n%s", changelist.Payload.Description, description)
+	}
+	plan, err := s.planService.CreatePlan(ctx, &v1pb.CreatePlanRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Plan: &v1pb.Plan{
+			Title:       title,
+			Description: description,
+			Steps: []*v1pb.Plan_Step{
+				{Specs: specs},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create plan")
+	}
+	issue, err := s.issueService.CreateIssue(ctx, &v1pb.CreateIssueRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Issue: &v1pb.Issue{
+			Title:       title,
+			Description: description,
+			Type:        v1pb.Issue_DATABASE_CHANGE,
+			Plan:        plan.Name,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create issue")
+	}
+	if _, err := s.rolloutService.CreateRollout(ctx, &v1pb.CreateRolloutRequest{
+		Parent: common.FormatProject(project.ResourceID),
+		Rollout: &v1pb.Rollout{
+			Plan: plan.Name,
+		},
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to create rollout")
+	}
+
+	// Link the source issue and the promoted issue.
+	_, issueUID, err := common.GetProjectIDIssueUID(issue.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	promotedIssue, err := s.store.UpdateIssueV2(ctx, issueUID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.IssuePayload{
+			PromotedFromIssue: common.FormatIssue(project.ResourceID, sourceIssue.UID),
+		},
+	}, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
+	}
+	if _, err := s.store.UpdateIssueV2(ctx, sourceIssue.UID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.IssuePayload{
+			PromotedToIssues: append(sourceIssue.Payload.GetPromotedToIssues(), issue.Name),
+		},
+	}, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update issue, error: %v", err)
+	}
+
+	v1Issue, err := convertToIssue(ctx, s.store, promotedIssue)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
+	}
+	return &v1pb.PromoteChangelistResponse{
+		Issue:         v1Issue,
+		BaselineDiffs: baselineDiffs,
+	}, nil
+}
+
+// getPromotedChangelistSpecs returns the specs applying the changes of the changelist to the targets.
+// The sheets, change types and schema versions are carried forward from the specs of the source plan,
+// so every change of the changelist must have been rolled out by the source plan.
+func getPromotedChangelistSpecs(changelist *storepb.Changelist, sourcePlanConfig *storepb.PlanConfig, targets []string) ([]*v1pb.Plan_Spec, error) {
+	sourceConfigs := make(map[string]*storepb.PlanConfig_ChangeDatabaseConfig)
+	for _, step := range sourcePlanConfig.GetSteps() {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				continue
+			}
+			if _, ok := sourceConfigs[config.Sheet]; !ok {
+				sourceConfigs[config.Sheet] = config
+			}
+		}
+	}
+
+	var specs []*v1pb.Plan_Spec
+	for _, target := range targets {
+		for _, change := range changelist.Changes {
+			sourceConfig, ok := sourceConfigs[change.Sheet]
+			if !ok {
+				return nil, errors.Errorf("sheet %q of the changelist is not rolled out by the source issue", change.Sheet)
+			}
+			schemaVersion := sourceConfig.SchemaVersion
+			if schemaVersion == "" {
+				schemaVersion = change.Version
+			}
+			specs = append(specs, &v1pb.Plan_Spec{
+				Id: uuid.NewString(),
+				Config: &v1pb.Plan_Spec_ChangeDatabaseConfig{
+					ChangeDatabaseConfig: &v1pb.Plan_ChangeDatabaseConfig{
+						Target:        target,
+						Sheet:         sourceConfig.Sheet,
+						Type:          convertToPlanSpecChangeDatabaseConfigType(sourceConfig.Type),
+						SchemaVersion: schemaVersion,
+						GhostFlags:    sourceConfig.GhostFlags,
+					},
+				},
+			})
+		}
+	}
+	return specs, nil
+}
+
+// getPromotedChangelistBaselineDiffs compares the schema of each target database with the schema of the source database
+// before the source rollout. The source database with the same name is preferred, otherwise the first one is used.
+func (s *ChangelistService) getPromotedChangelistBaselineDiffs(ctx context.Context, sourceTasks []*store.TaskMessage, targets []string) ([]*v1pb.PromoteChangelistResponse_BaselineDiff, error) {
+	var sourceTask *store.TaskMessage
+	sourceTaskByDatabaseName := make(map[string]*store.TaskMessage)
+	for _, task := range sourceTasks {
+		if task.DatabaseID == nil {
+			continue
+		}
+		if sourceTask == nil {
+			sourceTask = task
+		}
+		if _, ok := sourceTaskByDatabaseName[task.DatabaseName]; !ok {
+			sourceTaskByDatabaseName[task.DatabaseName] = task
+		}
+	}
+	if sourceTask == nil {
+		return nil, nil
+	}
+
+	var diffs []*v1pb.PromoteChangelistResponse_BaselineDiff
+	for _, target := range targets {
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(target)
+		if err != nil {
+			// Database group targets are not compared.
+			continue
+		}
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+		}
+		if instance == nil {
+			return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+		}
+		targetDatabase, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:          &instanceID,
+			DatabaseName:        &databaseName,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database %q, error: %v", target, err)
+		}
+		if targetDatabase == nil {
+			return nil, status.Errorf(codes.NotFound, "database %q not found", target)
+		}
+		targetSchema, err := s.store.GetDBSchema(ctx, targetDatabase.UID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database schema %q, error: %v", target, err)
+		}
+		if targetSchema == nil {
+			continue
+		}
+
+		task := sourceTask
+		if t, ok := sourceTaskByDatabaseName[targetDatabase.DatabaseName]; ok {
+			task = t
+		}
+		sourceDatabase, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database %d, error: %v", *task.DatabaseID, err)
+		}
+		if sourceDatabase == nil {
+			continue
+		}
+		// The snapshot taken before the source task is created is the schema baseline of the source rollout.
+		snapshot, err := s.store.GetDBSchemaSnapshotAsOf(ctx, sourceDatabase.UID, task.CreatedTs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get schema snapshot, error: %v", err)
+		}
+		if snapshot == nil {
+			continue
+		}
+
+		strictMode := true
+		if instance.Engine == storepb.Engine_ORACLE {
+			strictMode = false
+		}
+		diff, err := base.SchemaDiff(instance.Engine, base.DiffContext{
+			IgnoreCaseSensitive: false,
+			StrictMode:          strictMode,
+		}, snapshot.Schema, string(targetSchema.GetSchema()))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to compute diff between source and target schemas, error: %v", err)
+		}
+		if diff == "" {
+			continue
+		}
+		diffs = append(diffs, &v1pb.PromoteChangelistResponse_BaselineDiff{
+			SourceDatabase: common.FormatDatabase(sourceDatabase.InstanceID, sourceDatabase.DatabaseName),
+			TargetDatabase: common.FormatDatabase(targetDatabase.InstanceID, targetDatabase.DatabaseName),
+			Diff:           diff,
+		})
+	}
+	return diffs, nil
+}
+
 func convertV1ChangelistPayload(changelist *v1pb.Changelist) *storepb.Changelist {
 	storeChangelist := &storepb.Changelist{
 		Description: changelist.Description,
@@ -0,0 +1,64 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestGetPromotedChangelistSpecs(t *testing.T) {
+	a := require.New(t)
+	sourcePlanConfig := &storepb.PlanConfig{
+		Steps: []*storepb.PlanConfig_Step{
+			{
+				Specs: []*storepb.PlanConfig_Spec{
+					{
+						Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+							ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+								Target:        "instances/staging/databases/db",
+								Sheet:         "projects/p/sheets/101",
+								Type:          storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE,
+								SchemaVersion: "20240101",
+							},
+						},
+					},
+					{
+						Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+							ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+								Target: "instances/staging/databases/db",
+								Sheet:  "projects/p/sheets/102",
+								Type:   storepb.PlanConfig_ChangeDatabaseConfig_DATA,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	changelist := &storepb.Changelist{
+		Changes: []*storepb.Changelist_Change{
+			{Sheet: "projects/p/sheets/101"},
+			{Sheet: "projects/p/sheets/102", Version: "20240102"},
+		},
+	}
+
+	specs, err := getPromotedChangelistSpecs(changelist, sourcePlanConfig, []string{"instances/prod/databases/db"})
+	a.NoError(err)
+	a.Len(specs, 2)
+	first := specs[0].GetChangeDatabaseConfig()
+	a.Equal("instances/prod/databases/db", first.Target)
+	a.Equal("projects/p/sheets/101", first.Sheet)
+	a.Equal(v1pb.Plan_ChangeDatabaseConfig_MIGRATE, first.Type)
+	a.Equal("20240101", first.SchemaVersion)
+	second := specs[1].GetChangeDatabaseConfig()
+	a.Equal(v1pb.Plan_ChangeDatabaseConfig_DATA, second.Type)
+	a.Equal("20240102", second.SchemaVersion)
+	a.NotEqual(specs[0].Id, specs[1].Id)
+
+	changelist.Changes = append(changelist.Changes, &storepb.Changelist_Change{Sheet: "projects/p/sheets/103"})
+	_, err = getPromotedChangelistSpecs(changelist, sourcePlanConfig, []string{"instances/prod/databases/db"})
+	a.Error(err)
+}
@@ -0,0 +1,365 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	"github.com/bytebase/bytebase/backend/runner/backup"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// GetBackupSetting gets the backup setting of a database.
+func (s *DatabaseService) GetBackupSetting(ctx context.Context, request *v1pb.GetBackupSettingRequest) (*v1pb.BackupSetting, error) {
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.Name, common.BackupSettingSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	_, database, err := s.getInstanceDatabaseForBackup(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.store.GetBackupSetting(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get backup setting: %v", err)
+	}
+	if setting == nil {
+		setting = newDefaultBackupSetting(database)
+	}
+	return convertToV1BackupSetting(database, setting), nil
+}
+
+// UpdateBackupSetting updates the backup setting of a database.
+func (s *DatabaseService) UpdateBackupSetting(ctx context.Context, request *v1pb.UpdateBackupSettingRequest) (*v1pb.BackupSetting, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if request.BackupSetting == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "backup setting is required")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.BackupSetting.Name, common.BackupSettingSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, database, err := s.getInstanceDatabaseForBackup(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	if !backup.IsEngineSupported(instance.Engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "backup is not supported for engine %v", instance.Engine)
+	}
+
+	setting, err := s.store.GetBackupSetting(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get backup setting: %v", err)
+	}
+	if setting == nil {
+		setting = newDefaultBackupSetting(database)
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "enabled":
+			setting.Enabled = request.BackupSetting.Enabled
+		case "hour":
+			setting.Payload.Hour = request.BackupSetting.Hour
+		case "day_of_week":
+			setting.Payload.DayOfWeek = request.BackupSetting.DayOfWeek
+		case "retention":
+			setting.Payload.Retention = request.BackupSetting.Retention
+		case "storage":
+			setting.Payload.Storage = convertToStoreBackupStorage(request.BackupSetting.Storage, setting.Payload.Storage, s.secret)
+		case "encryption_key":
+			setting.Payload.ObfuscatedEncryptionKey = ""
+			if request.BackupSetting.EncryptionKey != "" {
+				setting.Payload.ObfuscatedEncryptionKey = common.Obfuscate(request.BackupSetting.EncryptionKey, s.secret)
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update_mask path %q", path)
+		}
+	}
+	if err := validateBackupSetting(setting); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	setting, err = s.store.UpsertBackupSetting(ctx, setting, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update backup setting: %v", err)
+	}
+	return convertToV1BackupSetting(database, setting), nil
+}
+
+// ListBackupRuns lists the backup runs of a database.
+func (s *DatabaseService) ListBackupRuns(ctx context.Context, request *v1pb.ListBackupRunsRequest) (*v1pb.ListBackupRunsResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	_, database, err := s.getInstanceDatabaseForBackup(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset, err := parseLimitAndOffset(request.PageToken, int(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := limit + 1
+	backupRuns, err := s.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		DatabaseUID: &database.UID,
+		Limit:       &limitPlusOne,
+		Offset:      &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list backup runs: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(backupRuns) == limitPlusOne {
+		backupRuns = backupRuns[:limit]
+		if nextPageToken, err = marshalPageToken(&storepb.PageToken{
+			Limit:  int32(limit),
+			Offset: int32(limit + offset),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal next page token, error: %v", err)
+		}
+	}
+
+	response := &v1pb.ListBackupRunsResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, backupRun := range backupRuns {
+		v1BackupRun, err := s.convertToV1BackupRun(ctx, database, backupRun)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert backup run: %v", err)
+		}
+		response.BackupRuns = append(response.BackupRuns, v1BackupRun)
+	}
+	return response, nil
+}
+
+// CreateBackupRun creates a manual backup run of a database, which is executed by the backup runner.
+func (s *DatabaseService) CreateBackupRun(ctx context.Context, request *v1pb.CreateBackupRunRequest) (*v1pb.BackupRun, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if s.profile.Readonly {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create backup in readonly mode")
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, database, err := s.getInstanceDatabaseForBackup(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	if !backup.IsEngineSupported(instance.Engine) {
+		return nil, status.Errorf(codes.InvalidArgument, "backup is not supported for engine %v", instance.Engine)
+	}
+	setting, err := s.store.GetBackupSetting(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get backup setting: %v", err)
+	}
+	if setting == nil || setting.Payload.GetStorage() == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "backup storage is not configured for database %q", request.Parent)
+	}
+	activeRuns, err := s.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		DatabaseUID: &database.UID,
+		StatusList:  []store.BackupRunStatus{store.BackupRunPending, store.BackupRunRunning},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list backup runs: %v", err)
+	}
+	if len(activeRuns) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q has a backup in progress", request.Parent)
+	}
+
+	backupRun, err := s.store.CreateBackupRun(ctx, &store.BackupRunMessage{
+		DatabaseUID: database.UID,
+		CreatorID:   principalID,
+		Status:      store.BackupRunPending,
+		Payload: &storepb.BackupRunPayload{
+			Manual: true,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create backup run: %v", err)
+	}
+	return s.convertToV1BackupRun(ctx, database, backupRun)
+}
+
+func (s *DatabaseService) getInstanceDatabaseForBackup(ctx context.Context, instanceID, databaseName string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get instance %s: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	return instance, database, nil
+}
+
+func newDefaultBackupSetting(database *store.DatabaseMessage) *store.BackupSettingMessage {
+	return &store.BackupSettingMessage{
+		DatabaseUID: database.UID,
+		Payload: &storepb.BackupSettingPayload{
+			// Every day at 00:00 UTC.
+			DayOfWeek: -1,
+		},
+	}
+}
+
+func validateBackupSetting(setting *store.BackupSettingMessage) error {
+	payload := setting.Payload
+	if payload.Hour < 0 || payload.Hour > 23 {
+		return errors.Errorf("hour must be between 0 and 23, got %d", payload.Hour)
+	}
+	if payload.DayOfWeek < -1 || payload.DayOfWeek > 6 {
+		return errors.Errorf("day_of_week must be between -1 and 6, got %d", payload.DayOfWeek)
+	}
+	if payload.Retention != nil && payload.Retention.AsDuration() < 24*time.Hour {
+		return errors.Errorf("retention must be at least one day")
+	}
+	if payload.Storage == nil {
+		if setting.Enabled {
+			return errors.Errorf("storage is required to enable the backup")
+		}
+		return nil
+	}
+	return validateBackupStorage(payload.Storage)
+}
+
+func validateBackupStorage(storage *storepb.BackupStorage) error {
+	if storage.Type == storepb.BackupStorage_TYPE_UNSPECIFIED {
+		return errors.Errorf("storage type is required")
+	}
+	if storage.Bucket == "" {
+		return errors.Errorf("storage bucket is required")
+	}
+	if storage.Type == storepb.BackupStorage_MINIO && storage.Endpoint == "" {
+		return errors.Errorf("storage endpoint is required for MinIO")
+	}
+	if storage.Type != storepb.BackupStorage_S3 && storage.AccessKeyId == "" {
+		return errors.Errorf("storage access key is required for %v", storage.Type)
+	}
+	if (storage.AccessKeyId == "") != (storage.ObfuscatedSecretAccessKey == "") {
+		return errors.Errorf("storage access key id and secret access key must be set together")
+	}
+	return nil
+}
+
+// convertToStoreBackupStorage converts the storage, the secret access key is kept if it's not provided.
+func convertToStoreBackupStorage(storage *v1pb.BackupStorage, current *storepb.BackupStorage, secret string) *storepb.BackupStorage {
+	if storage == nil {
+		return nil
+	}
+	storeStorage := &storepb.BackupStorage{
+		Type:        storepb.BackupStorage_Type(storage.Type),
+		Bucket:      storage.Bucket,
+		Prefix:      strings.Trim(storage.Prefix, "/"),
+		Region:      storage.Region,
+		Endpoint:    storage.Endpoint,
+		AccessKeyId: storage.AccessKeyId,
+	}
+	if storage.SecretAccessKey != "" {
+		storeStorage.ObfuscatedSecretAccessKey = common.Obfuscate(storage.SecretAccessKey, secret)
+	} else if storage.AccessKeyId != "" && storage.AccessKeyId == current.GetAccessKeyId() {
+		storeStorage.ObfuscatedSecretAccessKey = current.GetObfuscatedSecretAccessKey()
+	}
+	return storeStorage
+}
+
+func convertToV1BackupSetting(database *store.DatabaseMessage, setting *store.BackupSettingMessage) *v1pb.BackupSetting {
+	v1Setting := &v1pb.BackupSetting{
+		Name:      fmt.Sprintf("%s%s", common.FormatDatabase(database.InstanceID, database.DatabaseName), common.BackupSettingSuffix),
+		Enabled:   setting.Enabled,
+		Hour:      setting.Payload.Hour,
+		DayOfWeek: setting.Payload.DayOfWeek,
+		Retention: setting.Payload.Retention,
+		Encrypted: setting.Payload.ObfuscatedEncryptionKey != "",
+	}
+	if !setting.UpdatedTime.IsZero() {
+		v1Setting.UpdateTime = timestamppb.New(setting.UpdatedTime)
+	}
+	v1Setting.Storage = convertToV1BackupStorage(setting.Payload.Storage)
+	return v1Setting
+}
+
+// convertToV1BackupStorage converts the storage, the secret access key is never returned.
+func convertToV1BackupStorage(storage *storepb.BackupStorage) *v1pb.BackupStorage {
+	if storage == nil {
+		return nil
+	}
+	return &v1pb.BackupStorage{
+		Type:        v1pb.BackupStorage_Type(storage.Type),
+		Bucket:      storage.Bucket,
+		Prefix:      storage.Prefix,
+		Region:      storage.Region,
+		Endpoint:    storage.Endpoint,
+		AccessKeyId: storage.AccessKeyId,
+	}
+}
+
+func (s *DatabaseService) convertToV1BackupRun(ctx context.Context, database *store.DatabaseMessage, backupRun *store.BackupRunMessage) (*v1pb.BackupRun, error) {
+	creator, err := s.store.GetUserByID(ctx, backupRun.CreatorID)
+	if err != nil {
+		return nil, err
+	}
+	if creator == nil {
+		return nil, errors.Errorf("cannot found user with id %d", backupRun.CreatorID)
+	}
+
+	v1BackupRun := &v1pb.BackupRun{
+		Name:       fmt.Sprintf("%s/%s%d", common.FormatDatabase(database.InstanceID, database.DatabaseName), common.BackupRunPrefix, backupRun.UID),
+		Creator:    common.FormatUserEmail(creator.Email),
+		CreateTime: timestamppb.New(backupRun.CreatedTime),
+		StartTime:  backupRun.Payload.StartTime,
+		FinishTime: backupRun.Payload.FinishTime,
+		Size:       backupRun.Payload.Size,
+		Error:      backupRun.Payload.Error,
+		Encrypted:  backupRun.Payload.Encrypted,
+		Manual:     backupRun.Payload.Manual,
+	}
+	if backupRun.Payload.Path != "" {
+		v1BackupRun.Uri = objectstorage.GetObjectURI(backupRun.Payload.Storage, backupRun.Payload.Path)
+	}
+	switch backupRun.Status {
+	case store.BackupRunPending:
+		v1BackupRun.Status = v1pb.BackupRun_PENDING
+	case store.BackupRunRunning:
+		v1BackupRun.Status = v1pb.BackupRun_RUNNING
+	case store.BackupRunDone:
+		v1BackupRun.Status = v1pb.BackupRun_DONE
+	case store.BackupRunFailed:
+		v1BackupRun.Status = v1pb.BackupRun_FAILED
+	case store.BackupRunDeleted:
+		v1BackupRun.Status = v1pb.BackupRun_DELETED
+	}
+	return v1BackupRun, nil
+}
@@ -0,0 +1,281 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const changelogToolLiquibase = "LIQUIBASE"
+
+// changelogEntry is a change in the exported JSON changelog.
+type changelogEntry struct {
+	Version     string `json:"version"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Statement   string `json:"statement"`
+	Checksum    string `json:"checksum"`
+	AppliedTime string `json:"appliedTime"`
+	Author      string `json:"author"`
+	Issue       string `json:"issue,omitempty"`
+	// Tool is the external migration tool which applied the change.
+	Tool string `json:"tool,omitempty"`
+}
+
+// ExportChangeHistories exports the applied migration history of the database as a changelog.
+func (s *DatabaseService) ExportChangeHistories(ctx context.Context, request *v1pb.ExportChangeHistoriesRequest) (*v1pb.ExportChangeHistoriesResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, database, err := s.getInstanceAndDatabase(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	doneStatus := db.Done
+	histories, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		Status:     &doneStatus,
+		ShowFull:   true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change history, error: %v", err)
+	}
+	// The change histories are listed in the descending order of the sequence.
+	slices.Reverse(histories)
+
+	var entries []*changelogEntry
+	for _, history := range histories {
+		entry, err := s.convertToChangelogEntry(ctx, history)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	var content []byte
+	switch request.Format {
+	case v1pb.ExportChangeHistoriesRequest_FORMAT_UNSPECIFIED, v1pb.ExportChangeHistoriesRequest_JSON:
+		if entries == nil {
+			entries = []*changelogEntry{}
+		}
+		content, err = json.MarshalIndent(entries, "", "  ")
+	case v1pb.ExportChangeHistoriesRequest_LIQUIBASE:
+		content, err = json.MarshalIndent(buildLiquibaseChangelog(entries), "", "  ")
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported format %q", request.Format)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal changelog, error: %v", err)
+	}
+	return &v1pb.ExportChangeHistoriesResponse{Content: content}, nil
+}
+
+func (s *DatabaseService) convertToChangelogEntry(ctx context.Context, history *store.InstanceChangeHistoryMessage) (*changelogEntry, error) {
+	statement := history.Statement
+	if history.SheetID != nil {
+		// The statement in the change history may be truncated for the large sheets.
+		sheetStatement, err := s.store.GetSheetStatementByID(ctx, *history.SheetID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get sheet statement %d, error: %v", *history.SheetID, err)
+		}
+		statement = sheetStatement
+	}
+
+	entry := &changelogEntry{
+		Version:     history.Version.Version,
+		Type:        string(history.Type),
+		Description: history.Description,
+		Statement:   statement,
+		AppliedTime: time.Unix(history.CreatedTs, 0).UTC().Format(time.RFC3339),
+		Author:      history.Creator.Email,
+	}
+	if externalChangelog := history.Payload.GetExternalChangelog(); externalChangelog != nil {
+		entry.Tool = externalChangelog.Tool
+		entry.Checksum = externalChangelog.Checksum
+		if externalChangelog.AppliedTime != nil {
+			entry.AppliedTime = externalChangelog.AppliedTime.AsTime().UTC().Format(time.RFC3339)
+		}
+	} else {
+		h := sha256.Sum256([]byte(statement))
+		entry.Checksum = hex.EncodeToString(h[:])
+	}
+	if history.IssueUID != nil && history.ProjectUID != nil {
+		project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{UID: history.ProjectUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+		}
+		if project != nil {
+			entry.Issue = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, project.ResourceID, common.IssueNamePrefix, *history.IssueUID)
+		}
+	}
+	return entry, nil
+}
+
+// buildLiquibaseChangelog builds the Liquibase JSON changelog with one changeset per change.
+// The checksums of the changes applied by Liquibase are kept as the valid checksums.
+func buildLiquibaseChangelog(entries []*changelogEntry) map[string]any {
+	changeSets := []map[string]any{}
+	for _, entry := range entries {
+		changeSet := map[string]any{
+			"id":      entry.Version,
+			"author":  entry.Author,
+			"comment": entry.Description,
+			"changes": []map[string]any{
+				{"sql": map[string]any{"sql": entry.Statement}},
+			},
+		}
+		if entry.Tool == changelogToolLiquibase && entry.Checksum != "" {
+			changeSet["validCheckSum"] = entry.Checksum
+		}
+		if entry.Issue != "" {
+			changeSet["labels"] = entry.Issue
+		}
+		changeSets = append(changeSets, map[string]any{"changeSet": changeSet})
+	}
+	return map[string]any{"databaseChangeLog": changeSets}
+}
+
+// ImportChangeHistories seeds the migration history of a database which is already managed by an external migration tool.
+func (s *DatabaseService) ImportChangeHistories(ctx context.Context, request *v1pb.ImportChangeHistoriesRequest) (*v1pb.ImportChangeHistoriesResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, database, err := s.getInstanceAndDatabase(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	if len(request.Changes) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "changes must be set")
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	tool := strings.ToUpper(strings.TrimSpace(request.Tool))
+
+	var creates []*store.InstanceChangeHistoryMessage
+	seenVersions := make(map[string]bool)
+	for _, change := range request.Changes {
+		if change.Version == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "the version of the change must be set")
+		}
+		if seenVersions[change.Version] {
+			return nil, status.Errorf(codes.InvalidArgument, "found duplicate version %q", change.Version)
+		}
+		seenVersions[change.Version] = true
+		migrationType, err := convertToMigrationType(change.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		version := model.Version{Version: change.Version}
+		existing, err := s.store.GetInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+			InstanceID: &instance.UID,
+			DatabaseID: &database.UID,
+			Version:    &version,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get change history, error: %v", err)
+		}
+		if existing != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "change history with version %q already exists", change.Version)
+		}
+
+		creates = append(creates, &store.InstanceChangeHistoryMessage{
+			CreatorID:      user.ID,
+			InstanceUID:    &instance.UID,
+			DatabaseUID:    &database.UID,
+			ProjectUID:     &project.UID,
+			ReleaseVersion: s.profile.Version,
+			Source:         db.LIBRARY,
+			Type:           migrationType,
+			Status:         db.Done,
+			Version:        version,
+			Description:    change.Description,
+			Statement:      change.Statement,
+			Payload: &storepb.InstanceChangeHistoryPayload{
+				ExternalChangelog: &storepb.ExternalChangelog{
+					Tool:        tool,
+					Checksum:    change.Checksum,
+					AppliedTime: change.AppliedTime,
+				},
+			},
+		})
+	}
+
+	uids, err := s.store.CreateInstanceChangeHistories(ctx, creates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create change histories, error: %v", err)
+	}
+	// The schema version of the database follows the last change applied by the external tool.
+	lastVersion := creates[len(creates)-1].Version
+	if _, err := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:    database.InstanceID,
+		DatabaseName:  database.DatabaseName,
+		SchemaVersion: &lastVersion,
+	}, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update database schema version, error: %v", err)
+	}
+
+	resp := &v1pb.ImportChangeHistoriesResponse{}
+	for _, uid := range uids {
+		history, err := s.store.GetInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+			InstanceID: &instance.UID,
+			DatabaseID: &database.UID,
+			ID:         &uid,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get change history, error: %v", err)
+		}
+		if history == nil {
+			return nil, status.Errorf(codes.Internal, "change history %q not found", uid)
+		}
+		converted, err := s.convertToChangeHistory(ctx, history)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert change history, error: %v", err)
+		}
+		resp.ChangeHistories = append(resp.ChangeHistories, converted)
+	}
+	return resp, nil
+}
+
+func convertToMigrationType(t v1pb.ChangeHistory_Type) (db.MigrationType, error) {
+	switch t {
+	case v1pb.ChangeHistory_TYPE_UNSPECIFIED, v1pb.ChangeHistory_MIGRATE:
+		return db.Migrate, nil
+	case v1pb.ChangeHistory_BASELINE:
+		return db.Baseline, nil
+	case v1pb.ChangeHistory_MIGRATE_SDL:
+		return db.MigrateSDL, nil
+	case v1pb.ChangeHistory_DATA:
+		return db.Data, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported change type %q", t)
+	}
+}
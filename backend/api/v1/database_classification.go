@@ -0,0 +1,420 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ImportColumnClassifications imports the column classifications of a database.
+func (s *DatabaseService) ImportColumnClassifications(ctx context.Context, request *v1pb.ImportColumnClassificationsRequest) (*v1pb.ImportColumnClassificationsResponse, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instance, database, dbSchema, err := s.getDatabaseSchemaForClassification(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	classificationConfig, err := s.getDatabaseClassificationConfig(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+	// The schema sync overwrites the classifications with the ones parsed from the comments.
+	if !classificationConfig.ClassificationFromConfig && (instance.Engine == storepb.Engine_MYSQL || instance.Engine == storepb.Engine_POSTGRES) {
+		return nil, status.Errorf(codes.FailedPrecondition, "the classifications of database %q are managed by the table and column comments", request.Name)
+	}
+
+	classifications := request.Classifications
+	if request.Csv != "" {
+		csvClassifications, err := parseColumnClassificationsCSV(request.Csv)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid csv: %v", err)
+		}
+		classifications = append(classifications, csvClassifications...)
+	}
+	config, err := applyColumnClassifications(dbSchema.GetMetadata(), dbSchema.GetConfig(), classificationConfig, classifications, request.Replace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err := s.store.UpdateDBSchema(ctx, database.UID, &store.UpdateDBSchemaMessage{Config: config}, principalID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update database config: %v", err)
+	}
+
+	return &v1pb.ImportColumnClassificationsResponse{
+		Classifications: convertToColumnClassifications(config, classificationConfig),
+	}, nil
+}
+
+// ExportColumnClassifications exports the column classifications of a database.
+func (s *DatabaseService) ExportColumnClassifications(ctx context.Context, request *v1pb.ExportColumnClassificationsRequest) (*v1pb.ExportColumnClassificationsResponse, error) {
+	_, database, dbSchema, err := s.getDatabaseSchemaForClassification(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	classificationConfig, err := s.getDatabaseClassificationConfig(ctx, database)
+	if err != nil {
+		return nil, err
+	}
+
+	classifications := convertToColumnClassifications(dbSchema.GetConfig(), classificationConfig)
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	records := [][]string{{"schema", "table", "column", "classification", "level"}}
+	for _, c := range classifications {
+		records = append(records, []string{c.Schema, c.Table, c.Column, c.Classification, c.Level})
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write csv: %v", err)
+	}
+	return &v1pb.ExportColumnClassificationsResponse{
+		Classifications: classifications,
+		Csv:             buf.String(),
+	}, nil
+}
+
+// ListClassificationSuggestions lists the classification suggestions of a database.
+func (s *DatabaseService) ListClassificationSuggestions(ctx context.Context, request *v1pb.ListClassificationSuggestionsRequest) (*v1pb.ListClassificationSuggestionsResponse, error) {
+	_, database, _, err := s.getDatabaseSchemaForClassification(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	suggestions, err := s.store.ListClassificationSuggestions(ctx, &store.FindClassificationSuggestionMessage{
+		DatabaseUID: &database.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list classification suggestions: %v", err)
+	}
+	response := &v1pb.ListClassificationSuggestionsResponse{}
+	for _, suggestion := range suggestions {
+		response.Suggestions = append(response.Suggestions, convertToClassificationSuggestion(database, suggestion))
+	}
+	return response, nil
+}
+
+// AcceptClassificationSuggestion adds the suggested column to the masking policy of the database and removes the suggestion.
+func (s *DatabaseService) AcceptClassificationSuggestion(ctx context.Context, request *v1pb.AcceptClassificationSuggestionRequest) (*emptypb.Empty, error) {
+	if err := s.licenseService.IsFeatureEnabled(api.FeatureSensitiveData); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instanceID, databaseName, suggestionUID, err := common.GetInstanceDatabaseIDClassificationSuggestionUID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	_, database, _, err := s.getDatabaseSchemaForClassification(ctx, common.FormatDatabase(instanceID, databaseName))
+	if err != nil {
+		return nil, err
+	}
+	suggestion, err := s.store.GetClassificationSuggestion(ctx, &store.FindClassificationSuggestionMessage{
+		UID:         &suggestionUID,
+		DatabaseUID: &database.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get classification suggestion: %v", err)
+	}
+	if suggestion == nil {
+		return nil, status.Errorf(codes.NotFound, "classification suggestion %q not found", request.Name)
+	}
+
+	maskingLevel := convertToStorePBMaskingLevel(request.MaskingLevel)
+	if maskingLevel == storepb.MaskingLevel_MASKING_LEVEL_UNSPECIFIED {
+		maskingLevel = storepb.MaskingLevel_FULL
+	}
+	maskingPolicy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get masking policy: %v", err)
+	}
+	maskData := &storepb.MaskData{
+		Schema:       suggestion.Schema,
+		Table:        suggestion.Table,
+		Column:       suggestion.Column,
+		MaskingLevel: maskingLevel,
+	}
+	replaced := false
+	for i, data := range maskingPolicy.MaskData {
+		if data.Schema == maskData.Schema && data.Table == maskData.Table && data.Column == maskData.Column {
+			maskingPolicy.MaskData[i] = maskData
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		maskingPolicy.MaskData = append(maskingPolicy.MaskData, maskData)
+	}
+	payload, err := protojson.Marshal(maskingPolicy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal masking policy: %v", err)
+	}
+	payloadStr := string(payload)
+	resourceType := api.PolicyResourceTypeDatabase
+	policyType := api.PolicyTypeMasking
+	policy, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &database.UID,
+		Type:         &policyType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get masking policy: %v", err)
+	}
+	if policy == nil {
+		if _, err := s.store.CreatePolicyV2(ctx, &store.PolicyMessage{
+			ResourceUID:       database.UID,
+			ResourceType:      resourceType,
+			Payload:           payloadStr,
+			Type:              policyType,
+			InheritFromParent: true,
+			Enforce:           true,
+		}, principalID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create masking policy: %v", err)
+		}
+	} else {
+		if _, err := s.store.UpdatePolicyV2(ctx, &store.UpdatePolicyMessage{
+			UpdaterID:    principalID,
+			ResourceType: resourceType,
+			ResourceUID:  database.UID,
+			Type:         policyType,
+			Payload:      &payloadStr,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update masking policy: %v", err)
+		}
+	}
+
+	if err := s.store.DeleteClassificationSuggestion(ctx, suggestion.UID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete classification suggestion: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func convertToClassificationSuggestion(database *store.DatabaseMessage, suggestion *store.ClassificationSuggestionMessage) *v1pb.ClassificationSuggestion {
+	return &v1pb.ClassificationSuggestion{
+		Name:           fmt.Sprintf("%s/%s%d", common.FormatDatabase(database.InstanceID, database.DatabaseName), common.ClassificationSuggestionPrefix, suggestion.UID),
+		Schema:         suggestion.Schema,
+		Table:          suggestion.Table,
+		Column:         suggestion.Column,
+		Label:          v1pb.ClassificationSuggestion_Label(suggestion.Payload.GetLabel()),
+		NameMatched:    suggestion.Payload.GetNameMatched(),
+		DataMatchRatio: suggestion.Payload.GetDataMatchRatio(),
+		CreateTime:     timestamppb.New(time.Unix(suggestion.CreatedTs, 0)),
+	}
+}
+
+func (s *DatabaseService) getDatabaseSchemaForClassification(ctx context.Context, name string) (*store.InstanceMessage, *store.DatabaseMessage, *model.DBSchema, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(name)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, "failed to get instance %s: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if dbSchema == nil {
+		return nil, nil, nil, status.Errorf(codes.FailedPrecondition, "database schema metadata not found")
+	}
+	return instance, database, dbSchema, nil
+}
+
+func (s *DatabaseService) getDatabaseClassificationConfig(ctx context.Context, database *store.DatabaseMessage) (*storepb.DataClassificationSetting_DataClassificationConfig, error) {
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q: %v", database.ProjectID, err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", database.ProjectID)
+	}
+	if project.DataClassificationConfigID == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "project %q has no classification config", database.ProjectID)
+	}
+	classificationConfig, err := s.store.GetDataClassificationConfigByID(ctx, project.DataClassificationConfigID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get classification config %q: %v", project.DataClassificationConfigID, err)
+	}
+	return classificationConfig, nil
+}
+
+// parseColumnClassificationsCSV parses the classifications in CSV format with the header "schema,table,column,classification".
+func parseColumnClassificationsCSV(content string) ([]*v1pb.ColumnClassification, error) {
+	r := csv.NewReader(strings.NewReader(content))
+	r.TrimLeadingSpace = true
+	header, err := r.Read()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read header")
+	}
+	indexes := map[string]int{}
+	for i, h := range header {
+		indexes[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	for _, h := range []string{"schema", "table", "column", "classification"} {
+		if _, ok := indexes[h]; !ok {
+			return nil, errors.Errorf("missing %q in header", h)
+		}
+	}
+
+	var classifications []*v1pb.ColumnClassification
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		classifications = append(classifications, &v1pb.ColumnClassification{
+			Schema:         record[indexes["schema"]],
+			Table:          record[indexes["table"]],
+			Column:         record[indexes["column"]],
+			Classification: record[indexes["classification"]],
+		})
+	}
+	return classifications, nil
+}
+
+// applyColumnClassifications returns the database config with the classifications applied.
+// The classification is resolved by the id first, then by the title in the classification config.
+func applyColumnClassifications(metadata *storepb.DatabaseSchemaMetadata, config *storepb.DatabaseConfig, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig, classifications []*v1pb.ColumnClassification, replace bool) (*storepb.DatabaseConfig, error) {
+	dbMetadata := model.NewDatabaseMetadata(metadata)
+	newConfig := &storepb.DatabaseConfig{Name: metadata.GetName()}
+	if config != nil {
+		newConfig = proto.Clone(config).(*storepb.DatabaseConfig)
+	}
+	if replace {
+		for _, schemaConfig := range newConfig.SchemaConfigs {
+			for _, tableConfig := range schemaConfig.TableConfigs {
+				for _, columnConfig := range tableConfig.ColumnConfigs {
+					columnConfig.ClassificationId = ""
+				}
+			}
+		}
+	}
+
+	for _, c := range classifications {
+		schema := dbMetadata.GetSchema(c.Schema)
+		if schema == nil {
+			return nil, errors.Errorf("schema %q not found", c.Schema)
+		}
+		table := schema.GetTable(c.Table)
+		if table == nil {
+			return nil, errors.Errorf("table %q not found in schema %q", c.Table, c.Schema)
+		}
+		if table.GetColumn(c.Column) == nil {
+			return nil, errors.Errorf("column %q not found in table %q", c.Column, c.Table)
+		}
+		classificationID, err := resolveClassificationID(classificationConfig, c.Classification)
+		if err != nil {
+			return nil, err
+		}
+		getOrCreateColumnConfig(newConfig, c.Schema, c.Table, c.Column).ClassificationId = classificationID
+	}
+	return newConfig, nil
+}
+
+func resolveClassificationID(classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig, classification string) (string, error) {
+	if classification == "" {
+		return "", nil
+	}
+	if _, ok := classificationConfig.GetClassification()[classification]; ok {
+		return classification, nil
+	}
+	for id, c := range classificationConfig.GetClassification() {
+		if strings.EqualFold(c.Title, classification) {
+			return id, nil
+		}
+	}
+	return "", errors.Errorf("classification %q not found in classification config %q", classification, classificationConfig.GetTitle())
+}
+
+func getOrCreateColumnConfig(config *storepb.DatabaseConfig, schemaName, tableName, columnName string) *storepb.ColumnConfig {
+	var schemaConfig *storepb.SchemaConfig
+	for _, sc := range config.SchemaConfigs {
+		if sc.Name == schemaName {
+			schemaConfig = sc
+			break
+		}
+	}
+	if schemaConfig == nil {
+		schemaConfig = &storepb.SchemaConfig{Name: schemaName}
+		config.SchemaConfigs = append(config.SchemaConfigs, schemaConfig)
+	}
+
+	var tableConfig *storepb.TableConfig
+	for _, tc := range schemaConfig.TableConfigs {
+		if tc.Name == tableName {
+			tableConfig = tc
+			break
+		}
+	}
+	if tableConfig == nil {
+		tableConfig = &storepb.TableConfig{Name: tableName}
+		schemaConfig.TableConfigs = append(schemaConfig.TableConfigs, tableConfig)
+	}
+
+	for _, cc := range tableConfig.ColumnConfigs {
+		if cc.Name == columnName {
+			return cc
+		}
+	}
+	columnConfig := &storepb.ColumnConfig{Name: columnName}
+	tableConfig.ColumnConfigs = append(tableConfig.ColumnConfigs, columnConfig)
+	return columnConfig
+}
+
+func convertToColumnClassifications(config *storepb.DatabaseConfig, classificationConfig *storepb.DataClassificationSetting_DataClassificationConfig) []*v1pb.ColumnClassification {
+	var classifications []*v1pb.ColumnClassification
+	for _, schemaConfig := range config.GetSchemaConfigs() {
+		for _, tableConfig := range schemaConfig.GetTableConfigs() {
+			for _, columnConfig := range tableConfig.GetColumnConfigs() {
+				if columnConfig.ClassificationId == "" {
+					continue
+				}
+				classifications = append(classifications, &v1pb.ColumnClassification{
+					Schema:         schemaConfig.Name,
+					Table:          tableConfig.Name,
+					Column:         columnConfig.Name,
+					Classification: columnConfig.ClassificationId,
+					Level:          classificationConfig.GetClassification()[columnConfig.ClassificationId].GetLevelId(),
+				})
+			}
+		}
+	}
+	return classifications
+}
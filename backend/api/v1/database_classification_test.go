@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestApplyColumnClassifications(t *testing.T) {
+	a := require.New(t)
+
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "db",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"},
+							{Name: "email"},
+							{Name: "phone"},
+						},
+					},
+				},
+			},
+		},
+	}
+	classificationConfig := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Title: "default",
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1": {Id: "1-1", Title: "PII.Email", LevelId: proto.String("2")},
+			"1-2": {Id: "1-2", Title: "PII.Phone", LevelId: proto.String("3")},
+		},
+	}
+	config := &storepb.DatabaseConfig{
+		Name: "db",
+		SchemaConfigs: []*storepb.SchemaConfig{
+			{
+				Name: "public",
+				TableConfigs: []*storepb.TableConfig{
+					{
+						Name: "users",
+						ColumnConfigs: []*storepb.ColumnConfig{
+							{Name: "id", ClassificationId: "1-2", SemanticTypeId: "default"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	classifications, err := parseColumnClassificationsCSV("schema,table,column,classification\npublic,users,email,pii.email\n")
+	a.NoError(err)
+	classifications = append(classifications, &v1pb.ColumnClassification{Schema: "public", Table: "users", Column: "phone", Classification: "1-2"})
+
+	got, err := applyColumnClassifications(metadata, config, classificationConfig, classifications, false /* replace */)
+	a.NoError(err)
+	a.Equal([]*v1pb.ColumnClassification{
+		{Schema: "public", Table: "users", Column: "id", Classification: "1-2", Level: "3"},
+		{Schema: "public", Table: "users", Column: "email", Classification: "1-1", Level: "2"},
+		{Schema: "public", Table: "users", Column: "phone", Classification: "1-2", Level: "3"},
+	}, convertToColumnClassifications(got, classificationConfig))
+	// The original config is untouched.
+	a.Len(config.SchemaConfigs[0].TableConfigs[0].ColumnConfigs, 1)
+
+	got, err = applyColumnClassifications(metadata, config, classificationConfig, classifications, true /* replace */)
+	a.NoError(err)
+	a.Equal([]*v1pb.ColumnClassification{
+		{Schema: "public", Table: "users", Column: "email", Classification: "1-1", Level: "2"},
+		{Schema: "public", Table: "users", Column: "phone", Classification: "1-2", Level: "3"},
+	}, convertToColumnClassifications(got, classificationConfig))
+	// The other column configs are kept.
+	a.Equal("default", got.SchemaConfigs[0].TableConfigs[0].ColumnConfigs[0].SemanticTypeId)
+
+	_, err = applyColumnClassifications(metadata, config, classificationConfig, []*v1pb.ColumnClassification{
+		{Schema: "public", Table: "users", Column: "email", Classification: "unknown"},
+	}, false /* replace */)
+	a.Error(err)
+	_, err = applyColumnClassifications(metadata, config, classificationConfig, []*v1pb.ColumnClassification{
+		{Schema: "public", Table: "orders", Column: "email", Classification: "1-1"},
+	}, false /* replace */)
+	a.Error(err)
+}
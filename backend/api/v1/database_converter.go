@@ -135,6 +135,29 @@ func convertStoreDatabaseMetadata(ctx context.Context, metadata *storepb.Databas
 			Description: extension.Description,
 		})
 	}
+	for _, template := range metadata.IndexTemplates {
+		if template == nil {
+			continue
+		}
+		m.IndexTemplates = append(m.IndexTemplates, &v1pb.IndexTemplateMetadata{
+			Name:          template.Name,
+			IndexPatterns: template.IndexPatterns,
+			Priority:      template.Priority,
+			ComposedOf:    template.ComposedOf,
+			Mappings:      template.Mappings,
+			Settings:      template.Settings,
+			Definition:    template.Definition,
+		})
+	}
+	for _, policy := range metadata.LifecyclePolicies {
+		if policy == nil {
+			continue
+		}
+		m.LifecyclePolicies = append(m.LifecyclePolicies, &v1pb.LifecyclePolicyMetadata{
+			Name:       policy.Name,
+			Definition: policy.Definition,
+		})
+	}
 
 	databaseConfig := convertStoreDatabaseConfig(ctx, config, filter, optionalStores)
 	if databaseConfig != nil {
@@ -156,6 +179,8 @@ func convertStoreTableMetadata(table *storepb.TableMetadata) *v1pb.TableMetadata
 		Comment:       table.Comment,
 		UserComment:   table.UserComment,
 		Charset:       table.Charset,
+		Validator:     table.Validator,
+		ShardKey:      table.ShardKey,
 	}
 	for _, partition := range table.Partitions {
 		if partition == nil {
@@ -163,6 +188,16 @@ func convertStoreTableMetadata(table *storepb.TableMetadata) *v1pb.TableMetadata
 		}
 		t.Partitions = append(t.Partitions, convertStoreTablePartitionMetadata(partition))
 	}
+	if table.DynamicPartition != nil {
+		t.DynamicPartition = &v1pb.DynamicPartitionMetadata{
+			Enabled:  table.DynamicPartition.Enabled,
+			TimeUnit: table.DynamicPartition.TimeUnit,
+			Start:    table.DynamicPartition.Start,
+			End:      table.DynamicPartition.End,
+			Prefix:   table.DynamicPartition.Prefix,
+			Buckets:  table.DynamicPartition.Buckets,
+		}
+	}
 
 	for _, column := range table.Columns {
 		if column == nil {
@@ -185,6 +220,7 @@ func convertStoreTableMetadata(table *storepb.TableMetadata) *v1pb.TableMetadata
 			Visible:     index.Visible,
 			Comment:     index.Comment,
 			Definition:  index.Definition,
+			Parameters:  index.Parameters,
 		})
 	}
 	for _, foreignKey := range table.ForeignKeys {
@@ -543,6 +579,29 @@ func convertV1DatabaseMetadata(ctx context.Context, metadata *v1pb.DatabaseMetad
 			Description: extension.Description,
 		})
 	}
+	for _, template := range metadata.IndexTemplates {
+		if template == nil {
+			continue
+		}
+		m.IndexTemplates = append(m.IndexTemplates, &storepb.IndexTemplateMetadata{
+			Name:          template.Name,
+			IndexPatterns: template.IndexPatterns,
+			Priority:      template.Priority,
+			ComposedOf:    template.ComposedOf,
+			Mappings:      template.Mappings,
+			Settings:      template.Settings,
+			Definition:    template.Definition,
+		})
+	}
+	for _, policy := range metadata.LifecyclePolicies {
+		if policy == nil {
+			continue
+		}
+		m.LifecyclePolicies = append(m.LifecyclePolicies, &storepb.LifecyclePolicyMetadata{
+			Name:       policy.Name,
+			Definition: policy.Definition,
+		})
+	}
 
 	databaseConfig := convertV1DatabaseConfig(
 		ctx,
@@ -568,6 +627,18 @@ func convertV1TableMetadata(table *v1pb.TableMetadata) *storepb.TableMetadata {
 		Comment:       table.Comment,
 		UserComment:   table.UserComment,
 		Charset:       table.Charset,
+		Validator:     table.Validator,
+		ShardKey:      table.ShardKey,
+	}
+	if table.DynamicPartition != nil {
+		t.DynamicPartition = &storepb.DynamicPartitionMetadata{
+			Enabled:  table.DynamicPartition.Enabled,
+			TimeUnit: table.DynamicPartition.TimeUnit,
+			Start:    table.DynamicPartition.Start,
+			End:      table.DynamicPartition.End,
+			Prefix:   table.DynamicPartition.Prefix,
+			Buckets:  table.DynamicPartition.Buckets,
+		}
 	}
 	for _, column := range table.Columns {
 		if column == nil {
@@ -590,6 +661,7 @@ func convertV1TableMetadata(table *v1pb.TableMetadata) *storepb.TableMetadata {
 			Visible:     index.Visible,
 			Comment:     index.Comment,
 			Definition:  index.Definition,
+			Parameters:  index.Parameters,
 		})
 	}
 	for _, foreignKey := range table.ForeignKeys {
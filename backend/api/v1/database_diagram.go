@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ExportSchemaDiagram exports the ER diagram of a database.
+func (s *DatabaseService) ExportSchemaDiagram(ctx context.Context, request *v1pb.ExportSchemaDiagramRequest) (*v1pb.SchemaDiagram, error) {
+	_, _, dbSchema, err := s.getDatabaseSchemaForClassification(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	metadata := dbSchema.GetMetadata()
+	if request.Schema != "" {
+		found := false
+		for _, schema := range metadata.GetSchemas() {
+			if schema.Name == request.Schema {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "schema %q not found in database %q", request.Schema, request.Name)
+		}
+	}
+
+	diagram := buildSchemaDiagram(metadata, request.Schema)
+	switch request.Format {
+	case v1pb.ExportSchemaDiagramRequest_PLANTUML:
+		diagram.Source = renderPlantUML(diagram)
+	case v1pb.ExportSchemaDiagramRequest_MERMAID:
+		diagram.Source = renderMermaid(diagram)
+	}
+	return diagram, nil
+}
+
+// buildSchemaDiagram builds the diagram graph of the database metadata.
+// Only the schema is included if it's not empty.
+// Tables are sorted by schema and name so that the output is stable across syncs.
+func buildSchemaDiagram(metadata *storepb.DatabaseSchemaMetadata, schemaFilter string) *v1pb.SchemaDiagram {
+	diagram := &v1pb.SchemaDiagram{}
+	for _, schema := range metadata.GetSchemas() {
+		if schemaFilter != "" && schema.Name != schemaFilter {
+			continue
+		}
+		for _, table := range schema.Tables {
+			primaryKeys := make(map[string]bool)
+			for _, index := range table.Indexes {
+				if !index.Primary {
+					continue
+				}
+				for _, expression := range index.Expressions {
+					primaryKeys[expression] = true
+				}
+			}
+			diagramTable := &v1pb.SchemaDiagram_Table{
+				Schema:  schema.Name,
+				Name:    table.Name,
+				Comment: table.UserComment,
+			}
+			for _, column := range table.Columns {
+				diagramTable.Columns = append(diagramTable.Columns, &v1pb.SchemaDiagram_Column{
+					Name:       column.Name,
+					Type:       column.Type,
+					Nullable:   column.Nullable,
+					PrimaryKey: primaryKeys[column.Name],
+					Comment:    column.UserComment,
+				})
+			}
+			diagram.Tables = append(diagram.Tables, diagramTable)
+
+			for _, foreignKey := range table.ForeignKeys {
+				diagram.Relations = append(diagram.Relations, &v1pb.SchemaDiagram_Relation{
+					Name:              foreignKey.Name,
+					Schema:            schema.Name,
+					Table:             table.Name,
+					Columns:           foreignKey.Columns,
+					ReferencedSchema:  foreignKey.ReferencedSchema,
+					ReferencedTable:   foreignKey.ReferencedTable,
+					ReferencedColumns: foreignKey.ReferencedColumns,
+				})
+			}
+		}
+	}
+	sort.SliceStable(diagram.Tables, func(i, j int) bool {
+		if diagram.Tables[i].Schema != diagram.Tables[j].Schema {
+			return diagram.Tables[i].Schema < diagram.Tables[j].Schema
+		}
+		return diagram.Tables[i].Name < diagram.Tables[j].Name
+	})
+	sort.SliceStable(diagram.Relations, func(i, j int) bool {
+		a, b := diagram.Relations[i], diagram.Relations[j]
+		if a.Schema != b.Schema {
+			return a.Schema < b.Schema
+		}
+		if a.Table != b.Table {
+			return a.Table < b.Table
+		}
+		return a.Name < b.Name
+	})
+	return diagram
+}
+
+func renderPlantUML(diagram *v1pb.SchemaDiagram) string {
+	var buf strings.Builder
+	_, _ = buf.WriteString("@startuml\n")
+	for _, table := range diagram.Tables {
+		_, _ = fmt.Fprintf(&buf, "entity \"%s\" as %s {\n", getDiagramTableName(table.Schema, table.Name), getDiagramTableID(table.Schema, table.Name))
+		for _, column := range table.Columns {
+			if column.PrimaryKey {
+				_, _ = fmt.Fprintf(&buf, "  * %s : %s\n", column.Name, column.Type)
+			}
+		}
+		for _, column := range table.Columns {
+			if !column.PrimaryKey {
+				_, _ = fmt.Fprintf(&buf, "  %s : %s\n", column.Name, column.Type)
+			}
+		}
+		_, _ = buf.WriteString("}\n")
+	}
+	for _, relation := range diagram.Relations {
+		_, _ = fmt.Fprintf(&buf, "%s }o--|| %s : %s\n",
+			getDiagramTableID(relation.Schema, relation.Table),
+			getDiagramTableID(relation.ReferencedSchema, relation.ReferencedTable),
+			relation.Name,
+		)
+	}
+	_, _ = buf.WriteString("@enduml\n")
+	return buf.String()
+}
+
+func renderMermaid(diagram *v1pb.SchemaDiagram) string {
+	var buf strings.Builder
+	_, _ = buf.WriteString("erDiagram\n")
+	for _, table := range diagram.Tables {
+		_, _ = fmt.Fprintf(&buf, "  %s[\"%s\"] {\n", getDiagramTableID(table.Schema, table.Name), getDiagramTableName(table.Schema, table.Name))
+		for _, column := range table.Columns {
+			// Mermaid doesn't allow spaces or parentheses in attribute types.
+			columnType := strings.NewReplacer(" ", "_", "(", "_", ")", "", ",", "_").Replace(column.Type)
+			if column.PrimaryKey {
+				_, _ = fmt.Fprintf(&buf, "    %s %s PK\n", columnType, column.Name)
+			} else {
+				_, _ = fmt.Fprintf(&buf, "    %s %s\n", columnType, column.Name)
+			}
+		}
+		_, _ = buf.WriteString("  }\n")
+	}
+	for _, relation := range diagram.Relations {
+		_, _ = fmt.Fprintf(&buf, "  %s }o--|| %s : \"%s\"\n",
+			getDiagramTableID(relation.Schema, relation.Table),
+			getDiagramTableID(relation.ReferencedSchema, relation.ReferencedTable),
+			relation.Name,
+		)
+	}
+	return buf.String()
+}
+
+func getDiagramTableName(schema, table string) string {
+	if schema == "" {
+		return table
+	}
+	return fmt.Sprintf("%s.%s", schema, table)
+}
+
+// getDiagramTableID returns an identifier that is safe to use as the entity alias in both PlantUML and Mermaid.
+func getDiagramTableID(schema, table string) string {
+	var buf strings.Builder
+	for _, r := range getDiagramTableName(schema, table) {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			_, _ = buf.WriteRune(r)
+		} else {
+			_, _ = buf.WriteRune('_')
+		}
+	}
+	return buf.String()
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestBuildSchemaDiagram(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "user_id", Type: "integer", Nullable: true},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "orders_pkey", Expressions: []string{"id"}, Primary: true},
+						},
+						ForeignKeys: []*storepb.ForeignKeyMetadata{
+							{
+								Name:              "orders_user_id_fkey",
+								Columns:           []string{"user_id"},
+								ReferencedSchema:  "public",
+								ReferencedTable:   "users",
+								ReferencedColumns: []string{"id"},
+							},
+						},
+					},
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "name", Type: "character varying(64)"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "users_pkey", Expressions: []string{"id"}, Primary: true},
+						},
+					},
+				},
+			},
+			{
+				Name: "audit",
+				Tables: []*storepb.TableMetadata{
+					{Name: "log", Columns: []*storepb.ColumnMetadata{{Name: "id", Type: "integer"}}},
+				},
+			},
+		},
+	}
+
+	diagram := buildSchemaDiagram(metadata, "")
+	a.Len(diagram.Tables, 3)
+	a.Equal("audit", diagram.Tables[0].Schema)
+	a.Equal("orders", diagram.Tables[1].Name)
+	a.True(diagram.Tables[1].Columns[0].PrimaryKey)
+	a.False(diagram.Tables[1].Columns[1].PrimaryKey)
+	a.Len(diagram.Relations, 1)
+	a.Equal("users", diagram.Relations[0].ReferencedTable)
+
+	diagram = buildSchemaDiagram(metadata, "public")
+	a.Len(diagram.Tables, 2)
+
+	mermaid := renderMermaid(diagram)
+	a.Contains(mermaid, "public_orders }o--|| public_users : \"orders_user_id_fkey\"")
+	a.Contains(mermaid, "character_varying_64 name")
+	plantUML := renderPlantUML(diagram)
+	a.Contains(plantUML, "entity \"public.users\" as public_users {")
+	a.Contains(plantUML, "  * id : integer")
+}
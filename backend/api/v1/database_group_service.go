@@ -7,6 +7,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
@@ -69,8 +70,8 @@ func (s *DatabaseGroupService) CreateDatabaseGroup(ctx context.Context, request
 	if request.DatabaseGroup.DatabaseExpr == nil || request.DatabaseGroup.DatabaseExpr.Expression == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "database group database expression is required")
 	}
-	if _, err := common.ValidateGroupCELExpr(request.DatabaseGroup.DatabaseExpr.Expression); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid database group expression: %v", err)
+	if err := s.validateDatabaseGroupExpr(ctx, projectResourceID, request.DatabaseGroup.DatabaseExpr.Expression); err != nil {
+		return nil, err
 	}
 
 	storeDatabaseGroup := &store.DatabaseGroupMessage{
@@ -143,8 +144,8 @@ func (s *DatabaseGroupService) UpdateDatabaseGroup(ctx context.Context, request
 			if request.DatabaseGroup.DatabaseExpr == nil || request.DatabaseGroup.DatabaseExpr.Expression == "" {
 				return nil, status.Errorf(codes.InvalidArgument, "database group expr is required")
 			}
-			if _, err := common.ValidateGroupCELExpr(request.DatabaseGroup.DatabaseExpr.Expression); err != nil {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid database group expression: %v", err)
+			if err := s.validateDatabaseGroupExpr(ctx, projectResourceID, request.DatabaseGroup.DatabaseExpr.Expression); err != nil {
+				return nil, err
 			}
 			updateDatabaseGroup.Expression = request.DatabaseGroup.DatabaseExpr
 		case "multitenancy":
@@ -266,6 +267,143 @@ func (s *DatabaseGroupService) GetDatabaseGroup(ctx context.Context, request *v1
 	return s.convertStoreToAPIDatabaseGroupFull(ctx, databaseGroup, projectResourceID)
 }
 
+// PreviewMatchedDatabases previews the databases matched by the database group expression.
+func (s *DatabaseGroupService) PreviewMatchedDatabases(ctx context.Context, request *v1pb.PreviewMatchedDatabasesRequest) (*v1pb.PreviewMatchedDatabasesResponse, error) {
+	projectResourceID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectResourceID)
+	}
+	if request.DatabaseExpr == nil || request.DatabaseExpr.Expression == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "database group expression is required")
+	}
+	if _, err := common.ValidateGroupCELExpr(request.DatabaseExpr.Expression); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid database group expression: %v", err)
+	}
+	labelKeys, err := common.GetDatabaseGroupCELLabelKeys(request.DatabaseExpr.Expression)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid database group expression: %v", err)
+	}
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+		ProjectID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	matches, unmatches, err := utils.GetMatchedAndUnmatchedDatabasesInDatabaseGroup(ctx, &store.DatabaseGroupMessage{Expression: request.DatabaseExpr}, databases)
+	if err != nil {
+		return nil, err
+	}
+	return &v1pb.PreviewMatchedDatabasesResponse{
+		MatchedDatabases:   convertToDatabaseGroupDatabases(matches),
+		UnmatchedDatabases: convertToDatabaseGroupDatabases(unmatches),
+		LabelKeys:          labelKeys,
+		UnknownLabelKeys:   getUnknownDatabaseLabelKeys(labelKeys, databases),
+	}, nil
+}
+
+// ListDatabaseGroupRevisions lists the revisions of a database group.
+func (s *DatabaseGroupService) ListDatabaseGroupRevisions(ctx context.Context, request *v1pb.ListDatabaseGroupRevisionsRequest) (*v1pb.ListDatabaseGroupRevisionsResponse, error) {
+	projectResourceID, databaseGroupResourceID, err := common.GetProjectIDDatabaseGroupID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectResourceID)
+	}
+	databaseGroup, err := s.store.GetDatabaseGroup(ctx, &store.FindDatabaseGroupMessage{
+		ProjectUID: &project.UID,
+		ResourceID: &databaseGroupResourceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if databaseGroup == nil {
+		return nil, status.Errorf(codes.NotFound, "database group %q not found", databaseGroupResourceID)
+	}
+
+	revisions, err := s.store.ListDatabaseGroupRevisions(ctx, databaseGroup.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list database group revisions: %v", err)
+	}
+	response := &v1pb.ListDatabaseGroupRevisionsResponse{}
+	for _, revision := range revisions {
+		creator, err := s.store.GetUserByID(ctx, revision.CreatorID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get creator: %v", err)
+		}
+		creatorName := ""
+		if creator != nil {
+			creatorName = fmt.Sprintf("users/%s", creator.Email)
+		}
+		response.Revisions = append(response.Revisions, &v1pb.DatabaseGroupRevision{
+			Name:                fmt.Sprintf("%s%s/%s%s/revisions/%d", common.ProjectNamePrefix, projectResourceID, common.DatabaseGroupNamePrefix, databaseGroupResourceID, revision.UID),
+			DatabasePlaceholder: revision.Placeholder,
+			DatabaseExpr:        revision.Expression,
+			Multitenancy:        revision.Payload.GetMultitenancy(),
+			Creator:             creatorName,
+			CreateTime:          timestamppb.New(revision.CreatedTime),
+		})
+	}
+	return response, nil
+}
+
+// validateDatabaseGroupExpr validates the database group expression,
+// and the label keys referenced by the expression must be set on some databases in the project.
+func (s *DatabaseGroupService) validateDatabaseGroupExpr(ctx context.Context, projectResourceID string, expression string) error {
+	if _, err := common.ValidateGroupCELExpr(expression); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid database group expression: %v", err)
+	}
+	labelKeys, err := common.GetDatabaseGroupCELLabelKeys(expression)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid database group expression: %v", err)
+	}
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+		ProjectID: &projectResourceID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if unknownLabelKeys := getUnknownDatabaseLabelKeys(labelKeys, databases); len(unknownLabelKeys) > 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid database group expression: labels %q are not set on any database in project %q", unknownLabelKeys, projectResourceID)
+	}
+	return nil
+}
+
+// getUnknownDatabaseLabelKeys returns the label keys that are not set on any of the databases.
+func getUnknownDatabaseLabelKeys(labelKeys []string, databases []*store.DatabaseMessage) []string {
+	availableLabelKeys := make(map[string]bool)
+	for _, database := range databases {
+		if database.Metadata == nil {
+			continue
+		}
+		for key := range database.Metadata.Labels {
+			availableLabelKeys[key] = true
+		}
+	}
+	var unknownLabelKeys []string
+	for _, key := range labelKeys {
+		if !availableLabelKeys[key] {
+			unknownLabelKeys = append(unknownLabelKeys, key)
+		}
+	}
+	return unknownLabelKeys
+}
+
 func (s *DatabaseGroupService) convertStoreToAPIDatabaseGroupFull(ctx context.Context, databaseGroup *store.DatabaseGroupMessage, projectResourceID string) (*v1pb.DatabaseGroup, error) {
 	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{
 		ProjectID: &projectResourceID,
@@ -279,17 +417,19 @@ func (s *DatabaseGroupService) convertStoreToAPIDatabaseGroupFull(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	for _, database := range matches {
-		ret.MatchedDatabases = append(ret.MatchedDatabases, &v1pb.DatabaseGroup_Database{
-			Name: fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
-		})
-	}
-	for _, database := range unmatches {
-		ret.UnmatchedDatabases = append(ret.UnmatchedDatabases, &v1pb.DatabaseGroup_Database{
+	ret.MatchedDatabases = convertToDatabaseGroupDatabases(matches)
+	ret.UnmatchedDatabases = convertToDatabaseGroupDatabases(unmatches)
+	return ret, nil
+}
+
+func convertToDatabaseGroupDatabases(databases []*store.DatabaseMessage) []*v1pb.DatabaseGroup_Database {
+	var ret []*v1pb.DatabaseGroup_Database
+	for _, database := range databases {
+		ret = append(ret, &v1pb.DatabaseGroup_Database{
 			Name: fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
 		})
 	}
-	return ret, nil
+	return ret
 }
 
 func convertStoreToAPIDatabaseGroupBasic(databaseGroup *store.DatabaseGroupMessage, projectResourceID string) *v1pb.DatabaseGroup {
@@ -64,16 +64,18 @@ type DatabaseService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	secret         string
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager) *DatabaseService {
+func NewDatabaseService(store *store.Store, schemaSyncer *schemasync.Syncer, licenseService enterprise.LicenseService, profile *config.Profile, iamManager *iam.Manager, secret string) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		schemaSyncer:   schemaSyncer,
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		secret:         secret,
 	}
 }
 
@@ -1045,6 +1047,122 @@ func (s *DatabaseService) DiffSchema(ctx context.Context, request *v1pb.DiffSche
 	}, nil
 }
 
+// GetDatabaseSchemaAsOf gets the database schema from the latest schema snapshot taken at or before the given time.
+func (s *DatabaseService) GetDatabaseSchemaAsOf(ctx context.Context, request *v1pb.GetDatabaseSchemaAsOfRequest) (*v1pb.DatabaseSchema, error) {
+	instanceID, databaseName, err := common.TrimSuffixAndGetInstanceDatabaseID(request.Name, common.SchemaSuffix)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if request.Time == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "time is required")
+	}
+	instance, database, err := s.getInstanceAndDatabase(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := s.store.GetDBSchemaSnapshotAsOf(ctx, database.UID, request.Time.AsTime().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get schema snapshot, error: %v", err)
+	}
+	if snapshot == nil {
+		return nil, status.Errorf(codes.NotFound, "no schema snapshot of database %q found as of %s", databaseName, request.Time.AsTime().Format(time.RFC3339))
+	}
+	schema := snapshot.Schema
+	if request.SdlFormat {
+		switch instance.Engine {
+		case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+			sdlSchema, err := transform.SchemaTransform(storepb.Engine_MYSQL, schema)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert schema to sdl format, error %v", err.Error())
+			}
+			schema = sdlSchema
+		}
+	}
+	return &v1pb.DatabaseSchema{Schema: schema}, nil
+}
+
+// DiffSchemaSnapshots diffs the database schemas at two points in time.
+func (s *DatabaseService) DiffSchemaSnapshots(ctx context.Context, request *v1pb.DiffSchemaSnapshotsRequest) (*v1pb.DiffSchemaResponse, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if request.SourceTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "source_time is required")
+	}
+	instance, database, err := s.getInstanceAndDatabase(ctx, instanceID, databaseName)
+	if err != nil {
+		return nil, err
+	}
+
+	getSchemaAsOf := func(ts time.Time) (string, error) {
+		snapshot, err := s.store.GetDBSchemaSnapshotAsOf(ctx, database.UID, ts.Unix())
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get schema snapshot, error: %v", err)
+		}
+		if snapshot == nil {
+			return "", status.Errorf(codes.NotFound, "no schema snapshot of database %q found as of %s", databaseName, ts.Format(time.RFC3339))
+		}
+		return snapshot.Schema, nil
+	}
+	source, err := getSchemaAsOf(request.SourceTime.AsTime())
+	if err != nil {
+		return nil, err
+	}
+	var target string
+	if request.TargetTime != nil {
+		if target, err = getSchemaAsOf(request.TargetTime.AsTime()); err != nil {
+			return nil, err
+		}
+	} else {
+		dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+		if dbSchema == nil {
+			return nil, status.Errorf(codes.NotFound, "database schema %q not found", databaseName)
+		}
+		target = string(dbSchema.GetSchema())
+	}
+
+	strictMode := true
+	if instance.Engine == storepb.Engine_ORACLE {
+		strictMode = false
+	}
+	diff, err := base.SchemaDiff(instance.Engine, base.DiffContext{
+		IgnoreCaseSensitive: false,
+		StrictMode:          strictMode,
+	}, source, target)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to compute diff between schema snapshots, error: %v", err)
+	}
+	return &v1pb.DiffSchemaResponse{
+		Diff: diff,
+	}, nil
+}
+
+func (s *DatabaseService) getInstanceAndDatabase(ctx context.Context, instanceID, databaseName string) (*store.InstanceMessage, *store.DatabaseMessage, error) {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get instance %s, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	return instance, database, nil
+}
+
 func (s *DatabaseService) getSourceSchema(ctx context.Context, request *v1pb.DiffSchemaRequest) (string, error) {
 	if strings.Contains(request.Name, common.ChangeHistoryPrefix) {
 		changeHistory, err := s.GetChangeHistory(ctx, &v1pb.GetChangeHistoryRequest{
@@ -1363,14 +1481,20 @@ func (s *DatabaseService) UpdateSecret(ctx context.Context, request *v1pb.Update
 		if !request.AllowMissing {
 			return nil, status.Errorf(codes.NotFound, "secret %q not found", updateSecretName)
 		}
+		externalSecret, err := convertToStoreDataSourceExternalSecret(request.Secret.ExternalSecret)
+		if err != nil {
+			return nil, err
+		}
 		newSecret.Name = updateSecretName
 		newSecret.Value = request.Secret.Value
 		newSecret.Description = request.Secret.Description
+		newSecret.ExternalSecret = externalSecret
 	} else {
 		oldSecret := secretsMap[updateSecretName]
 		newSecret.Name = oldSecret.Name
 		newSecret.Value = oldSecret.Value
 		newSecret.Description = oldSecret.Description
+		newSecret.ExternalSecret = oldSecret.ExternalSecret
 		for _, path := range request.UpdateMask.Paths {
 			switch path {
 			case "value":
@@ -1380,6 +1504,12 @@ func (s *DatabaseService) UpdateSecret(ctx context.Context, request *v1pb.Update
 				return nil, status.Errorf(codes.InvalidArgument, "name of a secret is not allowed to be updated")
 			case "description":
 				newSecret.Description = request.Secret.Description
+			case "external_secret":
+				externalSecret, err := convertToStoreDataSourceExternalSecret(request.Secret.ExternalSecret)
+				if err != nil {
+					return nil, err
+				}
+				newSecret.ExternalSecret = externalSecret
 			}
 		}
 	}
@@ -1824,11 +1954,16 @@ func stripeAndConvertToServiceSecrets(secrets *storepb.Secrets, instanceID, data
 }
 
 func stripeAndConvertToServiceSecret(secretEntry *storepb.SecretItem, instanceID, databaseName string) *v1pb.Secret {
-	return &v1pb.Secret{
+	secret := &v1pb.Secret{
 		Name:        fmt.Sprintf("%s%s/%s%s/%s%s", common.InstanceNamePrefix, instanceID, common.DatabaseIDPrefix, databaseName, common.SecretNamePrefix, secretEntry.Name),
 		Value:       "", /* stripped */
 		Description: secretEntry.Description,
 	}
+	// The credentials of the external secret are cleared in the conversion.
+	if externalSecret, err := convertToV1DataSourceExternalSecret(secretEntry.ExternalSecret); err == nil {
+		secret.ExternalSecret = externalSecret
+	}
+	return secret
 }
 
 func isSecretValid(secret *storepb.SecretItem) error {
@@ -1836,8 +1971,8 @@ func isSecretValid(secret *storepb.SecretItem) error {
 	if secret.Name == "" {
 		return errors.Errorf("invalid secret name: %s, name can not be empty", secret.Name)
 	}
-	// Values can not be empty.
-	if secret.Value == "" {
+	// Values can not be empty unless they are fetched from the external secret manager.
+	if secret.Value == "" && secret.ExternalSecret == nil {
 		return errors.Errorf("the value of secret: %s can not be empty", secret.Name)
 	}
 
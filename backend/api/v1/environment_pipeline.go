@@ -0,0 +1,249 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const environmentPipelineName = "environmentPipeline"
+
+// GetEnvironmentPipeline gets the environment pipeline.
+func (s *EnvironmentService) GetEnvironmentPipeline(ctx context.Context, request *v1pb.GetEnvironmentPipelineRequest) (*v1pb.EnvironmentPipeline, error) {
+	if request.Name != environmentPipelineName {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid environment pipeline name %q", request.Name)
+	}
+	pipeline, err := s.store.GetEnvironmentPipelineSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get environment pipeline: %v", err)
+	}
+	return convertToEnvironmentPipeline(pipeline), nil
+}
+
+// UpdateEnvironmentPipeline updates the environment pipeline.
+func (s *EnvironmentService) UpdateEnvironmentPipeline(ctx context.Context, request *v1pb.UpdateEnvironmentPipelineRequest) (*v1pb.EnvironmentPipeline, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	if request.Pipeline == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "pipeline must be set")
+	}
+	if request.Pipeline.Name != environmentPipelineName {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid environment pipeline name %q", request.Pipeline.Name)
+	}
+	environments, err := s.store.ListEnvironmentV2(ctx, &store.FindEnvironmentMessage{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	pipeline, err := convertToStoreEnvironmentPipeline(request.Pipeline, environments)
+	if err != nil {
+		return nil, err
+	}
+	for _, stage := range pipeline.Stages {
+		if stage.RolloutPolicy != nil && (!stage.RolloutPolicy.Automatic || stage.RolloutPolicy.StageApprovalRequired) {
+			if err := s.licenseService.IsFeatureEnabled(api.FeatureApprovalPolicy); err != nil {
+				return nil, status.Errorf(codes.PermissionDenied, err.Error())
+			}
+		}
+	}
+
+	payload, err := protojson.Marshal(pipeline)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal environment pipeline: %v", err)
+	}
+	if _, err := s.store.UpsertSettingV2(ctx, &store.SetSettingMessage{
+		Name:  api.SettingEnvironmentPipeline,
+		Value: string(payload),
+	}, principalID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update environment pipeline: %v", err)
+	}
+	return convertToEnvironmentPipeline(pipeline), nil
+}
+
+func convertToEnvironmentPipeline(pipeline *storepb.EnvironmentPipelineSetting) *v1pb.EnvironmentPipeline {
+	result := &v1pb.EnvironmentPipeline{
+		Name: environmentPipelineName,
+	}
+	for _, stage := range pipeline.Stages {
+		v1Stage := &v1pb.EnvironmentPipeline_Stage{}
+		for _, environment := range stage.Environments {
+			v1Stage.Environments = append(v1Stage.Environments, common.FormatEnvironment(environment))
+		}
+		for _, check := range stage.RequiredChecks {
+			v1Stage.RequiredChecks = append(v1Stage.RequiredChecks, convertToPlanCheckRunType(store.PlanCheckRunType(check)))
+		}
+		if p := stage.RolloutPolicy; p != nil {
+			v1Stage.RolloutPolicy = &v1pb.RolloutPolicy{
+				Automatic:             p.Automatic,
+				WorkspaceRoles:        p.WorkspaceRoles,
+				ProjectRoles:          p.ProjectRoles,
+				IssueRoles:            p.IssueRoles,
+				Groups:                p.Groups,
+				StageApprovalRequired: p.StageApprovalRequired,
+				StageApproverGroups:   p.StageApproverGroups,
+			}
+		}
+		result.Stages = append(result.Stages, v1Stage)
+	}
+	return result
+}
+
+// convertToStoreEnvironmentPipeline converts the pipeline and validates that every environment is active and in at most one stage.
+func convertToStoreEnvironmentPipeline(pipeline *v1pb.EnvironmentPipeline, environments []*store.EnvironmentMessage) (*storepb.EnvironmentPipelineSetting, error) {
+	activeEnvironments := make(map[string]bool)
+	for _, environment := range environments {
+		activeEnvironments[environment.ResourceID] = true
+	}
+	seen := make(map[string]bool)
+	result := &storepb.EnvironmentPipelineSetting{}
+	for i, stage := range pipeline.Stages {
+		if len(stage.Environments) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "stage %d has no environments", i+1)
+		}
+		storeStage := &storepb.EnvironmentPipelineSetting_Stage{}
+		for _, name := range stage.Environments {
+			environmentID, err := common.GetEnvironmentID(name)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if !activeEnvironments[environmentID] {
+				return nil, status.Errorf(codes.InvalidArgument, "environment %q not found", name)
+			}
+			if seen[environmentID] {
+				return nil, status.Errorf(codes.InvalidArgument, "environment %q is in more than one stage", name)
+			}
+			seen[environmentID] = true
+			storeStage.Environments = append(storeStage.Environments, environmentID)
+		}
+		for _, check := range stage.RequiredChecks {
+			checkType, err := convertPlanCheckRunType(check)
+			if err != nil {
+				return nil, err
+			}
+			storeStage.RequiredChecks = append(storeStage.RequiredChecks, string(checkType))
+		}
+		if stage.RolloutPolicy != nil {
+			storeStage.RolloutPolicy = convertToStorePBRolloutPolicy(stage.RolloutPolicy)
+		}
+		result.Stages = append(result.Stages, storeStage)
+	}
+	return result, nil
+}
+
+func convertPlanCheckRunType(t v1pb.PlanCheckRun_Type) (store.PlanCheckRunType, error) {
+	switch t {
+	case v1pb.PlanCheckRun_DATABASE_STATEMENT_FAKE_ADVISE:
+		return store.PlanCheckDatabaseStatementFakeAdvise, nil
+	case v1pb.PlanCheckRun_DATABASE_STATEMENT_ADVISE:
+		return store.PlanCheckDatabaseStatementAdvise, nil
+	case v1pb.PlanCheckRun_DATABASE_STATEMENT_SUMMARY_REPORT:
+		return store.PlanCheckDatabaseStatementSummaryReport, nil
+	case v1pb.PlanCheckRun_DATABASE_CONNECT:
+		return store.PlanCheckDatabaseConnect, nil
+	case v1pb.PlanCheckRun_DATABASE_GHOST_SYNC:
+		return store.PlanCheckDatabaseGhostSync, nil
+	case v1pb.PlanCheckRun_DATABASE_STATEMENT_CONFLICT:
+		return store.PlanCheckDatabaseStatementConflict, nil
+	case v1pb.PlanCheckRun_DATABASE_STATEMENT_SIMULATE:
+		return store.PlanCheckDatabaseStatementSimulate, nil
+	case v1pb.PlanCheckRun_DATABASE_GRANT_PREVIEW:
+		return store.PlanCheckDatabaseGrantPreview, nil
+	case v1pb.PlanCheckRun_DATABASE_STATEMENT_DRY_RUN:
+		return store.PlanCheckDatabaseStatementDryRun, nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "unsupported plan check type %v", t)
+}
+
+// checkEnvironmentPipeline checks that the stages of the earlier pipeline stages are done or skipped,
+// and the required checks of the pipeline stage pass for the tasks to run.
+func (s *RolloutService) checkEnvironmentPipeline(ctx context.Context, issue *store.IssueMessage, stages []*store.StageMessage, stageToRun *store.StageMessage, tasksToRun []*store.TaskMessage) error {
+	pipeline, err := s.store.GetEnvironmentPipelineSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get environment pipeline, error: %v", err)
+	}
+	if len(pipeline.Stages) == 0 {
+		return nil
+	}
+	getPipelineStageIndex := func(environmentUID int) (int, *storepb.EnvironmentPipelineSetting_Stage, error) {
+		environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{UID: &environmentUID, ShowDeleted: true})
+		if err != nil {
+			return 0, nil, err
+		}
+		if environment == nil {
+			return len(pipeline.Stages), nil, nil
+		}
+		index, stage := store.GetEnvironmentPipelineStageIndex(pipeline, environment.ResourceID)
+		return index, stage, nil
+	}
+
+	indexToRun, pipelineStage, err := getPipelineStageIndex(stageToRun.EnvironmentID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get environment, error: %v", err)
+	}
+	for _, stage := range stages {
+		if stage.ID == stageToRun.ID {
+			continue
+		}
+		index, _, err := getPipelineStageIndex(stage.EnvironmentID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to get environment, error: %v", err)
+		}
+		if index >= indexToRun {
+			continue
+		}
+		tasks, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: &stage.PipelineID, StageID: &stage.ID})
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to list tasks, error: %v", err)
+		}
+		for _, task := range tasks {
+			skipped, err := utils.GetTaskSkipped(task)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get task skipped, error: %v", err)
+			}
+			if !skipped && task.LatestTaskRunStatus != api.TaskRunDone {
+				return status.Errorf(codes.FailedPrecondition, "cannot run the tasks because the stage %q of an earlier environment pipeline stage is not done", stage.Name)
+			}
+		}
+	}
+
+	if len(pipelineStage.GetRequiredChecks()) == 0 || issue.PlanUID == nil {
+		return nil
+	}
+	planCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		PlanUID:    issue.PlanUID,
+		LatestOnly: true,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list plan check runs, error: %v", err)
+	}
+	for _, task := range tasksToRun {
+		for _, checkType := range pipelineStage.RequiredChecks {
+			passed := false
+			for _, run := range planCheckRuns {
+				if string(run.Type) != checkType || int(run.Config.GetInstanceUid()) != task.InstanceID || run.Config.GetDatabaseName() != task.DatabaseName {
+					continue
+				}
+				passed = run.Status == store.PlanCheckRunStatusDone
+				for _, result := range run.Result.GetResults() {
+					if result.Status == storepb.PlanCheckRunResult_Result_ERROR {
+						passed = false
+					}
+				}
+			}
+			if !passed {
+				return status.Errorf(codes.FailedPrecondition, "cannot run the task %q because the required check %q has not passed", task.Name, checkType)
+			}
+		}
+	}
+	return nil
+}
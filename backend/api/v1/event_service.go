@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/eventstream"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// EventService implements the event service.
+type EventService struct {
+	v1pb.UnimplementedEventServiceServer
+	store       *store.Store
+	iamManager  *iam.Manager
+	eventBroker *eventstream.Broker
+}
+
+// NewEventService creates a new EventService.
+func NewEventService(store *store.Store, iamManager *iam.Manager, eventBroker *eventstream.Broker) *EventService {
+	return &EventService{
+		store:       store,
+		iamManager:  iamManager,
+		eventBroker: eventBroker,
+	}
+}
+
+// StreamEvents streams the workspace events which the caller has the permission to get.
+func (s *EventService) StreamEvents(request *v1pb.StreamEventsRequest, server v1pb.EventService_StreamEventsServer) error {
+	ctx := server.Context()
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if request.Project != "" {
+		if _, err := common.GetProjectID(request.Project); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid project %q: %v", request.Project, err)
+		}
+	}
+
+	subscription := s.eventBroker.Subscribe()
+	defer s.eventBroker.Unsubscribe(subscription)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event := <-subscription.Events():
+			if len(request.Types) > 0 && !slices.Contains(request.Types, event.Type) {
+				continue
+			}
+			if request.Project != "" && event.Project != request.Project {
+				continue
+			}
+			ok, err := s.canGetEvent(ctx, user, event)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to check permission: %v", err)
+			}
+			if !ok {
+				continue
+			}
+			if err := server.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// canGetEvent checks if the user has the permission to get the resource of the event.
+func (s *EventService) canGetEvent(ctx context.Context, user *store.UserMessage, event *v1pb.WorkspaceEvent) (bool, error) {
+	var projectIDs []string
+	if event.Project != "" {
+		projectID, err := common.GetProjectID(event.Project)
+		if err != nil {
+			return false, err
+		}
+		projectIDs = append(projectIDs, projectID)
+	}
+
+	var permission iam.Permission
+	switch event.Type {
+	case v1pb.WorkspaceEvent_ISSUE_CREATED,
+		v1pb.WorkspaceEvent_ISSUE_UPDATED,
+		v1pb.WorkspaceEvent_ISSUE_STATUS_UPDATED,
+		v1pb.WorkspaceEvent_ISSUE_COMMENT_CREATED,
+		v1pb.WorkspaceEvent_ISSUE_APPROVAL_REQUESTED,
+		v1pb.WorkspaceEvent_ISSUE_APPROVED,
+		v1pb.WorkspaceEvent_ISSUE_ROLLOUT_READY,
+		v1pb.WorkspaceEvent_STAGE_STATUS_UPDATED,
+		v1pb.WorkspaceEvent_TASK_RUN_STATUS_UPDATED:
+		permission = iam.PermissionIssuesGet
+	case v1pb.WorkspaceEvent_ANOMALY_DETECTED:
+		permission = iam.PermissionInstancesGet
+		if len(projectIDs) > 0 {
+			permission = iam.PermissionDatabasesGet
+		}
+	case v1pb.WorkspaceEvent_PROJECT_MEMBERS_UPDATED:
+		permission = iam.PermissionProjectsGetIAMPolicy
+	case v1pb.WorkspaceEvent_WORKSPACE_MEMBERS_UPDATED,
+		v1pb.WorkspaceEvent_USER_CREATED,
+		v1pb.WorkspaceEvent_USER_DELETED,
+		v1pb.WorkspaceEvent_USER_UNDELETED:
+		permission = iam.PermissionPoliciesGet
+	default:
+		return false, nil
+	}
+	return s.iamManager.CheckPermission(ctx, permission, user, projectIDs...)
+}
+
+// newWorkspaceEvent creates the workspace event triggered by the caller.
+func newWorkspaceEvent(ctx context.Context, eventType v1pb.WorkspaceEvent_Type, resource string, title string) *v1pb.WorkspaceEvent {
+	event := &v1pb.WorkspaceEvent{
+		Type:     eventType,
+		Resource: resource,
+		Title:    title,
+	}
+	if user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage); ok {
+		event.Actor = common.FormatUserEmail(user.Email)
+	}
+	return event
+}
@@ -142,6 +142,9 @@ func (s *GroupService) UpdateGroup(ctx context.Context, request *v1pb.UpdateGrou
 		case "description":
 			patch.Description = &request.Group.Description
 		case "members":
+			if group.Payload.GetSource() != "" {
+				return nil, status.Errorf(codes.FailedPrecondition, "the members of group %q are managed by %s", groupEmail, group.Payload.GetSource())
+			}
 			payload, err := s.convertToGroupPayload(ctx, request.Group)
 			if err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, err.Error())
@@ -252,6 +255,7 @@ func (s *GroupService) convertToV1Group(ctx context.Context, groupMessage *store
 		Description: groupMessage.Description,
 		Creator:     common.FormatUserEmail(creator.Email),
 		CreateTime:  timestamppb.New(groupMessage.CreatedTime),
+		Source:      groupMessage.Payload.GetSource(),
 	}
 
 	for _, member := range groupMessage.Payload.Members {
@@ -314,17 +314,18 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, requ
 				UserFilter:       identityProviderConfig.UserFilter,
 				SecurityProtocol: ldap.SecurityProtocol(identityProviderConfig.SecurityProtocol),
 				FieldMapping:     identityProviderConfig.FieldMapping,
+				GroupBaseDN:      identityProviderConfig.GroupBaseDn,
+				GroupFilter:      identityProviderConfig.GroupFilter,
+				NestedGroup:      identityProviderConfig.NestedGroup,
 			},
 		)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to create new LDAP identity provider: %v", err)
 		}
 
-		conn, err := ldapIdentityProvider.Connect()
-		if err != nil {
+		if err := ldapIdentityProvider.TestConnection(); err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "failed to test connection, error: %s", err.Error())
 		}
-		_ = conn.Close()
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", identityProvider.Type.String())
 	}
@@ -414,6 +415,13 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
 		}
+		var groupMappings []*v1pb.LDAPGroupMapping
+		for _, mapping := range v.GroupMappings {
+			groupMappings = append(groupMappings, &v1pb.LDAPGroupMapping{
+				GroupDn: mapping.GroupDn,
+				Role:    mapping.Role,
+			})
+		}
 		return &v1pb.IdentityProviderConfig{
 			Config: &v1pb.IdentityProviderConfig_LdapConfig{
 				LdapConfig: &v1pb.LDAPIdentityProviderConfig{
@@ -426,6 +434,10 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 					UserFilter:       v.UserFilter,
 					SecurityProtocol: v.SecurityProtocol,
 					FieldMapping:     &fieldMapping,
+					GroupBaseDn:      v.GroupBaseDn,
+					GroupFilter:      v.GroupFilter,
+					NestedGroup:      v.NestedGroup,
+					GroupMappings:    groupMappings,
 				},
 			},
 		}
@@ -482,6 +494,13 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 			Email:       v.FieldMapping.Email,
 			Phone:       v.FieldMapping.Phone,
 		}
+		var groupMappings []*storepb.LDAPGroupMapping
+		for _, mapping := range v.GroupMappings {
+			groupMappings = append(groupMappings, &storepb.LDAPGroupMapping{
+				GroupDn: mapping.GroupDn,
+				Role:    mapping.Role,
+			})
+		}
 		return &storepb.IdentityProviderConfig{
 			Config: &storepb.IdentityProviderConfig_LdapConfig{
 				LdapConfig: &storepb.LDAPIdentityProviderConfig{
@@ -494,6 +513,10 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 					UserFilter:       v.UserFilter,
 					SecurityProtocol: v.SecurityProtocol,
 					FieldMapping:     &fieldMapping,
+					GroupBaseDn:      v.GroupBaseDn,
+					GroupFilter:      v.GroupFilter,
+					NestedGroup:      v.NestedGroup,
+					GroupMappings:    groupMappings,
 				},
 			},
 		}
@@ -512,9 +535,21 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 			return errors.Errorf("unexpected provider config value")
 		}
 	} else if identityProviderType == v1pb.IdentityProviderType_LDAP {
-		if identityProviderConfig.GetLdapConfig() == nil {
+		ldapConfig := identityProviderConfig.GetLdapConfig()
+		if ldapConfig == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+		if len(ldapConfig.GroupMappings) > 0 && ldapConfig.GroupBaseDn == "" {
+			return errors.Errorf("group base DN is required for group mappings")
+		}
+		for _, mapping := range ldapConfig.GroupMappings {
+			if mapping.GroupDn == "" {
+				return errors.Errorf("group DN is required for group mapping")
+			}
+			if _, err := common.GetRoleID(mapping.Role); err != nil {
+				return errors.Wrapf(err, "invalid role in group mapping for %q", mapping.GroupDn)
+			}
+		}
 	} else {
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -293,6 +294,31 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 				patch.OptionsUpsert = instance.Options
 			}
 			patch.OptionsUpsert.MaximumConnections = request.Instance.Options.GetMaximumConnections()
+		case "options.max_concurrent_tasks":
+			if request.Instance.Options.GetMaxConcurrentTasks() < 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid maximum concurrent tasks %d", request.Instance.Options.GetMaxConcurrentTasks())
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = instance.Options
+			}
+			patch.OptionsUpsert.MaxConcurrentTasks = request.Instance.Options.GetMaxConcurrentTasks()
+		case "options.oracle_pdb_mode":
+			if request.Instance.Options.GetOraclePdbMode() && instance.Engine != storepb.Engine_ORACLE {
+				return nil, status.Errorf(codes.InvalidArgument, "pdb mode is only supported for Oracle")
+			}
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = instance.Options
+			}
+			patch.OptionsUpsert.OraclePdbMode = request.Instance.Options.GetOraclePdbMode()
+		case "options.connection_pool":
+			if patch.OptionsUpsert == nil {
+				patch.OptionsUpsert = instance.Options
+			}
+			connectionPool := request.Instance.Options.GetConnectionPool()
+			if err := validateConnectionPoolConfig(connectionPool); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			patch.OptionsUpsert.ConnectionPool = convertConnectionPoolConfig(connectionPool)
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupported update_mask "%s"`, path)
 		}
@@ -477,6 +503,39 @@ func (s *InstanceService) SyncSlowQueries(ctx context.Context, request *v1pb.Syn
 	}
 }
 
+// KillQuery cancels the running query of the connection in the instance.
+func (s *InstanceService) KillQuery(ctx context.Context, request *v1pb.KillQueryRequest) (*emptypb.Empty, error) {
+	instance, err := getInstanceMessage(ctx, s.store, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if instance.Deleted {
+		return nil, status.Errorf(codes.NotFound, "instance %q has been deleted", request.Name)
+	}
+	connectionID, err := strconv.ParseInt(request.ConnectionId, 10, 64)
+	if err != nil || connectionID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid connection id %q", request.ConnectionId)
+	}
+	statement := getKillQueryStatement(instance.Engine, connectionID)
+	if statement == "" {
+		return nil, status.Errorf(codes.Unimplemented, "kill query is not supported for engine %s", instance.Engine.String())
+	}
+
+	driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, status.Errorf(codes.Internal, "database connection not found")
+	}
+	if _, err := sqlDB.ExecContext(ctx, statement); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to kill query of connection %d: %v", connectionID, err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
 // DeleteInstance deletes an instance.
 func (s *InstanceService) DeleteInstance(ctx context.Context, request *v1pb.DeleteInstanceRequest) (*emptypb.Empty, error) {
 	instance, err := getInstanceMessage(ctx, s.store, request.Name)
@@ -800,6 +859,10 @@ func (s *InstanceService) UpdateDataSource(ctx context.Context, request *v1pb.Up
 			dataSource.DirectConnection = request.DataSource.DirectConnection
 		case "region":
 			dataSource.Region = request.DataSource.Region
+			patch.Region = &request.DataSource.Region
+		case "aws_role_arn":
+			dataSource.AWSRoleARN = request.DataSource.AwsRoleArn
+			patch.AWSRoleARN = &request.DataSource.AwsRoleArn
 		case "account_id":
 			dataSource.AccountID = request.DataSource.AccountId
 			patch.AccountID = &request.DataSource.AccountId
@@ -1007,6 +1070,9 @@ func (s *InstanceService) convertToInstanceMessage(instanceID string, instance *
 	if err != nil {
 		return nil, err
 	}
+	if err := validateConnectionPoolConfig(instance.Options.GetConnectionPool()); err != nil {
+		return nil, err
+	}
 
 	return &store.InstanceMessage{
 		ResourceID:    instanceID,
@@ -1066,6 +1132,7 @@ func convertToV1DataSourceExternalSecret(externalSecret *storepb.DataSourceExter
 		EngineName:      secret.EngineName,
 		SecretName:      secret.SecretName,
 		PasswordKeyName: secret.PasswordKeyName,
+		CacheTtl:        secret.CacheTtl,
 	}
 
 	// clear sensitive data.
@@ -1078,6 +1145,10 @@ func convertToV1DataSourceExternalSecret(externalSecret *storepb.DataSourceExter
 				MountPath: appRole.MountPath,
 			},
 		}
+	case v1pb.DataSourceExternalSecret_VAULT_KUBERNETES:
+		resp.AuthOption = &v1pb.DataSourceExternalSecret_Kubernetes{
+			Kubernetes: secret.GetKubernetes(),
+		}
 	case v1pb.DataSourceExternalSecret_TOKEN:
 		resp.AuthOption = &v1pb.DataSourceExternalSecret_Token{
 			Token: "",
@@ -1132,6 +1203,7 @@ func convertToV1DataSources(dataSources []*store.DataSourceMessage) ([]*v1pb.Dat
 			ReplicaSet:             ds.ReplicaSet,
 			DirectConnection:       ds.DirectConnection,
 			Region:                 ds.Region,
+			AwsRoleArn:             ds.AWSRoleARN,
 			WarehouseId:            ds.WarehouseID,
 			UseSsl:                 ds.UseSSL,
 			RedisType:              convertToV1RedisType(ds.RedisType),
@@ -1181,6 +1253,16 @@ func convertToStoreDataSourceExternalSecret(externalSecret *v1pb.DataSourceExter
 		if secret.GetAppRole() == nil {
 			return nil, status.Errorf(codes.InvalidArgument, "missing Vault approle")
 		}
+	case storepb.DataSourceExternalSecret_VAULT_KUBERNETES:
+		if secret.SecretType != storepb.DataSourceExternalSecret_VAULT_KV_V2 {
+			return nil, status.Errorf(codes.InvalidArgument, "kubernetes auth is only supported for Vault")
+		}
+		if secret.GetKubernetes().GetRole() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "missing Vault kubernetes role")
+		}
+	}
+	if secret.CacheTtl != nil && secret.CacheTtl.AsDuration() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "cache ttl must not be negative")
 	}
 
 	return secret, nil
@@ -1334,6 +1416,7 @@ func (s *InstanceService) convertToDataSourceMessage(dataSource *v1pb.DataSource
 		ReplicaSet:                         dataSource.ReplicaSet,
 		DirectConnection:                   dataSource.DirectConnection,
 		Region:                             dataSource.Region,
+		AWSRoleARN:                         dataSource.AwsRoleArn,
 		AccountID:                          dataSource.AccountId,
 		WarehouseID:                        dataSource.WarehouseId,
 		UseSSL:                             dataSource.UseSsl,
@@ -1379,6 +1462,20 @@ func convertToInstanceOptions(options *storepb.InstanceOptions) *v1pb.InstanceOp
 	return &v1pb.InstanceOptions{
 		SyncInterval:       options.SyncInterval,
 		MaximumConnections: options.MaximumConnections,
+		ConnectionPool:     convertToConnectionPoolConfig(options.ConnectionPool),
+		MaxConcurrentTasks: options.MaxConcurrentTasks,
+		OraclePdbMode:      options.OraclePdbMode,
+	}
+}
+
+func convertToConnectionPoolConfig(config *storepb.ConnectionPoolConfig) *v1pb.ConnectionPoolConfig {
+	if config == nil {
+		return nil
+	}
+	return &v1pb.ConnectionPoolConfig{
+		MaxOpenConnections:    config.MaxOpenConnections,
+		MaxIdleConnections:    config.MaxIdleConnections,
+		MaxConnectionLifetime: config.MaxConnectionLifetime,
 	}
 }
 
@@ -1390,5 +1487,35 @@ func convertInstanceOptions(options *v1pb.InstanceOptions) *storepb.InstanceOpti
 	return &storepb.InstanceOptions{
 		SyncInterval:       options.SyncInterval,
 		MaximumConnections: options.MaximumConnections,
+		ConnectionPool:     convertConnectionPoolConfig(options.ConnectionPool),
+		MaxConcurrentTasks: options.MaxConcurrentTasks,
+		OraclePdbMode:      options.OraclePdbMode,
 	}
 }
+
+func convertConnectionPoolConfig(config *v1pb.ConnectionPoolConfig) *storepb.ConnectionPoolConfig {
+	if config == nil {
+		return nil
+	}
+	return &storepb.ConnectionPoolConfig{
+		MaxOpenConnections:    config.MaxOpenConnections,
+		MaxIdleConnections:    config.MaxIdleConnections,
+		MaxConnectionLifetime: config.MaxConnectionLifetime,
+	}
+}
+
+func validateConnectionPoolConfig(config *v1pb.ConnectionPoolConfig) error {
+	if config.GetMaxOpenConnections() < 0 {
+		return errors.Errorf("max open connections must not be negative")
+	}
+	if config.GetMaxIdleConnections() < 0 {
+		return errors.Errorf("max idle connections must not be negative")
+	}
+	if config.GetMaxConnectionLifetime().AsDuration() < 0 {
+		return errors.Errorf("max connection lifetime must not be negative")
+	}
+	if config.GetMaxOpenConnections() > 0 && config.GetMaxIdleConnections() > config.GetMaxOpenConnections() {
+		return errors.Errorf("max idle connections must not exceed max open connections")
+	}
+	return nil
+}
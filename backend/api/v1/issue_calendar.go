@@ -0,0 +1,143 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const maxChangeCalendarDuration = 366 * 24 * time.Hour
+
+// ListChangeCalendarEvents lists the scheduled and completed rollouts in the time range.
+func (s *IssueService) ListChangeCalendarEvents(ctx context.Context, request *v1pb.ListChangeCalendarEventsRequest) (*v1pb.ListChangeCalendarEventsResponse, error) {
+	events, err := s.listChangeCalendarEvents(ctx, request.Parent, request.StartTime, request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1pb.ListChangeCalendarEventsResponse{}
+	for _, event := range events {
+		resp.Events = append(resp.Events, convertToChangeCalendarEvent(event))
+	}
+	return resp, nil
+}
+
+// ExportChangeCalendar exports the change calendar events in the time range as an iCalendar feed.
+func (s *IssueService) ExportChangeCalendar(ctx context.Context, request *v1pb.ExportChangeCalendarRequest) (*v1pb.ExportChangeCalendarResponse, error) {
+	events, err := s.listChangeCalendarEvents(ctx, request.Parent, request.StartTime, request.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, error: %v", err)
+	}
+	return &v1pb.ExportChangeCalendarResponse{
+		Content: []byte(buildICalendar(events, setting.ExternalUrl, time.Now())),
+	}, nil
+}
+
+func (s *IssueService) listChangeCalendarEvents(ctx context.Context, parent string, startTime, endTime *timestamppb.Timestamp) ([]*store.ChangeCalendarEventMessage, error) {
+	projectID, err := common.GetProjectID(parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if startTime == nil || endTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time and end_time must be set")
+	}
+	start, end := startTime.AsTime(), endTime.AsTime()
+	if !start.Before(end) {
+		return nil, status.Errorf(codes.InvalidArgument, "start_time must be before end_time")
+	}
+	if end.Sub(start) > maxChangeCalendarDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "the time range cannot exceed one year")
+	}
+
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	find := &store.FindChangeCalendarEventMessage{
+		StartTs: start.Unix(),
+		EndTs:   end.Unix(),
+	}
+	if projectID != "-" {
+		find.ProjectID = &projectID
+	}
+	projectIDsFilter, err := getProjectIDsSearchFilter(ctx, user, iam.PermissionIssuesGet, s.iamManager, s.store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get projectIDs, error: %v", err)
+	}
+	find.ProjectIDs = projectIDsFilter
+
+	events, err := s.store.ListChangeCalendarEvents(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list change calendar events, error: %v", err)
+	}
+	return events, nil
+}
+
+func convertToChangeCalendarEvent(event *store.ChangeCalendarEventMessage) *v1pb.ChangeCalendarEvent {
+	return &v1pb.ChangeCalendarEvent{
+		Type:        v1pb.ChangeCalendarEvent_Type(v1pb.ChangeCalendarEvent_Type_value[string(event.Type)]),
+		Title:       getChangeCalendarEventTitle(event),
+		Issue:       fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, event.ProjectID, common.IssueNamePrefix, event.IssueUID),
+		Environment: common.FormatEnvironment(event.EnvironmentID),
+		StartTime:   timestamppb.New(event.StartTime),
+		EndTime:     timestamppb.New(event.EndTime),
+	}
+}
+
+func getChangeCalendarEventTitle(event *store.ChangeCalendarEventMessage) string {
+	if event.Type == store.ChangeCalendarEventApprovalDue {
+		return fmt.Sprintf("Approval due: [%s] %s", event.EnvironmentID, event.IssueTitle)
+	}
+	return fmt.Sprintf("[%s] %s", event.EnvironmentID, event.IssueTitle)
+}
+
+// buildICalendar builds the iCalendar (RFC 5545) feed of the events.
+func buildICalendar(events []*store.ChangeCalendarEventMessage, externalURL string, now time.Time) string {
+	const timeLayout = "20060102T150405Z"
+	var buf strings.Builder
+	writeLine := func(line string) {
+		_, _ = buf.WriteString(line)
+		_, _ = buf.WriteString("\r\n")
+	}
+	writeLine("BEGIN:VCALENDAR")
+	writeLine("VERSION:2.0")
+	writeLine("PRODID:-//Bytebase//Change Calendar//EN")
+	writeLine("CALSCALE:GREGORIAN")
+	for _, event := range events {
+		endTime := event.EndTime
+		if !endTime.After(event.StartTime) {
+			// A scheduled rollout is a point in time, give it a minimal duration so that calendars can render it.
+			endTime = event.StartTime.Add(time.Minute)
+		}
+		writeLine("BEGIN:VEVENT")
+		writeLine(fmt.Sprintf("UID:%s-%s-%d-%s-%d@bytebase", strings.ToLower(string(event.Type)), event.ProjectID, event.IssueUID, event.EnvironmentID, event.StartTime.Unix()))
+		writeLine("DTSTAMP:" + now.UTC().Format(timeLayout))
+		writeLine("DTSTART:" + event.StartTime.UTC().Format(timeLayout))
+		writeLine("DTEND:" + endTime.UTC().Format(timeLayout))
+		writeLine("SUMMARY:" + escapeICalendarText(getChangeCalendarEventTitle(event)))
+		writeLine("CATEGORIES:" + string(event.Type))
+		if externalURL != "" {
+			writeLine(fmt.Sprintf("URL:%s/projects/%s/issues/%d", strings.TrimSuffix(externalURL, "/"), event.ProjectID, event.IssueUID))
+		}
+		writeLine("END:VEVENT")
+	}
+	writeLine("END:VCALENDAR")
+	return buf.String()
+}
+
+func escapeICalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(text)
+}
@@ -0,0 +1,225 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	calendarFeedTokenPrefix = "bbcal_"
+	calendarFeedSuffix      = ".ics"
+	// The calendar feed covers the recent past so that overdue approvals remain visible.
+	calendarFeedLookBack  = 7 * 24 * time.Hour
+	calendarFeedLookAhead = 365 * 24 * time.Hour
+)
+
+// RegenerateCalendarFeed generates a new secret calendar feed URL of the caller, which revokes the previous one.
+func (s *IssueService) RegenerateCalendarFeed(ctx context.Context, _ *v1pb.RegenerateCalendarFeedRequest) (*v1pb.CalendarFeed, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, error: %v", err)
+	}
+	if setting.ExternalUrl == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "external URL is required to generate the calendar feed URL")
+	}
+
+	random, err := common.RandomString(40)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate calendar feed token")
+	}
+	token := calendarFeedTokenPrefix + random
+	if err := s.store.UpsertCalendarFeedTokenHash(ctx, user.ID, hashCalendarFeedToken(token)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save calendar feed token, error: %v", err)
+	}
+	return &v1pb.CalendarFeed{
+		Url: fmt.Sprintf("%s/v1/calendarFeeds/%s%s", strings.TrimSuffix(setting.ExternalUrl, "/"), token, calendarFeedSuffix),
+	}, nil
+}
+
+// GetCalendarFeed returns the iCalendar feed of the pending approvals and the scheduled rollouts of the user who owns the token.
+func (s *IssueService) GetCalendarFeed(ctx context.Context, request *v1pb.GetCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	token := strings.TrimSuffix(request.Token, calendarFeedSuffix)
+	if !strings.HasPrefix(token, calendarFeedTokenPrefix) {
+		return nil, status.Errorf(codes.NotFound, "calendar feed not found")
+	}
+	principalUID, err := s.store.GetCalendarFeedPrincipalUID(ctx, hashCalendarFeedToken(token))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get calendar feed, error: %v", err)
+	}
+	if principalUID == nil {
+		return nil, status.Errorf(codes.NotFound, "calendar feed not found")
+	}
+	user, err := s.store.GetUserByID(ctx, *principalUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil || user.MemberDeleted {
+		return nil, status.Errorf(codes.NotFound, "calendar feed not found")
+	}
+
+	events, err := s.getUserCalendarFeedEvents(ctx, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get calendar feed events, error: %v", err)
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting, error: %v", err)
+	}
+	return &httpbody.HttpBody{
+		ContentType: "text/calendar; charset=utf-8",
+		Data:        []byte(buildICalendar(events, setting.ExternalUrl, time.Now())),
+	}, nil
+}
+
+// getUserCalendarFeedEvents returns the approval due events of the issues waiting for the user's approval,
+// and the scheduled rollouts which the user can release.
+func (s *IssueService) getUserCalendarFeedEvents(ctx context.Context, user *store.UserMessage) ([]*store.ChangeCalendarEventMessage, error) {
+	projectIDsFilter, err := getProjectIDsSearchFilter(ctx, user, iam.PermissionIssuesGet, s.iamManager, s.store)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get projectIDs")
+	}
+	now := time.Now()
+	scheduledEvents, err := s.store.ListChangeCalendarEvents(ctx, &store.FindChangeCalendarEventMessage{
+		ProjectIDs: projectIDsFilter,
+		StartTs:    now.Add(-calendarFeedLookBack).Unix(),
+		EndTs:      now.Add(calendarFeedLookAhead).Unix(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list change calendar events")
+	}
+	workspacePolicy, err := s.store.GetWorkspaceIamPolicy(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get workspace policy")
+	}
+
+	var events []*store.ChangeCalendarEventMessage
+	for _, event := range scheduledEvents {
+		if event.Type != store.ChangeCalendarEventScheduledRollout {
+			continue
+		}
+		issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &event.IssueUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get issue %d", event.IssueUID)
+		}
+		if issue == nil {
+			continue
+		}
+		projectPolicy, err := s.store.GetProjectIamPolicy(ctx, issue.Project.UID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get project policy")
+		}
+		policies := []*storepb.IamPolicy{projectPolicy.Policy, workspacePolicy.Policy}
+
+		approved, err := utils.CheckApprovalApproved(issue.Payload.GetApproval())
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check if the issue %d is approved", issue.UID)
+		}
+		if !approved {
+			approval := issue.Payload.GetApproval()
+			if !approval.GetApprovalFindingDone() || len(approval.GetApprovalTemplates()) == 0 {
+				continue
+			}
+			step := utils.FindNextPendingStep(approval.ApprovalTemplates[0], approval.Approvers)
+			if step == nil {
+				continue
+			}
+			ok, err := isUserReviewer(ctx, s.store, step, user, policies...)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to check if the user can approve the issue %d", issue.UID)
+			}
+			if ok {
+				approvalEvent := *event
+				approvalEvent.Type = store.ChangeCalendarEventApprovalDue
+				events = append(events, &approvalEvent)
+			}
+			continue
+		}
+
+		ok, err := s.isUserRolloutReleaser(ctx, issue, event.EnvironmentID, user, policies)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check if the user can rollout the issue %d", issue.UID)
+		}
+		if ok {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// isUserRolloutReleaser checks if the user can rollout the issue in the environment by the rollout policy.
+func (s *IssueService) isUserRolloutReleaser(ctx context.Context, issue *store.IssueMessage, environmentID string, user *store.UserMessage, policies []*storepb.IamPolicy) (bool, error) {
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &environmentID})
+	if err != nil {
+		return false, err
+	}
+	if environment == nil {
+		return false, nil
+	}
+	policy, err := s.store.GetRolloutPolicy(ctx, environment.UID)
+	if err != nil {
+		return false, err
+	}
+	roles := utils.GetUserFormattedRolesMap(ctx, s.store, user, policies...)
+	if policy.Automatic {
+		return issue.Creator.ID == user.ID || roles[common.FormatRole(api.ProjectOwner.String())], nil
+	}
+	for _, role := range policy.WorkspaceRoles {
+		if roles[role] {
+			return true, nil
+		}
+	}
+	for _, role := range policy.ProjectRoles {
+		if roles[role] {
+			return true, nil
+		}
+	}
+	for _, group := range policy.Groups {
+		ok, err := utils.IsUserInGroup(ctx, s.store, user, group)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	for _, role := range policy.IssueRoles {
+		switch role {
+		case "roles/CREATOR":
+			if issue.Creator.ID == user.ID {
+				return true, nil
+			}
+		case "roles/LAST_APPROVER":
+			approvers := issue.Payload.GetApproval().GetApprovers()
+			if len(approvers) > 0 && int(approvers[len(approvers)-1].GetPrincipalId()) == user.ID {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func hashCalendarFeedToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
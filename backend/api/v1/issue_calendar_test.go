@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+func TestBuildICalendar(t *testing.T) {
+	a := require.New(t)
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	events := []*store.ChangeCalendarEventMessage{
+		{
+			Type:          store.ChangeCalendarEventScheduledRollout,
+			ProjectID:     "pay",
+			IssueUID:      142,
+			IssueTitle:    "Add index; backfill, users",
+			EnvironmentID: "prod",
+			StartTime:     start,
+			EndTime:       start,
+		},
+	}
+	got := buildICalendar(events, "https://bytebase.example.com/", start)
+	a.True(strings.HasPrefix(got, "BEGIN:VCALENDAR\r\n"))
+	a.True(strings.HasSuffix(got, "END:VCALENDAR\r\n"))
+	a.Contains(got, "DTSTART:20240501T100000Z\r\n")
+	a.Contains(got, "DTEND:20240501T100100Z\r\n")
+	a.Contains(got, `SUMMARY:[prod] Add index\; backfill\, users`+"\r\n")
+	a.Contains(got, "URL:https://bytebase.example.com/projects/pay/issues/142\r\n")
+}
@@ -0,0 +1,201 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// issueDescriptionDiffLimit is the maximum characters of a rendered SQL diff block.
+const issueDescriptionDiffLimit = 20000
+
+// issueDescriptionReferenceRegex matches the references in the issue description,
+// e.g. {{spec:<spec id>}} and {{sheet:projects/{project}/sheets/{sheet}}}.
+var issueDescriptionReferenceRegex = regexp.MustCompile(`\{\{\s*(spec|sheet):\s*([^\s{}]+)\s*\}\}`)
+
+// issueDescriptionDiff is a rendered SQL diff block of a reference.
+type issueDescriptionDiff struct {
+	// title is the summary of the collapsible block.
+	title string
+	// diff is the unified diff, or the statement if the target has no current schema to diff against.
+	diff   string
+	isDiff bool
+}
+
+// renderIssueDescription replaces the references to the plan specs and the sheets in the description
+// with the collapsible SQL diff blocks against the current schema of the target databases.
+// The references that fail to render are replaced with the errors, so that the description is always rendered.
+func (s *IssueService) renderIssueDescription(ctx context.Context, issue *store.IssueMessage) string {
+	if !issueDescriptionReferenceRegex.MatchString(issue.Description) {
+		return issue.Description
+	}
+	var specs []*storepb.PlanConfig_Spec
+	if issue.PlanUID != nil {
+		plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+		if err != nil {
+			slog.Warn("failed to get plan to render issue description", slog.Int("issue", issue.UID), log.BBError(err))
+		}
+		if plan != nil {
+			for _, step := range plan.Config.GetSteps() {
+				specs = append(specs, step.Specs...)
+			}
+		}
+	}
+
+	return issueDescriptionReferenceRegex.ReplaceAllStringFunc(issue.Description, func(reference string) string {
+		matches := issueDescriptionReferenceRegex.FindStringSubmatch(reference)
+		diffs, err := s.getIssueDescriptionDiffs(ctx, issue, specs, matches[1], matches[2])
+		if err != nil {
+			return fmt.Sprintf("> Failed to render `%s`: %v", reference, err)
+		}
+		var blocks []string
+		for _, diff := range diffs {
+			blocks = append(blocks, formatIssueDescriptionDiff(diff))
+		}
+		return strings.Join(blocks, "\n\n")
+	})
+}
+
+func (s *IssueService) getIssueDescriptionDiffs(ctx context.Context, issue *store.IssueMessage, specs []*storepb.PlanConfig_Spec, kind, value string) ([]*issueDescriptionDiff, error) {
+	switch kind {
+	case "spec":
+		for _, spec := range specs {
+			if spec.Id != value {
+				continue
+			}
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				return nil, errors.Errorf("spec %q doesn't change databases", value)
+			}
+			diff, err := s.getChangeDatabaseConfigDiff(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+			return []*issueDescriptionDiff{diff}, nil
+		}
+		return nil, errors.Errorf("spec %q not found in the plan", value)
+	case "sheet":
+		projectID, sheetUID, err := common.GetProjectResourceIDSheetUID(value)
+		if err != nil {
+			return nil, err
+		}
+		if projectID != issue.Project.ResourceID {
+			return nil, errors.Errorf("sheet %q is not in the project of the issue", value)
+		}
+		// Diff against the targets of the specs using the sheet.
+		var diffs []*issueDescriptionDiff
+		for _, spec := range specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil || config.Sheet != value {
+				continue
+			}
+			diff, err := s.getChangeDatabaseConfigDiff(ctx, config)
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, diff)
+		}
+		if len(diffs) > 0 {
+			return diffs, nil
+		}
+		statement, err := s.store.GetSheetStatementByID(ctx, sheetUID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get statement of sheet %d", sheetUID)
+		}
+		return []*issueDescriptionDiff{{title: value, diff: statement}}, nil
+	default:
+		return nil, errors.Errorf("unsupported reference %q", kind)
+	}
+}
+
+// getChangeDatabaseConfigDiff gets the diff of the change against the current schema of the target database.
+// The sheet of MIGRATE_SDL is the desired schema, so it's diffed with the current schema directly.
+// The other changes are appended to the current schema because the schema after the change is only known after it runs.
+func (s *IssueService) getChangeDatabaseConfigDiff(ctx context.Context, config *storepb.PlanConfig_ChangeDatabaseConfig) (*issueDescriptionDiff, error) {
+	_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := s.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get statement of sheet %d", sheetUID)
+	}
+	title := fmt.Sprintf("%s (%s)", config.Target, config.Sheet)
+
+	// The database group targets have no single current schema.
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(config.Target)
+	if err != nil {
+		return &issueDescriptionDiff{title: title, diff: statement}, nil
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:   &instanceID,
+		DatabaseName: &databaseName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.Target)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database %q not found", config.Target)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schema of database %q", config.Target)
+	}
+	var current string
+	if dbSchema != nil {
+		current = string(dbSchema.GetSchema())
+	}
+
+	after := statement
+	if config.Type != storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL {
+		after = joinIssueDescriptionStatements(current, statement)
+	}
+	diff, err := getIssueDescriptionUnifiedDiff(config.Target, current, after)
+	if err != nil {
+		return nil, err
+	}
+	return &issueDescriptionDiff{title: title, diff: diff, isDiff: true}, nil
+}
+
+func joinIssueDescriptionStatements(current, statement string) string {
+	if current == "" || strings.HasSuffix(current, "\n") {
+		return current + statement
+	}
+	return current + "\n" + statement
+}
+
+func getIssueDescriptionUnifiedDiff(target, current, after string) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(current),
+		B:        difflib.SplitLines(after),
+		FromFile: fmt.Sprintf("%s (current)", target),
+		ToFile:   fmt.Sprintf("%s (after)", target),
+		Context:  3,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to diff schema")
+	}
+	return diff, nil
+}
+
+func formatIssueDescriptionDiff(diff *issueDescriptionDiff) string {
+	language := "sql"
+	if diff.isDiff {
+		language = "diff"
+	}
+	content, truncated := common.TruncateString(strings.TrimRight(diff.diff, "\n"), issueDescriptionDiffLimit)
+	if truncated {
+		content += "\n... (truncated)"
+	}
+	return fmt.Sprintf("<details>\n<summary>%s</summary>\n\n```%s\n%s\n```\n\n</details>", diff.title, language, content)
+}
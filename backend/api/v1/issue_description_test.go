@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueDescriptionReferenceRegex(t *testing.T) {
+	tests := []struct {
+		description string
+		want        [][]string
+	}{
+		{
+			description: "Add the email column.\n{{spec:9f2c}}",
+			want:        [][]string{{"{{spec:9f2c}}", "spec", "9f2c"}},
+		},
+		{
+			description: "{{ sheet: projects/p1/sheets/101 }} and {{spec:a}}",
+			want: [][]string{
+				{"{{ sheet: projects/p1/sheets/101 }}", "sheet", "projects/p1/sheets/101"},
+				{"{{spec:a}}", "spec", "a"},
+			},
+		},
+		{
+			description: "{{plan:projects/p1/plans/1}} {spec:a}",
+			want:        nil,
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, issueDescriptionReferenceRegex.FindAllStringSubmatch(test.description, -1), test.description)
+	}
+}
+
+func TestGetIssueDescriptionUnifiedDiff(t *testing.T) {
+	current := "CREATE TABLE t (\n  id INT\n);\n"
+	after := joinIssueDescriptionStatements(current, "ALTER TABLE t ADD COLUMN email TEXT;")
+	diff, err := getIssueDescriptionUnifiedDiff("instances/i1/databases/d1", current, after)
+	require.NoError(t, err)
+	require.Equal(t, "--- instances/i1/databases/d1 (current)\n+++ instances/i1/databases/d1 (after)\n@@ -1,3 +1,4 @@\n CREATE TABLE t (\n   id INT\n );\n+ALTER TABLE t ADD COLUMN email TEXT;\n", diff)
+}
+
+func TestFormatIssueDescriptionDiff(t *testing.T) {
+	require.Equal(t,
+		"<details>\n<summary>instances/i1/databases/d1 (projects/p1/sheets/101)</summary>\n\n```diff\n+ALTER TABLE t ADD COLUMN email TEXT;\n```\n\n</details>",
+		formatIssueDescriptionDiff(&issueDescriptionDiff{
+			title:  "instances/i1/databases/d1 (projects/p1/sheets/101)",
+			diff:   "+ALTER TABLE t ADD COLUMN email TEXT;\n",
+			isDiff: true,
+		}),
+	)
+}
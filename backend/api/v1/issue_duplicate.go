@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// findPossibleDuplicateIssues finds the open issues in the same project whose plans change
+// any of the same target databases with an overlapping statement fingerprint.
+func (s *IssueService) findPossibleDuplicateIssues(ctx context.Context, issue *store.IssueMessage, plan *store.PlanMessage) ([]string, error) {
+	fingerprints, err := s.getPlanStatementFingerprints(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	if len(fingerprints) == 0 {
+		return nil, nil
+	}
+
+	issues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		ProjectID:  &issue.Project.ResourceID,
+		StatusList: []api.IssueStatus{api.IssueOpen},
+		Types:      &[]api.IssueType{api.IssueDatabaseGeneral},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list issues")
+	}
+	var duplicates []string
+	for _, other := range issues {
+		if other.UID == issue.UID || other.PlanUID == nil {
+			continue
+		}
+		otherPlan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: other.PlanUID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get plan %d", *other.PlanUID)
+		}
+		if otherPlan == nil {
+			continue
+		}
+		otherFingerprints, err := s.getPlanStatementFingerprints(ctx, otherPlan)
+		if err != nil {
+			return nil, err
+		}
+		if hasOverlappingFingerprints(fingerprints, otherFingerprints) {
+			duplicates = append(duplicates, fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, other.Project.ResourceID, common.IssueNamePrefix, other.UID))
+		}
+	}
+	return duplicates, nil
+}
+
+// getPlanStatementFingerprints returns the statement fingerprints of the plan keyed by the target database.
+// Database group targets are skipped because the target databases are resolved at rollout.
+func (s *IssueService) getPlanStatementFingerprints(ctx context.Context, plan *store.PlanMessage) (map[string]map[string]bool, error) {
+	fingerprints := make(map[string]map[string]bool)
+	for _, step := range plan.Config.GetSteps() {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				continue
+			}
+			instanceID, databaseName, err := common.GetInstanceDatabaseID(config.Target)
+			if err != nil {
+				continue
+			}
+			_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+			if err != nil {
+				continue
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+			}
+			if instance == nil {
+				continue
+			}
+			statement, err := s.store.GetSheetStatementByID(ctx, sheetUID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+			}
+			target := common.FormatDatabase(instanceID, databaseName)
+			if fingerprints[target] == nil {
+				fingerprints[target] = make(map[string]bool)
+			}
+			for _, fingerprint := range getStatementFingerprints(instance.Engine, statement) {
+				fingerprints[target][fingerprint] = true
+			}
+		}
+	}
+	return fingerprints, nil
+}
+
+// getStatementFingerprints returns the fingerprint of each statement, so that statements
+// differing only in literals and whitespaces share the same fingerprint.
+func getStatementFingerprints(engine storepb.Engine, statement string) []string {
+	var texts []string
+	if list, err := base.SplitMultiSQL(engine, statement); err == nil {
+		for _, sql := range list {
+			if !sql.Empty {
+				texts = append(texts, sql.Text)
+			}
+		}
+	} else {
+		texts = append(texts, statement)
+	}
+
+	var fingerprints []string
+	for _, text := range texts {
+		fingerprint := ""
+		switch engine {
+		case storepb.Engine_POSTGRES:
+			fingerprint = pgparser.GetFingerprint(text)
+		case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+			if f, err := mysqlparser.GetFingerprint(text); err == nil {
+				fingerprint = f
+			}
+		}
+		if fingerprint == "" {
+			fingerprint = strings.ToLower(strings.Join(strings.Fields(strings.TrimSuffix(strings.TrimSpace(text), ";")), " "))
+		}
+		if fingerprint != "" {
+			fingerprints = append(fingerprints, fingerprint)
+		}
+	}
+	return fingerprints
+}
+
+func hasOverlappingFingerprints(a, b map[string]map[string]bool) bool {
+	for target, fingerprints := range a {
+		for fingerprint := range fingerprints {
+			if b[target][fingerprint] {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetStatementFingerprints(t *testing.T) {
+	a := require.New(t)
+	toSet := func(fingerprints []string) map[string]bool {
+		m := make(map[string]bool)
+		for _, fingerprint := range fingerprints {
+			m[fingerprint] = true
+		}
+		return m
+	}
+
+	a.Equal(
+		toSet(getStatementFingerprints(storepb.Engine_SNOWFLAKE, "ALTER TABLE t ADD COLUMN c INT;")),
+		toSet(getStatementFingerprints(storepb.Engine_SNOWFLAKE, "alter  table t\nadd column c int")),
+	)
+
+	a.True(hasOverlappingFingerprints(
+		map[string]map[string]bool{"instances/i/databases/db": {"alter table t add column c int": true}},
+		map[string]map[string]bool{"instances/i/databases/db": {"alter table t add column c int": true, "drop table x": true}},
+	))
+	a.False(hasOverlappingFingerprints(
+		map[string]map[string]bool{"instances/i/databases/db": {"alter table t add column c int": true}},
+		map[string]map[string]bool{"instances/i/databases/other": {"alter table t add column c int": true}},
+	))
+}
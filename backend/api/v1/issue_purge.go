@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// PurgeIssues hard deletes the done and canceled issues created before the time.
+func (s *IssueService) PurgeIssues(ctx context.Context, request *v1pb.PurgeIssuesRequest) (*v1pb.PurgeIssuesResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionIssuesPurge, user)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "user does not have permission %q", iam.PermissionIssuesPurge)
+	}
+	projectID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if request.CreateTimeBefore == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "create_time_before must be set")
+	}
+	if err := request.CreateTimeBefore.CheckValid(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid create_time_before: %v", err)
+	}
+
+	find := &store.FindIssuesToPurgeMessage{
+		CreatedBefore: request.CreateTimeBefore.AsTime().Unix(),
+	}
+	if projectID != "-" {
+		find.ProjectID = &projectID
+	}
+	issues, err := s.store.ListIssueUIDsToPurge(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issues to purge, error: %v", err)
+	}
+	var issueUIDs []int
+	var issueNames []string
+	for _, issue := range issues {
+		issueUIDs = append(issueUIDs, issue.UID)
+		issueNames = append(issueNames, common.FormatIssue(issue.ProjectID, issue.UID))
+	}
+
+	result, err := s.store.PurgeIssues(ctx, issueUIDs, request.DryRun)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge issues, error: %v", err)
+	}
+	return &v1pb.PurgeIssuesResponse{
+		Issues:       issueNames,
+		IssueCount:   result.IssueCount,
+		CommentCount: result.CommentCount,
+		TaskRunCount: result.TaskRunCount,
+		SheetCount:   result.SheetCount,
+	}, nil
+}
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/multierr"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -23,7 +24,7 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	metricapi "github.com/bytebase/bytebase/backend/metric"
-	relayplugin "github.com/bytebase/bytebase/backend/plugin/app/relay"
+	approvalplugin "github.com/bytebase/bytebase/backend/plugin/app/approval"
 	"github.com/bytebase/bytebase/backend/plugin/metric"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/relay"
@@ -75,41 +76,36 @@ func (s *IssueService) GetIssue(ctx context.Context, request *v1pb.GetIssueReque
 	if err != nil {
 		return nil, err
 	}
+	externalApprovalType := api.ExternalApprovalTypeRelay
+	approvals, err := s.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		Type:     &externalApprovalType,
+		IssueUID: &issue.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list external approvals, error: %v", err)
+	}
 	if request.Force {
-		externalApprovalType := api.ExternalApprovalTypeRelay
-		approvals, err := s.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
-			Type:     &externalApprovalType,
-			IssueUID: &issue.UID,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list external approvals, error: %v", err)
-		}
-		var errs error
-		for _, approval := range approvals {
-			msg := relay.CheckExternalApprovalChanMessage{
-				ExternalApproval: approval,
-				ErrChan:          make(chan error, 1),
-			}
-			s.relayRunner.CheckExternalApprovalChan <- msg
-			err := <-msg.ErrChan
-			if err != nil {
-				err = errors.Wrapf(err, "failed to check external approval status, issueUID %d", approval.IssueUID)
-				errs = multierr.Append(errs, err)
-			}
-		}
-		if errs != nil {
-			return nil, status.Errorf(codes.Internal, "failed to check external approval status, error: %v", errs)
-		}
-		issue, err = s.getIssueMessage(ctx, request.Name)
-		if err != nil {
-			return nil, err
-		}
+		// Don't block the read on the external systems, the results are reported in the external approvals.
+		s.relayRunner.RefreshExternalApprovals(approvals)
 	}
 
 	issueV1, err := convertToIssue(ctx, s.store, issue)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
 	}
+	for _, approval := range approvals {
+		payload := &storepb.ExternalApprovalPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(approval.Payload), payload); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal external approval payload, error: %v", err)
+		}
+		issueV1.ExternalApprovals = append(issueV1.ExternalApprovals, &v1pb.Issue_ExternalApproval{
+			NodeId:         payload.ExternalApprovalNodeId,
+			LastCheckTime:  payload.LastCheckTime,
+			LastCheckError: payload.LastCheckError,
+			Stale:          relay.IsStale(payload),
+		})
+	}
+	issueV1.RenderedDescription = s.renderIssueDescription(ctx, issue)
 	return issueV1, nil
 }
 
@@ -206,6 +202,14 @@ func (s *IssueService) getIssueFind(ctx context.Context, filter string, query st
 				issueFind.TaskTypes = &[]api.TaskType{
 					api.TaskDatabaseDataExport,
 				}
+			case "RESTORE":
+				issueFind.TaskTypes = &[]api.TaskType{
+					api.TaskDatabaseRestore,
+				}
+			case "DATA_LOAD":
+				issueFind.TaskTypes = &[]api.TaskType{
+					api.TaskDatabaseDataLoad,
+				}
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, `unknown value %q`, spec.value)
 			}
@@ -256,6 +260,16 @@ func (s *IssueService) getIssueFind(ctx context.Context, filter string, query st
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid value %q for has_pipeline", spec.value))
 			}
+		case "release":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "%s" filter`, spec.key)
+			}
+			projectID, releaseID, err := common.GetProjectIDReleaseID(spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			issueFind.ProjectID = &projectID
+			issueFind.ReleaseID = &releaseID
 		}
 	}
 
@@ -278,7 +292,24 @@ func (s *IssueService) ListIssues(ctx context.Context, request *v1pb.ListIssuesR
 	}
 	limitPlusOne := limit + 1
 
-	issueFind, err := s.getIssueFind(ctx, request.Filter, request.Query, &limitPlusOne, &offset)
+	filter := request.Filter
+	if request.SavedSearch != "" {
+		user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+		if !ok {
+			return nil, status.Errorf(codes.Internal, "user not found")
+		}
+		savedSearch, err := getSavedSearch(ctx, s.store, s.iamManager, user, request.SavedSearch)
+		if err != nil {
+			return nil, err
+		}
+		if filter == "" {
+			filter = savedSearch.Filter
+		} else {
+			filter = fmt.Sprintf("%s && %s", savedSearch.Filter, filter)
+		}
+	}
+
+	issueFind, err := s.getIssueFind(ctx, filter, request.Query, &limitPlusOne, &offset)
 	if err != nil {
 		return nil, err
 	}
@@ -398,7 +429,13 @@ func (s *IssueService) CreateIssue(ctx context.Context, request *v1pb.CreateIssu
 	case v1pb.Issue_DATABASE_CHANGE:
 		return s.createIssueDatabaseChange(ctx, request)
 	case v1pb.Issue_DATABASE_DATA_EXPORT:
-		return s.createIssueDatabaseDataExport(ctx, request)
+		return s.createIssueFromPlan(ctx, request, api.IssueDatabaseDataExport)
+	case v1pb.Issue_DATABASE_RESTORE:
+		return s.createIssueFromPlan(ctx, request, api.IssueDatabaseRestore)
+	case v1pb.Issue_DATABASE_DATA_LOAD:
+		return s.createIssueFromPlan(ctx, request, api.IssueDatabaseDataLoad)
+	case v1pb.Issue_DATABASE_CREATE:
+		return s.createIssueFromPlan(ctx, request, api.IssueDatabaseCreate)
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "unknown issue type %q", request.Issue.Type)
 	}
@@ -422,6 +459,9 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 	if project == nil {
 		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
 	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create issues in the archived project %q", projectID)
+	}
 
 	if request.Issue.Plan == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "plan is required")
@@ -456,6 +496,11 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 		rolloutUID = &pipeline.ID
 	}
 
+	releaseID, err := s.getIssueReleaseID(ctx, project.ResourceID, request.Issue.Release)
+	if err != nil {
+		return nil, err
+	}
+
 	issueCreateMessage := &store.IssueMessage{
 		Project:     project,
 		PlanUID:     planUID,
@@ -472,7 +517,9 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 			ApprovalTemplates:   nil,
 			Approvers:           nil,
 		},
-		Labels: request.Issue.Labels,
+		Labels:   request.Issue.Labels,
+		Release:  releaseID,
+		Priority: convertIssuePriority(request.Issue.Priority),
 	}
 
 	issue, err := s.store.CreateIssueV2(ctx, issueCreateMessage, user.ID)
@@ -493,6 +540,11 @@ func (s *IssueService) createIssueDatabaseChange(ctx context.Context, request *v
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert to issue, error: %v", err)
 	}
+	possibleDuplicates, err := s.findPossibleDuplicateIssues(ctx, issue, plan)
+	if err != nil {
+		slog.Warn("failed to find possible duplicate issues", slog.Int("issue", issue.UID), log.BBError(err))
+	}
+	converted.PossibleDuplicates = possibleDuplicates
 
 	return converted, nil
 }
@@ -515,6 +567,9 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 	if project == nil {
 		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
 	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create issues in the archived project %q", projectID)
+	}
 
 	if request.Issue.GrantRequest.GetRole() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "expect grant request role")
@@ -533,6 +588,11 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 		}
 	}
 
+	releaseID, err := s.getIssueReleaseID(ctx, project.ResourceID, request.Issue.Release)
+	if err != nil {
+		return nil, err
+	}
+
 	issueCreateMessage := &store.IssueMessage{
 		Project:     project,
 		PlanUID:     nil,
@@ -555,7 +615,8 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 			ApprovalTemplates:   nil,
 			Approvers:           nil,
 		},
-		Labels: request.Issue.Labels,
+		Labels:  request.Issue.Labels,
+		Release: releaseID,
 	}
 
 	issue, err := s.store.CreateIssueV2(ctx, issueCreateMessage, user.ID)
@@ -588,7 +649,8 @@ func (s *IssueService) createIssueGrantRequest(ctx context.Context, request *v1p
 	return converted, nil
 }
 
-func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, request *v1pb.CreateIssueRequest) (*v1pb.Issue, error) {
+// createIssueFromPlan creates the data export, database restore or database create issue whose tasks are all from the plan.
+func (s *IssueService) createIssueFromPlan(ctx context.Context, request *v1pb.CreateIssueRequest, issueType api.IssueType) (*v1pb.Issue, error) {
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
 	if !ok {
 		return nil, status.Errorf(codes.Internal, "user not found")
@@ -606,6 +668,9 @@ func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, reques
 	if project == nil {
 		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
 	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot create issues in the archived project %q", projectID)
+	}
 
 	if request.Issue.Plan == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "plan is required")
@@ -623,6 +688,33 @@ func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, reques
 	if plan == nil {
 		return nil, status.Errorf(codes.NotFound, "plan not found for id: %d", planID)
 	}
+	if issueType == api.IssueDatabaseRestore {
+		for _, step := range plan.Config.GetSteps() {
+			for _, spec := range step.GetSpecs() {
+				if spec.GetRestoreDatabaseConfig() == nil {
+					return nil, status.Errorf(codes.InvalidArgument, "the database restore issue only supports the restore database config, got spec %q", spec.Id)
+				}
+			}
+		}
+	}
+	if issueType == api.IssueDatabaseDataLoad {
+		for _, step := range plan.Config.GetSteps() {
+			for _, spec := range step.GetSpecs() {
+				if spec.GetLoadDataConfig() == nil {
+					return nil, status.Errorf(codes.InvalidArgument, "the data load issue only supports the load data config, got spec %q", spec.Id)
+				}
+			}
+		}
+	}
+	if issueType == api.IssueDatabaseCreate {
+		for _, step := range plan.Config.GetSteps() {
+			for _, spec := range step.GetSpecs() {
+				if spec.GetCreateDatabaseConfig() == nil {
+					return nil, status.Errorf(codes.InvalidArgument, "the database create issue only supports the create database config, got spec %q", spec.Id)
+				}
+			}
+		}
+	}
 	planUID = &plan.UID
 	var rolloutUID *int
 	if request.Issue.Rollout != "" {
@@ -640,13 +732,18 @@ func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, reques
 		rolloutUID = &pipeline.ID
 	}
 
+	releaseID, err := s.getIssueReleaseID(ctx, project.ResourceID, request.Issue.Release)
+	if err != nil {
+		return nil, err
+	}
+
 	issueCreateMessage := &store.IssueMessage{
 		Project:     project,
 		PlanUID:     planUID,
 		PipelineUID: rolloutUID,
 		Title:       request.Issue.Title,
 		Status:      api.IssueOpen,
-		Type:        api.IssueDatabaseDataExport,
+		Type:        issueType,
 		Description: request.Issue.Description,
 	}
 
@@ -656,7 +753,9 @@ func (s *IssueService) createIssueDatabaseDataExport(ctx context.Context, reques
 			ApprovalTemplates:   nil,
 			Approvers:           nil,
 		},
-		Labels: request.Issue.Labels,
+		Labels:   request.Issue.Labels,
+		Release:  releaseID,
+		Priority: convertIssuePriority(request.Issue.Priority),
 	}
 
 	issue, err := s.store.CreateIssueV2(ctx, issueCreateMessage, user.ID)
@@ -714,7 +813,7 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		node := step.Nodes[0]
 		_, ok := node.Payload.(*storepb.ApprovalNode_ExternalNodeId)
 		if ok {
-			return s.updateExternalApprovalWithStatus(ctx, issue, relayplugin.StatusApproved)
+			return s.updateExternalApprovalWithStatus(ctx, issue, approvalplugin.StatusApproved)
 		}
 	}
 
@@ -755,7 +854,7 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 		return nil, status.Errorf(codes.Internal, "failed to check if the approval is approved, error: %v", err)
 	}
 
-	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
+	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, s.store, issue, payload.Approval)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to handle incoming approval steps, error: %v", err)
 	}
@@ -773,7 +872,7 @@ func (s *IssueService) ApproveIssue(ctx context.Context, request *v1pb.ApproveIs
 	// Grant the privilege if the issue is approved.
 	if approved && issue.Type == api.IssueGrantRequest {
 		if err := utils.UpdateProjectPolicyFromGrantIssue(ctx, s.store, issue, payload.GrantRequest); err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.Internal, "failed to grant the privilege of the issue, error: %v", err)
 		}
 		// TODO(p0ny): Post project IAM policy update activity.
 	}
@@ -934,7 +1033,7 @@ func (s *IssueService) RejectIssue(ctx context.Context, request *v1pb.RejectIssu
 		node := step.Nodes[0]
 		_, ok := node.Payload.(*storepb.ApprovalNode_ExternalNodeId)
 		if ok {
-			return s.updateExternalApprovalWithStatus(ctx, issue, relayplugin.StatusRejected)
+			return s.updateExternalApprovalWithStatus(ctx, issue, approvalplugin.StatusRejected)
 		}
 	}
 
@@ -1051,7 +1150,7 @@ func (s *IssueService) RequestIssue(ctx context.Context, request *v1pb.RequestIs
 	}
 	payload.Approval.Approvers = newApprovers
 
-	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, s.store, s.relayRunner.Client, issue, payload.Approval)
+	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, s.store, issue, payload.Approval)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to handle incoming approval steps, error: %v", err)
 	}
@@ -1211,7 +1310,23 @@ func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssu
 
 		case "subscribers":
 			var subscribers []*store.UserMessage
+			var subscriberGroups []string
 			for _, subscriber := range request.Issue.Subscribers {
+				if strings.HasPrefix(subscriber, common.GroupPrefix) {
+					groupEmail, err := common.GetGroupEmail(subscriber)
+					if err != nil {
+						return nil, status.Errorf(codes.InvalidArgument, "failed to get group email from %v, error: %v", subscriber, err)
+					}
+					group, err := s.store.GetGroup(ctx, groupEmail)
+					if err != nil {
+						return nil, status.Errorf(codes.Internal, "failed to get group %v, error: %v", groupEmail, err)
+					}
+					if group == nil {
+						return nil, status.Errorf(codes.NotFound, "group %v not found", subscriber)
+					}
+					subscriberGroups = append(subscriberGroups, subscriber)
+					continue
+				}
 				subscriberEmail, err := common.GetUserEmail(subscriber)
 				if err != nil {
 					return nil, status.Errorf(codes.InvalidArgument, "failed to get user email from %v, error: %v", subscriber, err)
@@ -1226,6 +1341,14 @@ func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssu
 				subscribers = append(subscribers, user)
 			}
 			patch.Subscribers = &subscribers
+			if len(subscriberGroups) == 0 {
+				patch.RemoveSubscriberGroups = true
+			} else {
+				if patch.PayloadUpsert == nil {
+					patch.PayloadUpsert = &storepb.IssuePayload{}
+				}
+				patch.PayloadUpsert.SubscriberGroups = subscriberGroups
+			}
 
 		case "labels":
 			if len(request.Issue.Labels) == 0 {
@@ -1248,6 +1371,26 @@ func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssu
 					},
 				},
 			})
+
+		case "release":
+			releaseID, err := s.getIssueReleaseID(ctx, issue.Project.ResourceID, request.Issue.Release)
+			if err != nil {
+				return nil, err
+			}
+			if releaseID == "" {
+				patch.RemoveRelease = true
+			} else {
+				if patch.PayloadUpsert == nil {
+					patch.PayloadUpsert = &storepb.IssuePayload{}
+				}
+				patch.PayloadUpsert.Release = releaseID
+			}
+
+		case "priority":
+			if patch.PayloadUpsert == nil {
+				patch.PayloadUpsert = &storepb.IssuePayload{}
+			}
+			patch.PayloadUpsert.Priority = convertIssuePriority(request.Issue.Priority)
 		}
 	}
 
@@ -1277,6 +1420,36 @@ func (s *IssueService) UpdateIssue(ctx context.Context, request *v1pb.UpdateIssu
 }
 
 // BatchUpdateIssuesStatus batch updates issues status.
+// RefreshExternalApprovals queues the pending external approvals of the issues in the project to be checked in the background.
+func (s *IssueService) RefreshExternalApprovals(ctx context.Context, request *v1pb.RefreshExternalApprovalsRequest) (*v1pb.RefreshExternalApprovalsResponse, error) {
+	projectID, err := common.GetProjectID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
+	}
+
+	externalApprovalType := api.ExternalApprovalTypeRelay
+	approvals, err := s.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		Type:       &externalApprovalType,
+		ProjectUID: &project.UID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list external approvals, error: %v", err)
+	}
+	queued := s.relayRunner.RefreshExternalApprovals(approvals)
+	return &v1pb.RefreshExternalApprovalsResponse{
+		QueuedCount: int32(queued),
+	}, nil
+}
+
 func (s *IssueService) BatchUpdateIssuesStatus(ctx context.Context, request *v1pb.BatchUpdateIssuesStatusRequest) (*v1pb.BatchUpdateIssuesStatusResponse, error) {
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
 	if !ok {
@@ -1368,23 +1541,25 @@ func (s *IssueService) BatchUpdateIssuesStatus(ctx context.Context, request *v1p
 	return &v1pb.BatchUpdateIssuesStatusResponse{}, nil
 }
 
+// maxIssueCommentPageSize is the maximum page size of listing issue comments.
+const maxIssueCommentPageSize = 1000
+
 func (s *IssueService) ListIssueComments(ctx context.Context, request *v1pb.ListIssueCommentsRequest) (*v1pb.ListIssueCommentsResponse, error) {
 	if request.PageSize < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("page size must be non-negative: %d", request.PageSize))
 	}
-	_, issueUID, err := common.GetProjectIDIssueUID(request.Parent)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
-	}
-	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	issue, err := s.getIssueMessage(ctx, request.Parent)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get issue, err: %v", err)
+		return nil, err
 	}
 
 	limit, offset, err := parseLimitAndOffset(request.PageToken, int(request.PageSize))
 	if err != nil {
 		return nil, err
 	}
+	if limit > maxIssueCommentPageSize {
+		limit = maxIssueCommentPageSize
+	}
 	limitPlusOne := limit + 1
 
 	issueComments, err := s.store.ListIssueComment(ctx, &store.FindIssueCommentMessage{
@@ -1413,7 +1588,7 @@ func (s *IssueService) ListIssueComments(ctx context.Context, request *v1pb.List
 
 // CreateIssueComment creates the issue comment.
 func (s *IssueService) CreateIssueComment(ctx context.Context, request *v1pb.CreateIssueCommentRequest) (*v1pb.IssueComment, error) {
-	if request.IssueComment.Comment == "" {
+	if request.GetIssueComment().GetComment() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "issue comment is empty")
 	}
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
@@ -1457,7 +1632,7 @@ func (s *IssueService) CreateIssueComment(ctx context.Context, request *v1pb.Cre
 		if _, err := s.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
 			Subscribers: &issue.Subscribers,
 		}, user.ID); err != nil {
-			return nil, err
+			return nil, status.Errorf(codes.Internal, "failed to update issue subscribers: %v", err)
 		}
 	}
 
@@ -1466,9 +1641,12 @@ func (s *IssueService) CreateIssueComment(ctx context.Context, request *v1pb.Cre
 
 // UpdateIssueComment updates the issue comment.
 func (s *IssueService) UpdateIssueComment(ctx context.Context, request *v1pb.UpdateIssueCommentRequest) (*v1pb.IssueComment, error) {
-	if request.UpdateMask.Paths == nil {
+	if len(request.GetUpdateMask().GetPaths()) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
 	}
+	if request.IssueComment == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "issue comment is required")
+	}
 
 	issueCommentUID, err := strconv.Atoi(request.IssueComment.Uid)
 	if err != nil {
@@ -1478,8 +1656,15 @@ func (s *IssueService) UpdateIssueComment(ctx context.Context, request *v1pb.Upd
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get issue comment: %v", err)
 	}
-	if issueComment == nil {
-		return nil, status.Errorf(codes.NotFound, "issue comment not found")
+	issue, err := s.getIssueMessage(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if issueComment == nil || issueComment.IssueUID != issue.UID {
+		return nil, status.Errorf(codes.NotFound, "issue comment %q not found", request.IssueComment.Uid)
+	}
+	if issueComment.Deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "issue comment %q is deleted", request.IssueComment.Uid)
 	}
 
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
@@ -1513,10 +1698,79 @@ func (s *IssueService) UpdateIssueComment(ctx context.Context, request *v1pb.Upd
 	return convertToIssueComment(request.Parent, issueComment), nil
 }
 
+// DeleteIssueComment soft deletes the issue comment.
+func (s *IssueService) DeleteIssueComment(ctx context.Context, request *v1pb.DeleteIssueCommentRequest) (*emptypb.Empty, error) {
+	issueName, issueCommentUID, err := common.GetIssueNameIssueCommentUID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	issueComment, err := s.getIssueCommentMessage(ctx, issueName, issueCommentUID)
+	if err != nil {
+		return nil, err
+	}
+	if issueComment.Deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "issue comment %q is already deleted", request.Name)
+	}
+	if issueComment.Payload.Event != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete the event comment %q", request.Name)
+	}
+
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	if issueComment.Creator.ID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "only the creator can delete the issue comment")
+	}
+
+	if err := s.store.DeleteIssueComment(ctx, issueCommentUID, user.ID); err != nil {
+		if common.ErrorCode(err) == common.NotFound {
+			return nil, status.Errorf(codes.NotFound, "issue comment %q not found", request.Name)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete issue comment, error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ListIssueCommentRevisions lists the revisions of the issue comment.
+func (s *IssueService) ListIssueCommentRevisions(ctx context.Context, request *v1pb.ListIssueCommentRevisionsRequest) (*v1pb.ListIssueCommentRevisionsResponse, error) {
+	issueName, issueCommentUID, err := common.GetIssueNameIssueCommentUID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.getIssueCommentMessage(ctx, issueName, issueCommentUID); err != nil {
+		return nil, err
+	}
+	revisions, err := s.store.ListIssueCommentRevisions(ctx, issueCommentUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issue comment revisions, error: %v", err)
+	}
+	return &v1pb.ListIssueCommentRevisionsResponse{
+		Revisions: convertToIssueCommentRevisions(revisions),
+	}, nil
+}
+
+// getIssueCommentMessage gets the issue comment of the issue.
+func (s *IssueService) getIssueCommentMessage(ctx context.Context, issueName string, issueCommentUID int) (*store.IssueCommentMessage, error) {
+	issue, err := s.getIssueMessage(ctx, issueName)
+	if err != nil {
+		return nil, err
+	}
+	issueComment, err := s.store.GetIssueComment(ctx, &store.FindIssueCommentMessage{UID: &issueCommentUID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get issue comment, error: %v", err)
+	}
+	if issueComment == nil || issueComment.IssueUID != issue.UID {
+		return nil, status.Errorf(codes.NotFound, "issue comment %d not found in issue %q", issueCommentUID, issueName)
+	}
+	return issueComment, nil
+}
+
+// getIssueMessage gets the issue by the name identified by either the issue uid or the issue key.
 func (s *IssueService) getIssueMessage(ctx context.Context, name string) (*store.IssueMessage, error) {
 	issueID, err := common.GetIssueID(name)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return s.getIssueMessageByKey(ctx, name)
 	}
 	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueID})
 	if err != nil {
@@ -1528,12 +1782,34 @@ func (s *IssueService) getIssueMessage(ctx context.Context, name string) (*store
 	return issue, nil
 }
 
-func (s *IssueService) updateExternalApprovalWithStatus(ctx context.Context, issue *store.IssueMessage, approvalStatus relayplugin.Status) (*v1pb.Issue, error) {
+func (s *IssueService) getIssueMessageByKey(ctx context.Context, name string) (*store.IssueMessage, error) {
+	projectID, projectKey, number, err := common.GetProjectIDIssueKey(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil || !strings.EqualFold(project.Key, projectKey) {
+		return nil, status.Errorf(codes.NotFound, "issue %q not found", name)
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{ProjectID: &project.ResourceID, Number: &number})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get issue, error: %v", err)
+	}
+	if issue == nil {
+		return nil, status.Errorf(codes.NotFound, "issue %q not found", name)
+	}
+	return issue, nil
+}
+
+func (s *IssueService) updateExternalApprovalWithStatus(ctx context.Context, issue *store.IssueMessage, approvalStatus approvalplugin.Status) (*v1pb.Issue, error) {
 	approval, err := s.store.GetExternalApprovalByIssueIDV2(ctx, issue.UID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get external approval for issue %v, error: %v", issue.UID, err)
 	}
-	if approvalStatus == relayplugin.StatusApproved {
+	if approvalStatus == approvalplugin.StatusApproved {
 		if err := s.relayRunner.ApproveExternalApprovalNode(ctx, issue.UID); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to approve external node, error: %v", err)
 		}
@@ -1595,7 +1871,31 @@ func isUserReviewer(ctx context.Context, stores *store.Store, step *storepb.Appr
 		return roles[val.Role], nil
 	case *storepb.ApprovalNode_ExternalNodeId:
 		return true, nil
+	case *storepb.ApprovalNode_Group:
+		return utils.IsUserInGroup(ctx, stores, user, val.Group)
 	default:
 		return false, errors.Errorf("invalid node payload type")
 	}
 }
+
+// getIssueReleaseID returns the release resource ID of the release name, and validates that the release exists in the project.
+func (s *IssueService) getIssueReleaseID(ctx context.Context, projectID string, name string) (string, error) {
+	if name == "" {
+		return "", nil
+	}
+	releaseProjectID, releaseID, err := common.GetProjectIDReleaseID(name)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if releaseProjectID != projectID {
+		return "", status.Errorf(codes.InvalidArgument, "release %q is not in project %q", name, projectID)
+	}
+	release, err := s.store.GetRelease(ctx, &store.FindReleaseMessage{ProjectID: &projectID, ResourceID: &releaseID})
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get release, error: %v", err)
+	}
+	if release == nil {
+		return "", status.Errorf(codes.NotFound, "release %q not found", name)
+	}
+	return releaseID, nil
+}
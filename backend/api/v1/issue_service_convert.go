@@ -41,6 +41,11 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 		return nil, errors.Wrapf(err, "failed to get issue releasers")
 	}
 
+	conflicts, err := convertToIssueConflicts(ctx, s, issue)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get issue conflicts")
+	}
+
 	issueV1 := &v1pb.Issue{
 		Name:                 fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.IssueNamePrefix, issue.UID),
 		Uid:                  fmt.Sprintf("%d", issue.UID),
@@ -63,6 +68,18 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 		RiskLevel:            v1pb.Issue_RISK_LEVEL_UNSPECIFIED,
 		TaskStatusCount:      issue.TaskStatusCount,
 		Labels:               issuePayload.Labels,
+		PromotedFromIssue:    issuePayload.PromotedFromIssue,
+		PromotedToIssues:     issuePayload.PromotedToIssues,
+		Conflicts:            conflicts,
+		Key:                  common.FormatIssueKey(issue.Project.Key, issue.Number),
+		Priority:             convertToIssuePriority(issuePayload.Priority),
+	}
+	if externalTicket := issuePayload.GetExternalTicket(); externalTicket != nil {
+		issueV1.ExternalTicket = &v1pb.Issue_ExternalTicket{
+			Key:    externalTicket.Key,
+			Url:    externalTicket.Url,
+			Status: externalTicket.Status,
+		}
 	}
 
 	if issue.PlanUID != nil {
@@ -71,10 +88,14 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 	if issue.PipelineUID != nil {
 		issueV1.Rollout = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.RolloutPrefix, *issue.PipelineUID)
 	}
+	if issuePayload.Release != "" {
+		issueV1.Release = fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, issue.Project.ResourceID, common.ReleasesPrefix, issuePayload.Release)
+	}
 
 	for _, subscriber := range issue.Subscribers {
 		issueV1.Subscribers = append(issueV1.Subscribers, common.FormatUserEmail(subscriber.Email))
 	}
+	issueV1.Subscribers = append(issueV1.Subscribers, issue.Payload.GetSubscriberGroups()...)
 
 	if issuePayload.Approval != nil {
 		issueV1.ApprovalFindingDone = issuePayload.Approval.ApprovalFindingDone
@@ -97,6 +118,40 @@ func convertToIssue(ctx context.Context, s *store.Store, issue *store.IssueMessa
 	return issueV1, nil
 }
 
+// convertToIssueConflicts collects the conflicting issues from the latest statement conflict plan checks of the open issue.
+func convertToIssueConflicts(ctx context.Context, s *store.Store, issue *store.IssueMessage) ([]string, error) {
+	if issue.Type != api.IssueDatabaseGeneral {
+		return nil, nil
+	}
+	if issue.Status != api.IssueOpen {
+		return nil, nil
+	}
+	if issue.PlanUID == nil {
+		return nil, nil
+	}
+	planCheckRuns, err := s.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		PlanUID:    issue.PlanUID,
+		Type:       &[]store.PlanCheckRunType{store.PlanCheckDatabaseStatementConflict},
+		LatestOnly: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plan check runs")
+	}
+	var conflicts []string
+	seen := make(map[string]bool)
+	for _, run := range planCheckRuns {
+		for _, result := range run.Result.GetResults() {
+			conflictIssue := result.GetConflictReport().GetIssue()
+			if conflictIssue == "" || seen[conflictIssue] {
+				continue
+			}
+			seen[conflictIssue] = true
+			conflicts = append(conflicts, conflictIssue)
+		}
+	}
+	return conflicts, nil
+}
+
 func convertToIssueReleasers(ctx context.Context, s *store.Store, issue *store.IssueMessage) ([]string, error) {
 	if issue.Type != api.IssueDatabaseGeneral {
 		return nil, nil
@@ -134,6 +189,7 @@ func convertToIssueReleasers(ctx context.Context, s *store.Store, issue *store.I
 
 	releasers = append(releasers, policy.WorkspaceRoles...)
 	releasers = append(releasers, policy.ProjectRoles...)
+	releasers = append(releasers, policy.Groups...)
 
 	for _, role := range policy.IssueRoles {
 		switch role {
@@ -163,6 +219,12 @@ func convertToIssueType(t api.IssueType) v1pb.Issue_Type {
 		return v1pb.Issue_GRANT_REQUEST
 	case api.IssueDatabaseDataExport:
 		return v1pb.Issue_DATABASE_DATA_EXPORT
+	case api.IssueDatabaseRestore:
+		return v1pb.Issue_DATABASE_RESTORE
+	case api.IssueDatabaseDataLoad:
+		return v1pb.Issue_DATABASE_DATA_LOAD
+	case api.IssueDatabaseCreate:
+		return v1pb.Issue_DATABASE_CREATE
 	default:
 		return v1pb.Issue_TYPE_UNSPECIFIED
 	}
@@ -176,6 +238,12 @@ func convertToAPIIssueType(t v1pb.Issue_Type) (api.IssueType, error) {
 		return api.IssueGrantRequest, nil
 	case v1pb.Issue_DATABASE_DATA_EXPORT:
 		return api.IssueDatabaseDataExport, nil
+	case v1pb.Issue_DATABASE_RESTORE:
+		return api.IssueDatabaseRestore, nil
+	case v1pb.Issue_DATABASE_DATA_LOAD:
+		return api.IssueDatabaseDataLoad, nil
+	case v1pb.Issue_DATABASE_CREATE:
+		return api.IssueDatabaseCreate, nil
 	default:
 		return api.IssueType(""), errors.Errorf("invalid issue type %v", t)
 	}
@@ -222,6 +290,28 @@ func convertToIssueRiskLevel(riskLevel storepb.IssuePayloadApproval_RiskLevel) v
 	}
 }
 
+func convertToIssuePriority(priority storepb.IssuePayload_Priority) v1pb.Issue_Priority {
+	switch priority {
+	case storepb.IssuePayload_PRIORITY_LOW:
+		return v1pb.Issue_PRIORITY_LOW
+	case storepb.IssuePayload_PRIORITY_HIGH:
+		return v1pb.Issue_PRIORITY_HIGH
+	default:
+		return v1pb.Issue_PRIORITY_NORMAL
+	}
+}
+
+func convertIssuePriority(priority v1pb.Issue_Priority) storepb.IssuePayload_Priority {
+	switch priority {
+	case v1pb.Issue_PRIORITY_LOW:
+		return storepb.IssuePayload_PRIORITY_LOW
+	case v1pb.Issue_PRIORITY_HIGH:
+		return storepb.IssuePayload_PRIORITY_HIGH
+	default:
+		return storepb.IssuePayload_PRIORITY_NORMAL
+	}
+}
+
 func convertToApprovalTemplate(template *storepb.ApprovalTemplate) *v1pb.ApprovalTemplate {
 	return &v1pb.ApprovalTemplate{
 		Flow:        convertToApprovalFlow(template.Flow),
@@ -264,6 +354,10 @@ func convertToApprovalNode(node *storepb.ApprovalNode) *v1pb.ApprovalNode {
 		v1node.Payload = &v1pb.ApprovalNode_ExternalNodeId{
 			ExternalNodeId: payload.ExternalNodeId,
 		}
+	case *storepb.ApprovalNode_Group:
+		v1node.Payload = &v1pb.ApprovalNode_Group{
+			Group: payload.Group,
+		}
 	}
 	return v1node
 }
@@ -347,6 +441,8 @@ func convertToIssueComment(issueName string, ic *store.IssueCommentMessage) *v1p
 		UpdateTime: timestamppb.New(time.Unix(ic.UpdatedTs, 0)),
 		Name:       fmt.Sprintf("%s/%s%d", issueName, common.IssueCommentNamePrefix, ic.UID),
 		Creator:    common.FormatUserEmail(ic.Creator.Email),
+		Edited:     ic.Edited,
+		Deleted:    ic.Deleted,
 	}
 
 	switch e := ic.Payload.Event.(type) {
@@ -360,11 +456,25 @@ func convertToIssueComment(issueName string, ic *store.IssueCommentMessage) *v1p
 		r.Event = convertToIssueCommentEventTaskUpdate(e)
 	case *storepb.IssueCommentPayload_TaskPriorBackup_:
 		r.Event = convertToIssueCommentEventTaskPriorBackup(e)
+	case *storepb.IssueCommentPayload_StageApproval_:
+		r.Event = convertToIssueCommentEventStageApproval(e)
 	}
 
 	return r
 }
 
+func convertToIssueCommentRevisions(revisions []*store.IssueCommentRevisionMessage) []*v1pb.IssueCommentRevision {
+	var res []*v1pb.IssueCommentRevision
+	for _, revision := range revisions {
+		res = append(res, &v1pb.IssueCommentRevision{
+			Comment:    revision.Comment,
+			Creator:    common.FormatUserEmail(revision.Creator.Email),
+			CreateTime: timestamppb.New(time.Unix(revision.CreatedTs, 0)),
+		})
+	}
+	return res
+}
+
 func convertToIssueCommentEventApproval(a *storepb.IssueCommentPayload_Approval_) *v1pb.IssueComment_Approval_ {
 	return &v1pb.IssueComment_Approval_{
 		Approval: &v1pb.IssueComment_Approval{
@@ -396,6 +506,22 @@ func convertToIssueCommentEventStageEnd(e *storepb.IssueCommentPayload_StageEnd_
 	}
 }
 
+func convertToIssueCommentEventStageApproval(e *storepb.IssueCommentPayload_StageApproval_) *v1pb.IssueComment_StageApproval_ {
+	var approvalStatus v1pb.IssueComment_StageApproval_Status
+	switch e.StageApproval.Status {
+	case storepb.IssueCommentPayload_StageApproval_APPROVED:
+		approvalStatus = v1pb.IssueComment_StageApproval_APPROVED
+	case storepb.IssueCommentPayload_StageApproval_REJECTED:
+		approvalStatus = v1pb.IssueComment_StageApproval_REJECTED
+	}
+	return &v1pb.IssueComment_StageApproval_{
+		StageApproval: &v1pb.IssueComment_StageApproval{
+			Stage:  e.StageApproval.Stage,
+			Status: approvalStatus,
+		},
+	}
+}
+
 func convertToIssueCommentPayloadIssueUpdateIssueStatus(s *v1pb.IssueStatus) *storepb.IssueCommentPayload_IssueUpdate_IssueStatus {
 	if s == nil {
 		return nil
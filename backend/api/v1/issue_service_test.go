@@ -1,9 +1,17 @@
 package v1
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -28,6 +36,20 @@ func TestConvertToApprovalNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			node: &storepb.ApprovalNode{
+				Type: storepb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &storepb.ApprovalNode_Group{
+					Group: "groups/dba@example.com",
+				},
+			},
+			want: &v1pb.ApprovalNode{
+				Type: v1pb.ApprovalNode_ANY_IN_GROUP,
+				Payload: &v1pb.ApprovalNode_Group{
+					Group: "groups/dba@example.com",
+				},
+			},
+		},
 	}
 
 	a := require.New(t)
@@ -38,3 +60,73 @@ func TestConvertToApprovalNode(t *testing.T) {
 }
 
 // TODO(p0ny): update tests for isUserReviewer
+
+// gatewayIssueService records the requests decoded by the REST gateway.
+type gatewayIssueService struct {
+	v1pb.UnimplementedIssueServiceServer
+
+	updateIssueCommentRequest *v1pb.UpdateIssueCommentRequest
+	listIssueCommentsRequest  *v1pb.ListIssueCommentsRequest
+}
+
+func (s *gatewayIssueService) UpdateIssueComment(_ context.Context, request *v1pb.UpdateIssueCommentRequest) (*v1pb.IssueComment, error) {
+	s.updateIssueCommentRequest = request
+	return request.IssueComment, nil
+}
+
+func (s *gatewayIssueService) ListIssueComments(_ context.Context, request *v1pb.ListIssueCommentsRequest) (*v1pb.ListIssueCommentsResponse, error) {
+	s.listIssueCommentsRequest = request
+	return &v1pb.ListIssueCommentsResponse{NextPageToken: "next"}, nil
+}
+
+func (*gatewayIssueService) ApproveIssue(_ context.Context, request *v1pb.ApproveIssueRequest) (*v1pb.Issue, error) {
+	return nil, status.Errorf(codes.NotFound, "issue %q not found", request.Name)
+}
+
+func TestIssueServiceGateway(t *testing.T) {
+	a := require.New(t)
+
+	server := &gatewayIssueService{}
+	mux := runtime.NewServeMux()
+	a.NoError(v1pb.RegisterIssueServiceHandlerServer(context.Background(), mux, server))
+	serve := func(method, url, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		mux.ServeHTTP(recorder, httptest.NewRequest(method, url, strings.NewReader(body)))
+		return recorder
+	}
+
+	// The update mask is parsed from the query parameter.
+	recorder := serve(http.MethodPatch, "/v1/projects/p1/issues/1:comment?update_mask=comment", `{"uid": "101", "comment": "LGTM"}`)
+	a.Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	a.Equal("projects/p1/issues/1", server.updateIssueCommentRequest.Parent)
+	a.Equal("101", server.updateIssueCommentRequest.IssueComment.Uid)
+	a.Equal("LGTM", server.updateIssueCommentRequest.IssueComment.Comment)
+	a.Equal([]string{"comment"}, server.updateIssueCommentRequest.UpdateMask.GetPaths())
+
+	// The update mask is inferred from the request body if it's not set.
+	recorder = serve(http.MethodPatch, "/v1/projects/p1/issues/1:comment", `{"uid": "101", "comment": "LGTM"}`)
+	a.Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	a.ElementsMatch([]string{"uid", "comment"}, server.updateIssueCommentRequest.UpdateMask.GetPaths())
+
+	recorder = serve(http.MethodGet, "/v1/projects/p1/issues/1/issueComments?page_size=20&page_token=abc", "")
+	a.Equal(http.StatusOK, recorder.Code, recorder.Body.String())
+	a.Equal("projects/p1/issues/1", server.listIssueCommentsRequest.Parent)
+	a.Equal(int32(20), server.listIssueCommentsRequest.PageSize)
+	a.Equal("abc", server.listIssueCommentsRequest.PageToken)
+	var listResponse struct {
+		NextPageToken string `json:"nextPageToken"`
+	}
+	a.NoError(json.Unmarshal(recorder.Body.Bytes(), &listResponse))
+	a.Equal("next", listResponse.NextPageToken)
+
+	// The gRPC status is returned as the HTTP status and the error payload.
+	recorder = serve(http.MethodPost, "/v1/projects/p1/issues/1:approve", "")
+	a.Equal(http.StatusNotFound, recorder.Code)
+	var errorResponse struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	a.NoError(json.Unmarshal(recorder.Body.Bytes(), &errorResponse))
+	a.Equal(int(codes.NotFound), errorResponse.Code)
+	a.Equal(`issue "projects/p1/issues/1" not found`, errorResponse.Message)
+}
@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// GetIssueTimeline gets the timeline of the issue.
+func (s *IssueService) GetIssueTimeline(ctx context.Context, request *v1pb.GetIssueTimelineRequest) (*v1pb.GetIssueTimelineResponse, error) {
+	if request.PageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("page size must be non-negative: %d", request.PageSize))
+	}
+	issue, err := s.getIssueMessage(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parseLimitAndOffset(request.PageToken, int(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*v1pb.IssueTimelineEntry
+	issueComments, err := s.store.ListIssueComment(ctx, &store.FindIssueCommentMessage{IssueUID: &issue.UID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list issue comments, err: %v", err)
+	}
+	for _, ic := range issueComments {
+		entries = append(entries, &v1pb.IssueTimelineEntry{
+			Type:  getIssueCommentTimelineEntryType(ic.Payload),
+			Time:  timestamppb.New(time.Unix(ic.CreatedTs, 0)),
+			Entry: &v1pb.IssueTimelineEntry_IssueComment{IssueComment: convertToIssueComment(request.Parent, ic)},
+		})
+	}
+
+	if issue.PlanUID != nil {
+		planCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{PlanUID: issue.PlanUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list plan check runs, err: %v", err)
+		}
+		for _, run := range planCheckRuns {
+			planCheckRun, err := convertToPlanCheckRun(ctx, s.store, issue.Project.ResourceID, *issue.PlanUID, run)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert plan check run, err: %v", err)
+			}
+			entries = append(entries, &v1pb.IssueTimelineEntry{
+				Type:  v1pb.IssueTimelineEntry_PLAN_CHECK_RUN,
+				Time:  timestamppb.New(time.Unix(run.CreatedTs, 0)),
+				Entry: &v1pb.IssueTimelineEntry_PlanCheckRun{PlanCheckRun: planCheckRun},
+			})
+		}
+	}
+
+	if issue.PipelineUID != nil {
+		taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{PipelineUID: issue.PipelineUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list task runs, err: %v", err)
+		}
+		for _, run := range taskRuns {
+			taskRun, err := convertToTaskRun(ctx, s.store, s.stateCfg, run)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to convert task run, err: %v", err)
+			}
+			entries = append(entries, &v1pb.IssueTimelineEntry{
+				Type:  v1pb.IssueTimelineEntry_TASK_RUN,
+				Time:  timestamppb.New(time.Unix(run.UpdatedTs, 0)),
+				Entry: &v1pb.IssueTimelineEntry_TaskRun{TaskRun: taskRun},
+			})
+		}
+	}
+
+	sortIssueTimelineEntries(entries)
+	var nextPageToken string
+	if offset >= len(entries) {
+		entries = nil
+	} else {
+		entries = entries[offset:]
+	}
+	if len(entries) > limit {
+		pageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+		nextPageToken = pageToken
+		entries = entries[:limit]
+	}
+
+	return &v1pb.GetIssueTimelineResponse{
+		Entries:       entries,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func getIssueCommentTimelineEntryType(payload *storepb.IssueCommentPayload) v1pb.IssueTimelineEntry_Type {
+	switch payload.GetEvent().(type) {
+	case nil:
+		return v1pb.IssueTimelineEntry_COMMENT
+	case *storepb.IssueCommentPayload_Approval_:
+		return v1pb.IssueTimelineEntry_APPROVAL
+	default:
+		return v1pb.IssueTimelineEntry_ACTIVITY
+	}
+}
+
+// sortIssueTimelineEntries sorts the entries by time.
+// The entries at the same time are kept in the order of comments, plan check runs and task runs.
+func sortIssueTimelineEntries(entries []*v1pb.IssueTimelineEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.AsTime().Before(entries[j].Time.AsTime())
+	})
+}
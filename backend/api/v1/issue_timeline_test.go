@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestSortIssueTimelineEntries(t *testing.T) {
+	a := require.New(t)
+	newEntry := func(entryType v1pb.IssueTimelineEntry_Type, ts int64) *v1pb.IssueTimelineEntry {
+		return &v1pb.IssueTimelineEntry{Type: entryType, Time: timestamppb.New(time.Unix(ts, 0))}
+	}
+	entries := []*v1pb.IssueTimelineEntry{
+		newEntry(v1pb.IssueTimelineEntry_COMMENT, 30),
+		newEntry(v1pb.IssueTimelineEntry_APPROVAL, 10),
+		newEntry(v1pb.IssueTimelineEntry_PLAN_CHECK_RUN, 10),
+		newEntry(v1pb.IssueTimelineEntry_TASK_RUN, 20),
+	}
+	sortIssueTimelineEntries(entries)
+	var got []v1pb.IssueTimelineEntry_Type
+	for _, entry := range entries {
+		got = append(got, entry.Type)
+	}
+	a.Equal([]v1pb.IssueTimelineEntry_Type{
+		v1pb.IssueTimelineEntry_APPROVAL,
+		v1pb.IssueTimelineEntry_PLAN_CHECK_RUN,
+		v1pb.IssueTimelineEntry_TASK_RUN,
+		v1pb.IssueTimelineEntry_COMMENT,
+	}, got)
+
+	a.Equal(v1pb.IssueTimelineEntry_COMMENT, getIssueCommentTimelineEntryType(&storepb.IssueCommentPayload{Comment: "LGTM"}))
+	a.Equal(v1pb.IssueTimelineEntry_APPROVAL, getIssueCommentTimelineEntryType(&storepb.IssueCommentPayload{
+		Event: &storepb.IssueCommentPayload_Approval_{Approval: &storepb.IssueCommentPayload_Approval{}},
+	}))
+	a.Equal(v1pb.IssueTimelineEntry_ACTIVITY, getIssueCommentTimelineEntryType(&storepb.IssueCommentPayload{
+		Event: &storepb.IssueCommentPayload_StageEnd_{StageEnd: &storepb.IssueCommentPayload_StageEnd{}},
+	}))
+}
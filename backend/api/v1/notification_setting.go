@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// GetNotificationSetting gets the notification setting of the user.
+func (s *AuthService) GetNotificationSetting(ctx context.Context, request *v1pb.GetNotificationSettingRequest) (*v1pb.NotificationSetting, error) {
+	user, err := s.getNotificationSettingUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.store.GetNotificationSetting(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification setting, error: %v", err)
+	}
+	return convertToNotificationSetting(user, setting), nil
+}
+
+// UpdateNotificationSetting updates the notification setting of the user.
+func (s *AuthService) UpdateNotificationSetting(ctx context.Context, request *v1pb.UpdateNotificationSettingRequest) (*v1pb.NotificationSetting, error) {
+	if request.NotificationSetting == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "notification setting must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	user, err := s.getNotificationSettingUser(ctx, request.NotificationSetting.Name)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.store.GetNotificationSetting(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notification setting, error: %v", err)
+	}
+
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "email_events":
+			var events []storepb.NotificationSetting_Event
+			for _, event := range request.NotificationSetting.EmailEvents {
+				if event == v1pb.NotificationSetting_EVENT_UNSPECIFIED {
+					return nil, status.Errorf(codes.InvalidArgument, "email event must be specified")
+				}
+				storeEvent := storepb.NotificationSetting_Event(event)
+				if !slices.Contains(events, storeEvent) {
+					events = append(events, storeEvent)
+				}
+			}
+			setting.EmailEvents = events
+		case "email_delivery":
+			setting.EmailDelivery = storepb.NotificationSetting_EmailDelivery(request.NotificationSetting.EmailDelivery)
+		case "email_language":
+			setting.EmailLanguage = request.NotificationSetting.EmailLanguage
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
+		}
+	}
+	if err := s.store.UpsertNotificationSetting(ctx, user.ID, setting); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update notification setting, error: %v", err)
+	}
+	return convertToNotificationSetting(user, setting), nil
+}
+
+// getNotificationSettingUser returns the user of the notification setting, which must be the caller.
+func (s *AuthService) getNotificationSettingUser(ctx context.Context, name string) (*store.UserMessage, error) {
+	callerUser, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if !strings.HasSuffix(name, common.NotificationSettingSuffix) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid notification setting name %q", name)
+	}
+	email, err := common.GetUserEmail(strings.TrimSuffix(name, common.NotificationSettingSuffix))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if email != callerUser.Email {
+		return nil, status.Errorf(codes.PermissionDenied, "only the user itself can access the notification setting")
+	}
+	return callerUser, nil
+}
+
+func convertToNotificationSetting(user *store.UserMessage, setting *storepb.NotificationSetting) *v1pb.NotificationSetting {
+	v1Setting := &v1pb.NotificationSetting{
+		Name:          fmt.Sprintf("%s%s%s", common.UserNamePrefix, user.Email, common.NotificationSettingSuffix),
+		EmailDelivery: v1pb.NotificationSetting_EmailDelivery(setting.EmailDelivery),
+		EmailLanguage: setting.EmailLanguage,
+	}
+	for _, event := range setting.EmailEvents {
+		v1Setting.EmailEvents = append(v1Setting.EmailEvents, v1pb.NotificationSetting_Event(event))
+	}
+	return v1Setting
+}
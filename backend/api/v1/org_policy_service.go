@@ -541,7 +541,7 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 	switch policy.Type {
 	case v1pb.PolicyType_ROLLOUT_POLICY:
 		rolloutPolicy := convertToStorePBRolloutPolicy(policy.GetRolloutPolicy())
-		if !rolloutPolicy.Automatic {
+		if !rolloutPolicy.Automatic || rolloutPolicy.StageApprovalRequired {
 			if err := s.licenseService.IsFeatureEnabled(api.FeatureApprovalPolicy); err != nil {
 				return "", status.Errorf(codes.PermissionDenied, err.Error())
 			}
@@ -648,6 +648,32 @@ func (s *OrgPolicyService) convertPolicyPayloadToString(ctx context.Context, pol
 			return "", errors.Wrap(err, "failed to marshal data source query policy")
 		}
 		return string(payloadBytes), nil
+	case v1pb.PolicyType_PII_DETECTION:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSensitiveData); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := convertToPIIDetectionPolicyPayload(policy.GetPiiDetectionPolicy())
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal PII detection policy")
+		}
+		return string(payloadBytes), nil
+	case v1pb.PolicyType_ROW_ACCESS:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureAccessControl); err != nil {
+			return "", status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		payload, err := convertToRowAccessPolicyPayload(policy.GetRowAccessPolicy())
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		payloadBytes, err := protojson.Marshal(payload)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal row access policy")
+		}
+		return string(payloadBytes), nil
 	}
 
 	return "", status.Errorf(codes.InvalidArgument, "invalid policy %v", policy.Type)
@@ -754,6 +780,20 @@ func (s *OrgPolicyService) convertToPolicy(ctx context.Context, parentPath strin
 			return nil, err
 		}
 		policy.Policy = payload
+	case api.PolicyTypePIIDetection:
+		pType = v1pb.PolicyType_PII_DETECTION
+		payload, err := convertToV1PBPIIDetectionPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
+	case api.PolicyTypeRowAccess:
+		pType = v1pb.PolicyType_ROW_ACCESS
+		payload, err := convertToV1PBRowAccessPolicy(policyMessage.Payload)
+		if err != nil {
+			return nil, err
+		}
+		policy.Policy = payload
 	}
 
 	policy.Type = pType
@@ -925,10 +965,13 @@ func convertToV1RolloutPolicyPayload(payloadStr string) (*v1pb.Policy_RolloutPol
 
 func convertToStorePBRolloutPolicy(policy *v1pb.RolloutPolicy) *storepb.RolloutPolicy {
 	return &storepb.RolloutPolicy{
-		Automatic:      policy.Automatic,
-		WorkspaceRoles: policy.WorkspaceRoles,
-		ProjectRoles:   policy.ProjectRoles,
-		IssueRoles:     policy.IssueRoles,
+		Automatic:             policy.Automatic,
+		WorkspaceRoles:        policy.WorkspaceRoles,
+		ProjectRoles:          policy.ProjectRoles,
+		IssueRoles:            policy.IssueRoles,
+		Groups:                policy.Groups,
+		StageApprovalRequired: policy.StageApprovalRequired,
+		StageApproverGroups:   policy.StageApproverGroups,
 	}
 }
 
@@ -1111,6 +1154,7 @@ func convertToV1PBDataSourceQueryPolicy(payloadStr string) (*v1pb.Policy_DataSou
 	return &v1pb.Policy_DataSourceQueryPolicy{
 		DataSourceQueryPolicy: &v1pb.DataSourceQueryPolicy{
 			AdminDataSourceRestriction: v1pb.DataSourceQueryPolicy_Restriction(payload.AdminDataSourceRestriction),
+			Routing:                    v1pb.DataSourceQueryPolicy_Routing(payload.Routing),
 		},
 	}, nil
 }
@@ -1118,9 +1162,71 @@ func convertToV1PBDataSourceQueryPolicy(payloadStr string) (*v1pb.Policy_DataSou
 func convertToDataSourceQueryPayload(policy *v1pb.DataSourceQueryPolicy) (*storepb.DataSourceQueryPolicy, error) {
 	return &storepb.DataSourceQueryPolicy{
 		AdminDataSourceRestriction: storepb.DataSourceQueryPolicy_Restriction(policy.AdminDataSourceRestriction),
+		Routing:                    storepb.DataSourceQueryPolicy_Routing(policy.Routing),
+	}, nil
+}
+
+func convertToV1PBPIIDetectionPolicy(payloadStr string) (*v1pb.Policy_PiiDetectionPolicy, error) {
+	payload := &storepb.PIIDetectionPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(payloadStr), payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal PII detection policy payload")
+	}
+	return &v1pb.Policy_PiiDetectionPolicy{
+		PiiDetectionPolicy: &v1pb.PIIDetectionPolicy{
+			Active:     payload.Active,
+			SampleData: payload.SampleData,
+			SampleSize: payload.SampleSize,
+		},
+	}, nil
+}
+
+func convertToPIIDetectionPolicyPayload(policy *v1pb.PIIDetectionPolicy) (*storepb.PIIDetectionPolicy, error) {
+	if policy.GetSampleSize() < 0 {
+		return nil, errors.Errorf("sample size cannot be negative")
+	}
+	return &storepb.PIIDetectionPolicy{
+		Active:     policy.GetActive(),
+		SampleData: policy.GetSampleData(),
+		SampleSize: policy.GetSampleSize(),
 	}, nil
 }
 
+func convertToV1PBRowAccessPolicy(payloadStr string) (*v1pb.Policy_RowAccessPolicy, error) {
+	payload := &storepb.RowAccessPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(payloadStr), payload); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal row access policy payload")
+	}
+	policy := &v1pb.RowAccessPolicy{}
+	for _, rule := range payload.Rules {
+		policy.Rules = append(policy.Rules, &v1pb.RowAccessPolicy_Rule{
+			Schema:    rule.Schema,
+			Table:     rule.Table,
+			Condition: rule.Condition,
+		})
+	}
+	return &v1pb.Policy_RowAccessPolicy{
+		RowAccessPolicy: policy,
+	}, nil
+}
+
+func convertToRowAccessPolicyPayload(policy *v1pb.RowAccessPolicy) (*storepb.RowAccessPolicy, error) {
+	payload := &storepb.RowAccessPolicy{}
+	for _, rule := range policy.GetRules() {
+		if rule.Table == "" {
+			return nil, errors.Errorf("table is required in the row access rule")
+		}
+		if err := common.ValidateRowAccessCELExpr(rule.GetCondition().GetExpression()); err != nil {
+			return nil, errors.Wrapf(err, "invalid condition of the row access rule for table %q", rule.Table)
+		}
+		payload.Rules = append(payload.Rules, &storepb.RowAccessPolicy_Rule{
+			Schema:    rule.Schema,
+			Table:     rule.Table,
+			Condition: rule.Condition,
+		})
+	}
+	return payload, nil
+}
+
 func convertPolicyType(pType string) (api.PolicyType, error) {
 	var policyType api.PolicyType
 	switch strings.ToUpper(pType) {
@@ -1142,6 +1248,10 @@ func convertPolicyType(pType string) (api.PolicyType, error) {
 		return api.PolicyTypeRestrictIssueCreationForSQLReview, nil
 	case v1pb.PolicyType_DATA_SOURCE_QUERY.String():
 		return api.PolicyTypeDataSourceQuery, nil
+	case v1pb.PolicyType_PII_DETECTION.String():
+		return api.PolicyTypePIIDetection, nil
+	case v1pb.PolicyType_ROW_ACCESS.String():
+		return api.PolicyTypeRowAccess, nil
 	}
 	return policyType, errors.Errorf("invalid policy type %v", pType)
 }
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// PreviewPlanStatements returns the rendered statement of every target database of the plan,
+// so that the reviewers can see what will actually run on each database of a database group.
+func (s *PlanService) PreviewPlanStatements(ctx context.Context, request *v1pb.PreviewPlanStatementsRequest) (*v1pb.PreviewPlanStatementsResponse, error) {
+	projectID, planID, err := common.GetProjectIDPlanID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", projectID)
+	}
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planID, ProjectID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
+	}
+	if plan == nil {
+		return nil, status.Errorf(codes.NotFound, "plan not found for id: %d", planID)
+	}
+
+	resp := &v1pb.PreviewPlanStatementsResponse{}
+	for _, step := range plan.Config.GetSteps() {
+		for _, spec := range step.GetSpecs() {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				continue
+			}
+			statements, err := s.previewChangeDatabaseStatements(ctx, project, spec, config)
+			if err != nil {
+				return nil, err
+			}
+			resp.Statements = append(resp.Statements, statements...)
+		}
+	}
+	return resp, nil
+}
+
+func (s *PlanService) previewChangeDatabaseStatements(ctx context.Context, project *store.ProjectMessage, spec *storepb.PlanConfig_Spec, config *storepb.PlanConfig_ChangeDatabaseConfig) ([]*v1pb.PreviewPlanStatementsResponse_DatabaseStatement, error) {
+	_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sheet id from %q, error: %v", config.Sheet, err)
+	}
+	statement, err := s.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get sheet statement %d, error: %v", sheetUID, err)
+	}
+
+	specs := []*storepb.PlanConfig_Spec{spec}
+	if _, _, err := common.GetProjectIDDatabaseGroupID(config.Target); err == nil {
+		specs, err = transformDatabaseGroupTargetToSteps(ctx, s.store, spec, config, project)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to expand database group target %q, error: %v", config.Target, err)
+		}
+	}
+
+	var statements []*v1pb.PreviewPlanStatementsResponse_DatabaseStatement
+	for _, spec := range specs {
+		target := spec.GetChangeDatabaseConfig().GetTarget()
+		instanceID, databaseName, err := common.GetInstanceDatabaseID(target)
+		if err != nil {
+			// The target is not a database, e.g. the deployment config.
+			continue
+		}
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance %q, error: %v", instanceID, err)
+		}
+		if instance == nil {
+			return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+		}
+		database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:          &instanceID,
+			DatabaseName:        &databaseName,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get database %q, error: %v", target, err)
+		}
+		if database == nil {
+			return nil, status.Errorf(codes.NotFound, "database %q not found", target)
+		}
+
+		rendered, undefined := utils.RenderStatementVariables(statement, project.Setting.GetStatementVariables(), database)
+		rendered, truncated := common.TruncateString(rendered, common.MaxSheetSize)
+		statements = append(statements, &v1pb.PreviewPlanStatementsResponse_DatabaseStatement{
+			SpecId:             spec.Id,
+			Database:           target,
+			Statement:          rendered,
+			Truncated:          truncated,
+			UndefinedVariables: undefined,
+		})
+	}
+	return statements, nil
+}
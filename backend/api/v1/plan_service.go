@@ -3,16 +3,20 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log/slog"
+	"maps"
 	"slices"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -87,6 +91,40 @@ func (s *PlanService) GetPlan(ctx context.Context, request *v1pb.GetPlanRequest)
 	return convertedPlan, nil
 }
 
+// getPlanSheetSizeWarnings returns the warnings of the sheets larger than the warning size of the sheet storage setting.
+func getPlanSheetSizeWarnings(ctx context.Context, s *store.Store, steps []*storepb.PlanConfig_Step) ([]string, error) {
+	setting, err := s.GetSheetStorageSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var warnings []string
+	for _, step := range steps {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				continue
+			}
+			_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get sheet id from sheet name %q", config.Sheet)
+			}
+			sheet, err := s.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+			}
+			if sheet == nil || sheet.Size <= setting.WarningSize {
+				continue
+			}
+			warning := fmt.Sprintf("The statement of sheet %q is %dKB, which exceeds %dKB.", config.Sheet, sheet.Size/1024, setting.WarningSize/1024)
+			if sheet.Size > common.MaxSheetCheckSize {
+				warning += " The SQL review and the statement checks are skipped for it."
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings, nil
+}
+
 // ListPlans lists plans.
 func (s *PlanService) ListPlans(ctx context.Context, request *v1pb.ListPlansRequest) (*v1pb.ListPlansResponse, error) {
 	projectID, err := common.GetProjectID(request.Parent)
@@ -259,6 +297,109 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRe
 		}
 	}
 
+	return s.createPlan(ctx, planMessage, project, principalID)
+}
+
+// CreateSchemaDriftReconciliationPlan creates a plan migrating the drifted database schema back to the schema recorded by the latest migration.
+func (s *PlanService) CreateSchemaDriftReconciliationPlan(ctx context.Context, request *v1pb.CreateSchemaDriftReconciliationPlanRequest) (*v1pb.Plan, error) {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", request.Name)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &database.ProjectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project not found for id: %v", database.ProjectID)
+	}
+
+	rowStatus := api.Normal
+	anomalies, err := s.store.ListAnomalyV2(ctx, &store.ListAnomalyMessage{
+		RowStatus:   &rowStatus,
+		DatabaseUID: &database.UID,
+		Types:       []api.AnomalyType{api.AnomalyDatabaseSchemaDrift},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list anomalies, error: %v", err)
+	}
+	if len(anomalies) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q has no schema drift", request.Name)
+	}
+	payload := &storepb.AnomalyDatabaseSchemaDriftPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(anomalies[0].Payload), payload); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal schema drift anomaly payload, error: %v", err)
+	}
+	if strings.TrimSpace(payload.Diff) == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "schema drift of database %q cannot be reconciled automatically", request.Name)
+	}
+
+	sheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  principalID,
+		ProjectUID: project.UID,
+		Title:      fmt.Sprintf("Sheet for reconciling schema drift of database %v", database.DatabaseName),
+		Statement:  payload.Diff,
+
+		Payload: &storepb.SheetPayload{
+			Engine: instance.Engine,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create sheet, error: %v", err)
+	}
+
+	planMessage := &store.PlanMessage{
+		ProjectID:   project.ResourceID,
+		PipelineUID: nil,
+		Name:        fmt.Sprintf("Reconcile schema drift of database %s", database.DatabaseName),
+		Description: fmt.Sprintf("Migrate the schema of database %s back to the schema recorded by version %s.", database.DatabaseName, payload.Version),
+		Config: &storepb.PlanConfig{
+			Steps: []*storepb.PlanConfig_Step{
+				{
+					Specs: []*storepb.PlanConfig_Spec{
+						{
+							Id: uuid.NewString(),
+							Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+								ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+									Target: common.FormatDatabase(database.InstanceID, database.DatabaseName),
+									Sheet:  common.FormatSheet(project.ResourceID, sheet.UID),
+									Type:   storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return s.createPlan(ctx, planMessage, project, principalID)
+}
+
+func (s *PlanService) createPlan(ctx context.Context, planMessage *store.PlanMessage, project *store.ProjectMessage, principalID int) (*v1pb.Plan, error) {
 	if _, err := GetPipelineCreate(ctx, s.store, s.sheetManager, s.licenseService, s.dbFactory, planMessage.Config.GetSteps(), project); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to get pipeline from the plan, please check you request, error: %v", err)
 	}
@@ -282,6 +423,11 @@ func (s *PlanService) CreatePlan(ctx context.Context, request *v1pb.CreatePlanRe
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to convert to plan, error: %v", err)
 	}
+	warnings, err := getPlanSheetSizeWarnings(ctx, s.store, plan.Config.GetSteps())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check sheet sizes, error: %v", err)
+	}
+	convertedPlan.Warnings = warnings
 	return convertedPlan, nil
 }
 
@@ -486,20 +632,12 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 							return nil
 						}
 
-						var databaseName *string
-						if config.ChangeDatabaseConfig.PreUpdateBackupDetail == nil {
-							if payload.PreUpdateBackupDetail.Database != "" {
-								emptyValue := ""
-								databaseName = &emptyValue
-							}
-						} else {
-							if config.ChangeDatabaseConfig.PreUpdateBackupDetail.Database != payload.PreUpdateBackupDetail.Database {
-								databaseName = &config.ChangeDatabaseConfig.PreUpdateBackupDetail.Database
-							}
-						}
-						if databaseName != nil {
+						databaseName := config.ChangeDatabaseConfig.GetPreUpdateBackupDetail().GetDatabase()
+						fullTable := databaseName != "" && config.ChangeDatabaseConfig.GetPreUpdateBackupDetail().GetFullTable()
+						if databaseName != payload.PreUpdateBackupDetail.GetDatabase() || fullTable != payload.PreUpdateBackupDetail.GetFullTable() {
 							taskPatch.PreUpdateBackupDetail = &storepb.PreUpdateBackupDetail{
-								Database: *databaseName,
+								Database:  databaseName,
+								FullTable: fullTable,
 							}
 							doUpdate = true
 						}
@@ -508,6 +646,112 @@ func (s *PlanService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePlanRe
 						return nil, err
 					}
 
+					// VerificationQueries
+					if err := func() error {
+						switch task.Type {
+						case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostCutover, api.TaskDatabaseDataUpdate:
+						default:
+							return nil
+						}
+						payload := &storepb.TaskDatabaseUpdatePayload{}
+						if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+							return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+						}
+						config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig)
+						if !ok {
+							return nil
+						}
+						if err := validateVerificationQueries(config.ChangeDatabaseConfig.VerificationQueries); err != nil {
+							return status.Errorf(codes.InvalidArgument, err.Error())
+						}
+						newQueries := convertPlanVerificationQueries(config.ChangeDatabaseConfig.VerificationQueries)
+						if slices.EqualFunc(newQueries, payload.VerificationQueries, func(a, b *storepb.VerificationQuery) bool {
+							return proto.Equal(a, b)
+						}) {
+							return nil
+						}
+						taskPatch.VerificationQueries = &newQueries
+						doUpdate = true
+						return nil
+					}(); err != nil {
+						return nil, err
+					}
+
+					// TransactionMode
+					if err := func() error {
+						switch task.Type {
+						case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
+						default:
+							return nil
+						}
+						payload := &storepb.TaskDatabaseUpdatePayload{}
+						if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+							return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+						}
+						config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig)
+						if !ok {
+							return nil
+						}
+						newMode := storepb.TransactionMode(config.ChangeDatabaseConfig.TransactionMode)
+						if newMode == payload.TransactionMode {
+							return nil
+						}
+						instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+						if err != nil {
+							return status.Errorf(codes.Internal, "failed to get instance: %v", err)
+						}
+						if instance == nil {
+							return status.Errorf(codes.NotFound, "instance %d not found", task.InstanceID)
+						}
+						if err := validateTransactionMode(newMode, instance.Engine); err != nil {
+							return status.Errorf(codes.InvalidArgument, err.Error())
+						}
+						taskPatch.TransactionMode = &newMode
+						doUpdate = true
+						return nil
+					}(); err != nil {
+						return nil, err
+					}
+
+					// SessionVariables
+					if err := func() error {
+						switch task.Type {
+						case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
+						default:
+							return nil
+						}
+						payload := &storepb.TaskDatabaseUpdatePayload{}
+						if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+							return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+						}
+						config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig)
+						if !ok {
+							return nil
+						}
+						newVariables := config.ChangeDatabaseConfig.SessionVariables
+						if maps.Equal(newVariables, payload.SessionVariables) {
+							return nil
+						}
+						instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+						if err != nil {
+							return status.Errorf(codes.Internal, "failed to get instance: %v", err)
+						}
+						if instance == nil {
+							return status.Errorf(codes.NotFound, "instance %d not found", task.InstanceID)
+						}
+						if err := validateSessionVariables(newVariables, instance.Engine); err != nil {
+							return status.Errorf(codes.InvalidArgument, err.Error())
+						}
+						if newVariables == nil {
+							newVariables = map[string]string{}
+						}
+						taskPatch.SessionVariables = &newVariables
+						doUpdate = true
+						return nil
+					}(); err != nil {
+						return nil, err
+					}
+
 					// Sheet
 					if err := func() error {
 						switch task.Type {
@@ -918,6 +1162,38 @@ func (s *PlanService) BatchCancelPlanCheckRuns(ctx context.Context, request *v1p
 	return &v1pb.BatchCancelPlanCheckRunsResponse{}, nil
 }
 
+// ListPlanCheckRunQueues lists the plan check queues of the instances.
+func (s *PlanService) ListPlanCheckRunQueues(ctx context.Context, _ *v1pb.ListPlanCheckRunQueuesRequest) (*v1pb.ListPlanCheckRunQueuesResponse, error) {
+	setting, err := s.store.GetPlanCheckSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan check setting, error: %v", err)
+	}
+	queues, err := s.planCheckScheduler.ListInstanceQueues(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list plan check queues, error: %v", err)
+	}
+
+	resp := &v1pb.ListPlanCheckRunQueuesResponse{
+		MaxConcurrentPerInstance: setting.MaxConcurrentPerInstance,
+	}
+	for _, queue := range queues {
+		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &queue.InstanceUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get instance %d, error: %v", queue.InstanceUID, err)
+		}
+		if instance == nil {
+			continue
+		}
+		resp.Queues = append(resp.Queues, &v1pb.ListPlanCheckRunQueuesResponse_Queue{
+			Instance: common.FormatInstance(instance.ResourceID),
+			Running:  int32(queue.Running),
+			Queued:   int32(queue.Queued),
+			Boosted:  int32(queue.Boosted),
+		})
+	}
+	return resp, nil
+}
+
 func (s *PlanService) buildPlanFindWithFilter(ctx context.Context, planFind *store.FindPlanMessage, filter string) error {
 	filters, err := parseFilter(filter)
 	if err != nil {
@@ -101,6 +101,10 @@ func getPlanCheckRunsFromSpec(ctx context.Context, s *store.Store, plan *store.P
 		if _, _, err := common.GetInstanceDatabaseID(config.ExportDataConfig.Target); err == nil {
 			return getPlanCheckRunsFromExportDataConfigDatabaseTarget(ctx, s, plan, config.ExportDataConfig)
 		}
+	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
+		// The backup run to restore is validated when the rollout is created.
+	case *storepb.PlanConfig_Spec_LoadDataConfig:
+		// The data files are read by the database when the task runs.
 	default:
 		return nil, errors.Errorf("unknown spec config type %T", config)
 	}
@@ -237,7 +241,8 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 	preUpdateBackupDetail := (*storepb.PreUpdateBackupDetail)(nil)
 	if config.PreUpdateBackupDetail != nil {
 		preUpdateBackupDetail = &storepb.PreUpdateBackupDetail{
-			Database: config.PreUpdateBackupDetail.Database,
+			Database:  config.PreUpdateBackupDetail.Database,
+			FullTable: config.PreUpdateBackupDetail.FullTable,
 		}
 	}
 	planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
@@ -267,6 +272,81 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 			DatabaseName:       database.DatabaseName,
 		},
 	})
+	planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+		CreatorUID: api.SystemBotID,
+		UpdaterUID: api.SystemBotID,
+		PlanUID:    plan.UID,
+		Status:     store.PlanCheckRunStatusRunning,
+		Type:       store.PlanCheckDatabaseStatementConflict,
+		Config: &storepb.PlanCheckRunConfig{
+			SheetUid:           int32(sheetUID),
+			ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+			InstanceUid:        int32(instance.UID),
+			DatabaseName:       database.DatabaseName,
+			PlanUid:            plan.UID,
+		},
+	})
+	// The SDL sheet is the desired schema rather than the statement to apply, so it's not simulated.
+	if config.SimulationInstance != "" && config.Type != storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL {
+		simulationInstanceID, err := common.GetInstanceID(config.SimulationInstance)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get simulation instance from %q", config.SimulationInstance)
+		}
+		simulationInstance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
+			ResourceID: &simulationInstanceID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", simulationInstanceID)
+		}
+		if simulationInstance == nil {
+			return nil, errors.Errorf("simulation instance %q not found", simulationInstanceID)
+		}
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			CreatorUID: api.SystemBotID,
+			UpdaterUID: api.SystemBotID,
+			PlanUID:    plan.UID,
+			Status:     store.PlanCheckRunStatusRunning,
+			Type:       store.PlanCheckDatabaseStatementSimulate,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:              int32(sheetUID),
+				ChangeDatabaseType:    convertToChangeDatabaseType(config.Type),
+				InstanceUid:           int32(instance.UID),
+				DatabaseName:          database.DatabaseName,
+				SimulationInstanceUid: int32(simulationInstance.UID),
+			},
+		})
+	}
+	// Every Snowflake grant needs approval, so the effective privilege changes are previewed for the reviewers.
+	if instance.Engine == storepb.Engine_SNOWFLAKE && config.Type != storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			CreatorUID: api.SystemBotID,
+			UpdaterUID: api.SystemBotID,
+			PlanUID:    plan.UID,
+			Status:     store.PlanCheckRunStatusRunning,
+			Type:       store.PlanCheckDatabaseGrantPreview,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceUid:        int32(instance.UID),
+				DatabaseName:       database.DatabaseName,
+			},
+		})
+	}
+	if instance.Engine == storepb.Engine_BIGQUERY && config.Type != storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_SDL {
+		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+			CreatorUID: api.SystemBotID,
+			UpdaterUID: api.SystemBotID,
+			PlanUID:    plan.UID,
+			Status:     store.PlanCheckRunStatusRunning,
+			Type:       store.PlanCheckDatabaseStatementDryRun,
+			Config: &storepb.PlanCheckRunConfig{
+				SheetUid:           int32(sheetUID),
+				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
+				InstanceUid:        int32(instance.UID),
+				DatabaseName:       database.DatabaseName,
+			},
+		})
+	}
 	if config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST {
 		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
 			CreatorUID: api.SystemBotID,
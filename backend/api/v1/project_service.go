@@ -17,18 +17,22 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -40,15 +44,17 @@ type ProjectService struct {
 	profile        *config.Profile
 	iamManager     *iam.Manager
 	licenseService enterprise.LicenseService
+	webhookManager *webhook.Manager
 }
 
 // NewProjectService creates a new ProjectService.
-func NewProjectService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, licenseService enterprise.LicenseService) *ProjectService {
+func NewProjectService(store *store.Store, profile *config.Profile, iamManager *iam.Manager, licenseService enterprise.LicenseService, webhookManager *webhook.Manager) *ProjectService {
 	return &ProjectService{
 		store:          store,
 		profile:        profile,
 		iamManager:     iamManager,
 		licenseService: licenseService,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -219,6 +225,22 @@ func (s *ProjectService) UpdateProject(ctx context.Context, request *v1pb.Update
 			projectSettings := project.Setting
 			projectSettings.AutoResolveIssue = request.Project.AutoResolveIssue
 			patch.Setting = projectSettings
+		case "statement_variables":
+			statementVariables, err := convertToStoreStatementVariables(request.Project.StatementVariables)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			projectSettings := project.Setting
+			projectSettings.StatementVariables = statementVariables
+			patch.Setting = projectSettings
+		case "ticket_sync":
+			ticketSync, err := convertToStoreTicketSync(request.Project.TicketSync, project.Setting.GetTicketSync())
+			if err != nil {
+				return nil, err
+			}
+			projectSettings := project.Setting
+			projectSettings.TicketSync = ticketSync
+			patch.Setting = projectSettings
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
 		}
@@ -231,6 +253,64 @@ func (s *ProjectService) UpdateProject(ctx context.Context, request *v1pb.Update
 	return convertToProject(project), nil
 }
 
+// convertToStoreTicketSync converts the ticket sync,
+// the token is kept if it's not set and the type, url and username are unchanged.
+func convertToStoreTicketSync(ticketSync *v1pb.TicketSync, current *storepb.TicketSync) (*storepb.TicketSync, error) {
+	if ticketSync == nil || ticketSync.Type == v1pb.TicketSync_TYPE_UNSPECIFIED {
+		return nil, nil
+	}
+	if ticketSync.Project == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the project key or team id of the ticket sync is required")
+	}
+	if ticketSync.Type == v1pb.TicketSync_JIRA && ticketSync.Url == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "the url of the jira ticket sync is required")
+	}
+	externalSecret, err := convertToStoreDataSourceExternalSecret(ticketSync.ExternalSecret)
+	if err != nil {
+		return nil, err
+	}
+	storeTicketSync := &storepb.TicketSync{
+		Type:           storepb.TicketSync_Type(ticketSync.Type),
+		Url:            ticketSync.Url,
+		Username:       ticketSync.Username,
+		Token:          ticketSync.Token,
+		ExternalSecret: externalSecret,
+		Project:        ticketSync.Project,
+		IssueType:      ticketSync.IssueType,
+		OpenStatus:     ticketSync.OpenStatus,
+		DoneStatus:     ticketSync.DoneStatus,
+		CanceledStatus: ticketSync.CanceledStatus,
+	}
+	if storeTicketSync.Token == "" && storeTicketSync.ExternalSecret == nil && current != nil && current.Type == storeTicketSync.Type && current.Url == storeTicketSync.Url && current.Username == storeTicketSync.Username {
+		storeTicketSync.Token = current.Token
+	}
+	if storeTicketSync.Token == "" && storeTicketSync.ExternalSecret == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "the token of the ticket sync is required")
+	}
+	return storeTicketSync, nil
+}
+
+// convertToV1TicketSync converts the ticket sync, the token is never returned.
+func convertToV1TicketSync(ticketSync *storepb.TicketSync) *v1pb.TicketSync {
+	if ticketSync == nil {
+		return nil
+	}
+	v1TicketSync := &v1pb.TicketSync{
+		Type:           v1pb.TicketSync_Type(ticketSync.Type),
+		Url:            ticketSync.Url,
+		Username:       ticketSync.Username,
+		Project:        ticketSync.Project,
+		IssueType:      ticketSync.IssueType,
+		OpenStatus:     ticketSync.OpenStatus,
+		DoneStatus:     ticketSync.DoneStatus,
+		CanceledStatus: ticketSync.CanceledStatus,
+	}
+	if externalSecret, err := convertToV1DataSourceExternalSecret(ticketSync.ExternalSecret); err == nil {
+		v1TicketSync.ExternalSecret = externalSecret
+	}
+	return v1TicketSync
+}
+
 // DeleteProject deletes a project.
 func (s *ProjectService) DeleteProject(ctx context.Context, request *v1pb.DeleteProjectRequest) (*emptypb.Empty, error) {
 	project, err := s.getProjectMessage(ctx, request.Name)
@@ -257,7 +337,7 @@ func (s *ProjectService) DeleteProject(ctx context.Context, request *v1pb.Delete
 				return nil, err
 			}
 		}
-		// We don't close the issues because they might be open still.
+		// We don't close the issues because they might be open still, their tasks are not scheduled until the project is restored.
 	} else {
 		// Return the open issue error first because that's more important than transferring out databases.
 		openIssues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{ProjectIDs: &[]string{project.ResourceID}, StatusList: []api.IssueStatus{api.IssueOpen}})
@@ -312,6 +392,99 @@ func (s *ProjectService) UndeleteProject(ctx context.Context, request *v1pb.Unde
 	return convertToProject(project), nil
 }
 
+// ArchiveProject archives a project, the open issues are canceled or frozen by the open issue action.
+func (s *ProjectService) ArchiveProject(ctx context.Context, request *v1pb.ArchiveProjectRequest) (*v1pb.Project, error) {
+	project, err := s.getProjectMessage(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.InvalidArgument, "project %q has been archived", request.Name)
+	}
+	if project.ResourceID == api.DefaultProjectID {
+		return nil, status.Errorf(codes.InvalidArgument, "default project cannot be archived")
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{ProjectID: &project.ResourceID, ShowDeleted: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list databases, error: %v", err)
+	}
+	if len(databases) > 0 && !request.Force {
+		return nil, status.Errorf(codes.FailedPrecondition, "transfer all databases to the default project before archiving the project")
+	}
+	openIssues, err := s.store.ListIssueV2(ctx, &store.FindIssueMessage{ProjectIDs: &[]string{project.ResourceID}, StatusList: []api.IssueStatus{api.IssueOpen}})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list open issues, error: %v", err)
+	}
+	var pendingTaskRuns []*store.TaskRunMessage
+	if request.OpenIssueAction == v1pb.ArchiveProjectRequest_CLOSE {
+		for _, issue := range openIssues {
+			if issue.PipelineUID == nil {
+				continue
+			}
+			taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+				PipelineUID: issue.PipelineUID,
+				Status:      &[]api.TaskRunStatus{api.TaskRunRunning, api.TaskRunPending},
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list task runs, error: %v", err)
+			}
+			for _, taskRun := range taskRuns {
+				if taskRun.Status == api.TaskRunRunning {
+					return nil, status.Errorf(codes.FailedPrecondition, "cannot close the open issues because issue %d has running task runs", issue.UID)
+				}
+				pendingTaskRuns = append(pendingTaskRuns, taskRun)
+			}
+		}
+	}
+
+	if len(databases) > 0 {
+		if _, err := s.store.BatchUpdateDatabaseProject(ctx, databases, api.DefaultProjectID, user.ID); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to transfer databases, error: %v", err)
+		}
+	}
+	// The frozen issues stay open, their tasks are not scheduled while the project is archived.
+	if request.OpenIssueAction == v1pb.ArchiveProjectRequest_CLOSE {
+		for _, taskRun := range pendingTaskRuns {
+			if _, err := s.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
+				ID:        taskRun.ID,
+				UpdaterID: user.ID,
+				Status:    api.TaskRunCanceled,
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to cancel task run %d, error: %v", taskRun.ID, err)
+			}
+		}
+		reason := request.Reason
+		if reason == "" {
+			reason = fmt.Sprintf("The project %q is archived.", project.Title)
+		}
+		for _, issue := range openIssues {
+			if err := webhook.ChangeIssueStatus(ctx, s.store, s.webhookManager, issue, api.IssueCanceled, user, reason); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to cancel issue %d, error: %v", issue.UID, err)
+			}
+		}
+	}
+
+	project, err = s.store.UpdateProjectV2(ctx, &store.UpdateProjectMessage{
+		UpdaterID:  user.ID,
+		ResourceID: project.ResourceID,
+		Delete:     &deletePatch,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return convertToProject(project), nil
+}
+
+// RestoreProject restores an archived project, the frozen issues are resumed.
+func (s *ProjectService) RestoreProject(ctx context.Context, request *v1pb.RestoreProjectRequest) (*v1pb.Project, error) {
+	return s.UndeleteProject(ctx, &v1pb.UndeleteProjectRequest{Name: request.Name})
+}
+
 // GetIamPolicy returns the IAM policy for a project.
 func (s *ProjectService) GetIamPolicy(ctx context.Context, request *v1pb.GetIamPolicyRequest) (*v1pb.IamPolicy, error) {
 	projectID, err := common.GetProjectID(request.Resource)
@@ -373,8 +546,6 @@ func (s *ProjectService) BatchGetIamPolicy(ctx context.Context, request *v1pb.Ba
 
 // SetIamPolicy sets the IAM policy for a project.
 func (s *ProjectService) SetIamPolicy(ctx context.Context, request *v1pb.SetIamPolicyRequest) (*v1pb.IamPolicy, error) {
-	var oldIamPolicyMsg *store.IamPolicyMessage
-
 	projectID, err := common.GetProjectID(request.Resource)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
@@ -413,8 +584,160 @@ func (s *ProjectService) SetIamPolicy(ctx context.Context, request *v1pb.SetIamP
 	if request.Etag != "" && request.Etag != policyMessage.Etag {
 		return nil, status.Errorf(codes.Aborted, "there is concurrent update to the project iam policy, please refresh and try again.")
 	}
-	oldIamPolicyMsg = policyMessage
 
+	return s.updateProjectIamPolicy(ctx, project, policyMessage, policy, creatorUID)
+}
+
+// AddProjectMember adds the members to the project with the role.
+func (s *ProjectService) AddProjectMember(ctx context.Context, request *v1pb.AddProjectMemberRequest) (*v1pb.IamPolicy, error) {
+	projectID, err := common.GetProjectID(request.Project)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	creatorUID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "cannot get principal ID from context")
+	}
+	if len(request.Members) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "members are required")
+	}
+	condition, err := buildProjectMemberCondition(request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", request.Project)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", request.Project)
+	}
+
+	policyMessage, err := s.store.GetProjectIamPolicy(ctx, project.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project iam policy with error: %v", err.Error())
+	}
+	v1Policy, err := convertToV1IamPolicy(ctx, s.store, policyMessage)
+	if err != nil {
+		return nil, err
+	}
+	// Append the members to the binding with the same role and condition, same as the grant request does.
+	updated := false
+	for _, binding := range v1Policy.Bindings {
+		if binding.Role != request.Role || binding.GetCondition().GetExpression() != condition.Expression {
+			continue
+		}
+		binding.Members = append(binding.Members, request.Members...)
+		updated = true
+		break
+	}
+	if !updated {
+		v1Policy.Bindings = append(v1Policy.Bindings, &v1pb.Binding{
+			Role:      request.Role,
+			Members:   request.Members,
+			Condition: condition,
+		})
+	}
+	if err := s.validateIAMPolicy(ctx, v1Policy); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	policy, err := convertToStoreIamPolicy(ctx, s.store, v1Policy)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateProjectIamPolicy(ctx, project, policyMessage, policy, creatorUID)
+}
+
+// buildProjectMemberCondition builds the binding condition from the expire time, databases and row limit.
+func buildProjectMemberCondition(request *v1pb.AddProjectMemberRequest) (*expr.Expr, error) {
+	condition := &expr.Expr{
+		Title:       request.GetCondition().GetTitle(),
+		Description: request.GetCondition().GetDescription(),
+		Expression:  request.GetCondition().GetExpression(),
+	}
+	if request.ExpireTime == nil && len(request.Databases) == 0 && request.RowLimit == 0 {
+		return condition, nil
+	}
+	if condition.Expression != "" {
+		return nil, errors.Errorf("condition expression cannot be set together with expire_time, databases or row_limit")
+	}
+	for _, database := range request.Databases {
+		if _, _, err := common.GetInstanceDatabaseID(database); err != nil {
+			return nil, errors.Wrapf(err, "invalid database %q", database)
+		}
+	}
+	if request.RowLimit < 0 {
+		return nil, errors.Errorf("row_limit cannot be negative")
+	}
+	var expireTime *time.Time
+	if request.ExpireTime != nil {
+		t := request.ExpireTime.AsTime()
+		if !t.After(time.Now()) {
+			return nil, errors.Errorf("expire_time must be in the future")
+		}
+		expireTime = &t
+	}
+	expression, err := common.BuildBindingConditionExpression(request.Databases, expireTime, request.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	condition.Expression = expression
+	return condition, nil
+}
+
+// ListExpiringBindings lists the bindings in the project that expire within the given time.
+func (s *ProjectService) ListExpiringBindings(ctx context.Context, request *v1pb.ListExpiringBindingsRequest) (*v1pb.ListExpiringBindingsResponse, error) {
+	project, err := s.getProjectMessage(ctx, request.Project)
+	if err != nil {
+		return nil, err
+	}
+	within := 7 * 24 * time.Hour
+	if request.Within != nil {
+		within = request.Within.AsDuration()
+	}
+	if within <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "within must be positive")
+	}
+
+	policyMessage, err := s.store.GetProjectIamPolicy(ctx, project.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project iam policy with error: %v", err.Error())
+	}
+	v1Policy, err := convertToV1IamPolicy(ctx, s.store, policyMessage)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(within)
+	response := &v1pb.ListExpiringBindingsResponse{}
+	for _, binding := range v1Policy.Bindings {
+		expireTime, err := common.GetBindingExpireTime(binding.GetCondition().GetExpression())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get the expire time of the binding, error: %v", err)
+		}
+		if expireTime == nil || expireTime.After(deadline) {
+			continue
+		}
+		response.Bindings = append(response.Bindings, &v1pb.ExpiringBinding{
+			Role:       binding.Role,
+			Members:    binding.Members,
+			Condition:  binding.Condition,
+			ExpireTime: timestamppb.New(*expireTime),
+		})
+	}
+	slices.SortFunc(response.Bindings, func(a, b *v1pb.ExpiringBinding) int {
+		return a.ExpireTime.AsTime().Compare(b.ExpireTime.AsTime())
+	})
+	return response, nil
+}
+
+func (s *ProjectService) updateProjectIamPolicy(ctx context.Context, project *store.ProjectMessage, oldIamPolicyMsg *store.IamPolicyMessage, policy *storepb.IamPolicy, updaterUID int) (*v1pb.IamPolicy, error) {
 	policyPayload, err := protojson.Marshal(policy)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
@@ -427,7 +750,7 @@ func (s *ProjectService) SetIamPolicy(ctx context.Context, request *v1pb.SetIamP
 		InheritFromParent: false,
 		// Enforce cannot be false while creating a policy.
 		Enforce: true,
-	}, creatorUID); err != nil {
+	}, updaterUID); err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
@@ -445,6 +768,10 @@ func (s *ProjectService) SetIamPolicy(ctx context.Context, request *v1pb.SetIamP
 		setServiceData(p)
 	}
 
+	event := newWorkspaceEvent(ctx, v1pb.WorkspaceEvent_PROJECT_MEMBERS_UPDATED, common.FormatProject(project.ResourceID), project.Title)
+	event.Project = event.Resource
+	s.webhookManager.PublishEvent(ctx, event)
+
 	return convertToV1IamPolicy(ctx, s.store, iamPolicyMessage)
 }
 
@@ -720,9 +1047,18 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 			}
 			update.ActivityList = types
 		case "direct_message":
-			update.Payload = &storepb.ProjectWebhookPayload{
-				DirectMessage: request.Webhook.DirectMessage,
+			if update.Payload == nil {
+				update.Payload = proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
+			}
+			update.Payload.DirectMessage = request.Webhook.DirectMessage
+		case "integration_key":
+			if isIncidentWebhookType(webhook.Type) && request.Webhook.IntegrationKey == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "integration key is required for %s", convertWebhookTypeString(webhook.Type))
+			}
+			if update.Payload == nil {
+				update.Payload = proto.Clone(webhook.Payload).(*storepb.ProjectWebhookPayload)
 			}
+			update.Payload.IntegrationKey = request.Webhook.IntegrationKey
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid field %q", path)
 		}
@@ -848,6 +1184,13 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 				Description: "This is a test issue",
 			},
 			Project: &webhookplugin.Project{Name: project.Title},
+			// The incident webhooks only post the incidents, resolving the incident which doesn't exist verifies the integration key.
+			Incident: &webhookplugin.Incident{
+				Action:   webhookplugin.IncidentResolve,
+				DedupKey: "bytebase-test-webhook",
+				Summary:  fmt.Sprintf("Test webhook %q", webhook.Title),
+			},
+			IntegrationKey: webhook.Payload.GetIntegrationKey(),
 		},
 	)
 	if err != nil {
@@ -866,17 +1209,26 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 	if err != nil {
 		return nil, err
 	}
+	if isIncidentWebhookType(tp) && webhook.IntegrationKey == "" {
+		return nil, errors.Errorf("integration key is required for %s", webhook.Type)
+	}
 	return &store.ProjectWebhookMessage{
 		Type:         tp,
 		URL:          webhook.Url,
 		Title:        webhook.Title,
 		ActivityList: activityTypes,
 		Payload: &storepb.ProjectWebhookPayload{
-			DirectMessage: webhook.DirectMessage,
+			DirectMessage:  webhook.DirectMessage,
+			IntegrationKey: webhook.IntegrationKey,
 		},
 	}, nil
 }
 
+// isIncidentWebhookType returns true if the webhook opens the incidents with the integration key.
+func isIncidentWebhookType(tp string) bool {
+	return tp == "bb.plugin.webhook.pagerduty" || tp == "bb.plugin.webhook.opsgenie"
+}
+
 func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error) {
 	var result []string
 	for _, tp := range types {
@@ -925,6 +1277,8 @@ func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error)
 			result = append(result, string(api.ActivityNotifyIssueApproved))
 		case v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT:
 			result = append(result, string(api.ActivityNotifyPipelineRollout))
+		case v1pb.Activity_TYPE_NOTIFY_RELEASE_COMPLETE:
+			result = append(result, string(api.ActivityNotifyReleaseComplete))
 		default:
 			return nil, common.Errorf(common.Invalid, "unsupported activity type: %v", tp)
 		}
@@ -978,6 +1332,8 @@ func convertNotificationTypeStrings(types []string) []v1pb.Activity_Type {
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_ISSUE_APPROVED)
 		case string(api.ActivityNotifyPipelineRollout):
 			result = append(result, v1pb.Activity_TYPE_NOTIFY_PIPELINE_ROLLOUT)
+		case string(api.ActivityNotifyReleaseComplete):
+			result = append(result, v1pb.Activity_TYPE_NOTIFY_RELEASE_COMPLETE)
 		default:
 			result = append(result, v1pb.Activity_TYPE_UNSPECIFIED)
 		}
@@ -1004,6 +1360,10 @@ func convertToAPIWebhookTypeString(tp v1pb.Webhook_Type) (string, error) {
 		return "bb.plugin.webhook.wecom", nil
 	case v1pb.Webhook_TYPE_CUSTOM:
 		return "bb.plugin.webhook.custom", nil
+	case v1pb.Webhook_TYPE_PAGERDUTY:
+		return "bb.plugin.webhook.pagerduty", nil
+	case v1pb.Webhook_TYPE_OPSGENIE:
+		return "bb.plugin.webhook.opsgenie", nil
 	default:
 		return "", common.Errorf(common.Invalid, "webhook type %q is not supported", tp)
 	}
@@ -1025,6 +1385,10 @@ func convertWebhookTypeString(tp string) v1pb.Webhook_Type {
 		return v1pb.Webhook_TYPE_WECOM
 	case "bb.plugin.webhook.custom":
 		return v1pb.Webhook_TYPE_CUSTOM
+	case "bb.plugin.webhook.pagerduty":
+		return v1pb.Webhook_TYPE_PAGERDUTY
+	case "bb.plugin.webhook.opsgenie":
+		return v1pb.Webhook_TYPE_OPSGENIE
 	default:
 		return v1pb.Webhook_TYPE_UNSPECIFIED
 	}
@@ -1243,13 +1607,75 @@ func convertToProject(projectMessage *store.ProjectMessage) *v1pb.Project {
 		ForceIssueLabels:           projectMessage.Setting.ForceIssueLabels,
 		AllowModifyStatement:       projectMessage.Setting.AllowModifyStatement,
 		AutoResolveIssue:           projectMessage.Setting.AutoResolveIssue,
+		StatementVariables:         convertToV1StatementVariables(projectMessage.Setting.StatementVariables),
+		TicketSync:                 convertToV1TicketSync(projectMessage.Setting.GetTicketSync()),
+	}
+}
+
+func convertToV1StatementVariables(variables []*storepb.StatementVariable) []*v1pb.StatementVariable {
+	var v1Variables []*v1pb.StatementVariable
+	for _, variable := range variables {
+		v1Variable := &v1pb.StatementVariable{
+			Name:              variable.Name,
+			DefaultValue:      variable.DefaultValue,
+			EnvironmentValues: make(map[string]string),
+			DatabaseValues:    variable.DatabaseValues,
+		}
+		for environmentID, value := range variable.EnvironmentValues {
+			v1Variable.EnvironmentValues[common.FormatEnvironment(environmentID)] = value
+		}
+		v1Variables = append(v1Variables, v1Variable)
 	}
+	return v1Variables
+}
+
+// convertToStoreStatementVariables converts the statement variables and validates the names and the resource names of the values.
+func convertToStoreStatementVariables(v1Variables []*v1pb.StatementVariable) ([]*storepb.StatementVariable, error) {
+	var variables []*storepb.StatementVariable
+	names := make(map[string]bool)
+	for _, v1Variable := range v1Variables {
+		if !utils.StatementVariableNameRegexp.MatchString(v1Variable.Name) {
+			return nil, errors.Errorf("invalid statement variable name %q, it must start with an upper-case letter and only contain upper-case letters, digits and underscores", v1Variable.Name)
+		}
+		if names[v1Variable.Name] {
+			return nil, errors.Errorf("duplicate statement variable name %q", v1Variable.Name)
+		}
+		names[v1Variable.Name] = true
+
+		variable := &storepb.StatementVariable{
+			Name:              v1Variable.Name,
+			DefaultValue:      v1Variable.DefaultValue,
+			EnvironmentValues: make(map[string]string),
+			DatabaseValues:    make(map[string]string),
+		}
+		for environment, value := range v1Variable.EnvironmentValues {
+			environmentID, err := common.GetEnvironmentID(environment)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid environment of statement variable %q", v1Variable.Name)
+			}
+			variable.EnvironmentValues[environmentID] = value
+		}
+		for database, value := range v1Variable.DatabaseValues {
+			instanceID, databaseName, err := common.GetInstanceDatabaseID(database)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid database of statement variable %q", v1Variable.Name)
+			}
+			variable.DatabaseValues[common.FormatDatabase(instanceID, databaseName)] = value
+		}
+		variables = append(variables, variable)
+	}
+	return variables, nil
 }
 
 func convertToProjectMessage(resourceID string, project *v1pb.Project) (*store.ProjectMessage, error) {
+	statementVariables, err := convertToStoreStatementVariables(project.StatementVariables)
+	if err != nil {
+		return nil, err
+	}
 	setting := &storepb.Project{
 		AllowModifyStatement: project.AllowModifyStatement,
 		AutoResolveIssue:     project.AutoResolveIssue,
+		StatementVariables:   statementVariables,
 	}
 	return &store.ProjectMessage{
 		ResourceID: resourceID,
@@ -0,0 +1,122 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	mysqldb "github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	pgdb "github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// GetQueryPlan explains the query and normalizes the plan to a tree.
+func (s *SQLService) GetQueryPlan(ctx context.Context, request *v1pb.GetQueryPlanRequest) (*v1pb.QueryPlan, error) {
+	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var explainFormat string
+	var convert func(string) (*v1pb.QueryPlanNode, error)
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		explainFormat, convert = "EXPLAIN (FORMAT JSON) %s", pgdb.ConvertExplainJSON
+	case storepb.Engine_MYSQL:
+		explainFormat, convert = "EXPLAIN FORMAT=JSON %s", mysqldb.ConvertExplainJSON
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "query plan is not supported for engine %s", instance.Engine.String())
+	}
+
+	list, err := base.SplitMultiSQL(instance.Engine, request.Statement)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to split statement: %v", err)
+	}
+	list = base.FilterEmptySQL(list)
+	if len(list) != 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "expect one statement to explain, got %d", len(list))
+	}
+	statement := strings.TrimRight(strings.TrimSpace(list[0].Text), ";")
+	if err := validateQueryRequest(instance, statement); err != nil {
+		return nil, err
+	}
+
+	dataSource, err := s.getQueryDataSource(ctx, user, database, request.DataSourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The plan may reveal the tables and the row estimates, so the caller needs the same access as the query.
+	spans, err := base.GetQuerySpan(
+		ctx,
+		base.GetQuerySpanContext{
+			InstanceID:                    instance.ResourceID,
+			GetDatabaseMetadataFunc:       BuildGetDatabaseMetadataFunc(s.store),
+			ListDatabaseNamesFunc:         BuildListDatabaseNamesFunc(s.store),
+			GetLinkedDatabaseMetadataFunc: BuildGetLinkedDatabaseMetadataFunc(s.store, instance.Engine),
+		},
+		instance.Engine,
+		statement,
+		database.DatabaseName,
+		"",
+		store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get query span: %v", err.Error())
+	}
+	if s.licenseService.IsFeatureEnabled(api.FeatureAccessControl) == nil {
+		if err := s.accessCheck(ctx, instance, user, spans, 0, false /* isAdmin */, false /* isExport */); err != nil {
+			return nil, err
+		}
+	}
+	if statement, err = s.rewriteRowAccess(ctx, instance, database, user, statement); err != nil {
+		return nil, err
+	}
+
+	if err := s.reserveConnection(instance); err != nil {
+		return nil, err
+	}
+	defer s.stateCfg.InstanceOutstandingConnections.Decrement(instance.UID)
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, dataSource.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return nil, status.Errorf(codes.Internal, "database connection not found")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+	rows, err := sqlDB.QueryContext(ctx, fmt.Sprintf(explainFormat, statement))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to explain statement: %v", err)
+	}
+	defer rows.Close()
+	var raw string
+	if rows.Next() {
+		if err := rows.Scan(&raw); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan query plan: %v", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read query plan: %v", err)
+	}
+
+	root, err := convert(raw)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert query plan: %v", err)
+	}
+	return &v1pb.QueryPlan{
+		Root: root,
+		Raw:  raw,
+	}, nil
+}
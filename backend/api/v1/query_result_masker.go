@@ -300,6 +300,12 @@ func getMaskerByMaskingAlgorithmAndLevel(algorithm *storepb.MaskingAlgorithmSett
 		return masker.NewMD5Masker(m.Md5Mask.Salt)
 	case *storepb.MaskingAlgorithmSetting_Algorithm_InnerOuterMask_:
 		return masker.NewInnerOuterMasker(m.InnerOuterMask.Type, m.InnerOuterMask.PrefixLen, m.InnerOuterMask.SuffixLen, m.InnerOuterMask.Substitution)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_HashMask_:
+		return masker.NewHashMasker(m.HashMask.Salt)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask_:
+		return masker.NewFormatPreservingEncryptionMasker(m.FormatPreservingEncryptionMask.Key, m.FormatPreservingEncryptionMask.Tweak)
+	case *storepb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+		return masker.NewDateShiftMasker(m.DateShiftMask.MaxShiftDays, m.DateShiftMask.Salt)
 	}
 	return masker.NewNoneMasker()
 }
@@ -0,0 +1,154 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// queryStreamFrameRows is the maximum number of rows in a frame.
+	queryStreamFrameRows = 1000
+	// queryStreamFrameSize is the maximum size of the rows in a frame.
+	queryStreamFrameSize = 1024 * 1024
+)
+
+// QueryStream executes a SQL query and streams the results in frames.
+func (s *SQLService) QueryStream(request *v1pb.QueryRequest, server v1pb.SQLService_QueryStreamServer) error {
+	response, err := s.query(server.Context(), request)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range response.Results {
+		rows := result.Rows
+		header, ok := proto.Clone(result).(*v1pb.QueryResult)
+		if !ok {
+			return status.Errorf(codes.Internal, "failed to clone query result")
+		}
+		header.Rows = nil
+		if err := server.Send(&v1pb.QueryStreamResponse{
+			Frame: &v1pb.QueryStreamResponse_Header{Header: header},
+		}); err != nil {
+			return err
+		}
+
+		for len(rows) > 0 {
+			n, size := 0, 0
+			for n < len(rows) && n < queryStreamFrameRows && (n == 0 || size < queryStreamFrameSize) {
+				size += proto.Size(rows[n])
+				n++
+			}
+			if err := server.Send(&v1pb.QueryStreamResponse{
+				Frame: &v1pb.QueryStreamResponse_Rows_{Rows: &v1pb.QueryStreamResponse_Rows{Rows: rows[:n]}},
+			}); err != nil {
+				return err
+			}
+			rows = rows[n:]
+		}
+	}
+
+	return server.Send(&v1pb.QueryStreamResponse{
+		Frame: &v1pb.QueryStreamResponse_Footer_{Footer: &v1pb.QueryStreamResponse_Footer{
+			Advices:     response.Advices,
+			AllowExport: response.AllowExport,
+		}},
+	})
+}
+
+// CancelQuery cancels the running query of the caller.
+func (s *SQLService) CancelQuery(ctx context.Context, request *v1pb.CancelQueryRequest) (*emptypb.Empty, error) {
+	if request.QueryId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "query id is required")
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	cancelAny, ok := s.stateCfg.RunningQueriesCancelFunc.Load(getRunningQueryKey(user.ID, request.QueryId))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "query %q is not running", request.QueryId)
+	}
+	cancel, ok := cancelAny.(context.CancelFunc)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "expect cancel func to be of type context.CancelFunc but found %T", cancelAny)
+	}
+	cancel()
+	return &emptypb.Empty{}, nil
+}
+
+// getQueryCancelFunc returns the cancel func of the query running on the conn.
+// Besides canceling the context, it cancels the query in the database by a separate connection for the engines
+// supporting it, because the drivers may only close the connection and leave the query running.
+func (*SQLService) getQueryCancelFunc(ctx context.Context, engine storepb.Engine, sqlDB *sql.DB, conn *sql.Conn, cancelCtx context.CancelFunc) (context.CancelFunc, error) {
+	var connectionIDQuery string
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		connectionIDQuery = "SELECT pg_backend_pid()"
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		connectionIDQuery = "SELECT CONNECTION_ID()"
+	}
+	if connectionIDQuery == "" || sqlDB == nil || conn == nil {
+		return cancelCtx, nil
+	}
+
+	var connectionID int64
+	if err := conn.QueryRowContext(ctx, connectionIDQuery).Scan(&connectionID); err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection id")
+	}
+	cancelQuery := getKillQueryStatement(engine, connectionID)
+	return func() {
+		if _, err := sqlDB.ExecContext(context.Background(), cancelQuery); err != nil {
+			slog.Warn("failed to cancel query", slog.Int64("connectionID", connectionID), log.BBError(err))
+		}
+		cancelCtx()
+	}, nil
+}
+
+// getKillQueryStatement returns the statement canceling the running query of the connection,
+// it's empty if the engine doesn't support it.
+func getKillQueryStatement(engine storepb.Engine, connectionID int64) string {
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		return fmt.Sprintf("SELECT pg_cancel_backend(%d)", connectionID)
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		return fmt.Sprintf("KILL QUERY %d", connectionID)
+	default:
+		return ""
+	}
+}
+
+func getRunningQueryKey(userUID int, queryID string) string {
+	return fmt.Sprintf("%d/%s", userUID, queryID)
+}
+
+// pageResults keeps the `limit` rows after the `offset` rows of the results, which are queried with
+// `offset + limit + 1` rows to know if there are more rows.
+func pageResults(results []*v1pb.QueryResult, offset, limit int) {
+	for _, result := range results {
+		if offset > 0 {
+			if offset >= len(result.Rows) {
+				result.Rows = nil
+			} else {
+				result.Rows = result.Rows[offset:]
+			}
+		}
+		if limit > 0 && len(result.Rows) > limit {
+			result.Rows = result.Rows[:limit]
+			result.HasMore = true
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// RetryAfterMetadataKey is the metadata key of the seconds to wait before retrying the rate limited request.
+const RetryAfterMetadataKey = "retry-after"
+
+// readMethodPrefixes are the prefixes of the methods in the READ method class.
+var readMethodPrefixes = []string{"Get", "List", "Search", "BatchGet"}
+
+var rateLimitedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "bytebase_api_rate_limited_requests_total",
+	Help: "The number of the API requests rejected by the rate limits.",
+}, []string{"method_class", "principal_type"})
+
+// RateLimitInterceptor limits the request rate of the principals with the token buckets of the rate limit setting.
+type RateLimitInterceptor struct {
+	store *store.Store
+
+	mu sync.Mutex
+	// limiters are the token buckets keyed by the principal and the method class.
+	limiters map[rateLimiterKey]*rate.Limiter
+}
+
+type rateLimiterKey struct {
+	principalID int
+	methodClass storepb.RateLimitSetting_MethodClass
+}
+
+// NewRateLimitInterceptor returns a new v1 API rate limit interceptor.
+func NewRateLimitInterceptor(store *store.Store) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		store:    store,
+		limiters: make(map[rateLimiterKey]*rate.Limiter),
+	}
+}
+
+// RateLimitInterceptor is the unary interceptor for gRPC API.
+func (in *RateLimitInterceptor) RateLimitInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := in.checkRateLimit(ctx, serverInfo.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// RateLimitStreamInterceptor is the stream interceptor for gRPC API, the stream is limited on start.
+func (in *RateLimitInterceptor) RateLimitStreamInterceptor(request any, ss grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := in.checkRateLimit(ss.Context(), serverInfo.FullMethod); err != nil {
+		return err
+	}
+	return handler(request, ss)
+}
+
+func (in *RateLimitInterceptor) checkRateLimit(ctx context.Context, fullMethod string) error {
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok || principalID == api.SystemBotID {
+		return nil
+	}
+	setting, err := in.store.GetRateLimitSetting(ctx)
+	if err != nil {
+		// Don't block the requests if the setting is unavailable.
+		slog.Error("failed to get rate limit setting", log.BBError(err))
+		return nil
+	}
+	if len(setting.UserLimits) == 0 && len(setting.ServiceAccountLimits) == 0 && len(setting.Overrides) == 0 {
+		return nil
+	}
+	user, err := in.store.GetUserByID(ctx, principalID)
+	if err != nil {
+		slog.Error("failed to get user for rate limit", slog.Int("principal", principalID), log.BBError(err))
+		return nil
+	}
+	if user == nil {
+		return nil
+	}
+
+	methodClass := getMethodClass(fullMethod)
+	limit := getRateLimit(setting, user, methodClass)
+	key := rateLimiterKey{principalID: principalID, methodClass: methodClass}
+	if limit == nil {
+		in.mu.Lock()
+		delete(in.limiters, key)
+		in.mu.Unlock()
+		return nil
+	}
+
+	limiter := in.getLimiter(key, limit)
+	reservation := limiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+	// Give the token back so that the rejected requests don't consume the bucket.
+	reservation.Cancel()
+	rateLimitedRequests.WithLabelValues(methodClass.String(), string(user.Type)).Inc()
+
+	retryAfter := int(math.Ceil(delay.Seconds()))
+	if delay == rate.InfDuration {
+		retryAfter = 1
+	}
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterMetadataKey, fmt.Sprintf("%d", retryAfter))); err != nil {
+		slog.Warn("failed to set retry-after metadata", log.BBError(err))
+	}
+	return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s requests of %s, retry after %d seconds", methodClass, common.FormatUserEmail(user.Email), retryAfter)
+}
+
+// getLimiter gets the token bucket of the key, the bucket is updated if the limit is changed.
+func (in *RateLimitInterceptor) getLimiter(key rateLimiterKey, limit *storepb.RateLimitSetting_Limit) *rate.Limiter {
+	burst := int(limit.Burst)
+	if burst <= 0 {
+		burst = int(math.Ceil(limit.Rate))
+	}
+
+	in.mu.Lock()
+	defer in.mu.Unlock()
+	limiter, ok := in.limiters[key]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit.Rate), burst)
+		in.limiters[key] = limiter
+		return limiter
+	}
+	now := time.Now()
+	if limiter.Limit() != rate.Limit(limit.Rate) {
+		limiter.SetLimitAt(now, rate.Limit(limit.Rate))
+	}
+	if limiter.Burst() != burst {
+		limiter.SetBurstAt(now, burst)
+	}
+	return limiter
+}
+
+func getMethodClass(fullMethod string) storepb.RateLimitSetting_MethodClass {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return storepb.RateLimitSetting_READ
+		}
+	}
+	return storepb.RateLimitSetting_WRITE
+}
+
+// getRateLimit gets the limit of the user for the method class, nil means unlimited.
+// The overrides of the user take precedence over the user and service account limits.
+func getRateLimit(setting *storepb.RateLimitSetting, user *store.UserMessage, methodClass storepb.RateLimitSetting_MethodClass) *storepb.RateLimitSetting_Limit {
+	limits := setting.UserLimits
+	if user.Type == api.ServiceAccount {
+		limits = setting.ServiceAccountLimits
+	}
+	principal := common.FormatUserEmail(user.Email)
+	for _, override := range setting.Overrides {
+		if override.Principal == principal {
+			limits = override.Limits
+			break
+		}
+	}
+	for _, limit := range limits {
+		if limit.MethodClass == methodClass {
+			return limit
+		}
+	}
+	return nil
+}
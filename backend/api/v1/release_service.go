@@ -0,0 +1,237 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ReleaseService implements the release service.
+type ReleaseService struct {
+	v1pb.UnimplementedReleaseServiceServer
+	store *store.Store
+}
+
+// NewReleaseService creates a new ReleaseService.
+func NewReleaseService(store *store.Store) *ReleaseService {
+	return &ReleaseService{
+		store: store,
+	}
+}
+
+// CreateRelease creates a release.
+func (s *ReleaseService) CreateRelease(ctx context.Context, request *v1pb.CreateReleaseRequest) (*v1pb.Release, error) {
+	if request.Release == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "release must be set")
+	}
+	if !isValidResourceID(request.ReleaseId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid release id %q", request.ReleaseId)
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	project, err := s.getProject(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.store.GetRelease(ctx, &store.FindReleaseMessage{ProjectID: &project.ResourceID, ResourceID: &request.ReleaseId})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get release, error: %v", err)
+	}
+	if release != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "release %q already exists", request.ReleaseId)
+	}
+
+	release, err = s.store.CreateRelease(ctx, &store.ReleaseMessage{
+		ProjectID:  project.ResourceID,
+		ResourceID: request.ReleaseId,
+		Payload:    convertV1ReleasePayload(request.Release),
+		CreatorID:  principalID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create release, error: %v", err)
+	}
+	return s.convertToRelease(ctx, release)
+}
+
+// GetRelease gets a release.
+func (s *ReleaseService) GetRelease(ctx context.Context, request *v1pb.GetReleaseRequest) (*v1pb.Release, error) {
+	release, err := s.getRelease(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToRelease(ctx, release)
+}
+
+// ListReleases lists the releases in a project.
+func (s *ReleaseService) ListReleases(ctx context.Context, request *v1pb.ListReleasesRequest) (*v1pb.ListReleasesResponse, error) {
+	project, err := s.getProject(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset, err := parseLimitAndOffset(request.PageToken, int(request.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	limitPlusOne := limit + 1
+
+	releases, err := s.store.ListReleases(ctx, &store.FindReleaseMessage{
+		ProjectID: &project.ResourceID,
+		Limit:     &limitPlusOne,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list releases, error: %v", err)
+	}
+
+	var nextPageToken string
+	if len(releases) == limitPlusOne {
+		releases = releases[:limit]
+		if nextPageToken, err = getPageToken(limit, offset+limit); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	resp := &v1pb.ListReleasesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, release := range releases {
+		v1Release, err := s.convertToRelease(ctx, release)
+		if err != nil {
+			return nil, err
+		}
+		resp.Releases = append(resp.Releases, v1Release)
+	}
+	return resp, nil
+}
+
+// UpdateRelease updates a release.
+func (s *ReleaseService) UpdateRelease(ctx context.Context, request *v1pb.UpdateReleaseRequest) (*v1pb.Release, error) {
+	if request.Release == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "release must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "principal ID not found")
+	}
+	release, err := s.getRelease(ctx, request.Release.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := release.Payload
+	newPayload := convertV1ReleasePayload(request.Release)
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			payload.Title = newPayload.Title
+		case "target_time":
+			payload.TargetTime = newPayload.TargetTime
+		case "notes":
+			payload.Notes = newPayload.Notes
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
+		}
+	}
+	if err := s.store.UpdateRelease(ctx, &store.UpdateReleaseMessage{
+		ProjectID:  release.ProjectID,
+		ResourceID: release.ResourceID,
+		UpdaterID:  principalID,
+		Payload:    payload,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update release, error: %v", err)
+	}
+
+	release, err = s.getRelease(ctx, request.Release.Name)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToRelease(ctx, release)
+}
+
+// DeleteRelease deletes a release. The issues in the release are kept and detached from it.
+func (s *ReleaseService) DeleteRelease(ctx context.Context, request *v1pb.DeleteReleaseRequest) (*emptypb.Empty, error) {
+	release, err := s.getRelease(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.DeleteRelease(ctx, release.ProjectID, release.ResourceID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete release, error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ReleaseService) getProject(ctx context.Context, name string) (*store.ProjectMessage, error) {
+	projectID, err := common.GetProjectID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project %q, error: %v", projectID, err)
+	}
+	if project == nil || project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	return project, nil
+}
+
+func (s *ReleaseService) getRelease(ctx context.Context, name string) (*store.ReleaseMessage, error) {
+	projectID, releaseID, err := common.GetProjectIDReleaseID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	release, err := s.store.GetRelease(ctx, &store.FindReleaseMessage{ProjectID: &projectID, ResourceID: &releaseID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get release, error: %v", err)
+	}
+	if release == nil {
+		return nil, status.Errorf(codes.NotFound, "release %q not found", name)
+	}
+	return release, nil
+}
+
+func (s *ReleaseService) convertToRelease(ctx context.Context, release *store.ReleaseMessage) (*v1pb.Release, error) {
+	creator, err := s.store.GetUserByID(ctx, release.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator, error: %v", err)
+	}
+	if creator == nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find the creator: %d", release.CreatorID)
+	}
+	return &v1pb.Release{
+		Name:           fmt.Sprintf("%s%s/%s%s", common.ProjectNamePrefix, release.ProjectID, common.ReleasesPrefix, release.ResourceID),
+		Title:          release.Payload.Title,
+		TargetTime:     release.Payload.TargetTime,
+		Notes:          release.Payload.Notes,
+		Creator:        common.FormatUserEmail(creator.Email),
+		CreateTime:     timestamppb.New(release.CreatedTime),
+		UpdateTime:     timestamppb.New(release.UpdatedTime),
+		IssueCount:     int32(release.IssueCount),
+		DoneIssueCount: int32(release.DoneIssueCount),
+	}, nil
+}
+
+func convertV1ReleasePayload(release *v1pb.Release) *storepb.Release {
+	return &storepb.Release{
+		Title:      release.Title,
+		TargetTime: release.TargetTime,
+		Notes:      release.Notes,
+	}
+}
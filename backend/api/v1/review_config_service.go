@@ -298,6 +298,10 @@ func validateSQLReviewRules(rules []*v1pb.SQLReviewRule) error {
 			if _, err := advisor.UnmarshalNamingCaseRulePayload(rule.Payload); err != nil {
 				return err
 			}
+		case advisor.SchemaRuleCustom:
+			if _, err := advisor.UnmarshalCustomRulePayload(rule.Payload); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
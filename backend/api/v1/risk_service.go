@@ -186,6 +186,10 @@ func convertToSource(source store.RiskSource) v1pb.Risk_Source {
 		return v1pb.Risk_REQUEST_EXPORT
 	case store.RiskSourceDatabaseDataExport:
 		return v1pb.Risk_DATA_EXPORT
+	case store.RiskSourceDatabaseRestore:
+		return v1pb.Risk_DATABASE_RESTORE
+	case store.RiskSourceDatabaseDataLoad:
+		return v1pb.Risk_DATA_LOAD
 	}
 	return v1pb.Risk_SOURCE_UNSPECIFIED
 }
@@ -204,6 +208,10 @@ func convertSource(source v1pb.Risk_Source) store.RiskSource {
 		return store.RiskRequestExport
 	case v1pb.Risk_DATA_EXPORT:
 		return store.RiskSourceDatabaseDataExport
+	case v1pb.Risk_DATABASE_RESTORE:
+		return store.RiskSourceDatabaseRestore
+	case v1pb.Risk_DATA_LOAD:
+		return store.RiskSourceDatabaseDataLoad
 	}
 	return store.RiskSourceUnknown
 }
@@ -61,18 +61,16 @@ func (s *RoleService) CreateRole(ctx context.Context, request *v1pb.CreateRoleRe
 		return nil, err
 	}
 
-	permissions := make(map[string]bool)
-	for _, v := range request.GetRole().GetPermissions() {
-		permissions[v] = true
+	permissions, err := iam.ResolvePermissions(request.GetRole().GetPermissions(), request.GetRole().GetExcludedPermissions())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid permissions: %v", err)
 	}
 	create := &store.RoleMessage{
-		ResourceID:  request.RoleId,
-		Name:        request.Role.Title,
-		Description: request.Role.Description,
-		Permissions: permissions,
-	}
-	if ok := iam.PermissionsExist(request.Role.Permissions...); !ok {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid permissions")
+		ResourceID:          request.RoleId,
+		Name:                request.Role.Title,
+		Description:         request.Role.Description,
+		Permissions:         permissions,
+		ExcludedPermissions: request.GetRole().GetExcludedPermissions(),
 	}
 	roleMessage, err := s.store.CreateRole(ctx, create, principalID)
 	if err != nil {
@@ -111,6 +109,12 @@ func (s *RoleService) UpdateRole(ctx context.Context, request *v1pb.UpdateRoleRe
 		UpdaterID:  principalID,
 		ResourceID: roleID,
 	}
+	updatePermissions := false
+	excludedPermissions := role.ExcludedPermissions
+	var permissions []string
+	for p := range role.Permissions {
+		permissions = append(permissions, p)
+	}
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
 		case "title":
@@ -118,18 +122,23 @@ func (s *RoleService) UpdateRole(ctx context.Context, request *v1pb.UpdateRoleRe
 		case "description":
 			patch.Description = &request.Role.Description
 		case "permissions":
-			permissions := make(map[string]bool)
-			for _, v := range request.GetRole().GetPermissions() {
-				permissions[v] = true
-			}
-			patch.Permissions = &permissions
-			if ok := iam.PermissionsExist(request.Role.Permissions...); !ok {
-				return nil, status.Errorf(codes.InvalidArgument, "invalid permissions")
-			}
+			updatePermissions = true
+			permissions = request.GetRole().GetPermissions()
+		case "excluded_permissions":
+			updatePermissions = true
+			excludedPermissions = request.GetRole().GetExcludedPermissions()
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path: %s", path)
 		}
 	}
+	if updatePermissions {
+		effectivePermissions, err := iam.ResolvePermissions(permissions, excludedPermissions)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid permissions: %v", err)
+		}
+		patch.Permissions = &effectivePermissions
+		patch.ExcludedPermissions = &excludedPermissions
+	}
 
 	roleMessage, err := s.store.UpdateRole(ctx, patch)
 	if err != nil {
@@ -141,6 +150,24 @@ func (s *RoleService) UpdateRole(ctx context.Context, request *v1pb.UpdateRoleRe
 	return convertToRole(roleMessage), nil
 }
 
+// ListPermissions lists the permissions grouped by the resource.
+func (*RoleService) ListPermissions(_ context.Context, _ *v1pb.ListPermissionsRequest) (*v1pb.ListPermissionsResponse, error) {
+	response := &v1pb.ListPermissionsResponse{}
+	for _, group := range iam.ListPermissionGroups() {
+		permissionGroup := &v1pb.PermissionGroup{
+			Resource: group.Resource,
+		}
+		for _, p := range group.Permissions {
+			permissionGroup.Permissions = append(permissionGroup.Permissions, &v1pb.Permission{
+				Name:               p,
+				ImpliedPermissions: iam.GetImpliedPermissions(p),
+			})
+		}
+		response.Groups = append(response.Groups, permissionGroup)
+	}
+	return response, nil
+}
+
 // DeleteRole deletes an existing role.
 func (s *RoleService) DeleteRole(ctx context.Context, request *v1pb.DeleteRoleRequest) (*emptypb.Empty, error) {
 	roleID, err := common.GetRoleID(request.Name)
@@ -187,9 +214,10 @@ func convertToRole(role *store.RoleMessage) *v1pb.Role {
 	}
 	slices.Sort(permissions)
 	return &v1pb.Role{
-		Name:        common.FormatRole(role.ResourceID),
-		Title:       role.Name,
-		Description: role.Description,
-		Permissions: permissions,
+		Name:                common.FormatRole(role.ResourceID),
+		Title:               role.Name,
+		Description:         role.Description,
+		Permissions:         permissions,
+		ExcludedPermissions: role.ExcludedPermissions,
 	}
 }
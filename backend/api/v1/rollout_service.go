@@ -7,11 +7,13 @@ import (
 	"log/slog"
 	"slices"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/jackc/pgtype"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
@@ -28,6 +30,7 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	webhookplugin "github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -253,7 +256,42 @@ func (s *RolloutService) GetTaskRunLog(ctx context.Context, request *v1pb.GetTas
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to list task run logs, error: %v", err)
 	}
-	return convertToTaskRunLog(request.Parent, logs), nil
+	taskRunLog := convertToTaskRunLog(request.Parent, logs)
+	if request.Offset < 0 || request.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset and limit must not be negative")
+	}
+	logFile, err := s.store.GetTaskRunLogFile(ctx, taskRunUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task run log file, error: %v", err)
+	}
+	if logFile != nil {
+		taskRunLog.ContentSize = logFile.Size
+		taskRunLog.ContentTruncated = logFile.Truncated
+		if request.Limit > 0 && request.Offset < int64(len(logFile.Content)) {
+			end := min(request.Offset+request.Limit, int64(len(logFile.Content)))
+			taskRunLog.Content = logFile.Content[request.Offset:end]
+		}
+	}
+	return taskRunLog, nil
+}
+
+// DownloadTaskRunLog downloads the full text log of the finished task run.
+func (s *RolloutService) DownloadTaskRunLog(ctx context.Context, request *v1pb.DownloadTaskRunLogRequest) (*httpbody.HttpBody, error) {
+	_, _, _, _, taskRunUID, err := common.GetProjectIDRolloutIDStageIDTaskIDTaskRunID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to get task run uid, error: %v", err)
+	}
+	logFile, err := s.store.GetTaskRunLogFile(ctx, taskRunUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task run log file, error: %v", err)
+	}
+	if logFile == nil {
+		return nil, status.Errorf(codes.NotFound, "the log of task run %d is not persisted", taskRunUID)
+	}
+	return &httpbody.HttpBody{
+		ContentType: "text/plain; charset=utf-8",
+		Data:        logFile.Content,
+	}, nil
 }
 
 func (s *RolloutService) GetTaskRunSession(ctx context.Context, request *v1pb.GetTaskRunSessionRequest) (*v1pb.TaskRunSession, error) {
@@ -493,6 +531,19 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot run the tasks because the issue is not approved")
 	}
 
+	var tasksToRun []*store.TaskMessage
+	for _, task := range stageToRunTasks {
+		if taskIDsToRunMap[task.ID] {
+			tasksToRun = append(tasksToRun, task)
+		}
+	}
+	if err := s.checkEnvironmentPipeline(ctx, issue, stages, stageToRun, tasksToRun); err != nil {
+		return nil, err
+	}
+	if err := s.checkStageApproval(ctx, stageToRun); err != nil {
+		return nil, err
+	}
+
 	var taskRunCreates []*store.TaskRunMessage
 	for _, task := range stageToRunTasks {
 		if !taskIDsToRunMap[task.ID] {
@@ -523,6 +574,19 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 		slog.Warn("failed to create issue comment", "issueUID", issue.UID, log.BBError(err))
 	}
 
+	// Running the restore tasks in the production stages initiates the rollback.
+	var incident webhookplugin.IncidentAction
+	for _, task := range tasksToRun {
+		action, err := webhook.GetTaskRunIncidentAction(ctx, s.store, task, api.TaskRunPending)
+		if err != nil {
+			slog.Warn("failed to get the incident action of the task run", "taskUID", task.ID, log.BBError(err))
+			continue
+		}
+		if action != "" {
+			incident = action
+			break
+		}
+	}
 	s.webhookManager.CreateEvent(ctx, &webhook.Event{
 		Actor:   user,
 		Type:    webhook.EventTypeTaskRunStatusUpdate,
@@ -530,8 +594,9 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 		Issue:   webhook.NewIssue(issue),
 		Project: webhook.NewProject(issue.Project),
 		TaskRunStatusUpdate: &webhook.EventTaskRunStatusUpdate{
-			Title:  issue.Title,
-			Status: api.TaskRunPending.String(),
+			Title:    issue.Title,
+			Status:   api.TaskRunPending.String(),
+			Incident: incident,
 		},
 	})
 
@@ -861,6 +926,28 @@ func validateSteps(steps []*v1pb.Plan_Step) error {
 				} else {
 					return errors.Errorf("unknown target %q", config.Target)
 				}
+				if err := validateVerificationQueries(config.VerificationQueries); err != nil {
+					return err
+				}
+				if config.SimulationInstance != "" {
+					if _, err := common.GetInstanceID(config.SimulationInstance); err != nil {
+						return errors.Wrapf(err, "invalid simulation instance %q", config.SimulationInstance)
+					}
+				}
+				if config.TransactionMode != v1pb.TransactionMode_TRANSACTION_MODE_UNSPECIFIED {
+					switch config.Type {
+					case v1pb.Plan_ChangeDatabaseConfig_MIGRATE, v1pb.Plan_ChangeDatabaseConfig_DATA:
+					default:
+						return errors.Errorf("transaction mode is only supported for MIGRATE and DATA types, got %v", config.Type)
+					}
+				}
+				if len(config.SessionVariables) > 0 {
+					switch config.Type {
+					case v1pb.Plan_ChangeDatabaseConfig_MIGRATE, v1pb.Plan_ChangeDatabaseConfig_DATA:
+					default:
+						return errors.Errorf("session variables are only supported for MIGRATE and DATA types, got %v", config.Type)
+					}
+				}
 			}
 		}
 		for _, spec := range step.Specs {
@@ -889,6 +976,30 @@ func validateSteps(steps []*v1pb.Plan_Step) error {
 	return nil
 }
 
+func validateVerificationQueries(queries []*v1pb.Plan_VerificationQuery) error {
+	for _, query := range queries {
+		if strings.TrimSpace(query.Statement) == "" {
+			return errors.Errorf("the statement of verification query %q cannot be empty", query.Title)
+		}
+		switch query.Type {
+		case v1pb.Plan_VerificationQuery_ROW_COUNT_EQUALS:
+			if query.ExpectedRowCount < 0 {
+				return errors.Errorf("the expected row count of verification query %q cannot be negative", query.Title)
+			}
+		case v1pb.Plan_VerificationQuery_VALUE_IN_RANGE:
+			if query.MinValue == nil && query.MaxValue == nil {
+				return errors.Errorf("verification query %q must set the min value or the max value", query.Title)
+			}
+			if query.MinValue != nil && query.MaxValue != nil && *query.MinValue > *query.MaxValue {
+				return errors.Errorf("the min value of verification query %q cannot be greater than the max value", query.Title)
+			}
+		default:
+			return errors.Errorf("unsupported type %q of verification query %q", query.Type, query.Title)
+		}
+	}
+	return nil
+}
+
 // GetPipelineCreate gets a pipeline create message from a plan.
 func GetPipelineCreate(ctx context.Context, s *store.Store, sheetManager *sheet.Manager, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, steps []*storepb.PlanConfig_Step, project *store.ProjectMessage) (*store.PipelineMessage, error) {
 	// Flatten all specs from steps.
@@ -1176,6 +1287,15 @@ func (s *RolloutService) canUserRunStageTasks(ctx context.Context, user *store.U
 			return true, nil
 		}
 	}
+	for _, group := range p.Groups {
+		inGroup, err := utils.IsUserInGroup(ctx, s.store, user, group)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to check the member of group %q", group)
+		}
+		if inGroup {
+			return true, nil
+		}
+	}
 
 	if user.ID == issue.Creator.ID {
 		for _, issueRole := range p.IssueRoles {
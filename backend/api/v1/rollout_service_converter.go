@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -103,6 +104,10 @@ func convertToPlanSpec(spec *storepb.PlanConfig_Spec) *v1pb.Plan_Spec {
 		v1Spec.Config = convertToPlanSpecChangeDatabaseConfig(v)
 	case *storepb.PlanConfig_Spec_ExportDataConfig:
 		v1Spec.Config = convertToPlanSpecExportDataConfig(v)
+	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
+		v1Spec.Config = convertToPlanSpecRestoreDatabaseConfig(v)
+	case *storepb.PlanConfig_Spec_LoadDataConfig:
+		v1Spec.Config = convertToPlanSpecLoadDataConfig(v)
 	}
 
 	return v1Spec
@@ -121,6 +126,8 @@ func convertToPlanSpecCreateDatabaseConfig(config *storepb.PlanConfig_Spec_Creat
 			Owner:        c.Owner,
 			Environment:  c.Environment,
 			Labels:       c.Labels,
+			Template:     c.Template,
+			Deployment:   c.Deployment,
 		},
 	}
 }
@@ -135,12 +142,33 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 			SchemaVersion: c.SchemaVersion,
 			GhostFlags:    c.GhostFlags,
 			PreUpdateBackupDetail: &v1pb.Plan_ChangeDatabaseConfig_PreUpdateBackupDetail{
-				Database: c.PreUpdateBackupDetail.GetDatabase(),
+				Database:  c.PreUpdateBackupDetail.GetDatabase(),
+				FullTable: c.PreUpdateBackupDetail.GetFullTable(),
 			},
+			AllowDestructiveChanges: c.AllowDestructiveChanges,
+			VerificationQueries:     convertToPlanVerificationQueries(c.VerificationQueries),
+			SimulationInstance:      c.SimulationInstance,
+			TransactionMode:         v1pb.TransactionMode(c.TransactionMode),
+			SessionVariables:        c.SessionVariables,
 		},
 	}
 }
 
+func convertToPlanVerificationQueries(queries []*storepb.VerificationQuery) []*v1pb.Plan_VerificationQuery {
+	var v1Queries []*v1pb.Plan_VerificationQuery
+	for _, query := range queries {
+		v1Queries = append(v1Queries, &v1pb.Plan_VerificationQuery{
+			Title:            query.Title,
+			Statement:        query.Statement,
+			Type:             v1pb.Plan_VerificationQuery_Type(query.Type),
+			ExpectedRowCount: query.ExpectedRowCount,
+			MinValue:         query.MinValue,
+			MaxValue:         query.MaxValue,
+		})
+	}
+	return v1Queries
+}
+
 func convertToPlanSpecChangeDatabaseConfigType(t storepb.PlanConfig_ChangeDatabaseConfig_Type) v1pb.Plan_ChangeDatabaseConfig_Type {
 	switch t {
 	case storepb.PlanConfig_ChangeDatabaseConfig_TYPE_UNSPECIFIED:
@@ -172,6 +200,32 @@ func convertToPlanSpecExportDataConfig(config *storepb.PlanConfig_Spec_ExportDat
 	}
 }
 
+func convertToPlanSpecLoadDataConfig(config *storepb.PlanConfig_Spec_LoadDataConfig) *v1pb.Plan_Spec_LoadDataConfig {
+	c := config.LoadDataConfig
+	return &v1pb.Plan_Spec_LoadDataConfig{
+		LoadDataConfig: &v1pb.Plan_LoadDataConfig{
+			Target:         c.Target,
+			Table:          c.Table,
+			SourceUri:      c.SourceUri,
+			Format:         v1pb.DataLoadFormat(c.Format),
+			Header:         c.Header,
+			FieldDelimiter: c.FieldDelimiter,
+		},
+	}
+}
+
+func convertToPlanSpecRestoreDatabaseConfig(config *storepb.PlanConfig_Spec_RestoreDatabaseConfig) *v1pb.Plan_Spec_RestoreDatabaseConfig {
+	c := config.RestoreDatabaseConfig
+	return &v1pb.Plan_Spec_RestoreDatabaseConfig{
+		RestoreDatabaseConfig: &v1pb.Plan_RestoreDatabaseConfig{
+			Target:         c.Target,
+			BackupRun:      c.BackupRun,
+			RestoreTime:    c.RestoreTime,
+			TargetDatabase: c.TargetDatabase,
+		},
+	}
+}
+
 func convertPlanSteps(steps []*v1pb.Plan_Step) []*storepb.PlanConfig_Step {
 	storeSteps := make([]*storepb.PlanConfig_Step, len(steps))
 	for i := range steps {
@@ -209,6 +263,10 @@ func convertPlanSpec(spec *v1pb.Plan_Spec) *storepb.PlanConfig_Spec {
 		storeSpec.Config = convertPlanSpecChangeDatabaseConfig(v)
 	case *v1pb.Plan_Spec_ExportDataConfig:
 		storeSpec.Config = convertPlanSpecExportDataConfig(v)
+	case *v1pb.Plan_Spec_RestoreDatabaseConfig:
+		storeSpec.Config = convertPlanSpecRestoreDatabaseConfig(v)
+	case *v1pb.Plan_Spec_LoadDataConfig:
+		storeSpec.Config = convertPlanSpecLoadDataConfig(v)
 	}
 	return storeSpec
 }
@@ -231,6 +289,8 @@ func convertPlanConfigCreateDatabaseConfig(c *v1pb.Plan_CreateDatabaseConfig) *s
 		Owner:        c.Owner,
 		Environment:  c.Environment,
 		Labels:       c.Labels,
+		Template:     c.Template,
+		Deployment:   c.Deployment,
 	}
 }
 
@@ -239,21 +299,42 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 	var preUpdateBackupDetail *storepb.PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail
 	if c.PreUpdateBackupDetail != nil && c.GetPreUpdateBackupDetail().GetDatabase() != "" {
 		preUpdateBackupDetail = &storepb.PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail{
-			Database: c.GetPreUpdateBackupDetail().GetDatabase(),
+			Database:  c.GetPreUpdateBackupDetail().GetDatabase(),
+			FullTable: c.GetPreUpdateBackupDetail().GetFullTable(),
 		}
 	}
 	return &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
 		ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
-			Target:                c.Target,
-			Sheet:                 c.Sheet,
-			Type:                  storepb.PlanConfig_ChangeDatabaseConfig_Type(c.Type),
-			SchemaVersion:         c.SchemaVersion,
-			GhostFlags:            c.GhostFlags,
-			PreUpdateBackupDetail: preUpdateBackupDetail,
+			Target:                  c.Target,
+			Sheet:                   c.Sheet,
+			Type:                    storepb.PlanConfig_ChangeDatabaseConfig_Type(c.Type),
+			SchemaVersion:           c.SchemaVersion,
+			GhostFlags:              c.GhostFlags,
+			PreUpdateBackupDetail:   preUpdateBackupDetail,
+			AllowDestructiveChanges: c.AllowDestructiveChanges,
+			VerificationQueries:     convertPlanVerificationQueries(c.VerificationQueries),
+			SimulationInstance:      c.SimulationInstance,
+			TransactionMode:         storepb.TransactionMode(c.TransactionMode),
+			SessionVariables:        c.SessionVariables,
 		},
 	}
 }
 
+func convertPlanVerificationQueries(queries []*v1pb.Plan_VerificationQuery) []*storepb.VerificationQuery {
+	var storeQueries []*storepb.VerificationQuery
+	for _, query := range queries {
+		storeQueries = append(storeQueries, &storepb.VerificationQuery{
+			Title:            query.Title,
+			Statement:        query.Statement,
+			Type:             storepb.VerificationQuery_Type(query.Type),
+			ExpectedRowCount: query.ExpectedRowCount,
+			MinValue:         query.MinValue,
+			MaxValue:         query.MaxValue,
+		})
+	}
+	return storeQueries
+}
+
 func convertPlanSpecExportDataConfig(config *v1pb.Plan_Spec_ExportDataConfig) *storepb.PlanConfig_Spec_ExportDataConfig {
 	c := config.ExportDataConfig
 	return &storepb.PlanConfig_Spec_ExportDataConfig{
@@ -266,6 +347,32 @@ func convertPlanSpecExportDataConfig(config *v1pb.Plan_Spec_ExportDataConfig) *s
 	}
 }
 
+func convertPlanSpecLoadDataConfig(config *v1pb.Plan_Spec_LoadDataConfig) *storepb.PlanConfig_Spec_LoadDataConfig {
+	c := config.LoadDataConfig
+	return &storepb.PlanConfig_Spec_LoadDataConfig{
+		LoadDataConfig: &storepb.PlanConfig_LoadDataConfig{
+			Target:         c.Target,
+			Table:          c.Table,
+			SourceUri:      c.SourceUri,
+			Format:         storepb.DataLoadFormat(c.Format),
+			Header:         c.Header,
+			FieldDelimiter: c.FieldDelimiter,
+		},
+	}
+}
+
+func convertPlanSpecRestoreDatabaseConfig(config *v1pb.Plan_Spec_RestoreDatabaseConfig) *storepb.PlanConfig_Spec_RestoreDatabaseConfig {
+	c := config.RestoreDatabaseConfig
+	return &storepb.PlanConfig_Spec_RestoreDatabaseConfig{
+		RestoreDatabaseConfig: &storepb.PlanConfig_RestoreDatabaseConfig{
+			Target:         c.Target,
+			BackupRun:      c.BackupRun,
+			RestoreTime:    c.RestoreTime,
+			TargetDatabase: c.TargetDatabase,
+		},
+	}
+}
+
 // convertDatabaseLabels converts the map[string]string labels to []*api.DatabaseLabel JSON string.
 func convertDatabaseLabels(labelsMap map[string]string) (string, error) {
 	if len(labelsMap) == 0 {
@@ -348,6 +455,14 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabaseStatementConflict:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_CONFLICT
+	case store.PlanCheckDatabaseStatementSimulate:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_SIMULATE
+	case store.PlanCheckDatabaseGrantPreview:
+		return v1pb.PlanCheckRun_DATABASE_GRANT_PREVIEW
+	case store.PlanCheckDatabaseStatementDryRun:
+		return v1pb.PlanCheckRun_DATABASE_STATEMENT_DRY_RUN
 	}
 	return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
 }
@@ -403,6 +518,13 @@ func convertToPlanCheckRunResult(result *storepb.PlanCheckRunResult_Result) *v1p
 				EndPosition:   convertToPosition(report.SqlReviewReport.EndPosition),
 			},
 		}
+	case *storepb.PlanCheckRunResult_Result_ConflictReport_:
+		resultV1.Report = &v1pb.PlanCheckRun_Result_ConflictReport_{
+			ConflictReport: &v1pb.PlanCheckRun_Result_ConflictReport{
+				Issue:  report.ConflictReport.Issue,
+				Tables: report.ConflictReport.Tables,
+			},
+		}
 	}
 	return resultV1
 }
@@ -443,7 +565,7 @@ func convertToTaskRun(ctx context.Context, s *store.Store, stateCfg *state.State
 		UpdateTime:    timestamppb.New(time.Unix(taskRun.UpdatedTs, 0)),
 		StartTime:     timestamppb.New(time.Unix(taskRun.StartedTs, 0)),
 		Title:         taskRun.Name,
-		Status:        convertToTaskRunStatus(taskRun.Status),
+		Status:        convertToTaskRunStatus(taskRun.Status, taskRun.Code),
 		Detail:        taskRun.ResultProto.Detail,
 		ChangeHistory: taskRun.ResultProto.ChangeHistory,
 		SchemaVersion: taskRun.ResultProto.Version,
@@ -495,6 +617,15 @@ func convertToTaskRun(ctx context.Context, s *store.Store, stateCfg *state.State
 		t.PriorBackupDetail = convertToTaskRunPriorBackupDetail(taskRun.ResultProto.PriorBackupDetail)
 	}
 
+	for _, result := range taskRun.ResultProto.VerificationResults {
+		t.VerificationResults = append(t.VerificationResults, &v1pb.TaskRun_VerificationResult{
+			Title:     result.Title,
+			Statement: result.Statement,
+			Passed:    result.Passed,
+			Detail:    result.Detail,
+		})
+	}
+
 	return t, nil
 }
 
@@ -525,6 +656,12 @@ func convertToSchedulerInfoWaitingCause(ctx context.Context, s *store.Store, c *
 				ConnectionLimit: cause.ConnectionLimit,
 			},
 		}, nil
+	case *storepb.SchedulerInfo_WaitingCause_TaskLimit:
+		return &v1pb.TaskRun_SchedulerInfo_WaitingCause{
+			Cause: &v1pb.TaskRun_SchedulerInfo_WaitingCause_TaskLimit{
+				TaskLimit: cause.TaskLimit,
+			},
+		}, nil
 	case *storepb.SchedulerInfo_WaitingCause_TaskUid:
 		taskUID := cause.TaskUid
 		task, err := s.GetTaskV2ByID(ctx, int(taskUID))
@@ -554,7 +691,10 @@ func convertToSchedulerInfoWaitingCause(ctx context.Context, s *store.Store, c *
 	}
 }
 
-func convertToTaskRunStatus(status api.TaskRunStatus) v1pb.TaskRun_Status {
+func convertToTaskRunStatus(status api.TaskRunStatus, code common.Code) v1pb.TaskRun_Status {
+	if status == api.TaskRunFailed && code == common.VerificationFailed {
+		return v1pb.TaskRun_FAILED_VERIFICATION
+	}
 	switch status {
 	case api.TaskRunUnknown:
 		return v1pb.TaskRun_STATUS_UNSPECIFIED
@@ -629,6 +769,18 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 			Uid:   fmt.Sprintf("%d", stage.ID),
 			Title: stage.Name,
 		}
+		rolloutPolicy, err := s.GetRolloutPolicy(ctx, stage.EnvironmentID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get rollout policy for environment %d", stage.EnvironmentID)
+		}
+		rolloutStage.ApprovalRequired = rolloutPolicy.GetStageApprovalRequired()
+		if stage.Approval != nil {
+			approval, err := convertToStageApproval(ctx, s, stage.Approval)
+			if err != nil {
+				return nil, err
+			}
+			rolloutStage.Approval = approval
+		}
 		for _, task := range stage.TaskList {
 			rolloutTask, err := convertToTask(ctx, s, project, task)
 			if err != nil {
@@ -653,6 +805,27 @@ func convertToRollout(ctx context.Context, s *store.Store, project *store.Projec
 	return rolloutV1, nil
 }
 
+func convertToStageApproval(ctx context.Context, s *store.Store, approval *store.StageApprovalMessage) (*v1pb.Stage_Approval, error) {
+	creator, err := s.GetUserByID(ctx, approval.CreatorUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user %d", approval.CreatorUID)
+	}
+	v1Approval := &v1pb.Stage_Approval{
+		CreateTime: timestamppb.New(time.Unix(approval.CreatedTs, 0)),
+		Comment:    approval.Comment,
+	}
+	if creator != nil {
+		v1Approval.Creator = common.FormatUserEmail(creator.Email)
+	}
+	switch approval.Status {
+	case store.StageApprovalStatusApproved:
+		v1Approval.Status = v1pb.Stage_Approval_APPROVED
+	case store.StageApprovalStatusRejected:
+		v1Approval.Status = v1pb.Stage_Approval_REJECTED
+	}
+	return v1Approval, nil
+}
+
 func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
 	switch task.Type {
 	case api.TaskDatabaseCreate:
@@ -667,6 +840,10 @@ func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMe
 		return convertToTaskFromDataUpdate(ctx, s, project, task)
 	case api.TaskDatabaseDataExport:
 		return convertToTaskFromDatabaseDataExport(ctx, s, project, task)
+	case api.TaskDatabaseRestore:
+		return convertToTaskFromDatabaseRestore(ctx, s, project, task)
+	case api.TaskDatabaseDataLoad:
+		return convertToTaskFromDatabaseDataLoad(ctx, s, project, task)
 	case api.TaskGeneral:
 		fallthrough
 	default:
@@ -710,7 +887,7 @@ func convertToTaskFromDatabaseCreate(ctx context.Context, s *store.Store, projec
 		Title:          task.Name,
 		SpecId:         payload.SpecId,
 		Type:           convertToTaskType(task.Type),
-		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, payload.Skipped),
 		SkippedReason:  payload.SkippedReason,
 		DependsOnTasks: nil,
 		Target:         common.FormatInstance(instance.ResourceID),
@@ -752,7 +929,7 @@ func convertToTaskFromSchemaBaseline(ctx context.Context, s *store.Store, projec
 		Title:          task.Name,
 		SpecId:         payload.SpecId,
 		Type:           convertToTaskType(task.Type),
-		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, payload.Skipped),
 		SkippedReason:  payload.SkippedReason,
 		DependsOnTasks: nil,
 		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
@@ -787,7 +964,7 @@ func convertToTaskFromSchemaUpdate(ctx context.Context, s *store.Store, project
 		Title:          task.Name,
 		SpecId:         payload.SpecId,
 		Type:           convertToTaskType(task.Type),
-		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, payload.Skipped),
 		SkippedReason:  payload.SkippedReason,
 		DependsOnTasks: nil,
 		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
@@ -821,7 +998,7 @@ func convertToTaskFromSchemaUpdateGhostCutover(ctx context.Context, s *store.Sto
 		Uid:            fmt.Sprintf("%d", task.ID),
 		Title:          task.Name,
 		SpecId:         payload.SpecId,
-		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, payload.Skipped),
 		SkippedReason:  payload.SkippedReason,
 		Type:           convertToTaskType(task.Type),
 		DependsOnTasks: nil,
@@ -853,7 +1030,7 @@ func convertToTaskFromDataUpdate(ctx context.Context, s *store.Store, project *s
 		Title:          task.Name,
 		SpecId:         payload.SpecId,
 		Type:           convertToTaskType(task.Type),
-		Status:         convertToTaskStatus(task.LatestTaskRunStatus, payload.Skipped),
+		Status:         convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, payload.Skipped),
 		SkippedReason:  payload.SkippedReason,
 		DependsOnTasks: nil,
 		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
@@ -901,17 +1078,94 @@ func convertToTaskFromDatabaseDataExport(ctx context.Context, s *store.Store, pr
 		Title:   task.Name,
 		SpecId:  payload.SpecId,
 		Type:    convertToTaskType(task.Type),
-		Status:  convertToTaskStatus(task.LatestTaskRunStatus, false),
+		Status:  convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, false),
 		Target:  targetDatabaseName,
 		Payload: &v1pbTaskPayload,
 	}
 	return v1pbTask, nil
 }
 
-func convertToTaskStatus(latestTaskRunStatus api.TaskRunStatus, skipped bool) v1pb.Task_Status {
+func convertToTaskFromDatabaseRestore(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
+	if task.DatabaseID == nil {
+		return nil, errors.Errorf("database id is nil")
+	}
+	payload := &storepb.TaskDatabaseRestorePayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task payload")
+	}
+	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found")
+	}
+	targetDatabaseName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+	v1pbTask := &v1pb.Task{
+		Name:   fmt.Sprintf("%s%s/%s%d/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, task.PipelineID, common.StagePrefix, task.StageID, common.TaskPrefix, task.ID),
+		Uid:    fmt.Sprintf("%d", task.ID),
+		Title:  task.Name,
+		SpecId: payload.SpecId,
+		Type:   convertToTaskType(task.Type),
+		Status: convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, false),
+		Target: targetDatabaseName,
+		Payload: &v1pb.Task_DatabaseRestore_{
+			DatabaseRestore: &v1pb.Task_DatabaseRestore{
+				BackupRun:      fmt.Sprintf("%s/%s%d", targetDatabaseName, common.BackupRunPrefix, payload.BackupRunId),
+				TargetDatabase: payload.TargetDatabase,
+			},
+		},
+	}
+	return v1pbTask, nil
+}
+
+func convertToTaskFromDatabaseDataLoad(ctx context.Context, s *store.Store, project *store.ProjectMessage, task *store.TaskMessage) (*v1pb.Task, error) {
+	if task.DatabaseID == nil {
+		return nil, errors.Errorf("database id is nil")
+	}
+	payload := &storepb.TaskDatabaseDataLoadPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal task payload")
+	}
+	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID, ShowDeleted: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found")
+	}
+	v1pbTask := &v1pb.Task{
+		Name:   fmt.Sprintf("%s%s/%s%d/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, task.PipelineID, common.StagePrefix, task.StageID, common.TaskPrefix, task.ID),
+		Uid:    fmt.Sprintf("%d", task.ID),
+		Title:  task.Name,
+		SpecId: payload.SpecId,
+		Type:   convertToTaskType(task.Type),
+		Status: convertToTaskStatus(task.LatestTaskRunStatus, task.LatestTaskRunCode, false),
+		Target: common.FormatDatabase(database.InstanceID, database.DatabaseName),
+		Payload: &v1pb.Task_DatabaseDataLoad_{
+			DatabaseDataLoad: &v1pb.Task_DatabaseDataLoad{
+				Table:     payload.Table,
+				SourceUri: redactSourceURI(payload.SourceUri),
+				Format:    v1pb.DataLoadFormat(payload.Format),
+			},
+		},
+	}
+	return v1pbTask, nil
+}
+
+// redactSourceURI drops the query of the source URI, which may contain the credentials, e.g. s3://bucket/*.csv?access-key=xxx&secret-access-key=xxx.
+func redactSourceURI(uri string) string {
+	u, _, _ := strings.Cut(uri, "?")
+	return u
+}
+
+func convertToTaskStatus(latestTaskRunStatus api.TaskRunStatus, latestTaskRunCode common.Code, skipped bool) v1pb.Task_Status {
 	if skipped {
 		return v1pb.Task_SKIPPED
 	}
+	if latestTaskRunStatus == api.TaskRunFailed && latestTaskRunCode == common.VerificationFailed {
+		return v1pb.Task_FAILED_VERIFICATION
+	}
 	switch latestTaskRunStatus {
 	case api.TaskRunNotStarted:
 		return v1pb.Task_NOT_STARTED
@@ -950,6 +1204,10 @@ func convertToTaskType(taskType api.TaskType) v1pb.Task_Type {
 		return v1pb.Task_DATABASE_DATA_UPDATE
 	case api.TaskDatabaseDataExport:
 		return v1pb.Task_DATABASE_DATA_EXPORT
+	case api.TaskDatabaseRestore:
+		return v1pb.Task_DATABASE_RESTORE
+	case api.TaskDatabaseDataLoad:
+		return v1pb.Task_DATABASE_DATA_LOAD
 	default:
 		return v1pb.Task_TYPE_UNSPECIFIED
 	}
@@ -1062,6 +1320,31 @@ func convertToTaskRunLogEntries(logs []*store.TaskRunLog) []*v1pb.TaskRunLogEntr
 				},
 			}
 			entries = append(entries, e)
+
+		case storepb.TaskRunLog_PRIOR_BACKUP_START:
+			e := &v1pb.TaskRunLogEntry{
+				Type:     v1pb.TaskRunLogEntry_PRIOR_BACKUP,
+				LogTime:  timestamppb.New(l.T),
+				DeployId: l.Payload.DeployId,
+				PriorBackup: &v1pb.TaskRunLogEntry_PriorBackup{
+					StartTime: timestamppb.New(l.T),
+				},
+			}
+			entries = append(entries, e)
+
+		case storepb.TaskRunLog_PRIOR_BACKUP_END:
+			if len(entries) == 0 {
+				continue
+			}
+			prev := entries[len(entries)-1]
+			if prev == nil || prev.Type != v1pb.TaskRunLogEntry_PRIOR_BACKUP {
+				continue
+			}
+			prev.PriorBackup.EndTime = timestamppb.New(l.T)
+			prev.PriorBackup.Error = l.Payload.PriorBackupEnd.Error
+			if l.Payload.PriorBackupEnd.PriorBackupDetail != nil {
+				prev.PriorBackup.PriorBackupDetail = convertToTaskRunPriorBackupDetail(l.Payload.PriorBackupEnd.PriorBackupDetail)
+			}
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 
 	"log/slog"
@@ -20,6 +21,7 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/runner/backup"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -86,6 +88,10 @@ func getTaskCreatesFromSpec(ctx context.Context, s *store.Store, sheetManager *s
 		return getTaskCreatesFromChangeDatabaseConfig(ctx, s, spec, config.ChangeDatabaseConfig, project, registerEnvironmentID)
 	case *storepb.PlanConfig_Spec_ExportDataConfig:
 		return getTaskCreatesFromExportDataConfig(ctx, s, spec, config.ExportDataConfig, project, registerEnvironmentID)
+	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
+		return getTaskCreatesFromRestoreDatabaseConfig(ctx, s, spec, config.RestoreDatabaseConfig, project, registerEnvironmentID)
+	case *storepb.PlanConfig_Spec_LoadDataConfig:
+		return getTaskCreatesFromLoadDataConfig(ctx, s, spec, config.LoadDataConfig, project, registerEnvironmentID)
 	}
 
 	return nil, nil, errors.Errorf("invalid spec config type %T", spec.Config)
@@ -140,10 +146,23 @@ func getTaskCreatesFromCreateDatabaseConfig(ctx context.Context, s *store.Store,
 		if instance.Engine == storepb.Engine_MONGODB && c.Table == "" {
 			return nil, common.Errorf(common.Invalid, "Failed to create issue, collection name missing for MongoDB")
 		}
+		labels := c.Labels
+		if c.Deployment != "" {
+			deploymentLabels, err := getCreateDatabaseDeploymentLabels(ctx, s, project, c.Deployment, environmentID, c.Labels)
+			if err != nil {
+				return nil, err
+			}
+			labels = deploymentLabels
+		}
 		// Validate the labels. Labels are set upon task completion.
-		labelsJSON, err := convertDatabaseLabels(c.Labels)
+		labelsJSON, err := convertDatabaseLabels(labels)
 		if err != nil {
-			return nil, errors.Wrapf(err, "invalid database label %q", c.Labels)
+			return nil, errors.Wrapf(err, "invalid database label %q", labels)
+		}
+		if c.Template != "" {
+			if err := validateCreateDatabaseTemplate(ctx, s, project, instance, c.Template); err != nil {
+				return nil, err
+			}
 		}
 
 		// Get admin data source username.
@@ -206,6 +225,7 @@ func getTaskCreatesFromCreateDatabaseConfig(ctx context.Context, s *store.Store,
 			Labels:        labelsJSON,
 			DatabaseName:  databaseName,
 			SheetId:       int32(sheet.UID),
+			Template:      c.Template,
 		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
@@ -304,6 +324,191 @@ func getTaskCreatesFromExportDataConfig(ctx context.Context, s *store.Store, spe
 	return []*store.TaskMessage{taskCreate}, nil, nil
 }
 
+func getTaskCreatesFromRestoreDatabaseConfig(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_RestoreDatabaseConfig, _ *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(c.Target)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance and database from target %q", c.Target)
+	}
+
+	instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+	}
+	if instance == nil {
+		return nil, nil, errors.Errorf("instance %q not found", instanceID)
+	}
+	if !backup.IsEngineSupported(instance.Engine) {
+		return nil, nil, errors.Errorf("restore is not supported for engine %v", instance.Engine)
+	}
+	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+	}
+	if database == nil {
+		return nil, nil, errors.Errorf("database %q not found", databaseName)
+	}
+
+	if c.TargetDatabase != "" {
+		targetDatabase, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:          &instanceID,
+			DatabaseName:        &c.TargetDatabase,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+		})
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get database %q", c.TargetDatabase)
+		}
+		if targetDatabase != nil {
+			return nil, nil, errors.Errorf("target database %q already exists", c.TargetDatabase)
+		}
+	}
+
+	backupRun, err := getBackupRunToRestore(ctx, s, database, c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := registerEnvironmentID(database.EffectiveEnvironmentID); err != nil {
+		return nil, nil, err
+	}
+
+	payload := &storepb.TaskDatabaseRestorePayload{
+		SpecId:         spec.Id,
+		BackupRunId:    int32(backupRun.UID),
+		TargetDatabase: c.TargetDatabase,
+	}
+	bytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal task database restore payload")
+	}
+	name := fmt.Sprintf("Restore database %q from backup %d", database.DatabaseName, backupRun.UID)
+	if c.TargetDatabase != "" {
+		name = fmt.Sprintf("Restore backup %d of database %q to database %q", backupRun.UID, database.DatabaseName, c.TargetDatabase)
+	}
+	taskCreate := &store.TaskMessage{
+		Name:              name,
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Type:              api.TaskDatabaseRestore,
+		EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+		Payload:           string(bytes),
+	}
+	return []*store.TaskMessage{taskCreate}, nil, nil
+}
+
+func getTaskCreatesFromLoadDataConfig(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_LoadDataConfig, _ *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(c.Target)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance and database from target %q", c.Target)
+	}
+	if c.Table == "" {
+		return nil, nil, errors.Errorf("table is required")
+	}
+	if c.SourceUri == "" {
+		return nil, nil, errors.Errorf("source uri is required")
+	}
+
+	instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
+		ResourceID: &instanceID,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get instance %q", instanceID)
+	}
+	if instance == nil {
+		return nil, nil, errors.Errorf("instance %q not found", instanceID)
+	}
+	switch instance.Engine {
+	case storepb.Engine_TIDB:
+	case storepb.Engine_MYSQL:
+		if c.Format == storepb.DataLoadFormat_DATA_LOAD_FORMAT_PARQUET {
+			return nil, nil, errors.Errorf("parquet format is only supported for TiDB")
+		}
+		if !strings.HasPrefix(c.SourceUri, "s3://") {
+			return nil, nil, errors.Errorf("only s3 source uri is supported for MySQL, got %q", redactSourceURI(c.SourceUri))
+		}
+	default:
+		return nil, nil, errors.Errorf("data load is not supported for engine %v", instance.Engine)
+	}
+	database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get database %q", databaseName)
+	}
+	if database == nil {
+		return nil, nil, errors.Errorf("database %q not found", databaseName)
+	}
+
+	if err := registerEnvironmentID(database.EffectiveEnvironmentID); err != nil {
+		return nil, nil, err
+	}
+
+	payload := &storepb.TaskDatabaseDataLoadPayload{
+		SpecId:         spec.Id,
+		Table:          c.Table,
+		SourceUri:      c.SourceUri,
+		Format:         c.Format,
+		Header:         c.Header,
+		FieldDelimiter: c.FieldDelimiter,
+	}
+	bytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal task database data load payload")
+	}
+	taskCreate := &store.TaskMessage{
+		Name:              fmt.Sprintf("Load data into table %q of database %q", c.Table, database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Type:              api.TaskDatabaseDataLoad,
+		EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+		Payload:           string(bytes),
+	}
+	return []*store.TaskMessage{taskCreate}, nil, nil
+}
+
+// getBackupRunToRestore returns the backup run of the restore config,
+// or the latest successful backup run started at or before the restore time.
+func getBackupRunToRestore(ctx context.Context, s *store.Store, database *store.DatabaseMessage, c *storepb.PlanConfig_RestoreDatabaseConfig) (*store.BackupRunMessage, error) {
+	if (c.BackupRun == "") == (c.RestoreTime == nil) {
+		return nil, errors.Errorf("exactly one of backup run and restore time must be set")
+	}
+	find := &store.FindBackupRunMessage{
+		DatabaseUID: &database.UID,
+		StatusList:  []store.BackupRunStatus{store.BackupRunDone},
+	}
+	if c.BackupRun != "" {
+		instanceID, databaseName, backupRunUID, err := common.GetInstanceDatabaseIDBackupRunUID(c.BackupRun)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid backup run %q", c.BackupRun)
+		}
+		if instanceID != database.InstanceID || databaseName != database.DatabaseName {
+			return nil, errors.Errorf("backup run %q doesn't belong to the target database", c.BackupRun)
+		}
+		find.UID = &backupRunUID
+	}
+	backupRuns, err := s.ListBackupRuns(ctx, find)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list backup runs")
+	}
+	// The backup runs are ordered by id DESC, so the first one started at or before the restore time is the latest.
+	for _, backupRun := range backupRuns {
+		if c.RestoreTime == nil || !backupRun.Payload.GetStartTime().AsTime().After(c.RestoreTime.AsTime()) {
+			return backupRun, nil
+		}
+	}
+	if c.BackupRun != "" {
+		return nil, errors.Errorf("successful backup run %q not found", c.BackupRun)
+	}
+	return nil, errors.Errorf("no successful backup run of database %q at or before %v", database.DatabaseName, c.RestoreTime.AsTime())
+}
+
 func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, _ *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
 	instanceID, databaseName, err := common.GetInstanceDatabaseID(c.Target)
 	if err != nil {
@@ -361,10 +566,19 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
+		if err := validateTransactionMode(c.TransactionMode, instance.Engine); err != nil {
+			return nil, nil, err
+		}
+		if err := validateSessionVariables(c.SessionVariables, instance.Engine); err != nil {
+			return nil, nil, err
+		}
 		payload := &storepb.TaskDatabaseUpdatePayload{
-			SpecId:        spec.Id,
-			SheetId:       int32(sheetUID),
-			SchemaVersion: getOrDefaultSchemaVersion(c.SchemaVersion),
+			SpecId:              spec.Id,
+			SheetId:             int32(sheetUID),
+			SchemaVersion:       getOrDefaultSchemaVersion(c.SchemaVersion),
+			VerificationQueries: c.VerificationQueries,
+			TransactionMode:     c.TransactionMode,
+			SessionVariables:    c.SessionVariables,
 		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
@@ -387,9 +601,11 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
 		payload := &storepb.TaskDatabaseUpdatePayload{
-			SpecId:        spec.Id,
-			SheetId:       int32(sheetUID),
-			SchemaVersion: getOrDefaultSchemaVersion(c.SchemaVersion),
+			SpecId:                  spec.Id,
+			SheetId:                 int32(sheetUID),
+			SchemaVersion:           getOrDefaultSchemaVersion(c.SchemaVersion),
+			AllowDestructiveChanges: c.AllowDestructiveChanges,
+			VerificationQueries:     c.VerificationQueries,
 		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
@@ -437,7 +653,8 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 
 		// task "cutover"
 		payloadCutover := &storepb.TaskDatabaseUpdatePayload{
-			SpecId: spec.Id,
+			SpecId:              spec.Id,
+			VerificationQueries: c.VerificationQueries,
 		}
 		bytesCutover, err := protojson.Marshal(payloadCutover)
 		if err != nil {
@@ -464,15 +681,25 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to get sheet id from sheet %q", c.Sheet)
 		}
+		if err := validateTransactionMode(c.TransactionMode, instance.Engine); err != nil {
+			return nil, nil, err
+		}
+		if err := validateSessionVariables(c.SessionVariables, instance.Engine); err != nil {
+			return nil, nil, err
+		}
 		preUpdateBackupDetail := &storepb.PreUpdateBackupDetail{}
 		if c.GetPreUpdateBackupDetail().GetDatabase() != "" {
 			preUpdateBackupDetail.Database = c.GetPreUpdateBackupDetail().GetDatabase()
+			preUpdateBackupDetail.FullTable = c.GetPreUpdateBackupDetail().GetFullTable()
 		}
 		payload := &storepb.TaskDatabaseUpdatePayload{
 			SpecId:                spec.Id,
 			SheetId:               int32(sheetUID),
 			SchemaVersion:         getOrDefaultSchemaVersion(c.SchemaVersion),
 			PreUpdateBackupDetail: preUpdateBackupDetail,
+			VerificationQueries:   c.VerificationQueries,
+			TransactionMode:       c.TransactionMode,
+			SessionVariables:      c.SessionVariables,
 		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
@@ -493,6 +720,45 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 	}
 }
 
+// validateTransactionMode checks that the engine driver supports the transaction mode.
+func validateTransactionMode(mode storepb.TransactionMode, engine storepb.Engine) error {
+	if mode == storepb.TransactionMode_TRANSACTION_MODE_UNSPECIFIED {
+		return nil
+	}
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE, storepb.Engine_POSTGRES:
+		return nil
+	default:
+		return errors.Errorf("transaction mode %v is not supported for engine %v", mode, engine)
+	}
+}
+
+// sessionVariables are the session variables allowed to set before the statements run, keyed by the engine.
+// The values are checked by the database when the variables are set.
+var sessionVariables = map[storepb.Engine][]string{
+	storepb.Engine_MYSQL:     {"sql_mode", "lock_wait_timeout", "innodb_lock_wait_timeout", "max_execution_time", "transaction_isolation", "foreign_key_checks", "unique_checks"},
+	storepb.Engine_MARIADB:   {"sql_mode", "lock_wait_timeout", "innodb_lock_wait_timeout", "max_statement_time", "transaction_isolation", "foreign_key_checks", "unique_checks"},
+	storepb.Engine_OCEANBASE: {"sql_mode", "ob_query_timeout", "ob_trx_timeout", "ob_trx_idle_timeout", "transaction_isolation", "foreign_key_checks"},
+	storepb.Engine_POSTGRES:  {"statement_timeout", "lock_timeout", "idle_in_transaction_session_timeout", "default_transaction_isolation"},
+}
+
+// validateSessionVariables checks that the engine driver supports the session variables.
+func validateSessionVariables(variables map[string]string, engine storepb.Engine) error {
+	if len(variables) == 0 {
+		return nil
+	}
+	allowed, ok := sessionVariables[engine]
+	if !ok {
+		return errors.Errorf("session variables are not supported for engine %v", engine)
+	}
+	for name := range variables {
+		if !slices.Contains(allowed, name) {
+			return errors.Errorf("session variable %q is not supported for engine %v, supported variables: %s", name, engine, strings.Join(allowed, ", "))
+		}
+	}
+	return nil
+}
+
 // checkCharacterSetCollationOwner checks if the character set, collation and owner are legal according to the dbType.
 func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collation, owner string) error {
 	switch dbType {
@@ -519,6 +785,21 @@ func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collat
 		if collation != "" {
 			return errors.Errorf("Snowflake does not support collation, but got %s", collation)
 		}
+	case storepb.Engine_BIGQUERY:
+		// BigQuery does not support character set and collation at the dataset level.
+		if characterSet != "" {
+			return errors.Errorf("BigQuery does not support character set, but got %s", characterSet)
+		}
+		if collation != "" {
+			return errors.Errorf("BigQuery does not support collation, but got %s", collation)
+		}
+	case storepb.Engine_DATABRICKS:
+		if characterSet != "" {
+			return errors.Errorf("Databricks does not support character set, but got %s", characterSet)
+		}
+		if collation != "" {
+			return errors.Errorf("Databricks does not support collation, but got %s", collation)
+		}
 	case storepb.Engine_POSTGRES:
 		if owner == "" {
 			return errors.Errorf("database owner is required for PostgreSQL")
@@ -534,7 +815,7 @@ func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collat
 		if collation != "" {
 			return errors.Errorf("RisingWave does not support collation, but got %s", collation)
 		}
-	case storepb.Engine_SQLITE, storepb.Engine_MONGODB, storepb.Engine_MSSQL:
+	case storepb.Engine_SQLITE, storepb.Engine_DUCKDB, storepb.Engine_MONGODB, storepb.Engine_MSSQL:
 		// no-op.
 	default:
 		if characterSet == "" {
@@ -550,6 +831,56 @@ func checkCharacterSetCollationOwner(dbType storepb.Engine, characterSet, collat
 	return nil
 }
 
+// getCreateDatabaseDeploymentLabels returns the labels for the new database to belong to the deployment of the project deployment config.
+func getCreateDatabaseDeploymentLabels(ctx context.Context, s *store.Store, project *store.ProjectMessage, deploymentName string, environmentID string, labels map[string]string) (map[string]string, error) {
+	deploymentConfig, err := s.GetDeploymentConfigV2(ctx, project.UID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get deployment config of project %q", project.ResourceID)
+	}
+	for _, deployment := range deploymentConfig.Schedule.Deployments {
+		if deployment.Name != deploymentName {
+			continue
+		}
+		return utils.GetDeploymentDatabaseLabels(deployment, environmentID, labels)
+	}
+	return nil, errors.Errorf("deployment %q not found in project %q", deploymentName, project.ResourceID)
+}
+
+// validateCreateDatabaseTemplate validates the template database or branch of the new database is in the project with the same engine.
+func validateCreateDatabaseTemplate(ctx context.Context, s *store.Store, project *store.ProjectMessage, instance *store.InstanceMessage, template string) error {
+	if projectID, branchID, err := common.GetProjectAndBranchID(template); err == nil {
+		if projectID != project.ResourceID {
+			return errors.Errorf("template branch %q is not in project %q", template, project.ResourceID)
+		}
+		branch, err := s.GetBranch(ctx, &store.FindBranchMessage{ProjectID: &projectID, ResourceID: &branchID})
+		if err != nil {
+			return errors.Wrapf(err, "failed to get template branch %q", template)
+		}
+		if branch == nil {
+			return errors.Errorf("template branch %q not found", template)
+		}
+		if branch.Engine != instance.Engine {
+			return errors.Errorf("template branch %q engine %s doesn't match instance engine %s", template, branch.Engine, instance.Engine)
+		}
+		return nil
+	}
+	database, err := getDatabaseMessage(ctx, s, template)
+	if err != nil {
+		return errors.Wrapf(err, "invalid template %q", template)
+	}
+	if database.ProjectID != project.ResourceID {
+		return errors.Errorf("template database %q is not in project %q", template, project.ResourceID)
+	}
+	templateInstance, err := getInstanceMessage(ctx, s, common.FormatInstance(database.InstanceID))
+	if err != nil {
+		return err
+	}
+	if templateInstance.Engine != instance.Engine {
+		return errors.Errorf("template database %q engine %s doesn't match instance engine %s", template, templateInstance.Engine, instance.Engine)
+	}
+	return nil
+}
+
 func getCreateDatabaseStatement(dbType storepb.Engine, c *storepb.PlanConfig_CreateDatabaseConfig, databaseName, adminDatasourceUser string) (string, error) {
 	var stmt string
 	switch dbType {
@@ -588,6 +919,9 @@ func getCreateDatabaseStatement(dbType storepb.Engine, c *storepb.PlanConfig_Cre
 	case storepb.Engine_SQLITE:
 		// This is a fake CREATE DATABASE and USE statement since a single SQLite file represents a database. Engine driver will recognize it and establish a connection to create the sqlite file representing the database.
 		return fmt.Sprintf("CREATE DATABASE '%s';", databaseName), nil
+	case storepb.Engine_DUCKDB:
+		// Similar to SQLite, a single DuckDB file represents a database. The engine driver will recognize the fake statement and create the database file, or the MotherDuck database.
+		return fmt.Sprintf("CREATE DATABASE '%s';", databaseName), nil
 	case storepb.Engine_MONGODB:
 		// We just run createCollection in mongosh instead of execute `use <database>` first, because we execute the
 		// mongodb statement in mongosh with --file flag, and it doesn't support `use <database>` statement in the file.
@@ -613,6 +947,12 @@ func getCreateDatabaseStatement(dbType storepb.Engine, c *storepb.PlanConfig_Cre
 		return fmt.Sprintf("%s;", stmt), nil
 	case storepb.Engine_HIVE:
 		return fmt.Sprintf("CREATE DATABASE %s;", databaseName), nil
+	case storepb.Engine_BIGQUERY:
+		// The database of BigQuery is the dataset.
+		return fmt.Sprintf("CREATE SCHEMA `%s`;", databaseName), nil
+	case storepb.Engine_DATABRICKS:
+		// The database of Databricks is the catalog of the Unity Catalog.
+		return fmt.Sprintf("CREATE CATALOG `%s`;", databaseName), nil
 	}
 	return "", errors.Errorf("unsupported database type %s", dbType)
 }
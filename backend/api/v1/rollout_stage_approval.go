@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ApproveStage approves the stage to start.
+func (s *RolloutService) ApproveStage(ctx context.Context, request *v1pb.ApproveStageRequest) (*v1pb.Stage, error) {
+	return s.updateStageApproval(ctx, request.Name, request.Comment, store.StageApprovalStatusApproved)
+}
+
+// RejectStage rejects the stage to start.
+func (s *RolloutService) RejectStage(ctx context.Context, request *v1pb.RejectStageRequest) (*v1pb.Stage, error) {
+	return s.updateStageApproval(ctx, request.Name, request.Comment, store.StageApprovalStatusRejected)
+}
+
+func (s *RolloutService) updateStageApproval(ctx context.Context, name, comment string, approvalStatus store.StageApprovalStatus) (*v1pb.Stage, error) {
+	projectID, rolloutID, stageID, err := common.GetProjectIDRolloutIDMaybeStageID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if stageID == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid stage name %q", name)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find project, error: %v", err)
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %v not found", projectID)
+	}
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &rolloutID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find issue, error: %v", err)
+	}
+	if issue == nil {
+		return nil, status.Errorf(codes.NotFound, "issue not found for rollout %v", rolloutID)
+	}
+	if issue.Project.ResourceID != project.ResourceID {
+		return nil, status.Errorf(codes.NotFound, "rollout %v not found in project %v", rolloutID, projectID)
+	}
+	if issue.Status != api.IssueOpen {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot approve or reject the stage of the closed issue")
+	}
+	stages, err := s.store.ListStageV2(ctx, rolloutID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list stages, error: %v", err)
+	}
+	var stage *store.StageMessage
+	for _, v := range stages {
+		if v.ID == *stageID {
+			stage = v
+			break
+		}
+	}
+	if stage == nil {
+		return nil, status.Errorf(codes.NotFound, "stage %q not found", name)
+	}
+
+	rolloutPolicy, err := s.store.GetRolloutPolicy(ctx, stage.EnvironmentID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rollout policy, error: %v", err)
+	}
+	if !rolloutPolicy.StageApprovalRequired {
+		return nil, status.Errorf(codes.FailedPrecondition, "stage %q does not require approval", name)
+	}
+
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	ok, err = s.canUserApproveStage(ctx, user, rolloutPolicy)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check if the user can approve the stage, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to approve or reject the stage")
+	}
+
+	if _, err := s.store.UpsertStageApproval(ctx, &store.StageApprovalMessage{
+		StageID:    stage.ID,
+		CreatorUID: user.ID,
+		Status:     approvalStatus,
+		Comment:    comment,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update stage approval, error: %v", err)
+	}
+
+	commentStatus := storepb.IssueCommentPayload_StageApproval_APPROVED
+	if approvalStatus == store.StageApprovalStatusRejected {
+		commentStatus = storepb.IssueCommentPayload_StageApproval_REJECTED
+	}
+	stageName := fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, project.ResourceID, common.RolloutPrefix, rolloutID, common.StagePrefix, stage.ID)
+	if _, err := s.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+		IssueUID: issue.UID,
+		Payload: &storepb.IssueCommentPayload{
+			Comment: comment,
+			Event: &storepb.IssueCommentPayload_StageApproval_{
+				StageApproval: &storepb.IssueCommentPayload_StageApproval{
+					Stage:  stageName,
+					Status: commentStatus,
+				},
+			},
+		},
+	}, user.ID); err != nil {
+		slog.Warn("failed to create issue comment", "issueUID", issue.UID, log.BBError(err))
+	}
+
+	if approvalStatus == store.StageApprovalStatusApproved {
+		// Tickle task run scheduler to roll out the approved stage automatically.
+		s.stateCfg.TaskRunTickleChan <- 0
+	}
+
+	rollout, err := s.store.GetRollout(ctx, rolloutID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get rollout, error: %v", err)
+	}
+	rolloutV1, err := convertToRollout(ctx, s.store, project, rollout)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert to rollout, error: %v", err)
+	}
+	for _, v := range rolloutV1.Stages {
+		if v.Name == stageName {
+			return v, nil
+		}
+	}
+	return nil, status.Errorf(codes.Internal, "failed to find stage %q in rollout", stageName)
+}
+
+// canUserApproveStage returns if a user can approve or reject the stage.
+// The members of the stage approver groups can approve the stage.
+// Users with bb.taskRuns.create can approve the stage if there are no stage approver groups.
+func (s *RolloutService) canUserApproveStage(ctx context.Context, user *store.UserMessage, rolloutPolicy *storepb.RolloutPolicy) (bool, error) {
+	if len(rolloutPolicy.StageApproverGroups) == 0 {
+		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionTaskRunsCreate, user)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to check workspace role")
+		}
+		return ok, nil
+	}
+	for _, group := range rolloutPolicy.StageApproverGroups {
+		inGroup, err := utils.IsUserInGroup(ctx, s.store, user, group)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to check the member of group %q", group)
+		}
+		if inGroup {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkStageApproval checks that the stage is approved if the rollout policy of the stage environment requires stage approval.
+func (s *RolloutService) checkStageApproval(ctx context.Context, stage *store.StageMessage) error {
+	rolloutPolicy, err := s.store.GetRolloutPolicy(ctx, stage.EnvironmentID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get rollout policy, error: %v", err)
+	}
+	if !rolloutPolicy.StageApprovalRequired {
+		return nil
+	}
+	if stage.Approval == nil {
+		return status.Errorf(codes.FailedPrecondition, "cannot run the tasks because stage %q is not approved", stage.Name)
+	}
+	if stage.Approval.Status != store.StageApprovalStatusApproved {
+		return status.Errorf(codes.FailedPrecondition, "cannot run the tasks because stage %q is rejected", stage.Name)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// rewriteRowAccess rewrites the statement to only read the rows the user can access by the row access policies
+// of the databases in the instance.
+func (s *SQLService) rewriteRowAccess(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, user *store.UserMessage, statement string) (string, error) {
+	filters, err := s.getRowFilters(ctx, instance, user)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get row access policies: %v", err)
+	}
+	if len(filters) == 0 {
+		return statement, nil
+	}
+	rewritten, err := base.RewriteRowFilter(instance.Engine, statement, database.DatabaseName, filters)
+	if err != nil {
+		return "", status.Errorf(codes.PermissionDenied, "failed to apply row access policies: %v", err)
+	}
+	return rewritten, nil
+}
+
+// getRowFilters returns the row filters of the tables in the instance for the user.
+func (s *SQLService) getRowFilters(ctx context.Context, instance *store.InstanceMessage, user *store.UserMessage) ([]*base.RowFilter, error) {
+	resourceType := api.PolicyResourceTypeDatabase
+	policyType := api.PolicyTypeRowAccess
+	policies, err := s.store.ListPoliciesV2(ctx, &store.FindPolicyMessage{
+		ResourceType: &resourceType,
+		Type:         &policyType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	databases, err := s.store.ListDatabases(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID})
+	if err != nil {
+		return nil, err
+	}
+	databaseNames := make(map[int]string)
+	for _, database := range databases {
+		databaseNames[database.UID] = database.DatabaseName
+	}
+
+	var requester *common.RowAccessRequester
+	quoteIdentifier, quoteString := getRowAccessQuoteFuncs(instance.Engine)
+	var filters []*base.RowFilter
+	for _, policy := range policies {
+		databaseName, ok := databaseNames[policy.ResourceUID]
+		if !ok {
+			continue
+		}
+		if requester == nil {
+			if requester, err = s.getRowAccessRequester(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+		payload := &storepb.RowAccessPolicy{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal row access policy")
+		}
+
+		// A row is visible if it matches any rule of the table.
+		predicates := make(map[string][]string)
+		var tables []*base.RowFilter
+		for _, rule := range payload.Rules {
+			predicate, err := common.ConvertRowAccessConditionToSQL(rule.GetCondition().GetExpression(), requester, quoteIdentifier, quoteString)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to convert the row access rule of table %q", rule.Table)
+			}
+			key := fmt.Sprintf("%s.%s", rule.Schema, rule.Table)
+			if _, ok := predicates[key]; !ok {
+				tables = append(tables, &base.RowFilter{
+					Database: databaseName,
+					Schema:   rule.Schema,
+					Table:    rule.Table,
+				})
+			}
+			predicates[key] = append(predicates[key], predicate)
+		}
+		for _, table := range tables {
+			table.Predicate = strings.Join(predicates[fmt.Sprintf("%s.%s", table.Schema, table.Table)], " OR ")
+			filters = append(filters, table)
+		}
+	}
+	return filters, nil
+}
+
+func (s *SQLService) getRowAccessRequester(ctx context.Context, user *store.UserMessage) (*common.RowAccessRequester, error) {
+	groups, err := s.store.ListGroups(ctx, &store.FindGroupMessage{})
+	if err != nil {
+		return nil, err
+	}
+	requester := &common.RowAccessRequester{
+		Email: user.Email,
+	}
+	userName := common.FormatUserUID(user.ID)
+	for _, group := range groups {
+		for _, member := range group.Payload.GetMembers() {
+			if member.Member == userName {
+				requester.Groups = append(requester.Groups, group.Email)
+				break
+			}
+		}
+	}
+	return requester, nil
+}
+
+func getRowAccessQuoteFuncs(engine storepb.Engine) (func(string) string, func(string) string) {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB:
+		return func(s string) string {
+				return fmt.Sprintf("`%s`", strings.ReplaceAll(s, "`", "``"))
+			}, func(s string) string {
+				return fmt.Sprintf("'%s'", strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(s))
+			}
+	default:
+		return func(s string) string {
+				return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `""`))
+			}, func(s string) string {
+				return fmt.Sprintf("'%s'", strings.ReplaceAll(s, `'`, `''`))
+			}
+	}
+}
@@ -0,0 +1,283 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// SavedSearchService implements the saved search service.
+type SavedSearchService struct {
+	v1pb.UnimplementedSavedSearchServiceServer
+	store      *store.Store
+	iamManager *iam.Manager
+}
+
+// NewSavedSearchService creates a new SavedSearchService.
+func NewSavedSearchService(store *store.Store, iamManager *iam.Manager) *SavedSearchService {
+	return &SavedSearchService{
+		store:      store,
+		iamManager: iamManager,
+	}
+}
+
+// CreateSavedSearch creates a saved search.
+func (s *SavedSearchService) CreateSavedSearch(ctx context.Context, request *v1pb.CreateSavedSearchRequest) (*v1pb.SavedSearch, error) {
+	if request.SavedSearch == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saved search must be set")
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	if request.SavedSearch.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title must be set")
+	}
+	if err := validateSavedSearchFilter(request.SavedSearch.Filter); err != nil {
+		return nil, err
+	}
+	projectID, err := s.getSharedProjectID(ctx, user, request.SavedSearch.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSearch, err := s.store.CreateSavedSearch(ctx, &store.SavedSearchMessage{
+		CreatorID: user.ID,
+		ProjectID: projectID,
+		Title:     request.SavedSearch.Title,
+		Filter:    request.SavedSearch.Filter,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create saved search, error: %v", err)
+	}
+	return s.convertToSavedSearch(ctx, savedSearch)
+}
+
+// GetSavedSearch gets a saved search.
+func (s *SavedSearchService) GetSavedSearch(ctx context.Context, request *v1pb.GetSavedSearchRequest) (*v1pb.SavedSearch, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	savedSearch, err := getSavedSearch(ctx, s.store, s.iamManager, user, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return s.convertToSavedSearch(ctx, savedSearch)
+}
+
+// ListSavedSearches lists the saved searches owned by the user or shared with the projects of the user.
+func (s *SavedSearchService) ListSavedSearches(ctx context.Context, _ *v1pb.ListSavedSearchesRequest) (*v1pb.ListSavedSearchesResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	savedSearches, err := s.store.ListSavedSearches(ctx, &store.FindSavedSearchMessage{CreatorID: &user.ID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list saved searches, error: %v", err)
+	}
+	sharedSavedSearches, err := s.store.ListSavedSearches(ctx, &store.FindSavedSearchMessage{Shared: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list saved searches, error: %v", err)
+	}
+	projectAccess := make(map[string]bool)
+	for _, savedSearch := range sharedSavedSearches {
+		if savedSearch.CreatorID == user.ID {
+			continue
+		}
+		projectID := *savedSearch.ProjectID
+		if _, ok := projectAccess[projectID]; !ok {
+			ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionProjectsGet, user, projectID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+			}
+			projectAccess[projectID] = ok
+		}
+		if projectAccess[projectID] {
+			savedSearches = append(savedSearches, savedSearch)
+		}
+	}
+
+	resp := &v1pb.ListSavedSearchesResponse{}
+	for _, savedSearch := range savedSearches {
+		v1SavedSearch, err := s.convertToSavedSearch(ctx, savedSearch)
+		if err != nil {
+			return nil, err
+		}
+		resp.SavedSearches = append(resp.SavedSearches, v1SavedSearch)
+	}
+	slices.SortFunc(resp.SavedSearches, func(a, b *v1pb.SavedSearch) int {
+		return b.CreateTime.AsTime().Compare(a.CreateTime.AsTime())
+	})
+	return resp, nil
+}
+
+// UpdateSavedSearch updates a saved search.
+func (s *SavedSearchService) UpdateSavedSearch(ctx context.Context, request *v1pb.UpdateSavedSearchRequest) (*v1pb.SavedSearch, error) {
+	if request.SavedSearch == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "saved search must be set")
+	}
+	if request.UpdateMask == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	savedSearch, err := getSavedSearch(ctx, s.store, s.iamManager, user, request.SavedSearch.Name)
+	if err != nil {
+		return nil, err
+	}
+	if savedSearch.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "only the owner can update the saved search")
+	}
+
+	update := &store.UpdateSavedSearchMessage{UID: savedSearch.UID}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.SavedSearch.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "title must be set")
+			}
+			update.Title = &request.SavedSearch.Title
+		case "filter":
+			if err := validateSavedSearchFilter(request.SavedSearch.Filter); err != nil {
+				return nil, err
+			}
+			update.Filter = &request.SavedSearch.Filter
+		case "project":
+			projectID, err := s.getSharedProjectID(ctx, user, request.SavedSearch.Project)
+			if err != nil {
+				return nil, err
+			}
+			if projectID == nil {
+				projectID = new(string)
+			}
+			update.ProjectID = projectID
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
+		}
+	}
+	savedSearch, err = s.store.UpdateSavedSearch(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update saved search, error: %v", err)
+	}
+	return s.convertToSavedSearch(ctx, savedSearch)
+}
+
+// DeleteSavedSearch deletes a saved search.
+func (s *SavedSearchService) DeleteSavedSearch(ctx context.Context, request *v1pb.DeleteSavedSearchRequest) (*emptypb.Empty, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	savedSearch, err := getSavedSearch(ctx, s.store, s.iamManager, user, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if savedSearch.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "only the owner can delete the saved search")
+	}
+	if err := s.store.DeleteSavedSearch(ctx, savedSearch.UID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete saved search, error: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getSharedProjectID returns the resource ID of the project to share the saved search with,
+// and validates that the user can access the project.
+func (s *SavedSearchService) getSharedProjectID(ctx context.Context, user *store.UserMessage, name string) (*string, error) {
+	if name == "" {
+		return nil, nil
+	}
+	projectID, err := common.GetProjectID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get project, error: %v", err)
+	}
+	if project == nil || project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionProjectsGet, user, projectID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied to share with project %q", projectID)
+	}
+	return &project.ResourceID, nil
+}
+
+func (s *SavedSearchService) convertToSavedSearch(ctx context.Context, savedSearch *store.SavedSearchMessage) (*v1pb.SavedSearch, error) {
+	creator, err := s.store.GetUserByID(ctx, savedSearch.CreatorID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get creator, error: %v", err)
+	}
+	if creator == nil {
+		return nil, status.Errorf(codes.NotFound, "cannot find the creator: %d", savedSearch.CreatorID)
+	}
+	v1SavedSearch := &v1pb.SavedSearch{
+		Name:       fmt.Sprintf("%s%d", common.SavedSearchPrefix, savedSearch.UID),
+		Title:      savedSearch.Title,
+		Filter:     savedSearch.Filter,
+		Owner:      common.FormatUserEmail(creator.Email),
+		CreateTime: timestamppb.New(savedSearch.CreatedTime),
+		UpdateTime: timestamppb.New(savedSearch.UpdatedTime),
+	}
+	if savedSearch.ProjectID != nil {
+		v1SavedSearch.Project = common.FormatProject(*savedSearch.ProjectID)
+	}
+	return v1SavedSearch, nil
+}
+
+// getSavedSearch gets the saved search by name, and checks that the user is the owner
+// or can access the project which the saved search is shared with.
+func getSavedSearch(ctx context.Context, stores *store.Store, iamManager *iam.Manager, user *store.UserMessage, name string) (*store.SavedSearchMessage, error) {
+	uid, err := common.GetSavedSearchUID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	savedSearch, err := stores.GetSavedSearch(ctx, &store.FindSavedSearchMessage{UID: &uid})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get saved search, error: %v", err)
+	}
+	if savedSearch == nil {
+		return nil, status.Errorf(codes.NotFound, "saved search %q not found", name)
+	}
+	if savedSearch.CreatorID == user.ID {
+		return savedSearch, nil
+	}
+	if savedSearch.ProjectID != nil {
+		ok, err := iamManager.CheckPermission(ctx, iam.PermissionProjectsGet, user, *savedSearch.ProjectID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission, error: %v", err)
+		}
+		if ok {
+			return savedSearch, nil
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "cannot access saved search %q", name)
+}
+
+func validateSavedSearchFilter(filter string) error {
+	if filter == "" {
+		return status.Errorf(codes.InvalidArgument, "filter must be set")
+	}
+	if _, err := parseFilter(filter); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter %q, error: %v", filter, err)
+	}
+	return nil
+}
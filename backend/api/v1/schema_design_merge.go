@@ -117,6 +117,136 @@ func tryMerge(ancestor, head, base *storepb.DatabaseSchemaMetadata, engine store
 	return ancestor, nil
 }
 
+// mergeConflict is a conflict between the changes from ancestor to head and from ancestor to base.
+type mergeConflict struct {
+	schema string
+	// table is the name of the conflicting table, view, function or procedure.
+	// It's empty for schema-level conflicts.
+	table string
+	// column is empty for table-level conflicts.
+	column  string
+	message string
+}
+
+// findMergeConflicts returns all the conflicts of merging ancestor, head and base at table and column granularity.
+// Unlike tryMerge, it does not stop at the first conflict.
+func findMergeConflicts(ancestor, head, base *storepb.DatabaseSchemaMetadata, engine storepb.Engine) ([]*mergeConflict, error) {
+	ancestor, head, base = proto.Clone(ancestor).(*storepb.DatabaseSchemaMetadata), proto.Clone(head).(*storepb.DatabaseSchemaMetadata), proto.Clone(base).(*storepb.DatabaseSchemaMetadata)
+
+	if ancestor == nil {
+		ancestor = &storepb.DatabaseSchemaMetadata{}
+	}
+
+	diffBetweenAncestorAndHead, err := diffMetadata(ancestor, head)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to diff between ancestor and head")
+	}
+
+	diffBetweenAncestorAndBase, err := diffMetadata(ancestor, base)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to diff between ancestor and base")
+	}
+
+	var conflicts []*mergeConflict
+	sortedSchemaNames := make([]string, 0, len(diffBetweenAncestorAndBase.schemas))
+	for schemaName := range diffBetweenAncestorAndBase.schemas {
+		sortedSchemaNames = append(sortedSchemaNames, schemaName)
+	}
+	slices.Sort(sortedSchemaNames)
+	for _, schemaName := range sortedSchemaNames {
+		schemaNode := diffBetweenAncestorAndBase.schemas[schemaName]
+		otherSchemaNode, in := diffBetweenAncestorAndHead.schemas[schemaName]
+		if !in {
+			continue
+		}
+		if schemaNode.action != otherSchemaNode.action {
+			conflicts = append(conflicts, &mergeConflict{
+				schema:  schemaName,
+				message: fmt.Sprintf("conflict schema action, one is %s, the other is %s", schemaNode.action, otherSchemaNode.action),
+			})
+			continue
+		}
+		if schemaNode.action == diffActionDrop {
+			continue
+		}
+
+		sortedTableNames := make([]string, 0, len(schemaNode.tables))
+		for tableName := range schemaNode.tables {
+			sortedTableNames = append(sortedTableNames, tableName)
+		}
+		slices.Sort(sortedTableNames)
+		for _, tableName := range sortedTableNames {
+			otherTableNode, in := otherSchemaNode.tables[tableName]
+			if !in {
+				continue
+			}
+			conflicts = append(conflicts, findTableMergeConflicts(schemaName, schemaNode.tables[tableName], otherTableNode, engine)...)
+		}
+
+		for viewName, viewNode := range schemaNode.views {
+			if otherViewNode, in := otherSchemaNode.views[viewName]; in {
+				if conflict, msg := viewNode.tryMerge(otherViewNode); conflict {
+					conflicts = append(conflicts, &mergeConflict{schema: schemaName, table: viewName, message: msg})
+				}
+			}
+		}
+		for functionName, functionNode := range schemaNode.functions {
+			if otherFunctionNode, in := otherSchemaNode.functions[functionName]; in {
+				if conflict, msg := functionNode.tryMerge(otherFunctionNode); conflict {
+					conflicts = append(conflicts, &mergeConflict{schema: schemaName, table: functionName, message: msg})
+				}
+			}
+		}
+		for procedureName, procedureNode := range schemaNode.procedures {
+			if otherProcedureNode, in := otherSchemaNode.procedures[procedureName]; in {
+				if conflict, msg := procedureNode.tryMerge(otherProcedureNode); conflict {
+					conflicts = append(conflicts, &mergeConflict{schema: schemaName, table: procedureName, message: msg})
+				}
+			}
+		}
+	}
+	slices.SortStableFunc(conflicts, func(a, b *mergeConflict) int {
+		if a.schema != b.schema {
+			return strings.Compare(a.schema, b.schema)
+		}
+		return strings.Compare(a.table, b.table)
+	})
+	return conflicts, nil
+}
+
+func findTableMergeConflicts(schemaName string, tableNode, otherTableNode *metadataDiffTableNode, engine storepb.Engine) []*mergeConflict {
+	if tableNode.action != otherTableNode.action {
+		return []*mergeConflict{{
+			schema:  schemaName,
+			table:   tableNode.name,
+			message: fmt.Sprintf("conflict table action, one is %s, the other is %s", tableNode.action, otherTableNode.action),
+		}}
+	}
+	if tableNode.action == diffActionDrop {
+		return nil
+	}
+
+	var conflicts []*mergeConflict
+	for _, columnName := range tableNode.columnNames {
+		otherColumnNode, in := otherTableNode.columnsMap[columnName]
+		if !in {
+			continue
+		}
+		if conflict, msg := tableNode.columnsMap[columnName].tryMerge(otherColumnNode, engine); conflict {
+			conflicts = append(conflicts, &mergeConflict{schema: schemaName, table: tableNode.name, column: columnName, message: msg})
+		}
+	}
+
+	// Check the table attributes, foreign keys, indexes and partitions without the columns.
+	tableNodeWithoutColumns, otherTableNodeWithoutColumns := *tableNode, *otherTableNode
+	tableNodeWithoutColumns.columnNames, tableNodeWithoutColumns.columnsMap = nil, map[string]*metadataDiffColumnNode{}
+	otherTableNodeWithoutColumns.columnNames, otherTableNodeWithoutColumns.columnsMap = nil, map[string]*metadataDiffColumnNode{}
+	if conflict, msg := tableNodeWithoutColumns.tryMerge(&otherTableNodeWithoutColumns, engine); conflict {
+		conflicts = append(conflicts, &mergeConflict{schema: schemaName, table: tableNode.name, message: msg})
+	}
+	return conflicts
+}
+
 type metadataDiffBaseNode struct {
 	action diffAction
 }
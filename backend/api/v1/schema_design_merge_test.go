@@ -85,3 +85,48 @@ func TestNormalizeMySQLViewDefinition(t *testing.T) {
 	got := normalizeMySQLViewDefinition(query)
 	require.Equal(t, want, got)
 }
+
+func TestFindMergeConflicts(t *testing.T) {
+	a := require.New(t)
+	newMetadata := func(tables ...*storepb.TableMetadata) *storepb.DatabaseSchemaMetadata {
+		return &storepb.DatabaseSchemaMetadata{
+			Schemas: []*storepb.SchemaMetadata{{Name: "", Tables: tables}},
+		}
+	}
+	newTable := func(name string, comment string, columns ...*storepb.ColumnMetadata) *storepb.TableMetadata {
+		return &storepb.TableMetadata{Name: name, Comment: comment, Columns: columns}
+	}
+
+	ancestor := newMetadata(
+		newTable("t1", "", &storepb.ColumnMetadata{Name: "a", Type: "int"}, &storepb.ColumnMetadata{Name: "b", Type: "int"}),
+		newTable("t2", ""),
+		newTable("t3", "", &storepb.ColumnMetadata{Name: "a", Type: "int"}),
+	)
+	head := newMetadata(
+		newTable("t1", "head", &storepb.ColumnMetadata{Name: "a", Type: "bigint"}, &storepb.ColumnMetadata{Name: "b", Type: "int"}),
+		newTable("t3", "", &storepb.ColumnMetadata{Name: "a", Type: "int"}, &storepb.ColumnMetadata{Name: "c", Type: "int"}),
+	)
+	base := newMetadata(
+		newTable("t1", "base", &storepb.ColumnMetadata{Name: "a", Type: "varchar(10)"}, &storepb.ColumnMetadata{Name: "b", Type: "int"}),
+		newTable("t2", "", &storepb.ColumnMetadata{Name: "a", Type: "int"}),
+		newTable("t3", "", &storepb.ColumnMetadata{Name: "a", Type: "int"}, &storepb.ColumnMetadata{Name: "d", Type: "int"}),
+	)
+
+	conflicts, err := findMergeConflicts(ancestor, head, base, storepb.Engine_MYSQL)
+	a.NoError(err)
+	a.Len(conflicts, 3)
+	// Column type conflict.
+	a.Equal("t1", conflicts[0].table)
+	a.Equal("a", conflicts[0].column)
+	// Table comment conflict.
+	a.Equal("t1", conflicts[1].table)
+	a.Equal("", conflicts[1].column)
+	// Dropped in head but updated in base.
+	a.Equal("t2", conflicts[2].table)
+	a.Equal("", conflicts[2].column)
+
+	// Merging without conflicts.
+	conflicts, err = findMergeConflicts(ancestor, head, ancestor, storepb.Engine_MYSQL)
+	a.NoError(err)
+	a.Empty(conflicts)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"embed"
 	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
 	"time"
@@ -17,15 +18,18 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/testing/protocmp"
 
+	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	approvalplugin "github.com/bytebase/bytebase/backend/plugin/app/approval"
 	"github.com/bytebase/bytebase/backend/plugin/mail"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/slack"
+	teamsapp "github.com/bytebase/bytebase/backend/plugin/webhook/teams"
 	"github.com/bytebase/bytebase/backend/plugin/webhook/wecom"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -39,6 +43,7 @@ type SettingService struct {
 	profile        *config.Profile
 	licenseService enterprise.LicenseService
 	stateCfg       *state.State
+	secret         string
 }
 
 // NewSettingService creates a new setting service.
@@ -47,12 +52,14 @@ func NewSettingService(
 	profile *config.Profile,
 	licenseService enterprise.LicenseService,
 	stateCfg *state.State,
+	secret string,
 ) *SettingService {
 	return &SettingService{
 		store:          store,
 		profile:        profile,
 		licenseService: licenseService,
 		stateCfg:       stateCfg,
+		secret:         secret,
 	}
 }
 
@@ -73,6 +80,12 @@ var whitelistSettings = []api.SettingName{
 	api.SettingSemanticTypes,
 	api.SettingMaskingAlgorithm,
 	api.SettingSQLResultSizeLimit,
+	api.SettingSheetStorage,
+	api.SettingPlanCheck,
+	api.SettingEventBus,
+	api.SettingRateLimit,
+	api.SettingReadAudit,
+	api.SettingAI,
 }
 
 var preservedMaskingAlgorithmIDMatcher = regexp.MustCompile("^[0]{8}-[0]{4}-[0]{4}-[0]{4}-[0]{9}[0-9a-fA-F]{3}$")
@@ -246,6 +259,46 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 				oldSetting.EnforceIdentityDomain = payload.EnforceIdentityDomain
 			case "value.workspace_profile_setting_value.database_change_mode":
 				oldSetting.DatabaseChangeMode = payload.DatabaseChangeMode
+			case "value.workspace_profile_setting_value.schema_snapshot_retention":
+				if payload.SchemaSnapshotRetention != nil && payload.SchemaSnapshotRetention.Seconds > 0 && payload.SchemaSnapshotRetention.AsDuration() < 24*time.Hour {
+					return nil, status.Errorf(codes.InvalidArgument, "schema snapshot retention should be at least one day")
+				}
+				oldSetting.SchemaSnapshotRetention = payload.SchemaSnapshotRetention
+			case "value.workspace_profile_setting_value.query_history_retention":
+				if payload.QueryHistoryRetention != nil && payload.QueryHistoryRetention.Seconds > 0 && payload.QueryHistoryRetention.AsDuration() < 24*time.Hour {
+					return nil, status.Errorf(codes.InvalidArgument, "query history retention should be at least one day")
+				}
+				oldSetting.QueryHistoryRetention = payload.QueryHistoryRetention
+			case "value.workspace_profile_setting_value.require_admin_execute_justification":
+				oldSetting.RequireAdminExecuteJustification = payload.RequireAdminExecuteJustification
+			case "value.workspace_profile_setting_value.long_running_query_threshold":
+				if payload.LongRunningQueryThreshold != nil && payload.LongRunningQueryThreshold.Seconds > 0 && payload.LongRunningQueryThreshold.AsDuration() < time.Minute {
+					return nil, status.Errorf(codes.InvalidArgument, "long-running query threshold should be at least one minute")
+				}
+				oldSetting.LongRunningQueryThreshold = payload.LongRunningQueryThreshold
+			case "value.workspace_profile_setting_value.issue_retention":
+				if payload.IssueRetention != nil && payload.IssueRetention.Seconds > 0 && payload.IssueRetention.AsDuration() < 30*24*time.Hour {
+					return nil, status.Errorf(codes.InvalidArgument, "issue retention should be at least 30 days")
+				}
+				oldSetting.IssueRetention = payload.IssueRetention
+			case "value.workspace_profile_setting_value.task_run_log_retention":
+				if payload.TaskRunLogRetention != nil && payload.TaskRunLogRetention.Seconds > 0 && payload.TaskRunLogRetention.AsDuration() < 24*time.Hour {
+					return nil, status.Errorf(codes.InvalidArgument, "task run log retention should be at least one day")
+				}
+				oldSetting.TaskRunLogRetention = payload.TaskRunLogRetention
+			case "value.workspace_profile_setting_value.directory_sync_token":
+				if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+					return nil, status.Errorf(codes.PermissionDenied, err.Error())
+				}
+				oldSetting.DirectorySyncToken = payload.DirectorySyncToken
+			case "value.workspace_profile_setting_value.login_security":
+				if err := s.licenseService.IsFeatureEnabled(api.FeatureSecureToken); err != nil {
+					return nil, status.Errorf(codes.PermissionDenied, err.Error())
+				}
+				if err := validateLoginSecurity(payload.LoginSecurity); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid login security, error: %v", err)
+				}
+				oldSetting.LoginSecurity = payload.LoginSecurity
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path %v", path)
 			}
@@ -410,6 +463,13 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 				}
 				setting.Wecom = payload.Wecom
 
+			case "value.app_im_setting_value.teams":
+				teams := payload.GetTeams()
+				if err := teamsapp.Validate(ctx, teams.GetSecurityToken(), teams.GetTenantId(), teams.GetClientId(), teams.GetClientSecret()); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "validation failed, error: %v", err)
+				}
+				setting.Teams = payload.Teams
+
 			default:
 				return nil, status.Errorf(codes.InvalidArgument, "invalid update mask path %v", path)
 			}
@@ -441,10 +501,19 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 		if externalApprovalSetting == nil {
 			return nil, status.Errorf(codes.InvalidArgument, "value cannot be nil when setting external approval setting")
 		}
-		storeValue := convertExternalApprovalSetting(externalApprovalSetting)
+		storeValue, err := convertExternalApprovalSetting(externalApprovalSetting, oldSetting)
+		if err != nil {
+			return nil, err
+		}
 
 		newNode := make(map[string]*storepb.ExternalApprovalSetting_Node)
 		for _, node := range storeValue.Nodes {
+			if !approvalplugin.IsRegistered(node.Provider) {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown approval provider %q for node %q, available providers are %v", node.Provider, node.Title, approvalplugin.ListProviderTypes())
+			}
+			if _, err := approvalplugin.Open(node); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid external approval node %q: %v", node.Title, err)
+			}
 			newNode[node.Id] = node
 		}
 		removed := make(map[string]bool)
@@ -582,6 +651,99 @@ func (s *SettingService) UpdateSetting(ctx context.Context, request *v1pb.Update
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
 		}
 		storeSettingValue = string(bytes)
+	case api.SettingSheetStorage:
+		v1Value := request.Setting.Value.GetSheetStorageSettingValue()
+		currentSetting, err := s.store.GetSheetStorageSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get setting %s with error: %v", apiSettingName, err)
+		}
+		if v1Value.GetExternalThreshold() < 0 || v1Value.GetWarningSize() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sheet size")
+		}
+		sheetStorageSetting := &storepb.SheetStorageSetting{
+			Storage:           convertToStoreBackupStorage(v1Value.GetStorage(), currentSetting.Storage, s.secret),
+			ExternalThreshold: v1Value.GetExternalThreshold(),
+			WarningSize:       v1Value.GetWarningSize(),
+		}
+		if sheetStorageSetting.Storage != nil {
+			if err := validateBackupStorage(sheetStorageSetting.Storage); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+		}
+		bytes, err := protojson.Marshal(sheetStorageSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingPlanCheck:
+		v1Value := request.Setting.Value.GetPlanCheckSettingValue()
+		if v1Value.GetMaxConcurrentPerInstance() < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid maximum concurrent plan checks %d", v1Value.GetMaxConcurrentPerInstance())
+		}
+		bytes, err := protojson.Marshal(&storepb.PlanCheckSetting{
+			MaxConcurrentPerInstance: v1Value.GetMaxConcurrentPerInstance(),
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingEventBus:
+		currentSetting, err := s.store.GetEventBusSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get setting %s with error: %v", apiSettingName, err)
+		}
+		eventBusSetting, err := convertToStoreEventBusSetting(request.Setting.Value.GetEventBusSettingValue(), currentSetting, s.secret)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		bytes, err := protojson.Marshal(eventBusSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingRateLimit:
+		rateLimitSetting := new(storepb.RateLimitSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetRateLimitSettingValue(), rateLimitSetting); err != nil {
+			return nil, err
+		}
+		if err := validateRateLimitSetting(rateLimitSetting); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		bytes, err := protojson.Marshal(rateLimitSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingReadAudit:
+		readAuditSetting := new(storepb.ReadAuditSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetReadAuditSettingValue(), readAuditSetting); err != nil {
+			return nil, err
+		}
+		if err := validateReadAuditSetting(readAuditSetting); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		bytes, err := protojson.Marshal(readAuditSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingAI:
+		if err := s.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		currentSetting, err := s.store.GetAISetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get setting %s with error: %v", apiSettingName, err)
+		}
+		aiSetting, err := convertToStoreAISetting(request.Setting.Value.GetAiSettingValue(), currentSetting, s.secret)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		bytes, err := protojson.Marshal(aiSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
 	default:
 		storeSettingValue = request.Setting.Value.GetStringValue()
 	}
@@ -681,6 +843,9 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 						Wecom: &v1pb.AppIMSetting_Wecom{
 							Enabled: storeValue.Wecom != nil && storeValue.Wecom.Enabled,
 						},
+						Teams: &v1pb.AppIMSetting_Teams{
+							Enabled: storeValue.Teams != nil && storeValue.Teams.Enabled,
+						},
 					},
 				},
 			},
@@ -703,6 +868,8 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), v1Value); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
 		}
+		// The directory sync token is input only.
+		v1Value.DirectorySyncToken = ""
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
@@ -744,7 +911,10 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting values for %s with error: %v", setting.Name, err)
 		}
-		v1Value := convertToExternalApprovalSetting(storeValue)
+		v1Value, err := convertToExternalApprovalSetting(storeValue)
+		if err != nil {
+			return nil, err
+		}
 		return &v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
@@ -826,6 +996,100 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 				},
 			},
 		}, nil
+	case api.SettingSheetStorage:
+		storeValue := new(storepb.SheetStorageSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		v1Value := &v1pb.SheetStorageSetting{
+			Storage:           convertToV1BackupStorage(storeValue.Storage),
+			ExternalThreshold: storeValue.ExternalThreshold,
+			WarningSize:       storeValue.WarningSize,
+		}
+		if v1Value.ExternalThreshold <= 0 {
+			v1Value.ExternalThreshold = common.DefaultSheetExternalThreshold
+		}
+		if v1Value.WarningSize <= 0 {
+			v1Value.WarningSize = common.DefaultSheetWarningSize
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_SheetStorageSettingValue{
+					SheetStorageSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingPlanCheck:
+		storeValue := new(storepb.PlanCheckSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_PlanCheckSettingValue{
+					PlanCheckSettingValue: &v1pb.PlanCheckSetting{
+						MaxConcurrentPerInstance: storeValue.MaxConcurrentPerInstance,
+					},
+				},
+			},
+		}, nil
+	case api.SettingEventBus:
+		storeValue := new(storepb.EventBusSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_EventBusSettingValue{
+					EventBusSettingValue: convertToV1EventBusSetting(storeValue),
+				},
+			},
+		}, nil
+	case api.SettingRateLimit:
+		v1Value := new(v1pb.RateLimitSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_RateLimitSettingValue{
+					RateLimitSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingReadAudit:
+		v1Value := new(v1pb.ReadAuditSetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		if v1Value.SampleRate <= 0 {
+			v1Value.SampleRate = 1
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ReadAuditSettingValue{
+					ReadAuditSettingValue: v1Value,
+				},
+			},
+		}, nil
+	case api.SettingAI:
+		storeValue := new(storepb.AISetting)
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), storeValue); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_AiSettingValue{
+					AiSettingValue: convertToV1AISetting(storeValue),
+				},
+			},
+		}, nil
 	default:
 		return &v1pb.Setting{
 			Name: settingName,
@@ -932,6 +1196,39 @@ func settingInWhitelist(name api.SettingName) bool {
 	return false
 }
 
+func validateLoginSecurity(loginSecurity *storepb.LoginSecurity) error {
+	if loginSecurity == nil {
+		return nil
+	}
+	if err := auth.ValidateIPRanges(loginSecurity.IpAllowlist); err != nil {
+		return errors.Wrapf(err, "invalid IP allowlist")
+	}
+	if err := auth.ValidateIPRanges(loginSecurity.IpDenylist); err != nil {
+		return errors.Wrapf(err, "invalid IP denylist")
+	}
+	if loginSecurity.MaxConcurrentSessions < 0 {
+		return errors.Errorf("max concurrent sessions cannot be negative")
+	}
+	if d := loginSecurity.ReauthInterval; d != nil && d.Seconds > 0 && d.AsDuration() < time.Minute {
+		return errors.Errorf("reauth interval should be at least one minute")
+	}
+	if loginSecurity.MaxFailedAttempts < 0 {
+		return errors.Errorf("max failed attempts cannot be negative")
+	}
+	if d := loginSecurity.LockoutDuration; d != nil && d.AsDuration() < 0 {
+		return errors.Errorf("lockout duration cannot be negative")
+	}
+	for _, alertWebhook := range loginSecurity.AlertWebhooks {
+		if alertWebhook.Type == storepb.LoginSecurity_AlertWebhook_TYPE_UNSPECIFIED {
+			return errors.Errorf("alert webhook type is required")
+		}
+		if _, err := url.ParseRequestURI(alertWebhook.Url); err != nil {
+			return errors.Wrapf(err, "invalid alert webhook url %q", alertWebhook.Url)
+		}
+	}
+	return nil
+}
+
 func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 	if template.Flow == nil {
 		return errors.Errorf("approval template cannot be nil")
@@ -946,6 +1243,13 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 		if len(step.Nodes) != 1 {
 			return errors.Errorf("expect 1 node in approval step, got: %v", len(step.Nodes))
 		}
+		for _, node := range step.Nodes {
+			if group, ok := node.Payload.(*v1pb.ApprovalNode_Group); ok {
+				if _, err := common.GetGroupEmail(group.Group); err != nil {
+					return errors.Wrapf(err, "invalid group %q in approval node", group.Group)
+				}
+			}
+		}
 	}
 	return nil
 }
@@ -1100,48 +1404,103 @@ func convertToSMTPEncryptionType(encryptionType storepb.SMTPMailDeliverySetting_
 	return v1pb.SMTPMailDeliverySettingValue_ENCRYPTION_UNSPECIFIED
 }
 
-func convertToExternalApprovalSetting(s *storepb.ExternalApprovalSetting) *v1pb.ExternalApprovalSetting {
-	return &v1pb.ExternalApprovalSetting{
-		Nodes: convertToExternalApprovalSettingNodes(s.Nodes),
+func convertToExternalApprovalSetting(s *storepb.ExternalApprovalSetting) (*v1pb.ExternalApprovalSetting, error) {
+	nodes, err := convertToExternalApprovalSettingNodes(s.Nodes)
+	if err != nil {
+		return nil, err
 	}
+	return &v1pb.ExternalApprovalSetting{
+		Nodes: nodes,
+	}, nil
 }
 
-func convertToExternalApprovalSettingNodes(nodes []*storepb.ExternalApprovalSetting_Node) []*v1pb.ExternalApprovalSetting_Node {
+func convertToExternalApprovalSettingNodes(nodes []*storepb.ExternalApprovalSetting_Node) ([]*v1pb.ExternalApprovalSetting_Node, error) {
 	v1Nodes := make([]*v1pb.ExternalApprovalSetting_Node, len(nodes))
 	for i := range nodes {
-		v1Nodes[i] = convertToExternalApprovalSettingNode(nodes[i])
+		node, err := convertToExternalApprovalSettingNode(nodes[i])
+		if err != nil {
+			return nil, err
+		}
+		v1Nodes[i] = node
 	}
-	return v1Nodes
+	return v1Nodes, nil
 }
 
-func convertToExternalApprovalSettingNode(o *storepb.ExternalApprovalSetting_Node) *v1pb.ExternalApprovalSetting_Node {
-	return &v1pb.ExternalApprovalSetting_Node{
-		Id:       o.Id,
-		Title:    o.Title,
-		Endpoint: o.Endpoint,
+// convertToExternalApprovalSettingNode converts the node, the header values are never returned.
+func convertToExternalApprovalSettingNode(o *storepb.ExternalApprovalSetting_Node) (*v1pb.ExternalApprovalSetting_Node, error) {
+	node := &v1pb.ExternalApprovalSetting_Node{
+		Id:              o.Id,
+		Title:           o.Title,
+		Endpoint:        o.Endpoint,
+		Provider:        o.Provider,
+		PayloadTemplate: o.PayloadTemplate,
+	}
+	for _, header := range o.Headers {
+		externalSecret, err := convertToV1DataSourceExternalSecret(header.ExternalSecret)
+		if err != nil {
+			return nil, err
+		}
+		node.Headers = append(node.Headers, &v1pb.ExternalApprovalSetting_Node_Header{
+			Key:            header.Key,
+			ExternalSecret: externalSecret,
+		})
 	}
+	return node, nil
 }
 
-func convertExternalApprovalSetting(s *v1pb.ExternalApprovalSetting) *storepb.ExternalApprovalSetting {
-	return &storepb.ExternalApprovalSetting{
-		Nodes: convertExternalApprovalSettingNodes(s.Nodes),
+// convertExternalApprovalSetting converts the setting,
+// the value of a header is kept if it's not set and the header of the same key in the same node doesn't use the external secret.
+func convertExternalApprovalSetting(s *v1pb.ExternalApprovalSetting, current *storepb.ExternalApprovalSetting) (*storepb.ExternalApprovalSetting, error) {
+	currentNodes := make(map[string]*storepb.ExternalApprovalSetting_Node)
+	for _, node := range current.GetNodes() {
+		currentNodes[node.Id] = node
 	}
-}
-
-func convertExternalApprovalSettingNodes(nodes []*v1pb.ExternalApprovalSetting_Node) []*storepb.ExternalApprovalSetting_Node {
-	storeNodes := make([]*storepb.ExternalApprovalSetting_Node, len(nodes))
-	for i := range nodes {
-		storeNodes[i] = convertExternalApprovalSettingNode(nodes[i])
+	storeNodes := make([]*storepb.ExternalApprovalSetting_Node, len(s.Nodes))
+	for i, node := range s.Nodes {
+		storeNode, err := convertExternalApprovalSettingNode(node, currentNodes[node.Id])
+		if err != nil {
+			return nil, err
+		}
+		storeNodes[i] = storeNode
 	}
-	return storeNodes
+	return &storepb.ExternalApprovalSetting{
+		Nodes: storeNodes,
+	}, nil
 }
 
-func convertExternalApprovalSettingNode(o *v1pb.ExternalApprovalSetting_Node) *storepb.ExternalApprovalSetting_Node {
-	return &storepb.ExternalApprovalSetting_Node{
-		Id:       o.Id,
-		Title:    o.Title,
-		Endpoint: o.Endpoint,
+func convertExternalApprovalSettingNode(o *v1pb.ExternalApprovalSetting_Node, current *storepb.ExternalApprovalSetting_Node) (*storepb.ExternalApprovalSetting_Node, error) {
+	currentValues := make(map[string]string)
+	for _, header := range current.GetHeaders() {
+		if header.ExternalSecret == nil {
+			currentValues[header.Key] = header.Value
+		}
+	}
+	node := &storepb.ExternalApprovalSetting_Node{
+		Id:              o.Id,
+		Title:           o.Title,
+		Endpoint:        o.Endpoint,
+		Provider:        o.Provider,
+		PayloadTemplate: o.PayloadTemplate,
 	}
+	for _, header := range o.Headers {
+		if header.Key == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "header key is required for external approval node %q", o.Title)
+		}
+		externalSecret, err := convertToStoreDataSourceExternalSecret(header.ExternalSecret)
+		if err != nil {
+			return nil, err
+		}
+		value := header.Value
+		if value == "" && externalSecret == nil {
+			value = currentValues[header.Key]
+		}
+		node.Headers = append(node.Headers, &storepb.ExternalApprovalSetting_Node_Header{
+			Key:            header.Key,
+			Value:          value,
+			ExternalSecret: externalSecret,
+		})
+	}
+	return node, nil
 }
 
 // stripSensitiveData strips the sensitive data like password from the setting.value.
@@ -1302,6 +1661,13 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 			if err := checkSubstitution(m.InnerOuterMask.Substitution); err != nil {
 				return err
 			}
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_DateShiftMask_:
+			if m.DateShiftMask.MaxShiftDays <= 0 {
+				return status.Errorf(codes.InvalidArgument, "the max shift days of date shift mask must be positive")
+			}
+			if m.DateShiftMask.Salt == "" {
+				return status.Errorf(codes.InvalidArgument, "the salt of date shift mask is required")
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -1309,8 +1675,21 @@ func validateMaskingAlgorithm(algorithm *v1pb.MaskingAlgorithmSetting_Algorithm)
 		if algorithm.Mask == nil {
 			return nil
 		}
-		switch algorithm.Mask.(type) {
+		switch m := algorithm.Mask.(type) {
 		case *v1pb.MaskingAlgorithmSetting_Algorithm_Md5Mask:
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_HashMask_:
+			if m.HashMask.Salt == "" {
+				return status.Errorf(codes.InvalidArgument, "the salt of hash mask is required")
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
+		}
+	case "ENCRYPT":
+		switch m := algorithm.Mask.(type) {
+		case *v1pb.MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask_:
+			if len(m.FormatPreservingEncryptionMask.Key) < 16 {
+				return status.Errorf(codes.InvalidArgument, "the key of format preserving encryption mask must be at least 16 bytes")
+			}
 		default:
 			return status.Errorf(codes.InvalidArgument, "mismatch masking algorithm category and mask type: %T, %s", algorithm.Mask, algorithm.Category)
 		}
@@ -1380,3 +1759,188 @@ func validateDomains(domains []string) error {
 	}
 	return nil
 }
+
+// convertToStoreEventBusSetting converts and validates the event bus setting,
+// the password of a destination is kept if it's not set and the type, address and username are unchanged.
+func convertToStoreEventBusSetting(setting *v1pb.EventBusSetting, current *storepb.EventBusSetting, secret string) (*storepb.EventBusSetting, error) {
+	currentDestinations := make(map[string]*storepb.EventBusSetting_Destination)
+	for _, destination := range current.GetDestinations() {
+		currentDestinations[destination.Id] = destination
+	}
+
+	storeSetting := &storepb.EventBusSetting{}
+	ids := make(map[string]bool)
+	for _, destination := range setting.GetDestinations() {
+		if destination.Id == "" {
+			return nil, errors.Errorf("destination id is required")
+		}
+		if ids[destination.Id] {
+			return nil, errors.Errorf("duplicate destination id %q", destination.Id)
+		}
+		ids[destination.Id] = true
+		if destination.Type == v1pb.EventBusSetting_Destination_TYPE_UNSPECIFIED {
+			return nil, errors.Errorf("destination %q type is required", destination.Id)
+		}
+		if destination.Address == "" {
+			return nil, errors.Errorf("destination %q address is required", destination.Id)
+		}
+		if destination.Topic == "" {
+			return nil, errors.Errorf("destination %q topic is required", destination.Id)
+		}
+
+		storeDestination := &storepb.EventBusSetting_Destination{
+			Id:       destination.Id,
+			Type:     storepb.EventBusSetting_Destination_Type(destination.Type),
+			Address:  destination.Address,
+			Topic:    destination.Topic,
+			Username: destination.Username,
+		}
+		if destination.Password != "" {
+			storeDestination.ObfuscatedPassword = common.Obfuscate(destination.Password, secret)
+		} else if c, ok := currentDestinations[destination.Id]; ok && c.Type == storeDestination.Type && c.Address == storeDestination.Address && c.Username == storeDestination.Username {
+			storeDestination.ObfuscatedPassword = c.ObfuscatedPassword
+		}
+		if storeDestination.Type == storepb.EventBusSetting_Destination_PUBSUB && storeDestination.ObfuscatedPassword == "" {
+			return nil, errors.Errorf("destination %q service account key is required", destination.Id)
+		}
+		storeSetting.Destinations = append(storeSetting.Destinations, storeDestination)
+	}
+	return storeSetting, nil
+}
+
+// convertToV1EventBusSetting converts the event bus setting, the passwords are never returned.
+func convertToV1EventBusSetting(setting *storepb.EventBusSetting) *v1pb.EventBusSetting {
+	v1Setting := &v1pb.EventBusSetting{}
+	for _, destination := range setting.Destinations {
+		v1Setting.Destinations = append(v1Setting.Destinations, &v1pb.EventBusSetting_Destination{
+			Id:       destination.Id,
+			Type:     v1pb.EventBusSetting_Destination_Type(destination.Type),
+			Address:  destination.Address,
+			Topic:    destination.Topic,
+			Username: destination.Username,
+		})
+	}
+	return v1Setting
+}
+
+func validateRateLimitSetting(setting *storepb.RateLimitSetting) error {
+	validateLimits := func(limits []*storepb.RateLimitSetting_Limit) error {
+		classes := make(map[storepb.RateLimitSetting_MethodClass]bool)
+		for _, limit := range limits {
+			if limit.MethodClass == storepb.RateLimitSetting_METHOD_CLASS_UNSPECIFIED {
+				return errors.Errorf("method class is required")
+			}
+			if classes[limit.MethodClass] {
+				return errors.Errorf("duplicate limits for method class %s", limit.MethodClass)
+			}
+			classes[limit.MethodClass] = true
+			if limit.Rate <= 0 {
+				return errors.Errorf("rate must be positive for method class %s", limit.MethodClass)
+			}
+			if limit.Burst < 0 {
+				return errors.Errorf("burst must be non-negative for method class %s", limit.MethodClass)
+			}
+		}
+		return nil
+	}
+	if err := validateLimits(setting.UserLimits); err != nil {
+		return errors.Wrapf(err, "invalid user limits")
+	}
+	if err := validateLimits(setting.ServiceAccountLimits); err != nil {
+		return errors.Wrapf(err, "invalid service account limits")
+	}
+	principals := make(map[string]bool)
+	for _, override := range setting.Overrides {
+		if _, err := common.GetUserEmail(override.Principal); err != nil {
+			return errors.Wrapf(err, "invalid override principal %q", override.Principal)
+		}
+		if principals[override.Principal] {
+			return errors.Errorf("duplicate overrides for principal %q", override.Principal)
+		}
+		principals[override.Principal] = true
+		if err := validateLimits(override.Limits); err != nil {
+			return errors.Wrapf(err, "invalid limits of principal %q", override.Principal)
+		}
+	}
+	return nil
+}
+
+func validateReadAuditSetting(setting *storepb.ReadAuditSetting) error {
+	for _, project := range setting.Projects {
+		if _, err := common.GetProjectID(project); err != nil {
+			return errors.Wrapf(err, "invalid project %q", project)
+		}
+	}
+	for _, method := range setting.Methods {
+		if tokens := strings.Split(method, "/"); len(tokens) != 3 || tokens[0] != "" || !strings.HasPrefix(tokens[1], "bytebase.v1.") || tokens[2] == "" {
+			return errors.Errorf("invalid method %q, the method should be like /bytebase.v1.IssueService/GetIssue", method)
+		}
+	}
+	if setting.SampleRate < 0 || setting.SampleRate > 1 {
+		return errors.Errorf("sample rate must be between 0 and 1")
+	}
+	return nil
+}
+
+// convertToStoreAISetting converts and validates the AI setting,
+// the API key is kept if it's empty and the provider and endpoint are unchanged.
+func convertToStoreAISetting(setting *v1pb.AISetting, current *storepb.AISetting, secret string) (*storepb.AISetting, error) {
+	storeSetting := &storepb.AISetting{
+		Enabled:       setting.GetEnabled(),
+		Provider:      storepb.AISetting_Provider(setting.GetProvider()),
+		Endpoint:      setting.GetEndpoint(),
+		Model:         setting.GetModel(),
+		ApiVersion:    setting.GetApiVersion(),
+		ReviewEnabled: setting.GetReviewEnabled(),
+	}
+	if setting.GetApiKey() != "" {
+		storeSetting.ObfuscatedApiKey = common.Obfuscate(setting.GetApiKey(), secret)
+	} else if current.GetProvider() == storeSetting.Provider && current.GetEndpoint() == storeSetting.Endpoint {
+		storeSetting.ObfuscatedApiKey = current.GetObfuscatedApiKey()
+	}
+	if !storeSetting.Enabled {
+		return storeSetting, nil
+	}
+
+	switch storeSetting.Provider {
+	case storepb.AISetting_OPENAI:
+	case storepb.AISetting_AZURE_OPENAI:
+		if storeSetting.Endpoint == "" {
+			return nil, errors.Errorf("endpoint is required for Azure OpenAI")
+		}
+		if storeSetting.ApiVersion == "" {
+			return nil, errors.Errorf("API version is required for Azure OpenAI")
+		}
+	case storepb.AISetting_OPENAI_COMPATIBLE:
+		if storeSetting.Endpoint == "" {
+			return nil, errors.Errorf("endpoint is required for the OpenAI compatible provider")
+		}
+	default:
+		return nil, errors.Errorf("provider is required")
+	}
+	if storeSetting.Endpoint != "" {
+		if u, err := url.Parse(storeSetting.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errors.Errorf("invalid endpoint %q", storeSetting.Endpoint)
+		}
+	}
+	if storeSetting.Model == "" {
+		return nil, errors.Errorf("model is required")
+	}
+	// The self-hosted endpoints may not require the API key.
+	if storeSetting.Provider != storepb.AISetting_OPENAI_COMPATIBLE && storeSetting.ObfuscatedApiKey == "" {
+		return nil, errors.Errorf("API key is required")
+	}
+	return storeSetting, nil
+}
+
+// convertToV1AISetting converts the AI setting, the API key is never returned.
+func convertToV1AISetting(setting *storepb.AISetting) *v1pb.AISetting {
+	return &v1pb.AISetting{
+		Enabled:       setting.Enabled,
+		Provider:      v1pb.AISetting_Provider(setting.Provider),
+		Endpoint:      setting.Endpoint,
+		Model:         setting.Model,
+		ApiVersion:    setting.ApiVersion,
+		ReviewEnabled: setting.ReviewEnabled,
+	}
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	openai "github.com/sashabaranov/go-openai"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/ai"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// generateSQLSchemaLimit is the maximum characters of the schema metadata sent to the provider.
+	generateSQLSchemaLimit = 30000
+	generateSQLTimeout     = 2 * time.Minute
+)
+
+// GenerateSQL generates the SQL statement from the natural language, the prompts are recorded in the audit logs.
+func (s *SQLService) GenerateSQL(ctx context.Context, request *v1pb.GenerateSQLRequest) (*v1pb.GenerateSQLResponse, error) {
+	if err := s.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	if strings.TrimSpace(request.Prompt) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "prompt is required")
+	}
+	config, err := ai.GetConfig(ctx, s.store, s.secret)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get AI config, error: %v", err)
+	}
+	if config == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "AI provider is not configured")
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance, error: %v", err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database, error: %v", err)
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", request.Name)
+	}
+	dbSchema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get database schema, error: %v", err)
+	}
+	var metadata *storepb.DatabaseSchemaMetadata
+	if dbSchema != nil {
+		metadata = dbSchema.GetMetadata()
+	}
+
+	messages := []openai.ChatCompletionMessage{
+		{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: getGenerateSQLSystemPrompt(instance.Engine, metadata),
+		},
+	}
+	for _, message := range request.History {
+		role := openai.ChatMessageRoleUser
+		if message.Role == v1pb.GenerateSQLRequest_Message_ASSISTANT {
+			role = openai.ChatMessageRoleAssistant
+		}
+		messages = append(messages, openai.ChatCompletionMessage{
+			Role:    role,
+			Content: message.Content,
+		})
+	}
+	messages = append(messages, openai.ChatCompletionMessage{
+		Role:    openai.ChatMessageRoleUser,
+		Content: request.Prompt,
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, generateSQLTimeout)
+	defer cancel()
+	content, err := ai.Complete(ctx, config, messages)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to generate SQL, error: %v", err)
+	}
+	return &v1pb.GenerateSQLResponse{
+		Statement: extractGeneratedStatement(content),
+	}, nil
+}
+
+func getGenerateSQLSystemPrompt(engine storepb.Engine, metadata *storepb.DatabaseSchemaMetadata) string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "You are a %s database and SQL expert.\n", engine.String())
+	_, _ = b.WriteString("Write a SQL statement to answer the questions with the tables below. Respond with the SQL statement only, add the necessary quotes to the identifiers.\n")
+	if schema := getGenerateSQLSchemaText(metadata, generateSQLSchemaLimit); schema != "" {
+		_, _ = b.WriteString("The tables with their columns, types and comments:\n")
+		_, _ = b.WriteString(schema)
+	}
+	return b.String()
+}
+
+// getGenerateSQLSchemaText gets the text of the tables, the remaining tables are omitted once the text exceeds the limit.
+func getGenerateSQLSchemaText(metadata *storepb.DatabaseSchemaMetadata, limit int) string {
+	var b strings.Builder
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.Tables {
+			var t strings.Builder
+			name := table.Name
+			if schema.Name != "" {
+				name = fmt.Sprintf("%s.%s", schema.Name, table.Name)
+			}
+			_, _ = fmt.Fprintf(&t, "# %s(", name)
+			for i, column := range table.Columns {
+				if i > 0 {
+					_, _ = t.WriteString(", ")
+				}
+				_, _ = fmt.Fprintf(&t, "%s %s", column.Name, column.Type)
+				if column.Comment != "" {
+					_, _ = fmt.Fprintf(&t, " /* %s */", column.Comment)
+				}
+			}
+			_, _ = t.WriteString(")")
+			if table.Comment != "" {
+				_, _ = fmt.Fprintf(&t, " /* %s */", table.Comment)
+			}
+			_, _ = t.WriteString("\n")
+			if b.Len()+t.Len() > limit {
+				return b.String()
+			}
+			_, _ = b.WriteString(t.String())
+		}
+	}
+	return b.String()
+}
+
+// extractGeneratedStatement extracts the statement from the markdown code block if there is one.
+func extractGeneratedStatement(content string) string {
+	content = strings.TrimSpace(content)
+	start := strings.Index(content, "```")
+	if start < 0 {
+		return content
+	}
+	block := content[start+3:]
+	// Skip the language of the code block, e.g. ```sql.
+	if i := strings.Index(block, "\n"); i >= 0 {
+		block = block[i+1:]
+	}
+	if end := strings.Index(block, "```"); end >= 0 {
+		block = block[:end]
+	}
+	return strings.TrimSpace(block)
+}
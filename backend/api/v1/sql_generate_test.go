@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetGenerateSQLSchemaText(t *testing.T) {
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "public",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:    "users",
+						Comment: "The registered users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+							{Name: "email", Type: "text", Comment: "The login email"},
+						},
+					},
+					{
+						Name: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id", Type: "integer"},
+						},
+					},
+				},
+			},
+		},
+	}
+	require.Equal(t, "# public.users(id integer, email text /* The login email */) /* The registered users */\n# public.orders(id integer)\n", getGenerateSQLSchemaText(metadata, 1000))
+	// The tables exceeding the limit are omitted.
+	require.Equal(t, "# public.users(id integer, email text /* The login email */) /* The registered users */\n", getGenerateSQLSchemaText(metadata, 100))
+	require.Equal(t, "", getGenerateSQLSchemaText(nil, 1000))
+}
+
+func TestExtractGeneratedStatement(t *testing.T) {
+	require.Equal(t, "SELECT * FROM users;", extractGeneratedStatement("SELECT * FROM users;"))
+	require.Equal(t, "SELECT * FROM users;", extractGeneratedStatement("Here is the query:\n```sql\nSELECT * FROM users;\n```"))
+	require.Equal(t, "SELECT 1;", extractGeneratedStatement("```\nSELECT 1;\n```"))
+}
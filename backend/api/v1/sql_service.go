@@ -25,6 +25,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/component/sheet"
+	"github.com/bytebase/bytebase/backend/component/state"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/advisor"
@@ -32,6 +33,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	mapperparser "github.com/bytebase/bytebase/backend/plugin/parser/mybatis/mapper"
+	redisparser "github.com/bytebase/bytebase/backend/plugin/parser/redis"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
 	"github.com/bytebase/bytebase/backend/plugin/schema"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
@@ -57,6 +59,8 @@ type SQLService struct {
 	licenseService enterprise.LicenseService
 	profile        *config.Profile
 	iamManager     *iam.Manager
+	stateCfg       *state.State
+	secret         string
 }
 
 // NewSQLService creates a SQLService.
@@ -68,6 +72,8 @@ func NewSQLService(
 	licenseService enterprise.LicenseService,
 	profile *config.Profile,
 	iamManager *iam.Manager,
+	stateCfg *state.State,
+	secret string,
 ) *SQLService {
 	return &SQLService{
 		store:          store,
@@ -77,6 +83,8 @@ func NewSQLService(
 		licenseService: licenseService,
 		profile:        profile,
 		iamManager:     iamManager,
+		stateCfg:       stateCfg,
+		secret:         secret,
 	}
 }
 
@@ -85,6 +93,7 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 	ctx := server.Context()
 	var driver db.Driver
 	var conn *sql.Conn
+	var session *store.AdminSessionMessage
 	defer func() {
 		if conn != nil {
 			if err := conn.Close(); err != nil {
@@ -109,6 +118,14 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 			return err
 		}
 
+		// The whole stream is recorded as one admin session.
+		if session == nil {
+			session, err = s.startAdminSession(ctx, request, instance, database, user)
+			if err != nil {
+				return err
+			}
+		}
+
 		// We only need to get the driver and connection once.
 		if driver == nil {
 			driver, err = s.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
@@ -128,7 +145,11 @@ func (s *SQLService) AdminExecute(server v1pb.SQLService_AdminExecuteServer) err
 		result, durationNs, queryErr := s.doAdminExecute(ctx, driver, conn, request)
 		sanitizeResults(result)
 
-		if err := s.postQuery(ctx, database, request.Statement, user.ID, durationNs, queryErr); err != nil {
+		if err := s.recordAdminSessionStatement(ctx, session, request.Statement, durationNs, result, queryErr); err != nil {
+			slog.Error("failed to record admin session statement", log.BBError(err))
+		}
+
+		if err := s.postQuery(ctx, database, request.Statement, user.ID, durationNs, result, queryErr); err != nil {
 			slog.Error("failed to post admin execute activity", log.BBError(err))
 		}
 
@@ -184,6 +205,11 @@ func (s *SQLService) Execute(ctx context.Context, request *v1pb.ExecuteRequest)
 	}
 
 	statement := request.Statement
+	if instance.Engine == storepb.Engine_REDIS {
+		if err := s.checkRedisAdminCommands(ctx, statement); err != nil {
+			return nil, err
+		}
+	}
 	// Run SQL review.
 	adviceStatus, advices, err := s.SQLReviewCheck(ctx, statement, v1pb.CheckRequest_CHANGE_TYPE_UNSPECIFIED, instance, database, nil /* Override Metadata */)
 	if err != nil {
@@ -207,6 +233,30 @@ func (s *SQLService) Execute(ctx context.Context, request *v1pb.ExecuteRequest)
 	return response, nil
 }
 
+// checkRedisAdminCommands checks the permission to run the Redis commands changing the server configuration or the ACL users.
+// These commands affect the whole instance, so they require the permission to execute on the instance.
+func (s *SQLService) checkRedisAdminCommands(ctx context.Context, statement string) error {
+	containsAdminCommand, err := redisparser.ContainsAdminCommand(statement)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to parse the commands: %v", err)
+	}
+	if !containsAdminCommand {
+		return nil
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	ok, err = s.iamManager.CheckPermission(ctx, iam.PermissionInstancesAdminExecute, user)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission %q is required to run the commands changing the server configuration or the ACL users", iam.PermissionInstancesAdminExecute)
+	}
+	return nil
+}
+
 func (s *SQLService) preExecute(ctx context.Context, request *v1pb.ExecuteRequest) (*store.InstanceMessage, *store.DatabaseMessage, error) {
 	hasDatabase := strings.Contains(request.Name, "/databases/")
 	var err error
@@ -308,6 +358,13 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		return nil, err
 	}
 
+	dataSource, err := s.getQueryDataSource(ctx, user, database, request.DataSourceId)
+	if err != nil {
+		return nil, err
+	}
+	// Pin the export to the routed data source.
+	request.DataSourceId = dataSource.ID
+
 	spans, err := base.GetQuerySpan(
 		ctx,
 		base.GetQuerySpanContext{
@@ -332,11 +389,20 @@ func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*
 		}
 	}
 
+	// Filter the rows by the row access policies.
+	if request.Statement, err = s.rewriteRowAccess(ctx, instance, database, user, request.Statement); err != nil {
+		return nil, err
+	}
+
 	// Run SQL review.
 	if _, _, err = s.SQLReviewCheck(ctx, statement, v1pb.CheckRequest_CHANGE_TYPE_UNSPECIFIED, instance, database, nil /* Override Metadata */); err != nil {
 		return nil, err
 	}
 
+	if err := s.reserveConnection(instance); err != nil {
+		return nil, err
+	}
+	defer s.stateCfg.InstanceOutstandingConnections.Decrement(instance.UID)
 	bytes, durationNs, exportErr := DoExport(ctx, s.store, s.dbFactory, s.licenseService, request, instance, database, spans)
 
 	if err := s.postExport(ctx, database, statement, user.ID, durationNs, exportErr); err != nil {
@@ -418,7 +484,7 @@ func (s *SQLService) doExportFromIssue(ctx context.Context, issueName string) (*
 
 // DoExport does the export.
 func DoExport(ctx context.Context, storeInstance *store.Store, dbFactory *dbfactory.DBFactory, licenseService enterprise.LicenseService, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, spans []*base.QuerySpan) ([]byte, int64, error) {
-	driver, err := dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	driver, err := dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -580,6 +646,22 @@ func (s *SQLService) SearchQueryHistories(ctx context.Context, request *v1pb.Sea
 	}
 
 	for _, spec := range filters {
+		if spec.key == "create_time" {
+			t, err := time.Parse(time.RFC3339, spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid create_time %q, should be RFC 3339 timestamp", spec.value)
+			}
+			ts := t.Unix()
+			switch spec.operator {
+			case comparatorTypeGreaterEqual:
+				find.CreatedTsAfter = &ts
+			case comparatorTypeLessEqual:
+				find.CreatedTsBefore = &ts
+			default:
+				return nil, status.Errorf(codes.InvalidArgument, `only support ">=" and "<=" operation for "create_time" filter`)
+			}
+			continue
+		}
 		if spec.operator != comparatorTypeEqual {
 			return nil, status.Errorf(codes.InvalidArgument, `only support "=" operation for "%v" filter`, spec.key)
 		}
@@ -593,6 +675,33 @@ func (s *SQLService) SearchQueryHistories(ctx context.Context, request *v1pb.Sea
 		case "type":
 			historyType := store.QueryHistoryType(spec.value)
 			find.Type = &historyType
+		case "query":
+			query := spec.value
+			find.Query = &query
+		case "creator":
+			creatorEmail := strings.TrimPrefix(spec.value, "users/")
+			creator, err := s.store.GetUserByEmail(ctx, creatorEmail)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+			}
+			if creator == nil {
+				return nil, status.Errorf(codes.NotFound, "user %q not found", creatorEmail)
+			}
+			// Reviewing the data access of the other users is an audit.
+			if creator.ID != principalID {
+				user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+				if !ok {
+					return nil, status.Errorf(codes.Internal, "user not found")
+				}
+				ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionAuditLogsSearch, user)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+				}
+				if !ok {
+					return nil, status.Errorf(codes.PermissionDenied, "permission %q is required to search the query histories of other users", iam.PermissionAuditLogsSearch)
+				}
+			}
+			find.CreatorUID = &creator.ID
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid filter %s", spec.key)
 		}
@@ -657,6 +766,7 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 		CreateTime: timestamppb.New(history.CreatedTime),
 		Duration:   history.Payload.Duration,
 		Type:       historyType,
+		RowCount:   history.Payload.RowCount,
 	}, nil
 }
 
@@ -666,53 +776,19 @@ func (s *SQLService) convertToV1QueryHistory(ctx context.Context, history *store
 //  2. do query
 //  3. post-query
 func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error) {
+	return s.query(ctx, request)
+}
+
+func (s *SQLService) query(ctx context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error) {
 	// Prepare related message.
 	user, instance, database, err := s.prepareRelatedMessage(ctx, request.Name, request.ConnectionDatabase)
 	if err != nil {
 		return nil, err
 	}
 
-	dataSourceID := request.DataSourceId
-	if dataSourceID == "" {
-		dataSources, err := s.store.ListDataSourcesV2(ctx, &store.FindDataSourceMessage{
-			InstanceID: &database.InstanceID,
-		})
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to list data sources: %v", err)
-		}
-		if len(dataSources) == 0 {
-			return nil, status.Errorf(codes.NotFound, "no data source found")
-		}
-		readOnlyDataSources := make([]*store.DataSourceMessage, 0)
-		for _, dataSource := range dataSources {
-			if dataSource.Type == api.RO {
-				readOnlyDataSources = append(readOnlyDataSources, dataSource)
-			}
-		}
-		// First try to use read-only data source if available.
-		if len(readOnlyDataSources) > 0 {
-			dataSourceID = readOnlyDataSources[0].ID
-		} else {
-			dataSourceID = dataSources[0].ID
-		}
-	}
-
-	dataSource, err := s.store.GetDataSource(ctx, &store.FindDataSourceMessage{
-		InstanceID: &database.InstanceID,
-		Name:       &dataSourceID,
-	})
+	dataSource, err := s.getQueryDataSource(ctx, user, database, request.DataSourceId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get data source: %v", err)
-	}
-	if dataSource == nil {
-		return nil, status.Errorf(codes.NotFound, "data source %q not found", request.DataSourceId)
-	}
-	ok, err := s.checkDataSourceQueriable(ctx, database, dataSource)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to check data source queriable: %v", err)
-	}
-	if !ok {
-		return nil, status.Errorf(codes.InvalidArgument, "data source %q is not queriable", dataSource.Username)
+		return nil, err
 	}
 
 	statement := request.Statement
@@ -753,6 +829,11 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 		}
 	}
 
+	// Filter the rows by the row access policies.
+	if request.Statement, err = s.rewriteRowAccess(ctx, instance, database, user, request.Statement); err != nil {
+		return nil, err
+	}
+
 	// Run SQL review.
 	adviceStatus, advices, err := s.SQLReviewCheck(ctx, statement, v1pb.CheckRequest_CHANGE_TYPE_UNSPECIFIED, instance, database, nil /* Override Metadata */)
 	if err != nil {
@@ -763,7 +844,11 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	var queryErr error
 	var durationNs int64
 	if adviceStatus != storepb.Advice_ERROR {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database)
+		if err := s.reserveConnection(instance); err != nil {
+			return nil, err
+		}
+		defer s.stateCfg.InstanceOutstandingConnections.Decrement(instance.UID)
+		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database, dataSource.ID, user)
 		if queryErr == nil && s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil && !request.Explain {
 			masker := NewQueryResultMasker(s.store)
 			if err := masker.MaskResults(ctx, spans, results, instance, storepb.MaskingExceptionPolicy_MaskingException_QUERY); err != nil {
@@ -773,7 +858,7 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	}
 
 	// Update activity.
-	if err = s.postQuery(ctx, database, statement, user.ID, durationNs, queryErr); err != nil {
+	if err = s.postQuery(ctx, database, statement, user.ID, durationNs, results, queryErr); err != nil {
 		return nil, err
 	}
 	if queryErr != nil {
@@ -796,9 +881,19 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	return response, nil
 }
 
+// reserveConnection reserves a connection from the instance connection budget.
+// The caller must release it by decrementing InstanceOutstandingConnections.
+func (s *SQLService) reserveConnection(instance *store.InstanceMessage) error {
+	maximumConnections := int(instance.Options.GetMaximumConnections())
+	if s.stateCfg.InstanceOutstandingConnections.Increment(instance.UID, maximumConnections) {
+		return status.Errorf(codes.ResourceExhausted, "instance %q has reached its maximum connections of %d, please try again later", instance.ResourceID, state.GetMaximumConnections(maximumConnections))
+	}
+	return nil
+}
+
 // doQuery does query.
-func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.QueryResult, int64, error) {
-	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, request.DataSourceId)
+func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, dataSourceID string, user *store.UserMessage) ([]*v1pb.QueryResult, int64, error) {
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, dataSourceID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -821,26 +916,51 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 	ctx, cancelCtx := context.WithTimeout(ctx, timeout)
 	defer cancelCtx()
 
+	if request.QueryId != "" {
+		cancel, err := s.getQueryCancelFunc(ctx, instance.Engine, sqlDB, conn, cancelCtx)
+		if err != nil {
+			return nil, 0, err
+		}
+		key := getRunningQueryKey(user.ID, request.QueryId)
+		if _, loaded := s.stateCfg.RunningQueriesCancelFunc.LoadOrStore(key, cancel); loaded {
+			return nil, 0, status.Errorf(codes.AlreadyExists, "query %q is running", request.QueryId)
+		}
+		defer s.stateCfg.RunningQueriesCancelFunc.Delete(key)
+	}
+
+	// Fetch one more row to know if there are more rows.
+	limit := int(request.Limit)
+	if limit > 0 {
+		limit += int(request.Offset) + 1
+	}
 	start := time.Now().UnixNano()
 	results, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
-		Limit:           int(request.Limit),
+		Limit:           limit,
 		Explain:         request.Explain,
 		CurrentDatabase: database.DatabaseName,
 	})
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return nil, time.Now().UnixNano() - start, errors.Errorf("query canceled")
+		}
 		return nil, time.Now().UnixNano() - start, errors.Errorf("timeout reached: %v", timeout)
 	default:
 		// So the select will not block
 	}
 
 	sanitizeResults(results)
+	pageResults(results, int(request.Offset), int(request.Limit))
 
 	return results, time.Now().UnixNano() - start, err
 }
 
-func (s *SQLService) postQuery(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, queryErr error) error {
+func (s *SQLService) postQuery(ctx context.Context, database *store.DatabaseMessage, statement string, userUID int, durationNs int64, results []*v1pb.QueryResult, queryErr error) error {
+	var rowCount int64
+	for _, result := range results {
+		rowCount += int64(len(result.Rows))
+	}
 	qh := &store.QueryHistoryMessage{
 		CreatorUID: userUID,
 		ProjectID:  database.ProjectID,
@@ -850,6 +970,7 @@ func (s *SQLService) postQuery(ctx context.Context, database *store.DatabaseMess
 		Payload: &storepb.QueryHistoryPayload{
 			Error:    nil,
 			Duration: durationpb.New(time.Duration(durationNs)),
+			RowCount: rowCount,
 		},
 	}
 	if queryErr != nil {
@@ -1640,79 +1761,149 @@ func getOffsetAndOriginTable(backupTable string) (int, string, error) {
 	return offset, strings.Join(parts[3:], "_"), nil
 }
 
-func (s *SQLService) checkDataSourceQueriable(ctx context.Context, database *store.DatabaseMessage, dataSource *store.DataSourceMessage) (bool, error) {
-	// Always allow non-admin data source.
-	if dataSource.Type != api.Admin {
-		return true, nil
+// getQueryDataSource returns the data source to run a query or an export against.
+// If dataSourceID is empty, the data source is chosen by the routing of the data source query policy.
+func (s *SQLService) getQueryDataSource(ctx context.Context, user *store.UserMessage, database *store.DatabaseMessage, dataSourceID string) (*store.DataSourceMessage, error) {
+	dataSources, err := s.store.ListDataSourcesV2(ctx, &store.FindDataSourceMessage{
+		InstanceID: &database.InstanceID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list data sources: %v", err)
+	}
+	if len(dataSources) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no data source found")
+	}
+	var adminDataSource *store.DataSourceMessage
+	var readOnlyDataSources []*store.DataSourceMessage
+	for _, dataSource := range dataSources {
+		switch dataSource.Type {
+		case api.Admin:
+			adminDataSource = dataSource
+		case api.RO:
+			readOnlyDataSources = append(readOnlyDataSources, dataSource)
+		}
+	}
+
+	envPolicy, projectPolicy, err := s.getDataSourceQueryPolicies(ctx, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get data source query policy: %v", err)
+	}
+	// The project routing takes precedence over the environment routing.
+	routing := projectPolicy.GetRouting()
+	if routing == v1pb.DataSourceQueryPolicy_ROUTING_UNSPECIFIED {
+		routing = envPolicy.GetRouting()
+	}
+
+	var dataSource *store.DataSourceMessage
+	if dataSourceID == "" {
+		switch {
+		case routing == v1pb.DataSourceQueryPolicy_PREFER_ADMIN && adminDataSource != nil:
+			dataSource = adminDataSource
+		case len(readOnlyDataSources) > 0:
+			dataSource = readOnlyDataSources[0]
+		default:
+			dataSource = dataSources[0]
+		}
+	} else {
+		for _, ds := range dataSources {
+			if ds.ID == dataSourceID {
+				dataSource = ds
+				break
+			}
+		}
+		if dataSource == nil {
+			return nil, status.Errorf(codes.NotFound, "data source %q not found", dataSourceID)
+		}
+		// Bypassing the read-only data sources requires an extra permission.
+		if dataSource.Type == api.Admin && len(readOnlyDataSources) > 0 && routing != v1pb.DataSourceQueryPolicy_PREFER_ADMIN {
+			ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionDatabasesQueryPrimary, user, database.ProjectID)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+			}
+			if !ok {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied to query the admin data source of instance %q, requires %q", database.InstanceID, iam.PermissionDatabasesQueryPrimary)
+			}
+		}
+	}
+
+	if !checkDataSourceQueriable(dataSource, len(readOnlyDataSources) > 0, envPolicy, projectPolicy) {
+		return nil, status.Errorf(codes.InvalidArgument, "data source %q is not queriable", dataSource.Username)
 	}
+	return dataSource, nil
+}
 
-	var envAdminDataSourceRestriction, projectAdminDataSourceRestriction v1pb.DataSourceQueryPolicy_Restriction
+// getDataSourceQueryPolicies returns the data source query policies of the database's environment and project.
+// A missing policy is returned as nil.
+func (s *SQLService) getDataSourceQueryPolicies(ctx context.Context, database *store.DatabaseMessage) (*v1pb.DataSourceQueryPolicy, *v1pb.DataSourceQueryPolicy, error) {
 	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
 	if err != nil {
-		return false, errors.Wrapf(err, "failed to get environment")
+		return nil, nil, errors.Wrapf(err, "failed to get environment")
 	}
 	if environment == nil {
-		return false, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
+		return nil, nil, errors.Errorf("environment %q not found", database.EffectiveEnvironmentID)
 	}
 	dataSourceQueryPolicyType := api.PolicyTypeDataSourceQuery
 	environmentResourceType := api.PolicyResourceTypeEnvironment
 	projectResourceType := api.PolicyResourceTypeProject
+	var envPolicy, projectPolicy *v1pb.DataSourceQueryPolicy
 	environmentPolicy, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
 		ResourceType: &environmentResourceType,
 		ResourceUID:  &environment.UID,
 		Type:         &dataSourceQueryPolicyType,
 	})
 	if err != nil {
-		return false, errors.Wrapf(err, "failed to get policy")
+		return nil, nil, errors.Wrapf(err, "failed to get policy")
 	}
 	if environmentPolicy != nil {
 		envPayload, err := convertToV1PBDataSourceQueryPolicy(environmentPolicy.Payload)
 		if err != nil {
-			return false, errors.Wrapf(err, "failed to convert policy payload")
+			return nil, nil, errors.Wrapf(err, "failed to convert policy payload")
 		}
-		envAdminDataSourceRestriction = envPayload.DataSourceQueryPolicy.GetAdminDataSourceRestriction()
+		envPolicy = envPayload.DataSourceQueryPolicy
 	}
 
 	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
 	if err != nil {
-		return false, errors.Wrapf(err, "failed to get project")
+		return nil, nil, errors.Wrapf(err, "failed to get project")
 	}
 	if project == nil {
-		return false, errors.Errorf("project %q not found", database.ProjectID)
+		return nil, nil, errors.Errorf("project %q not found", database.ProjectID)
 	}
-	projectPolicy, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
+	projectPolicyMessage, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
 		ResourceType: &projectResourceType,
 		ResourceUID:  &project.UID,
 		Type:         &dataSourceQueryPolicyType,
 	})
 	if err != nil {
-		return false, errors.Wrapf(err, "failed to get policy")
+		return nil, nil, errors.Wrapf(err, "failed to get policy")
 	}
-	if projectPolicy != nil {
-		projectPayload, err := convertToV1PBDataSourceQueryPolicy(projectPolicy.Payload)
+	if projectPolicyMessage != nil {
+		projectPayload, err := convertToV1PBDataSourceQueryPolicy(projectPolicyMessage.Payload)
 		if err != nil {
-			return false, errors.Wrapf(err, "failed to convert policy payload")
+			return nil, nil, errors.Wrapf(err, "failed to convert policy payload")
 		}
-		projectAdminDataSourceRestriction = projectPayload.DataSourceQueryPolicy.GetAdminDataSourceRestriction()
+		projectPolicy = projectPayload.DataSourceQueryPolicy
+	}
+	return envPolicy, projectPolicy, nil
+}
+
+func checkDataSourceQueriable(dataSource *store.DataSourceMessage, hasReadOnlyDataSource bool, envPolicy, projectPolicy *v1pb.DataSourceQueryPolicy) bool {
+	// Always allow non-admin data source.
+	if dataSource.Type != api.Admin {
+		return true
 	}
 
+	envAdminDataSourceRestriction := envPolicy.GetAdminDataSourceRestriction()
+	projectAdminDataSourceRestriction := projectPolicy.GetAdminDataSourceRestriction()
 	// If any of the policy is DISALLOW, then return false.
 	if envAdminDataSourceRestriction == v1pb.DataSourceQueryPolicy_DISALLOW || projectAdminDataSourceRestriction == v1pb.DataSourceQueryPolicy_DISALLOW {
-		return false, nil
+		return false
 	} else if envAdminDataSourceRestriction == v1pb.DataSourceQueryPolicy_FALLBACK || projectAdminDataSourceRestriction == v1pb.DataSourceQueryPolicy_FALLBACK {
-		readOnlyDataSourceType := api.RO
-		readOnlyDataSources, err := s.store.ListDataSourcesV2(ctx, &store.FindDataSourceMessage{
-			InstanceID: &database.InstanceID,
-			Type:       &readOnlyDataSourceType,
-		})
-		if err != nil {
-			return false, errors.Wrapf(err, "failed to list read-only data sources")
-		}
 		// If there is any read-only data source, then return false.
-		if len(readOnlyDataSources) > 0 {
-			return false, nil
+		if hasReadOnlyDataSource {
+			return false
 		}
 	}
 
-	return true, nil
+	return true
 }
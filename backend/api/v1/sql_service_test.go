@@ -260,3 +260,60 @@ func TestGetOffsetAndOriginTable(t *testing.T) {
 		a.Equal(test.tableName, tabaleName)
 	}
 }
+
+func TestPageResults(t *testing.T) {
+	newRows := func(n int) []*v1pb.QueryRow {
+		var rows []*v1pb.QueryRow
+		for i := 0; i < n; i++ {
+			rows = append(rows, &v1pb.QueryRow{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: int64(i)}}}})
+		}
+		return rows
+	}
+	tests := []struct {
+		rows        int
+		offset      int
+		limit       int
+		wantFirst   int64
+		wantRows    int
+		wantHasMore bool
+	}{
+		{rows: 11, offset: 0, limit: 10, wantFirst: 0, wantRows: 10, wantHasMore: true},
+		{rows: 10, offset: 0, limit: 10, wantFirst: 0, wantRows: 10, wantHasMore: false},
+		{rows: 21, offset: 10, limit: 10, wantFirst: 10, wantRows: 10, wantHasMore: true},
+		{rows: 15, offset: 10, limit: 10, wantFirst: 10, wantRows: 5, wantHasMore: false},
+		{rows: 5, offset: 10, limit: 10, wantRows: 0, wantHasMore: false},
+		{rows: 5, offset: 0, limit: 0, wantFirst: 0, wantRows: 5, wantHasMore: false},
+	}
+
+	a := assert.New(t)
+	for _, test := range tests {
+		results := []*v1pb.QueryResult{{Rows: newRows(test.rows)}}
+		pageResults(results, test.offset, test.limit)
+		a.Len(results[0].Rows, test.wantRows)
+		a.Equal(test.wantHasMore, results[0].HasMore)
+		if test.wantRows > 0 {
+			a.Equal(test.wantFirst, results[0].Rows[0].Values[0].GetInt64Value())
+		}
+	}
+}
+
+func TestCountAdminSessionRows(t *testing.T) {
+	affectedRowsResult := func(n int64) *v1pb.QueryResult {
+		return &v1pb.QueryResult{
+			ColumnNames: []string{"Affected Rows"},
+			Rows:        []*v1pb.QueryRow{{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int64Value{Int64Value: n}}}}},
+		}
+	}
+	queryResult := &v1pb.QueryResult{
+		ColumnNames: []string{"id"},
+		Rows:        []*v1pb.QueryRow{{}, {}, {}},
+	}
+
+	a := assert.New(t)
+	affectedRows, rowCount := countAdminSessionRows(nil)
+	a.Equal(int64(0), affectedRows)
+	a.Equal(int64(0), rowCount)
+	affectedRows, rowCount = countAdminSessionRows([]*v1pb.QueryResult{affectedRowsResult(2), queryResult, affectedRowsResult(5)})
+	a.Equal(int64(7), affectedRows)
+	a.Equal(int64(3), rowCount)
+}
@@ -0,0 +1,528 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	defaultFlywayDirectory       = "sql"
+	defaultFlywayHistoryTable    = "flyway_schema_history"
+	defaultLiquibaseHistoryTable = "DATABASECHANGELOG"
+	migrationHistoryQueryTimeout = time.Minute
+)
+
+var (
+	// Flyway versioned migrations are named as V<version>__<description>.sql, e.g. V1_2__add_table.sql.
+	flywayMigrationFileRegex = regexp.MustCompile(`^V([0-9][0-9._]*)__(.+)\.sql$`)
+	liquibaseChangesetRegex  = regexp.MustCompile(`^--\s*changeset\s+([^:\s]+):(\S+)`)
+	historyTableRegex        = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+)
+
+// repositoryMigration is a migration read from the repository.
+type repositoryMigration struct {
+	version     string
+	description string
+	path        string
+	statement   string
+}
+
+// appliedMigration is a migration recorded in the history table of the tool.
+type appliedMigration struct {
+	version     string
+	checksum    string
+	appliedTime *timestamppb.Timestamp
+}
+
+// ImportMigrationHistory backfills the migration history of the database from the migrations
+// which have been applied by Flyway or Liquibase.
+func (s *VCSConnectorService) ImportMigrationHistory(ctx context.Context, request *v1pb.ImportMigrationHistoryRequest) (*v1pb.ImportMigrationHistoryResponse, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "user not found")
+	}
+	projectID, vcsConnectorID, err := common.GetProjectVCSConnectorID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	vcsConnector, err := s.store.GetVCSConnector(ctx, &store.FindVCSConnectorMessage{ProjectID: &project.ResourceID, ResourceID: &vcsConnectorID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if vcsConnector == nil {
+		return nil, status.Errorf(codes.NotFound, "vcs connector %q not found", vcsConnectorID)
+	}
+	vcsProvider, err := s.store.GetVCSProvider(ctx, &store.FindVCSProviderMessage{ResourceID: &vcsConnector.VCSResourceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find vcs: %s", err.Error())
+	}
+	if vcsProvider == nil {
+		return nil, status.Errorf(codes.NotFound, "vcs provider %q not found", vcsConnector.VCSResourceID)
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Database)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get instance %s, error: %v", instanceID, err)
+	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	database, err := s.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if database == nil {
+		return nil, status.Errorf(codes.NotFound, "database %q not found", databaseName)
+	}
+	if database.ProjectID != project.ResourceID {
+		return nil, status.Errorf(codes.InvalidArgument, "database %q does not belong to project %q", request.Database, project.ResourceID)
+	}
+
+	historyTable := request.HistoryTable
+	if historyTable != "" && !historyTableRegex.MatchString(historyTable) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid history table %q", historyTable)
+	}
+	vcsPlugin := vcs.Get(vcsProvider.Type, vcs.ProviderConfig{InstanceURL: vcsProvider.InstanceURL, AuthToken: vcsProvider.AccessToken})
+	refInfo := vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: vcsConnector.Payload.Branch}
+	externalID := vcsConnector.Payload.ExternalId
+
+	var tool string
+	var migrations []*repositoryMigration
+	var query string
+	switch request.Tool {
+	case v1pb.ImportMigrationHistoryRequest_FLYWAY:
+		tool = "FLYWAY"
+		directory := strings.Trim(request.Path, "/")
+		if directory == "" {
+			directory = defaultFlywayDirectory
+		}
+		migrations, err = readFlywayMigrations(ctx, vcsPlugin, externalID, directory, refInfo)
+		if historyTable == "" {
+			historyTable = defaultFlywayHistoryTable
+		}
+		query = fmt.Sprintf("SELECT version, checksum, installed_on, success FROM %s ORDER BY installed_rank", historyTable)
+	case v1pb.ImportMigrationHistoryRequest_LIQUIBASE:
+		tool = changelogToolLiquibase
+		changelogPath := strings.Trim(request.Path, "/")
+		if changelogPath == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "the path of the Liquibase changelog must be set")
+		}
+		migrations, err = readLiquibaseChangelog(ctx, vcsPlugin, externalID, changelogPath, refInfo)
+		if historyTable == "" {
+			historyTable = defaultLiquibaseHistoryTable
+		}
+		query = fmt.Sprintf("SELECT ID, MD5SUM, DATEEXECUTED FROM %s ORDER BY ORDEREXECUTED", historyTable)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported tool %q", request.Tool)
+	}
+	if err != nil {
+		if common.ErrorCode(err) == common.NotFound {
+			return nil, status.Errorf(codes.NotFound, "failed to read the migrations from the repository, error: %v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read the migrations from the repository, error: %v", err)
+	}
+
+	appliedMigrations, err := s.listAppliedMigrations(ctx, instance, database, query, request.Tool == v1pb.ImportMigrationHistoryRequest_FLYWAY)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to read the history table %q in the database, error: %v", historyTable, err)
+	}
+
+	resp := &v1pb.ImportMigrationHistoryResponse{}
+	var creates []*store.InstanceChangeHistoryMessage
+	var createMigrations []*v1pb.ImportMigrationHistoryResponse_Migration
+	seenVersions := make(map[string]bool)
+	for _, migration := range migrations {
+		m := &v1pb.ImportMigrationHistoryResponse_Migration{
+			Version:     migration.version,
+			Description: migration.description,
+			Path:        migration.path,
+		}
+		resp.Migrations = append(resp.Migrations, m)
+
+		applied, ok := appliedMigrations[migration.version]
+		if !ok {
+			m.SkipReason = "the migration is not applied in the database"
+			continue
+		}
+		m.Applied = true
+		m.Checksum = applied.checksum
+		m.AppliedTime = applied.appliedTime
+		if seenVersions[migration.version] {
+			m.SkipReason = "found duplicate version in the repository"
+			continue
+		}
+		seenVersions[migration.version] = true
+
+		version := model.Version{Version: migration.version}
+		existing, err := s.store.GetInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+			InstanceID: &instance.UID,
+			DatabaseID: &database.UID,
+			Version:    &version,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get change history, error: %v", err)
+		}
+		if existing != nil {
+			m.SkipReason = "the migration history already exists"
+			continue
+		}
+		creates = append(creates, &store.InstanceChangeHistoryMessage{
+			CreatorID:      user.ID,
+			InstanceUID:    &instance.UID,
+			DatabaseUID:    &database.UID,
+			ProjectUID:     &project.UID,
+			ReleaseVersion: s.profile.Version,
+			Source:         db.VCS,
+			Type:           db.Migrate,
+			Status:         db.Done,
+			Version:        version,
+			Description:    migration.description,
+			Statement:      migration.statement,
+			Payload: &storepb.InstanceChangeHistoryPayload{
+				ExternalChangelog: &storepb.ExternalChangelog{
+					Tool:        tool,
+					Checksum:    applied.checksum,
+					AppliedTime: applied.appliedTime,
+				},
+			},
+		})
+		createMigrations = append(createMigrations, m)
+	}
+	if request.ValidateOnly || len(creates) == 0 {
+		return resp, nil
+	}
+
+	for i, create := range creates {
+		sheet, err := s.sheetManager.CreateSheet(ctx, &store.SheetMessage{
+			CreatorID:   user.ID,
+			ProjectUID:  project.UID,
+			DatabaseUID: &database.UID,
+			Title:       createMigrations[i].Path,
+			Statement:   create.Statement,
+			Payload: &storepb.SheetPayload{
+				Engine: instance.Engine,
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create sheet for file %s, error: %v", createMigrations[i].Path, err)
+		}
+		create.SheetID = &sheet.UID
+	}
+	uids, err := s.store.CreateInstanceChangeHistories(ctx, creates)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create change histories, error: %v", err)
+	}
+	for i, uid := range uids {
+		createMigrations[i].ChangeHistory = fmt.Sprintf("%s/%s%s", common.FormatDatabase(instance.ResourceID, database.DatabaseName), common.ChangeHistoryPrefix, uid)
+	}
+
+	// The schema version of the database follows the last migration applied by the tool.
+	lastVersion := creates[len(creates)-1].Version
+	if _, err := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+		InstanceID:    database.InstanceID,
+		DatabaseName:  database.DatabaseName,
+		SchemaVersion: &lastVersion,
+	}, user.ID); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update database schema version, error: %v", err)
+	}
+	return resp, nil
+}
+
+// listAppliedMigrations reads the successfully applied migrations from the history table of the tool, keyed by the version.
+// The query must return the version, the checksum, the applied time and optionally the success flag in order.
+func (s *VCSConnectorService) listAppliedMigrations(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, query string, hasSuccess bool) (map[string]*appliedMigration, error) {
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	var conn *sql.Conn
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, migrationHistoryQueryTimeout)
+	defer cancel()
+	queryResults, err := driver.QueryConn(ctx, conn, query, &db.QueryContext{CurrentDatabase: database.DatabaseName})
+	if err != nil {
+		return nil, err
+	}
+	if len(queryResults) == 0 {
+		return nil, errors.New("the query returns no result")
+	}
+	queryResult := queryResults[len(queryResults)-1]
+	if queryResult.Error != "" {
+		return nil, errors.New(queryResult.Error)
+	}
+
+	applied := make(map[string]*appliedMigration)
+	for _, row := range queryResult.Rows {
+		if len(row.Values) < 3 {
+			continue
+		}
+		if hasSuccess && len(row.Values) > 3 {
+			// The success flag is a boolean or a tinyint depending on the engine.
+			if success := strings.ToLower(convertValueToStringInXLSX(row.Values[3])); success != "true" && success != "1" {
+				continue
+			}
+		}
+		version := convertValueToStringInXLSX(row.Values[0])
+		if version == "" {
+			// The repeatable migrations of Flyway have no version.
+			continue
+		}
+		applied[version] = &appliedMigration{
+			version:     version,
+			checksum:    convertValueToStringInXLSX(row.Values[1]),
+			appliedTime: parseAppliedTime(convertValueToStringInXLSX(row.Values[2])),
+		}
+	}
+	return applied, nil
+}
+
+func parseAppliedTime(s string) *timestamppb.Timestamp {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST", "2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return timestamppb.New(t)
+		}
+	}
+	return nil
+}
+
+// readFlywayMigrations reads the versioned migrations in the Flyway migration directory, sorted by the version.
+func readFlywayMigrations(ctx context.Context, vcsPlugin vcs.Provider, externalID, directory string, refInfo vcs.RefInfo) ([]*repositoryMigration, error) {
+	files, err := vcsPlugin.ListDirectoryFiles(ctx, externalID, directory, refInfo)
+	if err != nil {
+		return nil, err
+	}
+	var migrations []*repositoryMigration
+	for _, file := range files {
+		version, description, ok := parseFlywayMigrationFileName(path.Base(file))
+		if !ok {
+			continue
+		}
+		content, err := vcsPlugin.ReadFileContent(ctx, externalID, file, refInfo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %s", file)
+		}
+		migrations = append(migrations, &repositoryMigration{
+			version:     version,
+			description: description,
+			path:        file,
+			statement:   content,
+		})
+	}
+	slices.SortStableFunc(migrations, func(a, b *repositoryMigration) int {
+		return compareFlywayVersion(a.version, b.version)
+	})
+	return migrations, nil
+}
+
+// parseFlywayMigrationFileName parses the version and the description from the Flyway versioned migration file name.
+// The underscores in the version are replaced by dots as Flyway does.
+func parseFlywayMigrationFileName(name string) (string, string, bool) {
+	matches := flywayMigrationFileRegex.FindStringSubmatch(name)
+	if matches == nil {
+		return "", "", false
+	}
+	version := strings.ReplaceAll(matches[1], "_", ".")
+	description := strings.ReplaceAll(matches[2], "_", " ")
+	return version, description, true
+}
+
+// compareFlywayVersion compares the Flyway versions by the numeric parts, e.g. 1.10 is greater than 1.9.
+func compareFlywayVersion(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var x, y int64
+		if i < len(aParts) {
+			x, _ = strconv.ParseInt(aParts[i], 10, 64)
+		}
+		if i < len(bParts) {
+			y, _ = strconv.ParseInt(bParts[i], 10, 64)
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// readLiquibaseChangelog reads the changesets in the Liquibase changelog in the formatted SQL or JSON format.
+func readLiquibaseChangelog(ctx context.Context, vcsPlugin vcs.Provider, externalID, changelogPath string, refInfo vcs.RefInfo) ([]*repositoryMigration, error) {
+	content, err := vcsPlugin.ReadFileContent(ctx, externalID, changelogPath, refInfo)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read file %s", changelogPath)
+	}
+	switch strings.ToLower(path.Ext(changelogPath)) {
+	case ".sql":
+		return parseLiquibaseFormattedSQL(changelogPath, content)
+	case ".json":
+		migrations, sqlFiles, err := parseLiquibaseJSONChangelog(changelogPath, content)
+		if err != nil {
+			return nil, err
+		}
+		for i, sqlFile := range sqlFiles {
+			if sqlFile == "" {
+				continue
+			}
+			statement, err := vcsPlugin.ReadFileContent(ctx, externalID, sqlFile, refInfo)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to read file %s", sqlFile)
+			}
+			migrations[i].statement = statement
+		}
+		return migrations, nil
+	default:
+		return nil, errors.Errorf("unsupported changelog format %q, only the formatted SQL and JSON changelogs are supported", path.Ext(changelogPath))
+	}
+}
+
+// parseLiquibaseFormattedSQL parses the changesets in the Liquibase formatted SQL changelog.
+// The rollback statements are not included in the statement of the changeset.
+func parseLiquibaseFormattedSQL(changelogPath, content string) ([]*repositoryMigration, error) {
+	lines := strings.Split(content, "\n")
+	if len(lines) == 0 || !strings.HasPrefix(strings.ToLower(strings.TrimSpace(lines[0])), "--liquibase formatted sql") {
+		return nil, errors.Errorf("the changelog %s must start with \"--liquibase formatted sql\"", changelogPath)
+	}
+
+	var migrations []*repositoryMigration
+	var statement []string
+	flush := func() {
+		if len(migrations) > 0 {
+			migrations[len(migrations)-1].statement = strings.TrimSpace(strings.Join(statement, "\n"))
+		}
+		statement = nil
+	}
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		if matches := liquibaseChangesetRegex.FindStringSubmatch(trimmed); matches != nil {
+			flush()
+			migrations = append(migrations, &repositoryMigration{
+				version:     matches[2],
+				description: fmt.Sprintf("changeset %s:%s", matches[1], matches[2]),
+				path:        changelogPath,
+			})
+			continue
+		}
+		if len(migrations) == 0 {
+			continue
+		}
+		lower := strings.ToLower(trimmed)
+		switch {
+		case strings.HasPrefix(lower, "--comment:"):
+			migrations[len(migrations)-1].description = strings.TrimSpace(trimmed[len("--comment:"):])
+		case strings.HasPrefix(lower, "--rollback"), strings.HasPrefix(lower, "--precondition"):
+		default:
+			statement = append(statement, line)
+		}
+	}
+	flush()
+	return migrations, nil
+}
+
+// parseLiquibaseJSONChangelog parses the changesets in the Liquibase JSON changelog.
+// It returns the paths of the sql files referenced by the changesets, or empty if the changeset has inline sql.
+func parseLiquibaseJSONChangelog(changelogPath, content string) ([]*repositoryMigration, []string, error) {
+	type changelog struct {
+		DatabaseChangeLog []struct {
+			ChangeSet *struct {
+				ID      string `json:"id"`
+				Author  string `json:"author"`
+				Comment string `json:"comment"`
+				Changes []struct {
+					SQL *struct {
+						SQL string `json:"sql"`
+					} `json:"sql"`
+					SQLFile *struct {
+						Path                    string `json:"path"`
+						RelativeToChangelogFile bool   `json:"relativeToChangelogFile"`
+					} `json:"sqlFile"`
+				} `json:"changes"`
+			} `json:"changeSet"`
+		} `json:"databaseChangeLog"`
+	}
+	var c changelog
+	if err := json.Unmarshal([]byte(content), &c); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to unmarshal the changelog %s", changelogPath)
+	}
+
+	var migrations []*repositoryMigration
+	var sqlFiles []string
+	for _, item := range c.DatabaseChangeLog {
+		changeSet := item.ChangeSet
+		if changeSet == nil {
+			continue
+		}
+		description := changeSet.Comment
+		if description == "" {
+			description = fmt.Sprintf("changeset %s:%s", changeSet.Author, changeSet.ID)
+		}
+		migration := &repositoryMigration{
+			version:     changeSet.ID,
+			description: description,
+			path:        changelogPath,
+		}
+		var statements []string
+		sqlFile := ""
+		for _, change := range changeSet.Changes {
+			switch {
+			case change.SQL != nil:
+				statements = append(statements, change.SQL.SQL)
+			case change.SQLFile != nil:
+				sqlFile = change.SQLFile.Path
+				if change.SQLFile.RelativeToChangelogFile {
+					sqlFile = path.Join(path.Dir(changelogPath), sqlFile)
+				}
+				migration.path = sqlFile
+			}
+		}
+		migration.statement = strings.Join(statements, "\n")
+		migrations = append(migrations, migration)
+		sqlFiles = append(sqlFiles, sqlFile)
+	}
+	return migrations, sqlFiles, nil
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFlywayMigrationFileName(t *testing.T) {
+	a := require.New(t)
+	version, description, ok := parseFlywayMigrationFileName("V1_2__add_user_table.sql")
+	a.True(ok)
+	a.Equal("1.2", version)
+	a.Equal("add user table", description)
+
+	_, _, ok = parseFlywayMigrationFileName("R__refresh_view.sql")
+	a.False(ok)
+	_, _, ok = parseFlywayMigrationFileName("V1__init.txt")
+	a.False(ok)
+
+	a.Equal(-1, compareFlywayVersion("1.9", "1.10"))
+	a.Equal(0, compareFlywayVersion("2", "2.0"))
+	a.Equal(1, compareFlywayVersion("10", "9.1"))
+}
+
+func TestParseLiquibaseFormattedSQL(t *testing.T) {
+	a := require.New(t)
+	content := `--liquibase formatted sql
+
+--changeset alice:1
+--comment: create the user table
+CREATE TABLE t_user (id INT);
+--rollback DROP TABLE t_user;
+
+--changeset bob:2 runOnChange:true
+ALTER TABLE t_user ADD COLUMN name TEXT;
+`
+	migrations, err := parseLiquibaseFormattedSQL("db/changelog.sql", content)
+	a.NoError(err)
+	a.Len(migrations, 2)
+	a.Equal("1", migrations[0].version)
+	a.Equal("create the user table", migrations[0].description)
+	a.Equal("CREATE TABLE t_user (id INT);", migrations[0].statement)
+	a.Equal("2", migrations[1].version)
+	a.Equal("changeset bob:2", migrations[1].description)
+	a.Equal("ALTER TABLE t_user ADD COLUMN name TEXT;", migrations[1].statement)
+
+	_, err = parseLiquibaseFormattedSQL("db/changelog.sql", "CREATE TABLE t (id INT);")
+	a.Error(err)
+}
+
+func TestParseLiquibaseJSONChangelog(t *testing.T) {
+	a := require.New(t)
+	content := `{
+  "databaseChangeLog": [
+    {"changeSet": {"id": "1", "author": "alice", "changes": [{"sql": {"sql": "CREATE TABLE t (id INT);"}}]}},
+    {"changeSet": {"id": "2", "author": "bob", "comment": "seed", "changes": [{"sqlFile": {"path": "seed.sql", "relativeToChangelogFile": true}}]}}
+  ]
+}`
+	migrations, sqlFiles, err := parseLiquibaseJSONChangelog("db/changelog.json", content)
+	a.NoError(err)
+	a.Len(migrations, 2)
+	a.Equal("changeset alice:1", migrations[0].description)
+	a.Equal("CREATE TABLE t (id INT);", migrations[0].statement)
+	a.Equal("", sqlFiles[0])
+	a.Equal("seed", migrations[1].description)
+	a.Equal("db/seed.sql", migrations[1].path)
+	a.Equal("db/seed.sql", sqlFiles[1])
+}
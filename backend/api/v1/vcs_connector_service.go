@@ -16,6 +16,9 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
@@ -29,13 +32,19 @@ import (
 // VCSConnectorService implements the vcs connector service.
 type VCSConnectorService struct {
 	v1pb.UnimplementedVCSConnectorServiceServer
-	store *store.Store
+	store        *store.Store
+	sheetManager *sheet.Manager
+	dbFactory    *dbfactory.DBFactory
+	profile      *config.Profile
 }
 
 // NewVCSConnectorService creates a new VCSConnectorService.
-func NewVCSConnectorService(store *store.Store) *VCSConnectorService {
+func NewVCSConnectorService(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, profile *config.Profile) *VCSConnectorService {
 	return &VCSConnectorService{
-		store: store,
+		store:        store,
+		sheetManager: sheetManager,
+		dbFactory:    dbFactory,
+		profile:      profile,
 	}
 }
 
@@ -7,8 +7,10 @@ import (
 
 	"log/slog"
 
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -96,6 +99,9 @@ func (s *WorksheetService) CreateWorksheet(ctx context.Context, request *v1pb.Cr
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("failed to convert worksheet: %v", err))
 	}
+	if err := s.checkShareWorksheet(ctx, storeWorksheetCreate.Visibility); err != nil {
+		return nil, err
+	}
 	worksheet, err := s.store.CreateWorkSheet(ctx, storeWorksheetCreate)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to create worksheet: %v", err))
@@ -207,6 +213,26 @@ func (s *WorksheetService) SearchWorksheets(ctx context.Context, request *v1pb.S
 				}
 				worksheetFind.Visibilities = append(worksheetFind.Visibilities, visibility)
 			}
+		case "folder":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid operator %q for folder", spec.operator))
+			}
+			folder, err := normalizeWorksheetFolder(spec.value)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			worksheetFind.Folder = &folder
+		case "tag":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid operator %q for tag", spec.operator))
+			}
+			worksheetFind.Tags = append(worksheetFind.Tags, spec.value)
+		case "query":
+			if spec.operator != comparatorTypeEqual {
+				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid operator %q for query", spec.operator))
+			}
+			query := spec.value
+			worksheetFind.Query = &query
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid filter key %q", spec.key))
 		}
@@ -299,6 +325,19 @@ func (s *WorksheetService) UpdateWorksheet(ctx context.Context, request *v1pb.Up
 			if err != nil {
 				return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid visibility %q", request.Worksheet.Visibility))
 			}
+			// Only the creator and the worksheet managers can change the sharing.
+			if visibility != worksheet.Visibility {
+				ok, err := s.canManageWorksheet(ctx, worksheet)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
+				}
+				if !ok {
+					return nil, status.Errorf(codes.PermissionDenied, "only the creator can change the visibility of worksheet %s", worksheet.Title)
+				}
+				if err := s.checkShareWorksheet(ctx, visibility); err != nil {
+					return nil, err
+				}
+			}
 			stringVisibility := string(visibility)
 			worksheetPatch.Visibility = &stringVisibility
 		case "database":
@@ -317,6 +356,24 @@ func (s *WorksheetService) UpdateWorksheet(ctx context.Context, request *v1pb.Up
 				return nil, status.Errorf(codes.InvalidArgument, `database "%q" not found`, request.Worksheet.Database)
 			}
 			worksheetPatch.DatabaseUID = &database.UID
+		case "folder":
+			folder, err := normalizeWorksheetFolder(request.Worksheet.Folder)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if worksheetPatch.Payload == nil {
+				worksheetPatch.Payload = proto.Clone(worksheet.Payload).(*storepb.WorksheetPayload)
+			}
+			worksheetPatch.Payload.Folder = folder
+		case "tags":
+			tags, err := normalizeWorksheetTags(request.Worksheet.Tags)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			if worksheetPatch.Payload == nil {
+				worksheetPatch.Payload = proto.Clone(worksheet.Payload).(*storepb.WorksheetPayload)
+			}
+			worksheetPatch.Payload.Tags = tags
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid update mask path %q", path))
 		}
@@ -428,6 +485,55 @@ func (s *WorksheetService) UpdateWorksheetOrganizer(ctx context.Context, request
 	}, nil
 }
 
+// ListWorksheetRevisions lists the revisions of a worksheet.
+func (s *WorksheetService) ListWorksheetRevisions(ctx context.Context, request *v1pb.ListWorksheetRevisionsRequest) (*v1pb.ListWorksheetRevisionsResponse, error) {
+	worksheetUID, err := common.GetWorksheetUID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if worksheetUID <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid worksheet id %d, must be positive integer", worksheetUID))
+	}
+
+	worksheet, err := s.findWorksheet(ctx, &store.FindWorkSheetMessage{
+		UID: &worksheetUID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.canReadWorksheet(ctx, worksheet)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "cannot access worksheet %s", worksheet.Title)
+	}
+
+	revisions, err := s.store.ListWorksheetRevisions(ctx, worksheetUID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to list worksheet revisions: %v", err))
+	}
+	response := &v1pb.ListWorksheetRevisionsResponse{}
+	for _, revision := range revisions {
+		creator, err := s.store.GetUserByID(ctx, revision.CreatorID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get creator: %v", err))
+		}
+		creatorName := ""
+		if creator != nil {
+			creatorName = fmt.Sprintf("users/%s", creator.Email)
+		}
+		response.Revisions = append(response.Revisions, &v1pb.WorksheetRevision{
+			Name:       fmt.Sprintf("%s%d/revisions/%d", common.WorksheetIDPrefix, worksheetUID, revision.UID),
+			Title:      revision.Title,
+			Content:    []byte(revision.Statement),
+			Creator:    creatorName,
+			CreateTime: timestamppb.New(revision.CreatedTime),
+		})
+	}
+	return response, nil
+}
+
 func (s *WorksheetService) findWorksheet(ctx context.Context, find *store.FindWorkSheetMessage) (*store.WorkSheetMessage, error) {
 	principalID, ok := ctx.Value(common.PrincipalIDContextKey).(int)
 	if !ok {
@@ -443,6 +549,39 @@ func (s *WorksheetService) findWorksheet(ctx context.Context, find *store.FindWo
 	return worksheet, nil
 }
 
+// canManageWorksheet check if the principal can change the sharing of the worksheet.
+// worksheet sharing is managed by the creator and the users with bb.worksheets.manage permission on the workspace.
+func (s *WorksheetService) canManageWorksheet(ctx context.Context, worksheet *store.WorkSheetMessage) (bool, error) {
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return false, status.Errorf(codes.Internal, "user not found")
+	}
+	if worksheet.CreatorID == user.ID {
+		return true, nil
+	}
+	return s.iamManager.CheckPermission(ctx, iam.PermissionWorksheetsManage, user)
+}
+
+// checkShareWorksheet checks if the principal can share the worksheet with the visibility.
+// Only the users with bb.worksheets.manage permission on the workspace can share the worksheet to the workspace.
+func (s *WorksheetService) checkShareWorksheet(ctx context.Context, visibility store.WorkSheetVisibility) error {
+	if visibility != store.WorkspaceReadWorkSheet {
+		return nil
+	}
+	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
+	if !ok {
+		return status.Errorf(codes.Internal, "user not found")
+	}
+	ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionWorksheetsManage, user)
+	if err != nil {
+		return status.Errorf(codes.Internal, fmt.Sprintf("failed to check access with error: %v", err))
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission %q is required to share the worksheet to the workspace", iam.PermissionWorksheetsManage)
+	}
+	return nil
+}
+
 // canWriteWorksheet check if the principal can write the worksheet.
 // worksheet is writable when the user has bb.worksheets.manage permission on the workspace, or.
 // PRIVATE: the creator.
@@ -486,6 +625,7 @@ func (s *WorksheetService) canWriteWorksheet(ctx context.Context, worksheet *sto
 // PRIVATE: the creator only.
 // PROJECT_WRITE: all members with bb.projects.get permission in the project.
 // PROJECT_READ: all members with bb.projects.get permission in the project.
+// WORKSPACE_READ: all workspace members.
 func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *store.WorkSheetMessage) (bool, error) {
 	user, ok := ctx.Value(common.UserContextKey).(*store.UserMessage)
 	if !ok {
@@ -507,6 +647,8 @@ func (s *WorksheetService) canReadWorksheet(ctx context.Context, worksheet *stor
 	switch worksheet.Visibility {
 	case store.PrivateWorkSheet:
 		return false, nil
+	case store.WorkspaceReadWorkSheet:
+		return true, nil
 	case store.ProjectReadWorkSheet, store.ProjectWriteWorkSheet:
 		ok, err := s.iamManager.CheckPermission(ctx, iam.PermissionProjectsGet, user)
 		if err != nil {
@@ -543,6 +685,8 @@ func (s *WorksheetService) convertToAPIWorksheetMessage(ctx context.Context, wor
 		visibility = v1pb.Worksheet_VISIBILITY_PROJECT_WRITE
 	case store.PrivateWorkSheet:
 		visibility = v1pb.Worksheet_VISIBILITY_PRIVATE
+	case store.WorkspaceReadWorkSheet:
+		visibility = v1pb.Worksheet_VISIBILITY_WORKSPACE_READ
 	}
 
 	creator, err := s.store.GetUserByID(ctx, worksheet.CreatorID)
@@ -571,6 +715,8 @@ func (s *WorksheetService) convertToAPIWorksheetMessage(ctx context.Context, wor
 		ContentSize: worksheet.Size,
 		Visibility:  visibility,
 		Starred:     worksheet.Starred,
+		Folder:      worksheet.Payload.GetFolder(),
+		Tags:        worksheet.Payload.GetTags(),
 	}, nil
 }
 
@@ -580,6 +726,15 @@ func convertToStoreWorksheetMessage(projectUID int, databaseUID *int, creatorID
 		return nil, err
 	}
 
+	folder, err := normalizeWorksheetFolder(worksheet.Folder)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := normalizeWorksheetTags(worksheet.Tags)
+	if err != nil {
+		return nil, err
+	}
+
 	worksheetMessage := &store.WorkSheetMessage{
 		ProjectUID:  projectUID,
 		DatabaseUID: databaseUID,
@@ -587,6 +742,10 @@ func convertToStoreWorksheetMessage(projectUID int, databaseUID *int, creatorID
 		Title:       worksheet.Title,
 		Statement:   string(worksheet.Content),
 		Visibility:  visibility,
+		Payload: &storepb.WorksheetPayload{
+			Folder: folder,
+			Tags:   tags,
+		},
 	}
 
 	return worksheetMessage, nil
@@ -602,7 +761,44 @@ func convertToStoreWorksheetVisibility(visibility v1pb.Worksheet_Visibility) (st
 		return store.ProjectWriteWorkSheet, nil
 	case v1pb.Worksheet_VISIBILITY_PRIVATE:
 		return store.PrivateWorkSheet, nil
+	case v1pb.Worksheet_VISIBILITY_WORKSPACE_READ:
+		return store.WorkspaceReadWorkSheet, nil
 	default:
 		return store.WorkSheetVisibility(""), status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid visibility %q", visibility))
 	}
 }
+
+// normalizeWorksheetFolder trims the spaces and the slashes around the folder path, e.g. " /a/b/ " becomes "a/b".
+func normalizeWorksheetFolder(folder string) (string, error) {
+	folder = strings.Trim(strings.TrimSpace(folder), "/")
+	if folder == "" {
+		return "", nil
+	}
+	var segments []string
+	for _, segment := range strings.Split(folder, "/") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			return "", errors.Errorf("invalid folder %q, the folder name cannot be empty", folder)
+		}
+		segments = append(segments, segment)
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+// normalizeWorksheetTags trims and deduplicates the tags.
+func normalizeWorksheetTags(tags []string) ([]string, error) {
+	var result []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			return nil, errors.Errorf("tag cannot be empty")
+		}
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		result = append(result, tag)
+	}
+	return result, nil
+}
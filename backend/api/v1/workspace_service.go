@@ -9,6 +9,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/webhook"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -18,15 +19,17 @@ import (
 // WorkspaceService implements the workspace service.
 type WorkspaceService struct {
 	v1pb.UnimplementedWorkspaceServiceServer
-	store      *store.Store
-	iamManager *iam.Manager
+	store          *store.Store
+	iamManager     *iam.Manager
+	webhookManager *webhook.Manager
 }
 
 // NewWorkspaceService creates a new WorkspaceService.
-func NewWorkspaceService(store *store.Store, iamManager *iam.Manager) *WorkspaceService {
+func NewWorkspaceService(store *store.Store, iamManager *iam.Manager, webhookManager *webhook.Manager) *WorkspaceService {
 	return &WorkspaceService{
-		store:      store,
-		iamManager: iamManager,
+		store:          store,
+		iamManager:     iamManager,
+		webhookManager: webhookManager,
 	}
 }
 
@@ -36,6 +39,13 @@ func (s *WorkspaceService) GetIamPolicy(ctx context.Context, _ *v1pb.GetIamPolic
 		return nil, status.Errorf(codes.Internal, "failed to find iam policy with error: %v", err.Error())
 	}
 
+	workspaceID, err := s.store.GetWorkspaceID(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace id with error: %v", err.Error())
+	}
+	workspace := common.FormatWorkspace(workspaceID)
+	s.webhookManager.PublishEvent(ctx, newWorkspaceEvent(ctx, v1pb.WorkspaceEvent_WORKSPACE_MEMBERS_UPDATED, workspace, workspace))
+
 	return convertToV1IamPolicy(ctx, s.store, policy)
 }
 
@@ -27,11 +27,20 @@ func getBaseProfile(dataDir string) *config.Profile {
 		DataDir:            dataDir,
 		ResourceDir:        common.GetResourceDir(dataDir),
 		DemoName:           flags.demoName,
+		DemoScale:          flags.demoScale,
 		Version:            version,
 		GitCommit:          gitcommit,
 		PgURL:              flags.pgURL,
+		MasterKeyURI:       flags.masterKeyURI,
 		DeployID:           uuid.NewString()[:8],
 		LastActiveTs:       time.Now().Unix(),
 		Lsp:                flags.lsp,
+
+		GracefulShutdownPeriod:      flags.gracefulShutdownTimeout,
+		MetadataBackupURI:           flags.metadataBackupURI,
+		MetadataBackupInterval:      flags.metadataBackupInterval,
+		MetadataBackupRetention:     flags.metadataBackupRetention,
+		MetadataBackupEncryptionKey: flags.metadataBackupEncryptionKey,
+		RestoreMetadataBackup:       flags.restoreMetadataBackup,
 	}
 }
@@ -1,6 +1,10 @@
 package common
 
 import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
@@ -75,6 +79,16 @@ var MaskingExceptionPolicyCELAttributes = []cel.EnvOption{
 	cel.ParserExpressionSizeLimit(celLimit),
 }
 
+// CustomSQLReviewRuleCELAttributes are the variables when evaluating the custom SQL review rule.
+var CustomSQLReviewRuleCELAttributes = []cel.EnvOption{
+	cel.Variable("db_engine", cel.StringType),
+	cel.Variable("statement", cel.StringType),
+	cel.Variable("statement_type", cel.StringType),
+	cel.Variable("table_names", cel.ListType(cel.StringType)),
+	cel.Variable("column_names", cel.ListType(cel.StringType)),
+	cel.ParserExpressionSizeLimit(celLimit),
+}
+
 // ConvertUnparsedRisk converts unparsed risk to parsed format.
 func ConvertUnparsedRisk(expression *expr.Expr) (*exprproto.ParsedExpr, error) {
 	if expression == nil || expression.Expression == "" {
@@ -136,6 +150,100 @@ func ValidateGroupCELExpr(expr string) (cel.Program, error) {
 	return prog, nil
 }
 
+// databaseGroupResourceAttributes are the attributes of the resource variable in the database group expression.
+var databaseGroupResourceAttributes = map[string]bool{
+	"database_name":    true,
+	"environment_name": true,
+	"instance_id":      true,
+	"labels":           true,
+}
+
+// GetDatabaseGroupCELLabelKeys returns the sorted database label keys referenced by the database group expression,
+// e.g. `resource.labels.tenant == "a" && resource.labels["region"] == "eu"` references "region" and "tenant".
+// It returns an error if the expression references an unknown resource attribute.
+func GetDatabaseGroupCELLabelKeys(expression string) ([]string, error) {
+	e, err := cel.NewEnv(cel.ParserExpressionSizeLimit(celLimit))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create cel env")
+	}
+	ast, issues := e.Parse(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert ast to parsed expression")
+	}
+	labelKeys := make(map[string]bool)
+	if err := collectDatabaseGroupCELLabelKeys(parsedExpr.Expr, labelKeys); err != nil {
+		return nil, err
+	}
+	var keys []string
+	for key := range labelKeys {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys, nil
+}
+
+func collectDatabaseGroupCELLabelKeys(e *exprproto.Expr, labelKeys map[string]bool) error {
+	if e == nil {
+		return nil
+	}
+	switch e.ExprKind.(type) {
+	case *exprproto.Expr_SelectExpr:
+		selectExpr := e.GetSelectExpr()
+		switch getSelectPath(selectExpr.Operand) {
+		case "resource":
+			if !databaseGroupResourceAttributes[selectExpr.Field] {
+				return errors.Errorf("unknown attribute %q", "resource."+selectExpr.Field)
+			}
+			return nil
+		case "resource.labels":
+			labelKeys[selectExpr.Field] = true
+			return nil
+		}
+		return collectDatabaseGroupCELLabelKeys(selectExpr.Operand, labelKeys)
+	case *exprproto.Expr_CallExpr:
+		call := e.GetCallExpr()
+		if len(call.Args) == 2 {
+			// resource.labels["key"] and "key" in resource.labels.
+			switch {
+			case call.Function == "_[_]" && getSelectPath(call.Args[0]) == "resource.labels":
+				if key := call.Args[1].GetConstExpr().GetStringValue(); key != "" {
+					labelKeys[key] = true
+				}
+			case call.Function == "@in" && getSelectPath(call.Args[1]) == "resource.labels":
+				if key := call.Args[0].GetConstExpr().GetStringValue(); key != "" {
+					labelKeys[key] = true
+				}
+			}
+		}
+		if err := collectDatabaseGroupCELLabelKeys(call.Target, labelKeys); err != nil {
+			return err
+		}
+		for _, arg := range call.Args {
+			if err := collectDatabaseGroupCELLabelKeys(arg, labelKeys); err != nil {
+				return err
+			}
+		}
+	case *exprproto.Expr_ListExpr:
+		for _, element := range e.GetListExpr().Elements {
+			if err := collectDatabaseGroupCELLabelKeys(element, labelKeys); err != nil {
+				return err
+			}
+		}
+	case *exprproto.Expr_ComprehensionExpr:
+		comprehension := e.GetComprehensionExpr()
+		for _, child := range []*exprproto.Expr{comprehension.IterRange, comprehension.AccuInit, comprehension.LoopCondition, comprehension.LoopStep, comprehension.Result} {
+			if err := collectDatabaseGroupCELLabelKeys(child, labelKeys); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // ValidateMaskingRuleCELExpr validates masking rule expr.
 func ValidateMaskingRuleCELExpr(expr string) (cel.Program, error) {
 	e, err := cel.NewEnv(
@@ -174,6 +282,25 @@ func ValidateMaskingExceptionCELExpr(expr string) (cel.Program, error) {
 	return prog, nil
 }
 
+// ValidateCustomSQLReviewRuleCELExpr validates custom SQL review rule expr.
+func ValidateCustomSQLReviewRuleCELExpr(expr string) (cel.Program, error) {
+	e, err := cel.NewEnv(
+		CustomSQLReviewRuleCELAttributes...,
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	ast, issues := e.Compile(expr)
+	if issues != nil && issues.Err() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, issues.Err().Error())
+	}
+	prog, err := e.Program(ast)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return prog, nil
+}
+
 func ValidateProjectMemberCELExpr(expression *expr.Expr) (cel.Program, error) {
 	if expression == nil || expression.Expression == "" {
 		return nil, nil
@@ -305,3 +432,87 @@ func doEvalBindingCondition(expr string, input map[string]any) (bool, error) {
 	}
 	return res, nil
 }
+
+// BuildBindingConditionExpression builds the IAM binding condition expression in the same format as the grant request,
+// e.g. `resource.database in ["instances/i1/databases/d1"] && request.time < timestamp("2024-01-01T00:00:00Z")`.
+func BuildBindingConditionExpression(databases []string, expireTime *time.Time, rowLimit int64) (string, error) {
+	var expressions []string
+	if len(databases) > 0 {
+		list, err := json.Marshal(databases)
+		if err != nil {
+			return "", err
+		}
+		expressions = append(expressions, fmt.Sprintf("resource.database in %s", list))
+	}
+	if expireTime != nil {
+		expressions = append(expressions, fmt.Sprintf("request.time < timestamp(%q)", expireTime.UTC().Format(time.RFC3339)))
+	}
+	if rowLimit > 0 {
+		expressions = append(expressions, fmt.Sprintf("request.row_limit <= %d", rowLimit))
+	}
+	return strings.Join(expressions, " && "), nil
+}
+
+// GetBindingExpireTime returns the expire time of the IAM binding condition, which is the earliest
+// `request.time < timestamp("...")` in the top-level conjunctions. It returns nil if the condition doesn't expire.
+func GetBindingExpireTime(expression string) (*time.Time, error) {
+	if expression == "" {
+		return nil, nil
+	}
+	e, err := cel.NewEnv(IAMPolicyConditionCELAttributes...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	var expireTime *time.Time
+	var visit func(callExpr *exprproto.Expr_Call) error
+	visit = func(callExpr *exprproto.Expr_Call) error {
+		if callExpr == nil {
+			return nil
+		}
+		switch callExpr.Function {
+		case "_&&_":
+			for _, arg := range callExpr.Args {
+				if err := visit(arg.GetCallExpr()); err != nil {
+					return err
+				}
+			}
+		case "_<_":
+			if len(callExpr.Args) != 2 || !isRequestTime(callExpr.Args[0]) {
+				return nil
+			}
+			timestampExpr := callExpr.Args[1].GetCallExpr()
+			if timestampExpr.GetFunction() != "timestamp" || len(timestampExpr.GetArgs()) != 1 {
+				return nil
+			}
+			t, err := time.Parse(time.RFC3339, timestampExpr.Args[0].GetConstExpr().GetStringValue())
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse the expire time")
+			}
+			if expireTime == nil || t.Before(*expireTime) {
+				expireTime = &t
+			}
+		}
+		return nil
+	}
+	if err := visit(parsedExpr.Expr.GetCallExpr()); err != nil {
+		return nil, err
+	}
+	return expireTime, nil
+}
+
+func isRequestTime(e *exprproto.Expr) bool {
+	if e.GetIdentExpr().GetName() == "request.time" {
+		return true
+	}
+	selectExpr := e.GetSelectExpr()
+	return selectExpr.GetField() == "time" && selectExpr.GetOperand().GetIdentExpr().GetName() == "request"
+}
@@ -114,3 +114,45 @@ func TestGetQueryExportFactors(t *testing.T) {
 		a.Equal(tt.want, *factors)
 	}
 }
+
+func TestBindingExpireTime(t *testing.T) {
+	a := require.New(t)
+
+	expireTime := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	expression, err := BuildBindingConditionExpression([]string{"instances/i1/databases/d1"}, &expireTime, 100)
+	a.NoError(err)
+	a.Equal(`resource.database in ["instances/i1/databases/d1"] && request.time < timestamp("2024-02-01T00:00:00Z") && request.row_limit <= 100`, expression)
+
+	got, err := GetBindingExpireTime(expression)
+	a.NoError(err)
+	a.NotNil(got)
+	a.True(expireTime.Equal(*got))
+
+	got, err = GetBindingExpireTime(`request.time < timestamp("2024-03-01T00:00:00Z") && request.time < timestamp("2024-02-01T00:00:00Z")`)
+	a.NoError(err)
+	a.True(expireTime.Equal(*got))
+
+	// The binding doesn't expire if the expire time is in a disjunction.
+	got, err = GetBindingExpireTime(`request.time < timestamp("2024-02-01T00:00:00Z") || resource.database == "instances/i1/databases/d1"`)
+	a.NoError(err)
+	a.Nil(got)
+
+	got, err = GetBindingExpireTime("")
+	a.NoError(err)
+	a.Nil(got)
+}
+
+func TestGetDatabaseGroupCELLabelKeys(t *testing.T) {
+	a := require.New(t)
+
+	keys, err := GetDatabaseGroupCELLabelKeys(`resource.labels.tenant == "a" && resource.labels["region"] == "eu" && "tier" in resource.labels && resource.database_name.startsWith("db")`)
+	a.NoError(err)
+	a.Equal([]string{"region", "tenant", "tier"}, keys)
+
+	keys, err = GetDatabaseGroupCELLabelKeys(`resource.environment_name == "environments/prod"`)
+	a.NoError(err)
+	a.Empty(keys)
+
+	_, err = GetDatabaseGroupCELLabelKeys(`resource.db_name == "db"`)
+	a.Error(err)
+}
@@ -16,6 +16,8 @@ const (
 	UserContextKey
 	AuthContextKey
 	ServiceDataKey
+	// TokenPermissionsContextKey is the key name used to store the permissions granted by the scopes of a service account token.
+	TokenPermissionsContextKey
 )
 
 func WithSetServiceData(ctx context.Context, setServiceData func(a *anypb.Any)) context.Context {
@@ -27,6 +29,13 @@ func GetSetServiceDataFromContext(ctx context.Context) (func(a *anypb.Any), bool
 	return setServiceData, ok
 }
 
+// GetTokenPermissionsFromContext returns the permissions granted by the scoped service account token.
+// The request is not authenticated by a scoped token if ok is false.
+func GetTokenPermissionsFromContext(ctx context.Context) (map[string]bool, bool) {
+	permissions, ok := ctx.Value(TokenPermissionsContextKey).(map[string]bool)
+	return permissions, ok
+}
+
 type AuthMethod int
 
 const (
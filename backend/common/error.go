@@ -37,6 +37,8 @@ const (
 
 	// 301 task error.
 	TaskTimingNotAllowed Code = 301
+	// VerificationFailed is the code of the task whose change is applied but the verification queries failed.
+	VerificationFailed Code = 302
 
 	// 401 task sql type error.
 	TaskTypeNotDML         Code = 401
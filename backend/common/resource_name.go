@@ -11,48 +11,56 @@ import (
 
 // nolint:revive
 const (
-	WorkspacePrefix            = "workspaces/"
-	ProjectNamePrefix          = "projects/"
-	EnvironmentNamePrefix      = "environments/"
-	InstanceNamePrefix         = "instances/"
-	PolicyNamePrefix           = "policies/"
-	DatabaseIDPrefix           = "databases/"
-	InstanceRolePrefix         = "roles/"
-	UserNamePrefix             = "users/"
-	IdentityProviderNamePrefix = "idps/"
-	SettingNamePrefix          = "settings/"
-	VCSProviderPrefix          = "vcsProviders/"
-	RiskPrefix                 = "risks/"
-	RolloutPrefix              = "rollouts/"
-	StagePrefix                = "stages/"
-	TaskPrefix                 = "tasks/"
-	TaskRunPrefix              = "taskRuns/"
-	PlanPrefix                 = "plans/"
-	PlanCheckRunPrefix         = "planCheckRuns/"
-	RolePrefix                 = "roles/"
-	SecretNamePrefix           = "secrets/"
-	WebhookIDPrefix            = "webhooks/"
-	SheetIDPrefix              = "sheets/"
-	WorksheetIDPrefix          = "worksheets/"
-	DatabaseGroupNamePrefix    = "databaseGroups/"
-	SchemaNamePrefix           = "schemas/"
-	TableNamePrefix            = "tables/"
-	ChangeHistoryPrefix        = "changeHistories/"
-	IssueNamePrefix            = "issues/"
-	IssueCommentNamePrefix     = "issueComments/"
-	PipelineNamePrefix         = "pipelines/"
-	LogNamePrefix              = "logs/"
-	BranchPrefix               = "branches/"
-	DeploymentConfigPrefix     = "deploymentConfigs/"
-	ChangelistsPrefix          = "changelists/"
-	VCSConnectorPrefix         = "vcsConnectors/"
-	AuditLogPrefix             = "auditLogs/"
-	GroupPrefix                = "groups/"
-	ReviewConfigPrefix         = "reviewConfigs/"
-
-	SchemaSuffix     = "/schema"
-	MetadataSuffix   = "/metadata"
-	GitOpsInfoSuffix = "/gitOpsInfo"
+	WorkspacePrefix                = "workspaces/"
+	ProjectNamePrefix              = "projects/"
+	EnvironmentNamePrefix          = "environments/"
+	InstanceNamePrefix             = "instances/"
+	PolicyNamePrefix               = "policies/"
+	DatabaseIDPrefix               = "databases/"
+	InstanceRolePrefix             = "roles/"
+	UserNamePrefix                 = "users/"
+	IdentityProviderNamePrefix     = "idps/"
+	SettingNamePrefix              = "settings/"
+	VCSProviderPrefix              = "vcsProviders/"
+	RiskPrefix                     = "risks/"
+	RolloutPrefix                  = "rollouts/"
+	StagePrefix                    = "stages/"
+	TaskPrefix                     = "tasks/"
+	TaskRunPrefix                  = "taskRuns/"
+	PlanPrefix                     = "plans/"
+	PlanCheckRunPrefix             = "planCheckRuns/"
+	RolePrefix                     = "roles/"
+	SecretNamePrefix               = "secrets/"
+	WebhookIDPrefix                = "webhooks/"
+	SheetIDPrefix                  = "sheets/"
+	WorksheetIDPrefix              = "worksheets/"
+	DatabaseGroupNamePrefix        = "databaseGroups/"
+	SchemaNamePrefix               = "schemas/"
+	TableNamePrefix                = "tables/"
+	ChangeHistoryPrefix            = "changeHistories/"
+	IssueNamePrefix                = "issues/"
+	IssueCommentNamePrefix         = "issueComments/"
+	PipelineNamePrefix             = "pipelines/"
+	LogNamePrefix                  = "logs/"
+	BranchPrefix                   = "branches/"
+	DeploymentConfigPrefix         = "deploymentConfigs/"
+	ChangelistsPrefix              = "changelists/"
+	ReleasesPrefix                 = "releases/"
+	VCSConnectorPrefix             = "vcsConnectors/"
+	AuditLogPrefix                 = "auditLogs/"
+	GroupPrefix                    = "groups/"
+	ReviewConfigPrefix             = "reviewConfigs/"
+	TokenNamePrefix                = "tokens/"
+	ClassificationSuggestionPrefix = "classificationSuggestions/"
+	AdminSessionPrefix             = "adminSessions/"
+	BackupRunPrefix                = "backupRuns/"
+	SavedSearchPrefix              = "savedSearches/"
+
+	SchemaSuffix              = "/schema"
+	MetadataSuffix            = "/metadata"
+	GitOpsInfoSuffix          = "/gitOpsInfo"
+	BackupSettingSuffix       = "/backupSetting"
+	NotificationSettingSuffix = "/notificationSetting"
 )
 
 // GetProjectID returns the project ID from a resource name.
@@ -110,6 +118,14 @@ func GetProjectIDChangelistID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+func GetProjectIDReleaseID(name string) (string, string, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, ReleasesPrefix)
+	if err != nil {
+		return "", "", err
+	}
+	return tokens[0], tokens[1], nil
+}
+
 // GetUIDFromName returns the UID from a resource name.
 func GetUIDFromName(name, prefix string) (int, error) {
 	tokens, err := GetNameParentTokens(name, prefix)
@@ -200,6 +216,34 @@ func GetInstanceDatabaseIDSecretName(name string) (string, string, string, error
 	return tokens[0], tokens[1], tokens[2], nil
 }
 
+// GetInstanceDatabaseIDClassificationSuggestionUID returns the instance ID, database ID, and classification suggestion UID from a resource name.
+func GetInstanceDatabaseIDClassificationSuggestionUID(name string) (string, string, int, error) {
+	// the classification suggestion name should be instances/{instance-id}/databases/{database-id}/classificationSuggestions/{suggestion-uid}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, ClassificationSuggestionPrefix)
+	if err != nil {
+		return "", "", 0, err
+	}
+	uid, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", "", 0, errors.Errorf("invalid classification suggestion uid %q", tokens[2])
+	}
+	return tokens[0], tokens[1], uid, nil
+}
+
+// GetInstanceDatabaseIDBackupRunUID returns the instance ID, database ID, and backup run UID from a resource name.
+func GetInstanceDatabaseIDBackupRunUID(name string) (string, string, int, error) {
+	// the backup run name should be instances/{instance-id}/databases/{database-id}/backupRuns/{backup-run-uid}
+	tokens, err := GetNameParentTokens(name, InstanceNamePrefix, DatabaseIDPrefix, BackupRunPrefix)
+	if err != nil {
+		return "", "", 0, err
+	}
+	uid, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", "", 0, errors.Errorf("invalid backup run uid %q", tokens[2])
+	}
+	return tokens[0], tokens[1], uid, nil
+}
+
 // GetUserID returns the user ID from a resource name.
 func GetUserID(name string) (int, error) {
 	return GetUIDFromName(name, UserNamePrefix)
@@ -214,6 +258,19 @@ func GetUserEmail(name string) (string, error) {
 	return tokens[0], nil
 }
 
+// GetUserEmailTokenID returns the user email and service account token ID from a resource name.
+func GetUserEmailTokenID(name string) (string, int, error) {
+	tokens, err := GetNameParentTokens(name, UserNamePrefix, TokenNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	tokenID, err := strconv.Atoi(tokens[1])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid token ID %q", tokens[1])
+	}
+	return tokens[0], tokenID, nil
+}
+
 // GetSettingName returns the setting name from a resource name.
 func GetSettingName(name string) (string, error) {
 	token, err := GetNameParentTokens(name, SettingNamePrefix)
@@ -280,6 +337,46 @@ func GetIssueID(name string) (int, error) {
 	return issueID, nil
 }
 
+// GetProjectIDIssueKey returns the project ID, the project key and the issue number from the issue name
+// identified by the issue key, e.g. projects/pay/issues/PAY-142.
+func GetProjectIDIssueKey(name string) (string, string, int, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, IssueNamePrefix)
+	if err != nil {
+		return "", "", 0, err
+	}
+	index := strings.LastIndex(tokens[1], "-")
+	if index <= 0 {
+		return "", "", 0, errors.Errorf("invalid issue key %q", tokens[1])
+	}
+	number, err := strconv.Atoi(tokens[1][index+1:])
+	if err != nil || number <= 0 {
+		return "", "", 0, errors.Errorf("invalid issue key %q", tokens[1])
+	}
+	return tokens[0], tokens[1][:index], number, nil
+}
+
+// GetIssueNameIssueCommentUID returns the issue name and the issue comment uid from the issue comment name,
+// e.g. projects/pay/issues/142/issueComments/101.
+func GetIssueNameIssueCommentUID(name string) (string, int, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, IssueNamePrefix, IssueCommentNamePrefix)
+	if err != nil {
+		return "", 0, err
+	}
+	issueCommentUID, err := strconv.Atoi(tokens[2])
+	if err != nil {
+		return "", 0, errors.Errorf("invalid issue comment ID %q", tokens[2])
+	}
+	return fmt.Sprintf("%s%s/%s%s", ProjectNamePrefix, tokens[0], IssueNamePrefix, tokens[1]), issueCommentUID, nil
+}
+
+// FormatIssueKey formats the human-friendly issue key from the project key and the issue number, e.g. PAY-142.
+func FormatIssueKey(projectKey string, number int) string {
+	if projectKey == "" || number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s-%d", projectKey, number)
+}
+
 // GetTaskID returns the task ID from a resource name.
 func GetTaskID(name string) (int, error) {
 	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, RolloutPrefix, StagePrefix, TaskPrefix)
@@ -480,6 +577,19 @@ func GetProjectResourceIDSheetUID(name string) (string, int, error) {
 	return tokens[0], sheetUID, nil
 }
 
+// GetSavedSearchUID returns the saved search uid from a resource name.
+func GetSavedSearchUID(name string) (int, error) {
+	tokens, err := GetNameParentTokens(name, SavedSearchPrefix)
+	if err != nil {
+		return 0, err
+	}
+	uid, err := strconv.Atoi(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid saved search uid %q", tokens[0])
+	}
+	return uid, nil
+}
+
 // GetWorksheetUID returns the worksheet UID from a resource name.
 func GetWorksheetUID(name string) (int, error) {
 	tokens, err := GetNameParentTokens(name, WorksheetIDPrefix)
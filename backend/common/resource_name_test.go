@@ -66,3 +66,29 @@ func TestGetRiskID(t *testing.T) {
 		a.Equal(test.want, got)
 	}
 }
+
+func TestGetProjectIDIssueKey(t *testing.T) {
+	projectID, projectKey, number, err := GetProjectIDIssueKey("projects/pay/issues/PAY-142")
+	require.NoError(t, err)
+	require.Equal(t, "pay", projectID)
+	require.Equal(t, "PAY", projectKey)
+	require.Equal(t, 142, number)
+	require.Equal(t, "PAY-142", FormatIssueKey(projectKey, number))
+
+	for _, name := range []string{"projects/pay/issues/142", "projects/pay/issues/-142", "projects/pay/issues/PAY-", "projects/pay/issues/PAY-0"} {
+		_, _, _, err = GetProjectIDIssueKey(name)
+		require.Error(t, err)
+	}
+}
+
+func TestGetIssueNameIssueCommentUID(t *testing.T) {
+	issueName, uid, err := GetIssueNameIssueCommentUID("projects/pay/issues/PAY-142/issueComments/101")
+	require.NoError(t, err)
+	require.Equal(t, "projects/pay/issues/PAY-142", issueName)
+	require.Equal(t, 101, uid)
+
+	for _, name := range []string{"projects/pay/issues/142", "projects/pay/issues/142/issueComments/abc", "projects/pay/issueComments/101"} {
+		_, _, err = GetIssueNameIssueCommentUID(name)
+		require.Error(t, err)
+	}
+}
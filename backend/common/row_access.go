@@ -0,0 +1,219 @@
+package common
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	exprproto "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// RowAccessRequester is the requester attributes in the conditions of the row access rules.
+type RowAccessRequester struct {
+	// Email is `request.user.email`.
+	Email string
+	// Groups are `request.user.groups`, the emails of the groups the requester belongs to.
+	Groups []string
+}
+
+// ValidateRowAccessCELExpr validates the condition of the row access rule.
+func ValidateRowAccessCELExpr(expression string) error {
+	_, err := ConvertRowAccessConditionToSQL(expression, &RowAccessRequester{}, strconv.Quote, strconv.Quote)
+	return err
+}
+
+// ConvertRowAccessConditionToSQL converts the CEL condition of the row access rule to the SQL predicate,
+// e.g. `"support-eu@example.com" in request.user.groups && region == "EU"` becomes `(('support-eu@example.com' IN ('support-eu@example.com')) AND ("region" = 'EU'))`.
+// The requester attributes are converted to the literals, and the quoteIdentifier and quoteString quote the column names and the strings for the engine.
+func ConvertRowAccessConditionToSQL(expression string, requester *RowAccessRequester, quoteIdentifier, quoteString func(string) string) (string, error) {
+	if expression == "" {
+		return "", errors.Errorf("condition is required")
+	}
+	e, err := cel.NewEnv(cel.ParserExpressionSizeLimit(celLimit))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create cel env")
+	}
+	ast, issues := e.Parse(expression)
+	if issues != nil && issues.Err() != nil {
+		return "", errors.Wrapf(issues.Err(), "failed to parse condition %q", expression)
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert ast to parsed expression")
+	}
+	c := &rowAccessConverter{
+		requester:       requester,
+		quoteIdentifier: quoteIdentifier,
+		quoteString:     quoteString,
+	}
+	return c.convert(parsedExpr.Expr)
+}
+
+type rowAccessConverter struct {
+	requester       *RowAccessRequester
+	quoteIdentifier func(string) string
+	quoteString     func(string) string
+}
+
+var rowAccessComparisonOperators = map[string]string{
+	"_==_": "=",
+	"_!=_": "<>",
+	"_<_":  "<",
+	"_<=_": "<=",
+	"_>_":  ">",
+	"_>=_": ">=",
+}
+
+func (c *rowAccessConverter) convert(e *exprproto.Expr) (string, error) {
+	switch e.ExprKind.(type) {
+	case *exprproto.Expr_ConstExpr:
+		return c.convertConstant(e.GetConstExpr())
+	case *exprproto.Expr_IdentExpr:
+		name := e.GetIdentExpr().Name
+		if name == "request" {
+			return "", errors.Errorf("unsupported attribute %q", name)
+		}
+		return c.quoteIdentifier(name), nil
+	case *exprproto.Expr_SelectExpr:
+		path := getSelectPath(e)
+		if path == "request.user.email" {
+			return c.quoteString(c.requester.Email), nil
+		}
+		return "", errors.Errorf("unsupported attribute %q", path)
+	case *exprproto.Expr_CallExpr:
+		return c.convertCall(e.GetCallExpr())
+	}
+	return "", errors.Errorf("unsupported expression %v", e)
+}
+
+func (c *rowAccessConverter) convertCall(call *exprproto.Expr_Call) (string, error) {
+	if call.Target != nil {
+		return "", errors.Errorf("unsupported function %q", call.Function)
+	}
+	switch call.Function {
+	case "_&&_", "_||_":
+		operator := " AND "
+		if call.Function == "_||_" {
+			operator = " OR "
+		}
+		var args []string
+		for _, arg := range call.Args {
+			s, err := c.convert(arg)
+			if err != nil {
+				return "", err
+			}
+			args = append(args, s)
+		}
+		return "(" + strings.Join(args, operator) + ")", nil
+	case "!_":
+		if len(call.Args) != 1 {
+			return "", errors.Errorf("invalid arguments of %q", call.Function)
+		}
+		s, err := c.convert(call.Args[0])
+		if err != nil {
+			return "", err
+		}
+		return "(NOT " + s + ")", nil
+	case "@in":
+		if len(call.Args) != 2 {
+			return "", errors.Errorf("invalid arguments of %q", call.Function)
+		}
+		left, err := c.convert(call.Args[0])
+		if err != nil {
+			return "", err
+		}
+		var values []string
+		switch {
+		case call.Args[1].GetListExpr() != nil:
+			for _, element := range call.Args[1].GetListExpr().Elements {
+				s, err := c.convert(element)
+				if err != nil {
+					return "", err
+				}
+				values = append(values, s)
+			}
+		case getSelectPath(call.Args[1]) == "request.user.groups":
+			for _, group := range c.requester.Groups {
+				values = append(values, c.quoteString(group))
+			}
+		default:
+			return "", errors.Errorf("the right side of \"in\" must be a list or request.user.groups")
+		}
+		if len(values) == 0 {
+			return "FALSE", nil
+		}
+		return "(" + left + " IN (" + strings.Join(values, ", ") + "))", nil
+	}
+
+	operator, ok := rowAccessComparisonOperators[call.Function]
+	if !ok {
+		return "", errors.Errorf("unsupported function %q", call.Function)
+	}
+	if len(call.Args) != 2 {
+		return "", errors.Errorf("invalid arguments of %q", call.Function)
+	}
+	// Compare with null by IS NULL and IS NOT NULL.
+	for i, arg := range call.Args {
+		if _, ok := arg.GetConstExpr().GetConstantKind().(*exprproto.Constant_NullValue); !ok {
+			continue
+		}
+		s, err := c.convert(call.Args[1-i])
+		if err != nil {
+			return "", err
+		}
+		switch call.Function {
+		case "_==_":
+			return "(" + s + " IS NULL)", nil
+		case "_!=_":
+			return "(" + s + " IS NOT NULL)", nil
+		default:
+			return "", errors.Errorf("cannot compare with null by %q", operator)
+		}
+	}
+	left, err := c.convert(call.Args[0])
+	if err != nil {
+		return "", err
+	}
+	right, err := c.convert(call.Args[1])
+	if err != nil {
+		return "", err
+	}
+	return "(" + left + " " + operator + " " + right + ")", nil
+}
+
+func (c *rowAccessConverter) convertConstant(constant *exprproto.Constant) (string, error) {
+	switch v := constant.ConstantKind.(type) {
+	case *exprproto.Constant_NullValue:
+		return "NULL", nil
+	case *exprproto.Constant_BoolValue:
+		if v.BoolValue {
+			return "TRUE", nil
+		}
+		return "FALSE", nil
+	case *exprproto.Constant_Int64Value:
+		return strconv.FormatInt(v.Int64Value, 10), nil
+	case *exprproto.Constant_Uint64Value:
+		return strconv.FormatUint(v.Uint64Value, 10), nil
+	case *exprproto.Constant_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'f', -1, 64), nil
+	case *exprproto.Constant_StringValue:
+		return c.quoteString(v.StringValue), nil
+	}
+	return "", errors.Errorf("unsupported constant %v", constant)
+}
+
+// getSelectPath returns the dot separated path of the select expression, e.g. "request.user.email".
+func getSelectPath(e *exprproto.Expr) string {
+	switch e.ExprKind.(type) {
+	case *exprproto.Expr_IdentExpr:
+		return e.GetIdentExpr().Name
+	case *exprproto.Expr_SelectExpr:
+		operand := getSelectPath(e.GetSelectExpr().Operand)
+		if operand == "" {
+			return ""
+		}
+		return operand + "." + e.GetSelectExpr().Field
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertRowAccessConditionToSQL(t *testing.T) {
+	requester := &RowAccessRequester{
+		Email:  "alice@example.com",
+		Groups: []string{"support-eu@example.com"},
+	}
+	quoteIdentifier := func(s string) string {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(s, `"`, `""`))
+	}
+	quoteString := func(s string) string {
+		return fmt.Sprintf(`'%s'`, strings.ReplaceAll(s, `'`, `''`))
+	}
+
+	testCases := []struct {
+		expression string
+		want       string
+		wantErr    bool
+	}{
+		{
+			expression: `region == "EU"`,
+			want:       `("region" = 'EU')`,
+		},
+		{
+			expression: `"support-eu@example.com" in request.user.groups && region == "EU" || owner == request.user.email`,
+			want:       `((('support-eu@example.com' IN ('support-eu@example.com')) AND ("region" = 'EU')) OR ("owner" = 'alice@example.com'))`,
+		},
+		{
+			expression: `region in ["EU", "UK"] && !(level >= 3) && deleted_at == null`,
+			want:       `((("region" IN ('EU', 'UK')) AND (NOT ("level" >= 3))) AND ("deleted_at" IS NULL))`,
+		},
+		{
+			expression: `"support-us@example.com" in request.user.groups`,
+			want:       `('support-us@example.com' IN ('support-eu@example.com'))`,
+		},
+		{
+			expression: `region in []`,
+			want:       `FALSE`,
+		},
+		{
+			expression: `name == "O'Brien"`,
+			want:       `("name" = 'O''Brien')`,
+		},
+		{
+			expression: `region.startsWith("E")`,
+			wantErr:    true,
+		},
+		{
+			expression: `request.user.groups == "a"`,
+			wantErr:    true,
+		},
+		{
+			expression: ``,
+			wantErr:    true,
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range testCases {
+		got, err := ConvertRowAccessConditionToSQL(tc.expression, requester, quoteIdentifier, quoteString)
+		if tc.wantErr {
+			a.Error(err, tc.expression)
+			continue
+		}
+		a.NoError(err, tc.expression)
+		a.Equal(tc.want, got, tc.expression)
+	}
+}
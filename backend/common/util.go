@@ -25,6 +25,12 @@ const (
 	MaxSheetSize = 1024 * 1024
 	// MaxSheetCheckSize is the maximum size of a sheet for checking changes.
 	MaxSheetCheckSize = 1024 * 1024
+	// DefaultSheetExternalThreshold is the default size (8M) above which the sheets are stored in the object storage.
+	DefaultSheetExternalThreshold = 8 * 1024 * 1024
+	// DefaultSheetWarningSize is the default size (1M) above which the plans get a warning on creation.
+	DefaultSheetWarningSize = 1024 * 1024
+	// MaxTaskRunLogSize is the maximum size (16M) of the persisted full log of a task run.
+	MaxTaskRunLogSize = 16 * 1024 * 1024
 	// The maximum number of bytes for sql results in response body.
 	// 100 MB.
 	DefaultMaximumSQLResultSize = 100 * 1024 * 1024
@@ -49,6 +55,11 @@ var (
 		storepb.Engine_SNOWFLAKE:        true,
 		storepb.Engine_MSSQL:            true,
 		storepb.Engine_DYNAMODB:         true,
+		storepb.Engine_CLICKHOUSE:       true,
+		storepb.Engine_DUCKDB:           true,
+		storepb.Engine_DORIS:            true,
+		storepb.Engine_ELASTICSEARCH:    true,
+		storepb.Engine_REDIS:            true,
 	}
 	StatementReportEngines = map[storepb.Engine]bool{
 		storepb.Engine_POSTGRES:         true,
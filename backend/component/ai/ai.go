@@ -0,0 +1,117 @@
+// Package ai is the client of the AI providers compatible with the OpenAI chat completions API.
+package ai
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	openai "github.com/sashabaranov/go-openai"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// legacyModel is the model used with the legacy OpenAI key setting.
+const legacyModel = openai.GPT3Dot5Turbo
+
+// Config is the config of the AI provider.
+type Config struct {
+	Provider storepb.AISetting_Provider
+	Endpoint string
+	APIKey   string
+	// Model is the deployment name for Azure OpenAI.
+	Model      string
+	APIVersion string
+}
+
+// NewConfig creates the config from the AI setting.
+func NewConfig(setting *storepb.AISetting, secret string) (*Config, error) {
+	config := &Config{
+		Provider:   setting.Provider,
+		Endpoint:   setting.Endpoint,
+		Model:      setting.Model,
+		APIVersion: setting.ApiVersion,
+	}
+	if setting.ObfuscatedApiKey != "" {
+		key, err := common.Unobfuscate(setting.ObfuscatedApiKey, secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get API key")
+		}
+		config.APIKey = key
+	}
+	return config, nil
+}
+
+// GetConfig gets the config of the AI provider, it's nil if AI is not configured.
+// The legacy OpenAI key and endpoint settings are used if the AI setting is not enabled.
+func GetConfig(ctx context.Context, s *store.Store, secret string) (*Config, error) {
+	setting, err := s.GetAISetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if setting.Enabled {
+		return NewConfig(setting, secret)
+	}
+
+	keyName := api.SettingPluginOpenAIKey
+	key, err := s.GetSettingV2(ctx, &store.FindSettingMessage{Name: &keyName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", keyName)
+	}
+	if key == nil || key.Value == "" {
+		return nil, nil
+	}
+	endpointName := api.SettingPluginOpenAIEndpoint
+	endpoint, err := s.GetSettingV2(ctx, &store.FindSettingMessage{Name: &endpointName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", endpointName)
+	}
+	config := &Config{
+		Provider: storepb.AISetting_OPENAI,
+		APIKey:   key.Value,
+		Model:    legacyModel,
+	}
+	if endpoint != nil && endpoint.Value != "" {
+		// The legacy endpoint doesn't have the /v1 suffix.
+		config.Endpoint = endpoint.Value + "/v1"
+	}
+	return config, nil
+}
+
+// Complete requests the chat completion and returns the content of the first choice.
+func Complete(ctx context.Context, config *Config, messages []openai.ChatCompletionMessage) (string, error) {
+	var cfg openai.ClientConfig
+	switch config.Provider {
+	case storepb.AISetting_OPENAI:
+		cfg = openai.DefaultConfig(config.APIKey)
+		if config.Endpoint != "" {
+			cfg.BaseURL = config.Endpoint
+		}
+	case storepb.AISetting_AZURE_OPENAI:
+		cfg = openai.DefaultAzureConfig(config.APIKey, config.Endpoint)
+		cfg.APIVersion = config.APIVersion
+		cfg.AzureModelMapperFunc = func(string) string {
+			return config.Model
+		}
+	case storepb.AISetting_OPENAI_COMPATIBLE:
+		cfg = openai.DefaultConfig(config.APIKey)
+		cfg.BaseURL = config.Endpoint
+	default:
+		return "", errors.Errorf("unsupported provider %v", config.Provider)
+	}
+
+	resp, err := openai.NewClientWithConfig(cfg).CreateChatCompletion(ctx, openai.ChatCompletionRequest{
+		Model:       config.Model,
+		Messages:    messages,
+		Temperature: 0,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create chat completion")
+	}
+	if len(resp.Choices) == 0 {
+		return "", errors.Errorf("no chat completion choice")
+	}
+	return resp.Choices[0].Message.Content, nil
+}
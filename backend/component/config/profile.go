@@ -42,10 +42,14 @@ type Profile struct {
 	ResourceDir string
 	// DemoName specifies the demo name. Empty string means no demo.
 	DemoName string
+	// DemoScale is the number of the load test projects generated on top of the demo data.
+	DemoScale int
 	// AppRunnerInterval is the interval for application runner.
 	AppRunnerInterval time.Duration
 	// BackupRunnerInterval is the interval for backup runner.
 	BackupRunnerInterval time.Duration
+	// GracefulShutdownPeriod is the deadline to drain the executing task runs and shut down the server.
+	GracefulShutdownPeriod time.Duration
 
 	// Version is the bytebase's server version
 	Version string
@@ -53,6 +57,18 @@ type Profile struct {
 	GitCommit string
 	// PgURL is the optional external PostgreSQL instance connection url
 	PgURL string
+	// MasterKeyURI is the optional URI of the KMS master key that wraps the data encryption keys for secrets at rest.
+	MasterKeyURI string
+	// MetadataBackupURI is the optional object storage URI to back up the metadata database to, e.g. s3://bucket/prefix.
+	MetadataBackupURI string
+	// MetadataBackupInterval is the interval of the scheduled metadata backups, 0 disables the scheduled backups.
+	MetadataBackupInterval time.Duration
+	// MetadataBackupRetention is the retention of the metadata backups, 0 keeps all backups.
+	MetadataBackupRetention time.Duration
+	// MetadataBackupEncryptionKey is the optional passphrase to encrypt the metadata backups.
+	MetadataBackupEncryptionKey string
+	// RestoreMetadataBackup is the name of the metadata backup, or "latest", to restore on startup.
+	RestoreMetadataBackup string
 	// MetricConnectionKey is the connection key for metric.
 	MetricConnectionKey string
 	// EnableMetric will enable the metric collector.
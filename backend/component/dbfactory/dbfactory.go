@@ -227,6 +227,7 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 			AuthenticationDatabase:   dataSource.AuthenticationDatabase,
 			SID:                      dataSource.SID,
 			ServiceName:              dataSource.ServiceName,
+			OraclePDBMode:            instance.Options.GetOraclePdbMode(),
 			SSHConfig:                sshConfig,
 			ReadOnly:                 readOnly,
 			ConnectionContext:        connectionContext,
@@ -237,6 +238,7 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 			ReplicaSet:               dataSource.ReplicaSet,
 			DirectConnection:         dataSource.DirectConnection,
 			Region:                   dataSource.Region,
+			AWSRoleARN:               dataSource.AWSRoleARN,
 			AccountID:                dataSource.AccountID,
 			WarehouseID:              dataSource.WarehouseID,
 			RedisType:                dataSource.RedisType,
@@ -244,6 +246,7 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 			MasterUsername:           dataSource.MasterUsername,
 			MasterPassword:           masterPassword,
 			MaximumSQLResultSize:     maximumSQLResultSize,
+			ConnectionPool:           getConnectionPoolConfig(instance),
 		},
 	)
 	if err != nil {
@@ -252,3 +255,12 @@ func (d *DBFactory) GetDataSourceDriver(ctx context.Context, instance *store.Ins
 
 	return driver, nil
 }
+
+func getConnectionPoolConfig(instance *store.InstanceMessage) db.ConnectionPoolConfig {
+	connectionPool := instance.Options.GetConnectionPool()
+	return db.ConnectionPoolConfig{
+		MaxOpenConns:    int(connectionPool.GetMaxOpenConnections()),
+		MaxIdleConns:    int(connectionPool.GetMaxIdleConnections()),
+		ConnMaxLifetime: connectionPool.GetMaxConnectionLifetime().AsDuration(),
+	}
+}
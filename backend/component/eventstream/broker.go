@@ -0,0 +1,133 @@
+// Package eventstream is the broker of the workspace events streamed to the API clients and mirrored to the event buses.
+package eventstream
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// subscriptionBufferSize is the number of the events buffered for a subscription.
+// The events are dropped for the subscription if the client falls behind, so that the slow clients don't block the publishers.
+const subscriptionBufferSize = 256
+
+var _ store.AnomalyListener = (*Broker)(nil)
+
+// Broker fans out the published events to the subscriptions.
+type Broker struct {
+	store *store.Store
+
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]bool
+}
+
+// Subscription is the subscription of the events.
+type Subscription struct {
+	events chan *v1pb.WorkspaceEvent
+}
+
+// Events returns the channel of the events.
+func (s *Subscription) Events() <-chan *v1pb.WorkspaceEvent {
+	return s.events
+}
+
+// NewBroker creates a broker.
+func NewBroker(store *store.Store) *Broker {
+	return &Broker{
+		store:         store,
+		subscriptions: make(map[*Subscription]bool),
+	}
+}
+
+// Subscribe subscribes the events published after the call.
+func (b *Broker) Subscribe() *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := &Subscription{
+		events: make(chan *v1pb.WorkspaceEvent, subscriptionBufferSize),
+	}
+	b.subscriptions[s] = true
+	return s
+}
+
+// Unsubscribe removes the subscription.
+func (b *Broker) Unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subscriptions, s)
+}
+
+// Publish publishes the event to all subscriptions without blocking,
+// and queues the event to the event bus destinations for the at-least-once delivery.
+func (b *Broker) Publish(ctx context.Context, event *v1pb.WorkspaceEvent) {
+	if event.CreateTime == nil {
+		event.CreateTime = timestamppb.Now()
+	}
+	if err := b.queueEventBusEvent(ctx, event); err != nil {
+		slog.Error("failed to queue the workspace event to the event buses", slog.String("type", event.Type.String()), slog.String("resource", event.Resource), log.BBError(err))
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for s := range b.subscriptions {
+		select {
+		case s.events <- event:
+		default:
+			slog.Warn("drop the workspace event for the slow subscription", slog.String("type", event.Type.String()), slog.String("resource", event.Resource))
+		}
+	}
+}
+
+// OnAnomalyCreate publishes the anomaly detected event.
+func (b *Broker) OnAnomalyCreate(ctx context.Context, anomaly *store.AnomalyMessage) {
+	event := &v1pb.WorkspaceEvent{
+		Type:     v1pb.WorkspaceEvent_ANOMALY_DETECTED,
+		Resource: common.FormatInstance(anomaly.InstanceID),
+		Title:    anomaly.InstanceID,
+		Detail:   string(anomaly.Type),
+	}
+	if anomaly.DatabaseUID != nil {
+		database, err := b.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: anomaly.DatabaseUID})
+		if err != nil {
+			slog.Error("failed to get database of the anomaly", slog.Int("database", *anomaly.DatabaseUID), log.BBError(err))
+			return
+		}
+		if database == nil {
+			return
+		}
+		event.Project = common.FormatProject(database.ProjectID)
+		event.Resource = common.FormatDatabase(database.InstanceID, database.DatabaseName)
+		event.Title = database.DatabaseName
+	}
+	b.Publish(ctx, event)
+}
+
+func (b *Broker) queueEventBusEvent(ctx context.Context, event *v1pb.WorkspaceEvent) error {
+	setting, err := b.store.GetEventBusSetting(ctx)
+	if err != nil {
+		return err
+	}
+	if len(setting.Destinations) == 0 {
+		return nil
+	}
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	var creates []*store.EventOutboxMessage
+	for _, destination := range setting.Destinations {
+		creates = append(creates, &store.EventOutboxMessage{
+			Destination: destination.Id,
+			Payload:     payload,
+		})
+	}
+	return b.store.CreateEventOutboxes(ctx, creates)
+}
@@ -0,0 +1,127 @@
+package iam
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// PermissionGroup is the group of the permissions on the same resource, e.g. "databases".
+type PermissionGroup struct {
+	Resource    string
+	Permissions []Permission
+}
+
+// ListPermissionGroups returns all permissions grouped by the resource, sorted by the resource and permission.
+func ListPermissionGroups() []*PermissionGroup {
+	groupMap := make(map[string]*PermissionGroup)
+	var groups []*PermissionGroup
+	for _, p := range allPermissions {
+		resource := getPermissionResource(p)
+		group, ok := groupMap[resource]
+		if !ok {
+			group = &PermissionGroup{Resource: resource}
+			groupMap[resource] = group
+			groups = append(groups, group)
+		}
+		group.Permissions = append(group.Permissions, p)
+	}
+	slices.SortFunc(groups, func(a, b *PermissionGroup) int {
+		return strings.Compare(a.Resource, b.Resource)
+	})
+	for _, group := range groups {
+		slices.Sort(group.Permissions)
+	}
+	return groups
+}
+
+// GetImpliedPermissions returns the permissions implied by the given permission.
+// Every action on a resource requires reading the resource, so it implies the "get"
+// permission of the resource, or the "list" permission if the resource has no "get".
+func GetImpliedPermissions(p Permission) []Permission {
+	resource := getPermissionResource(p)
+	action := strings.TrimPrefix(p, "bb."+resource+".")
+	if action == "get" || action == "list" {
+		return nil
+	}
+	for _, implied := range []Permission{"bb." + resource + ".get", "bb." + resource + ".list"} {
+		if allPermissionsMap[implied] {
+			return []Permission{implied}
+		}
+	}
+	return nil
+}
+
+// ResolvePermissions validates the permissions of a custom role and returns the effective permissions.
+// The permission could be a wildcard on the resource, e.g. "bb.issues.*". The implied permissions are
+// added before removing the excluded permissions, so the excluded permissions take precedence.
+func ResolvePermissions(permissions []string, excludedPermissions []string) (map[Permission]bool, error) {
+	if len(permissions) == 0 {
+		return nil, errors.Errorf("permissions are required")
+	}
+
+	result := make(map[Permission]bool)
+	for _, p := range permissions {
+		matches, err := matchPermissions(p)
+		if err != nil {
+			return nil, err
+		}
+		for _, match := range matches {
+			result[match] = true
+			for _, implied := range GetImpliedPermissions(match) {
+				result[implied] = true
+			}
+		}
+	}
+	for _, p := range excludedPermissions {
+		matches, err := matchPermissions(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid excluded permission")
+		}
+		excluded := false
+		for _, match := range matches {
+			if result[match] {
+				delete(result, match)
+				excluded = true
+			}
+		}
+		if !excluded {
+			return nil, errors.Errorf("excluded permission %q is not granted by the permissions", p)
+		}
+	}
+	if len(result) == 0 {
+		return nil, errors.Errorf("all permissions are excluded")
+	}
+	return result, nil
+}
+
+// matchPermissions returns the permissions matching the permission or the wildcard, e.g. "bb.issues.*".
+func matchPermissions(p string) ([]Permission, error) {
+	prefix, ok := strings.CutSuffix(p, ".*")
+	if !ok {
+		if !allPermissionsMap[p] {
+			return nil, errors.Errorf("permission %q does not exist", p)
+		}
+		return []Permission{p}, nil
+	}
+	var matches []Permission
+	for _, permission := range allPermissions {
+		if strings.HasPrefix(permission, prefix+".") {
+			matches = append(matches, permission)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, errors.Errorf("permission %q does not match any permission", p)
+	}
+	return matches, nil
+}
+
+// getPermissionResource returns the resource of the permission, e.g. "databases" for "bb.databases.query".
+func getPermissionResource(p Permission) string {
+	parts := strings.Split(p, ".")
+	if len(parts) != 3 {
+		return p
+	}
+	return parts[1]
+}
@@ -0,0 +1,87 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPermissionGroups(t *testing.T) {
+	a := require.New(t)
+
+	count := 0
+	for _, group := range ListPermissionGroups() {
+		a.NotEmpty(group.Resource)
+		for _, p := range group.Permissions {
+			a.Equal(group.Resource, getPermissionResource(p))
+		}
+		count += len(group.Permissions)
+	}
+	a.Equal(len(allPermissions), count)
+}
+
+func TestGetImpliedPermissions(t *testing.T) {
+	a := require.New(t)
+
+	a.Equal([]Permission{PermissionDatabasesGet}, GetImpliedPermissions(PermissionDatabasesQuery))
+	a.Equal([]Permission{PermissionRisksList}, GetImpliedPermissions(PermissionRisksUpdate))
+	a.Empty(GetImpliedPermissions(PermissionDatabasesGet))
+	a.Empty(GetImpliedPermissions(PermissionDatabasesList))
+}
+
+func TestResolvePermissions(t *testing.T) {
+	a := require.New(t)
+
+	permissions, err := ResolvePermissions([]string{PermissionIssuesUpdate}, nil)
+	a.NoError(err)
+	a.Equal(map[Permission]bool{PermissionIssuesUpdate: true, PermissionIssuesGet: true}, permissions)
+
+	permissions, err = ResolvePermissions([]string{"bb.rollouts.*", PermissionIssuesUpdate}, []string{PermissionRolloutsCreate})
+	a.NoError(err)
+	a.Equal(map[Permission]bool{
+		PermissionIssuesUpdate:    true,
+		PermissionIssuesGet:       true,
+		PermissionRolloutsGet:     true,
+		PermissionRolloutsPreview: true,
+	}, permissions)
+
+	_, err = ResolvePermissions(nil, nil)
+	a.Error(err)
+	_, err = ResolvePermissions([]string{"bb.unknown.get"}, nil)
+	a.Error(err)
+	_, err = ResolvePermissions([]string{"bb.unknown.*"}, nil)
+	a.Error(err)
+	_, err = ResolvePermissions([]string{PermissionIssuesGet}, []string{PermissionIssuesUpdate})
+	a.Error(err)
+	_, err = ResolvePermissions([]string{PermissionIssuesGet}, []string{PermissionIssuesGet})
+	a.Error(err)
+}
+
+func TestGetScopePermissions(t *testing.T) {
+	a := require.New(t)
+
+	permissions, err := GetScopePermissions([]string{"issues.read-only", "sql-review.check"})
+	a.NoError(err)
+	a.Equal(map[Permission]bool{
+		PermissionIssuesGet:      true,
+		PermissionIssuesList:     true,
+		PermissionDatabasesCheck: true,
+		PermissionDatabasesGet:   true,
+		PermissionDatabasesList:  true,
+	}, permissions)
+
+	permissions, err = GetScopePermissions([]string{"rollouts.read-write"})
+	a.NoError(err)
+	a.True(permissions[PermissionRolloutsCreate])
+	a.False(permissions[PermissionIssuesGet])
+
+	for _, scope := range ListTokenScopes() {
+		_, err := GetScopePermissions([]string{scope})
+		a.NoError(err, scope)
+	}
+
+	_, err = GetScopePermissions([]string{"issues"})
+	a.Error(err)
+	_, err = GetScopePermissions([]string{"unknown.read-only"})
+	a.Error(err)
+}
@@ -52,6 +52,10 @@ func NewManager(store *store.Store, licenseService enterprise.LicenseService) (*
 // Check if the user has permission on the resource hierarchy.
 // When multiple projects are specified, the user should have permission on every projects.
 func (m *Manager) CheckPermission(ctx context.Context, p Permission, user *store.UserMessage, projectIDs ...string) (bool, error) {
+	// The request authenticated by a scoped token cannot exceed the scopes.
+	if tokenPermissions, ok := common.GetTokenPermissionsFromContext(ctx); ok && !tokenPermissions[p] {
+		return false, nil
+	}
 	if m.licenseService.IsFeatureEnabled(api.FeatureRBAC) != nil {
 		// nolint
 		return true, nil
@@ -1,12 +1,16 @@
 package iam
 
 import (
+	"context"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/bytebase/bytebase/backend/common"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -103,3 +107,37 @@ func TestCheck(t *testing.T) {
 		}
 	}
 }
+
+// rbacDisabledLicenseService disables the RBAC feature so that the permission check only depends on the token scopes.
+type rbacDisabledLicenseService struct {
+	enterprise.LicenseService
+}
+
+func (*rbacDisabledLicenseService) IsFeatureEnabled(feature api.FeatureType) error {
+	return errors.Errorf("feature %s is disabled", feature)
+}
+
+func TestCheckPermissionWithTokenScopes(t *testing.T) {
+	a := require.New(t)
+	m := &Manager{licenseService: &rbacDisabledLicenseService{}}
+	user := &store.UserMessage{ID: 123}
+
+	tokenPermissions, err := GetScopePermissions([]string{"issues.read-only"})
+	a.NoError(err)
+	ctx := context.WithValue(context.Background(), common.TokenPermissionsContextKey, tokenPermissions)
+
+	ok, err := m.CheckPermission(ctx, PermissionIssuesGet, user)
+	a.NoError(err)
+	a.True(ok)
+	ok, err = m.CheckPermission(ctx, PermissionIssuesUpdate, user)
+	a.NoError(err)
+	a.False(ok)
+	ok, err = m.CheckPermission(ctx, PermissionInstancesCreate, user)
+	a.NoError(err)
+	a.False(ok)
+
+	// The request without the scoped token is not limited by the scopes.
+	ok, err = m.CheckPermission(context.Background(), PermissionInstancesCreate, user)
+	a.NoError(err)
+	a.True(ok)
+}
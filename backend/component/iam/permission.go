@@ -29,6 +29,7 @@ const (
 	PermissionDatabasesGetSchema         Permission = "bb.databases.getSchema"
 	PermissionDatabasesList              Permission = "bb.databases.list"
 	PermissionDatabasesQuery             Permission = "bb.databases.query"
+	PermissionDatabasesQueryPrimary      Permission = "bb.databases.queryPrimary"
 	PermissionDatabasesSync              Permission = "bb.databases.sync"
 	PermissionDatabasesUpdate            Permission = "bb.databases.update"
 	PermissionEnvironmentsCreate         Permission = "bb.environments.create"
@@ -46,16 +47,20 @@ const (
 	PermissionInstancesCreate            Permission = "bb.instances.create"
 	PermissionInstancesDelete            Permission = "bb.instances.delete"
 	PermissionInstancesGet               Permission = "bb.instances.get"
+	PermissionInstancesKillQuery         Permission = "bb.instances.killQuery"
 	PermissionInstancesList              Permission = "bb.instances.list"
 	PermissionInstancesSync              Permission = "bb.instances.sync"
 	PermissionInstancesUndelete          Permission = "bb.instances.undelete"
 	PermissionInstancesUpdate            Permission = "bb.instances.update"
 	PermissionIssueCommentsCreate        Permission = "bb.issueComments.create"
+	PermissionIssueCommentsDelete        Permission = "bb.issueComments.delete"
 	PermissionIssueCommentsList          Permission = "bb.issueComments.list"
+	PermissionIssueCommentsListRevisions Permission = "bb.issueComments.listRevisions"
 	PermissionIssueCommentsUpdate        Permission = "bb.issueComments.update"
 	PermissionIssuesCreate               Permission = "bb.issues.create"
 	PermissionIssuesGet                  Permission = "bb.issues.get"
 	PermissionIssuesList                 Permission = "bb.issues.list"
+	PermissionIssuesPurge                Permission = "bb.issues.purge"
 	PermissionIssuesUpdate               Permission = "bb.issues.update"
 	PermissionPlanCheckRunsList          Permission = "bb.planCheckRuns.list"
 	PermissionPlanCheckRunsRun           Permission = "bb.planCheckRuns.run"
@@ -76,6 +81,11 @@ const (
 	PermissionProjectsSetIAMPolicy       Permission = "bb.projects.setIamPolicy"
 	PermissionProjectsUndelete           Permission = "bb.projects.undelete"
 	PermissionProjectsUpdate             Permission = "bb.projects.update"
+	PermissionReleasesCreate             Permission = "bb.releases.create"
+	PermissionReleasesDelete             Permission = "bb.releases.delete"
+	PermissionReleasesGet                Permission = "bb.releases.get"
+	PermissionReleasesList               Permission = "bb.releases.list"
+	PermissionReleasesUpdate             Permission = "bb.releases.update"
 	PermissionReviewConfigsCreate        Permission = "bb.reviewConfigs.create"
 	PermissionReviewConfigsDelete        Permission = "bb.reviewConfigs.delete"
 	PermissionReviewConfigsGet           Permission = "bb.reviewConfigs.get"
@@ -153,6 +163,7 @@ var allPermissions = []Permission{
 	PermissionDatabasesGetSchema,
 	PermissionDatabasesList,
 	PermissionDatabasesQuery,
+	PermissionDatabasesQueryPrimary,
 	PermissionDatabasesSync,
 	PermissionDatabasesUpdate,
 	PermissionEnvironmentsCreate,
@@ -170,6 +181,7 @@ var allPermissions = []Permission{
 	PermissionInstancesCreate,
 	PermissionInstancesDelete,
 	PermissionInstancesGet,
+	PermissionInstancesKillQuery,
 	PermissionInstancesList,
 	PermissionInstancesSync,
 	PermissionInstancesUndelete,
@@ -180,6 +192,7 @@ var allPermissions = []Permission{
 	PermissionIssuesCreate,
 	PermissionIssuesGet,
 	PermissionIssuesList,
+	PermissionIssuesPurge,
 	PermissionIssuesUpdate,
 	PermissionPlanCheckRunsList,
 	PermissionPlanCheckRunsRun,
@@ -200,6 +213,11 @@ var allPermissions = []Permission{
 	PermissionProjectsSetIAMPolicy,
 	PermissionProjectsUndelete,
 	PermissionProjectsUpdate,
+	PermissionReleasesCreate,
+	PermissionReleasesDelete,
+	PermissionReleasesGet,
+	PermissionReleasesList,
+	PermissionReleasesUpdate,
 	PermissionReviewConfigsCreate,
 	PermissionReviewConfigsDelete,
 	PermissionReviewConfigsGet,
@@ -0,0 +1,84 @@
+package iam
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// scopeReadOnlySuffix grants the get and list permissions of a resource, e.g. issues.read-only.
+	scopeReadOnlySuffix = ".read-only"
+	// scopeReadWriteSuffix grants all permissions of a resource, e.g. issues.read-write.
+	scopeReadWriteSuffix = ".read-write"
+)
+
+// extraScopes are the scopes for the common CI usages which don't fit in a single resource.
+var extraScopes = map[string][]Permission{
+	"sql-review.check": {PermissionDatabasesCheck, PermissionDatabasesGet, PermissionDatabasesList},
+}
+
+// ListTokenScopes returns all scopes of the service account tokens, sorted by the name.
+func ListTokenScopes() []string {
+	var scopes []string
+	for _, group := range ListPermissionGroups() {
+		scopes = append(scopes, group.Resource+scopeReadOnlySuffix, group.Resource+scopeReadWriteSuffix)
+	}
+	for scope := range extraScopes {
+		scopes = append(scopes, scope)
+	}
+	slices.Sort(scopes)
+	return scopes
+}
+
+// GetScopePermissions returns the permissions granted by the scopes.
+func GetScopePermissions(scopes []string) (map[Permission]bool, error) {
+	result := make(map[Permission]bool)
+	for _, scope := range scopes {
+		if permissions, ok := extraScopes[scope]; ok {
+			for _, p := range permissions {
+				result[p] = true
+			}
+			continue
+		}
+
+		readOnly := false
+		resource, ok := strings.CutSuffix(scope, scopeReadWriteSuffix)
+		if !ok {
+			resource, ok = strings.CutSuffix(scope, scopeReadOnlySuffix)
+			if !ok {
+				return nil, errors.Errorf("invalid scope %q", scope)
+			}
+			readOnly = true
+		}
+		matches, err := matchPermissions("bb." + resource + ".*")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid scope %q", scope)
+		}
+		for _, p := range matches {
+			if readOnly && p != "bb."+resource+".get" && p != "bb."+resource+".list" {
+				continue
+			}
+			result[p] = true
+		}
+	}
+	return result, nil
+}
+
+// GetExceededScopePermissions returns the sorted permissions granted by the scopes but not in the allowed permissions.
+// A scoped token must not create another token with the permissions beyond its own scopes.
+func GetExceededScopePermissions(scopes []string, allowed map[Permission]bool) ([]Permission, error) {
+	permissions, err := GetScopePermissions(scopes)
+	if err != nil {
+		return nil, err
+	}
+	var exceeded []Permission
+	for p := range permissions {
+		if !allowed[p] {
+			exceeded = append(exceeded, p)
+		}
+	}
+	slices.Sort(exceeded)
+	return exceeded, nil
+}
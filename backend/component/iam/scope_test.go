@@ -0,0 +1,32 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExceededScopePermissions(t *testing.T) {
+	a := require.New(t)
+
+	tokenPermissions, err := GetScopePermissions([]string{"issues.read-write", "plans.read-only"})
+	a.NoError(err)
+
+	exceeded, err := GetExceededScopePermissions([]string{"issues.read-only", "plans.read-only"}, tokenPermissions)
+	a.NoError(err)
+	a.Empty(exceeded)
+
+	// A scoped token cannot create a token with more permissions than its own scopes.
+	exceeded, err = GetExceededScopePermissions([]string{"plans.read-write"}, tokenPermissions)
+	a.NoError(err)
+	a.Contains(exceeded, PermissionPlansCreate)
+	a.Contains(exceeded, PermissionPlansUpdate)
+	a.NotContains(exceeded, PermissionPlansGet)
+
+	exceeded, err = GetExceededScopePermissions([]string{"sql-review.check"}, tokenPermissions)
+	a.NoError(err)
+	a.Equal([]Permission{PermissionDatabasesCheck, PermissionDatabasesGet, PermissionDatabasesList}, exceeded)
+
+	_, err = GetExceededScopePermissions([]string{"unknown.read-write"}, tokenPermissions)
+	a.Error(err)
+}
@@ -0,0 +1,280 @@
+package masker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"regexp"
+	"strconv"
+	"time"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// fpeRounds is the number of Feistel rounds, the same as FF1.
+const fpeRounds = 10
+
+// getStringValue returns the string representation of the data, the second return value is false for the
+// null and bool values which cannot be masked by the algorithms preserving the value.
+func getStringValue(data *v1pb.RowValue) (string, bool) {
+	switch kind := data.Kind.(type) {
+	case *v1pb.RowValue_BytesValue:
+		return string(kind.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(kind.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(kind.FloatValue), 'f', -1, 64), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(kind.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(kind.Int64Value, 10), true
+	case *v1pb.RowValue_StringValue:
+		return kind.StringValue, true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(kind.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(kind.Uint64Value, 10), true
+	}
+	return "", false
+}
+
+// maskStringValue masks the data with f, the null and bool values are fully masked.
+func maskStringValue(m Masker, data *MaskData, f func(string) string) *v1pb.RowValue {
+	if kind, ok := data.Data.Kind.(*v1pb.RowValue_ValueValue); ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_ValueValue{
+				ValueValue: maskProtoValue(m, kind.ValueValue),
+			},
+		}
+	}
+	s, ok := getStringValue(data.Data)
+	if !ok {
+		return &v1pb.RowValue{
+			Kind: &v1pb.RowValue_StringValue{
+				StringValue: "******",
+			},
+		}
+	}
+	return &v1pb.RowValue{
+		Kind: &v1pb.RowValue_StringValue{
+			StringValue: f(s),
+		},
+	}
+}
+
+// HashMasker is the masker that masks the data with their salted SHA-256 hash.
+// The same data is always masked to the same hash so that the masked columns can be joined.
+type HashMasker struct {
+	salt string
+}
+
+// NewHashMasker returns a new HashMasker.
+func NewHashMasker(salt string) *HashMasker {
+	return &HashMasker{
+		salt: salt,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *HashMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return maskStringValue(m, data, func(s string) string {
+		h := hmac.New(sha256.New, []byte(m.salt))
+		_, _ = h.Write([]byte(s))
+		return hex.EncodeToString(h.Sum(nil))
+	})
+}
+
+// Equal implements Masker.Equal.
+func (m *HashMasker) Equal(other Masker) bool {
+	if otherHashMasker, ok := other.(*HashMasker); ok {
+		return m.salt == otherHashMasker.salt
+	}
+	return false
+}
+
+// fpeAlphabets are the character classes encrypted by the FormatPreservingEncryptionMasker,
+// each class is encrypted separately so that a digit is always encrypted to a digit and so on.
+var fpeAlphabets = []string{
+	"0123456789",
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+}
+
+// FormatPreservingEncryptionMasker is the masker that encrypts the digits and the letters of the data
+// with an FF1 style Feistel cipher. The length, the character classes and the other characters are preserved.
+type FormatPreservingEncryptionMasker struct {
+	key   string
+	tweak string
+}
+
+// NewFormatPreservingEncryptionMasker returns a new FormatPreservingEncryptionMasker.
+func NewFormatPreservingEncryptionMasker(key, tweak string) *FormatPreservingEncryptionMasker {
+	return &FormatPreservingEncryptionMasker{
+		key:   key,
+		tweak: tweak,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *FormatPreservingEncryptionMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return maskStringValue(m, data, m.encrypt)
+}
+
+// Equal implements Masker.Equal.
+func (m *FormatPreservingEncryptionMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*FormatPreservingEncryptionMasker); ok {
+		return m.key == otherMasker.key && m.tweak == otherMasker.tweak
+	}
+	return false
+}
+
+func (m *FormatPreservingEncryptionMasker) encrypt(s string) string {
+	runes := []rune(s)
+	for class, alphabet := range fpeAlphabets {
+		var positions []int
+		var numerals []int
+		for i, r := range runes {
+			if idx := indexOf(alphabet, r); idx >= 0 {
+				positions = append(positions, i)
+				numerals = append(numerals, idx)
+			}
+		}
+		if len(numerals) == 0 {
+			continue
+		}
+		encrypted := m.feistel(byte(class), numerals, len(alphabet))
+		for i, pos := range positions {
+			runes[pos] = rune(alphabet[encrypted[i]])
+		}
+	}
+	return string(runes)
+}
+
+// feistel encrypts the numeral string x in the radix with an unbalanced Feistel network,
+// the round function is the HMAC-SHA256 of the key, the tweak, the round and the other half.
+func (m *FormatPreservingEncryptionMasker) feistel(class byte, x []int, radix int) []int {
+	n := len(x)
+	if n == 1 {
+		y := m.round(class, 0, n, nil, 1)
+		return []int{int((int64(x[0]) + new(big.Int).Mod(y, big.NewInt(int64(radix))).Int64()) % int64(radix))}
+	}
+
+	a, b := append([]int{}, x[:n/2]...), append([]int{}, x[n/2:]...)
+	for i := 0; i < fpeRounds; i++ {
+		size := len(a)
+		modulus := new(big.Int).Exp(big.NewInt(int64(radix)), big.NewInt(int64(size)), nil)
+		c := m.round(class, i, n, b, (modulus.BitLen()+7)/8+8)
+		c.Add(c, toNumber(a, radix))
+		c.Mod(c, modulus)
+		a, b = b, toNumerals(c, radix, size)
+	}
+	return append(a, b...)
+}
+
+// round returns the round function output of at least size bytes as a number.
+func (m *FormatPreservingEncryptionMasker) round(class byte, i, n int, b []int, size int) *big.Int {
+	var message []byte
+	message = append(message, []byte(m.tweak)...)
+	message = append(message, 0, class, byte(i))
+	message = binary.BigEndian.AppendUint32(message, uint32(n))
+	for _, v := range b {
+		message = binary.BigEndian.AppendUint16(message, uint16(v))
+	}
+
+	var output []byte
+	for counter := uint32(0); len(output) < size; counter++ {
+		h := hmac.New(sha256.New, []byte(m.key))
+		_, _ = h.Write(binary.BigEndian.AppendUint32(nil, counter))
+		_, _ = h.Write(message)
+		output = h.Sum(output)
+	}
+	return new(big.Int).SetBytes(output)
+}
+
+func indexOf(alphabet string, r rune) int {
+	for i, c := range alphabet {
+		if c == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func toNumber(x []int, radix int) *big.Int {
+	result := new(big.Int)
+	bigRadix := big.NewInt(int64(radix))
+	for _, v := range x {
+		result.Mul(result, bigRadix)
+		result.Add(result, big.NewInt(int64(v)))
+	}
+	return result
+}
+
+func toNumerals(v *big.Int, radix int, size int) []int {
+	result := make([]int, size)
+	bigRadix := big.NewInt(int64(radix))
+	v = new(big.Int).Set(v)
+	mod := new(big.Int)
+	for i := size - 1; i >= 0; i-- {
+		v.DivMod(v, bigRadix, mod)
+		result[i] = int(mod.Int64())
+	}
+	return result
+}
+
+var datePrefixRegexp = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})`)
+
+// DateShiftMasker is the masker that shifts the dates and the timestamps by a fixed number of days derived from the salt.
+// Only the date part is changed, so the time, the fraction and the time zone are kept as is.
+// The data not starting with a date is fully masked.
+type DateShiftMasker struct {
+	days int
+}
+
+// NewDateShiftMasker returns a new DateShiftMasker.
+func NewDateShiftMasker(maxShiftDays int32, salt string) *DateShiftMasker {
+	if maxShiftDays <= 0 {
+		return &DateShiftMasker{}
+	}
+	h := hmac.New(sha256.New, []byte(salt))
+	_, _ = h.Write([]byte("date-shift"))
+	sum := h.Sum(nil)
+	span := uint64(maxShiftDays)
+	days := int(binary.BigEndian.Uint64(sum[:8])%(2*span+1)) - int(maxShiftDays)
+	// Never keep the original dates.
+	if days == 0 {
+		days = int(maxShiftDays)
+	}
+	return &DateShiftMasker{
+		days: days,
+	}
+}
+
+// Mask implements Masker.Mask.
+func (m *DateShiftMasker) Mask(data *MaskData) *v1pb.RowValue {
+	return maskStringValue(m, data, func(s string) string {
+		prefix := datePrefixRegexp.FindString(s)
+		if prefix == "" {
+			return "******"
+		}
+		date, err := time.Parse(time.DateOnly, prefix)
+		if err != nil {
+			return "******"
+		}
+		return date.AddDate(0, 0, m.days).Format(time.DateOnly) + s[len(prefix):]
+	})
+}
+
+// Equal implements Masker.Equal.
+func (m *DateShiftMasker) Equal(other Masker) bool {
+	if otherMasker, ok := other.(*DateShiftMasker); ok {
+		return m.days == otherMasker.days
+	}
+	return false
+}
+
+var _ Masker = (*HashMasker)(nil)
+var _ Masker = (*FormatPreservingEncryptionMasker)(nil)
+var _ Masker = (*DateShiftMasker)(nil)
@@ -0,0 +1,124 @@
+package masterkey
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+// awsKMS calls the AWS KMS JSON API with the default credentials (environment).
+// ref: https://docs.aws.amazon.com/kms/latest/APIReference/Welcome.html
+type awsKMS struct {
+	keyID       string
+	region      string
+	credentials aws.CredentialsProvider
+	client      *http.Client
+}
+
+func newAWSKMS(ctx context.Context, uri string) (*awsKMS, error) {
+	keyID, rawQuery, _ := strings.Cut(uri, "?")
+	if keyID == "" {
+		return nil, errors.Errorf("missing AWS KMS key id")
+	}
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse AWS KMS key URI")
+	}
+	region := query.Get("region")
+	// The key ARN looks like arn:aws:kms:<region>:<account>:key/<key-id>.
+	if parts := strings.Split(keyID, ":"); region == "" && len(parts) > 3 && parts[0] == "arn" {
+		region = parts[3]
+	}
+
+	var optFns []func(*config.LoadOptions) error
+	if region != "" {
+		optFns = append(optFns, config.WithRegion(region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to init aws config: %v", err.Error())
+	}
+	if cfg.Region == "" {
+		return nil, errors.Errorf("missing AWS region for KMS key %q", keyID)
+	}
+	return &awsKMS{
+		keyID:       keyID,
+		region:      cfg.Region,
+		credentials: cfg.Credentials,
+		client:      &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (k *awsKMS) Wrap(ctx context.Context, plaintext []byte) ([]byte, error) {
+	var resp struct {
+		CiphertextBlob []byte `json:"CiphertextBlob"`
+	}
+	if err := k.call(ctx, "Encrypt", map[string]any{"KeyId": k.keyID, "Plaintext": plaintext}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CiphertextBlob, nil
+}
+
+func (k *awsKMS) Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext []byte `json:"Plaintext"`
+	}
+	if err := k.call(ctx, "Decrypt", map[string]any{"KeyId": k.keyID, "CiphertextBlob": ciphertext}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Plaintext, nil
+}
+
+// call invokes the KMS action, the []byte fields are base64 encoded as required by the API.
+func (k *awsKMS) call(ctx context.Context, action string, input, output any) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal AWS KMS %s request", action)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("https://kms.%s.amazonaws.com/", k.region), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+
+	credentials, err := k.credentials.Retrieve(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve aws credentials")
+	}
+	payloadHash := sha256.Sum256(body)
+	if err := v4.NewSigner().SignHTTP(ctx, credentials, req, hex.EncodeToString(payloadHash[:]), "kms", k.region, time.Now()); err != nil {
+		return errors.Wrapf(err, "failed to sign AWS KMS request")
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call AWS KMS %s", action)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read AWS KMS %s response", action)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to call AWS KMS %s, status %d: %s", action, resp.StatusCode, string(respBody))
+	}
+	if err := json.Unmarshal(respBody, output); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal AWS KMS %s response", action)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package masterkey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2/google"
+)
+
+const gcpKMSScope = "https://www.googleapis.com/auth/cloudkms"
+
+// gcpKMS calls the Cloud KMS REST API with the default credentials.
+// ref: https://cloud.google.com/kms/docs/reference/rest
+type gcpKMS struct {
+	keyName string
+	client  *http.Client
+}
+
+func newGCPKMS(ctx context.Context, keyName string) (*gcpKMS, error) {
+	if keyName == "" {
+		return nil, errors.Errorf("missing GCP KMS key name")
+	}
+	// will find default credentials in GKE, fallback to GOOGLE_APPLICATION_CREDENTIALS envionment.
+	client, err := google.DefaultClient(ctx, gcpKMSScope)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get GCP credentials")
+	}
+	return &gcpKMS{
+		keyName: keyName,
+		client:  client,
+	}, nil
+}
+
+func (k *gcpKMS) Wrap(ctx context.Context, plaintext []byte) ([]byte, error) {
+	var resp struct {
+		Ciphertext []byte `json:"ciphertext"`
+	}
+	if err := k.call(ctx, "encrypt", map[string]any{"plaintext": plaintext}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Ciphertext, nil
+}
+
+func (k *gcpKMS) Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext []byte `json:"plaintext"`
+	}
+	if err := k.call(ctx, "decrypt", map[string]any{"ciphertext": ciphertext}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Plaintext, nil
+}
+
+// call invokes the KMS method, the []byte fields are base64 encoded as required by the API.
+func (k *gcpKMS) call(ctx context.Context, method string, input, output any) error {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal GCP KMS %s request", method)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("https://cloudkms.googleapis.com/v1/%s:%s", k.keyName, method), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call GCP KMS %s", method)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read GCP KMS %s response", method)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to call GCP KMS %s, status %d: %s", method, resp.StatusCode, string(respBody))
+	}
+	if err := json.Unmarshal(respBody, output); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal GCP KMS %s response", method)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package masterkey
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	awsKMSScheme  = "awskms://"
+	gcpKMSScheme  = "gcpkms://"
+	fileKeyScheme = "file://"
+)
+
+// KMS wraps and unwraps the data encryption keys with the master key.
+type KMS interface {
+	Wrap(ctx context.Context, plaintext []byte) ([]byte, error)
+	Unwrap(ctx context.Context, ciphertext []byte) ([]byte, error)
+}
+
+// NewKMS returns the KMS for the master key URI.
+//
+// The supported URIs are:
+//   - awskms://<key-id, key ARN or alias>[?region=<region>]
+//   - gcpkms://projects/<project>/locations/<location>/keyRings/<key-ring>/cryptoKeys/<key>
+//   - file://<path to a file containing a base64 encoded 256-bit key>
+func NewKMS(ctx context.Context, uri string) (KMS, error) {
+	switch {
+	case strings.HasPrefix(uri, awsKMSScheme):
+		return newAWSKMS(ctx, strings.TrimPrefix(uri, awsKMSScheme))
+	case strings.HasPrefix(uri, gcpKMSScheme):
+		return newGCPKMS(ctx, strings.TrimPrefix(uri, gcpKMSScheme))
+	case strings.HasPrefix(uri, fileKeyScheme):
+		return newFileKMS(strings.TrimPrefix(uri, fileKeyScheme))
+	default:
+		return nil, errors.Errorf("unsupported master key URI %q", uri)
+	}
+}
+
+// fileKMS uses a local key file as the master key.
+// It is meant for deployments without a cloud KMS, the key file should be mounted from a secret store.
+type fileKMS struct {
+	aead cipher.AEAD
+}
+
+func newFileKMS(path string) (*fileKMS, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read master key file %q", path)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to base64 decode master key file %q", path)
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &fileKMS{aead: aead}, nil
+}
+
+func (k *fileKMS) Wrap(_ context.Context, plaintext []byte) ([]byte, error) {
+	return seal(k.aead, plaintext)
+}
+
+func (k *fileKMS) Unwrap(_ context.Context, ciphertext []byte) ([]byte, error) {
+	return open(k.aead, ciphertext)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, errors.Errorf("invalid key size %d, expected 32 bytes", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext and prepends the random nonce to the ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(aead cipher.AEAD, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt")
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,311 @@
+// Package masterkey implements the envelope encryption for the secrets stored in the metadata database.
+//
+// The data source credentials are encrypted with a data encryption key (DEK), and the DEK is wrapped by
+// the workspace master key hosted in the KMS. Only the wrapped DEKs are persisted in the setting.
+package masterkey
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// encryptedPrefix is the prefix of the encrypted values, the format is bbenc:v1:<key id>:<base64 nonce and ciphertext>.
+// The values without the prefix are the legacy obfuscated values.
+const encryptedPrefix = "bbenc:v1:"
+
+// Manager manages the data encryption keys and implements store.SecretCipher.
+type Manager struct {
+	store        *store.Store
+	masterKeyURI string
+	kms          KMS
+
+	// rotateMu serializes the key rotations.
+	rotateMu sync.Mutex
+
+	mu      sync.RWMutex
+	setting *storepb.EncryptionKeySetting
+	keys    map[string]cipher.AEAD
+}
+
+// NewManager creates the manager and loads the data encryption keys.
+// A data encryption key is created on the first start, and the keys wrapped by a previous master key are re-wrapped by the current one.
+func NewManager(ctx context.Context, store *store.Store, masterKeyURI string) (*Manager, error) {
+	kms, err := NewKMS(ctx, masterKeyURI)
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{
+		store:        store,
+		masterKeyURI: masterKeyURI,
+		kms:          kms,
+		keys:         make(map[string]cipher.AEAD),
+	}
+	if err := m.load(ctx); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MasterKeyURI returns the URI of the master key.
+func (m *Manager) MasterKeyURI() string {
+	return m.masterKeyURI
+}
+
+// GetSetting returns a copy of the encryption key setting.
+func (m *Manager) GetSetting() *storepb.EncryptionKeySetting {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return proto.Clone(m.setting).(*storepb.EncryptionKeySetting)
+}
+
+// GetActiveKey returns the active key in the setting.
+func GetActiveKey(setting *storepb.EncryptionKeySetting) *storepb.EncryptionKeySetting_Key {
+	for _, key := range setting.GetKeys() {
+		if key.Id == setting.GetActiveKeyId() {
+			return key
+		}
+	}
+	return nil
+}
+
+// Encrypt encrypts the value with the active data encryption key.
+func (m *Manager) Encrypt(value string) (string, error) {
+	if value == "" || strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+	m.mu.RLock()
+	keyID := m.setting.ActiveKeyId
+	aead, ok := m.keys[keyID]
+	m.mu.RUnlock()
+	if !ok {
+		return "", errors.Errorf("active encryption key %q not found", keyID)
+	}
+	ciphertext, err := seal(aead, []byte(value))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%s:%s", encryptedPrefix, keyID, base64.StdEncoding.EncodeToString(ciphertext)), nil
+}
+
+// Decrypt decrypts the value encrypted by Encrypt. The legacy values are returned as is.
+func (m *Manager) Decrypt(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+	keyID, encoded, ok := strings.Cut(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if !ok {
+		return "", errors.Errorf("invalid encrypted value")
+	}
+	m.mu.RLock()
+	aead, ok := m.keys[keyID]
+	m.mu.RUnlock()
+	if !ok {
+		return "", errors.Errorf("encryption key %q not found", keyID)
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to base64 decode encrypted value")
+	}
+	plaintext, err := open(aead, ciphertext)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// Rotate creates a new data encryption key as the active key and re-encrypts the data source credentials with it.
+// The retired keys are kept so that the values which failed to be re-encrypted can still be decrypted.
+func (m *Manager) Rotate(ctx context.Context) error {
+	m.rotateMu.Lock()
+	defer m.rotateMu.Unlock()
+
+	m.mu.RLock()
+	setting := proto.Clone(m.setting).(*storepb.EncryptionKeySetting)
+	m.mu.RUnlock()
+
+	key, aead, err := m.newKey(ctx)
+	if err != nil {
+		return err
+	}
+	setting.Keys = append(setting.Keys, key)
+	setting.ActiveKeyId = key.Id
+	if err := m.saveSetting(ctx, setting); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.keys[key.Id] = aead
+	m.setting = setting
+	m.mu.Unlock()
+
+	return m.reencryptIfNeeded(ctx)
+}
+
+// ReencryptIfNeeded re-encrypts the data source credentials if they are not encrypted by the active key yet,
+// including the legacy obfuscated credentials before the envelope encryption is enabled.
+func (m *Manager) ReencryptIfNeeded(ctx context.Context) error {
+	m.rotateMu.Lock()
+	defer m.rotateMu.Unlock()
+	return m.reencryptIfNeeded(ctx)
+}
+
+func (m *Manager) reencryptIfNeeded(ctx context.Context) error {
+	m.mu.RLock()
+	setting := proto.Clone(m.setting).(*storepb.EncryptionKeySetting)
+	m.mu.RUnlock()
+	if setting.ReencryptedKeyId == setting.ActiveKeyId {
+		return nil
+	}
+
+	instances, err := m.store.ListInstancesV2(ctx, &store.FindInstanceMessage{ShowDeleted: true})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list instances")
+	}
+	count := 0
+	for _, instance := range instances {
+		for _, dataSource := range instance.DataSources {
+			// The store encrypts the credentials with the active key on update.
+			if err := m.store.UpdateDataSourceV2(ctx, &store.UpdateDataSourceMessage{
+				UpdaterID:                          api.SystemBotID,
+				InstanceUID:                        instance.UID,
+				InstanceID:                         instance.ResourceID,
+				DataSourceID:                       dataSource.ID,
+				ObfuscatedPassword:                 &dataSource.ObfuscatedPassword,
+				ObfuscatedSslCa:                    &dataSource.ObfuscatedSslCa,
+				ObfuscatedSslCert:                  &dataSource.ObfuscatedSslCert,
+				ObfuscatedSslKey:                   &dataSource.ObfuscatedSslKey,
+				SSHObfuscatedPassword:              &dataSource.SSHObfuscatedPassword,
+				SSHObfuscatedPrivateKey:            &dataSource.SSHObfuscatedPrivateKey,
+				AuthenticationPrivateKeyObfuscated: &dataSource.AuthenticationPrivateKeyObfuscated,
+				MasterObfuscatedPassword:           &dataSource.MasterObfuscatedPassword,
+			}); err != nil {
+				return errors.Wrapf(err, "failed to re-encrypt data source %q in instance %q", dataSource.ID, instance.ResourceID)
+			}
+			count++
+		}
+	}
+
+	setting.ReencryptedKeyId = setting.ActiveKeyId
+	if err := m.saveSetting(ctx, setting); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	m.setting.ReencryptedKeyId = setting.ReencryptedKeyId
+	m.mu.Unlock()
+	slog.Info(fmt.Sprintf("re-encrypted %d data sources with encryption key %q", count, setting.ActiveKeyId))
+	return nil
+}
+
+func (m *Manager) load(ctx context.Context) error {
+	settingName := api.SettingEncryptionKey
+	stored, err := m.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &settingName})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get setting %q", settingName)
+	}
+	setting := &storepb.EncryptionKeySetting{}
+	if stored != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(stored.Value), setting); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal setting %q", settingName)
+		}
+	}
+
+	changed := false
+	kmsMap := map[string]KMS{m.masterKeyURI: m.kms}
+	for _, key := range setting.Keys {
+		kms, ok := kmsMap[key.MasterKeyUri]
+		if !ok {
+			kms, err = NewKMS(ctx, key.MasterKeyUri)
+			if err != nil {
+				return errors.Wrapf(err, "failed to init the previous master key for encryption key %q", key.Id)
+			}
+			kmsMap[key.MasterKeyUri] = kms
+		}
+		dek, err := kms.Unwrap(ctx, key.WrappedKey)
+		if err != nil {
+			return errors.Wrapf(err, "failed to unwrap encryption key %q", key.Id)
+		}
+		aead, err := newAEAD(dek)
+		if err != nil {
+			return err
+		}
+		if key.MasterKeyUri != m.masterKeyURI {
+			// The master key is changed, re-wrap the data encryption key with the current master key.
+			wrapped, err := m.kms.Wrap(ctx, dek)
+			if err != nil {
+				return errors.Wrapf(err, "failed to wrap encryption key %q", key.Id)
+			}
+			key.WrappedKey = wrapped
+			key.MasterKeyUri = m.masterKeyURI
+			changed = true
+		}
+		m.keys[key.Id] = aead
+	}
+	if _, ok := m.keys[setting.ActiveKeyId]; !ok {
+		key, aead, err := m.newKey(ctx)
+		if err != nil {
+			return err
+		}
+		setting.Keys = append(setting.Keys, key)
+		setting.ActiveKeyId = key.Id
+		m.keys[key.Id] = aead
+		changed = true
+	}
+	if changed {
+		if err := m.saveSetting(ctx, setting); err != nil {
+			return err
+		}
+	}
+	m.setting = setting
+	return nil
+}
+
+func (m *Manager) newKey(ctx context.Context) (*storepb.EncryptionKeySetting_Key, cipher.AEAD, error) {
+	dek := make([]byte, 32)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to generate encryption key")
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, nil, err
+	}
+	wrapped, err := m.kms.Wrap(ctx, dek)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to wrap encryption key")
+	}
+	return &storepb.EncryptionKeySetting_Key{
+		Id:           uuid.NewString(),
+		WrappedKey:   wrapped,
+		MasterKeyUri: m.masterKeyURI,
+		CreateTime:   timestamppb.Now(),
+	}, aead, nil
+}
+
+func (m *Manager) saveSetting(ctx context.Context, setting *storepb.EncryptionKeySetting) error {
+	bytes, err := protojson.Marshal(setting)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal setting %q", api.SettingEncryptionKey)
+	}
+	if _, err := m.store.UpsertSettingV2(ctx, &store.SetSettingMessage{
+		Name:  api.SettingEncryptionKey,
+		Value: string(bytes),
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to save setting %q", api.SettingEncryptionKey)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package masterkey
+
+import (
+	"context"
+	"crypto/cipher"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	a := require.New(t)
+
+	keyPath := filepath.Join(t.TempDir(), "master.key")
+	a.NoError(os.WriteFile(keyPath, []byte(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))), 0600))
+	kms, err := NewKMS(context.Background(), "file://"+keyPath)
+	a.NoError(err)
+
+	m := &Manager{
+		masterKeyURI: "file://" + keyPath,
+		kms:          kms,
+		keys:         make(map[string]cipher.AEAD),
+		setting:      &storepb.EncryptionKeySetting{},
+	}
+	for _, id := range []string{"old", "new"} {
+		key, aead, err := m.newKey(context.Background())
+		a.NoError(err)
+		key.Id = id
+		m.keys[id] = aead
+		m.setting.Keys = append(m.setting.Keys, key)
+	}
+
+	m.setting.ActiveKeyId = "old"
+	oldEncrypted, err := m.Encrypt("secret")
+	a.NoError(err)
+	a.True(strings.HasPrefix(oldEncrypted, encryptedPrefix+"old:"))
+
+	m.setting.ActiveKeyId = "new"
+	newEncrypted, err := m.Encrypt("secret")
+	a.NoError(err)
+	a.True(strings.HasPrefix(newEncrypted, encryptedPrefix+"new:"))
+
+	for _, encrypted := range []string{oldEncrypted, newEncrypted} {
+		decrypted, err := m.Decrypt(encrypted)
+		a.NoError(err)
+		a.Equal("secret", decrypted)
+	}
+
+	// The empty and legacy obfuscated values are kept as is.
+	for _, value := range []string{"", "bGVnYWN5"} {
+		decrypted, err := m.Decrypt(value)
+		a.NoError(err)
+		a.Equal(value, decrypted)
+	}
+	encrypted, err := m.Encrypt("")
+	a.NoError(err)
+	a.Equal("", encrypted)
+
+	_, err = m.Decrypt(encryptedPrefix + "unknown:" + base64.StdEncoding.EncodeToString([]byte("x")))
+	a.Error(err)
+}
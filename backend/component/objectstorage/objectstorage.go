@@ -0,0 +1,181 @@
+// Package objectstorage is the client of the S3-compatible object storage, e.g. S3, GCS and MinIO.
+package objectstorage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	gcsEndpoint = "https://storage.googleapis.com"
+	// defaultMinIORegion is the default region of MinIO, it's required to sign the requests.
+	defaultMinIORegion = "us-east-1"
+)
+
+// GetObjectURI returns the URI of the object, e.g. s3://bucket/prefix/instance/database/20240101T000000Z.sql.gz.
+func GetObjectURI(storage *storepb.BackupStorage, key string) string {
+	scheme := "s3"
+	if storage.GetType() == storepb.BackupStorage_GCS {
+		scheme = "gs"
+	}
+	return fmt.Sprintf("%s://%s/%s", scheme, storage.GetBucket(), key)
+}
+
+// ParseObjectURI parses the storage from the URI of the object prefix, e.g. s3://bucket/prefix?region=us-east-1 and gs://bucket/prefix.
+// The URI with the endpoint query, e.g. s3://bucket/prefix?endpoint=http://minio:9000, is the MinIO storage.
+// The storage is accessed with the default credential chain, e.g. AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, which hold the HMAC keys for GCS.
+func ParseObjectURI(uri string) (*storepb.BackupStorage, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid object storage URI %q", uri)
+	}
+	storage := &storepb.BackupStorage{
+		Bucket:   u.Host,
+		Prefix:   strings.Trim(u.Path, "/"),
+		Region:   u.Query().Get("region"),
+		Endpoint: u.Query().Get("endpoint"),
+	}
+	switch u.Scheme {
+	case "s3":
+		storage.Type = storepb.BackupStorage_S3
+		if storage.Endpoint != "" {
+			storage.Type = storepb.BackupStorage_MINIO
+		}
+	case "gs":
+		storage.Type = storepb.BackupStorage_GCS
+	default:
+		return nil, errors.Errorf("unsupported object storage scheme %q, must be s3 or gs", u.Scheme)
+	}
+	if storage.Bucket == "" {
+		return nil, errors.Errorf("missing bucket in the object storage URI %q", uri)
+	}
+	return storage, nil
+}
+
+// NewClient creates the client of the S3-compatible storage, GCS is accessed by its XML API with the HMAC keys.
+func NewClient(ctx context.Context, storage *storepb.BackupStorage, secret string) (*s3.Client, error) {
+	region, endpoint := storage.Region, storage.Endpoint
+	switch storage.Type {
+	case storepb.BackupStorage_GCS:
+		if endpoint == "" {
+			endpoint = gcsEndpoint
+		}
+		if region == "" {
+			region = "auto"
+		}
+	case storepb.BackupStorage_MINIO:
+		if region == "" {
+			region = defaultMinIORegion
+		}
+	}
+
+	var optFns []func(*config.LoadOptions) error
+	if region != "" {
+		optFns = append(optFns, config.WithRegion(region))
+	}
+	if storage.AccessKeyId != "" {
+		secretAccessKey, err := common.Unobfuscate(storage.ObfuscatedSecretAccessKey, secret)
+		if err != nil {
+			return nil, err
+		}
+		optFns = append(optFns, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(storage.AccessKeyId, secretAccessKey, "")))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, err
+	}
+	return s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		// MinIO doesn't support the virtual-hosted-style requests by default.
+		o.UsePathStyle = storage.Type == storepb.BackupStorage_MINIO
+	}), nil
+}
+
+// Upload uploads the body to the object, the body is uploaded in parts if it's large.
+func Upload(ctx context.Context, client *s3.Client, storage *storepb.BackupStorage, key string, body io.Reader) error {
+	_, err := manager.NewUploader(client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(storage.Bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	return err
+}
+
+// Download returns the body and the size of the object, the caller must close the body.
+func Download(ctx context.Context, storage *storepb.BackupStorage, key string, secret string) (io.ReadCloser, int64, error) {
+	client, err := NewClient(ctx, storage, secret)
+	if err != nil {
+		return nil, 0, err
+	}
+	output, err := client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(storage.Bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return output.Body, aws.ToInt64(output.ContentLength), nil
+}
+
+// Object is the object in the storage.
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// List lists the objects with the key prefix.
+func List(ctx context.Context, storage *storepb.BackupStorage, prefix string, secret string) ([]*Object, error) {
+	client, err := NewClient(ctx, storage, secret)
+	if err != nil {
+		return nil, err
+	}
+	var objects []*Object
+	paginator := s3.NewListObjectsV2Paginator(client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(storage.Bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range page.Contents {
+			objects = append(objects, &Object{
+				Key:          aws.ToString(object.Key),
+				Size:         aws.ToInt64(object.Size),
+				LastModified: aws.ToTime(object.LastModified),
+			})
+		}
+	}
+	return objects, nil
+}
+
+// Delete deletes the object.
+func Delete(ctx context.Context, storage *storepb.BackupStorage, key string, secret string) error {
+	client, err := NewClient(ctx, storage, secret)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(storage.Bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
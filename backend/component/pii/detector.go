@@ -0,0 +1,123 @@
+// Package pii detects the personally identifiable information in column names and values.
+package pii
+
+import (
+	"regexp"
+	"strings"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// MatchRatioThreshold is the minimal ratio of the sampled values matching a label to suggest the label.
+const MatchRatioThreshold = 0.8
+
+type rule struct {
+	label storepb.ClassificationSuggestionPayload_Label
+	name  *regexp.Regexp
+	value *regexp.Regexp
+}
+
+// The national ID rule goes before the phone rule because the national IDs look like phone numbers.
+var rules = []rule{
+	{
+		label: storepb.ClassificationSuggestionPayload_EMAIL,
+		name:  regexp.MustCompile(`(?i)e[-_]?mail`),
+		value: regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[A-Za-z]{2,}$`),
+	},
+	{
+		label: storepb.ClassificationSuggestionPayload_NATIONAL_ID,
+		name:  regexp.MustCompile(`(?i)((^|_)ssn($|_)|social_?security|national_?id|id_?card|id_?number|passport|tax_?id)`),
+		// US social security number and China resident identity card number.
+		value: regexp.MustCompile(`^(\d{3}-\d{2}-\d{4}|\d{17}[\dXx])$`),
+	},
+	{
+		label: storepb.ClassificationSuggestionPayload_PHONE,
+		name:  regexp.MustCompile(`(?i)(phone|mobile|telephone|(^|_)tel($|_))`),
+		value: regexp.MustCompile(`^\+?[\d\s\-().]{7,20}$`),
+	},
+}
+
+// DetectByName returns the label matching the column name, or LABEL_UNSPECIFIED if none matches.
+func DetectByName(column string) storepb.ClassificationSuggestionPayload_Label {
+	for _, r := range rules {
+		if r.name.MatchString(column) {
+			return r.label
+		}
+	}
+	return storepb.ClassificationSuggestionPayload_LABEL_UNSPECIFIED
+}
+
+// DetectByValues returns the label matched by the most sampled values and the match ratio.
+// The empty values are ignored.
+func DetectByValues(values []string) (storepb.ClassificationSuggestionPayload_Label, float64) {
+	counts := map[storepb.ClassificationSuggestionPayload_Label]int{}
+	total := 0
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		total++
+		for _, r := range rules {
+			if r.value.MatchString(v) && (r.label != storepb.ClassificationSuggestionPayload_PHONE || countDigits(v) >= 7) {
+				counts[r.label]++
+				break
+			}
+		}
+	}
+	if total == 0 {
+		return storepb.ClassificationSuggestionPayload_LABEL_UNSPECIFIED, 0
+	}
+
+	label, count := storepb.ClassificationSuggestionPayload_LABEL_UNSPECIFIED, 0
+	for _, r := range rules {
+		if counts[r.label] > count {
+			label, count = r.label, counts[r.label]
+		}
+	}
+	return label, float64(count) / float64(total)
+}
+
+// Detect suggests the label of a column by the name and the sampled values, nil values mean the data is not sampled.
+// If the data is sampled, the values must match the label to avoid false positives such as "email_verified".
+func Detect(column string, values []string) *storepb.ClassificationSuggestionPayload {
+	nameLabel := DetectByName(column)
+	valueLabel, ratio := DetectByValues(values)
+	if ratio < MatchRatioThreshold {
+		valueLabel = storepb.ClassificationSuggestionPayload_LABEL_UNSPECIFIED
+	}
+	switch {
+	case valueLabel != storepb.ClassificationSuggestionPayload_LABEL_UNSPECIFIED:
+		return &storepb.ClassificationSuggestionPayload{
+			Label:          valueLabel,
+			NameMatched:    nameLabel == valueLabel,
+			DataMatchRatio: ratio,
+		}
+	case nameLabel != storepb.ClassificationSuggestionPayload_LABEL_UNSPECIFIED && !hasValue(values):
+		return &storepb.ClassificationSuggestionPayload{
+			Label:       nameLabel,
+			NameMatched: true,
+		}
+	default:
+		return nil
+	}
+}
+
+func hasValue(values []string) bool {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func countDigits(s string) int {
+	count := 0
+	for _, c := range s {
+		if c >= '0' && c <= '9' {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,57 @@
+package pii
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		column string
+		values []string
+		want   *storepb.ClassificationSuggestionPayload
+	}{
+		{
+			column: "user_email",
+			want:   &storepb.ClassificationSuggestionPayload{Label: storepb.ClassificationSuggestionPayload_EMAIL, NameMatched: true},
+		},
+		{
+			column: "contact",
+			values: []string{"a@example.com", "b@example.com", "", "c@example.org"},
+			want:   &storepb.ClassificationSuggestionPayload{Label: storepb.ClassificationSuggestionPayload_EMAIL, DataMatchRatio: 1},
+		},
+		{
+			column: "email_verified",
+			values: []string{"true", "false"},
+			want:   nil,
+		},
+		{
+			column: "mobile",
+			values: []string{"+1 (555) 123-4567", "555-1234567", "n/a", "13800138000", "+86 138 0013 8000"},
+			want:   &storepb.ClassificationSuggestionPayload{Label: storepb.ClassificationSuggestionPayload_PHONE, NameMatched: true, DataMatchRatio: 0.8},
+		},
+		{
+			column: "ssn",
+			values: []string{"123-45-6789", "987-65-4321"},
+			want:   &storepb.ClassificationSuggestionPayload{Label: storepb.ClassificationSuggestionPayload_NATIONAL_ID, NameMatched: true, DataMatchRatio: 1},
+		},
+		{
+			column: "id_no",
+			values: []string{"11010519491231002X", "110105194912310021"},
+			want:   &storepb.ClassificationSuggestionPayload{Label: storepb.ClassificationSuggestionPayload_NATIONAL_ID, DataMatchRatio: 1},
+		},
+		{
+			column: "title",
+			values: []string{"hello", "world"},
+			want:   nil,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, Detect(test.column, test.values), test.column)
+	}
+}
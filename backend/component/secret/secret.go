@@ -8,26 +8,51 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
-var secretCache = make(map[string]string)
+// defaultCacheTTL is how long an external secret is cached if the data source doesn't specify one.
+// The secret is fetched again after it expires so that rotated secrets are picked up.
+const defaultCacheTTL = 5 * time.Minute
+
+type cachedSecret struct {
+	value    string
+	expireAt time.Time
+}
+
+var (
+	secretCacheMu sync.Mutex
+	secretCache   = make(map[string]cachedSecret)
+)
 
 // ReplaceExternalSecret replaces the secret with external secret.
 func ReplaceExternalSecret(ctx context.Context, secret string, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
 	if externalSecret != nil {
-		// TODO: consider cache?
-		switch externalSecret.SecretType {
-		case storepb.DataSourceExternalSecret_AWS_SECRETS_MANAGER:
-			return getSecretFromAWS(ctx, externalSecret)
-		case storepb.DataSourceExternalSecret_VAULT_KV_V2:
-			return getSecretFromVault(ctx, externalSecret)
-		case storepb.DataSourceExternalSecret_GCP_SECRET_MANAGER:
-			return getSecretFromGCP(ctx, externalSecret)
+		key, err := proto.MarshalOptions{Deterministic: true}.Marshal(externalSecret)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to marshal external secret")
+		}
+		ttl := defaultCacheTTL
+		if externalSecret.CacheTtl != nil {
+			ttl = externalSecret.CacheTtl.AsDuration()
 		}
+		return getCachedSecret(string(key), ttl, func() (string, error) {
+			switch externalSecret.SecretType {
+			case storepb.DataSourceExternalSecret_AWS_SECRETS_MANAGER:
+				return getSecretFromAWS(ctx, externalSecret)
+			case storepb.DataSourceExternalSecret_VAULT_KV_V2:
+				return getSecretFromVault(ctx, externalSecret)
+			case storepb.DataSourceExternalSecret_GCP_SECRET_MANAGER:
+				return getSecretFromGCP(ctx, externalSecret)
+			}
+			return secret, nil
+		})
 	}
 
 	return getSecretFromText(secret)
@@ -38,15 +63,32 @@ func getSecretFromText(secret string) (string, error) {
 	if !ok {
 		return secret, nil
 	}
-	if v, ok := secretCache[secretURL]; ok {
-		return v, nil
+	return getCachedSecret(secretURL, defaultCacheTTL, func() (string, error) {
+		return getSecretFromURL(secretURL)
+	})
+}
+
+// getCachedSecret returns the cached secret for the key, or fetches and caches it for ttl.
+// A non-positive ttl disables caching.
+func getCachedSecret(key string, ttl time.Duration, fetch func() (string, error)) (string, error) {
+	now := time.Now()
+	secretCacheMu.Lock()
+	v, ok := secretCache[key]
+	secretCacheMu.Unlock()
+	if ok && now.Before(v.expireAt) {
+		return v.value, nil
 	}
-	secret, err := getSecretFromURL(secretURL)
+
+	secret, err := fetch()
 	if err != nil {
 		return "", err
 	}
-	secretCache[secretURL] = secret
-	return secret, err
+	if ttl > 0 {
+		secretCacheMu.Lock()
+		secretCache[key] = cachedSecret{value: secret, expireAt: now.Add(ttl)}
+		secretCacheMu.Unlock()
+	}
+	return secret, nil
 }
 
 // GetExternalSecretURL gets external secret URL from secret.
@@ -2,6 +2,9 @@ package secret
 
 import (
 	"context"
+	"os"
+	"path"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -58,6 +61,12 @@ func getVaultClient(ctx context.Context, externalSecret *storepb.DataSourceExter
 			return nil, errors.Wrapf(err, "failed to login with approle: %v", err.Error())
 		}
 		token = resp.Auth.ClientToken
+	case storepb.DataSourceExternalSecret_VAULT_KUBERNETES:
+		clientToken, err := loginWithKubernetes(ctx, client, externalSecret.GetKubernetes())
+		if err != nil {
+			return nil, err
+		}
+		token = clientToken
 	}
 
 	client.SetToken(token)
@@ -65,6 +74,43 @@ func getVaultClient(ctx context.Context, externalSecret *storepb.DataSourceExter
 	return client, nil
 }
 
+const (
+	defaultKubernetesTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultKubernetesMountPath = "kubernetes"
+)
+
+// loginWithKubernetes logs in Vault with the Kubernetes service account token.
+// The token file is read on every login because Kubernetes rotates projected service account tokens.
+func loginWithKubernetes(ctx context.Context, client *vault.Client, option *storepb.DataSourceExternalSecret_KubernetesAuthOption) (string, error) {
+	if option == nil || option.Role == "" {
+		return "", errors.Errorf("kubernetes auth role is invalid")
+	}
+	tokenPath := option.ServiceAccountTokenPath
+	if tokenPath == "" {
+		tokenPath = defaultKubernetesTokenPath
+	}
+	mountPath := strings.Trim(option.MountPath, "/")
+	if mountPath == "" {
+		mountPath = defaultKubernetesMountPath
+	}
+	jwt, err := os.ReadFile(tokenPath)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read service account token from %q", tokenPath)
+	}
+
+	resp, err := client.Logical().WriteWithContext(ctx, path.Join("auth", mountPath, "login"), map[string]any{
+		"role": option.Role,
+		"jwt":  strings.TrimSpace(string(jwt)),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to login with kubernetes: %v", err.Error())
+	}
+	if resp == nil || resp.Auth == nil {
+		return "", errors.Errorf("no auth info returned from kubernetes login")
+	}
+	return resp.Auth.ClientToken, nil
+}
+
 func getSecretFromVault(ctx context.Context, externalSecret *storepb.DataSourceExternalSecret) (string, error) {
 	client, err := getVaultClient(ctx, externalSecret)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	lru "github.com/hashicorp/golang-lru/v2/expirable"
 	"github.com/zeebo/xxh3"
@@ -18,8 +19,10 @@ import (
 	tidbast "github.com/pingcap/tidb/pkg/parser/ast"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db/elasticsearch"
 	"github.com/bytebase/bytebase/backend/plugin/db/mssql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	dorisparser "github.com/bytebase/bytebase/backend/plugin/parser/doris"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	partiqlparser "github.com/bytebase/bytebase/backend/plugin/parser/partiql"
 	plsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
@@ -27,6 +30,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
 	tidbbbparser "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	"github.com/bytebase/bytebase/backend/plugin/parser/tokenizer"
 	tsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 	tsqlbatch "github.com/bytebase/bytebase/backend/plugin/parser/tsql/batch"
 	"github.com/bytebase/bytebase/backend/store"
@@ -82,6 +86,7 @@ func getSheetCommands(engine storepb.Engine, statement string) []*storepb.SheetC
 	case storepb.Engine_ORACLE:
 	case storepb.Engine_MSSQL:
 	case storepb.Engine_DYNAMODB:
+	case storepb.Engine_REDIS:
 	default:
 		return nil
 	}
@@ -231,6 +236,14 @@ func syntaxCheck(dbType storepb.Engine, statement string) (any, []*storepb.Advic
 		return mssqlSyntaxCheck(statement)
 	case storepb.Engine_DYNAMODB:
 		return partiqlSyntaxCheck(statement)
+	case storepb.Engine_CLICKHOUSE, storepb.Engine_DUCKDB:
+		return standardSyntaxCheck(statement)
+	case storepb.Engine_DORIS:
+		return dorisSyntaxCheck(statement)
+	case storepb.Engine_ELASTICSEARCH:
+		return elasticsearchSyntaxCheck(statement)
+	case storepb.Engine_REDIS:
+		return redisSyntaxCheck(statement)
 	}
 	return nil, []*storepb.Advice{
 		{
@@ -283,6 +296,141 @@ func partiqlSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	return result.Tree, nil
 }
 
+// standardSyntaxCheck splits the statement into single SQLs for ClickHouse and DuckDB advisors.
+// We don't have ClickHouse and DuckDB parsers yet, so the advisors work on the statement text.
+func standardSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	list, err := tokenizer.NewTokenizer(statement).SplitStandardMultiSQL()
+	if err != nil {
+		return nil, []*storepb.Advice{
+			{
+				Status:  storepb.Advice_WARNING,
+				Code:    InternalErrorCode,
+				Title:   "Split error",
+				Content: err.Error(),
+				StartPosition: &storepb.Position{
+					Line: 1,
+				},
+			},
+		}
+	}
+
+	var result []base.SingleSQL
+	for _, sql := range list {
+		if sql.Empty {
+			continue
+		}
+		lines := strings.Split(strings.TrimRightFunc(sql.Text, unicode.IsSpace), "\n")
+		sql.BaseLine = sql.LastLine - len(lines) + 1
+		sql.FirstStatementLine = sql.BaseLine
+		for _, line := range lines {
+			line = strings.TrimSpace(line)
+			if line != "" && !strings.HasPrefix(line, "--") {
+				break
+			}
+			sql.FirstStatementLine++
+		}
+		result = append(result, sql)
+	}
+	return result, nil
+}
+
+// dorisSyntaxCheck splits the statement into single SQLs for Doris advisors.
+// The MySQL parser mis-parses the Doris DDL, so the advisors work on the statement text.
+func dorisSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	list, err := dorisparser.SplitSQL(statement)
+	if err != nil {
+		return nil, []*storepb.Advice{
+			{
+				Status:  storepb.Advice_WARNING,
+				Code:    InternalErrorCode,
+				Title:   "Split error",
+				Content: err.Error(),
+				StartPosition: &storepb.Position{
+					Line: 1,
+				},
+			},
+		}
+	}
+
+	var result []base.SingleSQL
+	for _, sql := range list {
+		if sql.Empty {
+			continue
+		}
+		sql.BaseLine = strings.Count(statement[:sql.ByteOffsetStart], "\n")
+		sql.FirstStatementLine = sql.BaseLine
+		for _, line := range strings.Split(sql.Text, "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" && !strings.HasPrefix(line, "--") && !strings.HasPrefix(line, "#") {
+				break
+			}
+			sql.FirstStatementLine++
+		}
+		result = append(result, sql)
+	}
+	return result, nil
+}
+
+// elasticsearchSyntaxCheck splits the statement into the API calls for Elasticsearch advisors.
+// The text of a single SQL is the method and route line followed by the request body.
+func elasticsearchSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	statements, err := elasticsearch.SplitElasticsearchStatements(statement)
+	if err != nil {
+		return nil, []*storepb.Advice{
+			{
+				Status:  storepb.Advice_WARNING,
+				Code:    InternalErrorCode,
+				Title:   "Split error",
+				Content: err.Error(),
+				StartPosition: &storepb.Position{
+					Line: 1,
+				},
+			},
+		}
+	}
+
+	var result []base.SingleSQL
+	for _, s := range statements {
+		result = append(result, base.SingleSQL{
+			Text:               fmt.Sprintf("%s %s\n%s", s.Method(), s.Route(), s.QueryString()),
+			BaseLine:           s.Line(),
+			FirstStatementLine: s.Line(),
+		})
+	}
+	return result, nil
+}
+
+// redisSyntaxCheck splits the statement into the commands for Redis advisors, one command per line.
+func redisSyntaxCheck(statement string) (any, []*storepb.Advice) {
+	list, err := base.SplitMultiSQL(storepb.Engine_REDIS, statement)
+	if err != nil {
+		line := 1
+		if syntaxErr, ok := err.(*base.SyntaxError); ok {
+			line = syntaxErr.Line
+		}
+		return nil, []*storepb.Advice{
+			{
+				Status:  storepb.Advice_ERROR,
+				Code:    StatementSyntaxErrorCode,
+				Title:   SyntaxErrorTitle,
+				Content: err.Error(),
+				StartPosition: &storepb.Position{
+					Line: int32(line),
+				},
+			},
+		}
+	}
+
+	var result []base.SingleSQL
+	for _, sql := range list {
+		if sql.Empty {
+			continue
+		}
+		result = append(result, sql)
+	}
+	return result, nil
+}
+
 func mssqlSyntaxCheck(statement string) (any, []*storepb.Advice) {
 	result, err := tsqlparser.ParseTSQL(statement)
 	if err != nil {
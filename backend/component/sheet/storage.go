@@ -0,0 +1,39 @@
+package sheet
+
+import (
+	"context"
+	"io"
+
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// ContentStorage stores the content of the large sheets in the object storage.
+type ContentStorage struct {
+	secret string
+}
+
+// NewContentStorage creates a new sheet content storage.
+func NewContentStorage(secret string) *ContentStorage {
+	return &ContentStorage{
+		secret: secret,
+	}
+}
+
+// Upload uploads the content to the object storage.
+func (c *ContentStorage) Upload(ctx context.Context, storage *storepb.BackupStorage, key string, content io.Reader) error {
+	client, err := objectstorage.NewClient(ctx, storage, c.secret)
+	if err != nil {
+		return err
+	}
+	return objectstorage.Upload(ctx, client, storage, key, content)
+}
+
+// Open opens the content in the object storage, the caller must close the reader.
+func (c *ContentStorage) Open(ctx context.Context, content *storepb.SheetExternalContent) (io.ReadCloser, error) {
+	body, _, err := objectstorage.Download(ctx, content.Storage, content.Path, c.secret)
+	if err != nil {
+		return nil, err
+	}
+	return body, nil
+}
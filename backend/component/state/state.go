@@ -47,8 +47,16 @@ type State struct {
 	RunningPlanChecks sync.Map
 	// RunningPlanCheckRunsCancelFunc is the cancelFunc of running plan checks.
 	RunningPlanCheckRunsCancelFunc sync.Map // map[planCheckRunUID]context.CancelFunc
+	// RunningQueriesCancelFunc is the cancelFunc of running SQL Editor queries.
+	RunningQueriesCancelFunc sync.Map // map[userUID/queryID]context.CancelFunc
 	// InstanceOutstandingConnections is the maximum number of connections per instance.
 	InstanceOutstandingConnections *connectionLimiter
+	// InstanceRunningPlanChecks is the number of running plan checks per instance.
+	InstanceRunningPlanChecks *connectionLimiter
+	// InstanceRunningTasks is the number of running tasks per instance.
+	InstanceRunningTasks *connectionLimiter
+	// TaskRunWaitingSlotSince is the time when the task run starts waiting for a task slot of the instance.
+	TaskRunWaitingSlotSince sync.Map // map[taskRunID]time.Time
 
 	// IssueExternalApprovalRelayCancelChan cancels the external approval from relay for issue issueUID.
 	IssueExternalApprovalRelayCancelChan chan int
@@ -71,7 +79,9 @@ func New() (*State, error) {
 	}
 	return &State{
 		InstanceSlowQuerySyncChan:            make(chan *InstanceSlowQuerySyncMessage, 100),
-		InstanceOutstandingConnections:       &connectionLimiter{connections: map[int]int{}},
+		InstanceOutstandingConnections:       &connectionLimiter{connections: map[int]int{}, rejected: map[int]int{}},
+		InstanceRunningPlanChecks:            &connectionLimiter{connections: map[int]int{}, rejected: map[int]int{}},
+		InstanceRunningTasks:                 &connectionLimiter{connections: map[int]int{}, rejected: map[int]int{}},
 		IssueExternalApprovalRelayCancelChan: make(chan int, 1),
 		TaskSkippedOrDoneChan:                make(chan int, 1000),
 		PlanCheckTickleChan:                  make(chan int, 1000),
@@ -99,16 +109,17 @@ type TaskRunExecutionStatus struct {
 type connectionLimiter struct {
 	sync.Mutex
 	connections map[int]int
+	// rejected is the number of rejected connection requests since the last PopRejected call.
+	rejected map[int]int
 }
 
+// Increment reserves a connection for the instance.
+// It returns true if the instance has reached its maximum connections, in which case nothing is reserved.
 func (c *connectionLimiter) Increment(instanceID, maxConnections int) bool {
 	c.Lock()
 	defer c.Unlock()
-	if maxConnections == 0 {
-		maxConnections = defaultInstanceMaximumConnections
-	}
-
-	if c.connections[instanceID] >= maxConnections {
+	if c.connections[instanceID] >= GetMaximumConnections(maxConnections) {
+		c.rejected[instanceID]++
 		return true
 	}
 	c.connections[instanceID]++
@@ -120,3 +131,27 @@ func (c *connectionLimiter) Decrement(instanceID int) {
 	defer c.Unlock()
 	c.connections[instanceID]--
 }
+
+// Count returns the number of outstanding connections of the instance.
+func (c *connectionLimiter) Count(instanceID int) int {
+	c.Lock()
+	defer c.Unlock()
+	return c.connections[instanceID]
+}
+
+// PopRejected returns the number of rejected connection requests of the instance and resets it.
+func (c *connectionLimiter) PopRejected(instanceID int) int {
+	c.Lock()
+	defer c.Unlock()
+	rejected := c.rejected[instanceID]
+	delete(c.rejected, instanceID)
+	return rejected
+}
+
+// GetMaximumConnections returns the effective maximum connections for the configured value.
+func GetMaximumConnections(maxConnections int) int {
+	if maxConnections == 0 {
+		return defaultInstanceMaximumConnections
+	}
+	return maxConnections
+}
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// getEmailNotificationEvent returns the email notification event of the webhook event,
+// or EVENT_UNSPECIFIED if the event is not notified by email.
+func getEmailNotificationEvent(e *Event) storepb.NotificationSetting_Event {
+	switch e.Type {
+	case EventTypeIssueCreate:
+		return storepb.NotificationSetting_ISSUE_CREATED
+	case EventTypeIssueApprovalCreate:
+		return storepb.NotificationSetting_ISSUE_APPROVAL_NEEDED
+	case EventTypeTaskRunStatusUpdate:
+		if e.TaskRunStatusUpdate.Status == api.TaskRunFailed.String() {
+			return storepb.NotificationSetting_TASK_RUN_FAILED
+		}
+	}
+	return storepb.NotificationSetting_EVENT_UNSPECIFIED
+}
+
+// createEmailNotifications queues the email notifications of the event for the recipients who opt in.
+// The emails are sent by the notification mail sender.
+func (m *Manager) createEmailNotifications(ctx context.Context, e *Event, event storepb.NotificationSetting_Event, webhookCtx *webhook.Context) {
+	var recipients []*store.UserMessage
+	switch event {
+	case storepb.NotificationSetting_ISSUE_CREATED:
+		users, err := getUsersFromProjectRole(m.store, api.ProjectOwner, e.Project.UID)(ctx)
+		if err != nil {
+			slog.Warn("failed to get project owners", slog.String("issue_name", e.Issue.Title), log.BBError(err))
+			return
+		}
+		recipients = users
+	case storepb.NotificationSetting_ISSUE_APPROVAL_NEEDED:
+		recipients = webhookCtx.MentionUsers
+	case storepb.NotificationSetting_TASK_RUN_FAILED:
+		recipients = []*store.UserMessage{e.Issue.Creator}
+	default:
+		return
+	}
+
+	var principalUIDs []int
+	for _, user := range utils.DeduplicateUsers(recipients) {
+		// Do not notify the users of their own actions.
+		if user == nil || user.MemberDeleted || user.Type != api.EndUser || user.ID == e.Actor.ID {
+			continue
+		}
+		principalUIDs = append(principalUIDs, user.ID)
+	}
+	if len(principalUIDs) == 0 {
+		return
+	}
+	settings, err := m.store.ListNotificationSettings(ctx, principalUIDs)
+	if err != nil {
+		slog.Warn("failed to list notification settings", slog.String("issue_name", e.Issue.Title), log.BBError(err))
+		return
+	}
+
+	payload := &storepb.EmailNotification{
+		Event:        event,
+		ProjectTitle: e.Project.Title,
+		IssueTitle:   e.Issue.Title,
+		Link:         webhookCtx.Link,
+		Actor:        e.Actor.Name,
+	}
+	if u := e.TaskRunStatusUpdate; u != nil {
+		payload.Detail = fmt.Sprintf("%s: %s", u.Title, u.Detail)
+	}
+	var creates []*store.EmailNotificationMessage
+	for _, principalUID := range principalUIDs {
+		if !slices.Contains(settings[principalUID].GetEmailEvents(), event) {
+			continue
+		}
+		creates = append(creates, &store.EmailNotificationMessage{
+			PrincipalUID: principalUID,
+			Payload:      payload,
+		})
+	}
+	if err := m.store.CreateEmailNotifications(ctx, creates); err != nil {
+		slog.Warn("failed to create email notifications", slog.String("issue_name", e.Issue.Title), log.BBError(err))
+	}
+}
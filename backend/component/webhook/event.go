@@ -1,8 +1,13 @@
 package webhook
 
 import (
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
 type EventType string
@@ -18,6 +23,8 @@ const (
 
 	EventTypeStageStatusUpdate   = "bb.webhook.event.stage.status.update"
 	EventTypeTaskRunStatusUpdate = "bb.webhook.event.taskRun.status.update"
+
+	EventTypeReleaseComplete = "bb.webhook.event.release.complete"
 )
 
 type Event struct {
@@ -32,6 +39,7 @@ type Event struct {
 	IssueRolloutReady   *EventIssueRolloutReady
 	StageStatusUpdate   *EventStageStatusUpdate
 	TaskRunStatusUpdate *EventTaskRunStatusUpdate
+	ReleaseComplete     *EventReleaseComplete
 }
 
 func NewIssue(i *store.IssueMessage) *Issue {
@@ -43,6 +51,7 @@ func NewIssue(i *store.IssueMessage) *Issue {
 		Description: i.Description,
 		Creator:     i.Creator,
 		Approval:    i.Payload.GetApproval(),
+		Release:     i.Payload.GetRelease(),
 	}
 }
 
@@ -62,6 +71,7 @@ type Issue struct {
 	Description string
 	Creator     *store.UserMessage
 	Approval    *storepb.IssuePayloadApproval
+	Release     string
 }
 
 type Project struct {
@@ -93,4 +103,71 @@ type EventTaskRunStatusUpdate struct {
 	Status        string
 	Detail        string
 	SkippedReason string
+	// Incident is the action on the incident of the issue for the failed production rollouts.
+	// It's empty if the task run status update doesn't affect the incident.
+	Incident webhook.IncidentAction
+}
+
+type EventReleaseComplete struct {
+	ResourceID string
+	Title      string
+	IssueCount int
+}
+
+// convertToWorkspaceEvent converts the event to the workspace event of the event streams, and returns nil if the event is not streamed.
+func convertToWorkspaceEvent(e *Event) *v1pb.WorkspaceEvent {
+	if e.Issue == nil || e.Project == nil {
+		return nil
+	}
+	event := &v1pb.WorkspaceEvent{
+		Project:  common.FormatProject(e.Project.ResourceID),
+		Resource: common.FormatIssue(e.Project.ResourceID, e.Issue.UID),
+		Title:    e.Issue.Title,
+		Status:   e.Issue.Status,
+	}
+	if e.Actor != nil {
+		event.Actor = common.FormatUserEmail(e.Actor.Email)
+	}
+	switch e.Type {
+	case EventTypeIssueCreate:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_CREATED
+	case EventTypeIssueUpdate:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_UPDATED
+		if e.IssueUpdate != nil {
+			event.Detail = e.IssueUpdate.Path
+		}
+	case EventTypeIssueStatusUpdate:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_STATUS_UPDATED
+	case EventTypeIssueCommentCreate:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_COMMENT_CREATED
+		event.Detail = e.Comment
+	case EventTypeIssueApprovalCreate:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_APPROVAL_REQUESTED
+	case EventTypeIssueApprovalPass:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_APPROVED
+	case EventTypeIssueRolloutReady:
+		event.Type = v1pb.WorkspaceEvent_ISSUE_ROLLOUT_READY
+		if e.IssueRolloutReady != nil {
+			event.Detail = e.IssueRolloutReady.StageName
+		}
+	case EventTypeStageStatusUpdate:
+		event.Type = v1pb.WorkspaceEvent_STAGE_STATUS_UPDATED
+		if e.StageStatusUpdate != nil {
+			event.Detail = e.StageStatusUpdate.StageTitle
+		}
+	case EventTypeTaskRunStatusUpdate:
+		event.Type = v1pb.WorkspaceEvent_TASK_RUN_STATUS_UPDATED
+		if u := e.TaskRunStatusUpdate; u != nil {
+			event.Status = u.Status
+			event.Detail = u.Title
+			if u.Detail != "" {
+				event.Detail = fmt.Sprintf("%s: %s", u.Title, u.Detail)
+			} else if u.SkippedReason != "" {
+				event.Detail = fmt.Sprintf("%s: %s", u.Title, u.SkippedReason)
+			}
+		}
+	default:
+		return nil
+	}
+	return event
 }
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// GetTaskRunIncidentAction returns the incident action of the task run status update.
+// Only the tasks in the production stages, i.e. whose environment is protected, affect the incidents.
+// The incident is triggered if the task fails or a rollback, i.e. the restore task, is initiated,
+// and resolved if the task succeeds and no other task of the production stages is still failed.
+func GetTaskRunIncidentAction(ctx context.Context, s *store.Store, task *store.TaskMessage, status api.TaskRunStatus) (webhook.IncidentAction, error) {
+	var action webhook.IncidentAction
+	switch status {
+	case api.TaskRunFailed:
+		action = webhook.IncidentTrigger
+	case api.TaskRunPending:
+		if task.Type != api.TaskDatabaseRestore {
+			return "", nil
+		}
+		action = webhook.IncidentTrigger
+	case api.TaskRunDone:
+		action = webhook.IncidentResolve
+	default:
+		return "", nil
+	}
+
+	productionStages, err := getProductionStages(ctx, s, task.PipelineID)
+	if err != nil {
+		return "", err
+	}
+	if !productionStages[task.StageID] {
+		return "", nil
+	}
+	if action == webhook.IncidentResolve {
+		tasks, err := s.ListTasks(ctx, &api.TaskFind{PipelineID: &task.PipelineID})
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to list tasks")
+		}
+		for _, t := range tasks {
+			if t.ID != task.ID && productionStages[t.StageID] && t.LatestTaskRunStatus == api.TaskRunFailed {
+				return "", nil
+			}
+		}
+	}
+	return action, nil
+}
+
+// getProductionStages returns the set of the stage IDs whose environment is protected in the pipeline.
+func getProductionStages(ctx context.Context, s *store.Store, pipelineID int) (map[int]bool, error) {
+	stages, err := s.ListStageV2(ctx, pipelineID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list stages")
+	}
+	productionStages := make(map[int]bool)
+	for _, stage := range stages {
+		environmentID := stage.EnvironmentID
+		environment, err := s.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{UID: &environmentID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get environment %d", environmentID)
+		}
+		if environment != nil && environment.Protected {
+			productionStages[stage.ID] = true
+		}
+	}
+	return productionStages, nil
+}
@@ -13,20 +13,23 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/eventstream"
 	"github.com/bytebase/bytebase/backend/component/iam"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 
 	"github.com/pkg/errors"
 )
 
 // Manager is the webhook manager.
 type Manager struct {
-	store      *store.Store
-	iamManager *iam.Manager
+	store       *store.Store
+	iamManager  *iam.Manager
+	eventBroker *eventstream.Broker
 }
 
 // Metadata is the activity metadata.
@@ -35,14 +38,27 @@ type Metadata struct {
 }
 
 // NewManager creates an activity manager.
-func NewManager(store *store.Store, iamManager *iam.Manager) *Manager {
+func NewManager(store *store.Store, iamManager *iam.Manager, eventBroker *eventstream.Broker) *Manager {
 	return &Manager{
-		store:      store,
-		iamManager: iamManager,
+		store:       store,
+		iamManager:  iamManager,
+		eventBroker: eventBroker,
 	}
 }
 
+// PublishEvent publishes the workspace event to the event streams and the event buses.
+func (m *Manager) PublishEvent(ctx context.Context, event *v1pb.WorkspaceEvent) {
+	m.eventBroker.Publish(ctx, event)
+}
+
 func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
+	if event := convertToWorkspaceEvent(e); event != nil {
+		m.eventBroker.Publish(ctx, event)
+	}
+	if e.Type == EventTypeIssueStatusUpdate && e.Issue.Status == api.IssueDone.String() && e.Issue.Release != "" {
+		m.createReleaseCompleteEvent(ctx, e)
+	}
+
 	var activityType api.ActivityType
 	//exhaustive:enforce
 	switch e.Type {
@@ -64,6 +80,8 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		activityType = api.ActivityPipelineStageStatusUpdate
 	case EventTypeTaskRunStatusUpdate:
 		activityType = api.ActivityPipelineTaskRunStatusUpdate
+	case EventTypeReleaseComplete:
+		activityType = api.ActivityNotifyReleaseComplete
 	default:
 		return
 	}
@@ -76,7 +94,8 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 		return
 	}
 
-	if len(webhookList) == 0 {
+	emailEvent := getEmailNotificationEvent(e)
+	if len(webhookList) == 0 && emailEvent == storepb.NotificationSetting_EVENT_UNSPECIFIED {
 		return
 	}
 
@@ -87,10 +106,47 @@ func (m *Manager) CreateEvent(ctx context.Context, e *Event) {
 			log.BBError(err))
 		return
 	}
+	if emailEvent != storepb.NotificationSetting_EVENT_UNSPECIFIED {
+		m.createEmailNotifications(ctx, e, emailEvent, webhookCtx)
+	}
+	if len(webhookList) == 0 {
+		return
+	}
 	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
 	go m.postWebhookList(ctx, webhookCtx, webhookList)
 }
 
+// createReleaseCompleteEvent creates a release complete event if the resolved issue is the last open issue of its release.
+func (m *Manager) createReleaseCompleteEvent(ctx context.Context, e *Event) {
+	release, err := m.store.GetRelease(ctx, &store.FindReleaseMessage{
+		ProjectID:  &e.Project.ResourceID,
+		ResourceID: &e.Issue.Release,
+	})
+	if err != nil {
+		slog.Warn("failed to get release", slog.String("release", e.Issue.Release), log.BBError(err))
+		return
+	}
+	if release == nil || release.IssueCount == 0 || release.DoneIssueCount != release.IssueCount {
+		return
+	}
+	title := release.Payload.GetTitle()
+	if title == "" {
+		title = release.ResourceID
+	}
+	m.CreateEvent(ctx, &Event{
+		Actor:   e.Actor,
+		Type:    EventTypeReleaseComplete,
+		Comment: fmt.Sprintf("All %d issues in the release are done.", release.IssueCount),
+		Issue:   e.Issue,
+		Project: e.Project,
+		ReleaseComplete: &EventReleaseComplete{
+			ResourceID: release.ResourceID,
+			Title:      title,
+			IssueCount: release.IssueCount,
+		},
+	})
+}
+
 func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, activityType api.ActivityType) (*webhook.Context, error) {
 	var webhookCtx webhook.Context
 	var mentions []string
@@ -154,6 +210,11 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 		case api.TaskRunPending.String():
 			title = "Task run started"
 			titleZh = "任务开始"
+			if u.Incident == webhook.IncidentTrigger {
+				level = webhook.WebhookWarn
+				title = "Rollback started"
+				titleZh = "回滚开始"
+			}
 		case api.TaskRunRunning.String():
 			title = "Task run is running"
 			titleZh = "任务运行中"
@@ -176,17 +237,18 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			titleZh = "任务状态变更"
 		}
 
+	case EventTypeReleaseComplete:
+		u := e.ReleaseComplete
+		link = fmt.Sprintf("%s/projects/%s/releases/%s", setting.ExternalUrl, e.Project.ResourceID, u.ResourceID)
+		level = webhook.WebhookSuccess
+		title = fmt.Sprintf("Release %q completed", u.Title)
+		titleZh = fmt.Sprintf("版本 %q 发布完成", u.Title)
+
 	case EventTypeIssueApprovalPass:
 		title = "Issue approved"
 		titleZh = "工单审批通过"
 
 		mentionUsers = append(mentionUsers, e.Issue.Creator)
-		phone, err := maybeGetPhoneFromUser(e.Issue.Creator)
-		if err != nil {
-			slog.Warn("failed to parse phone number", slog.String("issue_title", e.Issue.Title), log.BBError(err))
-		} else if phone != "" {
-			mentions = append(mentions, phone)
-		}
 
 	case EventTypeIssueRolloutReady:
 		u := e.IssueRolloutReady
@@ -204,6 +266,9 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 				role := api.Role(strings.TrimPrefix(projectRole, "roles/"))
 				usersGetters = append(usersGetters, getUsersFromProjectRole(m.store, role, e.Project.UID))
 			}
+			for _, group := range u.RolloutPolicy.GetGroups() {
+				usersGetters = append(usersGetters, getUsersFromGroup(m.store, group))
+			}
 			for _, issueRole := range u.RolloutPolicy.GetIssueRoles() {
 				switch issueRole {
 				case "roles/LAST_APPROVER":
@@ -213,7 +278,6 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 				}
 			}
 		}
-		mentionedUser := map[int]bool{}
 		for _, usersGetter := range usersGetters {
 			users, err := usersGetter(ctx)
 			if err != nil {
@@ -222,23 +286,7 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 					log.BBError(err))
 				return nil, err
 			}
-			for _, user := range users {
-				if mentionedUser[user.ID] {
-					continue
-				}
-				mentionedUser[user.ID] = true
-				mentionUsers = append(mentionUsers, user)
-				phone, err := maybeGetPhoneFromUser(user)
-				if err != nil {
-					slog.Warn("failed to parse phone number",
-						slog.String("issue_name", e.Issue.Title),
-						log.BBError(err))
-					continue
-				}
-				if phone != "" {
-					mentions = append(mentions, phone)
-				}
-			}
+			mentionUsers = append(mentionUsers, users...)
 		}
 
 	case EventTypeIssueApprovalCreate:
@@ -279,6 +327,8 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			usersGetter = func(_ context.Context) ([]*store.UserMessage, error) {
 				return nil, nil
 			}
+		case *storepb.ApprovalNode_Group:
+			usersGetter = getUsersFromGroup(m.store, val.Group)
 		default:
 			return nil, errors.Errorf("invalid node payload type")
 		}
@@ -290,18 +340,21 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 				log.BBError(err))
 			return nil, err
 		}
-		for _, user := range users {
-			mentionUsers = append(mentionUsers, user)
-			phone, err := maybeGetPhoneFromUser(user)
-			if err != nil {
-				slog.Warn("failed to parse phone number",
-					slog.String("issue_name", e.Issue.Title),
-					log.BBError(err))
-				continue
-			}
-			if phone != "" {
-				mentions = append(mentions, phone)
-			}
+		mentionUsers = append(mentionUsers, users...)
+	}
+
+	// A user could be mentioned by several roles and groups, only mention once.
+	mentionUsers = utils.DeduplicateUsers(mentionUsers)
+	for _, user := range mentionUsers {
+		phone, err := maybeGetPhoneFromUser(user)
+		if err != nil {
+			slog.Warn("failed to parse phone number",
+				slog.String("issue_name", e.Issue.Title),
+				log.BBError(err))
+			continue
+		}
+		if phone != "" {
+			mentions = append(mentions, phone)
 		}
 	}
 
@@ -339,6 +392,13 @@ func (m *Manager) getWebhookContextFromEvent(ctx context.Context, e *Event, acti
 			SkippedReason: u.SkippedReason,
 		}
 	}
+	if u := e.TaskRunStatusUpdate; u != nil && u.Incident != "" {
+		webhookCtx.Incident = &webhook.Incident{
+			Action:   u.Incident,
+			DedupKey: fmt.Sprintf("bytebase-issue-%d", e.Issue.UID),
+			Summary:  fmt.Sprintf("[%s] %s in production: %s", e.Project.Title, title, u.Title),
+		}
+	}
 	if u := e.StageStatusUpdate; u != nil {
 		webhookCtx.Stage = &webhook.Stage{
 			Name: u.StageTitle,
@@ -362,6 +422,7 @@ func (m *Manager) postWebhookList(ctx context.Context, webhookCtx *webhook.Conte
 		webhookCtx.URL = hook.URL
 		webhookCtx.CreatedTs = time.Now().Unix()
 		webhookCtx.DirectMessage = hook.Payload.GetDirectMessage()
+		webhookCtx.IntegrationKey = hook.Payload.GetIntegrationKey()
 		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
 			if err := common.Retry(ctx, func() error {
 				return webhook.Post(hook.Type, *webhookCtx)
@@ -400,6 +461,12 @@ func getUsersFromProjectRole(s *store.Store, role api.Role, projectUID int) func
 	}
 }
 
+func getUsersFromGroup(s *store.Store, group string) func(context.Context) ([]*store.UserMessage, error) {
+	return func(ctx context.Context) ([]*store.UserMessage, error) {
+		return utils.GetUsersByMember(ctx, s, group), nil
+	}
+}
+
 func getUsersFromUsers(users ...*store.UserMessage) func(context.Context) ([]*store.UserMessage, error) {
 	return func(_ context.Context) ([]*store.UserMessage, error) {
 		return users, nil
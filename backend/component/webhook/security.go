@@ -0,0 +1,112 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SecurityEvent is the workspace security event, e.g. failed sign-ins and lockouts.
+type SecurityEvent struct {
+	Type api.ActivityType
+	// Email is the email of the user, the user may not exist for failed sign-ins.
+	Email string
+	// IP is the client IP address.
+	IP          string
+	UserAgent   string
+	Description string
+}
+
+// CreateSecurityEvent records the security event in the workspace audit log and
+// alerts the webhooks configured in the login security setting.
+func (m *Manager) CreateSecurityEvent(ctx context.Context, e *SecurityEvent) {
+	ctx = context.WithoutCancel(ctx)
+	workspaceID, err := m.store.GetWorkspaceID(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace id", log.BBError(err))
+		return
+	}
+	if err := m.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:   common.FormatWorkspace(workspaceID),
+		Method:   string(e.Type),
+		Resource: common.FormatUserEmail(e.Email),
+		User:     common.FormatUserEmail(e.Email),
+		Severity: storepb.AuditLog_WARNING,
+		Request:  fmt.Sprintf(`{"ip":%q,"userAgent":%q}`, e.IP, e.UserAgent),
+	}); err != nil {
+		slog.Error("failed to create security audit log", slog.String("type", string(e.Type)), log.BBError(err))
+	}
+
+	setting, err := m.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get workspace setting", log.BBError(err))
+		return
+	}
+	alertWebhooks := setting.GetLoginSecurity().GetAlertWebhooks()
+	if len(alertWebhooks) == 0 {
+		return
+	}
+	title, titleZh := getSecurityEventTitle(e.Type)
+	webhookCtx := webhook.Context{
+		Level:        webhook.WebhookWarn,
+		ActivityType: string(e.Type),
+		Title:        title,
+		TitleZh:      titleZh,
+		Description:  fmt.Sprintf("%s\nUser: %s\nIP: %s\nUser agent: %s", e.Description, e.Email, e.IP, e.UserAgent),
+		Link:         fmt.Sprintf("%s/settings/audit-log", setting.ExternalUrl),
+		CreatorName:  e.Email,
+		CreatorEmail: e.Email,
+		CreatedTs:    time.Now().Unix(),
+	}
+	for _, alertWebhook := range alertWebhooks {
+		webhookType, ok := securityWebhookTypes[alertWebhook.Type]
+		if !ok {
+			continue
+		}
+		webhookCtx := webhookCtx
+		webhookCtx.URL = alertWebhook.Url
+		// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
+		go func() {
+			if err := common.Retry(ctx, func() error {
+				return webhook.Post(webhookType, webhookCtx)
+			}); err != nil {
+				slog.Warn("Failed to post webhook event on security event",
+					slog.String("webhook type", webhookType),
+					slog.String("activity type", webhookCtx.ActivityType),
+					log.BBError(err))
+			}
+		}()
+	}
+}
+
+var securityWebhookTypes = map[storepb.LoginSecurity_AlertWebhook_Type]string{
+	storepb.LoginSecurity_AlertWebhook_SLACK:    "bb.plugin.webhook.slack",
+	storepb.LoginSecurity_AlertWebhook_DISCORD:  "bb.plugin.webhook.discord",
+	storepb.LoginSecurity_AlertWebhook_TEAMS:    "bb.plugin.webhook.teams",
+	storepb.LoginSecurity_AlertWebhook_DINGTALK: "bb.plugin.webhook.dingtalk",
+	storepb.LoginSecurity_AlertWebhook_FEISHU:   "bb.plugin.webhook.feishu",
+	storepb.LoginSecurity_AlertWebhook_WECOM:    "bb.plugin.webhook.wecom",
+	storepb.LoginSecurity_AlertWebhook_CUSTOM:   "bb.plugin.webhook.custom",
+}
+
+func getSecurityEventTitle(activityType api.ActivityType) (string, string) {
+	switch activityType {
+	case api.ActivitySecuritySignInFailed:
+		return "Failed sign-in attempt", "登录失败"
+	case api.ActivitySecurityUserLocked:
+		return "User locked out after failed sign-in attempts", "用户多次登录失败已被锁定"
+	case api.ActivitySecurityNewDeviceSignIn:
+		return "Sign-in from a new device", "新设备登录"
+	case api.ActivitySecurityTokenNewIP:
+		return "Service account token used from a new IP address", "服务账号令牌在新 IP 地址使用"
+	default:
+		return string(activityType), string(activityType)
+	}
+}
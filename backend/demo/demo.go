@@ -31,12 +31,7 @@ func LoadDemoDataIfNeeded(ctx context.Context, storeDB *store.DB, pgBinDir, demo
 
 	slog.Info(fmt.Sprintf("Setting up demo %q...", demoName))
 
-	metadataDriver, err := dbdriver.Open(
-		ctx,
-		storepb.Engine_POSTGRES,
-		dbdriver.DriverConfig{DbBinDir: pgBinDir},
-		storeDB.ConnCfg,
-	)
+	metadataDriver, err := openMetadataDriver(ctx, storeDB, pgBinDir)
 	if err != nil {
 		return err
 	}
@@ -74,6 +69,15 @@ func LoadDemoDataIfNeeded(ctx context.Context, storeDB *store.DB, pgBinDir, demo
 	return nil
 }
 
+func openMetadataDriver(ctx context.Context, storeDB *store.DB, pgBinDir string) (dbdriver.Driver, error) {
+	return dbdriver.Open(
+		ctx,
+		storepb.Engine_POSTGRES,
+		dbdriver.DriverConfig{DbBinDir: pgBinDir},
+		storeDB.ConnCfg,
+	)
+}
+
 // applyDataFile runs a single demo data file within a transaction.
 func applyDataFile(name string, db *sql.DB, mode common.ReleaseMode) error {
 	slog.Info(fmt.Sprintf("Applying data file %s...", name))
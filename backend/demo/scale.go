@@ -0,0 +1,505 @@
+package demo
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// scaleIssuesPerProject is the number of the issues generated in each load test project.
+	scaleIssuesPerProject = 100
+	// scaleDatabasesPerEnvironment is the number of the databases generated in each environment of a load test project.
+	scaleDatabasesPerEnvironment = 3
+	// scaleAnomalyRatio is the ratio of the generated databases with anomalies.
+	scaleAnomalyRatio = 0.1
+	// scaleHistory is the period of the creation time of the generated issues.
+	scaleHistory = 90 * 24 * time.Hour
+)
+
+// issueScenario is the stage of the generated issue in its lifecycle.
+type issueScenario int
+
+const (
+	scenarioAwaitingApproval issueScenario = iota
+	scenarioRejected
+	scenarioRollingOut
+	scenarioFailed
+	scenarioDone
+	scenarioCanceled
+)
+
+// scenarioWeights are the relative frequencies of the scenarios, most issues are done as in the real workspaces.
+var scenarioWeights = map[issueScenario]int{
+	scenarioAwaitingApproval: 15,
+	scenarioRejected:         5,
+	scenarioRollingOut:       10,
+	scenarioFailed:           5,
+	scenarioDone:             55,
+	scenarioCanceled:         10,
+}
+
+var scaleStatements = []struct {
+	taskType   api.TaskType
+	taskName   string
+	changeType storepb.PlanConfig_ChangeDatabaseConfig_Type
+	statement  string
+}{
+	{api.TaskDatabaseSchemaUpdate, "DDL(schema) for database %q", storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE, "ALTER TABLE employee ADD COLUMN nickname TEXT;"},
+	{api.TaskDatabaseSchemaUpdate, "DDL(schema) for database %q", storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE, "CREATE INDEX idx_salary_amount ON salary (amount);"},
+	{api.TaskDatabaseSchemaUpdate, "DDL(schema) for database %q", storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE, "CREATE TABLE audit (id SERIAL PRIMARY KEY, payload JSONB NOT NULL);"},
+	{api.TaskDatabaseDataUpdate, "DML(data) for database %q", storepb.PlanConfig_ChangeDatabaseConfig_DATA, "UPDATE employee SET hire_date = '2024-01-01' WHERE emp_no = 10001;"},
+	{api.TaskDatabaseDataUpdate, "DML(data) for database %q", storepb.PlanConfig_ChangeDatabaseConfig_DATA, "DELETE FROM title WHERE to_date < '1990-01-01';"},
+}
+
+type scaleEnvironment struct {
+	id         int
+	resourceID string
+	name       string
+	instances  []*scaleInstance
+}
+
+type scaleInstance struct {
+	id         int
+	resourceID string
+	engine     storepb.Engine
+}
+
+type scaleDatabase struct {
+	id       int
+	name     string
+	instance *scaleInstance
+}
+
+// scaleGenerator generates the load test data in a transaction.
+type scaleGenerator struct {
+	tx           *sql.Tx
+	rand         *rand.Rand
+	now          time.Time
+	users        []int
+	environments []*scaleEnvironment
+}
+
+// GenerateScaleDataIfNeeded generates the load test projects on top of the demo data, each project has the databases in every environment,
+// scaleIssuesPerProject issues across the statuses and the approval stages, and the anomalies on some databases.
+// The generated databases don't exist on the instances, so the load test issues are for browsing rather than rolling out.
+// The data is generated with a fixed seed, so the same scale always generates the same data.
+func GenerateScaleDataIfNeeded(ctx context.Context, storeDB *store.DB, pgBinDir string, scale int) error {
+	if scale <= 0 {
+		return nil
+	}
+	metadataDriver, err := openMetadataDriver(ctx, storeDB, pgBinDir)
+	if err != nil {
+		return err
+	}
+	defer metadataDriver.Close(ctx)
+	db := metadataDriver.GetDB()
+
+	var exists bool
+	if err := db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM project WHERE resource_id = $1)`, getScaleProjectID(1)).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		slog.Info("Skip generating load test data. Data already exists.")
+		return nil
+	}
+
+	slog.Info(fmt.Sprintf("Generating load test data with %d projects...", scale))
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	g := &scaleGenerator{
+		tx:   tx,
+		rand: rand.New(rand.NewSource(int64(scale))),
+		now:  time.Now(),
+	}
+	if err := g.load(ctx); err != nil {
+		return err
+	}
+	for i := 1; i <= scale; i++ {
+		if err := g.generateProject(ctx, i); err != nil {
+			return errors.Wrapf(err, "failed to generate load test project %d", i)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	slog.Info("Completed load test data generation.")
+	return nil
+}
+
+// load loads the users, the environments and the instances of the demo data.
+func (g *scaleGenerator) load(ctx context.Context) error {
+	rows, err := g.tx.QueryContext(ctx, `SELECT id FROM principal WHERE type = 'END_USER' AND row_status = 'NORMAL' ORDER BY id`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		g.users = append(g.users, id)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(g.users) == 0 {
+		return errors.Errorf("no user found in the demo data")
+	}
+
+	rows, err = g.tx.QueryContext(ctx, `
+		SELECT environment.id, environment.resource_id, environment.name, instance.id, instance.resource_id, instance.engine
+		FROM environment
+		JOIN instance ON instance.environment = environment.resource_id
+		WHERE environment.row_status = 'NORMAL' AND instance.row_status = 'NORMAL'
+		ORDER BY environment."order", instance.id`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var environment scaleEnvironment
+		var instance scaleInstance
+		var engine string
+		if err := rows.Scan(&environment.id, &environment.resourceID, &environment.name, &instance.id, &instance.resourceID, &engine); err != nil {
+			return err
+		}
+		instance.engine = storepb.Engine(storepb.Engine_value[engine])
+		if n := len(g.environments); n > 0 && g.environments[n-1].id == environment.id {
+			g.environments[n-1].instances = append(g.environments[n-1].instances, &instance)
+			continue
+		}
+		environment.instances = []*scaleInstance{&instance}
+		g.environments = append(g.environments, &environment)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(g.environments) == 0 {
+		return errors.Errorf("no instance found in the demo data")
+	}
+	return nil
+}
+
+func (g *scaleGenerator) generateProject(ctx context.Context, index int) error {
+	projectID := getScaleProjectID(index)
+	projectUID, err := g.insert(ctx, `
+		INSERT INTO project (creator_id, updater_id, name, key, resource_id)
+		VALUES ($1, $1, $2, $3, $4)
+		RETURNING id`,
+		api.SystemBotID, fmt.Sprintf("Load Test %d", index), fmt.Sprintf("LT%d", index), projectID)
+	if err != nil {
+		return err
+	}
+
+	// databases[i][j] is the j-th database of the project in the i-th environment.
+	databases := make([][]*scaleDatabase, len(g.environments))
+	for i, environment := range g.environments {
+		for j := 0; j < scaleDatabasesPerEnvironment; j++ {
+			database := &scaleDatabase{
+				name:     fmt.Sprintf("load_test_%d_%d", index, j),
+				instance: environment.instances[j%len(environment.instances)],
+			}
+			if database.id, err = g.insert(ctx, `
+				INSERT INTO db (creator_id, updater_id, instance_id, project_id, sync_status, last_successful_sync_ts, schema_version, name)
+				VALUES ($1, $1, $2, $3, 'OK', $4, '', $5)
+				RETURNING id`,
+				api.SystemBotID, database.instance.id, projectUID, g.now.Unix(), database.name); err != nil {
+				return err
+			}
+			if g.rand.Float64() < scaleAnomalyRatio {
+				if err := g.generateAnomaly(ctx, database); err != nil {
+					return err
+				}
+			}
+			databases[i] = append(databases[i], database)
+		}
+	}
+
+	for number := 1; number <= scaleIssuesPerProject; number++ {
+		j := g.rand.Intn(scaleDatabasesPerEnvironment)
+		var targets []*scaleDatabase
+		for i := range g.environments {
+			targets = append(targets, databases[i][j])
+		}
+		if err := g.generateIssue(ctx, projectUID, projectID, number, targets); err != nil {
+			return errors.Wrapf(err, "failed to generate issue %d", number)
+		}
+	}
+	return nil
+}
+
+func (g *scaleGenerator) generateAnomaly(ctx context.Context, database *scaleDatabase) error {
+	anomalyType, payload := api.AnomalyDatabaseSchemaDrift, proto.Message(&storepb.AnomalyDatabaseSchemaDriftPayload{
+		Version: g.now.Format("20060102150405"),
+		Expect:  "CREATE TABLE employee (emp_no INTEGER PRIMARY KEY);\n",
+		Actual:  "CREATE TABLE employee (emp_no INTEGER PRIMARY KEY, nickname TEXT);\n",
+	})
+	if g.rand.Intn(2) == 0 {
+		anomalyType, payload = api.AnomalyDatabaseConnection, &storepb.AnomalyConnectionPayload{
+			Detail: fmt.Sprintf("failed to connect database %q: connection refused", database.name),
+		}
+	}
+	bytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = g.insert(ctx, `
+		INSERT INTO anomaly (creator_id, updater_id, instance_id, database_id, type, payload)
+		VALUES ($1, $1, $2, $3, $4, $5)
+		RETURNING id`,
+		api.SystemBotID, database.instance.id, database.id, anomalyType, string(bytes))
+	return err
+}
+
+// generateIssue generates the issue with its plan, rollout and task runs, the change is rolled out to the targets in the order of the environments.
+func (g *scaleGenerator) generateIssue(ctx context.Context, projectUID int, projectID string, number int, targets []*scaleDatabase) error {
+	scenario := g.pickScenario()
+	change := scaleStatements[g.rand.Intn(len(scaleStatements))]
+	creator := g.users[g.rand.Intn(len(g.users))]
+	assignee := g.users[g.rand.Intn(len(g.users))]
+	createdTs := g.now.Add(-time.Duration(g.rand.Int63n(int64(scaleHistory)))).Unix()
+	updatedTs := createdTs + g.rand.Int63n(int64(72*time.Hour/time.Second))
+	title := fmt.Sprintf("[%s] Load test change #%d", projectID, number)
+
+	sheetPayload, err := protojson.Marshal(&storepb.SheetPayload{Engine: targets[0].instance.engine})
+	if err != nil {
+		return err
+	}
+	sheetUID, err := g.insert(ctx, `
+		INSERT INTO sheet (creator_id, created_ts, updater_id, updated_ts, project_id, database_id, name, statement, payload)
+		VALUES ($1, $2, $1, $2, $3, $4, $5, $6, $7)
+		RETURNING id`,
+		creator, createdTs, projectUID, targets[0].id, title, change.statement, string(sheetPayload))
+	if err != nil {
+		return err
+	}
+
+	pipelineUID, err := g.insert(ctx, `
+		INSERT INTO pipeline (creator_id, created_ts, updater_id, updated_ts, project_id, name)
+		VALUES ($1, $2, $1, $3, $4, 'Rollout Pipeline')
+		RETURNING id`,
+		creator, createdTs, updatedTs, projectUID)
+	if err != nil {
+		return err
+	}
+	planConfig := &storepb.PlanConfig{}
+	var specIDs []string
+	for _, target := range targets {
+		specID := uuid.NewString()
+		specIDs = append(specIDs, specID)
+		planConfig.Steps = append(planConfig.Steps, &storepb.PlanConfig_Step{
+			Specs: []*storepb.PlanConfig_Spec{{
+				Id: specID,
+				Config: &storepb.PlanConfig_Spec_ChangeDatabaseConfig{
+					ChangeDatabaseConfig: &storepb.PlanConfig_ChangeDatabaseConfig{
+						Target: common.FormatDatabase(target.instance.resourceID, target.name),
+						Sheet:  common.FormatSheet(projectID, sheetUID),
+						Type:   change.changeType,
+					},
+				},
+			}},
+		})
+	}
+	config, err := protojson.Marshal(planConfig)
+	if err != nil {
+		return err
+	}
+	planUID, err := g.insert(ctx, `
+		INSERT INTO plan (creator_id, created_ts, updater_id, updated_ts, project_id, pipeline_id, name, description, config)
+		VALUES ($1, $2, $1, $3, $4, $5, $6, '', $7)
+		RETURNING id`,
+		creator, createdTs, updatedTs, projectUID, pipelineUID, title, string(config))
+	if err != nil {
+		return err
+	}
+
+	taskStatuses := g.getTaskStatuses(scenario, len(targets))
+	for i, target := range targets {
+		environment := g.environments[i]
+		stageUID, err := g.insert(ctx, `
+			INSERT INTO stage (creator_id, created_ts, updater_id, updated_ts, pipeline_id, environment_id, name)
+			VALUES ($1, $2, $1, $3, $4, $5, $6)
+			RETURNING id`,
+			creator, createdTs, updatedTs, pipelineUID, environment.id, environment.name)
+		if err != nil {
+			return err
+		}
+		taskPayload, err := protojson.Marshal(&storepb.TaskDatabaseUpdatePayload{
+			SpecId:        specIDs[i],
+			SheetId:       int32(sheetUID),
+			SchemaVersion: fmt.Sprintf("%s-%s", time.Unix(createdTs, 0).UTC().Format("20060102150405"), change.changeType),
+		})
+		if err != nil {
+			return err
+		}
+		taskUID, err := g.insert(ctx, `
+			INSERT INTO task (creator_id, created_ts, updater_id, updated_ts, pipeline_id, stage_id, instance_id, database_id, name, status, type, payload)
+			VALUES ($1, $2, $1, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			RETURNING id`,
+			creator, createdTs, updatedTs, pipelineUID, stageUID, target.instance.id, target.id,
+			fmt.Sprintf(change.taskName, target.name), taskStatuses[i], change.taskType, string(taskPayload))
+		if err != nil {
+			return err
+		}
+		if err := g.generateTaskRun(ctx, taskUID, creator, updatedTs, taskStatuses[i]); err != nil {
+			return err
+		}
+	}
+
+	issueStatus := api.IssueOpen
+	switch scenario {
+	case scenarioDone:
+		issueStatus = api.IssueDone
+	case scenarioCanceled:
+		issueStatus = api.IssueCanceled
+	}
+	issuePayload, err := protojson.Marshal(&storepb.IssuePayload{Approval: g.getApproval(scenario)})
+	if err != nil {
+		return err
+	}
+	_, err = g.insert(ctx, `
+		INSERT INTO issue (creator_id, created_ts, updater_id, updated_ts, project_id, plan_id, pipeline_id, number, name, status, type, description, assignee_id, payload)
+		VALUES ($1, $2, $1, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id`,
+		creator, createdTs, updatedTs, projectUID, planUID, pipelineUID, number, title, issueStatus, api.IssueDatabaseGeneral,
+		fmt.Sprintf("Apply %q to the databases in all environments.", change.statement), assignee, string(issuePayload))
+	return err
+}
+
+func (g *scaleGenerator) pickScenario() issueScenario {
+	total := 0
+	for _, weight := range scenarioWeights {
+		total += weight
+	}
+	n := g.rand.Intn(total)
+	// Iterate in the order of the scenarios so that the result only depends on the seed.
+	for scenario := scenarioAwaitingApproval; scenario <= scenarioCanceled; scenario++ {
+		if n < scenarioWeights[scenario] {
+			return scenario
+		}
+		n -= scenarioWeights[scenario]
+	}
+	return scenarioDone
+}
+
+// getTaskStatuses returns the statuses of the tasks in the order of the environments.
+func (g *scaleGenerator) getTaskStatuses(scenario issueScenario, count int) []api.TaskStatus {
+	statuses := make([]api.TaskStatus, count)
+	// done is the number of the environments which the change has been rolled out to.
+	done, next := 0, api.TaskPending
+	switch scenario {
+	case scenarioRollingOut:
+		done = g.rand.Intn(count)
+	case scenarioFailed:
+		done, next = g.rand.Intn(count), api.TaskFailed
+	case scenarioDone:
+		done = count
+	case scenarioCanceled:
+		done, next = g.rand.Intn(count), api.TaskCanceled
+	}
+	for i := range statuses {
+		switch {
+		case i < done:
+			statuses[i] = api.TaskDone
+		case i == done:
+			statuses[i] = next
+		case next == api.TaskCanceled:
+			statuses[i] = api.TaskCanceled
+		default:
+			statuses[i] = api.TaskPending
+		}
+	}
+	return statuses
+}
+
+func (g *scaleGenerator) generateTaskRun(ctx context.Context, taskUID, creator int, ts int64, status api.TaskStatus) error {
+	var runStatus, detail string
+	switch status {
+	case api.TaskDone:
+		runStatus, detail = string(api.TaskRunDone), "Applied the change successfully."
+	case api.TaskFailed:
+		runStatus, detail = string(api.TaskRunFailed), `pq: relation "employee" does not exist`
+	default:
+		return nil
+	}
+	result, err := protojson.Marshal(&storepb.TaskRunResult{Detail: detail})
+	if err != nil {
+		return err
+	}
+	_, err = g.insert(ctx, `
+		INSERT INTO task_run (creator_id, created_ts, updater_id, updated_ts, task_id, attempt, name, status, started_ts, code, result)
+		VALUES ($1, $2, $1, $2, $3, 0, $4, $5, $2, $6, $7)
+		RETURNING id`,
+		creator, ts, taskUID, fmt.Sprintf("load test task run %d", taskUID), runStatus, common.Ok, string(result))
+	return err
+}
+
+// getApproval returns the approval with a two-step flow, the project owner approves first and then the DBA.
+func (g *scaleGenerator) getApproval(scenario issueScenario) *storepb.IssuePayloadApproval {
+	approval := &storepb.IssuePayloadApproval{
+		ApprovalFindingDone: true,
+		RiskLevel:           storepb.IssuePayloadApproval_RiskLevel(1 + g.rand.Intn(3)),
+		ApprovalTemplates: []*storepb.ApprovalTemplate{{
+			Title:       "Project Owner -> Workspace DBA",
+			Description: "The system defines the approval process, first the project Owner approves, then the DBA approves.",
+			Flow: &storepb.ApprovalFlow{
+				Steps: []*storepb.ApprovalStep{
+					{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{{Type: storepb.ApprovalNode_ANY_IN_GROUP, Payload: &storepb.ApprovalNode_GroupValue_{GroupValue: storepb.ApprovalNode_PROJECT_OWNER}}}},
+					{Type: storepb.ApprovalStep_ANY, Nodes: []*storepb.ApprovalNode{{Type: storepb.ApprovalNode_ANY_IN_GROUP, Payload: &storepb.ApprovalNode_GroupValue_{GroupValue: storepb.ApprovalNode_WORKSPACE_DBA}}}},
+				},
+			},
+		}},
+	}
+	approve := func(status storepb.IssuePayloadApproval_Approver_Status) {
+		approval.Approvers = append(approval.Approvers, &storepb.IssuePayloadApproval_Approver{
+			Status:      status,
+			PrincipalId: int32(g.users[g.rand.Intn(len(g.users))]),
+		})
+	}
+	switch scenario {
+	case scenarioAwaitingApproval:
+		// Awaiting either the first or the second step.
+		if g.rand.Intn(2) == 0 {
+			approve(storepb.IssuePayloadApproval_Approver_APPROVED)
+		}
+	case scenarioRejected:
+		if g.rand.Intn(2) == 0 {
+			approve(storepb.IssuePayloadApproval_Approver_APPROVED)
+		}
+		approve(storepb.IssuePayloadApproval_Approver_REJECTED)
+	default:
+		approve(storepb.IssuePayloadApproval_Approver_APPROVED)
+		approve(storepb.IssuePayloadApproval_Approver_APPROVED)
+	}
+	return approval
+}
+
+func (g *scaleGenerator) insert(ctx context.Context, query string, args ...any) (int, error) {
+	var id int
+	if err := g.tx.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func getScaleProjectID(index int) string {
+	return fmt.Sprintf("load-test-%d", index)
+}
@@ -11,6 +11,9 @@ const (
 	// ActivityPipelineRollout is the type for notifying releasers to rollout.
 	// Will not be stored. Only used for notification.
 	ActivityNotifyPipelineRollout ActivityType = "bb.notify.pipeline.rollout"
+	// ActivityNotifyReleaseComplete is the type for notifying that all issues in a release are done.
+	// Will not be stored. Only used for notification.
+	ActivityNotifyReleaseComplete ActivityType = "bb.notify.release.complete"
 
 	// Issue related.
 
@@ -59,6 +62,17 @@ const (
 	// ActivityMemberDeactivate is the type for deactivating members.
 	ActivityMemberDeactivate ActivityType = "bb.member.deactivate"
 
+	// Security related.
+
+	// ActivitySecuritySignInFailed is the type for failed sign-in attempts.
+	ActivitySecuritySignInFailed ActivityType = "bb.security.signin.failed"
+	// ActivitySecurityUserLocked is the type for locking out users after too many failed sign-in attempts.
+	ActivitySecurityUserLocked ActivityType = "bb.security.user.locked"
+	// ActivitySecurityNewDeviceSignIn is the type for signing in from a new device.
+	ActivitySecurityNewDeviceSignIn ActivityType = "bb.security.signin.new-device"
+	// ActivitySecurityTokenNewIP is the type for using service account tokens from a new IP address.
+	ActivitySecurityTokenNewIP ActivityType = "bb.security.token.new-ip"
+
 	// Project related.
 
 	// ActivityProjectRepositoryPush is the type for pushing repositories.
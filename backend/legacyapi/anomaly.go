@@ -8,8 +8,16 @@ const (
 	AnomalyInstanceConnection AnomalyType = "bb.anomaly.instance.connection"
 	// AnomalyInstanceMigrationSchema is the anomaly type for schema migrations.
 	AnomalyInstanceMigrationSchema AnomalyType = "bb.anomaly.instance.migration-schema"
+	// AnomalyInstanceConnectionBudget is the anomaly type for exceeding the instance connection budget.
+	AnomalyInstanceConnectionBudget AnomalyType = "bb.anomaly.instance.connection-budget"
+	// AnomalyInstanceLongRunningQuery is the anomaly type for the queries running too long or blocking other queries.
+	AnomalyInstanceLongRunningQuery AnomalyType = "bb.anomaly.instance.long-running-query"
+	// AnomalyInstanceFailover is the anomaly type for the changed primary replica of the availability group.
+	AnomalyInstanceFailover AnomalyType = "bb.anomaly.instance.failover"
 	// AnomalyDatabaseConnection is the anomaly type for database connections.
 	AnomalyDatabaseConnection AnomalyType = "bb.anomaly.database.connection"
 	// AnomalyDatabaseSchemaDrift is the anomaly type for database schema drifts.
 	AnomalyDatabaseSchemaDrift AnomalyType = "bb.anomaly.database.schema.drift"
+	// AnomalyDatabaseBackupFailed is the anomaly type for database backup failures.
+	AnomalyDatabaseBackupFailed AnomalyType = "bb.anomaly.database.backup.failed"
 )
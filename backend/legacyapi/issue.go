@@ -28,6 +28,15 @@ const (
 
 	// IssueDatabaseDataExport is the issue type for requesting data export.
 	IssueDatabaseDataExport IssueType = "bb.issue.database.data-export"
+
+	// IssueDatabaseRestore is the issue type for restoring databases from backups.
+	IssueDatabaseRestore IssueType = "bb.issue.database.restore"
+
+	// IssueDatabaseDataLoad is the issue type for bulk loading data files into tables.
+	IssueDatabaseDataLoad IssueType = "bb.issue.database.data-load"
+
+	// IssueDatabaseCreate is the issue type for provisioning databases.
+	IssueDatabaseCreate IssueType = "bb.issue.database.create"
 )
 
 func (t IssueType) String() string {
@@ -41,6 +41,10 @@ const (
 	PolicyTypeTag PolicyType = "bb.policy.tag"
 	// PolicyTypeDataSourceQuery is the policy type for data source query.
 	PolicyTypeDataSourceQuery PolicyType = "bb.policy.data-source-query"
+	// PolicyTypePIIDetection is the policy type for detecting personally identifiable information during the schema sync.
+	PolicyTypePIIDetection PolicyType = "bb.policy.pii-detection"
+	// PolicyTypeRowAccess is the policy type for filtering the rows of the tables queried in SQL Editor.
+	PolicyTypeRowAccess PolicyType = "bb.policy.row-access"
 
 	// PipelineApprovalValueManualNever means the pipeline will automatically be approved without user intervention.
 	PipelineApprovalValueManualNever PipelineApprovalValue = "MANUAL_APPROVAL_NEVER"
@@ -78,6 +82,8 @@ var (
 		PolicyTypeMasking:                           {PolicyResourceTypeDatabase},
 		PolicyTypeSlowQuery:                         {PolicyResourceTypeInstance},
 		PolicyTypeDisableCopyData:                   {PolicyResourceTypeEnvironment, PolicyResourceTypeProject},
+		PolicyTypePIIDetection:                      {PolicyResourceTypeEnvironment},
+		PolicyTypeRowAccess:                         {PolicyResourceTypeDatabase},
 		PolicyTypeMaskingRule:                       {PolicyResourceTypeWorkspace},
 		PolicyTypeMaskingException:                  {PolicyResourceTypeProject},
 		PolicyTypeRestrictIssueCreationForSQLReview: {PolicyResourceTypeWorkspace, PolicyResourceTypeProject},
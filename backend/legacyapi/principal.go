@@ -31,4 +31,6 @@ const (
 
 	// ServiceAccountAccessKeyPrefix is the prefix for service account access key.
 	ServiceAccountAccessKeyPrefix = "bbs_"
+	// ServiceAccountTokenPrefix is the prefix for the scoped service account token.
+	ServiceAccountTokenPrefix = "bbt_"
 )
@@ -42,4 +42,20 @@ const (
 	SettingMaskingAlgorithm SettingName = "bb.workspace.masking-algorithm"
 	// SettingSQLResultSizeLimit is the setting name for SQL query result size limit.
 	SettingSQLResultSizeLimit SettingName = "bb.workspace.maximum-sql-result-size"
+	// SettingEncryptionKey is the setting name for the wrapped data encryption keys.
+	SettingEncryptionKey SettingName = "bb.workspace.encryption-key"
+	// SettingEnvironmentPipeline is the setting name for the environment promotion pipeline.
+	SettingEnvironmentPipeline SettingName = "bb.workspace.environment-pipeline"
+	// SettingSheetStorage is the setting name for storing the large sheets in the object storage.
+	SettingSheetStorage SettingName = "bb.workspace.sheet-storage"
+	// SettingPlanCheck is the setting name for running the plan checks.
+	SettingPlanCheck SettingName = "bb.workspace.plan-check"
+	// SettingEventBus is the setting name for mirroring the workspace events to the event buses.
+	SettingEventBus SettingName = "bb.workspace.event-bus"
+	// SettingRateLimit is the setting name for limiting the API request rate of the principals.
+	SettingRateLimit SettingName = "bb.workspace.rate-limit"
+	// SettingReadAudit is the setting name for auditing the read requests to the sensitive projects.
+	SettingReadAudit SettingName = "bb.workspace.read-audit"
+	// SettingAI is the setting name for the AI provider.
+	SettingAI SettingName = "bb.workspace.ai"
 )
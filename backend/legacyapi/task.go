@@ -50,6 +50,10 @@ const (
 	TaskDatabaseDataUpdate TaskType = "bb.task.database.data.update"
 	// TaskDatabaseDataExport is the task type for exporting database data.
 	TaskDatabaseDataExport TaskType = "bb.task.database.data.export"
+	// TaskDatabaseRestore is the task type for restoring databases from backups.
+	TaskDatabaseRestore TaskType = "bb.task.database.restore"
+	// TaskDatabaseDataLoad is the task type for loading data files from the object storage into a table.
+	TaskDatabaseDataLoad TaskType = "bb.task.database.data.load"
 )
 
 // Sequetial returns whether the task should be executed sequentially.
@@ -137,6 +141,11 @@ type TaskPatch struct {
 
 	// Flags for gh-ost.
 	Flags *map[string]string
+
+	VerificationQueries *[]*storepb.VerificationQuery
+
+	TransactionMode  *storepb.TransactionMode
+	SessionVariables *map[string]string
 }
 
 func GetSheetUIDFromTaskPayload(payload string) (*int, error) {
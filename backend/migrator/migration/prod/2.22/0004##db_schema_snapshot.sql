@@ -0,0 +1,11 @@
+CREATE TABLE db_schema_snapshot (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    metadata JSONB NOT NULL DEFAULT '{}',
+    raw_dump TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_db_schema_snapshot_database_id_created_ts ON db_schema_snapshot(database_id, created_ts);
+
+ALTER SEQUENCE db_schema_snapshot_id_seq RESTART WITH 101;
@@ -0,0 +1,14 @@
+CREATE TABLE service_account_token (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id) ON DELETE CASCADE,
+    title TEXT NOT NULL DEFAULT '',
+    token_hash TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_service_account_token_unique_token_hash ON service_account_token(token_hash);
+
+CREATE INDEX idx_service_account_token_principal_id ON service_account_token(principal_id);
+
+ALTER SEQUENCE service_account_token_id_seq RESTART WITH 101;
@@ -0,0 +1,9 @@
+CREATE TABLE principal_session (
+    id TEXT PRIMARY KEY,
+    principal_id INTEGER NOT NULL REFERENCES principal (id) ON DELETE CASCADE,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    expires_ts BIGINT NOT NULL,
+    revoked BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_principal_session_principal_id ON principal_session(principal_id);
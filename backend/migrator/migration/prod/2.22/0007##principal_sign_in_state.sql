@@ -0,0 +1,5 @@
+CREATE TABLE principal_sign_in_state (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id) ON DELETE CASCADE,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
@@ -0,0 +1,13 @@
+CREATE TABLE classification_suggestion (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    schema_name TEXT NOT NULL,
+    table_name TEXT NOT NULL,
+    column_name TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_classification_suggestion_unique_column ON classification_suggestion(database_id, schema_name, table_name, column_name);
+
+ALTER SEQUENCE classification_suggestion_id_seq RESTART WITH 101;
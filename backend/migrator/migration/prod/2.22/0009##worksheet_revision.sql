@@ -0,0 +1,12 @@
+CREATE TABLE worksheet_revision (
+    id SERIAL PRIMARY KEY,
+    worksheet_id INTEGER NOT NULL REFERENCES worksheet (id) ON DELETE CASCADE,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    name TEXT NOT NULL,
+    statement TEXT NOT NULL
+);
+
+CREATE INDEX idx_worksheet_revision_worksheet_id ON worksheet_revision(worksheet_id);
+
+ALTER SEQUENCE worksheet_revision_id_seq RESTART WITH 101;
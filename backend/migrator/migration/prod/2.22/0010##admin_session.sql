@@ -0,0 +1,24 @@
+CREATE TABLE admin_session (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    db_name TEXT NOT NULL DEFAULT '',
+    justification TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_admin_session_instance_id ON admin_session(instance_id);
+
+ALTER SEQUENCE admin_session_id_seq RESTART WITH 101;
+
+CREATE TABLE admin_session_statement (
+    id BIGSERIAL PRIMARY KEY,
+    session_id INTEGER NOT NULL REFERENCES admin_session (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    statement TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_admin_session_statement_session_id ON admin_session_statement(session_id);
+
+ALTER SEQUENCE admin_session_statement_id_seq RESTART WITH 101;
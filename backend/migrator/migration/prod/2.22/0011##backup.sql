@@ -0,0 +1,28 @@
+CREATE TABLE backup_setting (
+    id SERIAL PRIMARY KEY,
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_backup_setting_unique_database_id ON backup_setting(database_id);
+
+ALTER SEQUENCE backup_setting_id_seq RESTART WITH 101;
+
+CREATE TABLE backup_run (
+    id SERIAL PRIMARY KEY,
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'DONE', 'FAILED', 'DELETED')),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_backup_run_database_id ON backup_run(database_id);
+
+CREATE INDEX idx_backup_run_status ON backup_run(status);
+
+ALTER SEQUENCE backup_run_id_seq RESTART WITH 101;
@@ -0,0 +1,16 @@
+CREATE TABLE db_group_revision (
+    id SERIAL PRIMARY KEY,
+    db_group_id BIGINT NOT NULL REFERENCES db_group (id) ON DELETE CASCADE,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    placeholder TEXT NOT NULL DEFAULT '',
+    expression JSONB NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_db_group_revision_db_group_id ON db_group_revision(db_group_id);
+
+ALTER SEQUENCE db_group_revision_id_seq RESTART WITH 101;
+
+INSERT INTO db_group_revision (db_group_id, creator_id, created_ts, placeholder, expression, payload)
+SELECT id, updater_id, updated_ts, placeholder, expression, payload FROM db_group;
@@ -0,0 +1,13 @@
+ALTER TABLE issue ADD COLUMN number INTEGER;
+
+UPDATE issue
+SET number = numbered.number
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY project_id ORDER BY id) AS number
+    FROM issue
+) AS numbered
+WHERE issue.id = numbered.id;
+
+ALTER TABLE issue ALTER COLUMN number SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_issue_unique_project_id_number ON issue(project_id, number);
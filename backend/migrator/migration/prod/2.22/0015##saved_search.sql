@@ -0,0 +1,16 @@
+CREATE TABLE saved_search (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- project_id is set if the saved search is shared with the project members.
+    project_id INTEGER REFERENCES project (id),
+    name TEXT NOT NULL,
+    filter TEXT NOT NULL
+);
+
+CREATE INDEX idx_saved_search_creator_id ON saved_search(creator_id);
+
+CREATE INDEX idx_saved_search_project_id ON saved_search(project_id);
+
+ALTER SEQUENCE saved_search_id_seq RESTART WITH 101;
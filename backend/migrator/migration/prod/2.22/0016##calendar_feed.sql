@@ -0,0 +1,7 @@
+CREATE TABLE calendar_feed (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id) ON DELETE CASCADE,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    token_hash TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_calendar_feed_unique_token_hash ON calendar_feed(token_hash);
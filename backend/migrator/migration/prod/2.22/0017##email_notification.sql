@@ -0,0 +1,14 @@
+CREATE TABLE principal_notification_setting (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id) ON DELETE CASCADE,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE email_notification (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id) ON DELETE CASCADE,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_email_notification_principal_id ON email_notification(principal_id);
@@ -0,0 +1,9 @@
+CREATE TABLE task_run_log_file (
+    task_run_id INTEGER PRIMARY KEY REFERENCES task_run (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- size is the size of the uncompressed content.
+    size BIGINT NOT NULL,
+    truncated BOOLEAN NOT NULL DEFAULT FALSE,
+    -- content is the gzip compressed text log.
+    content BYTEA NOT NULL
+);
@@ -0,0 +1,7 @@
+CREATE TABLE stage_approval (
+    stage_id INTEGER PRIMARY KEY REFERENCES stage (id),
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    status TEXT NOT NULL CHECK (status IN ('APPROVED', 'REJECTED')),
+    comment TEXT NOT NULL DEFAULT ''
+);
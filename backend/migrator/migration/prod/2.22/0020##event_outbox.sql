@@ -0,0 +1,8 @@
+CREATE TABLE event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    destination TEXT NOT NULL,
+    payload BYTEA NOT NULL
+);
+
+CREATE INDEX idx_event_outbox_destination ON event_outbox(destination);
@@ -0,0 +1,7 @@
+CREATE TABLE task_run_statement (
+    task_run_id INTEGER NOT NULL REFERENCES task_run (id),
+    -- command_index is the index of the statement in the sheet.
+    command_index INTEGER NOT NULL,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    PRIMARY KEY (task_run_id, command_index)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE issue_comment_revision (
+    id BIGSERIAL PRIMARY KEY,
+    issue_comment_id BIGINT NOT NULL REFERENCES issue_comment (id),
+    -- creator_id and created_ts are the author and the time of the revision, i.e. the updater and the updated time of the comment before it's edited or deleted.
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    comment TEXT NOT NULL
+);
+
+CREATE INDEX idx_issue_comment_revision_issue_comment_id ON issue_comment_revision(issue_comment_id);
@@ -81,6 +81,81 @@ VALUES
 
 ALTER SEQUENCE principal_id_seq RESTART WITH 101;
 
+-- service_account_token stores the scoped tokens of service accounts.
+CREATE TABLE service_account_token (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id) ON DELETE CASCADE,
+    title TEXT NOT NULL DEFAULT '',
+    token_hash TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_service_account_token_unique_token_hash ON service_account_token(token_hash);
+
+CREATE INDEX idx_service_account_token_principal_id ON service_account_token(principal_id);
+
+ALTER SEQUENCE service_account_token_id_seq RESTART WITH 101;
+
+-- principal_session stores the sign-in sessions of users, the id is the JWT ID of the access token.
+CREATE TABLE principal_session (
+    id TEXT PRIMARY KEY,
+    principal_id INTEGER NOT NULL REFERENCES principal (id) ON DELETE CASCADE,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    expires_ts BIGINT NOT NULL,
+    revoked BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX idx_principal_session_principal_id ON principal_session(principal_id);
+
+-- principal_sign_in_state stores the failed sign-in attempts, lockout and known devices of users.
+CREATE TABLE principal_sign_in_state (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id) ON DELETE CASCADE,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+-- calendar_feed stores the hash of the secret token in the iCalendar feed URL of users.
+CREATE TABLE calendar_feed (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id) ON DELETE CASCADE,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    token_hash TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_calendar_feed_unique_token_hash ON calendar_feed(token_hash);
+
+-- principal_notification_setting stores the email notification setting of users.
+CREATE TABLE principal_notification_setting (
+    principal_id INTEGER PRIMARY KEY REFERENCES principal (id) ON DELETE CASCADE,
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+-- email_notification stores the email notifications of issue events waiting to be sent.
+CREATE TABLE email_notification (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    principal_id INTEGER NOT NULL REFERENCES principal (id) ON DELETE CASCADE,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_email_notification_principal_id ON email_notification(principal_id);
+
+ALTER SEQUENCE email_notification_id_seq RESTART WITH 101;
+
+-- event_outbox stores the workspace events waiting to be published to the event bus destinations.
+-- The payload is the protobuf encoded bytebase.v1.WorkspaceEvent.
+CREATE TABLE event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    destination TEXT NOT NULL,
+    payload BYTEA NOT NULL
+);
+
+CREATE INDEX idx_event_outbox_destination ON event_outbox(destination);
+
+ALTER SEQUENCE event_outbox_id_seq RESTART WITH 101;
+
 -- Setting
 CREATE TABLE setting (
     id SERIAL PRIMARY KEY,
@@ -326,6 +401,34 @@ CREATE UNIQUE INDEX idx_db_schema_unique_database_id ON db_schema(database_id);
 
 ALTER SEQUENCE db_schema_id_seq RESTART WITH 101;
 
+-- db_schema_snapshot stores the periodic full schema snapshots of databases for point-in-time schema browsing.
+CREATE TABLE db_schema_snapshot (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    metadata JSONB NOT NULL DEFAULT '{}',
+    raw_dump TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_db_schema_snapshot_database_id_created_ts ON db_schema_snapshot(database_id, created_ts);
+
+ALTER SEQUENCE db_schema_snapshot_id_seq RESTART WITH 101;
+
+-- classification_suggestion stores the sensitive data classifications suggested by the PII detection of the schema sync.
+CREATE TABLE classification_suggestion (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    database_id INTEGER NOT NULL REFERENCES db (id) ON DELETE CASCADE,
+    schema_name TEXT NOT NULL,
+    table_name TEXT NOT NULL,
+    column_name TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_classification_suggestion_unique_column ON classification_suggestion(database_id, schema_name, table_name, column_name);
+
+ALTER SEQUENCE classification_suggestion_id_seq RESTART WITH 101;
+
 -- data_source table stores the data source for a particular database
 CREATE TABLE data_source (
     id SERIAL PRIMARY KEY,
@@ -412,6 +515,15 @@ CREATE INDEX idx_stage_pipeline_id ON stage(pipeline_id);
 
 ALTER SEQUENCE stage_id_seq RESTART WITH 101;
 
+-- stage_approval stores the manual approval of the stage required by the rollout policy.
+CREATE TABLE stage_approval (
+    stage_id INTEGER PRIMARY KEY REFERENCES stage (id),
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    status TEXT NOT NULL CHECK (status IN ('APPROVED', 'REJECTED')),
+    comment TEXT NOT NULL DEFAULT ''
+);
+
 -- task table stores the task for the stage
 CREATE TABLE task (
     id SERIAL PRIMARY KEY,
@@ -492,6 +604,26 @@ CREATE INDEX idx_task_run_log_task_run_id ON task_run_log(task_run_id);
 
 ALTER SEQUENCE task_run_log_id_seq RESTART WITH 101;
 
+-- task_run_log_file stores the full text log of the finished task runs.
+CREATE TABLE task_run_log_file (
+    task_run_id INTEGER PRIMARY KEY REFERENCES task_run (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- size is the size of the uncompressed content.
+    size BIGINT NOT NULL,
+    truncated BOOLEAN NOT NULL DEFAULT FALSE,
+    -- content is the gzip compressed text log.
+    content BYTEA NOT NULL
+);
+
+-- task_run_statement is the ledger of the statements committed by the task runs, the interrupted task runs resume from the next statement.
+CREATE TABLE task_run_statement (
+    task_run_id INTEGER NOT NULL REFERENCES task_run (id),
+    -- command_index is the index of the statement in the sheet.
+    command_index INTEGER NOT NULL,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    PRIMARY KEY (task_run_id, command_index)
+);
+
 -- Pipeline related END
 -----------------------
 -- Plan related BEGIN
@@ -546,6 +678,8 @@ CREATE TABLE issue (
     project_id INTEGER NOT NULL REFERENCES project (id),
     plan_id BIGINT REFERENCES plan (id),
     pipeline_id INTEGER REFERENCES pipeline (id),
+    -- number is the sequential number of the issue in the project, e.g. 142 in PAY-142.
+    number INTEGER NOT NULL,
     name TEXT NOT NULL,
     status TEXT NOT NULL CHECK (status IN ('OPEN', 'DONE', 'CANCELED')),
     type TEXT NOT NULL CHECK (type LIKE 'bb.issue.%'),
@@ -570,6 +704,8 @@ CREATE INDEX idx_issue_created_ts ON issue(created_ts);
 
 CREATE INDEX idx_issue_ts_vector ON issue USING GIN(ts_vector);
 
+CREATE UNIQUE INDEX idx_issue_unique_project_id_number ON issue(project_id, number);
+
 ALTER SEQUENCE issue_id_seq RESTART WITH 101;
 
 -- stores the issue subscribers. Unlike other tables, it doesn't have row_status/creator_id/created_ts/updater_id/updated_ts.
@@ -691,6 +827,20 @@ CREATE INDEX idx_issue_comment_issue_id ON issue_comment(issue_id);
 
 ALTER SEQUENCE issue_comment_id_seq RESTART WITH 101;
 
+-- issue_comment_revision is the history of the issue comments, the previous comment is recorded when it's edited or deleted.
+CREATE TABLE issue_comment_revision (
+    id BIGSERIAL PRIMARY KEY,
+    issue_comment_id BIGINT NOT NULL REFERENCES issue_comment (id),
+    -- creator_id and created_ts are the author and the time of the revision, i.e. the updater and the updated time of the comment before it's edited or deleted.
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    comment TEXT NOT NULL
+);
+
+CREATE INDEX idx_issue_comment_revision_issue_comment_id ON issue_comment_revision(issue_comment_id);
+
+ALTER SEQUENCE issue_comment_revision_id_seq RESTART WITH 101;
+
 CREATE TABLE query_history (
     id BIGSERIAL PRIMARY KEY,
     row_status row_status NOT NULL DEFAULT 'NORMAL',
@@ -816,6 +966,78 @@ CREATE UNIQUE INDEX idx_worksheet_organizer_unique_sheet_id_principal_id ON work
 
 CREATE INDEX idx_worksheet_organizer_principal_id ON worksheet_organizer(principal_id);
 
+-- worksheet_revision table stores the previous versions of the worksheet content.
+CREATE TABLE worksheet_revision (
+    id SERIAL PRIMARY KEY,
+    worksheet_id INTEGER NOT NULL REFERENCES worksheet (id) ON DELETE CASCADE,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    name TEXT NOT NULL,
+    statement TEXT NOT NULL
+);
+
+CREATE INDEX idx_worksheet_revision_worksheet_id ON worksheet_revision(worksheet_id);
+
+ALTER SEQUENCE worksheet_revision_id_seq RESTART WITH 101;
+
+-- admin_session table stores the SQL Editor admin mode sessions, the sessions and the statements are never changed.
+CREATE TABLE admin_session (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    instance_id INTEGER NOT NULL REFERENCES instance (id),
+    db_name TEXT NOT NULL DEFAULT '',
+    justification TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_admin_session_instance_id ON admin_session(instance_id);
+
+ALTER SEQUENCE admin_session_id_seq RESTART WITH 101;
+
+-- admin_session_statement table stores the statements executed in the admin session.
+CREATE TABLE admin_session_statement (
+    id BIGSERIAL PRIMARY KEY,
+    session_id INTEGER NOT NULL REFERENCES admin_session (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    statement TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_admin_session_statement_session_id ON admin_session_statement(session_id);
+
+ALTER SEQUENCE admin_session_statement_id_seq RESTART WITH 101;
+
+-- backup_setting table stores the scheduled logical backup setting of the database.
+CREATE TABLE backup_setting (
+    id SERIAL PRIMARY KEY,
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_backup_setting_unique_database_id ON backup_setting(database_id);
+
+ALTER SEQUENCE backup_setting_id_seq RESTART WITH 101;
+
+-- backup_run table stores the logical backups of the database.
+CREATE TABLE backup_run (
+    id SERIAL PRIMARY KEY,
+    database_id INTEGER NOT NULL REFERENCES db (id),
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'RUNNING', 'DONE', 'FAILED', 'DELETED')),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_backup_run_database_id ON backup_run(database_id);
+
+CREATE INDEX idx_backup_run_status ON backup_run(status);
+
+ALTER SEQUENCE backup_run_id_seq RESTART WITH 101;
+
 -- external_approval stores approval instances of third party applications.
 CREATE TABLE external_approval ( 
     id SERIAL PRIMARY KEY,
@@ -897,6 +1119,21 @@ CREATE UNIQUE INDEX idx_db_group_unique_project_id_placeholder ON db_group(proje
 
 ALTER SEQUENCE db_group_id_seq RESTART WITH 101;
 
+-- db_group_revision table stores the definitions of the database group each time it is created or changed.
+CREATE TABLE db_group_revision (
+    id SERIAL PRIMARY KEY,
+    db_group_id BIGINT NOT NULL REFERENCES db_group (id) ON DELETE CASCADE,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    placeholder TEXT NOT NULL DEFAULT '',
+    expression JSONB NOT NULL DEFAULT '{}',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_db_group_revision_db_group_id ON db_group_revision(db_group_id);
+
+ALTER SEQUENCE db_group_revision_id_seq RESTART WITH 101;
+
 -- changelist table stores project changelists.
 CREATE TABLE changelist (
     id SERIAL PRIMARY KEY,
@@ -914,6 +1151,41 @@ CREATE UNIQUE INDEX idx_changelist_project_id_name ON changelist(project_id, nam
 
 ALTER SEQUENCE changelist_id_seq RESTART WITH 101;
 
+-- release table stores project releases grouping issues.
+CREATE TABLE release (
+    id SERIAL PRIMARY KEY,
+    row_status row_status NOT NULL DEFAULT 'NORMAL',
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updater_id INTEGER NOT NULL REFERENCES principal (id),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    project_id INTEGER NOT NULL REFERENCES project (id),
+    name TEXT NOT NULL,
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_release_project_id_name ON release(project_id, name);
+
+ALTER SEQUENCE release_id_seq RESTART WITH 101;
+
+-- saved_search table stores the saved issue search filters of users.
+CREATE TABLE saved_search (
+    id SERIAL PRIMARY KEY,
+    creator_id INTEGER NOT NULL REFERENCES principal (id),
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- project_id is set if the saved search is shared with the project members.
+    project_id INTEGER REFERENCES project (id),
+    name TEXT NOT NULL,
+    filter TEXT NOT NULL
+);
+
+CREATE INDEX idx_saved_search_creator_id ON saved_search(creator_id);
+
+CREATE INDEX idx_saved_search_project_id ON saved_search(project_id);
+
+ALTER SEQUENCE saved_search_id_seq RESTART WITH 101;
+
 CREATE TABLE branch (
   id SERIAL PRIMARY KEY,
   row_status row_status NOT NULL DEFAULT 'NORMAL',
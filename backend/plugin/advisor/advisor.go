@@ -44,6 +44,7 @@ const (
 
 // Context is the context for advisor.
 type Context struct {
+	DBType                storepb.Engine
 	Charset               string
 	Collation             string
 	DBSchema              *storepb.DatabaseSchemaMetadata
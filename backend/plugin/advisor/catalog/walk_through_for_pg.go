@@ -948,6 +948,8 @@ func getIndexMethod(method ast.IndexMethodType) string {
 		return "brin"
 	case ast.IndexMethodTypeIvfflat:
 		return "ivfflat"
+	case ast.IndexMethodTypeHnsw:
+		return "hnsw"
 	}
 	return ""
 }
@@ -0,0 +1,163 @@
+// Package clickhouse is the advisor for ClickHouse database.
+package clickhouse
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	identifierPattern = "(?:`[^`]+`|\"[^\"]+\"|[A-Za-z_][A-Za-z0-9_$]*)"
+	tableNamePattern  = "(" + identifierPattern + `(?:\.` + identifierPattern + ")?)"
+)
+
+var (
+	createTableRegexp = regexp.MustCompile(`(?i)^CREATE (?:OR REPLACE )?(?:TEMPORARY )?TABLE (?:IF NOT EXISTS )?` + tableNamePattern)
+	tableEngineRegexp = regexp.MustCompile(`(?i)\bENGINE ?= ?([A-Za-z0-9_]+)`)
+	asSelectRegexp    = regexp.MustCompile(`(?i)\bAS \(?SELECT\b`)
+)
+
+// createTableStatement is the table definition extracted from a CREATE TABLE statement.
+type createTableStatement struct {
+	table  string
+	engine string
+	// clauses is the normalized text after the ENGINE clause, such as ORDER BY, PARTITION BY and TTL.
+	clauses string
+	// text is the whole normalized statement.
+	text string
+}
+
+// isMergeTreeFamily returns true if the table uses a MergeTree family table engine,
+// such as MergeTree, ReplacingMergeTree and ReplicatedMergeTree.
+func (s *createTableStatement) isMergeTreeFamily() bool {
+	return strings.HasSuffix(strings.ToLower(s.engine), "mergetree")
+}
+
+func getSingleSQLList(ast any) ([]base.SingleSQL, error) {
+	list, ok := ast.([]base.SingleSQL)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to SingleSQL list")
+	}
+	return list, nil
+}
+
+// getLine returns the 1-based line of the first non-comment line of the statement.
+func getLine(sql base.SingleSQL) int32 {
+	return int32(sql.FirstStatementLine + 1)
+}
+
+// parseCreateTable extracts the table definition from the normalized statement.
+// It returns nil if the statement is not a CREATE TABLE statement with an explicit table engine.
+func parseCreateTable(text string) *createTableStatement {
+	match := createTableRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	loc := tableEngineRegexp.FindStringSubmatchIndex(text)
+	if loc == nil {
+		return nil
+	}
+	clauses := text[loc[1]:]
+	if idx := asSelectRegexp.FindStringIndex(clauses); idx != nil {
+		clauses = clauses[:idx[0]]
+	}
+	return &createTableStatement{
+		table:   normalizeTableName(match[1]),
+		engine:  text[loc[2]:loc[3]],
+		clauses: clauses,
+		text:    text,
+	}
+}
+
+// normalizeTableName removes the database qualifier and the quotes of the table name.
+func normalizeTableName(name string) string {
+	var sb strings.Builder
+	var quote rune
+	for _, r := range name {
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			_, _ = sb.WriteRune(r)
+		case r == '`' || r == '"':
+			quote = r
+		case r == '.':
+			sb.Reset()
+		default:
+			_, _ = sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// normalizeStatement removes the comments and the string literal contents of the statement,
+// and collapses the blanks, so that the advisors could match the clauses by regular expressions.
+func normalizeStatement(statement string) string {
+	var sb strings.Builder
+	runes := []rune(statement)
+	blank := false
+	writeRune := func(r rune) {
+		if blank && sb.Len() > 0 {
+			_, _ = sb.WriteRune(' ')
+		}
+		blank = false
+		_, _ = sb.WriteRune(r)
+	}
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			blank = true
+		case runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			blank = true
+		case runes[i] == '\'':
+			writeRune('\'')
+			i++
+			for i < len(runes) && runes[i] != '\'' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			_, _ = sb.WriteRune('\'')
+		case runes[i] == '`' || runes[i] == '"':
+			quote := runes[i]
+			writeRune(quote)
+			i++
+			for i < len(runes) && runes[i] != quote {
+				_, _ = sb.WriteRune(runes[i])
+				i++
+			}
+			_, _ = sb.WriteRune(quote)
+		case runes[i] == ' ' || runes[i] == '\t' || runes[i] == '\n' || runes[i] == '\r':
+			blank = true
+		default:
+			writeRune(runes[i])
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// findTable returns the table metadata by name in the database schema, or nil if not found.
+func findTable(dbSchema *storepb.DatabaseSchemaMetadata, tableName string) *storepb.TableMetadata {
+	for _, schema := range dbSchema.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			if table.GetName() == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package clickhouse
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*MutationRowLimitAdvisor)(nil)
+
+	alterTableMutationRegexp = regexp.MustCompile(`(?i)^ALTER TABLE ` + tableNamePattern + `(?: ON CLUSTER \S+)? (?:UPDATE|DELETE)\b`)
+	lightweightDeleteRegexp  = regexp.MustCompile(`(?i)^DELETE FROM ` + tableNamePattern)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseMutationRowLimit, &MutationRowLimitAdvisor{})
+}
+
+// MutationRowLimitAdvisor is the advisor checking for the mutations on large tables.
+type MutationRowLimitAdvisor struct {
+}
+
+// Check checks for the mutations on large tables.
+// The mutations rewrite the whole data parts in the background and cannot be rolled back,
+// so they are expensive for large tables.
+func (*MutationRowLimitAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		match := alterTableMutationRegexp.FindStringSubmatch(text)
+		if match == nil {
+			match = lightweightDeleteRegexp.FindStringSubmatch(text)
+		}
+		if match == nil {
+			continue
+		}
+		tableName := normalizeTableName(match[1])
+		table := findTable(ctx.DBSchema, tableName)
+		if table == nil || table.GetRowCount() < int64(payload.Number) {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.StatementMutationOnLargeTable.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("Mutation on table %q (%d rows) rewrites the data parts and cannot be rolled back, the limit is %d rows", tableName, table.GetRowCount(), payload.Number),
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
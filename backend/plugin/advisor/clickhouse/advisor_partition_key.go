@@ -0,0 +1,95 @@
+package clickhouse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*PartitionKeyAdvisor)(nil)
+
+	partitionByRegexp = regexp.MustCompile(`(?i)\bPARTITION BY (.+?)(?: (?:ORDER BY|PRIMARY KEY|SAMPLE BY|TTL|SETTINGS|COMMENT)\b|;|$)`)
+	functionRegexp    = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_]*) ?\(`)
+
+	// fineGrainedPartitionFunctions are the functions that usually produce too many partitions.
+	// ClickHouse recommends the partition key not more granular than by month.
+	fineGrainedPartitionFunctions = map[string]bool{
+		"tostartofsecond":         true,
+		"tostartofminute":         true,
+		"tostartoffiveminute":     true,
+		"tostartoffiveminutes":    true,
+		"tostartoftenminutes":     true,
+		"tostartoffifteenminutes": true,
+		"tostartofhour":           true,
+		"tostartofinterval":       true,
+		"todatetime":              true,
+		"todatetime64":            true,
+		"toyyyymmddhhmmss":        true,
+		"tounixtimestamp":         true,
+		"tohour":                  true,
+		"tominute":                true,
+		"tosecond":                true,
+	}
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHousePartitionKey, &PartitionKeyAdvisor{})
+}
+
+// PartitionKeyAdvisor is the advisor checking for the partition key granularity.
+type PartitionKeyAdvisor struct {
+}
+
+// Check checks for the partition key granularity.
+func (*PartitionKeyAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		table := parseCreateTable(normalizeStatement(sql.Text))
+		if table == nil {
+			continue
+		}
+		match := partitionByRegexp.FindStringSubmatch(table.clauses)
+		if match == nil {
+			continue
+		}
+		if content := checkPartitionKey(table.table, strings.TrimSpace(match[1])); content != "" {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:  level,
+				Code:    advisor.ImproperPartitionKey.Int32(),
+				Title:   string(ctx.Rule.Type),
+				Content: content,
+				StartPosition: &storepb.Position{
+					Line: getLine(sql),
+				},
+			})
+		}
+	}
+	return adviceList, nil
+}
+
+// checkPartitionKey returns the advice content if the partition key may produce too many partitions.
+func checkPartitionKey(table, partitionKey string) string {
+	functions := functionRegexp.FindAllStringSubmatch(partitionKey, -1)
+	if len(functions) == 0 {
+		return fmt.Sprintf("Table %q is partitioned by the column expression %q directly, which may produce too many partitions. Consider using a coarse-grained expression such as toYYYYMM()", table, partitionKey)
+	}
+	for _, function := range functions {
+		if fineGrainedPartitionFunctions[strings.ToLower(function[1])] {
+			return fmt.Sprintf("Table %q is partitioned by %q, which is too fine-grained and may produce too many partitions. Consider partitioning by month or a coarser granularity", table, partitionKey)
+		}
+	}
+	return ""
+}
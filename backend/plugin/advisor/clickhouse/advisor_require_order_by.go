@@ -0,0 +1,73 @@
+package clickhouse
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*RequireOrderByAdvisor)(nil)
+
+	orderByRegexp      = regexp.MustCompile(`(?i)\bORDER BY ?(.*)`)
+	primaryKeyRegexp   = regexp.MustCompile(`(?i)\bPRIMARY KEY\b`)
+	emptySortKeyRegexp = regexp.MustCompile(`(?i)^tuple\( ?\)`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseRequireOrderBy, &RequireOrderByAdvisor{})
+}
+
+// RequireOrderByAdvisor is the advisor checking for the sorting key of MergeTree family tables.
+type RequireOrderByAdvisor struct {
+}
+
+// Check checks for the sorting key of MergeTree family tables.
+func (*RequireOrderByAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		table := parseCreateTable(normalizeStatement(sql.Text))
+		if table == nil || !table.isMergeTreeFamily() {
+			continue
+		}
+
+		match := orderByRegexp.FindStringSubmatch(table.clauses)
+		switch {
+		case match == nil && primaryKeyRegexp.MatchString(table.clauses):
+			// The primary key is used as the sorting key if the ORDER BY clause is omitted.
+			continue
+		case match == nil:
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:  level,
+				Code:    advisor.MergeTreeNoOrderBy.Int32(),
+				Title:   string(ctx.Rule.Type),
+				Content: fmt.Sprintf("Table %q uses the %s table engine but has no ORDER BY clause", table.table, table.engine),
+				StartPosition: &storepb.Position{
+					Line: getLine(sql),
+				},
+			})
+		case emptySortKeyRegexp.MatchString(match[1]):
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:  level,
+				Code:    advisor.MergeTreeNoOrderBy.Int32(),
+				Title:   string(ctx.Rule.Type),
+				Content: fmt.Sprintf("Table %q uses the %s table engine with an empty sorting key ORDER BY tuple()", table.table, table.engine),
+				StartPosition: &storepb.Position{
+					Line: getLine(sql),
+				},
+			})
+		}
+	}
+	return adviceList, nil
+}
@@ -0,0 +1,57 @@
+package clickhouse
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*RequireTTLAdvisor)(nil)
+
+	ttlRegexp = regexp.MustCompile(`(?i)\bTTL\b`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_CLICKHOUSE, advisor.ClickHouseRequireTTL, &RequireTTLAdvisor{})
+}
+
+// RequireTTLAdvisor is the advisor checking for the TTL of MergeTree family tables.
+type RequireTTLAdvisor struct {
+}
+
+// Check checks for the TTL of MergeTree family tables.
+func (*RequireTTLAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		table := parseCreateTable(normalizeStatement(sql.Text))
+		if table == nil || !table.isMergeTreeFamily() {
+			continue
+		}
+		// Both the table TTL and the column TTL are acceptable.
+		if ttlRegexp.MatchString(table.text) {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.MergeTreeNoTTL.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("Table %q uses the %s table engine but has no TTL", table.table, table.engine),
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
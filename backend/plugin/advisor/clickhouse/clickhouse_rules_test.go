@@ -0,0 +1,27 @@
+package clickhouse
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestClickHouseRules(t *testing.T) {
+	clickhouseRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleClickHouseRequireOrderBy,
+		advisor.SchemaRuleClickHousePartitionKey,
+		advisor.SchemaRuleClickHouseRequireTTL,
+		advisor.SchemaRuleStatementMutationRowLimit,
+	}
+
+	for _, rule := range clickhouseRules {
+		_, needMockData := advisorNeedMockData[rule]
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_CLICKHOUSE, needMockData, false /* record */)
+	}
+}
+
+// Add SQL review type here if you need metadata for test.
+var advisorNeedMockData = map[advisor.SQLReviewRuleType]bool{
+	advisor.SchemaRuleStatementMutationRowLimit: true,
+}
@@ -59,6 +59,8 @@ const (
 	StatementOfflineDDL                       Code = 232
 	StatementDisallowCrossDBQueries           Code = 233
 	StatementDisallowFunctionsAndCalculations Code = 234
+	StatementMutationOnLargeTable             Code = 235
+	StatementRequireWarehouseHint             Code = 236
 
 	// 301 ～ 399 naming error code
 	// 301 table naming advisor error code.
@@ -109,8 +111,17 @@ const (
 	DropIndexColumn                            Code = 424
 	DropColumn                                 Code = 425
 
-	// 501 engine error code.
-	NotInnoDBEngine Code = 501
+	// 501 ~ 599 engine error code.
+	NotInnoDBEngine            Code = 501
+	MergeTreeNoOrderBy         Code = 502
+	ImproperPartitionKey       Code = 503
+	MergeTreeNoTTL             Code = 504
+	ClusteringKeyRequireReview Code = 505
+	TableNoDistribution        Code = 506
+	InsufficientReplicationNum Code = 507
+	DynamicPartitionNoStart    Code = 508
+	IncompatibleMappingType    Code = 509
+	DisallowedCommand          Code = 510
 
 	// 601 ~ 699 table rule advisor error code.
 	TableNoPK                         Code = 601
@@ -153,6 +164,7 @@ const (
 	DuplicateIndexInTable      Code = 815
 	IndexTypeNotAllowed        Code = 816
 	RedundantIndex             Code = 817
+	VectorIndexOnLargeTable    Code = 818
 
 	// 1001 ~ 1099 charset error code.
 	DisabledCharset Code = 1001
@@ -196,6 +208,9 @@ const (
 
 	// 1901 ~ 1999 schema error code.
 	SchemaNotExists Code = 1901
+
+	// 2001 ~ 2099 custom rule error code.
+	CustomRuleViolation Code = 2001
 )
 
 // Int returns the int type of code.
@@ -541,6 +556,9 @@ const (
 	// PostgreSQLCreateIndexConcurrently is an advisor type for PostgreSQL to create index concurrently.
 	PostgreSQLCreateIndexConcurrently Type = "bb.plugin.advisor.postgresql.index.create-concurrently"
 
+	// PostgreSQLIndexVectorRowLimit is an advisor type for PostgreSQL to limit building vector indexes on large tables.
+	PostgreSQLIndexVectorRowLimit Type = "bb.plugin.advisor.postgresql.index.vector-row-limit"
+
 	// PostgreSQLColumnTypeDisallowList is an advisor type for Postgresql column type disallow list.
 	PostgreSQLColumnTypeDisallowList Type = "bb.plugin.advisor.postgresql.column.type-disallow-list"
 
@@ -716,6 +734,15 @@ const (
 	// SnowflakeMigrationCompatibility is an advisor type for Snowflake migration compatibility.
 	SnowflakeMigrationCompatibility Type = "bb.plugin.advisor.snowflake.migration-compatibility"
 
+	// SnowflakeNamingColumnConvention is an advisor type for Snowflake column naming convention.
+	SnowflakeNamingColumnConvention Type = "bb.plugin.advisor.snowflake.naming.column"
+
+	// SnowflakeClusteringKeyReview is an advisor type for Snowflake clustering key review.
+	SnowflakeClusteringKeyReview Type = "bb.plugin.advisor.snowflake.table.clustering-key-review"
+
+	// SnowflakeWarehouseSizeHint is an advisor type for Snowflake warehouse size hint on long-running DML.
+	SnowflakeWarehouseSizeHint Type = "bb.plugin.advisor.snowflake.statement.warehouse-size-hint"
+
 	// MSSQL Advisor.
 
 	// MSSQLSyntax is an advisor type for MSSQL syntax.
@@ -782,4 +809,55 @@ const (
 
 	// MSSQLStatementDisallowMixDDLDML is an advisor type for MSSQL disallow mix DDL and DML.
 	MSSQLStatementDisallowMixDDLDML Type = "bb.plugin.advisor.mssql.statement.disallow-mix-ddl-dml"
+
+	// ClickHouse Advisor.
+
+	// ClickHouseRequireOrderBy is an advisor type for ClickHouse MergeTree family tables requiring ORDER BY.
+	ClickHouseRequireOrderBy Type = "bb.plugin.advisor.clickhouse.engine.require-order-by"
+
+	// ClickHousePartitionKey is an advisor type for ClickHouse partition key granularity.
+	ClickHousePartitionKey Type = "bb.plugin.advisor.clickhouse.engine.partition-key"
+
+	// ClickHouseRequireTTL is an advisor type for ClickHouse MergeTree family tables requiring TTL.
+	ClickHouseRequireTTL Type = "bb.plugin.advisor.clickhouse.engine.require-ttl"
+
+	// ClickHouseMutationRowLimit is an advisor type for ClickHouse mutations on large tables.
+	ClickHouseMutationRowLimit Type = "bb.plugin.advisor.clickhouse.statement.mutation-row-limit"
+
+	// DuckDB Advisor.
+
+	// DuckDBWhereRequirement is an advisor type for DuckDB WHERE clause requirement.
+	DuckDBWhereRequirement Type = "bb.plugin.advisor.duckdb.where.require"
+
+	// DuckDBNoSelectAll is an advisor type for DuckDB no select all.
+	DuckDBNoSelectAll Type = "bb.plugin.advisor.duckdb.select.no-select-all"
+
+	// DuckDBTableRequirePK is an advisor type for DuckDB table require primary key.
+	DuckDBTableRequirePK Type = "bb.plugin.advisor.duckdb.table.require-pk"
+
+	// Doris Advisor.
+
+	// DorisRequireDistribution is an advisor type for Doris tables requiring DISTRIBUTED BY.
+	DorisRequireDistribution Type = "bb.plugin.advisor.doris.engine.require-distribution"
+
+	// DorisReplicationNum is an advisor type for Doris minimum replica count.
+	DorisReplicationNum Type = "bb.plugin.advisor.doris.engine.replication-num"
+
+	// DorisDynamicPartitionStart is an advisor type for Doris dynamic partitions requiring the start offset.
+	DorisDynamicPartitionStart Type = "bb.plugin.advisor.doris.engine.dynamic-partition-start"
+
+	// Elasticsearch Advisor.
+
+	// ElasticsearchMappingCompatibility is an advisor type for Elasticsearch mapping compatibility.
+	ElasticsearchMappingCompatibility Type = "bb.plugin.advisor.elasticsearch.engine.mapping-compatibility"
+
+	// Redis Advisor.
+
+	// RedisCommandDisallowList is an advisor type for Redis command disallow list.
+	RedisCommandDisallowList Type = "bb.plugin.advisor.redis.engine.command-disallow-list"
+
+	// Custom Advisor.
+
+	// CustomRule is an advisor type for the user-defined SQL review rules.
+	CustomRule Type = "bb.plugin.advisor.custom"
 )
@@ -0,0 +1,133 @@
+// Package custom is the advisor for the user-defined SQL review rules.
+package custom
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*RuleAdvisor)(nil)
+)
+
+func init() {
+	for engine := range common.StatementAdviseEngines {
+		advisor.Register(engine, advisor.CustomRule, &RuleAdvisor{})
+	}
+}
+
+// RuleAdvisor is the advisor evaluating the user-defined SQL review rules.
+type RuleAdvisor struct {
+}
+
+// Check evaluates the user-defined rule against each statement.
+func (*RuleAdvisor) Check(ctx advisor.Context, statement string) ([]*storepb.Advice, error) {
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalCustomRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var pattern *regexp.Regexp
+	if payload.Pattern != "" {
+		if pattern, err = regexp.Compile(payload.Pattern); err != nil {
+			return nil, errors.Wrapf(err, "failed to compile regular expression %q", payload.Pattern)
+		}
+	}
+	var prog cel.Program
+	if payload.Expression != "" {
+		if prog, err = common.ValidateCustomSQLReviewRuleCELExpr(payload.Expression); err != nil {
+			return nil, errors.Wrapf(err, "invalid CEL expression %q", payload.Expression)
+		}
+	}
+
+	engine := ctx.DBType
+	var adviceList []*storepb.Advice
+	for _, sql := range splitStatement(engine, statement) {
+		if pattern != nil && !pattern.MatchString(sql.text) {
+			continue
+		}
+		if prog != nil {
+			metadata := extractStatementMetadata(sql.text)
+			out, _, err := prog.Eval(map[string]any{
+				"db_engine":      engine.String(),
+				"statement":      sql.text,
+				"statement_type": metadata.statementType,
+				"table_names":    metadata.tableNames,
+				"column_names":   metadata.columnNames,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to evaluate expression %q", payload.Expression)
+			}
+			if res, ok := out.Equal(celtypes.True).Value().(bool); !ok || !res {
+				continue
+			}
+		}
+		content := payload.Message
+		if content == "" {
+			content = payload.Title
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.CustomRuleViolation.Int32(),
+			Title:   payload.Title,
+			Content: content,
+			StartPosition: &storepb.Position{
+				Line: int32(sql.line),
+			},
+		})
+	}
+	return adviceList, nil
+}
+
+type singleStatement struct {
+	text string
+	// line is the 1-based line of the first non-comment line of the statement.
+	line int
+}
+
+// splitStatement splits the statement into single statements and locates them in the original statement.
+// The whole statement is treated as a single statement if the engine has no splitter.
+func splitStatement(engine storepb.Engine, statement string) []singleStatement {
+	list, err := base.SplitMultiSQL(engine, statement)
+	if err != nil {
+		list = []base.SingleSQL{{Text: statement}}
+	}
+
+	var result []singleStatement
+	offset := 0
+	for _, sql := range list {
+		if sql.Empty || strings.TrimSpace(sql.Text) == "" {
+			continue
+		}
+		start := offset
+		if idx := strings.Index(statement[offset:], sql.Text); idx >= 0 {
+			start = offset + idx
+			offset = start + len(sql.Text)
+		}
+		line := strings.Count(statement[:start], "\n") + 1
+		for _, l := range strings.Split(sql.Text, "\n") {
+			trimmed := strings.TrimSpace(l)
+			if trimmed != "" && !strings.HasPrefix(trimmed, "--") {
+				break
+			}
+			line++
+		}
+		result = append(result, singleStatement{
+			text: strings.TrimSpace(sql.Text),
+			line: line,
+		})
+	}
+	return result
+}
@@ -0,0 +1,18 @@
+package custom
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestCustomRules(t *testing.T) {
+	customRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleCustom,
+	}
+
+	for _, rule := range customRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_MYSQL, false, false /* record */)
+	}
+}
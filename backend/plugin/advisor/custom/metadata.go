@@ -0,0 +1,354 @@
+package custom
+
+import (
+	"strings"
+	"unicode"
+)
+
+// statementMetadata is the metadata of a single statement exposed to the CEL expression.
+type statementMetadata struct {
+	// statementType is the upper case statement type, such as "SELECT", "UPDATE", "CREATE_TABLE" and "DROP_INDEX".
+	statementType string
+	// tableNames is the list of the referenced table names without the database and schema qualifiers.
+	tableNames []string
+	// columnNames is the list of the columns defined, changed or assigned by the statement.
+	columnNames []string
+}
+
+// objectModifiers are the keywords between CREATE/ALTER/DROP and the object type.
+var objectModifiers = map[string]bool{
+	"OR":           true,
+	"REPLACE":      true,
+	"TEMPORARY":    true,
+	"TEMP":         true,
+	"UNIQUE":       true,
+	"GLOBAL":       true,
+	"LOCAL":        true,
+	"EXTERNAL":     true,
+	"TRANSIENT":    true,
+	"VOLATILE":     true,
+	"MATERIALIZED": true,
+	"UNLOGGED":     true,
+	"CLUSTERED":    true,
+	"NONCLUSTERED": true,
+	"FULLTEXT":     true,
+	"SPATIAL":      true,
+}
+
+// tableNamePrefixKeywords are the keywords followed by a table name.
+var tableNamePrefixKeywords = map[string]bool{
+	"FROM":   true,
+	"JOIN":   true,
+	"INTO":   true,
+	"UPDATE": true,
+	"TABLE":  true,
+}
+
+// tableNameSkipKeywords are the keywords which may appear between the prefix keyword and the table name.
+var tableNameSkipKeywords = map[string]bool{
+	"IF":           true,
+	"NOT":          true,
+	"EXISTS":       true,
+	"ONLY":         true,
+	"LOW_PRIORITY": true,
+	"IGNORE":       true,
+}
+
+// reservedKeywords are the keywords which are never treated as table or column names.
+var reservedKeywords = map[string]bool{
+	"SELECT":     true,
+	"WHERE":      true,
+	"SET":        true,
+	"VALUES":     true,
+	"VALUE":      true,
+	"ON":         true,
+	"AS":         true,
+	"LATERAL":    true,
+	"UNNEST":     true,
+	"CONSTRAINT": true,
+	"PRIMARY":    true,
+	"FOREIGN":    true,
+	"KEY":        true,
+	"INDEX":      true,
+	"UNIQUE":     true,
+	"CHECK":      true,
+	"PERIOD":     true,
+	"LIKE":       true,
+	"PARTITION":  true,
+}
+
+type token struct {
+	text string
+	// upper is the upper case text for the unquoted token, it's empty for the quoted identifiers.
+	upper string
+}
+
+func (t token) isKeyword(keyword string) bool {
+	return t.upper == keyword
+}
+
+func (t token) isIdentifier() bool {
+	if t.upper == "" {
+		return t.text != ""
+	}
+	r := []rune(t.text)[0]
+	return (unicode.IsLetter(r) || r == '_') && !reservedKeywords[t.upper]
+}
+
+// extractStatementMetadata extracts the statement metadata by scanning the tokens of the statement.
+// It's a best effort extraction working for all engines, so it doesn't rely on the engine specific parsers.
+func extractStatementMetadata(statement string) *statementMetadata {
+	tokens := tokenize(statement)
+	metadata := &statementMetadata{
+		statementType: getStatementType(tokens),
+	}
+
+	tableSet := make(map[string]bool)
+	addTable := func(name string) {
+		if name != "" && !tableSet[name] {
+			tableSet[name] = true
+			metadata.tableNames = append(metadata.tableNames, name)
+		}
+	}
+	columnSet := make(map[string]bool)
+	addColumn := func(name string) {
+		if name != "" && !columnSet[name] {
+			columnSet[name] = true
+			metadata.columnNames = append(metadata.columnNames, name)
+		}
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		if !tableNamePrefixKeywords[tokens[i].upper] {
+			continue
+		}
+		j := i + 1
+		for j < len(tokens) && tableNameSkipKeywords[tokens[j].upper] {
+			j++
+		}
+		if name, next := readObjectName(tokens, j); name != "" {
+			addTable(name)
+			i = next - 1
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(metadata.statementType, "CREATE_TABLE"):
+		for _, column := range extractColumnDefinitions(tokens) {
+			addColumn(column)
+		}
+	case metadata.statementType == "ALTER_TABLE":
+		for i := 0; i < len(tokens); i++ {
+			switch tokens[i].upper {
+			case "ADD", "DROP", "MODIFY", "ALTER", "CHANGE", "RENAME":
+				j := i + 1
+				if j < len(tokens) && tokens[j].isKeyword("COLUMN") {
+					j++
+				} else if tokens[i].upper != "ADD" && tokens[i].upper != "MODIFY" && tokens[i].upper != "CHANGE" {
+					// DROP, ALTER and RENAME require the COLUMN keyword to work on columns.
+					continue
+				}
+				for j < len(tokens) && tableNameSkipKeywords[tokens[j].upper] {
+					j++
+				}
+				if j < len(tokens) && tokens[j].isIdentifier() && !tokens[j].isKeyword("TABLE") {
+					addColumn(tokens[j].text)
+				}
+			}
+		}
+	case metadata.statementType == "UPDATE":
+		for i := 0; i < len(tokens); i++ {
+			if !tokens[i].isKeyword("SET") {
+				continue
+			}
+			// SET a = 1, b = 2 WHERE ...
+			for j := i + 1; j+1 < len(tokens); j++ {
+				if tokens[j].isKeyword("WHERE") || tokens[j].isKeyword("FROM") {
+					break
+				}
+				if tokens[j+1].text == "=" && tokens[j].isIdentifier() {
+					addColumn(tokens[j].text)
+				}
+			}
+			break
+		}
+	case metadata.statementType == "INSERT" || metadata.statementType == "REPLACE":
+		for i := 0; i < len(tokens); i++ {
+			if !tokens[i].isKeyword("INTO") {
+				continue
+			}
+			_, next := readObjectName(tokens, i+1)
+			if next < len(tokens) && tokens[next].text == "(" {
+				for j := next + 1; j < len(tokens) && tokens[j].text != ")"; j++ {
+					if tokens[j].isIdentifier() {
+						addColumn(tokens[j].text)
+					}
+				}
+			}
+			break
+		}
+	}
+	return metadata
+}
+
+// getStatementType returns the statement type, such as "SELECT", "CREATE_TABLE" and "ALTER_INDEX".
+func getStatementType(tokens []token) string {
+	// Skip the leading parentheses, e.g. (SELECT 1) UNION (SELECT 2).
+	i := 0
+	for i < len(tokens) && tokens[i].text == "(" {
+		i++
+	}
+	if i >= len(tokens) {
+		return ""
+	}
+	first := tokens[i].upper
+	switch first {
+	case "CREATE", "ALTER", "DROP", "TRUNCATE", "RENAME", "COMMENT":
+		j := i + 1
+		for j < len(tokens) && objectModifiers[tokens[j].upper] {
+			j++
+		}
+		if first == "COMMENT" && j < len(tokens) && tokens[j].isKeyword("ON") {
+			j++
+		}
+		if j < len(tokens) && tokens[j].upper != "" {
+			return first + "_" + tokens[j].upper
+		}
+		return first
+	case "WITH":
+		// WITH cte AS (...) SELECT/INSERT/UPDATE/DELETE ...
+		depth := 0
+		for j := i + 1; j < len(tokens); j++ {
+			switch tokens[j].text {
+			case "(":
+				depth++
+			case ")":
+				depth--
+			default:
+				if depth == 0 {
+					switch tokens[j].upper {
+					case "SELECT", "INSERT", "UPDATE", "DELETE", "MERGE":
+						return tokens[j].upper
+					}
+				}
+			}
+		}
+		return first
+	default:
+		return first
+	}
+}
+
+// readObjectName reads the possibly qualified object name starting at tokens[i],
+// and returns the last part of the name and the index of the next token.
+func readObjectName(tokens []token, i int) (string, int) {
+	if i >= len(tokens) || !tokens[i].isIdentifier() {
+		return "", i
+	}
+	name := tokens[i].text
+	i++
+	for i+1 < len(tokens) && tokens[i].text == "." && tokens[i+1].isIdentifier() {
+		name = tokens[i+1].text
+		i += 2
+	}
+	return name, i
+}
+
+// extractColumnDefinitions returns the column names in the CREATE TABLE ... (...) definition list.
+func extractColumnDefinitions(tokens []token) []string {
+	start := -1
+	for i, t := range tokens {
+		if t.text == "(" {
+			start = i
+			break
+		}
+		if t.isKeyword("AS") {
+			// CREATE TABLE ... AS SELECT has no column definitions.
+			return nil
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+
+	var columns []string
+	depth := 0
+	expectColumn := true
+	for i := start + 1; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(":
+			depth++
+		case ")":
+			if depth == 0 {
+				return columns
+			}
+			depth--
+		case ",":
+			if depth == 0 {
+				expectColumn = true
+			}
+		default:
+			if expectColumn && depth == 0 {
+				expectColumn = false
+				if tokens[i].isIdentifier() {
+					columns = append(columns, tokens[i].text)
+				}
+			}
+		}
+	}
+	return columns
+}
+
+// tokenize splits the statement into tokens, skipping the comments and the string literals.
+// The quoted identifiers are unquoted and the punctuations are single character tokens.
+func tokenize(statement string) []token {
+	var tokens []token
+	runes := []rune(statement)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			i++
+		case r == '\'':
+			i++
+			for i < len(runes) && runes[i] != '\'' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			tokens = append(tokens, token{text: "''", upper: "''"})
+		case r == '"' || r == '`' || r == '[':
+			closing := r
+			if r == '[' {
+				closing = ']'
+			}
+			var sb strings.Builder
+			i++
+			for i < len(runes) && runes[i] != closing {
+				_, _ = sb.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, token{text: sb.String()})
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$' || r == '@' || r == '#':
+			start := i
+			for i+1 < len(runes) && (unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1]) || runes[i+1] == '_' || runes[i+1] == '$' || runes[i+1] == '@' || runes[i+1] == '#') {
+				i++
+			}
+			text := string(runes[start : i+1])
+			tokens = append(tokens, token{text: text, upper: strings.ToUpper(text)})
+		default:
+			tokens = append(tokens, token{text: string(r), upper: string(r)})
+		}
+	}
+	return tokens
+}
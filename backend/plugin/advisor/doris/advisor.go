@@ -0,0 +1,140 @@
+// Package doris is the advisor for Apache Doris database.
+package doris
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	identifierPattern = "(?:`[^`]+`|[A-Za-z_][A-Za-z0-9_$]*)"
+	tableNamePattern  = "(" + identifierPattern + `(?:\.` + identifierPattern + "){0,2})"
+)
+
+var (
+	createTableRegexp = regexp.MustCompile(`(?i)^CREATE (?:TEMPORARY )?TABLE (?:IF NOT EXISTS )?` + tableNamePattern)
+	alterTableRegexp  = regexp.MustCompile(`(?i)^ALTER TABLE ` + tableNamePattern)
+	createLikeRegexp  = regexp.MustCompile(`(?i)^CREATE (?:TEMPORARY )?TABLE (?:IF NOT EXISTS )?` + tableNamePattern + ` LIKE\b`)
+	propertyRegexp    = regexp.MustCompile(`"([^"]*)" ?= ?"([^"]*)"`)
+)
+
+func getSingleSQLList(ast any) ([]base.SingleSQL, error) {
+	list, ok := ast.([]base.SingleSQL)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to SingleSQL list")
+	}
+	return list, nil
+}
+
+// getLine returns the 1-based line of the first non-comment line of the statement.
+func getLine(sql base.SingleSQL) int32 {
+	return int32(sql.FirstStatementLine + 1)
+}
+
+// getProperties returns the properties in the PROPERTIES or SET clauses of the normalized statement.
+// The property keys are lowercased.
+func getProperties(text string) map[string]string {
+	properties := make(map[string]string)
+	for _, match := range propertyRegexp.FindAllStringSubmatch(text, -1) {
+		properties[strings.ToLower(match[1])] = match[2]
+	}
+	return properties
+}
+
+// getReplicaCount returns the replica count of the replication_num or replication_allocation property,
+// such as "3" or "tag.location.default: 1, tag.location.group_a: 2".
+func getReplicaCount(key, value string) (int, bool) {
+	if strings.HasSuffix(key, "replication_num") {
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return 0, false
+		}
+		return n, true
+	}
+	total := 0
+	for _, allocation := range strings.Split(value, ",") {
+		_, n, ok := strings.Cut(allocation, ":")
+		if !ok {
+			return 0, false
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(n))
+		if err != nil {
+			return 0, false
+		}
+		total += count
+	}
+	return total, true
+}
+
+// normalizeTableName removes the database qualifiers and the quotes of the table name.
+func normalizeTableName(name string) string {
+	var sb strings.Builder
+	quoted := false
+	for _, r := range name {
+		switch {
+		case r == '`':
+			quoted = !quoted
+		case !quoted && r == '.':
+			sb.Reset()
+		default:
+			_, _ = sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// normalizeStatement removes the comments of the statement and collapses the blanks,
+// so that the advisors could match the clauses by regular expressions.
+// Unlike the other engines, the string literals are kept since Doris table properties are string literals.
+func normalizeStatement(statement string) string {
+	var sb strings.Builder
+	runes := []rune(statement)
+	blank := false
+	writeRune := func(r rune) {
+		if blank && sb.Len() > 0 {
+			_, _ = sb.WriteRune(' ')
+		}
+		blank = false
+		_, _ = sb.WriteRune(r)
+	}
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-',
+			runes[i] == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			blank = true
+		case runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			blank = true
+		case runes[i] == '\'' || runes[i] == '"' || runes[i] == '`':
+			quote := runes[i]
+			writeRune(quote)
+			i++
+			for i < len(runes) && runes[i] != quote {
+				if runes[i] == '\\' && quote != '`' && i+1 < len(runes) {
+					_, _ = sb.WriteRune(runes[i])
+					i++
+				}
+				_, _ = sb.WriteRune(runes[i])
+				i++
+			}
+			_, _ = sb.WriteRune(quote)
+		case runes[i] == ' ' || runes[i] == '\t' || runes[i] == '\n' || runes[i] == '\r':
+			blank = true
+		default:
+			writeRune(runes[i])
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
@@ -0,0 +1,61 @@
+package doris
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*DynamicPartitionStartAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DORIS, advisor.DorisDynamicPartitionStart, &DynamicPartitionStartAdvisor{})
+}
+
+// DynamicPartitionStartAdvisor is the advisor checking for the start offset of the dynamic partitions.
+type DynamicPartitionStartAdvisor struct {
+}
+
+// Check checks for the start offset of the dynamic partitions.
+// Without dynamic_partition.start, the history partitions are never dropped and the table grows unbounded.
+// Only the CREATE TABLE statements are checked since ALTER TABLE may enable the dynamic partitions of a table with the start offset.
+func (*DynamicPartitionStartAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		match := createTableRegexp.FindStringSubmatch(text)
+		if match == nil {
+			continue
+		}
+		properties := getProperties(text)
+		if !strings.EqualFold(properties["dynamic_partition.enable"], "true") {
+			continue
+		}
+		if _, ok := properties["dynamic_partition.start"]; ok {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.DynamicPartitionNoStart.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("Table %q enables the dynamic partitions without \"dynamic_partition.start\", the history partitions will never be dropped", normalizeTableName(match[1])),
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
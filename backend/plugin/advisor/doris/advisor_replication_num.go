@@ -0,0 +1,75 @@
+package doris
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*ReplicationNumAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DORIS, advisor.DorisReplicationNum, &ReplicationNumAdvisor{})
+}
+
+// ReplicationNumAdvisor is the advisor checking for the minimum replica count.
+type ReplicationNumAdvisor struct {
+}
+
+// Check checks for the minimum replica count in the table and partition properties.
+func (*ReplicationNumAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		match := createTableRegexp.FindStringSubmatch(text)
+		if match == nil {
+			match = alterTableRegexp.FindStringSubmatch(text)
+		}
+		if match == nil {
+			continue
+		}
+		properties := getProperties(text)
+		var keys []string
+		for key := range properties {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			if !strings.HasSuffix(key, "replication_num") && !strings.HasSuffix(key, "replication_allocation") {
+				continue
+			}
+			count, ok := getReplicaCount(key, properties[key])
+			if !ok || count >= payload.Number {
+				continue
+			}
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:  level,
+				Code:    advisor.InsufficientReplicationNum.Int32(),
+				Title:   string(ctx.Rule.Type),
+				Content: fmt.Sprintf("Table %q sets %q to %d replicas, which is less than the minimum %d", normalizeTableName(match[1]), key, count, payload.Number),
+				StartPosition: &storepb.Position{
+					Line: getLine(sql),
+				},
+			})
+		}
+	}
+	return adviceList, nil
+}
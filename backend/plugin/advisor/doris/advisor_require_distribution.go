@@ -0,0 +1,62 @@
+package doris
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*RequireDistributionAdvisor)(nil)
+
+	distributedByRegexp = regexp.MustCompile(`(?i)\bDISTRIBUTED BY (?:HASH|RANDOM)\b`)
+	tableEngineRegexp   = regexp.MustCompile(`(?i)\) ?ENGINE ?= ?([A-Za-z_]+)`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DORIS, advisor.DorisRequireDistribution, &RequireDistributionAdvisor{})
+}
+
+// RequireDistributionAdvisor is the advisor checking for the explicit distribution of the tables.
+type RequireDistributionAdvisor struct {
+}
+
+// Check checks for the explicit distribution of the tables.
+// Doris picks the distribution and the bucket number implicitly if DISTRIBUTED BY is omitted,
+// which may skew the data and cannot be changed after the table is created.
+func (*RequireDistributionAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		match := createTableRegexp.FindStringSubmatch(text)
+		if match == nil || createLikeRegexp.MatchString(text) || distributedByRegexp.MatchString(text) {
+			continue
+		}
+		// Only the OLAP tables are distributed into the buckets.
+		if engine := tableEngineRegexp.FindStringSubmatch(text); engine != nil && !strings.EqualFold(engine[1], "olap") {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.TableNoDistribution.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("Table %q requires an explicit DISTRIBUTED BY clause", normalizeTableName(match[1])),
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
@@ -0,0 +1,20 @@
+package doris
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDorisRules(t *testing.T) {
+	dorisRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleDorisRequireDistribution,
+		advisor.SchemaRuleDorisReplicationNum,
+		advisor.SchemaRuleDorisDynamicPartitionStart,
+	}
+
+	for _, rule := range dorisRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_DORIS, false /* needMetaData */, false /* record */)
+	}
+}
@@ -0,0 +1,97 @@
+// Package duckdb is the advisor for DuckDB database.
+package duckdb
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+const (
+	identifierPattern = `(?:"[^"]+"|[A-Za-z_][A-Za-z0-9_$]*)`
+	tableNamePattern  = "(" + identifierPattern + `(?:\.` + identifierPattern + `){0,2})`
+)
+
+func getSingleSQLList(ast any) ([]base.SingleSQL, error) {
+	list, ok := ast.([]base.SingleSQL)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to SingleSQL list")
+	}
+	return list, nil
+}
+
+// getLine returns the 1-based line of the first non-comment line of the statement.
+func getLine(sql base.SingleSQL) int32 {
+	return int32(sql.FirstStatementLine + 1)
+}
+
+// normalizeTableName removes the database and schema qualifiers and the quotes of the table name.
+func normalizeTableName(name string) string {
+	var sb strings.Builder
+	quoted := false
+	for _, r := range name {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case !quoted && r == '.':
+			sb.Reset()
+		default:
+			_, _ = sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// normalizeStatement removes the comments and the string literal contents of the statement,
+// and collapses the blanks, so that the advisors could match the clauses by regular expressions.
+func normalizeStatement(statement string) string {
+	var sb strings.Builder
+	runes := []rune(statement)
+	blank := false
+	writeRune := func(r rune) {
+		if blank && sb.Len() > 0 {
+			_, _ = sb.WriteRune(' ')
+		}
+		blank = false
+		_, _ = sb.WriteRune(r)
+	}
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			blank = true
+		case runes[i] == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i < len(runes) && !(runes[i] == '*' && i+1 < len(runes) && runes[i+1] == '/') {
+				i++
+			}
+			i++
+			blank = true
+		case runes[i] == '\'':
+			// DuckDB escapes the single quote by doubling it, which is two adjacent literals here.
+			writeRune('\'')
+			i++
+			for i < len(runes) && runes[i] != '\'' {
+				i++
+			}
+			_, _ = sb.WriteRune('\'')
+		case runes[i] == '"':
+			writeRune('"')
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				_, _ = sb.WriteRune(runes[i])
+				i++
+			}
+			_, _ = sb.WriteRune('"')
+		case runes[i] == ' ' || runes[i] == '\t' || runes[i] == '\n' || runes[i] == '\r':
+			blank = true
+		default:
+			writeRune(runes[i])
+		}
+	}
+	return strings.TrimSpace(sb.String())
+}
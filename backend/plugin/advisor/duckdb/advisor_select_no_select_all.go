@@ -0,0 +1,55 @@
+package duckdb
+
+import (
+	"regexp"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*NoSelectAllAdvisor)(nil)
+
+	selectAllRegexp = regexp.MustCompile(`(?i)\bSELECT (?:DISTINCT |ALL )?\*`)
+	// fromFirstRegexp matches the FROM-first query without SELECT, such as "FROM t", which is a shorthand of "SELECT * FROM t".
+	fromFirstRegexp = regexp.MustCompile(`(?i)^\(?FROM\b`)
+	selectRegexp    = regexp.MustCompile(`(?i)\bSELECT\b`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DUCKDB, advisor.DuckDBNoSelectAll, &NoSelectAllAdvisor{})
+}
+
+// NoSelectAllAdvisor is the advisor checking for no "select *".
+type NoSelectAllAdvisor struct {
+}
+
+// Check checks for no "select *".
+func (*NoSelectAllAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		if !selectAllRegexp.MatchString(text) && !(fromFirstRegexp.MatchString(text) && !selectRegexp.MatchString(text)) {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.StatementSelectAll.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: "Avoid using SELECT *.",
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
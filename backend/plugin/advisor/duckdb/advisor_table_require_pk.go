@@ -0,0 +1,57 @@
+package duckdb
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*TableRequirePKAdvisor)(nil)
+
+	createTableRegexp = regexp.MustCompile(`(?i)^CREATE (?:OR REPLACE )?(?:(?:TEMPORARY|TEMP) )?TABLE (?:IF NOT EXISTS )?` + tableNamePattern + ` ?\(`)
+	primaryKeyRegexp  = regexp.MustCompile(`(?i)\bPRIMARY KEY\b`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DUCKDB, advisor.DuckDBTableRequirePK, &TableRequirePKAdvisor{})
+}
+
+// TableRequirePKAdvisor is the advisor checking table requires PK.
+type TableRequirePKAdvisor struct {
+}
+
+// Check checks table requires PK.
+// DuckDB doesn't support adding the primary key to an existing table, so only the CREATE TABLE statements are checked.
+func (*TableRequirePKAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		// CREATE TABLE ... AS SELECT doesn't match since there is no column definition list.
+		match := createTableRegexp.FindStringSubmatch(text)
+		if match == nil || primaryKeyRegexp.MatchString(text) {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.TableNoPK.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("Table %s requires PRIMARY KEY.", normalizeTableName(match[1])),
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
@@ -0,0 +1,57 @@
+package duckdb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*WhereRequirementAdvisor)(nil)
+
+	// dmlRegexp matches the UPDATE and DELETE statements, optionally with the leading CTEs.
+	dmlRegexp   = regexp.MustCompile(`(?i)^(?:WITH .*?\) )?(UPDATE|DELETE)\b`)
+	whereRegexp = regexp.MustCompile(`(?i)\bWHERE\b`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_DUCKDB, advisor.DuckDBWhereRequirement, &WhereRequirementAdvisor{})
+}
+
+// WhereRequirementAdvisor is the advisor checking for the WHERE clause requirement.
+type WhereRequirementAdvisor struct {
+}
+
+// Check checks for the WHERE clause requirement of the UPDATE and DELETE statements.
+func (*WhereRequirementAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		text := normalizeStatement(sql.Text)
+		match := dmlRegexp.FindStringSubmatch(text)
+		if match == nil || whereRegexp.MatchString(text) {
+			continue
+		}
+		adviceList = append(adviceList, &storepb.Advice{
+			Status:  level,
+			Code:    advisor.StatementNoWhere.Int32(),
+			Title:   string(ctx.Rule.Type),
+			Content: fmt.Sprintf("WHERE clause is required for %s statement.", strings.ToUpper(match[1])),
+			StartPosition: &storepb.Position{
+				Line: getLine(sql),
+			},
+		})
+	}
+	return adviceList, nil
+}
@@ -0,0 +1,20 @@
+package duckdb
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDuckDBRules(t *testing.T) {
+	duckdbRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleStatementRequireWhere,
+		advisor.SchemaRuleStatementNoSelectAll,
+		advisor.SchemaRuleTableRequirePK,
+	}
+
+	for _, rule := range duckdbRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_DUCKDB, false /* needMetaData */, false /* record */)
+	}
+}
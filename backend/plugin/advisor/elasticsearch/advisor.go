@@ -0,0 +1,48 @@
+// Package elasticsearch is the advisor for Elasticsearch and OpenSearch.
+package elasticsearch
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func getSingleSQLList(ast any) ([]base.SingleSQL, error) {
+	list, ok := ast.([]base.SingleSQL)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to SingleSQL list")
+	}
+	return list, nil
+}
+
+// getLine returns the 1-based line of the method of the API call.
+func getLine(sql base.SingleSQL) int32 {
+	return int32(sql.FirstStatementLine + 1)
+}
+
+// matchIndexPattern returns true if the index matches the comma-separated index names or wildcard patterns.
+func matchIndexPattern(patterns string, index string) bool {
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" || strings.HasPrefix(pattern, "-") {
+			continue
+		}
+		if pattern == "_all" || pattern == index {
+			return true
+		}
+		if !strings.Contains(pattern, "*") {
+			continue
+		}
+		re, err := regexp.Compile("^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$")
+		if err != nil {
+			continue
+		}
+		if re.MatchString(index) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/db/elasticsearch"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*MappingCompatibilityAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_ELASTICSEARCH, advisor.ElasticsearchMappingCompatibility, &MappingCompatibilityAdvisor{})
+}
+
+// MappingCompatibilityAdvisor is the advisor checking for the type changes of the existing fields.
+type MappingCompatibilityAdvisor struct {
+}
+
+// Check checks for the type changes of the existing fields in the PUT mapping and PUT index template calls.
+// Elasticsearch cannot change the type of an existing field in place, the update mapping calls fail,
+// and the index templates with conflicting types make the new indices inconsistent with the existing ones.
+func (*MappingCompatibilityAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		statements, err := elasticsearch.SplitElasticsearchStatements(sql.Text)
+		if err != nil || len(statements) == 0 {
+			continue
+		}
+		statement := statements[0]
+		if statement.Method() != "PUT" && statement.Method() != "POST" {
+			continue
+		}
+		for _, content := range checkStatement(ctx.DBSchema, statement) {
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:  level,
+				Code:    advisor.IncompatibleMappingType.Int32(),
+				Title:   string(ctx.Rule.Type),
+				Content: content,
+				StartPosition: &storepb.Position{
+					Line: getLine(sql),
+				},
+			})
+		}
+	}
+	return adviceList, nil
+}
+
+// checkStatement returns the advice contents for the type changes in the PUT mapping and PUT index template calls.
+func checkStatement(dbSchema *storepb.DatabaseSchemaMetadata, statement *elasticsearch.Statement) []string {
+	route := strings.TrimPrefix(statement.Route(), "/")
+	if i := strings.Index(route, "?"); i >= 0 {
+		route = route[:i]
+	}
+	parts := strings.Split(route, "/")
+	if len(parts) != 2 {
+		return nil
+	}
+	var body map[string]any
+	if err := json.Unmarshal([]byte(statement.QueryString()), &body); err != nil {
+		return nil
+	}
+
+	var contents []string
+	switch {
+	case parts[0] == "_index_template":
+		template, _ := body["template"].(map[string]any)
+		mappings, _ := template["mappings"].(map[string]any)
+		fields := elasticsearch.ConvertMappingsToColumns(mappings)
+		for _, existing := range dbSchema.GetIndexTemplates() {
+			if existing.Name != parts[1] || existing.Mappings == "" {
+				continue
+			}
+			var existingMappings map[string]any
+			if err := json.Unmarshal([]byte(existing.Mappings), &existingMappings); err != nil {
+				continue
+			}
+			contents = append(contents, checkFieldTypes(fields, elasticsearch.ConvertMappingsToColumns(existingMappings), fmt.Sprintf("index template %q", existing.Name))...)
+		}
+		// The new indices created from the template conflict with the existing indices matching the same patterns.
+		patterns := strings.Join(getStringList(body["index_patterns"]), ",")
+		for _, index := range getIndices(dbSchema) {
+			if matchIndexPattern(patterns, index.Name) {
+				contents = append(contents, checkFieldTypes(fields, index.Columns, fmt.Sprintf("index %q", index.Name))...)
+			}
+		}
+	case parts[1] == "_mapping" && !strings.HasPrefix(parts[0], "_"):
+		fields := elasticsearch.ConvertMappingsToColumns(body)
+		for _, index := range getIndices(dbSchema) {
+			if matchIndexPattern(parts[0], index.Name) {
+				contents = append(contents, checkFieldTypes(fields, index.Columns, fmt.Sprintf("index %q", index.Name))...)
+			}
+		}
+	}
+	return contents
+}
+
+// checkFieldTypes returns the advice contents for the fields whose types differ from the existing fields.
+func checkFieldTypes(fields, existingFields []*storepb.ColumnMetadata, target string) []string {
+	existingTypes := make(map[string]string)
+	for _, field := range existingFields {
+		existingTypes[field.Name] = field.Type
+	}
+	var contents []string
+	for _, field := range fields {
+		existingType, ok := existingTypes[field.Name]
+		if !ok || field.Type == "" || existingType == "" || field.Type == existingType {
+			continue
+		}
+		contents = append(contents, fmt.Sprintf("Field %q is changed from %q to %q, which is incompatible with %s", field.Name, existingType, field.Type, target))
+	}
+	return contents
+}
+
+func getIndices(dbSchema *storepb.DatabaseSchemaMetadata) []*storepb.TableMetadata {
+	var indices []*storepb.TableMetadata
+	for _, schema := range dbSchema.GetSchemas() {
+		indices = append(indices, schema.GetTables()...)
+	}
+	return indices
+}
+
+func getStringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var list []string
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestElasticsearchRules(t *testing.T) {
+	elasticsearchRules := []advisor.SQLReviewRuleType{
+		advisor.SchemaRuleElasticsearchMappingCompatibility,
+	}
+
+	for _, rule := range elasticsearchRules {
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_ELASTICSEARCH, true /* needMetaData */, false /* record */)
+	}
+}
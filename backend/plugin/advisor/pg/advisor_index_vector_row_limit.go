@@ -0,0 +1,189 @@
+package pg
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*IndexVectorRowLimitAdvisor)(nil)
+	_ ast.Visitor     = (*indexVectorRowLimitChecker)(nil)
+
+	reindexRegexp = regexp.MustCompile(`(?is)^REINDEX\s+(?:\(.*?\)\s*)?(INDEX|TABLE)\s+(?:CONCURRENTLY\s+)?([^\s;]+)`)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_POSTGRES, advisor.PostgreSQLIndexVectorRowLimit, &IndexVectorRowLimitAdvisor{})
+}
+
+// IndexVectorRowLimitAdvisor is the advisor checking for building or rebuilding vector indexes on large tables.
+type IndexVectorRowLimitAdvisor struct {
+}
+
+// Check checks for building or rebuilding vector indexes on large tables.
+// Building pgvector hnsw and ivfflat indexes is expensive in time and memory for large tables,
+// and it happens on CREATE INDEX, REINDEX and changing the type of the indexed column.
+func (*IndexVectorRowLimitAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	stmtList, ok := ctx.AST.([]ast.Node)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Node")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+	checker := &indexVectorRowLimitChecker{
+		level:    level,
+		title:    string(ctx.Rule.Type),
+		maxRows:  int64(payload.Number),
+		dbSchema: ctx.DBSchema,
+	}
+
+	for _, stmt := range stmtList {
+		checker.line = stmt.LastLine()
+		if _, ok := stmt.(*ast.UnconvertedStmt); ok {
+			checker.checkReindex(strings.TrimSpace(stmt.Text()))
+			continue
+		}
+		ast.Walk(checker, stmt)
+	}
+
+	return checker.adviceList, nil
+}
+
+type indexVectorRowLimitChecker struct {
+	adviceList []*storepb.Advice
+	level      storepb.Advice_Status
+	title      string
+	line       int
+	maxRows    int64
+	dbSchema   *storepb.DatabaseSchemaMetadata
+}
+
+// Visit implements ast.Visitor interface.
+func (checker *indexVectorRowLimitChecker) Visit(in ast.Node) ast.Visitor {
+	switch node := in.(type) {
+	case *ast.CreateIndexStmt:
+		if node.Index.Method != ast.IndexMethodTypeHnsw && node.Index.Method != ast.IndexMethodTypeIvfflat {
+			break
+		}
+		table := checker.findTable(node.Index.Table.Schema, node.Index.Table.Name)
+		if table == nil || table.RowCount <= checker.maxRows {
+			break
+		}
+		checker.addAdvice(fmt.Sprintf("Creating %s index on table %q (%d rows) is expensive, the limit is %d rows", node.Index.Method, node.Index.Table.Name, table.RowCount, checker.maxRows))
+	case *ast.AlterColumnTypeStmt:
+		table := checker.findTable(node.Table.Schema, node.Table.Name)
+		if table == nil || table.RowCount <= checker.maxRows {
+			break
+		}
+		for _, index := range table.Indexes {
+			if isVectorIndex(index) && slices.Contains(index.Expressions, node.ColumnName) {
+				checker.addAdvice(fmt.Sprintf("Changing the type of column %q rebuilds the %s index %q on table %q (%d rows), the limit is %d rows", node.ColumnName, index.Type, index.Name, node.Table.Name, table.RowCount, checker.maxRows))
+			}
+		}
+	}
+	return checker
+}
+
+// checkReindex checks the REINDEX statement, which is not converted to the AST.
+func (checker *indexVectorRowLimitChecker) checkReindex(text string) {
+	matches := reindexRegexp.FindStringSubmatch(text)
+	if matches == nil {
+		return
+	}
+	schemaName, objectName := splitQualifiedName(matches[2])
+	if strings.EqualFold(matches[1], "TABLE") {
+		table := checker.findTable(schemaName, objectName)
+		if table == nil || table.RowCount <= checker.maxRows {
+			return
+		}
+		for _, index := range table.Indexes {
+			if isVectorIndex(index) {
+				checker.addAdvice(fmt.Sprintf("Reindexing table %q rebuilds the %s index %q (%d rows), the limit is %d rows", objectName, index.Type, index.Name, table.RowCount, checker.maxRows))
+			}
+		}
+		return
+	}
+	schema := checker.findSchema(schemaName)
+	if schema == nil {
+		return
+	}
+	for _, table := range schema.Tables {
+		for _, index := range table.Indexes {
+			if index.Name != objectName || !isVectorIndex(index) || table.RowCount <= checker.maxRows {
+				continue
+			}
+			checker.addAdvice(fmt.Sprintf("Reindexing %s index %q on table %q (%d rows) is expensive, the limit is %d rows", index.Type, index.Name, table.Name, table.RowCount, checker.maxRows))
+		}
+	}
+}
+
+func (checker *indexVectorRowLimitChecker) addAdvice(content string) {
+	checker.adviceList = append(checker.adviceList, &storepb.Advice{
+		Status:  checker.level,
+		Code:    advisor.VectorIndexOnLargeTable.Int32(),
+		Title:   checker.title,
+		Content: content,
+		StartPosition: &storepb.Position{
+			Line: int32(checker.line),
+		},
+	})
+}
+
+func (checker *indexVectorRowLimitChecker) findSchema(schemaName string) *storepb.SchemaMetadata {
+	if checker.dbSchema == nil {
+		return nil
+	}
+	schemaName = normalizeSchemaName(schemaName)
+	for _, schema := range checker.dbSchema.Schemas {
+		if schema.Name == schemaName {
+			return schema
+		}
+	}
+	return nil
+}
+
+func (checker *indexVectorRowLimitChecker) findTable(schemaName, tableName string) *storepb.TableMetadata {
+	schema := checker.findSchema(schemaName)
+	if schema == nil {
+		return nil
+	}
+	for _, table := range schema.Tables {
+		if table.Name == tableName {
+			return table
+		}
+	}
+	return nil
+}
+
+func isVectorIndex(index *storepb.IndexMetadata) bool {
+	return index.Type == "hnsw" || index.Type == "ivfflat"
+}
+
+// splitQualifiedName splits the optionally schema qualified name, and unquotes the identifiers.
+func splitQualifiedName(name string) (string, string) {
+	unquote := func(s string) string {
+		if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+			return strings.ReplaceAll(s[1:len(s)-1], `""`, `"`)
+		}
+		return strings.ToLower(s)
+	}
+	if schema, object, ok := strings.Cut(name, "."); ok {
+		return unquote(schema), unquote(object)
+	}
+	return "", unquote(name)
+}
@@ -49,6 +49,7 @@ func TestPostgreSQLRules(t *testing.T) {
 		advisor.SchemaRuleColumnRequireDefault,
 		advisor.SchemaRuleStatementDisallowAddColumnWithDefault,
 		advisor.SchemaRuleCreateIndexConcurrently,
+		advisor.SchemaRuleIndexVectorRowLimit,
 		advisor.SchemaRuleStatementAddCheckNotValid,
 		advisor.SchemaRuleStatementAddFKNotValid,
 		advisor.SchemaRuleStatementDisallowAddNotNull,
@@ -66,4 +67,5 @@ func TestPostgreSQLRules(t *testing.T) {
 // Add SQL review type here if you need metadata for test.
 var advisorNeedMockData = map[advisor.SQLReviewRuleType]bool{
 	advisor.SchemaRuleFullyQualifiedObjectName: true,
+	advisor.SchemaRuleIndexVectorRowLimit:      true,
 }
@@ -0,0 +1,21 @@
+// Package redis is the advisor for Redis.
+package redis
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+)
+
+func getSingleSQLList(ast any) ([]base.SingleSQL, error) {
+	list, ok := ast.([]base.SingleSQL)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to SingleSQL list")
+	}
+	return list, nil
+}
+
+// getLine returns the 1-based line of the command.
+func getLine(sql base.SingleSQL) int32 {
+	return int32(sql.FirstStatementLine + 1)
+}
@@ -0,0 +1,77 @@
+package redis
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/shlex"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*CommandDisallowListAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_REDIS, advisor.RedisCommandDisallowList, &CommandDisallowListAdvisor{})
+}
+
+// CommandDisallowListAdvisor is the advisor checking for the disallowed commands.
+type CommandDisallowListAdvisor struct {
+}
+
+// Check checks for the disallowed commands.
+// An item of the list is a command such as "FLUSHALL", or a command with the subcommand such as "CONFIG SET".
+func (*CommandDisallowListAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	list, err := getSingleSQLList(ctx.AST)
+	if err != nil {
+		return nil, err
+	}
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalStringArrayTypeRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var adviceList []*storepb.Advice
+	for _, sql := range list {
+		fields, err := shlex.Split(sql.Text)
+		if err != nil || len(fields) == 0 {
+			continue
+		}
+		for _, disallowed := range payload.List {
+			if !matchCommand(fields, strings.Fields(disallowed)) {
+				continue
+			}
+			adviceList = append(adviceList, &storepb.Advice{
+				Status:  level,
+				Code:    advisor.DisallowedCommand.Int32(),
+				Title:   string(ctx.Rule.Type),
+				Content: fmt.Sprintf("Command %q is disallowed", strings.ToUpper(strings.Join(fields[:min(len(fields), len(strings.Fields(disallowed)))], " "))),
+				StartPosition: &storepb.Position{
+					Line: getLine(sql),
+				},
+			})
+			break
+		}
+	}
+	return adviceList, nil
+}
+
+// matchCommand returns true if the command fields start with the disallowed command, case-insensitively.
+func matchCommand(fields []string, disallowed []string) bool {
+	if len(disallowed) == 0 || len(fields) < len(disallowed) {
+		return false
+	}
+	for i, v := range disallowed {
+		if !strings.EqualFold(fields[i], v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,139 @@
+// Package snowflake is the advisor for snowflake database.
+package snowflake
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*NamingColumnAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_SNOWFLAKE, advisor.SnowflakeNamingColumnConvention, &NamingColumnAdvisor{})
+}
+
+// NamingColumnAdvisor is the advisor checking for column naming convention.
+type NamingColumnAdvisor struct {
+}
+
+// Check checks for column naming convention.
+func (*NamingColumnAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	tree, ok := ctx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	format, maxLength, err := advisor.UnmarshalNamingRulePayloadAsRegexp(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &namingColumnListener{
+		level:     level,
+		title:     string(ctx.Rule.Type),
+		format:    format,
+		maxLength: maxLength,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.generateAdvice()
+}
+
+type namingColumnListener struct {
+	*parser.BaseSnowflakeParserListener
+
+	level     storepb.Advice_Status
+	title     string
+	format    *regexp.Regexp
+	maxLength int
+
+	// currentNormalizedTableName is the normalized table name of the statement being walked.
+	currentNormalizedTableName string
+
+	adviceList []*storepb.Advice
+}
+
+// generateAdvice returns the advices generated by the listener, the advices must not be empty.
+func (l *namingColumnListener) generateAdvice() ([]*storepb.Advice, error) {
+	return l.adviceList, nil
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *namingColumnListener) EnterCreate_table(ctx *parser.Create_tableContext) {
+	l.currentNormalizedTableName = snowsqlparser.NormalizeSnowSQLObjectNamePart(ctx.Object_name().GetO())
+}
+
+// ExitCreate_table is called when production create_table is exited.
+func (l *namingColumnListener) ExitCreate_table(*parser.Create_tableContext) {
+	l.currentNormalizedTableName = ""
+}
+
+// EnterColumn_decl_item_list is called when production column_decl_item_list is entered.
+func (l *namingColumnListener) EnterColumn_decl_item_list(ctx *parser.Column_decl_item_listContext) {
+	if l.currentNormalizedTableName == "" {
+		return
+	}
+	for _, item := range ctx.AllColumn_decl_item() {
+		fullColDecl := item.Full_col_decl()
+		if fullColDecl == nil {
+			continue
+		}
+		columnID := fullColDecl.Col_decl().Column_name().Id_()
+		l.checkColumnName(snowsqlparser.NormalizeSnowSQLObjectNamePart(columnID), columnID.GetStart().GetLine())
+	}
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *namingColumnListener) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	if ctx.Table_column_action() == nil || ctx.Table_column_action().RENAME() == nil {
+		return
+	}
+	l.currentNormalizedTableName = snowsqlparser.NormalizeSnowSQLObjectNamePart(ctx.Object_name(0).GetO())
+	renameToID := ctx.Table_column_action().Column_name(1).Id_()
+	l.checkColumnName(snowsqlparser.NormalizeSnowSQLObjectNamePart(renameToID), renameToID.GetStart().GetLine())
+}
+
+// ExitAlter_table is called when production alter_table is exited.
+func (l *namingColumnListener) ExitAlter_table(*parser.Alter_tableContext) {
+	l.currentNormalizedTableName = ""
+}
+
+func (l *namingColumnListener) checkColumnName(columnName string, line int) {
+	if !l.format.MatchString(columnName) {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:  l.level,
+			Code:    advisor.NamingColumnConventionMismatch.Int32(),
+			Title:   l.title,
+			Content: fmt.Sprintf(`"%s"."%s" mismatches column naming convention, naming format should be %q`, l.currentNormalizedTableName, columnName, l.format),
+			StartPosition: &storepb.Position{
+				Line: int32(line),
+			},
+		})
+	}
+	if l.maxLength > 0 && len(columnName) > l.maxLength {
+		l.adviceList = append(l.adviceList, &storepb.Advice{
+			Status:  l.level,
+			Code:    advisor.NamingColumnConventionMismatch.Int32(),
+			Title:   l.title,
+			Content: fmt.Sprintf(`"%s"."%s" mismatches column naming convention, its length should be within %d characters`, l.currentNormalizedTableName, columnName, l.maxLength),
+			StartPosition: &storepb.Position{
+				Line: int32(line),
+			},
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// Package snowflake is the advisor for snowflake database.
+package snowflake
+
+import (
+	"fmt"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*WarehouseSizeHintAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_SNOWFLAKE, advisor.SnowflakeWarehouseSizeHint, &WarehouseSizeHintAdvisor{})
+}
+
+// WarehouseSizeHintAdvisor is the advisor checking for DML statements on large tables
+// which are not preceded by USE WAREHOUSE.
+type WarehouseSizeHintAdvisor struct {
+}
+
+// Check checks for DML statements on large tables without warehouse hint.
+func (*WarehouseSizeHintAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	tree, ok := ctx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := advisor.UnmarshalNumberTypeRulePayload(ctx.Rule.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &warehouseSizeHintChecker{
+		level:    level,
+		title:    string(ctx.Rule.Type),
+		maxRows:  int64(payload.Number),
+		dbSchema: ctx.DBSchema,
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.generateAdvice()
+}
+
+// warehouseSizeHintChecker is the listener for DML statements on large tables.
+type warehouseSizeHintChecker struct {
+	*parser.BaseSnowflakeParserListener
+
+	level    storepb.Advice_Status
+	title    string
+	maxRows  int64
+	dbSchema *storepb.DatabaseSchemaMetadata
+
+	// useWarehouse is true if a USE WAREHOUSE statement has been seen before.
+	useWarehouse bool
+
+	adviceList []*storepb.Advice
+}
+
+// generateAdvice returns the advices generated by the listener, the advices must not be empty.
+func (l *warehouseSizeHintChecker) generateAdvice() ([]*storepb.Advice, error) {
+	return l.adviceList, nil
+}
+
+// EnterUse_warehouse is called when production use_warehouse is entered.
+func (l *warehouseSizeHintChecker) EnterUse_warehouse(*parser.Use_warehouseContext) {
+	l.useWarehouse = true
+}
+
+// EnterUpdate_statement is called when production update_statement is entered.
+func (l *warehouseSizeHintChecker) EnterUpdate_statement(ctx *parser.Update_statementContext) {
+	l.checkTable("UPDATE", ctx.Object_name(), ctx.GetStart().GetLine())
+}
+
+// EnterDelete_statement is called when production delete_statement is entered.
+func (l *warehouseSizeHintChecker) EnterDelete_statement(ctx *parser.Delete_statementContext) {
+	l.checkTable("DELETE", ctx.Object_name(), ctx.GetStart().GetLine())
+}
+
+// EnterMerge_statement is called when production merge_statement is entered.
+func (l *warehouseSizeHintChecker) EnterMerge_statement(ctx *parser.Merge_statementContext) {
+	l.checkTable("MERGE", ctx.Object_name(), ctx.GetStart().GetLine())
+}
+
+// EnterInsert_statement is called when production insert_statement is entered.
+func (l *warehouseSizeHintChecker) EnterInsert_statement(ctx *parser.Insert_statementContext) {
+	// INSERT ... VALUES is cheap, only INSERT ... SELECT scans the source tables.
+	if ctx.Query_statement() == nil {
+		return
+	}
+	l.checkTable("INSERT", ctx.Object_name(), ctx.GetStart().GetLine())
+}
+
+func (l *warehouseSizeHintChecker) checkTable(statementType string, objectName parser.IObject_nameContext, line int) {
+	if l.useWarehouse || objectName == nil {
+		return
+	}
+	schemaName := "PUBLIC"
+	if s := snowsqlparser.NormalizeSnowSQLObjectNamePart(objectName.GetS()); s != "" {
+		schemaName = s
+	}
+	tableName := snowsqlparser.NormalizeSnowSQLObjectNamePart(objectName.GetO())
+	table := l.findTable(schemaName, tableName)
+	if table == nil || table.GetRowCount() < l.maxRows {
+		return
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:  l.level,
+		Code:    advisor.StatementRequireWarehouseHint.Int32(),
+		Title:   l.title,
+		Content: fmt.Sprintf("%s on table %q with about %d rows may run for a long time, consider running USE WAREHOUSE with a properly sized warehouse before it", statementType, tableName, table.GetRowCount()),
+		StartPosition: &storepb.Position{
+			Line: int32(line),
+		},
+	})
+}
+
+func (l *warehouseSizeHintChecker) findTable(schemaName, tableName string) *storepb.TableMetadata {
+	for _, schema := range l.dbSchema.GetSchemas() {
+		if schema.GetName() != schemaName {
+			continue
+		}
+		for _, table := range schema.GetTables() {
+			if table.GetName() == tableName {
+				return table
+			}
+		}
+	}
+	return nil
+}
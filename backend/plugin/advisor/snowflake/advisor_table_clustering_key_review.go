@@ -0,0 +1,147 @@
+// Package snowflake is the advisor for snowflake database.
+package snowflake
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	_ advisor.Advisor = (*ClusteringKeyReviewAdvisor)(nil)
+)
+
+func init() {
+	advisor.Register(storepb.Engine_SNOWFLAKE, advisor.SnowflakeClusteringKeyReview, &ClusteringKeyReviewAdvisor{})
+}
+
+// ClusteringKeyReviewAdvisor is the advisor checking for clustering key changes.
+// Defining or changing a clustering key triggers Automatic Clustering, which consumes credits
+// in the background, so such changes should be reviewed explicitly.
+type ClusteringKeyReviewAdvisor struct {
+}
+
+// Check checks for clustering key changes.
+func (*ClusteringKeyReviewAdvisor) Check(ctx advisor.Context, _ string) ([]*storepb.Advice, error) {
+	tree, ok := ctx.AST.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to Tree")
+	}
+
+	level, err := advisor.NewStatusBySQLReviewRuleLevel(ctx.Rule.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	listener := &clusteringKeyReviewChecker{
+		level: level,
+		title: string(ctx.Rule.Type),
+	}
+
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+
+	return listener.generateAdvice()
+}
+
+// clusteringKeyReviewChecker is the listener for clustering key changes.
+type clusteringKeyReviewChecker struct {
+	*parser.BaseSnowflakeParserListener
+
+	level storepb.Advice_Status
+	title string
+
+	// currentNormalizedTableName is the normalized table name of the statement being walked.
+	currentNormalizedTableName string
+
+	adviceList []*storepb.Advice
+}
+
+// generateAdvice returns the advices generated by the listener, the advices must not be empty.
+func (l *clusteringKeyReviewChecker) generateAdvice() ([]*storepb.Advice, error) {
+	return l.adviceList, nil
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *clusteringKeyReviewChecker) EnterCreate_table(ctx *parser.Create_tableContext) {
+	l.currentNormalizedTableName = snowsqlparser.NormalizeSnowSQLObjectNamePart(ctx.Object_name().GetO())
+}
+
+// ExitCreate_table is called when production create_table is exited.
+func (l *clusteringKeyReviewChecker) ExitCreate_table(*parser.Create_tableContext) {
+	l.currentNormalizedTableName = ""
+}
+
+// EnterCreate_table_as_select is called when production create_table_as_select is entered.
+func (l *clusteringKeyReviewChecker) EnterCreate_table_as_select(ctx *parser.Create_table_as_selectContext) {
+	l.currentNormalizedTableName = snowsqlparser.NormalizeSnowSQLObjectNamePart(ctx.Object_name().GetO())
+}
+
+// ExitCreate_table_as_select is called when production create_table_as_select is exited.
+func (l *clusteringKeyReviewChecker) ExitCreate_table_as_select(*parser.Create_table_as_selectContext) {
+	l.currentNormalizedTableName = ""
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *clusteringKeyReviewChecker) EnterAlter_table(ctx *parser.Alter_tableContext) {
+	l.currentNormalizedTableName = snowsqlparser.NormalizeSnowSQLObjectNamePart(ctx.Object_name(0).GetO())
+}
+
+// ExitAlter_table is called when production alter_table is exited.
+func (l *clusteringKeyReviewChecker) ExitAlter_table(*parser.Alter_tableContext) {
+	l.currentNormalizedTableName = ""
+}
+
+// EnterCluster_by is called when production cluster_by is entered.
+func (l *clusteringKeyReviewChecker) EnterCluster_by(ctx *parser.Cluster_byContext) {
+	if l.currentNormalizedTableName == "" {
+		return
+	}
+	// The clustering key in ALTER TABLE is handled in EnterClustering_action.
+	for parent := ctx.GetParent(); parent != nil; parent = parent.GetParent() {
+		if _, ok := parent.(*parser.Clustering_actionContext); ok {
+			return
+		}
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:  l.level,
+		Code:    advisor.ClusteringKeyRequireReview.Int32(),
+		Title:   l.title,
+		Content: fmt.Sprintf("Table %q defines clustering key, which enables Automatic Clustering and consumes credits, please review the clustering key carefully", l.currentNormalizedTableName),
+		StartPosition: &storepb.Position{
+			Line: int32(ctx.GetStart().GetLine()),
+		},
+	})
+}
+
+// EnterClustering_action is called when production clustering_action is entered.
+func (l *clusteringKeyReviewChecker) EnterClustering_action(ctx *parser.Clustering_actionContext) {
+	if l.currentNormalizedTableName == "" {
+		return
+	}
+	text := strings.ToUpper(ctx.GetText())
+	var content string
+	switch {
+	case strings.HasPrefix(text, "CLUSTERBY"):
+		content = fmt.Sprintf("Table %q changes clustering key, which reclusters the table and consumes credits, please review the clustering key carefully", l.currentNormalizedTableName)
+	case strings.HasPrefix(text, "DROPCLUSTERINGKEY"):
+		content = fmt.Sprintf("Table %q drops clustering key, which may degrade the query performance on large tables", l.currentNormalizedTableName)
+	default:
+		return
+	}
+	l.adviceList = append(l.adviceList, &storepb.Advice{
+		Status:  l.level,
+		Code:    advisor.ClusteringKeyRequireReview.Int32(),
+		Title:   l.title,
+		Content: content,
+		StartPosition: &storepb.Position{
+			Line: int32(ctx.GetStart().GetLine()),
+		},
+	})
+}
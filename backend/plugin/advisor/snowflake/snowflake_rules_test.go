@@ -23,9 +23,18 @@ func TestSnowflakeRules(t *testing.T) {
 		advisor.SchemaRuleStatementNoSelectAll,
 		advisor.SchemaRuleTableDropNamingConvention,
 		advisor.SchemaRuleSchemaBackwardCompatibility,
+		advisor.SchemaRuleColumnNaming,
+		advisor.SchemaRuleSnowflakeClusteringKeyReview,
+		advisor.SchemaRuleSnowflakeWarehouseSizeHint,
 	}
 
 	for _, rule := range snowflakeRules {
-		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_SNOWFLAKE, false, false /* record */)
+		_, needMockData := advisorNeedMockData[rule]
+		advisor.RunSQLReviewRuleTest(t, rule, storepb.Engine_SNOWFLAKE, needMockData, false /* record */)
 	}
 }
+
+// Add SQL review type here if you need metadata for test.
+var advisorNeedMockData = map[advisor.SQLReviewRuleType]bool{
+	advisor.SchemaRuleSnowflakeWarehouseSizeHint: true,
+}
@@ -31,6 +31,29 @@ type SQLReviewRuleType string
 const (
 	// SchemaRuleMySQLEngine require InnoDB as the storage engine.
 	SchemaRuleMySQLEngine SQLReviewRuleType = "engine.mysql.use-innodb"
+	// SchemaRuleClickHouseRequireOrderBy require ORDER BY for the MergeTree family table engines.
+	SchemaRuleClickHouseRequireOrderBy SQLReviewRuleType = "engine.clickhouse.require-order-by"
+	// SchemaRuleClickHousePartitionKey disallow the too fine-grained partition key.
+	SchemaRuleClickHousePartitionKey SQLReviewRuleType = "engine.clickhouse.partition-key"
+	// SchemaRuleClickHouseRequireTTL require TTL for the MergeTree family table engines.
+	SchemaRuleClickHouseRequireTTL SQLReviewRuleType = "engine.clickhouse.require-ttl"
+	// SchemaRuleDorisRequireDistribution require DISTRIBUTED BY for the tables.
+	SchemaRuleDorisRequireDistribution SQLReviewRuleType = "engine.doris.require-distribution"
+	// SchemaRuleDorisReplicationNum require the minimum replica count for the tables and partitions.
+	SchemaRuleDorisReplicationNum SQLReviewRuleType = "engine.doris.replication-num"
+	// SchemaRuleDorisDynamicPartitionStart require dynamic_partition.start for the tables enabling dynamic partitions.
+	SchemaRuleDorisDynamicPartitionStart SQLReviewRuleType = "engine.doris.dynamic-partition-start"
+	// SchemaRuleElasticsearchMappingCompatibility disallow changing the type of existing fields in the mappings and index templates.
+	SchemaRuleElasticsearchMappingCompatibility SQLReviewRuleType = "engine.elasticsearch.mapping-compatibility"
+	// SchemaRuleRedisCommandDisallowList disallow the Redis commands in the list.
+	SchemaRuleRedisCommandDisallowList SQLReviewRuleType = "engine.redis.command-disallow-list"
+	// SchemaRuleSnowflakeClusteringKeyReview require reviewing the clustering key changes.
+	SchemaRuleSnowflakeClusteringKeyReview SQLReviewRuleType = "engine.snowflake.clustering-key-review"
+	// SchemaRuleSnowflakeWarehouseSizeHint require USE WAREHOUSE before the long-running DML on large tables.
+	SchemaRuleSnowflakeWarehouseSizeHint SQLReviewRuleType = "engine.snowflake.warehouse-size-hint"
+
+	// SchemaRuleCustom is the user-defined rule, which matches the statements by regular expression or CEL expression.
+	SchemaRuleCustom SQLReviewRuleType = "custom"
 
 	// SchemaRuleFullyQualifiedObjectName enforces using fully qualified object name.
 	SchemaRuleFullyQualifiedObjectName SQLReviewRuleType = "naming.fully-qualified"
@@ -129,6 +152,8 @@ const (
 	SchemaRuleStatementDisallowOfflineDDL = "statement.disallow-offline-ddl"
 	// SchemaRuleStatementDisallowCrossDBQueries disallow cross database queries.
 	SchemaRuleStatementDisallowCrossDBQueries = "statement.disallow-cross-db-queries"
+	// SchemaRuleStatementMutationRowLimit disallow the mutations on tables whose row count exceeds the limit.
+	SchemaRuleStatementMutationRowLimit SQLReviewRuleType = "statement.mutation.row-limit"
 	// SchemaRuleTableRequirePK require the table to have a primary key.
 	SchemaRuleTableRequirePK SQLReviewRuleType = "table.require-pk"
 	// SchemaRuleTableNoFK require the table disallow the foreign key.
@@ -216,6 +241,8 @@ const (
 	SchemaRuleIndexTypeAllowList SQLReviewRuleType = "index.type-allow-list"
 	// SchemaRuleIndexNotRedundant prohibits createing redundant indices.
 	SchemaRuleIndexNotRedundant SQLReviewRuleType = "index.not-redundant"
+	// SchemaRuleIndexVectorRowLimit disallow building or rebuilding vector indexes on tables whose row count exceeds the limit.
+	SchemaRuleIndexVectorRowLimit SQLReviewRuleType = "index.vector.row-limit"
 
 	// SchemaRuleCharsetAllowlist enforce the charset allowlist.
 	SchemaRuleCharsetAllowlist SQLReviewRuleType = "system.charset.allowlist"
@@ -313,6 +340,20 @@ type StringTypeRulePayload struct {
 	String string `json:"string"`
 }
 
+// CustomRulePayload is the payload for the user-defined rule.
+// A statement violates the rule if it matches the pattern and the expression evaluates to true.
+// At least one of the pattern and the expression is required.
+type CustomRulePayload struct {
+	// Title is the title of the advice.
+	Title string `json:"title"`
+	// Message is the content of the advice.
+	Message string `json:"message"`
+	// Pattern is the regular expression to match the statement text.
+	Pattern string `json:"pattern"`
+	// Expression is the CEL expression evaluated on the statement metadata, see common.CustomSQLReviewRuleCELAttributes.
+	Expression string `json:"expression"`
+}
+
 // NamingCaseRulePayload is the payload for naming case rule.
 type NamingCaseRulePayload struct {
 	// Upper is true means the case should be upper case, otherwise lower case.
@@ -438,6 +479,31 @@ func UnmarshalNumberTypeRulePayload(payload string) (*NumberTypeRulePayload, err
 	return &nlr, nil
 }
 
+// UnmarshalCustomRulePayload will unmarshal payload to CustomRulePayload and validate it.
+func UnmarshalCustomRulePayload(payload string) (*CustomRulePayload, error) {
+	var crp CustomRulePayload
+	if err := json.Unmarshal([]byte(payload), &crp); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal custom rule payload %q", payload)
+	}
+	if crp.Title == "" {
+		return nil, errors.Errorf("invalid custom rule payload, title cannot be empty")
+	}
+	if crp.Pattern == "" && crp.Expression == "" {
+		return nil, errors.Errorf("invalid custom rule payload, pattern and expression cannot be both empty")
+	}
+	if crp.Pattern != "" {
+		if _, err := regexp.Compile(crp.Pattern); err != nil {
+			return nil, errors.Wrapf(err, "failed to compile regular expression %q", crp.Pattern)
+		}
+	}
+	if crp.Expression != "" {
+		if _, err := common.ValidateCustomSQLReviewRuleCELExpr(crp.Expression); err != nil {
+			return nil, errors.Wrapf(err, "invalid CEL expression %q", crp.Expression)
+		}
+	}
+	return &crp, nil
+}
+
 // UnmarshalStringTypeRulePayload will unmarshal payload to StringTypeRulePayload.
 func UnmarshalStringTypeRulePayload(payload string) (*StringTypeRulePayload, error) {
 	var slr StringTypeRulePayload
@@ -532,6 +598,7 @@ func SQLReviewCheck(
 			checkContext.DbType,
 			advisorType,
 			Context{
+				DBType:                checkContext.DbType,
 				Charset:               checkContext.Charset,
 				Collation:             checkContext.Collation,
 				DBSchema:              checkContext.DBSchema,
@@ -881,6 +948,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeWhereRequirement, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLWhereRequirement, nil
+		case storepb.Engine_DUCKDB:
+			return DuckDBWhereRequirement, nil
 		}
 	case SchemaRuleStatementNoLeadingWildcardLike:
 		switch engine {
@@ -903,6 +972,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeNoSelectAll, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLNoSelectAll, nil
+		case storepb.Engine_DUCKDB:
+			return DuckDBNoSelectAll, nil
 		}
 	case SchemaRuleSchemaBackwardCompatibility:
 		switch engine {
@@ -963,6 +1034,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return MySQLNamingColumnConvention, nil
 		case storepb.Engine_POSTGRES:
 			return PostgreSQLNamingColumnConvention, nil
+		case storepb.Engine_SNOWFLAKE:
+			return SnowflakeNamingColumnConvention, nil
 		}
 	case SchemaRuleAutoIncrementColumnNaming:
 		switch engine {
@@ -1139,6 +1212,8 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 			return SnowflakeTableRequirePK, nil
 		case storepb.Engine_MSSQL:
 			return MSSQLTableRequirePK, nil
+		case storepb.Engine_DUCKDB:
+			return DuckDBTableRequirePK, nil
 		}
 	case SchemaRuleTableNoFK:
 		switch engine {
@@ -1321,6 +1396,10 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		if engine == storepb.Engine_POSTGRES {
 			return PostgreSQLCreateIndexConcurrently, nil
 		}
+	case SchemaRuleIndexVectorRowLimit:
+		if engine == storepb.Engine_POSTGRES {
+			return PostgreSQLIndexVectorRowLimit, nil
+		}
 	case SchemaRuleIndexTypeAllowList:
 		if engine == storepb.Engine_MYSQL {
 			return MySQLIndexTypeAllowList, nil
@@ -1497,6 +1576,53 @@ func getAdvisorTypeByRule(ruleType SQLReviewRuleType, engine storepb.Engine) (Ty
 		if engine == storepb.Engine_OCEANBASE {
 			return MySQLDisallowOfflineDDL, nil
 		}
+	case SchemaRuleClickHouseRequireOrderBy:
+		if engine == storepb.Engine_CLICKHOUSE {
+			return ClickHouseRequireOrderBy, nil
+		}
+	case SchemaRuleClickHousePartitionKey:
+		if engine == storepb.Engine_CLICKHOUSE {
+			return ClickHousePartitionKey, nil
+		}
+	case SchemaRuleClickHouseRequireTTL:
+		if engine == storepb.Engine_CLICKHOUSE {
+			return ClickHouseRequireTTL, nil
+		}
+	case SchemaRuleStatementMutationRowLimit:
+		if engine == storepb.Engine_CLICKHOUSE {
+			return ClickHouseMutationRowLimit, nil
+		}
+	case SchemaRuleDorisRequireDistribution:
+		if engine == storepb.Engine_DORIS {
+			return DorisRequireDistribution, nil
+		}
+	case SchemaRuleDorisReplicationNum:
+		if engine == storepb.Engine_DORIS {
+			return DorisReplicationNum, nil
+		}
+	case SchemaRuleDorisDynamicPartitionStart:
+		if engine == storepb.Engine_DORIS {
+			return DorisDynamicPartitionStart, nil
+		}
+	case SchemaRuleElasticsearchMappingCompatibility:
+		if engine == storepb.Engine_ELASTICSEARCH {
+			return ElasticsearchMappingCompatibility, nil
+		}
+	case SchemaRuleRedisCommandDisallowList:
+		if engine == storepb.Engine_REDIS {
+			return RedisCommandDisallowList, nil
+		}
+	case SchemaRuleCustom:
+		// The custom rule is evaluated on the statement text and metadata, which works for all engines.
+		return CustomRule, nil
+	case SchemaRuleSnowflakeClusteringKeyReview:
+		if engine == storepb.Engine_SNOWFLAKE {
+			return SnowflakeClusteringKeyReview, nil
+		}
+	case SchemaRuleSnowflakeWarehouseSizeHint:
+		if engine == storepb.Engine_SNOWFLAKE {
+			return SnowflakeWarehouseSizeHint, nil
+		}
 	}
 	return "", errors.Errorf("unknown SQL review rule type %v for %v", ruleType, engine)
 }
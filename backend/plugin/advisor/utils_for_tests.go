@@ -115,10 +115,97 @@ var (
 							},
 						},
 					},
+					{
+						Name:     "tech_embedding",
+						RowCount: 10000000,
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"},
+							{Name: "embedding", Type: "public.vector(1536)"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{
+								Name:        "idx_tech_embedding_embedding",
+								Expressions: []string{"embedding"},
+								Type:        "hnsw",
+								Parameters:  map[string]string{"m": "16", "ef_construction": "64"},
+							},
+						},
+					},
 				},
 			},
 		},
 	}
+	// MockClickHouseDatabase is the mock ClickHouse database for test.
+	MockClickHouseDatabase = &storepb.DatabaseSchemaMetadata{
+		Name: "test",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:     MockTableName,
+						RowCount: 10000000,
+					},
+					{
+						Name:     "tech_author",
+						RowCount: 100,
+					},
+				},
+			},
+		},
+	}
+	// MockSnowflakeDatabase is the mock Snowflake database for test.
+	MockSnowflakeDatabase = &storepb.DatabaseSchemaMetadata{
+		Name: "TEST_DB",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: "PUBLIC",
+				Tables: []*storepb.TableMetadata{
+					{
+						Name:     "TECH_BOOK",
+						RowCount: 10000000,
+					},
+					{
+						Name:     "TECH_AUTHOR",
+						RowCount: 100,
+					},
+				},
+			},
+		},
+	}
+	// MockElasticsearchDatabase is the mock Elasticsearch cluster for test.
+	MockElasticsearchDatabase = &storepb.DatabaseSchemaMetadata{
+		Name: "node",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "books",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "author", Position: 1, Type: "keyword", Nullable: true},
+							{Name: "page_count", Position: 2, Type: "integer", Nullable: true},
+							{Name: "title", Position: 3, Type: "text", Nullable: true},
+							{Name: "title.raw", Position: 4, Type: "keyword", Nullable: true},
+						},
+					},
+					{
+						Name: "logs-app-2024.01",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "message", Position: 1, Type: "text", Nullable: true},
+							{Name: "status", Position: 2, Type: "keyword", Nullable: true},
+						},
+					},
+				},
+			},
+		},
+		IndexTemplates: []*storepb.IndexTemplateMetadata{
+			{
+				Name:          "logs-app",
+				IndexPatterns: []string{"logs-app-*"},
+				Priority:      200,
+				Mappings:      `{"properties":{"message":{"type":"text"},"status":{"type":"keyword"},"user":{"properties":{"id":{"type":"keyword"}}}}}`,
+			},
+		},
+	}
 	MockMSSQLDatabase = &storepb.DatabaseSchemaMetadata{
 		Name: "master",
 		Schemas: []*storepb.SchemaMetadata{
@@ -195,6 +282,12 @@ func RunSQLReviewRuleTest(t *testing.T, rule SQLReviewRuleType, dbType storepb.E
 			case storepb.Engine_MSSQL:
 				curDB = "master"
 				schemaMetadata = MockMSSQLDatabase
+			case storepb.Engine_CLICKHOUSE:
+				schemaMetadata = MockClickHouseDatabase
+			case storepb.Engine_SNOWFLAKE:
+				schemaMetadata = MockSnowflakeDatabase
+			case storepb.Engine_ELASTICSEARCH:
+				schemaMetadata = MockElasticsearchDatabase
 			default:
 				panic(fmt.Sprintf("%s doesn't have mocked metadata support", storepb.Engine_name[int32(dbType)]))
 			}
@@ -404,7 +497,14 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		SchemaRuleStatementJoinStrictColumnAttrs,
 		SchemaRuleTableDisallowSetCharset,
 		SchemaRuleStatementDisallowCrossDBQueries,
-		SchemaRuleIndexNotRedundant:
+		SchemaRuleIndexNotRedundant,
+		SchemaRuleClickHouseRequireOrderBy,
+		SchemaRuleClickHousePartitionKey,
+		SchemaRuleClickHouseRequireTTL,
+		SchemaRuleSnowflakeClusteringKeyReview,
+		SchemaRuleDorisRequireDistribution,
+		SchemaRuleDorisDynamicPartitionStart,
+		SchemaRuleElasticsearchMappingCompatibility:
 	case SchemaRuleTableDropNamingConvention:
 		payload, err = json.Marshal(NamingRulePayload{
 			Format: "_delete$",
@@ -452,6 +552,20 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		payload, err = json.Marshal(NumberTypeRulePayload{
 			Number: 5,
 		})
+	case SchemaRuleCustom:
+		payload, err = json.Marshal(CustomRulePayload{
+			Title:      "Disallow TRUNCATE and salary column",
+			Message:    "TRUNCATE statement and salary column are not allowed",
+			Expression: `statement_type == "TRUNCATE_TABLE" || "salary" in column_names`,
+		})
+	case SchemaRuleStatementMutationRowLimit, SchemaRuleSnowflakeWarehouseSizeHint, SchemaRuleIndexVectorRowLimit:
+		payload, err = json.Marshal(NumberTypeRulePayload{
+			Number: 1000000,
+		})
+	case SchemaRuleDorisReplicationNum:
+		payload, err = json.Marshal(NumberTypeRulePayload{
+			Number: 3,
+		})
 	case SchemaRuleStatementMaximumJoinTableCount:
 		payload, err = json.Marshal(NumberTypeRulePayload{
 			Number: 2,
@@ -483,6 +597,10 @@ func SetDefaultSQLReviewRulePayload(ruleTp SQLReviewRuleType, dbType storepb.Eng
 		payload, err = json.Marshal(StringArrayTypeRulePayload{
 			List: []string{"JSON", "BINARY_FLOAT"},
 		})
+	case SchemaRuleRedisCommandDisallowList:
+		payload, err = json.Marshal(StringArrayTypeRulePayload{
+			List: []string{"FLUSHALL", "FLUSHDB", "KEYS", "CONFIG SET"},
+		})
 	case SchemaRuleColumnMaximumCharacterLength:
 		payload, err = json.Marshal(NumberTypeRulePayload{
 			Number: 20,
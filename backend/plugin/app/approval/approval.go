@@ -0,0 +1,169 @@
+// Package approval is the interface of the external approval providers, e.g. the relay service and the in-house connectors to the approval systems of record.
+// A provider registers itself by the type in the init function, and the external approval nodes in the workspace setting refer to the provider by the type.
+package approval
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// ProviderRelay is the type of the relay provider, it's the provider of the nodes without the provider type.
+const ProviderRelay = "relay"
+
+var (
+	providerMu sync.RWMutex
+	providers  = make(map[string]Factory)
+)
+
+// Status is the status of the external approval.
+type Status string
+
+const (
+	// StatusPending means that the external approval is waiting for the decision.
+	StatusPending Status = "PENDING"
+	// StatusApproved means that the external approval is approved.
+	StatusApproved Status = "APPROVED"
+	// StatusRejected means that the external approval is rejected.
+	StatusRejected Status = "REJECTED"
+)
+
+// Capabilities are the optional features supported by the provider.
+type Capabilities struct {
+	// Statuses are the decisions reported by the provider, APPROVED is always supported.
+	Statuses []Status
+	// CommentSync means that the provider reports the comments on the external approval, which are synced to the issue.
+	CommentSync bool
+	// Cancel means that the provider withdraws the external approval when the issue is canceled or changed.
+	Cancel bool
+}
+
+// SupportsStatus returns true if the provider reports the status.
+func (c *Capabilities) SupportsStatus(status Status) bool {
+	return status == StatusApproved || slices.Contains(c.Statuses, status)
+}
+
+// Request is the request to create the external approval for an issue.
+type Request struct {
+	IssueID     string
+	Creator     string
+	Title       string
+	Description string
+	Project     string
+	// Statement is the previews of the statements joined by the new lines, it's kept for the providers reading the single statement.
+	Statement  string
+	CreateTime time.Time
+	// Statements are the statement previews of the change targets.
+	Statements []*Statement
+	// RiskLevel is the risk level of the issue, e.g. HIGH, empty if it's not evaluated.
+	RiskLevel string
+	// PlanCheckSummary is nil if the issue doesn't have the plan checks.
+	PlanCheckSummary *PlanCheckSummary
+}
+
+// Statement is the statement preview of a change target.
+type Statement struct {
+	// Target is the database name, e.g. instances/{instance}/databases/{database}.
+	Target    string
+	Statement string
+	// Truncated means that the statement is truncated for the preview.
+	Truncated bool
+}
+
+// PlanCheckSummary counts the results of the latest plan check runs by the status.
+type PlanCheckSummary struct {
+	Success int
+	Warning int
+	Error   int
+}
+
+// Comment is the comment on the external approval.
+type Comment struct {
+	// ID is unique in the external approval, it's used to sync the comment only once.
+	ID         string
+	Author     string
+	Content    string
+	CreateTime time.Time
+}
+
+// Result is the current state of the external approval.
+type Result struct {
+	Status Status
+	// Comments are only reported by the providers with the CommentSync capability.
+	Comments []*Comment
+}
+
+// Provider creates and tracks the external approvals in the approval system of record.
+// The implementations must be safe for the concurrent use.
+type Provider interface {
+	// Capabilities returns the optional features of the provider, it may ask the external system.
+	Capabilities(ctx context.Context) (*Capabilities, error)
+	// Create creates the external approval and returns its id in the external system.
+	Create(ctx context.Context, request *Request) (string, error)
+	// Get returns the current state of the external approval.
+	Get(ctx context.Context, id string) (*Result, error)
+	// Cancel withdraws the external approval, it's only called for the providers with the Cancel capability.
+	Cancel(ctx context.Context, id string) error
+}
+
+// Factory creates the provider for the external approval node.
+type Factory func(node *storepb.ExternalApprovalSetting_Node) (Provider, error)
+
+// Register makes a provider available by the type.
+// If Register is called twice with the same type or if factory is nil, it panics.
+func Register(providerType string, factory Factory) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	if factory == nil {
+		panic("approval: Register factory is nil")
+	}
+	if _, dup := providers[providerType]; dup {
+		panic("approval: Register called twice for provider " + providerType)
+	}
+	providers[providerType] = factory
+}
+
+// IsRegistered returns true if the provider type is registered.
+func IsRegistered(providerType string) bool {
+	providerMu.RLock()
+	defer providerMu.RUnlock()
+	_, ok := providers[getProviderType(providerType)]
+	return ok
+}
+
+// ListProviderTypes lists the registered provider types in the alphabetical order.
+func ListProviderTypes() []string {
+	providerMu.RLock()
+	defer providerMu.RUnlock()
+	var types []string
+	for providerType := range providers {
+		types = append(types, providerType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Open creates the provider for the external approval node.
+func Open(node *storepb.ExternalApprovalSetting_Node) (Provider, error) {
+	providerType := getProviderType(node.Provider)
+	providerMu.RLock()
+	factory, ok := providers[providerType]
+	providerMu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("approval: unknown provider %q for external approval node %q", providerType, node.Id)
+	}
+	return factory(node)
+}
+
+func getProviderType(providerType string) string {
+	if providerType == "" {
+		return ProviderRelay
+	}
+	return providerType
+}
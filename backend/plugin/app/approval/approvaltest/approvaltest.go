@@ -0,0 +1,134 @@
+// Package approvaltest is the conformance test harness of the approval providers,
+// the in-house connectors run it against a fake or a sandbox of their approval systems.
+package approvaltest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/approval"
+)
+
+// Backend makes the decisions in the approval system behind the provider under test.
+type Backend interface {
+	// Approve approves the external approval.
+	Approve(id string) error
+	// Reject rejects the external approval, it's only called if the provider reports REJECTED.
+	Reject(id string) error
+	// AddComment adds the comment to the external approval, it's only called for the providers with the CommentSync capability.
+	AddComment(id string, content string) error
+	// IsCanceled returns true if the external approval is withdrawn, it's only called for the providers with the Cancel capability.
+	IsCanceled(id string) (bool, error)
+}
+
+// TestProvider runs the conformance tests of the provider.
+func TestProvider(t *testing.T, provider approval.Provider, backend Backend) {
+	ctx := context.Background()
+	capabilities, err := provider.Capabilities(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, capabilities)
+	for _, status := range capabilities.Statuses {
+		require.Contains(t, []approval.Status{approval.StatusApproved, approval.StatusRejected}, status, "the provider can only decide APPROVED or REJECTED")
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		a := require.New(t)
+		id1, err := provider.Create(ctx, newRequest(1))
+		a.NoError(err)
+		a.NotEmpty(id1)
+		id2, err := provider.Create(ctx, newRequest(2))
+		a.NoError(err)
+		a.NotEqual(id1, id2, "the ids of the external approvals must be unique")
+
+		result, err := provider.Get(ctx, id1)
+		a.NoError(err)
+		a.Equal(approval.StatusPending, result.Status)
+	})
+
+	t.Run("Approve", func(t *testing.T) {
+		a := require.New(t)
+		id, err := provider.Create(ctx, newRequest(3))
+		a.NoError(err)
+		a.NoError(backend.Approve(id))
+		result, err := provider.Get(ctx, id)
+		a.NoError(err)
+		a.Equal(approval.StatusApproved, result.Status)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		if !capabilities.SupportsStatus(approval.StatusRejected) {
+			t.Skip("the provider doesn't report REJECTED")
+		}
+		a := require.New(t)
+		id, err := provider.Create(ctx, newRequest(4))
+		a.NoError(err)
+		a.NoError(backend.Reject(id))
+		result, err := provider.Get(ctx, id)
+		a.NoError(err)
+		a.Equal(approval.StatusRejected, result.Status)
+	})
+
+	t.Run("CommentSync", func(t *testing.T) {
+		if !capabilities.CommentSync {
+			t.Skip("the provider doesn't sync the comments")
+		}
+		a := require.New(t)
+		id, err := provider.Create(ctx, newRequest(5))
+		a.NoError(err)
+		a.NoError(backend.AddComment(id, "LGTM"))
+		a.NoError(backend.AddComment(id, "Please run it after 6 PM"))
+
+		result, err := provider.Get(ctx, id)
+		a.NoError(err)
+		a.Len(result.Comments, 2)
+		ids := make(map[string]bool)
+		for _, comment := range result.Comments {
+			a.NotEmpty(comment.ID)
+			a.False(ids[comment.ID], "the comment ids must be unique")
+			ids[comment.ID] = true
+		}
+		a.Equal("LGTM", result.Comments[0].Content)
+
+		// The comment ids must be stable, so that the comments are synced only once.
+		again, err := provider.Get(ctx, id)
+		a.NoError(err)
+		a.Len(again.Comments, 2)
+		for i, comment := range again.Comments {
+			a.Equal(result.Comments[i].ID, comment.ID)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		if !capabilities.Cancel {
+			t.Skip("the provider doesn't support cancel")
+		}
+		a := require.New(t)
+		id, err := provider.Create(ctx, newRequest(6))
+		a.NoError(err)
+		a.NoError(provider.Cancel(ctx, id))
+		canceled, err := backend.IsCanceled(id)
+		a.NoError(err)
+		a.True(canceled)
+	})
+
+	t.Run("GetUnknown", func(t *testing.T) {
+		_, err := provider.Get(ctx, "unknown-external-approval")
+		require.Error(t, err)
+	})
+}
+
+func newRequest(issueUID int) *approval.Request {
+	return &approval.Request{
+		IssueID:     fmt.Sprintf("%d", issueUID),
+		Creator:     "dev@example.com",
+		Title:       fmt.Sprintf("Conformance test issue %d", issueUID),
+		Description: "Add the nickname column.",
+		Project:     "conformance",
+		Statement:   "ALTER TABLE employee ADD COLUMN nickname TEXT;",
+		CreateTime:  time.Now(),
+	}
+}
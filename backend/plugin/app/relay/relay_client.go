@@ -3,15 +3,52 @@ package relay
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
-	"strings"
+	"text/template"
 	"time"
 
 	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/component/secret"
+	"github.com/bytebase/bytebase/backend/plugin/app/approval"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+func init() {
+	approval.Register(approval.ProviderRelay, func(node *storepb.ExternalApprovalSetting_Node) (approval.Provider, error) {
+		if node.Endpoint == "" {
+			return nil, errors.Errorf("endpoint is required for the relay external approval node %q", node.Id)
+		}
+		provider := &Provider{client: NewClient(), endpoint: node.Endpoint, headers: node.Headers}
+		if node.PayloadTemplate != "" {
+			tmpl, err := ParsePayloadTemplate(node.PayloadTemplate)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid payload template of the relay external approval node %q", node.Id)
+			}
+			provider.payloadTemplate = tmpl
+		}
+		return provider, nil
+	})
+}
+
+// ParsePayloadTemplate parses the template rendering the body of the request creating the external approval.
+// The json function renders the JSON encoding of a value, e.g. {{json .Title}}.
+func ParsePayloadTemplate(text string) (*template.Template, error) {
+	return template.New("payload").Option("missingkey=error").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			out, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+			return string(out), nil
+		},
+	}).Parse(text)
+}
+
 // Client is the client for relay.
 type Client struct {
 	client *http.Client
@@ -35,95 +72,262 @@ type CreatePayload struct {
 	Project     string    `json:"project"`
 	Statement   string    `json:"statement"`
 	CreateTime  time.Time `json:"createTime"`
+
+	Statements       []*StatementPayload      `json:"statements,omitempty"`
+	RiskLevel        string                   `json:"riskLevel,omitempty"`
+	PlanCheckSummary *PlanCheckSummaryPayload `json:"planCheckSummary,omitempty"`
 }
 
-// Status is the status of the external approval.
-type Status string
+// StatementPayload is the statement preview of a change target.
+type StatementPayload struct {
+	Target    string `json:"target"`
+	Statement string `json:"statement"`
+	Truncated bool   `json:"truncated"`
+}
 
-const (
-	// StatusApproved means that the external approval is approved.
-	StatusApproved Status = "APPROVED"
-	// StatusRejected means that the external approval is rejected.
-	StatusRejected Status = "REJECTED"
-)
+// PlanCheckSummaryPayload is the number of the plan check results by the status.
+type PlanCheckSummaryPayload struct {
+	Success int `json:"success"`
+	Warning int `json:"warning"`
+	Error   int `json:"error"`
+}
 
 // ResponsePayload is the response message to for external approval.
 type ResponsePayload struct {
-	ID     string `json:"id"`
-	Status Status `json:"status"`
+	ID     string          `json:"id"`
+	Status approval.Status `json:"status"`
+	// Comments are only returned by the relay servers with the comment sync capability.
+	Comments []*CommentPayload `json:"comments,omitempty"`
+}
+
+// CommentPayload is the comment on the external approval.
+type CommentPayload struct {
+	ID         string    `json:"id"`
+	Author     string    `json:"author"`
+	Content    string    `json:"content"`
+	CreateTime time.Time `json:"createTime"`
+}
+
+// CapabilitiesPayload is the optional features of the relay server.
+type CapabilitiesPayload struct {
+	Statuses    []approval.Status `json:"statuses"`
+	CommentSync bool              `json:"commentSync"`
+	Cancel      bool              `json:"cancel"`
+}
+
+// UpdatePayload is the message to update external approval.
+type UpdatePayload struct {
+	Title     string `json:"title"`
+	Statement string `json:"statement"`
 }
 
 // Create sends a message to create external approval.
-func (c *Client) Create(relayEndpoint string, payload *CreatePayload) (string, error) {
-	out, err := json.Marshal(&payload)
-	if err != nil {
-		return "", err
+// The payload is either a *CreatePayload or the json.RawMessage rendered by the payload template.
+func (c *Client) Create(ctx context.Context, relayEndpoint string, header http.Header, payload any) (string, error) {
+	responseBody := &ResponsePayload{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("%s/approval", relayEndpoint), header, payload, responseBody); err != nil {
+		return "", errors.Wrapf(err, "failed to create external approval")
 	}
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/approval", relayEndpoint), bytes.NewBuffer(out))
+	return responseBody.ID, nil
+}
+
+// UpdateApproval sends a message to update the external approval.
+func (c *Client) UpdateApproval(ctx context.Context, relayEndpoint string, header http.Header, id string, payload *UpdatePayload) error {
+	if err := c.do(ctx, http.MethodPatch, fmt.Sprintf("%s/approval/%s", relayEndpoint, id), header, payload, nil); err != nil {
+		return errors.Wrapf(err, "failed to update external approval")
+	}
+	return nil
+}
+
+// GetApproval gets the approval of an external approval.
+func (c *Client) GetApproval(ctx context.Context, relayEndpoint string, header http.Header, id string) (*ResponsePayload, error) {
+	responseBody := &ResponsePayload{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/approval/%s", relayEndpoint, id), header, nil, responseBody); err != nil {
+		return nil, errors.Wrapf(err, "failed to get external approval status")
+	}
+	return responseBody, nil
+}
+
+// GetCapabilities gets the capabilities of the relay server, nil if the relay server doesn't support the discovery.
+func (c *Client) GetCapabilities(ctx context.Context, relayEndpoint string, header http.Header) (*CapabilitiesPayload, error) {
+	responseBody := &CapabilitiesPayload{}
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("%s/capabilities", relayEndpoint), header, nil, responseBody); err != nil {
+		if errors.Is(err, errNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get relay capabilities")
+	}
+	return responseBody, nil
+}
+
+var errNotFound = errors.New("not found")
+
+func (c *Client) do(ctx context.Context, method, url string, header http.Header, requestBody, responseBody any) error {
+	var body io.Reader
+	if requestBody != nil {
+		out, err := json.Marshal(requestBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(out)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
-		return "", err
+		return err
+	}
+	for key, values := range header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return "", err
+		return err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return errNotFound
+	}
 	if resp.StatusCode != http.StatusOK {
-		return "", errors.Errorf("failed to create external approval with status %v", resp.Status)
+		return errors.Errorf("unexpected status %v", resp.Status)
 	}
-	responseBody := &ResponsePayload{}
-	err = json.NewDecoder(resp.Body).Decode(responseBody)
-	if err != nil {
-		return "", err
+	if responseBody == nil {
+		return nil
 	}
-	return responseBody.ID, nil
+	return json.NewDecoder(resp.Body).Decode(responseBody)
 }
 
-// UpdatePayload is the message to update external approval.
-type UpdatePayload struct {
-	Title     string `json:"title"`
-	Statement string `json:"statement"`
+// Provider is the approval provider of the relay server.
+type Provider struct {
+	client          *Client
+	endpoint        string
+	headers         []*storepb.ExternalApprovalSetting_Node_Header
+	payloadTemplate *template.Template
 }
 
-// UpdateApproval sends a message to update the external approval.
-func (c *Client) UpdateApproval(relayEndpoint string, id string, payload *UpdatePayload) error {
-	out, err := json.Marshal(&payload)
+// getHeader gets the headers of the requests, the values are resolved from the secret stores on every request so that the rotated tokens are picked up.
+func (p *Provider) getHeader(ctx context.Context) (http.Header, error) {
+	header := make(http.Header)
+	for _, h := range p.headers {
+		value, err := secret.ReplaceExternalSecret(ctx, h.Value, h.ExternalSecret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the value of header %q", h.Key)
+		}
+		header.Add(h.Key, value)
+	}
+	return header, nil
+}
+
+// Capabilities returns the capabilities advertised by the relay server.
+// The relay servers without the discovery report APPROVED and REJECTED, and are notified on cancel.
+func (p *Provider) Capabilities(ctx context.Context) (*approval.Capabilities, error) {
+	header, err := p.getHeader(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/approval/%s", relayEndpoint, id), bytes.NewBuffer(out))
+	payload, err := p.client.GetCapabilities(ctx, p.endpoint, header)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	resp, err := c.client.Do(req)
+	if payload == nil {
+		return &approval.Capabilities{
+			Statuses: []approval.Status{approval.StatusApproved, approval.StatusRejected},
+			Cancel:   true,
+		}, nil
+	}
+	return &approval.Capabilities{
+		Statuses:    payload.Statuses,
+		CommentSync: payload.CommentSync,
+		Cancel:      payload.Cancel,
+	}, nil
+}
+
+// Create creates the external approval on the relay server.
+func (p *Provider) Create(ctx context.Context, request *approval.Request) (string, error) {
+	header, err := p.getHeader(ctx)
 	if err != nil {
-		return err
+		return "", err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to create external approval with status %v", resp.Status)
+	payload := &CreatePayload{
+		IssueID:     request.IssueID,
+		Creator:     request.Creator,
+		Title:       request.Title,
+		Description: request.Description,
+		Project:     request.Project,
+		Statement:   request.Statement,
+		CreateTime:  request.CreateTime,
+		RiskLevel:   request.RiskLevel,
 	}
-	return nil
+	for _, statement := range request.Statements {
+		payload.Statements = append(payload.Statements, &StatementPayload{
+			Target:    statement.Target,
+			Statement: statement.Statement,
+			Truncated: statement.Truncated,
+		})
+	}
+	if summary := request.PlanCheckSummary; summary != nil {
+		payload.PlanCheckSummary = &PlanCheckSummaryPayload{
+			Success: summary.Success,
+			Warning: summary.Warning,
+			Error:   summary.Error,
+		}
+	}
+	if p.payloadTemplate == nil {
+		return p.client.Create(ctx, p.endpoint, header, payload)
+	}
+	body, err := renderPayload(p.payloadTemplate, payload)
+	if err != nil {
+		return "", err
+	}
+	return p.client.Create(ctx, p.endpoint, header, body)
 }
 
-// GetApproval gets the approval of an external approval.
-func (c *Client) GetApproval(relayEndpoint string, id string) (*ResponsePayload, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/approval/%s", relayEndpoint, id), strings.NewReader(""))
+// renderPayload renders the request body with the payload template, the body must be a JSON object.
+func renderPayload(tmpl *template.Template, payload *CreatePayload) (json.RawMessage, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to render the payload template")
+	}
+	var object map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &object); err != nil {
+		return nil, errors.Wrapf(err, "the payload template must render a JSON object")
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+// Get gets the status and the comments of the external approval.
+func (p *Provider) Get(ctx context.Context, id string) (*approval.Result, error) {
+	header, err := p.getHeader(ctx)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.client.Do(req)
+	payload, err := p.client.GetApproval(ctx, p.endpoint, header, id)
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("failed to get external approval status with status %v", resp.Status)
+	result := &approval.Result{Status: payload.Status}
+	if result.Status == "" {
+		result.Status = approval.StatusPending
 	}
-	responseBody := &ResponsePayload{}
-	err = json.NewDecoder(resp.Body).Decode(responseBody)
+	for _, comment := range payload.Comments {
+		result.Comments = append(result.Comments, &approval.Comment{
+			ID:         comment.ID,
+			Author:     comment.Author,
+			Content:    comment.Content,
+			CreateTime: comment.CreateTime,
+		})
+	}
+	return result, nil
+}
+
+// Cancel notifies the relay server with an empty update.
+func (p *Provider) Cancel(ctx context.Context, id string) error {
+	header, err := p.getHeader(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return responseBody, nil
+	return p.client.UpdateApproval(ctx, p.endpoint, header, id, &UpdatePayload{})
 }
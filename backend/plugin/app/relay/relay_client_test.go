@@ -0,0 +1,197 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/approval"
+	"github.com/bytebase/bytebase/backend/plugin/app/approval/approvaltest"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// fakeRelay is the relay server for the tests, it's also the backend of the conformance tests.
+type fakeRelay struct {
+	// capabilities is nil for the relay servers without the discovery.
+	capabilities *CapabilitiesPayload
+
+	mu        sync.Mutex
+	approvals map[string]*ResponsePayload
+	canceled  map[string]bool
+}
+
+func newFakeRelay(capabilities *CapabilitiesPayload) *fakeRelay {
+	return &fakeRelay{
+		capabilities: capabilities,
+		approvals:    make(map[string]*ResponsePayload),
+		canceled:     make(map[string]bool),
+	}
+}
+
+func (f *fakeRelay) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := strings.TrimPrefix(r.URL.Path, "/approval/")
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/capabilities":
+		if f.capabilities == nil {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(f.capabilities)
+	case r.Method == http.MethodPost && r.URL.Path == "/approval":
+		payload := &CreatePayload{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		id := fmt.Sprintf("approval-%d", len(f.approvals)+1)
+		f.approvals[id] = &ResponsePayload{ID: id}
+		_ = json.NewEncoder(w).Encode(f.approvals[id])
+	case r.Method == http.MethodGet && f.approvals[id] != nil:
+		_ = json.NewEncoder(w).Encode(f.approvals[id])
+	case r.Method == http.MethodPatch && f.approvals[id] != nil:
+		f.canceled[id] = true
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (f *fakeRelay) setStatus(id string, status approval.Status) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, ok := f.approvals[id]
+	if !ok {
+		return errors.Errorf("approval %q not found", id)
+	}
+	a.Status = status
+	return nil
+}
+
+func (f *fakeRelay) Approve(id string) error {
+	return f.setStatus(id, approval.StatusApproved)
+}
+
+func (f *fakeRelay) Reject(id string) error {
+	return f.setStatus(id, approval.StatusRejected)
+}
+
+func (f *fakeRelay) AddComment(id string, content string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	a, ok := f.approvals[id]
+	if !ok {
+		return errors.Errorf("approval %q not found", id)
+	}
+	a.Comments = append(a.Comments, &CommentPayload{
+		ID:         fmt.Sprintf("%s-comment-%d", id, len(a.Comments)+1),
+		Author:     "approver@example.com",
+		Content:    content,
+		CreateTime: time.Now(),
+	})
+	return nil
+}
+
+func (f *fakeRelay) IsCanceled(id string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.canceled[id], nil
+}
+
+func TestProviderConformance(t *testing.T) {
+	tests := []struct {
+		name         string
+		capabilities *CapabilitiesPayload
+	}{
+		{
+			name: "without discovery",
+		},
+		{
+			name: "with comment sync",
+			capabilities: &CapabilitiesPayload{
+				Statuses:    []approval.Status{approval.StatusApproved, approval.StatusRejected},
+				CommentSync: true,
+				Cancel:      true,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			relay := newFakeRelay(test.capabilities)
+			server := httptest.NewServer(relay)
+			defer server.Close()
+
+			provider, err := approval.Open(&storepb.ExternalApprovalSetting_Node{
+				Id:       "relay",
+				Endpoint: server.URL,
+			})
+			require.NoError(t, err)
+			approvaltest.TestProvider(t, provider, relay)
+		})
+	}
+}
+
+func TestCreateWithHeadersAndPayloadTemplate(t *testing.T) {
+	var header http.Header
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&ResponsePayload{ID: "approval-1"})
+	}))
+	defer server.Close()
+
+	provider, err := approval.Open(&storepb.ExternalApprovalSetting_Node{
+		Id:       "relay",
+		Endpoint: server.URL,
+		Headers: []*storepb.ExternalApprovalSetting_Node_Header{
+			{Key: "Authorization", Value: "Bearer token"},
+		},
+		PayloadTemplate: `{"summary": {{json .Title}}, "risk": {{json .RiskLevel}}, "errors": {{.PlanCheckSummary.Error}}, "targets": [{{range $i, $s := .Statements}}{{if $i}}, {{end}}{{json $s.Target}}{{end}}]}`,
+	})
+	require.NoError(t, err)
+	id, err := provider.Create(context.Background(), &approval.Request{
+		Title:     `Drop "users"`,
+		RiskLevel: "HIGH",
+		Statements: []*approval.Statement{
+			{Target: "instances/prod/databases/db1", Statement: "DROP TABLE users;"},
+			{Target: "instances/prod/databases/db2", Statement: "DROP TABLE users;"},
+		},
+		PlanCheckSummary: &approval.PlanCheckSummary{Error: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "approval-1", id)
+	require.Equal(t, "Bearer token", header.Get("Authorization"))
+	require.Equal(t, map[string]any{
+		"summary": `Drop "users"`,
+		"risk":    "HIGH",
+		"errors":  float64(2),
+		"targets": []any{"instances/prod/databases/db1", "instances/prod/databases/db2"},
+	}, body)
+}
+
+func TestOpenInvalidPayloadTemplate(t *testing.T) {
+	_, err := approval.Open(&storepb.ExternalApprovalSetting_Node{
+		Id:              "relay",
+		Endpoint:        "http://relay",
+		PayloadTemplate: "{{.Title",
+	})
+	require.Error(t, err)
+
+	tmpl, err := ParsePayloadTemplate("not a JSON object {{.Title}}")
+	require.NoError(t, err)
+	_, err = renderPayload(tmpl, &CreatePayload{Title: "title"})
+	require.Error(t, err)
+}
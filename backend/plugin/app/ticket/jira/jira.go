@@ -0,0 +1,184 @@
+// Package jira is the Jira provider of the ticket sync.
+package jira
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/ticket"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	ticket.Register(storepb.TicketSync_JIRA, newProvider)
+}
+
+// provider is the ticket provider of Jira, it uses the REST API v2 with the basic auth of the email and the API token.
+// ref: https://developer.atlassian.com/cloud/jira/platform/rest/v2/intro/
+type provider struct {
+	client    *http.Client
+	url       string
+	username  string
+	token     string
+	project   string
+	issueType string
+}
+
+func newProvider(config *ticket.Config) (ticket.Provider, error) {
+	if config.URL == "" {
+		return nil, errors.New("jira: url is required")
+	}
+	if config.Project == "" {
+		return nil, errors.New("jira: project key is required")
+	}
+	issueType := config.IssueType
+	if issueType == "" {
+		issueType = "Task"
+	}
+	return &provider{
+		client:    &http.Client{Timeout: 10 * time.Second},
+		url:       strings.TrimSuffix(config.URL, "/"),
+		username:  config.Username,
+		token:     config.Token,
+		project:   config.Project,
+		issueType: issueType,
+	}, nil
+}
+
+type createIssueRequest struct {
+	Fields createIssueFields `json:"fields"`
+}
+
+type createIssueFields struct {
+	Project     keyField  `json:"project"`
+	Summary     string    `json:"summary"`
+	Description string    `json:"description"`
+	IssueType   nameField `json:"issuetype"`
+}
+
+type keyField struct {
+	Key string `json:"key"`
+}
+
+type nameField struct {
+	Name string `json:"name"`
+}
+
+type issue struct {
+	Key    string `json:"key"`
+	Fields struct {
+		Status nameField `json:"status"`
+	} `json:"fields"`
+}
+
+type transitionsResponse struct {
+	Transitions []*transition `json:"transitions"`
+}
+
+type transition struct {
+	ID string    `json:"id"`
+	To nameField `json:"to"`
+}
+
+type transitionRequest struct {
+	Transition struct {
+		ID string `json:"id"`
+	} `json:"transition"`
+}
+
+// Create creates the Jira issue.
+func (p *provider) Create(ctx context.Context, request *ticket.CreateRequest) (*ticket.Ticket, error) {
+	description := request.Description
+	if request.Link != "" {
+		description = strings.TrimSpace(fmt.Sprintf("%s\n\n%s", description, request.Link))
+	}
+	created := &issue{}
+	if err := p.do(ctx, http.MethodPost, "/rest/api/2/issue", &createIssueRequest{
+		Fields: createIssueFields{
+			Project:     keyField{Key: p.project},
+			Summary:     request.Title,
+			Description: description,
+			IssueType:   nameField{Name: p.issueType},
+		},
+	}, created); err != nil {
+		return nil, errors.Wrapf(err, "failed to create jira issue")
+	}
+	// The create response doesn't include the status.
+	return p.Get(ctx, created.Key)
+}
+
+// Get gets the Jira issue.
+func (p *provider) Get(ctx context.Context, key string) (*ticket.Ticket, error) {
+	i := &issue{}
+	if err := p.do(ctx, http.MethodGet, fmt.Sprintf("/rest/api/2/issue/%s?fields=status", url.PathEscape(key)), nil, i); err != nil {
+		return nil, errors.Wrapf(err, "failed to get jira issue %s", key)
+	}
+	return &ticket.Ticket{
+		Key:    i.Key,
+		URL:    fmt.Sprintf("%s/browse/%s", p.url, i.Key),
+		Status: i.Fields.Status.Name,
+	}, nil
+}
+
+// Transition moves the Jira issue with the transition to the status.
+func (p *provider) Transition(ctx context.Context, key string, status string) error {
+	path := fmt.Sprintf("/rest/api/2/issue/%s/transitions", url.PathEscape(key))
+	transitions := &transitionsResponse{}
+	if err := p.do(ctx, http.MethodGet, path, nil, transitions); err != nil {
+		return errors.Wrapf(err, "failed to list the transitions of jira issue %s", key)
+	}
+	for _, t := range transitions.Transitions {
+		if !strings.EqualFold(t.To.Name, status) {
+			continue
+		}
+		request := &transitionRequest{}
+		request.Transition.ID = t.ID
+		if err := p.do(ctx, http.MethodPost, path, request, nil); err != nil {
+			return errors.Wrapf(err, "failed to transition jira issue %s to %q", key, status)
+		}
+		return nil
+	}
+	return errors.Errorf("no transition to %q for jira issue %s", status, key)
+}
+
+func (p *provider) do(ctx context.Context, method, path string, requestBody, responseBody any) error {
+	var body io.Reader
+	if requestBody != nil {
+		out, err := json.Marshal(requestBody)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(out)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, p.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(p.username, p.token)
+	req.Header.Set("Accept", "application/json")
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("unexpected status %v: %s", resp.Status, string(b))
+	}
+	if responseBody == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(responseBody)
+}
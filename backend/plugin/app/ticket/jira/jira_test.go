@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/ticket"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// fakeJira is the Jira server with a single project and the To Do, In Progress and Done statuses.
+type fakeJira struct {
+	mu       sync.Mutex
+	statuses map[string]string
+}
+
+var fakeTransitions = map[string]string{
+	"11": "To Do",
+	"21": "In Progress",
+	"31": "Done",
+}
+
+func (f *fakeJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if username, password, ok := r.BasicAuth(); !ok || username != "bot@example.com" || password != "token" {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue")
+	switch {
+	case r.Method == http.MethodPost && path == "":
+		request := &createIssueRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil || request.Fields.Project.Key != "PAY" || request.Fields.IssueType.Name != "Task" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		key := "PAY-1"
+		f.statuses[key] = "To Do"
+		_ = json.NewEncoder(w).Encode(map[string]string{"key": key})
+	case r.Method == http.MethodGet && strings.HasSuffix(path, "/transitions"):
+		response := &transitionsResponse{}
+		for id, name := range fakeTransitions {
+			response.Transitions = append(response.Transitions, &transition{ID: id, To: nameField{Name: name}})
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/transitions"):
+		key := strings.TrimSuffix(strings.TrimPrefix(path, "/"), "/transitions")
+		request := &transitionRequest{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		f.statuses[key] = fakeTransitions[request.Transition.ID]
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet:
+		key := strings.TrimPrefix(path, "/")
+		status, ok := f.statuses[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		i := &issue{Key: key}
+		i.Fields.Status.Name = status
+		_ = json.NewEncoder(w).Encode(i)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestProvider(t *testing.T) {
+	server := httptest.NewServer(&fakeJira{statuses: make(map[string]string)})
+	defer server.Close()
+
+	provider, err := ticket.Open(storepb.TicketSync_JIRA, &ticket.Config{
+		URL:      server.URL + "/",
+		Username: "bot@example.com",
+		Token:    "token",
+		Project:  "PAY",
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	created, err := provider.Create(ctx, &ticket.CreateRequest{Title: "Add index", Link: "https://bytebase.example.com/projects/pay/issues/1"})
+	require.NoError(t, err)
+	require.Equal(t, &ticket.Ticket{Key: "PAY-1", URL: server.URL + "/browse/PAY-1", Status: "To Do"}, created)
+
+	require.NoError(t, provider.Transition(ctx, "PAY-1", "done"))
+	got, err := provider.Get(ctx, "PAY-1")
+	require.NoError(t, err)
+	require.Equal(t, "Done", got.Status)
+
+	require.Error(t, provider.Transition(ctx, "PAY-1", "Won't Do"))
+	_, err = provider.Get(ctx, "PAY-2")
+	require.Error(t, err)
+}
@@ -0,0 +1,189 @@
+// Package linear is the Linear provider of the ticket sync.
+package linear
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/ticket"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const defaultURL = "https://api.linear.app"
+
+func init() {
+	ticket.Register(storepb.TicketSync_LINEAR, newProvider)
+}
+
+// provider is the ticket provider of Linear, it uses the GraphQL API with the API key.
+// ref: https://developers.linear.app/docs/graphql/working-with-the-graphql-api
+type provider struct {
+	client *http.Client
+	url    string
+	token  string
+	teamID string
+}
+
+func newProvider(config *ticket.Config) (ticket.Provider, error) {
+	if config.Project == "" {
+		return nil, errors.New("linear: team id is required")
+	}
+	u := config.URL
+	if u == "" {
+		u = defaultURL
+	}
+	return &provider{
+		client: &http.Client{Timeout: 10 * time.Second},
+		url:    strings.TrimSuffix(u, "/"),
+		token:  config.Token,
+		teamID: config.Project,
+	}, nil
+}
+
+type issue struct {
+	Identifier string `json:"identifier"`
+	URL        string `json:"url"`
+	State      struct {
+		Name string `json:"name"`
+	} `json:"state"`
+}
+
+func (i *issue) toTicket() *ticket.Ticket {
+	return &ticket.Ticket{
+		Key:    i.Identifier,
+		URL:    i.URL,
+		Status: i.State.Name,
+	}
+}
+
+const issueFields = "identifier url state { name }"
+
+// Create creates the Linear issue.
+func (p *provider) Create(ctx context.Context, request *ticket.CreateRequest) (*ticket.Ticket, error) {
+	description := request.Description
+	if request.Link != "" {
+		description = strings.TrimSpace(description + "\n\n" + request.Link)
+	}
+	var data struct {
+		IssueCreate struct {
+			Success bool   `json:"success"`
+			Issue   *issue `json:"issue"`
+		} `json:"issueCreate"`
+	}
+	if err := p.query(ctx, `mutation($input: IssueCreateInput!) { issueCreate(input: $input) { success issue { `+issueFields+` } } }`, map[string]any{
+		"input": map[string]any{
+			"teamId":      p.teamID,
+			"title":       request.Title,
+			"description": description,
+		},
+	}, &data); err != nil {
+		return nil, errors.Wrapf(err, "failed to create linear issue")
+	}
+	if !data.IssueCreate.Success || data.IssueCreate.Issue == nil {
+		return nil, errors.New("failed to create linear issue")
+	}
+	return data.IssueCreate.Issue.toTicket(), nil
+}
+
+// Get gets the Linear issue by the identifier.
+func (p *provider) Get(ctx context.Context, key string) (*ticket.Ticket, error) {
+	var data struct {
+		Issue *issue `json:"issue"`
+	}
+	if err := p.query(ctx, `query($id: String!) { issue(id: $id) { `+issueFields+` } }`, map[string]any{"id": key}, &data); err != nil {
+		return nil, errors.Wrapf(err, "failed to get linear issue %s", key)
+	}
+	if data.Issue == nil {
+		return nil, errors.Errorf("linear issue %s not found", key)
+	}
+	return data.Issue.toTicket(), nil
+}
+
+// Transition moves the Linear issue to the workflow state of the team by the name.
+func (p *provider) Transition(ctx context.Context, key string, status string) error {
+	var states struct {
+		WorkflowStates struct {
+			Nodes []struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"nodes"`
+		} `json:"workflowStates"`
+	}
+	if err := p.query(ctx, `query($teamId: ID!) { workflowStates(filter: { team: { id: { eq: $teamId } } }) { nodes { id name } } }`, map[string]any{"teamId": p.teamID}, &states); err != nil {
+		return errors.Wrapf(err, "failed to list the workflow states of linear team %s", p.teamID)
+	}
+	for _, state := range states.WorkflowStates.Nodes {
+		if !strings.EqualFold(state.Name, status) {
+			continue
+		}
+		var data struct {
+			IssueUpdate struct {
+				Success bool `json:"success"`
+			} `json:"issueUpdate"`
+		}
+		if err := p.query(ctx, `mutation($id: String!, $stateId: String!) { issueUpdate(id: $id, input: { stateId: $stateId }) { success } }`, map[string]any{
+			"id":      key,
+			"stateId": state.ID,
+		}, &data); err != nil {
+			return errors.Wrapf(err, "failed to transition linear issue %s to %q", key, status)
+		}
+		if !data.IssueUpdate.Success {
+			return errors.Errorf("failed to transition linear issue %s to %q", key, status)
+		}
+		return nil
+	}
+	return errors.Errorf("no workflow state %q in linear team %s", status, p.teamID)
+}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func (p *provider) query(ctx context.Context, query string, variables map[string]any, data any) error {
+	out, err := json.Marshal(&graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url+"/graphql", bytes.NewReader(out))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", p.token)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("unexpected status %v: %s", resp.Status, string(b))
+	}
+	response := &graphQLResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		var messages []string
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New(strings.Join(messages, "; "))
+	}
+	return json.Unmarshal(response.Data, data)
+}
@@ -0,0 +1,81 @@
+// Package ticket is the interface of the issue trackers, e.g. Jira and Linear, which the issues are synced to.
+// A provider registers itself by the type in the init function.
+package ticket
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	providerMu sync.RWMutex
+	providers  = make(map[storepb.TicketSync_Type]Factory)
+)
+
+// Config is the config of the issue tracker with the resolved token.
+type Config struct {
+	URL       string
+	Username  string
+	Token     string
+	Project   string
+	IssueType string
+}
+
+// CreateRequest is the request to create the ticket for an issue.
+type CreateRequest struct {
+	Title       string
+	Description string
+	// Link is the URL of the issue in Bytebase.
+	Link string
+}
+
+// Ticket is the ticket in the issue tracker.
+type Ticket struct {
+	// Key is the human-readable key of the ticket, e.g. PAY-123.
+	Key string
+	URL string
+	// Status is the name of the ticket status, e.g. In Progress.
+	Status string
+}
+
+// Provider creates and transitions the tickets in the issue tracker.
+type Provider interface {
+	// Create creates the ticket.
+	Create(ctx context.Context, request *CreateRequest) (*Ticket, error)
+	// Get gets the ticket by the key.
+	Get(ctx context.Context, key string) (*Ticket, error)
+	// Transition moves the ticket to the status by the name.
+	Transition(ctx context.Context, key string, status string) error
+}
+
+// Factory creates the provider for the config.
+type Factory func(config *Config) (Provider, error)
+
+// Register makes a provider available by the type.
+// If Register is called twice with the same type or if factory is nil, it panics.
+func Register(providerType storepb.TicketSync_Type, factory Factory) {
+	providerMu.Lock()
+	defer providerMu.Unlock()
+	if factory == nil {
+		panic("ticket: Register factory is nil")
+	}
+	if _, dup := providers[providerType]; dup {
+		panic("ticket: Register called twice for provider " + providerType.String())
+	}
+	providers[providerType] = factory
+}
+
+// Open creates the provider of the type.
+func Open(providerType storepb.TicketSync_Type, config *Config) (Provider, error) {
+	providerMu.RLock()
+	factory, ok := providers[providerType]
+	providerMu.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("ticket: unknown provider %q", providerType)
+	}
+	return factory(config)
+}
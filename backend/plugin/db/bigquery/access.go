@@ -0,0 +1,141 @@
+package bigquery
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/pkg/errors"
+
+	bqparser "github.com/bytebase/bytebase/backend/plugin/parser/bigquery"
+)
+
+// applyAccessStatement applies the authorized view or the policy tags change with the BigQuery API.
+// The metadata is updated with its etag, so the concurrent changes fail instead of being overwritten.
+func (d *Driver) applyAccessStatement(ctx context.Context, s *bqparser.AccessStatement) error {
+	switch s.Type {
+	case bqparser.AccessStatementTypeAddAuthorizedView, bqparser.AccessStatementTypeDropAuthorizedView:
+		dataset := d.client.Dataset(d.getDataset(s.Dataset))
+		metadata, err := dataset.Metadata(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get dataset %q", dataset.DatasetID)
+		}
+		view := d.getAuthorizedView(s)
+		i := slices.IndexFunc(metadata.Access, func(entry *bigquery.AccessEntry) bool { return isAccessEntryOfView(entry, view) })
+		access := metadata.Access
+		if s.Type == bqparser.AccessStatementTypeAddAuthorizedView {
+			if i >= 0 {
+				return nil
+			}
+			access = append(access, &bigquery.AccessEntry{
+				EntityType: bigquery.ViewEntity,
+				View:       view,
+			})
+		} else {
+			if i < 0 {
+				return errors.Errorf("view %s is not an authorized view of dataset %q", view.FullyQualifiedName(), dataset.DatasetID)
+			}
+			access = slices.Delete(access, i, i+1)
+		}
+		if _, err := dataset.Update(ctx, bigquery.DatasetMetadataToUpdate{Access: access}, metadata.ETag); err != nil {
+			return errors.Wrapf(err, "failed to update the access of dataset %q", dataset.DatasetID)
+		}
+		return nil
+	case bqparser.AccessStatementTypeSetPolicyTags, bqparser.AccessStatementTypeDropPolicyTags:
+		table := d.client.Dataset(d.getDataset(s.Dataset)).Table(s.Table)
+		metadata, err := table.Metadata(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get table %q", s.Table)
+		}
+		schema := slices.Clone(metadata.Schema)
+		i := slices.IndexFunc(schema, func(field *bigquery.FieldSchema) bool { return strings.EqualFold(field.Name, s.Column) })
+		if i < 0 {
+			return errors.Errorf("column %q not found in table %q", s.Column, s.Table)
+		}
+		field := *schema[i]
+		// The empty list clears the policy tags of the column.
+		field.PolicyTags = &bigquery.PolicyTagList{Names: s.PolicyTags}
+		if field.PolicyTags.Names == nil {
+			field.PolicyTags.Names = []string{}
+		}
+		schema[i] = &field
+		if _, err := table.Update(ctx, bigquery.TableMetadataToUpdate{Schema: schema}, metadata.ETag); err != nil {
+			return errors.Wrapf(err, "failed to update the policy tags of column %q in table %q", s.Column, s.Table)
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported access statement type %v", s.Type)
+	}
+}
+
+// CheckAccessStatement checks the references of the access statement against the current datasets and tables.
+// The policy tags must be in the same location as the dataset, and a column can have at most one policy tag.
+func (d *Driver) CheckAccessStatement(ctx context.Context, s *bqparser.AccessStatement) error {
+	dataset := d.client.Dataset(d.getDataset(s.Dataset))
+	datasetMetadata, err := dataset.Metadata(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get dataset %q", dataset.DatasetID)
+	}
+	switch s.Type {
+	case bqparser.AccessStatementTypeAddAuthorizedView, bqparser.AccessStatementTypeDropAuthorizedView:
+		view := d.getAuthorizedView(s)
+		viewMetadata, err := view.Metadata(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get view %s", view.FullyQualifiedName())
+		}
+		if viewMetadata.Type != bigquery.ViewTable && viewMetadata.Type != bigquery.MaterializedView {
+			return errors.Errorf("%s is not a view", view.FullyQualifiedName())
+		}
+		authorized := slices.ContainsFunc(datasetMetadata.Access, func(entry *bigquery.AccessEntry) bool { return isAccessEntryOfView(entry, view) })
+		if s.Type == bqparser.AccessStatementTypeDropAuthorizedView && !authorized {
+			return errors.Errorf("view %s is not an authorized view of dataset %q", view.FullyQualifiedName(), dataset.DatasetID)
+		}
+		return nil
+	case bqparser.AccessStatementTypeSetPolicyTags, bqparser.AccessStatementTypeDropPolicyTags:
+		if s.Type == bqparser.AccessStatementTypeSetPolicyTags {
+			if len(s.PolicyTags) != 1 {
+				return errors.Errorf("a column can have exactly one policy tag, got %d", len(s.PolicyTags))
+			}
+			for _, policyTag := range s.PolicyTags {
+				location, ok := bqparser.GetPolicyTagLocation(policyTag)
+				if !ok {
+					return errors.Errorf("invalid policy tag %q, the policy tag should be projects/{project}/locations/{location}/taxonomies/{taxonomy}/policyTags/{policy_tag}", policyTag)
+				}
+				if !strings.EqualFold(location, datasetMetadata.Location) {
+					return errors.Errorf("policy tag %q is in location %q, but dataset %q is in location %q", policyTag, location, dataset.DatasetID, datasetMetadata.Location)
+				}
+			}
+		}
+		tableMetadata, err := dataset.Table(s.Table).Metadata(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get table %q", s.Table)
+		}
+		if !slices.ContainsFunc(tableMetadata.Schema, func(field *bigquery.FieldSchema) bool { return strings.EqualFold(field.Name, s.Column) }) {
+			return errors.Errorf("column %q not found in table %q", s.Column, s.Table)
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported access statement type %v", s.Type)
+	}
+}
+
+func (d *Driver) getDataset(dataset string) string {
+	if dataset == "" {
+		return d.databaseName
+	}
+	return dataset
+}
+
+func (d *Driver) getAuthorizedView(s *bqparser.AccessStatement) *bigquery.Table {
+	project := s.ViewProject
+	if project == "" {
+		project = d.client.Project()
+	}
+	return d.client.DatasetInProject(project, s.ViewDataset).Table(s.View)
+}
+
+func isAccessEntryOfView(entry *bigquery.AccessEntry, view *bigquery.Table) bool {
+	return entry.EntityType == bigquery.ViewEntity && entry.View != nil &&
+		entry.View.ProjectID == view.ProjectID && entry.View.DatasetID == view.DatasetID && entry.View.TableID == view.TableID
+}
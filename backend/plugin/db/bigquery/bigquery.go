@@ -6,6 +6,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/bigquery"
@@ -18,6 +20,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	bqparser "github.com/bytebase/bytebase/backend/plugin/parser/bigquery"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -86,21 +89,83 @@ func (*Driver) GetDB() *sql.DB {
 }
 
 // Execute executes a SQL statement.
+// The access statements changing the authorized views and the policy tags are applied with the BigQuery API,
+// and the other statements between them are run as scripts.
 func (d *Driver) Execute(ctx context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
+	stmts, err := util.SanitizeSQL(statement)
+	if err != nil {
+		// The procedural language may not be split, so it's run as a whole script.
+		// nolint:nilerr
+		return 0, d.runScript(ctx, statement)
+	}
+	var accessStatements []*bqparser.AccessStatement
+	for _, stmt := range stmts {
+		s, _ := bqparser.ParseAccessStatement(stmt)
+		accessStatements = append(accessStatements, s)
+	}
+	if !slices.ContainsFunc(accessStatements, func(s *bqparser.AccessStatement) bool { return s != nil }) {
+		return 0, d.runScript(ctx, statement)
+	}
+
+	var script []string
+	for i, stmt := range stmts {
+		if accessStatements[i] == nil {
+			script = append(script, stmt)
+			continue
+		}
+		if len(script) > 0 {
+			if err := d.runScript(ctx, strings.Join(script, ";\n")); err != nil {
+				return 0, err
+			}
+			script = nil
+		}
+		if err := d.applyAccessStatement(ctx, accessStatements[i]); err != nil {
+			return 0, err
+		}
+	}
+	if len(script) > 0 {
+		if err := d.runScript(ctx, strings.Join(script, ";\n")); err != nil {
+			return 0, err
+		}
+	}
+	return 0, nil
+}
+
+func (d *Driver) runScript(ctx context.Context, statement string) error {
 	q := d.client.Query(statement)
 	q.DefaultDatasetID = d.databaseName
 	job, err := q.Run(ctx)
 	if err != nil {
-		return 0, err
+		return err
 	}
 	status, err := job.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	return status.Err()
+}
+
+// DryRun validates the statement with a dry run query job, and returns the estimated bytes processed.
+// Docs: https://cloud.google.com/bigquery/docs/running-queries#dry-run.
+func (d *Driver) DryRun(ctx context.Context, statement string) (int64, error) {
+	q := d.client.Query(statement)
+	q.DefaultDatasetID = d.databaseName
+	q.DryRun = true
+	job, err := q.Run(ctx)
 	if err != nil {
 		return 0, err
 	}
+	status := job.LastStatus()
+	if status == nil {
+		return 0, errors.New("no dry run status returned")
+	}
 	if err := status.Err(); err != nil {
 		return 0, err
 	}
-	return 0, nil
+	if status.Statistics == nil {
+		return 0, nil
+	}
+	return status.Statistics.TotalBytesProcessed, nil
 }
 
 // QueryConn queries a SQL statement in a given connection.
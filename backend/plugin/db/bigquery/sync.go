@@ -113,6 +113,14 @@ func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetad
 		if err != nil {
 			return nil, err
 		}
+		if tmd.Type == bigquery.ViewTable {
+			schemaMetadata.Views = append(schemaMetadata.Views, &storepb.ViewMetadata{
+				Name:       t.TableID,
+				Definition: tmd.ViewQuery,
+				Comment:    tmd.Description,
+			})
+			continue
+		}
 		schemaMetadata.Tables = append(schemaMetadata.Tables, &storepb.TableMetadata{
 			Name:     t.TableID,
 			Columns:  columns,
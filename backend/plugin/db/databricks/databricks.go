@@ -100,7 +100,10 @@ func (d *Driver) QueryConn(ctx context.Context, _ *sql.Conn, statement string, _
 			return nil, err
 		}
 		if dataArr == nil || colInfo == nil {
-			break
+			// The statements without result set, e.g. DDL and GRANT.
+			result.Latency = durationpb.New(time.Since(startTime))
+			results = append(results, result)
+			continue
 		}
 
 		colNames, colTypeNames := toStrColInfo(colInfo)
@@ -127,10 +130,30 @@ func (d *Driver) QueryConn(ctx context.Context, _ *sql.Conn, statement string, _
 	return results, nil
 }
 
-func (d *Driver) Execute(ctx context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
-	// No ways of fetching affected rows.
-	_, err := d.QueryConn(ctx, nil, statement, nil)
-	return 0, err
+// Execute executes the statements one by one in the catalog of the driver.
+func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	stmts, err := base.SplitMultiSQL(storepb.Engine_DATABRICKS, statement)
+	if err != nil {
+		return 0, err
+	}
+	nonEmptyStmts, idxMap := base.FilterEmptySQLWithIndexes(stmts)
+	for i, stmt := range nonEmptyStmts {
+		if opts.UpdateExecutionStatus != nil {
+			opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(nonEmptyStmts)),
+				CommandsCompleted: int32(i),
+			})
+		}
+		indexes := []int32{idxMap[i]}
+		opts.LogCommandExecute(indexes)
+		// No ways of fetching affected rows.
+		if _, _, err := d.execSingleSQLSync(ctx, stmt.Text); err != nil {
+			opts.LogCommandResponse(indexes, 0, []int32{0}, err.Error())
+			return 0, err
+		}
+		opts.LogCommandResponse(indexes, 0, []int32{0}, "")
+	}
+	return 0, nil
 }
 
 func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
@@ -142,10 +165,15 @@ func (d *Driver) execSingleSQLSync(ctx context.Context, statement string) ([][]s
 	resp, err := d.Client.StatementExecution.ExecuteAndWait(ctx, dbsql.ExecuteStatementRequest{
 		Statement:   statement,
 		WarehouseId: d.WarehouseID,
+		// The unqualified names are resolved in the catalog of the driver, or the default catalog of the workspace.
+		Catalog: d.curCatalog,
 	})
 	if err != nil {
 		return nil, nil, err
 	}
+	if resp.Status != nil && resp.Status.Error != nil {
+		return nil, nil, errors.Errorf("failed to execute statement: %s", resp.Status.Error.Message)
+	}
 	if resp.Result == nil {
 		return nil, nil, errors.New("no response")
 	}
@@ -28,7 +28,8 @@ var (
 )
 
 func (d *Driver) Dump(ctx context.Context, writer io.Writer) (string, error) {
-	catalogMap, err := d.listCatologTables(ctx, "")
+	// The database of Databricks is the catalog.
+	catalogMap, err := d.listCatologTables(ctx, d.curCatalog)
 	if err != nil {
 		return "", err
 	}
@@ -1 +1,72 @@
 package databricks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Grants
+// The roles of Databricks are the principals, e.g. users, groups and service principals, of the Unity Catalog grants.
+// The role attribute is the GRANT statements of the privileges granted to the principal directly.
+// Docs: https://docs.databricks.com/en/data-governance/unity-catalog/manage-privileges/privileges.html.
+
+// privilegeQueries are the queries of the direct grants on the securables keyed by the securable type,
+// the inherited privileges come from the grants on the parent securables.
+var privilegeQueries = []struct {
+	securableType string
+	query         string
+}{
+	{
+		securableType: "CATALOG",
+		query:         "SELECT grantee, privilege_type, catalog_name FROM system.information_schema.catalog_privileges WHERE inherited_from = 'NONE'",
+	},
+	{
+		securableType: "SCHEMA",
+		query:         "SELECT grantee, privilege_type, catalog_name, schema_name FROM system.information_schema.schema_privileges WHERE inherited_from = 'NONE'",
+	},
+	{
+		securableType: "TABLE",
+		query:         "SELECT grantee, privilege_type, table_catalog, table_schema, table_name FROM system.information_schema.table_privileges WHERE inherited_from = 'NONE'",
+	},
+}
+
+// getInstanceRoles gets the principals with the grants of the Unity Catalog.
+func (d *Driver) getInstanceRoles(ctx context.Context) ([]*storepb.InstanceRole, error) {
+	grants := make(map[string][]string)
+	for _, q := range privilegeQueries {
+		rows, _, err := d.execSingleSQLSync(ctx, q.query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list %s privileges", strings.ToLower(q.securableType))
+		}
+		for _, row := range rows {
+			if len(row) < 3 {
+				return nil, errors.Errorf("invalid %s privilege row %v", strings.ToLower(q.securableType), row)
+			}
+			var parts []string
+			for _, name := range row[2:] {
+				parts = append(parts, fmt.Sprintf("`%s`", name))
+			}
+			principal := row[0]
+			grants[principal] = append(grants[principal], fmt.Sprintf("GRANT %s ON %s %s TO `%s`;", strings.ReplaceAll(row[1], "_", " "), q.securableType, strings.Join(parts, "."), principal))
+		}
+	}
+
+	var instanceRoles []*storepb.InstanceRole
+	for principal, list := range grants {
+		attribute := strings.Join(list, "\n")
+		instanceRoles = append(instanceRoles, &storepb.InstanceRole{
+			Name:      principal,
+			Attribute: &attribute,
+		})
+	}
+	sort.Slice(instanceRoles, func(i, j int) bool {
+		return instanceRoles[i].Name < instanceRoles[j].Name
+	})
+	return instanceRoles, nil
+}
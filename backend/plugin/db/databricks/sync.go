@@ -3,12 +3,14 @@ package databricks
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"github.com/databricks/databricks-sdk-go/service/catalog"
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -82,8 +84,14 @@ func (d *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error)
 		instanceMetadata.Databases = append(instanceMetadata.Databases, &dbSchemaMeta)
 	}
 
-	// fetch workspace users.
-	// TODO(tommy): complete this part when Permissions API for Golang is implemented.
+	// The information schema of the system catalog requires the metastore to be enabled for the Unity Catalog.
+	instanceRoles, err := d.getInstanceRoles(ctx)
+	if err != nil {
+		slog.Debug("failed to get the grants of the unity catalog", log.BBError(err))
+	}
+	instanceMetadata.Metadata = &storepb.InstanceMetadata{
+		Roles: instanceRoles,
+	}
 
 	return instanceMetadata, nil
 }
@@ -0,0 +1,298 @@
+// Package doris is the plugin for Apache Doris driver.
+package doris
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	dorisparser "github.com/bytebase/bytebase/backend/plugin/parser/doris"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	baseTableType = "BASE TABLE"
+	viewTableType = "VIEW"
+
+	// dorisVersionRegexp matches the Doris version in the version comment, such as "Doris version doris-2.1.2-rc04-a3f3f3c".
+	dorisVersionRegexp = regexp.MustCompile(`(?i)doris-(\d+\.\d+\.\d+)(\S*)`)
+
+	_ db.Driver = (*Driver)(nil)
+)
+
+func init() {
+	db.Register(storepb.Engine_DORIS, newDriver)
+}
+
+// Driver is the Doris driver.
+type Driver struct {
+	connectionCtx db.ConnectionContext
+	connCfg       db.ConnectionConfig
+	db            *sql.DB
+	databaseName  string
+	sshTunnel     *util.SSHTunnel
+
+	// Called upon driver.Open() finishes.
+	openCleanUp []func()
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a Doris driver.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, connCfg db.ConnectionConfig) (db.Driver, error) {
+	defer func() {
+		for _, f := range driver.openCleanUp {
+			f()
+		}
+	}()
+
+	protocol := "tcp"
+	if strings.HasPrefix(connCfg.Host, "/") {
+		protocol = "unix"
+	}
+	params := []string{"multiStatements=true", "maxAllowedPacket=0"}
+	if connCfg.SSHConfig.Host != "" {
+		sshTunnel, err := util.NewSSHTunnel(connCfg.SSHConfig)
+		if err != nil {
+			return nil, err
+		}
+		driver.sshTunnel = sshTunnel
+		// Now we register the dialer with the ssh connection as a parameter.
+		mysql.RegisterDialContext("mysql+tcp", func(_ context.Context, addr string) (net.Conn, error) {
+			return sshTunnel.Dial("tcp", addr)
+		})
+		protocol = "mysql+tcp"
+	}
+
+	tlsConfig, err := connCfg.TLSConfig.GetSslConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "sql: tls config error")
+	}
+	tlsKey := uuid.NewString()
+	if tlsConfig != nil {
+		if err := mysql.RegisterTLSConfig(tlsKey, tlsConfig); err != nil {
+			return nil, errors.Wrap(err, "sql: failed to register tls config")
+		}
+		// TLS config is only used during sql.Open, so should be safe to deregister afterwards.
+		driver.openCleanUp = append(driver.openCleanUp, func() { mysql.DeregisterTLSConfig(tlsKey) })
+		params = append(params, fmt.Sprintf("tls=%s", tlsKey))
+	}
+
+	dsn := fmt.Sprintf("%s:%s@%s(%s:%s)/%s?%s", connCfg.Username, connCfg.Password, protocol, connCfg.Host, connCfg.Port, connCfg.Database, strings.Join(params, "&"))
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	db.SetConnMaxLifetime(2 * time.Hour)
+	db.SetMaxOpenConns(50)
+	db.SetMaxIdleConns(15)
+	driver.connectionCtx = connCfg.ConnectionContext
+	driver.connCfg = connCfg
+	driver.databaseName = connCfg.Database
+
+	return driver, nil
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	var err error
+	err = multierr.Append(err, driver.db.Close())
+	if driver.sshTunnel != nil {
+		err = multierr.Append(err, driver.sshTunnel.Close())
+	}
+	return err
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// getVersion gets the version.
+// Doris reports a MySQL compatible version by VERSION(), the actual version is in the version comment.
+func (driver *Driver) getVersion(ctx context.Context) (string, error) {
+	query := "SELECT @@version_comment"
+	var versionComment string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&versionComment); err != nil {
+		if err == sql.ErrNoRows {
+			return "", common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	return parseVersion(versionComment)
+}
+
+func parseVersion(versionComment string) (string, error) {
+	if match := dorisVersionRegexp.FindStringSubmatch(versionComment); match != nil {
+		return match[1], nil
+	}
+	return "", errors.Errorf("failed to parse version %q", versionComment)
+}
+
+// Execute executes a SQL statement.
+// Doris doesn't support DDL in transactions, so the statements are executed one by one.
+func (driver *Driver) Execute(ctx context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
+	list, err := dorisparser.SplitSQL(statement)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to split statements")
+	}
+	list = base.FilterEmptySQL(list)
+
+	conn, err := driver.db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+
+	connectionID, err := getConnectionID(ctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	slog.Debug("connectionID", slog.String("connectionID", connectionID))
+
+	var totalRowsAffected int64
+	for _, singleSQL := range list {
+		sqlResult, err := conn.ExecContext(ctx, singleSQL.Text)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				slog.Info("cancel connection", slog.String("connectionID", connectionID))
+				if err := driver.StopConnectionByID(connectionID); err != nil {
+					slog.Error("failed to cancel connection", slog.String("connectionID", connectionID), log.BBError(err))
+				}
+			}
+			return 0, err
+		}
+		rowsAffected, err := sqlResult.RowsAffected()
+		if err != nil {
+			// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+			slog.Debug("rowsAffected returns error", log.BBError(err))
+			continue
+		}
+		totalRowsAffected += rowsAffected
+	}
+
+	return totalRowsAffected, nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_DORIS, statement)
+	if err != nil {
+		return nil, err
+	}
+	singleSQLs = base.FilterEmptySQL(singleSQLs)
+	if len(singleSQLs) == 0 {
+		return nil, nil
+	}
+
+	connectionID, err := getConnectionID(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("connectionID", slog.String("connectionID", connectionID))
+
+	var results []*v1pb.QueryResult
+	for _, singleSQL := range singleSQLs {
+		statement := singleSQL.Text
+		if queryContext != nil && queryContext.Explain {
+			statement = fmt.Sprintf("EXPLAIN %s", statement)
+		} else if queryContext != nil && queryContext.Limit > 0 {
+			statement = getStatementWithResultLimit(statement, queryContext.Limit)
+		}
+		sqlWithBytebaseAppComment := util.MySQLPrependBytebaseAppComment(statement)
+
+		_, allQuery, err := base.ValidateSQLForEditor(storepb.Engine_DORIS, statement)
+		if err != nil {
+			// The MySQL parser doesn't recognize some Doris statements.
+			slog.Debug("failed to validate sql", slog.String("statement", statement), log.BBError(err))
+			allQuery = true
+		}
+		startTime := time.Now()
+		queryResult, err := func() (*v1pb.QueryResult, error) {
+			if allQuery {
+				rows, err := conn.QueryContext(ctx, sqlWithBytebaseAppComment)
+				if err != nil {
+					return nil, util.FormatErrorWithQuery(err, statement)
+				}
+				defer rows.Close()
+				r, err := util.RowsToQueryResult(rows, driver.connCfg.MaximumSQLResultSize)
+				if err != nil {
+					return nil, err
+				}
+				if err := rows.Err(); err != nil {
+					return nil, err
+				}
+				return r, nil
+			}
+
+			sqlResult, err := conn.ExecContext(ctx, statement)
+			if err != nil {
+				return nil, err
+			}
+			affectedRows, err := sqlResult.RowsAffected()
+			if err != nil {
+				slog.Info("rowsAffected returns error", log.BBError(err))
+			}
+			return util.BuildAffectedRowsResult(affectedRows), nil
+		}()
+		stop := false
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				slog.Info("cancel connection", slog.String("connectionID", connectionID))
+				if err := driver.StopConnectionByID(connectionID); err != nil {
+					slog.Error("failed to cancel connection", slog.String("connectionID", connectionID), log.BBError(err))
+				}
+			}
+			queryResult = &v1pb.QueryResult{
+				Error: err.Error(),
+			}
+			stop = true
+		}
+		queryResult.Statement = statement
+		queryResult.Latency = durationpb.New(time.Since(startTime))
+		results = append(results, queryResult)
+		if stop {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func (driver *Driver) StopConnectionByID(id string) error {
+	_, err := driver.db.Exec(fmt.Sprintf("KILL QUERY %s", id))
+	return err
+}
+
+func getConnectionID(ctx context.Context, conn *sql.Conn) (string, error) {
+	var id string
+	if err := conn.QueryRowContext(ctx, `SELECT CONNECTION_ID();`).Scan(&id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
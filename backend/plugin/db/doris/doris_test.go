@@ -0,0 +1,73 @@
+package doris
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		versionComment string
+		want           string
+		wantErr        bool
+	}{
+		{
+			versionComment: "Doris version doris-2.1.2-rc04-a3f3f3c",
+			want:           "2.1.2",
+		},
+		{
+			versionComment: "Doris version doris-1.2.7.1-Release",
+			want:           "1.2.7",
+		},
+		{
+			versionComment: "MySQL Community Server (GPL)",
+			wantErr:        true,
+		},
+	}
+
+	a := require.New(t)
+	for _, tc := range tests {
+		version, err := parseVersion(tc.versionComment)
+		if tc.wantErr {
+			a.Error(err)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(tc.want, version)
+	}
+}
+
+func TestParseDynamicPartition(t *testing.T) {
+	a := require.New(t)
+	tableName, dynamicPartition := parseDynamicPartition(map[string]string{
+		"TableName": "orders",
+		"Enable":    "true",
+		"TimeUnit":  "DAY",
+		"Start":     "-7",
+		"End":       "3",
+		"Prefix":    "p",
+		"Buckets":   "32",
+	})
+	a.Equal("orders", tableName)
+	a.Equal(&storepb.DynamicPartitionMetadata{
+		Enabled:  true,
+		TimeUnit: "DAY",
+		Start:    -7,
+		End:      3,
+		Prefix:   "p",
+		Buckets:  32,
+	}, dynamicPartition)
+
+	_, dynamicPartition = parseDynamicPartition(map[string]string{
+		"TableName": "events",
+		"Enable":    "false",
+		"TimeUnit":  "MONTH",
+		"End":       "1",
+	})
+	a.False(dynamicPartition.Enabled)
+	a.Equal(int32(math.MinInt32), dynamicPartition.Start)
+}
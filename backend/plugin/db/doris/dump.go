@@ -0,0 +1,254 @@
+package doris
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// Dump and restore.
+const (
+	tableStmtFmt = "" +
+		"--\n" +
+		"-- Table structure for `%s`\n" +
+		"--\n" +
+		"%s;\n"
+	viewStmtFmt = "" +
+		"--\n" +
+		"-- View structure for `%s`\n" +
+		"--\n" +
+		"%s;\n"
+	tempViewStmtFmt = "" +
+		"--\n" +
+		"-- Temporary view structure for `%s`\n" +
+		"--\n" +
+		"%s\n"
+	materializedViewStmtFmt = "" +
+		"--\n" +
+		"-- Materialized view structure for `%s`\n" +
+		"--\n" +
+		"%s;\n"
+)
+
+// tableSchema describes the schema of a table or view.
+type tableSchema struct {
+	name        string
+	tableType   string
+	statement   string
+	viewColumns []string
+}
+
+// Dump dumps the database.
+// Unlike MySQL, Doris has no routines, events and foreign keys, but has the asynchronous materialized views.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer) (string, error) {
+	conn, err := driver.db.Conn(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	slog.Debug("begin to dump database", slog.String("database", driver.databaseName))
+	materializedViews, err := driver.getMaterializedViews(ctx)
+	if err != nil {
+		// The asynchronous materialized view is introduced in Doris 2.1.
+		slog.Debug("failed to get materialized views", slog.String("database", driver.databaseName), log.BBError(err))
+	}
+	materializedViewNames := make(map[string]bool)
+	for _, mv := range materializedViews {
+		materializedViewNames[mv.Name] = true
+	}
+
+	tables, err := getTables(ctx, conn, driver.databaseName, materializedViewNames)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get tables of database %q", driver.databaseName)
+	}
+
+	// Construct temporary views with the same columns first to satisfy the views depending on other views.
+	// They are replaced by the final views after the tables are created.
+	for _, tbl := range tables {
+		if tbl.tableType != viewTableType {
+			continue
+		}
+		if _, err := io.WriteString(out, fmt.Sprintf("%s\n", getTemporaryView(tbl.name, tbl.viewColumns))); err != nil {
+			return "", err
+		}
+	}
+	// Construct tables.
+	for _, tbl := range tables {
+		if tbl.tableType == viewTableType {
+			continue
+		}
+		if _, err := io.WriteString(out, fmt.Sprintf("%s\n", tbl.statement)); err != nil {
+			return "", err
+		}
+	}
+	// Construct final views.
+	for _, tbl := range tables {
+		if tbl.tableType != viewTableType {
+			continue
+		}
+		if _, err := io.WriteString(out, fmt.Sprintf("DROP VIEW IF EXISTS `%s`;\n", tbl.name)); err != nil {
+			return "", err
+		}
+		if _, err := io.WriteString(out, fmt.Sprintf("%s\n", tbl.statement)); err != nil {
+			return "", err
+		}
+	}
+	// Construct materialized views at last since they depend on the tables and views.
+	for _, mv := range materializedViews {
+		stmt, err := getMaterializedViewStmt(ctx, conn, driver.databaseName, mv.Name)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get materialized view %q", mv.Name)
+		}
+		if _, err := io.WriteString(out, fmt.Sprintf("%s\n", stmt)); err != nil {
+			return "", err
+		}
+	}
+
+	return "", nil
+}
+
+func getTemporaryView(name string, columns []string) string {
+	var parts []string
+	for _, col := range columns {
+		parts = append(parts, fmt.Sprintf("1 AS `%s`", col))
+	}
+	stmt := fmt.Sprintf("CREATE VIEW `%s` AS SELECT\n  %s;\n", name, strings.Join(parts, ",\n  "))
+	return fmt.Sprintf(tempViewStmtFmt, name, stmt)
+}
+
+// getTables gets the tables and views of the database except the materialized views.
+func getTables(ctx context.Context, conn *sql.Conn, dbName string, materializedViewNames map[string]bool) ([]*tableSchema, error) {
+	query := fmt.Sprintf("SELECT TABLE_NAME, TABLE_TYPE FROM information_schema.TABLES WHERE TABLE_SCHEMA = '%s' ORDER BY TABLE_NAME;", dbName)
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var tables []*tableSchema
+	for rows.Next() {
+		var tbl tableSchema
+		if err := rows.Scan(&tbl.name, &tbl.tableType); err != nil {
+			return nil, err
+		}
+		if materializedViewNames[tbl.name] {
+			continue
+		}
+		tables = append(tables, &tbl)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	for _, tbl := range tables {
+		stmt, err := getTableStmt(ctx, conn, dbName, tbl.name, tbl.tableType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to call getTableStmt(%q, %q, %q)", dbName, tbl.name, tbl.tableType)
+		}
+		tbl.statement = stmt
+		if tbl.tableType == viewTableType {
+			viewColumns, err := getViewColumns(ctx, conn, dbName, tbl.name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get columns of view %q", tbl.name)
+			}
+			tbl.viewColumns = viewColumns
+		}
+	}
+	return tables, nil
+}
+
+// getTableStmt gets the create statement of a table or view.
+func getTableStmt(ctx context.Context, conn *sql.Conn, dbName, tblName, tblType string) (string, error) {
+	switch tblType {
+	case baseTableType:
+		query := fmt.Sprintf("SHOW CREATE TABLE `%s`.`%s`;", dbName, tblName)
+		var stmt, unused string
+		if err := conn.QueryRowContext(ctx, query).Scan(&unused, &stmt); err != nil {
+			if err == sql.ErrNoRows {
+				return "", common.FormatDBErrorEmptyRowWithQuery(query)
+			}
+			return "", err
+		}
+		return fmt.Sprintf(tableStmtFmt, tblName, stmt), nil
+	case viewTableType:
+		query := fmt.Sprintf("SHOW CREATE VIEW `%s`.`%s`;", dbName, tblName)
+		var createStmt, unused string
+		if err := conn.QueryRowContext(ctx, query).Scan(&unused, &createStmt, &unused, &unused); err != nil {
+			if err == sql.ErrNoRows {
+				return "", common.FormatDBErrorEmptyRowWithQuery(query)
+			}
+			return "", err
+		}
+		return fmt.Sprintf(viewStmtFmt, tblName, createStmt), nil
+	default:
+		return "", errors.Errorf("unrecognized table type %q for database %q table %q", tblType, dbName, tblName)
+	}
+}
+
+// getViewColumns gets the column names of a view.
+func getViewColumns(ctx context.Context, conn *sql.Conn, dbName, tblName string) ([]string, error) {
+	query := fmt.Sprintf("SELECT COLUMN_NAME FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s' ORDER BY ORDINAL_POSITION;", dbName, tblName)
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return columns, nil
+}
+
+// getMaterializedViewStmt gets the create statement of an asynchronous materialized view.
+func getMaterializedViewStmt(ctx context.Context, conn *sql.Conn, dbName, mvName string) (string, error) {
+	query := fmt.Sprintf("SHOW CREATE MATERIALIZED VIEW `%s`.`%s`;", dbName, mvName)
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return "", err
+	}
+	if len(columns) < 2 {
+		return "", errors.Errorf("unexpected columns %v of query %q", columns, query)
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return "", util.FormatErrorWithQuery(err, query)
+		}
+		return "", common.FormatDBErrorEmptyRowWithQuery(query)
+	}
+	// The first two columns are the name and the create statement.
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return "", err
+	}
+	stmt := strings.TrimSuffix(strings.TrimSpace(values[1].String), ";")
+	return fmt.Sprintf(materializedViewStmtFmt, mvName, stmt), nil
+}
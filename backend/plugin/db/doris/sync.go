@@ -0,0 +1,379 @@
+package doris
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var (
+	systemDatabases = map[string]bool{
+		"information_schema": true,
+		"__internal_schema":  true,
+		"mysql":              true,
+	}
+	systemDatabaseClause = func() string {
+		var l []string
+		for k := range systemDatabases {
+			l = append(l, fmt.Sprintf("'%s'", k))
+		}
+		return strings.Join(l, ", ")
+	}()
+)
+
+// SyncInstance syncs the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	version, err := driver.getVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lowerCaseTableNames := 0
+	lowerCaseTableNamesText, err := driver.getServerVariable(ctx, "lower_case_table_names")
+	if err != nil {
+		slog.Debug("failed to get lower_case_table_names variable", log.BBError(err))
+	} else {
+		lowerCaseTableNames, err = strconv.Atoi(lowerCaseTableNamesText)
+		if err != nil {
+			slog.Debug("failed to parse lower_case_table_names variable", log.BBError(err))
+		}
+	}
+
+	// Doris reports the MySQL compatible character set and collation with the trailing NUL characters,
+	// which are meaningless for Doris, so we don't sync them.
+	query := fmt.Sprintf(`
+		SELECT
+			SCHEMA_NAME
+		FROM information_schema.SCHEMATA
+		WHERE LOWER(SCHEMA_NAME) NOT IN (%s)`, systemDatabaseClause)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var databases []*storepb.DatabaseSchemaMetadata
+	for rows.Next() {
+		database := &storepb.DatabaseSchemaMetadata{}
+		if err := rows.Scan(&database.Name); err != nil {
+			return nil, err
+		}
+		databases = append(databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	return &db.InstanceMetadata{
+		Version:   version,
+		Databases: databases,
+		Metadata: &storepb.InstanceMetadata{
+			MysqlLowerCaseTableNames: int32(lowerCaseTableNames),
+		},
+	}, nil
+}
+
+func (driver *Driver) getServerVariable(ctx context.Context, varName string) (string, error) {
+	query := fmt.Sprintf("SHOW VARIABLES LIKE '%s'", varName)
+	var varNameFound, value string
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&varNameFound, &value); err != nil {
+		if err == sql.ErrNoRows {
+			return "", common.FormatDBErrorEmptyRowWithQuery(query)
+		}
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	if varName != varNameFound {
+		return "", errors.Errorf("expecting variable %s, but got %s", varName, varNameFound)
+	}
+	return value, nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	var count int
+	databaseQuery := fmt.Sprintf("SELECT COUNT(*) FROM information_schema.SCHEMATA WHERE SCHEMA_NAME = '%s'", driver.databaseName)
+	if err := driver.db.QueryRowContext(ctx, databaseQuery).Scan(&count); err != nil {
+		return nil, util.FormatErrorWithQuery(err, databaseQuery)
+	}
+	if count == 0 {
+		return nil, common.Errorf(common.NotFound, "database %q not found", driver.databaseName)
+	}
+
+	columnMap, err := driver.getColumns(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get columns")
+	}
+	viewMap, err := driver.getViews(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views")
+	}
+	materializedViews, err := driver.getMaterializedViews(ctx)
+	if err != nil {
+		// The asynchronous materialized view is introduced in Doris 2.1.
+		slog.Debug("failed to get materialized views", slog.String("database", driver.databaseName), log.BBError(err))
+	}
+	materializedViewNames := make(map[string]bool)
+	for _, mv := range materializedViews {
+		materializedViewNames[mv.Name] = true
+	}
+	dynamicPartitionMap, err := driver.getDynamicPartitions(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get dynamic partitions")
+	}
+
+	schemaMetadata := &storepb.SchemaMetadata{
+		Name:              "",
+		MaterializedViews: materializedViews,
+	}
+	tableQuery := fmt.Sprintf(`
+		SELECT
+			TABLE_NAME,
+			TABLE_TYPE,
+			IFNULL(ENGINE, ''),
+			IFNULL(TABLE_ROWS, 0),
+			IFNULL(DATA_LENGTH, 0),
+			IFNULL(TABLE_COMMENT, '')
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = '%s'
+		ORDER BY TABLE_NAME`, driver.databaseName)
+	tableRows, err := driver.db.QueryContext(ctx, tableQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, tableQuery)
+	}
+	defer tableRows.Close()
+	for tableRows.Next() {
+		var tableName, tableType, engine, comment string
+		var rowCount, dataSize int64
+		if err := tableRows.Scan(
+			&tableName,
+			&tableType,
+			&engine,
+			&rowCount,
+			&dataSize,
+			&comment,
+		); err != nil {
+			return nil, err
+		}
+
+		switch tableType {
+		case baseTableType:
+			// The asynchronous materialized views are listed as tables as well.
+			if materializedViewNames[tableName] {
+				continue
+			}
+			schemaMetadata.Tables = append(schemaMetadata.Tables, &storepb.TableMetadata{
+				Name:             tableName,
+				Columns:          columnMap[tableName],
+				Engine:           engine,
+				RowCount:         rowCount,
+				DataSize:         dataSize,
+				Comment:          comment,
+				DynamicPartition: dynamicPartitionMap[tableName],
+			})
+		case viewTableType:
+			if view, ok := viewMap[tableName]; ok {
+				view.Comment = comment
+				schemaMetadata.Views = append(schemaMetadata.Views, view)
+			}
+		}
+	}
+	if err := tableRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, tableQuery)
+	}
+
+	return &storepb.DatabaseSchemaMetadata{
+		Name:    driver.databaseName,
+		Schemas: []*storepb.SchemaMetadata{schemaMetadata},
+	}, nil
+}
+
+// getColumns gets the columns of the database keyed by the table name.
+func (driver *Driver) getColumns(ctx context.Context) (map[string][]*storepb.ColumnMetadata, error) {
+	columnMap := make(map[string][]*storepb.ColumnMetadata)
+	query := fmt.Sprintf(`
+		SELECT
+			TABLE_NAME,
+			IFNULL(COLUMN_NAME, ''),
+			ORDINAL_POSITION,
+			COLUMN_DEFAULT,
+			IS_NULLABLE,
+			COLUMN_TYPE,
+			IFNULL(COLUMN_COMMENT, '')
+		FROM information_schema.COLUMNS
+		WHERE TABLE_SCHEMA = '%s'
+		ORDER BY TABLE_NAME, ORDINAL_POSITION`, driver.databaseName)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		column := &storepb.ColumnMetadata{}
+		var tableName, nullable string
+		var defaultStr sql.NullString
+		if err := rows.Scan(
+			&tableName,
+			&column.Name,
+			&column.Position,
+			&defaultStr,
+			&nullable,
+			&column.Type,
+			&column.Comment,
+		); err != nil {
+			return nil, err
+		}
+		if defaultStr.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_Default{Default: &wrapperspb.StringValue{Value: defaultStr.String}}
+		}
+		isNullBool, err := util.ConvertYesNo(nullable)
+		if err != nil {
+			return nil, err
+		}
+		column.Nullable = isNullBool
+		columnMap[tableName] = append(columnMap[tableName], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return columnMap, nil
+}
+
+// getViews gets the views of the database keyed by the view name.
+func (driver *Driver) getViews(ctx context.Context) (map[string]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string]*storepb.ViewMetadata)
+	query := fmt.Sprintf(`
+		SELECT
+			TABLE_NAME,
+			VIEW_DEFINITION
+		FROM information_schema.VIEWS
+		WHERE TABLE_SCHEMA = '%s'`, driver.databaseName)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		view := &storepb.ViewMetadata{}
+		if err := rows.Scan(&view.Name, &view.Definition); err != nil {
+			return nil, err
+		}
+		viewMap[view.Name] = view
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return viewMap, nil
+}
+
+// getMaterializedViews gets the asynchronous materialized views of the database.
+// The synchronous materialized views are rollups of a single table, so they are part of the table definition.
+func (driver *Driver) getMaterializedViews(ctx context.Context) ([]*storepb.MaterializedViewMetadata, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			Name,
+			QuerySql
+		FROM mv_infos('database' = '%s')
+		ORDER BY Name`, driver.databaseName)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var materializedViews []*storepb.MaterializedViewMetadata
+	for rows.Next() {
+		mv := &storepb.MaterializedViewMetadata{}
+		if err := rows.Scan(&mv.Name, &mv.Definition); err != nil {
+			return nil, err
+		}
+		materializedViews = append(materializedViews, mv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return materializedViews, nil
+}
+
+// getDynamicPartitions gets the dynamic partition properties of the database keyed by the table name.
+func (driver *Driver) getDynamicPartitions(ctx context.Context) (map[string]*storepb.DynamicPartitionMetadata, error) {
+	query := fmt.Sprintf("SHOW DYNAMIC PARTITION TABLES FROM `%s`", driver.databaseName)
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	// The columns vary among Doris versions, so we read them by name.
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	dynamicPartitionMap := make(map[string]*storepb.DynamicPartitionMetadata)
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		properties := make(map[string]string)
+		for i, column := range columns {
+			properties[column] = values[i].String
+		}
+		tableName, dynamicPartition := parseDynamicPartition(properties)
+		if tableName == "" {
+			continue
+		}
+		dynamicPartitionMap[tableName] = dynamicPartition
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return dynamicPartitionMap, nil
+}
+
+// parseDynamicPartition parses a row of SHOW DYNAMIC PARTITION TABLES keyed by the column name,
+// and returns the table name and the dynamic partition properties.
+func parseDynamicPartition(properties map[string]string) (string, *storepb.DynamicPartitionMetadata) {
+	parseInt32 := func(s string, defaultValue int32) int32 {
+		v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+		if err != nil {
+			return defaultValue
+		}
+		return int32(v)
+	}
+	return properties["TableName"], &storepb.DynamicPartitionMetadata{
+		Enabled:  strings.EqualFold(properties["Enable"], "true"),
+		TimeUnit: properties["TimeUnit"],
+		Start:    parseInt32(properties["Start"], math.MinInt32),
+		End:      parseInt32(properties["End"], 0),
+		Prefix:   properties["Prefix"],
+		Buckets:  parseInt32(properties["Buckets"], 0),
+	}
+}
+
+// SyncSlowQuery syncs slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks whether the slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
@@ -185,8 +185,13 @@ type ConnectionConfig struct {
 	TLSConfig          TLSConfig
 	// Only used for Hive.
 	SASLConfig SASLConfig
-	// ReadOnly is only supported for Postgres at the moment.
+	// ReadOnly is only supported for Postgres and SQL Server at the moment.
 	ReadOnly bool
+	// PreferSimpleProtocol is Postgres only, it disables the implicit prepared statements,
+	// so that the connection works through the poolers in the transaction mode, e.g. PgBouncer.
+	PreferSimpleProtocol bool
+	// TargetSessionAttrs is Postgres only, e.g. read-write only connects to the primary of the hosts.
+	TargetSessionAttrs string
 	// SRV is only supported for MongoDB now.
 	SRV bool
 	// AuthenticationDatabase is only supported for MongoDB now.
@@ -194,7 +199,9 @@ type ConnectionConfig struct {
 	// SID and ServiceName are Oracle only.
 	SID         string
 	ServiceName string
-	SSHConfig   SSHConfig
+	// OraclePDBMode is Oracle only, the databases are the pluggable databases rather than the schemas.
+	OraclePDBMode bool
+	SSHConfig     SSHConfig
 	// AuthenticationPrivateKey is used by Snowflake and Databricks (databricks access token).
 	AuthenticationPrivateKey string
 
@@ -210,6 +217,8 @@ type ConnectionConfig struct {
 
 	// Region is the location of where the DB is, works for AWS RDS.
 	Region string
+	// AWSRoleARN is the IAM role assumed for AWS RDS IAM authentication.
+	AWSRoleARN string
 
 	// AccountID is used by Databricks.
 	AccountID string
@@ -224,6 +233,17 @@ type ConnectionConfig struct {
 
 	// The maximum number of bytes for sql results in response body.
 	MaximumSQLResultSize int64
+
+	// ConnectionPool is applied to drivers backed by database/sql.
+	ConnectionPool ConnectionPoolConfig
+}
+
+// ConnectionPoolConfig is the configuration for the connection pool of a driver.
+// The zero value keeps the driver defaults.
+type ConnectionPoolConfig struct {
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
 }
 
 // SSHConfig is the configuration for connection over SSH.
@@ -340,21 +360,64 @@ func Open(ctx context.Context, dbType storepb.Engine, driverConfig DriverConfig,
 	if err != nil {
 		return nil, err
 	}
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		applyConnectionPoolConfig(sqlDB, connectionConfig.ConnectionPool)
+	}
 
 	return driver, nil
 }
 
+func applyConnectionPoolConfig(sqlDB *sql.DB, config ConnectionPoolConfig) {
+	if config.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	if config.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+	}
+	if config.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
+}
+
 // ExecuteOptions is the options for execute.
 type ExecuteOptions struct {
 	CreateDatabase        bool
 	UpdateExecutionStatus func(*v1pb.TaskRun_ExecutionDetail)
 	CreateTaskRunLog      func(time.Time, *storepb.TaskRunLog) error
+	// TransactionMode is how the statements are run in transactions, the unspecified mode keeps the default of the driver.
+	TransactionMode storepb.TransactionMode
+	// SessionVariables are set on the session before the statements run.
+	SessionVariables map[string]string
+	// CommittedCommandIndexes are the indexes of the commands committed by the previous executions of the task run,
+	// the driver skips them to resume the execution from the next command.
+	CommittedCommandIndexes map[int32]bool
+	// RecordCommandsCommitted durably records the commands once they are committed in the database.
+	RecordCommandsCommitted func(commandIndexes []int32) error
 
 	// Record the connection id first before executing.
 	SetConnectionID    func(id string)
 	DeleteConnectionID func()
 }
 
+// IsCommandCommitted returns whether the command is committed by the previous executions of the task run.
+func (o *ExecuteOptions) IsCommandCommitted(commandIndex int32) bool {
+	if o == nil {
+		return false
+	}
+	return o.CommittedCommandIndexes[commandIndex]
+}
+
+// RecordCommitted records the commands which are committed in the database.
+func (o *ExecuteOptions) RecordCommitted(commandIndexes []int32) error {
+	if o == nil || o.RecordCommandsCommitted == nil || len(commandIndexes) == 0 {
+		return nil
+	}
+	if err := o.RecordCommandsCommitted(commandIndexes); err != nil {
+		return errors.Wrapf(err, "failed to record the committed commands %v", commandIndexes)
+	}
+	return nil
+}
+
 func (o *ExecuteOptions) LogSchemaDumpStart() {
 	if o == nil || o.CreateTaskRunLog == nil {
 		return
@@ -0,0 +1,233 @@
+// Package duckdb is the plugin for DuckDB and MotherDuck driver.
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	// Import DuckDB driver.
+	_ "github.com/marcboeker/go-duckdb"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+var (
+	_ db.Driver = (*Driver)(nil)
+)
+
+const (
+	// motherDuckPrefix is the host prefix of the MotherDuck instances, such as "md:".
+	motherDuckPrefix      = "md:"
+	databaseFileExtension = ".duckdb"
+)
+
+func init() {
+	db.Register(storepb.Engine_DUCKDB, newDriver)
+}
+
+// Driver is the DuckDB driver.
+type Driver struct {
+	// dir is the directory containing all the DuckDB database files, it's empty for MotherDuck.
+	dir string
+	// motherDuckToken is the MotherDuck service token, it's empty for the local DuckDB.
+	motherDuckToken      string
+	motherDuck           bool
+	db                   *sql.DB
+	connectionCtx        db.ConnectionContext
+	databaseName         string
+	maximumSQLResultSize int64
+}
+
+func newDriver(db.DriverConfig) db.Driver {
+	return &Driver{}
+}
+
+// Open opens a DuckDB driver.
+func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+	// Host is either the directory (instance) containing all DuckDB database files, or "md:" for MotherDuck.
+	if strings.HasPrefix(config.Host, motherDuckPrefix) {
+		driver.motherDuck = true
+		driver.motherDuckToken = config.Password
+	} else {
+		driver.dir = config.Host
+	}
+
+	// If config.Database is empty, we will get a connection to in-memory database for DuckDB,
+	// or a connection to the default database for MotherDuck.
+	db, err := driver.createDBConnection(config.Database)
+	if err != nil {
+		return nil, err
+	}
+	driver.db = db
+	driver.connectionCtx = config.ConnectionContext
+	driver.databaseName = config.Database
+	driver.maximumSQLResultSize = config.MaximumSQLResultSize
+	return driver, nil
+}
+
+// Close closes the driver.
+func (driver *Driver) Close(context.Context) error {
+	if driver.db != nil {
+		return driver.db.Close()
+	}
+	return nil
+}
+
+// Ping pings the database.
+func (driver *Driver) Ping(ctx context.Context) error {
+	return driver.db.PingContext(ctx)
+}
+
+// GetDB gets the database.
+func (driver *Driver) GetDB() *sql.DB {
+	return driver.db
+}
+
+// createDBConnection gets a database connection.
+func (driver *Driver) createDBConnection(database string) (*sql.DB, error) {
+	var dsn string
+	switch {
+	case driver.motherDuck:
+		dsn = motherDuckPrefix + database
+		if driver.motherDuckToken != "" {
+			dsn = fmt.Sprintf("%s?motherduck_token=%s", dsn, url.QueryEscape(driver.motherDuckToken))
+		}
+	case database == "":
+		dsn = ""
+	default:
+		dsn = path.Join(driver.dir, database+databaseFileExtension)
+	}
+	db, err := sql.Open("duckdb", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+func (driver *Driver) getDatabases(ctx context.Context) ([]string, error) {
+	if driver.motherDuck {
+		query := `
+			SELECT
+				database_name
+			FROM duckdb_databases()
+			WHERE NOT internal AND database_name NOT IN ('memory', 'temp', 'system')
+			ORDER BY database_name;`
+		rows, err := driver.db.QueryContext(ctx, query)
+		if err != nil {
+			return nil, util.FormatErrorWithQuery(err, query)
+		}
+		defer rows.Close()
+		var databases []string
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				return nil, err
+			}
+			databases = append(databases, name)
+		}
+		if err := rows.Err(); err != nil {
+			return nil, util.FormatErrorWithQuery(err, query)
+		}
+		return databases, nil
+	}
+
+	files, err := os.ReadDir(driver.dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read directory %q", driver.dir)
+	}
+	var databases []string
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), databaseFileExtension) {
+			continue
+		}
+		databases = append(databases, strings.TrimSuffix(file.Name(), databaseFileExtension))
+	}
+	return databases, nil
+}
+
+// Execute executes a SQL statement.
+func (driver *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
+	if opts.CreateDatabase {
+		parts := strings.Split(statement, `'`)
+		if len(parts) != 3 {
+			return 0, errors.Errorf("invalid statement %q", statement)
+		}
+		if driver.motherDuck {
+			if _, err := driver.db.ExecContext(ctx, fmt.Sprintf(`CREATE DATABASE "%s";`, parts[1])); err != nil {
+				return 0, err
+			}
+			return 0, nil
+		}
+		db, err := driver.createDBConnection(parts[1])
+		if err != nil {
+			return 0, err
+		}
+		defer db.Close()
+		// We need to query to persist the database file.
+		if _, err := db.ExecContext(ctx, "SELECT 1;"); err != nil {
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	tx, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	sqlResult, err := tx.ExecContext(ctx, statement)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	rowsAffected, err := sqlResult.RowsAffected()
+	if err != nil {
+		// Since we cannot differentiate DDL and DML yet, we have to ignore the error.
+		slog.Debug("rowsAffected returns error", log.BBError(err))
+		return 0, nil
+	}
+
+	return rowsAffected, nil
+}
+
+// QueryConn queries a SQL statement in a given connection.
+func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, _ *db.QueryContext) ([]*v1pb.QueryResult, error) {
+	startTime := time.Now()
+	rows, err := conn.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, statement)
+	}
+	defer rows.Close()
+
+	result, err := util.RowsToQueryResult(rows, driver.maximumSQLResultSize)
+	if err != nil {
+		// nolint
+		return []*v1pb.QueryResult{
+			{
+				Error: err.Error(),
+			},
+		}, nil
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	result.Latency = durationpb.New(time.Since(startTime))
+	result.Statement = statement
+	return []*v1pb.QueryResult{result}, nil
+}
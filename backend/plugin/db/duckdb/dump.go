@@ -0,0 +1,99 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// Dump dumps the database.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer) (string, error) {
+	if driver.databaseName == "" {
+		return "", errors.Errorf("DuckDB can dump one database only at a time")
+	}
+
+	// Find all dumpable databases and make sure the existence of the database to be dumped.
+	databases, err := driver.getDatabases(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to get databases")
+	}
+	exist := false
+	for _, n := range databases {
+		if n == driver.databaseName {
+			exist = true
+			break
+		}
+	}
+	if !exist {
+		return "", errors.Errorf("database %s not found", driver.databaseName)
+	}
+
+	if err := driver.dumpOneDatabase(ctx, out); err != nil {
+		return "", err
+	}
+
+	return "", nil
+}
+
+func (driver *Driver) dumpOneDatabase(ctx context.Context, out io.Writer) error {
+	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer txn.Rollback()
+
+	// The objects are dumped in the dependency order, the schemas first and the indexes last.
+	queries := []string{
+		`SELECT format('CREATE SCHEMA "{}";', schema_name) FROM duckdb_schemas()
+			WHERE database_name = ? AND NOT internal AND schema_name <> 'main' ORDER BY schema_name;`,
+		`SELECT sql FROM duckdb_sequences()
+			WHERE database_name = ? AND NOT temporary ORDER BY schema_name, sequence_name;`,
+		`SELECT sql FROM duckdb_tables()
+			WHERE database_name = ? AND NOT internal AND NOT temporary ORDER BY schema_name, table_name;`,
+		`SELECT sql FROM duckdb_views()
+			WHERE database_name = ? AND NOT internal AND NOT temporary ORDER BY schema_name, view_name;`,
+		`SELECT sql FROM duckdb_indexes()
+			WHERE database_name = ? AND sql IS NOT NULL ORDER BY schema_name, table_name, index_name;`,
+	}
+	for _, query := range queries {
+		statements, err := queryStatements(ctx, txn, query, driver.databaseName)
+		if err != nil {
+			return err
+		}
+		for _, statement := range statements {
+			if _, err := io.WriteString(out, fmt.Sprintf("%s;\n", statement)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return txn.Commit()
+}
+
+// queryStatements returns the DDL statements without the trailing semicolons.
+func queryStatements(ctx context.Context, txn *sql.Tx, query string, databaseName string) ([]string, error) {
+	rows, err := txn.QueryContext(ctx, query, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var statements []string
+	for rows.Next() {
+		var statement string
+		if err := rows.Scan(&statement); err != nil {
+			return nil, err
+		}
+		statements = append(statements, strings.TrimSuffix(strings.TrimSpace(statement), ";"))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return statements, nil
+}
@@ -0,0 +1,34 @@
+package duckdb
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+// CreateRole creates the role.
+func (*Driver) CreateRole(_ context.Context, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	return nil, errors.Errorf("create role for DuckDB is not implemented yet")
+}
+
+// UpdateRole updates the role.
+func (*Driver) UpdateRole(_ context.Context, _ string, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	return nil, errors.Errorf("update role for DuckDB is not implemented yet")
+}
+
+// FindRole finds the role by name.
+func (*Driver) FindRole(_ context.Context, _ string) (*db.DatabaseRoleMessage, error) {
+	return nil, errors.Errorf("find role for DuckDB is not implemented yet")
+}
+
+// ListRole lists the role.
+func (*Driver) ListRole(_ context.Context) ([]*db.DatabaseRoleMessage, error) {
+	return nil, errors.Errorf("list role for DuckDB is not implemented yet")
+}
+
+// DeleteRole deletes the role by name.
+func (*Driver) DeleteRole(_ context.Context, _ string) error {
+	return errors.Errorf("delete role for DuckDB is not implemented yet")
+}
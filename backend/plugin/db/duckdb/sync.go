@@ -0,0 +1,350 @@
+package duckdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// SyncInstance syncs the instance.
+func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
+	version, err := driver.getVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseNames, err := driver.getDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var databases []*storepb.DatabaseSchemaMetadata
+	for _, databaseName := range databaseNames {
+		databases = append(databases, &storepb.DatabaseSchemaMetadata{Name: databaseName})
+	}
+
+	return &db.InstanceMetadata{
+		Version:   version,
+		Databases: databases,
+	}, nil
+}
+
+// getVersion gets the version.
+func (driver *Driver) getVersion(ctx context.Context) (string, error) {
+	var version string
+	if err := driver.db.QueryRowContext(ctx, "SELECT version();").Scan(&version); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// SyncDBSchema syncs a single database schema.
+func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	databases, err := driver.getDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, database := range databases {
+		if database == driver.databaseName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, common.Errorf(common.NotFound, "database %q not found", driver.databaseName)
+	}
+
+	txn, err := driver.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	schemaNames, err := getSchemas(txn, driver.databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get schemas")
+	}
+	tableMap, err := getTables(txn, driver.databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get tables")
+	}
+	viewMap, err := getViews(txn, driver.databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get views")
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	databaseMetadata := &storepb.DatabaseSchemaMetadata{
+		Name: driver.databaseName,
+	}
+	for _, schemaName := range schemaNames {
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:   schemaName,
+			Tables: tableMap[schemaName],
+			Views:  viewMap[schemaName],
+		})
+	}
+	return databaseMetadata, nil
+}
+
+// getSchemas gets all schemas of a database.
+func getSchemas(txn *sql.Tx, databaseName string) ([]string, error) {
+	query := `
+		SELECT
+			schema_name
+		FROM duckdb_schemas()
+		WHERE database_name = ? AND NOT internal
+		ORDER BY schema_name;`
+	rows, err := txn.Query(query, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var schemas []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return schemas, nil
+}
+
+// getTables gets all tables of a database keyed by the schema name.
+func getTables(txn *sql.Tx, databaseName string) (map[string][]*storepb.TableMetadata, error) {
+	columnMap, err := getColumns(txn, databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get columns")
+	}
+	indexMap, err := getIndexes(txn, databaseName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get indexes")
+	}
+
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	query := `
+		SELECT
+			schema_name,
+			table_name,
+			estimated_size,
+			COALESCE(comment, '')
+		FROM duckdb_tables()
+		WHERE database_name = ? AND NOT internal AND NOT temporary
+		ORDER BY schema_name, table_name;`
+	rows, err := txn.Query(query, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName string
+		table := &storepb.TableMetadata{}
+		if err := rows.Scan(&schemaName, &table.Name, &table.RowCount, &table.Comment); err != nil {
+			return nil, err
+		}
+		key := db.TableKey{Schema: schemaName, Table: table.Name}
+		table.Columns = columnMap[key]
+		table.Indexes = indexMap[key]
+		tableMap[schemaName] = append(tableMap[schemaName], table)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return tableMap, nil
+}
+
+// getColumns gets all columns of a database keyed by the table.
+func getColumns(txn *sql.Tx, databaseName string) (map[db.TableKey][]*storepb.ColumnMetadata, error) {
+	columnMap := make(map[db.TableKey][]*storepb.ColumnMetadata)
+	query := `
+		SELECT
+			schema_name,
+			table_name,
+			column_name,
+			column_index,
+			data_type,
+			is_nullable,
+			column_default,
+			COALESCE(comment, '')
+		FROM duckdb_columns()
+		WHERE database_name = ? AND NOT internal
+		ORDER BY schema_name, table_name, column_index;`
+	rows, err := txn.Query(query, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, tableName string
+		var defaultStr sql.NullString
+		column := &storepb.ColumnMetadata{}
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Position, &column.Type, &column.Nullable, &defaultStr, &column.Comment); err != nil {
+			return nil, err
+		}
+		if defaultStr.Valid {
+			column.DefaultValue = &storepb.ColumnMetadata_DefaultExpression{DefaultExpression: defaultStr.String}
+		}
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		columnMap[key] = append(columnMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return columnMap, nil
+}
+
+// getIndexes gets all indexes including the primary keys of a database keyed by the table.
+func getIndexes(txn *sql.Tx, databaseName string) (map[db.TableKey][]*storepb.IndexMetadata, error) {
+	indexMap := make(map[db.TableKey][]*storepb.IndexMetadata)
+
+	// The primary keys are constraints rather than indexes in duckdb_indexes().
+	pkQuery := `
+		SELECT
+			schema_name,
+			table_name,
+			to_json(constraint_column_names)
+		FROM duckdb_constraints()
+		WHERE database_name = ? AND constraint_type = 'PRIMARY KEY'
+		ORDER BY schema_name, table_name;`
+	pkRows, err := txn.Query(pkQuery, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, pkQuery)
+	}
+	defer pkRows.Close()
+	for pkRows.Next() {
+		var schemaName, tableName, columns string
+		if err := pkRows.Scan(&schemaName, &tableName, &columns); err != nil {
+			return nil, err
+		}
+		index := &storepb.IndexMetadata{
+			Name:    "PRIMARY",
+			Primary: true,
+			Unique:  true,
+		}
+		if err := json.Unmarshal([]byte(columns), &index.Expressions); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal primary key columns %q", columns)
+		}
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := pkRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, pkQuery)
+	}
+
+	query := `
+		SELECT
+			schema_name,
+			table_name,
+			index_name,
+			is_unique,
+			COALESCE(expressions, ''),
+			COALESCE(sql, '')
+		FROM duckdb_indexes()
+		WHERE database_name = ?
+		ORDER BY schema_name, table_name, index_name;`
+	rows, err := txn.Query(query, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName, tableName, expressions string
+		index := &storepb.IndexMetadata{}
+		if err := rows.Scan(&schemaName, &tableName, &index.Name, &index.Unique, &expressions, &index.Definition); err != nil {
+			return nil, err
+		}
+		index.Expressions = parseIndexExpressions(expressions)
+		key := db.TableKey{Schema: schemaName, Table: tableName}
+		indexMap[key] = append(indexMap[key], index)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return indexMap, nil
+}
+
+// parseIndexExpressions parses the index expressions in the list format, such as "[a, lower(b)]".
+func parseIndexExpressions(expressions string) []string {
+	expressions = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(expressions), "["), "]")
+	var result []string
+	depth := 0
+	start := 0
+	for i, r := range expressions {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				if expression := strings.TrimSpace(expressions[start:i]); expression != "" {
+					result = append(result, expression)
+				}
+				start = i + 1
+			}
+		}
+	}
+	if expression := strings.TrimSpace(expressions[start:]); expression != "" {
+		result = append(result, expression)
+	}
+	return result
+}
+
+// getViews gets all views of a database keyed by the schema name.
+func getViews(txn *sql.Tx, databaseName string) (map[string][]*storepb.ViewMetadata, error) {
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+	query := `
+		SELECT
+			schema_name,
+			view_name,
+			sql,
+			COALESCE(comment, '')
+		FROM duckdb_views()
+		WHERE database_name = ? AND NOT internal AND NOT temporary
+		ORDER BY schema_name, view_name;`
+	rows, err := txn.Query(query, databaseName)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var schemaName string
+		view := &storepb.ViewMetadata{}
+		if err := rows.Scan(&schemaName, &view.Name, &view.Definition, &view.Comment); err != nil {
+			return nil, err
+		}
+		viewMap[schemaName] = append(viewMap[schemaName], view)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return viewMap, nil
+}
+
+// SyncSlowQuery syncs the slow query.
+func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	return nil, errors.Errorf("not implemented")
+}
+
+// CheckSlowQueryLogEnabled checks if slow query log is enabled.
+func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
+	return errors.Errorf("not implemented")
+}
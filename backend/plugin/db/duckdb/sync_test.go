@@ -0,0 +1,31 @@
+package duckdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIndexExpressions(t *testing.T) {
+	testCases := []struct {
+		expressions string
+		want        []string
+	}{
+		{
+			expressions: "[a]",
+			want:        []string{"a"},
+		},
+		{
+			expressions: "[a, lower(b), substr(c, 1, 2)]",
+			want:        []string{"a", "lower(b)", "substr(c, 1, 2)"},
+		},
+		{
+			expressions: "",
+			want:        nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, parseIndexExpressions(tc.expressions), tc.expressions)
+	}
+}
@@ -1,11 +1,78 @@
 package elasticsearch
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
-// Dump() is not applicable to Elasticsearch.
-func (*Driver) Dump(_ context.Context, _ io.Writer) (string, error) {
+// Dump dumps the lifecycle policies, index templates and index mappings as the PUT API calls.
+// The PUT APIs create or update the objects, so the dump could be applied repeatedly,
+// except that updating an OpenSearch ISM policy requires the sequence number of the existing policy.
+// The policies are dumped first since the templates and indices refer to them by the lifecycle settings.
+func (d *Driver) Dump(_ context.Context, out io.Writer) (string, error) {
+	versionResult, err := d.getVersionResult()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to fetch version from Elasticsearch server")
+	}
+	templates, policies := d.getTemplatesAndPolicies(versionResult.Version.Distribution)
+	mappings, err := d.getMappings()
+	if err != nil {
+		return "", err
+	}
+
+	policyRoute := "_ilm/policy"
+	if versionResult.Version.Distribution == openSearchDistribution {
+		policyRoute = "_plugins/_ism/policies"
+	}
+	for _, policy := range policies {
+		if err := writeStatement(out, fmt.Sprintf("%s/%s", policyRoute, policy.Name), policy.Definition); err != nil {
+			return "", err
+		}
+	}
+	for _, template := range templates {
+		if err := writeStatement(out, fmt.Sprintf("_index_template/%s", template.Name), template.Definition); err != nil {
+			return "", err
+		}
+	}
+
+	var indices []string
+	for index := range mappings {
+		// Skip the hidden and system indices.
+		if strings.HasPrefix(index, ".") {
+			continue
+		}
+		indices = append(indices, index)
+	}
+	slices.Sort(indices)
+	for _, index := range indices {
+		properties, ok := mappings[index]["properties"]
+		if !ok {
+			continue
+		}
+		body, err := json.Marshal(map[string]any{"properties": properties})
+		if err != nil {
+			return "", err
+		}
+		if err := writeStatement(out, fmt.Sprintf("%s/_mapping", index), string(body)); err != nil {
+			return "", err
+		}
+	}
 	return "", nil
 }
+
+// writeStatement writes the PUT statement with the indented JSON body.
+func writeStatement(out io.Writer, route string, body string) error {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(body), "", "  "); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "PUT %s\n%s\n\n", route, buf.String())
+	return err
+}
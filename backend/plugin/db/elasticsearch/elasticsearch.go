@@ -50,6 +50,10 @@ type BasicAuthClient struct {
 
 func (client *BasicAuthClient) Do(method string, route []byte, queryString []byte) (*http.Response, error) {
 	address := client.addrScheduler.GetNewAddress()
+	// The route could be written without the leading slash, such as "GET _cat/indices".
+	if !bytes.HasPrefix(route, []byte("/")) {
+		route = append([]byte("/"), route...)
+	}
 	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", address, string(route)), bytes.NewReader(queryString))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to init a HTTP request")
@@ -155,14 +159,36 @@ func (d *Driver) Ping(ctx context.Context) error {
 	return nil
 }
 
-func (d *Driver) Execute(ctx context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
-	_, err := d.QueryConn(ctx, nil, statement, nil)
+// Execute executes the statements one by one, and fails on the first request with an error response.
+func (d *Driver) Execute(_ context.Context, statement string, _ db.ExecuteOptions) (int64, error) {
+	statements, err := SplitElasticsearchStatements(statement)
 	if err != nil {
 		return 0, err
 	}
+	for _, s := range statements {
+		if _, err := d.do(s.method, string(s.route), s.queryString); err != nil {
+			return 0, err
+		}
+	}
 	return 0, nil
 }
 
+// do sends the request and returns the response body, the error responses are converted to errors.
+func (d *Driver) do(method, route string, body []byte) ([]byte, error) {
+	resp, err := d.basicAuthClient.Do(method, []byte(route), body)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to send HTTP request %s %s", method, route)
+	}
+	respBytes, err := readBytesAndClose(resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, errors.Errorf("failed to execute %s %s, status: %s, response: %s", method, route, resp.Status, string(respBytes))
+	}
+	return respBytes, nil
+}
+
 func (d *Driver) QueryConn(_ context.Context, _ *sql.Conn, statement string, _ *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	statements, err := SplitElasticsearchStatements(statement)
 	if err != nil {
@@ -176,7 +202,7 @@ func (d *Driver) QueryConn(_ context.Context, _ *sql.Conn, statement string, _ *
 			// send HTTP request.
 			resp, err := d.basicAuthClient.Do(s.method, s.route, s.queryString)
 			if err != nil {
-				return errors.Wrapf(err, "failed to send HTTP request, status code message: %s", resp.Status)
+				return errors.Wrapf(err, "failed to send HTTP request")
 			}
 			defer resp.Body.Close()
 
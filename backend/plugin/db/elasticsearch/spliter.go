@@ -10,6 +10,9 @@ func SplitElasticsearchStatements(statementsStr string) ([]*Statement, error) {
 
 	for idx, c := range statementsStr {
 		statement := sm.transfer(c)
+		if c == '\n' {
+			sm.line++
+		}
 		if sm.state == StatusError {
 			return nil, errors.New("failed to parse statements")
 		}
@@ -19,6 +22,7 @@ func SplitElasticsearchStatements(statementsStr string) ([]*Statement, error) {
 			tmpStatement := &Statement{
 				method: sm.statement.method,
 				route:  sm.statement.route,
+				line:   sm.statement.line,
 			}
 			if sm.statement.queryString != nil && sm.numLeftBrace == 0 {
 				tmpStatement.queryString = sm.statement.queryString
@@ -48,6 +52,28 @@ type Statement struct {
 	method      string
 	route       []byte
 	queryString []byte
+	// line is the 0-based line of the method.
+	line int
+}
+
+// Method returns the HTTP method of the statement.
+func (s *Statement) Method() string {
+	return s.method
+}
+
+// Route returns the route of the statement.
+func (s *Statement) Route() string {
+	return string(s.route)
+}
+
+// QueryString returns the request body of the statement.
+func (s *Statement) QueryString() string {
+	return string(s.queryString)
+}
+
+// Line returns the 0-based line of the statement.
+func (s *Statement) Line() int {
+	return s.line
 }
 
 func (s *Statement) Clear() {
@@ -60,6 +86,7 @@ type StateMachine struct {
 	state        int
 	statement    Statement
 	numLeftBrace int
+	line         int
 }
 
 func (sm *StateMachine) transfer(c rune) *Statement {
@@ -68,6 +95,7 @@ func (sm *StateMachine) transfer(c rune) *Statement {
 		if isASCIIAlpha(c) {
 			sm.state = StatusMethod
 			sm.statement.method += string(c)
+			sm.statement.line = sm.line
 		}
 
 	case StatusMethod:
@@ -98,11 +126,13 @@ func (sm *StateMachine) transfer(c rune) *Statement {
 				method:      sm.statement.method,
 				route:       sm.statement.route,
 				queryString: sm.statement.queryString,
+				line:        sm.statement.line,
 			}
 
 			sm.state = StatusInit
 			sm.statement.Clear()
 			sm.statement.method += string(c)
+			sm.statement.line = sm.line
 			return statement
 		}
 		sm.statement.queryString = append(sm.statement.queryString, string(c)...)
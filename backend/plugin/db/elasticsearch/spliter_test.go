@@ -29,6 +29,7 @@ GET _cat/indices`,
 { "index" : { "_index" : "books" } }
 {"name": "1984", "author": "天舟", "page_count": 328}
 `),
+			line: 0,
 		}, {
 			method: "GET",
 			route:  []byte(`books/_search`),
@@ -38,10 +39,12 @@ GET _cat/indices`,
 	}
 }
 `),
+			line: 5,
 		}, {
 			method:      "GET",
 			route:       []byte(`_cat/indices`),
 			queryString: []byte{},
+			line:        11,
 		}},
 	}
 
@@ -54,7 +57,8 @@ GET _cat/indices`,
 		expectedStatement := test.expected[index]
 		if expectedStatement.method != statement.method ||
 			string(expectedStatement.route) != string(statement.route) ||
-			string(statement.queryString) != string(expectedStatement.queryString) {
+			string(statement.queryString) != string(expectedStatement.queryString) ||
+			expectedStatement.line != statement.line {
 			t.Fail()
 		}
 	}
@@ -47,18 +47,33 @@ func (d *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error)
 }
 
 // SyncDBSchema implements db.Driver.
+// The indices are synced as tables with the mapping fields as columns,
+// and the index templates and lifecycle policies are synced as the database level objects.
 func (d *Driver) SyncDBSchema(_ context.Context) (*storepb.DatabaseSchemaMetadata, error) {
 	var dbSchemaMetadata storepb.DatabaseSchemaMetadata
 
+	versionResult, err := d.getVersionResult()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch version from Elasticsearch server")
+	}
+
 	// indices.
 	indices, err := d.getIndices()
 	if err != nil {
 		return nil, err
 	}
+	mappings, err := d.getMappings()
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		index.Columns = ConvertMappingsToColumns(mappings[index.Name])
+	}
 
 	// TODO(tommy): database name?
 	dbSchemaMetadata.Name = "node"
 	dbSchemaMetadata.Schemas = append(dbSchemaMetadata.Schemas, &storepb.SchemaMetadata{Tables: indices})
+	dbSchemaMetadata.IndexTemplates, dbSchemaMetadata.LifecyclePolicies = d.getTemplatesAndPolicies(versionResult.Version.Distribution)
 
 	return &dbSchemaMetadata, nil
 }
@@ -75,31 +90,41 @@ func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
 type VersionResult struct {
 	Version struct {
 		Number string `json:"number"`
+		// Distribution is "opensearch" for OpenSearch, and empty for Elasticsearch.
+		Distribution string `json:"distribution"`
 	} `json:"version"`
 }
 
 func (d *Driver) getVerison() (string, error) {
-	resp, err := d.basicAuthClient.Do("GET", []byte("/"), nil)
+	result, err := d.getVersionResult()
 	if err != nil {
 		return "", err
 	}
+	return result.Version.Number, nil
+}
+
+func (d *Driver) getVersionResult() (*VersionResult, error) {
+	resp, err := d.basicAuthClient.Do("GET", []byte("/"), nil)
+	if err != nil {
+		return nil, err
+	}
 	defer resp.Body.Close()
 
 	bytes, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	if err := resp.Body.Close(); err != nil {
-		return "", err
+		return nil, err
 	}
 
 	var result VersionResult
 	err = json.Unmarshal(bytes, &result)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return result.Version.Number, nil
+	return &result, nil
 }
 
 type IndicesResult struct {
@@ -0,0 +1,251 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// openSearchDistribution is the version distribution reported by OpenSearch.
+	openSearchDistribution = "opensearch"
+)
+
+// getMappings gets the mappings of the indices keyed by the index name.
+func (d *Driver) getMappings() (map[string]map[string]any, error) {
+	bytes, err := d.do("GET", "/_mapping", nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get mappings")
+	}
+	var result map[string]struct {
+		Mappings map[string]any `json:"mappings"`
+	}
+	if err := json.Unmarshal(bytes, &result); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse mappings")
+	}
+	mappings := make(map[string]map[string]any)
+	for index, m := range result {
+		mappings[index] = m.Mappings
+	}
+	return mappings, nil
+}
+
+// getIndexTemplates gets the composable index templates except the built-in ones.
+func (d *Driver) getIndexTemplates() ([]*storepb.IndexTemplateMetadata, error) {
+	bytes, err := d.do("GET", "/_index_template", nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get index templates")
+	}
+	var result struct {
+		IndexTemplates []struct {
+			Name          string         `json:"name"`
+			IndexTemplate map[string]any `json:"index_template"`
+		} `json:"index_templates"`
+	}
+	if err := json.Unmarshal(bytes, &result); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse index templates")
+	}
+
+	var templates []*storepb.IndexTemplateMetadata
+	for _, t := range result.IndexTemplates {
+		if isBuiltin(t.Name, t.IndexTemplate) {
+			continue
+		}
+		template, err := convertIndexTemplate(t.Name, t.IndexTemplate)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	slices.SortFunc(templates, func(a, b *storepb.IndexTemplateMetadata) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return templates, nil
+}
+
+func convertIndexTemplate(name string, body map[string]any) (*storepb.IndexTemplateMetadata, error) {
+	template := &storepb.IndexTemplateMetadata{
+		Name:          name,
+		IndexPatterns: getStringList(body["index_patterns"]),
+		ComposedOf:    getStringList(body["composed_of"]),
+	}
+	if priority, ok := body["priority"].(float64); ok {
+		template.Priority = int64(priority)
+	}
+	if t, ok := body["template"].(map[string]any); ok {
+		if mappings, ok := t["mappings"]; ok {
+			b, err := json.Marshal(mappings)
+			if err != nil {
+				return nil, err
+			}
+			template.Mappings = string(b)
+		}
+		if settings, ok := t["settings"]; ok {
+			b, err := json.Marshal(settings)
+			if err != nil {
+				return nil, err
+			}
+			template.Settings = string(b)
+		}
+	}
+	definition, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	template.Definition = string(definition)
+	return template, nil
+}
+
+// getLifecyclePolicies gets the ILM policies of Elasticsearch or the ISM policies of OpenSearch except the built-in ones.
+// The definition of a policy is the body of the PUT policy API.
+func (d *Driver) getLifecyclePolicies(distribution string) ([]*storepb.LifecyclePolicyMetadata, error) {
+	var policies []*storepb.LifecyclePolicyMetadata
+	if distribution == openSearchDistribution {
+		bytes, err := d.do("GET", "/_plugins/_ism/policies", nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get ISM policies")
+		}
+		var result struct {
+			Policies []struct {
+				ID     string         `json:"_id"`
+				Policy map[string]any `json:"policy"`
+			} `json:"policies"`
+		}
+		if err := json.Unmarshal(bytes, &result); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse ISM policies")
+		}
+		for _, p := range result.Policies {
+			// Remove the read-only fields which are rejected by the PUT policy API.
+			delete(p.Policy, "policy_id")
+			delete(p.Policy, "last_updated_time")
+			delete(p.Policy, "schema_version")
+			delete(p.Policy, "error_notification")
+			definition, err := json.Marshal(map[string]any{"policy": p.Policy})
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, &storepb.LifecyclePolicyMetadata{
+				Name:       p.ID,
+				Definition: string(definition),
+			})
+		}
+	} else {
+		bytes, err := d.do("GET", "/_ilm/policy", nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get ILM policies")
+		}
+		var result map[string]struct {
+			Policy map[string]any `json:"policy"`
+		}
+		if err := json.Unmarshal(bytes, &result); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse ILM policies")
+		}
+		for name, p := range result {
+			if isBuiltin(name, p.Policy) {
+				continue
+			}
+			definition, err := json.Marshal(map[string]any{"policy": p.Policy})
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, &storepb.LifecyclePolicyMetadata{
+				Name:       name,
+				Definition: string(definition),
+			})
+		}
+	}
+	slices.SortFunc(policies, func(a, b *storepb.LifecyclePolicyMetadata) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return policies, nil
+}
+
+// getTemplatesAndPolicies gets the index templates and lifecycle policies.
+// They are optional for the sync, the errors are logged since the APIs depend on the version, plugins and privileges.
+func (d *Driver) getTemplatesAndPolicies(distribution string) ([]*storepb.IndexTemplateMetadata, []*storepb.LifecyclePolicyMetadata) {
+	templates, err := d.getIndexTemplates()
+	if err != nil {
+		slog.Debug("failed to get index templates", log.BBError(err))
+	}
+	policies, err := d.getLifecyclePolicies(distribution)
+	if err != nil {
+		slog.Debug("failed to get lifecycle policies", log.BBError(err))
+	}
+	return templates, policies
+}
+
+// isBuiltin returns true for the hidden objects and the objects managed by Elasticsearch.
+func isBuiltin(name string, body map[string]any) bool {
+	if strings.HasPrefix(name, ".") {
+		return true
+	}
+	meta, ok := body["_meta"].(map[string]any)
+	if !ok {
+		return false
+	}
+	managed, _ := meta["managed"].(bool)
+	return managed
+}
+
+func getStringList(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var list []string
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+// ConvertMappingsToColumns converts the field mappings to the columns.
+// The nested fields, object sub-fields and multi-fields are named by the dotted path, such as "user.name" and "title.raw".
+// The type of an object field without explicit type is "object".
+func ConvertMappingsToColumns(mappings map[string]any) []*storepb.ColumnMetadata {
+	properties, _ := mappings["properties"].(map[string]any)
+	return appendFieldColumns(nil, "", properties)
+}
+
+func appendFieldColumns(columns []*storepb.ColumnMetadata, prefix string, properties map[string]any) []*storepb.ColumnMetadata {
+	var names []string
+	for name := range properties {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		field, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		path := prefix + name
+		tp, _ := field["type"].(string)
+		subProperties, hasProperties := field["properties"].(map[string]any)
+		if tp == "" && hasProperties {
+			tp = "object"
+		}
+		columns = append(columns, &storepb.ColumnMetadata{
+			Name:     path,
+			Position: int32(len(columns) + 1),
+			Type:     tp,
+			Nullable: true,
+		})
+		if hasProperties {
+			columns = appendFieldColumns(columns, path+".", subProperties)
+		}
+		if fields, ok := field["fields"].(map[string]any); ok {
+			columns = appendFieldColumns(columns, path+".", fields)
+		}
+	}
+	return columns
+}
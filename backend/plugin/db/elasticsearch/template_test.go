@@ -0,0 +1,76 @@
+package elasticsearch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertMappingsToColumns(t *testing.T) {
+	a := require.New(t)
+	var mappings map[string]any
+	a.NoError(json.Unmarshal([]byte(`{
+		"dynamic": "strict",
+		"properties": {
+			"title": {"type": "text", "fields": {"raw": {"type": "keyword"}}},
+			"user": {"properties": {"name": {"type": "keyword"}, "age": {"type": "integer"}}},
+			"tags": {"type": "nested", "properties": {"value": {"type": "keyword"}}}
+		}
+	}`), &mappings))
+
+	type column struct {
+		name string
+		tp   string
+	}
+	var got []column
+	for i, c := range ConvertMappingsToColumns(mappings) {
+		a.Equal(int32(i+1), c.Position)
+		got = append(got, column{name: c.Name, tp: c.Type})
+	}
+	a.Equal([]column{
+		{name: "tags", tp: "nested"},
+		{name: "tags.value", tp: "keyword"},
+		{name: "title", tp: "text"},
+		{name: "title.raw", tp: "keyword"},
+		{name: "user", tp: "object"},
+		{name: "user.age", tp: "integer"},
+		{name: "user.name", tp: "keyword"},
+	}, got)
+}
+
+func TestConvertIndexTemplate(t *testing.T) {
+	a := require.New(t)
+	var body map[string]any
+	a.NoError(json.Unmarshal([]byte(`{
+		"index_patterns": ["logs-app-*"],
+		"priority": 200,
+		"composed_of": ["logs@settings"],
+		"template": {
+			"settings": {"index": {"lifecycle": {"name": "logs-app"}}},
+			"mappings": {"properties": {"message": {"type": "text"}}}
+		}
+	}`), &body))
+
+	template, err := convertIndexTemplate("logs-app", body)
+	a.NoError(err)
+	a.Equal("logs-app", template.Name)
+	a.Equal([]string{"logs-app-*"}, template.IndexPatterns)
+	a.Equal(int64(200), template.Priority)
+	a.Equal([]string{"logs@settings"}, template.ComposedOf)
+	a.Equal(`{"properties":{"message":{"type":"text"}}}`, template.Mappings)
+	a.Equal(`{"index":{"lifecycle":{"name":"logs-app"}}}`, template.Settings)
+	a.JSONEq(`{
+		"index_patterns": ["logs-app-*"],
+		"priority": 200,
+		"composed_of": ["logs@settings"],
+		"template": {
+			"settings": {"index": {"lifecycle": {"name": "logs-app"}}},
+			"mappings": {"properties": {"message": {"type": "text"}}}
+		}
+	}`, template.Definition)
+
+	a.True(isBuiltin(".kibana", nil))
+	a.True(isBuiltin("logs", map[string]any{"_meta": map[string]any{"managed": true}}))
+	a.False(isBuiltin("logs-app", body))
+}
@@ -0,0 +1,106 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Dump dumps the collections, validators, indexes and shard keys of the database as a mongosh script.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer) (string, error) {
+	// MongoDB creates the database lazily on the first write, so the database may not exist yet.
+	exist, err := driver.isDatabaseExist(ctx, driver.databaseName)
+	if err != nil {
+		return "", err
+	}
+	if !exist {
+		return "", nil
+	}
+	metadata, err := driver.SyncDBSchema(ctx)
+	if err != nil {
+		return "", err
+	}
+	dump, err := dumpDatabaseSchema(metadata)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(out, dump); err != nil {
+		return "", err
+	}
+	return "", nil
+}
+
+// dumpDatabaseSchema returns the mongosh script creating the collections in the database metadata.
+// The output is deterministic so that it can be used for schema drift detection.
+func dumpDatabaseSchema(metadata *storepb.DatabaseSchemaMetadata) (string, error) {
+	var buf strings.Builder
+	for _, schema := range metadata.GetSchemas() {
+		for _, table := range schema.GetTables() {
+			collectionName := strconv.Quote(table.GetName())
+			if table.GetValidator() != "" {
+				if _, err := fmt.Fprintf(&buf, "db.createCollection(%s, {\"validator\":%s});\n", collectionName, table.GetValidator()); err != nil {
+					return "", err
+				}
+			} else {
+				if _, err := fmt.Fprintf(&buf, "db.createCollection(%s);\n", collectionName); err != nil {
+					return "", err
+				}
+			}
+			for _, index := range table.GetIndexes() {
+				// The _id index is created with the collection.
+				if index.GetPrimary() || len(index.GetExpressions()) == 0 {
+					continue
+				}
+				indexOptions, err := getIndexOptions(index)
+				if err != nil {
+					return "", err
+				}
+				if _, err := fmt.Fprintf(&buf, "db.getCollection(%s).createIndex(%s, %s);\n", collectionName, index.GetExpressions()[0], indexOptions); err != nil {
+					return "", err
+				}
+			}
+			if table.GetShardKey() != "" {
+				namespace := strconv.Quote(fmt.Sprintf("%s.%s", metadata.GetName(), table.GetName()))
+				if _, err := fmt.Fprintf(&buf, "sh.shardCollection(%s, %s);\n", namespace, table.GetShardKey()); err != nil {
+					return "", err
+				}
+			}
+			if _, err := buf.WriteString("\n"); err != nil {
+				return "", err
+			}
+		}
+	}
+	return buf.String(), nil
+}
+
+// getIndexOptions returns the createIndex options in the index definition, such as name, unique and partialFilterExpression.
+func getIndexOptions(index *storepb.IndexMetadata) (string, error) {
+	if index.GetDefinition() == "" {
+		return fmt.Sprintf("{\"name\":%s}", strconv.Quote(index.GetName())), nil
+	}
+	var definition bson.D
+	if err := bson.UnmarshalExtJSON([]byte(index.GetDefinition()), false /* canonical */, &definition); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal definition of index %s", index.GetName())
+	}
+	var indexOptions bson.D
+	for _, e := range definition {
+		switch e.Key {
+		// The version, key and namespace are not the options of createIndex.
+		case "v", "key", "ns":
+		default:
+			indexOptions = append(indexOptions, e)
+		}
+	}
+	b, err := bson.MarshalExtJSON(indexOptions, false /* canonical */, false /* escapeHTML */)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal options of index %s", index.GetName())
+	}
+	return string(b), nil
+}
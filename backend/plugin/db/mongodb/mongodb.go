@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"net"
 	"net/url"
 	"os"
 	"os/exec"
@@ -25,6 +26,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	"github.com/bytebase/bytebase/backend/resources/mongoutil"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -43,6 +45,7 @@ type Driver struct {
 	connCfg       db.ConnectionConfig
 	client        *mongo.Client
 	databaseName  string
+	sshTunnel     *util.SSHTunnel
 }
 
 func newDriver(dc db.DriverConfig) db.Driver {
@@ -51,6 +54,33 @@ func newDriver(dc db.DriverConfig) db.Driver {
 
 // Open opens a MongoDB driver.
 func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, connCfg db.ConnectionConfig) (db.Driver, error) {
+	if connCfg.SSHConfig.Host != "" {
+		if connCfg.SRV {
+			return nil, errors.New("SSH tunnel is not supported for MongoDB SRV connection")
+		}
+		sshTunnel, err := util.NewSSHTunnel(connCfg.SSHConfig)
+		if err != nil {
+			return nil, err
+		}
+		// Both the driver and mongosh connect to the local forwarded address,
+		// so we connect to the target host directly instead of discovering the replica set members.
+		localAddr, err := sshTunnel.Forward(fmt.Sprintf("%s:%s", connCfg.Host, connCfg.Port))
+		if err != nil {
+			_ = sshTunnel.Close()
+			return nil, err
+		}
+		host, port, err := net.SplitHostPort(localAddr)
+		if err != nil {
+			_ = sshTunnel.Close()
+			return nil, err
+		}
+		driver.sshTunnel = sshTunnel
+		connCfg.Host = host
+		connCfg.Port = port
+		connCfg.AdditionalAddresses = nil
+		connCfg.ReplicaSet = ""
+		connCfg.DirectConnection = true
+	}
 	connectionURI := getBasicMongoDBConnectionURI(connCfg)
 	opts := options.Client().ApplyURI(connectionURI)
 	tlsConfig, err := connCfg.TLSConfig.GetSslConfig()
@@ -64,6 +94,9 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, connCfg db.Con
 	}
 	client, err := mongo.Connect(ctx, opts)
 	if err != nil {
+		if driver.sshTunnel != nil {
+			_ = driver.sshTunnel.Close()
+		}
 		return nil, errors.Wrap(err, "failed to create MongoDB client")
 	}
 	driver.client = client
@@ -75,6 +108,9 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, connCfg db.Con
 
 // Close closes the MongoDB driver.
 func (driver *Driver) Close(ctx context.Context) error {
+	if driver.sshTunnel != nil {
+		defer driver.sshTunnel.Close()
+	}
 	if err := driver.client.Disconnect(ctx); err != nil {
 		return errors.Wrap(err, "failed to disconnect MongoDB")
 	}
@@ -180,11 +216,6 @@ func (driver *Driver) Execute(ctx context.Context, statement string, _ db.Execut
 	return 0, nil
 }
 
-// Dump dumps the database.
-func (*Driver) Dump(_ context.Context, _ io.Writer) (string, error) {
-	return "", nil
-}
-
 // getBasicMongoDBConnectionURI returns the basic MongoDB connection URI, the following fields are excluded:
 // - TLS related
 // https://www.mongodb.com/docs/manual/reference/connection-string/
@@ -241,3 +241,48 @@ func TestGetOrderedColumns(t *testing.T) {
 		a.Equal(tt.wantColumnIndexMap, gotMap)
 	}
 }
+
+func TestDumpDatabaseSchema(t *testing.T) {
+	a := require.New(t)
+	metadata := &storepb.DatabaseSchemaMetadata{
+		Name: "shop",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "orders",
+						Indexes: []*storepb.IndexMetadata{
+							{
+								Name:        "_id_",
+								Expressions: []string{`{"_id":1}`},
+								Primary:     true,
+								Definition:  `{"v":2,"key":{"_id":1},"name":"_id_"}`,
+							},
+							{
+								Name:        "user_id_1_created_at_-1",
+								Expressions: []string{`{"user_id":1,"created_at":-1}`},
+								Unique:      true,
+								Definition:  `{"v":2,"key":{"user_id":1,"created_at":-1},"name":"user_id_1_created_at_-1","unique":true}`,
+							},
+						},
+						Validator: `{"$jsonSchema":{"required":["user_id"]}}`,
+						ShardKey:  `{"user_id":"hashed"}`,
+					},
+					{
+						Name: "logs",
+					},
+				},
+			},
+		},
+	}
+	want := `db.createCollection("orders", {"validator":{"$jsonSchema":{"required":["user_id"]}}});
+db.getCollection("orders").createIndex({"user_id":1,"created_at":-1}, {"name":"user_id_1_created_at_-1","unique":true});
+sh.shardCollection("shop.orders", {"user_id":"hashed"});
+
+db.createCollection("logs");
+
+`
+	got, err := dumpDatabaseSchema(metadata)
+	a.NoError(err)
+	a.Equal(want, got)
+}
@@ -2,8 +2,8 @@ package mongodb
 
 import (
 	"context"
-	"encoding/json"
 	"log/slog"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -102,6 +102,7 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 	}
 	var collectionNames []string
 	var viewNames []string
+	validators := make(map[string]string)
 	for collectionList.Next(ctx) {
 		var collection bson.M
 		if err := collectionList.Decode(&collection); err != nil {
@@ -127,6 +128,14 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		switch tp {
 		case "collection":
 			collectionNames = append(collectionNames, collectionName)
+			// Decode the validator from the raw document to keep the field order.
+			if validator, ok := collectionList.Current.Lookup("options", "validator").DocumentOK(); ok {
+				validatorJSON, err := bson.MarshalExtJSON(validator, false /* canonical */, false /* escapeHTML */)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to marshal validator of collection %s", collectionName)
+				}
+				validators[collectionName] = string(validatorJSON)
+			}
 		case "view":
 			viewNames = append(viewNames, collectionName)
 		}
@@ -140,6 +149,12 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 	sort.Strings(collectionNames)
 	sort.Strings(viewNames)
 
+	shardKeys, err := driver.getShardKeys(ctx)
+	if err != nil {
+		// Only mongos has the sharding metadata, and the user may have no privilege to read it.
+		slog.Debug("Failed to get shard keys", slog.String("database", driver.databaseName), slog.String("error", err.Error()))
+	}
+
 	for _, collectionName := range collectionNames {
 		if systemCollection[collectionName] {
 			continue
@@ -187,6 +202,8 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 			DataSize:  dataSize64,
 			IndexSize: totalIndexSize64,
 			Indexes:   indexes,
+			Validator: validators[collectionName],
+			ShardKey:  shardKeys[collectionName],
 		})
 	}
 
@@ -207,56 +224,102 @@ func getIndexes(ctx context.Context, collection *mongo.Collection) ([]*storepb.I
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list indexes")
 	}
-	indexMap := make(map[string]*storepb.IndexMetadata)
 	defer indexCursor.Close(ctx)
+	var indexes []*storepb.IndexMetadata
 	for indexCursor.Next(ctx) {
-		var indexInfo bson.M
-		if err := indexCursor.Decode(&indexInfo); err != nil {
-			return nil, errors.Wrap(err, "failed to decode index info")
-		}
-		name, ok := indexInfo["name"]
+		// Use the raw document instead of bson.M to keep the order of the compound index keys.
+		indexInfo := indexCursor.Current
+		indexName, ok := indexInfo.Lookup("name").StringValueOK()
 		if !ok {
 			return nil, errors.New("cannot get index name from index info")
 		}
-		indexName, ok := name.(string)
-		if !ok {
-			return nil, errors.New("cannot cinvert index name to string")
-		}
-		key, ok := indexInfo["key"]
+		key, ok := indexInfo.Lookup("key").DocumentOK()
 		if !ok {
 			return nil, errors.New("cannot get index key from index info")
 		}
-		expression, err := json.Marshal(key)
+		expression, err := bson.MarshalExtJSON(key, false /* canonical */, false /* escapeHTML */)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot marshal index key to json")
 		}
 		unique := false
-		if u, ok := indexInfo["unique"]; ok {
-			unique, ok = u.(bool)
+		if u, err := indexInfo.LookupErr("unique"); err == nil {
+			unique, ok = u.BooleanOK()
 			if !ok {
 				return nil, errors.New("cannot convert unique to bool")
 			}
 		}
+		definition, err := bson.MarshalExtJSON(indexInfo, false /* canonical */, false /* escapeHTML */)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot marshal index definition to json")
+		}
 
-		if _, ok := indexMap[indexName]; !ok {
-			indexMap[indexName] = &storepb.IndexMetadata{
-				Name:   indexName,
-				Unique: unique,
-			}
+		indexes = append(indexes, &storepb.IndexMetadata{
+			Name:        indexName,
+			Expressions: []string{string(expression)},
+			Type:        getIndexType(key),
+			Unique:      unique,
+			Primary:     indexName == "_id_",
+			Definition:  string(definition),
+		})
+	}
+	if err := indexCursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to list indexes")
+	}
+
+	sort.Slice(indexes, func(i, j int) bool {
+		return indexes[i].Name < indexes[j].Name
+	})
+	return indexes, nil
+}
+
+// getIndexType returns the special index type such as "text", "hashed" and "2dsphere" in the index key,
+// and returns empty for the regular index.
+// https://www.mongodb.com/docs/manual/core/indexes/index-types/
+func getIndexType(key bson.Raw) string {
+	elements, err := key.Elements()
+	if err != nil {
+		return ""
+	}
+	for _, element := range elements {
+		if tp, ok := element.Value().StringValueOK(); ok {
+			return tp
 		}
-		indexMap[indexName].Expressions = append(indexMap[indexName].Expressions, string(expression))
 	}
+	return ""
+}
 
-	var indexes []*storepb.IndexMetadata
-	var indexNames []string
-	for name := range indexMap {
-		indexNames = append(indexNames, name)
+// getShardKeys returns the shard keys of the sharded collections in the database, keyed by collection name.
+// https://www.mongodb.com/docs/manual/reference/config-database/#mongodb-data-config.collections
+func (driver *Driver) getShardKeys(ctx context.Context) (map[string]string, error) {
+	shardKeys := make(map[string]string)
+	prefix := driver.databaseName + "."
+	cursor, err := driver.client.Database("config").Collection("collections").Find(ctx, bson.M{
+		"_id":     bson.M{"$regex": "^" + regexp.QuoteMeta(prefix)},
+		"dropped": bson.M{"$ne": true},
+	})
+	if err != nil {
+		return shardKeys, errors.Wrap(err, "failed to list sharded collections")
 	}
-	sort.Strings(indexNames)
-	for _, name := range indexNames {
-		indexes = append(indexes, indexMap[name])
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		namespace, ok := cursor.Current.Lookup("_id").StringValueOK()
+		if !ok {
+			continue
+		}
+		key, ok := cursor.Current.Lookup("key").DocumentOK()
+		if !ok {
+			continue
+		}
+		keyJSON, err := bson.MarshalExtJSON(key, false /* canonical */, false /* escapeHTML */)
+		if err != nil {
+			return shardKeys, errors.Wrapf(err, "failed to marshal shard key of %s", namespace)
+		}
+		shardKeys[strings.TrimPrefix(namespace, prefix)] = string(keyJSON)
 	}
-	return indexes, nil
+	if err := cursor.Err(); err != nil {
+		return shardKeys, errors.Wrap(err, "failed to list sharded collections")
+	}
+	return shardKeys, nil
 }
 
 // getVersion returns the version of mongod or mongos instance.
@@ -0,0 +1,125 @@
+package mssql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Always On availability groups.
+// Docs: https://learn.microsoft.com/en-us/sql/database-engine/availability-groups/windows/overview-of-always-on-availability-groups-sql-server.
+
+// getAvailabilityGroups gets the availability groups which the instance is a replica of.
+// The DMVs require the VIEW SERVER STATE permission, and they're empty if the Always On availability groups are not enabled.
+func (driver *Driver) getAvailabilityGroups(ctx context.Context) ([]*storepb.AvailabilityGroup, error) {
+	query := `
+		SELECT
+			ag.name,
+			ISNULL(gs.primary_replica, ''),
+			ar.replica_server_name,
+			ISNULL(rs.role_desc, ''),
+			ar.secondary_role_allow_connections_desc,
+			ISNULL(ar.read_only_routing_url, ''),
+			ISNULL(agl.dns_name, ''),
+			ISNULL(agl.port, 0)
+		FROM sys.availability_groups ag
+		JOIN sys.availability_replicas ar ON ar.group_id = ag.group_id
+		LEFT JOIN sys.dm_hadr_availability_group_states gs ON gs.group_id = ag.group_id
+		LEFT JOIN sys.dm_hadr_availability_replica_states rs ON rs.replica_id = ar.replica_id
+		LEFT JOIN sys.availability_group_listeners agl ON agl.group_id = ag.group_id
+		ORDER BY ag.name, ar.replica_server_name`
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var groups []*storepb.AvailabilityGroup
+	for rows.Next() {
+		var groupName, primaryReplica, allowConnections string
+		var listenerPort int32
+		var listenerDNSName string
+		replica := &storepb.AvailabilityGroup_Replica{}
+		if err := rows.Scan(
+			&groupName,
+			&primaryReplica,
+			&replica.ServerName,
+			&replica.Role,
+			&allowConnections,
+			&replica.ReadOnlyRoutingUrl,
+			&listenerDNSName,
+			&listenerPort,
+		); err != nil {
+			return nil, err
+		}
+		// The secondary replica only sees the state of itself, and the other replicas are the primary replica or the secondary replicas.
+		if replica.ServerName == primaryReplica {
+			replica.Role = "PRIMARY"
+		} else if replica.Role == "" {
+			replica.Role = "SECONDARY"
+		}
+		replica.Readable = allowConnections == "READ_ONLY" || allowConnections == "ALL"
+		if len(groups) == 0 || groups[len(groups)-1].Name != groupName {
+			groups = append(groups, &storepb.AvailabilityGroup{
+				Name:            groupName,
+				PrimaryReplica:  primaryReplica,
+				ListenerDnsName: listenerDNSName,
+				ListenerPort:    listenerPort,
+			})
+		}
+		group := groups[len(groups)-1]
+		// The group with many listeners has a row per listener and replica.
+		if !slices.ContainsFunc(group.Replicas, func(r *storepb.AvailabilityGroup_Replica) bool { return r.ServerName == replica.ServerName }) {
+			group.Replicas = append(group.Replicas, replica)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return groups, nil
+}
+
+// getPrimaryReplicaAddress returns the address of the primary replica if the connected instance is a secondary replica,
+// or empty if it's the primary replica or not in any availability group.
+// The availability group of the database is used if the database is specified.
+func (driver *Driver) getPrimaryReplicaAddress(ctx context.Context, databaseName string, port string) (string, error) {
+	query := `
+		SELECT TOP 1
+			gs.primary_replica,
+			ISNULL(ar.read_only_routing_url, '')
+		FROM sys.dm_hadr_availability_replica_states rs
+		JOIN sys.dm_hadr_availability_group_states gs ON gs.group_id = rs.group_id
+		LEFT JOIN sys.availability_replicas ar ON ar.group_id = rs.group_id AND ar.replica_server_name = gs.primary_replica
+		WHERE rs.is_local = 1 AND rs.role_desc = 'SECONDARY'
+			AND (@p1 = '' OR EXISTS (
+				SELECT 1 FROM sys.availability_databases_cluster adc
+				WHERE adc.group_id = rs.group_id AND adc.database_name = @p1
+			))`
+	var primaryReplica, routingURL string
+	if err := driver.db.QueryRowContext(ctx, query, databaseName).Scan(&primaryReplica, &routingURL); err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", util.FormatErrorWithQuery(err, query)
+	}
+	return getReplicaAddress(primaryReplica, routingURL, port), nil
+}
+
+// getReplicaAddress returns the client address of the replica.
+// The read-only routing URL is the client address if it's configured, e.g. TCP://host:1433.
+// Otherwise, the host of the server name is used with the port of the data source, e.g. host\instance.
+func getReplicaAddress(serverName, routingURL, port string) string {
+	if routingURL != "" {
+		if u, err := url.Parse(strings.ToLower(routingURL)); err == nil && u.Host != "" {
+			return u.Host
+		}
+	}
+	host, _, _ := strings.Cut(serverName, `\`)
+	return fmt.Sprintf("%s:%s", host, port)
+}
@@ -0,0 +1,39 @@
+package mssql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReplicaAddress(t *testing.T) {
+	tests := []struct {
+		serverName string
+		routingURL string
+		port       string
+		want       string
+	}{
+		{
+			serverName: `sql-2\AG`,
+			routingURL: "TCP://sql-2.corp.example.com:1433",
+			port:       "1500",
+			want:       "sql-2.corp.example.com:1433",
+		},
+		{
+			serverName: `sql-2\AG`,
+			routingURL: "",
+			port:       "1500",
+			want:       "sql-2:1500",
+		},
+		{
+			serverName: "sql-3",
+			routingURL: "",
+			port:       "1433",
+			want:       "sql-3:1433",
+		},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, getReplicaAddress(test.serverName, test.routingURL, test.port))
+	}
+}
@@ -12,8 +12,7 @@ import (
 	"strings"
 	"time"
 
-	// Import MSSQL driver.
-	_ "github.com/microsoft/go-mssqldb"
+	mssql "github.com/microsoft/go-mssqldb"
 	_ "github.com/microsoft/go-mssqldb/integratedauth/krb5"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -44,6 +43,7 @@ type Driver struct {
 	// certificate file path should be deleted if calling closed.
 	certFilePath         string
 	maximumSQLResultSize int64
+	sshTunnel            *util.SSHTunnel
 }
 
 func newDriver(db.DriverConfig) db.Driver {
@@ -51,12 +51,17 @@ func newDriver(db.DriverConfig) db.Driver {
 }
 
 // Open opens a MSSQL driver.
-func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
+func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.ConnectionConfig) (db.Driver, error) {
 	query := url.Values{}
 	query.Add("app name", "bytebase")
 	if config.Database != "" {
 		query.Add("database", config.Database)
 	}
+	if config.ReadOnly {
+		// The read-only connections are routed to the readable secondary replicas by the availability group listener,
+		// and they're rejected by the secondary replicas which only allow the read-intent connections.
+		query.Add("ApplicationIntent", "ReadOnly")
+	}
 
 	// In order to be compatible with db servers that only support old versions of tls.
 	// See: https://github.com/microsoft/go-mssqldb/issues/33
@@ -98,18 +103,53 @@ func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.Connec
 		query.Add("certificate", fName)
 	}
 	query.Add("TrustServerCertificate", trustServerCertificate)
-	u := &url.URL{
-		Scheme:   "sqlserver",
-		User:     url.UserPassword(config.Username, config.Password),
-		Host:     fmt.Sprintf("%s:%s", config.Host, config.Port),
-		RawQuery: query.Encode(),
+	if config.SSHConfig.Host != "" {
+		var sshTunnel *util.SSHTunnel
+		// Assign to err so that the certificate file is cleaned up on failure.
+		sshTunnel, err = util.NewSSHTunnel(config.SSHConfig)
+		if err != nil {
+			return nil, err
+		}
+		driver.sshTunnel = sshTunnel
+	}
+	openDB := func(address string) (*sql.DB, error) {
+		u := &url.URL{
+			Scheme:   "sqlserver",
+			User:     url.UserPassword(config.Username, config.Password),
+			Host:     address,
+			RawQuery: query.Encode(),
+		}
+		connector, err := mssql.NewConnector(u.String())
+		if err != nil {
+			return nil, err
+		}
+		if driver.sshTunnel != nil {
+			connector.Dialer = driver.sshTunnel
+		}
+		return sql.OpenDB(connector), nil
 	}
-	var db *sql.DB
-	db, err = sql.Open("sqlserver", u.String())
+	driver.db, err = openDB(fmt.Sprintf("%s:%s", config.Host, config.Port))
 	if err != nil {
 		return nil, err
 	}
-	driver.db = db
+	if !config.ReadOnly {
+		// The writes go to the current primary replica if the data source is a secondary replica of an availability group,
+		// e.g. the data source was the primary replica before the failover.
+		primaryAddress, perr := driver.getPrimaryReplicaAddress(ctx, config.Database, config.Port)
+		if perr != nil {
+			slog.Debug("failed to get the primary replica of the availability group", slog.String("host", config.Host), log.BBError(perr))
+		} else if primaryAddress != "" {
+			var primaryDB *sql.DB
+			primaryDB, err = openDB(primaryAddress)
+			if err != nil {
+				_ = driver.db.Close()
+				return nil, err
+			}
+			slog.Info("route the connection to the primary replica of the availability group", slog.String("host", config.Host), slog.String("primary", primaryAddress))
+			_ = driver.db.Close()
+			driver.db = primaryDB
+		}
+	}
 	driver.databaseName = config.Database
 	driver.maximumSQLResultSize = config.MaximumSQLResultSize
 	return driver, nil
@@ -122,6 +162,9 @@ func (driver *Driver) Close(_ context.Context) error {
 			slog.Warn("failed to delete temporary file", slog.String("path", driver.certFilePath), slog.Any("error", err))
 		}
 	}
+	if driver.sshTunnel != nil {
+		return driver.sshTunnel.Close()
+	}
 	return nil
 }
 
@@ -3,7 +3,10 @@ package mssql
 import (
 	"context"
 	"database/sql"
+	"log/slog"
+	"math"
 
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 )
 
@@ -19,6 +22,13 @@ func (driver *Driver) CountAffectedRows(ctx context.Context, statement string) (
 	if _, err := conn.ExecContext(ctx, "SET SHOWPLAN_ALL ON;"); err != nil {
 		return 0, err
 	}
+	// The connection is returned to the pool after closing, so we must turn off the SHOWPLAN_ALL,
+	// otherwise the following queries on the connection will not be executed.
+	defer func() {
+		if _, err := conn.ExecContext(context.Background(), "SET SHOWPLAN_ALL OFF;"); err != nil {
+			slog.Warn("failed to turn off SHOWPLAN_ALL", log.BBError(err))
+		}
+	}()
 	rows, err := conn.QueryContext(ctx, statement)
 	if err != nil {
 		return 0, err
@@ -39,14 +49,15 @@ func (driver *Driver) CountAffectedRows(ctx context.Context, statement string) (
 			var unused any
 			scanArgs[i] = &unused
 		}
-		var rowsColumn sql.NullInt64
+		// EstimateRows is a float value, such as 1.5.
+		var rowsColumn sql.NullFloat64
 		scanArgs[rowsIndex] = &rowsColumn
 		if err := rows.Scan(scanArgs...); err != nil {
 			return 0, err
 		}
 
 		if rowsColumn.Valid {
-			return rowsColumn.Int64, nil
+			return int64(math.Round(rowsColumn.Float64)), nil
 		}
 	}
 	if err := rows.Err(); err != nil {
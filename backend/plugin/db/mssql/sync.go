@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -51,9 +53,18 @@ func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, e
 		return nil, err
 	}
 
+	availabilityGroups, err := driver.getAvailabilityGroups(ctx)
+	if err != nil {
+		// The availability groups are not supported by all editions and cloud vendors, e.g. Azure SQL Database.
+		slog.Debug("failed to get availability groups", log.BBError(err))
+	}
+
 	return &db.InstanceMetadata{
 		Version:   version,
 		Databases: databases,
+		Metadata: &storepb.InstanceMetadata{
+			AvailabilityGroups: availabilityGroups,
+		},
 	}, nil
 }
 
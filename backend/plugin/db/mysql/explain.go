@@ -0,0 +1,193 @@
+package mysql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// explainAccessTypes are the operations of the access types in the output of EXPLAIN FORMAT=JSON.
+var explainAccessTypes = map[string]string{
+	"system":          "System Table Scan",
+	"const":           "Constant Lookup",
+	"eq_ref":          "Unique Key Lookup",
+	"ref":             "Index Lookup",
+	"fulltext":        "Fulltext Index Scan",
+	"ref_or_null":     "Index Lookup Or Null",
+	"index_merge":     "Index Merge",
+	"unique_subquery": "Unique Subquery",
+	"index_subquery":  "Index Subquery",
+	"range":           "Index Range Scan",
+	"index":           "Full Index Scan",
+	"ALL":             "Full Table Scan",
+}
+
+// explainOperations are the operations of the blocks in the output of EXPLAIN FORMAT=JSON,
+// the blocks are converted to the nodes in the order.
+var explainOperations = []struct {
+	key       string
+	operation string
+}{
+	{"union_result", "Union"},
+	{"windowing", "Window"},
+	{"ordering_operation", "Sort"},
+	{"grouping_operation", "Group"},
+	{"duplicates_removal", "Distinct"},
+	{"nested_loop", "Nested Loop"},
+	{"table", ""},
+	{"query_block", "Query Block"},
+	{"query_specifications", ""},
+	{"materialized_from_subquery", "Materialized Subquery"},
+	{"attached_subqueries", "Subqueries"},
+	{"optimized_away_subqueries", "Optimized Away Subqueries"},
+}
+
+// ConvertExplainJSON converts the output of EXPLAIN FORMAT=JSON to the plan tree.
+func ConvertExplainJSON(data string) (*v1pb.QueryPlanNode, error) {
+	var explain map[string]any
+	if err := json.Unmarshal([]byte(data), &explain); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal explain output")
+	}
+	block, ok := explain["query_block"].(map[string]any)
+	if !ok {
+		return nil, errors.Errorf("query block not found in explain output")
+	}
+	return convertExplainQueryBlock(block), nil
+}
+
+func convertExplainQueryBlock(block map[string]any) *v1pb.QueryPlanNode {
+	node := &v1pb.QueryPlanNode{
+		Operation: "Query Block",
+		Children:  convertExplainChildren(block),
+	}
+	if id, ok := block["select_id"]; ok {
+		node.Operation = fmt.Sprintf("Query Block #%v", id)
+	}
+	if costInfo, ok := block["cost_info"].(map[string]any); ok {
+		node.TotalCost = getExplainFloat(costInfo["query_cost"])
+	}
+	if message, ok := block["message"].(string); ok {
+		node.Details = append(node.Details, message)
+	}
+	return node
+}
+
+func convertExplainTable(table map[string]any) *v1pb.QueryPlanNode {
+	node := &v1pb.QueryPlanNode{
+		Operation:     "Table Scan",
+		EstimatedRows: getExplainFloat(table["rows_produced_per_join"]),
+		Children:      convertExplainChildren(table),
+	}
+	if name, ok := table["table_name"].(string); ok {
+		node.Relation = name
+	}
+	if key, ok := table["key"].(string); ok {
+		node.Index = key
+	}
+	if accessType, ok := table["access_type"].(string); ok {
+		if operation, ok := explainAccessTypes[accessType]; ok {
+			node.Operation = operation
+		}
+	}
+	if costInfo, ok := table["cost_info"].(map[string]any); ok {
+		node.TotalCost = getExplainFloat(costInfo["prefix_cost"])
+	}
+	if ref, ok := table["ref"].([]any); ok && len(ref) > 0 {
+		node.Details = append(node.Details, fmt.Sprintf("Ref: %v", ref))
+	}
+	if condition, ok := table["index_condition"].(string); ok {
+		node.Details = append(node.Details, fmt.Sprintf("Index Condition: %s", condition))
+	}
+	if condition, ok := table["attached_condition"].(string); ok {
+		node.Details = append(node.Details, fmt.Sprintf("Condition: %s", condition))
+	}
+	if message, ok := table["message"].(string); ok {
+		node.Details = append(node.Details, message)
+	}
+	return node
+}
+
+// convertExplainChildren converts the nested blocks to the children nodes.
+func convertExplainChildren(block map[string]any) []*v1pb.QueryPlanNode {
+	var children []*v1pb.QueryPlanNode
+	for _, operation := range explainOperations {
+		value, ok := block[operation.key]
+		if !ok {
+			continue
+		}
+		switch operation.key {
+		case "table":
+			if table, ok := value.(map[string]any); ok {
+				children = append(children, convertExplainTable(table))
+			}
+		case "query_block":
+			if queryBlock, ok := value.(map[string]any); ok {
+				children = append(children, convertExplainQueryBlock(queryBlock))
+			}
+		case "query_specifications":
+			// The query blocks of the union.
+			for _, item := range toExplainObjects(value) {
+				children = append(children, convertExplainChildren(item)...)
+			}
+		default:
+			node := &v1pb.QueryPlanNode{
+				Operation: operation.operation,
+			}
+			for _, item := range toExplainObjects(value) {
+				if costInfo, ok := item["cost_info"].(map[string]any); ok {
+					node.TotalCost = getExplainFloat(costInfo["sort_cost"])
+				}
+				if usingFilesort, ok := item["using_filesort"].(bool); ok && usingFilesort {
+					node.Details = append(node.Details, "Using filesort")
+				}
+				if usingTemporaryTable, ok := item["using_temporary_table"].(bool); ok && usingTemporaryTable {
+					node.Details = append(node.Details, "Using temporary table")
+				}
+				node.Children = append(node.Children, convertExplainChildren(item)...)
+			}
+			// The subqueries without the details are flattened.
+			if operation.key == "attached_subqueries" && len(node.Details) == 0 {
+				children = append(children, node.Children...)
+				continue
+			}
+			children = append(children, node)
+		}
+	}
+	return children
+}
+
+// toExplainObjects returns the objects in the value, which is an object or an array of objects.
+func toExplainObjects(value any) []map[string]any {
+	switch v := value.(type) {
+	case map[string]any:
+		return []map[string]any{v}
+	case []any:
+		var objects []map[string]any
+		for _, item := range v {
+			if object, ok := item.(map[string]any); ok {
+				objects = append(objects, object)
+			}
+		}
+		return objects
+	}
+	return nil
+}
+
+// getExplainFloat returns the number in the explain output, the costs are strings and the rows are numbers.
+func getExplainFloat(value any) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	}
+	return 0
+}
@@ -0,0 +1,120 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertExplainJSON(t *testing.T) {
+	a := require.New(t)
+	explain := `{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {
+      "query_cost": "4.60"
+    },
+    "ordering_operation": {
+      "using_filesort": true,
+      "nested_loop": [
+        {
+          "table": {
+            "table_name": "o",
+            "access_type": "ALL",
+            "rows_examined_per_scan": 10,
+            "rows_produced_per_join": 3,
+            "filtered": "33.33",
+            "cost_info": {
+              "read_cost": "1.02",
+              "eval_cost": "0.33",
+              "prefix_cost": "1.35"
+            },
+            "attached_condition": "(` + "`db`.`o`.`amount` > 100" + `)"
+          }
+        },
+        {
+          "table": {
+            "table_name": "c",
+            "access_type": "eq_ref",
+            "key": "PRIMARY",
+            "ref": ["db.o.customer_id"],
+            "rows_examined_per_scan": 1,
+            "rows_produced_per_join": 3,
+            "cost_info": {
+              "prefix_cost": "2.50"
+            }
+          }
+        }
+      ]
+    }
+  }
+}`
+	root, err := ConvertExplainJSON(explain)
+	a.NoError(err)
+	a.Equal("Query Block #1", root.Operation)
+	a.Equal(4.6, root.TotalCost)
+	a.Len(root.Children, 1)
+
+	sort := root.Children[0]
+	a.Equal("Sort", sort.Operation)
+	a.Equal([]string{"Using filesort"}, sort.Details)
+	a.Len(sort.Children, 1)
+
+	nestedLoop := sort.Children[0]
+	a.Equal("Nested Loop", nestedLoop.Operation)
+	a.Len(nestedLoop.Children, 2)
+
+	orders := nestedLoop.Children[0]
+	a.Equal("Full Table Scan", orders.Operation)
+	a.Equal("o", orders.Relation)
+	a.Equal(float64(3), orders.EstimatedRows)
+	a.Equal(1.35, orders.TotalCost)
+	a.Equal([]string{"Condition: (`db`.`o`.`amount` > 100)"}, orders.Details)
+
+	customers := nestedLoop.Children[1]
+	a.Equal("Unique Key Lookup", customers.Operation)
+	a.Equal("PRIMARY", customers.Index)
+	a.Equal([]string{"Ref: [db.o.customer_id]"}, customers.Details)
+}
+
+func TestConvertExplainJSONUnion(t *testing.T) {
+	a := require.New(t)
+	explain := `{
+  "query_block": {
+    "union_result": {
+      "using_temporary_table": true,
+      "table_name": "<union1,2>",
+      "access_type": "ALL",
+      "query_specifications": [
+        {
+          "dependent": false,
+          "cacheable": true,
+          "query_block": {
+            "select_id": 1,
+            "message": "No tables used"
+          }
+        },
+        {
+          "dependent": false,
+          "cacheable": true,
+          "query_block": {
+            "select_id": 2,
+            "message": "No tables used"
+          }
+        }
+      ]
+    }
+  }
+}`
+	root, err := ConvertExplainJSON(explain)
+	a.NoError(err)
+	a.Equal("Query Block", root.Operation)
+	a.Len(root.Children, 1)
+
+	union := root.Children[0]
+	a.Equal("Union", union.Operation)
+	a.Equal([]string{"Using temporary table"}, union.Details)
+	a.Len(union.Children, 2)
+	a.Equal("Query Block #2", union.Children[1].Operation)
+	a.Equal([]string{"No tables used"}, union.Children[1].Details)
+}
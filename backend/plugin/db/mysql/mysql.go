@@ -13,18 +13,17 @@ import (
 	"net"
 	"net/http"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/cloudsqlconn"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
 	"github.com/blang/semver/v4"
 	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -42,6 +41,9 @@ var (
 	viewTableType = "VIEW"
 
 	_ db.Driver = (*Driver)(nil)
+
+	// sessionStatementReg matches the statements changing the session, e.g. SET @var = 1 and USE db.
+	sessionStatementReg = regexp.MustCompile(`(?i)^\s*(SET|USE)\s+`)
 )
 
 func init() {
@@ -58,7 +60,7 @@ type Driver struct {
 	dbBinDir      string
 	db            *sql.DB
 	databaseName  string
-	sshClient     *ssh.Client
+	sshTunnel     *util.SSHTunnel
 
 	// Called upon driver.Open() finishes.
 	openCleanUp []func()
@@ -92,7 +94,12 @@ func (d *Driver) Open(ctx context.Context, dbType storepb.Engine, connCfg db.Con
 		return nil, err
 	}
 
-	db, err := sql.Open("mysql", dsn)
+	var db *sql.DB
+	if connCfg.AuthenticationType == storepb.DataSourceOptions_AWS_RDS_IAM {
+		db, err = openRDSConnection(dsn, connCfg)
+	} else {
+		db, err = sql.Open("mysql", dsn)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -117,14 +124,14 @@ func (d *Driver) getMySQLConnection(connCfg db.ConnectionConfig) (string, error)
 
 	params := []string{"multiStatements=true", "maxAllowedPacket=0"}
 	if connCfg.SSHConfig.Host != "" {
-		sshClient, err := util.GetSSHClient(connCfg.SSHConfig)
+		sshTunnel, err := util.NewSSHTunnel(connCfg.SSHConfig)
 		if err != nil {
 			return "", err
 		}
-		d.sshClient = sshClient
+		d.sshTunnel = sshTunnel
 		// Now we register the dialer with the ssh connection as a parameter.
 		mysql.RegisterDialContext("mysql+tcp", func(_ context.Context, addr string) (net.Conn, error) {
-			return sshClient.Dial("tcp", addr)
+			return sshTunnel.Dial("tcp", addr)
 		})
 		protocol = "mysql+tcp"
 	}
@@ -189,15 +196,9 @@ func registerRDSMysqlCerts(ctx context.Context) error {
 // https://repost.aws/knowledge-center/rds-mysql-access-denied
 func getRDSConnection(ctx context.Context, connCfg db.ConnectionConfig) (string, error) {
 	dbEndpoint := fmt.Sprintf("%s:%s", connCfg.Host, connCfg.Port)
-	cfg, err := config.LoadDefaultConfig(ctx)
-	if err != nil {
-		return "", errors.Wrap(err, "load aws config failed")
-	}
-
-	authenticationToken, err := auth.BuildAuthToken(
-		ctx, dbEndpoint, connCfg.Region, connCfg.Username, cfg.Credentials)
+	authenticationToken, err := util.GetRDSAuthToken(ctx, connCfg)
 	if err != nil {
-		return "", errors.Wrap(err, "failed to create authentication token")
+		return "", err
 	}
 
 	err = registerRDSMysqlCerts(ctx)
@@ -210,6 +211,30 @@ func getRDSConnection(ctx context.Context, connCfg db.ConnectionConfig) (string,
 	), nil
 }
 
+// openRDSConnection opens the database with a new authentication token for each new connection,
+// because the authentication token expires in 15 minutes.
+func openRDSConnection(dsn string, connCfg db.ConnectionConfig) (*sql.DB, error) {
+	cfg, err := mysql.ParseDSN(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if err := cfg.Apply(mysql.BeforeConnect(func(ctx context.Context, cfg *mysql.Config) error {
+		authenticationToken, err := util.GetRDSAuthToken(ctx, connCfg)
+		if err != nil {
+			return err
+		}
+		cfg.Passwd = authenticationToken
+		return nil
+	})); err != nil {
+		return nil, err
+	}
+	connector, err := mysql.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return sql.OpenDB(connector), nil
+}
+
 func getCloudSQLConnection(ctx context.Context, connCfg db.ConnectionConfig) (string, error) {
 	d, err := cloudsqlconn.NewDialer(ctx, cloudsqlconn.WithIAMAuthN())
 	if err != nil {
@@ -228,8 +253,8 @@ func getCloudSQLConnection(ctx context.Context, connCfg db.ConnectionConfig) (st
 func (d *Driver) Close(context.Context) error {
 	var err error
 	err = multierr.Append(err, d.db.Close())
-	if d.sshClient != nil {
-		err = multierr.Append(err, d.sshClient.Close())
+	if d.sshTunnel != nil {
+		err = multierr.Append(err, d.sshTunnel.Close())
 	}
 	return err
 }
@@ -301,6 +326,10 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 	}
 	slog.Debug("connectionID", slog.String("connectionID", connectionID))
 
+	if err := setSessionVariables(ctx, conn, opts.SessionVariables); err != nil {
+		return 0, err
+	}
+
 	var totalCommands int
 	var commands []base.SingleSQL
 	var originalIndex []int32
@@ -330,34 +359,15 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 	}
 
 	var totalRowsAffected int64
+	var executedIndexes []int32
 
-	if err := conn.Raw(func(driverConn any) error {
-		//nolint
-		exer := driverConn.(driver.ExecerContext)
-		//nolint
-		txer := driverConn.(driver.ConnBeginTx)
-		tx, err := txer.BeginTx(ctx, driver.TxOptions{})
-		if err != nil {
-			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_BEGIN, err.Error())
-			return err
-		} else {
-			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_BEGIN, "")
-		}
-
-		committed := false
-		defer func() {
-			err := tx.Rollback()
-			if committed {
-				return
-			}
-			var rerr string
-			if err != nil {
-				rerr = err.Error()
-			}
-			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_ROLLBACK, rerr)
-		}()
-
+	// executeCommands runs the commands which are not committed by the previous executions,
+	// the commands are recorded as committed once they are run if autocommit is true.
+	executeCommands := func(exer driver.ExecerContext, autocommit bool) error {
 		for i, command := range commands {
+			if opts.IsCommandCommitted(originalIndex[i]) && !isSessionStatement(command.Text) {
+				continue
+			}
 			// Set the progress information for the current chunk.
 			if opts.UpdateExecutionStatus != nil {
 				opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
@@ -390,7 +400,7 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 				opts.LogCommandResponse(indexes, 0, nil, err.Error())
 
 				return &db.ErrorWithPosition{
-					Err: errors.Wrapf(err, "failed to execute context in a transaction"),
+					Err: errors.Wrapf(err, "failed to execute context"),
 					Start: &storepb.TaskRunResult_Position{
 						Line:   int32(command.FirstStatementLine),
 						Column: int32(command.FirstStatementColumn),
@@ -412,6 +422,53 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 			totalRowsAffected += rowsAffected
 
 			opts.LogCommandResponse(indexes, int32(rowsAffected), allRowsAffectedInt32, "")
+			executedIndexes = append(executedIndexes, indexes...)
+			if autocommit {
+				if err := opts.RecordCommitted(indexes); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	if err := conn.Raw(func(driverConn any) error {
+		//nolint
+		exer := driverConn.(driver.ExecerContext)
+		// In the autocommit mode, each statement is committed on its own.
+		// In the manual mode, the transactions are controlled by the BEGIN and COMMIT statements in the sheet.
+		// The commit points are unknown in the manual mode, so the commands are not recorded.
+		if opts.TransactionMode == storepb.TransactionMode_TRANSACTION_MODE_AUTOCOMMIT {
+			return executeCommands(exer, true /* autocommit */)
+		}
+		if opts.TransactionMode == storepb.TransactionMode_TRANSACTION_MODE_MANUAL {
+			return executeCommands(exer, false /* autocommit */)
+		}
+		//nolint
+		txer := driverConn.(driver.ConnBeginTx)
+		tx, err := txer.BeginTx(ctx, driver.TxOptions{})
+		if err != nil {
+			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_BEGIN, err.Error())
+			return err
+		} else {
+			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_BEGIN, "")
+		}
+
+		committed := false
+		defer func() {
+			err := tx.Rollback()
+			if committed {
+				return
+			}
+			var rerr string
+			if err != nil {
+				rerr = err.Error()
+			}
+			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_ROLLBACK, rerr)
+		}()
+
+		if err := executeCommands(exer, false /* autocommit */); err != nil {
+			return err
 		}
 
 		if err := tx.Commit(); err != nil {
@@ -421,7 +478,7 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_COMMIT, "")
 			committed = true
 		}
-		return nil
+		return opts.RecordCommitted(executedIndexes)
 	}); err != nil {
 		return 0, err
 	}
@@ -429,6 +486,31 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 	return totalRowsAffected, nil
 }
 
+// isSessionStatement returns whether the statement changes the session, such statements are run again when the execution is resumed.
+func isSessionStatement(statement string) bool {
+	return sessionStatementReg.MatchString(statement)
+}
+
+// setSessionVariables sets the session variables in the order of the names.
+// The integer values are not quoted, because MySQL rejects the string values for the integer variables.
+func setSessionVariables(ctx context.Context, conn *sql.Conn, variables map[string]string) error {
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		value := variables[name]
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			value = fmt.Sprintf("'%s'", strings.ReplaceAll(strings.ReplaceAll(value, `\`, `\\`), "'", "''"))
+		}
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET SESSION %s = %s", name, value)); err != nil {
+			return errors.Wrapf(err, "failed to set session variable %q", name)
+		}
+	}
+	return nil
+}
+
 // QueryConn queries a SQL statement in a given connection.
 func (d *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	singleSQLs, err := base.SplitMultiSQL(storepb.Engine_MYSQL, statement)
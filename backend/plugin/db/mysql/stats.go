@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,8 +16,11 @@ import (
 )
 
 func (driver *Driver) CountAffectedRows(ctx context.Context, statement string) (int64, error) {
-	if driver.dbType == storepb.Engine_OCEANBASE {
+	switch driver.dbType {
+	case storepb.Engine_OCEANBASE:
 		return countAffectedRowsForOceanBase(ctx, driver.db, statement)
+	case storepb.Engine_TIDB:
+		return countAffectedRowsForTiDB(ctx, driver.db, statement)
 	}
 
 	explainSQL := fmt.Sprintf("EXPLAIN %s", statement)
@@ -69,6 +74,66 @@ func (driver *Driver) CountAffectedRows(ctx context.Context, statement string) (
 	return 0, nil
 }
 
+func countAffectedRowsForTiDB(ctx context.Context, sqlDB *sql.DB, dml string) (int64, error) {
+	explainSQL := fmt.Sprintf("EXPLAIN %s", dml)
+	rows, err := sqlDB.QueryContext(ctx, explainSQL)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	// TiDB uses the estRows column instead of the rows column, and the root DML operator has no estimation.
+	// mysql> explain delete from t where a > 1;
+	// +---------------------------+---------+-----------+---------------+--------------------------------+
+	// | id                        | estRows | task      | access object | operator info                  |
+	// +---------------------------+---------+-----------+---------------+--------------------------------+
+	// | Delete_4                  | N/A     | root      |               | N/A                            |
+	// | └─TableReader_8           | 3333.33 | root      |               | data:Selection_7               |
+	// |   └─Selection_7           | 3333.33 | cop[tikv] |               | gt(test.t.a, 1)                |
+	// |     └─TableFullScan_6     | 10000.00| cop[tikv] | table:t       | keep order:false, stats:pseudo |
+	// +---------------------------+---------+-----------+---------------+--------------------------------+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	estRowsIndex, ok := util.GetColumnIndex(columns, "estRows")
+	if !ok {
+		return 0, nil
+	}
+	for rows.Next() {
+		scanArgs := make([]any, len(columns))
+		for i := range scanArgs {
+			var unused any
+			scanArgs[i] = &unused
+		}
+		var estRowsColumn sql.NullString
+		scanArgs[estRowsIndex] = &estRowsColumn
+		if err := rows.Scan(scanArgs...); err != nil {
+			return 0, err
+		}
+
+		if count, ok := parseTiDBEstRows(estRowsColumn); ok {
+			return count, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+	return 0, nil
+}
+
+// parseTiDBEstRows parses the estRows column of TiDB EXPLAIN, such as "3333.33" and "N/A".
+func parseTiDBEstRows(estRows sql.NullString) (int64, bool) {
+	if !estRows.Valid {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(estRows.String), 64)
+	if err != nil {
+		return 0, false
+	}
+	return int64(math.Round(v)), true
+}
+
 func countAffectedRowsForOceanBase(ctx context.Context, sqlDB *sql.DB, dml string) (int64, error) {
 	explainSQL := fmt.Sprintf("EXPLAIN FORMAT=JSON %s", dml)
 	rows, err := sqlDB.QueryContext(ctx, explainSQL)
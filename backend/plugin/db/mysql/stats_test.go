@@ -0,0 +1,40 @@
+package mysql
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTiDBEstRows(t *testing.T) {
+	tests := []struct {
+		estRows sql.NullString
+		want    int64
+		wantOK  bool
+	}{
+		{
+			estRows: sql.NullString{String: "3333.33", Valid: true},
+			want:    3333,
+			wantOK:  true,
+		},
+		{
+			estRows: sql.NullString{String: "10000.00", Valid: true},
+			want:    10000,
+			wantOK:  true,
+		},
+		{
+			estRows: sql.NullString{String: "N/A", Valid: true},
+		},
+		{
+			estRows: sql.NullString{},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, ok := parseTiDBEstRows(test.estRows)
+		a.Equal(test.wantOK, ok)
+		a.Equal(test.want, got)
+	}
+}
@@ -23,7 +23,22 @@ func (driver *Driver) Dump(ctx context.Context, out io.Writer) (string, error) {
 	}
 	defer txn.Rollback()
 
-	if err := driver.dumpSchemaTxn(ctx, txn, driver.databaseName, out); err != nil {
+	if driver.pdbMode {
+		// The pluggable database has many schemas, and the dumped statements are not schema qualified,
+		// so the current schema is switched before dumping each schema.
+		schemas, err := getSchemas(txn)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to get schemas from database %q", driver.databaseName)
+		}
+		for _, schema := range schemas {
+			if _, err := fmt.Fprintf(out, "ALTER SESSION SET CURRENT_SCHEMA = \"%s\";\n\n", schema); err != nil {
+				return "", err
+			}
+			if err := driver.dumpSchemaTxn(ctx, txn, schema, out); err != nil {
+				return "", errors.Wrapf(err, "failed to dump schema %q in database %q", schema, driver.databaseName)
+			}
+		}
+	} else if err := driver.dumpSchemaTxn(ctx, txn, driver.databaseName, out); err != nil {
 		return "", errors.Wrapf(err, "failed to dump database %q", driver.databaseName)
 	}
 
@@ -17,6 +17,7 @@ import (
 	"github.com/antlr4-go/antlr/v4"
 	"github.com/pkg/errors"
 	goora "github.com/sijms/go-ora/v2"
+	"go.uber.org/multierr"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	plsql "github.com/bytebase/plsql-parser"
@@ -45,8 +46,10 @@ type Driver struct {
 	db                   *sql.DB
 	databaseName         string
 	serviceName          string
+	pdbMode              bool
 	connectionCtx        db.ConnectionContext
 	maximumSQLResultSize int64
+	sshTunnel            *util.SSHTunnel
 }
 
 func newDriver(db.DriverConfig) db.Driver {
@@ -70,18 +73,33 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 		options["SID"] = config.SID
 	}
 	dsn := goora.BuildUrl(config.Host, port, config.ServiceName, config.Username, config.Password, options)
-	db, err := sql.Open("oracle", dsn)
-	if err != nil {
-		return nil, err
+	connector := goora.NewConnector(dsn)
+	if config.SSHConfig.Host != "" {
+		sshTunnel, err := util.NewSSHTunnel(config.SSHConfig)
+		if err != nil {
+			return nil, err
+		}
+		driver.sshTunnel = sshTunnel
+		oracleConnector, ok := connector.(*goora.OracleConnector)
+		if !ok {
+			_ = sshTunnel.Close()
+			return nil, errors.Errorf("unexpected oracle connector type %T", connector)
+		}
+		oracleConnector.Dialer(sshTunnel)
 	}
-	if config.Database != "" {
+	db := sql.OpenDB(connector)
+	// In PDB mode, the database is the pluggable database connected by the service name rather than the schema.
+	if config.Database != "" && !config.OraclePDBMode {
 		if _, err := db.ExecContext(ctx, fmt.Sprintf("ALTER SESSION SET CURRENT_SCHEMA = \"%s\"", config.Database)); err != nil {
+			_ = db.Close()
+			_ = driver.closeSSHTunnel()
 			return nil, errors.Wrapf(err, "failed to set current schema to %q", config.Database)
 		}
 	}
 	driver.db = db
 	driver.databaseName = config.Database
 	driver.serviceName = config.ServiceName
+	driver.pdbMode = config.OraclePDBMode
 	driver.connectionCtx = config.ConnectionContext
 	driver.maximumSQLResultSize = config.MaximumSQLResultSize
 	return driver, nil
@@ -89,7 +107,14 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 
 // Close closes the driver.
 func (driver *Driver) Close(_ context.Context) error {
-	return driver.db.Close()
+	return multierr.Append(driver.db.Close(), driver.closeSSHTunnel())
+}
+
+func (driver *Driver) closeSSHTunnel() error {
+	if driver.sshTunnel == nil {
+		return nil
+	}
+	return driver.sshTunnel.Close()
 }
 
 // Ping pings the database.
@@ -12,6 +12,7 @@ import (
 
 func (driver *Driver) CountAffectedRows(ctx context.Context, statement string) (int64, error) {
 	// Statement includes trailing semicolon, so we need to remove it.
+	statement = strings.TrimRight(strings.TrimSpace(statement), ";")
 	if _, err := driver.db.ExecContext(ctx, fmt.Sprintf("EXPLAIN PLAN FOR %s", statement)); err != nil {
 		return 0, err
 	}
@@ -55,14 +56,40 @@ func (driver *Driver) CountAffectedRows(ctx context.Context, statement string) (
 		if rowsToken == "" {
 			continue
 		}
-		v, err := strconv.ParseInt(rowsToken, 10, 64)
-		if err != nil {
-			return 0, errors.Errorf("failed to get integer from %q", rowsToken)
-		}
-		return v, nil
+		return parseExplainPlanRows(rowsToken)
 	}
 	if err := rows.Err(); err != nil {
 		return 0, err
 	}
 	return 0, nil
 }
+
+// parseExplainPlanRows parses the Rows column of DBMS_XPLAN.DISPLAY.
+// Oracle abbreviates the large numbers with the K, M, G, T, P and E suffixes, such as "1000K".
+func parseExplainPlanRows(rowsToken string) (int64, error) {
+	multiplier := int64(1)
+	if len(rowsToken) > 0 {
+		switch rowsToken[len(rowsToken)-1] {
+		case 'K':
+			multiplier = 1000
+		case 'M':
+			multiplier = 1000 * 1000
+		case 'G':
+			multiplier = 1000 * 1000 * 1000
+		case 'T':
+			multiplier = 1000 * 1000 * 1000 * 1000
+		case 'P':
+			multiplier = 1000 * 1000 * 1000 * 1000 * 1000
+		case 'E':
+			multiplier = 1000 * 1000 * 1000 * 1000 * 1000 * 1000
+		}
+		if multiplier != 1 {
+			rowsToken = rowsToken[:len(rowsToken)-1]
+		}
+	}
+	v, err := strconv.ParseInt(rowsToken, 10, 64)
+	if err != nil {
+		return 0, errors.Errorf("failed to get integer from %q", rowsToken)
+	}
+	return v * multiplier, nil
+}
@@ -0,0 +1,43 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExplainPlanRows(t *testing.T) {
+	tests := []struct {
+		rows    string
+		want    int64
+		wantErr bool
+	}{
+		{
+			rows: "1",
+			want: 1,
+		},
+		{
+			rows: "1000K",
+			want: 1000000,
+		},
+		{
+			rows: "12M",
+			want: 12000000,
+		},
+		{
+			rows:    "abc",
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := parseExplainPlanRows(test.rows)
+		if test.wantErr {
+			a.Error(err)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+}
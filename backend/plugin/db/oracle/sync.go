@@ -46,6 +46,17 @@ func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, e
 		version = fmt.Sprintf("%s (%s)", version, canonicalVersion)
 	}
 
+	if driver.pdbMode {
+		databases, err := driver.getPluggableDatabases(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &db.InstanceMetadata{
+			Version:   version,
+			Databases: databases,
+		}, nil
+	}
+
 	txn, err := driver.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, err
@@ -82,13 +93,26 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 	}
 	defer txn.Rollback()
 
-	tableMap, err := getTables(txn, driver.databaseName)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get tables from database %q", driver.databaseName)
+	// The database is the schema, or the pluggable database with many schemas in PDB mode.
+	schemas := []string{driver.databaseName}
+	if driver.pdbMode {
+		if schemas, err = getSchemas(txn); err != nil {
+			return nil, errors.Wrapf(err, "failed to get schemas from database %q", driver.databaseName)
+		}
 	}
-	viewMap, err := getViews(txn, driver.databaseName)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get views from database %q", driver.databaseName)
+	tableMap := make(map[string][]*storepb.TableMetadata)
+	viewMap := make(map[string][]*storepb.ViewMetadata)
+	for _, schema := range schemas {
+		schemaTableMap, err := getTables(txn, schema)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get tables from schema %q in database %q", schema, driver.databaseName)
+		}
+		schemaViewMap, err := getViews(txn, schema)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get views from schema %q in database %q", schema, driver.databaseName)
+		}
+		tableMap[schema] = schemaTableMap[schema]
+		viewMap[schema] = schemaViewMap[schema]
 	}
 	dbLinks, err := getDBLinks(txn)
 	if err != nil {
@@ -104,14 +128,65 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		ServiceName:     driver.serviceName,
 		LinkedDatabases: dbLinks,
 	}
-	databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
-		Name:   driver.databaseName,
-		Tables: tableMap[driver.databaseName],
-		Views:  viewMap[driver.databaseName],
-	})
+	for _, schema := range schemas {
+		databaseMetadata.Schemas = append(databaseMetadata.Schemas, &storepb.SchemaMetadata{
+			Name:   schema,
+			Tables: tableMap[schema],
+			Views:  viewMap[schema],
+		})
+	}
 	return databaseMetadata, nil
 }
 
+// getPluggableDatabases gets the pluggable databases of the container database, and the service names to connect them.
+// The seed PDB is a template rather than a database, so it's skipped.
+// Docs: https://docs.oracle.com/en/database/oracle/oracle-database/19/refrn/V-PDBS.html.
+func (driver *Driver) getPluggableDatabases(ctx context.Context) ([]*storepb.DatabaseSchemaMetadata, error) {
+	var containerName string
+	containerQuery := "SELECT SYS_CONTEXT('USERENV', 'CON_NAME') FROM DUAL"
+	if err := driver.db.QueryRowContext(ctx, containerQuery).Scan(&containerName); err != nil {
+		return nil, util.FormatErrorWithQuery(err, containerQuery)
+	}
+	if containerName != "CDB$ROOT" {
+		return nil, errors.Errorf("PDB mode requires connecting to the root container CDB$ROOT, but connected to %q", containerName)
+	}
+
+	query := `
+		SELECT p.NAME, MIN(s.NETWORK_NAME)
+		FROM v$pdbs p
+		LEFT JOIN v$services s ON s.CON_ID = p.CON_ID
+		WHERE p.NAME <> 'PDB$SEED'
+		GROUP BY p.NAME
+		ORDER BY p.NAME`
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var databases []*storepb.DatabaseSchemaMetadata
+	for rows.Next() {
+		var name string
+		var serviceName sql.NullString
+		if err := rows.Scan(&name, &serviceName); err != nil {
+			return nil, err
+		}
+		// The services are not registered for the closed PDBs, and the default service has the same name as the PDB.
+		databaseMetadata := &storepb.DatabaseSchemaMetadata{
+			Name:        name,
+			ServiceName: name,
+		}
+		if serviceName.Valid && serviceName.String != "" {
+			databaseMetadata.ServiceName = serviceName.String
+		}
+		databases = append(databases, databaseMetadata)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return databases, nil
+}
+
 func getDBLinks(txn *sql.Tx) ([]*storepb.LinkedDatabaseMetadata, error) {
 	query := `
 	SELECT DB_LINK, HOST, USERNAME
@@ -0,0 +1,146 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	pgparser "github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// autoExplainLogMaxSize is the maximum size of the tail of the server log to read the auto_explain samples.
+const autoExplainLogMaxSize = 8 * 1024 * 1024
+
+var (
+	// autoExplainHeaderRegexp matches the log line of the auto_explain plan, e.g.
+	// 2024-01-01 00:00:00.000 UTC [123] LOG:  duration: 1002.123 ms  plan:
+	autoExplainHeaderRegexp = regexp.MustCompile(`^(?:(\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)?(?: [A-Za-z]+)?)\s)?.*LOG:\s+duration: ([0-9.]+) ms\s+plan:\s*$`)
+	// autoExplainPlanRegexp matches the first line of the plan in the text format.
+	autoExplainPlanRegexp = regexp.MustCompile(`\(cost=\d|\(actual time=|\(never executed\)`)
+	// autoExplainJSONQueryTextRegexp matches the query text of the plan in the JSON format.
+	autoExplainJSONQueryTextRegexp = regexp.MustCompile(`"Query Text":\s*("(?:[^"\\]|\\.)*")`)
+	autoExplainTimeLayouts         = []string{"2006-01-02 15:04:05.999999999 MST", "2006-01-02 15:04:05 MST", "2006-01-02 15:04:05.999999999", "2006-01-02 15:04:05"}
+)
+
+// listAutoExplainSamples reads the slow query samples logged by auto_explain in the current server log,
+// the samples are grouped by the fingerprint.
+// It requires the auto_explain to be loaded, the logging collector to be on, and the privilege to read the server files.
+func (driver *Driver) listAutoExplainSamples(ctx context.Context) (map[string][]*storepb.SlowQueryDetails, error) {
+	var libraries string
+	if err := driver.db.QueryRowContext(ctx, `SELECT string_agg(setting, ',') FROM pg_settings WHERE name IN ('shared_preload_libraries', 'session_preload_libraries')`).Scan(&libraries); err != nil {
+		return nil, err
+	}
+	if !strings.Contains(libraries, "auto_explain") {
+		return nil, nil
+	}
+
+	var logFile sql.NullString
+	getLogFile := `SELECT pg_current_logfile('stderr')`
+	if err := driver.db.QueryRowContext(ctx, getLogFile).Scan(&logFile); err != nil {
+		return nil, util.FormatErrorWithQuery(err, getLogFile)
+	}
+	if !logFile.Valid || logFile.String == "" {
+		return nil, errors.New("logging collector is off")
+	}
+
+	var size int64
+	getSize := `SELECT size FROM pg_stat_file($1)`
+	if err := driver.db.QueryRowContext(ctx, getSize, logFile.String).Scan(&size); err != nil {
+		return nil, util.FormatErrorWithQuery(err, getSize)
+	}
+	offset := max(size-autoExplainLogMaxSize, 0)
+	var content string
+	readFile := `SELECT pg_read_file($1, $2, $3)`
+	if err := driver.db.QueryRowContext(ctx, readFile, logFile.String, offset, size-offset).Scan(&content); err != nil {
+		return nil, util.FormatErrorWithQuery(err, readFile)
+	}
+
+	samples := make(map[string][]*storepb.SlowQueryDetails)
+	for _, sample := range parseAutoExplainLog(content) {
+		fingerprint := pgparser.GetFingerprint(sample.SqlText)
+		if len(fingerprint) > db.SlowQueryMaxLen {
+			fingerprint, _ = common.TruncateString(fingerprint, db.SlowQueryMaxLen)
+		}
+		if len(sample.SqlText) > db.SlowQueryMaxLen {
+			sample.SqlText, _ = common.TruncateString(sample.SqlText, db.SlowQueryMaxLen)
+		}
+		samples[fingerprint] = append(samples[fingerprint], sample)
+	}
+	return samples, nil
+}
+
+// parseAutoExplainLog parses the auto_explain plans in the server log of the stderr format,
+// the plans can be in the text or the JSON format.
+func parseAutoExplainLog(content string) []*storepb.SlowQueryDetails {
+	var samples []*storepb.SlowQueryDetails
+	lines := strings.Split(content, "\n")
+	for i := 0; i < len(lines); i++ {
+		matches := autoExplainHeaderRegexp.FindStringSubmatch(lines[i])
+		if matches == nil {
+			continue
+		}
+		milliseconds, err := strconv.ParseFloat(matches[2], 64)
+		if err != nil {
+			continue
+		}
+		sample := &storepb.SlowQueryDetails{
+			QueryTime: durationpb.New(time.Duration(milliseconds * float64(time.Millisecond))),
+		}
+		if matches[1] != "" {
+			for _, layout := range autoExplainTimeLayouts {
+				if t, err := time.Parse(layout, matches[1]); err == nil {
+					sample.StartTime = timestamppb.New(t.Add(-sample.QueryTime.AsDuration()))
+					break
+				}
+			}
+		}
+
+		// The plan is in the continuation lines starting with the whitespace.
+		var body []string
+		for i+1 < len(lines) && (strings.HasPrefix(lines[i+1], "\t") || strings.HasPrefix(lines[i+1], " ")) {
+			i++
+			body = append(body, lines[i])
+		}
+		if len(body) == 0 {
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(body[0]), "{") {
+			if m := autoExplainJSONQueryTextRegexp.FindStringSubmatch(strings.Join(body, "\n")); m != nil {
+				if text, err := strconv.Unquote(m[1]); err == nil {
+					sample.SqlText = text
+				}
+			}
+		} else {
+			var text []string
+			for j, line := range body {
+				line = strings.TrimSpace(line)
+				if j == 0 {
+					var ok bool
+					if line, ok = strings.CutPrefix(line, "Query Text:"); !ok {
+						break
+					}
+				} else if autoExplainPlanRegexp.MatchString(line) {
+					break
+				}
+				text = append(text, strings.TrimSpace(line))
+			}
+			sample.SqlText = strings.Join(text, "\n")
+		}
+		if sample.SqlText == "" {
+			continue
+		}
+		samples = append(samples, sample)
+	}
+	return samples
+}
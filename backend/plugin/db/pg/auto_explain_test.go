@@ -0,0 +1,34 @@
+package pg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAutoExplainLog(t *testing.T) {
+	a := require.New(t)
+	content := "2024-01-01 00:00:02.000 UTC [123] LOG:  duration: 1500.000 ms  plan:\n" +
+		"\tQuery Text: SELECT *\n" +
+		"\tFROM t WHERE id = 1;\n" +
+		"\tSeq Scan on t  (cost=0.00..35.50 rows=10 width=4)\n" +
+		"\t  Filter: (id = 1)\n" +
+		"2024-01-01 00:00:03.000 UTC [124] LOG:  connection received: host=[local]\n" +
+		"2024-01-01 00:00:05.000 UTC [125] LOG:  duration: 2000.000 ms  plan:\n" +
+		"\t{\n" +
+		"\t  \"Query Text\": \"SELECT \\\"name\\\" FROM t\",\n" +
+		"\t  \"Plan\": {\"Node Type\": \"Seq Scan\"}\n" +
+		"\t}\n" +
+		"2024-01-01 00:00:06.000 UTC [126] LOG:  duration: 1000.000 ms  plan:\n"
+
+	samples := parseAutoExplainLog(content)
+	a.Len(samples, 2)
+
+	a.Equal("SELECT *\nFROM t WHERE id = 1;", samples[0].SqlText)
+	a.Equal(1500*time.Millisecond, samples[0].QueryTime.AsDuration())
+	a.Equal(time.Date(2024, 1, 1, 0, 0, 0, 500*int(time.Millisecond), time.UTC), samples[0].StartTime.AsTime())
+
+	a.Equal(`SELECT "name" FROM t`, samples[1].SqlText)
+	a.Equal(2*time.Second, samples[1].QueryTime.AsDuration())
+}
@@ -70,7 +70,7 @@ func (driver *Driver) Dump(ctx context.Context, out io.Writer) (string, error) {
 func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database string, out io.Writer) error {
 	var args []string
 	var host, port string
-	if driver.sshClient == nil {
+	if driver.sshTunnel == nil {
 		host = driver.config.Host
 		port = driver.config.Port
 	} else {
@@ -86,12 +86,12 @@ func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database st
 		}
 		defer listener.Close()
 		databaseAddress := fmt.Sprintf("%s:%s", driver.config.Host, driver.config.Port)
-		go util.ProxyConnection(driver.sshClient, listener, databaseAddress)
+		go util.ProxyConnection(driver.sshTunnel, listener, databaseAddress)
 	}
 
 	password := driver.config.Password
 	if driver.config.AuthenticationType == storepb.DataSourceOptions_AWS_RDS_IAM {
-		rdsPassword, err := getRDSConnectionPassword(ctx, driver.config)
+		rdsPassword, err := util.GetRDSAuthToken(ctx, driver.config)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,95 @@
+package pg
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// explainPlan is the plan node in the output of EXPLAIN (FORMAT JSON).
+type explainPlan struct {
+	NodeType     string         `json:"Node Type"`
+	JoinType     string         `json:"Join Type"`
+	Strategy     string         `json:"Strategy"`
+	RelationName string         `json:"Relation Name"`
+	Schema       string         `json:"Schema"`
+	Alias        string         `json:"Alias"`
+	IndexName    string         `json:"Index Name"`
+	StartupCost  float64        `json:"Startup Cost"`
+	TotalCost    float64        `json:"Total Cost"`
+	PlanRows     float64        `json:"Plan Rows"`
+	Filter       string         `json:"Filter"`
+	IndexCond    string         `json:"Index Cond"`
+	RecheckCond  string         `json:"Recheck Cond"`
+	HashCond     string         `json:"Hash Cond"`
+	MergeCond    string         `json:"Merge Cond"`
+	JoinFilter   string         `json:"Join Filter"`
+	SortKey      []string       `json:"Sort Key"`
+	GroupKey     []string       `json:"Group Key"`
+	Plans        []*explainPlan `json:"Plans"`
+}
+
+// ConvertExplainJSON converts the output of EXPLAIN (FORMAT JSON) to the plan tree.
+func ConvertExplainJSON(data string) (*v1pb.QueryPlanNode, error) {
+	var plans []struct {
+		Plan *explainPlan `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(data), &plans); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal explain output")
+	}
+	if len(plans) == 0 || plans[0].Plan == nil {
+		return nil, errors.Errorf("plan not found in explain output")
+	}
+	return convertExplainPlan(plans[0].Plan), nil
+}
+
+func convertExplainPlan(plan *explainPlan) *v1pb.QueryPlanNode {
+	node := &v1pb.QueryPlanNode{
+		Operation:     plan.NodeType,
+		Relation:      plan.RelationName,
+		Index:         plan.IndexName,
+		StartupCost:   plan.StartupCost,
+		TotalCost:     plan.TotalCost,
+		EstimatedRows: plan.PlanRows,
+	}
+	if plan.JoinType != "" {
+		node.Operation = fmt.Sprintf("%s (%s)", plan.NodeType, plan.JoinType)
+	} else if plan.Strategy != "" && plan.Strategy != "Plain" {
+		node.Operation = fmt.Sprintf("%s (%s)", plan.NodeType, plan.Strategy)
+	}
+	if plan.Schema != "" && plan.RelationName != "" {
+		node.Relation = fmt.Sprintf("%s.%s", plan.Schema, plan.RelationName)
+	}
+	if plan.Alias != "" && plan.Alias != plan.RelationName {
+		node.Details = append(node.Details, fmt.Sprintf("Alias: %s", plan.Alias))
+	}
+	for _, detail := range []struct {
+		name  string
+		value string
+	}{
+		{"Index Cond", plan.IndexCond},
+		{"Recheck Cond", plan.RecheckCond},
+		{"Hash Cond", plan.HashCond},
+		{"Merge Cond", plan.MergeCond},
+		{"Join Filter", plan.JoinFilter},
+		{"Filter", plan.Filter},
+	} {
+		if detail.value != "" {
+			node.Details = append(node.Details, fmt.Sprintf("%s: %s", detail.name, detail.value))
+		}
+	}
+	if len(plan.SortKey) > 0 {
+		node.Details = append(node.Details, fmt.Sprintf("Sort Key: %s", strings.Join(plan.SortKey, ", ")))
+	}
+	if len(plan.GroupKey) > 0 {
+		node.Details = append(node.Details, fmt.Sprintf("Group Key: %s", strings.Join(plan.GroupKey, ", ")))
+	}
+	for _, child := range plan.Plans {
+		node.Children = append(node.Children, convertExplainPlan(child))
+	}
+	return node
+}
@@ -0,0 +1,73 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertExplainJSON(t *testing.T) {
+	a := require.New(t)
+	explain := `[
+  {
+    "Plan": {
+      "Node Type": "Hash Join",
+      "Join Type": "Inner",
+      "Startup Cost": 1.09,
+      "Total Cost": 2.24,
+      "Plan Rows": 4,
+      "Hash Cond": "(o.customer_id = c.id)",
+      "Plans": [
+        {
+          "Node Type": "Seq Scan",
+          "Relation Name": "orders",
+          "Alias": "o",
+          "Startup Cost": 0.00,
+          "Total Cost": 1.10,
+          "Plan Rows": 10,
+          "Filter": "(amount > 100)"
+        },
+        {
+          "Node Type": "Hash",
+          "Startup Cost": 1.04,
+          "Total Cost": 1.04,
+          "Plan Rows": 4,
+          "Plans": [
+            {
+              "Node Type": "Index Scan",
+              "Relation Name": "customers",
+              "Alias": "customers",
+              "Index Name": "customers_pkey",
+              "Startup Cost": 0.15,
+              "Total Cost": 1.04,
+              "Plan Rows": 4,
+              "Index Cond": "(id < 5)"
+            }
+          ]
+        }
+      ]
+    }
+  }
+]`
+	root, err := ConvertExplainJSON(explain)
+	a.NoError(err)
+	a.Equal("Hash Join (Inner)", root.Operation)
+	a.Equal(2.24, root.TotalCost)
+	a.Equal([]string{"Hash Cond: (o.customer_id = c.id)"}, root.Details)
+	a.Len(root.Children, 2)
+
+	seqScan := root.Children[0]
+	a.Equal("Seq Scan", seqScan.Operation)
+	a.Equal("orders", seqScan.Relation)
+	a.Equal(float64(10), seqScan.EstimatedRows)
+	a.Equal([]string{"Alias: o", "Filter: (amount > 100)"}, seqScan.Details)
+
+	indexScan := root.Children[1].Children[0]
+	a.Equal("Index Scan", indexScan.Operation)
+	a.Equal("customers_pkey", indexScan.Index)
+	a.Equal(0.15, indexScan.StartupCost)
+	a.Equal([]string{"Index Cond: (id < 5)"}, indexScan.Details)
+
+	_, err = ConvertExplainJSON(`[]`)
+	a.Error(err)
+}
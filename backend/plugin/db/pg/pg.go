@@ -8,18 +8,16 @@ import (
 	"log/slog"
 	"net"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/cloudsqlconn"
-	"github.com/aws/aws-sdk-go-v2/config"
-	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	pgquery "github.com/pganalyze/pg_query_go/v5"
@@ -51,7 +49,7 @@ type Driver struct {
 	config   db.ConnectionConfig
 
 	db        *sql.DB
-	sshClient *ssh.Client
+	sshTunnel *util.SSHTunnel
 	// connectionString is the connection string registered by pgx.
 	// Unregister connectionString if we don't need it.
 	connectionString string
@@ -83,14 +81,14 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 	}
 
 	if config.SSHConfig.Host != "" {
-		sshClient, err := util.GetSSHClient(config.SSHConfig)
+		sshTunnel, err := util.NewSSHTunnel(config.SSHConfig)
 		if err != nil {
 			return nil, err
 		}
-		driver.sshClient = sshClient
+		driver.sshTunnel = sshTunnel
 
 		connConfig.Config.DialFunc = func(_ context.Context, network, addr string) (net.Conn, error) {
-			conn, err := sshClient.Dial(network, addr)
+			conn, err := sshTunnel.Dial(network, addr)
 			if err != nil {
 				return nil, err
 			}
@@ -109,10 +107,23 @@ func (driver *Driver) Open(ctx context.Context, _ storepb.Engine, config db.Conn
 	}
 	driver.config = config
 
-	driver.connectionString = stdlib.RegisterConnConfig(connConfig)
-	db, err := sql.Open(driverName, driver.connectionString)
-	if err != nil {
-		return nil, err
+	var db *sql.DB
+	if config.AuthenticationType == storepb.DataSourceOptions_AWS_RDS_IAM {
+		// The authentication token expires in 15 minutes, so we generate a new one for each new connection.
+		db = stdlib.OpenDB(*connConfig, stdlib.OptionBeforeConnect(func(ctx context.Context, connCfg *pgx.ConnConfig) error {
+			password, err := util.GetRDSAuthToken(ctx, config)
+			if err != nil {
+				return err
+			}
+			connCfg.Password = password
+			return nil
+		}))
+	} else {
+		driver.connectionString = stdlib.RegisterConnConfig(connConfig)
+		db, err = sql.Open(driverName, driver.connectionString)
+		if err != nil {
+			return nil, err
+		}
 	}
 	driver.db = db
 	if config.ConnectionContext.UseDatabaseOwner {
@@ -151,6 +162,9 @@ func getPGConnectionConfig(config db.ConnectionConfig) (*pgx.ConnConfig, error)
 	connStr := fmt.Sprintf("host=%s port=%s", config.Host, config.Port)
 	sslMode := getSSLMode(config.TLSConfig, config.SSHConfig)
 	connStr += fmt.Sprintf(" sslmode=%s", sslMode)
+	if config.TargetSessionAttrs != "" {
+		connStr += fmt.Sprintf(" target_session_attrs=%s", config.TargetSessionAttrs)
+	}
 
 	connConfig, err := pgx.ParseConfig(connStr)
 	if err != nil {
@@ -171,31 +185,18 @@ func getPGConnectionConfig(config db.ConnectionConfig) (*pgx.ConnConfig, error)
 		connConfig.RuntimeParams["default_transaction_read_only"] = "true"
 		connConfig.RuntimeParams["application_name"] = "bytebase"
 	}
-
-	return connConfig, nil
-}
-
-func getRDSConnectionPassword(ctx context.Context, conf db.ConnectionConfig) (string, error) {
-	cfg, err := config.LoadDefaultConfig(ctx)
-	if err != nil {
-		return "", errors.Wrap(err, "load aws config failed")
-	}
-
-	dbEndpoint := fmt.Sprintf("%s:%s", conf.Host, conf.Port)
-	authenticationToken, err := auth.BuildAuthToken(
-		ctx, dbEndpoint, conf.Region, conf.Username, cfg.Credentials)
-	if err != nil {
-		return "", errors.Wrap(err, "failed to create authentication token")
+	if config.PreferSimpleProtocol {
+		connConfig.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
 	}
 
-	return authenticationToken, nil
+	return connConfig, nil
 }
 
 // getRDSConnectionConfig returns connection config for AWS RDS.
 //
 // https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.Go.html
 func getRDSConnectionConfig(ctx context.Context, conf db.ConnectionConfig) (*pgx.ConnConfig, error) {
-	password, err := getRDSConnectionPassword(ctx, conf)
+	password, err := util.GetRDSAuthToken(ctx, conf)
 	if err != nil {
 		return nil, err
 	}
@@ -266,8 +267,8 @@ func (driver *Driver) Close(context.Context) error {
 	stdlib.UnregisterConnConfig(driver.connectionString)
 	var err error
 	err = multierr.Append(err, driver.db.Close())
-	if driver.sshClient != nil {
-		err = multierr.Append(err, driver.sshClient.Close())
+	if driver.sshTunnel != nil {
+		err = multierr.Append(err, driver.sshTunnel.Close())
 	}
 	return err
 }
@@ -360,6 +361,8 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 	var nonTransactionAndSetRoleStmts []string
 	var nonTransactionAndSetRoleStmtsIndex []int32
 	var isPlsql bool
+	// In the autocommit and manual modes, the statements are run in order on the session without the transaction of Bytebase.
+	withoutTransaction := opts.TransactionMode == storepb.TransactionMode_TRANSACTION_MODE_AUTOCOMMIT || opts.TransactionMode == storepb.TransactionMode_TRANSACTION_MODE_MANUAL
 	oneshot := true
 	// HACK(p0ny): always split for pg
 	//nolint
@@ -387,6 +390,12 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		var tmpOriginalIndex []int32
 		for i, command := range commands {
 			switch {
+			case withoutTransaction:
+				if isSuperuserStatement(command.Text) {
+					// Use superuser privilege to run privileged statements.
+					slog.Info("Use superuser privilege to run privileged statements", slog.String("statement", command.Text))
+					command.Text = fmt.Sprintf("SET SESSION ROLE NONE;%sSET SESSION ROLE '%s';", command.Text, owner)
+				}
 			case isSetRoleStatement(command.Text):
 				nonTransactionAndSetRoleStmts = append(nonTransactionAndSetRoleStmts, command.Text)
 				nonTransactionAndSetRoleStmtsIndex = append(nonTransactionAndSetRoleStmtsIndex, originalIndex[i])
@@ -433,11 +442,18 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		}
 	}
 
+	if err := setSessionVariables(ctx, conn, opts.SessionVariables); err != nil {
+		return 0, err
+	}
+
 	if isPlsql {
 		// USE SET SESSION ROLE to set the role for the current session.
 		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET SESSION ROLE '%s'", owner)); err != nil {
 			return 0, errors.Wrapf(err, "failed to set role to database owner %q", owner)
 		}
+		if opts.IsCommandCommitted(0) {
+			return 0, nil
+		}
 		opts.LogCommandExecute([]int32{0})
 		if _, err := conn.ExecContext(ctx, statement); err != nil {
 			opts.LogCommandResponse([]int32{0}, 0, []int32{0}, err.Error())
@@ -445,7 +461,11 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 		}
 		opts.LogCommandResponse([]int32{0}, 0, []int32{0}, "")
 
-		return 0, nil
+		return 0, opts.RecordCommitted([]int32{0})
+	}
+
+	if withoutTransaction {
+		return executeWithoutTransaction(ctx, conn, owner, commands, originalIndex, opts)
 	}
 
 	totalRowsAffected := int64(0)
@@ -480,7 +500,12 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 				return err
 			}
 
+			var executedIndexes []int32
 			for i, command := range commands {
+				// The commands are committed together, so either all or none of them are committed by the previous executions.
+				if opts.IsCommandCommitted(originalIndex[i]) && !isSetStatement(command.Text) {
+					continue
+				}
 				// Start the current chunk.
 				// Set the progress information for the current chunk.
 				if opts.UpdateExecutionStatus != nil {
@@ -529,6 +554,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 				opts.LogCommandResponse(indexes, int32(rowsAffected), allRowsAffected, "")
 
 				totalRowsAffected += rowsAffected
+				executedIndexes = append(executedIndexes, indexes...)
 			}
 
 			if err := tx.Commit(ctx); err != nil {
@@ -538,7 +564,7 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 			opts.LogTransactionControl(storepb.TaskRunLog_TransactionControl_COMMIT, "")
 			committed = true
 
-			return nil
+			return opts.RecordCommitted(executedIndexes)
 		})
 		if err != nil {
 			return 0, err
@@ -552,12 +578,107 @@ func (driver *Driver) Execute(ctx context.Context, statement string, opts db.Exe
 	// Run non-transaction statements at the end.
 	for i, stmt := range nonTransactionAndSetRoleStmts {
 		indexes := []int32{nonTransactionAndSetRoleStmtsIndex[i]}
+		if opts.IsCommandCommitted(indexes[0]) && !isSetStatement(stmt) {
+			continue
+		}
 		opts.LogCommandExecute(indexes)
 		if _, err := conn.ExecContext(ctx, stmt); err != nil {
 			opts.LogCommandResponse(indexes, 0, []int32{0}, err.Error())
 			return 0, err
 		}
 		opts.LogCommandResponse(indexes, 0, []int32{0}, "")
+		if err := opts.RecordCommitted(indexes); err != nil {
+			return 0, err
+		}
+	}
+	return totalRowsAffected, nil
+}
+
+// setSessionVariables sets the session variables in the order of the names.
+// The SET without LOCAL lasts for the session, so the settings apply to the transaction of the statements as well.
+func setSessionVariables(ctx context.Context, conn *sql.Conn, variables map[string]string) error {
+	var names []string
+	for name := range variables {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		value := strings.ReplaceAll(variables[name], "'", "''")
+		if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET %s = '%s'", name, value)); err != nil {
+			return errors.Wrapf(err, "failed to set session variable %q", name)
+		}
+	}
+	return nil
+}
+
+// executeWithoutTransaction runs the commands one by one on the session with the database owner role.
+// Each command is committed on its own unless the commands begin the transactions themselves.
+func executeWithoutTransaction(ctx context.Context, conn *sql.Conn, owner string, commands []base.SingleSQL, originalIndex []int32, opts db.ExecuteOptions) (int64, error) {
+	// USE SET SESSION ROLE to set the role for the current session.
+	if _, err := conn.ExecContext(ctx, fmt.Sprintf("SET SESSION ROLE '%s'", owner)); err != nil {
+		return 0, errors.Wrapf(err, "failed to set role to database owner %q", owner)
+	}
+
+	totalRowsAffected := int64(0)
+	totalCommands := len(commands)
+	if err := conn.Raw(func(driverConn any) error {
+		pgConn := driverConn.(*stdlib.Conn).Conn().PgConn()
+		for i, command := range commands {
+			if opts.IsCommandCommitted(originalIndex[i]) && !isSetStatement(command.Text) {
+				continue
+			}
+			if opts.UpdateExecutionStatus != nil {
+				opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+					CommandsTotal:     int32(totalCommands),
+					CommandsCompleted: int32(i),
+					CommandStartPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+						Line:   int32(command.FirstStatementLine),
+						Column: int32(command.FirstStatementColumn),
+					},
+					CommandEndPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+						Line:   int32(command.LastLine),
+						Column: int32(command.LastColumn),
+					},
+				})
+			}
+
+			indexes := []int32{originalIndex[i]}
+			opts.LogCommandExecute(indexes)
+
+			results, err := pgConn.Exec(ctx, command.Text).ReadAll()
+			if err != nil {
+				opts.LogCommandResponse(indexes, 0, nil, err.Error())
+
+				return &db.ErrorWithPosition{
+					Err: errors.Wrapf(err, "failed to execute context"),
+					Start: &storepb.TaskRunResult_Position{
+						Line:   int32(command.FirstStatementLine),
+						Column: int32(command.FirstStatementColumn),
+					},
+					End: &storepb.TaskRunResult_Position{
+						Line:   int32(command.LastLine),
+						Column: int32(command.LastColumn),
+					},
+				}
+			}
+
+			var rowsAffected int64
+			var allRowsAffected []int32
+			for _, result := range results {
+				ra := result.CommandTag.RowsAffected()
+				allRowsAffected = append(allRowsAffected, int32(ra))
+				rowsAffected += ra
+			}
+			opts.LogCommandResponse(indexes, int32(rowsAffected), allRowsAffected, "")
+
+			totalRowsAffected += rowsAffected
+			if err := opts.RecordCommitted(indexes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
 	}
 	return totalRowsAffected, nil
 }
@@ -602,8 +723,15 @@ var (
 	vacuumReg = regexp.MustCompile(`(?i)VACUUM`)
 	// SET ROLE is a special statement that should be run before any other statements containing inside a transaction block or not.
 	setRoleReg = regexp.MustCompile(`(?i)SET\s+((SESSION|LOCAL)\s+)?ROLE`)
+	// setReg matches the SET statements changing the run-time parameters of the session.
+	setReg = regexp.MustCompile(`(?i)^\s*SET\s+`)
 )
 
+// isSetStatement returns whether the statement changes the session, such statements are run again when the execution is resumed.
+func isSetStatement(stmt string) bool {
+	return len(setReg.FindString(stmt)) > 0
+}
+
 func isSetRoleStatement(stmt string) bool {
 	return len(setRoleReg.FindString(stmt)) > 0
 }
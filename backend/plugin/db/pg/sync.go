@@ -515,7 +515,10 @@ SELECT
 	cols.collation_name,
 	cols.udt_schema,
 	cols.udt_name,
-	pg_catalog.col_description(format('%s.%s', quote_ident(table_schema), quote_ident(table_name))::regclass, cols.ordinal_position::int) as column_comment
+	pg_catalog.col_description(format('%s.%s', quote_ident(table_schema), quote_ident(table_name))::regclass, cols.ordinal_position::int) as column_comment,
+	(SELECT attr.atttypmod FROM pg_catalog.pg_attribute AS attr
+		WHERE attr.attrelid = format('%s.%s', quote_ident(table_schema), quote_ident(table_name))::regclass
+		AND attr.attname = cols.column_name) AS type_modifier
 FROM INFORMATION_SCHEMA.COLUMNS AS cols` + fmt.Sprintf(`
 WHERE cols.table_schema NOT IN (%s)
 ORDER BY cols.table_schema, cols.table_name, cols.ordinal_position;`, pgparser.SystemSchemaWhereClause)
@@ -532,7 +535,8 @@ func getTableColumns(txn *sql.Tx) (map[db.TableKey][]*storepb.ColumnMetadata, er
 		column := &storepb.ColumnMetadata{}
 		var schemaName, tableName, nullable string
 		var characterMaxLength, defaultStr, collation, udtSchema, udtName, comment sql.NullString
-		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Type, &characterMaxLength, &column.Position, &defaultStr, &nullable, &collation, &udtSchema, &udtName, &comment); err != nil {
+		var typeModifier sql.NullInt32
+		if err := rows.Scan(&schemaName, &tableName, &column.Name, &column.Type, &characterMaxLength, &column.Position, &defaultStr, &nullable, &collation, &udtSchema, &udtName, &comment, &typeModifier); err != nil {
 			return nil, err
 		}
 		if defaultStr.Valid {
@@ -548,6 +552,11 @@ func getTableColumns(txn *sql.Tx) (map[db.TableKey][]*storepb.ColumnMetadata, er
 		switch column.Type {
 		case "USER-DEFINED":
 			column.Type = fmt.Sprintf("%s.%s", udtSchema.String, udtName.String)
+			// The type modifier of pgvector types is the dimension, and it's -1 if the dimension is not specified.
+			// https://github.com/pgvector/pgvector.
+			if isVectorType(udtName.String) && typeModifier.Valid && typeModifier.Int32 > 0 {
+				column.Type = fmt.Sprintf("%s(%d)", column.Type, typeModifier.Int32)
+			}
 		case "ARRAY":
 			column.Type = udtName.String
 		case "character", "character varying", "bit", "bit varying":
@@ -788,7 +797,9 @@ SELECT idx.schemaname, idx.tablename, idx.indexname, idx.indexdef, (SELECT 1
 	AND table_schema = idx.schemaname
 	AND table_name = idx.tablename
 	AND constraint_type = 'PRIMARY KEY') AS primary,
-	obj_description(format('%s.%s', quote_ident(idx.schemaname), quote_ident(idx.indexname))::regclass) AS comment` + fmt.Sprintf(`
+	obj_description(format('%s.%s', quote_ident(idx.schemaname), quote_ident(idx.indexname))::regclass) AS comment,
+	(SELECT array_to_string(cls.reloptions, ',') FROM pg_catalog.pg_class AS cls
+		WHERE cls.oid = format('%s.%s', quote_ident(idx.schemaname), quote_ident(idx.indexname))::regclass) AS reloptions` + fmt.Sprintf(`
 FROM pg_indexes AS idx WHERE idx.schemaname NOT IN (%s)
 ORDER BY idx.schemaname, idx.tablename, idx.indexname;`, pgparser.SystemSchemaWhereClause)
 
@@ -805,8 +816,8 @@ func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
 		index := &storepb.IndexMetadata{}
 		var schemaName, tableName, statement string
 		var primary sql.NullInt32
-		var comment sql.NullString
-		if err := rows.Scan(&schemaName, &tableName, &index.Name, &statement, &primary, &comment); err != nil {
+		var comment, reloptions sql.NullString
+		if err := rows.Scan(&schemaName, &tableName, &index.Name, &statement, &primary, &comment, &reloptions); err != nil {
 			return nil, err
 		}
 
@@ -829,6 +840,13 @@ func getIndexes(txn *sql.Tx) (map[db.TableKey][]*storepb.IndexMetadata, error) {
 		index.Definition = deparsed
 
 		index.Type = getIndexMethodType(statement)
+		if isVectorIndexMethodType(index.Type) {
+			// The deparsed format drops the operator classes and the storage parameters that vector indexes depend on.
+			index.Definition = statement
+		}
+		if reloptions.Valid && reloptions.String != "" {
+			index.Parameters = parseIndexParameters(reloptions.String)
+		}
 		index.Unique = node.Index.Unique
 		index.Expressions = node.Index.GetKeyNameList()
 		if primary.Valid && primary.Int32 == 1 {
@@ -857,6 +875,34 @@ func getIndexMethodType(stmt string) string {
 	return matches[1]
 }
 
+// parseIndexParameters parses the storage parameters of an index, e.g. "m=16,ef_construction=64".
+func parseIndexParameters(reloptions string) map[string]string {
+	parameters := make(map[string]string)
+	for _, option := range strings.Split(reloptions, ",") {
+		key, value, ok := strings.Cut(option, "=")
+		if !ok {
+			continue
+		}
+		parameters[key] = value
+	}
+	return parameters
+}
+
+// isVectorType returns true if the type is a pgvector type.
+func isVectorType(typeName string) bool {
+	switch typeName {
+	case "vector", "halfvec", "sparsevec":
+		return true
+	default:
+		return false
+	}
+}
+
+// isVectorIndexMethodType returns true if the index method type is a pgvector index method type.
+func isVectorIndexMethodType(method string) bool {
+	return method == "hnsw" || method == "ivfflat"
+}
+
 var listFunctionQuery = `
 select n.nspname as function_schema,
 	p.proname as function_name,
@@ -962,37 +1008,66 @@ func (driver *Driver) SyncSlowQuery(ctx context.Context, _ time.Time) (map[strin
 		`
 	}
 
+	// The samples are best-effort, the auto_explain may not be loaded or the server log may not be readable.
+	samples, err := driver.listAutoExplainSamples(ctx)
+	if err != nil {
+		slog.Debug("failed to list auto_explain samples", log.BBError(err))
+	}
+
 	slowQueryStatisticsRows, err := driver.db.QueryContext(ctx, query)
 	if err != nil {
 		return nil, util.FormatErrorWithQuery(err, query)
 	}
 	defer slowQueryStatisticsRows.Close()
+	// The statements with different query ids may share the same fingerprint, e.g. the IN lists of different lengths.
+	itemMap := make(map[string]map[string]*storepb.SlowQueryStatisticsItem)
 	for slowQueryStatisticsRows.Next() {
 		var database string
-		var fingerprint string
+		var statement string
 		var calls int32
 		var totalExecTime float64
 		var maxExecTime float64
 		var rows int32
-		if err := slowQueryStatisticsRows.Scan(&database, &fingerprint, &calls, &totalExecTime, &maxExecTime, &rows); err != nil {
+		if err := slowQueryStatisticsRows.Scan(&database, &statement, &calls, &totalExecTime, &maxExecTime, &rows); err != nil {
 			return nil, err
 		}
+		fingerprint := pgparser.GetFingerprint(statement)
 		if len(fingerprint) > db.SlowQueryMaxLen {
 			fingerprint, _ = common.TruncateString(fingerprint, db.SlowQueryMaxLen)
 		}
-		item := storepb.SlowQueryStatisticsItem{
+		if _, ok := itemMap[database]; !ok {
+			itemMap[database] = make(map[string]*storepb.SlowQueryStatisticsItem)
+		}
+		totalQueryTime := time.Duration(totalExecTime * float64(time.Millisecond))
+		maximumQueryTime := time.Duration(maxExecTime * float64(time.Millisecond))
+		if item, ok := itemMap[database][fingerprint]; ok {
+			item.Count += calls
+			item.TotalQueryTime = durationpb.New(item.TotalQueryTime.AsDuration() + totalQueryTime)
+			if item.MaximumQueryTime.AsDuration() < maximumQueryTime {
+				item.MaximumQueryTime = durationpb.New(maximumQueryTime)
+			}
+			item.TotalRowsSent += rows
+			continue
+		}
+		item := &storepb.SlowQueryStatisticsItem{
 			SqlFingerprint:   fingerprint,
 			Count:            calls,
 			LatestLogTime:    timestamppb.New(now.UTC()),
-			TotalQueryTime:   durationpb.New(time.Duration(totalExecTime * float64(time.Millisecond))),
-			MaximumQueryTime: durationpb.New(time.Duration(maxExecTime * float64(time.Millisecond))),
+			TotalQueryTime:   durationpb.New(totalQueryTime),
+			MaximumQueryTime: durationpb.New(maximumQueryTime),
 			TotalRowsSent:    rows,
 		}
+		if itemSamples := samples[fingerprint]; len(itemSamples) > db.SlowQueryMaxSamplePerFingerprint {
+			item.Samples = itemSamples[len(itemSamples)-db.SlowQueryMaxSamplePerFingerprint:]
+		} else {
+			item.Samples = itemSamples
+		}
+		itemMap[database][fingerprint] = item
 		if statistics, exists := result[database]; exists {
-			statistics.Items = append(statistics.Items, &item)
+			statistics.Items = append(statistics.Items, item)
 		} else {
 			result[database] = &storepb.SlowQueryStatistics{
-				Items: []*storepb.SlowQueryStatisticsItem{&item},
+				Items: []*storepb.SlowQueryStatisticsItem{item},
 			}
 		}
 	}
@@ -42,3 +42,28 @@ func TestIsAtLeastPG10(t *testing.T) {
 		require.Equal(t, test.want, got)
 	}
 }
+
+func TestParseIndexParameters(t *testing.T) {
+	tests := []struct {
+		reloptions string
+		want       map[string]string
+	}{
+		{
+			"m=16,ef_construction=64",
+			map[string]string{"m": "16", "ef_construction": "64"},
+		},
+		{
+			"lists=100",
+			map[string]string{"lists": "100"},
+		},
+		{
+			"invalid",
+			map[string]string{},
+		},
+	}
+
+	for _, test := range tests {
+		got := parseIndexParameters(test.reloptions)
+		require.Equal(t, test.want, got)
+	}
+}
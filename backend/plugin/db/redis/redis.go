@@ -15,13 +15,14 @@ import (
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 	"go.uber.org/multierr"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	redisparser "github.com/bytebase/bytebase/backend/plugin/parser/redis"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -37,7 +38,7 @@ func init() {
 // Driver is the redis driver.
 type Driver struct {
 	rdb                  redis.UniversalClient
-	sshClient            *ssh.Client
+	sshTunnel            *util.SSHTunnel
 	databaseName         string
 	maximumSQLResultSize int64
 }
@@ -71,14 +72,14 @@ func (d *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionC
 			DB:        db,
 		}
 		if config.SSHConfig.Host != "" {
-			sshClient, err := util.GetSSHClient(config.SSHConfig)
+			sshTunnel, err := util.NewSSHTunnel(config.SSHConfig)
 			if err != nil {
 				return nil, err
 			}
-			d.sshClient = sshClient
+			d.sshTunnel = sshTunnel
 
 			options.Dialer = func(_ context.Context, network, addr string) (net.Conn, error) {
-				conn, err := sshClient.Dial(network, addr)
+				conn, err := sshTunnel.Dial(network, addr)
 				if err != nil {
 					return nil, err
 				}
@@ -105,14 +106,14 @@ func (d *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionC
 			TLSConfig:        tlsConfig,
 		}
 		if config.SSHConfig.Host != "" {
-			sshClient, err := util.GetSSHClient(config.SSHConfig)
+			sshTunnel, err := util.NewSSHTunnel(config.SSHConfig)
 			if err != nil {
 				return nil, err
 			}
-			d.sshClient = sshClient
+			d.sshTunnel = sshTunnel
 
 			options.Dialer = func(_ context.Context, network, addr string) (net.Conn, error) {
-				conn, err := sshClient.Dial(network, addr)
+				conn, err := sshTunnel.Dial(network, addr)
 				if err != nil {
 					return nil, err
 				}
@@ -135,14 +136,14 @@ func (d *Driver) Open(_ context.Context, _ storepb.Engine, config db.ConnectionC
 			TLSConfig: tlsConfig,
 		}
 		if config.SSHConfig.Host != "" {
-			sshClient, err := util.GetSSHClient(config.SSHConfig)
+			sshTunnel, err := util.NewSSHTunnel(config.SSHConfig)
 			if err != nil {
 				return nil, err
 			}
-			d.sshClient = sshClient
+			d.sshTunnel = sshTunnel
 
 			options.Dialer = func(_ context.Context, network, addr string) (net.Conn, error) {
-				conn, err := sshClient.Dial(network, addr)
+				conn, err := sshTunnel.Dial(network, addr)
 				if err != nil {
 					return nil, err
 				}
@@ -170,8 +171,8 @@ func (*noDeadlineConn) SetWriteDeadline(time.Time) error { return nil }
 func (d *Driver) Close(context.Context) error {
 	var err error
 	err = multierr.Append(err, d.rdb.Close())
-	if d.sshClient != nil {
-		err = multierr.Append(err, d.sshClient.Close())
+	if d.sshTunnel != nil {
+		err = multierr.Append(err, d.sshTunnel.Close())
 	}
 	return err
 }
@@ -188,30 +189,57 @@ func (*Driver) GetDB() *sql.DB {
 
 // Execute will execute the statement. For CREATE DATABASE statement, some types of databases such as Postgres
 // will not use transactions to execute the statement but will still use transactions to execute the rest of statements.
+// Redis commands are executed one by one, and the execution stops at the first failed command.
 func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteOptions) (int64, error) {
 	if opts.CreateDatabase {
 		return 0, errors.New("redis: cannot create database")
 	}
 
-	lines := strings.Split(statement, "\n")
-	if _, err := d.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
-		for _, line := range lines {
-			fields, err := shlex.Split(line)
-			if err != nil {
-				return errors.Wrapf(err, "failed to split command %s", line)
-			}
-			if len(fields) == 0 {
-				continue
-			}
-			var input []any
-			for _, v := range fields {
-				input = append(input, v)
+	commands, err := redisparser.SplitSQL(statement)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to split commands")
+	}
+	nonEmptyCommands, idxMap := base.FilterEmptySQLWithIndexes(commands)
+	for currentIndex, command := range nonEmptyCommands {
+		fields, err := shlex.Split(command.Text)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to split command %s", command.Text)
+		}
+		if opts.UpdateExecutionStatus != nil {
+			opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(nonEmptyCommands)),
+				CommandsCompleted: int32(currentIndex),
+				CommandStartPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(command.FirstStatementLine),
+					Column: int32(command.FirstStatementColumn),
+				},
+				CommandEndPosition: &v1pb.TaskRun_ExecutionDetail_Position{
+					Line:   int32(command.LastLine),
+					Column: int32(command.LastColumn),
+				},
+			})
+		}
+		indexes := []int32{int32(idxMap[currentIndex])}
+		opts.LogCommandExecute(indexes)
+		var input []any
+		for _, v := range fields {
+			input = append(input, v)
+		}
+		if err := d.rdb.Do(ctx, input...).Err(); err != nil && err != redis.Nil {
+			opts.LogCommandResponse(indexes, 0, []int32{0}, err.Error())
+			return 0, &db.ErrorWithPosition{
+				Err: errors.Wrapf(err, "failed to execute command %q", strings.TrimSpace(command.Text)),
+				Start: &storepb.TaskRunResult_Position{
+					Line:   int32(command.FirstStatementLine),
+					Column: int32(command.FirstStatementColumn),
+				},
+				End: &storepb.TaskRunResult_Position{
+					Line:   int32(command.LastLine),
+					Column: int32(command.LastColumn),
+				},
 			}
-			_ = p.Do(ctx, input...)
 		}
-		return nil
-	}); err != nil && err != redis.Nil {
-		return 0, err
+		opts.LogCommandResponse(indexes, 0, []int32{0}, "")
 	}
 
 	return 0, nil
@@ -2,35 +2,152 @@ package redis
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // Role
+// The roles of Redis are the ACL users, and the role attribute is the ACL rules of the user, e.g. "on ~app:* +@read".
+// Docs: https://redis.io/docs/latest/operate/oss_and_stack/management/security/acl/.
 
 // CreateRole creates the role.
-func (*Driver) CreateRole(context.Context, *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.New("redis: not supported")
+func (d *Driver) CreateRole(ctx context.Context, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	role, err := d.FindRole(ctx, upsert.Name)
+	if err == nil && role != nil {
+		return nil, errors.Errorf("redis: user %q already exists", upsert.Name)
+	}
+	if err := d.setUser(ctx, upsert.Name, upsert, true /* reset */); err != nil {
+		return nil, err
+	}
+	return d.FindRole(ctx, upsert.Name)
 }
 
 // UpdateRole updates the role.
-func (*Driver) UpdateRole(context.Context, string, *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.New("redis: not supported")
+func (d *Driver) UpdateRole(ctx context.Context, roleName string, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	if upsert.Name != "" && upsert.Name != roleName {
+		return nil, errors.New("redis: cannot rename the user")
+	}
+	if _, err := d.FindRole(ctx, roleName); err != nil {
+		return nil, err
+	}
+	// The rules replace the existing rules of the user if the attribute is specified.
+	if err := d.setUser(ctx, roleName, upsert, upsert.Attribute != nil /* reset */); err != nil {
+		return nil, err
+	}
+	return d.FindRole(ctx, roleName)
 }
 
 // FindRole finds the role by name.
-func (*Driver) FindRole(context.Context, string) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.New("redis: not supported")
+func (d *Driver) FindRole(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, error) {
+	roles, err := d.ListRole(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.Name == roleName {
+			return role, nil
+		}
+	}
+	return nil, errors.Errorf("redis: user %q not found", roleName)
 }
 
 // ListRole lists the role.
-func (*Driver) ListRole(context.Context) ([]*db.DatabaseRoleMessage, error) {
-	return nil, errors.New("redis: not supported")
+func (d *Driver) ListRole(ctx context.Context) ([]*db.DatabaseRoleMessage, error) {
+	rules, err := d.rdb.Do(ctx, "ACL", "LIST").StringSlice()
+	if err != nil {
+		return nil, errors.Wrapf(err, "redis: failed to list users")
+	}
+	var roles []*db.DatabaseRoleMessage
+	for _, rule := range rules {
+		name, attribute, err := parseACLRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, &db.DatabaseRoleMessage{
+			Name:      name,
+			Attribute: &attribute,
+		})
+	}
+	return roles, nil
 }
 
 // DeleteRole deletes the role by name.
-func (*Driver) DeleteRole(context.Context, string) error {
-	return errors.New("redis: not supported")
+func (d *Driver) DeleteRole(ctx context.Context, roleName string) error {
+	if roleName == "default" {
+		return errors.New("redis: cannot delete the default user")
+	}
+	if err := d.rdb.Do(ctx, "ACL", "DELUSER", roleName).Err(); err != nil {
+		return errors.Wrapf(err, "redis: failed to delete user %q", roleName)
+	}
+	return nil
+}
+
+func (d *Driver) setUser(ctx context.Context, name string, upsert *db.DatabaseRoleUpsertMessage, reset bool) error {
+	args := []any{"ACL", "SETUSER", name}
+	if reset {
+		args = append(args, "reset")
+	}
+	if upsert.Password != nil {
+		args = append(args, "resetpass", fmt.Sprintf(">%s", *upsert.Password))
+	}
+	if upsert.Attribute != nil {
+		for _, rule := range strings.Fields(*upsert.Attribute) {
+			args = append(args, rule)
+		}
+	}
+	if err := d.rdb.Do(ctx, args...).Err(); err != nil {
+		return errors.Wrapf(err, "redis: failed to set user %q", name)
+	}
+	return nil
+}
+
+// getInstanceRoles gets the ACL users of the instance.
+// ACL is available since Redis 6, and the cloud vendors may disable the command, so no roles are returned in that case.
+func (d *Driver) getInstanceRoles(ctx context.Context) ([]*storepb.InstanceRole, error) {
+	roles, err := d.ListRole(ctx)
+	if err != nil {
+		if isACLUnavailable(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var instanceRoles []*storepb.InstanceRole
+	for _, role := range roles {
+		instanceRoles = append(instanceRoles, &storepb.InstanceRole{
+			Name:      role.Name,
+			Attribute: role.Attribute,
+		})
+	}
+	return instanceRoles, nil
+}
+
+// parseACLRule parses the rule returned by ACL LIST, e.g. "user alice on #<sha256> ~app:* resetchannels -@all +@read".
+// The password hashes are dropped from the attribute.
+func parseACLRule(rule string) (string, string, error) {
+	fields := strings.Fields(rule)
+	if len(fields) < 2 || fields[0] != "user" {
+		return "", "", errors.Errorf("redis: invalid ACL rule %q", rule)
+	}
+	var attributes []string
+	for _, field := range fields[2:] {
+		if strings.HasPrefix(field, "#") || strings.HasPrefix(field, ">") {
+			continue
+		}
+		attributes = append(attributes, field)
+	}
+	return fields[1], strings.Join(attributes, " "), nil
+}
+
+func isACLUnavailable(err error) bool {
+	if err == redis.Nil {
+		return true
+	}
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "unknown command") || strings.Contains(message, "noperm")
 }
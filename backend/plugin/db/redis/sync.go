@@ -49,6 +49,14 @@ func (d *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error)
 	}
 	instance.Databases = databases
 
+	instanceRoles, err := d.getInstanceRoles(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get users")
+	}
+	instance.Metadata = &storepb.InstanceMetadata{
+		Roles: instanceRoles,
+	}
+
 	return &instance, nil
 }
 
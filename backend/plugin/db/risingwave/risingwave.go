@@ -17,7 +17,6 @@ import (
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -47,7 +46,7 @@ type Driver struct {
 	config   db.ConnectionConfig
 
 	db        *sql.DB
-	sshClient *ssh.Client
+	sshTunnel *util.SSHTunnel
 	// connectionString is the connection string registered by pgx.
 	// Unregister connectionString if we don't need it.
 	connectionString string
@@ -97,14 +96,14 @@ func (driver *Driver) Open(_ context.Context, _ storepb.Engine, config db.Connec
 		connConfig.TLSConfig = cfg
 	}
 	if config.SSHConfig.Host != "" {
-		sshClient, err := util.GetSSHClient(config.SSHConfig)
+		sshTunnel, err := util.NewSSHTunnel(config.SSHConfig)
 		if err != nil {
 			return nil, err
 		}
-		driver.sshClient = sshClient
+		driver.sshTunnel = sshTunnel
 
 		connConfig.Config.DialFunc = func(_ context.Context, network, addr string) (net.Conn, error) {
-			conn, err := sshClient.Dial(network, addr)
+			conn, err := sshTunnel.Dial(network, addr)
 			if err != nil {
 				return nil, err
 			}
@@ -171,8 +170,8 @@ func (driver *Driver) Close(context.Context) error {
 	stdlib.UnregisterConnConfig(driver.connectionString)
 	var err error
 	err = multierr.Append(err, driver.db.Close())
-	if driver.sshClient != nil {
-		err = multierr.Append(err, driver.sshClient.Close())
+	if driver.sshTunnel != nil {
+		err = multierr.Append(err, driver.sshTunnel.Close())
 	}
 	return err
 }
@@ -0,0 +1,94 @@
+package snowflake
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+)
+
+// Grant is a privilege granted to a role, or a role granted to a user.
+type Grant struct {
+	// Privilege is the privilege, e.g. SELECT, or USAGE for the role granted to another role.
+	Privilege string
+	// GrantedOn is the object type, e.g. TABLE, or ROLE for the role granted to another role.
+	GrantedOn string
+	// Name is the fully qualified name of the object.
+	Name        string
+	GrantOption bool
+}
+
+// ListGrantsToRole lists the privileges and roles granted to the role.
+// Docs: https://docs.snowflake.com/en/sql-reference/sql/show-grants.
+func (driver *Driver) ListGrantsToRole(ctx context.Context, role string) ([]*Grant, error) {
+	query := fmt.Sprintf("SHOW GRANTS TO ROLE %s", quoteIdentifier(role))
+	rows, err := queryShowRows(ctx, driver.db, query)
+	if err != nil {
+		return nil, err
+	}
+	var grants []*Grant
+	for _, row := range rows {
+		grants = append(grants, &Grant{
+			Privilege:   row["privilege"],
+			GrantedOn:   row["granted_on"],
+			Name:        row["name"],
+			GrantOption: strings.EqualFold(row["grant_option"], "true"),
+		})
+	}
+	return grants, nil
+}
+
+// ListRolesGrantedToUser lists the roles granted to the user.
+func (driver *Driver) ListRolesGrantedToUser(ctx context.Context, user string) ([]string, error) {
+	query := fmt.Sprintf("SHOW GRANTS TO USER %s", quoteIdentifier(user))
+	rows, err := queryShowRows(ctx, driver.db, query)
+	if err != nil {
+		return nil, err
+	}
+	var roles []string
+	for _, row := range rows {
+		roles = append(roles, row["role"])
+	}
+	return roles, nil
+}
+
+// queryShowRows runs the SHOW command and returns the rows keyed by the column names,
+// since the columns of the SHOW commands vary between the grantee types and the Snowflake versions.
+func queryShowRows(ctx context.Context, db *sql.DB, query string) ([]map[string]string, error) {
+	rows, err := db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result []map[string]string
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := make(map[string]string)
+		for i, column := range columns {
+			row[strings.ToLower(column)] = values[i].String
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return result, nil
+}
+
+// quoteIdentifier quotes the normalized identifier, where the unquoted identifiers are upper case.
+func quoteIdentifier(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
+}
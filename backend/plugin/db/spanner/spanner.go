@@ -5,10 +5,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 	"time"
 
+	"cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"cloud.google.com/go/spanner"
 	spannerdb "cloud.google.com/go/spanner/admin/database/apiv1"
 	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
@@ -17,6 +19,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -34,6 +37,14 @@ var (
 	_ db.Driver = (*Driver)(nil)
 )
 
+const (
+	// maxDDLBatchSize is the max number of the DDL statements in a schema update operation.
+	// Spanner recommends batching the schema updates, because the validation of each operation may backfill or scan the data.
+	maxDDLBatchSize = 100
+	// ddlOperationPollInterval is the interval to poll the schema update operation.
+	ddlOperationPollInterval = 5 * time.Second
+)
+
 func init() {
 	db.Register(storepb.Engine_SPANNER, newDriver)
 }
@@ -132,35 +143,130 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 		return 0, nil
 	}
 
-	var rowCount int64
 	stmts, err := util.SanitizeSQL(statement)
 	if err != nil {
 		return 0, err
 	}
 
-	ddl := func() bool {
-		for _, stmt := range stmts {
-			if util.IsDDL(stmt) {
-				return true
+	// The consecutive DDL statements are submitted as one schema update operation, and the consecutive DML statements are run in one transaction.
+	var rowCount int64
+	for _, batch := range splitStatementBatches(stmts) {
+		if batch.ddl {
+			if err := d.updateDatabaseDDL(ctx, stmts, batch, opts); err != nil {
+				return 0, err
 			}
+			continue
 		}
-		return false
-	}()
-
-	if ddl {
-		op, err := d.dbClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
-			Database:   getDSN(d.config.Host, d.databaseName),
-			Statements: stmts,
-		})
+		count, err := d.batchUpdate(ctx, stmts, batch, opts)
 		if err != nil {
 			return 0, err
 		}
-		return 0, op.Wait(ctx)
+		rowCount += count
 	}
+	return rowCount, nil
+}
 
+// statementBatch is the statements [start, end) of the same kind.
+type statementBatch struct {
+	start int
+	end   int
+	ddl   bool
+}
+
+// splitStatementBatches splits the statements into the batches of the consecutive DDL or DML statements.
+// The DDL batch has at most maxDDLBatchSize statements.
+func splitStatementBatches(stmts []string) []statementBatch {
+	var batches []statementBatch
+	for i, stmt := range stmts {
+		ddl := util.IsDDL(stmt)
+		if len(batches) > 0 {
+			last := &batches[len(batches)-1]
+			if last.ddl == ddl && (!ddl || last.end-last.start < maxDDLBatchSize) {
+				last.end = i + 1
+				continue
+			}
+		}
+		batches = append(batches, statementBatch{start: i, end: i + 1, ddl: ddl})
+	}
+	return batches
+}
+
+// updateDatabaseDDL submits the DDL statements as a schema update operation and polls it until it's done.
+// The statements are applied one by one, so the progress is reported by the commit timestamps of the applied statements.
+// The operation is cancelled if the context is canceled, and the statements applied before remain.
+// Docs: https://cloud.google.com/spanner/docs/schema-updates#large-updates.
+func (d *Driver) updateDatabaseDDL(ctx context.Context, stmts []string, batch statementBatch, opts db.ExecuteOptions) error {
+	indexes := batchIndexes(batch)
+	opts.LogCommandExecute(indexes)
+	op, err := d.dbClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+		Database:   getDSN(d.config.Host, d.databaseName),
+		Statements: stmts[batch.start:batch.end],
+	})
+	if err != nil {
+		opts.LogCommandResponse(indexes, 0, nil, err.Error())
+		return err
+	}
+
+	completed := 0
+	for {
+		pollErr := op.Poll(ctx)
+		if metadata, err := op.Metadata(); err == nil && metadata != nil {
+			completed = len(metadata.CommitTimestamps)
+		}
+		if pollErr != nil {
+			if ctx.Err() != nil {
+				d.cancelOperation(ctx, op.Name())
+				opts.LogCommandResponse(indexes, 0, nil, ctx.Err().Error())
+				return ctx.Err()
+			}
+			opts.LogCommandResponse(indexes, 0, nil, pollErr.Error())
+			failed := min(batch.start+completed, batch.end-1)
+			return errors.Wrapf(pollErr, "failed to execute statement %q, %d statements applied", stmts[failed], batch.start+completed)
+		}
+		if op.Done() {
+			break
+		}
+		if opts.UpdateExecutionStatus != nil {
+			opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+				CommandsTotal:     int32(len(stmts)),
+				CommandsCompleted: int32(batch.start + completed),
+			})
+		}
+		select {
+		case <-ctx.Done():
+			d.cancelOperation(ctx, op.Name())
+			opts.LogCommandResponse(indexes, 0, nil, ctx.Err().Error())
+			return ctx.Err()
+		case <-time.After(ddlOperationPollInterval):
+		}
+	}
+	opts.LogCommandResponse(indexes, 0, make([]int32, len(indexes)), "")
+	return nil
+}
+
+// cancelOperation cancels the schema update operation, the statements being applied are rolled back.
+func (d *Driver) cancelOperation(ctx context.Context, name string) {
+	if err := d.dbClient.CancelOperation(context.WithoutCancel(ctx), &longrunningpb.CancelOperationRequest{Name: name}); err != nil {
+		slog.Warn("failed to cancel the spanner schema update operation", slog.String("operation", name), log.BBError(err))
+	}
+}
+
+// batchUpdate runs the DML statements in a read-write transaction.
+func (d *Driver) batchUpdate(ctx context.Context, stmts []string, batch statementBatch, opts db.ExecuteOptions) (int64, error) {
+	indexes := batchIndexes(batch)
+	if opts.UpdateExecutionStatus != nil {
+		opts.UpdateExecutionStatus(&v1pb.TaskRun_ExecutionDetail{
+			CommandsTotal:     int32(len(stmts)),
+			CommandsCompleted: int32(batch.start),
+		})
+	}
+	opts.LogCommandExecute(indexes)
+	var rowCount int64
+	var allAffectedRows []int32
 	if _, err := d.client.ReadWriteTransaction(ctx, func(ctx context.Context, rwt *spanner.ReadWriteTransaction) error {
+		rowCount, allAffectedRows = 0, nil
 		spannerStmts := []spanner.Statement{}
-		for _, stmt := range stmts {
+		for _, stmt := range stmts[batch.start:batch.end] {
 			spannerStmts = append(spannerStmts, spanner.NewStatement(stmt))
 		}
 		counts, err := rwt.BatchUpdate(ctx, spannerStmts)
@@ -169,14 +275,25 @@ func (d *Driver) Execute(ctx context.Context, statement string, opts db.ExecuteO
 		}
 		for _, count := range counts {
 			rowCount += count
+			allAffectedRows = append(allAffectedRows, int32(count))
 		}
 		return nil
 	}); err != nil {
+		opts.LogCommandResponse(indexes, 0, nil, err.Error())
 		return 0, err
 	}
+	opts.LogCommandResponse(indexes, int32(rowCount), allAffectedRows, "")
 	return rowCount, nil
 }
 
+func batchIndexes(batch statementBatch) []int32 {
+	var indexes []int32
+	for i := batch.start; i < batch.end; i++ {
+		indexes = append(indexes, int32(i))
+	}
+	return indexes
+}
+
 func (d *Driver) creataDatabase(ctx context.Context, createStatement string, extraStatement []string) error {
 	op, err := d.dbClient.CreateDatabase(ctx, &databasepb.CreateDatabaseRequest{
 		Parent:          d.config.Host,
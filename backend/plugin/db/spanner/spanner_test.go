@@ -77,3 +77,28 @@ func TestGetColumnTypeName(t *testing.T) {
 		a.Equal(tests[i].want, got)
 	}
 }
+
+func TestSplitStatementBatches(t *testing.T) {
+	a := require.New(t)
+	stmts := []string{
+		"CREATE TABLE t1 (id INT64) PRIMARY KEY (id)",
+		"CREATE INDEX idx1 ON t1 (id)",
+		"INSERT INTO t1 (id) VALUES (1)",
+		"UPDATE t1 SET id = 2 WHERE id = 1",
+		"ALTER TABLE t1 ADD COLUMN name STRING(MAX)",
+	}
+	a.Equal([]statementBatch{
+		{start: 0, end: 2, ddl: true},
+		{start: 2, end: 4, ddl: false},
+		{start: 4, end: 5, ddl: true},
+	}, splitStatementBatches(stmts))
+
+	var ddls []string
+	for i := 0; i < maxDDLBatchSize+1; i++ {
+		ddls = append(ddls, "CREATE INDEX idx ON t1 (id)")
+	}
+	a.Equal([]statementBatch{
+		{start: 0, end: maxDDLBatchSize, ddl: true},
+		{start: maxDDLBatchSize, end: maxDDLBatchSize + 1, ddl: true},
+	}, splitStatementBatches(ddls))
+}
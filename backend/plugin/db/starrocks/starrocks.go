@@ -15,7 +15,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -37,7 +36,6 @@ var (
 
 func init() {
 	db.Register(storepb.Engine_STARROCKS, newDriver)
-	db.Register(storepb.Engine_DORIS, newDriver)
 }
 
 // Driver is the MySQL driver.
@@ -48,7 +46,7 @@ type Driver struct {
 	dbBinDir      string
 	db            *sql.DB
 	databaseName  string
-	sshClient     *ssh.Client
+	sshTunnel     *util.SSHTunnel
 
 	// Called upon driver.Open() finishes.
 	openCleanUp []func()
@@ -74,14 +72,14 @@ func (driver *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.
 	}
 	params := []string{"multiStatements=true", "maxAllowedPacket=0"}
 	if connCfg.SSHConfig.Host != "" {
-		sshClient, err := util.GetSSHClient(connCfg.SSHConfig)
+		sshTunnel, err := util.NewSSHTunnel(connCfg.SSHConfig)
 		if err != nil {
 			return nil, err
 		}
-		driver.sshClient = sshClient
+		driver.sshTunnel = sshTunnel
 		// Now we register the dialer with the ssh connection as a parameter.
 		mysql.RegisterDialContext("mysql+tcp", func(_ context.Context, addr string) (net.Conn, error) {
-			return sshClient.Dial("tcp", addr)
+			return sshTunnel.Dial("tcp", addr)
 		})
 		protocol = "mysql+tcp"
 	}
@@ -123,8 +121,8 @@ func (driver *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.
 func (driver *Driver) Close(context.Context) error {
 	var err error
 	err = multierr.Append(err, driver.db.Close())
-	if driver.sshClient != nil {
-		err = multierr.Append(err, driver.sshClient.Close())
+	if driver.sshTunnel != nil {
+		err = multierr.Append(err, driver.sshTunnel.Close())
 	}
 	return err
 }
@@ -86,10 +86,6 @@ func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, e
 		); err != nil {
 			return nil, err
 		}
-		if driver.dbType == storepb.Engine_DORIS {
-			database.CharacterSet = ""
-			database.Collation = ""
-		}
 		databases = append(databases, database)
 	}
 	if err := rows.Err(); err != nil {
@@ -315,12 +311,6 @@ func (driver *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchema
 		}
 		return nil, err
 	}
-	// "characterSet":"utf8\u0000", "collation":"utf8_general_ci\u0000".
-	// ERROR: unsupported Unicode escape sequence (SQLSTATE 22P05).
-	if driver.dbType == storepb.Engine_DORIS {
-		databaseMetadata.CharacterSet = ""
-		databaseMetadata.Collation = ""
-	}
 
 	return databaseMetadata, err
 }
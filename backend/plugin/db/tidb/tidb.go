@@ -16,7 +16,6 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
-	"golang.org/x/crypto/ssh"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -51,7 +50,7 @@ type Driver struct {
 	dbBinDir      string
 	db            *sql.DB
 	databaseName  string
-	sshClient     *ssh.Client
+	sshTunnel     *util.SSHTunnel
 
 	// Called upon driver.Open() finishes.
 	openCleanUp []func()
@@ -77,14 +76,14 @@ func (d *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.Conne
 	}
 	params := []string{"multiStatements=true", "maxAllowedPacket=0"}
 	if connCfg.SSHConfig.Host != "" {
-		sshClient, err := util.GetSSHClient(connCfg.SSHConfig)
+		sshTunnel, err := util.NewSSHTunnel(connCfg.SSHConfig)
 		if err != nil {
 			return nil, err
 		}
-		d.sshClient = sshClient
+		d.sshTunnel = sshTunnel
 		// Now we register the dialer with the ssh connection as a parameter.
 		mysql.RegisterDialContext("mysql+tcp", func(_ context.Context, addr string) (net.Conn, error) {
-			return sshClient.Dial("tcp", addr)
+			return sshTunnel.Dial("tcp", addr)
 		})
 		protocol = "mysql+tcp"
 	}
@@ -126,8 +125,8 @@ func (d *Driver) Open(_ context.Context, dbType storepb.Engine, connCfg db.Conne
 func (d *Driver) Close(context.Context) error {
 	var err error
 	err = multierr.Append(err, d.db.Close())
-	if d.sshClient != nil {
-		err = multierr.Append(err, d.sshClient.Close())
+	if d.sshTunnel != nil {
+		err = multierr.Append(err, d.sshTunnel.Close())
 	}
 	return err
 }
@@ -0,0 +1,62 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/feature/rds/auth"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+// rdsRoleSessionName is the session name used when assuming the IAM role for AWS RDS IAM authentication.
+const rdsRoleSessionName = "bytebase"
+
+// rdsRoleCredentials caches the assumed role credentials so that they are reused until expiration.
+var rdsRoleCredentials sync.Map // map[roleARN/region]*aws.CredentialsCache
+
+// GetRDSAuthToken generates an authentication token for AWS RDS IAM authentication.
+// If the AWS role ARN is set, the role is assumed with the default AWS credentials to sign the token.
+//
+// The token is only valid for 15 minutes, so a new token should be generated for each new connection.
+// https://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/UsingWithRDS.IAMDBAuth.Connecting.Go.html
+func GetRDSAuthToken(ctx context.Context, connCfg db.ConnectionConfig) (string, error) {
+	var optFns []func(*config.LoadOptions) error
+	if connCfg.Region != "" {
+		optFns = append(optFns, config.WithRegion(connCfg.Region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return "", errors.Wrap(err, "load aws config failed")
+	}
+
+	credentials := cfg.Credentials
+	if connCfg.AWSRoleARN != "" {
+		key := fmt.Sprintf("%s/%s", connCfg.AWSRoleARN, connCfg.Region)
+		v, ok := rdsRoleCredentials.Load(key)
+		if !ok {
+			provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), connCfg.AWSRoleARN, func(o *stscreds.AssumeRoleOptions) {
+				o.RoleSessionName = rdsRoleSessionName
+			})
+			v, _ = rdsRoleCredentials.LoadOrStore(key, aws.NewCredentialsCache(provider))
+		}
+		cache, ok := v.(*aws.CredentialsCache)
+		if !ok {
+			return "", errors.Errorf("unexpected credentials cache type %T", v)
+		}
+		credentials = cache
+	}
+
+	dbEndpoint := fmt.Sprintf("%s:%s", connCfg.Host, connCfg.Port)
+	authenticationToken, err := auth.BuildAuthToken(ctx, dbEndpoint, connCfg.Region, connCfg.Username, credentials)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create authentication token")
+	}
+	return authenticationToken, nil
+}
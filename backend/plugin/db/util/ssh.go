@@ -1,12 +1,16 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log/slog"
 	"net"
 	"os"
+	"sync"
+	"time"
 
+	"github.com/pkg/errors"
 	"golang.org/x/crypto/ssh"
 	"golang.org/x/crypto/ssh/agent"
 
@@ -54,8 +58,182 @@ func GetSSHClient(cfg db.SSHConfig) (*ssh.Client, error) {
 	return sshConn, nil
 }
 
-// ProxyConnection proxies the connection between ssh client and listener.
-func ProxyConnection(sshClient *ssh.Client, listener net.Listener, databaseAddr string) {
+// sshKeepAliveInterval is the interval to check the health of SSH tunnels.
+const sshKeepAliveInterval = 30 * time.Second
+
+// Dialer dials connections to the target address, e.g. through a SSH tunnel.
+type Dialer interface {
+	Dial(network, addr string) (net.Conn, error)
+}
+
+// SSHTunnel is a SSH tunnel through a bastion host for TCP-based drivers.
+// It sends keepalive requests periodically and reconnects the dropped SSH connection.
+type SSHTunnel struct {
+	cfg db.SSHConfig
+
+	mu        sync.Mutex
+	client    *ssh.Client
+	listeners []net.Listener
+	closed    bool
+	done      chan struct{}
+}
+
+// NewSSHTunnel connects to the bastion host and returns a SSH tunnel.
+// Upon successful return, caller must call Close().
+func NewSSHTunnel(cfg db.SSHConfig) (*SSHTunnel, error) {
+	client, err := GetSSHClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	t := &SSHTunnel{
+		cfg:    cfg,
+		client: client,
+		done:   make(chan struct{}),
+	}
+	go t.keepAlive()
+	return t, nil
+}
+
+// Dial dials the address through the tunnel.
+// If the SSH connection is dropped, it reconnects and dials again.
+func (t *SSHTunnel) Dial(network, addr string) (net.Conn, error) {
+	client, err := t.getClient()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.Dial(network, addr)
+	if err == nil {
+		return conn, nil
+	}
+	if healthErr := checkSSHClient(client); healthErr == nil {
+		return nil, err
+	}
+	client, err = t.reconnect(client)
+	if err != nil {
+		return nil, err
+	}
+	return client.Dial(network, addr)
+}
+
+// DialContext dials the address through the tunnel.
+func (t *SSHTunnel) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		conn, err := t.Dial(network, addr)
+		ch <- result{conn: conn, err: err}
+	}()
+	select {
+	case <-ctx.Done():
+		go func() {
+			if r := <-ch; r.conn != nil {
+				r.conn.Close()
+			}
+		}()
+		return nil, ctx.Err()
+	case r := <-ch:
+		return r.conn, r.err
+	}
+}
+
+// Forward listens on a local port and forwards the connections to the address through the tunnel.
+// It returns the local address, which is valid until the tunnel is closed.
+// It is used by the drivers and the tools which cannot use a custom dialer.
+func (t *SSHTunnel) Forward(addr string) (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to listen on local port")
+	}
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		listener.Close()
+		return "", errors.New("ssh tunnel is closed")
+	}
+	t.listeners = append(t.listeners, listener)
+	t.mu.Unlock()
+	go ProxyConnection(t, listener, addr)
+	return listener.Addr().String(), nil
+}
+
+// Close closes the tunnel and all its forwarded listeners.
+func (t *SSHTunnel) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil
+	}
+	t.closed = true
+	close(t.done)
+	for _, listener := range t.listeners {
+		_ = listener.Close()
+	}
+	return t.client.Close()
+}
+
+func (t *SSHTunnel) getClient() (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil, errors.New("ssh tunnel is closed")
+	}
+	return t.client, nil
+}
+
+// reconnect replaces the broken client with a new one.
+// It's a no-op if the client has been replaced by others.
+func (t *SSHTunnel) reconnect(broken *ssh.Client) (*ssh.Client, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.closed {
+		return nil, errors.New("ssh tunnel is closed")
+	}
+	if t.client != broken {
+		return t.client, nil
+	}
+	client, err := GetSSHClient(t.cfg)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to reconnect ssh tunnel to %s:%s", t.cfg.Host, t.cfg.Port)
+	}
+	_ = broken.Close()
+	t.client = client
+	slog.Debug("ssh tunnel reconnected", slog.String("host", t.cfg.Host), slog.String("port", t.cfg.Port))
+	return client, nil
+}
+
+func (t *SSHTunnel) keepAlive() {
+	ticker := time.NewTicker(sshKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.done:
+			return
+		case <-ticker.C:
+			client, err := t.getClient()
+			if err != nil {
+				return
+			}
+			if err := checkSSHClient(client); err != nil {
+				slog.Warn("ssh tunnel is unhealthy, reconnecting", slog.String("host", t.cfg.Host), slog.String("port", t.cfg.Port), log.BBError(err))
+				if _, err := t.reconnect(client); err != nil {
+					slog.Warn("failed to reconnect ssh tunnel", log.BBError(err))
+				}
+			}
+		}
+	}
+}
+
+// checkSSHClient checks the health of the SSH connection by sending a keepalive request.
+func checkSSHClient(client *ssh.Client) error {
+	_, _, err := client.SendRequest("keepalive@openssh.com", true, nil)
+	return err
+}
+
+// ProxyConnection proxies the connections from listener to the database address through the dialer.
+func ProxyConnection(dialer Dialer, listener net.Listener, databaseAddr string) {
 	// Accept incoming connections.
 	for {
 		conn, err := listener.Accept()
@@ -64,9 +242,11 @@ func ProxyConnection(sshClient *ssh.Client, listener net.Listener, databaseAddr
 		}
 
 		// Create a new connection to the target server.
-		targetConn, err := sshClient.Dial("tcp", databaseAddr)
+		targetConn, err := dialer.Dial("tcp", databaseAddr)
 		if err != nil {
-			return
+			slog.Error("proxy dial error", log.BBError(err))
+			conn.Close()
+			continue
 		}
 
 		// Copy data from the incoming connection to the target connection.
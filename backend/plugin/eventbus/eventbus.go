@@ -0,0 +1,41 @@
+// Package eventbus provides the publishers of the event buses, e.g. Kafka, NATS and Google Cloud Pub/Sub.
+package eventbus
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Message is the message to publish.
+type Message struct {
+	// ID is the unique id of the message, it's the same for the redelivery so that the consumers can deduplicate the messages.
+	ID string
+	// Key is the partition key for Kafka and the ordering key for Pub/Sub.
+	Key        string
+	Data       []byte
+	Attributes map[string]string
+}
+
+// Publisher publishes the messages to the event bus.
+type Publisher interface {
+	// Publish publishes the messages and returns after the event bus acknowledges all of them.
+	Publish(ctx context.Context, messages []*Message) error
+	Close() error
+}
+
+// NewPublisher creates a publisher for the destination.
+func NewPublisher(ctx context.Context, destination *storepb.EventBusSetting_Destination, password string) (Publisher, error) {
+	switch destination.Type {
+	case storepb.EventBusSetting_Destination_KAFKA:
+		return newKafkaPublisher(destination, password), nil
+	case storepb.EventBusSetting_Destination_NATS:
+		return newNATSPublisher(destination, password)
+	case storepb.EventBusSetting_Destination_PUBSUB:
+		return newPubSubPublisher(ctx, destination, password)
+	default:
+		return nil, errors.Errorf("unsupported event bus type %s", destination.Type)
+	}
+}
@@ -0,0 +1,65 @@
+package eventbus
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl/plain"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type kafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func newKafkaPublisher(destination *storepb.EventBusSetting_Destination, password string) *kafkaPublisher {
+	transport := &kafka.Transport{}
+	if destination.Username != "" {
+		transport.SASL = plain.Mechanism{
+			Username: destination.Username,
+			Password: password,
+		}
+	}
+	var brokers []string
+	for _, broker := range strings.Split(destination.Address, ",") {
+		if broker = strings.TrimSpace(broker); broker != "" {
+			brokers = append(brokers, broker)
+		}
+	}
+	return &kafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:  kafka.TCP(brokers...),
+			Topic: destination.Topic,
+			// The messages of the same resource go to the same partition to keep their order.
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			Transport:    transport,
+		},
+	}
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, messages []*Message) error {
+	var kafkaMessages []kafka.Message
+	for _, message := range messages {
+		headers := []kafka.Header{{Key: "id", Value: []byte(message.ID)}}
+		for k, v := range message.Attributes {
+			headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+		}
+		kafkaMessages = append(kafkaMessages, kafka.Message{
+			Key:     []byte(message.Key),
+			Value:   message.Data,
+			Headers: headers,
+		})
+	}
+	if err := p.writer.WriteMessages(ctx, kafkaMessages...); err != nil {
+		return errors.Wrapf(err, "failed to write messages to kafka topic %q", p.writer.Topic)
+	}
+	return nil
+}
+
+func (p *kafkaPublisher) Close() error {
+	return p.writer.Close()
+}
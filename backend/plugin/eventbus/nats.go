@@ -0,0 +1,59 @@
+package eventbus
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type natsPublisher struct {
+	conn      *nats.Conn
+	jetStream jetstream.JetStream
+	subject   string
+}
+
+func newNATSPublisher(destination *storepb.EventBusSetting_Destination, password string) (*natsPublisher, error) {
+	options := []nats.Option{nats.Name("bytebase")}
+	if destination.Username != "" {
+		options = append(options, nats.UserInfo(destination.Username, password))
+	} else if password != "" {
+		options = append(options, nats.Token(password))
+	}
+	conn, err := nats.Connect(destination.Address, options...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to nats server %q", destination.Address)
+	}
+	jetStream, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+		return nil, errors.Wrapf(err, "failed to create jetstream context")
+	}
+	return &natsPublisher{
+		conn:      conn,
+		jetStream: jetStream,
+		subject:   destination.Topic,
+	}, nil
+}
+
+func (p *natsPublisher) Publish(ctx context.Context, messages []*Message) error {
+	for _, message := range messages {
+		msg := nats.NewMsg(p.subject)
+		msg.Data = message.Data
+		for k, v := range message.Attributes {
+			msg.Header.Set(k, v)
+		}
+		// JetStream deduplicates the redelivered messages with the same id in the duplicate window of the stream.
+		if _, err := p.jetStream.PublishMsg(ctx, msg, jetstream.WithMsgID(message.ID)); err != nil {
+			return errors.Wrapf(err, "failed to publish message to nats subject %q", p.subject)
+		}
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() error {
+	return p.conn.Drain()
+}
@@ -0,0 +1,56 @@
+package eventbus
+
+import (
+	"context"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type pubSubPublisher struct {
+	client *pubsub.Client
+	topic  *pubsub.Topic
+}
+
+func newPubSubPublisher(ctx context.Context, destination *storepb.EventBusSetting_Destination, serviceAccountKey string) (*pubSubPublisher, error) {
+	client, err := pubsub.NewClient(ctx, destination.Address, option.WithCredentialsJSON([]byte(serviceAccountKey)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create pubsub client for project %q", destination.Address)
+	}
+	topic := client.Topic(destination.Topic)
+	// The messages of the same resource are delivered in order to the subscriptions with message ordering enabled.
+	topic.EnableMessageOrdering = true
+	return &pubSubPublisher{
+		client: client,
+		topic:  topic,
+	}, nil
+}
+
+func (p *pubSubPublisher) Publish(ctx context.Context, messages []*Message) error {
+	var results []*pubsub.PublishResult
+	for _, message := range messages {
+		attributes := map[string]string{"id": message.ID}
+		for k, v := range message.Attributes {
+			attributes[k] = v
+		}
+		results = append(results, p.topic.Publish(ctx, &pubsub.Message{
+			Data:        message.Data,
+			Attributes:  attributes,
+			OrderingKey: message.Key,
+		}))
+	}
+	for _, result := range results {
+		if _, err := result.Get(ctx); err != nil {
+			return errors.Wrapf(err, "failed to publish message to pubsub topic %q", p.topic.ID())
+		}
+	}
+	return nil
+}
+
+func (p *pubSubPublisher) Close() error {
+	p.topic.Stop()
+	return p.client.Close()
+}
@@ -56,8 +56,20 @@ type IdentityProviderConfig struct {
 	// FieldMapping is the mapping of the user attributes returned by the LDAP
 	// server.
 	FieldMapping *storepb.FieldMapping `json:"fieldMapping"`
+	// GroupBaseDN is the base DN to search for groups, e.g.
+	// "ou=groups,dc=example,dc=com". Groups are not searched when it's empty.
+	GroupBaseDN string `json:"groupBaseDn"`
+	// GroupFilter is the filter to search for the groups of a member, the "%s"
+	// is replaced with the member DN, e.g. "(member=%s)".
+	GroupFilter string `json:"groupFilter"`
+	// NestedGroup controls whether to resolve the groups that the user belongs
+	// to through other groups.
+	NestedGroup bool `json:"nestedGroup"`
 }
 
+// maxNestedGroupDepth is the maximum depth to resolve the nested groups.
+const maxNestedGroupDepth = 10
+
 // NewIdentityProvider initializes a new LDAP Identity Provider with the given
 // configuration.
 func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, error) {
@@ -74,6 +86,10 @@ func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, erro
 		}
 	}
 
+	if config.GroupBaseDN != "" && !strings.Contains(config.GroupFilter, "%s") {
+		return nil, errors.Errorf("the field %q must contain %q", "groupFilter", "%s")
+	}
+
 	if config.Port <= 0 {
 		if config.SecurityProtocol == SecurityProtocolLDAPS {
 			config.Port = 636
@@ -168,9 +184,86 @@ func (p *IdentityProvider) Authenticate(username, password string) (*storepb.Ide
 	if identifier == "" {
 		return nil, errors.Errorf("the attribute %q is not found or has empty value", p.config.FieldMapping.Identifier)
 	}
+
+	var groups []string
+	if p.config.GroupBaseDN != "" {
+		// Search the groups as the system account, the user may not have the permission.
+		if err := conn.Bind(p.config.BindDN, p.config.BindPassword); err != nil {
+			return nil, errors.Errorf("bind: %v", err)
+		}
+		groups, err = p.searchGroups(conn, entry.DN)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &storepb.IdentityProviderUserInfo{
 		Identifier:  identifier,
 		DisplayName: entry.GetAttributeValue(p.config.FieldMapping.DisplayName),
 		Email:       entry.GetAttributeValue(p.config.FieldMapping.Email),
+		Groups:      groups,
 	}, nil
 }
+
+// TestConnection verifies the bind DN and bind password, and the group search
+// settings if the group base DN is configured.
+func (p *IdentityProvider) TestConnection() error {
+	conn, err := p.Connect()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close() }()
+
+	if p.config.GroupBaseDN == "" {
+		return nil
+	}
+	if _, err := conn.Search(p.newGroupSearchRequest(p.config.BindDN, 1)); err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return errors.Errorf("search groups: %v", err)
+	}
+	return nil
+}
+
+// searchGroups returns the DN of the groups that the member belongs to. The
+// nested groups are resolved level by level, which works for both OpenLDAP and
+// Active Directory.
+func (p *IdentityProvider) searchGroups(conn *ldap.Conn, memberDN string) ([]string, error) {
+	var groups []string
+	visited := map[string]bool{}
+	members := []string{memberDN}
+	for depth := 0; len(members) > 0 && depth < maxNestedGroupDepth; depth++ {
+		var next []string
+		for _, member := range members {
+			sr, err := conn.Search(p.newGroupSearchRequest(member, 0))
+			if err != nil {
+				return nil, errors.Errorf("search groups of %q: %v", member, err)
+			}
+			for _, entry := range sr.Entries {
+				dn := strings.ToLower(entry.DN)
+				if visited[dn] {
+					continue
+				}
+				visited[dn] = true
+				groups = append(groups, entry.DN)
+				next = append(next, entry.DN)
+			}
+		}
+		if !p.config.NestedGroup {
+			break
+		}
+		members = next
+	}
+	return groups, nil
+}
+
+func (p *IdentityProvider) newGroupSearchRequest(memberDN string, sizeLimit int) *ldap.SearchRequest {
+	return ldap.NewSearchRequest(
+		p.config.GroupBaseDN,
+		ldap.ScopeWholeSubtree,
+		ldap.NeverDerefAliases,
+		sizeLimit,
+		0,
+		false,
+		strings.ReplaceAll(p.config.GroupFilter, "%s", ldap.EscapeFilter(memberDN)),
+		[]string{"dn"},
+		nil,
+	)
+}
@@ -119,6 +119,23 @@ func TestNewIdentityProvider(t *testing.T) {
 			},
 			containsErr: `the field "fieldMapping.identifier" is empty but required`,
 		},
+		{
+			name: "groupFilter without placeholder",
+			config: IdentityProviderConfig{
+				Host:             "ldap.example.com",
+				BindDN:           "uid=system,ou=Users,o=6456a5e9c25dabb51ccad385,dc=example,dc=com",
+				BindPassword:     "pa$$word",
+				BaseDN:           "ou=Users,o=6456a5e9c25dabb51ccad385,dc=example,dc=com",
+				UserFilter:       "(&(objectClass=posixAccount)(uid=%s))",
+				SecurityProtocol: SecurityProtocolStartTLS,
+				FieldMapping: &storepb.FieldMapping{
+					Identifier: "uid",
+				},
+				GroupBaseDN: "ou=Groups,o=6456a5e9c25dabb51ccad385,dc=example,dc=com",
+				GroupFilter: "(objectClass=groupOfNames)",
+			},
+			containsErr: `the field "groupFilter" must contain "%s"`,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
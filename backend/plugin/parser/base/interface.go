@@ -24,6 +24,7 @@ var (
 	spans                   = make(map[storepb.Engine]GetQuerySpanFunc)
 	transformDMLToSelect    = make(map[storepb.Engine]TransformDMLToSelectFunc)
 	generateRestoreSQL      = make(map[storepb.Engine]GenerateRestoreSQLFunc)
+	rowFilterRewriters      = make(map[storepb.Engine]RewriteRowFilterFunc)
 )
 
 type ValidateSQLForEditorFunc func(string) (bool, bool, error)
@@ -41,6 +42,9 @@ type TransformDMLToSelectFunc func(ctx context.Context, tCtx TransformContext, s
 
 type GenerateRestoreSQLFunc func(ctx context.Context, rCtx RestoreContext, statement string, backupDatabase string, backupTable string, originalDatabase string, originalTable string) (string, error)
 
+// RewriteRowFilterFunc is the interface of rewriting the statement to filter the rows of the tables.
+type RewriteRowFilterFunc func(statement string, database string, filters []*RowFilter) (string, error)
+
 func RegisterQueryValidator(engine storepb.Engine, f ValidateSQLForEditorFunc) {
 	mux.Lock()
 	defer mux.Unlock()
@@ -253,3 +257,26 @@ func NewRange(statement, singleSQL string) *storepb.Range {
 		End:   int32(start + len(singleSQLBytes)),
 	}
 }
+
+// RegisterRewriteRowFilter registers the rewriteRowFilter function for the engine.
+func RegisterRewriteRowFilter(engine storepb.Engine, f RewriteRowFilterFunc) {
+	mux.Lock()
+	defer mux.Unlock()
+	if _, dup := rowFilterRewriters[engine]; dup {
+		panic(fmt.Sprintf("Register called twice %s", engine))
+	}
+	rowFilterRewriters[engine] = f
+}
+
+// RewriteRowFilter rewrites the statement so that every reference to the filtered tables only reads the rows matching the filter predicates.
+// The database is the connected database used for the unqualified table references.
+func RewriteRowFilter(engine storepb.Engine, statement string, database string, filters []*RowFilter) (string, error) {
+	if len(filters) == 0 {
+		return statement, nil
+	}
+	f, ok := rowFilterRewriters[engine]
+	if !ok {
+		return "", errors.Errorf("engine %s is not supported", engine)
+	}
+	return f(statement, database, filters)
+}
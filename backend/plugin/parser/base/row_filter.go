@@ -0,0 +1,13 @@
+package base
+
+// RowFilter is the filter of the rows of a table.
+type RowFilter struct {
+	// Database is the normalized database name.
+	Database string
+	// Schema is the normalized schema name, it's empty for the engines that don't support schema.
+	Schema string
+	// Table is the normalized table name.
+	Table string
+	// Predicate is the boolean SQL expression over the columns of the table.
+	Predicate string
+}
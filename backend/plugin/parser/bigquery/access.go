@@ -0,0 +1,150 @@
+package bigquery
+
+import (
+	"regexp"
+	"strings"
+)
+
+// BigQuery has no DDL for the authorized views of a dataset and the policy tags of a column,
+// so Bytebase accepts the following statements and applies them with the BigQuery API:
+//
+//	ALTER SCHEMA dataset ADD AUTHORIZED VIEW [project.]dataset.view;
+//	ALTER SCHEMA dataset DROP AUTHORIZED VIEW [project.]dataset.view;
+//	ALTER TABLE [dataset.]table ALTER COLUMN column SET POLICY TAGS ('projects/p/locations/l/taxonomies/t/policyTags/id', ...);
+//	ALTER TABLE [dataset.]table ALTER COLUMN column DROP POLICY TAGS;
+//
+// Docs: https://cloud.google.com/bigquery/docs/authorized-views, https://cloud.google.com/bigquery/docs/column-level-security.
+
+// AccessStatementType is the type of the access statement.
+type AccessStatementType int
+
+const (
+	// AccessStatementTypeAddAuthorizedView is ALTER SCHEMA ... ADD AUTHORIZED VIEW ....
+	AccessStatementTypeAddAuthorizedView AccessStatementType = iota
+	// AccessStatementTypeDropAuthorizedView is ALTER SCHEMA ... DROP AUTHORIZED VIEW ....
+	AccessStatementTypeDropAuthorizedView
+	// AccessStatementTypeSetPolicyTags is ALTER TABLE ... ALTER COLUMN ... SET POLICY TAGS (...).
+	AccessStatementTypeSetPolicyTags
+	// AccessStatementTypeDropPolicyTags is ALTER TABLE ... ALTER COLUMN ... DROP POLICY TAGS.
+	AccessStatementTypeDropPolicyTags
+)
+
+// AccessStatement is the statement changing the authorized views of a dataset or the policy tags of a column.
+type AccessStatement struct {
+	Type AccessStatementType
+	// Dataset is the dataset of the authorized view change, or the dataset of the table, empty for the connected dataset.
+	Dataset string
+	// ViewProject is the project of the authorized view, empty for the project of the instance.
+	ViewProject string
+	// ViewDataset is the dataset of the authorized view.
+	ViewDataset string
+	// View is the name of the authorized view.
+	View string
+	// Table is the table of the policy tags change.
+	Table string
+	// Column is the column of the policy tags change.
+	Column string
+	// PolicyTags are the resource names of the policy tags to set.
+	PolicyTags []string
+}
+
+var (
+	authorizedViewRegexp = regexp.MustCompile("(?is)^ALTER\\s+SCHEMA\\s+(\\S+)\\s+(ADD|DROP)\\s+AUTHORIZED\\s+VIEW\\s+(\\S+)$")
+	setPolicyTagsRegexp  = regexp.MustCompile("(?is)^ALTER\\s+TABLE\\s+(\\S+)\\s+ALTER\\s+COLUMN\\s+(\\S+)\\s+SET\\s+POLICY\\s+TAGS\\s*\\((.*)\\)$")
+	dropPolicyTagsRegexp = regexp.MustCompile("(?is)^ALTER\\s+TABLE\\s+(\\S+)\\s+ALTER\\s+COLUMN\\s+(\\S+)\\s+DROP\\s+POLICY\\s+TAGS$")
+	policyTagRegexp      = regexp.MustCompile(`^projects/[^/]+/locations/([^/]+)/taxonomies/[^/]+/policyTags/[^/]+$`)
+	commentRegexp        = regexp.MustCompile(`(?s)--[^\n]*|#[^\n]*|/\*.*?\*/`)
+)
+
+// ParseAccessStatement parses the access statement, and returns false if it's not an access statement.
+func ParseAccessStatement(statement string) (*AccessStatement, bool) {
+	text := strings.TrimSpace(commentRegexp.ReplaceAllString(statement, " "))
+	text = strings.TrimSpace(strings.TrimSuffix(text, ";"))
+	if matches := authorizedViewRegexp.FindStringSubmatch(text); matches != nil {
+		parts := splitIdentifier(matches[3])
+		if len(parts) < 2 || len(parts) > 3 {
+			return nil, false
+		}
+		datasetParts := splitIdentifier(matches[1])
+		if len(datasetParts) == 0 {
+			return nil, false
+		}
+		s := &AccessStatement{
+			Type:        AccessStatementTypeAddAuthorizedView,
+			Dataset:     datasetParts[len(datasetParts)-1],
+			ViewDataset: parts[len(parts)-2],
+			View:        parts[len(parts)-1],
+		}
+		if len(parts) == 3 {
+			s.ViewProject = parts[0]
+		}
+		if strings.EqualFold(matches[2], "DROP") {
+			s.Type = AccessStatementTypeDropAuthorizedView
+		}
+		return s, true
+	}
+	if matches := setPolicyTagsRegexp.FindStringSubmatch(text); matches != nil {
+		s := &AccessStatement{
+			Type:   AccessStatementTypeSetPolicyTags,
+			Column: strings.Join(splitIdentifier(matches[2]), "."),
+		}
+		s.Dataset, s.Table = splitTable(matches[1])
+		if s.Table == "" {
+			return nil, false
+		}
+		for _, tag := range strings.Split(matches[3], ",") {
+			tag = strings.TrimSpace(tag)
+			if len(tag) >= 2 && (tag[0] == '\'' || tag[0] == '"') && tag[len(tag)-1] == tag[0] {
+				tag = tag[1 : len(tag)-1]
+			}
+			if tag != "" {
+				s.PolicyTags = append(s.PolicyTags, tag)
+			}
+		}
+		return s, true
+	}
+	if matches := dropPolicyTagsRegexp.FindStringSubmatch(text); matches != nil {
+		s := &AccessStatement{
+			Type:   AccessStatementTypeDropPolicyTags,
+			Column: strings.Join(splitIdentifier(matches[2]), "."),
+		}
+		s.Dataset, s.Table = splitTable(matches[1])
+		if s.Table == "" {
+			return nil, false
+		}
+		return s, true
+	}
+	return nil, false
+}
+
+// GetPolicyTagLocation returns the location of the policy tag, and returns false if the policy tag is not a valid resource name.
+func GetPolicyTagLocation(policyTag string) (string, bool) {
+	matches := policyTagRegexp.FindStringSubmatch(policyTag)
+	if matches == nil {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// splitTable splits the table reference into the dataset and the table.
+func splitTable(s string) (string, string) {
+	parts := splitIdentifier(s)
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return "", parts[0]
+	}
+	return parts[len(parts)-2], parts[len(parts)-1]
+}
+
+// splitIdentifier splits the path expression, e.g. `project.dataset`.view or `project.dataset.view`.
+func splitIdentifier(s string) []string {
+	var parts []string
+	for _, part := range strings.Split(strings.ReplaceAll(s, "`", ""), ".") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,76 @@
+package bigquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAccessStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      *AccessStatement
+	}{
+		{
+			statement: "ALTER SCHEMA shared ADD AUTHORIZED VIEW `my-project.reporting.orders_view`;",
+			want: &AccessStatement{
+				Type:        AccessStatementTypeAddAuthorizedView,
+				Dataset:     "shared",
+				ViewProject: "my-project",
+				ViewDataset: "reporting",
+				View:        "orders_view",
+			},
+		},
+		{
+			statement: "-- Revoke the view.\nalter schema `my-project.shared` drop authorized view reporting.orders_view",
+			want: &AccessStatement{
+				Type:        AccessStatementTypeDropAuthorizedView,
+				Dataset:     "shared",
+				ViewDataset: "reporting",
+				View:        "orders_view",
+			},
+		},
+		{
+			statement: "ALTER TABLE shared.customers ALTER COLUMN email SET POLICY TAGS ('projects/p/locations/us/taxonomies/1/policyTags/2', \"projects/p/locations/us/taxonomies/1/policyTags/3\");",
+			want: &AccessStatement{
+				Type:       AccessStatementTypeSetPolicyTags,
+				Dataset:    "shared",
+				Table:      "customers",
+				Column:     "email",
+				PolicyTags: []string{"projects/p/locations/us/taxonomies/1/policyTags/2", "projects/p/locations/us/taxonomies/1/policyTags/3"},
+			},
+		},
+		{
+			statement: "ALTER TABLE `customers` ALTER COLUMN `email` DROP POLICY TAGS;",
+			want: &AccessStatement{
+				Type:   AccessStatementTypeDropPolicyTags,
+				Table:  "customers",
+				Column: "email",
+			},
+		},
+		{
+			statement: "ALTER SCHEMA shared SET OPTIONS (description = 'shared');",
+			want:      nil,
+		},
+		{
+			statement: "ALTER TABLE customers ALTER COLUMN email SET DATA TYPE STRING;",
+			want:      nil,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, ok := ParseAccessStatement(test.statement)
+		a.Equal(test.want != nil, ok, test.statement)
+		a.Equal(test.want, got, test.statement)
+	}
+}
+
+func TestGetPolicyTagLocation(t *testing.T) {
+	a := require.New(t)
+	location, ok := GetPolicyTagLocation("projects/p/locations/us/taxonomies/1/policyTags/2")
+	a.True(ok)
+	a.Equal("us", location)
+	_, ok = GetPolicyTagLocation("projects/p/locations/us/taxonomies/1")
+	a.False(ok)
+}
@@ -0,0 +1,24 @@
+// Package doris provides the Apache Doris parser plugins.
+package doris
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/tokenizer"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterSplitterFunc(storepb.Engine_DORIS, SplitSQL)
+}
+
+// SplitSQL splits the given SQL statement into multiple SQL statements.
+// Doris DDL such as DISTRIBUTED BY, PROPERTIES and materialized views is not recognized by the MySQL parser,
+// so we split the statement by the tokenizer which only needs to understand the MySQL style quotes and comments.
+func SplitSQL(statement string) ([]base.SingleSQL, error) {
+	t := tokenizer.NewTokenizer(statement)
+	list, err := t.SplitTiDBMultiSQL()
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,40 @@
+package doris
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSQL(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      []string
+	}{
+		{
+			statement: "CREATE TABLE `t;1` (k INT) DUPLICATE KEY(k) DISTRIBUTED BY HASH(k) BUCKETS 10 PROPERTIES (\"replication_num\" = \"3\");\nINSERT INTO t VALUES (';');",
+			want: []string{
+				"CREATE TABLE `t;1` (k INT) DUPLICATE KEY(k) DISTRIBUTED BY HASH(k) BUCKETS 10 PROPERTIES (\"replication_num\" = \"3\");",
+				"INSERT INTO t VALUES (';');",
+			},
+		},
+		{
+			statement: "CREATE MATERIALIZED VIEW mv BUILD IMMEDIATE REFRESH AUTO ON SCHEDULE EVERY 1 HOUR DISTRIBUTED BY RANDOM BUCKETS 2 AS SELECT k FROM t;\n-- comment;\nSELECT 1",
+			want: []string{
+				"CREATE MATERIALIZED VIEW mv BUILD IMMEDIATE REFRESH AUTO ON SCHEDULE EVERY 1 HOUR DISTRIBUTED BY RANDOM BUCKETS 2 AS SELECT k FROM t;",
+				"-- comment;\nSELECT 1",
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		list, err := SplitSQL(test.statement)
+		a.NoError(err)
+		var got []string
+		for _, sql := range list {
+			got = append(got, sql.Text)
+		}
+		a.Equal(test.want, got, test.statement)
+	}
+}
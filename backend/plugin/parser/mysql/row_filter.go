@@ -0,0 +1,98 @@
+package mysql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/mysql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterRewriteRowFilter(storepb.Engine_MYSQL, RewriteRowFilter)
+	base.RegisterRewriteRowFilter(storepb.Engine_MARIADB, RewriteRowFilter)
+}
+
+// RewriteRowFilter replaces the filtered tables with the derived tables filtering the rows,
+// e.g. `SELECT * FROM t` becomes "SELECT * FROM (SELECT * FROM t WHERE region = 'EU') AS `t`".
+// The statement referencing the filtered tables elsewhere, such as `TABLE t`, is rejected.
+func RewriteRowFilter(statement string, database string, filters []*base.RowFilter) (string, error) {
+	list, err := ParseMySQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	var result []string
+	for _, item := range list {
+		listener := &rowFilterListener{
+			database: database,
+			filters:  filters,
+			rewriter: antlr.NewTokenStreamRewriter(item.Tokens),
+		}
+		antlr.ParseTreeWalkerDefault.Walk(listener, item.Tree)
+		if listener.err != nil {
+			return "", listener.err
+		}
+		result = append(result, listener.rewriter.GetTextDefault())
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+type rowFilterListener struct {
+	*parser.BaseMySQLParserListener
+
+	database string
+	filters  []*base.RowFilter
+	rewriter *antlr.TokenStreamRewriter
+	err      error
+}
+
+func (l *rowFilterListener) EnterTableRef(ctx *parser.TableRefContext) {
+	if l.err != nil {
+		return
+	}
+	database, table := NormalizeMySQLTableRef(ctx)
+	if database == "" {
+		database = l.database
+	}
+	filter := findRowFilter(l.filters, database, table)
+	if filter == nil {
+		return
+	}
+
+	singleTable, ok := ctx.GetParent().(*parser.SingleTableContext)
+	if !ok {
+		l.err = errors.Errorf("cannot filter the rows of table %q in the statement", table)
+		return
+	}
+	// The derived table cannot have the partitions or the index hints.
+	stop := ctx.GetStop().GetTokenIndex()
+	if singleTable.TableAlias() != nil {
+		stop = singleTable.TableAlias().GetStop().GetTokenIndex()
+	}
+	if stop != singleTable.GetStop().GetTokenIndex() {
+		l.err = errors.Errorf("cannot filter the rows of table %q with the partitions or the index hints", table)
+		return
+	}
+
+	replacement := fmt.Sprintf("(SELECT * FROM %s WHERE %s)", ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx), filter.Predicate)
+	if singleTable.TableAlias() == nil {
+		replacement = fmt.Sprintf("%s AS `%s`", replacement, strings.ReplaceAll(table, "`", "``"))
+	}
+	l.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), replacement)
+}
+
+// findRowFilter finds the filter of the table case-insensitively, because the table names may be
+// case-insensitive depending on lower_case_table_names. Filtering more rows is safer than leaking them.
+func findRowFilter(filters []*base.RowFilter, database, table string) *base.RowFilter {
+	for _, filter := range filters {
+		if strings.EqualFold(filter.Database, database) && strings.EqualFold(filter.Table, table) {
+			return filter
+		}
+	}
+	return nil
+}
@@ -16,7 +16,6 @@ func init() {
 	base.RegisterSplitterFunc(storepb.Engine_MARIADB, SplitSQL)
 	base.RegisterSplitterFunc(storepb.Engine_OCEANBASE, SplitSQL)
 	base.RegisterSplitterFunc(storepb.Engine_STARROCKS, SplitSQL)
-	base.RegisterSplitterFunc(storepb.Engine_DORIS, SplitSQL)
 }
 
 // SplitSQL splits the given SQL statement into multiple SQL statements.
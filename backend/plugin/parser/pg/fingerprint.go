@@ -0,0 +1,123 @@
+package pg
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	fingerprintInListRegexp = regexp.MustCompile(`\b(in|values)\s*\(\s*\?(?:\s*,\s*\?)*\s*\)(?:\s*,\s*\(\s*\?(?:\s*,\s*\?)*\s*\))*`)
+	fingerprintLimitRegexp  = regexp.MustCompile(`\blimit \?(?: offset \?)?`)
+)
+
+// GetFingerprint gets the PostgreSQL query fingerprint.
+// The literals, the parameters of the normalized queries in pg_stat_statements and the comments are removed,
+// so the queries only differing in the values share the same fingerprint.
+func GetFingerprint(query string) string {
+	// The special characters are ASCII, so it's safe to scan the bytes.
+	var buf strings.Builder
+	n := len(query)
+	// writeSpace writes at most one space between the tokens.
+	writeSpace := func() {
+		s := buf.String()
+		if len(s) > 0 && s[len(s)-1] != ' ' {
+			buf.WriteByte(' ')
+		}
+	}
+	isIdentifierByte := func(c byte) bool {
+		return c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c > 127
+	}
+
+	for i := 0; i < n; i++ {
+		c := query[i]
+		switch {
+		case c == '-' && i+1 < n && query[i+1] == '-':
+			for i < n && query[i] != '\n' {
+				i++
+			}
+			writeSpace()
+		case c == '/' && i+1 < n && query[i+1] == '*':
+			i += 2
+			for i+1 < n && !(query[i] == '*' && query[i+1] == '/') {
+				i++
+			}
+			i++
+			writeSpace()
+		case c == '\'':
+			// The string literal, the quote is escaped by doubling it.
+			i++
+			for i < n {
+				if query[i] == '\'' {
+					if i+1 < n && query[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					break
+				}
+				i++
+			}
+			// Drop the prefix of the escape string constant, e.g. E'...'.
+			if s := buf.String(); strings.HasSuffix(s, "e") && (len(s) == 1 || !isIdentifierByte(s[len(s)-2])) {
+				buf.Reset()
+				buf.WriteString(s[:len(s)-1])
+			}
+			buf.WriteByte('?')
+		case c == '"':
+			// The quoted identifier is case-sensitive.
+			start := i
+			i++
+			for i < n && query[i] != '"' {
+				i++
+			}
+			end := i + 1
+			if end > n {
+				end = n
+			}
+			buf.WriteString(query[start:end])
+		case c == '$' && (i == 0 || !isIdentifierByte(query[i-1])):
+			j := i + 1
+			for j < n && query[j] >= '0' && query[j] <= '9' {
+				j++
+			}
+			if j > i+1 {
+				// The parameter, e.g. $1.
+				buf.WriteByte('?')
+				i = j - 1
+				continue
+			}
+			// The dollar-quoted string, e.g. $$...$$ or $tag$...$tag$.
+			for j < n && isIdentifierByte(query[j]) && query[j] != '$' {
+				j++
+			}
+			if j >= n || query[j] != '$' {
+				buf.WriteByte(c)
+				continue
+			}
+			tag := query[i : j+1]
+			end := strings.Index(query[j+1:], tag)
+			if end < 0 {
+				i = n
+			} else {
+				i = j + end + len(tag)
+			}
+			buf.WriteByte('?')
+		case c >= '0' && c <= '9' && (i == 0 || !isIdentifierByte(query[i-1])):
+			for i+1 < n && (query[i+1] >= '0' && query[i+1] <= '9' || query[i+1] == '.' || query[i+1] == 'e' || query[i+1] == 'E') {
+				i++
+			}
+			buf.WriteByte('?')
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f':
+			writeSpace()
+		case c >= 'A' && c <= 'Z':
+			buf.WriteByte(c + 'a' - 'A')
+		default:
+			buf.WriteByte(c)
+		}
+	}
+
+	fingerprint := strings.TrimSpace(buf.String())
+	fingerprint = strings.TrimRight(fingerprint, "; ")
+	fingerprint = fingerprintInListRegexp.ReplaceAllString(fingerprint, "$1 (?+)")
+	fingerprint = fingerprintLimitRegexp.ReplaceAllString(fingerprint, "limit ?")
+	return fingerprint
+}
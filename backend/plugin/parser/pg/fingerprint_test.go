@@ -0,0 +1,49 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFingerprint(t *testing.T) {
+	tests := []struct {
+		stmt string
+		want string
+	}{
+		{
+			stmt: "SELECT * FROM t WHERE id = 1",
+			want: "select * from t where id = ?",
+		},
+		// The normalized query in pg_stat_statements.
+		{
+			stmt: "SELECT * FROM t WHERE id = $1",
+			want: "select * from t where id = ?",
+		},
+		{
+			stmt: "-- comment\nSELECT  a,\n\tb FROM t /* comment */ WHERE name = 'it''s' AND c = E'\\n';",
+			want: "select a, b from t where name = ? and c = ?",
+		},
+		{
+			stmt: `SELECT "MyColumn" FROM "MyTable" WHERE t1.id IN (1, 2, 3)`,
+			want: `select "MyColumn" from "MyTable" where t1.id in (?+)`,
+		},
+		{
+			stmt: "SELECT * FROM t WHERE id IN ($1, $2) LIMIT $3 OFFSET $4",
+			want: "select * from t where id in (?+) limit ?",
+		},
+		{
+			stmt: "INSERT INTO t (a, b) VALUES (1, 'a'), (2, 'b')",
+			want: "insert into t (a, b) values (?+)",
+		},
+		{
+			stmt: "SELECT $$dollar 'quoted'$$, $tag$x$tag$, 1.5e3::numeric",
+			want: "select ?, ?, ?::numeric",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, GetFingerprint(test.stmt), test.stmt)
+	}
+}
@@ -0,0 +1,118 @@
+package pg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/postgresql-parser"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func init() {
+	base.RegisterRewriteRowFilter(storepb.Engine_POSTGRES, RewriteRowFilter)
+}
+
+// RewriteRowFilter replaces the filtered tables with the subqueries filtering the rows,
+// e.g. `SELECT * FROM t` becomes `SELECT * FROM (SELECT * FROM t WHERE region = 'EU') AS "t"`.
+// The statement referencing the filtered tables elsewhere, such as `TABLE t`, is rejected.
+func RewriteRowFilter(statement string, database string, filters []*base.RowFilter) (string, error) {
+	result, err := ParsePostgreSQL(statement)
+	if err != nil {
+		return "", err
+	}
+
+	listener := &rowFilterListener{
+		database: database,
+		filters:  filters,
+		rewriter: antlr.NewTokenStreamRewriter(result.Tokens),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(listener, result.Tree)
+	if listener.err != nil {
+		return "", listener.err
+	}
+	return listener.rewriter.GetTextDefault(), nil
+}
+
+type rowFilterListener struct {
+	*parser.BasePostgreSQLParserListener
+
+	database string
+	filters  []*base.RowFilter
+	rewriter *antlr.TokenStreamRewriter
+	err      error
+}
+
+func (l *rowFilterListener) EnterRelation_expr(ctx *parser.Relation_exprContext) {
+	if l.err != nil {
+		return
+	}
+	list := NormalizePostgreSQLQualifiedName(ctx.Qualified_name())
+	var filter *base.RowFilter
+	switch len(list) {
+	case 1:
+		filter = l.findRowFilter(l.database, "", list[0])
+	case 2:
+		filter = l.findRowFilter(l.database, list[0], list[1])
+	case 3:
+		filter = l.findRowFilter(list[0], list[1], list[2])
+	}
+	if filter == nil {
+		return
+	}
+
+	tableRef, ok := ctx.GetParent().(*parser.Table_refContext)
+	if !ok {
+		l.err = errors.Errorf("cannot filter the rows of table %q in the statement", filter.Table)
+		return
+	}
+	tableAlias, _ := normalizeTableAlias(tableRef.Opt_alias_clause())
+	stop := ctx.GetStop().GetTokenIndex()
+	if tableAlias != "" {
+		stop = tableRef.Opt_alias_clause().GetStop().GetTokenIndex()
+	}
+	// The subquery cannot be sampled.
+	if next := nextDefaultChannelToken(ctx.GetParser().GetTokenStream(), stop); next != nil && strings.EqualFold(next.GetText(), "TABLESAMPLE") {
+		l.err = errors.Errorf("cannot filter the rows of table %q with TABLESAMPLE", filter.Table)
+		return
+	}
+
+	replacement := fmt.Sprintf("(SELECT * FROM %s WHERE %s)", ctx.GetParser().GetTokenStream().GetTextFromRuleContext(ctx), filter.Predicate)
+	if tableAlias == "" {
+		replacement = fmt.Sprintf(`%s AS "%s"`, replacement, strings.ReplaceAll(filter.Table, `"`, `""`))
+	}
+	l.rewriter.ReplaceDefault(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex(), replacement)
+}
+
+// findRowFilter finds the filter of the table. The unqualified table matches the filters in any schema,
+// because the search path may be changed. Filtering more rows is safer than leaking them.
+func (l *rowFilterListener) findRowFilter(database, schema, table string) *base.RowFilter {
+	if database != l.database {
+		return nil
+	}
+	for _, filter := range l.filters {
+		if filter.Database != database || filter.Table != table {
+			continue
+		}
+		if schema == "" || filter.Schema == schema {
+			return filter
+		}
+	}
+	return nil
+}
+
+func nextDefaultChannelToken(tokens antlr.TokenStream, index int) antlr.Token {
+	for i := index + 1; i < tokens.Size(); i++ {
+		token := tokens.Get(i)
+		if token.GetTokenType() == antlr.TokenEOF {
+			return nil
+		}
+		if token.GetChannel() == antlr.TokenDefaultChannel {
+			return token
+		}
+	}
+	return nil
+}
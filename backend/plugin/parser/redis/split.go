@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"strings"
+
+	"github.com/google/shlex"
+
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// adminCommands are the commands changing the server rather than the data of a database.
+// The key is the command name, and the value is the subcommands, or nil for all subcommands.
+var adminCommands = map[string][]string{
+	"acl":          {"setuser", "deluser", "load", "save"},
+	"bgrewriteaof": nil,
+	"bgsave":       nil,
+	"cluster":      nil,
+	"config":       {"set", "rewrite", "resetstat"},
+	"debug":        nil,
+	"failover":     nil,
+	"flushall":     nil,
+	"module":       nil,
+	"replicaof":    nil,
+	"save":         nil,
+	"shutdown":     nil,
+	"slaveof":      nil,
+}
+
+func init() {
+	base.RegisterSplitterFunc(storepb.Engine_REDIS, SplitSQL)
+}
+
+// SplitSQL splits the given Redis commands by lines, one command per line.
+// The text of each single SQL contains the trailing newline so that the texts add up to the statement.
+func SplitSQL(statement string) ([]base.SingleSQL, error) {
+	var list []base.SingleSQL
+	offset := 0
+	for i, line := range strings.SplitAfter(statement, "\n") {
+		if line == "" {
+			continue
+		}
+		fields, err := shlex.Split(line)
+		if err != nil {
+			return nil, &base.SyntaxError{
+				Line:    i + 1,
+				Column:  0,
+				Message: err.Error(),
+			}
+		}
+		content := strings.TrimRight(line, "\r\n")
+		list = append(list, base.SingleSQL{
+			Text:                 line,
+			BaseLine:             i,
+			FirstStatementLine:   i,
+			FirstStatementColumn: len(content) - len(strings.TrimLeft(content, " \t")),
+			LastLine:             i,
+			LastColumn:           max(len(content)-1, 0),
+			Empty:                len(fields) == 0,
+			ByteOffsetStart:      offset,
+			ByteOffsetEnd:        offset + len(line),
+		})
+		offset += len(line)
+	}
+	return list, nil
+}
+
+// IsAdminCommand returns true if the command changes the server configuration or the ACL users,
+// or affects the data of all databases.
+func IsAdminCommand(fields []string) bool {
+	if len(fields) == 0 {
+		return false
+	}
+	subcommands, ok := adminCommands[strings.ToLower(fields[0])]
+	if !ok {
+		return false
+	}
+	if subcommands == nil {
+		return true
+	}
+	if len(fields) < 2 {
+		return false
+	}
+	for _, subcommand := range subcommands {
+		if strings.EqualFold(fields[1], subcommand) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsAdminCommand returns true if any command in the statement is an admin command.
+func ContainsAdminCommand(statement string) (bool, error) {
+	list, err := SplitSQL(statement)
+	if err != nil {
+		return false, err
+	}
+	for _, sql := range list {
+		if sql.Empty {
+			continue
+		}
+		fields, err := shlex.Split(sql.Text)
+		if err != nil {
+			return false, err
+		}
+		if IsAdminCommand(fields) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
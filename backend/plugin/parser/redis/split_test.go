@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitSQL(t *testing.T) {
+	statement := "CONFIG SET maxmemory 2gb\n\nACL SETUSER alice on >secret ~app:* +@read\n"
+	list, err := SplitSQL(statement)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+
+	require.Equal(t, "CONFIG SET maxmemory 2gb\n", list[0].Text)
+	require.Equal(t, 0, list[0].FirstStatementLine)
+	require.False(t, list[0].Empty)
+	require.True(t, list[1].Empty)
+	require.Equal(t, 2, list[2].FirstStatementLine)
+	require.Equal(t, len(statement), list[2].ByteOffsetEnd)
+
+	_, err = SplitSQL("SET a \"b\nGET a")
+	require.Error(t, err)
+}
+
+func TestContainsAdminCommand(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      bool
+	}{
+		{
+			statement: "set hello 1\nget hello",
+			want:      false,
+		},
+		{
+			statement: "config get maxmemory",
+			want:      false,
+		},
+		{
+			statement: "config set maxmemory 2gb",
+			want:      true,
+		},
+		{
+			statement: "get hello\nACL SETUSER alice on >secret",
+			want:      true,
+		},
+		{
+			statement: "flushall",
+			want:      true,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := ContainsAdminCommand(test.statement)
+		require.NoError(t, err)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
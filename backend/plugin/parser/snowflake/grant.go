@@ -0,0 +1,143 @@
+package snowflake
+
+import (
+	"regexp"
+	"strings"
+)
+
+// GrantStatementType is the type of the grant statement.
+type GrantStatementType int
+
+const (
+	// GrantStatementTypeGrantPrivileges is GRANT <privileges> ON <object> TO <grantee>.
+	GrantStatementTypeGrantPrivileges GrantStatementType = iota
+	// GrantStatementTypeRevokePrivileges is REVOKE <privileges> ON <object> FROM <grantee>.
+	GrantStatementTypeRevokePrivileges
+	// GrantStatementTypeGrantRole is GRANT ROLE <role> TO <grantee>.
+	GrantStatementTypeGrantRole
+	// GrantStatementTypeRevokeRole is REVOKE ROLE <role> FROM <grantee>.
+	GrantStatementTypeRevokeRole
+)
+
+// GrantStatement is a GRANT or REVOKE statement on privileges or roles.
+// Docs: https://docs.snowflake.com/en/sql-reference/commands-user-role.
+type GrantStatement struct {
+	Type GrantStatementType
+	// Privileges are the upper case privileges, e.g. SELECT, USAGE, OWNERSHIP.
+	Privileges []string
+	// ObjectType is the upper case object type, e.g. TABLE, SCHEMA, DATABASE.
+	// For the grants on all or future objects, it's the plural object type, e.g. TABLES.
+	ObjectType string
+	// ObjectName is the upper case name of the object, or the container of all or future objects.
+	ObjectName string
+	// Bulk is "ALL" or "FUTURE" for the grants on all or future objects in the container.
+	Bulk string
+	// Role is the upper case name of the granted or revoked role.
+	Role string
+	// GranteeType is the upper case grantee type, e.g. ROLE, USER, DATABASE ROLE, SHARE.
+	GranteeType string
+	// Grantee is the upper case name of the grantee.
+	Grantee string
+	// GrantOption is true for WITH GRANT OPTION, or REVOKE GRANT OPTION FOR.
+	GrantOption bool
+}
+
+var (
+	grantRoleRegexp        = regexp.MustCompile(`(?is)^GRANT\s+(?:DATABASE\s+)?ROLE\s+(\S+)\s+TO\s+(ROLE|USER|DATABASE\s+ROLE|SHARE)\s+(\S+)\s*$`)
+	revokeRoleRegexp       = regexp.MustCompile(`(?is)^REVOKE\s+(?:DATABASE\s+)?ROLE\s+(\S+)\s+FROM\s+(ROLE|USER|DATABASE\s+ROLE|SHARE)\s+(\S+)\s*$`)
+	grantPrivilegesRegexp  = regexp.MustCompile(`(?is)^GRANT\s+(.+?)\s+ON\s+(.+?)\s+TO\s+(ROLE|USER|DATABASE\s+ROLE|SHARE|APPLICATION)\s+(\S+)(\s+WITH\s+GRANT\s+OPTION)?(?:\s+(?:COPY|REVOKE)\s+CURRENT\s+GRANTS)?\s*$`)
+	revokePrivilegesRegexp = regexp.MustCompile(`(?is)^REVOKE\s+(GRANT\s+OPTION\s+FOR\s+)?(.+?)\s+ON\s+(.+?)\s+FROM\s+(ROLE|USER|DATABASE\s+ROLE|SHARE|APPLICATION)\s+(\S+)(?:\s+(?:CASCADE|RESTRICT))?\s*$`)
+	bulkObjectRegexp       = regexp.MustCompile(`(?is)^(ALL|FUTURE)\s+(.+?)\s+IN\s+(?:SCHEMA|DATABASE)\s+(\S+)$`)
+	commentRegexp          = regexp.MustCompile(`(?s)--[^\n]*|/\*.*?\*/`)
+)
+
+// ParseGrantStatement parses the GRANT or REVOKE statement, and returns false if it's not a grant statement.
+func ParseGrantStatement(statement string) (*GrantStatement, bool) {
+	text := strings.TrimSpace(commentRegexp.ReplaceAllString(statement, " "))
+	text = strings.TrimSpace(strings.TrimSuffix(text, ";"))
+	if matches := grantRoleRegexp.FindStringSubmatch(text); matches != nil {
+		return &GrantStatement{
+			Type:        GrantStatementTypeGrantRole,
+			Role:        NormalizeGrantIdentifier(matches[1]),
+			GranteeType: normalizeGrantKeyword(matches[2]),
+			Grantee:     NormalizeGrantIdentifier(matches[3]),
+		}, true
+	}
+	if matches := revokeRoleRegexp.FindStringSubmatch(text); matches != nil {
+		return &GrantStatement{
+			Type:        GrantStatementTypeRevokeRole,
+			Role:        NormalizeGrantIdentifier(matches[1]),
+			GranteeType: normalizeGrantKeyword(matches[2]),
+			Grantee:     NormalizeGrantIdentifier(matches[3]),
+		}, true
+	}
+	if matches := grantPrivilegesRegexp.FindStringSubmatch(text); matches != nil {
+		grant := &GrantStatement{
+			Type:        GrantStatementTypeGrantPrivileges,
+			Privileges:  splitPrivileges(matches[1]),
+			GranteeType: normalizeGrantKeyword(matches[3]),
+			Grantee:     NormalizeGrantIdentifier(matches[4]),
+			GrantOption: matches[5] != "",
+		}
+		grant.Bulk, grant.ObjectType, grant.ObjectName = splitGrantObject(matches[2])
+		return grant, true
+	}
+	if matches := revokePrivilegesRegexp.FindStringSubmatch(text); matches != nil {
+		grant := &GrantStatement{
+			Type:        GrantStatementTypeRevokePrivileges,
+			Privileges:  splitPrivileges(matches[2]),
+			GranteeType: normalizeGrantKeyword(matches[4]),
+			Grantee:     NormalizeGrantIdentifier(matches[5]),
+			GrantOption: matches[1] != "",
+		}
+		grant.Bulk, grant.ObjectType, grant.ObjectName = splitGrantObject(matches[3])
+		return grant, true
+	}
+	return nil, false
+}
+
+func splitPrivileges(s string) []string {
+	var privileges []string
+	for _, privilege := range strings.Split(s, ",") {
+		privilege = normalizeGrantKeyword(privilege)
+		if privilege == "ALL PRIVILEGES" {
+			privilege = "ALL"
+		}
+		if privilege != "" {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
+// splitGrantObject splits the object of the grant statement, e.g. "TABLE db.schema.t" or "FUTURE TABLES IN SCHEMA db.schema".
+func splitGrantObject(s string) (string, string, string) {
+	s = strings.TrimSpace(s)
+	if matches := bulkObjectRegexp.FindStringSubmatch(s); matches != nil {
+		return strings.ToUpper(matches[1]), normalizeGrantKeyword(matches[2]), NormalizeGrantIdentifier(matches[3])
+	}
+	i := strings.LastIndexFunc(s, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' })
+	if i < 0 {
+		// The object type is omitted for tables, e.g. GRANT SELECT ON t TO ROLE r.
+		return "", "TABLE", NormalizeGrantIdentifier(s)
+	}
+	return "", normalizeGrantKeyword(s[:i]), NormalizeGrantIdentifier(s[i+1:])
+}
+
+func normalizeGrantKeyword(s string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(s), " "))
+}
+
+// NormalizeGrantIdentifier normalizes the identifier in the way Snowflake resolves it,
+// the unquoted parts are upper case and the quoted parts keep the case.
+func NormalizeGrantIdentifier(s string) string {
+	var parts []string
+	for _, part := range strings.Split(strings.TrimSpace(s), ".") {
+		if len(part) >= 2 && strings.HasPrefix(part, `"`) && strings.HasSuffix(part, `"`) {
+			parts = append(parts, strings.ReplaceAll(part[1:len(part)-1], `""`, `"`))
+			continue
+		}
+		parts = append(parts, strings.ToUpper(part))
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,79 @@
+package snowflake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGrantStatement(t *testing.T) {
+	tests := []struct {
+		statement string
+		want      *GrantStatement
+	}{
+		{
+			statement: `GRANT SELECT, INSERT ON TABLE db.public."Orders" TO ROLE analyst WITH GRANT OPTION;`,
+			want: &GrantStatement{
+				Type:        GrantStatementTypeGrantPrivileges,
+				Privileges:  []string{"SELECT", "INSERT"},
+				ObjectType:  "TABLE",
+				ObjectName:  "DB.PUBLIC.Orders",
+				GranteeType: "ROLE",
+				Grantee:     "ANALYST",
+				GrantOption: true,
+			},
+		},
+		{
+			statement: "grant usage on future schemas in database db to role analyst",
+			want: &GrantStatement{
+				Type:        GrantStatementTypeGrantPrivileges,
+				Privileges:  []string{"USAGE"},
+				ObjectType:  "SCHEMAS",
+				ObjectName:  "DB",
+				Bulk:        "FUTURE",
+				GranteeType: "ROLE",
+				Grantee:     "ANALYST",
+			},
+		},
+		{
+			statement: "REVOKE GRANT OPTION FOR ALL PRIVILEGES ON WAREHOUSE wh FROM ROLE analyst CASCADE;",
+			want: &GrantStatement{
+				Type:        GrantStatementTypeRevokePrivileges,
+				Privileges:  []string{"ALL"},
+				ObjectType:  "WAREHOUSE",
+				ObjectName:  "WH",
+				GranteeType: "ROLE",
+				Grantee:     "ANALYST",
+				GrantOption: true,
+			},
+		},
+		{
+			statement: "-- Give the analysts to the reporting role.\nGRANT ROLE analyst TO ROLE reporting;",
+			want: &GrantStatement{
+				Type:        GrantStatementTypeGrantRole,
+				Role:        "ANALYST",
+				GranteeType: "ROLE",
+				Grantee:     "REPORTING",
+			},
+		},
+		{
+			statement: "REVOKE ROLE analyst FROM USER alice;",
+			want: &GrantStatement{
+				Type:        GrantStatementTypeRevokeRole,
+				Role:        "ANALYST",
+				GranteeType: "USER",
+				Grantee:     "ALICE",
+			},
+		},
+		{
+			statement: "CREATE TABLE t(id INT);",
+			want:      nil,
+		},
+	}
+
+	for _, test := range tests {
+		got, ok := ParseGrantStatement(test.statement)
+		require.Equal(t, test.want != nil, ok, test.statement)
+		require.Equal(t, test.want, got, test.statement)
+	}
+}
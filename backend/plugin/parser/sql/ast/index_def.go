@@ -20,6 +20,9 @@ const (
 	// https://github.com/bytebase/bytebase/issues/6783.
 	// https://github.com/pgvector/pgvector.
 	IndexMethodTypeIvfflat
+	// IndexMethodTypeHnsw is the index method type for hnsw.
+	// https://github.com/pgvector/pgvector.
+	IndexMethodTypeHnsw
 )
 
 // String implements fmt.Stringer interface.
@@ -39,6 +42,8 @@ func (tp IndexMethodType) String() string {
 		return "brin"
 	case IndexMethodTypeIvfflat:
 		return "ivfflat"
+	case IndexMethodTypeHnsw:
+		return "hnsw"
 	default:
 		return ""
 	}
@@ -1147,6 +1147,8 @@ func convertMethodType(method string) (ast.IndexMethodType, error) {
 		return ast.IndexMethodTypeBrin, nil
 	case "ivfflat":
 		return ast.IndexMethodTypeIvfflat, nil
+	case "hnsw":
+		return ast.IndexMethodTypeHnsw, nil
 	default:
 		// Fallback to btree for index from plugins.
 		return ast.IndexMethodTypeBTree, nil
@@ -321,6 +321,8 @@ func deparseIndexMethod(method ast.IndexMethodType, buf *strings.Builder) (err e
 		_, err = buf.WriteString("brin")
 	case ast.IndexMethodTypeIvfflat:
 		_, err = buf.WriteString("ivfflat")
+	case ast.IndexMethodTypeHnsw:
+		_, err = buf.WriteString("hnsw")
 	}
 	return err
 }
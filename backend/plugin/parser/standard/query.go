@@ -16,12 +16,14 @@ import (
 func init() {
 	base.RegisterQueryValidator(storepb.Engine_CLICKHOUSE, ValidateSQLForEditor)
 	base.RegisterQueryValidator(storepb.Engine_SQLITE, ValidateSQLForEditor)
+	base.RegisterQueryValidator(storepb.Engine_DUCKDB, ValidateSQLForEditor)
 	base.RegisterQueryValidator(storepb.Engine_SPANNER, ValidateSQLForEditor)
 	base.RegisterQueryValidator(storepb.Engine_HIVE, ValidateSQLForEditor)
 	base.RegisterQueryValidator(storepb.Engine_BIGQUERY, ValidateSQLForEditor)
 
 	base.RegisterExtractResourceListFunc(storepb.Engine_CLICKHOUSE, ExtractResourceList)
 	base.RegisterExtractResourceListFunc(storepb.Engine_SQLITE, ExtractResourceList)
+	base.RegisterExtractResourceListFunc(storepb.Engine_DUCKDB, ExtractResourceList)
 	base.RegisterExtractResourceListFunc(storepb.Engine_SPANNER, ExtractResourceList)
 	base.RegisterExtractResourceListFunc(storepb.Engine_MONGODB, ExtractResourceList)
 	base.RegisterExtractResourceListFunc(storepb.Engine_REDIS, ExtractResourceList)
@@ -0,0 +1,72 @@
+package tidb
+
+import (
+	"sort"
+
+	tidbast "github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pkg/errors"
+)
+
+// GetStatementTypes returns the statement types of the TiDB statements.
+func GetStatementTypes(asts any) ([]string, error) {
+	nodes, ok := asts.([]tidbast.StmtNode)
+	if !ok {
+		return nil, errors.Errorf("invalid ast type %T", asts)
+	}
+	sqlTypeSet := make(map[string]bool)
+	for _, node := range nodes {
+		t := getStatementType(node)
+		sqlTypeSet[t] = true
+	}
+	var sqlTypes []string
+	for sqlType := range sqlTypeSet {
+		sqlTypes = append(sqlTypes, sqlType)
+	}
+	sort.Strings(sqlTypes)
+	return sqlTypes, nil
+}
+
+// getStatementType returns the type of statement, which is consistent with the MySQL statement types.
+func getStatementType(node tidbast.StmtNode) string {
+	switch node := node.(type) {
+	// ddl.
+	case *tidbast.CreateDatabaseStmt:
+		return "CREATE_DATABASE"
+	case *tidbast.CreateIndexStmt:
+		return "CREATE_INDEX"
+	case *tidbast.CreateTableStmt:
+		return "CREATE_TABLE"
+	case *tidbast.CreateViewStmt:
+		return "CREATE_VIEW"
+	case *tidbast.CreateSequenceStmt:
+		return "CREATE_SEQUENCE"
+	case *tidbast.DropIndexStmt:
+		return "DROP_INDEX"
+	case *tidbast.DropTableStmt:
+		if node.IsView {
+			return "DROP_VIEW"
+		}
+		return "DROP_TABLE"
+	case *tidbast.DropDatabaseStmt:
+		return "DROP_DATABASE"
+	case *tidbast.DropSequenceStmt:
+		return "DROP_SEQUENCE"
+	case *tidbast.AlterTableStmt:
+		return "ALTER_TABLE"
+	case *tidbast.AlterDatabaseStmt:
+		return "ALTER_DATABASE"
+	case *tidbast.TruncateTableStmt:
+		return "TRUNCATE"
+	case *tidbast.RenameTableStmt:
+		return "RENAME"
+
+	// dml.
+	case *tidbast.DeleteStmt:
+		return "DELETE"
+	case *tidbast.InsertStmt:
+		return "INSERT"
+	case *tidbast.UpdateStmt:
+		return "UPDATE"
+	}
+	return "UNKNOWN"
+}
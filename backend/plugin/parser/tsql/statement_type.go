@@ -0,0 +1,104 @@
+package tsql
+
+import (
+	"sort"
+
+	"github.com/antlr4-go/antlr/v4"
+	parser "github.com/bytebase/tsql-parser"
+	"github.com/pkg/errors"
+)
+
+// GetStatementTypes returns the statement types of the T-SQL statements.
+func GetStatementTypes(asts any) ([]string, error) {
+	tree, ok := asts.(antlr.Tree)
+	if !ok {
+		return nil, errors.Errorf("invalid ast type %T", asts)
+	}
+	l := &statementTypeListener{
+		types: make(map[string]bool),
+	}
+	antlr.ParseTreeWalkerDefault.Walk(l, tree)
+
+	var sqlTypes []string
+	for sqlType := range l.types {
+		sqlTypes = append(sqlTypes, sqlType)
+	}
+	sort.Strings(sqlTypes)
+	return sqlTypes, nil
+}
+
+type statementTypeListener struct {
+	*parser.BaseTSqlParserListener
+
+	types map[string]bool
+}
+
+// EnterCreate_table is called when production create_table is entered.
+func (l *statementTypeListener) EnterCreate_table(_ *parser.Create_tableContext) {
+	l.types["CREATE_TABLE"] = true
+}
+
+// EnterDrop_table is called when production drop_table is entered.
+func (l *statementTypeListener) EnterDrop_table(_ *parser.Drop_tableContext) {
+	l.types["DROP_TABLE"] = true
+}
+
+// EnterAlter_table is called when production alter_table is entered.
+func (l *statementTypeListener) EnterAlter_table(_ *parser.Alter_tableContext) {
+	l.types["ALTER_TABLE"] = true
+}
+
+// EnterCreate_index is called when production create_index is entered.
+func (l *statementTypeListener) EnterCreate_index(_ *parser.Create_indexContext) {
+	l.types["CREATE_INDEX"] = true
+}
+
+// EnterDrop_index is called when production drop_index is entered.
+func (l *statementTypeListener) EnterDrop_index(_ *parser.Drop_indexContext) {
+	l.types["DROP_INDEX"] = true
+}
+
+// EnterCreate_view is called when production create_view is entered.
+func (l *statementTypeListener) EnterCreate_view(_ *parser.Create_viewContext) {
+	l.types["CREATE_VIEW"] = true
+}
+
+// EnterDrop_view is called when production drop_view is entered.
+func (l *statementTypeListener) EnterDrop_view(_ *parser.Drop_viewContext) {
+	l.types["DROP_VIEW"] = true
+}
+
+// EnterCreate_or_alter_procedure is called when production create_or_alter_procedure is entered.
+func (l *statementTypeListener) EnterCreate_or_alter_procedure(_ *parser.Create_or_alter_procedureContext) {
+	l.types["CREATE_PROCEDURE"] = true
+}
+
+// EnterDrop_procedure is called when production drop_procedure is entered.
+func (l *statementTypeListener) EnterDrop_procedure(_ *parser.Drop_procedureContext) {
+	l.types["DROP_PROCEDURE"] = true
+}
+
+// EnterCreate_or_alter_function is called when production create_or_alter_function is entered.
+func (l *statementTypeListener) EnterCreate_or_alter_function(_ *parser.Create_or_alter_functionContext) {
+	l.types["CREATE_FUNCTION"] = true
+}
+
+// EnterDrop_function is called when production drop_function is entered.
+func (l *statementTypeListener) EnterDrop_function(_ *parser.Drop_functionContext) {
+	l.types["DROP_FUNCTION"] = true
+}
+
+// EnterInsert_statement is called when production insert_statement is entered.
+func (l *statementTypeListener) EnterInsert_statement(_ *parser.Insert_statementContext) {
+	l.types["INSERT"] = true
+}
+
+// EnterUpdate_statement is called when production update_statement is entered.
+func (l *statementTypeListener) EnterUpdate_statement(_ *parser.Update_statementContext) {
+	l.types["UPDATE"] = true
+}
+
+// EnterDelete_statement is called when production delete_statement is entered.
+func (l *statementTypeListener) EnterDelete_statement(_ *parser.Delete_statementContext) {
+	l.types["DELETE"] = true
+}
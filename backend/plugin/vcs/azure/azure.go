@@ -292,16 +292,9 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	values.Set("resolveLfs", "true")
 	values.Set("includeContent", "true")
 	values.Set("path", filePath)
-	var refType string
-	switch refInfo.RefType {
-	case vcs.RefTypeBranch:
-		refType = "branch"
-	case vcs.RefTypeTag:
-		refType = "tag"
-	case vcs.RefTypeCommit:
-		refType = "commit"
-	default:
-		return "", errors.Errorf("invalid ref type %q", refInfo.RefType)
+	refType, err := getVersionType(refInfo)
+	if err != nil {
+		return "", err
 	}
 	values.Set("versionDescriptor.versionType", refType)
 	values.Set("versionDescriptor.version", refInfo.RefName)
@@ -317,6 +310,72 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return string(body), nil
 }
 
+// Item is the API message for an item in an Azure DevOps repository.
+type Item struct {
+	Path     string `json:"path"`
+	IsFolder bool   `json:"isFolder"`
+}
+
+// ListDirectoryFiles lists the paths of the files in the directory.
+//
+// Docs: https://learn.microsoft.com/en-us/rest/api/azure/devops/git/items/list?view=azure-devops-rest-7.0
+func (p *Provider) ListDirectoryFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	apiURL, err := p.getRepositoryAPIURL(repositoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	values := &url.Values{}
+	values.Set("api-version", "7.0")
+	values.Set("scopePath", directory)
+	values.Set("recursionLevel", "OneLevel")
+	refType, err := getVersionType(refInfo)
+	if err != nil {
+		return nil, err
+	}
+	values.Set("versionDescriptor.versionType", refType)
+	values.Set("versionDescriptor.version", refInfo.RefName)
+	url := fmt.Sprintf("%s/items?%s", apiURL, values.Encode())
+
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list directory from URL %s", url)
+	}
+	if code != http.StatusOK {
+		return nil, errors.Errorf("non-200 GET %s status code %d with body %q", url, code, string(body))
+	}
+
+	var resp struct {
+		Value []Item `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	var files []string
+	for _, item := range resp.Value {
+		if !item.IsFolder {
+			files = append(files, strings.TrimPrefix(item.Path, "/"))
+		}
+	}
+	return files, nil
+}
+
+func getVersionType(refInfo vcs.RefInfo) (string, error) {
+	switch refInfo.RefType {
+	case vcs.RefTypeBranch:
+		return "branch", nil
+	case vcs.RefTypeTag:
+		return "tag", nil
+	case vcs.RefTypeCommit:
+		return "commit", nil
+	default:
+		return "", errors.Errorf("invalid ref type %q", refInfo.RefType)
+	}
+}
+
 type BranchCommit struct {
 	CommitID string `json:"commitId"`
 }
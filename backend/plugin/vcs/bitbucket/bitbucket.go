@@ -233,6 +233,51 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return body, nil
 }
 
+// SourceItem is the API message for an item in a Bitbucket Cloud repository directory.
+type SourceItem struct {
+	Path string `json:"path"`
+	// Available values: "commit_file", "commit_directory"
+	Type string `json:"type"`
+}
+
+// ListDirectoryFiles lists the paths of the files in the directory.
+//
+// Docs: https://developer.atlassian.com/cloud/bitbucket/rest/api-group-source/#api-repositories-workspace-repo-slug-src-commit-path-get
+func (p *Provider) ListDirectoryFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	var files []string
+	next := fmt.Sprintf("%s/repositories/%s/src/%s/%s/?pagelen=%d", p.APIURL(p.instanceURL), repositoryID, url.PathEscape(refInfo.RefName), url.PathEscape(strings.Trim(directory, "/")), apiPageSize)
+	for next != "" {
+		code, body, err := internal.Get(ctx, next, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", next)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list directory from URL %s", next)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list directory from URL %s, status code: %d, body: %s",
+				next,
+				code,
+				body,
+			)
+		}
+
+		var resp struct {
+			Values []SourceItem `json:"values"`
+			Next   string       `json:"next"`
+		}
+		if err := json.Unmarshal([]byte(body), &resp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, item := range resp.Values {
+			if item.Type == "commit_file" {
+				files = append(files, item.Path)
+			}
+		}
+		next = resp.Next
+	}
+	return files, nil
+}
+
 // Target is the API message for Bitbucket Cloud target.
 type Target struct {
 	Hash string `json:"hash"`
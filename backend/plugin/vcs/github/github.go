@@ -240,6 +240,45 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return body, nil
 }
 
+// RepositoryContent is the API message for an item in a GitHub repository directory.
+type RepositoryContent struct {
+	Path string `json:"path"`
+	// Available values: "file", "dir", "symlink", "submodule"
+	Type string `json:"type"`
+}
+
+// ListDirectoryFiles lists the paths of the files in the directory.
+//
+// Docs: https://docs.github.com/en/rest/repos/contents#get-repository-content
+func (p *Provider) ListDirectoryFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	url := fmt.Sprintf("%s/repos/%s/contents/%s?ref=%s", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(directory), refInfo.RefName)
+	code, body, err := internal.Get(ctx, url, p.getAuthorization())
+	if err != nil {
+		return nil, errors.Wrapf(err, "GET %s", url)
+	}
+	if code == http.StatusNotFound {
+		return nil, common.Errorf(common.NotFound, "failed to list directory from URL %s", url)
+	} else if code >= 300 {
+		return nil, errors.Errorf("failed to list directory from URL %s, status code: %d, body: %s",
+			url,
+			code,
+			body,
+		)
+	}
+
+	var contents []RepositoryContent
+	if err := json.Unmarshal([]byte(body), &contents); err != nil {
+		return nil, errors.Wrap(err, "unmarshal body")
+	}
+	var files []string
+	for _, content := range contents {
+		if content.Type == "file" {
+			files = append(files, content.Path)
+		}
+	}
+	return files, nil
+}
+
 // PullRequestFile is the API message for files in GitHub pull request.
 type PullRequestFile struct {
 	FileName string `json:"filename"`
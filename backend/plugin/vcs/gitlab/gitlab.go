@@ -240,6 +240,42 @@ func (p *Provider) ReadFileContent(ctx context.Context, repositoryID, filePath s
 	return file.Content, nil
 }
 
+// ListDirectoryFiles lists the paths of the files in the directory.
+//
+// Docs: https://docs.gitlab.com/ee/api/repositories.html#list-repository-tree
+func (p *Provider) ListDirectoryFiles(ctx context.Context, repositoryID, directory string, refInfo vcs.RefInfo) ([]string, error) {
+	var files []string
+	for page := 1; ; page++ {
+		url := fmt.Sprintf("%s/projects/%s/repository/tree?path=%s&ref=%s&per_page=%d&page=%d", p.APIURL(p.instanceURL), repositoryID, url.QueryEscape(directory), url.QueryEscape(refInfo.RefName), apiPageSize, page)
+		code, body, err := internal.Get(ctx, url, p.getAuthorization())
+		if err != nil {
+			return nil, errors.Wrapf(err, "GET %s", url)
+		}
+		if code == http.StatusNotFound {
+			return nil, common.Errorf(common.NotFound, "failed to list directory from URL %s", url)
+		} else if code >= 300 {
+			return nil, errors.Errorf("failed to list directory from URL %s, status code: %d, body: %s",
+				url,
+				code,
+				body,
+			)
+		}
+
+		var nodes []RepositoryTreeNode
+		if err := json.Unmarshal([]byte(body), &nodes); err != nil {
+			return nil, errors.Wrap(err, "unmarshal body")
+		}
+		for _, node := range nodes {
+			if node.Type == "blob" {
+				files = append(files, node.Path)
+			}
+		}
+		if len(nodes) < apiPageSize {
+			return files, nil
+		}
+	}
+}
+
 // MergeRequestChange is the API message for GitLab merge request changes.
 type MergeRequestChange struct {
 	SHA     string             `json:"sha"`
@@ -86,6 +86,9 @@ type Provider interface {
 	// Reads the file content
 	ReadFileContent(ctx context.Context, repositoryID, filePath string, refInfo RefInfo) (string, error)
 
+	// ListDirectoryFiles lists the paths of the files in the directory, not including the sub-directories.
+	ListDirectoryFiles(ctx context.Context, repositoryID, directory string, refInfo RefInfo) ([]string, error)
+
 	// GetBranch gets the given branch in the repository.
 	GetBranch(ctx context.Context, repositoryID, branchName string) (*BranchInfo, error)
 
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+)
+
+// opsgenieMessageLimit is the max length of the Opsgenie alert message.
+const opsgenieMessageLimit = 130
+
+// OpsgenieAlert is the API message for creating the Opsgenie alert.
+// ref: https://docs.opsgenie.com/docs/alert-api
+type OpsgenieAlert struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description,omitempty"`
+	Source      string            `json:"source"`
+	Priority    string            `json:"priority"`
+	Details     map[string]string `json:"details,omitempty"`
+}
+
+// OpsgenieCloseAlert is the API message for closing the Opsgenie alert.
+type OpsgenieCloseAlert struct {
+	Source string `json:"source"`
+	Note   string `json:"note,omitempty"`
+}
+
+func init() {
+	Register("bb.plugin.webhook.opsgenie", &OpsgenieReceiver{})
+}
+
+// OpsgenieReceiver is the receiver for Opsgenie.
+// It only posts the incidents as the alerts whose alias is the dedup key, and the other events are ignored.
+type OpsgenieReceiver struct {
+}
+
+func (*OpsgenieReceiver) Post(context Context) error {
+	if context.Incident == nil {
+		return nil
+	}
+	if context.IntegrationKey == "" {
+		return errors.Errorf("API key is required for Opsgenie webhook %s", context.URL)
+	}
+
+	baseURL := strings.TrimSuffix(context.URL, "/")
+	var endpoint string
+	var post any
+	switch context.Incident.Action {
+	case IncidentTrigger:
+		details := map[string]string{}
+		for _, meta := range context.GetMetaList() {
+			details[meta.Name] = meta.Value
+		}
+		description := context.Description
+		if context.Link != "" {
+			description = strings.TrimSpace(fmt.Sprintf("%s\n\n%s", description, context.Link))
+		}
+		message, _ := common.TruncateString(context.Incident.Summary, opsgenieMessageLimit)
+		endpoint = fmt.Sprintf("%s/v2/alerts", baseURL)
+		post = OpsgenieAlert{
+			Message:     message,
+			Alias:       context.Incident.DedupKey,
+			Description: description,
+			Source:      "Bytebase",
+			Priority:    "P1",
+			Details:     details,
+		}
+	case IncidentResolve:
+		endpoint = fmt.Sprintf("%s/v2/alerts/%s/close?identifierType=alias", baseURL, url.PathEscape(context.Incident.DedupKey))
+		post = OpsgenieCloseAlert{
+			Source: "Bytebase",
+			Note:   context.Incident.Summary,
+		}
+	default:
+		return errors.Errorf("unknown incident action %q", context.Incident.Action)
+	}
+
+	body, err := json.Marshal(post)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", endpoint)
+	}
+	req, err := http.NewRequest("POST",
+		endpoint, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct webhook POST request to %s", endpoint)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("GenieKey %s", context.IntegrationKey))
+	client := &http.Client{
+		Timeout: Timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST webhook to %s", endpoint)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read POST webhook response from %s", endpoint)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", endpoint, resp.StatusCode, b)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// PagerDutyEvent is the API message for PagerDuty Events API v2.
+// ref: https://developer.pagerduty.com/docs/events-api-v2/overview/
+type PagerDutyEvent struct {
+	RoutingKey  string                 `json:"routing_key"`
+	EventAction string                 `json:"event_action"`
+	DedupKey    string                 `json:"dedup_key"`
+	Payload     *PagerDutyEventPayload `json:"payload,omitempty"`
+	Links       []PagerDutyEventLink   `json:"links,omitempty"`
+}
+
+// PagerDutyEventPayload is the API message for PagerDuty event payload.
+type PagerDutyEventPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// PagerDutyEventLink is the API message for PagerDuty event link.
+type PagerDutyEventLink struct {
+	Href string `json:"href"`
+	Text string `json:"text"`
+}
+
+func init() {
+	Register("bb.plugin.webhook.pagerduty", &PagerDutyReceiver{})
+}
+
+// PagerDutyReceiver is the receiver for PagerDuty.
+// It only posts the incidents, and the other events are ignored.
+type PagerDutyReceiver struct {
+}
+
+func (*PagerDutyReceiver) Post(context Context) error {
+	if context.Incident == nil {
+		return nil
+	}
+	if context.IntegrationKey == "" {
+		return errors.Errorf("routing key is required for PagerDuty webhook %s", context.URL)
+	}
+
+	post := PagerDutyEvent{
+		RoutingKey:  context.IntegrationKey,
+		EventAction: string(context.Incident.Action),
+		DedupKey:    context.Incident.DedupKey,
+	}
+	// The payload is only required to trigger the incident.
+	if context.Incident.Action == IncidentTrigger {
+		details := map[string]string{}
+		for _, meta := range context.GetMetaList() {
+			details[meta.Name] = meta.Value
+		}
+		post.Payload = &PagerDutyEventPayload{
+			Summary:       context.Incident.Summary,
+			Source:        "Bytebase",
+			Severity:      "critical",
+			CustomDetails: details,
+		}
+		if context.Link != "" {
+			post.Links = []PagerDutyEventLink{{Href: context.Link, Text: "View in Bytebase"}}
+		}
+	}
+	body, err := json.Marshal(post)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal webhook POST request to %s", context.URL)
+	}
+	req, err := http.NewRequest("POST",
+		context.URL, bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrapf(err, "failed to construct webhook POST request to %s", context.URL)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	client := &http.Client{
+		Timeout: Timeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to POST webhook to %s", context.URL)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read POST webhook response from %s", context.URL)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return errors.Errorf("failed to POST webhook %s, status code: %d, response body: %s", context.URL, resp.StatusCode, b)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagerDutyReceiver(t *testing.T) {
+	a := require.New(t)
+	var events []*PagerDutyEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		event := &PagerDutyEvent{}
+		if err := json.NewDecoder(r.Body).Decode(event); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		events = append(events, event)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	receiver := &PagerDutyReceiver{}
+	context := Context{
+		URL:            server.URL,
+		Link:           "https://bytebase.example.com/projects/pay/issues/add-index-1",
+		IntegrationKey: "routing-key",
+		TaskResult:     &TaskResult{Name: "add index", Status: "FAILED"},
+	}
+	// The events without the incident are ignored.
+	a.NoError(receiver.Post(context))
+	a.Empty(events)
+
+	context.Incident = &Incident{Action: IncidentTrigger, DedupKey: "bytebase-issue-1", Summary: "Task run failed in production: add index"}
+	a.NoError(receiver.Post(context))
+	context.Incident = &Incident{Action: IncidentResolve, DedupKey: "bytebase-issue-1"}
+	a.NoError(receiver.Post(context))
+
+	a.Len(events, 2)
+	a.Equal("trigger", events[0].EventAction)
+	a.Equal("routing-key", events[0].RoutingKey)
+	a.Equal("bytebase-issue-1", events[0].DedupKey)
+	a.Equal("Task run failed in production: add index", events[0].Payload.Summary)
+	a.Equal("FAILED", events[0].Payload.CustomDetails["Status"])
+	a.Equal("resolve", events[1].EventAction)
+	a.Equal("bytebase-issue-1", events[1].DedupKey)
+	a.Nil(events[1].Payload)
+
+	context.IntegrationKey = ""
+	a.Error(receiver.Post(context))
+}
@@ -2,11 +2,17 @@ package slack
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -42,6 +48,16 @@ type lookupByEmailResponse struct {
 	} `json:"user"`
 }
 
+type usersInfoResponse struct {
+	OK    bool   `json:"ok"`
+	Error string `json:"error"`
+	User  struct {
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+	} `json:"user"`
+}
+
 type conversationsOpenResponse struct {
 	OK      bool   `json:"ok"`
 	Error   string `json:"error"`
@@ -141,6 +157,51 @@ func (p *provider) lookupByEmail(ctx context.Context, email string) (string, err
 	return res.User.ID, nil
 }
 
+// GetUserEmail gets the email of the Slack user, which requires the users:read.email scope.
+func GetUserEmail(ctx context.Context, token string, userID string) (string, error) {
+	return newProvider(token).usersInfo(ctx, userID)
+}
+
+// https://api.slack.com/methods/users.info
+func (p *provider) usersInfo(ctx context.Context, userID string) (string, error) {
+	q := url.Values{}
+	q.Set("user", userID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://slack.com/api/users.info", nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to new request")
+	}
+	req.URL.RawQuery = q.Encode()
+	req.Header.Add("Authorization", "Bearer "+p.token)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to send GET request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read body")
+	}
+	var res usersInfoResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+	if !res.OK {
+		return "", errors.Errorf("failed to get user, error: %v", res.Error)
+	}
+	if res.User.Profile.Email == "" {
+		return "", errors.Errorf("the email of user %s is not visible", userID)
+	}
+
+	return res.User.Profile.Email, nil
+}
+
 // https://api.slack.com/methods/conversations.open
 func (p *provider) openConversation(ctx context.Context, userID string) (string, error) {
 	data := url.Values{}
@@ -220,3 +281,24 @@ func (p *provider) chatPostMessage(ctx context.Context, channelID string, webhoo
 
 	return nil
 }
+
+// VerifySignature verifies the signature of the request sent by the Slack app, e.g. the slash commands.
+// The request older than 5 minutes is rejected to prevent the replay attacks.
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func VerifySignature(signingSecret, timestamp, signature string, body []byte, now time.Time) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid timestamp %q", timestamp)
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > 5*time.Minute || d < -5*time.Minute {
+		return errors.New("the request is expired")
+	}
+	mac := hmac.New(sha256.New, []byte(signingSecret))
+	_, _ = fmt.Fprintf(mac, "v0:%s:", timestamp)
+	_, _ = mac.Write(body)
+	want := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(signature), []byte(want)) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
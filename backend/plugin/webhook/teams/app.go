@@ -0,0 +1,159 @@
+// Package teams is the Microsoft Teams app to receive the ChatOps commands from the outgoing webhook.
+package teams
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type provider struct {
+	c            *http.Client
+	tenantID     string
+	clientID     string
+	clientSecret string
+}
+
+func newProvider(tenantID, clientID, clientSecret string) *provider {
+	return &provider{
+		c:            &http.Client{},
+		tenantID:     tenantID,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+	}
+}
+
+// Validate validates the security token of the outgoing webhook and the Microsoft Entra application.
+func Validate(ctx context.Context, securityToken, tenantID, clientID, clientSecret string) error {
+	if _, err := base64.StdEncoding.DecodeString(securityToken); err != nil {
+		return errors.Wrapf(err, "invalid security token")
+	}
+	if _, err := newProvider(tenantID, clientID, clientSecret).getToken(ctx); err != nil {
+		return errors.Wrapf(err, "failed to get token")
+	}
+	return nil
+}
+
+// VerifySignature verifies the HMAC signature in the Authorization header of the outgoing webhook request.
+// https://learn.microsoft.com/en-us/microsoftteams/platform/webhooks-and-connectors/how-to/add-outgoing-webhook
+func VerifySignature(securityToken, authorization string, body []byte) error {
+	signature, ok := strings.CutPrefix(authorization, "HMAC ")
+	if !ok {
+		return errors.New("missing HMAC signature")
+	}
+	key, err := base64.StdEncoding.DecodeString(securityToken)
+	if err != nil {
+		return errors.Wrapf(err, "invalid security token")
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(body)
+	want := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(signature), []byte(want)) {
+		return errors.New("invalid HMAC signature")
+	}
+	return nil
+}
+
+// GetUserEmail gets the email of the Teams user by the Microsoft Entra object id.
+func GetUserEmail(ctx context.Context, tenantID, clientID, clientSecret, objectID string) (string, error) {
+	return newProvider(tenantID, clientID, clientSecret).getUserEmail(ctx, objectID)
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+type userResponse struct {
+	Mail              string `json:"mail"`
+	UserPrincipalName string `json:"userPrincipalName"`
+}
+
+// https://learn.microsoft.com/en-us/entra/identity-platform/v2-oauth2-client-creds-grant-flow
+func (p *provider) getToken(ctx context.Context) (string, error) {
+	data := url.Values{}
+	data.Set("grant_type", "client_credentials")
+	data.Set("client_id", p.clientID)
+	data.Set("client_secret", p.clientSecret)
+	data.Set("scope", "https://graph.microsoft.com/.default")
+	u := fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", url.PathEscape(p.tenantID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to new request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to send request")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read body")
+	}
+	var res tokenResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+	if res.Error != "" {
+		return "", errors.Errorf("failed to get token, error: %v, %v", res.Error, res.ErrorDescription)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+
+	return res.AccessToken, nil
+}
+
+// https://learn.microsoft.com/en-us/graph/api/user-get
+func (p *provider) getUserEmail(ctx context.Context, objectID string) (string, error) {
+	token, err := p.getToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	u := fmt.Sprintf("https://graph.microsoft.com/v1.0/users/%s?$select=mail,userPrincipalName", url.PathEscape(objectID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to new request")
+	}
+	req.Header.Add("Authorization", "Bearer "+token)
+
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to send GET request")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.Errorf("received non-200 status code %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read body")
+	}
+	var res userResponse
+	if err := json.Unmarshal(body, &res); err != nil {
+		return "", errors.Wrapf(err, "failed to unmarshal")
+	}
+	// The mail is empty for the users without the Exchange mailbox, whose principal name is usually the email.
+	if res.Mail != "" {
+		return res.Mail, nil
+	}
+	if res.UserPrincipalName == "" {
+		return "", errors.Errorf("the email of user %s is not found", objectID)
+	}
+	return res.UserPrincipalName, nil
+}
@@ -69,6 +69,24 @@ type Project struct {
 	Name string `json:"name"`
 }
 
+// IncidentAction is the action on the incident of the incident webhooks, e.g. PagerDuty.
+type IncidentAction string
+
+const (
+	// IncidentTrigger opens the incident.
+	IncidentTrigger IncidentAction = "trigger"
+	// IncidentResolve resolves the incident.
+	IncidentResolve IncidentAction = "resolve"
+)
+
+// Incident is the incident of the failed production rollout.
+type Incident struct {
+	Action IncidentAction
+	// DedupKey identifies the incident so that the incidents of the same issue are deduplicated.
+	DedupKey string
+	Summary  string
+}
+
 // Context is the context of webhook.
 type Context struct {
 	URL                 string
@@ -91,6 +109,11 @@ type Context struct {
 
 	DirectMessage bool
 	IMSetting     *storepb.AppIMSetting
+
+	// Incident is only set for the task run status updates of the production stages.
+	Incident *Incident
+	// IntegrationKey is the key of the incident webhooks.
+	IntegrationKey string
 }
 
 // Receiver is the webhook receiver.
@@ -0,0 +1,187 @@
+package approval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	openai "github.com/sashabaranov/go-openai"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/ai"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// aiReviewStatementLimit is the maximum characters of the statements sent to the provider.
+	aiReviewStatementLimit = 20000
+	aiReviewTimeout        = 2 * time.Minute
+	aiReviewQueueSize      = 100
+)
+
+const aiReviewSystemPrompt = `You are a database administrator reviewing the SQL statements of a database change before it's approved.
+Summarize what the statements change in a few sentences of plain language for the approvers.
+Flag the risky patterns, e.g. dropping or truncating tables and columns, UPDATE or DELETE without WHERE, locking DDL on large tables, changing column types, and revoking or granting privileges.
+Respond with a JSON object only, like {"summary": "...", "risks": [{"statement": "...", "reason": "..."}]}. Use an empty risks array if nothing is risky.`
+
+type aiReview struct {
+	Summary string          `json:"summary"`
+	Risks   []*aiReviewRisk `json:"risks"`
+}
+
+type aiReviewRisk struct {
+	Statement string `json:"statement"`
+	Reason    string `json:"reason"`
+}
+
+// runAIReview reviews the issues queued when their approval finding completes.
+func (r *Runner) runAIReview(ctx context.Context) {
+	for {
+		select {
+		case issueUID := <-r.aiReviewQueue:
+			if err := r.reviewIssue(ctx, issueUID); err != nil {
+				slog.Warn("failed to review issue with AI", slog.Int("issue", issueUID), log.BBError(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// enqueueAIReview queues the issue for the AI review, the issue is skipped if the queue is full
+// so that the approval finding is never blocked by the provider.
+func (r *Runner) enqueueAIReview(issue *store.IssueMessage) {
+	if issue.PlanUID == nil {
+		return
+	}
+	select {
+	case r.aiReviewQueue <- issue.UID:
+	default:
+		slog.Warn("AI review queue is full, skip reviewing issue", slog.Int("issue", issue.UID))
+	}
+}
+
+func (r *Runner) reviewIssue(ctx context.Context, issueUID int) error {
+	// Check the setting right before sending the statements, so no data is sent once it's disabled.
+	setting, err := r.store.GetAISetting(ctx)
+	if err != nil {
+		return err
+	}
+	if !setting.Enabled || !setting.ReviewEnabled || r.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI) != nil {
+		return nil
+	}
+	config, err := ai.NewConfig(setting, r.secret)
+	if err != nil {
+		return err
+	}
+
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %d", issueUID)
+	}
+	if issue == nil || issue.PlanUID == nil {
+		return nil
+	}
+	statements, err := r.getPlanStatements(ctx, *issue.PlanUID)
+	if err != nil {
+		return err
+	}
+	if statements == "" {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, aiReviewTimeout)
+	defer cancel()
+	content, err := ai.Complete(ctx, config, []openai.ChatCompletionMessage{
+		{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: aiReviewSystemPrompt,
+		},
+		{
+			Role:    openai.ChatMessageRoleUser,
+			Content: statements,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := r.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+		IssueUID: issue.UID,
+		Payload: &storepb.IssueCommentPayload{
+			Comment: formatAIReview(parseAIReview(content)),
+		},
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to create issue comment")
+	}
+	return nil
+}
+
+// getPlanStatements gets the statements of the plan specs, which are prefixed with the targets.
+func (r *Runner) getPlanStatements(ctx context.Context, planUID int64) (string, error) {
+	plan, err := r.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planUID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get plan %d", planUID)
+	}
+	if plan == nil {
+		return "", nil
+	}
+	var b strings.Builder
+	for _, step := range plan.Config.GetSteps() {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil {
+				continue
+			}
+			_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to get sheet id from %q", config.Sheet)
+			}
+			statement, err := r.store.GetSheetStatementByID(ctx, sheetUID)
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to get statement of sheet %d", sheetUID)
+			}
+			_, _ = fmt.Fprintf(&b, "-- Target: %s\n%s\n\n", config.Target, statement)
+		}
+	}
+	statements, _ := common.TruncateString(b.String(), aiReviewStatementLimit)
+	return statements, nil
+}
+
+// parseAIReview parses the JSON response, the whole content is used as the summary if it's not JSON.
+func parseAIReview(content string) *aiReview {
+	content = strings.TrimSpace(content)
+	// The models may wrap the JSON in the markdown code block.
+	trimmed := strings.TrimPrefix(content, "```json")
+	trimmed = strings.TrimPrefix(trimmed, "```")
+	trimmed = strings.TrimSuffix(strings.TrimSpace(trimmed), "```")
+	review := &aiReview{}
+	if err := json.Unmarshal([]byte(trimmed), review); err != nil || review.Summary == "" {
+		return &aiReview{Summary: content}
+	}
+	return review
+}
+
+func formatAIReview(review *aiReview) string {
+	var b strings.Builder
+	_, _ = b.WriteString("AI review summary:\n")
+	_, _ = b.WriteString(review.Summary)
+	if len(review.Risks) > 0 {
+		_, _ = b.WriteString("\n\nFlagged risks:")
+		for _, risk := range review.Risks {
+			if risk.Statement == "" {
+				_, _ = fmt.Fprintf(&b, "\n- %s", risk.Reason)
+				continue
+			}
+			_, _ = fmt.Fprintf(&b, "\n- `%s`: %s", risk.Statement, risk.Reason)
+		}
+	}
+	_, _ = b.WriteString("\n\nThe review is generated by AI and may be inaccurate.")
+	return b.String()
+}
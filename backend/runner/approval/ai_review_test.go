@@ -0,0 +1,44 @@
+package approval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAIReview(t *testing.T) {
+	tests := []struct {
+		content string
+		want    *aiReview
+	}{
+		{
+			content: `{"summary": "Drop the users table.", "risks": [{"statement": "DROP TABLE users", "reason": "The data is lost."}]}`,
+			want: &aiReview{
+				Summary: "Drop the users table.",
+				Risks:   []*aiReviewRisk{{Statement: "DROP TABLE users", Reason: "The data is lost."}},
+			},
+		},
+		{
+			content: "```json\n{\"summary\": \"Add a column.\", \"risks\": []}\n```",
+			want:    &aiReview{Summary: "Add a column.", Risks: []*aiReviewRisk{}},
+		},
+		{
+			content: "Add a column to the users table.",
+			want:    &aiReview{Summary: "Add a column to the users table."},
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, parseAIReview(test.content), test.content)
+	}
+}
+
+func TestFormatAIReview(t *testing.T) {
+	got := formatAIReview(&aiReview{
+		Summary: "Drop the users table.",
+		Risks: []*aiReviewRisk{
+			{Statement: "DROP TABLE users", Reason: "The data is lost."},
+			{Reason: "No backup is taken."},
+		},
+	})
+	require.Equal(t, "AI review summary:\nDrop the users table.\n\nFlagged risks:\n- `DROP TABLE users`: The data is lost.\n- No backup is taken.\n\nThe review is generated by AI and may be inaccurate.", got)
+}
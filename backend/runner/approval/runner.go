@@ -25,7 +25,6 @@ import (
 	"github.com/bytebase/bytebase/backend/component/webhook"
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
-	"github.com/bytebase/bytebase/backend/runner/relay"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -39,20 +38,22 @@ type Runner struct {
 	dbFactory      *dbfactory.DBFactory
 	stateCfg       *state.State
 	webhookManager *webhook.Manager
-	relayRunner    *relay.Runner
 	licenseService enterprise.LicenseService
+	secret         string
+	aiReviewQueue  chan int
 }
 
 // NewRunner creates a new runner.
-func NewRunner(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, stateCfg *state.State, webhookManager *webhook.Manager, relayRunner *relay.Runner, licenseService enterprise.LicenseService) *Runner {
+func NewRunner(store *store.Store, sheetManager *sheet.Manager, dbFactory *dbfactory.DBFactory, stateCfg *state.State, webhookManager *webhook.Manager, licenseService enterprise.LicenseService, secret string) *Runner {
 	return &Runner{
 		store:          store,
 		sheetManager:   sheetManager,
 		dbFactory:      dbFactory,
 		stateCfg:       stateCfg,
 		webhookManager: webhookManager,
-		relayRunner:    relayRunner,
 		licenseService: licenseService,
+		secret:         secret,
+		aiReviewQueue:  make(chan int, aiReviewQueueSize),
 	}
 }
 
@@ -65,6 +66,7 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	defer wg.Done()
 	slog.Debug(fmt.Sprintf("Approval runner started and will run every %v", approvalRunnerInterval))
 	r.retryFindApprovalTemplate(ctx)
+	go r.runAIReview(ctx)
 
 	for {
 		select {
@@ -198,7 +200,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 		payload.Approval.ApprovalTemplates = []*storepb.ApprovalTemplate{approvalTemplate}
 	}
 
-	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, r.store, r.relayRunner.Client, issue, payload.Approval)
+	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, r.store, issue, payload.Approval)
 	if err != nil {
 		err = errors.Wrapf(err, "failed to handle incoming approval steps")
 		if updateErr := updateIssueApprovalPayload(ctx, r.store, issue, &storepb.IssuePayloadApproval{
@@ -214,6 +216,7 @@ func (r *Runner) findApprovalTemplateForIssue(ctx context.Context, issue *store.
 	if err := updateIssueApprovalPayload(ctx, r.store, issue, payload.Approval); err != nil {
 		return false, errors.Wrap(err, "failed to update issue payload")
 	}
+	r.enqueueAIReview(issue)
 
 	if err := func() error {
 		for _, ic := range issueComments {
@@ -328,10 +331,14 @@ func getIssueRisk(ctx context.Context, s *store.Store, sheetManager *sheet.Manag
 	switch issue.Type {
 	case api.IssueGrantRequest:
 		return getGrantRequestIssueRisk(ctx, s, issue, risks)
-	case api.IssueDatabaseGeneral:
+	case api.IssueDatabaseGeneral, api.IssueDatabaseCreate:
 		return getDatabaseGeneralIssueRisk(ctx, s, sheetManager, licenseService, dbFactory, issue, risks)
 	case api.IssueDatabaseDataExport:
-		return getDatabaseDataExportIssueRisk(ctx, s, sheetManager, licenseService, dbFactory, issue, risks)
+		return getDatabaseTaskIssueRisk(ctx, s, sheetManager, licenseService, dbFactory, issue, risks, api.TaskDatabaseDataExport, store.RiskSourceDatabaseDataExport)
+	case api.IssueDatabaseRestore:
+		return getDatabaseTaskIssueRisk(ctx, s, sheetManager, licenseService, dbFactory, issue, risks, api.TaskDatabaseRestore, store.RiskSourceDatabaseRestore)
+	case api.IssueDatabaseDataLoad:
+		return getDatabaseTaskIssueRisk(ctx, s, sheetManager, licenseService, dbFactory, issue, risks, api.TaskDatabaseDataLoad, store.RiskSourceDatabaseDataLoad)
 	default:
 		return 0, store.RiskSourceUnknown, false, errors.Errorf("unknown issue type %v", issue.Type)
 	}
@@ -552,7 +559,9 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, sheetManag
 	return maxRiskLevel, riskSource, true, nil
 }
 
-func getDatabaseDataExportIssueRisk(ctx context.Context, s *store.Store, sheetManager *sheet.Manager, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, issue *store.IssueMessage, risks []*store.RiskMessage) (int32, store.RiskSource, bool, error) {
+// getDatabaseTaskIssueRisk returns the max risk level of the tasks with the task type in the issue,
+// the risks are evaluated with the database factors only.
+func getDatabaseTaskIssueRisk(ctx context.Context, s *store.Store, sheetManager *sheet.Manager, licenseService enterprise.LicenseService, dbFactory *dbfactory.DBFactory, issue *store.IssueMessage, risks []*store.RiskMessage, taskType api.TaskType, riskSource store.RiskSource) (int32, store.RiskSource, bool, error) {
 	if issue.PlanUID == nil {
 		return 0, store.RiskSourceUnknown, false, errors.Errorf("expected plan UID in issue %v", issue.UID)
 	}
@@ -569,8 +578,6 @@ func getDatabaseDataExportIssueRisk(ctx context.Context, s *store.Store, sheetMa
 		return 0, store.RiskSourceUnknown, false, errors.Wrap(err, "failed to get pipeline create")
 	}
 
-	riskSource := store.RiskSourceDatabaseDataExport
-
 	e, err := cel.NewEnv(common.RiskFactors...)
 	if err != nil {
 		return 0, store.RiskSourceUnknown, false, err
@@ -579,7 +586,7 @@ func getDatabaseDataExportIssueRisk(ctx context.Context, s *store.Store, sheetMa
 	var maxRiskLevel int32
 	for _, stage := range pipelineCreate.Stages {
 		for _, task := range stage.TaskList {
-			if task.Type != api.TaskDatabaseDataExport {
+			if task.Type != taskType {
 				continue
 			}
 			instance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{
@@ -592,11 +599,6 @@ func getDatabaseDataExportIssueRisk(ctx context.Context, s *store.Store, sheetMa
 				continue
 			}
 
-			payload := &storepb.TaskDatabaseDataExportPayload{}
-			if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
-				return 0, store.RiskSourceUnknown, false, err
-			}
-
 			database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
 				UID: task.DatabaseID,
 			})
@@ -863,6 +865,10 @@ func convertToSource(source store.RiskSource) v1pb.Risk_Source {
 		return v1pb.Risk_REQUEST_EXPORT
 	case store.RiskSourceDatabaseDataExport:
 		return v1pb.Risk_DATA_EXPORT
+	case store.RiskSourceDatabaseRestore:
+		return v1pb.Risk_DATABASE_RESTORE
+	case store.RiskSourceDatabaseDataLoad:
+		return v1pb.Risk_DATA_LOAD
 	}
 	return v1pb.Risk_SOURCE_UNSPECIFIED
 }
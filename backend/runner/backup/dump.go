@@ -0,0 +1,127 @@
+package backup
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/secret"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// IsEngineSupported returns true if the logical backup is supported for the engine.
+func IsEngineSupported(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		return true
+	default:
+		return false
+	}
+}
+
+// getDumpCommand returns the pg_dump or mysqldump command dumping the database to the stdout with the admin data source.
+func (r *Runner) getDumpCommand(ctx context.Context, instance *store.InstanceMessage, databaseName string) (*exec.Cmd, error) {
+	dataSource, password, err := r.getAdminDataSource(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		args := append([]string{"--dbname", databaseName}, getPGConnectionArgs(dataSource)...)
+		cmd := exec.CommandContext(ctx, filepath.Join(r.pgBinDir, "pg_dump"), args...)
+		cmd.Env = getPGEnv(dataSource, password)
+		return cmd, nil
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		args := []string{
+			"--single-transaction",
+			"--hex-blob",
+			"--triggers",
+			// The column statistics are not available before MySQL 8.0.
+			"--column-statistics=0",
+		}
+		if instance.Engine == storepb.Engine_MYSQL || instance.Engine == storepb.Engine_MARIADB {
+			args = append(args, "--routines", "--events")
+		}
+		args = append(args, getMySQLConnectionArgs(dataSource)...)
+		args = append(args, databaseName)
+		cmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQLDump, r.mysqlBinDir), args...)
+		cmd.Env = append(os.Environ(), "MYSQL_PWD="+password)
+		return cmd, nil
+	default:
+		return nil, errors.Errorf("backup is not supported for engine %v", instance.Engine)
+	}
+}
+
+// getAdminDataSource returns the admin data source of the instance and its password.
+// The command line tools can only connect to the data source directly with the password.
+func (r *Runner) getAdminDataSource(ctx context.Context, instance *store.InstanceMessage) (*store.DataSourceMessage, string, error) {
+	dataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+	if dataSource == nil {
+		return nil, "", errors.Errorf("admin data source not found for instance %q", instance.Title)
+	}
+	if dataSource.SSHHost != "" {
+		return nil, "", errors.Errorf("backup is not supported for the data source with SSH tunnel")
+	}
+	switch dataSource.AuthenticationType {
+	case storepb.DataSourceOptions_AUTHENTICATION_UNSPECIFIED, storepb.DataSourceOptions_PASSWORD:
+	default:
+		return nil, "", errors.Errorf("backup is not supported for the authentication type %v", dataSource.AuthenticationType)
+	}
+	password, err := common.Unobfuscate(dataSource.ObfuscatedPassword, r.secret)
+	if err != nil {
+		return nil, "", err
+	}
+	password, err = secret.ReplaceExternalSecret(ctx, password, dataSource.ExternalSecret)
+	if err != nil {
+		return nil, "", err
+	}
+	return dataSource, password, nil
+}
+
+func getPGConnectionArgs(dataSource *store.DataSourceMessage) []string {
+	args := []string{"--no-password"}
+	if dataSource.Host != "" {
+		args = append(args, "--host", dataSource.Host)
+	}
+	if dataSource.Port != "" {
+		args = append(args, "--port", dataSource.Port)
+	}
+	if dataSource.Username != "" {
+		args = append(args, "--username", dataSource.Username)
+	}
+	return args
+}
+
+func getPGEnv(dataSource *store.DataSourceMessage, password string) []string {
+	env := append(os.Environ(), "PGPASSWORD="+password, "PGCONNECT_TIMEOUT=10")
+	if dataSource.UseSSL {
+		env = append(env, "PGSSLMODE=require")
+	}
+	return env
+}
+
+func getMySQLConnectionArgs(dataSource *store.DataSourceMessage) []string {
+	args := []string{"--connect-timeout=10"}
+	if dataSource.Host != "" {
+		args = append(args, "--host", dataSource.Host)
+	}
+	if dataSource.Port != "" {
+		args = append(args, "--port", dataSource.Port)
+	}
+	if dataSource.Username != "" {
+		args = append(args, "--user", dataSource.Username)
+	}
+	if dataSource.UseSSL {
+		args = append(args, "--ssl-mode=REQUIRED")
+	}
+	return args
+}
@@ -0,0 +1,188 @@
+package backup
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// The encrypted backup is the salt of the key derivation followed by the chunks.
+// Each chunk is the big-endian uint32 length of the sealed chunk followed by the sealed chunk,
+// the nonce is the big-endian chunk index, and the additional data marks the last chunk so that the truncation is detected.
+// The backup can be decrypted by deriving the key with scrypt(passphrase, salt, N=32768, r=8, p=1, keyLen=32).
+const (
+	encryptionSaltSize  = 16
+	encryptionChunkSize = 64 * 1024
+)
+
+var (
+	encryptionChunkData     = []byte{0}
+	encryptionLastChunkData = []byte{1}
+)
+
+// encryptWriter encrypts the data with AES-256-GCM in chunks, so that the backups of any size can be streamed.
+type encryptWriter struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+}
+
+func newEncryptWriter(w io.Writer, passphrase string) (*encryptWriter, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := newEncryptionAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(salt); err != nil {
+		return nil, err
+	}
+	return &encryptWriter{
+		w:    w,
+		aead: aead,
+		buf:  make([]byte, 0, encryptionChunkSize),
+	}, nil
+}
+
+func newEncryptionAEAD(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to derive the encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// The full chunk is only sealed when there is more data, because the last chunk is sealed by Close.
+		if len(e.buf) == encryptionChunkSize {
+			if err := e.seal(false); err != nil {
+				return 0, err
+			}
+		}
+		size := min(encryptionChunkSize-len(e.buf), len(p))
+		e.buf = append(e.buf, p[:size]...)
+		p = p[size:]
+	}
+	return n, nil
+}
+
+// Close seals the last chunk. It doesn't close the underlying writer.
+func (e *encryptWriter) Close() error {
+	return e.seal(true)
+}
+
+func (e *encryptWriter) seal(last bool) error {
+	nonce := make([]byte, e.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], e.index)
+	additionalData := encryptionChunkData
+	if last {
+		additionalData = encryptionLastChunkData
+	}
+	sealed := e.aead.Seal(nil, nonce, e.buf, additionalData)
+	var length [4]byte
+	binary.BigEndian.PutUint32(length[:], uint32(len(sealed)))
+	if _, err := e.w.Write(length[:]); err != nil {
+		return err
+	}
+	if _, err := e.w.Write(sealed); err != nil {
+		return err
+	}
+	e.index++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+// decryptReader decrypts the backup encrypted by encryptWriter.
+type decryptReader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+	done  bool
+}
+
+func newDecryptReader(r io.Reader, passphrase string) (*decryptReader, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := io.ReadFull(r, salt); err != nil {
+		return nil, errors.Wrapf(err, "failed to read the salt")
+	}
+	aead, err := newEncryptionAEAD(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{
+		r:    bufio.NewReader(r),
+		aead: aead,
+	}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptReader) open() error {
+	var length [4]byte
+	if _, err := io.ReadFull(d.r, length[:]); err != nil {
+		// The backup ends without the last chunk.
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	size := binary.BigEndian.Uint32(length[:])
+	if size > uint32(encryptionChunkSize+d.aead.Overhead()) {
+		return errors.Errorf("invalid chunk size %d", size)
+	}
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(d.r, sealed); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	_, err := d.r.Peek(1)
+	last := err == io.EOF
+	if err != nil && !last {
+		return err
+	}
+
+	nonce := make([]byte, d.aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], d.index)
+	additionalData := encryptionChunkData
+	if last {
+		additionalData = encryptionLastChunkData
+	}
+	chunk, err := d.aead.Open(sealed[:0], nonce, sealed, additionalData)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decrypt the backup, the encryption key may be wrong or the backup is corrupted")
+	}
+	d.buf = chunk
+	d.index++
+	d.done = last
+	return nil
+}
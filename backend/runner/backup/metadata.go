@@ -0,0 +1,394 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	// metadataBackupCheckInterval is the interval to check if the scheduled metadata backup is due.
+	metadataBackupCheckInterval = 10 * time.Minute
+	// metadataBackupDir is the directory of the metadata backups under the prefix of the storage.
+	metadataBackupDir = "metadata"
+	// metadataBackupNameFormat is the time format of the metadata backup name.
+	metadataBackupNameFormat = "20060102T150405Z"
+	// LatestMetadataBackup is the alias of the latest metadata backup.
+	LatestMetadataBackup = "latest"
+)
+
+// MetadataBackup is the manifest of the metadata backup, it's uploaded next to the backup after the backup is uploaded,
+// so that the backups without the manifest are incomplete and never restored.
+type MetadataBackup struct {
+	// Name is the creation time of the backup in 20060102T150405Z format.
+	Name string `json:"name"`
+	// Path is the object key of the backup.
+	Path       string    `json:"path"`
+	CreateTime time.Time `json:"createTime"`
+	// Size is the size of the uploaded object.
+	Size int64 `json:"size"`
+	// SHA256 is the hex encoded SHA-256 checksum of the uploaded object.
+	SHA256    string `json:"sha256"`
+	Encrypted bool   `json:"encrypted"`
+	// Version is the version of the server taking the backup.
+	Version string `json:"version"`
+}
+
+// MetadataBackupRunner takes the scheduled backups of the metadata database to the object storage with pg_dump,
+// and restores the metadata database from the backups with psql.
+// pg_dump dumps the database in a single repeatable read transaction, so the backup is a consistent snapshot.
+type MetadataBackupRunner struct {
+	profile  *config.Profile
+	storage  *storepb.BackupStorage
+	connCfg  dbdriver.ConnectionConfig
+	pgBinDir string
+}
+
+// NewMetadataBackupRunner creates a metadata backup runner, it returns nil if the metadata backup URI is not set.
+func NewMetadataBackupRunner(profile *config.Profile, connCfg dbdriver.ConnectionConfig, pgBinDir string) (*MetadataBackupRunner, error) {
+	if profile.MetadataBackupURI == "" {
+		return nil, nil
+	}
+	storage, err := objectstorage.ParseObjectURI(profile.MetadataBackupURI)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid metadata backup URI")
+	}
+	return &MetadataBackupRunner{
+		profile:  profile,
+		storage:  storage,
+		connCfg:  connCfg,
+		pgBinDir: pgBinDir,
+	}, nil
+}
+
+// Run runs the scheduled metadata backups.
+// Every replica checks the latest backup in the storage, so the backup is taken by the replicas once per interval in most cases.
+func (r *MetadataBackupRunner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(metadataBackupCheckInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Metadata backup runner started and will take a backup every %v", r.profile.MetadataBackupInterval))
+	for {
+		r.runOnce(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *MetadataBackupRunner) runOnce(ctx context.Context) {
+	backups, err := r.List(ctx)
+	if err != nil {
+		slog.Error("failed to list metadata backups", log.BBError(err))
+		return
+	}
+	now := time.Now()
+	if len(backups) == 0 || now.Sub(backups[0].CreateTime) >= r.profile.MetadataBackupInterval {
+		backup, err := r.Backup(ctx)
+		if err != nil {
+			slog.Error("failed to back up the metadata database", log.BBError(err))
+			return
+		}
+		slog.Info("Backed up the metadata database", slog.String("uri", objectstorage.GetObjectURI(r.storage, backup.Path)), slog.Int64("size", backup.Size))
+		backups = slices.Insert(backups, 0, backup)
+	}
+	r.purgeExpiredBackups(ctx, backups, now)
+}
+
+// purgeExpiredBackups deletes the backups older than the retention, the latest backup is always kept.
+func (r *MetadataBackupRunner) purgeExpiredBackups(ctx context.Context, backups []*MetadataBackup, now time.Time) {
+	if r.profile.MetadataBackupRetention <= 0 {
+		return
+	}
+	for i, backup := range backups {
+		if i == 0 || now.Sub(backup.CreateTime) < r.profile.MetadataBackupRetention {
+			continue
+		}
+		// Delete the manifest first, so that the backup is never listed without the object.
+		if err := objectstorage.Delete(ctx, r.storage, r.getManifestKey(backup.Name), ""); err != nil {
+			slog.Error("failed to delete expired metadata backup manifest", slog.String("name", backup.Name), log.BBError(err))
+			continue
+		}
+		if err := objectstorage.Delete(ctx, r.storage, backup.Path, ""); err != nil {
+			slog.Error("failed to delete expired metadata backup", slog.String("name", backup.Name), log.BBError(err))
+		}
+	}
+}
+
+// Backup dumps the metadata database, compresses and optionally encrypts the dump, and uploads it with the manifest.
+func (r *MetadataBackupRunner) Backup(ctx context.Context) (*MetadataBackup, error) {
+	createTime := time.Now().UTC()
+	name := createTime.Format(metadataBackupNameFormat)
+	encryptionKey := r.profile.MetadataBackupEncryptionKey
+	key := path.Join(r.storage.GetPrefix(), metadataBackupDir, name+".sql.gz")
+	if encryptionKey != "" {
+		key += ".enc"
+	}
+	client, err := objectstorage.NewClient(ctx, r.storage, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create storage client")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmd := r.getPGCommand(ctx, "pg_dump", "--no-owner", "--no-privileges")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "failed to start %s", cmd.Path)
+	}
+
+	pr, pw := io.Pipe()
+	hash := sha256.New()
+	counter := &countingWriter{w: io.MultiWriter(pw, hash)}
+	dumpErrCh := make(chan error, 1)
+	go func() {
+		err := writeBackup(counter, stdout, encryptionKey)
+		if err != nil {
+			// Stop the dump if the upload fails.
+			cancel()
+		}
+		if waitErr := cmd.Wait(); waitErr != nil && err == nil {
+			err = errors.Errorf("%s failed: %v, %s", cmd.Path, waitErr, stderr.String())
+		}
+		_ = pw.CloseWithError(err)
+		dumpErrCh <- err
+	}()
+
+	uploadErr := objectstorage.Upload(ctx, client, r.storage, key, pr)
+	if uploadErr != nil {
+		// Unblock the writer.
+		_ = pr.CloseWithError(uploadErr)
+	}
+	if err := <-dumpErrCh; err != nil {
+		return nil, err
+	}
+	if uploadErr != nil {
+		return nil, errors.Wrapf(uploadErr, "failed to upload metadata backup")
+	}
+
+	backup := &MetadataBackup{
+		Name:       name,
+		Path:       key,
+		CreateTime: createTime,
+		Size:       counter.n,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+		Encrypted:  encryptionKey != "",
+		Version:    r.profile.Version,
+	}
+	manifest, err := json.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+	if err := objectstorage.Upload(ctx, client, r.storage, r.getManifestKey(name), bytes.NewReader(manifest)); err != nil {
+		return nil, errors.Wrapf(err, "failed to upload metadata backup manifest")
+	}
+	return backup, nil
+}
+
+// List lists the complete metadata backups in the storage, the latest backup comes first.
+func (r *MetadataBackupRunner) List(ctx context.Context) ([]*MetadataBackup, error) {
+	objects, err := objectstorage.List(ctx, r.storage, path.Join(r.storage.GetPrefix(), metadataBackupDir)+"/", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list metadata backups")
+	}
+	var backups []*MetadataBackup
+	for _, object := range objects {
+		if !strings.HasSuffix(object.Key, ".json") {
+			continue
+		}
+		backup, err := r.getManifest(ctx, object.Key)
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, backup)
+	}
+	slices.SortFunc(backups, func(a, b *MetadataBackup) int {
+		return b.CreateTime.Compare(a.CreateTime)
+	})
+	return backups, nil
+}
+
+// Get gets the metadata backup by the name, or the latest backup if the name is "latest".
+func (r *MetadataBackupRunner) Get(ctx context.Context, name string) (*MetadataBackup, error) {
+	if name != LatestMetadataBackup {
+		if _, err := time.Parse(metadataBackupNameFormat, name); err != nil {
+			return nil, errors.Errorf("invalid metadata backup name %q, must be in %s format or latest", name, metadataBackupNameFormat)
+		}
+		return r.getManifest(ctx, r.getManifestKey(name))
+	}
+	backups, err := r.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(backups) == 0 {
+		return nil, errors.Errorf("no metadata backup found in %q", r.profile.MetadataBackupURI)
+	}
+	return backups[0], nil
+}
+
+// Verify downloads the backup, and checks the checksum and the integrity of the compressed and encrypted stream.
+func (r *MetadataBackupRunner) Verify(ctx context.Context, backup *MetadataBackup) error {
+	body, _, err := objectstorage.Download(ctx, r.storage, backup.Path, "")
+	if err != nil {
+		return errors.Wrapf(err, "failed to download metadata backup %q", objectstorage.GetObjectURI(r.storage, backup.Path))
+	}
+	defer body.Close()
+	return verifyMetadataBackup(body, backup, r.profile.MetadataBackupEncryptionKey)
+}
+
+// Restore replaces the metadata database with the backup, the backup is verified before touching the database.
+// All Bytebase replicas other than the caller must be stopped, the caller runs the migration after the restore.
+func (r *MetadataBackupRunner) Restore(ctx context.Context, backup *MetadataBackup) error {
+	if backup.Version != r.profile.Version {
+		slog.Warn("The metadata backup is taken by a different server version", slog.String("backup", backup.Version), slog.String("server", r.profile.Version))
+	}
+	body, _, err := objectstorage.Download(ctx, r.storage, backup.Path, "")
+	if err != nil {
+		return errors.Wrapf(err, "failed to download metadata backup %q", objectstorage.GetObjectURI(r.storage, backup.Path))
+	}
+	defer body.Close()
+
+	// Download the backup to a temporary file and verify it first, psql commits whatever it reads from a truncated or corrupted stream.
+	f, err := os.CreateTemp("", "bytebase-metadata-backup-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := verifyMetadataBackup(io.TeeReader(body, f), backup, r.profile.MetadataBackupEncryptionKey); err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	var encryptionKey string
+	if backup.Encrypted {
+		encryptionKey = r.profile.MetadataBackupEncryptionKey
+	}
+	dump, err := readBackup(f, encryptionKey)
+	if err != nil {
+		return err
+	}
+
+	cmd := r.getPGCommand(ctx, "psql", "--set", "ON_ERROR_STOP=1", "--single-transaction", "--quiet")
+	// The schema is recreated in the same transaction, so the database is unchanged if the restore fails.
+	cmd.Stdin = io.MultiReader(strings.NewReader("DROP SCHEMA IF EXISTS public CASCADE;\nCREATE SCHEMA public;\n"), dump)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("%s failed: %v, %s", cmd.Path, err, stderr.String())
+	}
+	return nil
+}
+
+func (r *MetadataBackupRunner) getManifest(ctx context.Context, key string) (*MetadataBackup, error) {
+	body, _, err := objectstorage.Download(ctx, r.storage, key, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download metadata backup manifest %q", objectstorage.GetObjectURI(r.storage, key))
+	}
+	defer body.Close()
+	backup := &MetadataBackup{}
+	if err := json.NewDecoder(body).Decode(backup); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode metadata backup manifest %q", key)
+	}
+	return backup, nil
+}
+
+func (r *MetadataBackupRunner) getManifestKey(name string) string {
+	return path.Join(r.storage.GetPrefix(), metadataBackupDir, name+".json")
+}
+
+// getPGCommand returns the pg_dump or psql command connecting to the metadata database.
+func (r *MetadataBackupRunner) getPGCommand(ctx context.Context, name string, args ...string) *exec.Cmd {
+	args = append(args, "--no-password", "--dbname", r.getConnString())
+	cmd := exec.CommandContext(ctx, filepath.Join(r.pgBinDir, name), args...)
+	cmd.Env = append(os.Environ(), "PGPASSWORD="+r.connCfg.Password, "PGCONNECT_TIMEOUT=10")
+	return cmd
+}
+
+// getConnString returns the libpq connection string of the metadata database without the password.
+// The external database keeps the parameters of the --pg URL, e.g. sslmode, sslrootcert and the hosts of the HA setups.
+func (r *MetadataBackupRunner) getConnString() string {
+	if r.profile.UseEmbedDB() {
+		return fmt.Sprintf("host=%s port=%s user=%s dbname=%s", quoteConnValue(r.connCfg.Host), quoteConnValue(r.connCfg.Port), quoteConnValue(r.connCfg.Username), quoteConnValue(r.connCfg.Database))
+	}
+	u, err := url.Parse(r.profile.PgURL)
+	if err != nil {
+		// The URL has been parsed on startup.
+		return r.profile.PgURL
+	}
+	u.User = url.User(r.connCfg.Username)
+	q := u.Query()
+	// prefer_simple_protocol is not a libpq parameter.
+	q.Del("prefer_simple_protocol")
+	if r.connCfg.TargetSessionAttrs != "" {
+		q.Set("target_session_attrs", r.connCfg.TargetSessionAttrs)
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// quoteConnValue quotes the value in the libpq keyword/value connection string.
+func quoteConnValue(s string) string {
+	return `'` + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + `'`
+}
+
+// verifyMetadataBackup checks the checksum and the size of the backup, and reads through the dump,
+// so that the truncated stream, the wrong encryption key and the tampered chunks are detected.
+func verifyMetadataBackup(r io.Reader, backup *MetadataBackup, encryptionKey string) error {
+	if backup.Encrypted && encryptionKey == "" {
+		return errors.Errorf("metadata backup %q is encrypted but --metadata-backup-encryption-key is not set", backup.Name)
+	}
+	if !backup.Encrypted {
+		encryptionKey = ""
+	}
+	hash := sha256.New()
+	counter := &countingReader{r: io.TeeReader(r, hash), progress: func(int64) {}}
+	dump, err := readBackup(counter, encryptionKey)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read metadata backup %q", backup.Name)
+	}
+	if _, err := io.Copy(io.Discard, dump); err != nil {
+		return errors.Wrapf(err, "metadata backup %q is corrupted", backup.Name)
+	}
+	// Drain the trailing bytes after the end of the gzip stream, if any.
+	if _, err := io.Copy(io.Discard, counter); err != nil {
+		return errors.Wrapf(err, "failed to read metadata backup %q", backup.Name)
+	}
+	if counter.n != backup.Size {
+		return errors.Errorf("metadata backup %q size mismatch, expected %d, got %d", backup.Name, backup.Size, counter.n)
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != backup.SHA256 {
+		return errors.Errorf("metadata backup %q checksum mismatch, expected %s, got %s", backup.Name, backup.SHA256, checksum)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyMetadataBackup(t *testing.T) {
+	a := require.New(t)
+	var buf bytes.Buffer
+	a.NoError(writeBackup(&buf, strings.NewReader("CREATE TABLE t (id INTEGER);\n"), "passphrase"))
+	checksum := sha256.Sum256(buf.Bytes())
+	backup := &MetadataBackup{
+		Name:      "20240101T000000Z",
+		Size:      int64(buf.Len()),
+		SHA256:    hex.EncodeToString(checksum[:]),
+		Encrypted: true,
+	}
+
+	a.NoError(verifyMetadataBackup(bytes.NewReader(buf.Bytes()), backup, "passphrase"))
+	a.Error(verifyMetadataBackup(bytes.NewReader(buf.Bytes()), backup, "wrong passphrase"))
+	a.Error(verifyMetadataBackup(bytes.NewReader(buf.Bytes()), backup, ""))
+	a.Error(verifyMetadataBackup(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), backup, "passphrase"))
+
+	tampered := bytes.Clone(buf.Bytes())
+	tampered[len(tampered)-1] ^= 0xff
+	a.Error(verifyMetadataBackup(bytes.NewReader(tampered), backup, "passphrase"))
+}
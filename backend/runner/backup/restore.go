@@ -0,0 +1,180 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// Restore loads the backup of the backup run into the database on the instance with psql or mysql.
+// If createDatabase is false, the database is dropped and recreated before loading the backup.
+// Otherwise, the database is created and must not exist.
+// The progress is reported with the downloaded and the total bytes of the backup.
+func (r *Runner) Restore(ctx context.Context, instance *store.InstanceMessage, backupRun *store.BackupRunMessage, databaseName string, createDatabase bool, progress func(completed, total int64)) error {
+	if backupRun.Status != store.BackupRunDone {
+		return errors.Errorf("backup run %d is %s", backupRun.UID, backupRun.Status)
+	}
+	var encryptionKey string
+	if backupRun.Payload.Encrypted {
+		// The backup run doesn't keep the encryption key, the backup is decrypted with the current key in the setting.
+		setting, err := r.store.GetBackupSetting(ctx, backupRun.DatabaseUID)
+		if err != nil {
+			return err
+		}
+		if setting == nil {
+			return errors.Errorf("backup setting not found for the encrypted backup")
+		}
+		if encryptionKey, err = common.Unobfuscate(setting.Payload.ObfuscatedEncryptionKey, r.secret); err != nil {
+			return errors.Wrapf(err, "failed to get encryption key")
+		}
+		if encryptionKey == "" {
+			return errors.Errorf("the backup is encrypted but the encryption key is not set")
+		}
+	}
+
+	body, size, err := objectstorage.Download(ctx, backupRun.Payload.Storage, backupRun.Payload.Path, r.secret)
+	if err != nil {
+		return errors.Wrapf(err, "failed to download backup %q", objectstorage.GetObjectURI(backupRun.Payload.Storage, backupRun.Payload.Path))
+	}
+	defer body.Close()
+
+	// Read the header of the backup before touching the database, so that the wrong encryption key fails early.
+	dump, err := readBackup(&countingReader{r: body, progress: func(n int64) { progress(n, size) }}, encryptionKey)
+	if err != nil {
+		return err
+	}
+	cmd, err := r.getRestoreCommand(ctx, instance, databaseName)
+	if err != nil {
+		return err
+	}
+	if err := r.prepareDatabase(ctx, instance, databaseName, createDatabase); err != nil {
+		return err
+	}
+	cmd.Stdin = dump
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Errorf("%s failed: %v, %s", cmd.Path, err, stderr.String())
+	}
+	return nil
+}
+
+// readBackup returns the dump of the backup written by writeBackup.
+func readBackup(r io.Reader, encryptionKey string) (io.Reader, error) {
+	if encryptionKey != "" {
+		decryptor, err := newDecryptReader(r, encryptionKey)
+		if err != nil {
+			return nil, err
+		}
+		r = decryptor
+	}
+	return gzip.NewReader(r)
+}
+
+// prepareDatabase recreates the existing database or creates the new database to restore into.
+func (r *Runner) prepareDatabase(ctx context.Context, instance *store.InstanceMessage, databaseName string, createDatabase bool) error {
+	dataSource, password, err := r.getAdminDataSource(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	var cmd *exec.Cmd
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		// Connect to the maintenance database because the database to restore cannot be dropped in its own connection.
+		maintenanceDatabase := dataSource.Database
+		if maintenanceDatabase == "" || maintenanceDatabase == databaseName {
+			maintenanceDatabase = "postgres"
+		}
+		args := append([]string{"--dbname", maintenanceDatabase, "--set", "ON_ERROR_STOP=1"}, getPGConnectionArgs(dataSource)...)
+		// Each command is executed in its own transaction, because DROP DATABASE cannot run inside a transaction block.
+		identifier := quotePGIdentifier(databaseName)
+		if !createDatabase {
+			args = append(args,
+				"--command", fmt.Sprintf("SELECT pg_terminate_backend(pid) FROM pg_stat_activity WHERE datname = %s AND pid <> pg_backend_pid()", quotePGLiteral(databaseName)),
+				"--command", fmt.Sprintf("DROP DATABASE IF EXISTS %s", identifier),
+			)
+		}
+		args = append(args, "--command", fmt.Sprintf("CREATE DATABASE %s", identifier))
+		cmd = exec.CommandContext(ctx, filepath.Join(r.pgBinDir, "psql"), args...)
+		cmd.Env = getPGEnv(dataSource, password)
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		identifier := quoteMySQLIdentifier(databaseName)
+		statement := fmt.Sprintf("CREATE DATABASE %s", identifier)
+		if !createDatabase {
+			statement = fmt.Sprintf("DROP DATABASE IF EXISTS %s; %s", identifier, statement)
+		}
+		args := append(getMySQLConnectionArgs(dataSource), "--execute", statement)
+		cmd = exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQL, r.mysqlBinDir), args...)
+		cmd.Env = append(os.Environ(), "MYSQL_PWD="+password)
+	default:
+		return errors.Errorf("restore is not supported for engine %v", instance.Engine)
+	}
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return errors.Errorf("failed to prepare database %q: %v, %s", databaseName, err, string(output))
+	}
+	return nil
+}
+
+// getRestoreCommand returns the psql or mysql command loading the dump from the stdin into the database.
+func (r *Runner) getRestoreCommand(ctx context.Context, instance *store.InstanceMessage, databaseName string) (*exec.Cmd, error) {
+	dataSource, password, err := r.getAdminDataSource(ctx, instance)
+	if err != nil {
+		return nil, err
+	}
+
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		args := append([]string{"--dbname", databaseName, "--set", "ON_ERROR_STOP=1", "--single-transaction", "--quiet"}, getPGConnectionArgs(dataSource)...)
+		cmd := exec.CommandContext(ctx, filepath.Join(r.pgBinDir, "psql"), args...)
+		cmd.Env = getPGEnv(dataSource, password)
+		return cmd, nil
+	case storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		args := append(getMySQLConnectionArgs(dataSource), databaseName)
+		cmd := exec.CommandContext(ctx, mysqlutil.GetPath(mysqlutil.MySQL, r.mysqlBinDir), args...)
+		cmd.Env = append(os.Environ(), "MYSQL_PWD="+password)
+		return cmd, nil
+	default:
+		return nil, errors.Errorf("restore is not supported for engine %v", instance.Engine)
+	}
+}
+
+func quotePGIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+func quotePGLiteral(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
+
+func quoteMySQLIdentifier(s string) string {
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	r        io.Reader
+	n        int64
+	progress func(int64)
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	c.progress(c.n)
+	return n, err
+}
@@ -0,0 +1,383 @@
+// Package backup is a runner that takes the scheduled and manual logical backups of databases to the object storage,
+// and restores the databases from the backups.
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// maxErrorLength is the maximum length of the dump error recorded in the backup run.
+const maxErrorLength = 4096
+
+// NewRunner creates a backup runner.
+func NewRunner(stores *store.Store, profile *config.Profile, mysqlBinDir, pgBinDir, secret string) *Runner {
+	return &Runner{
+		store:       stores,
+		profile:     profile,
+		mysqlBinDir: mysqlBinDir,
+		pgBinDir:    pgBinDir,
+		secret:      secret,
+	}
+}
+
+// Runner is the backup runner.
+// It creates the pending backup runs by the schedules in the backup settings, executes the pending backup runs,
+// and purges the backups older than the retention.
+type Runner struct {
+	store       *store.Store
+	profile     *config.Profile
+	mysqlBinDir string
+	pgBinDir    string
+	secret      string
+}
+
+// Run will run the backup runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(r.profile.BackupRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Backup runner started and will run every %v", r.profile.BackupRunnerInterval))
+
+	// The running backups are interrupted by the restart.
+	r.failInterruptedBackupRuns(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			r.runOnce(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	enabled := true
+	settings, err := r.store.ListBackupSettings(ctx, &store.FindBackupSettingMessage{Enabled: &enabled})
+	if err != nil {
+		slog.Error("Failed to list backup settings", log.BBError(err))
+		return
+	}
+	now := time.Now()
+	for _, setting := range settings {
+		if err := r.scheduleBackupRun(ctx, setting, now); err != nil {
+			slog.Error("Failed to schedule backup run", slog.Int("database", setting.DatabaseUID), log.BBError(err))
+		}
+		if err := r.purgeExpiredBackupRuns(ctx, setting, now); err != nil {
+			slog.Error("Failed to purge expired backup runs", slog.Int("database", setting.DatabaseUID), log.BBError(err))
+		}
+	}
+
+	backupRuns, err := r.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		StatusList: []store.BackupRunStatus{store.BackupRunPending},
+	})
+	if err != nil {
+		slog.Error("Failed to list pending backup runs", log.BBError(err))
+		return
+	}
+	// The backup runs are listed newest first, run the oldest first.
+	for i := len(backupRuns) - 1; i >= 0; i-- {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := r.runBackup(ctx, backupRuns[i]); err != nil {
+			slog.Error("Failed to run backup", slog.Int("backupRun", backupRuns[i].UID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) failInterruptedBackupRuns(ctx context.Context) {
+	backupRuns, err := r.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		StatusList: []store.BackupRunStatus{store.BackupRunRunning},
+	})
+	if err != nil {
+		slog.Error("Failed to list running backup runs", log.BBError(err))
+		return
+	}
+	for _, backupRun := range backupRuns {
+		backupRun.Payload.Error = "the backup is interrupted by the Bytebase restart"
+		backupRun.Payload.FinishTime = timestamppb.Now()
+		failed := store.BackupRunFailed
+		if err := r.store.UpdateBackupRun(ctx, &store.UpdateBackupRunMessage{
+			UID:     backupRun.UID,
+			Status:  &failed,
+			Payload: backupRun.Payload,
+		}); err != nil {
+			slog.Error("Failed to update interrupted backup run", slog.Int("backupRun", backupRun.UID), log.BBError(err))
+		}
+	}
+}
+
+// scheduleBackupRun creates a pending backup run if there is no backup since the latest scheduled time.
+func (r *Runner) scheduleBackupRun(ctx context.Context, setting *store.BackupSettingMessage, now time.Time) error {
+	scheduledTime := getLatestScheduledTime(now, setting.Payload.Hour, setting.Payload.DayOfWeek)
+	// Don't backup for the schedule before the setting is enabled or updated.
+	if !scheduledTime.After(setting.UpdatedTime) {
+		return nil
+	}
+	limit := 1
+	latestRuns, err := r.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		DatabaseUID: &setting.DatabaseUID,
+		Limit:       &limit,
+	})
+	if err != nil {
+		return err
+	}
+	if len(latestRuns) > 0 && !latestRuns[0].CreatedTime.Before(scheduledTime) {
+		return nil
+	}
+	if _, err := r.store.CreateBackupRun(ctx, &store.BackupRunMessage{
+		DatabaseUID: setting.DatabaseUID,
+		CreatorID:   api.SystemBotID,
+		Status:      store.BackupRunPending,
+		Payload:     &storepb.BackupRunPayload{},
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// getLatestScheduledTime returns the latest scheduled time in UTC not after now.
+// The dayOfWeek is from 0 (Sunday) to 6, and -1 means every day.
+func getLatestScheduledTime(now time.Time, hour, dayOfWeek int32) time.Time {
+	now = now.UTC()
+	scheduledTime := time.Date(now.Year(), now.Month(), now.Day(), int(hour), 0, 0, 0, time.UTC)
+	if scheduledTime.After(now) {
+		scheduledTime = scheduledTime.AddDate(0, 0, -1)
+	}
+	if dayOfWeek >= 0 {
+		for int32(scheduledTime.Weekday()) != dayOfWeek {
+			scheduledTime = scheduledTime.AddDate(0, 0, -1)
+		}
+	}
+	return scheduledTime
+}
+
+// purgeExpiredBackupRuns deletes the backups older than the retention from the storage.
+func (r *Runner) purgeExpiredBackupRuns(ctx context.Context, setting *store.BackupSettingMessage, now time.Time) error {
+	retention := setting.Payload.Retention
+	if retention == nil || retention.AsDuration() <= 0 {
+		return nil
+	}
+	backupRuns, err := r.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		DatabaseUID: &setting.DatabaseUID,
+		StatusList:  []store.BackupRunStatus{store.BackupRunDone},
+	})
+	if err != nil {
+		return err
+	}
+	for _, backupRun := range backupRuns {
+		if now.Sub(backupRun.CreatedTime) < retention.AsDuration() {
+			continue
+		}
+		if err := objectstorage.Delete(ctx, backupRun.Payload.Storage, backupRun.Payload.Path, r.secret); err != nil {
+			return errors.Wrapf(err, "failed to delete backup %q", objectstorage.GetObjectURI(backupRun.Payload.Storage, backupRun.Payload.Path))
+		}
+		deleted := store.BackupRunDeleted
+		if err := r.store.UpdateBackupRun(ctx, &store.UpdateBackupRunMessage{
+			UID:    backupRun.UID,
+			Status: &deleted,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Runner) runBackup(ctx context.Context, backupRun *store.BackupRunMessage) error {
+	database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &backupRun.DatabaseUID})
+	if err != nil {
+		return err
+	}
+	if database == nil {
+		return r.finishBackupRun(ctx, nil, backupRun, errors.Errorf("database %d not found", backupRun.DatabaseUID))
+	}
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return err
+	}
+	if instance == nil {
+		return r.finishBackupRun(ctx, database, backupRun, errors.Errorf("instance %q not found", database.InstanceID))
+	}
+	setting, err := r.store.GetBackupSetting(ctx, database.UID)
+	if err != nil {
+		return err
+	}
+	if setting == nil || setting.Payload.Storage == nil {
+		return r.finishBackupRun(ctx, database, backupRun, errors.Errorf("backup storage is not configured"))
+	}
+
+	encryptionKey, err := common.Unobfuscate(setting.Payload.ObfuscatedEncryptionKey, r.secret)
+	if err != nil {
+		return r.finishBackupRun(ctx, database, backupRun, errors.Wrapf(err, "failed to get encryption key"))
+	}
+	startTime := time.Now()
+	backupRun.Payload.Storage = setting.Payload.Storage
+	backupRun.Payload.Encrypted = encryptionKey != ""
+	backupRun.Payload.StartTime = timestamppb.New(startTime)
+	backupRun.Payload.Path = getObjectKey(setting.Payload.Storage, instance.ResourceID, database.DatabaseName, startTime, backupRun.Payload.Encrypted)
+	running := store.BackupRunRunning
+	if err := r.store.UpdateBackupRun(ctx, &store.UpdateBackupRunMessage{
+		UID:     backupRun.UID,
+		Status:  &running,
+		Payload: backupRun.Payload,
+	}); err != nil {
+		return err
+	}
+
+	size, backupErr := r.backup(ctx, instance, database, setting.Payload.Storage, backupRun.Payload.Path, encryptionKey)
+	backupRun.Payload.Size = size
+	return r.finishBackupRun(ctx, database, backupRun, backupErr)
+}
+
+// backup dumps the database, compresses and optionally encrypts the dump, and streams it to the storage.
+// It returns the size of the uploaded object.
+func (r *Runner) backup(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, storage *storepb.BackupStorage, key, encryptionKey string) (int64, error) {
+	client, err := objectstorage.NewClient(ctx, storage, r.secret)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create storage client")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	cmd, err := r.getDumpCommand(ctx, instance, database.DatabaseName)
+	if err != nil {
+		return 0, err
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return 0, err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Start(); err != nil {
+		return 0, errors.Wrapf(err, "failed to start %s", cmd.Path)
+	}
+
+	pr, pw := io.Pipe()
+	counter := &countingWriter{w: pw}
+	dumpErrCh := make(chan error, 1)
+	go func() {
+		err := writeBackup(counter, stdout, encryptionKey)
+		if err != nil {
+			// Stop the dump if the upload fails.
+			cancel()
+		}
+		if waitErr := cmd.Wait(); waitErr != nil && err == nil {
+			err = errors.Errorf("%s failed: %v, %s", cmd.Path, waitErr, stderr.String())
+		}
+		_ = pw.CloseWithError(err)
+		dumpErrCh <- err
+	}()
+
+	uploadErr := objectstorage.Upload(ctx, client, storage, key, pr)
+	if uploadErr != nil {
+		// Unblock the writer.
+		_ = pr.CloseWithError(uploadErr)
+	}
+	dumpErr := <-dumpErrCh
+	if dumpErr != nil {
+		return 0, dumpErr
+	}
+	if uploadErr != nil {
+		return 0, errors.Wrapf(uploadErr, "failed to upload backup")
+	}
+	return counter.n, nil
+}
+
+func writeBackup(w io.Writer, dump io.Reader, encryptionKey string) error {
+	var encryptor *encryptWriter
+	if encryptionKey != "" {
+		var err error
+		if encryptor, err = newEncryptWriter(w, encryptionKey); err != nil {
+			return err
+		}
+		w = encryptor
+	}
+	gz := gzip.NewWriter(w)
+	if _, err := io.Copy(gz, dump); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+	if encryptor != nil {
+		return encryptor.Close()
+	}
+	return nil
+}
+
+func (r *Runner) finishBackupRun(ctx context.Context, database *store.DatabaseMessage, backupRun *store.BackupRunMessage, backupErr error) error {
+	backupRun.Payload.FinishTime = timestamppb.Now()
+	status := store.BackupRunDone
+	if backupErr != nil {
+		status = store.BackupRunFailed
+		backupRun.Payload.Error, _ = common.TruncateString(backupErr.Error(), maxErrorLength)
+	}
+	if err := r.store.UpdateBackupRun(ctx, &store.UpdateBackupRunMessage{
+		UID:     backupRun.UID,
+		Status:  &status,
+		Payload: backupRun.Payload,
+	}); err != nil {
+		return err
+	}
+	if database == nil {
+		return nil
+	}
+
+	if backupErr == nil {
+		err := r.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+			DatabaseUID: &database.UID,
+			Type:        api.AnomalyDatabaseBackupFailed,
+		})
+		if err != nil && common.ErrorCode(err) != common.NotFound {
+			return errors.Wrapf(err, "failed to archive backup failed anomaly")
+		}
+		return nil
+	}
+	payload, err := protojson.Marshal(&storepb.AnomalyDatabaseBackupFailedPayload{
+		BackupRunUid: int32(backupRun.UID),
+		Error:        backupRun.Payload.Error,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal backup failed anomaly payload")
+	}
+	if _, err := r.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		DatabaseUID: &database.UID,
+		Type:        api.AnomalyDatabaseBackupFailed,
+		Payload:     string(payload),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to upsert backup failed anomaly")
+	}
+	return nil
+}
+
+// countingWriter counts the bytes written to the underlying writer.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
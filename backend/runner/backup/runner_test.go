@@ -0,0 +1,95 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLatestScheduledTime(t *testing.T) {
+	// 2024-01-03 is Wednesday.
+	now := time.Date(2024, 1, 3, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		hour      int32
+		dayOfWeek int32
+		want      time.Time
+	}{
+		{
+			hour:      2,
+			dayOfWeek: -1,
+			want:      time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			hour:      12,
+			dayOfWeek: -1,
+			want:      time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			hour:      10,
+			dayOfWeek: 3,
+			want:      time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			hour:      12,
+			dayOfWeek: 3,
+			want:      time.Date(2023, 12, 27, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			hour:      0,
+			dayOfWeek: 0,
+			want:      time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getLatestScheduledTime(now, test.hour, test.dayOfWeek), "hour %d, day of week %d", test.hour, test.dayOfWeek)
+	}
+}
+
+func TestWriteBackupEncrypted(t *testing.T) {
+	a := require.New(t)
+	// The random values are incompressible, so that the backup has multiple chunks.
+	r := rand.New(rand.NewSource(1))
+	var sb strings.Builder
+	for i := 0; i < 8*1024; i++ {
+		fmt.Fprintf(&sb, "INSERT INTO t VALUES (%d, '%x');\n", i, r.Int63())
+	}
+	dump := sb.String()
+
+	var buf bytes.Buffer
+	a.NoError(writeBackup(&buf, strings.NewReader(dump), "passphrase"))
+
+	plaintext, err := decryptBackup(buf.Bytes(), "passphrase")
+	a.NoError(err)
+	gz, err := gzip.NewReader(bytes.NewReader(plaintext))
+	a.NoError(err)
+	got, err := io.ReadAll(gz)
+	a.NoError(err)
+	a.Equal(dump, string(got))
+
+	_, err = decryptBackup(buf.Bytes(), "wrong passphrase")
+	a.Error(err)
+
+	// The backup truncated to the first chunk is rejected.
+	firstChunkEnd := encryptionSaltSize + 4 + int(binary.BigEndian.Uint32(buf.Bytes()[encryptionSaltSize:]))
+	a.Less(firstChunkEnd, buf.Len())
+	_, err = decryptBackup(buf.Bytes()[:firstChunkEnd], "passphrase")
+	a.Error(err)
+}
+
+func decryptBackup(data []byte, passphrase string) ([]byte, error) {
+	r, err := newDecryptReader(bytes.NewReader(data), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
@@ -0,0 +1,18 @@
+package backup
+
+import (
+	"fmt"
+	"path"
+	"time"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// getObjectKey returns the object key of the backup.
+func getObjectKey(storage *storepb.BackupStorage, instanceID, databaseName string, startTime time.Time, encrypted bool) string {
+	name := fmt.Sprintf("%s.sql.gz", startTime.UTC().Format("20060102T150405Z"))
+	if encrypted {
+		name += ".enc"
+	}
+	return path.Join(storage.GetPrefix(), instanceID, databaseName, name)
+}
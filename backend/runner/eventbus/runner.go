@@ -0,0 +1,129 @@
+// Package eventbus is the runner publishing the queued workspace events to the event bus destinations.
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/eventbus"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	eventBusPublishInterval = 5 * time.Second
+	// eventBusPublishBatchSize is the maximum number of the events published to a destination in a batch.
+	eventBusPublishBatchSize = 100
+)
+
+// NewRunner creates a new event bus runner.
+func NewRunner(store *store.Store, secret string) *Runner {
+	return &Runner{
+		store:  store,
+		secret: secret,
+	}
+}
+
+// Runner publishes the queued events to the event bus destinations.
+// The events are deleted from the queue after the destination acknowledges them,
+// so the events are redelivered with the same id if the runner fails in the middle.
+type Runner struct {
+	store  *store.Store
+	secret string
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(eventBusPublishInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Event bus runner started and will run every %v", eventBusPublishInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.publishEvents(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) publishEvents(ctx context.Context) {
+	setting, err := r.store.GetEventBusSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get event bus setting", log.BBError(err))
+		return
+	}
+	destinations := []string{}
+	for _, destination := range setting.Destinations {
+		destinations = append(destinations, destination.Id)
+	}
+	// The events of the removed destinations are never published.
+	if err := r.store.DeleteEventOutboxesExcept(ctx, destinations); err != nil {
+		slog.Error("failed to delete event outboxes of the removed destinations", log.BBError(err))
+		return
+	}
+	for _, destination := range setting.Destinations {
+		if err := r.publishDestinationEvents(ctx, destination); err != nil {
+			slog.Error("failed to publish events to the event bus", slog.String("destination", destination.Id), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) publishDestinationEvents(ctx context.Context, destination *storepb.EventBusSetting_Destination) error {
+	limit := eventBusPublishBatchSize
+	outboxes, err := r.store.ListEventOutboxes(ctx, &store.FindEventOutboxMessage{
+		Destination: &destination.Id,
+		Limit:       &limit,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list event outboxes")
+	}
+	if len(outboxes) == 0 {
+		return nil
+	}
+
+	password, err := common.Unobfuscate(destination.ObfuscatedPassword, r.secret)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unobfuscate password")
+	}
+	publisher, err := eventbus.NewPublisher(ctx, destination, password)
+	if err != nil {
+		return err
+	}
+	defer publisher.Close()
+
+	var messages []*eventbus.Message
+	var uids []int64
+	for _, outbox := range outboxes {
+		event := &v1pb.WorkspaceEvent{}
+		if err := proto.Unmarshal(outbox.Payload, event); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal event %d", outbox.UID)
+		}
+		messages = append(messages, &eventbus.Message{
+			ID:   strconv.FormatInt(outbox.UID, 10),
+			Key:  event.Resource,
+			Data: outbox.Payload,
+			Attributes: map[string]string{
+				"content-type": "application/x-protobuf",
+				"schema":       string(proto.MessageName(event)),
+				"event-type":   event.Type.String(),
+			},
+		})
+		uids = append(uids, outbox.UID)
+	}
+	if err := publisher.Publish(ctx, messages); err != nil {
+		return err
+	}
+	return r.store.DeleteEventOutboxes(ctx, uids)
+}
@@ -0,0 +1,227 @@
+// Package iamcleaner is a runner that prunes the expired project IAM bindings and notifies the grantees,
+// it also deletes the expired sign-in sessions.
+package iamcleaner
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/mail"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const cleanInterval = 1 * time.Hour
+
+// NewRunner creates an IAM cleaner runner.
+func NewRunner(stores *store.Store) *Runner {
+	return &Runner{
+		store: stores,
+	}
+}
+
+// Runner is the IAM cleaner runner.
+type Runner struct {
+	store *store.Store
+}
+
+// Run will run the IAM cleaner runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(cleanInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("IAM cleaner started and will run every %v", cleanInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.cleanExpiredBindings(ctx)
+			r.cleanExpiredSessions(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+// expiredBinding is the binding removed from the project IAM policy.
+type expiredBinding struct {
+	project    *store.ProjectMessage
+	binding    *storepb.Binding
+	expireTime time.Time
+}
+
+func (r *Runner) cleanExpiredBindings(ctx context.Context) {
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("Failed to list projects", log.BBError(err))
+		return
+	}
+	now := time.Now()
+	var expiredBindings []*expiredBinding
+	for _, project := range projects {
+		bindings, err := r.cleanProject(ctx, project, now)
+		if err != nil {
+			slog.Error("Failed to clean the expired bindings",
+				slog.String("project", project.ResourceID),
+				log.BBError(err))
+			continue
+		}
+		expiredBindings = append(expiredBindings, bindings...)
+	}
+	if len(expiredBindings) == 0 {
+		return
+	}
+
+	mailSetting, err := mail.GetMailDeliverySetting(ctx, r.store)
+	if err != nil {
+		slog.Error("Failed to get mail delivery setting", log.BBError(err))
+		return
+	}
+	if mailSetting == nil {
+		return
+	}
+	for _, expired := range expiredBindings {
+		r.notify(ctx, mailSetting, expired)
+	}
+}
+
+func (r *Runner) cleanExpiredSessions(ctx context.Context) {
+	count, err := r.store.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		slog.Error("Failed to delete the expired sessions", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Debug("Deleted the expired sessions", slog.Int64("count", count))
+	}
+}
+
+// cleanProject removes the expired bindings from the project IAM policy and returns them.
+func (r *Runner) cleanProject(ctx context.Context, project *store.ProjectMessage, now time.Time) ([]*expiredBinding, error) {
+	policyMessage, err := r.store.GetProjectIamPolicy(ctx, project.UID)
+	if err != nil {
+		return nil, err
+	}
+	var expiredBindings []*expiredBinding
+	var bindings []*storepb.Binding
+	for _, binding := range policyMessage.Policy.Bindings {
+		expireTime, err := common.GetBindingExpireTime(binding.GetCondition().GetExpression())
+		if err != nil {
+			slog.Warn("Failed to get the expire time of the binding",
+				slog.String("project", project.ResourceID),
+				slog.String("role", binding.Role),
+				log.BBError(err))
+		}
+		// Keep the owner bindings, the project must have at least one owner.
+		if expireTime != nil && !expireTime.After(now) && binding.Role != common.FormatRole(api.ProjectOwner.String()) {
+			expiredBindings = append(expiredBindings, &expiredBinding{
+				project:    project,
+				binding:    binding,
+				expireTime: *expireTime,
+			})
+			continue
+		}
+		bindings = append(bindings, binding)
+	}
+	if len(expiredBindings) == 0 {
+		return nil, nil
+	}
+
+	policyMessage.Policy.Bindings = bindings
+	policyPayload, err := protojson.Marshal(policyMessage.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.store.CreatePolicyV2(ctx, &store.PolicyMessage{
+		ResourceUID:       project.UID,
+		ResourceType:      api.PolicyResourceTypeProject,
+		Payload:           string(policyPayload),
+		Type:              api.PolicyTypeIAM,
+		InheritFromParent: false,
+		// Enforce cannot be false while creating a policy.
+		Enforce: true,
+	}, api.SystemBotID); err != nil {
+		return nil, err
+	}
+	slog.Info("Pruned the expired project IAM bindings",
+		slog.String("project", project.ResourceID),
+		slog.Int("count", len(expiredBindings)))
+	return expiredBindings, nil
+}
+
+func (r *Runner) notify(ctx context.Context, mailSetting *storepb.SMTPMailDeliverySetting, expired *expiredBinding) {
+	emails, err := r.getMemberEmails(ctx, expired.binding.Members)
+	if err != nil {
+		slog.Error("Failed to get the members of the expired binding", log.BBError(err))
+		return
+	}
+	role := strings.TrimPrefix(expired.binding.Role, common.RolePrefix)
+	subject := fmt.Sprintf("Your %s role in project %s has expired", role, expired.project.Title)
+	body := fmt.Sprintf("<p>Your <b>%s</b> role in project <b>%s</b> expired at %s and has been removed.</p><p>Please request the role again if you still need it.</p>",
+		html.EscapeString(role),
+		html.EscapeString(expired.project.Title),
+		expired.expireTime.UTC().Format(time.RFC3339),
+	)
+	for _, email := range emails {
+		if err := mail.Send(mailSetting, subject, body, email); err != nil {
+			slog.Error("Failed to send the expired binding email", slog.String("to", email), log.BBError(err))
+		}
+	}
+}
+
+// getMemberEmails returns the deduplicated emails of the users in the members, the groups are expanded.
+func (r *Runner) getMemberEmails(ctx context.Context, members []string) ([]string, error) {
+	var userNames []string
+	for _, member := range members {
+		if strings.HasPrefix(member, common.GroupPrefix) {
+			groupEmail, err := common.GetGroupEmail(member)
+			if err != nil {
+				return nil, err
+			}
+			group, err := r.store.GetGroup(ctx, groupEmail)
+			if err != nil {
+				return nil, err
+			}
+			if group == nil {
+				continue
+			}
+			for _, groupMember := range group.Payload.GetMembers() {
+				userNames = append(userNames, groupMember.Member)
+			}
+			continue
+		}
+		if strings.HasPrefix(member, common.UserNamePrefix) {
+			userNames = append(userNames, member)
+		}
+	}
+	slices.Sort(userNames)
+	userNames = slices.Compact(userNames)
+
+	var emails []string
+	for _, userName := range userNames {
+		userID, err := common.GetUserID(userName)
+		if err != nil {
+			return nil, err
+		}
+		user, err := r.store.GetUserByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || user.MemberDeleted {
+			continue
+		}
+		emails = append(emails, user.Email)
+	}
+	return emails, nil
+}
@@ -0,0 +1,95 @@
+// Package issueretention is a runner that purges the closed issues out of the retention.
+package issueretention
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const purgeInterval = 1 * time.Hour
+
+// NewRunner creates an issue retention runner.
+func NewRunner(stores *store.Store) *Runner {
+	return &Runner{
+		store: stores,
+	}
+}
+
+// Runner is the issue retention runner.
+type Runner struct {
+	store *store.Store
+}
+
+// Run will run the issue retention runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Issue retention runner started and will run every %v", purgeInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.purgeExpired(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) purgeExpired(ctx context.Context) {
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace profile setting", log.BBError(err))
+		return
+	}
+	// Keep the issues forever if the retention is not set.
+	if setting.GetIssueRetention().GetSeconds() <= 0 {
+		return
+	}
+	createdBefore := time.Now().Add(-setting.GetIssueRetention().AsDuration()).Unix()
+	issues, err := r.store.ListIssueUIDsToPurge(ctx, &store.FindIssuesToPurgeMessage{CreatedBefore: createdBefore})
+	if err != nil {
+		slog.Error("Failed to list issues to purge", log.BBError(err))
+		return
+	}
+	if len(issues) == 0 {
+		return
+	}
+	var issueUIDs []int
+	for _, issue := range issues {
+		issueUIDs = append(issueUIDs, issue.UID)
+	}
+	result, err := r.store.PurgeIssues(ctx, issueUIDs, false /* dryRun */)
+	if err != nil {
+		slog.Error("Failed to purge expired issues", log.BBError(err))
+		return
+	}
+	slog.Info(fmt.Sprintf("Purged %d expired issues", result.IssueCount))
+
+	workspaceID, err := r.store.GetWorkspaceID(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace id", log.BBError(err))
+		return
+	}
+	if err := r.store.CreateAuditLog(ctx, &storepb.AuditLog{
+		Parent:   common.FormatWorkspace(workspaceID),
+		Method:   store.AuditLogMethodIssueRetentionPurge.String(),
+		Resource: common.FormatWorkspace(workspaceID),
+		User:     common.FormatUserEmail(api.SystemBotEmail),
+		Severity: storepb.AuditLog_NOTICE,
+		Request:  fmt.Sprintf(`{"createTimeBefore":%q}`, time.Unix(createdBefore, 0).UTC().Format(time.RFC3339)),
+		Response: fmt.Sprintf(`{"issueCount":%d,"commentCount":%d,"taskRunCount":%d,"sheetCount":%d}`, result.IssueCount, result.CommentCount, result.TaskRunCount, result.SheetCount),
+	}); err != nil {
+		slog.Error("Failed to create issue purge audit log", log.BBError(err))
+	}
+}
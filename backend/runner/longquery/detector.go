@@ -0,0 +1,292 @@
+// Package longquery is a runner that detects the long-running and blocking queries of instances.
+package longquery
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	detectorInterval = 1 * time.Minute
+	// maxStatementLength is the maximum length of the statement recorded in the anomaly.
+	maxStatementLength = 4096
+)
+
+const (
+	// listPostgresQueriesStatement lists the active queries of the client backends with the pids blocking them.
+	listPostgresQueriesStatement = `
+		SELECT
+			pid::TEXT,
+			COALESCE(datname, ''),
+			COALESCE(usename, ''),
+			COALESCE(state, ''),
+			COALESCE(query, ''),
+			EXTRACT(EPOCH FROM (now() - query_start))::BIGINT,
+			COALESCE(array_to_string(pg_blocking_pids(pid), ','), '')
+		FROM pg_stat_activity
+		WHERE pid <> pg_backend_pid() AND backend_type = 'client backend' AND state <> 'idle' AND query_start IS NOT NULL`
+	// listMySQLQueriesStatement lists the running queries except the sleeping connections.
+	listMySQLQueriesStatement = `
+		SELECT
+			ID,
+			COALESCE(DB, ''),
+			COALESCE(USER, ''),
+			COALESCE(STATE, ''),
+			COALESCE(INFO, ''),
+			TIME
+		FROM information_schema.PROCESSLIST
+		WHERE COMMAND <> 'Sleep' AND ID <> CONNECTION_ID() AND INFO IS NOT NULL`
+	// listMySQLLockWaitsStatement lists the InnoDB lock waits, the sys schema is available since MySQL 5.7.
+	listMySQLLockWaitsStatement = `SELECT waiting_pid, blocking_pid FROM sys.innodb_lock_waits`
+)
+
+// NewDetector creates a long-running query detector.
+func NewDetector(stores *store.Store, dbFactory *dbfactory.DBFactory) *Detector {
+	return &Detector{
+		store:     stores,
+		dbFactory: dbFactory,
+	}
+}
+
+// Detector is the long-running query detector.
+// It periodically polls the running queries of each instance, and reports the queries running longer than
+// the threshold in the workspace profile or blocking other queries as an anomaly.
+type Detector struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run will run the long-running query detector.
+func (d *Detector) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(detectorInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Long-running query detector started and will run every %v", detectorInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			d.detectAll(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (d *Detector) detectAll(ctx context.Context) {
+	setting, err := d.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace general setting", log.BBError(err))
+		return
+	}
+	threshold := setting.GetLongRunningQueryThreshold()
+	if threshold == nil || threshold.AsDuration() <= 0 {
+		return
+	}
+
+	instances, err := d.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+	if err != nil {
+		slog.Error("Failed to list instances", log.BBError(err))
+		return
+	}
+	for _, instance := range instances {
+		if !supportLongRunningQueryDetection(instance.Engine) {
+			continue
+		}
+		if err := d.detectInstance(ctx, instance, threshold.AsDuration()); err != nil {
+			slog.Debug("Failed to detect long-running queries",
+				slog.String("instance", instance.ResourceID),
+				log.BBError(err))
+		}
+	}
+}
+
+func (d *Detector) detectInstance(ctx context.Context, instance *store.InstanceMessage, threshold time.Duration) error {
+	driver, err := d.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+	if sqlDB == nil {
+		return errors.Errorf("database connection not found")
+	}
+
+	var queries []*storepb.AnomalyLongRunningQueryPayload_Query
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES:
+		queries, err = listPostgresQueries(ctx, sqlDB)
+	default:
+		queries, err = listMySQLQueries(ctx, sqlDB)
+	}
+	if err != nil {
+		return err
+	}
+
+	queries = filterLongRunningQueries(queries, threshold)
+	if len(queries) == 0 {
+		err := d.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+			InstanceID: &instance.ResourceID,
+			Type:       api.AnomalyInstanceLongRunningQuery,
+		})
+		if err != nil && common.ErrorCode(err) != common.NotFound {
+			return errors.Wrapf(err, "failed to archive long-running query anomaly")
+		}
+		return nil
+	}
+
+	payload, err := protojson.Marshal(&storepb.AnomalyLongRunningQueryPayload{
+		Queries:   queries,
+		Threshold: durationpb.New(threshold),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal long-running query anomaly payload")
+	}
+	if _, err := d.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		InstanceID: instance.ResourceID,
+		Type:       api.AnomalyInstanceLongRunningQuery,
+		Payload:    string(payload),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to upsert long-running query anomaly")
+	}
+	return nil
+}
+
+func listPostgresQueries(ctx context.Context, sqlDB *sql.DB) ([]*storepb.AnomalyLongRunningQueryPayload_Query, error) {
+	rows, err := sqlDB.QueryContext(ctx, listPostgresQueriesStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queries []*storepb.AnomalyLongRunningQueryPayload_Query
+	for rows.Next() {
+		query := &storepb.AnomalyLongRunningQueryPayload_Query{}
+		var seconds int64
+		var blockedBy string
+		if err := rows.Scan(
+			&query.ConnectionId,
+			&query.Database,
+			&query.User,
+			&query.State,
+			&query.Statement,
+			&seconds,
+			&blockedBy,
+		); err != nil {
+			return nil, err
+		}
+		query.Duration = durationpb.New(time.Duration(seconds) * time.Second)
+		if blockedBy != "" {
+			query.BlockedBy = strings.Split(blockedBy, ",")
+		}
+		queries = append(queries, query)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
+
+func listMySQLQueries(ctx context.Context, sqlDB *sql.DB) ([]*storepb.AnomalyLongRunningQueryPayload_Query, error) {
+	rows, err := sqlDB.QueryContext(ctx, listMySQLQueriesStatement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var queries []*storepb.AnomalyLongRunningQueryPayload_Query
+	queryMap := make(map[string]*storepb.AnomalyLongRunningQueryPayload_Query)
+	for rows.Next() {
+		query := &storepb.AnomalyLongRunningQueryPayload_Query{}
+		var seconds int64
+		if err := rows.Scan(
+			&query.ConnectionId,
+			&query.Database,
+			&query.User,
+			&query.State,
+			&query.Statement,
+			&seconds,
+		); err != nil {
+			return nil, err
+		}
+		query.Duration = durationpb.New(time.Duration(seconds) * time.Second)
+		queries = append(queries, query)
+		queryMap[query.ConnectionId] = query
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// The lock waits are best-effort, the sys schema may not exist, e.g. in MariaDB and TiDB.
+	lockRows, err := sqlDB.QueryContext(ctx, listMySQLLockWaitsStatement)
+	if err != nil {
+		slog.Debug("Failed to list lock waits", log.BBError(err))
+		return queries, nil
+	}
+	defer lockRows.Close()
+	for lockRows.Next() {
+		var waiting, blocking string
+		if err := lockRows.Scan(&waiting, &blocking); err != nil {
+			return nil, err
+		}
+		if query, ok := queryMap[waiting]; ok {
+			query.BlockedBy = append(query.BlockedBy, blocking)
+		}
+	}
+	if err := lockRows.Err(); err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
+
+// filterLongRunningQueries returns the queries running longer than the threshold or blocking other queries,
+// the longest first.
+func filterLongRunningQueries(queries []*storepb.AnomalyLongRunningQueryPayload_Query, threshold time.Duration) []*storepb.AnomalyLongRunningQueryPayload_Query {
+	blockingCount := make(map[string]int32)
+	for _, query := range queries {
+		for _, id := range query.BlockedBy {
+			blockingCount[id]++
+		}
+	}
+
+	var result []*storepb.AnomalyLongRunningQueryPayload_Query
+	for _, query := range queries {
+		query.BlockingCount = blockingCount[query.ConnectionId]
+		if query.BlockingCount == 0 && query.Duration.AsDuration() < threshold {
+			continue
+		}
+		query.Statement, _ = common.TruncateString(query.Statement, maxStatementLength)
+		result = append(result, query)
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Duration.AsDuration() > result[j].Duration.AsDuration()
+	})
+	return result
+}
+
+func supportLongRunningQueryDetection(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,39 @@
+package longquery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestFilterLongRunningQueries(t *testing.T) {
+	a := require.New(t)
+	newQuery := func(id string, d time.Duration, blockedBy ...string) *storepb.AnomalyLongRunningQueryPayload_Query {
+		return &storepb.AnomalyLongRunningQueryPayload_Query{
+			ConnectionId: id,
+			Duration:     durationpb.New(d),
+			BlockedBy:    blockedBy,
+		}
+	}
+	queries := []*storepb.AnomalyLongRunningQueryPayload_Query{
+		// The short query holding the locks.
+		newQuery("1", 5*time.Second),
+		newQuery("2", 2*time.Minute, "1"),
+		newQuery("3", 30*time.Second, "1"),
+		newQuery("4", 10*time.Minute),
+		newQuery("5", 10*time.Second),
+	}
+
+	got := filterLongRunningQueries(queries, time.Minute)
+	var ids []string
+	for _, query := range got {
+		ids = append(ids, query.ConnectionId)
+	}
+	a.Equal([]string{"4", "2", "1"}, ids)
+	a.Equal(int32(2), got[2].BlockingCount)
+	a.Equal(int32(0), got[0].BlockingCount)
+}
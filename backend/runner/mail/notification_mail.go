@@ -0,0 +1,226 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const notificationSendInterval = 1 * time.Minute
+
+var (
+	//go:embed templates/notification/en.html
+	//go:embed templates/notification/zh.html
+	notificationTemplates embed.FS
+)
+
+// notificationLanguage is the localized text of the notification emails.
+type notificationLanguage struct {
+	template      string
+	eventTitles   map[storepb.NotificationSetting_Event]string
+	digestSubject string
+}
+
+var (
+	notificationLanguageEn = &notificationLanguage{
+		template: "templates/notification/en.html",
+		eventTitles: map[storepb.NotificationSetting_Event]string{
+			storepb.NotificationSetting_ISSUE_CREATED:         "Issue created",
+			storepb.NotificationSetting_ISSUE_APPROVAL_NEEDED: "Issue approval needed",
+			storepb.NotificationSetting_TASK_RUN_FAILED:       "Task run failed",
+		},
+		digestSubject: "[Bytebase] You have %d issue notifications",
+	}
+	notificationLanguageZh = &notificationLanguage{
+		template: "templates/notification/zh.html",
+		eventTitles: map[storepb.NotificationSetting_Event]string{
+			storepb.NotificationSetting_ISSUE_CREATED:         "创建工单",
+			storepb.NotificationSetting_ISSUE_APPROVAL_NEEDED: "工单待审批",
+			storepb.NotificationSetting_TASK_RUN_FAILED:       "任务失败",
+		},
+		digestSubject: "[Bytebase] 您有 %d 条工单通知",
+	}
+)
+
+// NewNotificationSender creates a new notification mail sender.
+func NewNotificationSender(store *store.Store) *NotificationMailSender {
+	return &NotificationMailSender{
+		store: store,
+	}
+}
+
+// NotificationMailSender sends the queued email notifications of issue events,
+// either one email per event or batched in digests by the notification setting of the recipient.
+type NotificationMailSender struct {
+	store *store.Store
+}
+
+// Run will run the notification mail sender.
+func (s *NotificationMailSender) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(notificationSendInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Notification mail sender started and will run every %v", notificationSendInterval))
+	for {
+		select {
+		case <-ticker.C:
+			s.sendNotifications(ctx, time.Now())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *NotificationMailSender) sendNotifications(ctx context.Context, now time.Time) {
+	notifications, err := s.store.ListEmailNotifications(ctx)
+	if err != nil {
+		slog.Error("Failed to list email notifications", log.BBError(err))
+		return
+	}
+	if len(notifications) == 0 {
+		return
+	}
+	mailSetting, err := GetMailDeliverySetting(ctx, s.store)
+	if err != nil {
+		slog.Error("Failed to get mail delivery setting", log.BBError(err))
+		return
+	}
+	if mailSetting == nil {
+		// The notifications cannot be delivered without the mail delivery setting, drop them.
+		s.deleteNotifications(ctx, notifications)
+		return
+	}
+
+	var principalUIDs []int
+	notificationsMap := make(map[int][]*store.EmailNotificationMessage)
+	for _, notification := range notifications {
+		if _, ok := notificationsMap[notification.PrincipalUID]; !ok {
+			principalUIDs = append(principalUIDs, notification.PrincipalUID)
+		}
+		notificationsMap[notification.PrincipalUID] = append(notificationsMap[notification.PrincipalUID], notification)
+	}
+	settings, err := s.store.ListNotificationSettings(ctx, principalUIDs)
+	if err != nil {
+		slog.Error("Failed to list notification settings", log.BBError(err))
+		return
+	}
+
+	for _, principalUID := range principalUIDs {
+		userNotifications := notificationsMap[principalUID]
+		user, err := s.store.GetUserByID(ctx, principalUID)
+		if err != nil {
+			slog.Error("Failed to get user", slog.Int("id", principalUID), log.BBError(err))
+			continue
+		}
+		if user == nil || user.MemberDeleted {
+			s.deleteNotifications(ctx, userNotifications)
+			continue
+		}
+		setting := settings[principalUID]
+		// The notifications are ordered by creation, so the first one is the oldest.
+		if !isNotificationDue(setting.GetEmailDelivery(), userNotifications[0].CreatedTime, now) {
+			continue
+		}
+
+		language := getNotificationLanguage(setting.GetEmailLanguage())
+		var batches [][]*store.EmailNotificationMessage
+		switch setting.GetEmailDelivery() {
+		case storepb.NotificationSetting_HOURLY_DIGEST, storepb.NotificationSetting_DAILY_DIGEST:
+			batches = append(batches, userNotifications)
+		default:
+			for _, notification := range userNotifications {
+				batches = append(batches, []*store.EmailNotificationMessage{notification})
+			}
+		}
+		for _, batch := range batches {
+			subject, body, err := renderNotificationMail(language, batch)
+			if err != nil {
+				slog.Error("Failed to render the notification email", log.BBError(err))
+			} else if err := Send(mailSetting, subject, body, user.Email); err != nil {
+				slog.Error("Failed to send the notification email", slog.String("to", user.Email), log.BBError(err))
+			}
+			// The notifications are not retried to avoid flooding the recipients.
+			s.deleteNotifications(ctx, batch)
+		}
+	}
+}
+
+func (s *NotificationMailSender) deleteNotifications(ctx context.Context, notifications []*store.EmailNotificationMessage) {
+	var uids []int64
+	for _, notification := range notifications {
+		uids = append(uids, notification.UID)
+	}
+	if err := s.store.DeleteEmailNotifications(ctx, uids); err != nil {
+		slog.Error("Failed to delete email notifications", log.BBError(err))
+	}
+}
+
+// isNotificationDue returns whether the notifications should be sent now,
+// the digest is sent when its oldest notification has waited for the digest period.
+func isNotificationDue(delivery storepb.NotificationSetting_EmailDelivery, oldest time.Time, now time.Time) bool {
+	switch delivery {
+	case storepb.NotificationSetting_HOURLY_DIGEST:
+		return now.Sub(oldest) >= time.Hour
+	case storepb.NotificationSetting_DAILY_DIGEST:
+		return now.Sub(oldest) >= 24*time.Hour
+	default:
+		return true
+	}
+}
+
+func getNotificationLanguage(language string) *notificationLanguage {
+	if strings.HasPrefix(strings.ToLower(language), "zh") {
+		return notificationLanguageZh
+	}
+	return notificationLanguageEn
+}
+
+// renderNotificationMail renders the subject and the body of the notification email.
+// A single notification is titled by its event and issue, and several are titled as a digest.
+func renderNotificationMail(language *notificationLanguage, notifications []*store.EmailNotificationMessage) (string, string, error) {
+	type item struct {
+		EventTitle   string
+		ProjectTitle string
+		IssueTitle   string
+		Link         string
+		Actor        string
+		Detail       string
+	}
+	var items []item
+	for _, notification := range notifications {
+		payload := notification.Payload
+		items = append(items, item{
+			EventTitle:   language.eventTitles[payload.Event],
+			ProjectTitle: payload.ProjectTitle,
+			IssueTitle:   payload.IssueTitle,
+			Link:         payload.Link,
+			Actor:        payload.Actor,
+			Detail:       payload.Detail,
+		})
+	}
+
+	tmpl, err := template.ParseFS(notificationTemplates, language.template)
+	if err != nil {
+		return "", "", err
+	}
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, map[string]any{"Items": items}); err != nil {
+		return "", "", err
+	}
+
+	subject := fmt.Sprintf(language.digestSubject, len(items))
+	if len(items) == 1 {
+		subject = fmt.Sprintf("[Bytebase] %s: %s", items[0].EventTitle, items[0].IssueTitle)
+	}
+	return subject, body.String(), nil
+}
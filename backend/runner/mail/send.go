@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/mail"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetMailDeliverySetting returns the SMTP mail delivery setting, or nil if it's not configured.
+func GetMailDeliverySetting(ctx context.Context, stores *store.Store) (*storepb.SMTPMailDeliverySetting, error) {
+	name := api.SettingWorkspaceMailDelivery
+	setting, err := stores.GetSettingV2(ctx, &store.FindSettingMessage{Name: &name})
+	if err != nil {
+		return nil, err
+	}
+	if setting == nil {
+		return nil, nil
+	}
+	value := &storepb.SMTPMailDeliverySetting{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Send sends the HTML email with the mail delivery setting.
+func Send(mailSetting *storepb.SMTPMailDeliverySetting, subject, body string, to ...string) error {
+	email := mail.NewEmailMsg()
+	email.SetFrom(fmt.Sprintf("Bytebase <%s>", mailSetting.From)).
+		AddTo(to...).
+		SetSubject(subject).
+		SetBody(body)
+	client := mail.NewSMTPClient(mailSetting.Server, int(mailSetting.Port))
+	client.SetAuthType(convertToMailSMTPAuthType(mailSetting.Authentication)).
+		SetAuthCredentials(mailSetting.Username, mailSetting.Password).
+		SetEncryptionType(convertToMailSMTPEncryptionType(mailSetting.Encryption))
+	return client.SendMail(email)
+}
@@ -0,0 +1,164 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	bigquerydriver "github.com/bytebase/bytebase/backend/plugin/db/bigquery"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	bqparser "github.com/bytebase/bytebase/backend/plugin/parser/bigquery"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// onDemandPricePerTiB is the on-demand price of BigQuery in USD per TiB processed.
+// Docs: https://cloud.google.com/bigquery/pricing#on_demand_pricing.
+const onDemandPricePerTiB = 6.25
+
+var _ Executor = (*DryRunExecutor)(nil)
+
+// NewDryRunExecutor creates a dry run executor.
+func NewDryRunExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &DryRunExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// DryRunExecutor validates the statements with dry runs and estimates the bytes processed,
+// and checks the references of the authorized view and policy tag statements.
+type DryRunExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run runs the dry run executor.
+func (e *DryRunExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Dry run for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if instance.Engine != storepb.Engine_BIGQUERY {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Dry run is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	stmts, err := util.SanitizeSQL(statement)
+	if err != nil {
+		// The syntax error is reported by the statement advise check.
+		// nolint:nilerr
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Ok.Int32(),
+				Title:   "Failed to split the statement for the dry run",
+				Content: err.Error(),
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	bigqueryDriver, ok := driver.(*bigquerydriver.Driver)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to bigquery driver")
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	var totalBytes int64
+	for _, stmt := range stmts {
+		if s, ok := bqparser.ParseAccessStatement(stmt); ok {
+			if err := bigqueryDriver.CheckAccessStatement(ctx, s); err != nil {
+				results = append(results, &storepb.PlanCheckRunResult_Result{
+					Status:  storepb.PlanCheckRunResult_Result_ERROR,
+					Code:    common.Internal.Int32(),
+					Title:   "Invalid access statement",
+					Content: fmt.Sprintf("%s\n%s", stmt, err.Error()),
+				})
+			}
+			continue
+		}
+		bytes, err := bigqueryDriver.DryRun(ctx, stmt)
+		if err != nil {
+			// The statement may reference the objects created by the previous statements, which don't exist before the change.
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Internal.Int32(),
+				Title:   "Dry run failed",
+				Content: fmt.Sprintf("%s\n%s", stmt, err.Error()),
+			})
+			continue
+		}
+		totalBytes += bytes
+	}
+
+	results = append(results, &storepb.PlanCheckRunResult_Result{
+		Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+		Code:    common.Ok.Int32(),
+		Title:   "Estimated cost",
+		Content: fmt.Sprintf("The statements will process %s, about $%.4f at the on-demand price of $%.2f per TiB.", formatBytes(totalBytes), float64(totalBytes)/(1<<40)*onDemandPricePerTiB, onDemandPricePerTiB),
+	})
+	return results, nil
+}
+
+func formatBytes(bytes int64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+	value := float64(bytes)
+	i := 0
+	for value >= 1024 && i < len(units)-1 {
+		value /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%d B", bytes)
+	}
+	return fmt.Sprintf("%.2f %s", value, units[i])
+}
@@ -92,7 +92,14 @@ func (e *GhostSyncExecutor) Run(ctx context.Context, config *storepb.PlanCheckRu
 		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
 	}
 
-	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	statement, err = utils.RenderStatementVariablesForDatabase(ctx, e.store, statement, database)
+	if err != nil {
+		return nil, err
+	}
+	materials, err := utils.GetSecretMapFromDatabaseMessage(ctx, database)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database secrets")
+	}
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
@@ -0,0 +1,332 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	snowflakedriver "github.com/bytebase/bytebase/backend/plugin/db/snowflake"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	snowsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/snowflake"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var _ Executor = (*GrantPreviewExecutor)(nil)
+
+// NewGrantPreviewExecutor creates a grant preview executor.
+func NewGrantPreviewExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &GrantPreviewExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// GrantPreviewExecutor previews the effective privilege changes of the GRANT and REVOKE statements
+// against the current grants of the instance, so that the reviewers can approve the grants.
+type GrantPreviewExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run runs the grant preview executor.
+func (e *GrantPreviewExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	sheetUID := int(config.SheetUid)
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil {
+		return nil, errors.Errorf("sheet %d not found", sheetUID)
+	}
+	if sheet.Size > common.MaxSheetCheckSize {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.SizeExceeded.Int32(),
+				Title:   "Grant preview for large SQL is not supported",
+				Content: "",
+			},
+		}, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if instance.Engine != storepb.Engine_SNOWFLAKE {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Grant preview is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	list, err := base.SplitMultiSQL(storepb.Engine_SNOWFLAKE, statement)
+	if err != nil {
+		// The syntax error is reported by the statement advise check.
+		// nolint:nilerr
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Ok.Int32(),
+				Title:   "Failed to split the statement for the grant preview",
+				Content: err.Error(),
+			},
+		}, nil
+	}
+	var grants []*snowsqlparser.GrantStatement
+	for _, sql := range list {
+		if sql.Empty {
+			continue
+		}
+		if grant, ok := snowsqlparser.ParseGrantStatement(sql.Text); ok {
+			grants = append(grants, grant)
+		}
+	}
+	if len(grants) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "No grant changes",
+				Content: "",
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	snowflakeDriver, ok := driver.(*snowflakedriver.Driver)
+	if !ok {
+		return nil, errors.Errorf("failed to convert to snowflake driver")
+	}
+
+	previewer := &grantPreviewer{
+		driver:    snowflakeDriver,
+		roleGrant: make(map[string][]*snowflakedriver.Grant),
+		userRoles: make(map[string][]string),
+	}
+	var results []*storepb.PlanCheckRunResult_Result
+	for _, grant := range grants {
+		result, err := previewer.preview(ctx, grant)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// grantPreviewer previews the grant statements in order, and applies each previewed change to
+// the cached grants so that the later statements in the same sheet see the earlier ones.
+type grantPreviewer struct {
+	driver *snowflakedriver.Driver
+	// roleGrant is the grants to the role keyed by the role name.
+	roleGrant map[string][]*snowflakedriver.Grant
+	// userRoles is the roles granted to the user keyed by the user name.
+	userRoles map[string][]string
+}
+
+func (p *grantPreviewer) preview(ctx context.Context, grant *snowsqlparser.GrantStatement) (*storepb.PlanCheckRunResult_Result, error) {
+	switch grant.Type {
+	case snowsqlparser.GrantStatementTypeGrantPrivileges, snowsqlparser.GrantStatementTypeRevokePrivileges:
+		return p.previewPrivileges(ctx, grant)
+	case snowsqlparser.GrantStatementTypeGrantRole, snowsqlparser.GrantStatementTypeRevokeRole:
+		return p.previewRole(ctx, grant)
+	default:
+		return nil, errors.Errorf("unsupported grant statement type %v", grant.Type)
+	}
+}
+
+func (p *grantPreviewer) previewPrivileges(ctx context.Context, grant *snowsqlparser.GrantStatement) (*storepb.PlanCheckRunResult_Result, error) {
+	isGrant := grant.Type == snowsqlparser.GrantStatementTypeGrantPrivileges
+	title := fmt.Sprintf("Revoke privileges from %s %s", grant.GranteeType, grant.Grantee)
+	sign := "-"
+	if isGrant {
+		title = fmt.Sprintf("Grant privileges to %s %s", grant.GranteeType, grant.Grantee)
+		sign = "+"
+	}
+	object := fmt.Sprintf("%s %s", grant.ObjectType, grant.ObjectName)
+	if grant.Bulk != "" {
+		object = fmt.Sprintf("%s %s in %s", grant.Bulk, grant.ObjectType, grant.ObjectName)
+	}
+	grantOption := ""
+	if grant.GrantOption {
+		grantOption = " WITH GRANT OPTION"
+	}
+
+	// Only the grants to roles can be compared with the current grants, the grants on all or future objects
+	// apply to the objects rather than the container, so they are described as is.
+	if grant.GranteeType != "ROLE" || grant.Bulk != "" || slices.Contains(grant.Privileges, "ALL") {
+		var lines []string
+		for _, privilege := range grant.Privileges {
+			lines = append(lines, fmt.Sprintf("%s %s ON %s%s", sign, privilege, object, grantOption))
+		}
+		return newGrantPreviewResult(storepb.PlanCheckRunResult_Result_SUCCESS, title, lines), nil
+	}
+
+	current, err := p.getRoleGrants(ctx, grant.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	var lines, unchanged []string
+	for _, privilege := range grant.Privileges {
+		i := slices.IndexFunc(current, func(g *snowflakedriver.Grant) bool {
+			return g.Privilege == privilege && g.GrantedOn == grant.ObjectType && snowsqlparser.NormalizeGrantIdentifier(g.Name) == grant.ObjectName
+		})
+		switch {
+		case isGrant && i >= 0 && (current[i].GrantOption || !grant.GrantOption):
+			unchanged = append(unchanged, fmt.Sprintf("%s ON %s is already granted", privilege, object))
+		case isGrant:
+			lines = append(lines, fmt.Sprintf("+ %s ON %s%s", privilege, object, grantOption))
+			if i >= 0 {
+				current[i].GrantOption = true
+			} else {
+				current = append(current, &snowflakedriver.Grant{Privilege: privilege, GrantedOn: grant.ObjectType, Name: grant.ObjectName, GrantOption: grant.GrantOption})
+			}
+		case i < 0 || (grant.GrantOption && !current[i].GrantOption):
+			unchanged = append(unchanged, fmt.Sprintf("%s ON %s%s is not granted", privilege, object, grantOption))
+		case grant.GrantOption:
+			lines = append(lines, fmt.Sprintf("- GRANT OPTION FOR %s ON %s", privilege, object))
+			current[i].GrantOption = false
+		default:
+			lines = append(lines, fmt.Sprintf("- %s ON %s", privilege, object))
+			current = slices.Delete(current, i, i+1)
+		}
+	}
+	p.roleGrant[grant.Grantee] = current
+	return newGrantPreviewResultWithUnchanged(title, lines, unchanged), nil
+}
+
+func (p *grantPreviewer) previewRole(ctx context.Context, grant *snowsqlparser.GrantStatement) (*storepb.PlanCheckRunResult_Result, error) {
+	isGrant := grant.Type == snowsqlparser.GrantStatementTypeGrantRole
+	title := fmt.Sprintf("Revoke role %s from %s %s", grant.Role, grant.GranteeType, grant.Grantee)
+	sign := "-"
+	if isGrant {
+		title = fmt.Sprintf("Grant role %s to %s %s", grant.Role, grant.GranteeType, grant.Grantee)
+		sign = "+"
+	}
+
+	var granted bool
+	switch grant.GranteeType {
+	case "ROLE":
+		current, err := p.getRoleGrants(ctx, grant.Grantee)
+		if err != nil {
+			return nil, err
+		}
+		i := slices.IndexFunc(current, func(g *snowflakedriver.Grant) bool {
+			return g.GrantedOn == "ROLE" && snowsqlparser.NormalizeGrantIdentifier(g.Name) == grant.Role
+		})
+		granted = i >= 0
+		if isGrant && !granted {
+			current = append(current, &snowflakedriver.Grant{Privilege: "USAGE", GrantedOn: "ROLE", Name: grant.Role})
+		} else if !isGrant && granted {
+			current = slices.Delete(current, i, i+1)
+		}
+		p.roleGrant[grant.Grantee] = current
+	case "USER":
+		roles, ok := p.userRoles[grant.Grantee]
+		if !ok {
+			var err error
+			roles, err = p.driver.ListRolesGrantedToUser(ctx, grant.Grantee)
+			if err != nil {
+				return nil, err
+			}
+		}
+		i := slices.IndexFunc(roles, func(role string) bool {
+			return snowsqlparser.NormalizeGrantIdentifier(role) == grant.Role
+		})
+		granted = i >= 0
+		if isGrant && !granted {
+			roles = append(roles, grant.Role)
+		} else if !isGrant && granted {
+			roles = slices.Delete(roles, i, i+1)
+		}
+		p.userRoles[grant.Grantee] = roles
+	default:
+		// The grants to database roles and shares are described as is.
+		return newGrantPreviewResult(storepb.PlanCheckRunResult_Result_SUCCESS, title, []string{fmt.Sprintf("%s ROLE %s", sign, grant.Role)}), nil
+	}
+	if isGrant == granted {
+		state := "is not granted"
+		if granted {
+			state = "is already granted"
+		}
+		return newGrantPreviewResultWithUnchanged(title, nil, []string{fmt.Sprintf("Role %s %s to %s %s", grant.Role, state, grant.GranteeType, grant.Grantee)}), nil
+	}
+
+	// The grantee inherits or loses the privileges of the role, including the privileges of the roles granted to the role.
+	roleGrants, err := p.getRoleGrants(ctx, grant.Role)
+	if err != nil {
+		return nil, err
+	}
+	lines := []string{fmt.Sprintf("%s ROLE %s", sign, grant.Role)}
+	for _, g := range roleGrants {
+		lines = append(lines, fmt.Sprintf("  %s %s ON %s %s", sign, g.Privilege, g.GrantedOn, g.Name))
+	}
+	return newGrantPreviewResult(storepb.PlanCheckRunResult_Result_SUCCESS, title, lines), nil
+}
+
+func (p *grantPreviewer) getRoleGrants(ctx context.Context, role string) ([]*snowflakedriver.Grant, error) {
+	if grants, ok := p.roleGrant[role]; ok {
+		return grants, nil
+	}
+	grants, err := p.driver.ListGrantsToRole(ctx, role)
+	if err != nil {
+		// The role is created in the same sheet.
+		if strings.Contains(err.Error(), "does not exist") {
+			grants = nil
+		} else {
+			return nil, errors.Wrapf(err, "failed to list grants to role %q", role)
+		}
+	}
+	p.roleGrant[role] = grants
+	return grants, nil
+}
+
+func newGrantPreviewResultWithUnchanged(title string, lines, unchanged []string) *storepb.PlanCheckRunResult_Result {
+	if len(unchanged) == 0 {
+		return newGrantPreviewResult(storepb.PlanCheckRunResult_Result_SUCCESS, title, lines)
+	}
+	for _, line := range unchanged {
+		lines = append(lines, fmt.Sprintf("  %s (no change)", line))
+	}
+	return newGrantPreviewResult(storepb.PlanCheckRunResult_Result_WARNING, title, lines)
+}
+
+func newGrantPreviewResult(status storepb.PlanCheckRunResult_Result_Status, title string, lines []string) *storepb.PlanCheckRunResult_Result {
+	return &storepb.PlanCheckRunResult_Result{
+		Status:  status,
+		Code:    common.Ok.Int32(),
+		Title:   title,
+		Content: strings.Join(lines, "\n"),
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"math"
+	"slices"
 	"sync"
 	"time"
 
@@ -15,6 +17,7 @@ import (
 	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -80,22 +83,120 @@ func (s *Scheduler) runOnce(ctx context.Context) {
 		}
 	}()
 
+	planCheckRuns, boostedPlans, err := s.listPendingPlanCheckRuns(ctx)
+	if err != nil {
+		slog.Error("failed to list running plan check runs", log.BBError(err))
+		return
+	}
+	setting, err := s.store.GetPlanCheckSetting(ctx)
+	if err != nil {
+		slog.Error("failed to get plan check setting", log.BBError(err))
+		return
+	}
+	// The plan checks of the issues awaiting approval run first so that the approvers don't wait for them.
+	slices.SortStableFunc(planCheckRuns, func(a, b *store.PlanCheckRunMessage) int {
+		if boostedPlans[a.PlanUID] == boostedPlans[b.PlanUID] {
+			return 0
+		}
+		if boostedPlans[a.PlanUID] {
+			return -1
+		}
+		return 1
+	})
+
+	for _, planCheckRun := range planCheckRuns {
+		s.runPlanCheckRun(ctx, planCheckRun, int(setting.MaxConcurrentPerInstance))
+	}
+}
+
+// listPendingPlanCheckRuns lists the plan check runs in the RUNNING status, which are either running or queued,
+// and the set of the plans belonging to the issues awaiting approval.
+func (s *Scheduler) listPendingPlanCheckRuns(ctx context.Context) ([]*store.PlanCheckRunMessage, map[int64]bool, error) {
 	planCheckRuns, err := s.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
 		Status: &[]store.PlanCheckRunStatus{
 			store.PlanCheckRunStatusRunning,
 		},
 	})
 	if err != nil {
-		slog.Error("failed to list running plan check runs", log.BBError(err))
-		return
+		return nil, nil, err
+	}
+	boostedPlans := make(map[int64]bool)
+	checkedPlans := make(map[int64]bool)
+	for _, planCheckRun := range planCheckRuns {
+		if checkedPlans[planCheckRun.PlanUID] {
+			continue
+		}
+		checkedPlans[planCheckRun.PlanUID] = true
+		awaiting, err := s.isAwaitingApproval(ctx, planCheckRun.PlanUID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if awaiting {
+			boostedPlans[planCheckRun.PlanUID] = true
+		}
+	}
+	return planCheckRuns, boostedPlans, nil
+}
+
+func (s *Scheduler) isAwaitingApproval(ctx context.Context, planUID int64) (bool, error) {
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PlanUID: &planUID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get issue of plan %d", planUID)
 	}
+	if issue == nil || issue.Status != api.IssueOpen {
+		return false, nil
+	}
+	approval := issue.Payload.GetApproval()
+	if !approval.GetApprovalFindingDone() || len(approval.GetApprovalTemplates()) == 0 {
+		return false, nil
+	}
+	approved, err := utils.CheckApprovalApproved(approval)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to check if the issue %d is approved", issue.UID)
+	}
+	return !approved, nil
+}
 
+// InstanceQueue is the plan check queue of an instance.
+type InstanceQueue struct {
+	InstanceUID int
+	// Running is the number of the executing plan checks.
+	Running int
+	// Queued is the number of the plan checks waiting to execute.
+	Queued int
+	// Boosted is the number of the queued plan checks belonging to the issues awaiting approval.
+	Boosted int
+}
+
+// ListInstanceQueues returns the plan check queues of the instances which have pending plan checks.
+func (s *Scheduler) ListInstanceQueues(ctx context.Context) ([]*InstanceQueue, error) {
+	planCheckRuns, boostedPlans, err := s.listPendingPlanCheckRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var queues []*InstanceQueue
+	queueMap := make(map[int]*InstanceQueue)
 	for _, planCheckRun := range planCheckRuns {
-		s.runPlanCheckRun(ctx, planCheckRun)
+		instanceUID := int(planCheckRun.Config.GetInstanceUid())
+		queue, ok := queueMap[instanceUID]
+		if !ok {
+			queue = &InstanceQueue{InstanceUID: instanceUID}
+			queueMap[instanceUID] = queue
+			queues = append(queues, queue)
+		}
+		if _, ok := s.stateCfg.RunningPlanChecks.Load(planCheckRun.UID); ok {
+			queue.Running++
+			continue
+		}
+		queue.Queued++
+		if boostedPlans[planCheckRun.PlanUID] {
+			queue.Boosted++
+		}
 	}
+	return queues, nil
 }
 
-func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.PlanCheckRunMessage) {
+func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.PlanCheckRunMessage, maxConcurrentPerInstance int) {
 	executor, ok := s.executors[planCheckRun.Type]
 	if !ok {
 		slog.Error("Skip running plan check for unknown type", slog.Int("uid", planCheckRun.UID), slog.Int64("plan_uid", planCheckRun.PlanUID), slog.String("type", string(planCheckRun.Type)))
@@ -117,12 +218,21 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 	if s.stateCfg.InstanceOutstandingConnections.Increment(instanceUID, maximumConnections) {
 		return
 	}
+	if maxConcurrentPerInstance <= 0 {
+		// The plan checks are only limited by the maximum connections of the instance.
+		maxConcurrentPerInstance = math.MaxInt32
+	}
+	if s.stateCfg.InstanceRunningPlanChecks.Increment(instanceUID, maxConcurrentPerInstance) {
+		s.stateCfg.InstanceOutstandingConnections.Decrement(instanceUID)
+		return
+	}
 
 	s.stateCfg.RunningPlanChecks.Store(planCheckRun.UID, true)
 	go func() {
 		defer func() {
 			s.stateCfg.RunningPlanChecks.Delete(planCheckRun.UID)
 			s.stateCfg.RunningPlanCheckRunsCancelFunc.Delete(planCheckRun.UID)
+			s.stateCfg.InstanceRunningPlanChecks.Decrement(instanceUID)
 			s.stateCfg.InstanceOutstandingConnections.Decrement(instanceUID)
 		}()
 
@@ -3,6 +3,7 @@ package plancheck
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -99,6 +100,33 @@ func (e *StatementAdviseExecutor) Run(ctx context.Context, config *storepb.PlanC
 	if instance == nil {
 		return nil, errors.Errorf("instance not found UID %v", instanceUID)
 	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+
+	project, err := e.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return nil, errors.Errorf("project not found %q", database.ProjectID)
+	}
+	statement, undefined := utils.RenderStatementVariables(statement, project.Setting.GetStatementVariables(), database)
+	if len(undefined) > 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Code:    common.Invalid.Int32(),
+				Title:   "Undefined statement variables",
+				Content: fmt.Sprintf("The statement variables %s have no value for database %q in environment %q", strings.Join(undefined, ", "), database.DatabaseName, database.EffectiveEnvironmentID),
+			},
+		}, nil
+	}
+
 	if !common.StatementAdviseEngines[instance.Engine] {
 		return []*storepb.PlanCheckRunResult_Result{
 			{
@@ -110,14 +138,6 @@ func (e *StatementAdviseExecutor) Run(ctx context.Context, config *storepb.PlanC
 		}, nil
 	}
 
-	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
-	}
-	if database == nil {
-		return nil, errors.Errorf("database not found %q", config.DatabaseName)
-	}
-
 	results, err := e.runReview(ctx, instance, database, changeType, statement, preUpdateBackupDetail)
 	if err != nil {
 		return nil, err
@@ -177,7 +197,10 @@ func (e *StatementAdviseExecutor) runReview(
 	defer driver.Close(ctx)
 	connection := driver.GetDB()
 
-	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	materials, err := utils.GetSecretMapFromDatabaseMessage(ctx, database)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database secrets")
+	}
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 	adviceList, err := advisor.SQLReviewCheck(e.sheetManager, renderedStatement, reviewConfig.SqlReviewRules, advisor.SQLReviewCheckContext{
@@ -0,0 +1,203 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/sheet"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var _ Executor = (*StatementConflictExecutor)(nil)
+
+// NewStatementConflictExecutor creates a statement conflict executor.
+func NewStatementConflictExecutor(store *store.Store, sheetManager *sheet.Manager) Executor {
+	return &StatementConflictExecutor{
+		store:        store,
+		sheetManager: sheetManager,
+	}
+}
+
+// StatementConflictExecutor checks if other open issues change the same tables of the database.
+type StatementConflictExecutor struct {
+	store        *store.Store
+	sheetManager *sheet.Manager
+}
+
+// Run runs the statement conflict executor.
+func (e *StatementConflictExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	if !common.StatementReportEngines[instance.Engine] {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Conflict check is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	dbSchema, err := e.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, err
+	}
+	if dbSchema == nil {
+		return nil, errors.Errorf("database schema not found: %d", database.UID)
+	}
+
+	tables, err := e.getChangedTables(ctx, instance.Engine, database.DatabaseName, dbSchema, int(config.SheetUid))
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*storepb.PlanCheckRunResult_Result
+	if len(tables) > 0 {
+		issues, err := e.store.ListIssueV2(ctx, &store.FindIssueMessage{
+			ProjectID:  &database.ProjectID,
+			StatusList: []api.IssueStatus{api.IssueOpen},
+			Types:      &[]api.IssueType{api.IssueDatabaseGeneral},
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list issues")
+		}
+		target := common.FormatDatabase(instance.ResourceID, database.DatabaseName)
+		for _, issue := range issues {
+			if issue.PlanUID == nil || *issue.PlanUID == config.PlanUid {
+				continue
+			}
+			plan, err := e.store.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get plan %d", *issue.PlanUID)
+			}
+			if plan == nil {
+				continue
+			}
+			conflictTables := make(map[string]bool)
+			for _, step := range plan.Config.GetSteps() {
+				for _, spec := range step.Specs {
+					changeDatabaseConfig := spec.GetChangeDatabaseConfig()
+					if changeDatabaseConfig == nil || changeDatabaseConfig.Target != target {
+						continue
+					}
+					_, sheetUID, err := common.GetProjectResourceIDSheetUID(changeDatabaseConfig.Sheet)
+					if err != nil {
+						continue
+					}
+					otherTables, err := e.getChangedTables(ctx, instance.Engine, database.DatabaseName, dbSchema, sheetUID)
+					if err != nil {
+						return nil, err
+					}
+					for table := range otherTables {
+						if tables[table] {
+							conflictTables[table] = true
+						}
+					}
+				}
+			}
+			if len(conflictTables) == 0 {
+				continue
+			}
+			issueName := fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, issue.Project.ResourceID, common.IssueNamePrefix, issue.UID)
+			var tableList []string
+			for table := range conflictTables {
+				tableList = append(tableList, table)
+			}
+			slices.Sort(tableList)
+			results = append(results, &storepb.PlanCheckRunResult_Result{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Conflict.Int32(),
+				Title:   fmt.Sprintf("Conflicting change in issue #%d", issue.UID),
+				Content: fmt.Sprintf("Issue %q (%s) also changes %s", issue.Title, issueName, strings.Join(tableList, ", ")),
+				Report: &storepb.PlanCheckRunResult_Result_ConflictReport_{
+					ConflictReport: &storepb.PlanCheckRunResult_Result_ConflictReport{
+						Issue:  issueName,
+						Tables: tableList,
+					},
+				},
+			})
+		}
+	}
+
+	if len(results) == 0 {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   "OK",
+				Content: "",
+			},
+		}, nil
+	}
+	return results, nil
+}
+
+// getChangedTables returns the tables changed by the sheet in the format of schema.table or table.
+// Large sheets and sheets with syntax errors are skipped since other checks report them.
+func (e *StatementConflictExecutor) getChangedTables(ctx context.Context, engine storepb.Engine, databaseName string, dbSchema *model.DBSchema, sheetUID int) (map[string]bool, error) {
+	sheet, err := e.store.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+	}
+	if sheet == nil || sheet.Size > common.MaxSheetCheckSize {
+		return nil, nil
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, err
+	}
+	asts, advices := e.sheetManager.GetASTsForChecks(engine, statement)
+	if len(advices) > 0 {
+		return nil, nil
+	}
+
+	var defaultSchema string
+	switch engine {
+	case storepb.Engine_POSTGRES:
+		defaultSchema = "public"
+	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
+		defaultSchema = databaseName
+	case storepb.Engine_MSSQL:
+		defaultSchema = "DBO"
+	}
+	changeSummary, err := base.ExtractChangedResources(engine, databaseName, defaultSchema, dbSchema, asts, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to extract changed resources")
+	}
+
+	tables := make(map[string]bool)
+	for _, database := range changeSummary.ChangedResources.Build().GetDatabases() {
+		for _, schema := range database.Schemas {
+			for _, table := range schema.Tables {
+				if schema.Name == "" {
+					tables[table.Name] = true
+				} else {
+					tables[fmt.Sprintf("%s.%s", schema.Name, table.Name)] = true
+				}
+			}
+		}
+	}
+	return tables, nil
+}
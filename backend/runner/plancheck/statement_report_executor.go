@@ -19,6 +19,9 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
 	mysqlparser "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/pg"
+	plsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
+	tidbparser "github.com/bytebase/bytebase/backend/plugin/parser/tidb"
+	tsqlparser "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -123,7 +126,14 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		return nil, errors.Errorf("database schema metadata not found: %d", database.UID)
 	}
 
-	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	statement, err = utils.RenderStatementVariablesForDatabase(ctx, e.store, statement, database)
+	if err != nil {
+		return nil, err
+	}
+	materials, err := utils.GetSecretMapFromDatabaseMessage(ctx, database)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get database secrets")
+	}
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
@@ -180,13 +190,16 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		}
 		explainCalculator = md.CountAffectedRows
 
-		if instance.Engine != storepb.Engine_OCEANBASE {
+		switch instance.Engine {
+		case storepb.Engine_MYSQL:
 			sqlTypes, err = mysqlparser.GetStatementTypes(asts)
-			if err != nil {
-				return nil, err
-			}
+		case storepb.Engine_TIDB:
+			sqlTypes, err = tidbparser.GetStatementTypes(asts)
+		default:
+		}
+		if err != nil {
+			return nil, err
 		}
-		// TODO(d): implement TiDB sqlTypes.
 		defaultSchema = ""
 	case storepb.Engine_ORACLE, storepb.Engine_OCEANBASE_ORACLE:
 		od, ok := driver.(*oracledriver.Driver)
@@ -195,6 +208,10 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		}
 		explainCalculator = od.CountAffectedRows
 
+		sqlTypes, err = plsqlparser.GetStatementTypes(asts)
+		if err != nil {
+			return nil, err
+		}
 		defaultSchema = database.DatabaseName
 	case storepb.Engine_MSSQL:
 		md, ok := driver.(*mssqldriver.Driver)
@@ -203,6 +220,10 @@ func (e *StatementReportExecutor) runReport(ctx context.Context, instance *store
 		}
 		explainCalculator = md.CountAffectedRows
 
+		sqlTypes, err = tsqlparser.GetStatementTypes(asts)
+		if err != nil {
+			return nil, err
+		}
 		defaultSchema = "DBO"
 	default:
 		// Already checked in the Run().
@@ -0,0 +1,206 @@
+package plancheck
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/runner/backup"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+var _ Executor = (*StatementSimulateExecutor)(nil)
+
+// NewStatementSimulateExecutor creates a statement simulate executor.
+func NewStatementSimulateExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, backupRunner *backup.Runner) Executor {
+	return &StatementSimulateExecutor{
+		store:        store,
+		dbFactory:    dbFactory,
+		backupRunner: backupRunner,
+	}
+}
+
+// StatementSimulateExecutor applies the change to a scratch clone of the database on the simulation instance,
+// and reports the timing and the errors. The clone is dropped afterwards.
+type StatementSimulateExecutor struct {
+	store        *store.Store
+	dbFactory    *dbfactory.DBFactory
+	backupRunner *backup.Runner
+}
+
+// Run runs the statement simulate executor.
+func (e *StatementSimulateExecutor) Run(ctx context.Context, config *storepb.PlanCheckRunConfig) ([]*storepb.PlanCheckRunResult_Result, error) {
+	instanceUID := int(config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+	simulationInstanceUID := int(config.SimulationInstanceUid)
+	simulationInstance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &simulationInstanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", simulationInstanceUID)
+	}
+	if simulationInstance == nil {
+		return nil, errors.Errorf("instance not found UID %v", simulationInstanceUID)
+	}
+	switch instance.Engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_TIDB, storepb.Engine_OCEANBASE:
+	default:
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+				Code:    common.Ok.Int32(),
+				Title:   fmt.Sprintf("Simulation is not supported for %s", instance.Engine),
+				Content: "",
+			},
+		}, nil
+	}
+	if simulationInstance.Engine != instance.Engine {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Internal.Int32(),
+				Title:   "Simulation skipped",
+				Content: fmt.Sprintf("The simulation instance %q is %s, but the database is on %s", simulationInstance.Title, simulationInstance.Engine, instance.Engine),
+			},
+		}, nil
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", config.DatabaseName)
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, int(config.SheetUid))
+	if err != nil {
+		return nil, err
+	}
+
+	scratchDatabaseName := fmt.Sprintf("bytebase_simulation_%d", time.Now().UnixNano())
+	cloneStart := time.Now()
+	source, err := e.cloneDatabase(ctx, instance, database, simulationInstance, scratchDatabaseName)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_WARNING,
+				Code:    common.Internal.Int32(),
+				Title:   "Simulation skipped",
+				Content: fmt.Sprintf("Failed to clone %q to %q: %v", database.DatabaseName, simulationInstance.Title, err),
+			},
+		}, nil
+	}
+	cloneDuration := time.Since(cloneStart)
+	defer func() {
+		// Use a new context to drop the scratch database even if the plan check is canceled.
+		if err := e.dropDatabase(context.Background(), simulationInstance, scratchDatabaseName); err != nil {
+			slog.Error("failed to drop the scratch database", slog.String("instance", simulationInstance.ResourceID), slog.String("database", scratchDatabaseName), log.BBError(err))
+		}
+	}()
+
+	applyStart := time.Now()
+	affectedRows, err := func() (int64, error) {
+		driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, simulationInstance, &store.DatabaseMessage{DatabaseName: scratchDatabaseName}, db.ConnectionContext{})
+		if err != nil {
+			return 0, err
+		}
+		defer driver.Close(ctx)
+		return driver.Execute(ctx, statement, db.ExecuteOptions{})
+	}()
+	applyDuration := time.Since(applyStart)
+	cloneSummary := fmt.Sprintf("Cloned %q to %q from %s in %v.", database.DatabaseName, simulationInstance.Title, source, cloneDuration.Round(time.Millisecond))
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Code:    common.DbExecutionError.Int32(),
+				Title:   "Simulation failed",
+				Content: fmt.Sprintf("%s The change failed after %v: %v", cloneSummary, applyDuration.Round(time.Millisecond), err),
+			},
+		}, nil
+	}
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+			Code:    common.Ok.Int32(),
+			Title:   "Simulation succeeded",
+			Content: fmt.Sprintf("%s The change was applied in %v with %d rows affected.", cloneSummary, applyDuration.Round(time.Millisecond), affectedRows),
+		},
+	}, nil
+}
+
+// cloneDatabase clones the database into the scratch database on the simulation instance, and returns the description of the clone source.
+// Postgres databases are cloned with CREATE DATABASE ... TEMPLATE on the same instance, which fails if the database has other connections.
+// Otherwise, the latest backup of the database is restored.
+func (e *StatementSimulateExecutor) cloneDatabase(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, simulationInstance *store.InstanceMessage, scratchDatabaseName string) (string, error) {
+	var templateErr error
+	if instance.Engine == storepb.Engine_POSTGRES && instance.UID == simulationInstance.UID {
+		templateErr = func() error {
+			driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, simulationInstance, nil /* database */, db.ConnectionContext{})
+			if err != nil {
+				return err
+			}
+			defer driver.Close(ctx)
+			_, err = driver.Execute(ctx, fmt.Sprintf("CREATE DATABASE %s TEMPLATE %s", quoteIdentifier(instance.Engine, scratchDatabaseName), quoteIdentifier(instance.Engine, database.DatabaseName)), db.ExecuteOptions{CreateDatabase: true})
+			return err
+		}()
+		if templateErr == nil {
+			return "the template", nil
+		}
+	}
+
+	limit := 1
+	backupRuns, err := e.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{
+		DatabaseUID: &database.UID,
+		StatusList:  []store.BackupRunStatus{store.BackupRunDone},
+		Limit:       &limit,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list backup runs")
+	}
+	if len(backupRuns) == 0 {
+		if templateErr != nil {
+			return "", errors.Wrapf(templateErr, "no backup to restore and failed to create the database from the template")
+		}
+		return "", errors.Errorf("no backup to restore")
+	}
+	backupRun := backupRuns[0]
+	if err := e.backupRunner.Restore(ctx, simulationInstance, backupRun, scratchDatabaseName, true /* createDatabase */, func(int64, int64) {}); err != nil {
+		// The database may be created before the restore fails.
+		if dropErr := e.dropDatabase(context.Background(), simulationInstance, scratchDatabaseName); dropErr != nil {
+			slog.Error("failed to drop the scratch database", slog.String("instance", simulationInstance.ResourceID), slog.String("database", scratchDatabaseName), log.BBError(dropErr))
+		}
+		return "", errors.Wrapf(err, "failed to restore backup run %d", backupRun.UID)
+	}
+	return fmt.Sprintf("the backup taken at %s", backupRun.CreatedTime.UTC().Format(time.RFC3339)), nil
+}
+
+func (e *StatementSimulateExecutor) dropDatabase(ctx context.Context, instance *store.InstanceMessage, databaseName string) error {
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */, db.ConnectionContext{})
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+	_, err = driver.Execute(ctx, fmt.Sprintf("DROP DATABASE IF EXISTS %s", quoteIdentifier(instance.Engine, databaseName)), db.ExecuteOptions{CreateDatabase: true})
+	return err
+}
+
+func quoteIdentifier(engine storepb.Engine, s string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
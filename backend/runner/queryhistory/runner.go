@@ -0,0 +1,64 @@
+// Package queryhistory is a runner that deletes the SQL Editor query histories out of the retention.
+package queryhistory
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const cleanInterval = 1 * time.Hour
+
+// NewRunner creates a query history runner.
+func NewRunner(stores *store.Store) *Runner {
+	return &Runner{
+		store: stores,
+	}
+}
+
+// Runner is the query history runner.
+type Runner struct {
+	store *store.Store
+}
+
+// Run will run the query history runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(cleanInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Query history runner started and will run every %v", cleanInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.purgeExpired(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) purgeExpired(ctx context.Context) {
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace profile setting", log.BBError(err))
+		return
+	}
+	// Keep the query histories forever if the retention is not set.
+	if setting.GetQueryHistoryRetention().GetSeconds() <= 0 {
+		return
+	}
+	deleted, err := r.store.DeleteQueryHistoriesBefore(ctx, time.Now().Add(-setting.GetQueryHistoryRetention().AsDuration()).Unix())
+	if err != nil {
+		slog.Error("Failed to delete expired query histories", log.BBError(err))
+		return
+	}
+	if deleted > 0 {
+		slog.Debug(fmt.Sprintf("Deleted %d expired query histories", deleted))
+	}
+}
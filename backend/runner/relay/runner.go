@@ -1,4 +1,4 @@
-// Package relay is the runner for the relay plugin.
+// Package relay is the runner tracking the external approvals of the approval providers, e.g. the relay service.
 package relay
 
 import (
@@ -10,6 +10,8 @@ import (
 
 	"github.com/pkg/errors"
 	"go.uber.org/multierr"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
@@ -18,19 +20,20 @@ import (
 	"github.com/bytebase/bytebase/backend/utils"
 
 	api "github.com/bytebase/bytebase/backend/legacyapi"
-	relayplugin "github.com/bytebase/bytebase/backend/plugin/app/relay"
+	approvalplugin "github.com/bytebase/bytebase/backend/plugin/app/approval"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // NewRunner creates a new runner instance.
-func NewRunner(store *store.Store, webhookManager *webhook.Manager, stateCfg *state.State) *Runner {
+func NewRunner(stores *store.Store, webhookManager *webhook.Manager, stateCfg *state.State) *Runner {
 	return &Runner{
-		store:                     store,
+		store:                     stores,
 		webhookManager:            webhookManager,
 		stateCfg:                  stateCfg,
-		Client:                    relayplugin.NewClient(),
 		CheckExternalApprovalChan: make(chan CheckExternalApprovalChanMessage, 100),
+		refreshPending:            make(map[int]*store.ExternalApprovalMessage),
+		refreshSignal:             make(chan struct{}, 1),
 	}
 }
 
@@ -48,13 +51,91 @@ type Runner struct {
 	webhookManager *webhook.Manager
 	stateCfg       *state.State
 
-	Client *relayplugin.Client
-
 	CheckExternalApprovalChan chan CheckExternalApprovalChanMessage
+
+	// refreshPending are the external approvals queued by RefreshExternalApprovals keyed by the id.
+	refreshMu      sync.Mutex
+	refreshPending map[int]*store.ExternalApprovalMessage
+	refreshSignal  chan struct{}
 }
 
 const relayRunnerInterval = time.Minute * 10
 
+// StaleDuration is how long the status of an external approval is fresh after it's checked successfully.
+// It allows one failed periodic check before the status is reported as stale.
+const StaleDuration = relayRunnerInterval * 2
+
+// IsStale returns true if the external approval hasn't been checked successfully within StaleDuration.
+func IsStale(payload *storepb.ExternalApprovalPayload) bool {
+	if payload.LastCheckTime == nil || payload.LastCheckError != "" {
+		return true
+	}
+	return time.Since(payload.LastCheckTime.AsTime()) > StaleDuration
+}
+
+// RefreshExternalApprovals queues the external approvals to be checked in the background, and returns the number of the newly queued ones.
+// It doesn't block, the external approvals which are already queued are skipped.
+func (r *Runner) RefreshExternalApprovals(approvals []*store.ExternalApprovalMessage) int {
+	r.refreshMu.Lock()
+	queued := 0
+	for _, approval := range approvals {
+		if _, ok := r.refreshPending[approval.ID]; ok {
+			continue
+		}
+		r.refreshPending[approval.ID] = approval
+		queued++
+	}
+	r.refreshMu.Unlock()
+	if queued > 0 {
+		select {
+		case r.refreshSignal <- struct{}{}:
+		default:
+		}
+	}
+	return queued
+}
+
+// popRefreshPending pops an external approval queued by RefreshExternalApprovals, nil if the queue is empty.
+func (r *Runner) popRefreshPending() *store.ExternalApprovalMessage {
+	r.refreshMu.Lock()
+	defer r.refreshMu.Unlock()
+	for id, approval := range r.refreshPending {
+		delete(r.refreshPending, id)
+		return approval
+	}
+	return nil
+}
+
+func (r *Runner) listenRefreshSignal(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+	for {
+		select {
+		case <-r.refreshSignal:
+			for approval := r.popRefreshPending(); approval != nil; approval = r.popRefreshPending() {
+				msg := CheckExternalApprovalChanMessage{
+					ExternalApproval: approval,
+					ErrChan:          make(chan error, 1),
+				}
+				select {
+				case r.CheckExternalApprovalChan <- msg:
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case err := <-msg.ErrChan:
+					if err != nil {
+						slog.Warn("failed to refresh external approval", slog.Int("issue", approval.IssueUID), log.BBError(err))
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // Run runs the runner.
 func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	ticker := time.NewTicker(relayRunnerInterval)
@@ -66,6 +147,8 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 	go r.listenIssueExternalApprovalRelayCancelChan(ctx, wg)
 	wg.Add(1)
 	go r.listenCheckExternalApprovalChan(ctx, wg)
+	wg.Add(1)
+	go r.listenRefreshSignal(ctx, wg)
 
 	for {
 		select {
@@ -115,40 +198,101 @@ func (r *Runner) listenCheckExternalApprovalChan(ctx context.Context, wg *sync.W
 	}
 }
 
+// checkExternalApproval checks the status of the external approval, and records the check time and error in the payload.
+// The external approval is archived once it's approved or rejected.
 func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.ExternalApprovalMessage) error {
 	payload := &storepb.ExternalApprovalPayload{}
 	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(approval.Payload), payload); err != nil {
 		return errors.Wrapf(err, "failed to unmarshal external approval payload")
 	}
+	done, checkErr := r.checkExternalApprovalStatus(ctx, approval, payload)
+
+	rowStatus := api.Normal
+	if done {
+		rowStatus = api.Archived
+	}
+	payload.LastCheckTime = timestamppb.Now()
+	payload.LastCheckError = ""
+	if checkErr != nil {
+		payload.LastCheckError = checkErr.Error()
+	}
+	bytes, err := protojson.Marshal(payload)
+	if err != nil {
+		return multierr.Append(checkErr, err)
+	}
+	p := string(bytes)
+	if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
+		ID:        approval.ID,
+		RowStatus: rowStatus,
+		Payload:   &p,
+	}); err != nil {
+		return multierr.Append(checkErr, err)
+	}
+	return checkErr
+}
+
+// checkExternalApprovalStatus returns true if the external approval is approved or rejected.
+func (r *Runner) checkExternalApprovalStatus(ctx context.Context, approval *store.ExternalApprovalMessage, payload *storepb.ExternalApprovalPayload) (bool, error) {
 	node, err := getExternalApprovalByID(ctx, r.store, payload.ExternalApprovalNodeId)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get external approval node %s", payload.ExternalApprovalNodeId)
+		return false, errors.Wrapf(err, "failed to get external approval node %s", payload.ExternalApprovalNodeId)
+	}
+	if node == nil {
+		return false, errors.Errorf("external approval node %s not found", payload.ExternalApprovalNodeId)
+	}
+	provider, err := approvalplugin.Open(node)
+	if err != nil {
+		return false, err
+	}
+	capabilities, err := provider.Capabilities(ctx)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get the capabilities of external approval node %s", node.Id)
 	}
 	id := payload.Id
-	resp, err := r.Client.GetApproval(node.Endpoint, id)
+	resp, err := provider.Get(ctx, id)
 	if err != nil {
-		return errors.Wrapf(err, "failed to get external approval status, id: %v, endpoint: %s, id: %s", node.Id, node.Endpoint, id)
+		return false, errors.Wrapf(err, "failed to get external approval status, node: %v, id: %s", node.Id, id)
 	}
-	if resp.Status == relayplugin.StatusApproved {
-		if err := r.ApproveExternalApprovalNode(ctx, approval.IssueUID); err != nil {
-			return err
+	if capabilities.CommentSync {
+		if err := r.syncComments(ctx, approval, payload, resp.Comments); err != nil {
+			return false, errors.Wrapf(err, "failed to sync external approval comments, node: %v, id: %s", node.Id, id)
 		}
-		if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
-			ID:        approval.ID,
-			RowStatus: api.Archived,
-		}); err != nil {
-			return err
+	}
+	if resp.Status == approvalplugin.StatusApproved {
+		if err := r.ApproveExternalApprovalNode(ctx, approval.IssueUID); err != nil {
+			return false, err
 		}
-	} else if resp.Status == relayplugin.StatusRejected {
+		return true, nil
+	} else if resp.Status == approvalplugin.StatusRejected && capabilities.SupportsStatus(approvalplugin.StatusRejected) {
 		if err := r.RejectExternalApprovalNode(ctx, approval.IssueUID); err != nil {
-			return err
+			return false, err
 		}
-		if _, err := r.store.UpdateExternalApprovalV2(ctx, &store.UpdateExternalApprovalMessage{
-			ID:        approval.ID,
-			RowStatus: api.Archived,
-		}); err != nil {
+		return true, nil
+	}
+	return false, nil
+}
+
+// syncComments creates the issue comments for the external approval comments which haven't been synced.
+// The synced comment ids are appended to the payload.
+func (r *Runner) syncComments(ctx context.Context, approval *store.ExternalApprovalMessage, payload *storepb.ExternalApprovalPayload, comments []*approvalplugin.Comment) error {
+	synced := make(map[string]bool)
+	for _, id := range payload.SyncedCommentIds {
+		synced[id] = true
+	}
+	for _, comment := range comments {
+		if synced[comment.ID] {
+			continue
+		}
+		if _, err := r.store.CreateIssueComment(ctx, &store.IssueCommentMessage{
+			IssueUID: approval.IssueUID,
+			Payload: &storepb.IssueCommentPayload{
+				Comment: fmt.Sprintf("%s commented in the external approval: %s", comment.Author, comment.Content),
+			},
+		}, api.SystemBotID); err != nil {
 			return err
 		}
+		payload.SyncedCommentIds = append(payload.SyncedCommentIds, comment.ID)
+		synced[comment.ID] = true
 	}
 	return nil
 }
@@ -200,9 +344,23 @@ func (r *Runner) cancelExternalApproval(ctx context.Context, issueUID int) {
 			slog.Error("external approval node not found", slog.String("id", payload.ExternalApprovalNodeId))
 			continue
 		}
+		provider, err := approvalplugin.Open(node)
+		if err != nil {
+			slog.Error("failed to open approval provider", slog.String("node", node.Id), log.BBError(err))
+			continue
+		}
 		go func() {
-			if err := r.Client.UpdateApproval(node.Endpoint, payload.Id, &relayplugin.UpdatePayload{}); err != nil {
-				slog.Error("failed to update external approval status", slog.String("endpoint", node.Endpoint), slog.String("id", payload.Id), log.BBError(err))
+			ctx := context.WithoutCancel(ctx)
+			capabilities, err := provider.Capabilities(ctx)
+			if err != nil {
+				slog.Error("failed to get approval provider capabilities", slog.String("node", node.Id), log.BBError(err))
+				return
+			}
+			if !capabilities.Cancel {
+				return
+			}
+			if err := provider.Cancel(ctx, payload.Id); err != nil {
+				slog.Error("failed to cancel external approval", slog.String("node", node.Id), slog.String("id", payload.Id), log.BBError(err))
 			}
 		}()
 	}
@@ -259,7 +417,7 @@ func (r *Runner) ApproveExternalApprovalNode(ctx context.Context, issueUID int)
 		return errors.Wrapf(err, "failed to check if the approval is approved")
 	}
 
-	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, r.store, r.Client, issue, payload.Approval)
+	newApprovers, issueComments, err := utils.HandleIncomingApprovalSteps(ctx, r.store, issue, payload.Approval)
 	if err != nil {
 		return errors.Wrapf(err, "failed to handle incoming approval steps")
 	}
@@ -0,0 +1,186 @@
+// Package schemadrift is a runner that detects the schema drift of databases.
+package schemadrift
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	detectorInterval = 30 * time.Minute
+)
+
+// NewDetector creates a schema drift detector.
+func NewDetector(stores *store.Store, licenseService enterprise.LicenseService) *Detector {
+	return &Detector{
+		store:          stores,
+		licenseService: licenseService,
+	}
+}
+
+// Detector is the schema drift detector.
+// It periodically diffs the synced schema of each database against the schema recorded by the latest migration,
+// and reports the drift as an anomaly with the DDL statements reconciling the drift.
+type Detector struct {
+	store          *store.Store
+	licenseService enterprise.LicenseService
+}
+
+// Run will run the schema drift detector.
+func (d *Detector) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(detectorInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Schema drift detector started and will run every %v", detectorInterval))
+
+	// Detect once on startup so that the drift doesn't wait for the first tick to be reported.
+	d.detectAll(ctx)
+	for {
+		select {
+		case <-ticker.C:
+			d.detectAll(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (d *Detector) detectAll(ctx context.Context) {
+	instances, err := d.store.ListInstancesV2(ctx, &store.FindInstanceMessage{})
+	if err != nil {
+		slog.Error("Failed to list instances", log.BBError(err))
+		return
+	}
+	for _, instance := range instances {
+		if d.licenseService.IsFeatureEnabledForInstance(api.FeatureSchemaDrift, instance) != nil {
+			continue
+		}
+		// Redis is schemaless.
+		if disableSchemaDriftAnomalyCheck(instance.Engine) {
+			continue
+		}
+		databases, err := d.store.ListDatabases(ctx, &store.FindDatabaseMessage{
+			InstanceID: &instance.ResourceID,
+		})
+		if err != nil {
+			slog.Error("Failed to list databases",
+				slog.String("instance", instance.ResourceID),
+				log.BBError(err))
+			continue
+		}
+		for _, database := range databases {
+			if database.SyncState != api.OK {
+				continue
+			}
+			if err := d.DetectDatabaseSchemaDrift(ctx, instance, database); err != nil {
+				slog.Error("Failed to detect schema drift",
+					slog.String("instance", instance.ResourceID),
+					slog.String("database", database.DatabaseName),
+					log.BBError(err))
+			}
+		}
+	}
+}
+
+// DetectDatabaseSchemaDrift compares the synced schema of the database with the schema recorded by the latest migration.
+// It upserts the schema drift anomaly if they differ, and archives the anomaly otherwise.
+func (d *Detector) DetectDatabaseSchemaDrift(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage) error {
+	dbSchema, err := d.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get schema for database %q", database.DatabaseName)
+	}
+	if dbSchema == nil {
+		return nil
+	}
+	limit := 1
+	list, err := d.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
+		InstanceID: &instance.UID,
+		DatabaseID: &database.UID,
+		ShowFull:   true,
+		Limit:      &limit,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list change history for database %q", database.DatabaseName)
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	// MongoDB migrations recorded before the schema dump was supported have no schema.
+	if instance.Engine == storepb.Engine_MONGODB && list[0].Schema == "" {
+		return nil
+	}
+
+	anomalyPayload := newSchemaDriftPayload(instance.Engine, list[0].Version.Version, list[0].Schema, string(dbSchema.GetSchema()))
+	if anomalyPayload == nil {
+		err := d.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+			DatabaseUID: &database.UID,
+			Type:        api.AnomalyDatabaseSchemaDrift,
+		})
+		if err != nil && common.ErrorCode(err) != common.NotFound {
+			return errors.Wrapf(err, "failed to archive schema drift anomaly")
+		}
+		return nil
+	}
+	payload, err := protojson.Marshal(anomalyPayload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal schema drift anomaly payload")
+	}
+	if _, err = d.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		InstanceID:  instance.ResourceID,
+		DatabaseUID: &database.UID,
+		Type:        api.AnomalyDatabaseSchemaDrift,
+		Payload:     string(payload),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to upsert schema drift anomaly")
+	}
+	return nil
+}
+
+// newSchemaDriftPayload returns the schema drift anomaly payload, or nil if the actual schema matches the expected schema.
+func newSchemaDriftPayload(engine storepb.Engine, version, expect, actual string) *storepb.AnomalyDatabaseSchemaDriftPayload {
+	if expect == actual {
+		return nil
+	}
+	// The diff migrates the actual schema back to the expected schema.
+	// Leave it empty if the engine doesn't support schema diff, the drift is still reported.
+	diff, err := base.SchemaDiff(engine, base.DiffContext{
+		IgnoreCaseSensitive: false,
+		StrictMode:          true,
+	}, actual, expect)
+	if err != nil {
+		slog.Debug("Failed to compute schema drift diff", slog.String("engine", engine.String()), log.BBError(err))
+		diff = ""
+	}
+	return &storepb.AnomalyDatabaseSchemaDriftPayload{
+		Version: version,
+		Expect:  expect,
+		Actual:  actual,
+		Diff:    diff,
+	}
+}
+
+func disableSchemaDriftAnomalyCheck(dbTp storepb.Engine) bool {
+	m := map[storepb.Engine]struct{}{
+		storepb.Engine_REDIS:            {},
+		storepb.Engine_ORACLE:           {},
+		storepb.Engine_OCEANBASE_ORACLE: {},
+		storepb.Engine_MSSQL:            {},
+		storepb.Engine_REDSHIFT:         {},
+	}
+	_, ok := m[dbTp]
+	return ok
+}
@@ -0,0 +1,33 @@
+package schemadrift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestNewSchemaDriftPayload(t *testing.T) {
+	a := require.New(t)
+
+	schema := "CREATE TABLE t (id INT);\n"
+	a.Nil(newSchemaDriftPayload(storepb.Engine_MYSQL, "0001", schema, schema))
+
+	// Redis has no schema differ, the drift is reported without the diff.
+	payload := newSchemaDriftPayload(storepb.Engine_REDIS, "0001", schema, "CREATE TABLE t (id INT, name TEXT);\n")
+	a.NotNil(payload)
+	a.Equal("0001", payload.Version)
+	a.Equal(schema, payload.Expect)
+	a.Equal("CREATE TABLE t (id INT, name TEXT);\n", payload.Actual)
+	a.Equal("", payload.Diff)
+}
+
+func TestDisableSchemaDriftAnomalyCheck(t *testing.T) {
+	a := require.New(t)
+
+	a.True(disableSchemaDriftAnomalyCheck(storepb.Engine_REDIS))
+	a.True(disableSchemaDriftAnomalyCheck(storepb.Engine_MSSQL))
+	a.False(disableSchemaDriftAnomalyCheck(storepb.Engine_MYSQL))
+	a.False(disableSchemaDriftAnomalyCheck(storepb.Engine_POSTGRES))
+}
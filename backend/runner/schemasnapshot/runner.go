@@ -0,0 +1,119 @@
+// Package schemasnapshot is a runner that takes periodic full schema snapshots of databases.
+package schemasnapshot
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	snapshotInterval = 1 * time.Hour
+	// defaultRetention is the retention of the schema snapshots if the workspace doesn't configure one.
+	defaultRetention = 90 * 24 * time.Hour
+)
+
+// NewRunner creates a schema snapshot runner.
+func NewRunner(stores *store.Store) *Runner {
+	return &Runner{
+		store: stores,
+	}
+}
+
+// Runner is the schema snapshot runner.
+// It snapshots the synced schema of each database when the schema changes since the last snapshot,
+// and deletes the snapshots out of the retention.
+type Runner struct {
+	store *store.Store
+}
+
+// Run will run the schema snapshot runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(snapshotInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Schema snapshot runner started and will run every %v", snapshotInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.snapshotAll(ctx)
+			r.purgeExpired(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) snapshotAll(ctx context.Context) {
+	databases, err := r.store.ListDatabases(ctx, &store.FindDatabaseMessage{})
+	if err != nil {
+		slog.Error("Failed to list databases", log.BBError(err))
+		return
+	}
+	now := time.Now().Unix()
+	for _, database := range databases {
+		if database.SyncState != api.OK {
+			continue
+		}
+		dbSchema, err := r.store.GetDBSchema(ctx, database.UID)
+		if err != nil {
+			slog.Error("Failed to get database schema",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+			continue
+		}
+		if dbSchema == nil {
+			continue
+		}
+		latest, err := r.store.GetDBSchemaSnapshotAsOf(ctx, database.UID, now)
+		if err != nil {
+			slog.Error("Failed to get latest schema snapshot",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+			continue
+		}
+		// Only snapshot the changed schema, the latest snapshot before a timestamp is the schema at the timestamp.
+		if latest != nil && latest.Schema == string(dbSchema.GetSchema()) {
+			continue
+		}
+		if _, err := r.store.CreateDBSchemaSnapshot(ctx, &store.DBSchemaSnapshotMessage{
+			DatabaseUID: database.UID,
+			Metadata:    dbSchema.GetMetadata(),
+			Schema:      string(dbSchema.GetSchema()),
+		}); err != nil {
+			slog.Error("Failed to create schema snapshot",
+				slog.String("instance", database.InstanceID),
+				slog.String("database", database.DatabaseName),
+				log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) purgeExpired(ctx context.Context) {
+	retention := defaultRetention
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace profile setting", log.BBError(err))
+		return
+	}
+	if setting.GetSchemaSnapshotRetention().GetSeconds() > 0 {
+		retention = setting.GetSchemaSnapshotRetention().AsDuration()
+	}
+	deleted, err := r.store.DeleteDBSchemaSnapshotsBefore(ctx, time.Now().Add(-retention).Unix())
+	if err != nil {
+		slog.Error("Failed to delete expired schema snapshots", log.BBError(err))
+		return
+	}
+	if deleted > 0 {
+		slog.Debug(fmt.Sprintf("Deleted %d expired schema snapshots", deleted))
+	}
+}
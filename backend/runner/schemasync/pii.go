@@ -0,0 +1,186 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/pii"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	defaultPIISampleSize = 100
+	maxPIISampleSize     = 1000
+	piiDetectionTimeout  = 5 * time.Minute
+)
+
+// detectPII suggests the sensitive data classifications of the database if the PII detection policy
+// of the environment is active. The columns already in the masking policy are skipped.
+func (s *Syncer) detectPII(ctx context.Context, driver db.Driver, instance *store.InstanceMessage, database *store.DatabaseMessage, metadata *storepb.DatabaseSchemaMetadata) error {
+	policy, err := s.getPIIDetectionPolicy(ctx, database)
+	if err != nil {
+		return err
+	}
+	if !policy.GetActive() {
+		return nil
+	}
+	maskingPolicy, err := s.store.GetMaskingPolicyByDatabaseUID(ctx, database.UID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get masking policy")
+	}
+	masked := map[string]bool{}
+	for _, maskData := range maskingPolicy.MaskData {
+		masked[fmt.Sprintf("%s.%s.%s", maskData.Schema, maskData.Table, maskData.Column)] = true
+	}
+
+	sampleSize := int(policy.SampleSize)
+	if sampleSize <= 0 {
+		sampleSize = defaultPIISampleSize
+	}
+	sampleSize = min(sampleSize, maxPIISampleSize)
+	sampleData := policy.SampleData && driver.GetDB() != nil && supportPIISampling(instance.Engine)
+
+	ctx, cancel := context.WithTimeout(ctx, piiDetectionTimeout)
+	defer cancel()
+	var suggestions []*store.ClassificationSuggestionMessage
+	for _, schema := range metadata.Schemas {
+		for _, table := range schema.Tables {
+			var columns []string
+			for _, column := range table.Columns {
+				if !masked[fmt.Sprintf("%s.%s.%s", schema.Name, table.Name, column.Name)] {
+					columns = append(columns, column.Name)
+				}
+			}
+			if len(columns) == 0 {
+				continue
+			}
+			var values map[string][]string
+			if sampleData {
+				values, err = sampleTableValues(ctx, driver.GetDB(), instance.Engine, schema.Name, table, columns, sampleSize)
+				if err != nil {
+					return errors.Wrapf(err, "failed to sample table %q", table.Name)
+				}
+			}
+			for _, column := range columns {
+				payload := pii.Detect(column, values[column])
+				if payload == nil {
+					continue
+				}
+				suggestions = append(suggestions, &store.ClassificationSuggestionMessage{
+					DatabaseUID: database.UID,
+					Schema:      schema.Name,
+					Table:       table.Name,
+					Column:      column,
+					Payload:     payload,
+				})
+			}
+		}
+	}
+	return s.store.ReplaceClassificationSuggestions(ctx, database.UID, suggestions)
+}
+
+func (s *Syncer) getPIIDetectionPolicy(ctx context.Context, database *store.DatabaseMessage) (*storepb.PIIDetectionPolicy, error) {
+	environment, err := s.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get environment %q", database.EffectiveEnvironmentID)
+	}
+	if environment == nil {
+		return nil, nil
+	}
+	resourceType := api.PolicyResourceTypeEnvironment
+	policyType := api.PolicyTypePIIDetection
+	policy, err := s.store.GetPolicyV2(ctx, &store.FindPolicyMessage{
+		ResourceType: &resourceType,
+		ResourceUID:  &environment.UID,
+		Type:         &policyType,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get PII detection policy")
+	}
+	if policy == nil {
+		return nil, nil
+	}
+	payload := &storepb.PIIDetectionPolicy{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(policy.Payload), payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal PII detection policy")
+	}
+	return payload, nil
+}
+
+func supportPIISampling(engine storepb.Engine) bool {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_POSTGRES:
+		return true
+	default:
+		return false
+	}
+}
+
+// sampleTableValues samples the values of the text columns, the other columns are detected by the names only.
+func sampleTableValues(ctx context.Context, sqlDB *sql.DB, engine storepb.Engine, schema string, table *storepb.TableMetadata, columns []string, sampleSize int) (map[string][]string, error) {
+	var textColumns []string
+	for _, column := range table.Columns {
+		if !slices.Contains(columns, column.Name) {
+			continue
+		}
+		t := strings.ToLower(column.Type)
+		if strings.Contains(t, "char") || strings.Contains(t, "text") {
+			textColumns = append(textColumns, column.Name)
+		}
+	}
+	if len(textColumns) == 0 {
+		return nil, nil
+	}
+
+	var quotedColumns []string
+	for _, column := range textColumns {
+		quotedColumns = append(quotedColumns, quoteIdentifier(engine, column))
+	}
+	tableName := quoteIdentifier(engine, table.Name)
+	if schema != "" {
+		tableName = fmt.Sprintf("%s.%s", quoteIdentifier(engine, schema), tableName)
+	}
+	rows, err := sqlDB.QueryContext(ctx, fmt.Sprintf("SELECT %s FROM %s LIMIT %d", strings.Join(quotedColumns, ", "), tableName, sampleSize))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	values := map[string][]string{}
+	for rows.Next() {
+		row := make([]sql.NullString, len(textColumns))
+		dest := make([]any, len(textColumns))
+		for i := range row {
+			dest[i] = &row[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		for i, column := range textColumns {
+			if row[i].Valid {
+				values[column] = append(values[column], row[i].String)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func quoteIdentifier(engine storepb.Engine, identifier string) string {
+	if engine == storepb.Engine_POSTGRES {
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	}
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+}
@@ -20,7 +20,6 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
-	enterprise "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
@@ -35,16 +34,17 @@ const (
 	// defaultSyncInterval means never sync.
 	defaultSyncInterval = 0 * time.Second
 	MaximumOutstanding  = 100
+	// failoverAnomalyRetention is how long the failover anomaly stays open after the last failover.
+	failoverAnomalyRetention = 24 * time.Hour
 )
 
 // NewSyncer creates a schema syncer.
-func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile, licenseService enterprise.LicenseService) *Syncer {
+func NewSyncer(stores *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State, profile *config.Profile) *Syncer {
 	return &Syncer{
-		store:          stores,
-		dbFactory:      dbFactory,
-		stateCfg:       stateCfg,
-		profile:        profile,
-		licenseService: licenseService,
+		store:     stores,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+		profile:   profile,
 	}
 }
 
@@ -56,7 +56,6 @@ type Syncer struct {
 	dbFactory       *dbfactory.DBFactory
 	stateCfg        *state.State
 	profile         *config.Profile
-	licenseService  enterprise.LicenseService
 	databaseSyncMap sync.Map // map[int]*store.DatabaseMessage
 }
 
@@ -252,6 +251,7 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 	}
 	defer driver.Close(ctx)
 	s.upsertInstanceConnectionAnomaly(ctx, instance, nil)
+	s.upsertInstanceConnectionBudgetAnomaly(ctx, instance)
 
 	deadlineCtx, cancelFunc := context.WithDeadline(ctx, time.Now().Add(syncTimeout))
 	defer cancelFunc()
@@ -264,6 +264,7 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 		instanceMeta.Metadata = &storepb.InstanceMetadata{}
 	}
 	instanceMeta.Metadata.LastSyncTime = timestamppb.Now()
+	s.upsertInstanceFailoverAnomaly(ctx, instance, instanceMeta.Metadata)
 	updateInstance := &store.UpdateInstanceMessage{
 		ResourceID: instance.ResourceID,
 		Metadata:   instanceMeta.Metadata,
@@ -286,6 +287,16 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 		for _, database := range databases {
 			if database.DatabaseName == databaseMetadata.Name {
 				exist = true
+				// The Oracle database switches between the schema and the pluggable database with the PDB mode of the instance.
+				if database.ServiceName != databaseMetadata.ServiceName {
+					if _, err := s.store.UpdateDatabase(ctx, &store.UpdateDatabaseMessage{
+						InstanceID:   instance.ResourceID,
+						DatabaseName: database.DatabaseName,
+						ServiceName:  &databaseMetadata.ServiceName,
+					}, api.SystemBotID); err != nil {
+						return updatedInstance, errors.Wrapf(err, "failed to update service name of database %q for instance %q", database.DatabaseName, instance.ResourceID)
+					}
+				}
 				break
 			}
 		}
@@ -431,70 +442,11 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		return errors.Wrapf(err, "failed to upsert database schema for database %q", database.DatabaseName)
 	}
 
-	// Check schema drift
-	if s.licenseService.IsFeatureEnabledForInstance(api.FeatureSchemaDrift, instance) == nil {
-		// Redis and MongoDB are schemaless.
-		if disableSchemaDriftAnomalyCheck(instance.Engine) {
-			return nil
-		}
-		limit := 1
-		list, err := s.store.ListInstanceChangeHistory(ctx, &store.FindInstanceChangeHistoryMessage{
-			InstanceID: &instance.UID,
-			DatabaseID: &database.UID,
-			ShowFull:   true,
-			Limit:      &limit,
-		})
-		if err != nil {
-			slog.Error("Failed to check anomaly",
-				slog.String("instance", instance.ResourceID),
-				slog.String("database", database.DatabaseName),
-				slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
-				log.BBError(err))
-			return nil
-		}
-		latestSchema := string(rawDump)
-		if len(list) > 0 {
-			if list[0].Schema != latestSchema {
-				anomalyPayload := &storepb.AnomalyDatabaseSchemaDriftPayload{
-					Version: list[0].Version.Version,
-					Expect:  list[0].Schema,
-					Actual:  latestSchema,
-				}
-				payload, err := protojson.Marshal(anomalyPayload)
-				if err != nil {
-					slog.Error("Failed to marshal anomaly payload",
-						slog.String("instance", instance.ResourceID),
-						slog.String("database", database.DatabaseName),
-						slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
-						log.BBError(err))
-				} else {
-					if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
-						InstanceID:  instance.ResourceID,
-						DatabaseUID: &database.UID,
-						Type:        api.AnomalyDatabaseSchemaDrift,
-						Payload:     string(payload),
-					}); err != nil {
-						slog.Error("Failed to create anomaly",
-							slog.String("instance", instance.ResourceID),
-							slog.String("database", database.DatabaseName),
-							slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
-							log.BBError(err))
-					}
-				}
-			} else {
-				err := s.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
-					DatabaseUID: &database.UID,
-					Type:        api.AnomalyDatabaseSchemaDrift,
-				})
-				if err != nil && common.ErrorCode(err) != common.NotFound {
-					slog.Error("Failed to close anomaly",
-						slog.String("instance", instance.ResourceID),
-						slog.String("database", database.DatabaseName),
-						slog.String("type", string(api.AnomalyDatabaseSchemaDrift)),
-						log.BBError(err))
-				}
-			}
-		}
+	if err := s.detectPII(ctx, driver, instance, database, databaseMetadata); err != nil {
+		slog.Warn("failed to detect PII",
+			slog.String("instance", database.InstanceID),
+			slog.String("database", database.DatabaseName),
+			log.BBError(err))
 	}
 	return nil
 }
@@ -537,6 +489,123 @@ func (s *Syncer) upsertInstanceConnectionAnomaly(ctx context.Context, instance *
 	}
 }
 
+// upsertInstanceConnectionBudgetAnomaly raises an anomaly if any connection request was rejected by the instance connection budget since the last sync.
+// Otherwise, it closes the anomaly.
+func (s *Syncer) upsertInstanceConnectionBudgetAnomaly(ctx context.Context, instance *store.InstanceMessage) {
+	rejected := s.stateCfg.InstanceOutstandingConnections.PopRejected(instance.UID)
+	if rejected > 0 {
+		maximumConnections := state.GetMaximumConnections(int(instance.Options.GetMaximumConnections()))
+		slog.Warn("Instance connection budget exceeded",
+			slog.String("instance", instance.ResourceID),
+			slog.Int("maximumConnections", maximumConnections),
+			slog.Int("outstanding", s.stateCfg.InstanceOutstandingConnections.Count(instance.UID)),
+			slog.Int("rejected", rejected))
+		anomalyPayload := &storepb.AnomalyConnectionBudgetPayload{
+			MaximumConnections: int32(maximumConnections),
+			RejectedCount:      int32(rejected),
+		}
+		payload, err := protojson.Marshal(anomalyPayload)
+		if err != nil {
+			slog.Error("Failed to marshal anomaly payload",
+				slog.String("instance", instance.ResourceID),
+				slog.String("type", string(api.AnomalyInstanceConnectionBudget)),
+				log.BBError(err))
+			return
+		}
+		if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+			InstanceID: instance.ResourceID,
+			Type:       api.AnomalyInstanceConnectionBudget,
+			Payload:    string(payload),
+		}); err != nil {
+			slog.Error("Failed to create anomaly",
+				slog.String("instance", instance.ResourceID),
+				slog.String("type", string(api.AnomalyInstanceConnectionBudget)),
+				log.BBError(err))
+		}
+		return
+	}
+
+	err := s.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+		InstanceID: &instance.ResourceID,
+		Type:       api.AnomalyInstanceConnectionBudget,
+	})
+	if err != nil && common.ErrorCode(err) != common.NotFound {
+		slog.Error("Failed to close anomaly",
+			slog.String("instance", instance.ResourceID),
+			slog.String("type", string(api.AnomalyInstanceConnectionBudget)),
+			log.BBError(err))
+	}
+}
+
+// upsertInstanceFailoverAnomaly raises an anomaly if the primary replica of any availability group changed since the last sync.
+// Otherwise, it closes the anomaly a day after the last failover.
+func (s *Syncer) upsertInstanceFailoverAnomaly(ctx context.Context, instance *store.InstanceMessage, metadata *storepb.InstanceMetadata) {
+	for _, group := range metadata.GetAvailabilityGroups() {
+		for _, previousGroup := range instance.Metadata.GetAvailabilityGroups() {
+			if previousGroup.Name != group.Name || previousGroup.PrimaryReplica == "" || group.PrimaryReplica == "" || previousGroup.PrimaryReplica == group.PrimaryReplica {
+				continue
+			}
+			slog.Warn("Availability group failover",
+				slog.String("instance", instance.ResourceID),
+				slog.String("availabilityGroup", group.Name),
+				slog.String("previousPrimary", previousGroup.PrimaryReplica),
+				slog.String("currentPrimary", group.PrimaryReplica))
+			anomalyPayload := &storepb.AnomalyFailoverPayload{
+				AvailabilityGroup: group.Name,
+				PreviousPrimary:   previousGroup.PrimaryReplica,
+				CurrentPrimary:    group.PrimaryReplica,
+			}
+			payload, err := protojson.Marshal(anomalyPayload)
+			if err != nil {
+				slog.Error("Failed to marshal anomaly payload",
+					slog.String("instance", instance.ResourceID),
+					slog.String("type", string(api.AnomalyInstanceFailover)),
+					log.BBError(err))
+				return
+			}
+			if _, err = s.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+				InstanceID: instance.ResourceID,
+				Type:       api.AnomalyInstanceFailover,
+				Payload:    string(payload),
+			}); err != nil {
+				slog.Error("Failed to create anomaly",
+					slog.String("instance", instance.ResourceID),
+					slog.String("type", string(api.AnomalyInstanceFailover)),
+					log.BBError(err))
+			}
+			return
+		}
+	}
+
+	rowStatus := api.Normal
+	anomalies, err := s.store.ListAnomalyV2(ctx, &store.ListAnomalyMessage{
+		RowStatus:  &rowStatus,
+		InstanceID: &instance.ResourceID,
+		Types:      []api.AnomalyType{api.AnomalyInstanceFailover},
+	})
+	if err != nil {
+		slog.Error("Failed to list anomalies",
+			slog.String("instance", instance.ResourceID),
+			slog.String("type", string(api.AnomalyInstanceFailover)),
+			log.BBError(err))
+		return
+	}
+	for _, anomaly := range anomalies {
+		if time.Since(time.Unix(anomaly.UpdatedTs, 0)) < failoverAnomalyRetention {
+			continue
+		}
+		if err := s.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+			InstanceID: &instance.ResourceID,
+			Type:       api.AnomalyInstanceFailover,
+		}); err != nil && common.ErrorCode(err) != common.NotFound {
+			slog.Error("Failed to close anomaly",
+				slog.String("instance", instance.ResourceID),
+				slog.String("type", string(api.AnomalyInstanceFailover)),
+				log.BBError(err))
+		}
+	}
+}
+
 func (s *Syncer) upsertDatabaseConnectionAnomaly(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, connErr error) {
 	if connErr != nil {
 		anomalyPayload := &storepb.AnomalyConnectionPayload{
@@ -646,16 +715,3 @@ func getOrDefaultLastSyncTime(t *timestamppb.Timestamp) time.Time {
 	}
 	return time.Unix(0, 0)
 }
-
-func disableSchemaDriftAnomalyCheck(dbTp storepb.Engine) bool {
-	m := map[storepb.Engine]struct{}{
-		storepb.Engine_MONGODB:          {},
-		storepb.Engine_REDIS:            {},
-		storepb.Engine_ORACLE:           {},
-		storepb.Engine_OCEANBASE_ORACLE: {},
-		storepb.Engine_MSSQL:            {},
-		storepb.Engine_REDSHIFT:         {},
-	}
-	_, ok := m[dbTp]
-	return ok
-}
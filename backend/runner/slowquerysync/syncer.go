@@ -255,6 +255,7 @@ func pgMergeSlowQueryLog(statistics *storepb.SlowQueryStatistics, logs []*v1pb.S
 				TotalQueryTime:   durationpb.New(log.Statistics.AverageQueryTime.AsDuration() * time.Duration(log.Statistics.Count)),
 				MaximumQueryTime: log.Statistics.MaximumQueryTime,
 				TotalRowsSent:    log.Statistics.AverageRowsSent * log.Statistics.Count,
+				Samples:          convertToStoreSlowQueryDetails(log.Statistics.Samples),
 			}
 		} else {
 			value.Count += log.Statistics.Count
@@ -264,6 +265,11 @@ func pgMergeSlowQueryLog(statistics *storepb.SlowQueryStatistics, logs []*v1pb.S
 				value.MaximumQueryTime = log.Statistics.MaximumQueryTime
 			}
 			value.TotalRowsSent += log.Statistics.AverageRowsSent * log.Statistics.Count
+			// Keep the latest samples, the auto_explain samples in the server log may have been synced.
+			value.Samples = dedupSlowQuerySamples(append(convertToStoreSlowQueryDetails(log.Statistics.Samples), value.Samples...))
+			if len(value.Samples) > db.SlowQueryMaxSamplePerFingerprint {
+				value.Samples = value.Samples[len(value.Samples)-db.SlowQueryMaxSamplePerFingerprint:]
+			}
 		}
 	}
 
@@ -274,6 +280,35 @@ func pgMergeSlowQueryLog(statistics *storepb.SlowQueryStatistics, logs []*v1pb.S
 	return &storepb.SlowQueryStatistics{Items: result}
 }
 
+func convertToStoreSlowQueryDetails(samples []*v1pb.SlowQueryDetails) []*storepb.SlowQueryDetails {
+	var result []*storepb.SlowQueryDetails
+	for _, sample := range samples {
+		result = append(result, &storepb.SlowQueryDetails{
+			StartTime:    sample.StartTime,
+			QueryTime:    sample.QueryTime,
+			LockTime:     sample.LockTime,
+			RowsSent:     sample.RowsSent,
+			RowsExamined: sample.RowsExamined,
+			SqlText:      sample.SqlText,
+		})
+	}
+	return result
+}
+
+func dedupSlowQuerySamples(samples []*storepb.SlowQueryDetails) []*storepb.SlowQueryDetails {
+	seen := make(map[string]bool)
+	var result []*storepb.SlowQueryDetails
+	for _, sample := range samples {
+		key := fmt.Sprintf("%d/%s", sample.GetStartTime().AsTime().UnixNano(), sample.SqlText)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, sample)
+	}
+	return result
+}
+
 func getLatestLogTime(logMap map[string]*storepb.SlowQueryStatistics) time.Time {
 	for _, log := range logMap {
 		for _, item := range log.Items {
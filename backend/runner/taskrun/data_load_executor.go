@@ -0,0 +1,279 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	"github.com/bytebase/bytebase/backend/component/state"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// importJobPollInterval is the interval to poll the status of the TiDB import job.
+const importJobPollInterval = 5 * time.Second
+
+// NewDataLoadExecutor creates a data load task executor.
+func NewDataLoadExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) Executor {
+	return &DataLoadExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// DataLoadExecutor is the data load task executor.
+// It loads the data files from the object storage into the table with the fast path of the engine,
+// IMPORT INTO for TiDB, and LOAD DATA LOCAL INFILE for MySQL.
+type DataLoadExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+// RunOnce will run the data load task executor once.
+func (exec *DataLoadExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (terminated bool, result *storepb.TaskRunResult, err error) {
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_PRE_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	payload := &storepb.TaskDatabaseDataLoadPayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database data load payload")
+	}
+
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get instance")
+	}
+	if instance == nil {
+		return true, nil, errors.Errorf("instance not found")
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(driverCtx, instance, database, db.ConnectionContext{})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver")
+	}
+	defer driver.Close(driverCtx)
+
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+	startTime := time.Now()
+	var rows int64
+	switch instance.Engine {
+	case storepb.Engine_TIDB:
+		rows, err = exec.importInto(ctx, driverCtx, driver.GetDB(), payload, taskRunUID)
+	case storepb.Engine_MYSQL:
+		rows, err = exec.loadDataLocalInfile(driverCtx, driver.GetDB(), payload, taskRunUID)
+	default:
+		err = errors.Errorf("data load is not supported for engine %v", instance.Engine)
+	}
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to load data into table %q", payload.Table)
+	}
+
+	return true, &storepb.TaskRunResult{
+		Detail: fmt.Sprintf("Loaded %d rows into table %q within %v", rows, payload.Table, time.Since(startTime).String()),
+	}, nil
+}
+
+// importInto loads the data with the IMPORT INTO statement of TiDB, the TiDB nodes read the files from the object storage.
+// The statement is run as a detached job and polled, so that the progress is reported and the job is cancelled if the task run is canceled.
+// Docs: https://docs.pingcap.com/tidb/stable/sql-statement-import-into.
+func (exec *DataLoadExecutor) importInto(ctx context.Context, driverCtx context.Context, sqlDB *sql.DB, payload *storepb.TaskDatabaseDataLoadPayload, taskRunUID int) (int64, error) {
+	statement := buildImportIntoStatement(payload)
+	job, err := queryImportJob(driverCtx, sqlDB, statement)
+	if err != nil {
+		return 0, err
+	}
+	jobID := job["job_id"]
+
+	ticker := time.NewTicker(importJobPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-driverCtx.Done():
+			// The job keeps running on the TiDB nodes after the connection is closed, so it must be cancelled explicitly.
+			if _, err := sqlDB.ExecContext(ctx, fmt.Sprintf("CANCEL IMPORT JOB %s", jobID)); err != nil {
+				slog.Error("failed to cancel the import job", slog.String("jobID", jobID), log.BBError(err))
+			}
+			return 0, driverCtx.Err()
+		case <-ticker.C:
+		}
+
+		job, err = queryImportJob(driverCtx, sqlDB, fmt.Sprintf("SHOW IMPORT JOB %s", jobID))
+		if err != nil {
+			return 0, err
+		}
+		rows, _ := strconv.ParseInt(job["imported_rows"], 10, 64)
+		switch strings.ToLower(job["status"]) {
+		case "finished":
+			return rows, nil
+		case "failed", "cancelled":
+			return rows, errors.Errorf("import job %s %s: %s", jobID, strings.ToLower(job["status"]), job["result_message"])
+		}
+		exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+			state.TaskRunExecutionStatus{
+				ExecutionStatus: v1pb.TaskRun_EXECUTING,
+				ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+					RowsCompleted: rows,
+				},
+				UpdateTime: time.Now(),
+			})
+	}
+}
+
+func buildImportIntoStatement(payload *storepb.TaskDatabaseDataLoadPayload) string {
+	format := "csv"
+	if payload.Format == storepb.DataLoadFormat_DATA_LOAD_FORMAT_PARQUET {
+		format = "parquet"
+	}
+	options := []string{"DETACHED"}
+	if format == "csv" {
+		if payload.Header {
+			options = append(options, "skip_rows=1")
+		}
+		if payload.FieldDelimiter != "" {
+			options = append(options, fmt.Sprintf("fields_terminated_by=%s", quoteString(payload.FieldDelimiter)))
+		}
+	}
+	return fmt.Sprintf("IMPORT INTO %s FROM %s FORMAT '%s' WITH %s", quoteTable(payload.Table), quoteString(payload.SourceUri), format, strings.Join(options, ", "))
+}
+
+// queryImportJob runs the statement returning the import job, and returns the columns of the job keyed by the lower case column name.
+func queryImportJob(ctx context.Context, sqlDB *sql.DB, statement string) (map[string]string, error) {
+	rows, err := sqlDB.QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		return nil, errors.Errorf("no import job returned")
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	job := make(map[string]string)
+	for i, column := range columns {
+		job[strings.ToLower(column)] = values[i].String
+	}
+	if job["job_id"] == "" {
+		return nil, errors.Errorf("no job id returned")
+	}
+	return job, rows.Err()
+}
+
+// loadDataLocalInfile streams the object from the S3 through Bytebase to MySQL with the LOAD DATA LOCAL INFILE statement.
+// The object is downloaded with the default credentials of the Bytebase server, and the server must enable local_infile.
+func (exec *DataLoadExecutor) loadDataLocalInfile(ctx context.Context, sqlDB *sql.DB, payload *storepb.TaskDatabaseDataLoadPayload, taskRunUID int) (int64, error) {
+	u, err := url.Parse(payload.SourceUri)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid source uri")
+	}
+	if u.Scheme != "s3" {
+		return 0, errors.Errorf("only s3 source uri is supported, got scheme %q", u.Scheme)
+	}
+	storage := &storepb.BackupStorage{
+		Type:   storepb.BackupStorage_S3,
+		Bucket: u.Host,
+	}
+	body, size, err := objectstorage.Download(ctx, storage, strings.TrimPrefix(u.Path, "/"), "" /* secret */)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to download %s", objectstorage.GetObjectURI(storage, strings.TrimPrefix(u.Path, "/")))
+	}
+	defer body.Close()
+
+	reader := &progressReader{
+		reader: body,
+		progress: func(completed int64) {
+			exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+				state.TaskRunExecutionStatus{
+					ExecutionStatus: v1pb.TaskRun_EXECUTING,
+					ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+						BytesTotal:     size,
+						BytesCompleted: completed,
+					},
+					UpdateTime: time.Now(),
+				})
+		},
+	}
+	readerName := fmt.Sprintf("bytebase-data-load-%d", taskRunUID)
+	mysql.RegisterReaderHandler(readerName, func() io.Reader { return reader })
+	defer mysql.DeregisterReaderHandler(readerName)
+
+	fieldDelimiter := payload.FieldDelimiter
+	if fieldDelimiter == "" {
+		fieldDelimiter = ","
+	}
+	statement := fmt.Sprintf("LOAD DATA LOCAL INFILE 'Reader::%s' INTO TABLE %s CHARACTER SET utf8mb4 FIELDS TERMINATED BY %s OPTIONALLY ENCLOSED BY '\"' LINES TERMINATED BY '\\n'", readerName, quoteTable(payload.Table), quoteString(fieldDelimiter))
+	if payload.Header {
+		statement += " IGNORE 1 LINES"
+	}
+	sqlResult, err := sqlDB.ExecContext(ctx, statement)
+	if err != nil {
+		return 0, err
+	}
+	return sqlResult.RowsAffected()
+}
+
+// progressReader reports the number of bytes read.
+type progressReader struct {
+	reader    io.Reader
+	completed atomic.Int64
+	progress  func(completed int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.progress(r.completed.Add(int64(n)))
+	return n, err
+}
+
+func quoteTable(table string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(table, "`", "``"))
+}
+
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -67,7 +68,26 @@ func (exec *DataUpdateExecutor) RunOnce(ctx context.Context, driverCtx context.C
 	if err != nil {
 		return true, nil, err
 	}
+	if payload.PreUpdateBackupDetail.GetDatabase() != "" {
+		exec.store.CreateTaskRunLogS(ctx, taskRunUID, time.Now(), exec.profile.DeployID, &storepb.TaskRunLog{
+			Type:             storepb.TaskRunLog_PRIOR_BACKUP_START,
+			PriorBackupStart: &storepb.TaskRunLog_PriorBackupStart{},
+		})
+	}
 	priorBackupDetail, err := exec.backupData(ctx, driverCtx, statement, payload, task)
+	if payload.PreUpdateBackupDetail.GetDatabase() != "" {
+		// The backup tables are logged so that they can be found even if the change fails.
+		priorBackupEnd := &storepb.TaskRunLog_PriorBackupEnd{
+			PriorBackupDetail: priorBackupDetail,
+		}
+		if err != nil {
+			priorBackupEnd.Error = err.Error()
+		}
+		exec.store.CreateTaskRunLogS(ctx, taskRunUID, time.Now(), exec.profile.DeployID, &storepb.TaskRunLog{
+			Type:           storepb.TaskRunLog_PRIOR_BACKUP_END,
+			PriorBackupEnd: priorBackupEnd,
+		})
+	}
 	if err != nil {
 		return true, nil, err
 	}
@@ -157,6 +177,12 @@ func (exec *DataUpdateExecutor) backupData(
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to transform DML to select")
 	}
+	if payload.PreUpdateBackupDetail.FullTable {
+		statements, err = getFullTableBackupStatements(ctx, instance.Engine, tc, statements, database.DatabaseName, backupDatabaseName, prefix)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to transform to full table backup")
+		}
+	}
 
 	priorBackupDetail := &storepb.PriorBackupDetail{}
 	for _, statement := range statements {
@@ -240,6 +266,69 @@ func (exec *DataUpdateExecutor) backupData(
 	return priorBackupDetail, nil
 }
 
+// getFullTableBackupStatements returns the statements backing up the entire tables changed by the statements of the row-level backup.
+// Each table is backed up once, and the positions cover all the statements changing the table.
+func getFullTableBackupStatements(ctx context.Context, engine storepb.Engine, tc base.TransformContext, statements []base.BackupStatement, sourceDatabase, backupDatabase, prefix string) ([]base.BackupStatement, error) {
+	type table struct {
+		schema string
+		name   string
+	}
+	var tables []table
+	positions := make(map[table]*base.BackupStatement)
+	for _, statement := range statements {
+		t := table{schema: statement.SourceSchema, name: statement.SourceTableName}
+		if position, ok := positions[t]; ok {
+			position.EndPosition = statement.EndPosition
+			continue
+		}
+		tables = append(tables, t)
+		positions[t] = &base.BackupStatement{StartPosition: statement.StartPosition, EndPosition: statement.EndPosition}
+	}
+
+	// Back up the entire tables by transforming the DELETE statements without the WHERE clause.
+	var buf strings.Builder
+	for _, t := range tables {
+		name, err := quoteTableName(engine, sourceDatabase, t.schema, t.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(&buf, "DELETE FROM %s;\n", name); err != nil {
+			return nil, err
+		}
+	}
+	fullTableStatements, err := base.TransformDMLToSelect(ctx, engine, tc, buf.String(), sourceDatabase, backupDatabase, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(fullTableStatements) != len(tables) {
+		return nil, errors.Errorf("expected %d full table backup statements, got %d", len(tables), len(fullTableStatements))
+	}
+	for i, t := range tables {
+		fullTableStatements[i].StartPosition = positions[t].StartPosition
+		fullTableStatements[i].EndPosition = positions[t].EndPosition
+	}
+	return fullTableStatements, nil
+}
+
+func quoteTableName(engine storepb.Engine, database, schema, table string) (string, error) {
+	switch engine {
+	case storepb.Engine_MYSQL, storepb.Engine_TIDB, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+		return fmt.Sprintf("`%s`.`%s`", database, table), nil
+	case storepb.Engine_MSSQL:
+		if schema == "" {
+			return fmt.Sprintf("[%s]", table), nil
+		}
+		return fmt.Sprintf("[%s].[%s]", schema, table), nil
+	case storepb.Engine_POSTGRES, storepb.Engine_ORACLE:
+		if schema == "" {
+			return fmt.Sprintf(`"%s"`, table), nil
+		}
+		return fmt.Sprintf(`"%s"."%s"`, schema, table), nil
+	default:
+		return "", errors.Errorf("full table backup is not supported for engine %v", engine)
+	}
+}
+
 func BuildGetDatabaseMetadataFunc(storeInstance *store.Store) base.GetDatabaseMetadataFunc {
 	return func(ctx context.Context, instanceID, databaseName string) (string, *model.DatabaseMetadata, error) {
 		database, err := storeInstance.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
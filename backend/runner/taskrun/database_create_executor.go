@@ -177,8 +177,8 @@ func (exec *DatabaseCreateExecutor) RunOnce(ctx context.Context, driverCtx conte
 	if err != nil {
 		return true, nil, err
 	}
-	// We will use schema from existing tenant databases for creating a database in a tenant mode project if possible.
-	peerDatabase, peerSchemaVersion, peerSchema, err := exec.createInitialSchema(ctx, driverCtx, environment, instance, project, task, taskRunUID, database)
+	// We will use schema from the template, or from existing tenant databases for creating a database in a tenant mode project if possible.
+	peerDatabase, peerSchemaVersion, peerSchema, err := exec.createInitialSchema(ctx, driverCtx, environment, instance, project, task, taskRunUID, database, payload.Template)
 	if err != nil {
 		return true, nil, err
 	}
@@ -366,8 +366,16 @@ func (exec *DatabaseCreateExecutor) reconcilePlan(ctx context.Context, project *
 	}
 }
 
-func (exec *DatabaseCreateExecutor) createInitialSchema(ctx context.Context, driverCtx context.Context, environment *store.EnvironmentMessage, instance *store.InstanceMessage, project *store.ProjectMessage, task *store.TaskMessage, taskRunUID int, database *store.DatabaseMessage) (*store.DatabaseMessage, model.Version, string, error) {
-	peerDatabase, schemaVersion, schema, err := exec.getSchemaFromPeerTenantDatabase(ctx, instance, project, database)
+func (exec *DatabaseCreateExecutor) createInitialSchema(ctx context.Context, driverCtx context.Context, environment *store.EnvironmentMessage, instance *store.InstanceMessage, project *store.ProjectMessage, task *store.TaskMessage, taskRunUID int, database *store.DatabaseMessage, template string) (*store.DatabaseMessage, model.Version, string, error) {
+	var peerDatabase *store.DatabaseMessage
+	var schemaVersion model.Version
+	var schema string
+	var err error
+	if template != "" {
+		peerDatabase, schemaVersion, schema, err = exec.getSchemaFromTemplate(ctx, template)
+	} else {
+		peerDatabase, schemaVersion, schema, err = exec.getSchemaFromPeerTenantDatabase(ctx, instance, project, database)
+	}
 	if err != nil {
 		return nil, model.Version{}, "", err
 	}
@@ -447,19 +455,75 @@ func getConnectionStatement(dbType storepb.Engine, databaseName string) (string,
 		return fmt.Sprintf("USE DATABASE %s;\n", databaseName), nil
 	case storepb.Engine_SQLITE:
 		return fmt.Sprintf("USE `%s`;\n", databaseName), nil
+	case storepb.Engine_DUCKDB:
+		return fmt.Sprintf("USE \"%s\";\n", databaseName), nil
 	case storepb.Engine_MONGODB:
 		// We embed mongosh to execute the mongodb statement, and `use` statement is not effective in mongosh.
 		// We will connect to the specified database by specifying the database name in the connection string.
 		return "", nil
 	case storepb.Engine_REDSHIFT:
 		return fmt.Sprintf("\\connect \"%s\";\n", databaseName), nil
-	case storepb.Engine_SPANNER:
+	case storepb.Engine_SPANNER, storepb.Engine_BIGQUERY, storepb.Engine_DATABRICKS:
 		return "", nil
 	}
 
 	return "", errors.Errorf("unsupported database type %s", dbType)
 }
 
+// getSchemaFromTemplate gets the baseline schema from the template database or branch.
+// The schema version is only available for the template database.
+func (exec *DatabaseCreateExecutor) getSchemaFromTemplate(ctx context.Context, template string) (*store.DatabaseMessage, model.Version, string, error) {
+	if projectID, branchID, err := common.GetProjectAndBranchID(template); err == nil {
+		branch, err := exec.store.GetBranch(ctx, &store.FindBranchMessage{ProjectID: &projectID, ResourceID: &branchID, LoadFull: true})
+		if err != nil {
+			return nil, model.Version{}, "", errors.Wrapf(err, "failed to get template branch %q", template)
+		}
+		if branch == nil {
+			return nil, model.Version{}, "", errors.Errorf("template branch %q not found", template)
+		}
+		return nil, model.Version{}, string(branch.HeadSchema), nil
+	}
+
+	instanceID, databaseName, err := common.GetInstanceDatabaseID(template)
+	if err != nil {
+		return nil, model.Version{}, "", errors.Wrapf(err, "invalid template %q", template)
+	}
+	templateInstance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		return nil, model.Version{}, "", err
+	}
+	if templateInstance == nil {
+		return nil, model.Version{}, "", errors.Errorf("instance %q not found", instanceID)
+	}
+	templateDatabase, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+		InstanceID:          &instanceID,
+		DatabaseName:        &databaseName,
+		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(templateInstance),
+	})
+	if err != nil {
+		return nil, model.Version{}, "", err
+	}
+	if templateDatabase == nil {
+		return nil, model.Version{}, "", errors.Errorf("template database %q not found", template)
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, templateInstance, templateDatabase, db.ConnectionContext{})
+	if err != nil {
+		return nil, model.Version{}, "", err
+	}
+	defer driver.Close(ctx)
+	schemaVersion, err := getLatestDoneSchemaVersion(ctx, exec.store, templateInstance.UID, templateDatabase.UID, templateDatabase.DatabaseName)
+	if err != nil {
+		return nil, model.Version{}, "", errors.Wrapf(err, "failed to get migration history for database %q", templateDatabase.DatabaseName)
+	}
+
+	var schemaBuf bytes.Buffer
+	if _, err := driver.Dump(ctx, &schemaBuf); err != nil {
+		return nil, model.Version{}, "", err
+	}
+	return templateDatabase, schemaVersion, schemaBuf.String(), nil
+}
+
 // getSchemaFromPeerTenantDatabase gets the schema version and schema from a peer tenant database.
 // It's used for creating a database in a tenant mode project.
 // When a peer tenant database doesn't exist, we will return an error if there are databases in the project with the same name.
@@ -0,0 +1,142 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/objectstorage"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/backup"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// NewDatabaseRestoreExecutor creates a database restore task executor.
+func NewDatabaseRestoreExecutor(store *store.Store, stateCfg *state.State, schemaSyncer *schemasync.Syncer, backupRunner *backup.Runner, profile *config.Profile) Executor {
+	return &DatabaseRestoreExecutor{
+		store:        store,
+		stateCfg:     stateCfg,
+		schemaSyncer: schemaSyncer,
+		backupRunner: backupRunner,
+		profile:      profile,
+	}
+}
+
+// DatabaseRestoreExecutor is the database restore task executor.
+// It restores the database in place or to a new database on the same instance from a logical backup.
+type DatabaseRestoreExecutor struct {
+	store        *store.Store
+	stateCfg     *state.State
+	schemaSyncer *schemasync.Syncer
+	backupRunner *backup.Runner
+	profile      *config.Profile
+}
+
+// RunOnce will run the database restore task executor once.
+func (exec *DatabaseRestoreExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (terminated bool, result *storepb.TaskRunResult, err error) {
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_PRE_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	payload := &storepb.TaskDatabaseRestorePayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database restore payload")
+	}
+
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get database")
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database not found")
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get instance")
+	}
+	if instance == nil {
+		return true, nil, errors.Errorf("instance not found")
+	}
+	backupRunUID := int(payload.BackupRunId)
+	backupRuns, err := exec.store.ListBackupRuns(ctx, &store.FindBackupRunMessage{UID: &backupRunUID, DatabaseUID: &database.UID})
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get backup run")
+	}
+	if len(backupRuns) == 0 {
+		return true, nil, errors.Errorf("backup run %d not found", backupRunUID)
+	}
+	backupRun := backupRuns[0]
+
+	databaseName := database.DatabaseName
+	createDatabase := payload.TargetDatabase != ""
+	if createDatabase {
+		databaseName = payload.TargetDatabase
+	}
+
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+	startTime := time.Now()
+	if err := exec.backupRunner.Restore(driverCtx, instance, backupRun, databaseName, createDatabase, func(completed, total int64) {
+		exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+			state.TaskRunExecutionStatus{
+				ExecutionStatus: v1pb.TaskRun_EXECUTING,
+				ExecutionDetail: &v1pb.TaskRun_ExecutionDetail{
+					BytesTotal:     total,
+					BytesCompleted: completed,
+				},
+				UpdateTime: time.Now(),
+			})
+	}); err != nil {
+		return true, nil, errors.Wrapf(err, "failed to restore database %q", databaseName)
+	}
+	duration := time.Since(startTime)
+
+	exec.stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
+		state.TaskRunExecutionStatus{
+			ExecutionStatus: v1pb.TaskRun_POST_EXECUTING,
+			UpdateTime:      time.Now(),
+		})
+
+	restoredDatabase := database
+	if createDatabase {
+		// The restored database belongs to the project and the environment of the backup database.
+		restoredDatabase, err = exec.store.UpsertDatabase(ctx, &store.DatabaseMessage{
+			ProjectID:            database.ProjectID,
+			InstanceID:           instance.ResourceID,
+			DatabaseName:         databaseName,
+			EnvironmentID:        database.EnvironmentID,
+			SyncState:            api.OK,
+			SuccessfulSyncTimeTs: time.Now().Unix(),
+			Metadata:             &storepb.DatabaseMetadata{},
+		})
+		if err != nil {
+			return true, nil, err
+		}
+	}
+	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, restoredDatabase, true /* force */); err != nil {
+		slog.Error("failed to sync database schema",
+			slog.String("instanceName", instance.ResourceID),
+			slog.String("databaseName", databaseName),
+			log.BBError(err),
+		)
+	}
+
+	return true, &storepb.TaskRunResult{
+		Detail: fmt.Sprintf("Restored database %q from backup %s within %v", databaseName, objectstorage.GetObjectURI(backupRun.Payload.Storage, backupRun.Payload.Path), duration.String()),
+	}, nil
+}
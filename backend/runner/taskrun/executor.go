@@ -33,6 +33,7 @@ type Executor interface {
 	// 1. It's possible that err could be non-nil while terminated is false, which
 	// usually indicates a transient error and will make scheduler retry later.
 	// 2. If err is non-nil, then the detail field will be ignored since info is provided in the err.
+	// The result is kept only for the VerificationFailed error, whose change has been applied.
 	// driverCtx is used by the database driver so that we can cancel the query
 	// while have the ability to cleanup migration history etc.
 	RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, taskRunUID int) (terminated bool, result *storepb.TaskRunResult, err error)
@@ -225,6 +226,40 @@ func executeMigration(
 		stateCfg.TaskRunConnectionID.Delete(taskRunUID)
 	}
 
+	switch task.Type {
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
+		payload := &storepb.TaskDatabaseUpdatePayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+			return "", "", errors.Wrapf(err, "invalid database update payload")
+		}
+		opts.TransactionMode = payload.TransactionMode
+		opts.SessionVariables = payload.SessionVariables
+	}
+
+	resumable, err := isTaskResumable(instance.Engine, task)
+	if err != nil {
+		return "", "", err
+	}
+	if resumable {
+		commandIndexes, err := stores.ListTaskRunStatements(ctx, taskRunUID)
+		if err != nil {
+			return "", "", err
+		}
+		if len(commandIndexes) > 0 {
+			slog.Info("Resume task run from the statement ledger",
+				slog.Int("task_run", taskRunUID),
+				slog.Int("committed_statements", len(commandIndexes)),
+			)
+		}
+		opts.CommittedCommandIndexes = map[int32]bool{}
+		for _, commandIndex := range commandIndexes {
+			opts.CommittedCommandIndexes[commandIndex] = true
+		}
+		opts.RecordCommandsCommitted = func(commandIndexes []int32) error {
+			return stores.CreateTaskRunStatements(ctx, taskRunUID, commandIndexes)
+		}
+	}
+
 	if stateCfg != nil {
 		switch task.Type {
 		case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
@@ -233,7 +268,7 @@ func executeMigration(
 				storepb.Engine_STARROCKS, storepb.Engine_DORIS, storepb.Engine_POSTGRES,
 				storepb.Engine_REDSHIFT, storepb.Engine_RISINGWAVE, storepb.Engine_ORACLE,
 				storepb.Engine_DM, storepb.Engine_OCEANBASE_ORACLE, storepb.Engine_MSSQL,
-				storepb.Engine_DYNAMODB:
+				storepb.Engine_DYNAMODB, storepb.Engine_SPANNER, storepb.Engine_DATABRICKS:
 				opts.UpdateExecutionStatus = func(detail *v1pb.TaskRun_ExecutionDetail) {
 					stateCfg.TaskRunExecutionStatuses.Store(taskRunUID,
 						state.TaskRunExecutionStatus{
@@ -257,6 +292,30 @@ func executeMigration(
 	return migrationID, schema, nil
 }
 
+// isTaskResumable returns whether the task records the committed statements in the ledger,
+// so that the interrupted task run resumes from the next statement instead of running the whole sheet again.
+// The commit points are unknown in the manual transaction mode, and the prior backup of the data update can't be taken again in the middle.
+func isTaskResumable(engine storepb.Engine, task *store.TaskMessage) (bool, error) {
+	switch task.Type {
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseDataUpdate:
+	default:
+		return false, nil
+	}
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_MYSQL, storepb.Engine_MARIADB, storepb.Engine_OCEANBASE:
+	default:
+		return false, nil
+	}
+	payload := &storepb.TaskDatabaseUpdatePayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return false, errors.Wrapf(err, "invalid database update payload")
+	}
+	if payload.TransactionMode == storepb.TransactionMode_TRANSACTION_MODE_MANUAL {
+		return false, nil
+	}
+	return payload.PreUpdateBackupDetail.GetDatabase() == "", nil
+}
+
 func postMigration(ctx context.Context, stores *store.Store, task *store.TaskMessage, mi *db.MigrationInfo, migrationID string, sheetID *int) (bool, *storepb.TaskRunResult, error) {
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
@@ -346,5 +405,10 @@ func runMigration(ctx context.Context, driverCtx context.Context, store *store.S
 	if err != nil {
 		return true, nil, err
 	}
-	return postMigration(ctx, store, task, mi, migrationID, sheetID)
+	terminated, result, err = postMigration(ctx, store, task, mi, migrationID, sheetID)
+	if err != nil || mi.Type == db.Baseline {
+		return terminated, result, err
+	}
+	result, err = verifyMigration(ctx, store, dbFactory, task, result)
+	return true, result, err
 }
@@ -0,0 +1,145 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// persistTaskRunLogFile renders the logs of the finished task run into a full text log and persists it.
+// The log is not persisted if it fails, since the structured logs are still available.
+func persistTaskRunLogFile(ctx context.Context, stores *store.Store, taskRunUID int, task *store.TaskMessage) {
+	content, truncated, err := renderTaskRunLog(ctx, stores, taskRunUID, task)
+	if err != nil {
+		slog.Error("failed to render task run log", slog.Int("task_run_id", taskRunUID), log.BBError(err))
+		return
+	}
+	if err := stores.UpsertTaskRunLogFile(ctx, taskRunUID, []byte(content), truncated); err != nil {
+		slog.Error("failed to persist task run log", slog.Int("task_run_id", taskRunUID), log.BBError(err))
+	}
+}
+
+func renderTaskRunLog(ctx context.Context, stores *store.Store, taskRunUID int, task *store.TaskMessage) (string, bool, error) {
+	taskRuns, err := stores.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{UID: &taskRunUID})
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to get task run")
+	}
+	if len(taskRuns) == 0 {
+		return "", false, errors.Errorf("task run %d not found", taskRunUID)
+	}
+	taskRun := taskRuns[0]
+	logs, err := stores.ListTaskRunLogs(ctx, taskRunUID)
+	if err != nil {
+		return "", false, errors.Wrapf(err, "failed to list task run logs")
+	}
+
+	var statement string
+	var commands []*storepb.SheetCommand
+	sheetUID := 0
+	if taskRun.SheetUID != nil {
+		sheetUID = *taskRun.SheetUID
+	} else if id, err := utils.GetTaskSheetID(task.Payload); err == nil {
+		sheetUID = id
+	}
+	if sheetUID > 0 {
+		sheet, err := stores.GetSheet(ctx, &store.FindSheetMessage{UID: &sheetUID})
+		if err != nil {
+			return "", false, errors.Wrapf(err, "failed to get sheet %d", sheetUID)
+		}
+		if sheet != nil {
+			commands = sheet.Payload.GetCommands()
+		}
+		statement, err = stores.GetSheetStatementByID(ctx, sheetUID)
+		if err != nil {
+			return "", false, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+		}
+	}
+
+	w := &taskRunLogWriter{}
+	w.printf(time.Unix(taskRun.CreatedTs, 0), "Task run %q of task %q started", taskRun.Name, task.Name)
+	for _, l := range logs {
+		switch l.Payload.Type {
+		case storepb.TaskRunLog_SCHEMA_DUMP_START:
+			w.printf(l.T, "Schema dump started")
+		case storepb.TaskRunLog_SCHEMA_DUMP_END:
+			w.printResult(l.T, "Schema dump finished", l.Payload.SchemaDumpEnd.GetError())
+		case storepb.TaskRunLog_COMMAND_EXECUTE:
+			indexes := l.Payload.CommandExecute.GetCommandIndexes()
+			w.printf(l.T, "Executing commands %v", indexes)
+			for _, index := range indexes {
+				if len(commands) == 0 {
+					// The statement is executed as a whole if it's not split into commands.
+					w.write(statement + "\n")
+					break
+				}
+				if int(index) < len(commands) {
+					command := commands[index]
+					if int(command.Start) <= int(command.End) && int(command.End) <= len(statement) {
+						w.write(strings.TrimSpace(statement[command.Start:command.End]) + "\n")
+					}
+				}
+			}
+		case storepb.TaskRunLog_COMMAND_RESPONSE:
+			response := l.Payload.CommandResponse
+			w.printResult(l.T, fmt.Sprintf("Executed commands %v, affected rows %d", response.GetCommandIndexes(), response.GetAffectedRows()), response.GetError())
+		case storepb.TaskRunLog_DATABASE_SYNC_START:
+			w.printf(l.T, "Database sync started")
+		case storepb.TaskRunLog_DATABASE_SYNC_END:
+			w.printResult(l.T, "Database sync finished", l.Payload.DatabaseSyncEnd.GetError())
+		case storepb.TaskRunLog_TASK_RUN_STATUS_UPDATE:
+			w.printf(l.T, "Task run status updated to %s", l.Payload.TaskRunStatusUpdate.GetStatus())
+		case storepb.TaskRunLog_TRANSACTION_CONTROL:
+			control := l.Payload.TransactionControl
+			w.printResult(l.T, fmt.Sprintf("Transaction %s", control.GetType()), control.GetError())
+		case storepb.TaskRunLog_PRIOR_BACKUP_START:
+			w.printf(l.T, "Prior backup started")
+		case storepb.TaskRunLog_PRIOR_BACKUP_END:
+			w.printResult(l.T, "Prior backup finished", l.Payload.PriorBackupEnd.GetError())
+		}
+	}
+	w.printf(time.Unix(taskRun.UpdatedTs, 0), "Task run finished with status %s", taskRun.Status)
+	if detail := taskRun.ResultProto.GetDetail(); detail != "" {
+		w.write(detail + "\n")
+	}
+	return w.String(), w.truncated, nil
+}
+
+// taskRunLogWriter writes the text log up to common.MaxTaskRunLogSize, and drops the rest.
+type taskRunLogWriter struct {
+	strings.Builder
+	truncated bool
+}
+
+func (w *taskRunLogWriter) write(s string) {
+	if w.truncated {
+		return
+	}
+	if w.Len()+len(s) > common.MaxTaskRunLogSize {
+		w.WriteString(s[:common.MaxTaskRunLogSize-w.Len()])
+		w.truncated = true
+		return
+	}
+	w.WriteString(s)
+}
+
+func (w *taskRunLogWriter) printf(t time.Time, format string, args ...any) {
+	w.write(fmt.Sprintf("%s %s\n", t.UTC().Format(time.RFC3339Nano), fmt.Sprintf(format, args...)))
+}
+
+func (w *taskRunLogWriter) printResult(t time.Time, message, errMessage string) {
+	if errMessage != "" {
+		w.printf(t, "%s with error: %s", message, errMessage)
+		return
+	}
+	w.printf(t, "%s", message)
+}
@@ -0,0 +1,13 @@
+package taskrun
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// taskRunSlotWaitSeconds is the time the task runs wait for a task slot of the instance by the priority of the issue.
+var taskRunSlotWaitSeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "bytebase_task_run_slot_wait_seconds",
+	Help:    "The time in seconds the task runs wait for a task slot of the instance before executing.",
+	Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+}, []string{"instance", "priority"})
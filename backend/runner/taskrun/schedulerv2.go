@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -26,6 +29,11 @@ import (
 
 const (
 	taskSchedulerInterval = 5 * time.Second
+	// taskRunStarvationThreshold is the time after which the task run waiting for a task slot is scheduled ahead of all priorities,
+	// so that the low priority task runs are not starved by a steady stream of the high priority ones.
+	taskRunStarvationThreshold = 30 * time.Minute
+	// taskRunCancelWaitPeriod is the time reserved at the end of the shutdown for the canceled task runs to persist their status.
+	taskRunCancelWaitPeriod = 3 * time.Second
 )
 
 // SchedulerV2 is the V2 scheduler for task run.
@@ -35,6 +43,12 @@ type SchedulerV2 struct {
 	webhookManager *webhook.Manager
 	executorMap    map[api.TaskType]Executor
 	profile        *config.Profile
+
+	// drainMu guards draining, a scheduling round holds the read lock so that no task run is started after the draining begins.
+	drainMu  sync.RWMutex
+	draining bool
+	// taskRunWG waits for the executing task runs.
+	taskRunWG sync.WaitGroup
 }
 
 // NewSchedulerV2 will create a new scheduler.
@@ -79,6 +93,67 @@ func (s *SchedulerV2) Run(ctx context.Context, wg *sync.WaitGroup) {
 	}
 }
 
+// Drain stops starting the task runs and waits for the executing task runs to finish before the deadline of ctx.
+// The task runs still executing near the deadline are canceled. The resumable ones remain RUNNING and resume
+// from the next statement of the ledger after the server restarts, the others are marked as CANCELED
+// instead of being executed again from the beginning.
+// The task runs which haven't started remain RUNNING and are resumed after the server restarts.
+func (s *SchedulerV2) Drain(ctx context.Context) {
+	s.drainMu.Lock()
+	s.draining = true
+	s.drainMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.taskRunWG.Wait()
+		close(done)
+	}()
+
+	drainCtx := ctx
+	if deadline, ok := ctx.Deadline(); ok {
+		var cancel context.CancelFunc
+		drainCtx, cancel = context.WithDeadline(ctx, deadline.Add(-taskRunCancelWaitPeriod))
+		defer cancel()
+	}
+	select {
+	case <-done:
+		return
+	case <-drainCtx.Done():
+	}
+
+	var taskRunIDs []int
+	s.stateCfg.RunningTaskRunsCancelFunc.Range(func(key, value any) bool {
+		taskRunIDs = append(taskRunIDs, key.(int))
+		value.(context.CancelFunc)()
+		return true
+	})
+	slog.Warn("cancel the task runs executing at the shutdown deadline", slog.Any("taskRuns", taskRunIDs))
+	select {
+	case <-done:
+	case <-ctx.Done():
+		slog.Error("task runs didn't stop before the shutdown deadline", slog.Any("taskRuns", taskRunIDs))
+	}
+}
+
+// isTaskResumable returns whether the task run of the task resumes from the statement ledger after it's interrupted.
+func (s *SchedulerV2) isTaskResumable(ctx context.Context, task *store.TaskMessage) bool {
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil || instance == nil {
+		return false
+	}
+	resumable, err := isTaskResumable(instance.Engine, task)
+	if err != nil {
+		return false
+	}
+	return resumable
+}
+
+func (s *SchedulerV2) isDraining() bool {
+	s.drainMu.RLock()
+	defer s.drainMu.RUnlock()
+	return s.draining
+}
+
 func (s *SchedulerV2) runOnce(ctx context.Context) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -133,6 +208,28 @@ func (s *SchedulerV2) scheduleAutoRolloutTasks(ctx context.Context) error {
 	return nil
 }
 
+// isTaskStageApproved returns false if the rollout policy of the task stage requires stage approval and the stage is not approved.
+func (s *SchedulerV2) isTaskStageApproved(ctx context.Context, task *store.TaskMessage) (bool, error) {
+	stages, err := s.store.ListStageV2(ctx, task.PipelineID)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list stages")
+	}
+	for _, stage := range stages {
+		if stage.ID != task.StageID {
+			continue
+		}
+		policy, err := s.store.GetRolloutPolicy(ctx, stage.EnvironmentID)
+		if err != nil {
+			return false, errors.Wrapf(err, "failed to get rollout policy for environment ID %d", stage.EnvironmentID)
+		}
+		if !policy.StageApprovalRequired {
+			return true, nil
+		}
+		return stage.Approval != nil && stage.Approval.Status == store.StageApprovalStatusApproved, nil
+	}
+	return false, errors.Errorf("stage %d not found", task.StageID)
+}
+
 func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int) error {
 	task, err := s.store.GetTaskV2ByID(ctx, taskUID)
 	if err != nil {
@@ -164,6 +261,15 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 		}
 	}
 
+	// the stage must be approved if the rollout policy requires stage approval
+	stageApproved, err := s.isTaskStageApproved(ctx, task)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check if the stage is approved")
+	}
+	if !stageApproved {
+		return nil
+	}
+
 	// the latest checks of the plan must pass
 	pass, err := func() (bool, error) {
 		plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &task.PipelineID})
@@ -221,6 +327,10 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 			slog.Warn("failed to create issue comment", "issueUID", issue.UID, log.BBError(err))
 		}
 
+		incident, err := webhook.GetTaskRunIncidentAction(ctx, s.store, task, api.TaskRunPending)
+		if err != nil {
+			slog.Warn("failed to get the incident action of the task run", "taskUID", task.ID, log.BBError(err))
+		}
 		s.webhookManager.CreateEvent(ctx, &webhook.Event{
 			Actor:   s.store.GetSystemBotUser(ctx),
 			Type:    webhook.EventTypeTaskRunStatusUpdate,
@@ -228,8 +338,9 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 			Issue:   webhook.NewIssue(issue),
 			Project: webhook.NewProject(issue.Project),
 			TaskRunStatusUpdate: &webhook.EventTaskRunStatusUpdate{
-				Title:  issue.Title,
-				Status: api.TaskRunPending.String(),
+				Title:    issue.Title,
+				Status:   api.TaskRunPending.String(),
+				Incident: incident,
 			},
 		})
 	}
@@ -239,7 +350,8 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 
 func (s *SchedulerV2) schedulePendingTaskRuns(ctx context.Context) error {
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
-		Status: &[]api.TaskRunStatus{api.TaskRunPending},
+		Status:                 &[]api.TaskRunStatus{api.TaskRunPending},
+		ExcludeArchivedProject: true,
 	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to list pending tasks")
@@ -299,6 +411,13 @@ func (s *SchedulerV2) schedulePendingTaskRun(ctx context.Context, taskRun *store
 }
 
 func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
+	s.drainMu.RLock()
+	defer s.drainMu.RUnlock()
+	// The running task runs are resumed after the server restarts.
+	if s.draining {
+		return nil
+	}
+
 	taskRuns, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
 		Status: &[]api.TaskRunStatus{api.TaskRunRunning},
 	})
@@ -309,12 +428,23 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 	// Find the minimum task ID for each database.
 	// We only run the first (i.e. which has the minimum task ID) task for each database.
 	minTaskIDForDatabase := map[int]int{}
+	// The priority of the task runs, and the rank which the task runs are scheduled in.
+	priorities := map[int]storepb.IssuePayload_Priority{}
+	ranks := map[int]int{}
+	pipelinePriorities := map[int]storepb.IssuePayload_Priority{}
 	for _, taskRun := range taskRuns {
 		task, err := s.store.GetTaskV2ByID(ctx, taskRun.TaskUID)
 		if err != nil {
 			slog.Error("failed to get task", slog.Int("task id", taskRun.TaskUID), log.BBError(err))
 			continue
 		}
+		priority, ok := pipelinePriorities[task.PipelineID]
+		if !ok {
+			priority = s.getPipelinePriority(ctx, task.PipelineID)
+			pipelinePriorities[task.PipelineID] = priority
+		}
+		priorities[taskRun.ID] = priority
+		ranks[taskRun.ID] = s.getTaskRunRank(taskRun.ID, priority)
 		if task.DatabaseID == nil {
 			continue
 		}
@@ -328,6 +458,14 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 		}
 	}
 
+	// Schedule the task runs in the order of the rank, and the task runs of the same rank in the order of creation.
+	sort.SliceStable(taskRuns, func(i, j int) bool {
+		return ranks[taskRuns[i].ID] > ranks[taskRuns[j].ID]
+	})
+	// waitingRanks is the highest rank of the task runs waiting for a task slot, keyed by the instance UID.
+	// The lower rank task runs can't take the slot freed in the middle of the round ahead of them.
+	waitingRanks := map[int]int{}
+
 	for _, taskRun := range taskRuns {
 		// Skip the task run if it is already executing.
 		if _, ok := s.stateCfg.RunningTaskRuns.Load(taskRun.ID); ok {
@@ -382,6 +520,18 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 			)
 			continue
 		}
+		if waitingRank, ok := waitingRanks[task.InstanceID]; ok && waitingRank > ranks[taskRun.ID] {
+			s.stateCfg.TaskRunWaitingSlotSince.LoadOrStore(taskRun.ID, time.Now())
+			s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
+				ReportTime: timestamppb.Now(),
+				WaitingCause: &storepb.SchedulerInfo_WaitingCause{
+					Cause: &storepb.SchedulerInfo_WaitingCause_TaskLimit{
+						TaskLimit: true,
+					},
+				},
+			})
+			continue
+		}
 		maximumConnections := int(instance.Options.GetMaximumConnections())
 		if s.stateCfg.InstanceOutstandingConnections.Increment(task.InstanceID, maximumConnections) {
 			s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
@@ -394,6 +544,30 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 			})
 			continue
 		}
+		maxConcurrentTasks := int(instance.Options.GetMaxConcurrentTasks())
+		if maxConcurrentTasks <= 0 {
+			// The tasks are only limited by the maximum connections of the instance.
+			maxConcurrentTasks = math.MaxInt32
+		}
+		if s.stateCfg.InstanceRunningTasks.Increment(task.InstanceID, maxConcurrentTasks) {
+			s.stateCfg.InstanceOutstandingConnections.Decrement(task.InstanceID)
+			s.stateCfg.TaskRunWaitingSlotSince.LoadOrStore(taskRun.ID, time.Now())
+			if _, ok := waitingRanks[task.InstanceID]; !ok {
+				waitingRanks[task.InstanceID] = ranks[taskRun.ID]
+			}
+			s.stateCfg.TaskRunSchedulerInfo.Store(taskRun.ID, &storepb.SchedulerInfo{
+				ReportTime: timestamppb.Now(),
+				WaitingCause: &storepb.SchedulerInfo_WaitingCause{
+					Cause: &storepb.SchedulerInfo_WaitingCause_TaskLimit{
+						TaskLimit: true,
+					},
+				},
+			})
+			continue
+		}
+		if since, ok := s.stateCfg.TaskRunWaitingSlotSince.LoadAndDelete(taskRun.ID); ok {
+			taskRunSlotWaitSeconds.WithLabelValues(instance.ResourceID, getPriorityLabel(priorities[taskRun.ID])).Observe(time.Since(since.(time.Time)).Seconds())
+		}
 
 		s.stateCfg.TaskRunSchedulerInfo.Delete(taskRun.ID)
 
@@ -408,13 +582,43 @@ func (s *SchedulerV2) scheduleRunningTaskRuns(ctx context.Context) error {
 				Status: storepb.TaskRunLog_TaskRunStatusUpdate_RUNNING_RUNNING,
 			},
 		})
-		go s.runTaskRunOnce(ctx, taskRun, task, executor)
+		// The task run is detached from the scheduler so that it's not interrupted when the server begins to shut down,
+		// Drain cancels it if it doesn't finish before the shutdown deadline.
+		s.taskRunWG.Add(1)
+		go s.runTaskRunOnce(context.WithoutCancel(ctx), taskRun, task, executor)
 	}
 
 	return nil
 }
 
+// getPipelinePriority returns the priority of the issue of the pipeline, and the normal priority if the issue is not found.
+func (s *SchedulerV2) getPipelinePriority(ctx context.Context, pipelineID int) storepb.IssuePayload_Priority {
+	issue, err := s.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &pipelineID})
+	if err != nil {
+		slog.Error("failed to get issue", slog.Int("pipeline id", pipelineID), log.BBError(err))
+		return storepb.IssuePayload_PRIORITY_NORMAL
+	}
+	if issue == nil || issue.Payload.GetPriority() == storepb.IssuePayload_PRIORITY_UNSPECIFIED {
+		return storepb.IssuePayload_PRIORITY_NORMAL
+	}
+	return issue.Payload.GetPriority()
+}
+
+// getTaskRunRank returns the rank of the task run, which is the priority unless the task run has waited for a task slot
+// longer than the starvation threshold, then it's ranked above all priorities.
+func (s *SchedulerV2) getTaskRunRank(taskRunID int, priority storepb.IssuePayload_Priority) int {
+	if since, ok := s.stateCfg.TaskRunWaitingSlotSince.Load(taskRunID); ok && time.Since(since.(time.Time)) > taskRunStarvationThreshold {
+		return int(storepb.IssuePayload_PRIORITY_HIGH) + 1
+	}
+	return int(priority)
+}
+
+func getPriorityLabel(priority storepb.IssuePayload_Priority) string {
+	return strings.ToLower(strings.TrimPrefix(priority.String(), "PRIORITY_"))
+}
+
 func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRunMessage, task *store.TaskMessage, executor Executor) {
+	defer s.taskRunWG.Done()
 	defer func() {
 		s.stateCfg.TaskRunExecutionStatuses.Delete(taskRun.ID)
 		// We don't need to do s.stateCfg.RunningTaskRuns.Delete(taskRun.ID) to avoid race condition.
@@ -422,6 +626,7 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		if task.DatabaseID != nil {
 			s.stateCfg.RunningDatabaseMigration.Delete(*task.DatabaseID)
 		}
+		s.stateCfg.InstanceRunningTasks.Decrement(task.InstanceID)
 		s.stateCfg.InstanceOutstandingConnections.Decrement(task.InstanceID)
 	}()
 
@@ -441,6 +646,18 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 		return
 	}
 
+	if done && err != nil && errors.Is(err, context.Canceled) && s.isDraining() && s.isTaskResumable(ctx, task) {
+		// Leave the task run RUNNING, it resumes from the next statement of the ledger after the server restarts.
+		slog.Warn("task run is interrupted by the server shutdown and will resume after the server restarts",
+			slog.Int("id", task.ID),
+			slog.String("name", task.Name),
+			slog.String("type", string(task.Type)),
+		)
+		return
+	}
+	// Persist the full log after the task run status is updated.
+	defer persistTaskRunLogFile(ctx, s.store, taskRun.ID, task)
+
 	if done && err != nil && errors.Is(err, context.Canceled) {
 		slog.Warn("task run is canceled",
 			slog.Int("id", task.ID),
@@ -448,8 +665,12 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			slog.String("type", string(task.Type)),
 			log.BBError(err),
 		)
+		detail := "The task run is canceled"
+		if s.isDraining() {
+			detail = "The task run is canceled because the server shut down before it finished. The statements executed in a transaction are rolled back, please check the database before rerunning the task"
+		}
 		resultBytes, marshalErr := protojson.Marshal(&storepb.TaskRunResult{
-			Detail:        "The task run is canceled",
+			Detail:        detail,
 			ChangeHistory: "",
 			Version:       "",
 		})
@@ -495,6 +716,11 @@ func (s *SchedulerV2) runTaskRunOnce(ctx context.Context, taskRun *store.TaskRun
 			ChangeHistory: "",
 			Version:       "",
 		}
+		if result != nil && common.ErrorCode(err) == common.VerificationFailed {
+			// The change has been applied, keep the change history and the verification results.
+			taskRunResult = result
+			taskRunResult.Detail = err.Error()
+		}
 
 		var errWithPosition *db.ErrorWithPosition
 		if errors.As(err, &errWithPosition) {
@@ -796,6 +1022,10 @@ func (s *SchedulerV2) createActivityForTaskRunStatusUpdate(ctx context.Context,
 		if issue == nil {
 			return nil
 		}
+		incident, err := webhook.GetTaskRunIncidentAction(ctx, s.store, task, newStatus)
+		if err != nil {
+			return errors.Wrap(err, "failed to get the incident action")
+		}
 		s.webhookManager.CreateEvent(ctx, &webhook.Event{
 			Actor:   s.store.GetSystemBotUser(ctx),
 			Type:    webhook.EventTypeTaskRunStatusUpdate,
@@ -803,9 +1033,10 @@ func (s *SchedulerV2) createActivityForTaskRunStatusUpdate(ctx context.Context,
 			Issue:   webhook.NewIssue(issue),
 			Project: webhook.NewProject(issue.Project),
 			TaskRunStatusUpdate: &webhook.EventTaskRunStatusUpdate{
-				Title:  task.Name,
-				Status: newStatus.String(),
-				Detail: errDetail,
+				Title:    task.Name,
+				Status:   newStatus.String(),
+				Detail:   errDetail,
+				Incident: incident,
 			},
 		})
 		return nil
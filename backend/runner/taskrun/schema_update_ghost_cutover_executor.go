@@ -86,7 +86,14 @@ func (e *SchemaUpdateGhostCutoverExecutor) RunOnce(ctx context.Context, taskCont
 	if err != nil {
 		return true, nil, errors.Wrapf(err, "failed to get sheet statement by id: %d", sheetID)
 	}
-	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	statement, err = utils.RenderStatementVariablesForDatabase(ctx, e.store, statement, database)
+	if err != nil {
+		return true, nil, err
+	}
+	materials, err := utils.GetSecretMapFromDatabaseMessage(ctx, database)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get database secrets")
+	}
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
@@ -163,7 +170,12 @@ func cutover(ctx context.Context, taskContext context.Context, stores *store.Sto
 		return true, nil, err
 	}
 
-	return postMigration(ctx, stores, task, mi, migrationID, &sheetID)
+	terminated, result, err = postMigration(ctx, stores, task, mi, migrationID, &sheetID)
+	if err != nil {
+		return terminated, result, err
+	}
+	result, err = verifyMigration(ctx, stores, dbFactory, task, result)
+	return true, result, err
 }
 
 func waitForCutover(ctx context.Context, taskContext context.Context, migrationContext *base.MigrationContext) bool {
@@ -99,7 +99,14 @@ func (exec *SchemaUpdateGhostSyncExecutor) runGhostMigration(ctx context.Context
 		return true, nil, errors.Errorf("database not found")
 	}
 
-	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	statement, err = utils.RenderStatementVariablesForDatabase(ctx, exec.store, statement, database)
+	if err != nil {
+		return true, nil, err
+	}
+	materials, err := utils.GetSecretMapFromDatabaseMessage(ctx, database)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get database secrets")
+	}
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := utils.RenderStatement(statement, materials)
 
@@ -3,6 +3,7 @@ package taskrun
 import (
 	"context"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -73,7 +74,14 @@ func (exec *SchemaUpdateSDLExecutor) RunOnce(ctx context.Context, driverCtx cont
 		return true, nil, err
 	}
 
-	materials := backendutils.GetSecretMapFromDatabaseMessage(database)
+	statement, err = backendutils.RenderStatementVariablesForDatabase(ctx, exec.store, statement, database)
+	if err != nil {
+		return true, nil, err
+	}
+	materials, err := backendutils.GetSecretMapFromDatabaseMessage(ctx, database)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get database secrets")
+	}
 	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
 	renderedStatement := backendutils.RenderStatement(statement, materials)
 
@@ -82,6 +90,15 @@ func (exec *SchemaUpdateSDLExecutor) RunOnce(ctx context.Context, driverCtx cont
 	if err != nil {
 		return true, nil, errors.Wrap(err, "invalid database schema diff")
 	}
+	if !payload.AllowDestructiveChanges {
+		destructiveStatements, err := utils.GetDestructiveStatements(instance.Engine, ddl)
+		if err != nil {
+			return true, nil, errors.Wrap(err, "failed to check destructive statements")
+		}
+		if len(destructiveStatements) > 0 {
+			return true, nil, errors.Errorf("the schema change contains destructive statements which must be allowed explicitly:\n%s", strings.Join(destructiveStatements, "\n"))
+		}
+	}
 	terminated, result, err := runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.stateCfg, exec.profile, task, taskRunUID, db.MigrateSDL, ddl, version, &sheetID)
 
 	if err := exec.schemaSyncer.SyncDatabaseSchema(ctx, database, false /* force */); err != nil {
@@ -0,0 +1,150 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const verificationQueryTimeout = 5 * time.Minute
+
+// verifyMigration runs the verification queries of the task after the change is applied.
+// The verification results are appended to the task run result. It returns a VerificationFailed error
+// together with the result if any verification fails, so that the applied change is still recorded.
+func verifyMigration(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, task *store.TaskMessage, result *storepb.TaskRunResult) (*storepb.TaskRunResult, error) {
+	payload := &storepb.TaskDatabaseUpdatePayload{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, errors.Wrap(err, "invalid database update payload")
+	}
+	if len(payload.VerificationQueries) == 0 {
+		return result, nil
+	}
+
+	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, err
+	}
+	database, err := stores.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return nil, err
+	}
+	driver, err := dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database, "" /* dataSourceID */)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	var conn *sql.Conn
+	if sqlDB := driver.GetDB(); sqlDB != nil {
+		conn, err = sqlDB.Conn(ctx)
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close()
+	}
+
+	var failed []string
+	for _, query := range payload.VerificationQueries {
+		verificationResult := &storepb.VerificationResult{
+			Title:     query.Title,
+			Statement: query.Statement,
+		}
+		queryResults, err := runVerificationQuery(ctx, driver, conn, database.DatabaseName, query.Statement)
+		if err != nil {
+			verificationResult.Detail = fmt.Sprintf("failed to run the query: %v", err)
+		} else {
+			verificationResult.Passed, verificationResult.Detail = evaluateVerificationQuery(query, queryResults)
+		}
+		if !verificationResult.Passed {
+			failed = append(failed, fmt.Sprintf("%q", query.Title))
+		}
+		result.VerificationResults = append(result.VerificationResults, verificationResult)
+	}
+	if len(failed) > 0 {
+		return result, common.Errorf(common.VerificationFailed, "the change is applied but the verification %s failed", strings.Join(failed, ", "))
+	}
+	return result, nil
+}
+
+func runVerificationQuery(ctx context.Context, driver db.Driver, conn *sql.Conn, databaseName, statement string) ([]*v1pb.QueryResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, verificationQueryTimeout)
+	defer cancel()
+	queryResults, err := driver.QueryConn(ctx, conn, statement, &db.QueryContext{CurrentDatabase: databaseName})
+	if err != nil {
+		return nil, err
+	}
+	for _, queryResult := range queryResults {
+		if queryResult.Error != "" {
+			return nil, errors.New(queryResult.Error)
+		}
+	}
+	return queryResults, nil
+}
+
+// evaluateVerificationQuery checks the last query result against the expectation of the verification query.
+func evaluateVerificationQuery(query *storepb.VerificationQuery, queryResults []*v1pb.QueryResult) (bool, string) {
+	if len(queryResults) == 0 {
+		return false, "the query returns no result"
+	}
+	queryResult := queryResults[len(queryResults)-1]
+
+	switch query.Type {
+	case storepb.VerificationQuery_ROW_COUNT_EQUALS:
+		rowCount := int64(len(queryResult.Rows))
+		if rowCount != query.ExpectedRowCount {
+			return false, fmt.Sprintf("expected %d rows, got %d", query.ExpectedRowCount, rowCount)
+		}
+		return true, fmt.Sprintf("got %d rows", rowCount)
+	case storepb.VerificationQuery_VALUE_IN_RANGE:
+		if len(queryResult.Rows) == 0 || len(queryResult.Rows[0].Values) == 0 {
+			return false, "the query returns no value"
+		}
+		value, ok := getRowValueNumber(queryResult.Rows[0].Values[0])
+		if !ok {
+			return false, "the value is not a number"
+		}
+		if query.MinValue != nil && value < *query.MinValue {
+			return false, fmt.Sprintf("the value %v is less than %v", value, *query.MinValue)
+		}
+		if query.MaxValue != nil && value > *query.MaxValue {
+			return false, fmt.Sprintf("the value %v is greater than %v", value, *query.MaxValue)
+		}
+		return true, fmt.Sprintf("got value %v", value)
+	default:
+		return false, fmt.Sprintf("unsupported verification type %q", query.Type)
+	}
+}
+
+func getRowValueNumber(value *v1pb.RowValue) (float64, bool) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_Int32Value:
+		return float64(v.Int32Value), true
+	case *v1pb.RowValue_Int64Value:
+		return float64(v.Int64Value), true
+	case *v1pb.RowValue_Uint32Value:
+		return float64(v.Uint32Value), true
+	case *v1pb.RowValue_Uint64Value:
+		return float64(v.Uint64Value), true
+	case *v1pb.RowValue_FloatValue:
+		return float64(v.FloatValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return v.DoubleValue, true
+	case *v1pb.RowValue_StringValue:
+		// Some drivers return the decimal values as strings.
+		f, err := strconv.ParseFloat(strings.TrimSpace(v.StringValue), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,67 @@
+package taskrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestEvaluateVerificationQuery(t *testing.T) {
+	float := func(f float64) *float64 { return &f }
+	row := func(value *v1pb.RowValue) *v1pb.QueryRow {
+		return &v1pb.QueryRow{Values: []*v1pb.RowValue{value}}
+	}
+	int64Value := func(v int64) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v}}
+	}
+
+	tests := []struct {
+		query  *storepb.VerificationQuery
+		result *v1pb.QueryResult
+		want   bool
+	}{
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_ROW_COUNT_EQUALS},
+			result: &v1pb.QueryResult{},
+			want:   true,
+		},
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_ROW_COUNT_EQUALS},
+			result: &v1pb.QueryResult{Rows: []*v1pb.QueryRow{row(int64Value(1))}},
+			want:   false,
+		},
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_VALUE_IN_RANGE, MinValue: float(1), MaxValue: float(10)},
+			result: &v1pb.QueryResult{Rows: []*v1pb.QueryRow{row(int64Value(10))}},
+			want:   true,
+		},
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_VALUE_IN_RANGE, MinValue: float(1), MaxValue: float(10)},
+			result: &v1pb.QueryResult{Rows: []*v1pb.QueryRow{row(int64Value(11))}},
+			want:   false,
+		},
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_VALUE_IN_RANGE, MaxValue: float(0.5)},
+			result: &v1pb.QueryResult{Rows: []*v1pb.QueryRow{row(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "0.25"}})}},
+			want:   true,
+		},
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_VALUE_IN_RANGE, MinValue: float(0)},
+			result: &v1pb.QueryResult{Rows: []*v1pb.QueryRow{row(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "abc"}})}},
+			want:   false,
+		},
+		{
+			query:  &storepb.VerificationQuery{Type: storepb.VerificationQuery_VALUE_IN_RANGE, MinValue: float(0)},
+			result: &v1pb.QueryResult{},
+			want:   false,
+		},
+	}
+
+	for i, test := range tests {
+		got, _ := evaluateVerificationQuery(test.query, []*v1pb.QueryResult{test.result})
+		require.Equal(t, test.want, got, "test case %d", i)
+	}
+}
@@ -0,0 +1,65 @@
+// Package taskrunlog is a runner that deletes the task run logs out of the retention.
+package taskrunlog
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const cleanInterval = 1 * time.Hour
+
+// NewRunner creates a task run log retention runner.
+func NewRunner(stores *store.Store) *Runner {
+	return &Runner{
+		store: stores,
+	}
+}
+
+// Runner is the task run log retention runner.
+type Runner struct {
+	store *store.Store
+}
+
+// Run will run the task run log retention runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(cleanInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Task run log retention runner started and will run every %v", cleanInterval))
+
+	for {
+		select {
+		case <-ticker.C:
+			r.deleteExpired(ctx)
+		case <-ctx.Done(): // if cancel() execute
+			return
+		}
+	}
+}
+
+func (r *Runner) deleteExpired(ctx context.Context) {
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		slog.Error("Failed to get workspace profile setting", log.BBError(err))
+		return
+	}
+	// Keep the logs forever if the retention is not set.
+	if setting.GetTaskRunLogRetention().GetSeconds() <= 0 {
+		return
+	}
+	updatedBefore := time.Now().Add(-setting.GetTaskRunLogRetention().AsDuration()).Unix()
+	count, err := r.store.DeleteTaskRunLogsBefore(ctx, updatedBefore)
+	if err != nil {
+		slog.Error("Failed to delete expired task run logs", log.BBError(err))
+		return
+	}
+	if count > 0 {
+		slog.Info(fmt.Sprintf("Deleted the logs of %d expired task runs", count))
+	}
+}
@@ -0,0 +1,275 @@
+// Package ticketsync is the runner syncing the issues with the linked tickets in the issue trackers, e.g. Jira and Linear.
+package ticketsync
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/eventstream"
+	"github.com/bytebase/bytebase/backend/component/secret"
+	"github.com/bytebase/bytebase/backend/component/webhook"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/app/ticket"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// ticketSyncInterval is the interval to poll the status of the tickets linked to the open issues.
+const ticketSyncInterval = time.Minute
+
+// NewRunner creates a new ticket sync runner.
+func NewRunner(store *store.Store, webhookManager *webhook.Manager, eventBroker *eventstream.Broker) *Runner {
+	return &Runner{
+		store:          store,
+		webhookManager: webhookManager,
+		eventBroker:    eventBroker,
+	}
+}
+
+// Runner syncs the issues with the tickets.
+// The ticket is created on the issue created event, and moved on the issue status updated event.
+// The tickets of the open issues are polled, and the issue is resolved or canceled if its ticket is moved to the mapped status.
+type Runner struct {
+	store          *store.Store
+	webhookManager *webhook.Manager
+	eventBroker    *eventstream.Broker
+}
+
+// Run runs the runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	subscription := r.eventBroker.Subscribe()
+	defer r.eventBroker.Unsubscribe(subscription)
+	ticker := time.NewTicker(ticketSyncInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	slog.Debug(fmt.Sprintf("Ticket sync runner started and will run every %v", ticketSyncInterval))
+	for {
+		select {
+		case event := <-subscription.Events():
+			if err := r.handleEvent(ctx, event); err != nil {
+				slog.Error("failed to sync the issue event to the ticket", slog.String("type", event.Type.String()), slog.String("issue", event.Resource), log.BBError(err))
+			}
+		case <-ticker.C:
+			r.pollTickets(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) handleEvent(ctx context.Context, event *v1pb.WorkspaceEvent) error {
+	if event.Type != v1pb.WorkspaceEvent_ISSUE_CREATED && event.Type != v1pb.WorkspaceEvent_ISSUE_STATUS_UPDATED {
+		return nil
+	}
+	projectID, issueUID, err := common.GetProjectIDIssueUID(event.Resource)
+	if err != nil {
+		return err
+	}
+	project, err := r.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get project %s", projectID)
+	}
+	if project == nil || !isTicketSyncEnabled(project.Setting.GetTicketSync()) {
+		return nil
+	}
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{UID: &issueUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue %d", issueUID)
+	}
+	if issue == nil {
+		return nil
+	}
+	provider, err := openProvider(ctx, project.Setting.TicketSync)
+	if err != nil {
+		return err
+	}
+
+	if event.Type == v1pb.WorkspaceEvent_ISSUE_CREATED {
+		return r.createTicket(ctx, provider, issue)
+	}
+	return r.transitionTicket(ctx, provider, project.Setting.TicketSync, issue)
+}
+
+// createTicket creates the linked ticket of the issue.
+func (r *Runner) createTicket(ctx context.Context, provider ticket.Provider, issue *store.IssueMessage) error {
+	if issue.Payload.GetExternalTicket() != nil {
+		return nil
+	}
+	setting, err := r.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get workspace setting")
+	}
+	request := &ticket.CreateRequest{
+		Title:       issue.Title,
+		Description: issue.Description,
+	}
+	if setting.ExternalUrl != "" {
+		request.Link = fmt.Sprintf("%s/projects/%s/issues/%s-%d", setting.ExternalUrl, issue.Project.ResourceID, slug.Make(issue.Title), issue.UID)
+	}
+	created, err := provider.Create(ctx, request)
+	if err != nil {
+		return err
+	}
+	return r.updateExternalTicket(ctx, issue, created)
+}
+
+// transitionTicket moves the linked ticket of the issue to the status mapped from the issue status.
+func (r *Runner) transitionTicket(ctx context.Context, provider ticket.Provider, ticketSync *storepb.TicketSync, issue *store.IssueMessage) error {
+	externalTicket := issue.Payload.GetExternalTicket()
+	if externalTicket == nil {
+		return nil
+	}
+	status := getTicketStatus(ticketSync, issue.Status)
+	if status == "" {
+		return nil
+	}
+	current, err := provider.Get(ctx, externalTicket.Key)
+	if err != nil {
+		return err
+	}
+	// The issue status may be updated from the ticket.
+	if current.Status != status {
+		if err := provider.Transition(ctx, externalTicket.Key, status); err != nil {
+			return err
+		}
+		current.Status = status
+	}
+	return r.updateExternalTicket(ctx, issue, current)
+}
+
+// pollTickets updates the status of the open issues whose tickets are moved to the done or canceled status.
+func (r *Runner) pollTickets(ctx context.Context) {
+	projects, err := r.store.ListProjectV2(ctx, &store.FindProjectMessage{})
+	if err != nil {
+		slog.Error("failed to list projects", log.BBError(err))
+		return
+	}
+	for _, project := range projects {
+		ticketSync := project.Setting.GetTicketSync()
+		if !isTicketSyncEnabled(ticketSync) || (ticketSync.DoneStatus == "" && ticketSync.CanceledStatus == "") {
+			continue
+		}
+		if err := r.pollProjectTickets(ctx, project); err != nil {
+			slog.Error("failed to poll the tickets of the project", slog.String("project", project.ResourceID), log.BBError(err))
+		}
+	}
+}
+
+func (r *Runner) pollProjectTickets(ctx context.Context, project *store.ProjectMessage) error {
+	issues, err := r.store.ListIssueV2(ctx, &store.FindIssueMessage{
+		ProjectID:  &project.ResourceID,
+		StatusList: []api.IssueStatus{api.IssueOpen},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list open issues")
+	}
+	var provider ticket.Provider
+	for _, issue := range issues {
+		externalTicket := issue.Payload.GetExternalTicket()
+		if externalTicket == nil {
+			continue
+		}
+		if provider == nil {
+			if provider, err = openProvider(ctx, project.Setting.TicketSync); err != nil {
+				return err
+			}
+		}
+		current, err := provider.Get(ctx, externalTicket.Key)
+		if err != nil {
+			slog.Warn("failed to get the ticket", slog.String("ticket", externalTicket.Key), log.BBError(err))
+			continue
+		}
+		if current.Status == externalTicket.Status {
+			continue
+		}
+		if err := r.syncIssueStatus(ctx, project.Setting.TicketSync, issue, current); err != nil {
+			slog.Error("failed to sync the ticket status to the issue", slog.String("ticket", externalTicket.Key), slog.Int("issue", issue.UID), log.BBError(err))
+		}
+	}
+	return nil
+}
+
+// syncIssueStatus resolves or cancels the open issue if its ticket is moved to the done or canceled status.
+func (r *Runner) syncIssueStatus(ctx context.Context, ticketSync *storepb.TicketSync, issue *store.IssueMessage, current *ticket.Ticket) error {
+	var newStatus api.IssueStatus
+	if ticketSync.DoneStatus != "" && current.Status == ticketSync.DoneStatus {
+		newStatus = api.IssueDone
+	} else if ticketSync.CanceledStatus != "" && current.Status == ticketSync.CanceledStatus {
+		newStatus = api.IssueCanceled
+	}
+	if newStatus != "" {
+		// Don't close the issue under the running task runs, the ticket status is synced again after they finish.
+		if issue.PipelineUID != nil {
+			taskRunStatusList := []api.TaskRunStatus{api.TaskRunRunning, api.TaskRunPending}
+			taskRuns, err := r.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{PipelineUID: issue.PipelineUID, Status: &taskRunStatusList})
+			if err != nil {
+				return errors.Wrapf(err, "failed to list task runs")
+			}
+			if len(taskRuns) > 0 {
+				return nil
+			}
+		}
+		if err := r.updateExternalTicket(ctx, issue, current); err != nil {
+			return err
+		}
+		comment := fmt.Sprintf("The ticket %s is moved to %q.", current.Key, current.Status)
+		return webhook.ChangeIssueStatus(ctx, r.store, r.webhookManager, issue, newStatus, r.store.GetSystemBotUser(ctx), comment)
+	}
+	return r.updateExternalTicket(ctx, issue, current)
+}
+
+func (r *Runner) updateExternalTicket(ctx context.Context, issue *store.IssueMessage, t *ticket.Ticket) error {
+	if _, err := r.store.UpdateIssueV2(ctx, issue.UID, &store.UpdateIssueMessage{
+		PayloadUpsert: &storepb.IssuePayload{
+			ExternalTicket: &storepb.ExternalTicket{
+				Key:    t.Key,
+				Url:    t.URL,
+				Status: t.Status,
+			},
+		},
+	}, api.SystemBotID); err != nil {
+		return errors.Wrapf(err, "failed to update the ticket of issue %d", issue.UID)
+	}
+	return nil
+}
+
+func isTicketSyncEnabled(ticketSync *storepb.TicketSync) bool {
+	return ticketSync != nil && ticketSync.Type != storepb.TicketSync_TYPE_UNSPECIFIED
+}
+
+func getTicketStatus(ticketSync *storepb.TicketSync, status api.IssueStatus) string {
+	switch status {
+	case api.IssueOpen:
+		return ticketSync.OpenStatus
+	case api.IssueDone:
+		return ticketSync.DoneStatus
+	case api.IssueCanceled:
+		return ticketSync.CanceledStatus
+	default:
+		return ""
+	}
+}
+
+// openProvider opens the provider of the ticket sync, the token is resolved from the secret stores on every call so that the rotated tokens are picked up.
+func openProvider(ctx context.Context, ticketSync *storepb.TicketSync) (ticket.Provider, error) {
+	token, err := secret.ReplaceExternalSecret(ctx, ticketSync.Token, ticketSync.ExternalSecret)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get the token of the ticket sync")
+	}
+	return ticket.Open(ticketSync.Type, &ticket.Config{
+		URL:       ticketSync.Url,
+		Username:  ticketSync.Username,
+		Token:     token,
+		Project:   ticketSync.Project,
+		IssueType: ticketSync.IssueType,
+	})
+}
@@ -11,6 +11,8 @@ import (
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/parser/base"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
+	pgrawparser "github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -44,9 +46,13 @@ func ComputeDatabaseSchemaDiff(ctx context.Context, instance *store.InstanceMess
 		return "", errors.Errorf("unsupported database engine %q", instance.Engine)
 	}
 
-	sdlFormat, err := transform.SchemaTransform(engine, schema.String())
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to transform SDL format")
+	// The PostgreSQL dump is diffed as it is since the PostgreSQL differ works on the DDL statements directly.
+	sdlFormat := schema.String()
+	if engine == storepb.Engine_MYSQL {
+		sdlFormat, err = transform.SchemaTransform(engine, schema.String())
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to transform SDL format")
+		}
 	}
 	diff, err := base.SchemaDiff(engine, base.DiffContext{
 		IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(instance),
@@ -57,3 +63,40 @@ func ComputeDatabaseSchemaDiff(ctx context.Context, instance *store.InstanceMess
 	}
 	return diff, nil
 }
+
+// GetDestructiveStatements returns the statements of the diff which may lose data, e.g. dropping tables or columns.
+// Only PostgreSQL is supported, it returns nil for other engines.
+func GetDestructiveStatements(engine storepb.Engine, diff string) ([]string, error) {
+	switch engine {
+	case storepb.Engine_POSTGRES, storepb.Engine_RISINGWAVE:
+	default:
+		return nil, nil
+	}
+
+	nodes, err := pgrawparser.Parse(pgrawparser.ParseContext{}, diff)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse schema diff")
+	}
+	var statements []string
+	for _, node := range nodes {
+		if isDestructiveNode(node) {
+			statements = append(statements, node.Text())
+		}
+	}
+	return statements, nil
+}
+
+func isDestructiveNode(node ast.Node) bool {
+	switch node := node.(type) {
+	case *ast.DropTableStmt, *ast.DropSchemaStmt, *ast.DropSequenceStmt, *ast.DropTypeStmt, *ast.DropFunctionStmt, *ast.DropExtensionStmt, *ast.DropDatabaseStmt:
+		return true
+	case *ast.AlterTableStmt:
+		for _, item := range node.AlterItemList {
+			switch item.(type) {
+			case *ast.DropColumnStmt, *ast.AlterColumnTypeStmt:
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetDestructiveStatements(t *testing.T) {
+	a := require.New(t)
+	diff := `ALTER TABLE "public"."t1" DROP COLUMN "c1";
+ALTER TABLE "public"."t1" ADD COLUMN "c2" integer;
+DROP TABLE "public"."t2";
+CREATE TABLE "public"."t3" ("id" integer);
+ALTER TABLE "public"."t1" ALTER COLUMN "c3" SET DATA TYPE bigint;
+`
+	statements, err := GetDestructiveStatements(storepb.Engine_POSTGRES, diff)
+	a.NoError(err)
+	a.Len(statements, 3)
+	a.Contains(statements[0], "DROP COLUMN")
+	a.Contains(statements[1], "DROP TABLE")
+	a.Contains(statements[2], "SET DATA TYPE")
+
+	statements, err = GetDestructiveStatements(storepb.Engine_MYSQL, "DROP TABLE t2;")
+	a.NoError(err)
+	a.Empty(statements)
+}
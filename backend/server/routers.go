@@ -15,13 +15,15 @@ import (
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"google.golang.org/grpc"
 
+	"github.com/bytebase/bytebase/backend/api/chatops"
+	"github.com/bytebase/bytebase/backend/api/directorysync"
 	"github.com/bytebase/bytebase/backend/api/gitops"
 	"github.com/bytebase/bytebase/backend/api/lsp"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/config"
 )
 
-func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.Server, gitOpsServer *gitops.Service, mux *grpcruntime.ServeMux, profile *config.Profile) {
+func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.Server, gitOpsServer *gitops.Service, directorySyncServer *directorysync.Service, chatOpsServer *chatops.Service, mux *grpcruntime.ServeMux, profile *config.Profile) {
 	// Embed frontend.
 	embedFrontend(e)
 
@@ -47,7 +49,8 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.
 		return strings.HasPrefix(c.Request().URL.Path, "/bytebase.v1.") ||
 			strings.HasPrefix(c.Request().URL.Path, "/v1:adminExecute") ||
 			strings.HasPrefix(c.Request().URL.Path, lspAPI) ||
-			strings.HasPrefix(c.Request().URL.Path, webhookAPIPrefix)
+			strings.HasPrefix(c.Request().URL.Path, webhookAPIPrefix) ||
+			strings.HasPrefix(c.Request().URL.Path, scimAPIPrefix)
 	}
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
 		Skipper: grpcSkipper,
@@ -84,6 +87,10 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.
 		// 100M.
 		wsproxy.WithMaxRespBodyBufferSize(100*1024*1024),
 	)))
+	e.GET("/v1/events:stream", echo.WrapHandler(wsproxy.WebsocketProxy(
+		mux,
+		wsproxy.WithTokenCookieName("access-token"),
+	)))
 	e.Any("/v1/*", echo.WrapHandler(mux))
 
 	// GRPC web proxy.
@@ -106,6 +113,12 @@ func configureEchoRouters(e *echo.Echo, grpcServer *grpc.Server, lspServer *lsp.
 	// GitOps Webhook server.
 	webhookGroup := e.Group(webhookAPIPrefix)
 	gitOpsServer.RegisterWebhookRoutes(webhookGroup)
+	// ChatOps command server.
+	chatOpsServer.RegisterChatOpsRoutes(webhookGroup)
+
+	// SCIM directory sync server.
+	scimGroup := e.Group(scimAPIPrefix)
+	directorySyncServer.RegisterDirectorySyncRoutes(scimGroup)
 }
 
 func recoverMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
@@ -22,6 +22,8 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 
 	"github.com/bytebase/bytebase/backend/api/auth"
+	"github.com/bytebase/bytebase/backend/api/chatops"
+	"github.com/bytebase/bytebase/backend/api/directorysync"
 	"github.com/bytebase/bytebase/backend/api/gitops"
 	"github.com/bytebase/bytebase/backend/api/lsp"
 	apiv1 "github.com/bytebase/bytebase/backend/api/v1"
@@ -29,7 +31,9 @@ import (
 	"github.com/bytebase/bytebase/backend/common/stacktrace"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/eventstream"
 	"github.com/bytebase/bytebase/backend/component/iam"
+	"github.com/bytebase/bytebase/backend/component/masterkey"
 	"github.com/bytebase/bytebase/backend/component/sheet"
 	"github.com/bytebase/bytebase/backend/component/state"
 	"github.com/bytebase/bytebase/backend/component/webhook"
@@ -43,19 +47,31 @@ import (
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 	"github.com/bytebase/bytebase/backend/runner/approval"
+	"github.com/bytebase/bytebase/backend/runner/backup"
+	"github.com/bytebase/bytebase/backend/runner/eventbus"
+	"github.com/bytebase/bytebase/backend/runner/iamcleaner"
+	"github.com/bytebase/bytebase/backend/runner/issueretention"
+	"github.com/bytebase/bytebase/backend/runner/longquery"
 	"github.com/bytebase/bytebase/backend/runner/mail"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
+	"github.com/bytebase/bytebase/backend/runner/queryhistory"
 	"github.com/bytebase/bytebase/backend/runner/relay"
+	"github.com/bytebase/bytebase/backend/runner/schemadrift"
+	"github.com/bytebase/bytebase/backend/runner/schemasnapshot"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/runner/slowquerysync"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/runner/taskrunlog"
+	"github.com/bytebase/bytebase/backend/runner/ticketsync"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
 const (
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
+	// scimAPIPrefix is the API prefix for SCIM directory sync.
+	scimAPIPrefix = "/scim/v2"
 	// lspAPI is the API for Bytebase Language Server Protocol.
 	lspAPI                 = "/lsp"
 	maxStacksize           = 1024 * 10240
@@ -65,18 +81,32 @@ const (
 // Server is the Bytebase server.
 type Server struct {
 	// Asynchronous runners.
-	taskSchedulerV2    *taskrun.SchedulerV2
-	planCheckScheduler *plancheck.Scheduler
-	metricReporter     *metricreport.Reporter
-	schemaSyncer       *schemasync.Syncer
-	slowQuerySyncer    *slowquerysync.Syncer
-	mailSender         *mail.SlowQueryWeeklyMailSender
-	approvalRunner     *approval.Runner
-	relayRunner        *relay.Runner
-	runnerWG           sync.WaitGroup
+	taskSchedulerV2      *taskrun.SchedulerV2
+	planCheckScheduler   *plancheck.Scheduler
+	metricReporter       *metricreport.Reporter
+	schemaSyncer         *schemasync.Syncer
+	schemaDriftDetector  *schemadrift.Detector
+	longQueryDetector    *longquery.Detector
+	backupRunner         *backup.Runner
+	schemaSnapshotRunner *schemasnapshot.Runner
+	queryHistoryRunner   *queryhistory.Runner
+	issueRetentionRunner *issueretention.Runner
+	taskRunLogRunner     *taskrunlog.Runner
+	slowQuerySyncer      *slowquerysync.Syncer
+	mailSender           *mail.SlowQueryWeeklyMailSender
+	notificationSender   *mail.NotificationMailSender
+	eventBusRunner       *eventbus.Runner
+	approvalRunner       *approval.Runner
+	relayRunner          *relay.Runner
+	ticketSyncRunner     *ticketsync.Runner
+	iamCleaner           *iamcleaner.Runner
+	// metadataBackupRunner is nil if the metadata backup is not configured.
+	metadataBackupRunner *backup.MetadataBackupRunner
+	runnerWG             sync.WaitGroup
 
 	webhookManager *webhook.Manager
 	iamManager     *iam.Manager
+	eventBroker    *eventstream.Broker
 
 	licenseService enterprise.LicenseService
 
@@ -90,6 +120,8 @@ type Server struct {
 	dbFactory    *dbfactory.DBFactory
 	startedTs    int64
 	secret       string
+	// masterKeyManager is nil if the envelope encryption is not enabled.
+	masterKeyManager *masterkey.Manager
 
 	// Stubs.
 	planService    *apiv1.PlanService
@@ -175,6 +207,23 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		connCfg = cfg
 	}
 	connCfg.ReadOnly = profile.Readonly
+	if !profile.UseEmbedDB() && !profile.Readonly && connCfg.TargetSessionAttrs == "" {
+		// Only connect to the primary of the HA setups, so that the new connections after the failover go to the new primary.
+		connCfg.TargetSessionAttrs = "read-write"
+	}
+
+	s.metadataBackupRunner, err = backup.NewMetadataBackupRunner(profile, connCfg, s.pgBinDir)
+	if err != nil {
+		return nil, err
+	}
+	if profile.RestoreMetadataBackup != "" {
+		if s.metadataBackupRunner == nil {
+			return nil, errors.Errorf("--restore-metadata-backup requires --metadata-backup-uri")
+		}
+		if profile.Readonly {
+			return nil, errors.Errorf("cannot restore the metadata backup in readonly mode")
+		}
+	}
 
 	// Start Postgres sample servers. It is used for onboarding users without requiring them to
 	// configure an external instance.
@@ -199,16 +248,46 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	if profile.Readonly {
 		slog.Info("Database is opened in readonly mode. Skip migration and demo data setup.")
 	} else {
-		if err := demo.LoadDemoDataIfNeeded(ctx, storeDB, s.pgBinDir, profile.DemoName, profile.Mode); err != nil {
-			return nil, errors.Wrapf(err, "failed to load demo data")
-		}
-		if _, err := migrator.MigrateSchema(ctx, storeDB, storeInstance, s.pgBinDir, profile.Version, profile.Mode); err != nil {
+		if err := storeDB.WithMigrationLock(ctx, func() error {
+			// Restore before the migration, so that the backups taken by the older versions are migrated.
+			if profile.RestoreMetadataBackup != "" {
+				if err := s.restoreMetadataBackup(ctx, profile.RestoreMetadataBackup); err != nil {
+					return err
+				}
+				// The restored data replaces everything cached from the database.
+				storeInstance.DeleteCache()
+			}
+			if err := demo.LoadDemoDataIfNeeded(ctx, storeDB, s.pgBinDir, profile.DemoName, profile.Mode); err != nil {
+				return errors.Wrapf(err, "failed to load demo data")
+			}
+			if _, err := migrator.MigrateSchema(ctx, storeDB, storeInstance, s.pgBinDir, profile.Version, profile.Mode); err != nil {
+				return err
+			}
+			// The load test data is generated with the latest schema.
+			if err := demo.GenerateScaleDataIfNeeded(ctx, storeDB, s.pgBinDir, profile.DemoScale); err != nil {
+				return errors.Wrapf(err, "failed to generate load test data")
+			}
+			return nil
+		}); err != nil {
 			return nil, err
 		}
 	}
 	s.store = storeInstance
 	s.sheetManager = sheet.NewManager(storeInstance)
 
+	if profile.MasterKeyURI != "" {
+		s.masterKeyManager, err = masterkey.NewManager(ctx, storeInstance, profile.MasterKeyURI)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to init master key")
+		}
+		storeInstance.SetSecretCipher(s.masterKeyManager)
+		if !profile.Readonly {
+			if err := s.masterKeyManager.ReencryptIfNeeded(ctx); err != nil {
+				return nil, errors.Wrap(err, "failed to re-encrypt data sources")
+			}
+		}
+	}
+
 	s.stateCfg, err = state.New()
 	if err != nil {
 		return nil, err
@@ -230,6 +309,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		return nil, errors.Wrap(err, "failed to init config")
 	}
 	s.secret = secret
+	storeInstance.SetSheetContentStorage(sheet.NewContentStorage(s.secret))
 	s.iamManager, err = iam.NewManager(storeInstance, s.licenseService)
 	if err := s.iamManager.ReloadCache(ctx); err != nil {
 		return nil, err
@@ -237,7 +317,9 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create iam manager")
 	}
-	s.webhookManager = webhook.NewManager(storeInstance, s.iamManager)
+	s.eventBroker = eventstream.NewBroker(storeInstance)
+	storeInstance.SetAnomalyListener(s.eventBroker)
+	s.webhookManager = webhook.NewManager(storeInstance, s.iamManager, s.eventBroker)
 	s.dbFactory = dbfactory.New(
 		s.store,
 		s.mysqlBinDir,
@@ -253,15 +335,35 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 	// Note: the gateway response modifier takes the token duration on server startup. If the value is changed,
 	// the user has to restart the server to take the latest value.
 	gatewayModifier := auth.GatewayResponseModifier{TokenDuration: tokenDuration}
-	mux := grpcruntime.NewServeMux(grpcruntime.WithForwardResponseOption(gatewayModifier.Modify))
+	mux := grpcruntime.NewServeMux(
+		grpcruntime.WithForwardResponseOption(gatewayModifier.Modify),
+		grpcruntime.WithOutgoingHeaderMatcher(func(key string) (string, bool) {
+			// The rate limited requests get the standard Retry-After header.
+			if key == apiv1.RetryAfterMetadataKey {
+				return "Retry-After", true
+			}
+			return fmt.Sprintf("%s%s", grpcruntime.MetadataHeaderPrefix, key), true
+		}),
+	)
 
 	s.metricReporter = metricreport.NewReporter(s.store, s.licenseService, s.profile, false)
-	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile, s.licenseService)
+	s.schemaSyncer = schemasync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
 	if !profile.Readonly {
 		s.slowQuerySyncer = slowquerysync.NewSyncer(storeInstance, s.dbFactory, s.stateCfg, profile)
 		s.mailSender = mail.NewSender(s.store, s.stateCfg, s.iamManager)
+		s.notificationSender = mail.NewNotificationSender(s.store)
+		s.eventBusRunner = eventbus.NewRunner(s.store, s.secret)
+		s.schemaDriftDetector = schemadrift.NewDetector(storeInstance, s.licenseService)
+		s.longQueryDetector = longquery.NewDetector(storeInstance, s.dbFactory)
+		s.backupRunner = backup.NewRunner(storeInstance, profile, s.mysqlBinDir, s.pgBinDir, s.secret)
+		s.schemaSnapshotRunner = schemasnapshot.NewRunner(storeInstance)
+		s.queryHistoryRunner = queryhistory.NewRunner(storeInstance)
+		s.issueRetentionRunner = issueretention.NewRunner(storeInstance)
+		s.taskRunLogRunner = taskrunlog.NewRunner(storeInstance)
+		s.iamCleaner = iamcleaner.NewRunner(storeInstance)
 		s.relayRunner = relay.NewRunner(storeInstance, s.webhookManager, s.stateCfg)
-		s.approvalRunner = approval.NewRunner(storeInstance, s.sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.relayRunner, s.licenseService)
+		s.ticketSyncRunner = ticketsync.NewRunner(storeInstance, s.webhookManager, s.eventBroker)
+		s.approvalRunner = approval.NewRunner(storeInstance, s.sheetManager, s.dbFactory, s.stateCfg, s.webhookManager, s.licenseService, s.secret)
 
 		s.taskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.webhookManager, profile)
 		s.taskSchedulerV2.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
@@ -271,6 +373,8 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateSDL, taskrun.NewSchemaUpdateSDLExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseDataUpdate, taskrun.NewDataUpdateExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 		s.taskSchedulerV2.Register(api.TaskDatabaseDataExport, taskrun.NewDataExportExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseRestore, taskrun.NewDatabaseRestoreExecutor(storeInstance, s.stateCfg, s.schemaSyncer, s.backupRunner, profile))
+		s.taskSchedulerV2.Register(api.TaskDatabaseDataLoad, taskrun.NewDataLoadExecutor(storeInstance, s.dbFactory, s.stateCfg))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.taskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.licenseService, s.stateCfg, s.schemaSyncer, profile))
 
@@ -283,15 +387,24 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
 		statementReportExecutor := plancheck.NewStatementReportExecutor(storeInstance, s.sheetManager, s.dbFactory)
 		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
+		statementConflictExecutor := plancheck.NewStatementConflictExecutor(storeInstance, s.sheetManager)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementConflict, statementConflictExecutor)
+		statementSimulateExecutor := plancheck.NewStatementSimulateExecutor(storeInstance, s.dbFactory, s.backupRunner)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementSimulate, statementSimulateExecutor)
+		grantPreviewExecutor := plancheck.NewGrantPreviewExecutor(storeInstance, s.dbFactory)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseGrantPreview, grantPreviewExecutor)
+		dryRunExecutor := plancheck.NewDryRunExecutor(storeInstance, s.dbFactory)
+		s.planCheckScheduler.Register(store.PlanCheckDatabaseStatementDryRun, dryRunExecutor)
 
 		// Metric reporter
 		s.initMetricReporter()
 	}
 
 	// Setup the gRPC and grpc-gateway.
-	authProvider := auth.New(s.store, s.secret, tokenDuration, s.licenseService, s.stateCfg, s.profile)
+	authProvider := auth.New(s.store, s.secret, tokenDuration, s.licenseService, s.stateCfg, s.profile, s.webhookManager)
 	auditProvider := apiv1.NewAuditInterceptor(s.store)
 	aclProvider := apiv1.NewACLInterceptor(s.store, s.secret, s.iamManager, s.profile)
+	rateLimitProvider := apiv1.NewRateLimitInterceptor(s.store)
 	debugProvider := apiv1.NewDebugInterceptor(s.metricReporter)
 	onPanic := func(p any) error {
 		stack := stacktrace.TakeStacktrace(20 /* n */, 5 /* skip */)
@@ -310,6 +423,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		grpc.ChainUnaryInterceptor(
 			debugProvider.DebugInterceptor,
 			authProvider.AuthenticationInterceptor,
+			rateLimitProvider.RateLimitInterceptor,
 			aclProvider.ACLInterceptor,
 			auditProvider.AuditInterceptor,
 			recoveryUnaryInterceptor,
@@ -317,6 +431,7 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		grpc.ChainStreamInterceptor(
 			debugProvider.DebugStreamInterceptor,
 			authProvider.AuthenticationStreamInterceptor,
+			rateLimitProvider.RateLimitStreamInterceptor,
 			aclProvider.ACLStreamInterceptor,
 			auditProvider.AuditStreamInterceptor,
 			recoveryStreamInterceptor,
@@ -344,16 +459,20 @@ func NewServer(ctx context.Context, profile *config.Profile) (*Server, error) {
 		}
 		return nil
 	}
-	planService, rolloutService, issueService, sqlService, err := configureGrpcRouters(ctx, mux, s.grpcServer, s.store, s.sheetManager, s.dbFactory, s.licenseService, s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.webhookManager, s.iamManager, s.relayRunner, s.planCheckScheduler, postCreateUser, s.secret, tokenDuration)
+	planService, rolloutService, issueService, sqlService, err := configureGrpcRouters(ctx, mux, s.grpcServer, s.store, s.sheetManager, s.dbFactory, s.licenseService, s.profile, s.metricReporter, s.stateCfg, s.schemaSyncer, s.webhookManager, s.iamManager, s.eventBroker, s.relayRunner, s.planCheckScheduler, postCreateUser, s.secret, tokenDuration, s.masterKeyManager, s.metadataBackupRunner)
 	if err != nil {
 		return nil, err
 	}
 	s.planService, s.rolloutService, s.issueService = planService, rolloutService, issueService
 	// GitOps webhook server.
 	gitOpsServer := gitops.NewService(s.store, s.stateCfg, s.licenseService, planService, rolloutService, issueService, sqlService, s.sheetManager)
+	// SCIM directory sync server.
+	directorySyncServer := directorysync.NewService(s.store, s.licenseService, s.iamManager)
+	// ChatOps command server.
+	chatOpsServer := chatops.NewService(s.store, aclProvider, auditProvider, issueService, rolloutService)
 
 	// Configure echo server routes.
-	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, mux, profile)
+	configureEchoRouters(s.echoServer, s.grpcServer, s.lspServer, gitOpsServer, directorySyncServer, chatOpsServer, mux, profile)
 
 	serverStarted = true
 	return s, nil
@@ -370,19 +489,46 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		s.runnerWG.Add(1)
 		go s.schemaSyncer.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
+		go s.schemaDriftDetector.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.longQueryDetector.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.backupRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.schemaSnapshotRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.queryHistoryRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.issueRetentionRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.taskRunLogRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.iamCleaner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
 		go s.slowQuerySyncer.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.mailSender.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
+		go s.notificationSender.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.eventBusRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
 		go s.approvalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.relayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.ticketSyncRunner.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.metricReporter.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.planCheckScheduler.Run(ctx, &s.runnerWG)
+
+		if s.metadataBackupRunner != nil && s.profile.MetadataBackupInterval > 0 {
+			s.runnerWG.Add(1)
+			go s.metadataBackupRunner.Run(ctx, &s.runnerWG)
+		}
 	}
 
 	address := fmt.Sprintf(":%d", port)
@@ -425,9 +571,19 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		s.metricReporter.Close()
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, gracefulShutdownPeriod)
+	shutdownPeriod := s.profile.GracefulShutdownPeriod
+	if shutdownPeriod <= 0 {
+		shutdownPeriod = gracefulShutdownPeriod
+	}
+	ctx, cancel := context.WithTimeout(ctx, shutdownPeriod)
 	defer cancel()
 
+	// Drain the task runs before cancelling the workers, so that the executing migrations can finish with the store available.
+	if s.taskSchedulerV2 != nil {
+		slog.Info("Draining task runs...")
+		s.taskSchedulerV2.Drain(ctx)
+	}
+
 	// Cancel the worker
 	if s.cancel != nil {
 		s.cancel()
@@ -441,12 +597,10 @@ func (s *Server) Shutdown(ctx context.Context) error {
 			close(stopped)
 		}()
 
-		t := time.NewTimer(gracefulShutdownPeriod)
 		select {
-		case <-t.C:
+		case <-ctx.Done():
 			s.grpcServer.Stop()
 		case <-stopped:
-			t.Stop()
 		}
 	}
 	if s.echoServer != nil {
@@ -475,3 +629,17 @@ func (s *Server) Shutdown(ctx context.Context) error {
 
 	return nil
 }
+
+// restoreMetadataBackup verifies and restores the metadata backup, all other replicas must be stopped.
+func (s *Server) restoreMetadataBackup(ctx context.Context, name string) error {
+	metadataBackup, err := s.metadataBackupRunner.Get(ctx, name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get metadata backup %q", name)
+	}
+	slog.Info("Restoring the metadata database", slog.String("backup", metadataBackup.Name), slog.String("version", metadataBackup.Version))
+	if err := s.metadataBackupRunner.Restore(ctx, metadataBackup); err != nil {
+		return errors.Wrapf(err, "failed to restore metadata backup %q", metadataBackup.Name)
+	}
+	slog.Info("Restored the metadata database", slog.String("backup", metadataBackup.Name))
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/db/clickhouse"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/databricks"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/dm"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/doris"
+	_ "github.com/bytebase/bytebase/backend/plugin/db/duckdb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/dynamodb"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/elasticsearch"
 	_ "github.com/bytebase/bytebase/backend/plugin/db/hive"
@@ -25,6 +27,7 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/db/tidb"
 
 	// Parsers.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/doris"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/mysql"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/partiql"
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/plsql"
@@ -35,10 +38,16 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/tsql"
 
 	// Advisors.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/clickhouse"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/custom"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/doris"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/duckdb"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/elasticsearch"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mssql"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mysql"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oceanbase"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/oracle"
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/redis"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/snowflake"
 	_ "github.com/bytebase/bytebase/backend/plugin/advisor/tidb"
 
@@ -55,4 +64,11 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/feishu"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/slack"
 	_ "github.com/bytebase/bytebase/backend/plugin/webhook/wecom"
+
+	// Approval providers.
+	_ "github.com/bytebase/bytebase/backend/plugin/app/relay"
+
+	// Ticket providers.
+	_ "github.com/bytebase/bytebase/backend/plugin/app/ticket/jira"
+	_ "github.com/bytebase/bytebase/backend/plugin/app/ticket/linear"
 )
@@ -0,0 +1,212 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// AdminSessionMessage is the store message for an admin execute session of the SQL Editor admin mode.
+// The sessions and the statements are append-only.
+type AdminSessionMessage struct {
+	InstanceUID   int
+	DatabaseName  string
+	CreatorID     int
+	Justification string
+
+	// Output only fields
+	UID         int
+	CreatedTime time.Time
+	Statements  []*AdminSessionStatementMessage
+}
+
+// AdminSessionStatementMessage is the store message for a statement executed in the admin session.
+type AdminSessionStatementMessage struct {
+	SessionUID int
+	Statement  string
+	Payload    *storepb.AdminSessionStatementPayload
+
+	// Output only fields
+	UID         int64
+	CreatedTime time.Time
+}
+
+// FindAdminSessionMessage is the message for finding admin sessions.
+type FindAdminSessionMessage struct {
+	InstanceUID *int
+
+	Limit  *int
+	Offset *int
+}
+
+// CreateAdminSession creates the admin session.
+func (s *Store) CreateAdminSession(ctx context.Context, create *AdminSessionMessage) (*AdminSessionMessage, error) {
+	var createdTs int64
+	if err := s.db.db.QueryRowContext(ctx, `
+		INSERT INTO admin_session (
+			creator_id,
+			instance_id,
+			db_name,
+			justification
+		)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_ts
+	`,
+		create.CreatorID,
+		create.InstanceUID,
+		create.DatabaseName,
+		create.Justification,
+	).Scan(&create.UID, &createdTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create admin session")
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+	return create, nil
+}
+
+// CreateAdminSessionStatement appends the statement to the admin session.
+func (s *Store) CreateAdminSessionStatement(ctx context.Context, create *AdminSessionStatementMessage) (*AdminSessionStatementMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var createdTs int64
+	if err := s.db.db.QueryRowContext(ctx, `
+		INSERT INTO admin_session_statement (
+			session_id,
+			statement,
+			payload
+		)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_ts
+	`,
+		create.SessionUID,
+		create.Statement,
+		payload,
+	).Scan(&create.UID, &createdTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create admin session statement")
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+	return create, nil
+}
+
+// ListAdminSessions lists the admin sessions with the statements, the newest first.
+func (s *Store) ListAdminSessions(ctx context.Context, find *FindAdminSessionMessage) ([]*AdminSessionMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.InstanceUID; v != nil {
+		where, args = append(where, fmt.Sprintf("instance_id = $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			instance_id,
+			db_name,
+			justification
+		FROM admin_session
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*AdminSessionMessage
+	sessionMap := make(map[int]*AdminSessionMessage)
+	var sessionUIDs []int
+	for rows.Next() {
+		var session AdminSessionMessage
+		var createdTs int64
+		if err := rows.Scan(
+			&session.UID,
+			&session.CreatorID,
+			&createdTs,
+			&session.InstanceUID,
+			&session.DatabaseName,
+			&session.Justification,
+		); err != nil {
+			return nil, err
+		}
+		session.CreatedTime = time.Unix(createdTs, 0)
+		sessions = append(sessions, &session)
+		sessionMap[session.UID] = &session
+		sessionUIDs = append(sessionUIDs, session.UID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, tx.Commit()
+	}
+
+	statementRows, err := tx.QueryContext(ctx, `
+		SELECT
+			id,
+			session_id,
+			created_ts,
+			statement,
+			payload
+		FROM admin_session_statement
+		WHERE session_id = ANY($1)
+		ORDER BY id`,
+		sessionUIDs,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer statementRows.Close()
+	for statementRows.Next() {
+		var statement AdminSessionStatementMessage
+		var createdTs int64
+		var payload []byte
+		if err := statementRows.Scan(
+			&statement.UID,
+			&statement.SessionUID,
+			&createdTs,
+			&statement.Statement,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		statement.CreatedTime = time.Unix(createdTs, 0)
+		statementPayload := &storepb.AdminSessionStatementPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, statementPayload); err != nil {
+			return nil, err
+		}
+		statement.Payload = statementPayload
+		if session, ok := sessionMap[statement.SessionUID]; ok {
+			session.Statements = append(session.Statements, &statement)
+		}
+	}
+	if err := statementRows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
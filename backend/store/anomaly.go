@@ -75,7 +75,8 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 	}
 
 	var anomaly *AnomalyMessage
-	if len(list) == 0 {
+	created := len(list) == 0
+	if created {
 		anomaly, err = s.createAnomalyImplV2(ctx, tx, principalUID, &AnomalyMessage{
 			InstanceID:  upsert.InstanceID,
 			DatabaseUID: upsert.DatabaseUID,
@@ -102,6 +103,9 @@ func (s *Store) UpsertActiveAnomalyV2(ctx context.Context, principalUID int, ups
 		return nil, err
 	}
 
+	if created && s.anomalyListener != nil {
+		s.anomalyListener.OnAnomalyCreate(ctx, anomaly)
+	}
 	return anomaly, nil
 }
 
@@ -16,7 +16,10 @@ import (
 type AuditLogMethod string
 
 // The methods other than v1 api.
-const AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+const (
+	AuditLogMethodProjectRepositoryPush AuditLogMethod = "bb.project.repository.push"
+	AuditLogMethodIssueRetentionPurge   AuditLogMethod = "bb.issue.retention.purge"
+)
 
 func (m AuditLogMethod) String() string {
 	return string(m)
@@ -0,0 +1,317 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// BackupRunStatus is the status of a backup run.
+type BackupRunStatus string
+
+const (
+	// BackupRunPending is the status of the backup run waiting for the backup runner.
+	BackupRunPending BackupRunStatus = "PENDING"
+	// BackupRunRunning is the status of the running backup run.
+	BackupRunRunning BackupRunStatus = "RUNNING"
+	// BackupRunDone is the status of the backup run uploaded to the storage.
+	BackupRunDone BackupRunStatus = "DONE"
+	// BackupRunFailed is the status of the failed backup run.
+	BackupRunFailed BackupRunStatus = "FAILED"
+	// BackupRunDeleted is the status of the backup run purged from the storage.
+	BackupRunDeleted BackupRunStatus = "DELETED"
+)
+
+// BackupSettingMessage is the message for the backup setting of a database.
+type BackupSettingMessage struct {
+	DatabaseUID int
+	Enabled     bool
+	Payload     *storepb.BackupSettingPayload
+
+	// Output only fields
+	UpdaterID   int
+	UpdatedTime time.Time
+}
+
+// FindBackupSettingMessage is the message for finding backup settings.
+type FindBackupSettingMessage struct {
+	DatabaseUID *int
+	Enabled     *bool
+}
+
+// BackupRunMessage is the message for a backup run of a database.
+type BackupRunMessage struct {
+	DatabaseUID int
+	CreatorID   int
+	Status      BackupRunStatus
+	Payload     *storepb.BackupRunPayload
+
+	// Output only fields
+	UID         int
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+// FindBackupRunMessage is the message for finding backup runs.
+type FindBackupRunMessage struct {
+	UID         *int
+	DatabaseUID *int
+	StatusList  []BackupRunStatus
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdateBackupRunMessage is the message for updating a backup run.
+type UpdateBackupRunMessage struct {
+	UID     int
+	Status  *BackupRunStatus
+	Payload *storepb.BackupRunPayload
+}
+
+// GetBackupSetting gets the backup setting of the database.
+func (s *Store) GetBackupSetting(ctx context.Context, databaseUID int) (*BackupSettingMessage, error) {
+	settings, err := s.ListBackupSettings(ctx, &FindBackupSettingMessage{DatabaseUID: &databaseUID})
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) == 0 {
+		return nil, nil
+	}
+	return settings[0], nil
+}
+
+// ListBackupSettings lists the backup settings.
+func (s *Store) ListBackupSettings(ctx context.Context, find *FindBackupSettingMessage) ([]*BackupSettingMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Enabled; v != nil {
+		where, args = append(where, fmt.Sprintf("enabled = $%d", len(args)+1)), append(args, *v)
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			database_id,
+			enabled,
+			updater_id,
+			updated_ts,
+			payload
+		FROM backup_setting
+		WHERE %s
+		ORDER BY database_id`, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var settings []*BackupSettingMessage
+	for rows.Next() {
+		var setting BackupSettingMessage
+		var updatedTs int64
+		var payload []byte
+		if err := rows.Scan(
+			&setting.DatabaseUID,
+			&setting.Enabled,
+			&setting.UpdaterID,
+			&updatedTs,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		setting.UpdatedTime = time.Unix(updatedTs, 0)
+		settingPayload := &storepb.BackupSettingPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, settingPayload); err != nil {
+			return nil, err
+		}
+		setting.Payload = settingPayload
+		settings = append(settings, &setting)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpsertBackupSetting creates or updates the backup setting of the database.
+func (s *Store) UpsertBackupSetting(ctx context.Context, upsert *BackupSettingMessage, updaterID int) (*BackupSettingMessage, error) {
+	payload, err := protojson.Marshal(upsert.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var updatedTs int64
+	if err := s.db.db.QueryRowContext(ctx, `
+		INSERT INTO backup_setting (
+			database_id,
+			enabled,
+			updater_id,
+			payload
+		)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT(database_id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			updater_id = EXCLUDED.updater_id,
+			updated_ts = extract(epoch from now()),
+			payload = EXCLUDED.payload
+		RETURNING updated_ts
+	`,
+		upsert.DatabaseUID,
+		upsert.Enabled,
+		updaterID,
+		payload,
+	).Scan(&updatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to upsert backup setting")
+	}
+	upsert.UpdaterID = updaterID
+	upsert.UpdatedTime = time.Unix(updatedTs, 0)
+	return upsert, nil
+}
+
+// CreateBackupRun creates a backup run.
+func (s *Store) CreateBackupRun(ctx context.Context, create *BackupRunMessage) (*BackupRunMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+	var createdTs, updatedTs int64
+	if err := s.db.db.QueryRowContext(ctx, `
+		INSERT INTO backup_run (
+			database_id,
+			creator_id,
+			status,
+			payload
+		)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_ts, updated_ts
+	`,
+		create.DatabaseUID,
+		create.CreatorID,
+		create.Status,
+		payload,
+	).Scan(&create.UID, &createdTs, &updatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup run")
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+	create.UpdatedTime = time.Unix(updatedTs, 0)
+	return create, nil
+}
+
+// UpdateBackupRun updates the backup run.
+func (s *Store) UpdateBackupRun(ctx context.Context, update *UpdateBackupRunMessage) error {
+	set, args := []string{"updated_ts = extract(epoch from now())"}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	args = append(args, update.UID)
+
+	if _, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE backup_run
+		SET %s
+		WHERE id = $%d`, strings.Join(set, ", "), len(args)),
+		args...,
+	); err != nil {
+		return errors.Wrapf(err, "failed to update backup run %d", update.UID)
+	}
+	return nil
+}
+
+// ListBackupRuns lists the backup runs, the newest first.
+func (s *Store) ListBackupRuns(ctx context.Context, find *FindBackupRunMessage) ([]*BackupRunMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.StatusList; v != nil {
+		var statusList []string
+		for _, status := range v {
+			statusList = append(statusList, string(status))
+		}
+		where, args = append(where, fmt.Sprintf("status = ANY($%d)", len(args)+1)), append(args, statusList)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			database_id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			status,
+			payload
+		FROM backup_run
+		WHERE %s
+		ORDER BY id DESC`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var backupRuns []*BackupRunMessage
+	for rows.Next() {
+		var backupRun BackupRunMessage
+		var createdTs, updatedTs int64
+		var payload []byte
+		if err := rows.Scan(
+			&backupRun.UID,
+			&backupRun.DatabaseUID,
+			&backupRun.CreatorID,
+			&createdTs,
+			&updatedTs,
+			&backupRun.Status,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		backupRun.CreatedTime = time.Unix(createdTs, 0)
+		backupRun.UpdatedTime = time.Unix(updatedTs, 0)
+		backupRunPayload := &storepb.BackupRunPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, backupRunPayload); err != nil {
+			return nil, err
+		}
+		backupRun.Payload = backupRunPayload
+		backupRuns = append(backupRuns, &backupRun)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return backupRuns, nil
+}
@@ -0,0 +1,35 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// UpsertCalendarFeedTokenHash sets the hash of the calendar feed token of the principal, which revokes the previous token.
+func (s *Store) UpsertCalendarFeedTokenHash(ctx context.Context, principalUID int, tokenHash string) error {
+	if _, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO calendar_feed (principal_id, updated_ts, token_hash)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (principal_id) DO UPDATE SET
+			updated_ts = EXCLUDED.updated_ts,
+			token_hash = EXCLUDED.token_hash`,
+		principalUID, time.Now().Unix(), tokenHash); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetCalendarFeedPrincipalUID returns the principal UID of the calendar feed token hash, or nil if the token is not found.
+func (s *Store) GetCalendarFeedPrincipalUID(ctx context.Context, tokenHash string) (*int, error) {
+	var principalUID int
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT principal_id FROM calendar_feed WHERE token_hash = $1`,
+		tokenHash).Scan(&principalUID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &principalUID, nil
+}
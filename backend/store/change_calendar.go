@@ -0,0 +1,137 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+// ChangeCalendarEventType is the type of a change calendar event.
+type ChangeCalendarEventType string
+
+const (
+	// ChangeCalendarEventScheduledRollout is the event of a stage rollout scheduled at a time.
+	ChangeCalendarEventScheduledRollout ChangeCalendarEventType = "SCHEDULED_ROLLOUT"
+	// ChangeCalendarEventCompletedRollout is the event of a finished stage rollout.
+	ChangeCalendarEventCompletedRollout ChangeCalendarEventType = "COMPLETED_ROLLOUT"
+	// ChangeCalendarEventApprovalDue is the event of an issue approval due at the scheduled rollout time.
+	// It's only used in the calendar feed.
+	ChangeCalendarEventApprovalDue ChangeCalendarEventType = "APPROVAL_DUE"
+)
+
+// ChangeCalendarEventMessage is the message for a change calendar event.
+type ChangeCalendarEventMessage struct {
+	Type          ChangeCalendarEventType
+	ProjectID     string
+	IssueUID      int
+	IssueTitle    string
+	EnvironmentID string
+	StartTime     time.Time
+	EndTime       time.Time
+}
+
+// FindChangeCalendarEventMessage is the message for finding change calendar events.
+type FindChangeCalendarEventMessage struct {
+	ProjectID  *string
+	ProjectIDs *[]string
+	// StartTs and EndTs are the time range [StartTs, EndTs) in unix seconds.
+	StartTs int64
+	EndTs   int64
+}
+
+// ListChangeCalendarEvents lists the scheduled rollouts of the open issues and the completed rollouts per issue stage in the time range.
+func (s *Store) ListChangeCalendarEvents(ctx context.Context, find *FindChangeCalendarEventMessage) ([]*ChangeCalendarEventMessage, error) {
+	where, args := []string{"TRUE"}, []any{find.StartTs, find.EndTs}
+	if v := find.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("project.resource_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ProjectIDs; v != nil {
+		where, args = append(where, fmt.Sprintf("project.resource_id = ANY($%d)", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			'%s',
+			project.resource_id,
+			issue.id,
+			issue.name,
+			environment.resource_id,
+			task.earliest_allowed_ts,
+			task.earliest_allowed_ts
+		FROM task
+		JOIN stage ON stage.id = task.stage_id
+		JOIN environment ON environment.id = stage.environment_id
+		JOIN issue ON issue.pipeline_id = task.pipeline_id
+		JOIN project ON project.id = issue.project_id
+		WHERE issue.status = '%s'
+			AND task.earliest_allowed_ts >= $1 AND task.earliest_allowed_ts < $2
+			AND NOT EXISTS (SELECT 1 FROM task_run WHERE task_run.task_id = task.id AND task_run.status = '%s')
+			AND %s
+		GROUP BY project.resource_id, issue.id, issue.name, environment.resource_id, task.earliest_allowed_ts
+		UNION ALL
+		SELECT
+			'%s',
+			project.resource_id,
+			issue.id,
+			issue.name,
+			environment.resource_id,
+			MIN(CASE WHEN task_run.started_ts > 0 THEN task_run.started_ts ELSE task_run.created_ts END),
+			MAX(task_run.updated_ts)
+		FROM task_run
+		JOIN task ON task.id = task_run.task_id
+		JOIN stage ON stage.id = task.stage_id
+		JOIN environment ON environment.id = stage.environment_id
+		JOIN issue ON issue.pipeline_id = task.pipeline_id
+		JOIN project ON project.id = issue.project_id
+		WHERE task_run.status = '%s'
+			AND task_run.updated_ts >= $1 AND task_run.updated_ts < $2
+			AND %s
+		GROUP BY project.resource_id, issue.id, issue.name, environment.resource_id
+		ORDER BY 6, 3`,
+		ChangeCalendarEventScheduledRollout, api.IssueOpen, api.TaskRunDone, strings.Join(where, " AND "),
+		ChangeCalendarEventCompletedRollout, api.TaskRunDone, strings.Join(where, " AND "),
+	)
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*ChangeCalendarEventMessage
+	for rows.Next() {
+		var event ChangeCalendarEventMessage
+		var startTs, endTs int64
+		if err := rows.Scan(
+			&event.Type,
+			&event.ProjectID,
+			&event.IssueUID,
+			&event.IssueTitle,
+			&event.EnvironmentID,
+			&startTs,
+			&endTs,
+		); err != nil {
+			return nil, err
+		}
+		event.StartTime = time.Unix(startTs, 0)
+		event.EndTime = time.Unix(endTs, 0)
+		events = append(events, &event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
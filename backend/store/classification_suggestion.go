@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// ClassificationSuggestionMessage is the message of a sensitive data classification suggested by the PII detection.
+type ClassificationSuggestionMessage struct {
+	DatabaseUID int
+	Schema      string
+	Table       string
+	Column      string
+	Payload     *storepb.ClassificationSuggestionPayload
+
+	// Output only fields.
+	UID       int
+	CreatedTs int64
+}
+
+// FindClassificationSuggestionMessage is the message for finding classification suggestions.
+type FindClassificationSuggestionMessage struct {
+	UID         *int
+	DatabaseUID *int
+}
+
+// GetClassificationSuggestion gets a classification suggestion.
+func (s *Store) GetClassificationSuggestion(ctx context.Context, find *FindClassificationSuggestionMessage) (*ClassificationSuggestionMessage, error) {
+	suggestions, err := s.ListClassificationSuggestions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(suggestions) == 0 {
+		return nil, nil
+	}
+	if len(suggestions) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d classification suggestions with filter %+v, expect 1", len(suggestions), find)}
+	}
+	return suggestions[0], nil
+}
+
+// ListClassificationSuggestions lists the classification suggestions.
+func (s *Store) ListClassificationSuggestions(ctx context.Context, find *FindClassificationSuggestionMessage) ([]*ClassificationSuggestionMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DatabaseUID; v != nil {
+		where, args = append(where, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			database_id,
+			schema_name,
+			table_name,
+			column_name,
+			payload
+		FROM classification_suggestion
+		WHERE %s
+		ORDER BY schema_name, table_name, column_name
+	`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var suggestions []*ClassificationSuggestionMessage
+	for rows.Next() {
+		suggestion := &ClassificationSuggestionMessage{}
+		var payload []byte
+		if err := rows.Scan(
+			&suggestion.UID,
+			&suggestion.CreatedTs,
+			&suggestion.DatabaseUID,
+			&suggestion.Schema,
+			&suggestion.Table,
+			&suggestion.Column,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		suggestion.Payload = &storepb.ClassificationSuggestionPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, suggestion.Payload); err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return suggestions, nil
+}
+
+// ReplaceClassificationSuggestions replaces the classification suggestions of a database.
+// The suggestions of the columns still suggested keep their creation time.
+func (s *Store) ReplaceClassificationSuggestions(ctx context.Context, databaseUID int, suggestions []*ClassificationSuggestionMessage) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	var schemas, tables, columns []string
+	for _, suggestion := range suggestions {
+		payload, err := protojson.Marshal(suggestion.Payload)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO classification_suggestion (
+				database_id,
+				schema_name,
+				table_name,
+				column_name,
+				payload
+			) VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (database_id, schema_name, table_name, column_name) DO UPDATE SET
+				payload = EXCLUDED.payload
+		`, databaseUID, suggestion.Schema, suggestion.Table, suggestion.Column, payload); err != nil {
+			return errors.Wrapf(err, "failed to upsert classification suggestion")
+		}
+		schemas, tables, columns = append(schemas, suggestion.Schema), append(tables, suggestion.Table), append(columns, suggestion.Column)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM classification_suggestion
+		WHERE database_id = $1 AND (schema_name, table_name, column_name) NOT IN (
+			SELECT * FROM unnest($2::TEXT[], $3::TEXT[], $4::TEXT[])
+		)
+	`, databaseUID, schemas, tables, columns); err != nil {
+		return errors.Wrapf(err, "failed to delete stale classification suggestions")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// DeleteClassificationSuggestion deletes a classification suggestion.
+func (s *Store) DeleteClassificationSuggestion(ctx context.Context, uid int) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM classification_suggestion WHERE id = $1`, uid); err != nil {
+		return errors.Wrapf(err, "failed to delete classification suggestion")
+	}
+	return nil
+}
@@ -52,6 +52,7 @@ type DataSourceMessage struct {
 	ReplicaSet               string
 	DirectConnection         bool
 	Region                   string
+	AWSRoleARN               string
 	AccountID                string
 	WarehouseID              string
 	UseSSL                   bool
@@ -101,6 +102,7 @@ func (m *DataSourceMessage) Copy() *DataSourceMessage {
 		ReplicaSet:                         m.ReplicaSet,
 		DirectConnection:                   m.DirectConnection,
 		Region:                             m.Region,
+		AWSRoleARN:                         m.AWSRoleARN,
 		UseSSL:                             m.UseSSL,
 		RedisType:                          m.RedisType,
 		MasterName:                         m.MasterName,
@@ -148,6 +150,7 @@ type UpdateDataSourceMessage struct {
 	RemoveSASLConfig  bool
 	DirectConnection  *bool
 	Region            *string
+	AWSRoleARN        *string
 	AccountID         *string
 	WarehouseID       *string
 	UseSSL            *bool
@@ -158,7 +161,7 @@ type UpdateDataSourceMessage struct {
 	MasterObfuscatedPassword *string
 }
 
-func (*Store) listInstanceDataSourceMap(ctx context.Context, tx *Tx, find *FindDataSourceMessage) (map[string][]*DataSourceMessage, error) {
+func (s *Store) listInstanceDataSourceMap(ctx context.Context, tx *Tx, find *FindDataSourceMessage) (map[string][]*DataSourceMessage, error) {
 	where, args := []string{"TRUE"}, []any{}
 	if find.ID != nil {
 		where, args = append(where, fmt.Sprintf("data_source.id = $%d", len(args)+1)), append(args, *find.ID)
@@ -240,6 +243,7 @@ func (*Store) listInstanceDataSourceMap(ctx context.Context, tx *Tx, find *FindD
 		dataSourceMessage.ReplicaSet = dataSourceOptions.ReplicaSet
 		dataSourceMessage.DirectConnection = dataSourceOptions.DirectConnection
 		dataSourceMessage.Region = dataSourceOptions.Region
+		dataSourceMessage.AWSRoleARN = dataSourceOptions.AwsRoleArn
 		dataSourceMessage.AccountID = dataSourceOptions.AccountId
 		dataSourceMessage.WarehouseID = dataSourceOptions.WarehouseId
 		dataSourceMessage.UseSSL = dataSourceOptions.UseSsl
@@ -247,6 +251,9 @@ func (*Store) listInstanceDataSourceMap(ctx context.Context, tx *Tx, find *FindD
 		dataSourceMessage.MasterName = dataSourceOptions.MasterName
 		dataSourceMessage.MasterObfuscatedPassword = dataSourceOptions.MasterObfuscatedPassword
 		dataSourceMessage.MasterUsername = dataSourceOptions.MasterUsername
+		if err := s.decryptDataSource(&dataSourceMessage); err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt data source %q", dataSourceMessage.ID)
+		}
 		instanceDataSourcesMap[instanceID] = append(instanceDataSourcesMap[instanceID], &dataSourceMessage)
 	}
 	if err := rows.Err(); err != nil {
@@ -343,6 +350,10 @@ func (s *Store) RemoveDataSourceV2(ctx context.Context, instanceUID int, instanc
 
 // UpdateDataSourceV2 updates a data source and returns the instance.
 func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceMessage) error {
+	patch, err := s.encryptDataSourcePatch(patch)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt data source")
+	}
 	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
 
 	if v := patch.Username; v != nil {
@@ -443,6 +454,9 @@ func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceM
 	if v := patch.Region; v != nil {
 		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('region', $%d::TEXT)", len(args)+1)), append(args, *v)
 	}
+	if v := patch.AWSRoleARN; v != nil {
+		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('awsRoleArn', $%d::TEXT)", len(args)+1)), append(args, *v)
+	}
 	if v := patch.AccountID; v != nil {
 		optionSet, args = append(optionSet, fmt.Sprintf("jsonb_build_object('accountId', $%d::TEXT)", len(args)+1)), append(args, *v)
 	}
@@ -500,7 +514,11 @@ func (s *Store) UpdateDataSourceV2(ctx context.Context, patch *UpdateDataSourceM
 	return nil
 }
 
-func (*Store) addDataSourceToInstanceImplV2(ctx context.Context, tx *Tx, instanceUID, creatorID int, dataSource *DataSourceMessage) error {
+func (s *Store) addDataSourceToInstanceImplV2(ctx context.Context, tx *Tx, instanceUID, creatorID int, dataSource *DataSourceMessage) error {
+	dataSource, err := s.encryptDataSource(dataSource)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt data source")
+	}
 	// We flatten the data source fields in DataSourceMessage, so we need to compose them in store layer before INSERT.
 	dataSourceOptions := storepb.DataSourceOptions{
 		Srv:                                dataSource.SRV,
@@ -520,6 +538,7 @@ func (*Store) addDataSourceToInstanceImplV2(ctx context.Context, tx *Tx, instanc
 		ReplicaSet:                         dataSource.ReplicaSet,
 		DirectConnection:                   dataSource.DirectConnection,
 		Region:                             dataSource.Region,
+		AwsRoleArn:                         dataSource.AWSRoleARN,
 		WarehouseId:                        dataSource.WarehouseID,
 		AccountId:                          dataSource.AccountID,
 		UseSsl:                             dataSource.UseSSL,
@@ -569,3 +588,75 @@ func (*Store) clearDataSourceImpl(ctx context.Context, tx *Tx, instanceID int) e
 	}
 	return nil
 }
+
+// dataSourceSecrets returns the pointers to the credentials which are encrypted at rest.
+func dataSourceSecrets(m *DataSourceMessage) []*string {
+	return []*string{
+		&m.ObfuscatedPassword,
+		&m.ObfuscatedSslCa,
+		&m.ObfuscatedSslCert,
+		&m.ObfuscatedSslKey,
+		&m.SSHObfuscatedPassword,
+		&m.SSHObfuscatedPrivateKey,
+		&m.AuthenticationPrivateKeyObfuscated,
+		&m.MasterObfuscatedPassword,
+	}
+}
+
+func (s *Store) decryptDataSource(dataSource *DataSourceMessage) error {
+	if s.secretCipher == nil {
+		return nil
+	}
+	for _, secret := range dataSourceSecrets(dataSource) {
+		v, err := s.secretCipher.Decrypt(*secret)
+		if err != nil {
+			return err
+		}
+		*secret = v
+	}
+	return nil
+}
+
+// encryptDataSource returns a shallow copy of the data source with the credentials encrypted.
+func (s *Store) encryptDataSource(dataSource *DataSourceMessage) (*DataSourceMessage, error) {
+	if s.secretCipher == nil {
+		return dataSource, nil
+	}
+	encrypted := *dataSource
+	for _, secret := range dataSourceSecrets(&encrypted) {
+		v, err := s.secretCipher.Encrypt(*secret)
+		if err != nil {
+			return nil, err
+		}
+		*secret = v
+	}
+	return &encrypted, nil
+}
+
+// encryptDataSourcePatch returns a shallow copy of the patch with the credentials encrypted.
+func (s *Store) encryptDataSourcePatch(patch *UpdateDataSourceMessage) (*UpdateDataSourceMessage, error) {
+	if s.secretCipher == nil {
+		return patch, nil
+	}
+	encrypted := *patch
+	for _, secret := range []**string{
+		&encrypted.ObfuscatedPassword,
+		&encrypted.ObfuscatedSslCa,
+		&encrypted.ObfuscatedSslCert,
+		&encrypted.ObfuscatedSslKey,
+		&encrypted.SSHObfuscatedPassword,
+		&encrypted.SSHObfuscatedPrivateKey,
+		&encrypted.AuthenticationPrivateKeyObfuscated,
+		&encrypted.MasterObfuscatedPassword,
+	} {
+		if *secret == nil {
+			continue
+		}
+		v, err := s.secretCipher.Encrypt(**secret)
+		if err != nil {
+			return nil, err
+		}
+		*secret = &v
+	}
+	return &encrypted, nil
+}
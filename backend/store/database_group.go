@@ -238,6 +238,7 @@ func (s *Store) UpdateDatabaseGroup(ctx context.Context, updaterPrincipalID int,
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to commit ")
 	}
+	defer tx.Rollback()
 
 	var updatedDatabaseGroup DatabaseGroupMessage
 	var exprBytes, payloadBytes []byte
@@ -259,6 +260,9 @@ func (s *Store) UpdateDatabaseGroup(ctx context.Context, updaterPrincipalID int,
 	); err != nil {
 		return nil, errors.Wrapf(err, "failed to scan")
 	}
+	if err := createDatabaseGroupRevisionImpl(ctx, tx, updatedDatabaseGroup.UID, updaterPrincipalID, updatedDatabaseGroup.Placeholder, exprBytes, payloadBytes); err != nil {
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrapf(err, "failed to commit transaction")
 	}
@@ -323,6 +327,9 @@ func (s *Store) CreateDatabaseGroup(ctx context.Context, creatorPrincipalID int,
 	); err != nil {
 		return nil, errors.Wrapf(err, "failed to scan")
 	}
+	if err := createDatabaseGroupRevisionImpl(ctx, tx, create.UID, creatorPrincipalID, create.Placeholder, exprBytes, payloadBytes); err != nil {
+		return nil, err
+	}
 	if err := tx.Commit(); err != nil {
 		return nil, errors.Wrapf(err, "failed to commit transaction")
 	}
@@ -333,3 +340,101 @@ func (s *Store) CreateDatabaseGroup(ctx context.Context, creatorPrincipalID int,
 	s.databaseGroupIDCache.Add(create.UID, create)
 	return create, nil
 }
+
+// DatabaseGroupRevisionMessage is the store message for a definition of the database group.
+type DatabaseGroupRevisionMessage struct {
+	UID              int
+	DatabaseGroupUID int64
+	CreatorID        int
+	CreatedTime      time.Time
+	Placeholder      string
+	Expression       *expr.Expr
+	Payload          *storepb.DatabaseGroupPayload
+}
+
+// ListDatabaseGroupRevisions lists the revisions of the database group, the newest first.
+func (s *Store) ListDatabaseGroupRevisions(ctx context.Context, databaseGroupUID int64) ([]*DatabaseGroupRevisionMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			id,
+			db_group_id,
+			creator_id,
+			created_ts,
+			placeholder,
+			expression,
+			payload
+		FROM db_group_revision
+		WHERE db_group_id = $1
+		ORDER BY id DESC`,
+		databaseGroupUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*DatabaseGroupRevisionMessage
+	for rows.Next() {
+		var revision DatabaseGroupRevisionMessage
+		var createdTs int64
+		var exprBytes, payloadBytes []byte
+		if err := rows.Scan(
+			&revision.UID,
+			&revision.DatabaseGroupUID,
+			&revision.CreatorID,
+			&createdTs,
+			&revision.Placeholder,
+			&exprBytes,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+		var expression expr.Expr
+		if err := common.ProtojsonUnmarshaler.Unmarshal(exprBytes, &expression); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal expression")
+		}
+		var payload storepb.DatabaseGroupPayload
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payloadBytes, &payload); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal payload")
+		}
+		revision.CreatedTime = time.Unix(createdTs, 0)
+		revision.Expression = &expression
+		revision.Payload = &payload
+		revisions = append(revisions, &revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// createDatabaseGroupRevisionImpl saves the current definition of the database group as a revision.
+func createDatabaseGroupRevisionImpl(ctx context.Context, tx *Tx, databaseGroupUID int64, creatorID int, placeholder string, exprBytes, payloadBytes []byte) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO db_group_revision (
+			db_group_id,
+			creator_id,
+			placeholder,
+			expression,
+			payload
+		)
+		VALUES ($1, $2, $3, $4, $5)`,
+		databaseGroupUID,
+		creatorID,
+		placeholder,
+		exprBytes,
+		payloadBytes,
+	); err != nil {
+		return errors.Wrapf(err, "failed to create database group revision")
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// DBSchemaSnapshotMessage is the message of a full schema snapshot of a database.
+type DBSchemaSnapshotMessage struct {
+	DatabaseUID int
+	Metadata    *storepb.DatabaseSchemaMetadata
+	Schema      string
+
+	// Output only fields.
+	UID       int
+	CreatedTs int64
+}
+
+// CreateDBSchemaSnapshot creates a schema snapshot of the database.
+func (s *Store) CreateDBSchemaSnapshot(ctx context.Context, create *DBSchemaSnapshotMessage) (*DBSchemaSnapshotMessage, error) {
+	metadataBytes, err := protojson.Marshal(create.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	snapshot := &DBSchemaSnapshotMessage{
+		DatabaseUID: create.DatabaseUID,
+		Metadata:    create.Metadata,
+		Schema:      create.Schema,
+	}
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO db_schema_snapshot (
+			database_id,
+			metadata,
+			raw_dump
+		)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_ts
+	`,
+		create.DatabaseUID,
+		metadataBytes,
+		create.Schema,
+	).Scan(
+		&snapshot.UID,
+		&snapshot.CreatedTs,
+	); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// GetDBSchemaSnapshotAsOf gets the latest schema snapshot of the database taken at or before the given timestamp.
+// It returns nil if there is no such snapshot.
+func (s *Store) GetDBSchemaSnapshotAsOf(ctx context.Context, databaseUID int, ts int64) (*DBSchemaSnapshotMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	snapshot := &DBSchemaSnapshotMessage{
+		DatabaseUID: databaseUID,
+	}
+	var metadata []byte
+	if err := tx.QueryRowContext(ctx, `
+		SELECT
+			id,
+			created_ts,
+			metadata,
+			raw_dump
+		FROM db_schema_snapshot
+		WHERE database_id = $1 AND created_ts <= $2
+		ORDER BY created_ts DESC, id DESC
+		LIMIT 1
+	`,
+		databaseUID,
+		ts,
+	).Scan(
+		&snapshot.UID,
+		&snapshot.CreatedTs,
+		&metadata,
+		&snapshot.Schema,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	snapshot.Metadata = &storepb.DatabaseSchemaMetadata{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(metadata, snapshot.Metadata); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal metadata of schema snapshot %d", snapshot.UID)
+	}
+	return snapshot, nil
+}
+
+// DeleteDBSchemaSnapshotsBefore deletes the schema snapshots taken before the given timestamp.
+// The latest snapshot of each database before the timestamp is kept, so that the schema at the timestamp can still be browsed.
+func (s *Store) DeleteDBSchemaSnapshotsBefore(ctx context.Context, ts int64) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM db_schema_snapshot
+		WHERE created_ts < $1 AND id NOT IN (
+			SELECT DISTINCT ON (database_id) id
+			FROM db_schema_snapshot
+			WHERE created_ts < $1
+			ORDER BY database_id, created_ts DESC, id DESC
+		)
+	`, ts)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
@@ -180,6 +180,11 @@ func (s *Store) getDefaultDeploymentConfigV2(ctx context.Context) (*DeploymentCo
 	if err != nil {
 		return nil, err
 	}
+	pipeline, err := s.GetEnvironmentPipelineSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	environmentList = SortEnvironmentsByPipeline(environmentList, pipeline)
 	scheduleList := &Schedule{}
 	for _, environment := range environmentList {
 		scheduleList.Deployments = append(scheduleList.Deployments, &Deployment{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -329,3 +330,66 @@ func listEnvironmentImplV2(ctx context.Context, tx *Tx, find *FindEnvironmentMes
 func convertRowStatusToDeleted(rowStatus string) bool {
 	return rowStatus == string(api.Archived)
 }
+
+// GetEnvironmentPipelineStage returns the environment pipeline stage containing the environment.
+// It returns nil if the environment is not in the pipeline.
+func (s *Store) GetEnvironmentPipelineStage(ctx context.Context, environmentUID int) (*storepb.EnvironmentPipelineSetting_Stage, error) {
+	pipeline, err := s.GetEnvironmentPipelineSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(pipeline.Stages) == 0 {
+		return nil, nil
+	}
+	environment, err := s.GetEnvironmentV2(ctx, &FindEnvironmentMessage{UID: &environmentUID, ShowDeleted: true})
+	if err != nil {
+		return nil, err
+	}
+	if environment == nil {
+		return nil, nil
+	}
+	_, stage := GetEnvironmentPipelineStageIndex(pipeline, environment.ResourceID)
+	return stage, nil
+}
+
+// GetEnvironmentPipelineStageIndex returns the index and the stage of the environment in the pipeline.
+// The environments not in the pipeline are after all the stages, so the index is the number of the stages.
+func GetEnvironmentPipelineStageIndex(pipeline *storepb.EnvironmentPipelineSetting, environmentID string) (int, *storepb.EnvironmentPipelineSetting_Stage) {
+	for i, stage := range pipeline.GetStages() {
+		if slices.Contains(stage.Environments, environmentID) {
+			return i, stage
+		}
+	}
+	return len(pipeline.GetStages()), nil
+}
+
+// SortEnvironmentsByPipeline sorts the environments by the pipeline stages,
+// and the environments not in the pipeline keep the environment order after the stages.
+func SortEnvironmentsByPipeline(environments []*EnvironmentMessage, pipeline *storepb.EnvironmentPipelineSetting) []*EnvironmentMessage {
+	if len(pipeline.GetStages()) == 0 {
+		return environments
+	}
+	positions := make(map[string]int)
+	position := 0
+	for _, stage := range pipeline.Stages {
+		for _, environmentID := range stage.Environments {
+			positions[environmentID] = position
+			position++
+		}
+	}
+	sorted := slices.Clone(environments)
+	slices.SortStableFunc(sorted, func(a, b *EnvironmentMessage) int {
+		pa, oka := positions[a.ResourceID]
+		pb, okb := positions[b.ResourceID]
+		switch {
+		case oka && okb:
+			return pa - pb
+		case oka:
+			return -1
+		case okb:
+			return 1
+		}
+		return 0
+	})
+	return sorted
+}
@@ -0,0 +1,39 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestSortEnvironmentsByPipeline(t *testing.T) {
+	a := require.New(t)
+	environments := []*EnvironmentMessage{
+		{ResourceID: "test"},
+		{ResourceID: "staging"},
+		{ResourceID: "prod-us"},
+		{ResourceID: "prod-eu"},
+		{ResourceID: "sandbox"},
+	}
+	pipeline := &storepb.EnvironmentPipelineSetting{
+		Stages: []*storepb.EnvironmentPipelineSetting_Stage{
+			{Environments: []string{"staging"}},
+			{Environments: []string{"prod-eu", "prod-us"}},
+		},
+	}
+
+	var got []string
+	for _, environment := range SortEnvironmentsByPipeline(environments, pipeline) {
+		got = append(got, environment.ResourceID)
+	}
+	a.Equal([]string{"staging", "prod-eu", "prod-us", "test", "sandbox"}, got)
+
+	index, stage := GetEnvironmentPipelineStageIndex(pipeline, "prod-us")
+	a.Equal(1, index)
+	a.Equal([]string{"prod-eu", "prod-us"}, stage.Environments)
+	index, stage = GetEnvironmentPipelineStageIndex(pipeline, "test")
+	a.Equal(2, index)
+	a.Nil(stage)
+}
@@ -0,0 +1,99 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EventOutboxMessage is the message for a workspace event waiting to be published to an event bus destination.
+type EventOutboxMessage struct {
+	// Destination is the id of the event bus destination.
+	Destination string
+	// Payload is the protobuf encoded v1 workspace event.
+	Payload []byte
+
+	// Output only fields
+	UID         int64
+	CreatedTime time.Time
+}
+
+// FindEventOutboxMessage is the message for finding the event outboxes.
+type FindEventOutboxMessage struct {
+	Destination *string
+	Limit       *int
+}
+
+// CreateEventOutboxes queues the events to publish.
+func (s *Store) CreateEventOutboxes(ctx context.Context, creates []*EventOutboxMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+	var values []string
+	var args []any
+	for _, create := range creates {
+		values = append(values, fmt.Sprintf("($%d, $%d)", len(args)+1, len(args)+2))
+		args = append(args, create.Destination, create.Payload)
+	}
+	if _, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO event_outbox (destination, payload) VALUES %s
+	`, strings.Join(values, ", ")), args...); err != nil {
+		return errors.Wrapf(err, "failed to create event outboxes")
+	}
+	return nil
+}
+
+// ListEventOutboxes lists the queued events in the order of creation.
+func (s *Store) ListEventOutboxes(ctx context.Context, find *FindEventOutboxMessage) ([]*EventOutboxMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.Destination; v != nil {
+		where, args = append(where, fmt.Sprintf("destination = $%d", len(args)+1)), append(args, *v)
+	}
+	query := fmt.Sprintf(`
+		SELECT id, created_ts, destination, payload FROM event_outbox WHERE %s ORDER BY id
+	`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var outboxes []*EventOutboxMessage
+	for rows.Next() {
+		var outbox EventOutboxMessage
+		var createdTs int64
+		if err := rows.Scan(&outbox.UID, &createdTs, &outbox.Destination, &outbox.Payload); err != nil {
+			return nil, err
+		}
+		outbox.CreatedTime = time.Unix(createdTs, 0)
+		outboxes = append(outboxes, &outbox)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return outboxes, nil
+}
+
+// DeleteEventOutboxes deletes the event outboxes.
+func (s *Store) DeleteEventOutboxes(ctx context.Context, uids []int64) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM event_outbox WHERE id = ANY($1)`, uids); err != nil {
+		return errors.Wrapf(err, "failed to delete event outboxes")
+	}
+	return nil
+}
+
+// DeleteEventOutboxesExcept deletes the event outboxes of the destinations not in the list,
+// it's used to clean up the events of the removed destinations.
+func (s *Store) DeleteEventOutboxesExcept(ctx context.Context, destinations []string) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM event_outbox WHERE destination <> ALL($1)`, destinations); err != nil {
+		return errors.Wrapf(err, "failed to delete event outboxes")
+	}
+	return nil
+}
@@ -48,6 +48,8 @@ type ListExternalApprovalMessage struct {
 	// IssueUID is the unique identifier of the issue.
 	IssueUID *int
 	Type     *api.ExternalApprovalType
+	// ProjectUID is the unique identifier of the project of the issues.
+	ProjectUID *int
 }
 
 // CreateExternalApprovalV2 creates an ExternalApproval.
@@ -178,6 +180,9 @@ func (*Store) findExternalApprovalImplV2(ctx context.Context, tx *Tx, find *List
 	if v := find.Type; v != nil {
 		where, args = append(where, fmt.Sprintf("type = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.ProjectUID; v != nil {
+		where, args = append(where, fmt.Sprintf("issue_id IN (SELECT id FROM issue WHERE project_id = $%d)", len(args)+1)), append(args, *v)
+	}
 
 	rows, err := tx.QueryContext(ctx, `
 		SELECT
@@ -799,6 +799,35 @@ func (s *Store) CreatePendingInstanceChangeHistory(ctx context.Context, prevSche
 	return uid, nil
 }
 
+// CreateInstanceChangeHistories creates the instance change histories of a database in order in one transaction.
+// It's used for importing the changes applied by external migration tools.
+func (s *Store) CreateInstanceChangeHistories(ctx context.Context, creates []*InstanceChangeHistoryMessage) ([]string, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var uids []string
+	for _, create := range creates {
+		nextSequence, err := s.getNextInstanceChangeHistorySequence(ctx, tx, create.InstanceUID, create.DatabaseUID)
+		if err != nil {
+			return nil, err
+		}
+		create.Sequence = nextSequence
+		uid, err := s.createInstanceChangeHistoryImpl(ctx, tx, create)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return uids, nil
+}
+
 // ListInstanceChangeHistoryForMigrator finds the instance change history for the migrator,
 // the users are not composed.
 // The sheet_id is not loaded.
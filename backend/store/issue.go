@@ -46,7 +46,9 @@ type IssueMessage struct {
 	TaskStatusCount map[string]int32
 
 	// The following fields are output only and not used for create().
-	UID         int
+	UID int
+	// Number is the sequential number of the issue in the project.
+	Number      int
 	Creator     *UserMessage
 	CreatedTime time.Time
 	Updater     *UserMessage
@@ -69,14 +71,20 @@ type UpdateIssueMessage struct {
 	// PayloadUpsert upserts the presented top-level keys.
 	PayloadUpsert *storepb.IssuePayload
 	RemoveLabels  bool
-	Subscribers   *[]*UserMessage
+	// RemoveSubscriberGroups removes all group subscribers.
+	RemoveSubscriberGroups bool
+	Subscribers            *[]*UserMessage
+	// RemoveRelease detaches the issue from the release.
+	RemoveRelease bool
 
 	PipelineUID *int
 }
 
 // FindIssueMessage is the message to find issues.
 type FindIssueMessage struct {
-	UID        *int
+	UID *int
+	// Number is the sequential number of the issue in the project, used together with ProjectID.
+	Number     *int
 	ProjectID  *string
 	ProjectIDs *[]string
 	PlanUID    *int64
@@ -102,6 +110,8 @@ type FindIssueMessage struct {
 	Query *string
 
 	LabelList []string
+	// The resource ID of the release in the project.
+	ReleaseID *string
 
 	NoPipeline bool
 }
@@ -159,6 +169,7 @@ func (s *Store) CreateIssueV2(ctx context.Context, create *IssueMessage, creator
 			project_id,
 			pipeline_id,
 			plan_id,
+			number,
 			name,
 			status,
 			type,
@@ -166,8 +177,8 @@ func (s *Store) CreateIssueV2(ctx context.Context, create *IssueMessage, creator
 			payload,
 			ts_vector
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-		RETURNING id, created_ts, updated_ts
+		VALUES ($1, $2, $3, $4, $5, (SELECT COALESCE(MAX(number), 0) + 1 FROM issue WHERE project_id = $3), $6, $7, $8, $9, $10, $11)
+		RETURNING id, number, created_ts, updated_ts
 	`
 
 	tx, err := s.db.BeginTx(ctx, nil)
@@ -176,6 +187,10 @@ func (s *Store) CreateIssueV2(ctx context.Context, create *IssueMessage, creator
 	}
 	defer tx.Rollback()
 
+	// Lock the project row to serialize the issue number generation in the project.
+	if _, err := tx.ExecContext(ctx, "SELECT 1 FROM project WHERE id = $1 FOR UPDATE", create.Project.UID); err != nil {
+		return nil, err
+	}
 	if err := tx.QueryRowContext(ctx, query,
 		creatorID,
 		creatorID,
@@ -190,6 +205,7 @@ func (s *Store) CreateIssueV2(ctx context.Context, create *IssueMessage, creator
 		tsVector,
 	).Scan(
 		&create.UID,
+		&create.Number,
 		&create.createdTs,
 		&create.updatedTs,
 	); err != nil {
@@ -234,14 +250,25 @@ func (s *Store) UpdateIssueV2(ctx context.Context, uid int, patch *UpdateIssueMe
 	if v := patch.Description; v != nil {
 		set, args = append(set, fmt.Sprintf("description = $%d", len(args)+1)), append(args, *v)
 	}
+	var payloadUpdates []string
 	if v := patch.PayloadUpsert; v != nil {
 		p, err := protojson.Marshal(v)
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to marshal patch.PayloadUpsert")
 		}
-		set, args = append(set, fmt.Sprintf("payload = payload || $%d", len(args)+1)), append(args, p)
-	} else if patch.RemoveLabels {
-		set, args = append(set, fmt.Sprintf("payload = payload || jsonb_build_object('labels', $%d::JSONB)", len(args)+1)), append(args, nil)
+		payloadUpdates, args = append(payloadUpdates, fmt.Sprintf("$%d", len(args)+1)), append(args, p)
+	}
+	if patch.RemoveLabels {
+		payloadUpdates, args = append(payloadUpdates, fmt.Sprintf("jsonb_build_object('labels', $%d::JSONB)", len(args)+1)), append(args, nil)
+	}
+	if patch.RemoveSubscriberGroups {
+		payloadUpdates, args = append(payloadUpdates, fmt.Sprintf("jsonb_build_object('subscriberGroups', $%d::JSONB)", len(args)+1)), append(args, nil)
+	}
+	if patch.RemoveRelease {
+		payloadUpdates, args = append(payloadUpdates, fmt.Sprintf("jsonb_build_object('release', $%d::JSONB)", len(args)+1)), append(args, nil)
+	}
+	if len(payloadUpdates) > 0 {
+		set = append(set, fmt.Sprintf("payload = payload || %s", strings.Join(payloadUpdates, " || ")))
 	}
 
 	if patch.Title != nil || patch.Description != nil {
@@ -373,6 +400,9 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 	if v := find.UID; v != nil {
 		where, args = append(where, fmt.Sprintf("issue.id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.Number; v != nil {
+		where, args = append(where, fmt.Sprintf("issue.number = $%d", len(args)+1)), append(args, *v)
+	}
 	if v := find.PipelineID; v != nil {
 		where, args = append(where, fmt.Sprintf("issue.pipeline_id = $%d", len(args)+1)), append(args, *v)
 	}
@@ -438,6 +468,9 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 		where = append(where, fmt.Sprintf("payload->'labels' ?& $%d::TEXT[]", len(args)+1))
 		args = append(args, find.LabelList)
 	}
+	if v := find.ReleaseID; v != nil {
+		where, args = append(where, fmt.Sprintf("issue.payload->>'release' = $%d", len(args)+1)), append(args, *v)
+	}
 	if find.NoPipeline {
 		where = append(where, "issue.pipeline_id IS NULL")
 	}
@@ -459,6 +492,7 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 		issue.project_id,
 		issue.pipeline_id,
 		issue.plan_id,
+		issue.number,
 		issue.name,
 		issue.status,
 		issue.type,
@@ -517,6 +551,7 @@ func (s *Store) ListIssueV2(ctx context.Context, find *FindIssueMessage) ([]*Iss
 			&issue.projectUID,
 			&issue.PipelineUID,
 			&issue.PlanUID,
+			&issue.Number,
 			&issue.Title,
 			&issue.Status,
 			&issue.Type,
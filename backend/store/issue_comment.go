@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -20,11 +22,24 @@ type IssueCommentMessage struct {
 	IssueUID  int
 	Payload   *storepb.IssueCommentPayload
 	Creator   *UserMessage
+	// Edited is true if the comment has revisions.
+	Edited bool
+	// Deleted is true if the comment is soft deleted, the comment of the tombstone is kept in the revisions.
+	Deleted bool
 
 	creatorUID int
 	updaterUID int
 }
 
+// IssueCommentRevisionMessage is a previous comment of the issue comment.
+type IssueCommentRevisionMessage struct {
+	UID            int
+	IssueCommentID int
+	CreatedTs      int64
+	Comment        string
+	Creator        *UserMessage
+}
+
 type FindIssueCommentMessage struct {
 	UID      *int
 	IssueUID *int
@@ -77,12 +92,14 @@ func (s *Store) ListIssueComment(ctx context.Context, find *FindIssueCommentMess
 	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
 			id,
+			row_status,
 			creator_id,
 			created_ts,
 			updater_id,
 			updated_ts,
 			issue_id,
-			payload
+			payload,
+			EXISTS (SELECT 1 FROM issue_comment_revision WHERE issue_comment_revision.issue_comment_id = issue_comment.id)
 		FROM
 			issue_comment
 		WHERE %s
@@ -99,18 +116,22 @@ func (s *Store) ListIssueComment(ctx context.Context, find *FindIssueCommentMess
 		ic := IssueCommentMessage{
 			Payload: &storepb.IssueCommentPayload{},
 		}
+		var rowStatus string
 		var p []byte
 		if err := rows.Scan(
 			&ic.UID,
+			&rowStatus,
 			&ic.creatorUID,
 			&ic.CreatedTs,
 			&ic.updaterUID,
 			&ic.UpdatedTs,
 			&ic.IssueUID,
 			&p,
+			&ic.Edited,
 		); err != nil {
 			return nil, errors.Wrapf(err, "failed to scan")
 		}
+		ic.Deleted = rowStatus == string(api.Archived)
 		if err := common.ProtojsonUnmarshaler.Unmarshal(p, ic.Payload); err != nil {
 			return nil, errors.Wrapf(err, "failed to unmarshal")
 		}
@@ -194,16 +215,131 @@ func (s *Store) CreateIssueComment(ctx context.Context, create *IssueCommentMess
 	return ic, nil
 }
 
+// UpdateIssueComment updates the issue comment, the previous comment is recorded as a revision if it's changed.
 func (s *Store) UpdateIssueComment(ctx context.Context, patch *UpdateIssueCommentMessage) error {
-	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
+	if v := patch.Comment; v != nil {
+		if err := recordIssueCommentRevision(ctx, tx, patch.UID, v); err != nil {
+			return err
+		}
+	}
+
+	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
 	if v := patch.Comment; v != nil {
 		set, args = append(set, fmt.Sprintf("payload = payload || jsonb_build_object('comment',$%d::TEXT)", len(args)+1)), append(args, *v)
 	}
 	args = append(args, patch.UID)
 	query := `UPDATE issue_comment SET ` + strings.Join(set, ", ") + fmt.Sprintf(` WHERE id = $%d`, len(args))
-	if _, err := s.db.db.ExecContext(ctx, query, args...); err != nil {
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
 		return errors.Wrapf(err, "failed to update issue comment")
 	}
+	return tx.Commit()
+}
+
+// DeleteIssueComment soft deletes the issue comment, the comment is moved to the revisions and a tombstone is left.
+func (s *Store) DeleteIssueComment(ctx context.Context, uid int, updaterID int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	empty := ""
+	if err := recordIssueCommentRevision(ctx, tx, uid, &empty); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE issue_comment
+		SET row_status = $1, updater_id = $2, updated_ts = $3, payload = payload || jsonb_build_object('comment', ''::TEXT)
+		WHERE id = $4
+	`, api.Archived, updaterID, time.Now().Unix(), uid); err != nil {
+		return errors.Wrapf(err, "failed to delete issue comment")
+	}
+	return tx.Commit()
+}
+
+// recordIssueCommentRevision records the current comment as a revision if it's different from the new comment.
+func recordIssueCommentRevision(ctx context.Context, tx *Tx, uid int, comment *string) error {
+	var updaterID int
+	var updatedTs int64
+	var current string
+	if err := tx.QueryRowContext(ctx, `
+		SELECT updater_id, updated_ts, COALESCE(payload->>'comment', '')
+		FROM issue_comment
+		WHERE id = $1
+		FOR UPDATE
+	`, uid).Scan(&updaterID, &updatedTs, &current); err != nil {
+		if err == sql.ErrNoRows {
+			return &common.Error{Code: common.NotFound, Err: errors.Errorf("issue comment %d not found", uid)}
+		}
+		return errors.Wrapf(err, "failed to get issue comment")
+	}
+	if current == *comment {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO issue_comment_revision (
+			issue_comment_id,
+			creator_id,
+			created_ts,
+			comment
+		) VALUES ($1, $2, $3, $4)
+	`, uid, updaterID, updatedTs, current); err != nil {
+		return errors.Wrapf(err, "failed to create issue comment revision")
+	}
 	return nil
 }
+
+// ListIssueCommentRevisions lists the revisions of the issue comment, the latest first.
+func (s *Store) ListIssueCommentRevisions(ctx context.Context, issueCommentID int) ([]*IssueCommentRevisionMessage, error) {
+	rows, err := s.db.db.QueryContext(ctx, `
+		SELECT
+			id,
+			issue_comment_id,
+			creator_id,
+			created_ts,
+			comment
+		FROM issue_comment_revision
+		WHERE issue_comment_id = $1
+		ORDER BY id DESC
+	`, issueCommentID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query context")
+	}
+	defer rows.Close()
+
+	var revisions []*IssueCommentRevisionMessage
+	var creatorIDs []int
+	for rows.Next() {
+		revision := &IssueCommentRevisionMessage{}
+		var creatorID int
+		if err := rows.Scan(
+			&revision.UID,
+			&revision.IssueCommentID,
+			&creatorID,
+			&revision.CreatedTs,
+			&revision.Comment,
+		); err != nil {
+			return nil, errors.Wrapf(err, "failed to scan")
+		}
+		revisions = append(revisions, revision)
+		creatorIDs = append(creatorIDs, creatorID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(err, "rows err")
+	}
+
+	for i, revision := range revisions {
+		creator, err := s.GetUserByID(ctx, creatorIDs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get creator")
+		}
+		revision.Creator = creator
+	}
+	return revisions, nil
+}
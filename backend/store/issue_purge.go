@@ -0,0 +1,165 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+)
+
+// FindIssuesToPurgeMessage is the message for finding the issues to purge.
+type FindIssuesToPurgeMessage struct {
+	// CreatedBefore is the unix seconds, the closed issues created before it are purged.
+	CreatedBefore int64
+	ProjectID     *string
+}
+
+// IssueToPurgeMessage is an issue to purge.
+type IssueToPurgeMessage struct {
+	UID       int
+	ProjectID string
+}
+
+// IssuePurgeResult is the result of purging issues.
+type IssuePurgeResult struct {
+	IssueCount   int64
+	CommentCount int64
+	TaskRunCount int64
+	SheetCount   int64
+}
+
+// ListIssueUIDsToPurge lists the UIDs and the project IDs of the done or canceled issues created before the time.
+// The open issues are never purged.
+func (s *Store) ListIssueUIDsToPurge(ctx context.Context, find *FindIssuesToPurgeMessage) ([]*IssueToPurgeMessage, error) {
+	where, args := []string{"issue.status != $1", "issue.created_ts < $2"}, []any{api.IssueOpen, find.CreatedBefore}
+	if v := find.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("project.resource_id = $%d", len(args)+1)), append(args, *v)
+	}
+	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT issue.id, project.resource_id
+		FROM issue
+		JOIN project ON project.id = issue.project_id
+		WHERE %s
+		ORDER BY issue.id`, strings.Join(where, " AND ")),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var issues []*IssueToPurgeMessage
+	for rows.Next() {
+		issue := &IssueToPurgeMessage{}
+		if err := rows.Scan(&issue.UID, &issue.ProjectID); err != nil {
+			return nil, err
+		}
+		issues = append(issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// PurgeIssues hard deletes the issues with their comments, subscribers, legacy activities, plans, rollouts and the sheets
+// only used by the rollouts. The change histories of the issues are kept but unlinked from the issues.
+// Nothing is deleted in the dry run, and the result reports what would be removed.
+func (s *Store) PurgeIssues(ctx context.Context, issueUIDs []int, dryRun bool) (*IssuePurgeResult, error) {
+	result := &IssuePurgeResult{}
+	if len(issueUIDs) == 0 {
+		return result, nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TEMP TABLE purge_issue ON COMMIT DROP AS
+		SELECT id, plan_id, pipeline_id FROM issue WHERE id = ANY($1) AND status != $2
+	`, issueUIDs, api.IssueOpen); err != nil {
+		return nil, errors.Wrapf(err, "failed to find issues")
+	}
+	// The sheets of the tasks, which are not used by the other rollouts, the change histories and the changelists.
+	if _, err := tx.ExecContext(ctx, `
+		CREATE TEMP TABLE purge_sheet ON COMMIT DROP AS
+		SELECT DISTINCT (task.payload->>'sheetId')::INT AS id
+		FROM task
+		WHERE task.pipeline_id IN (SELECT pipeline_id FROM purge_issue) AND task.payload ? 'sheetId'
+	`); err != nil {
+		return nil, errors.Wrapf(err, "failed to find sheets")
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM purge_sheet
+		WHERE EXISTS (
+			SELECT 1 FROM task
+			WHERE (task.payload->>'sheetId')::INT = purge_sheet.id
+			AND task.pipeline_id NOT IN (SELECT pipeline_id FROM purge_issue WHERE pipeline_id IS NOT NULL)
+		)
+		OR EXISTS (SELECT 1 FROM instance_change_history WHERE instance_change_history.sheet_id = purge_sheet.id)
+		OR EXISTS (
+			SELECT 1 FROM changelist, jsonb_array_elements(changelist.payload->'changes') AS change
+			WHERE change->>'sheet' LIKE '%/sheets/' || purge_sheet.id
+		)
+	`); err != nil {
+		return nil, errors.Wrapf(err, "failed to filter sheets")
+	}
+
+	statements := []struct {
+		query string
+		count *int64
+	}{
+		{query: `DELETE FROM task_run_statement WHERE task_run_id IN (SELECT task_run.id FROM task_run JOIN task ON task.id = task_run.task_id WHERE task.pipeline_id IN (SELECT pipeline_id FROM purge_issue))`},
+		{query: `DELETE FROM task_run_log_file WHERE task_run_id IN (SELECT task_run.id FROM task_run JOIN task ON task.id = task_run.task_id WHERE task.pipeline_id IN (SELECT pipeline_id FROM purge_issue))`},
+		{query: `DELETE FROM task_run_log WHERE task_run_id IN (SELECT task_run.id FROM task_run JOIN task ON task.id = task_run.task_id WHERE task.pipeline_id IN (SELECT pipeline_id FROM purge_issue))`},
+		{query: `DELETE FROM task_run WHERE task_id IN (SELECT id FROM task WHERE pipeline_id IN (SELECT pipeline_id FROM purge_issue))`, count: &result.TaskRunCount},
+		{query: `DELETE FROM task_dag WHERE from_task_id IN (SELECT id FROM task WHERE pipeline_id IN (SELECT pipeline_id FROM purge_issue)) OR to_task_id IN (SELECT id FROM task WHERE pipeline_id IN (SELECT pipeline_id FROM purge_issue))`},
+		{query: `DELETE FROM task WHERE pipeline_id IN (SELECT pipeline_id FROM purge_issue)`},
+		{query: `DELETE FROM stage_approval WHERE stage_id IN (SELECT id FROM stage WHERE pipeline_id IN (SELECT pipeline_id FROM purge_issue))`},
+		{query: `DELETE FROM stage WHERE pipeline_id IN (SELECT pipeline_id FROM purge_issue)`},
+		{query: `UPDATE instance_change_history SET issue_id = NULL WHERE issue_id IN (SELECT id FROM purge_issue)`},
+		{query: `DELETE FROM issue_comment_revision WHERE issue_comment_id IN (SELECT id FROM issue_comment WHERE issue_id IN (SELECT id FROM purge_issue))`},
+		{query: `DELETE FROM issue_comment WHERE issue_id IN (SELECT id FROM purge_issue)`, count: &result.CommentCount},
+		{query: `DELETE FROM issue_subscriber WHERE issue_id IN (SELECT id FROM purge_issue)`},
+		{query: `DELETE FROM external_approval WHERE issue_id IN (SELECT id FROM purge_issue)`},
+		{query: `DELETE FROM activity WHERE container_id IN (SELECT id FROM purge_issue) AND (type LIKE 'bb.issue.%' OR type LIKE 'bb.pipeline.%')`},
+		{query: `DELETE FROM issue WHERE id IN (SELECT id FROM purge_issue)`, count: &result.IssueCount},
+		{query: `DELETE FROM plan_check_run WHERE plan_id IN (SELECT plan_id FROM purge_issue)`},
+		{query: `DELETE FROM plan WHERE id IN (SELECT plan_id FROM purge_issue)`},
+		{query: `DELETE FROM pipeline WHERE id IN (SELECT pipeline_id FROM purge_issue)`},
+		{query: `DELETE FROM sheet WHERE id IN (SELECT id FROM purge_sheet)`, count: &result.SheetCount},
+	}
+	for _, statement := range statements {
+		sqlResult, err := tx.ExecContext(ctx, statement.query)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to execute %q", statement.query)
+		}
+		if statement.count != nil {
+			count, err := sqlResult.RowsAffected()
+			if err != nil {
+				return nil, err
+			}
+			*statement.count = count
+		}
+	}
+
+	if dryRun {
+		return result, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	// The purged issues, rollouts and sheets may be cached.
+	s.issueCache.Purge()
+	s.issueByPipelineCache.Purge()
+	s.pipelineCache.Purge()
+	s.sheetCache.Purge()
+	s.sheetStatementCache.Purge()
+	return result, nil
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 
@@ -51,7 +53,16 @@ func GetConnectionConfig(pgURL string) (dbdriver.ConnectionConfig, error) {
 	if connCfg.Username == "" {
 		return dbdriver.ConnectionConfig{}, errors.Errorf("missing user in the --pg connection string")
 	}
-	if host, port, err := net.SplitHostPort(u.Host); err != nil {
+	if strings.Contains(u.Host, ",") {
+		// The HA setups list the hosts of all nodes, e.g. postgresql://bb@node1:5432,node2:5432/bytebase?target_session_attrs=read-write,
+		// the connection is established to the first node that satisfies the target_session_attrs.
+		hosts, ports, err := parseMultiHosts(u.Host)
+		if err != nil {
+			return dbdriver.ConnectionConfig{}, err
+		}
+		connCfg.Host = strings.Join(hosts, ",")
+		connCfg.Port = strings.Join(ports, ",")
+	} else if host, port, err := net.SplitHostPort(u.Host); err != nil {
 		connCfg.Host = u.Host
 	} else {
 		// There is a hack. PostgreSQL document(https://www.postgresql.org/docs/14/libpq-connect.html)
@@ -86,5 +97,35 @@ func GetConnectionConfig(pgURL string) (dbdriver.ConnectionConfig, error) {
 		SslKey:  q.Get("sslkey"),
 		SslCert: q.Get("sslcert"),
 	}
+	connCfg.TargetSessionAttrs = q.Get("target_session_attrs")
+	// Use prefer_simple_protocol=true for PgBouncer in the transaction or statement pooling mode,
+	// which doesn't support the prepared statements.
+	if v := q.Get("prefer_simple_protocol"); v != "" {
+		preferSimpleProtocol, err := strconv.ParseBool(v)
+		if err != nil {
+			return dbdriver.ConnectionConfig{}, errors.Wrapf(err, "invalid prefer_simple_protocol %q", v)
+		}
+		connCfg.PreferSimpleProtocol = preferSimpleProtocol
+	}
 	return connCfg, nil
 }
+
+// parseMultiHosts parses the comma separated host[:port] list, the port defaults to 5432.
+func parseMultiHosts(hostList string) ([]string, []string, error) {
+	var hosts, ports []string
+	for _, hostPort := range strings.Split(hostList, ",") {
+		if hostPort == "" {
+			return nil, nil, errors.Errorf("empty host in %q", hostList)
+		}
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			host, port = hostPort, "5432"
+		}
+		if host == "" {
+			return nil, nil, errors.Errorf("empty host in %q", hostList)
+		}
+		hosts = append(hosts, host)
+		ports = append(ports, port)
+	}
+	return hosts, ports, nil
+}
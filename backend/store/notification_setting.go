@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// EmailNotificationMessage is the message for an email notification waiting to be sent.
+type EmailNotificationMessage struct {
+	PrincipalUID int
+	Payload      *storepb.EmailNotification
+
+	// Output only fields
+	UID         int64
+	CreatedTime time.Time
+}
+
+// GetNotificationSetting gets the notification setting of a user, an empty setting is returned if not found.
+func (s *Store) GetNotificationSetting(ctx context.Context, principalUID int) (*storepb.NotificationSetting, error) {
+	var payload []byte
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT payload FROM principal_notification_setting WHERE principal_id = $1
+	`, principalUID).Scan(&payload); err != nil {
+		if err == sql.ErrNoRows {
+			return &storepb.NotificationSetting{}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get notification setting")
+	}
+	setting := &storepb.NotificationSetting{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, setting); err != nil {
+		return nil, err
+	}
+	return setting, nil
+}
+
+// ListNotificationSettings lists the notification settings of the users, keyed by the principal ID.
+func (s *Store) ListNotificationSettings(ctx context.Context, principalUIDs []int) (map[int]*storepb.NotificationSetting, error) {
+	rows, err := s.db.db.QueryContext(ctx, `
+		SELECT principal_id, payload FROM principal_notification_setting WHERE principal_id = ANY($1)
+	`, principalUIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	settings := make(map[int]*storepb.NotificationSetting)
+	for rows.Next() {
+		var principalUID int
+		var payload []byte
+		if err := rows.Scan(&principalUID, &payload); err != nil {
+			return nil, err
+		}
+		setting := &storepb.NotificationSetting{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, setting); err != nil {
+			return nil, err
+		}
+		settings[principalUID] = setting
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// UpsertNotificationSetting creates or updates the notification setting of a user.
+func (s *Store) UpsertNotificationSetting(ctx context.Context, principalUID int, setting *storepb.NotificationSetting) error {
+	payload, err := protojson.Marshal(setting)
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO principal_notification_setting (principal_id, payload) VALUES ($1, $2)
+		ON CONFLICT (principal_id) DO UPDATE SET
+			updated_ts = extract(epoch from now()),
+			payload = EXCLUDED.payload
+	`, principalUID, payload); err != nil {
+		return errors.Wrapf(err, "failed to upsert notification setting")
+	}
+	return nil
+}
+
+// CreateEmailNotifications queues the email notifications.
+func (s *Store) CreateEmailNotifications(ctx context.Context, creates []*EmailNotificationMessage) error {
+	if len(creates) == 0 {
+		return nil
+	}
+	var values []string
+	var args []any
+	for _, create := range creates {
+		payload, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return err
+		}
+		values = append(values, fmt.Sprintf("($%d, $%d)", len(args)+1, len(args)+2))
+		args = append(args, create.PrincipalUID, payload)
+	}
+	if _, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`
+		INSERT INTO email_notification (principal_id, payload) VALUES %s
+	`, strings.Join(values, ", ")), args...); err != nil {
+		return errors.Wrapf(err, "failed to create email notifications")
+	}
+	return nil
+}
+
+// ListEmailNotifications lists all the queued email notifications in the order of creation.
+func (s *Store) ListEmailNotifications(ctx context.Context) ([]*EmailNotificationMessage, error) {
+	rows, err := s.db.db.QueryContext(ctx, `
+		SELECT id, created_ts, principal_id, payload FROM email_notification ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*EmailNotificationMessage
+	for rows.Next() {
+		var notification EmailNotificationMessage
+		var createdTs int64
+		var payload []byte
+		if err := rows.Scan(&notification.UID, &createdTs, &notification.PrincipalUID, &payload); err != nil {
+			return nil, err
+		}
+		notification.Payload = &storepb.EmailNotification{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, notification.Payload); err != nil {
+			return nil, err
+		}
+		notification.CreatedTime = time.Unix(createdTs, 0)
+		notifications = append(notifications, &notification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+// DeleteEmailNotifications deletes the email notifications.
+func (s *Store) DeleteEmailNotifications(ctx context.Context, uids []int64) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM email_notification WHERE id = ANY($1)`, uids); err != nil {
+		return errors.Wrapf(err, "failed to delete email notifications")
+	}
+	return nil
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"github.com/cenkalti/backoff/v4"
 	"github.com/pkg/errors"
 
 	dbdriver "github.com/bytebase/bytebase/backend/plugin/db"
@@ -13,6 +16,17 @@ import (
 	// Register postgres driver.
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+const (
+	// metadbConnectTimeout is how long to retry connecting to the metadata database, e.g. while the HA setup is failing over.
+	metadbConnectTimeout = 5 * time.Minute
+	// The connections are recycled so that the connections to the old primary are replaced after the failover.
+	metadbConnMaxLifetime = 30 * time.Minute
+	metadbConnMaxIdleTime = 5 * time.Minute
+	// migrationLockKey is the key of the advisory lock held while migrating the metadata schema, it's "bytebase" in hex.
+	migrationLockKey int64 = 0x6279746562617365
 )
 
 // DB represents the metdata database connection.
@@ -68,12 +82,7 @@ func (db *DB) Open(ctx context.Context, createDB bool) error {
 		}
 	}
 
-	metadataDriver, err := dbdriver.Open(
-		ctx,
-		storepb.Engine_POSTGRES,
-		dbdriver.DriverConfig{DbBinDir: db.binDir},
-		db.ConnCfg,
-	)
+	metadataDriver, err := db.connect(ctx)
 	if err != nil {
 		return err
 	}
@@ -95,9 +104,71 @@ func (db *DB) Open(ctx context.Context, createDB bool) error {
 		maxOpenConns = 50
 	}
 	db.db.SetMaxOpenConns(maxOpenConns)
+	db.db.SetConnMaxLifetime(metadbConnMaxLifetime)
+	db.db.SetConnMaxIdleTime(metadbConnMaxIdleTime)
 	return nil
 }
 
+// connect connects to the metadata database with the exponential backoff,
+// so that the server starts after the HA setup finishes failing over instead of exiting.
+func (db *DB) connect(ctx context.Context) (dbdriver.Driver, error) {
+	var metadataDriver dbdriver.Driver
+	b := backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(time.Second),
+		backoff.WithMaxInterval(30*time.Second),
+		backoff.WithMaxElapsedTime(metadbConnectTimeout),
+	)
+	if err := backoff.RetryNotify(func() error {
+		driver, err := dbdriver.Open(
+			ctx,
+			storepb.Engine_POSTGRES,
+			dbdriver.DriverConfig{DbBinDir: db.binDir},
+			db.ConnCfg,
+		)
+		if err != nil {
+			return err
+		}
+		if err := driver.GetDB().PingContext(ctx); err != nil {
+			driver.Close(ctx)
+			return err
+		}
+		metadataDriver = driver
+		return nil
+	}, backoff.WithContext(b, ctx), func(err error, next time.Duration) {
+		slog.Warn("failed to connect to the metadata database, retrying", slog.Duration("backoff", next), log.BBError(err))
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to connect to the metadata database")
+	}
+	return metadataDriver, nil
+}
+
+// WithMigrationLock runs f while holding the advisory lock of the metadata schema migration,
+// so that the replicas starting at the same time don't race on the upgrade.
+// The lock is bound to a transaction instead of the session, so it also works through PgBouncer in the transaction pooling mode.
+func (db *DB) WithMigrationLock(ctx context.Context, f func() error) error {
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction for the migration lock")
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.QueryRowContext(ctx, `SELECT pg_try_advisory_xact_lock($1)`, migrationLockKey).Scan(&locked); err != nil {
+		return errors.Wrapf(err, "failed to acquire the migration lock")
+	}
+	if !locked {
+		slog.Info("Waiting for another Bytebase server to finish migrating the metadata database...")
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, migrationLockKey); err != nil {
+			return errors.Wrapf(err, "failed to acquire the migration lock")
+		}
+	}
+
+	if err := f(); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 // Close closes the database connection.
 func (db *DB) Close(ctx context.Context) error {
 	return db.metadataDriver.Close(ctx)
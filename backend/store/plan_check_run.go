@@ -29,6 +29,14 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabaseStatementConflict is the plan check type for conflicting changes in other open issues.
+	PlanCheckDatabaseStatementConflict PlanCheckRunType = "bb.plan-check.database.statement.conflict"
+	// PlanCheckDatabaseStatementSimulate is the plan check type for applying the change to a scratch clone of the database.
+	PlanCheckDatabaseStatementSimulate PlanCheckRunType = "bb.plan-check.database.statement.simulate"
+	// PlanCheckDatabaseGrantPreview is the plan check type for previewing the privilege changes of the grant statements.
+	PlanCheckDatabaseGrantPreview PlanCheckRunType = "bb.plan-check.database.grant.preview"
+	// PlanCheckDatabaseStatementDryRun is the plan check type for validating the statements and estimating the cost with dry runs.
+	PlanCheckDatabaseStatementDryRun PlanCheckRunType = "bb.plan-check.database.statement.dry-run"
 )
 
 // PlanCheckRunStatus is the status of a plan check run.
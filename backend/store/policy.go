@@ -129,6 +129,15 @@ func (s *Store) getIamPolicy(ctx context.Context, find *FindPolicyMessage) (*Iam
 }
 
 func (s *Store) GetRolloutPolicy(ctx context.Context, environmentID int) (*storepb.RolloutPolicy, error) {
+	// The rollout policy of the environment pipeline stage takes precedence.
+	pipelineStage, err := s.GetEnvironmentPipelineStage(ctx, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	if pipelineStage.GetRolloutPolicy() != nil {
+		return pipelineStage.GetRolloutPolicy(), nil
+	}
+
 	resourceType := api.PolicyResourceTypeEnvironment
 	pType := api.PolicyTypeRollout
 	policy, err := s.GetPolicyV2(ctx, &FindPolicyMessage{
@@ -53,6 +53,11 @@ type FindQueryHistoryMessage struct {
 	// Database is database resource name like instances/{instance}/databases/{database}.
 	Database *string
 	Type     *QueryHistoryType
+	// CreatedTsAfter and CreatedTsBefore find the histories created in the range, inclusive.
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+	// Query finds the histories whose statement contains the text case-insensitively.
+	Query *string
 
 	Limit  *int
 	Offset *int
@@ -124,6 +129,15 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 	if v := find.Type; v != nil {
 		where, args = append(where, fmt.Sprintf("query_history.type = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := find.CreatedTsAfter; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.created_ts >= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatedTsBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.created_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Query; v != nil {
+		where, args = append(where, fmt.Sprintf("query_history.statement ILIKE $%d", len(args)+1)), append(args, "%"+escapeLikePattern(*v)+"%")
+	}
 
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -194,3 +208,25 @@ func (s *Store) ListQueryHistories(ctx context.Context, find *FindQueryHistoryMe
 
 	return queryHistories, nil
 }
+
+// DeleteQueryHistoriesBefore deletes the query histories created before the given timestamp.
+func (s *Store) DeleteQueryHistoriesBefore(ctx context.Context, ts int64) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM query_history WHERE created_ts < $1`, ts)
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return rows, nil
+}
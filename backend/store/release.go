@@ -0,0 +1,270 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// ReleaseMessage is the message for a release.
+type ReleaseMessage struct {
+	ProjectID  string
+	ResourceID string
+
+	Payload *storepb.Release
+
+	// Output only fields
+	UID            int
+	CreatorID      int
+	UpdaterID      int
+	CreatedTime    time.Time
+	UpdatedTime    time.Time
+	IssueCount     int
+	DoneIssueCount int
+}
+
+// FindReleaseMessage is the API message for finding releases.
+type FindReleaseMessage struct {
+	ProjectID  *string
+	ResourceID *string
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdateReleaseMessage is the message to update a release.
+type UpdateReleaseMessage struct {
+	ProjectID  string
+	ResourceID string
+	UpdaterID  int
+	Payload    *storepb.Release
+}
+
+// GetRelease gets a release.
+func (s *Store) GetRelease(ctx context.Context, find *FindReleaseMessage) (*ReleaseMessage, error) {
+	releases, err := s.ListReleases(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(releases) == 0 {
+		return nil, nil
+	}
+	if len(releases) > 1 {
+		return nil, errors.Errorf("expected 1 release, got %d", len(releases))
+	}
+	return releases[0], nil
+}
+
+// ListReleases returns a list of releases with the aggregated issue status.
+func (s *Store) ListReleases(ctx context.Context, find *FindReleaseMessage) ([]*ReleaseMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+
+	if v := find.ProjectID; v != nil {
+		where, args = append(where, fmt.Sprintf("project.resource_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ResourceID; v != nil {
+		where, args = append(where, fmt.Sprintf("release.name = $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			release.id,
+			release.creator_id,
+			release.created_ts,
+			release.updater_id,
+			release.updated_ts,
+			project.resource_id AS project_id,
+			release.name,
+			release.payload,
+			(SELECT COUNT(1) FROM issue WHERE issue.project_id = release.project_id AND issue.payload->>'release' = release.name AND issue.status != '%s'),
+			(SELECT COUNT(1) FROM issue WHERE issue.project_id = release.project_id AND issue.payload->>'release' = release.name AND issue.status = '%s')
+		FROM release
+		LEFT JOIN project ON release.project_id = project.id
+		WHERE %s
+		ORDER BY release.id DESC`, api.IssueCanceled, api.IssueDone, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var releases []*ReleaseMessage
+	for rows.Next() {
+		var release ReleaseMessage
+		var createdTs, updatedTs int64
+		var payload []byte
+		if err := rows.Scan(
+			&release.UID,
+			&release.CreatorID,
+			&createdTs,
+			&release.UpdaterID,
+			&updatedTs,
+			&release.ProjectID,
+			&release.ResourceID,
+			&payload,
+			&release.IssueCount,
+			&release.DoneIssueCount,
+		); err != nil {
+			return nil, err
+		}
+		releasePayload := &storepb.Release{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, releasePayload); err != nil {
+			return nil, err
+		}
+		release.Payload = releasePayload
+		release.CreatedTime = time.Unix(createdTs, 0)
+		release.UpdatedTime = time.Unix(updatedTs, 0)
+
+		releases = append(releases, &release)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return releases, nil
+}
+
+// CreateRelease creates a release.
+func (s *Store) CreateRelease(ctx context.Context, create *ReleaseMessage) (*ReleaseMessage, error) {
+	project, err := s.GetProjectV2(ctx, &FindProjectMessage{ResourceID: &create.ProjectID})
+	if err != nil {
+		return nil, err
+	}
+	if create.Payload == nil {
+		create.Payload = &storepb.Release{}
+	}
+	create.UpdaterID = create.CreatorID
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO release (
+			creator_id,
+			updater_id,
+			project_id,
+			name,
+			payload
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_ts, updated_ts;
+	`
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	var createdTs, updatedTs int64
+	if err := tx.QueryRowContext(ctx, query,
+		create.CreatorID,
+		create.CreatorID,
+		project.UID,
+		create.ResourceID,
+		payload,
+	).Scan(
+		&create.UID,
+		&createdTs,
+		&updatedTs,
+	); err != nil {
+		return nil, err
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+	create.UpdatedTime = time.Unix(updatedTs, 0)
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+// UpdateRelease updates a release.
+func (s *Store) UpdateRelease(ctx context.Context, update *UpdateReleaseMessage) error {
+	project, err := s.GetProjectV2(ctx, &FindProjectMessage{ResourceID: &update.ProjectID})
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{update.UpdaterID, time.Now().Unix()}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(update.Payload)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	args = append(args, project.UID, update.ResourceID)
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE release
+		SET `+strings.Join(set, ", ")+`
+		WHERE release.project_id = $%d AND release.name = $%d`, len(set)+1, len(set)+2), args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeleteRelease deletes a release and detaches the issues from it.
+func (s *Store) DeleteRelease(ctx context.Context, projectID, resourceID string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE issue
+		SET payload = payload - 'release'
+		FROM project
+		WHERE issue.project_id = project.id AND project.resource_id = $1 AND issue.payload->>'release' = $2;`,
+		projectID, resourceID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM release
+		USING project
+		WHERE release.project_id = project.id AND project.resource_id = $1 AND release.name = $2;`,
+		projectID, resourceID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	s.issueCache.Purge()
+	s.issueByPipelineCache.Purge()
+	return nil
+}
@@ -29,6 +29,10 @@ const (
 	RiskSourceDatabaseDataExport RiskSource = "bb.risk.database.data.export"
 	// RiskSourceDatabaseCreate is for creating databases.
 	RiskSourceDatabaseCreate RiskSource = "bb.risk.database.create"
+	// RiskSourceDatabaseRestore is for restoring databases from backups.
+	RiskSourceDatabaseRestore RiskSource = "bb.risk.database.restore"
+	// RiskSourceDatabaseDataLoad is for bulk loading data files into tables.
+	RiskSourceDatabaseDataLoad RiskSource = "bb.risk.database.data.load"
 	// RiskRequestQuery is for requesting query grant.
 	RiskRequestQuery RiskSource = "bb.risk.request.query"
 	// RiskRequestExport is for requesting export grant.
@@ -19,6 +19,8 @@ type RoleMessage struct {
 	Name        string
 	Description string
 	Permissions map[string]bool
+	// ExcludedPermissions are the permissions excluded from the custom role.
+	ExcludedPermissions []string
 
 	// Output only
 	CreatorID int
@@ -29,9 +31,10 @@ type UpdateRoleMessage struct {
 	UpdaterID  int
 	ResourceID string
 
-	Name        *string
-	Description *string
-	Permissions *map[string]bool
+	Name                *string
+	Description         *string
+	Permissions         *map[string]bool
+	ExcludedPermissions *[]string
 }
 
 func (s *Store) CheckRoleInUse(ctx context.Context, role string) (bool, error) {
@@ -56,7 +59,9 @@ func (s *Store) CreateRole(ctx context.Context, create *RoleMessage, creatorID i
 			role (creator_id, updater_id, resource_id, name, description, permissions)
 		VALUES ($1, $2, $3, $4, $5, $6)
 	`
-	p := &storepb.RolePermissions{}
+	p := &storepb.RolePermissions{
+		ExcludedPermissions: create.ExcludedPermissions,
+	}
 	for k := range create.Permissions {
 		p.Permissions = append(p.Permissions, k)
 	}
@@ -99,6 +104,7 @@ func (s *Store) GetRole(ctx context.Context, resourceID string) (*RoleMessage, e
 	for _, v := range rolePermissions.Permissions {
 		role.Permissions[v] = true
 	}
+	role.ExcludedPermissions = rolePermissions.ExcludedPermissions
 	role.ResourceID = resourceID
 	s.rolesCache.Add(resourceID, role)
 	return role, nil
@@ -133,6 +139,7 @@ func (s *Store) ListRoles(ctx context.Context) ([]*RoleMessage, error) {
 		for _, v := range rolePermissions.Permissions {
 			role.Permissions[v] = true
 		}
+		role.ExcludedPermissions = rolePermissions.ExcludedPermissions
 		s.rolesCache.Add(role.ResourceID, role)
 		roles = append(roles, role)
 	}
@@ -158,6 +165,9 @@ func (s *Store) UpdateRole(ctx context.Context, patch *UpdateRoleMessage) (*Role
 		for k := range *v {
 			p.Permissions = append(p.Permissions, k)
 		}
+		if patch.ExcludedPermissions != nil {
+			p.ExcludedPermissions = *patch.ExcludedPermissions
+		}
 		permissionBytes, err := protojson.Marshal(p)
 		if err != nil {
 			return nil, err
@@ -189,6 +199,7 @@ func (s *Store) UpdateRole(ctx context.Context, patch *UpdateRoleMessage) (*Role
 	for _, v := range rolePermissions.Permissions {
 		role.Permissions[v] = true
 	}
+	role.ExcludedPermissions = rolePermissions.ExcludedPermissions
 
 	s.rolesCache.Add(role.ResourceID, role)
 	return role, nil
@@ -0,0 +1,218 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SavedSearchMessage is the message for a saved issue search.
+type SavedSearchMessage struct {
+	CreatorID int
+	// ProjectID is the resource ID of the project which the saved search is shared with.
+	// The saved search is private to the creator if it's nil.
+	ProjectID *string
+	Title     string
+	Filter    string
+
+	// Output only fields
+	UID         int
+	CreatedTime time.Time
+	UpdatedTime time.Time
+}
+
+// FindSavedSearchMessage is the message for finding saved searches.
+type FindSavedSearchMessage struct {
+	UID       *int
+	CreatorID *int
+	// Shared finds the saved searches shared with any project.
+	Shared bool
+}
+
+// UpdateSavedSearchMessage is the message for updating a saved search.
+type UpdateSavedSearchMessage struct {
+	UID    int
+	Title  *string
+	Filter *string
+	// ProjectID updates the project which the saved search is shared with.
+	// An empty string makes the saved search private.
+	ProjectID *string
+}
+
+// GetSavedSearch gets a saved search.
+func (s *Store) GetSavedSearch(ctx context.Context, find *FindSavedSearchMessage) (*SavedSearchMessage, error) {
+	savedSearches, err := s.ListSavedSearches(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(savedSearches) == 0 {
+		return nil, nil
+	}
+	if len(savedSearches) > 1 {
+		return nil, errors.Errorf("expected 1 saved search, got %d", len(savedSearches))
+	}
+	return savedSearches[0], nil
+}
+
+// ListSavedSearches lists the saved searches.
+func (s *Store) ListSavedSearches(ctx context.Context, find *FindSavedSearchMessage) ([]*SavedSearchMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("saved_search.id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, fmt.Sprintf("saved_search.creator_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if find.Shared {
+		where = append(where, "saved_search.project_id IS NOT NULL")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			saved_search.id,
+			saved_search.creator_id,
+			saved_search.created_ts,
+			saved_search.updated_ts,
+			project.resource_id,
+			saved_search.name,
+			saved_search.filter
+		FROM saved_search
+		LEFT JOIN project ON saved_search.project_id = project.id
+		WHERE %s
+		ORDER BY saved_search.id DESC`, strings.Join(where, " AND "))
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var savedSearches []*SavedSearchMessage
+	for rows.Next() {
+		var savedSearch SavedSearchMessage
+		var createdTs, updatedTs int64
+		var projectID sql.NullString
+		if err := rows.Scan(
+			&savedSearch.UID,
+			&savedSearch.CreatorID,
+			&createdTs,
+			&updatedTs,
+			&projectID,
+			&savedSearch.Title,
+			&savedSearch.Filter,
+		); err != nil {
+			return nil, err
+		}
+		if projectID.Valid {
+			savedSearch.ProjectID = &projectID.String
+		}
+		savedSearch.CreatedTime = time.Unix(createdTs, 0)
+		savedSearch.UpdatedTime = time.Unix(updatedTs, 0)
+		savedSearches = append(savedSearches, &savedSearch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return savedSearches, nil
+}
+
+// CreateSavedSearch creates a saved search.
+func (s *Store) CreateSavedSearch(ctx context.Context, create *SavedSearchMessage) (*SavedSearchMessage, error) {
+	projectUID, err := s.getSavedSearchProjectUID(ctx, create.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `
+		INSERT INTO saved_search (
+			creator_id,
+			project_id,
+			name,
+			filter
+		)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_ts, updated_ts
+	`
+	var createdTs, updatedTs int64
+	if err := s.db.db.QueryRowContext(ctx, query,
+		create.CreatorID,
+		projectUID,
+		create.Title,
+		create.Filter,
+	).Scan(
+		&create.UID,
+		&createdTs,
+		&updatedTs,
+	); err != nil {
+		return nil, err
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+	create.UpdatedTime = time.Unix(updatedTs, 0)
+	return create, nil
+}
+
+// UpdateSavedSearch updates a saved search.
+func (s *Store) UpdateSavedSearch(ctx context.Context, update *UpdateSavedSearchMessage) (*SavedSearchMessage, error) {
+	set, args := []string{"updated_ts = $1"}, []any{time.Now().Unix()}
+	if v := update.Title; v != nil {
+		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Filter; v != nil {
+		set, args = append(set, fmt.Sprintf("filter = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.ProjectID; v != nil {
+		var projectID *string
+		if *v != "" {
+			projectID = v
+		}
+		projectUID, err := s.getSavedSearchProjectUID(ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, fmt.Sprintf("project_id = $%d", len(args)+1)), append(args, projectUID)
+	}
+	args = append(args, update.UID)
+
+	if _, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE saved_search
+		SET %s
+		WHERE id = $%d`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return nil, err
+	}
+	return s.GetSavedSearch(ctx, &FindSavedSearchMessage{UID: &update.UID})
+}
+
+// DeleteSavedSearch deletes a saved search.
+func (s *Store) DeleteSavedSearch(ctx context.Context, uid int) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM saved_search WHERE id = $1`, uid); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Store) getSavedSearchProjectUID(ctx context.Context, projectID *string) (*int, error) {
+	if projectID == nil {
+		return nil, nil
+	}
+	project, err := s.GetProjectV2(ctx, &FindProjectMessage{ResourceID: projectID})
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, errors.Errorf("project %q not found", *projectID)
+	}
+	return &project.UID, nil
+}
@@ -0,0 +1,187 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// ServiceAccountTokenMessage is the message for a scoped service account token.
+type ServiceAccountTokenMessage struct {
+	ID           int
+	PrincipalUID int
+	Title        string
+	// TokenHash is the SHA-256 hex digest of the token, the plaintext token is never stored.
+	TokenHash   string
+	Payload     *storepb.ServiceAccountTokenPayload
+	CreatedTime time.Time
+}
+
+// FindServiceAccountTokenMessage is the message for finding service account tokens.
+type FindServiceAccountTokenMessage struct {
+	ID           *int
+	PrincipalUID *int
+	TokenHash    *string
+}
+
+// GetServiceAccountToken gets a service account token.
+func (s *Store) GetServiceAccountToken(ctx context.Context, find *FindServiceAccountTokenMessage) (*ServiceAccountTokenMessage, error) {
+	tokens, err := s.ListServiceAccountTokens(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, nil
+	} else if len(tokens) > 1 {
+		return nil, &common.Error{Code: common.Conflict, Err: errors.Errorf("found %d service account tokens with filter %+v, expect 1", len(tokens), find)}
+	}
+	return tokens[0], nil
+}
+
+// ListServiceAccountTokens lists service account tokens.
+func (s *Store) ListServiceAccountTokens(ctx context.Context, find *FindServiceAccountTokenMessage) ([]*ServiceAccountTokenMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.TokenHash; v != nil {
+		where, args = append(where, fmt.Sprintf("token_hash = $%d", len(args)+1)), append(args, *v)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+	SELECT
+		id,
+		created_ts,
+		principal_id,
+		title,
+		token_hash,
+		payload
+	FROM service_account_token
+	WHERE %s
+	ORDER BY id ASC
+	`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []*ServiceAccountTokenMessage
+	for rows.Next() {
+		var token ServiceAccountTokenMessage
+		var payload []byte
+		var createdTs int64
+		if err := rows.Scan(
+			&token.ID,
+			&createdTs,
+			&token.PrincipalUID,
+			&token.Title,
+			&token.TokenHash,
+			&payload,
+		); err != nil {
+			return nil, err
+		}
+		token.CreatedTime = time.Unix(createdTs, 0)
+		tokenPayload := &storepb.ServiceAccountTokenPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, tokenPayload); err != nil {
+			return nil, err
+		}
+		token.Payload = tokenPayload
+		tokens = append(tokens, &token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// CreateServiceAccountToken creates a service account token.
+func (s *Store) CreateServiceAccountToken(ctx context.Context, create *ServiceAccountTokenMessage) (*ServiceAccountTokenMessage, error) {
+	if create.Payload == nil {
+		create.Payload = &storepb.ServiceAccountTokenPayload{}
+	}
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	var createdTs int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO service_account_token (
+			principal_id,
+			title,
+			token_hash,
+			payload
+		) VALUES ($1, $2, $3, $4)
+		RETURNING id, created_ts
+	`,
+		create.PrincipalUID,
+		create.Title,
+		create.TokenHash,
+		payload,
+	).Scan(&create.ID, &createdTs); err != nil {
+		return nil, err
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit")
+	}
+	return create, nil
+}
+
+// UpdateServiceAccountTokenPayload updates the payload of a service account token.
+func (s *Store) UpdateServiceAccountTokenPayload(ctx context.Context, id int, payload *storepb.ServiceAccountTokenPayload) error {
+	p, err := protojson.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.db.ExecContext(ctx, `UPDATE service_account_token SET payload = $1 WHERE id = $2`, p, id); err != nil {
+		return errors.Wrapf(err, "failed to update service account token")
+	}
+	return nil
+}
+
+// DeleteServiceAccountToken deletes a service account token.
+func (s *Store) DeleteServiceAccountToken(ctx context.Context, id int) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM service_account_token WHERE id = $1`, id); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+	return nil
+}
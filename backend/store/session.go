@@ -0,0 +1,177 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SessionMessage is the message for a sign-in session of a user.
+type SessionMessage struct {
+	// ID is the JWT ID of the access token.
+	ID           string
+	PrincipalUID int
+	CreatedTime  time.Time
+	ExpireTime   time.Time
+	Revoked      bool
+}
+
+// FindSessionMessage is the message for finding sessions.
+type FindSessionMessage struct {
+	ID           *string
+	PrincipalUID *int
+	// Active filters the sessions which are neither revoked nor expired.
+	Active bool
+}
+
+// GetSession gets a session by the ID.
+func (s *Store) GetSession(ctx context.Context, id string) (*SessionMessage, error) {
+	if v, ok := s.sessionCache.Get(id); ok {
+		return v, nil
+	}
+
+	sessions, err := s.ListSessions(ctx, &FindSessionMessage{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+	return sessions[0], nil
+}
+
+// ListSessions lists the sessions ordered by the created time ascending.
+func (s *Store) ListSessions(ctx context.Context, find *FindSessionMessage) ([]*SessionMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PrincipalUID; v != nil {
+		where, args = append(where, fmt.Sprintf("principal_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if find.Active {
+		where, args = append(where, fmt.Sprintf("NOT revoked AND expires_ts > $%d", len(args)+1)), append(args, time.Now().Unix())
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`
+	SELECT
+		id,
+		principal_id,
+		created_ts,
+		expires_ts,
+		revoked
+	FROM principal_session
+	WHERE %s
+	ORDER BY created_ts ASC
+	`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []*SessionMessage
+	for rows.Next() {
+		var session SessionMessage
+		var createdTs, expiresTs int64
+		if err := rows.Scan(
+			&session.ID,
+			&session.PrincipalUID,
+			&createdTs,
+			&expiresTs,
+			&session.Revoked,
+		); err != nil {
+			return nil, err
+		}
+		session.CreatedTime = time.Unix(createdTs, 0)
+		session.ExpireTime = time.Unix(expiresTs, 0)
+		sessions = append(sessions, &session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		s.sessionCache.Add(session.ID, session)
+	}
+	return sessions, nil
+}
+
+// CreateSession creates a session.
+func (s *Store) CreateSession(ctx context.Context, create *SessionMessage) (*SessionMessage, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	var createdTs int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO principal_session (
+			id,
+			principal_id,
+			expires_ts
+		) VALUES ($1, $2, $3)
+		RETURNING created_ts
+	`,
+		create.ID,
+		create.PrincipalUID,
+		create.ExpireTime.Unix(),
+	).Scan(&createdTs); err != nil {
+		return nil, err
+	}
+	create.CreatedTime = time.Unix(createdTs, 0)
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "failed to commit")
+	}
+
+	s.sessionCache.Add(create.ID, create)
+	return create, nil
+}
+
+// RevokeSessions revokes the sessions, the access tokens of the sessions cannot be used anymore.
+func (s *Store) RevokeSessions(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `UPDATE principal_session SET revoked = TRUE WHERE id = ANY($1)`, ids); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	for _, id := range ids {
+		s.sessionCache.Remove(id)
+	}
+	return nil
+}
+
+// DeleteExpiredSessions deletes the sessions expired before the time.
+func (s *Store) DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.db.db.ExecContext(ctx, `DELETE FROM principal_session WHERE expires_ts < $1`, before.Unix())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -158,6 +158,149 @@ func (s *Store) GetWorkspaceExternalApprovalSetting(ctx context.Context) (*store
 	return payload, nil
 }
 
+// GetEnvironmentPipelineSetting gets the environment pipeline setting.
+func (s *Store) GetEnvironmentPipelineSetting(ctx context.Context) (*storepb.EnvironmentPipelineSetting, error) {
+	settingName := api.SettingEnvironmentPipeline
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.EnvironmentPipelineSetting{}, nil
+	}
+
+	payload := new(storepb.EnvironmentPipelineSetting)
+	if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetSheetStorageSetting gets the sheet storage setting, the default sizes are used if they're not set.
+func (s *Store) GetSheetStorageSetting(ctx context.Context) (*storepb.SheetStorageSetting, error) {
+	settingName := api.SettingSheetStorage
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+
+	payload := new(storepb.SheetStorageSetting)
+	if setting != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+			return nil, err
+		}
+	}
+	if payload.ExternalThreshold <= 0 {
+		payload.ExternalThreshold = common.DefaultSheetExternalThreshold
+	}
+	if payload.WarningSize <= 0 {
+		payload.WarningSize = common.DefaultSheetWarningSize
+	}
+	return payload, nil
+}
+
+// GetPlanCheckSetting gets the plan check setting.
+func (s *Store) GetPlanCheckSetting(ctx context.Context) (*storepb.PlanCheckSetting, error) {
+	settingName := api.SettingPlanCheck
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+
+	payload := new(storepb.PlanCheckSetting)
+	if setting != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+			return nil, err
+		}
+	}
+	return payload, nil
+}
+
+// GetEventBusSetting gets the event bus setting.
+func (s *Store) GetEventBusSetting(ctx context.Context) (*storepb.EventBusSetting, error) {
+	settingName := api.SettingEventBus
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+
+	payload := new(storepb.EventBusSetting)
+	if setting != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+			return nil, err
+		}
+	}
+	return payload, nil
+}
+
+// GetRateLimitSetting gets the rate limit setting.
+func (s *Store) GetRateLimitSetting(ctx context.Context) (*storepb.RateLimitSetting, error) {
+	settingName := api.SettingRateLimit
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+
+	payload := new(storepb.RateLimitSetting)
+	if setting != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+			return nil, err
+		}
+	}
+	return payload, nil
+}
+
+// GetReadAuditSetting gets the read audit setting, the default sample rate is used if it's not set.
+func (s *Store) GetReadAuditSetting(ctx context.Context) (*storepb.ReadAuditSetting, error) {
+	settingName := api.SettingReadAudit
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+
+	payload := new(storepb.ReadAuditSetting)
+	if setting != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+			return nil, err
+		}
+	}
+	if payload.SampleRate <= 0 {
+		payload.SampleRate = 1
+	}
+	return payload, nil
+}
+
+// GetAISetting gets the AI setting, it's disabled if it's not set.
+func (s *Store) GetAISetting(ctx context.Context) (*storepb.AISetting, error) {
+	settingName := api.SettingAI
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+
+	payload := new(storepb.AISetting)
+	if setting != nil {
+		if err := common.ProtojsonUnmarshaler.Unmarshal([]byte(setting.Value), payload); err != nil {
+			return nil, err
+		}
+	}
+	return payload, nil
+}
+
 // GetMaskingAlgorithmSetting gets the masking algorithm setting.
 func (s *Store) GetMaskingAlgorithmSetting(ctx context.Context) (*storepb.MaskingAlgorithmSetting, error) {
 	settingName := api.SettingMaskingAlgorithm
@@ -2,8 +2,12 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"path"
 	"strings"
 	"time"
 
@@ -77,11 +81,68 @@ func (s *Store) GetSheetStatementByID(ctx context.Context, id int) (string, erro
 		return "", errors.Errorf("sheet not found with id %d", id)
 	}
 
+	if externalContent := sheet.Payload.GetExternalContent(); externalContent != nil {
+		// The large content is not cached to bound the memory usage.
+		return s.readSheetExternalContent(ctx, externalContent)
+	}
 	statement := sheet.Statement
 	s.sheetStatementCache.Add(id, statement)
 	return statement, nil
 }
 
+// readSheetExternalContent streams the sheet content from the external storage and verifies its checksum.
+func (s *Store) readSheetExternalContent(ctx context.Context, content *storepb.SheetExternalContent) (string, error) {
+	if s.sheetContentStorage == nil {
+		return "", errors.Errorf("sheet content storage is not configured")
+	}
+	body, err := s.sheetContentStorage.Open(ctx, content)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to open sheet content %q", content.Path)
+	}
+	defer body.Close()
+
+	var buf strings.Builder
+	buf.Grow(int(content.Size))
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(&buf, hash), body); err != nil {
+		return "", errors.Wrapf(err, "failed to read sheet content %q", content.Path)
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != content.Sha256 {
+		return "", errors.Errorf("checksum mismatch for sheet content %q, expected %s, got %s", content.Path, content.Sha256, checksum)
+	}
+	return buf.String(), nil
+}
+
+// offloadSheetStatement stores the statement in the external storage if it's larger than the threshold of the sheet storage setting.
+// It returns the statement to store in the metadata database, which is the head of the statement if it's offloaded.
+func (s *Store) offloadSheetStatement(ctx context.Context, statement string) (string, *storepb.SheetExternalContent, error) {
+	if s.sheetContentStorage == nil {
+		return statement, nil, nil
+	}
+	setting, err := s.GetSheetStorageSetting(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if setting.Storage == nil || int64(len(statement)) <= setting.ExternalThreshold {
+		return statement, nil, nil
+	}
+
+	sum := sha256.Sum256([]byte(statement))
+	checksum := hex.EncodeToString(sum[:])
+	// The content is addressed by its checksum so that the identical statements share the object.
+	key := path.Join(setting.Storage.Prefix, "sheets", checksum+".sql")
+	if err := s.sheetContentStorage.Upload(ctx, setting.Storage, key, strings.NewReader(statement)); err != nil {
+		return "", nil, errors.Wrapf(err, "failed to upload sheet content")
+	}
+	head, _ := common.TruncateString(statement, common.MaxSheetSize)
+	return head, &storepb.SheetExternalContent{
+		Storage: setting.Storage,
+		Path:    key,
+		Size:    int64(len(statement)),
+		Sha256:  checksum,
+	}, nil
+}
+
 // GetSheet gets a sheet.
 func (s *Store) GetSheet(ctx context.Context, find *FindSheetMessage) (*SheetMessage, error) {
 	shouldCache := !find.LoadFull && find.UID != nil
@@ -185,6 +246,9 @@ func (s *Store) listSheets(ctx context.Context, find *FindSheetMessage) ([]*Shee
 			return nil, err
 		}
 		sheet.Payload = sheetPayload
+		if externalContent := sheetPayload.ExternalContent; externalContent != nil {
+			sheet.Size = externalContent.Size
+		}
 
 		sheets = append(sheets, &sheet)
 	}
@@ -210,6 +274,11 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 	if create.Payload == nil {
 		create.Payload = &storepb.SheetPayload{}
 	}
+	statement, externalContent, err := s.offloadSheetStatement(ctx, create.Statement)
+	if err != nil {
+		return nil, err
+	}
+	create.Payload.ExternalContent = externalContent
 	payload, err := protojson.Marshal(create.Payload)
 	if err != nil {
 		return nil, err
@@ -241,7 +310,7 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 		create.ProjectUID,
 		create.DatabaseUID,
 		create.Title,
-		create.Statement,
+		statement,
 		payload,
 	).Scan(
 		&create.UID,
@@ -258,6 +327,9 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 		return nil, errors.Wrapf(err, "failed to commit transaction")
 	}
 
+	if externalContent != nil {
+		create.Size = externalContent.Size
+	}
 	create.CreatedTime = time.Unix(create.createdTs, 0)
 	create.UpdatedTime = time.Unix(create.updatedTs, 0)
 
@@ -266,12 +338,22 @@ func (s *Store) CreateSheet(ctx context.Context, create *SheetMessage) (*SheetMe
 
 // PatchSheet updates a sheet.
 func (s *Store) PatchSheet(ctx context.Context, patch *PatchSheetMessage) (*SheetMessage, error) {
+	var statement *string
+	var externalContent *storepb.SheetExternalContent
+	if v := patch.Statement; v != nil {
+		storedStatement, content, err := s.offloadSheetStatement(ctx, *v)
+		if err != nil {
+			return nil, err
+		}
+		statement, externalContent = &storedStatement, content
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to begin transaction")
 	}
 
-	sheet, err := patchSheetImpl(ctx, tx, patch)
+	sheet, err := patchSheetImpl(ctx, tx, patch.UID, patch.UpdaterID, statement, externalContent)
 	if err != nil {
 		return nil, err
 	}
@@ -280,21 +362,34 @@ func (s *Store) PatchSheet(ctx context.Context, patch *PatchSheetMessage) (*Shee
 		return nil, errors.Wrapf(err, "failed to commit transaction")
 	}
 	if v := patch.Statement; v != nil {
-		s.sheetStatementCache.Add(patch.UID, *v)
+		if externalContent != nil {
+			s.sheetStatementCache.Remove(patch.UID)
+		} else {
+			s.sheetStatementCache.Add(patch.UID, *v)
+		}
 	}
 
 	s.sheetCache.Remove(patch.UID)
 	return sheet, nil
 }
 
-// patchSheetImpl updates a sheet's name/statement/payload/database_id/project_id.
-func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*SheetMessage, error) {
-	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
-	if v := patch.Statement; v != nil {
+// patchSheetImpl updates a sheet's statement, the external content is replaced along with the statement.
+func patchSheetImpl(ctx context.Context, tx *Tx, uid, updaterID int, statement *string, externalContent *storepb.SheetExternalContent) (*SheetMessage, error) {
+	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{updaterID, time.Now().Unix()}
+	if v := statement; v != nil {
 		set, args = append(set, fmt.Sprintf("statement = $%d", len(args)+1)), append(args, *v)
+		if externalContent != nil {
+			content, err := protojson.Marshal(externalContent)
+			if err != nil {
+				return nil, err
+			}
+			set, args = append(set, fmt.Sprintf("payload = jsonb_set(payload, '{externalContent}', $%d)", len(args)+1)), append(args, content)
+		} else {
+			set = append(set, "payload = payload - 'externalContent'")
+		}
 	}
 
-	args = append(args, patch.UID)
+	args = append(args, uid)
 
 	var sheet SheetMessage
 	var payload []byte
@@ -321,7 +416,7 @@ func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*She
 		&sheet.Size,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("sheet ID not found: %d", patch.UID)}
+			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("sheet ID not found: %d", uid)}
 		}
 		return nil, err
 	}
@@ -330,6 +425,9 @@ func patchSheetImpl(ctx context.Context, tx *Tx, patch *PatchSheetMessage) (*She
 		return nil, err
 	}
 	sheet.Payload = sheetPayload
+	if externalContent := sheetPayload.ExternalContent; externalContent != nil {
+		sheet.Size = externalContent.Size
+	}
 
 	if databaseID.Valid {
 		value := int(databaseID.Int32)
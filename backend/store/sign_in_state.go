@@ -0,0 +1,48 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// GetSignInState gets the sign-in state of a user, an empty state is returned if not found.
+func (s *Store) GetSignInState(ctx context.Context, principalUID int) (*storepb.SignInState, error) {
+	var payload []byte
+	if err := s.db.db.QueryRowContext(ctx, `
+		SELECT payload FROM principal_sign_in_state WHERE principal_id = $1
+	`, principalUID).Scan(&payload); err != nil {
+		if err == sql.ErrNoRows {
+			return &storepb.SignInState{}, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get sign-in state")
+	}
+	state := &storepb.SignInState{}
+	if err := common.ProtojsonUnmarshaler.Unmarshal(payload, state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// UpsertSignInState creates or updates the sign-in state of a user.
+func (s *Store) UpsertSignInState(ctx context.Context, principalUID int, state *storepb.SignInState) error {
+	payload, err := protojson.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if _, err := s.db.db.ExecContext(ctx, `
+		INSERT INTO principal_sign_in_state (principal_id, payload) VALUES ($1, $2)
+		ON CONFLICT (principal_id) DO UPDATE SET
+			updated_ts = extract(epoch from now()),
+			payload = EXCLUDED.payload
+	`, principalUID, payload); err != nil {
+		return errors.Wrapf(err, "failed to upsert sign-in state")
+	}
+	return nil
+}
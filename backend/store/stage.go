@@ -15,8 +15,9 @@ type StageMessage struct {
 	TaskList      []*TaskMessage
 
 	// Output only.
-	ID     int
-	Active bool
+	ID       int
+	Active   bool
+	Approval *StageApprovalMessage
 
 	// TODO(d): this is used to create the tasks.
 	TaskIndexDAGList []TaskIndexDAG
@@ -97,7 +98,7 @@ func (s *Store) CreateStageV2(ctx context.Context, stagesCreate []*StageMessage,
 // ListStageV2 finds a list of stages based on find.
 func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessage, error) {
 	where, args := []string{"TRUE"}, []any{}
-	where, args = append(where, fmt.Sprintf("pipeline_id = $%d", len(args)+1)), append(args, pipelineUID)
+	where, args = append(where, fmt.Sprintf("stage.pipeline_id = $%d", len(args)+1)), append(args, pipelineUID)
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
 	if err != nil {
@@ -111,6 +112,10 @@ func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessa
 			stage.pipeline_id,
 			stage.environment_id,
 			stage.name,
+			stage_approval.creator_id,
+			stage_approval.created_ts,
+			stage_approval.status,
+			stage_approval.comment,
 			(
 				SELECT EXISTS (
 					SELECT 1 FROM task
@@ -134,7 +139,8 @@ func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessa
 				)
 			) AS active
 		FROM stage
-		WHERE %s ORDER BY id ASC`, strings.Join(where, " AND ")),
+		LEFT JOIN stage_approval ON stage_approval.stage_id = stage.id
+		WHERE %s ORDER BY stage.id ASC`, strings.Join(where, " AND ")),
 		args...,
 	)
 	if err != nil {
@@ -145,15 +151,31 @@ func (s *Store) ListStageV2(ctx context.Context, pipelineUID int) ([]*StageMessa
 	var stages []*StageMessage
 	for rows.Next() {
 		var stage StageMessage
+		var approvalCreatorUID sql.NullInt32
+		var approvalCreatedTs sql.NullInt64
+		var approvalStatus, approvalComment sql.NullString
 		if err := rows.Scan(
 			&stage.ID,
 			&stage.PipelineID,
 			&stage.EnvironmentID,
 			&stage.Name,
+			&approvalCreatorUID,
+			&approvalCreatedTs,
+			&approvalStatus,
+			&approvalComment,
 			&stage.Active,
 		); err != nil {
 			return nil, err
 		}
+		if approvalStatus.Valid {
+			stage.Approval = &StageApprovalMessage{
+				StageID:    stage.ID,
+				CreatorUID: int(approvalCreatorUID.Int32),
+				CreatedTs:  approvalCreatedTs.Int64,
+				Status:     StageApprovalStatus(approvalStatus.String),
+				Comment:    approvalComment.String,
+			}
+		}
 
 		stages = append(stages, &stage)
 	}
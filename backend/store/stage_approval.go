@@ -0,0 +1,76 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+)
+
+// StageApprovalStatus is the status of the stage approval.
+type StageApprovalStatus string
+
+const (
+	// StageApprovalStatusApproved is the status for the approved stage.
+	StageApprovalStatusApproved StageApprovalStatus = "APPROVED"
+	// StageApprovalStatusRejected is the status for the rejected stage.
+	StageApprovalStatusRejected StageApprovalStatus = "REJECTED"
+)
+
+// StageApprovalMessage is the manual approval of the stage required by the rollout policy.
+type StageApprovalMessage struct {
+	StageID    int
+	CreatorUID int
+	CreatedTs  int64
+	Status     StageApprovalStatus
+	Comment    string
+}
+
+// UpsertStageApproval approves or rejects the stage. The later decision overrides the earlier one.
+func (s *Store) UpsertStageApproval(ctx context.Context, upsert *StageApprovalMessage) (*StageApprovalMessage, error) {
+	query := `
+		INSERT INTO stage_approval (
+			stage_id,
+			creator_id,
+			status,
+			comment
+		) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (stage_id) DO UPDATE SET
+			creator_id = EXCLUDED.creator_id,
+			created_ts = extract(epoch from now()),
+			status = EXCLUDED.status,
+			comment = EXCLUDED.comment
+		RETURNING created_ts
+	`
+	approval := *upsert
+	if err := s.db.db.QueryRowContext(ctx, query, upsert.StageID, upsert.CreatorUID, upsert.Status, upsert.Comment).Scan(&approval.CreatedTs); err != nil {
+		return nil, errors.Wrapf(err, "failed to upsert stage approval")
+	}
+	return &approval, nil
+}
+
+// GetStageApproval gets the approval of the stage, or nil if the stage is not approved or rejected yet.
+func (s *Store) GetStageApproval(ctx context.Context, stageID int) (*StageApprovalMessage, error) {
+	query := `
+		SELECT
+			creator_id,
+			created_ts,
+			status,
+			comment
+		FROM stage_approval
+		WHERE stage_id = $1
+	`
+	approval := &StageApprovalMessage{StageID: stageID}
+	if err := s.db.db.QueryRowContext(ctx, query, stageID).Scan(
+		&approval.CreatorUID,
+		&approval.CreatedTs,
+		&approval.Status,
+		&approval.Comment,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get stage approval")
+	}
+	return approval, nil
+}
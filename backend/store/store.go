@@ -4,6 +4,7 @@ package store
 import (
 	"context"
 	"fmt"
+	"io"
 	"strings"
 
 	lru "github.com/hashicorp/golang-lru/v2"
@@ -11,12 +12,38 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/store/model"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+// SecretCipher encrypts the data source credentials before they are persisted, and decrypts them after they are loaded.
+type SecretCipher interface {
+	Encrypt(value string) (string, error)
+	Decrypt(value string) (string, error)
+}
+
+// SheetContentStorage stores the content of the large sheets outside the metadata database, e.g. in the object storage.
+type SheetContentStorage interface {
+	Upload(ctx context.Context, storage *storepb.BackupStorage, key string, content io.Reader) error
+	// Open opens the content of the sheet, the caller must close the reader.
+	Open(ctx context.Context, content *storepb.SheetExternalContent) (io.ReadCloser, error)
+}
+
+// AnomalyListener is notified of the anomalies detected.
+type AnomalyListener interface {
+	// OnAnomalyCreate is called after a new active anomaly is created, it must not block.
+	OnAnomalyCreate(ctx context.Context, anomaly *AnomalyMessage)
+}
+
 // Store provides database access to all raw objects.
 type Store struct {
 	db      *DB
 	profile *config.Profile
+	// secretCipher is nil if the envelope encryption is not enabled.
+	secretCipher SecretCipher
+	// sheetContentStorage is nil if the large sheets are always stored in the metadata database.
+	sheetContentStorage SheetContentStorage
+	// anomalyListener is nil if no one listens to the anomalies.
+	anomalyListener AnomalyListener
 
 	userIDCache            *lru.Cache[int, *UserMessage]
 	userEmailCache         *lru.Cache[string, *UserMessage]
@@ -41,6 +68,7 @@ type Store struct {
 	vcsIDCache             *lru.Cache[int, *VCSProviderMessage]
 	rolesCache             *lru.Cache[string, *RoleMessage]
 	groupCache             *lru.Cache[string, *GroupMessage]
+	sessionCache           *lru.Cache[string, *SessionMessage]
 	sheetCache             *lru.Cache[int, *SheetMessage]
 
 	// Large objects.
@@ -154,6 +182,10 @@ func New(db *DB, profile *config.Profile) (*Store, error) {
 	if err != nil {
 		return nil, err
 	}
+	sessionCache, err := lru.New[string, *SessionMessage](32768)
+	if err != nil {
+		return nil, err
+	}
 
 	return &Store{
 		db:      db,
@@ -186,6 +218,7 @@ func New(db *DB, profile *config.Profile) (*Store, error) {
 		sheetStatementCache:    sheetStatementCache,
 		dbSchemaCache:          dbSchemaCache,
 		groupCache:             groupCache,
+		sessionCache:           sessionCache,
 	}, nil
 }
 
@@ -194,6 +227,23 @@ func (s *Store) Close(ctx context.Context) error {
 	return s.db.Close(ctx)
 }
 
+// SetSecretCipher sets the cipher to encrypt the data source credentials at rest.
+func (s *Store) SetSecretCipher(secretCipher SecretCipher) {
+	s.secretCipher = secretCipher
+	s.instanceCache.Purge()
+	s.instanceIDCache.Purge()
+}
+
+// SetSheetContentStorage sets the storage of the large sheet content.
+func (s *Store) SetSheetContentStorage(sheetContentStorage SheetContentStorage) {
+	s.sheetContentStorage = sheetContentStorage
+}
+
+// SetAnomalyListener sets the listener of the anomalies.
+func (s *Store) SetAnomalyListener(anomalyListener AnomalyListener) {
+	s.anomalyListener = anomalyListener
+}
+
 func getInstanceCacheKey(instanceID string) string {
 	return instanceID
 }
@@ -45,6 +45,7 @@ type TaskMessage struct {
 	DatabaseName string
 
 	LatestTaskRunStatus api.TaskRunStatus
+	LatestTaskRunCode   common.Code
 }
 
 // GetTaskV2ByID gets a task by ID.
@@ -219,6 +220,7 @@ func (s *Store) ListTasks(ctx context.Context, find *api.TaskFind) ([]*TaskMessa
 			task.database_id,
 			task.name,
 			latest_task_run.status AS latest_task_run_status,
+			latest_task_run.code AS latest_task_run_code,
 			task.type,
 			task.payload,
 			task.earliest_allowed_ts,
@@ -233,7 +235,16 @@ func (s *Store) ListTasks(ctx context.Context, find *api.TaskFind) ([]*TaskMessa
 				ORDER BY task_run.id DESC
 				LIMIT 1
 				), $%d
-			) AS status
+			) AS status,
+			COALESCE(
+				(SELECT
+					task_run.code
+				FROM task_run
+				WHERE task_run.task_id = task.id
+				ORDER BY task_run.id DESC
+				LIMIT 1
+				), 0
+			) AS code
 		) AS latest_task_run ON TRUE
 		WHERE %s
 		ORDER BY task.id ASC`, len(args), strings.Join(where, " AND ")),
@@ -260,6 +271,7 @@ func (s *Store) ListTasks(ctx context.Context, find *api.TaskFind) ([]*TaskMessa
 			&task.DatabaseID,
 			&task.Name,
 			&task.LatestTaskRunStatus,
+			&task.LatestTaskRunCode,
 			&task.Type,
 			&task.Payload,
 			&task.EarliestAllowedTs,
@@ -318,6 +330,23 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 		}
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('flags', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
 	}
+	if v := patch.VerificationQueries; v != nil {
+		jsonb, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal verificationQueries")
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('verificationQueries', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
+	}
+	if v := patch.TransactionMode; v != nil {
+		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('transactionMode', $%d::INT)`, len(args)+1)), append(args, *v)
+	}
+	if v := patch.SessionVariables; v != nil {
+		jsonb, err := json.Marshal(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal sessionVariables")
+		}
+		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('sessionVariables', $%d::JSONB)`, len(args)+1)), append(args, jsonb)
+	}
 	if len(payloadSet) != 0 {
 		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
 	}
@@ -397,7 +426,8 @@ func (s *Store) BatchSkipTasks(ctx context.Context, taskUIDs []int, comment stri
 // 3. are associated with an open issue or no issue
 // 4. are in an environment that has auto rollout enabled
 // 5. are in the stage that is the first among the selected stages in the pipeline
-// 6. are not data export tasks.
+// 6. are not data export tasks
+// 7. are not in an archived project.
 func (s *Store) ListTasksToAutoRollout(ctx context.Context, environmentIDs []int) ([]int, error) {
 	rows, err := s.db.db.QueryContext(ctx, `
 	SELECT
@@ -408,10 +438,12 @@ func (s *Store) ListTasksToAutoRollout(ctx context.Context, environmentIDs []int
 	LEFT JOIN stage ON stage.id = task.stage_id
 	LEFT JOIN pipeline ON pipeline.id = task.pipeline_id
 	LEFT JOIN issue ON issue.pipeline_id = pipeline.id
+	LEFT JOIN project ON project.id = pipeline.project_id
 	WHERE NOT EXISTS (SELECT 1 FROM task_run WHERE task_run.task_id = task.id)
 	AND task.type != 'bb.task.database.data.export'
 	AND COALESCE((task.payload->>'skipped')::BOOLEAN, FALSE) IS FALSE
 	AND COALESCE(issue.status, 'OPEN') = 'OPEN'
+	AND project.row_status = 'NORMAL'
 	AND stage.environment_id = ANY($1)
 	`, environmentIDs)
 	if err != nil {
@@ -47,6 +47,8 @@ type FindTaskRunMessage struct {
 	StageUID    *int
 	PipelineUID *int
 	Status      *[]api.TaskRunStatus
+	// ExcludeArchivedProject excludes the task runs in the archived projects, which are frozen until the projects are restored.
+	ExcludeArchivedProject bool
 }
 
 // TaskRunFind is the API message for finding task runs.
@@ -99,6 +101,9 @@ func (s *Store) ListTaskRunsV2(ctx context.Context, find *FindTaskRunMessage) ([
 		}
 		where = append(where, fmt.Sprintf("task_run.status in (%s)", strings.Join(list, ",")))
 	}
+	if find.ExcludeArchivedProject {
+		where = append(where, fmt.Sprintf("project.row_status = '%s'", api.Normal))
+	}
 
 	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
 		SELECT
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// TaskRunLogFileMessage is the full text log of a finished task run.
+type TaskRunLogFileMessage struct {
+	TaskRunUID int
+	CreatedTs  int64
+	// Size is the size of the uncompressed content.
+	Size int64
+	// Truncated is true if the log exceeds the size cap and the tail is dropped.
+	Truncated bool
+	// Content is the uncompressed content.
+	Content []byte
+}
+
+// UpsertTaskRunLogFile compresses and saves the full text log of the task run.
+func (s *Store) UpsertTaskRunLogFile(ctx context.Context, taskRunUID int, content []byte, truncated bool) error {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(content); err != nil {
+		return errors.Wrapf(err, "failed to compress task run log")
+	}
+	if err := w.Close(); err != nil {
+		return errors.Wrapf(err, "failed to compress task run log")
+	}
+
+	query := `
+		INSERT INTO task_run_log_file (
+			task_run_id,
+			size,
+			truncated,
+			content
+		) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (task_run_id) DO UPDATE SET
+			created_ts = extract(epoch from now()),
+			size = EXCLUDED.size,
+			truncated = EXCLUDED.truncated,
+			content = EXCLUDED.content
+	`
+	if _, err := s.db.db.ExecContext(ctx, query, taskRunUID, len(content), truncated, buf.Bytes()); err != nil {
+		return errors.Wrapf(err, "failed to upsert task run log file")
+	}
+	return nil
+}
+
+// GetTaskRunLogFile gets the full text log of the task run, or nil if the log is not persisted.
+func (s *Store) GetTaskRunLogFile(ctx context.Context, taskRunUID int) (*TaskRunLogFileMessage, error) {
+	query := `
+		SELECT
+			created_ts,
+			size,
+			truncated,
+			content
+		FROM task_run_log_file
+		WHERE task_run_id = $1
+	`
+	file := &TaskRunLogFileMessage{TaskRunUID: taskRunUID}
+	var compressed []byte
+	if err := s.db.db.QueryRowContext(ctx, query, taskRunUID).Scan(
+		&file.CreatedTs,
+		&file.Size,
+		&file.Truncated,
+		&compressed,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get task run log file")
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress task run log")
+	}
+	defer r.Close()
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress task run log")
+	}
+	file.Content = content
+	return file, nil
+}
+
+// DeleteTaskRunLogsBefore deletes the logs of the finished task runs updated before the timestamp.
+// It returns the number of the task runs whose logs are deleted.
+func (s *Store) DeleteTaskRunLogsBefore(ctx context.Context, updatedBefore int64) (int64, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	finishedTaskRuns := `SELECT id FROM task_run WHERE status IN ('DONE', 'FAILED', 'CANCELED') AND updated_ts < $1`
+	result, err := tx.ExecContext(ctx, `DELETE FROM task_run_log_file WHERE task_run_id IN (`+finishedTaskRuns+`)`, updatedBefore)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to delete task run log files")
+	}
+	count, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM task_run_log WHERE task_run_id IN (`+finishedTaskRuns+`)`, updatedBefore); err != nil {
+		return 0, errors.Wrapf(err, "failed to delete task run logs")
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
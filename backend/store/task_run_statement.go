@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// CreateTaskRunStatements records the statements committed by the task run.
+func (s *Store) CreateTaskRunStatements(ctx context.Context, taskRunUID int, commandIndexes []int32) error {
+	if len(commandIndexes) == 0 {
+		return nil
+	}
+	query := `
+		INSERT INTO task_run_statement (task_run_id, command_index)
+		SELECT $1, unnest($2::INTEGER[])
+		ON CONFLICT DO NOTHING
+	`
+	if _, err := s.db.db.ExecContext(ctx, query, taskRunUID, commandIndexes); err != nil {
+		return errors.Wrapf(err, "failed to create task run statements")
+	}
+	return nil
+}
+
+// ListTaskRunStatements lists the indexes of the statements committed by the task run.
+func (s *Store) ListTaskRunStatements(ctx context.Context, taskRunUID int) ([]int32, error) {
+	rows, err := s.db.db.QueryContext(ctx, `
+		SELECT command_index FROM task_run_statement WHERE task_run_id = $1 ORDER BY command_index
+	`, taskRunUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list task run statements")
+	}
+	defer rows.Close()
+
+	var commandIndexes []int32
+	for rows.Next() {
+		var commandIndex int32
+		if err := rows.Scan(&commandIndex); err != nil {
+			return nil, err
+		}
+		commandIndexes = append(commandIndexes, commandIndex)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return commandIndexes, nil
+}
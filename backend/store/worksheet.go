@@ -24,6 +24,8 @@ const (
 	ProjectReadWorkSheet WorkSheetVisibility = "PROJECT_READ"
 	// ProjectWriteWorkSheet is the sheet visibility for PROJECT. Both sheet OWNER and project OWNER can read/write, and project DEVELOPER can read.
 	ProjectWriteWorkSheet WorkSheetVisibility = "PROJECT_WRITE"
+	// WorkspaceReadWorkSheet is the sheet visibility for WORKSPACE. Sheet OWNER can read/write, and all workspace members can read.
+	WorkspaceReadWorkSheet WorkSheetVisibility = "WORKSPACE_READ"
 )
 
 // WorkSheetMessage is the message for a sheet.
@@ -40,6 +42,7 @@ type WorkSheetMessage struct {
 	Title      string
 	Statement  string
 	Visibility WorkSheetVisibility
+	Payload    *storepb.WorksheetPayload
 
 	// Output only fields
 	UID         int
@@ -69,6 +72,12 @@ type FindWorkSheetMessage struct {
 
 	// Domain fields
 	Visibilities []WorkSheetVisibility
+	// Folder finds the sheets in the folder and its subfolders.
+	Folder *string
+	// Tags finds the sheets with all the tags.
+	Tags []string
+	// Query finds the sheets whose title or statement contains the text case-insensitively.
+	Query *string
 
 	// Used to find (un)starred sheet list, could be PRIVATE/PROJECT/PUBLIC sheet.
 	// For now, we only need the starred sheets.
@@ -84,6 +93,7 @@ type PatchWorkSheetMessage struct {
 	Statement   *string
 	Visibility  *string
 	DatabaseUID *int
+	Payload     *storepb.WorksheetPayload
 }
 
 // GetWorkSheet gets a sheet.
@@ -127,6 +137,15 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 	if len(visibilitiesWhere) > 0 {
 		where = append(where, fmt.Sprintf("(%s)", strings.Join(visibilitiesWhere, " OR ")))
 	}
+	if v := find.Folder; v != nil {
+		where, args = append(where, fmt.Sprintf("(worksheet.payload->>'folder' = $%d OR worksheet.payload->>'folder' LIKE $%d)", len(args)+1, len(args)+2)), append(args, *v, escapeLikePattern(*v)+"/%")
+	}
+	if len(find.Tags) > 0 {
+		where, args = append(where, fmt.Sprintf("worksheet.payload->'tags' ?& $%d::TEXT[]", len(args)+1)), append(args, find.Tags)
+	}
+	if v := find.Query; v != nil {
+		where, args = append(where, fmt.Sprintf("(worksheet.name ILIKE $%d OR worksheet.statement ILIKE $%d)", len(args)+1, len(args)+1)), append(args, "%"+escapeLikePattern(*v)+"%")
+	}
 	if v := find.OrganizerPrincipalIDStarred; v != nil {
 		where, args = append(where, fmt.Sprintf("worksheet.id IN (SELECT worksheet_id FROM worksheet_organizer WHERE principal_id = $%d AND starred = true)", len(args)+1)), append(args, *v)
 	}
@@ -156,6 +175,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 			worksheet.name,
 			%s,
 			worksheet.visibility,
+			worksheet.payload,
 			OCTET_LENGTH(worksheet.statement),
 			COALESCE(worksheet_organizer.starred, FALSE)
 		FROM worksheet
@@ -171,6 +191,7 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 	var sheets []*WorkSheetMessage
 	for rows.Next() {
 		var sheet WorkSheetMessage
+		var payload []byte
 		if err := rows.Scan(
 			&sheet.UID,
 			&sheet.CreatorID,
@@ -182,11 +203,17 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 			&sheet.Title,
 			&sheet.Statement,
 			&sheet.Visibility,
+			&payload,
 			&sheet.Size,
 			&sheet.Starred,
 		); err != nil {
 			return nil, err
 		}
+		worksheetPayload := &storepb.WorksheetPayload{}
+		if err := common.ProtojsonUnmarshaler.Unmarshal(payload, worksheetPayload); err != nil {
+			return nil, err
+		}
+		sheet.Payload = worksheetPayload
 
 		sheets = append(sheets, &sheet)
 	}
@@ -207,7 +234,10 @@ func (s *Store) ListWorkSheets(ctx context.Context, find *FindWorkSheetMessage,
 
 // CreateWorkSheet creates a new sheet.
 func (s *Store) CreateWorkSheet(ctx context.Context, create *WorkSheetMessage) (*WorkSheetMessage, error) {
-	payload, err := protojson.Marshal(&storepb.SheetPayload{})
+	if create.Payload == nil {
+		create.Payload = &storepb.WorksheetPayload{}
+	}
+	payload, err := protojson.Marshal(create.Payload)
 	if err != nil {
 		return nil, err
 	}
@@ -270,6 +300,8 @@ func (s *Store) PatchWorkSheet(ctx context.Context, patch *PatchWorkSheetMessage
 		return errors.Wrapf(err, "failed to begin transaction")
 	}
 
+	defer tx.Rollback()
+
 	if err := patchWorkSheetImpl(ctx, tx, patch); err != nil {
 		return err
 	}
@@ -298,8 +330,28 @@ func (s *Store) DeleteWorkSheet(ctx context.Context, sheetUID int) error {
 	return tx.Commit()
 }
 
-// patchWorkSheetImpl updates a sheet's name/statement/visibility/database_id/project_id.
+// patchWorkSheetImpl updates a sheet's name/statement/visibility/database_id/payload.
+// The previous version is saved as a revision if the statement is changed.
 func patchWorkSheetImpl(ctx context.Context, tx *Tx, patch *PatchWorkSheetMessage) error {
+	if v := patch.Statement; v != nil {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO worksheet_revision (
+				worksheet_id,
+				creator_id,
+				created_ts,
+				name,
+				statement
+			)
+			SELECT id, updater_id, updated_ts, name, statement
+			FROM worksheet
+			WHERE id = $1 AND statement != $2`,
+			patch.UID,
+			*v,
+		); err != nil {
+			return err
+		}
+	}
+
 	set, args := []string{"updater_id = $1", "updated_ts = $2"}, []any{patch.UpdaterID, time.Now().Unix()}
 	if v := patch.Title; v != nil {
 		set, args = append(set, fmt.Sprintf("name = $%d", len(args)+1)), append(args, *v)
@@ -313,6 +365,13 @@ func patchWorkSheetImpl(ctx context.Context, tx *Tx, patch *PatchWorkSheetMessag
 	if v := patch.DatabaseUID; v != nil {
 		set, args = append(set, fmt.Sprintf("database_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if v := patch.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return err
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
 	args = append(args, patch.UID)
 
 	query := fmt.Sprintf(`
@@ -378,3 +437,70 @@ func (s *Store) UpsertWorksheetOrganizer(ctx context.Context, organizer *Workshe
 
 	return &worksheetOrganizer, nil
 }
+
+// WorksheetRevisionMessage is the store message for a previous version of the worksheet.
+type WorksheetRevisionMessage struct {
+	UID          int
+	WorksheetUID int
+	CreatorID    int
+	CreatedTime  time.Time
+	Title        string
+	Statement    string
+}
+
+// ListWorksheetRevisions lists the revisions of the worksheet, the newest first.
+func (s *Store) ListWorksheetRevisions(ctx context.Context, worksheetUID int) ([]*WorksheetRevisionMessage, error) {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT
+			id,
+			worksheet_id,
+			creator_id,
+			created_ts,
+			name,
+			statement
+		FROM worksheet_revision
+		WHERE worksheet_id = $1
+		ORDER BY id DESC`,
+		worksheetUID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var revisions []*WorksheetRevisionMessage
+	for rows.Next() {
+		var revision WorksheetRevisionMessage
+		var createdTs int64
+		if err := rows.Scan(
+			&revision.UID,
+			&revision.WorksheetUID,
+			&revision.CreatorID,
+			&createdTs,
+			&revision.Title,
+			&revision.Statement,
+		); err != nil {
+			return nil, err
+		}
+		revision.CreatedTime = time.Unix(createdTs, 0)
+		revisions = append(revisions, &revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
+
+// escapeLikePattern escapes the wildcards of the LIKE pattern.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
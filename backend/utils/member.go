@@ -90,6 +90,43 @@ func GetUsersByMember(ctx context.Context, stores *store.Store, member string) [
 	return users
 }
 
+// IsUserInGroup returns true if the user is a member of the group.
+// The group should in groups/{email} format.
+func IsUserInGroup(ctx context.Context, stores *store.Store, user *store.UserMessage, group string) (bool, error) {
+	groupEmail, err := common.GetGroupEmail(group)
+	if err != nil {
+		return false, err
+	}
+	groupMessage, err := stores.GetGroup(ctx, groupEmail)
+	if err != nil {
+		return false, err
+	}
+	if groupMessage == nil {
+		return false, nil
+	}
+	userIDFullName := common.FormatUserUID(user.ID)
+	for _, member := range groupMessage.Payload.GetMembers() {
+		if member.Member == userIDFullName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DeduplicateUsers removes the duplicate users by id and keeps the first occurrence.
+func DeduplicateUsers(users []*store.UserMessage) []*store.UserMessage {
+	seen := make(map[int]bool)
+	var result []*store.UserMessage
+	for _, user := range users {
+		if user == nil || seen[user.ID] {
+			continue
+		}
+		seen[user.ID] = true
+		result = append(result, user)
+	}
+	return result
+}
+
 // getUserByIdentifier gets user message by identifier.
 // The identifier should in users/{uid} format.
 func getUserByIdentifier(ctx context.Context, stores *store.Store, identifier string) *store.UserMessage {
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// StatementVariableNameRegexp matches the valid names of the statement variables.
+var StatementVariableNameRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// statementVariableReferenceRegexp matches the references of the statement variables, e.g. ${RETENTION_DAYS}.
+var statementVariableReferenceRegexp = regexp.MustCompile(`\${([A-Z][A-Z0-9_]*)}`)
+
+// RenderStatementVariables substitutes the project statement variables referenced in the statement with their values for the database.
+// The references to the names not defined in the project are kept as is.
+// It returns the rendered statement and the names of the referenced variables which have no value for the database.
+func RenderStatementVariables(statement string, variables []*storepb.StatementVariable, database *store.DatabaseMessage) (string, []string) {
+	if len(variables) == 0 || !strings.Contains(statement, "${") {
+		return statement, nil
+	}
+	variableMap := make(map[string]*storepb.StatementVariable)
+	for _, variable := range variables {
+		variableMap[variable.Name] = variable
+	}
+
+	databaseName := common.FormatDatabase(database.InstanceID, database.DatabaseName)
+	var undefined []string
+	rendered := statementVariableReferenceRegexp.ReplaceAllStringFunc(statement, func(reference string) string {
+		name := statementVariableReferenceRegexp.FindStringSubmatch(reference)[1]
+		variable, ok := variableMap[name]
+		if !ok {
+			return reference
+		}
+		if value, ok := variable.DatabaseValues[databaseName]; ok {
+			return value
+		}
+		if value, ok := variable.EnvironmentValues[database.EffectiveEnvironmentID]; ok {
+			return value
+		}
+		if variable.DefaultValue != "" {
+			return variable.DefaultValue
+		}
+		if !slices.Contains(undefined, name) {
+			undefined = append(undefined, name)
+		}
+		return reference
+	})
+	return rendered, undefined
+}
+
+// RenderStatementVariablesForDatabase substitutes the statement variables of the database project in the statement.
+// It returns an error if any referenced variable has no value for the database.
+func RenderStatementVariablesForDatabase(ctx context.Context, stores *store.Store, statement string, database *store.DatabaseMessage) (string, error) {
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil {
+		return "", errors.Errorf("project %q not found", database.ProjectID)
+	}
+	rendered, undefined := RenderStatementVariables(statement, project.Setting.GetStatementVariables(), database)
+	if len(undefined) > 0 {
+		return "", errors.Errorf("statement variables %s have no value for database %q", strings.Join(undefined, ", "), database.DatabaseName)
+	}
+	return rendered, nil
+}
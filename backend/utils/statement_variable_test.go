@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestRenderStatementVariables(t *testing.T) {
+	variables := []*storepb.StatementVariable{
+		{
+			Name:              "SHARD_SUFFIX",
+			EnvironmentValues: map[string]string{"prod": "_eu"},
+			DatabaseValues:    map[string]string{"instances/us/databases/tenant": "_us"},
+		},
+		{
+			Name:         "RETENTION_DAYS",
+			DefaultValue: "30",
+		},
+		{
+			Name: "REGION",
+		},
+	}
+	tests := []struct {
+		database  *store.DatabaseMessage
+		statement string
+		want      string
+		undefined []string
+	}{
+		{
+			database:  &store.DatabaseMessage{InstanceID: "eu", DatabaseName: "tenant", EffectiveEnvironmentID: "prod"},
+			statement: "CREATE TABLE t${SHARD_SUFFIX} (id INT); -- keep ${RETENTION_DAYS} days",
+			want:      "CREATE TABLE t_eu (id INT); -- keep 30 days",
+		},
+		{
+			database:  &store.DatabaseMessage{InstanceID: "us", DatabaseName: "tenant", EffectiveEnvironmentID: "prod"},
+			statement: "CREATE TABLE t${SHARD_SUFFIX} (id INT);",
+			want:      "CREATE TABLE t_us (id INT);",
+		},
+		{
+			database:  &store.DatabaseMessage{InstanceID: "eu", DatabaseName: "tenant", EffectiveEnvironmentID: "test"},
+			statement: "CREATE TABLE t${SHARD_SUFFIX}${REGION} (id INT); SELECT '${UNKNOWN}', '${REGION}';",
+			want:      "CREATE TABLE t${SHARD_SUFFIX}${REGION} (id INT); SELECT '${UNKNOWN}', '${REGION}';",
+			undefined: []string{"SHARD_SUFFIX", "REGION"},
+		},
+	}
+
+	for _, test := range tests {
+		got, undefined := RenderStatementVariables(test.statement, variables, test.database)
+		require.Equal(t, test.want, got)
+		require.Equal(t, test.undefined, undefined)
+	}
+}
@@ -29,9 +29,10 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/secret"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
-	"github.com/bytebase/bytebase/backend/plugin/app/relay"
+	approvalplugin "github.com/bytebase/bytebase/backend/plugin/app/approval"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -92,6 +93,34 @@ func isMatchExpressions(labels map[string]string, expressionList []*store.LabelS
 	return true
 }
 
+// GetDeploymentDatabaseLabels returns the labels of a new database in the environment to belong to the deployment.
+// The labels required by the "In" expressions with exactly one value are added if they are not set,
+// and it returns an error if the database still doesn't match the deployment.
+func GetDeploymentDatabaseLabels(deployment *store.Deployment, environmentID string, labels map[string]string) (map[string]string, error) {
+	result := make(map[string]string)
+	for k, v := range labels {
+		result[k] = v
+	}
+	for _, expression := range deployment.Spec.Selector.MatchExpressions {
+		if expression.Key == api.EnvironmentLabelKey || expression.Operator != store.InOperatorType || len(expression.Values) != 1 {
+			continue
+		}
+		if _, ok := result[expression.Key]; !ok {
+			result[expression.Key] = expression.Values[0]
+		}
+	}
+
+	matchLabels := make(map[string]string)
+	for k, v := range result {
+		matchLabels[k] = v
+	}
+	matchLabels[api.EnvironmentLabelKey] = environmentID
+	if !isMatchExpressions(matchLabels, deployment.Spec.Selector.MatchExpressions) {
+		return nil, errors.Errorf("database with labels %v in environment %q doesn't match deployment %q", labels, environmentID, deployment.Name)
+	}
+	return result, nil
+}
+
 // ValidateAndGetDeploymentSchedule validates and returns the deployment schedule.
 // Note: this validation only checks whether the payloads is a valid json, however, invalid field name errors are ignored.
 func ValidateDeploymentSchedule(schedule *store.Schedule) error {
@@ -250,7 +279,7 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 	var prevSchemaBuf bytes.Buffer
 	if m.Type.NeedDump() {
 		opts.LogSchemaDumpStart()
-		// Don't record schema if the database hasn't existed yet or is schemaless, e.g. MongoDB.
+		// Don't record schema if the database hasn't existed yet or is schemaless, e.g. Redis.
 		// For baseline migration, we also record the live schema to detect the schema drift.
 		// See https://bytebase.com/blog/what-is-database-schema-drift
 		if _, err := driver.Dump(ctx, &prevSchemaBuf); err != nil {
@@ -286,6 +315,7 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 	}
 	if doMigrate {
 		renderedStatement := statement
+		var materials map[string]string
 		// The m.DatabaseID is nil means the migration is a instance level migration
 		if m.DatabaseID != nil {
 			database, err := s.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
@@ -297,9 +327,16 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 			if database == nil {
 				return "", "", errors.Errorf("database %d not found", *m.DatabaseID)
 			}
-			materials := GetSecretMapFromDatabaseMessage(database)
+			renderedStatement, err = RenderStatementVariablesForDatabase(ctx, s, statement, database)
+			if err != nil {
+				return "", "", err
+			}
+			materials, err = GetSecretMapFromDatabaseMessage(ctx, database)
+			if err != nil {
+				return "", "", errors.Wrapf(err, "failed to get database secrets")
+			}
 			// To avoid leak the rendered statement, the error message should use the original statement and not the rendered statement.
-			renderedStatement = RenderStatement(statement, materials)
+			renderedStatement = RenderStatement(renderedStatement, materials)
 		}
 
 		if stateCfg != nil {
@@ -311,6 +348,10 @@ func ExecuteMigrationWithFunc(ctx context.Context, driverCtx context.Context, s
 		}
 
 		if err := execFunc(driverCtx, renderedStatement); err != nil {
+			if len(materials) > 0 {
+				// The driver error may quote the rendered statement, mask the secret values in it.
+				return "", "", errors.New(RedactSecrets(err.Error(), materials))
+			}
 			return "", "", err
 		}
 	}
@@ -453,7 +494,7 @@ func CheckIssueApproved(issue *store.IssueMessage) (bool, error) {
 // HandleIncomingApprovalSteps handles incoming approval steps.
 // - Blocks approval steps if no user can approve the step.
 // - creates external approvals for external approval nodes.
-func HandleIncomingApprovalSteps(ctx context.Context, s *store.Store, relayClient *relay.Client, issue *store.IssueMessage, approval *storepb.IssuePayloadApproval) ([]*storepb.IssuePayloadApproval_Approver, []*store.IssueCommentMessage, error) {
+func HandleIncomingApprovalSteps(ctx context.Context, s *store.Store, issue *store.IssueMessage, approval *storepb.IssuePayloadApproval) ([]*storepb.IssuePayloadApproval_Approver, []*store.IssueCommentMessage, error) {
 	if len(approval.ApprovalTemplates) == 0 {
 		return nil, nil, nil
 	}
@@ -473,7 +514,7 @@ func HandleIncomingApprovalSteps(ctx context.Context, s *store.Store, relayClien
 	}
 	node := step.Nodes[0]
 	if v, ok := node.GetPayload().(*storepb.ApprovalNode_ExternalNodeId); ok {
-		if err := handleApprovalNodeExternalNode(ctx, s, relayClient, issue, v.ExternalNodeId); err != nil {
+		if err := handleApprovalNodeExternalNode(ctx, s, issue, v.ExternalNodeId); err != nil {
 			approvers = append(approvers, &storepb.IssuePayloadApproval_Approver{
 				Status:      storepb.IssuePayloadApproval_Approver_REJECTED,
 				PrincipalId: api.SystemBotID,
@@ -494,7 +535,7 @@ func HandleIncomingApprovalSteps(ctx context.Context, s *store.Store, relayClien
 	return approvers, issueComments, nil
 }
 
-func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayClient *relay.Client, issue *store.IssueMessage, externalNodeID string) error {
+func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, issue *store.IssueMessage, externalNodeID string) error {
 	getExternalApprovalByID := func(ctx context.Context, s *store.Store, externalApprovalID string) (*storepb.ExternalApprovalSetting_Node, error) {
 		setting, err := s.GetWorkspaceExternalApprovalSetting(ctx)
 		if err != nil {
@@ -514,14 +555,15 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	if node == nil {
 		return errors.Errorf("external approval node %s not found", externalNodeID)
 	}
-	id, err := relayClient.Create(node.Endpoint, &relay.CreatePayload{
-		IssueID:     fmt.Sprintf("%d", issue.UID),
-		Title:       issue.Title,
-		Description: issue.Description,
-		Project:     issue.Project.ResourceID,
-		CreateTime:  issue.CreatedTime,
-		Creator:     issue.Creator.Email,
-	})
+	provider, err := approvalplugin.Open(node)
+	if err != nil {
+		return err
+	}
+	request, err := getExternalApprovalRequest(ctx, s, issue)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get external approval request")
+	}
+	id, err := provider.Create(ctx, request)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create external approval")
 	}
@@ -544,6 +586,86 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	return nil
 }
 
+// externalApprovalStatementPreviewLimit is the max number of characters of the statement previews sent to the external approvals.
+const externalApprovalStatementPreviewLimit = 2000
+
+// getExternalApprovalRequest gets the request with the statement previews, the risk level and the plan check summary of the issue,
+// so that the external approval systems don't need to call back for the details.
+func getExternalApprovalRequest(ctx context.Context, s *store.Store, issue *store.IssueMessage) (*approvalplugin.Request, error) {
+	request := &approvalplugin.Request{
+		IssueID:     fmt.Sprintf("%d", issue.UID),
+		Title:       issue.Title,
+		Description: issue.Description,
+		Project:     issue.Project.ResourceID,
+		CreateTime:  issue.CreatedTime,
+		Creator:     issue.Creator.Email,
+	}
+	if riskLevel := issue.Payload.GetApproval().GetRiskLevel(); riskLevel != storepb.IssuePayloadApproval_RISK_LEVEL_UNSPECIFIED {
+		request.RiskLevel = riskLevel.String()
+	}
+	if issue.PlanUID == nil {
+		return request, nil
+	}
+
+	plan, err := s.GetPlan(ctx, &store.FindPlanMessage{UID: issue.PlanUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get plan %d", *issue.PlanUID)
+	}
+	if plan != nil {
+		var previews []string
+		for _, step := range plan.Config.GetSteps() {
+			for _, spec := range step.Specs {
+				config := spec.GetChangeDatabaseConfig()
+				if config == nil {
+					continue
+				}
+				_, sheetUID, err := common.GetProjectResourceIDSheetUID(config.Sheet)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to get sheet id from %q", config.Sheet)
+				}
+				statement, err := s.GetSheetStatementByID(ctx, sheetUID)
+				if err != nil {
+					return nil, errors.Wrapf(err, "failed to get statement of sheet %d", sheetUID)
+				}
+				preview, truncated := common.TruncateString(statement, externalApprovalStatementPreviewLimit)
+				request.Statements = append(request.Statements, &approvalplugin.Statement{
+					Target:    config.Target,
+					Statement: preview,
+					Truncated: truncated,
+				})
+				previews = append(previews, preview)
+			}
+		}
+		request.Statement = strings.Join(previews, "\n")
+	}
+
+	planCheckRuns, err := s.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{
+		PlanUID:    issue.PlanUID,
+		LatestOnly: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plan check runs")
+	}
+	if len(planCheckRuns) > 0 {
+		summary := &approvalplugin.PlanCheckSummary{}
+		for _, run := range planCheckRuns {
+			for _, result := range run.Result.GetResults() {
+				switch result.Status {
+				case storepb.PlanCheckRunResult_Result_SUCCESS:
+					summary.Success++
+				case storepb.PlanCheckRunResult_Result_WARNING:
+					summary.Warning++
+				case storepb.PlanCheckRunResult_Result_ERROR:
+					summary.Error++
+				default:
+				}
+			}
+		}
+		request.PlanCheckSummary = summary
+	}
+	return request, nil
+}
+
 // UpdateProjectPolicyFromGrantIssue updates the project policy from grant issue.
 func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store, issue *store.IssueMessage, grantRequest *storepb.GrantRequest) error {
 	policyMessage, err := stores.GetProjectIamPolicy(ctx, issue.Project.UID)
@@ -616,6 +738,14 @@ func UpdateProjectPolicyFromGrantIssue(ctx context.Context, stores *store.Store,
 	return nil
 }
 
+// secretReferenceRegexp matches the secret references in the statement, both ${{ secrets.NAME }} and {{secret.NAME}} are supported.
+// The regular expression consists of:
+// \${{\s*secrets: matches the string ${{ secrets, where $ is escaped with a backslash and \s* matches zero or more whitespace characters.
+// {{\s*secret: matches the string {{secret.
+// \.: matches the character . which is escaped with a backslash.
+// (?P<name>[A-Z0-9_]+): uses a named capture group name to match the secret name. The capture group is defined using the syntax (?P<name>) and matches one or more uppercase letters, digits, or underscores.
+var secretReferenceRegexp = regexp.MustCompile(`(?:\${{\s*secrets|{{\s*secret)\.(?P<name>[A-Z0-9_]+)\s*}}`)
+
 // RenderStatement renders the given template statement with the given key-value map.
 func RenderStatement(templateStatement string, secrets map[string]string) string {
 	// Happy path for empty template statement.
@@ -631,13 +761,7 @@ func RenderStatement(templateStatement string, secrets map[string]string) string
 		return templateStatement
 	}
 
-	// The regular expression consists of:
-	// \${{: matches the string ${{, where $ is escaped with a backslash.
-	// \s*: matches zero or more whitespace characters.
-	// secrets\.: matches the string secrets., where . is escaped with a backslash.
-	// (?P<name>[A-Z0-9_]+): uses a named capture group name to match the secret name. The capture group is defined using the syntax (?P<name>) and matches one or more uppercase letters, digits, or underscores.
-	re := regexp.MustCompile(`\${{\s*secrets\.(?P<name>[A-Z0-9_]+)\s*}}`)
-	matches := re.FindAllStringSubmatch(templateStatement, -1)
+	matches := secretReferenceRegexp.FindAllStringSubmatch(templateStatement, -1)
 	for _, match := range matches {
 		name := match[1]
 		if value, ok := secrets[name]; ok {
@@ -648,16 +772,36 @@ func RenderStatement(templateStatement string, secrets map[string]string) string
 }
 
 // GetSecretMapFromDatabaseMessage extracts the secret map from the given database message.
-func GetSecretMapFromDatabaseMessage(databaseMessage *store.DatabaseMessage) map[string]string {
+// The values of the secrets stored in the external secret manager are fetched at the time of calling.
+func GetSecretMapFromDatabaseMessage(ctx context.Context, databaseMessage *store.DatabaseMessage) (map[string]string, error) {
 	materials := make(map[string]string)
 	if databaseMessage.Secrets == nil || len(databaseMessage.Secrets.Items) == 0 {
-		return materials
+		return materials, nil
 	}
 
 	for _, item := range databaseMessage.Secrets.Items {
-		materials[item.Name] = item.Value
+		if item.ExternalSecret == nil {
+			materials[item.Name] = item.Value
+			continue
+		}
+		value, err := secret.ReplaceExternalSecret(ctx, item.Value, item.ExternalSecret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the value of secret %q", item.Name)
+		}
+		materials[item.Name] = value
+	}
+	return materials, nil
+}
+
+// RedactSecrets masks the secret values in the text, e.g. the error message of the rendered statement.
+func RedactSecrets(text string, secrets map[string]string) string {
+	for _, value := range secrets {
+		if value == "" {
+			continue
+		}
+		text = strings.ReplaceAll(text, value, "******")
 	}
-	return materials
+	return text
 }
 
 // GetMatchedAndUnmatchedDatabasesInDatabaseGroup returns the matched and unmatched databases in the given database group.
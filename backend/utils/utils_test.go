@@ -276,6 +276,33 @@ func TestGetDatabaseMatrixFromDeploymentSchedule(t *testing.T) {
 	}
 }
 
+func TestGetDeploymentDatabaseLabels(t *testing.T) {
+	a := require.New(t)
+	deployment := &store.Deployment{
+		Name: "Prod EU",
+		Spec: &store.DeploymentSpec{
+			Selector: &store.LabelSelector{
+				MatchExpressions: []*store.LabelSelectorRequirement{
+					{Key: "environment", Operator: store.InOperatorType, Values: []string{"prod"}},
+					{Key: "location", Operator: store.InOperatorType, Values: []string{"eu"}},
+					{Key: "tenant", Operator: store.ExistsOperatorType},
+				},
+			},
+		},
+	}
+
+	labels, err := GetDeploymentDatabaseLabels(deployment, "prod", map[string]string{"tenant": "acme"})
+	a.NoError(err)
+	a.Equal(map[string]string{"tenant": "acme", "location": "eu"}, labels)
+
+	// The tenant label is required by the "Exists" expression.
+	_, err = GetDeploymentDatabaseLabels(deployment, "prod", nil)
+	a.Error(err)
+
+	_, err = GetDeploymentDatabaseLabels(deployment, "test", map[string]string{"tenant": "acme"})
+	a.Error(err)
+}
+
 func TestMergeTaskCreateLists(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -425,6 +452,13 @@ func TestGetRenderedStatement(t *testing.T) {
 			template: "select * from table where password = ${{ secrets.PASSWORD }}",
 			expected: "select * from table where password = ${{ secrets.PASSWORD }}",
 		},
+		{
+			material: map[string]string{
+				"API_KEY": "abc",
+			},
+			template: "INSERT INTO config (key) VALUES ('{{secret.API_KEY}}'), ('{{ secret.API_KEY }}')",
+			expected: "INSERT INTO config (key) VALUES ('abc'), ('abc')",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -433,6 +467,15 @@ func TestGetRenderedStatement(t *testing.T) {
 	}
 }
 
+func TestRedactSecrets(t *testing.T) {
+	secrets := map[string]string{
+		"API_KEY": "abc123",
+		"EMPTY":   "",
+	}
+	actual := RedactSecrets(`syntax error at or near "abc123"`, secrets)
+	assert.Equal(t, `syntax error at or near "******"`, actual)
+}
+
 func TestConvertBytesToUTF8String(t *testing.T) {
 	tests := []struct {
 		input    []byte
@@ -38,6 +38,9 @@ export const isSimilarIssueComment = (
 };
 
 export const isUserEditableComment = (comment: ComposedIssueComment) => {
+  if (comment.deleted) {
+    return false;
+  }
   if (comment.type === IssueCommentType.USER_COMMENT) {
     return true;
   }
@@ -38,6 +38,10 @@ export const useDynamicSuggestions = () => {
   });
 
   const requestAI = async (messages: OpenAIMessage[]) => {
+    // The suggestions are only available with the legacy OpenAI key.
+    if (!context.openAIKey.value) {
+      return [];
+    }
     const body = {
       model: "gpt-3.5-turbo",
       messages,
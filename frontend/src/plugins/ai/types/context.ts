@@ -17,6 +17,8 @@ export type AIChatInfo = {
 };
 
 export type AIContext = {
+  // Whether the AI provider is configured in the workspace settings.
+  enabled: Ref<boolean>;
   openAIKey: Ref<string>;
   openAIEndpoint: Ref<string>;
   engine: Ref<Engine | undefined>;
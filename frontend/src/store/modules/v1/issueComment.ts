@@ -100,6 +100,33 @@ export const useIssueCommentStore = defineStore("issue_comment", () => {
           return {
             ...issueComment,
             comment,
+            edited: true,
+          };
+        }
+        return issueComment;
+      })
+    );
+  };
+
+  const deleteIssueComment = async ({
+    issueName,
+    commentId,
+  }: {
+    issueName: string;
+    commentId: string;
+  }) => {
+    await issueServiceClient.deleteIssueComment({
+      name: `${issueName}/issueComments/${commentId}`,
+    });
+    issueCommentMap.set(
+      issueName,
+      (issueCommentMap.get(issueName) ?? []).map((issueComment) => {
+        if (issueComment.uid === commentId) {
+          return {
+            ...issueComment,
+            comment: "",
+            edited: true,
+            deleted: true,
           };
         }
         return issueComment;
@@ -115,6 +142,7 @@ export const useIssueCommentStore = defineStore("issue_comment", () => {
     listIssueComments,
     createIssueComment,
     updateIssueComment,
+    deleteIssueComment,
     getIssueComments,
   };
 });
@@ -29,15 +29,19 @@ export type Permission =
   | "bb.databases.getSchema"
   | "bb.databases.list"
   | "bb.databases.query"
+  | "bb.databases.queryPrimary"
   | "bb.databases.execute"
   | "bb.databases.sync"
   | "bb.databases.update"
   | "bb.issueComments.list"
   | "bb.issueComments.create"
   | "bb.issueComments.update"
+  | "bb.issueComments.delete"
+  | "bb.issueComments.listRevisions"
   | "bb.issues.create"
   | "bb.issues.get"
   | "bb.issues.list"
+  | "bb.issues.purge"
   | "bb.issues.update"
   | "bb.planCheckRuns.list"
   | "bb.planCheckRuns.run"
@@ -49,6 +53,11 @@ export type Permission =
   | "bb.projects.getIamPolicy"
   | "bb.projects.setIamPolicy"
   | "bb.projects.update"
+  | "bb.releases.create"
+  | "bb.releases.delete"
+  | "bb.releases.get"
+  | "bb.releases.list"
+  | "bb.releases.update"
   | "bb.rollouts.create"
   | "bb.rollouts.get"
   | "bb.rollouts.preview"
@@ -77,6 +86,7 @@ export type Permission =
   | "bb.instances.create"
   | "bb.instances.delete"
   | "bb.instances.get"
+  | "bb.instances.killQuery"
   | "bb.instances.list"
   | "bb.instances.sync"
   | "bb.instances.undelete"
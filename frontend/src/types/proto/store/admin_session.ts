@@ -0,0 +1,144 @@
+/* eslint-disable */
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
+
+export const protobufPackage = "bytebase.store";
+
+export interface AdminSessionStatementPayload {
+  duration:
+    | Duration
+    | undefined;
+  /** The number of rows affected by the DML and DDL statements. */
+  affectedRows: Long;
+  /** The number of rows returned by the query statements. */
+  rowCount: Long;
+  error: string;
+}
+
+function createBaseAdminSessionStatementPayload(): AdminSessionStatementPayload {
+  return { duration: undefined, affectedRows: Long.ZERO, rowCount: Long.ZERO, error: "" };
+}
+
+export const AdminSessionStatementPayload = {
+  encode(message: AdminSessionStatementPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(10).fork()).ldelim();
+    }
+    if (!message.affectedRows.isZero()) {
+      writer.uint32(16).int64(message.affectedRows);
+    }
+    if (!message.rowCount.isZero()) {
+      writer.uint32(24).int64(message.rowCount);
+    }
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AdminSessionStatementPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAdminSessionStatementPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.affectedRows = reader.int64() as Long;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.rowCount = reader.int64() as Long;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AdminSessionStatementPayload {
+    return {
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      affectedRows: isSet(object.affectedRows) ? Long.fromValue(object.affectedRows) : Long.ZERO,
+      rowCount: isSet(object.rowCount) ? Long.fromValue(object.rowCount) : Long.ZERO,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: AdminSessionStatementPayload): unknown {
+    const obj: any = {};
+    if (message.duration !== undefined) {
+      obj.duration = Duration.toJSON(message.duration);
+    }
+    if (!message.affectedRows.isZero()) {
+      obj.affectedRows = (message.affectedRows || Long.ZERO).toString();
+    }
+    if (!message.rowCount.isZero()) {
+      obj.rowCount = (message.rowCount || Long.ZERO).toString();
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AdminSessionStatementPayload>): AdminSessionStatementPayload {
+    return AdminSessionStatementPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AdminSessionStatementPayload>): AdminSessionStatementPayload {
+    const message = createBaseAdminSessionStatementPayload();
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    message.affectedRows = (object.affectedRows !== undefined && object.affectedRows !== null)
+      ? Long.fromValue(object.affectedRows)
+      : Long.ZERO;
+    message.rowCount = (object.rowCount !== undefined && object.rowCount !== null)
+      ? Long.fromValue(object.rowCount)
+      : Long.ZERO;
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Long ? string | number | Long : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
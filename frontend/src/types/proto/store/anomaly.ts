@@ -1,6 +1,7 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 
 export const protobufPackage = "bytebase.store";
 
@@ -9,6 +10,44 @@ export interface AnomalyConnectionPayload {
   detail: string;
 }
 
+export interface AnomalyConnectionBudgetPayload {
+  /** The maximum number of connections of the instance. */
+  maximumConnections: number;
+  /** The number of connection requests rejected since the last check. */
+  rejectedCount: number;
+}
+
+export interface AnomalyLongRunningQueryPayload {
+  queries: AnomalyLongRunningQueryPayload_Query[];
+  /** The threshold of the long-running queries. */
+  threshold: Duration | undefined;
+}
+
+export interface AnomalyLongRunningQueryPayload_Query {
+  /** The connection id running the query. */
+  connectionId: string;
+  database: string;
+  user: string;
+  state: string;
+  statement: string;
+  duration:
+    | Duration
+    | undefined;
+  /** The connection ids blocking the query by the locks. */
+  blockedBy: string[];
+  /** The number of the queries waiting for the locks held by the query. */
+  blockingCount: number;
+}
+
+export interface AnomalyFailoverPayload {
+  /** The name of the availability group. */
+  availabilityGroup: string;
+  /** The server name of the primary replica before the failover. */
+  previousPrimary: string;
+  /** The server name of the primary replica after the failover. */
+  currentPrimary: string;
+}
+
 export interface AnomalyDatabaseSchemaDriftPayload {
   /** The schema version corresponds to the expected schema */
   version: string;
@@ -16,6 +55,15 @@ export interface AnomalyDatabaseSchemaDriftPayload {
   expect: string;
   /** The actual schema dumped from the database */
   actual: string;
+  /** The DDL statements migrating the actual schema to the expected schema */
+  diff: string;
+}
+
+export interface AnomalyDatabaseBackupFailedPayload {
+  /** The uid of the failed backup run. */
+  backupRunUid: number;
+  /** The error of the failed backup. */
+  error: string;
 }
 
 function createBaseAnomalyConnectionPayload(): AnomalyConnectionPayload {
@@ -75,8 +123,426 @@ export const AnomalyConnectionPayload = {
   },
 };
 
+function createBaseAnomalyConnectionBudgetPayload(): AnomalyConnectionBudgetPayload {
+  return { maximumConnections: 0, rejectedCount: 0 };
+}
+
+export const AnomalyConnectionBudgetPayload = {
+  encode(message: AnomalyConnectionBudgetPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.maximumConnections !== 0) {
+      writer.uint32(8).int32(message.maximumConnections);
+    }
+    if (message.rejectedCount !== 0) {
+      writer.uint32(16).int32(message.rejectedCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AnomalyConnectionBudgetPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomalyConnectionBudgetPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maximumConnections = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.rejectedCount = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AnomalyConnectionBudgetPayload {
+    return {
+      maximumConnections: isSet(object.maximumConnections) ? globalThis.Number(object.maximumConnections) : 0,
+      rejectedCount: isSet(object.rejectedCount) ? globalThis.Number(object.rejectedCount) : 0,
+    };
+  },
+
+  toJSON(message: AnomalyConnectionBudgetPayload): unknown {
+    const obj: any = {};
+    if (message.maximumConnections !== 0) {
+      obj.maximumConnections = Math.round(message.maximumConnections);
+    }
+    if (message.rejectedCount !== 0) {
+      obj.rejectedCount = Math.round(message.rejectedCount);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AnomalyConnectionBudgetPayload>): AnomalyConnectionBudgetPayload {
+    return AnomalyConnectionBudgetPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AnomalyConnectionBudgetPayload>): AnomalyConnectionBudgetPayload {
+    const message = createBaseAnomalyConnectionBudgetPayload();
+    message.maximumConnections = object.maximumConnections ?? 0;
+    message.rejectedCount = object.rejectedCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseAnomalyLongRunningQueryPayload(): AnomalyLongRunningQueryPayload {
+  return { queries: [], threshold: undefined };
+}
+
+export const AnomalyLongRunningQueryPayload = {
+  encode(message: AnomalyLongRunningQueryPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.queries) {
+      AnomalyLongRunningQueryPayload_Query.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.threshold !== undefined) {
+      Duration.encode(message.threshold, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AnomalyLongRunningQueryPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomalyLongRunningQueryPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.queries.push(AnomalyLongRunningQueryPayload_Query.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.threshold = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AnomalyLongRunningQueryPayload {
+    return {
+      queries: globalThis.Array.isArray(object?.queries)
+        ? object.queries.map((e: any) => AnomalyLongRunningQueryPayload_Query.fromJSON(e))
+        : [],
+      threshold: isSet(object.threshold) ? Duration.fromJSON(object.threshold) : undefined,
+    };
+  },
+
+  toJSON(message: AnomalyLongRunningQueryPayload): unknown {
+    const obj: any = {};
+    if (message.queries?.length) {
+      obj.queries = message.queries.map((e) => AnomalyLongRunningQueryPayload_Query.toJSON(e));
+    }
+    if (message.threshold !== undefined) {
+      obj.threshold = Duration.toJSON(message.threshold);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AnomalyLongRunningQueryPayload>): AnomalyLongRunningQueryPayload {
+    return AnomalyLongRunningQueryPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AnomalyLongRunningQueryPayload>): AnomalyLongRunningQueryPayload {
+    const message = createBaseAnomalyLongRunningQueryPayload();
+    message.queries = object.queries?.map((e) => AnomalyLongRunningQueryPayload_Query.fromPartial(e)) || [];
+    message.threshold = (object.threshold !== undefined && object.threshold !== null)
+      ? Duration.fromPartial(object.threshold)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAnomalyLongRunningQueryPayload_Query(): AnomalyLongRunningQueryPayload_Query {
+  return {
+    connectionId: "",
+    database: "",
+    user: "",
+    state: "",
+    statement: "",
+    duration: undefined,
+    blockedBy: [],
+    blockingCount: 0,
+  };
+}
+
+export const AnomalyLongRunningQueryPayload_Query = {
+  encode(message: AnomalyLongRunningQueryPayload_Query, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.connectionId !== "") {
+      writer.uint32(10).string(message.connectionId);
+    }
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
+    }
+    if (message.user !== "") {
+      writer.uint32(26).string(message.user);
+    }
+    if (message.state !== "") {
+      writer.uint32(34).string(message.state);
+    }
+    if (message.statement !== "") {
+      writer.uint32(42).string(message.statement);
+    }
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.blockedBy) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.blockingCount !== 0) {
+      writer.uint32(64).int32(message.blockingCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AnomalyLongRunningQueryPayload_Query {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomalyLongRunningQueryPayload_Query();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.connectionId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.state = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.blockedBy.push(reader.string());
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.blockingCount = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AnomalyLongRunningQueryPayload_Query {
+    return {
+      connectionId: isSet(object.connectionId) ? globalThis.String(object.connectionId) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      user: isSet(object.user) ? globalThis.String(object.user) : "",
+      state: isSet(object.state) ? globalThis.String(object.state) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      blockedBy: globalThis.Array.isArray(object?.blockedBy)
+        ? object.blockedBy.map((e: any) => globalThis.String(e))
+        : [],
+      blockingCount: isSet(object.blockingCount) ? globalThis.Number(object.blockingCount) : 0,
+    };
+  },
+
+  toJSON(message: AnomalyLongRunningQueryPayload_Query): unknown {
+    const obj: any = {};
+    if (message.connectionId !== "") {
+      obj.connectionId = message.connectionId;
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    if (message.user !== "") {
+      obj.user = message.user;
+    }
+    if (message.state !== "") {
+      obj.state = message.state;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.duration !== undefined) {
+      obj.duration = Duration.toJSON(message.duration);
+    }
+    if (message.blockedBy?.length) {
+      obj.blockedBy = message.blockedBy;
+    }
+    if (message.blockingCount !== 0) {
+      obj.blockingCount = Math.round(message.blockingCount);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AnomalyLongRunningQueryPayload_Query>): AnomalyLongRunningQueryPayload_Query {
+    return AnomalyLongRunningQueryPayload_Query.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AnomalyLongRunningQueryPayload_Query>): AnomalyLongRunningQueryPayload_Query {
+    const message = createBaseAnomalyLongRunningQueryPayload_Query();
+    message.connectionId = object.connectionId ?? "";
+    message.database = object.database ?? "";
+    message.user = object.user ?? "";
+    message.state = object.state ?? "";
+    message.statement = object.statement ?? "";
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    message.blockedBy = object.blockedBy?.map((e) => e) || [];
+    message.blockingCount = object.blockingCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseAnomalyFailoverPayload(): AnomalyFailoverPayload {
+  return { availabilityGroup: "", previousPrimary: "", currentPrimary: "" };
+}
+
+export const AnomalyFailoverPayload = {
+  encode(message: AnomalyFailoverPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.availabilityGroup !== "") {
+      writer.uint32(10).string(message.availabilityGroup);
+    }
+    if (message.previousPrimary !== "") {
+      writer.uint32(18).string(message.previousPrimary);
+    }
+    if (message.currentPrimary !== "") {
+      writer.uint32(26).string(message.currentPrimary);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AnomalyFailoverPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomalyFailoverPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.availabilityGroup = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previousPrimary = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.currentPrimary = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AnomalyFailoverPayload {
+    return {
+      availabilityGroup: isSet(object.availabilityGroup) ? globalThis.String(object.availabilityGroup) : "",
+      previousPrimary: isSet(object.previousPrimary) ? globalThis.String(object.previousPrimary) : "",
+      currentPrimary: isSet(object.currentPrimary) ? globalThis.String(object.currentPrimary) : "",
+    };
+  },
+
+  toJSON(message: AnomalyFailoverPayload): unknown {
+    const obj: any = {};
+    if (message.availabilityGroup !== "") {
+      obj.availabilityGroup = message.availabilityGroup;
+    }
+    if (message.previousPrimary !== "") {
+      obj.previousPrimary = message.previousPrimary;
+    }
+    if (message.currentPrimary !== "") {
+      obj.currentPrimary = message.currentPrimary;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AnomalyFailoverPayload>): AnomalyFailoverPayload {
+    return AnomalyFailoverPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AnomalyFailoverPayload>): AnomalyFailoverPayload {
+    const message = createBaseAnomalyFailoverPayload();
+    message.availabilityGroup = object.availabilityGroup ?? "";
+    message.previousPrimary = object.previousPrimary ?? "";
+    message.currentPrimary = object.currentPrimary ?? "";
+    return message;
+  },
+};
+
 function createBaseAnomalyDatabaseSchemaDriftPayload(): AnomalyDatabaseSchemaDriftPayload {
-  return { version: "", expect: "", actual: "" };
+  return { version: "", expect: "", actual: "", diff: "" };
 }
 
 export const AnomalyDatabaseSchemaDriftPayload = {
@@ -90,6 +556,9 @@ export const AnomalyDatabaseSchemaDriftPayload = {
     if (message.actual !== "") {
       writer.uint32(26).string(message.actual);
     }
+    if (message.diff !== "") {
+      writer.uint32(34).string(message.diff);
+    }
     return writer;
   },
 
@@ -121,6 +590,13 @@ export const AnomalyDatabaseSchemaDriftPayload = {
 
           message.actual = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.diff = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -135,6 +611,7 @@ export const AnomalyDatabaseSchemaDriftPayload = {
       version: isSet(object.version) ? globalThis.String(object.version) : "",
       expect: isSet(object.expect) ? globalThis.String(object.expect) : "",
       actual: isSet(object.actual) ? globalThis.String(object.actual) : "",
+      diff: isSet(object.diff) ? globalThis.String(object.diff) : "",
     };
   },
 
@@ -149,6 +626,9 @@ export const AnomalyDatabaseSchemaDriftPayload = {
     if (message.actual !== "") {
       obj.actual = message.actual;
     }
+    if (message.diff !== "") {
+      obj.diff = message.diff;
+    }
     return obj;
   },
 
@@ -160,6 +640,81 @@ export const AnomalyDatabaseSchemaDriftPayload = {
     message.version = object.version ?? "";
     message.expect = object.expect ?? "";
     message.actual = object.actual ?? "";
+    message.diff = object.diff ?? "";
+    return message;
+  },
+};
+
+function createBaseAnomalyDatabaseBackupFailedPayload(): AnomalyDatabaseBackupFailedPayload {
+  return { backupRunUid: 0, error: "" };
+}
+
+export const AnomalyDatabaseBackupFailedPayload = {
+  encode(message: AnomalyDatabaseBackupFailedPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.backupRunUid !== 0) {
+      writer.uint32(8).int32(message.backupRunUid);
+    }
+    if (message.error !== "") {
+      writer.uint32(18).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AnomalyDatabaseBackupFailedPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomalyDatabaseBackupFailedPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.backupRunUid = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AnomalyDatabaseBackupFailedPayload {
+    return {
+      backupRunUid: isSet(object.backupRunUid) ? globalThis.Number(object.backupRunUid) : 0,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: AnomalyDatabaseBackupFailedPayload): unknown {
+    const obj: any = {};
+    if (message.backupRunUid !== 0) {
+      obj.backupRunUid = Math.round(message.backupRunUid);
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AnomalyDatabaseBackupFailedPayload>): AnomalyDatabaseBackupFailedPayload {
+    return AnomalyDatabaseBackupFailedPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AnomalyDatabaseBackupFailedPayload>): AnomalyDatabaseBackupFailedPayload {
+    const message = createBaseAnomalyDatabaseBackupFailedPayload();
+    message.backupRunUid = object.backupRunUid ?? 0;
+    message.error = object.error ?? "";
     return message;
   },
 };
@@ -230,7 +230,14 @@ export interface ApprovalNode {
     | undefined;
   /** Format: roles/{role} */
   role?: string | undefined;
-  externalNodeId?: string | undefined;
+  externalNodeId?:
+    | string
+    | undefined;
+  /**
+   * The user group whose members can approve the node.
+   * Format: groups/{email}
+   */
+  group?: string | undefined;
 }
 
 /**
@@ -812,6 +819,7 @@ function createBaseApprovalNode(): ApprovalNode {
     groupValue: undefined,
     role: undefined,
     externalNodeId: undefined,
+    group: undefined,
   };
 }
 
@@ -829,6 +837,9 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.group !== undefined) {
+      writer.uint32(42).string(message.group);
+    }
     return writer;
   },
 
@@ -867,6 +878,13 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.group = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -882,6 +900,7 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? globalThis.String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? globalThis.String(object.externalNodeId) : undefined,
+      group: isSet(object.group) ? globalThis.String(object.group) : undefined,
     };
   },
 
@@ -899,6 +918,9 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       obj.externalNodeId = message.externalNodeId;
     }
+    if (message.group !== undefined) {
+      obj.group = message.group;
+    }
     return obj;
   },
 
@@ -911,6 +933,7 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.group = object.group ?? undefined;
     return message;
   },
 };
@@ -0,0 +1,617 @@
+/* eslint-disable */
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
+import { Timestamp } from "../google/protobuf/timestamp";
+
+export const protobufPackage = "bytebase.store";
+
+export interface BackupSettingPayload {
+  /** The hour of the day in UTC to run the backup, from 0 to 23. */
+  hour: number;
+  /** The day of the week in UTC to run the backup, from 0 (Sunday) to 6. -1 means every day. */
+  dayOfWeek: number;
+  /** The backups older than the retention are purged. Unset means keeping the backups forever. */
+  retention: Duration | undefined;
+  storage:
+    | BackupStorage
+    | undefined;
+  /** The obfuscated passphrase to encrypt the backups. Empty means the backups are not encrypted. */
+  obfuscatedEncryptionKey: string;
+}
+
+/** BackupStorage is the S3-compatible object storage to store the backups. */
+export interface BackupStorage {
+  type: BackupStorage_Type;
+  bucket: string;
+  /** The prefix of the object keys. */
+  prefix: string;
+  region: string;
+  /** The endpoint of the storage, required for MinIO. */
+  endpoint: string;
+  /** The access key id. The default credentials of the Bytebase server are used if it's empty. */
+  accessKeyId: string;
+  obfuscatedSecretAccessKey: string;
+}
+
+export enum BackupStorage_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  S3 = "S3",
+  /** GCS - GCS is accessed by the XML API with the HMAC keys. */
+  GCS = "GCS",
+  MINIO = "MINIO",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function backupStorage_TypeFromJSON(object: any): BackupStorage_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return BackupStorage_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "S3":
+      return BackupStorage_Type.S3;
+    case 2:
+    case "GCS":
+      return BackupStorage_Type.GCS;
+    case 3:
+    case "MINIO":
+      return BackupStorage_Type.MINIO;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BackupStorage_Type.UNRECOGNIZED;
+  }
+}
+
+export function backupStorage_TypeToJSON(object: BackupStorage_Type): string {
+  switch (object) {
+    case BackupStorage_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case BackupStorage_Type.S3:
+      return "S3";
+    case BackupStorage_Type.GCS:
+      return "GCS";
+    case BackupStorage_Type.MINIO:
+      return "MINIO";
+    case BackupStorage_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function backupStorage_TypeToNumber(object: BackupStorage_Type): number {
+  switch (object) {
+    case BackupStorage_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case BackupStorage_Type.S3:
+      return 1;
+    case BackupStorage_Type.GCS:
+      return 2;
+    case BackupStorage_Type.MINIO:
+      return 3;
+    case BackupStorage_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface BackupRunPayload {
+  /** The storage of the backup. It's kept in the backup run so that the backup can be purged after the setting changes. */
+  storage:
+    | BackupStorage
+    | undefined;
+  /** The object key of the backup. */
+  path: string;
+  /** The size of the backup in bytes. */
+  size: Long;
+  /** The error of the failed backup. */
+  error: string;
+  startTime: Date | undefined;
+  finishTime: Date | undefined;
+  encrypted: boolean;
+  /** The backup is created manually rather than by the schedule. */
+  manual: boolean;
+}
+
+function createBaseBackupSettingPayload(): BackupSettingPayload {
+  return { hour: 0, dayOfWeek: 0, retention: undefined, storage: undefined, obfuscatedEncryptionKey: "" };
+}
+
+export const BackupSettingPayload = {
+  encode(message: BackupSettingPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.hour !== 0) {
+      writer.uint32(8).int32(message.hour);
+    }
+    if (message.dayOfWeek !== 0) {
+      writer.uint32(16).int32(message.dayOfWeek);
+    }
+    if (message.retention !== undefined) {
+      Duration.encode(message.retention, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.storage !== undefined) {
+      BackupStorage.encode(message.storage, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.obfuscatedEncryptionKey !== "") {
+      writer.uint32(42).string(message.obfuscatedEncryptionKey);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BackupSettingPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackupSettingPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.hour = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.dayOfWeek = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.retention = Duration.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.storage = BackupStorage.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.obfuscatedEncryptionKey = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BackupSettingPayload {
+    return {
+      hour: isSet(object.hour) ? globalThis.Number(object.hour) : 0,
+      dayOfWeek: isSet(object.dayOfWeek) ? globalThis.Number(object.dayOfWeek) : 0,
+      retention: isSet(object.retention) ? Duration.fromJSON(object.retention) : undefined,
+      storage: isSet(object.storage) ? BackupStorage.fromJSON(object.storage) : undefined,
+      obfuscatedEncryptionKey: isSet(object.obfuscatedEncryptionKey)
+        ? globalThis.String(object.obfuscatedEncryptionKey)
+        : "",
+    };
+  },
+
+  toJSON(message: BackupSettingPayload): unknown {
+    const obj: any = {};
+    if (message.hour !== 0) {
+      obj.hour = Math.round(message.hour);
+    }
+    if (message.dayOfWeek !== 0) {
+      obj.dayOfWeek = Math.round(message.dayOfWeek);
+    }
+    if (message.retention !== undefined) {
+      obj.retention = Duration.toJSON(message.retention);
+    }
+    if (message.storage !== undefined) {
+      obj.storage = BackupStorage.toJSON(message.storage);
+    }
+    if (message.obfuscatedEncryptionKey !== "") {
+      obj.obfuscatedEncryptionKey = message.obfuscatedEncryptionKey;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<BackupSettingPayload>): BackupSettingPayload {
+    return BackupSettingPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BackupSettingPayload>): BackupSettingPayload {
+    const message = createBaseBackupSettingPayload();
+    message.hour = object.hour ?? 0;
+    message.dayOfWeek = object.dayOfWeek ?? 0;
+    message.retention = (object.retention !== undefined && object.retention !== null)
+      ? Duration.fromPartial(object.retention)
+      : undefined;
+    message.storage = (object.storage !== undefined && object.storage !== null)
+      ? BackupStorage.fromPartial(object.storage)
+      : undefined;
+    message.obfuscatedEncryptionKey = object.obfuscatedEncryptionKey ?? "";
+    return message;
+  },
+};
+
+function createBaseBackupStorage(): BackupStorage {
+  return {
+    type: BackupStorage_Type.TYPE_UNSPECIFIED,
+    bucket: "",
+    prefix: "",
+    region: "",
+    endpoint: "",
+    accessKeyId: "",
+    obfuscatedSecretAccessKey: "",
+  };
+}
+
+export const BackupStorage = {
+  encode(message: BackupStorage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== BackupStorage_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(backupStorage_TypeToNumber(message.type));
+    }
+    if (message.bucket !== "") {
+      writer.uint32(18).string(message.bucket);
+    }
+    if (message.prefix !== "") {
+      writer.uint32(26).string(message.prefix);
+    }
+    if (message.region !== "") {
+      writer.uint32(34).string(message.region);
+    }
+    if (message.endpoint !== "") {
+      writer.uint32(42).string(message.endpoint);
+    }
+    if (message.accessKeyId !== "") {
+      writer.uint32(50).string(message.accessKeyId);
+    }
+    if (message.obfuscatedSecretAccessKey !== "") {
+      writer.uint32(58).string(message.obfuscatedSecretAccessKey);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BackupStorage {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackupStorage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = backupStorage_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.bucket = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.region = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.endpoint = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.accessKeyId = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.obfuscatedSecretAccessKey = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BackupStorage {
+    return {
+      type: isSet(object.type) ? backupStorage_TypeFromJSON(object.type) : BackupStorage_Type.TYPE_UNSPECIFIED,
+      bucket: isSet(object.bucket) ? globalThis.String(object.bucket) : "",
+      prefix: isSet(object.prefix) ? globalThis.String(object.prefix) : "",
+      region: isSet(object.region) ? globalThis.String(object.region) : "",
+      endpoint: isSet(object.endpoint) ? globalThis.String(object.endpoint) : "",
+      accessKeyId: isSet(object.accessKeyId) ? globalThis.String(object.accessKeyId) : "",
+      obfuscatedSecretAccessKey: isSet(object.obfuscatedSecretAccessKey)
+        ? globalThis.String(object.obfuscatedSecretAccessKey)
+        : "",
+    };
+  },
+
+  toJSON(message: BackupStorage): unknown {
+    const obj: any = {};
+    if (message.type !== BackupStorage_Type.TYPE_UNSPECIFIED) {
+      obj.type = backupStorage_TypeToJSON(message.type);
+    }
+    if (message.bucket !== "") {
+      obj.bucket = message.bucket;
+    }
+    if (message.prefix !== "") {
+      obj.prefix = message.prefix;
+    }
+    if (message.region !== "") {
+      obj.region = message.region;
+    }
+    if (message.endpoint !== "") {
+      obj.endpoint = message.endpoint;
+    }
+    if (message.accessKeyId !== "") {
+      obj.accessKeyId = message.accessKeyId;
+    }
+    if (message.obfuscatedSecretAccessKey !== "") {
+      obj.obfuscatedSecretAccessKey = message.obfuscatedSecretAccessKey;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<BackupStorage>): BackupStorage {
+    return BackupStorage.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BackupStorage>): BackupStorage {
+    const message = createBaseBackupStorage();
+    message.type = object.type ?? BackupStorage_Type.TYPE_UNSPECIFIED;
+    message.bucket = object.bucket ?? "";
+    message.prefix = object.prefix ?? "";
+    message.region = object.region ?? "";
+    message.endpoint = object.endpoint ?? "";
+    message.accessKeyId = object.accessKeyId ?? "";
+    message.obfuscatedSecretAccessKey = object.obfuscatedSecretAccessKey ?? "";
+    return message;
+  },
+};
+
+function createBaseBackupRunPayload(): BackupRunPayload {
+  return {
+    storage: undefined,
+    path: "",
+    size: Long.ZERO,
+    error: "",
+    startTime: undefined,
+    finishTime: undefined,
+    encrypted: false,
+    manual: false,
+  };
+}
+
+export const BackupRunPayload = {
+  encode(message: BackupRunPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.storage !== undefined) {
+      BackupStorage.encode(message.storage, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.path !== "") {
+      writer.uint32(18).string(message.path);
+    }
+    if (!message.size.isZero()) {
+      writer.uint32(24).int64(message.size);
+    }
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.finishTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.finishTime), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.encrypted === true) {
+      writer.uint32(56).bool(message.encrypted);
+    }
+    if (message.manual === true) {
+      writer.uint32(64).bool(message.manual);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BackupRunPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackupRunPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.storage = BackupStorage.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.size = reader.int64() as Long;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.finishTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.encrypted = reader.bool();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.manual = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BackupRunPayload {
+    return {
+      storage: isSet(object.storage) ? BackupStorage.fromJSON(object.storage) : undefined,
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      size: isSet(object.size) ? Long.fromValue(object.size) : Long.ZERO,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      finishTime: isSet(object.finishTime) ? fromJsonTimestamp(object.finishTime) : undefined,
+      encrypted: isSet(object.encrypted) ? globalThis.Boolean(object.encrypted) : false,
+      manual: isSet(object.manual) ? globalThis.Boolean(object.manual) : false,
+    };
+  },
+
+  toJSON(message: BackupRunPayload): unknown {
+    const obj: any = {};
+    if (message.storage !== undefined) {
+      obj.storage = BackupStorage.toJSON(message.storage);
+    }
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (!message.size.isZero()) {
+      obj.size = (message.size || Long.ZERO).toString();
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
+    }
+    if (message.finishTime !== undefined) {
+      obj.finishTime = message.finishTime.toISOString();
+    }
+    if (message.encrypted === true) {
+      obj.encrypted = message.encrypted;
+    }
+    if (message.manual === true) {
+      obj.manual = message.manual;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<BackupRunPayload>): BackupRunPayload {
+    return BackupRunPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BackupRunPayload>): BackupRunPayload {
+    const message = createBaseBackupRunPayload();
+    message.storage = (object.storage !== undefined && object.storage !== null)
+      ? BackupStorage.fromPartial(object.storage)
+      : undefined;
+    message.path = object.path ?? "";
+    message.size = (object.size !== undefined && object.size !== null) ? Long.fromValue(object.size) : Long.ZERO;
+    message.error = object.error ?? "";
+    message.startTime = object.startTime ?? undefined;
+    message.finishTime = object.finishTime ?? undefined;
+    message.encrypted = object.encrypted ?? false;
+    message.manual = object.manual ?? false;
+    return message;
+  },
+};
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Long ? string | number | Long : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
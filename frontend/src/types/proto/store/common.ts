@@ -30,6 +30,7 @@ export enum Engine {
   BIGQUERY = "BIGQUERY",
   DYNAMODB = "DYNAMODB",
   DATABRICKS = "DATABRICKS",
+  DUCKDB = "DUCKDB",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -110,6 +111,9 @@ export function engineFromJSON(object: any): Engine {
     case 24:
     case "DATABRICKS":
       return Engine.DATABRICKS;
+    case 25:
+    case "DUCKDB":
+      return Engine.DUCKDB;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -169,6 +173,8 @@ export function engineToJSON(object: Engine): string {
       return "DYNAMODB";
     case Engine.DATABRICKS:
       return "DATABRICKS";
+    case Engine.DUCKDB:
+      return "DUCKDB";
     case Engine.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -227,6 +233,8 @@ export function engineToNumber(object: Engine): number {
       return 23;
     case Engine.DATABRICKS:
       return 24;
+    case Engine.DUCKDB:
+      return 25;
     case Engine.UNRECOGNIZED:
     default:
       return -1;
@@ -436,6 +444,126 @@ export function exportFormatToNumber(object: ExportFormat): number {
   }
 }
 
+/** DataLoadFormat is the format of the files loaded by the data load task. */
+export enum DataLoadFormat {
+  DATA_LOAD_FORMAT_UNSPECIFIED = "DATA_LOAD_FORMAT_UNSPECIFIED",
+  DATA_LOAD_FORMAT_CSV = "DATA_LOAD_FORMAT_CSV",
+  DATA_LOAD_FORMAT_PARQUET = "DATA_LOAD_FORMAT_PARQUET",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function dataLoadFormatFromJSON(object: any): DataLoadFormat {
+  switch (object) {
+    case 0:
+    case "DATA_LOAD_FORMAT_UNSPECIFIED":
+      return DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED;
+    case 1:
+    case "DATA_LOAD_FORMAT_CSV":
+      return DataLoadFormat.DATA_LOAD_FORMAT_CSV;
+    case 2:
+    case "DATA_LOAD_FORMAT_PARQUET":
+      return DataLoadFormat.DATA_LOAD_FORMAT_PARQUET;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DataLoadFormat.UNRECOGNIZED;
+  }
+}
+
+export function dataLoadFormatToJSON(object: DataLoadFormat): string {
+  switch (object) {
+    case DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED:
+      return "DATA_LOAD_FORMAT_UNSPECIFIED";
+    case DataLoadFormat.DATA_LOAD_FORMAT_CSV:
+      return "DATA_LOAD_FORMAT_CSV";
+    case DataLoadFormat.DATA_LOAD_FORMAT_PARQUET:
+      return "DATA_LOAD_FORMAT_PARQUET";
+    case DataLoadFormat.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function dataLoadFormatToNumber(object: DataLoadFormat): number {
+  switch (object) {
+    case DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED:
+      return 0;
+    case DataLoadFormat.DATA_LOAD_FORMAT_CSV:
+      return 1;
+    case DataLoadFormat.DATA_LOAD_FORMAT_PARQUET:
+      return 2;
+    case DataLoadFormat.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** TransactionMode is how the statements of a change task are run in transactions. */
+export enum TransactionMode {
+  /** TRANSACTION_MODE_UNSPECIFIED - The default behavior of the engine driver. */
+  TRANSACTION_MODE_UNSPECIFIED = "TRANSACTION_MODE_UNSPECIFIED",
+  /** TRANSACTION_MODE_SINGLE - All statements are run in a single transaction, and rolled back together on failure. */
+  TRANSACTION_MODE_SINGLE = "TRANSACTION_MODE_SINGLE",
+  /** TRANSACTION_MODE_AUTOCOMMIT - Each statement is committed on its own, the statements run before the failed one are kept. */
+  TRANSACTION_MODE_AUTOCOMMIT = "TRANSACTION_MODE_AUTOCOMMIT",
+  /** TRANSACTION_MODE_MANUAL - The statements are run as is, and the transactions are controlled by the BEGIN and COMMIT statements in the sheet. */
+  TRANSACTION_MODE_MANUAL = "TRANSACTION_MODE_MANUAL",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function transactionModeFromJSON(object: any): TransactionMode {
+  switch (object) {
+    case 0:
+    case "TRANSACTION_MODE_UNSPECIFIED":
+      return TransactionMode.TRANSACTION_MODE_UNSPECIFIED;
+    case 1:
+    case "TRANSACTION_MODE_SINGLE":
+      return TransactionMode.TRANSACTION_MODE_SINGLE;
+    case 2:
+    case "TRANSACTION_MODE_AUTOCOMMIT":
+      return TransactionMode.TRANSACTION_MODE_AUTOCOMMIT;
+    case 3:
+    case "TRANSACTION_MODE_MANUAL":
+      return TransactionMode.TRANSACTION_MODE_MANUAL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TransactionMode.UNRECOGNIZED;
+  }
+}
+
+export function transactionModeToJSON(object: TransactionMode): string {
+  switch (object) {
+    case TransactionMode.TRANSACTION_MODE_UNSPECIFIED:
+      return "TRANSACTION_MODE_UNSPECIFIED";
+    case TransactionMode.TRANSACTION_MODE_SINGLE:
+      return "TRANSACTION_MODE_SINGLE";
+    case TransactionMode.TRANSACTION_MODE_AUTOCOMMIT:
+      return "TRANSACTION_MODE_AUTOCOMMIT";
+    case TransactionMode.TRANSACTION_MODE_MANUAL:
+      return "TRANSACTION_MODE_MANUAL";
+    case TransactionMode.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function transactionModeToNumber(object: TransactionMode): number {
+  switch (object) {
+    case TransactionMode.TRANSACTION_MODE_UNSPECIFIED:
+      return 0;
+    case TransactionMode.TRANSACTION_MODE_SINGLE:
+      return 1;
+    case TransactionMode.TRANSACTION_MODE_AUTOCOMMIT:
+      return 2;
+    case TransactionMode.TRANSACTION_MODE_MANUAL:
+      return 3;
+    case TransactionMode.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 /** Used internally for obfuscating the page token. */
 export interface PageToken {
   limit: number;
@@ -457,6 +585,74 @@ export interface DatabaseLabel {
   value: string;
 }
 
+/** VerificationQuery is a query that runs after a change is applied to verify the result. */
+export interface VerificationQuery {
+  /** The title of the verification. */
+  title: string;
+  /** The read-only statement to run. */
+  statement: string;
+  type: VerificationQuery_Type;
+  expectedRowCount: Long;
+  /** The bounds are inclusive, and an unset bound is unbounded. */
+  minValue?: number | undefined;
+  maxValue?: number | undefined;
+}
+
+export enum VerificationQuery_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  /** ROW_COUNT_EQUALS - The number of the returned rows equals expected_row_count. */
+  ROW_COUNT_EQUALS = "ROW_COUNT_EQUALS",
+  /** VALUE_IN_RANGE - The value of the first column in the first returned row is within [min_value, max_value]. */
+  VALUE_IN_RANGE = "VALUE_IN_RANGE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function verificationQuery_TypeFromJSON(object: any): VerificationQuery_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return VerificationQuery_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "ROW_COUNT_EQUALS":
+      return VerificationQuery_Type.ROW_COUNT_EQUALS;
+    case 2:
+    case "VALUE_IN_RANGE":
+      return VerificationQuery_Type.VALUE_IN_RANGE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return VerificationQuery_Type.UNRECOGNIZED;
+  }
+}
+
+export function verificationQuery_TypeToJSON(object: VerificationQuery_Type): string {
+  switch (object) {
+    case VerificationQuery_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case VerificationQuery_Type.ROW_COUNT_EQUALS:
+      return "ROW_COUNT_EQUALS";
+    case VerificationQuery_Type.VALUE_IN_RANGE:
+      return "VALUE_IN_RANGE";
+    case VerificationQuery_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function verificationQuery_TypeToNumber(object: VerificationQuery_Type): number {
+  switch (object) {
+    case VerificationQuery_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case VerificationQuery_Type.ROW_COUNT_EQUALS:
+      return 1;
+    case VerificationQuery_Type.VALUE_IN_RANGE:
+      return 2;
+    case VerificationQuery_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 function createBasePageToken(): PageToken {
   return { limit: 0, offset: 0 };
 }
@@ -753,6 +949,149 @@ export const DatabaseLabel = {
   },
 };
 
+function createBaseVerificationQuery(): VerificationQuery {
+  return {
+    title: "",
+    statement: "",
+    type: VerificationQuery_Type.TYPE_UNSPECIFIED,
+    expectedRowCount: Long.ZERO,
+    minValue: undefined,
+    maxValue: undefined,
+  };
+}
+
+export const VerificationQuery = {
+  encode(message: VerificationQuery, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.statement !== "") {
+      writer.uint32(18).string(message.statement);
+    }
+    if (message.type !== VerificationQuery_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(24).int32(verificationQuery_TypeToNumber(message.type));
+    }
+    if (!message.expectedRowCount.isZero()) {
+      writer.uint32(32).int64(message.expectedRowCount);
+    }
+    if (message.minValue !== undefined) {
+      writer.uint32(41).double(message.minValue);
+    }
+    if (message.maxValue !== undefined) {
+      writer.uint32(49).double(message.maxValue);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VerificationQuery {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVerificationQuery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.type = verificationQuery_TypeFromJSON(reader.int32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.expectedRowCount = reader.int64() as Long;
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.minValue = reader.double();
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.maxValue = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VerificationQuery {
+    return {
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      type: isSet(object.type) ? verificationQuery_TypeFromJSON(object.type) : VerificationQuery_Type.TYPE_UNSPECIFIED,
+      expectedRowCount: isSet(object.expectedRowCount) ? Long.fromValue(object.expectedRowCount) : Long.ZERO,
+      minValue: isSet(object.minValue) ? globalThis.Number(object.minValue) : undefined,
+      maxValue: isSet(object.maxValue) ? globalThis.Number(object.maxValue) : undefined,
+    };
+  },
+
+  toJSON(message: VerificationQuery): unknown {
+    const obj: any = {};
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.type !== VerificationQuery_Type.TYPE_UNSPECIFIED) {
+      obj.type = verificationQuery_TypeToJSON(message.type);
+    }
+    if (!message.expectedRowCount.isZero()) {
+      obj.expectedRowCount = (message.expectedRowCount || Long.ZERO).toString();
+    }
+    if (message.minValue !== undefined) {
+      obj.minValue = message.minValue;
+    }
+    if (message.maxValue !== undefined) {
+      obj.maxValue = message.maxValue;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<VerificationQuery>): VerificationQuery {
+    return VerificationQuery.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<VerificationQuery>): VerificationQuery {
+    const message = createBaseVerificationQuery();
+    message.title = object.title ?? "";
+    message.statement = object.statement ?? "";
+    message.type = object.type ?? VerificationQuery_Type.TYPE_UNSPECIFIED;
+    message.expectedRowCount = (object.expectedRowCount !== undefined && object.expectedRowCount !== null)
+      ? Long.fromValue(object.expectedRowCount)
+      : Long.ZERO;
+    message.minValue = object.minValue ?? undefined;
+    message.maxValue = object.maxValue ?? undefined;
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
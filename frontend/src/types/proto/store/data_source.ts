@@ -1,6 +1,7 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 
 export const protobufPackage = "bytebase.store";
 
@@ -9,6 +10,7 @@ export interface DataSourceExternalSecret {
   url: string;
   authType: DataSourceExternalSecret_AuthType;
   appRole?: DataSourceExternalSecret_AppRoleAuthOption | undefined;
+  kubernetes?: DataSourceExternalSecret_KubernetesAuthOption | undefined;
   token?:
     | string
     | undefined;
@@ -18,6 +20,11 @@ export interface DataSourceExternalSecret {
   secretName: string;
   /** the key name for the password. */
   passwordKeyName: string;
+  /**
+   * How long the fetched secret is cached before it is fetched again.
+   * The default is 5 minutes if unset.
+   */
+  cacheTtl: Duration | undefined;
 }
 
 export enum DataSourceExternalSecret_SecretType {
@@ -90,6 +97,8 @@ export enum DataSourceExternalSecret_AuthType {
   TOKEN = "TOKEN",
   /** VAULT_APP_ROLE - ref: https://developer.hashicorp.com/vault/docs/auth/approle */
   VAULT_APP_ROLE = "VAULT_APP_ROLE",
+  /** VAULT_KUBERNETES - ref: https://developer.hashicorp.com/vault/docs/auth/kubernetes */
+  VAULT_KUBERNETES = "VAULT_KUBERNETES",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -104,6 +113,9 @@ export function dataSourceExternalSecret_AuthTypeFromJSON(object: any): DataSour
     case 2:
     case "VAULT_APP_ROLE":
       return DataSourceExternalSecret_AuthType.VAULT_APP_ROLE;
+    case 3:
+    case "VAULT_KUBERNETES":
+      return DataSourceExternalSecret_AuthType.VAULT_KUBERNETES;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -119,6 +131,8 @@ export function dataSourceExternalSecret_AuthTypeToJSON(object: DataSourceExtern
       return "TOKEN";
     case DataSourceExternalSecret_AuthType.VAULT_APP_ROLE:
       return "VAULT_APP_ROLE";
+    case DataSourceExternalSecret_AuthType.VAULT_KUBERNETES:
+      return "VAULT_KUBERNETES";
     case DataSourceExternalSecret_AuthType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -133,6 +147,8 @@ export function dataSourceExternalSecret_AuthTypeToNumber(object: DataSourceExte
       return 1;
     case DataSourceExternalSecret_AuthType.VAULT_APP_ROLE:
       return 2;
+    case DataSourceExternalSecret_AuthType.VAULT_KUBERNETES:
+      return 3;
     case DataSourceExternalSecret_AuthType.UNRECOGNIZED:
     default:
       return -1;
@@ -207,6 +223,21 @@ export function dataSourceExternalSecret_AppRoleAuthOption_SecretTypeToNumber(
   }
 }
 
+export interface DataSourceExternalSecret_KubernetesAuthOption {
+  /** The Vault role bound to the Bytebase service account. */
+  role: string;
+  /**
+   * The path to the service account token.
+   * The default is /var/run/secrets/kubernetes.io/serviceaccount/token.
+   */
+  serviceAccountTokenPath: string;
+  /**
+   * The path where the kubernetes auth method is mounted.
+   * The default is "kubernetes".
+   */
+  mountPath: string;
+}
+
 export interface DataSourceOptions {
   /** srv is a boolean flag that indicates whether the host is a DNS SRV record. */
   srv: boolean;
@@ -258,6 +289,11 @@ export interface DataSourceOptions {
   redisType: DataSourceOptions_RedisType;
   /** Use SSL to connect to the data source. By default, we use system default SSL configuration. */
   useSsl: boolean;
+  /**
+   * aws_role_arn is the IAM role assumed to generate the authentication token for AWS RDS IAM authentication.
+   * The default AWS credentials of the Bytebase server are used if it's empty.
+   */
+  awsRoleArn: string;
 }
 
 export enum DataSourceOptions_AuthenticationType {
@@ -407,10 +443,12 @@ function createBaseDataSourceExternalSecret(): DataSourceExternalSecret {
     url: "",
     authType: DataSourceExternalSecret_AuthType.AUTH_TYPE_UNSPECIFIED,
     appRole: undefined,
+    kubernetes: undefined,
     token: undefined,
     engineName: "",
     secretName: "",
     passwordKeyName: "",
+    cacheTtl: undefined,
   };
 }
 
@@ -428,6 +466,9 @@ export const DataSourceExternalSecret = {
     if (message.appRole !== undefined) {
       DataSourceExternalSecret_AppRoleAuthOption.encode(message.appRole, writer.uint32(34).fork()).ldelim();
     }
+    if (message.kubernetes !== undefined) {
+      DataSourceExternalSecret_KubernetesAuthOption.encode(message.kubernetes, writer.uint32(74).fork()).ldelim();
+    }
     if (message.token !== undefined) {
       writer.uint32(42).string(message.token);
     }
@@ -440,6 +481,9 @@ export const DataSourceExternalSecret = {
     if (message.passwordKeyName !== "") {
       writer.uint32(66).string(message.passwordKeyName);
     }
+    if (message.cacheTtl !== undefined) {
+      Duration.encode(message.cacheTtl, writer.uint32(82).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -478,6 +522,13 @@ export const DataSourceExternalSecret = {
 
           message.appRole = DataSourceExternalSecret_AppRoleAuthOption.decode(reader, reader.uint32());
           continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.kubernetes = DataSourceExternalSecret_KubernetesAuthOption.decode(reader, reader.uint32());
+          continue;
         case 5:
           if (tag !== 42) {
             break;
@@ -506,6 +557,13 @@ export const DataSourceExternalSecret = {
 
           message.passwordKeyName = reader.string();
           continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.cacheTtl = Duration.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -525,10 +583,14 @@ export const DataSourceExternalSecret = {
         ? dataSourceExternalSecret_AuthTypeFromJSON(object.authType)
         : DataSourceExternalSecret_AuthType.AUTH_TYPE_UNSPECIFIED,
       appRole: isSet(object.appRole) ? DataSourceExternalSecret_AppRoleAuthOption.fromJSON(object.appRole) : undefined,
+      kubernetes: isSet(object.kubernetes)
+        ? DataSourceExternalSecret_KubernetesAuthOption.fromJSON(object.kubernetes)
+        : undefined,
       token: isSet(object.token) ? globalThis.String(object.token) : undefined,
       engineName: isSet(object.engineName) ? globalThis.String(object.engineName) : "",
       secretName: isSet(object.secretName) ? globalThis.String(object.secretName) : "",
       passwordKeyName: isSet(object.passwordKeyName) ? globalThis.String(object.passwordKeyName) : "",
+      cacheTtl: isSet(object.cacheTtl) ? Duration.fromJSON(object.cacheTtl) : undefined,
     };
   },
 
@@ -546,6 +608,9 @@ export const DataSourceExternalSecret = {
     if (message.appRole !== undefined) {
       obj.appRole = DataSourceExternalSecret_AppRoleAuthOption.toJSON(message.appRole);
     }
+    if (message.kubernetes !== undefined) {
+      obj.kubernetes = DataSourceExternalSecret_KubernetesAuthOption.toJSON(message.kubernetes);
+    }
     if (message.token !== undefined) {
       obj.token = message.token;
     }
@@ -558,6 +623,9 @@ export const DataSourceExternalSecret = {
     if (message.passwordKeyName !== "") {
       obj.passwordKeyName = message.passwordKeyName;
     }
+    if (message.cacheTtl !== undefined) {
+      obj.cacheTtl = Duration.toJSON(message.cacheTtl);
+    }
     return obj;
   },
 
@@ -572,10 +640,16 @@ export const DataSourceExternalSecret = {
     message.appRole = (object.appRole !== undefined && object.appRole !== null)
       ? DataSourceExternalSecret_AppRoleAuthOption.fromPartial(object.appRole)
       : undefined;
+    message.kubernetes = (object.kubernetes !== undefined && object.kubernetes !== null)
+      ? DataSourceExternalSecret_KubernetesAuthOption.fromPartial(object.kubernetes)
+      : undefined;
     message.token = object.token ?? undefined;
     message.engineName = object.engineName ?? "";
     message.secretName = object.secretName ?? "";
     message.passwordKeyName = object.passwordKeyName ?? "";
+    message.cacheTtl = (object.cacheTtl !== undefined && object.cacheTtl !== null)
+      ? Duration.fromPartial(object.cacheTtl)
+      : undefined;
     return message;
   },
 };
@@ -693,6 +767,101 @@ export const DataSourceExternalSecret_AppRoleAuthOption = {
   },
 };
 
+function createBaseDataSourceExternalSecret_KubernetesAuthOption(): DataSourceExternalSecret_KubernetesAuthOption {
+  return { role: "", serviceAccountTokenPath: "", mountPath: "" };
+}
+
+export const DataSourceExternalSecret_KubernetesAuthOption = {
+  encode(message: DataSourceExternalSecret_KubernetesAuthOption, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.role !== "") {
+      writer.uint32(10).string(message.role);
+    }
+    if (message.serviceAccountTokenPath !== "") {
+      writer.uint32(18).string(message.serviceAccountTokenPath);
+    }
+    if (message.mountPath !== "") {
+      writer.uint32(26).string(message.mountPath);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceExternalSecret_KubernetesAuthOption {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDataSourceExternalSecret_KubernetesAuthOption();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.serviceAccountTokenPath = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.mountPath = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DataSourceExternalSecret_KubernetesAuthOption {
+    return {
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      serviceAccountTokenPath: isSet(object.serviceAccountTokenPath)
+        ? globalThis.String(object.serviceAccountTokenPath)
+        : "",
+      mountPath: isSet(object.mountPath) ? globalThis.String(object.mountPath) : "",
+    };
+  },
+
+  toJSON(message: DataSourceExternalSecret_KubernetesAuthOption): unknown {
+    const obj: any = {};
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.serviceAccountTokenPath !== "") {
+      obj.serviceAccountTokenPath = message.serviceAccountTokenPath;
+    }
+    if (message.mountPath !== "") {
+      obj.mountPath = message.mountPath;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<DataSourceExternalSecret_KubernetesAuthOption>,
+  ): DataSourceExternalSecret_KubernetesAuthOption {
+    return DataSourceExternalSecret_KubernetesAuthOption.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<DataSourceExternalSecret_KubernetesAuthOption>,
+  ): DataSourceExternalSecret_KubernetesAuthOption {
+    const message = createBaseDataSourceExternalSecret_KubernetesAuthOption();
+    message.role = object.role ?? "";
+    message.serviceAccountTokenPath = object.serviceAccountTokenPath ?? "";
+    message.mountPath = object.mountPath ?? "";
+    return message;
+  },
+};
+
 function createBaseDataSourceOptions(): DataSourceOptions {
   return {
     srv: false,
@@ -719,6 +888,7 @@ function createBaseDataSourceOptions(): DataSourceOptions {
     masterObfuscatedPassword: "",
     redisType: DataSourceOptions_RedisType.REDIS_TYPE_UNSPECIFIED,
     useSsl: false,
+    awsRoleArn: "",
   };
 }
 
@@ -796,6 +966,9 @@ export const DataSourceOptions = {
     if (message.useSsl === true) {
       writer.uint32(192).bool(message.useSsl);
     }
+    if (message.awsRoleArn !== "") {
+      writer.uint32(202).string(message.awsRoleArn);
+    }
     return writer;
   },
 
@@ -974,6 +1147,13 @@ export const DataSourceOptions = {
 
           message.useSsl = reader.bool();
           continue;
+        case 25:
+          if (tag !== 202) {
+            break;
+          }
+
+          message.awsRoleArn = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1025,6 +1205,7 @@ export const DataSourceOptions = {
         ? dataSourceOptions_RedisTypeFromJSON(object.redisType)
         : DataSourceOptions_RedisType.REDIS_TYPE_UNSPECIFIED,
       useSsl: isSet(object.useSsl) ? globalThis.Boolean(object.useSsl) : false,
+      awsRoleArn: isSet(object.awsRoleArn) ? globalThis.String(object.awsRoleArn) : "",
     };
   },
 
@@ -1102,6 +1283,9 @@ export const DataSourceOptions = {
     if (message.useSsl === true) {
       obj.useSsl = message.useSsl;
     }
+    if (message.awsRoleArn !== "") {
+      obj.awsRoleArn = message.awsRoleArn;
+    }
     return obj;
   },
 
@@ -1140,6 +1324,7 @@ export const DataSourceOptions = {
     message.masterObfuscatedPassword = object.masterObfuscatedPassword ?? "";
     message.redisType = object.redisType ?? DataSourceOptions_RedisType.REDIS_TYPE_UNSPECIFIED;
     message.useSsl = object.useSsl ?? false;
+    message.awsRoleArn = object.awsRoleArn ?? "";
     return message;
   },
 };
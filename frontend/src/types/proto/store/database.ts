@@ -3,6 +3,7 @@ import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Timestamp } from "../google/protobuf/timestamp";
 import { StringValue } from "../google/protobuf/wrappers";
+import { DataSourceExternalSecret } from "./data_source";
 
 export const protobufPackage = "bytebase.store";
 
@@ -33,6 +34,10 @@ export interface DatabaseSchemaMetadata {
   /** The service name of the database. It's the Oracle specific concept. */
   serviceName: string;
   linkedDatabases: LinkedDatabaseMetadata[];
+  /** The index_templates is the list of index templates. It's the Elasticsearch and OpenSearch specific concept. */
+  indexTemplates: IndexTemplateMetadata[];
+  /** The lifecycle_policies is the list of index lifecycle policies. It's the Elasticsearch and OpenSearch specific concept. */
+  lifecyclePolicies: LifecyclePolicyMetadata[];
 }
 
 /**
@@ -306,6 +311,31 @@ export interface TableMetadata {
   partitions: TablePartitionMetadata[];
   /** The check_constraints is the list of check constraints in a table. */
   checkConstraints: CheckConstraintMetadata[];
+  /** The validator is the document validator of a MongoDB collection in relaxed extended JSON. */
+  validator: string;
+  /** The shard_key is the shard key of a sharded MongoDB collection in relaxed extended JSON. */
+  shardKey: string;
+  /** The dynamic_partition is the dynamic partition of a Doris table. */
+  dynamicPartition: DynamicPartitionMetadata | undefined;
+}
+
+/** DynamicPartitionMetadata is the metadata for the dynamic partition of a Doris table. */
+export interface DynamicPartitionMetadata {
+  /** The enabled is whether the dynamic partition is enabled. */
+  enabled: boolean;
+  /** The time_unit is the unit of the dynamic partitions, such as DAY, WEEK and MONTH. */
+  timeUnit: string;
+  /**
+   * The start is the offset of the earliest partition to keep, the earlier partitions are dropped.
+   * It's the minimum int32 if the history partitions are never dropped.
+   */
+  start: number;
+  /** The end is the offset of the latest partition to create in advance. */
+  end: number;
+  /** The prefix is the name prefix of the dynamic partitions. */
+  prefix: string;
+  /** The buckets is the bucket number of the dynamic partitions. */
+  buckets: number;
 }
 
 export interface CheckConstraintMetadata {
@@ -640,6 +670,13 @@ export interface IndexMetadata {
   comment: string;
   /** The definition of an index. */
   definition: string;
+  /** The parameters are the storage parameters of an index, e.g. m and ef_construction of pgvector hnsw indexes, lists of ivfflat indexes. */
+  parameters: { [key: string]: string };
+}
+
+export interface IndexMetadata_ParametersEntry {
+  key: string;
+  value: string;
 }
 
 /** ExtensionMetadata is the metadata for extensions. */
@@ -654,6 +691,32 @@ export interface ExtensionMetadata {
   description: string;
 }
 
+/** IndexTemplateMetadata is the metadata for Elasticsearch and OpenSearch composable index templates. */
+export interface IndexTemplateMetadata {
+  /** The name is the name of an index template. */
+  name: string;
+  /** The index_patterns is the list of index name patterns the template applies to. */
+  indexPatterns: string[];
+  /** The priority decides the template applied when multiple templates match an index. */
+  priority: Long;
+  /** The composed_of is the list of component templates the template is composed of. */
+  composedOf: string[];
+  /** The mappings is the field mappings of the template in JSON. */
+  mappings: string;
+  /** The settings is the index settings of the template in JSON. */
+  settings: string;
+  /** The definition is the whole template body in JSON, which could be used in the PUT template API directly. */
+  definition: string;
+}
+
+/** LifecyclePolicyMetadata is the metadata for Elasticsearch ILM policies and OpenSearch ISM policies. */
+export interface LifecyclePolicyMetadata {
+  /** The name is the name of a lifecycle policy. */
+  name: string;
+  /** The definition is the policy body in JSON. */
+  definition: string;
+}
+
 /** ForeignKeyMetadata is the metadata for foreign keys. */
 export interface ForeignKeyMetadata {
   /** The name is the name of a foreign key. */
@@ -701,6 +764,81 @@ export interface SecretItem {
   value: string;
   /** The description is the description of the secret. */
   description: string;
+  /**
+   * The external_secret is the external secret manager storing the value, e.g. Vault.
+   * If set, the value is fetched at execution time instead of stored in Bytebase.
+   */
+  externalSecret: DataSourceExternalSecret | undefined;
+}
+
+/** ClassificationSuggestionPayload is the payload of the sensitive data classification suggested by the PII detection. */
+export interface ClassificationSuggestionPayload {
+  label: ClassificationSuggestionPayload_Label;
+  /** The column name matches the label. */
+  nameMatched: boolean;
+  /** The ratio of the sampled values matching the label, from 0 to 1. */
+  dataMatchRatio: number;
+}
+
+export enum ClassificationSuggestionPayload_Label {
+  LABEL_UNSPECIFIED = "LABEL_UNSPECIFIED",
+  EMAIL = "EMAIL",
+  PHONE = "PHONE",
+  NATIONAL_ID = "NATIONAL_ID",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function classificationSuggestionPayload_LabelFromJSON(object: any): ClassificationSuggestionPayload_Label {
+  switch (object) {
+    case 0:
+    case "LABEL_UNSPECIFIED":
+      return ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED;
+    case 1:
+    case "EMAIL":
+      return ClassificationSuggestionPayload_Label.EMAIL;
+    case 2:
+    case "PHONE":
+      return ClassificationSuggestionPayload_Label.PHONE;
+    case 3:
+    case "NATIONAL_ID":
+      return ClassificationSuggestionPayload_Label.NATIONAL_ID;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ClassificationSuggestionPayload_Label.UNRECOGNIZED;
+  }
+}
+
+export function classificationSuggestionPayload_LabelToJSON(object: ClassificationSuggestionPayload_Label): string {
+  switch (object) {
+    case ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED:
+      return "LABEL_UNSPECIFIED";
+    case ClassificationSuggestionPayload_Label.EMAIL:
+      return "EMAIL";
+    case ClassificationSuggestionPayload_Label.PHONE:
+      return "PHONE";
+    case ClassificationSuggestionPayload_Label.NATIONAL_ID:
+      return "NATIONAL_ID";
+    case ClassificationSuggestionPayload_Label.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function classificationSuggestionPayload_LabelToNumber(object: ClassificationSuggestionPayload_Label): number {
+  switch (object) {
+    case ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED:
+      return 0;
+    case ClassificationSuggestionPayload_Label.EMAIL:
+      return 1;
+    case ClassificationSuggestionPayload_Label.PHONE:
+      return 2;
+    case ClassificationSuggestionPayload_Label.NATIONAL_ID:
+      return 3;
+    case ClassificationSuggestionPayload_Label.UNRECOGNIZED:
+    default:
+      return -1;
+  }
 }
 
 export interface DatabaseConfig {
@@ -998,6 +1136,8 @@ function createBaseDatabaseSchemaMetadata(): DatabaseSchemaMetadata {
     datashare: false,
     serviceName: "",
     linkedDatabases: [],
+    indexTemplates: [],
+    lifecyclePolicies: [],
   };
 }
 
@@ -1027,6 +1167,12 @@ export const DatabaseSchemaMetadata = {
     for (const v of message.linkedDatabases) {
       LinkedDatabaseMetadata.encode(v!, writer.uint32(66).fork()).ldelim();
     }
+    for (const v of message.indexTemplates) {
+      IndexTemplateMetadata.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+    for (const v of message.lifecyclePolicies) {
+      LifecyclePolicyMetadata.encode(v!, writer.uint32(82).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1093,6 +1239,20 @@ export const DatabaseSchemaMetadata = {
 
           message.linkedDatabases.push(LinkedDatabaseMetadata.decode(reader, reader.uint32()));
           continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.indexTemplates.push(IndexTemplateMetadata.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.lifecyclePolicies.push(LifecyclePolicyMetadata.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1118,6 +1278,12 @@ export const DatabaseSchemaMetadata = {
       linkedDatabases: globalThis.Array.isArray(object?.linkedDatabases)
         ? object.linkedDatabases.map((e: any) => LinkedDatabaseMetadata.fromJSON(e))
         : [],
+      indexTemplates: globalThis.Array.isArray(object?.indexTemplates)
+        ? object.indexTemplates.map((e: any) => IndexTemplateMetadata.fromJSON(e))
+        : [],
+      lifecyclePolicies: globalThis.Array.isArray(object?.lifecyclePolicies)
+        ? object.lifecyclePolicies.map((e: any) => LifecyclePolicyMetadata.fromJSON(e))
+        : [],
     };
   },
 
@@ -1147,6 +1313,12 @@ export const DatabaseSchemaMetadata = {
     if (message.linkedDatabases?.length) {
       obj.linkedDatabases = message.linkedDatabases.map((e) => LinkedDatabaseMetadata.toJSON(e));
     }
+    if (message.indexTemplates?.length) {
+      obj.indexTemplates = message.indexTemplates.map((e) => IndexTemplateMetadata.toJSON(e));
+    }
+    if (message.lifecyclePolicies?.length) {
+      obj.lifecyclePolicies = message.lifecyclePolicies.map((e) => LifecyclePolicyMetadata.toJSON(e));
+    }
     return obj;
   },
 
@@ -1163,6 +1335,8 @@ export const DatabaseSchemaMetadata = {
     message.datashare = object.datashare ?? false;
     message.serviceName = object.serviceName ?? "";
     message.linkedDatabases = object.linkedDatabases?.map((e) => LinkedDatabaseMetadata.fromPartial(e)) || [];
+    message.indexTemplates = object.indexTemplates?.map((e) => IndexTemplateMetadata.fromPartial(e)) || [];
+    message.lifecyclePolicies = object.lifecyclePolicies?.map((e) => LifecyclePolicyMetadata.fromPartial(e)) || [];
     return message;
   },
 };
@@ -1782,6 +1956,9 @@ function createBaseTableMetadata(): TableMetadata {
     foreignKeys: [],
     partitions: [],
     checkConstraints: [],
+    validator: "",
+    shardKey: "",
+    dynamicPartition: undefined,
   };
 }
 
@@ -1835,6 +2012,15 @@ export const TableMetadata = {
     for (const v of message.checkConstraints) {
       CheckConstraintMetadata.encode(v!, writer.uint32(130).fork()).ldelim();
     }
+    if (message.validator !== "") {
+      writer.uint32(146).string(message.validator);
+    }
+    if (message.shardKey !== "") {
+      writer.uint32(154).string(message.shardKey);
+    }
+    if (message.dynamicPartition !== undefined) {
+      DynamicPartitionMetadata.encode(message.dynamicPartition, writer.uint32(162).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1957,6 +2143,27 @@ export const TableMetadata = {
 
           message.checkConstraints.push(CheckConstraintMetadata.decode(reader, reader.uint32()));
           continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.validator = reader.string();
+          continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.shardKey = reader.string();
+          continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.dynamicPartition = DynamicPartitionMetadata.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1994,6 +2201,11 @@ export const TableMetadata = {
       checkConstraints: globalThis.Array.isArray(object?.checkConstraints)
         ? object.checkConstraints.map((e: any) => CheckConstraintMetadata.fromJSON(e))
         : [],
+      validator: isSet(object.validator) ? globalThis.String(object.validator) : "",
+      shardKey: isSet(object.shardKey) ? globalThis.String(object.shardKey) : "",
+      dynamicPartition: isSet(object.dynamicPartition)
+        ? DynamicPartitionMetadata.fromJSON(object.dynamicPartition)
+        : undefined,
     };
   },
 
@@ -2047,6 +2259,15 @@ export const TableMetadata = {
     if (message.checkConstraints?.length) {
       obj.checkConstraints = message.checkConstraints.map((e) => CheckConstraintMetadata.toJSON(e));
     }
+    if (message.validator !== "") {
+      obj.validator = message.validator;
+    }
+    if (message.shardKey !== "") {
+      obj.shardKey = message.shardKey;
+    }
+    if (message.dynamicPartition !== undefined) {
+      obj.dynamicPartition = DynamicPartitionMetadata.toJSON(message.dynamicPartition);
+    }
     return obj;
   },
 
@@ -2079,6 +2300,145 @@ export const TableMetadata = {
     message.foreignKeys = object.foreignKeys?.map((e) => ForeignKeyMetadata.fromPartial(e)) || [];
     message.partitions = object.partitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
     message.checkConstraints = object.checkConstraints?.map((e) => CheckConstraintMetadata.fromPartial(e)) || [];
+    message.validator = object.validator ?? "";
+    message.shardKey = object.shardKey ?? "";
+    message.dynamicPartition = (object.dynamicPartition !== undefined && object.dynamicPartition !== null)
+      ? DynamicPartitionMetadata.fromPartial(object.dynamicPartition)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseDynamicPartitionMetadata(): DynamicPartitionMetadata {
+  return { enabled: false, timeUnit: "", start: 0, end: 0, prefix: "", buckets: 0 };
+}
+
+export const DynamicPartitionMetadata = {
+  encode(message: DynamicPartitionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.timeUnit !== "") {
+      writer.uint32(18).string(message.timeUnit);
+    }
+    if (message.start !== 0) {
+      writer.uint32(24).int32(message.start);
+    }
+    if (message.end !== 0) {
+      writer.uint32(32).int32(message.end);
+    }
+    if (message.prefix !== "") {
+      writer.uint32(42).string(message.prefix);
+    }
+    if (message.buckets !== 0) {
+      writer.uint32(48).int32(message.buckets);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DynamicPartitionMetadata {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDynamicPartitionMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeUnit = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.start = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.end = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.buckets = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DynamicPartitionMetadata {
+    return {
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+      timeUnit: isSet(object.timeUnit) ? globalThis.String(object.timeUnit) : "",
+      start: isSet(object.start) ? globalThis.Number(object.start) : 0,
+      end: isSet(object.end) ? globalThis.Number(object.end) : 0,
+      prefix: isSet(object.prefix) ? globalThis.String(object.prefix) : "",
+      buckets: isSet(object.buckets) ? globalThis.Number(object.buckets) : 0,
+    };
+  },
+
+  toJSON(message: DynamicPartitionMetadata): unknown {
+    const obj: any = {};
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
+    }
+    if (message.timeUnit !== "") {
+      obj.timeUnit = message.timeUnit;
+    }
+    if (message.start !== 0) {
+      obj.start = Math.round(message.start);
+    }
+    if (message.end !== 0) {
+      obj.end = Math.round(message.end);
+    }
+    if (message.prefix !== "") {
+      obj.prefix = message.prefix;
+    }
+    if (message.buckets !== 0) {
+      obj.buckets = Math.round(message.buckets);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DynamicPartitionMetadata>): DynamicPartitionMetadata {
+    return DynamicPartitionMetadata.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DynamicPartitionMetadata>): DynamicPartitionMetadata {
+    const message = createBaseDynamicPartitionMetadata();
+    message.enabled = object.enabled ?? false;
+    message.timeUnit = object.timeUnit ?? "";
+    message.start = object.start ?? 0;
+    message.end = object.end ?? 0;
+    message.prefix = object.prefix ?? "";
+    message.buckets = object.buckets ?? 0;
     return message;
   },
 };
@@ -3200,6 +3560,7 @@ function createBaseIndexMetadata(): IndexMetadata {
     visible: false,
     comment: "",
     definition: "",
+    parameters: {},
   };
 }
 
@@ -3239,6 +3600,9 @@ export const IndexMetadata = {
     if (message.definition !== "") {
       writer.uint32(66).string(message.definition);
     }
+    Object.entries(message.parameters).forEach(([key, value]) => {
+      IndexMetadata_ParametersEntry.encode({ key: key as any, value }, writer.uint32(90).fork()).ldelim();
+    });
     return writer;
   },
 
@@ -3339,6 +3703,16 @@ export const IndexMetadata = {
 
           message.definition = reader.string();
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          const entry11 = IndexMetadata_ParametersEntry.decode(reader, reader.uint32());
+          if (entry11.value !== undefined) {
+            message.parameters[entry11.key] = entry11.value;
+          }
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3364,6 +3738,12 @@ export const IndexMetadata = {
       visible: isSet(object.visible) ? globalThis.Boolean(object.visible) : false,
       comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
       definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      parameters: isObject(object.parameters)
+        ? Object.entries(object.parameters).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
@@ -3399,6 +3779,15 @@ export const IndexMetadata = {
     if (message.definition !== "") {
       obj.definition = message.definition;
     }
+    if (message.parameters) {
+      const entries = Object.entries(message.parameters);
+      if (entries.length > 0) {
+        obj.parameters = {};
+        entries.forEach(([k, v]) => {
+          obj.parameters[k] = v;
+        });
+      }
+    }
     return obj;
   },
 
@@ -3417,6 +3806,89 @@ export const IndexMetadata = {
     message.visible = object.visible ?? false;
     message.comment = object.comment ?? "";
     message.definition = object.definition ?? "";
+    message.parameters = Object.entries(object.parameters ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    return message;
+  },
+};
+
+function createBaseIndexMetadata_ParametersEntry(): IndexMetadata_ParametersEntry {
+  return { key: "", value: "" };
+}
+
+export const IndexMetadata_ParametersEntry = {
+  encode(message: IndexMetadata_ParametersEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexMetadata_ParametersEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIndexMetadata_ParametersEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IndexMetadata_ParametersEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: IndexMetadata_ParametersEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IndexMetadata_ParametersEntry>): IndexMetadata_ParametersEntry {
+    return IndexMetadata_ParametersEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IndexMetadata_ParametersEntry>): IndexMetadata_ParametersEntry {
+    const message = createBaseIndexMetadata_ParametersEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
@@ -3525,6 +3997,243 @@ export const ExtensionMetadata = {
   },
 };
 
+function createBaseIndexTemplateMetadata(): IndexTemplateMetadata {
+  return {
+    name: "",
+    indexPatterns: [],
+    priority: Long.ZERO,
+    composedOf: [],
+    mappings: "",
+    settings: "",
+    definition: "",
+  };
+}
+
+export const IndexTemplateMetadata = {
+  encode(message: IndexTemplateMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.indexPatterns) {
+      writer.uint32(18).string(v!);
+    }
+    if (!message.priority.isZero()) {
+      writer.uint32(24).int64(message.priority);
+    }
+    for (const v of message.composedOf) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.mappings !== "") {
+      writer.uint32(42).string(message.mappings);
+    }
+    if (message.settings !== "") {
+      writer.uint32(50).string(message.settings);
+    }
+    if (message.definition !== "") {
+      writer.uint32(58).string(message.definition);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexTemplateMetadata {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIndexTemplateMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.indexPatterns.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.priority = reader.int64() as Long;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.composedOf.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.mappings = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.settings = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.definition = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IndexTemplateMetadata {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      indexPatterns: globalThis.Array.isArray(object?.indexPatterns)
+        ? object.indexPatterns.map((e: any) => globalThis.String(e))
+        : [],
+      priority: isSet(object.priority) ? Long.fromValue(object.priority) : Long.ZERO,
+      composedOf: globalThis.Array.isArray(object?.composedOf)
+        ? object.composedOf.map((e: any) => globalThis.String(e))
+        : [],
+      mappings: isSet(object.mappings) ? globalThis.String(object.mappings) : "",
+      settings: isSet(object.settings) ? globalThis.String(object.settings) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+    };
+  },
+
+  toJSON(message: IndexTemplateMetadata): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.indexPatterns?.length) {
+      obj.indexPatterns = message.indexPatterns;
+    }
+    if (!message.priority.isZero()) {
+      obj.priority = (message.priority || Long.ZERO).toString();
+    }
+    if (message.composedOf?.length) {
+      obj.composedOf = message.composedOf;
+    }
+    if (message.mappings !== "") {
+      obj.mappings = message.mappings;
+    }
+    if (message.settings !== "") {
+      obj.settings = message.settings;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IndexTemplateMetadata>): IndexTemplateMetadata {
+    return IndexTemplateMetadata.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IndexTemplateMetadata>): IndexTemplateMetadata {
+    const message = createBaseIndexTemplateMetadata();
+    message.name = object.name ?? "";
+    message.indexPatterns = object.indexPatterns?.map((e) => e) || [];
+    message.priority = (object.priority !== undefined && object.priority !== null)
+      ? Long.fromValue(object.priority)
+      : Long.ZERO;
+    message.composedOf = object.composedOf?.map((e) => e) || [];
+    message.mappings = object.mappings ?? "";
+    message.settings = object.settings ?? "";
+    message.definition = object.definition ?? "";
+    return message;
+  },
+};
+
+function createBaseLifecyclePolicyMetadata(): LifecyclePolicyMetadata {
+  return { name: "", definition: "" };
+}
+
+export const LifecyclePolicyMetadata = {
+  encode(message: LifecyclePolicyMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.definition !== "") {
+      writer.uint32(18).string(message.definition);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LifecyclePolicyMetadata {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLifecyclePolicyMetadata();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.definition = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LifecyclePolicyMetadata {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+    };
+  },
+
+  toJSON(message: LifecyclePolicyMetadata): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LifecyclePolicyMetadata>): LifecyclePolicyMetadata {
+    return LifecyclePolicyMetadata.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LifecyclePolicyMetadata>): LifecyclePolicyMetadata {
+    const message = createBaseLifecyclePolicyMetadata();
+    message.name = object.name ?? "";
+    message.definition = object.definition ?? "";
+    return message;
+  },
+};
+
 function createBaseForeignKeyMetadata(): ForeignKeyMetadata {
   return {
     name: "",
@@ -3834,7 +4543,7 @@ export const Secrets = {
 };
 
 function createBaseSecretItem(): SecretItem {
-  return { name: "", value: "", description: "" };
+  return { name: "", value: "", description: "", externalSecret: undefined };
 }
 
 export const SecretItem = {
@@ -3848,6 +4557,9 @@ export const SecretItem = {
     if (message.description !== "") {
       writer.uint32(26).string(message.description);
     }
+    if (message.externalSecret !== undefined) {
+      DataSourceExternalSecret.encode(message.externalSecret, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -3879,6 +4591,13 @@ export const SecretItem = {
 
           message.description = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.externalSecret = DataSourceExternalSecret.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3893,6 +4612,9 @@ export const SecretItem = {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
       value: isSet(object.value) ? globalThis.String(object.value) : "",
       description: isSet(object.description) ? globalThis.String(object.description) : "",
+      externalSecret: isSet(object.externalSecret)
+        ? DataSourceExternalSecret.fromJSON(object.externalSecret)
+        : undefined,
     };
   },
 
@@ -3907,6 +4629,9 @@ export const SecretItem = {
     if (message.description !== "") {
       obj.description = message.description;
     }
+    if (message.externalSecret !== undefined) {
+      obj.externalSecret = DataSourceExternalSecret.toJSON(message.externalSecret);
+    }
     return obj;
   },
 
@@ -3918,6 +4643,100 @@ export const SecretItem = {
     message.name = object.name ?? "";
     message.value = object.value ?? "";
     message.description = object.description ?? "";
+    message.externalSecret = (object.externalSecret !== undefined && object.externalSecret !== null)
+      ? DataSourceExternalSecret.fromPartial(object.externalSecret)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseClassificationSuggestionPayload(): ClassificationSuggestionPayload {
+  return { label: ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED, nameMatched: false, dataMatchRatio: 0 };
+}
+
+export const ClassificationSuggestionPayload = {
+  encode(message: ClassificationSuggestionPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.label !== ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED) {
+      writer.uint32(8).int32(classificationSuggestionPayload_LabelToNumber(message.label));
+    }
+    if (message.nameMatched === true) {
+      writer.uint32(16).bool(message.nameMatched);
+    }
+    if (message.dataMatchRatio !== 0) {
+      writer.uint32(25).double(message.dataMatchRatio);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ClassificationSuggestionPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseClassificationSuggestionPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.label = classificationSuggestionPayload_LabelFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.nameMatched = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.dataMatchRatio = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ClassificationSuggestionPayload {
+    return {
+      label: isSet(object.label)
+        ? classificationSuggestionPayload_LabelFromJSON(object.label)
+        : ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED,
+      nameMatched: isSet(object.nameMatched) ? globalThis.Boolean(object.nameMatched) : false,
+      dataMatchRatio: isSet(object.dataMatchRatio) ? globalThis.Number(object.dataMatchRatio) : 0,
+    };
+  },
+
+  toJSON(message: ClassificationSuggestionPayload): unknown {
+    const obj: any = {};
+    if (message.label !== ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED) {
+      obj.label = classificationSuggestionPayload_LabelToJSON(message.label);
+    }
+    if (message.nameMatched === true) {
+      obj.nameMatched = message.nameMatched;
+    }
+    if (message.dataMatchRatio !== 0) {
+      obj.dataMatchRatio = message.dataMatchRatio;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ClassificationSuggestionPayload>): ClassificationSuggestionPayload {
+    return ClassificationSuggestionPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ClassificationSuggestionPayload>): ClassificationSuggestionPayload {
+    const message = createBaseClassificationSuggestionPayload();
+    message.label = object.label ?? ClassificationSuggestionPayload_Label.LABEL_UNSPECIFIED;
+    message.nameMatched = object.nameMatched ?? false;
+    message.dataMatchRatio = object.dataMatchRatio ?? 0;
     return message;
   },
 };
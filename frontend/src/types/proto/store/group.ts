@@ -69,6 +69,11 @@ export function groupMember_RoleToNumber(object: GroupMember_Role): number {
 
 export interface GroupPayload {
   members: GroupMember[];
+  /**
+   * The source system where the group is provisioned from, for example, SCIM.
+   * Empty for the groups managed in Bytebase.
+   */
+  source: string;
 }
 
 function createBaseGroupMember(): GroupMember {
@@ -146,7 +151,7 @@ export const GroupMember = {
 };
 
 function createBaseGroupPayload(): GroupPayload {
-  return { members: [] };
+  return { members: [], source: "" };
 }
 
 export const GroupPayload = {
@@ -154,6 +159,9 @@ export const GroupPayload = {
     for (const v of message.members) {
       GroupMember.encode(v!, writer.uint32(10).fork()).ldelim();
     }
+    if (message.source !== "") {
+      writer.uint32(18).string(message.source);
+    }
     return writer;
   },
 
@@ -171,6 +179,13 @@ export const GroupPayload = {
 
           message.members.push(GroupMember.decode(reader, reader.uint32()));
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.source = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -183,6 +198,7 @@ export const GroupPayload = {
   fromJSON(object: any): GroupPayload {
     return {
       members: globalThis.Array.isArray(object?.members) ? object.members.map((e: any) => GroupMember.fromJSON(e)) : [],
+      source: isSet(object.source) ? globalThis.String(object.source) : "",
     };
   },
 
@@ -191,6 +207,9 @@ export const GroupPayload = {
     if (message.members?.length) {
       obj.members = message.members.map((e) => GroupMember.toJSON(e));
     }
+    if (message.source !== "") {
+      obj.source = message.source;
+    }
     return obj;
   },
 
@@ -200,6 +219,7 @@ export const GroupPayload = {
   fromPartial(object: DeepPartial<GroupPayload>): GroupPayload {
     const message = createBaseGroupPayload();
     message.members = object.members?.map((e) => GroupMember.fromPartial(e)) || [];
+    message.source = object.source ?? "";
     return message;
   },
 };
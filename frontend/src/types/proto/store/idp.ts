@@ -191,7 +191,37 @@ export interface LDAPIdentityProviderConfig {
    * FieldMapping is the mapping of the user attributes returned by the LDAP
    * server.
    */
-  fieldMapping: FieldMapping | undefined;
+  fieldMapping:
+    | FieldMapping
+    | undefined;
+  /**
+   * GroupBaseDN is the base DN to search for groups, e.g.
+   * "ou=groups,dc=example,dc=com". Group mapping is disabled when it's empty.
+   */
+  groupBaseDn: string;
+  /**
+   * GroupFilter is the filter to search for the groups of a member, the "%s"
+   * is replaced with the member DN, e.g. "(member=%s)".
+   */
+  groupFilter: string;
+  /**
+   * NestedGroup controls whether to resolve the groups that the user belongs to
+   * through other groups.
+   */
+  nestedGroup: boolean;
+  /** GroupMappings is the mapping from the LDAP groups to the workspace roles. */
+  groupMappings: LDAPGroupMapping[];
+}
+
+/** LDAPGroupMapping maps the members of an LDAP group to a workspace role. */
+export interface LDAPGroupMapping {
+  /** GroupDN is the DN of the LDAP group, e.g. "cn=dba,ou=groups,dc=example,dc=com". */
+  groupDn: string;
+  /**
+   * Role is the workspace role granted to the group members.
+   * Format: roles/{role}
+   */
+  role: string;
 }
 
 /**
@@ -224,6 +254,8 @@ export interface IdentityProviderUserInfo {
   email: string;
   /** Phone is the value of primary phone in 3rd-party idp user info. */
   phone: string;
+  /** Groups is the DN of the groups that the user belongs to, only for LDAP. */
+  groups: string[];
 }
 
 function createBaseIdentityProviderConfig(): IdentityProviderConfig {
@@ -670,6 +702,10 @@ function createBaseLDAPIdentityProviderConfig(): LDAPIdentityProviderConfig {
     userFilter: "",
     securityProtocol: "",
     fieldMapping: undefined,
+    groupBaseDn: "",
+    groupFilter: "",
+    nestedGroup: false,
+    groupMappings: [],
   };
 }
 
@@ -702,6 +738,18 @@ export const LDAPIdentityProviderConfig = {
     if (message.fieldMapping !== undefined) {
       FieldMapping.encode(message.fieldMapping, writer.uint32(74).fork()).ldelim();
     }
+    if (message.groupBaseDn !== "") {
+      writer.uint32(82).string(message.groupBaseDn);
+    }
+    if (message.groupFilter !== "") {
+      writer.uint32(90).string(message.groupFilter);
+    }
+    if (message.nestedGroup === true) {
+      writer.uint32(96).bool(message.nestedGroup);
+    }
+    for (const v of message.groupMappings) {
+      LDAPGroupMapping.encode(v!, writer.uint32(106).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -775,6 +823,34 @@ export const LDAPIdentityProviderConfig = {
 
           message.fieldMapping = FieldMapping.decode(reader, reader.uint32());
           continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.groupBaseDn = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.groupFilter = reader.string();
+          continue;
+        case 12:
+          if (tag !== 96) {
+            break;
+          }
+
+          message.nestedGroup = reader.bool();
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.groupMappings.push(LDAPGroupMapping.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -795,6 +871,12 @@ export const LDAPIdentityProviderConfig = {
       userFilter: isSet(object.userFilter) ? globalThis.String(object.userFilter) : "",
       securityProtocol: isSet(object.securityProtocol) ? globalThis.String(object.securityProtocol) : "",
       fieldMapping: isSet(object.fieldMapping) ? FieldMapping.fromJSON(object.fieldMapping) : undefined,
+      groupBaseDn: isSet(object.groupBaseDn) ? globalThis.String(object.groupBaseDn) : "",
+      groupFilter: isSet(object.groupFilter) ? globalThis.String(object.groupFilter) : "",
+      nestedGroup: isSet(object.nestedGroup) ? globalThis.Boolean(object.nestedGroup) : false,
+      groupMappings: globalThis.Array.isArray(object?.groupMappings)
+        ? object.groupMappings.map((e: any) => LDAPGroupMapping.fromJSON(e))
+        : [],
     };
   },
 
@@ -827,6 +909,18 @@ export const LDAPIdentityProviderConfig = {
     if (message.fieldMapping !== undefined) {
       obj.fieldMapping = FieldMapping.toJSON(message.fieldMapping);
     }
+    if (message.groupBaseDn !== "") {
+      obj.groupBaseDn = message.groupBaseDn;
+    }
+    if (message.groupFilter !== "") {
+      obj.groupFilter = message.groupFilter;
+    }
+    if (message.nestedGroup === true) {
+      obj.nestedGroup = message.nestedGroup;
+    }
+    if (message.groupMappings?.length) {
+      obj.groupMappings = message.groupMappings.map((e) => LDAPGroupMapping.toJSON(e));
+    }
     return obj;
   },
 
@@ -846,6 +940,84 @@ export const LDAPIdentityProviderConfig = {
     message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
       ? FieldMapping.fromPartial(object.fieldMapping)
       : undefined;
+    message.groupBaseDn = object.groupBaseDn ?? "";
+    message.groupFilter = object.groupFilter ?? "";
+    message.nestedGroup = object.nestedGroup ?? false;
+    message.groupMappings = object.groupMappings?.map((e) => LDAPGroupMapping.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseLDAPGroupMapping(): LDAPGroupMapping {
+  return { groupDn: "", role: "" };
+}
+
+export const LDAPGroupMapping = {
+  encode(message: LDAPGroupMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.groupDn !== "") {
+      writer.uint32(10).string(message.groupDn);
+    }
+    if (message.role !== "") {
+      writer.uint32(18).string(message.role);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LDAPGroupMapping {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLDAPGroupMapping();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.groupDn = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LDAPGroupMapping {
+    return {
+      groupDn: isSet(object.groupDn) ? globalThis.String(object.groupDn) : "",
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+    };
+  },
+
+  toJSON(message: LDAPGroupMapping): unknown {
+    const obj: any = {};
+    if (message.groupDn !== "") {
+      obj.groupDn = message.groupDn;
+    }
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LDAPGroupMapping>): LDAPGroupMapping {
+    return LDAPGroupMapping.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LDAPGroupMapping>): LDAPGroupMapping {
+    const message = createBaseLDAPGroupMapping();
+    message.groupDn = object.groupDn ?? "";
+    message.role = object.role ?? "";
     return message;
   },
 };
@@ -955,7 +1127,7 @@ export const FieldMapping = {
 };
 
 function createBaseIdentityProviderUserInfo(): IdentityProviderUserInfo {
-  return { identifier: "", displayName: "", email: "", phone: "" };
+  return { identifier: "", displayName: "", email: "", phone: "", groups: [] };
 }
 
 export const IdentityProviderUserInfo = {
@@ -972,6 +1144,9 @@ export const IdentityProviderUserInfo = {
     if (message.phone !== "") {
       writer.uint32(34).string(message.phone);
     }
+    for (const v of message.groups) {
+      writer.uint32(42).string(v!);
+    }
     return writer;
   },
 
@@ -1010,6 +1185,13 @@ export const IdentityProviderUserInfo = {
 
           message.phone = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.groups.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1025,6 +1207,7 @@ export const IdentityProviderUserInfo = {
       displayName: isSet(object.displayName) ? globalThis.String(object.displayName) : "",
       email: isSet(object.email) ? globalThis.String(object.email) : "",
       phone: isSet(object.phone) ? globalThis.String(object.phone) : "",
+      groups: globalThis.Array.isArray(object?.groups) ? object.groups.map((e: any) => globalThis.String(e)) : [],
     };
   },
 
@@ -1042,6 +1225,9 @@ export const IdentityProviderUserInfo = {
     if (message.phone !== "") {
       obj.phone = message.phone;
     }
+    if (message.groups?.length) {
+      obj.groups = message.groups;
+    }
     return obj;
   },
 
@@ -1054,6 +1240,7 @@ export const IdentityProviderUserInfo = {
     message.displayName = object.displayName ?? "";
     message.email = object.email ?? "";
     message.phone = object.phone ?? "";
+    message.groups = object.groups?.map((e) => e) || [];
     return message;
   },
 };
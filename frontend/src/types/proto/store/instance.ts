@@ -17,6 +17,40 @@ export interface InstanceOptions {
    * The default is 10 if the value is unset or zero.
    */
   maximumConnections: number;
+  /** The connection pool configuration of each driver connection. */
+  connectionPool:
+    | ConnectionPoolConfig
+    | undefined;
+  /**
+   * The maximum number of the tasks running concurrently on the instance.
+   * The tasks are only limited by the maximum connections if the value is unset or zero.
+   */
+  maxConcurrentTasks: number;
+  /**
+   * Oracle only. The pluggable databases (PDBs) of the container database are synced as the databases of the instance,
+   * rather than the schemas of the connected database. The admin data source must connect to the root container (CDB$ROOT)
+   * with a common user, and the PDBs are connected by their default services.
+   */
+  oraclePdbMode: boolean;
+}
+
+/** ConnectionPoolConfig is the connection pool configuration for instances. */
+export interface ConnectionPoolConfig {
+  /**
+   * The maximum number of open connections to the database.
+   * There is no limit if the value is unset or zero.
+   */
+  maxOpenConnections: number;
+  /**
+   * The maximum number of idle connections to the database.
+   * The driver default is used if the value is unset or zero.
+   */
+  maxIdleConnections: number;
+  /**
+   * The maximum amount of time a connection may be reused.
+   * Connections are reused forever if the value is unset or zero.
+   */
+  maxConnectionLifetime: Duration | undefined;
 }
 
 /** InstanceMetadata is the metadata for instances. */
@@ -28,6 +62,30 @@ export interface InstanceMetadata {
   mysqlLowerCaseTableNames: number;
   lastSyncTime: Date | undefined;
   roles: InstanceRole[];
+  /** The Always On availability groups of SQL Server instances. */
+  availabilityGroups: AvailabilityGroup[];
+}
+
+/** AvailabilityGroup is the SQL Server Always On availability group which the instance is a replica of. */
+export interface AvailabilityGroup {
+  name: string;
+  /** The server name of the current primary replica. */
+  primaryReplica: string;
+  replicas: AvailabilityGroup_Replica[];
+  /** The DNS name and the port of the availability group listener. */
+  listenerDnsName: string;
+  listenerPort: number;
+}
+
+export interface AvailabilityGroup_Replica {
+  /** The server name of the replica, e.g. host\instance. */
+  serverName: string;
+  /** The role of the replica, e.g. PRIMARY, SECONDARY and RESOLVING. */
+  role: string;
+  /** Whether the secondary replica accepts the read-only connections. */
+  readable: boolean;
+  /** The read-only routing URL of the replica, e.g. tcp://host:1433. */
+  readOnlyRoutingUrl: string;
 }
 
 /** InstanceRole is the API message for instance role. */
@@ -51,7 +109,13 @@ export interface InstanceRole {
 }
 
 function createBaseInstanceOptions(): InstanceOptions {
-  return { syncInterval: undefined, maximumConnections: 0 };
+  return {
+    syncInterval: undefined,
+    maximumConnections: 0,
+    connectionPool: undefined,
+    maxConcurrentTasks: 0,
+    oraclePdbMode: false,
+  };
 }
 
 export const InstanceOptions = {
@@ -62,6 +126,15 @@ export const InstanceOptions = {
     if (message.maximumConnections !== 0) {
       writer.uint32(24).int32(message.maximumConnections);
     }
+    if (message.connectionPool !== undefined) {
+      ConnectionPoolConfig.encode(message.connectionPool, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.maxConcurrentTasks !== 0) {
+      writer.uint32(40).int32(message.maxConcurrentTasks);
+    }
+    if (message.oraclePdbMode === true) {
+      writer.uint32(48).bool(message.oraclePdbMode);
+    }
     return writer;
   },
 
@@ -86,6 +159,27 @@ export const InstanceOptions = {
 
           message.maximumConnections = reader.int32();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.connectionPool = ConnectionPoolConfig.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.maxConcurrentTasks = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.oraclePdbMode = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -99,6 +193,9 @@ export const InstanceOptions = {
     return {
       syncInterval: isSet(object.syncInterval) ? Duration.fromJSON(object.syncInterval) : undefined,
       maximumConnections: isSet(object.maximumConnections) ? globalThis.Number(object.maximumConnections) : 0,
+      connectionPool: isSet(object.connectionPool) ? ConnectionPoolConfig.fromJSON(object.connectionPool) : undefined,
+      maxConcurrentTasks: isSet(object.maxConcurrentTasks) ? globalThis.Number(object.maxConcurrentTasks) : 0,
+      oraclePdbMode: isSet(object.oraclePdbMode) ? globalThis.Boolean(object.oraclePdbMode) : false,
     };
   },
 
@@ -110,6 +207,15 @@ export const InstanceOptions = {
     if (message.maximumConnections !== 0) {
       obj.maximumConnections = Math.round(message.maximumConnections);
     }
+    if (message.connectionPool !== undefined) {
+      obj.connectionPool = ConnectionPoolConfig.toJSON(message.connectionPool);
+    }
+    if (message.maxConcurrentTasks !== 0) {
+      obj.maxConcurrentTasks = Math.round(message.maxConcurrentTasks);
+    }
+    if (message.oraclePdbMode === true) {
+      obj.oraclePdbMode = message.oraclePdbMode;
+    }
     return obj;
   },
 
@@ -122,12 +228,111 @@ export const InstanceOptions = {
       ? Duration.fromPartial(object.syncInterval)
       : undefined;
     message.maximumConnections = object.maximumConnections ?? 0;
+    message.connectionPool = (object.connectionPool !== undefined && object.connectionPool !== null)
+      ? ConnectionPoolConfig.fromPartial(object.connectionPool)
+      : undefined;
+    message.maxConcurrentTasks = object.maxConcurrentTasks ?? 0;
+    message.oraclePdbMode = object.oraclePdbMode ?? false;
+    return message;
+  },
+};
+
+function createBaseConnectionPoolConfig(): ConnectionPoolConfig {
+  return { maxOpenConnections: 0, maxIdleConnections: 0, maxConnectionLifetime: undefined };
+}
+
+export const ConnectionPoolConfig = {
+  encode(message: ConnectionPoolConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.maxOpenConnections !== 0) {
+      writer.uint32(8).int32(message.maxOpenConnections);
+    }
+    if (message.maxIdleConnections !== 0) {
+      writer.uint32(16).int32(message.maxIdleConnections);
+    }
+    if (message.maxConnectionLifetime !== undefined) {
+      Duration.encode(message.maxConnectionLifetime, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ConnectionPoolConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConnectionPoolConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxOpenConnections = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxIdleConnections = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.maxConnectionLifetime = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConnectionPoolConfig {
+    return {
+      maxOpenConnections: isSet(object.maxOpenConnections) ? globalThis.Number(object.maxOpenConnections) : 0,
+      maxIdleConnections: isSet(object.maxIdleConnections) ? globalThis.Number(object.maxIdleConnections) : 0,
+      maxConnectionLifetime: isSet(object.maxConnectionLifetime)
+        ? Duration.fromJSON(object.maxConnectionLifetime)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ConnectionPoolConfig): unknown {
+    const obj: any = {};
+    if (message.maxOpenConnections !== 0) {
+      obj.maxOpenConnections = Math.round(message.maxOpenConnections);
+    }
+    if (message.maxIdleConnections !== 0) {
+      obj.maxIdleConnections = Math.round(message.maxIdleConnections);
+    }
+    if (message.maxConnectionLifetime !== undefined) {
+      obj.maxConnectionLifetime = Duration.toJSON(message.maxConnectionLifetime);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ConnectionPoolConfig>): ConnectionPoolConfig {
+    return ConnectionPoolConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ConnectionPoolConfig>): ConnectionPoolConfig {
+    const message = createBaseConnectionPoolConfig();
+    message.maxOpenConnections = object.maxOpenConnections ?? 0;
+    message.maxIdleConnections = object.maxIdleConnections ?? 0;
+    message.maxConnectionLifetime =
+      (object.maxConnectionLifetime !== undefined && object.maxConnectionLifetime !== null)
+        ? Duration.fromPartial(object.maxConnectionLifetime)
+        : undefined;
     return message;
   },
 };
 
 function createBaseInstanceMetadata(): InstanceMetadata {
-  return { mysqlLowerCaseTableNames: 0, lastSyncTime: undefined, roles: [] };
+  return { mysqlLowerCaseTableNames: 0, lastSyncTime: undefined, roles: [], availabilityGroups: [] };
 }
 
 export const InstanceMetadata = {
@@ -141,6 +346,9 @@ export const InstanceMetadata = {
     for (const v of message.roles) {
       InstanceRole.encode(v!, writer.uint32(26).fork()).ldelim();
     }
+    for (const v of message.availabilityGroups) {
+      AvailabilityGroup.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -172,6 +380,13 @@ export const InstanceMetadata = {
 
           message.roles.push(InstanceRole.decode(reader, reader.uint32()));
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.availabilityGroups.push(AvailabilityGroup.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -188,6 +403,9 @@ export const InstanceMetadata = {
         : 0,
       lastSyncTime: isSet(object.lastSyncTime) ? fromJsonTimestamp(object.lastSyncTime) : undefined,
       roles: globalThis.Array.isArray(object?.roles) ? object.roles.map((e: any) => InstanceRole.fromJSON(e)) : [],
+      availabilityGroups: globalThis.Array.isArray(object?.availabilityGroups)
+        ? object.availabilityGroups.map((e: any) => AvailabilityGroup.fromJSON(e))
+        : [],
     };
   },
 
@@ -202,6 +420,9 @@ export const InstanceMetadata = {
     if (message.roles?.length) {
       obj.roles = message.roles.map((e) => InstanceRole.toJSON(e));
     }
+    if (message.availabilityGroups?.length) {
+      obj.availabilityGroups = message.availabilityGroups.map((e) => AvailabilityGroup.toJSON(e));
+    }
     return obj;
   },
 
@@ -213,6 +434,232 @@ export const InstanceMetadata = {
     message.mysqlLowerCaseTableNames = object.mysqlLowerCaseTableNames ?? 0;
     message.lastSyncTime = object.lastSyncTime ?? undefined;
     message.roles = object.roles?.map((e) => InstanceRole.fromPartial(e)) || [];
+    message.availabilityGroups = object.availabilityGroups?.map((e) => AvailabilityGroup.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseAvailabilityGroup(): AvailabilityGroup {
+  return { name: "", primaryReplica: "", replicas: [], listenerDnsName: "", listenerPort: 0 };
+}
+
+export const AvailabilityGroup = {
+  encode(message: AvailabilityGroup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.primaryReplica !== "") {
+      writer.uint32(18).string(message.primaryReplica);
+    }
+    for (const v of message.replicas) {
+      AvailabilityGroup_Replica.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.listenerDnsName !== "") {
+      writer.uint32(34).string(message.listenerDnsName);
+    }
+    if (message.listenerPort !== 0) {
+      writer.uint32(40).int32(message.listenerPort);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AvailabilityGroup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAvailabilityGroup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.primaryReplica = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.replicas.push(AvailabilityGroup_Replica.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.listenerDnsName = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.listenerPort = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AvailabilityGroup {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      primaryReplica: isSet(object.primaryReplica) ? globalThis.String(object.primaryReplica) : "",
+      replicas: globalThis.Array.isArray(object?.replicas)
+        ? object.replicas.map((e: any) => AvailabilityGroup_Replica.fromJSON(e))
+        : [],
+      listenerDnsName: isSet(object.listenerDnsName) ? globalThis.String(object.listenerDnsName) : "",
+      listenerPort: isSet(object.listenerPort) ? globalThis.Number(object.listenerPort) : 0,
+    };
+  },
+
+  toJSON(message: AvailabilityGroup): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.primaryReplica !== "") {
+      obj.primaryReplica = message.primaryReplica;
+    }
+    if (message.replicas?.length) {
+      obj.replicas = message.replicas.map((e) => AvailabilityGroup_Replica.toJSON(e));
+    }
+    if (message.listenerDnsName !== "") {
+      obj.listenerDnsName = message.listenerDnsName;
+    }
+    if (message.listenerPort !== 0) {
+      obj.listenerPort = Math.round(message.listenerPort);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AvailabilityGroup>): AvailabilityGroup {
+    return AvailabilityGroup.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AvailabilityGroup>): AvailabilityGroup {
+    const message = createBaseAvailabilityGroup();
+    message.name = object.name ?? "";
+    message.primaryReplica = object.primaryReplica ?? "";
+    message.replicas = object.replicas?.map((e) => AvailabilityGroup_Replica.fromPartial(e)) || [];
+    message.listenerDnsName = object.listenerDnsName ?? "";
+    message.listenerPort = object.listenerPort ?? 0;
+    return message;
+  },
+};
+
+function createBaseAvailabilityGroup_Replica(): AvailabilityGroup_Replica {
+  return { serverName: "", role: "", readable: false, readOnlyRoutingUrl: "" };
+}
+
+export const AvailabilityGroup_Replica = {
+  encode(message: AvailabilityGroup_Replica, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.serverName !== "") {
+      writer.uint32(10).string(message.serverName);
+    }
+    if (message.role !== "") {
+      writer.uint32(18).string(message.role);
+    }
+    if (message.readable === true) {
+      writer.uint32(24).bool(message.readable);
+    }
+    if (message.readOnlyRoutingUrl !== "") {
+      writer.uint32(34).string(message.readOnlyRoutingUrl);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AvailabilityGroup_Replica {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAvailabilityGroup_Replica();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.serverName = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.readable = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.readOnlyRoutingUrl = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AvailabilityGroup_Replica {
+    return {
+      serverName: isSet(object.serverName) ? globalThis.String(object.serverName) : "",
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      readable: isSet(object.readable) ? globalThis.Boolean(object.readable) : false,
+      readOnlyRoutingUrl: isSet(object.readOnlyRoutingUrl) ? globalThis.String(object.readOnlyRoutingUrl) : "",
+    };
+  },
+
+  toJSON(message: AvailabilityGroup_Replica): unknown {
+    const obj: any = {};
+    if (message.serverName !== "") {
+      obj.serverName = message.serverName;
+    }
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.readable === true) {
+      obj.readable = message.readable;
+    }
+    if (message.readOnlyRoutingUrl !== "") {
+      obj.readOnlyRoutingUrl = message.readOnlyRoutingUrl;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AvailabilityGroup_Replica>): AvailabilityGroup_Replica {
+    return AvailabilityGroup_Replica.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AvailabilityGroup_Replica>): AvailabilityGroup_Replica {
+    const message = createBaseAvailabilityGroup_Replica();
+    message.serverName = object.serverName ?? "";
+    message.role = object.role ?? "";
+    message.readable = object.readable ?? false;
+    message.readOnlyRoutingUrl = object.readOnlyRoutingUrl ?? "";
     return message;
   },
 };
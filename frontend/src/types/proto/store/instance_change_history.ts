@@ -1,12 +1,25 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../google/protobuf/timestamp";
 import { Range } from "./common";
 
 export const protobufPackage = "bytebase.store";
 
 export interface InstanceChangeHistoryPayload {
-  changedResources: ChangedResources | undefined;
+  changedResources:
+    | ChangedResources
+    | undefined;
+  /** The external changelog is set if the change is imported from an external migration tool. */
+  externalChangelog: ExternalChangelog | undefined;
+}
+
+export interface ExternalChangelog {
+  /** The migration tool, e.g. FLYWAY or LIQUIBASE. */
+  tool: string;
+  /** The checksum computed by the migration tool. */
+  checksum: string;
+  appliedTime: Date | undefined;
 }
 
 export interface ChangedResources {
@@ -53,7 +66,7 @@ export interface ChangedResourceProcedure {
 }
 
 function createBaseInstanceChangeHistoryPayload(): InstanceChangeHistoryPayload {
-  return { changedResources: undefined };
+  return { changedResources: undefined, externalChangelog: undefined };
 }
 
 export const InstanceChangeHistoryPayload = {
@@ -61,6 +74,9 @@ export const InstanceChangeHistoryPayload = {
     if (message.changedResources !== undefined) {
       ChangedResources.encode(message.changedResources, writer.uint32(10).fork()).ldelim();
     }
+    if (message.externalChangelog !== undefined) {
+      ExternalChangelog.encode(message.externalChangelog, writer.uint32(18).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -78,6 +94,13 @@ export const InstanceChangeHistoryPayload = {
 
           message.changedResources = ChangedResources.decode(reader, reader.uint32());
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.externalChangelog = ExternalChangelog.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -90,6 +113,9 @@ export const InstanceChangeHistoryPayload = {
   fromJSON(object: any): InstanceChangeHistoryPayload {
     return {
       changedResources: isSet(object.changedResources) ? ChangedResources.fromJSON(object.changedResources) : undefined,
+      externalChangelog: isSet(object.externalChangelog)
+        ? ExternalChangelog.fromJSON(object.externalChangelog)
+        : undefined,
     };
   },
 
@@ -98,6 +124,9 @@ export const InstanceChangeHistoryPayload = {
     if (message.changedResources !== undefined) {
       obj.changedResources = ChangedResources.toJSON(message.changedResources);
     }
+    if (message.externalChangelog !== undefined) {
+      obj.externalChangelog = ExternalChangelog.toJSON(message.externalChangelog);
+    }
     return obj;
   },
 
@@ -109,6 +138,98 @@ export const InstanceChangeHistoryPayload = {
     message.changedResources = (object.changedResources !== undefined && object.changedResources !== null)
       ? ChangedResources.fromPartial(object.changedResources)
       : undefined;
+    message.externalChangelog = (object.externalChangelog !== undefined && object.externalChangelog !== null)
+      ? ExternalChangelog.fromPartial(object.externalChangelog)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseExternalChangelog(): ExternalChangelog {
+  return { tool: "", checksum: "", appliedTime: undefined };
+}
+
+export const ExternalChangelog = {
+  encode(message: ExternalChangelog, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.tool !== "") {
+      writer.uint32(10).string(message.tool);
+    }
+    if (message.checksum !== "") {
+      writer.uint32(18).string(message.checksum);
+    }
+    if (message.appliedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.appliedTime), writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExternalChangelog {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExternalChangelog();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.tool = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.checksum = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.appliedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExternalChangelog {
+    return {
+      tool: isSet(object.tool) ? globalThis.String(object.tool) : "",
+      checksum: isSet(object.checksum) ? globalThis.String(object.checksum) : "",
+      appliedTime: isSet(object.appliedTime) ? fromJsonTimestamp(object.appliedTime) : undefined,
+    };
+  },
+
+  toJSON(message: ExternalChangelog): unknown {
+    const obj: any = {};
+    if (message.tool !== "") {
+      obj.tool = message.tool;
+    }
+    if (message.checksum !== "") {
+      obj.checksum = message.checksum;
+    }
+    if (message.appliedTime !== undefined) {
+      obj.appliedTime = message.appliedTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExternalChangelog>): ExternalChangelog {
+    return ExternalChangelog.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExternalChangelog>): ExternalChangelog {
+    const message = createBaseExternalChangelog();
+    message.tool = object.tool ?? "";
+    message.checksum = object.checksum ?? "";
+    message.appliedTime = object.appliedTime ?? undefined;
     return message;
   },
 };
@@ -698,6 +819,32 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
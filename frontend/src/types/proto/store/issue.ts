@@ -11,6 +11,100 @@ export interface IssuePayload {
   approval: IssuePayloadApproval | undefined;
   grantRequest: GrantRequest | undefined;
   labels: string[];
+  /**
+   * The group subscribers of the issue.
+   * Format: groups/{email}
+   */
+  subscriberGroups: string[];
+  /**
+   * The issue which the changelist of this issue is promoted from.
+   * Format: projects/{project}/issues/{issue}
+   */
+  promotedFromIssue: string;
+  /**
+   * The issues which the changelist of this issue is promoted to.
+   * Format: projects/{project}/issues/{issue}
+   */
+  promotedToIssues: string[];
+  /** The resource ID of the release in the project which the issue is attached to. */
+  release: string;
+  /**
+   * The priority of the task runs of the issue in the execution queue of the instance.
+   * The unspecified priority is the same as PRIORITY_NORMAL.
+   */
+  priority: IssuePayload_Priority;
+  /** The linked ticket in the issue tracker of the project. */
+  externalTicket: ExternalTicket | undefined;
+}
+
+export enum IssuePayload_Priority {
+  PRIORITY_UNSPECIFIED = "PRIORITY_UNSPECIFIED",
+  PRIORITY_LOW = "PRIORITY_LOW",
+  PRIORITY_NORMAL = "PRIORITY_NORMAL",
+  PRIORITY_HIGH = "PRIORITY_HIGH",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function issuePayload_PriorityFromJSON(object: any): IssuePayload_Priority {
+  switch (object) {
+    case 0:
+    case "PRIORITY_UNSPECIFIED":
+      return IssuePayload_Priority.PRIORITY_UNSPECIFIED;
+    case 1:
+    case "PRIORITY_LOW":
+      return IssuePayload_Priority.PRIORITY_LOW;
+    case 2:
+    case "PRIORITY_NORMAL":
+      return IssuePayload_Priority.PRIORITY_NORMAL;
+    case 3:
+    case "PRIORITY_HIGH":
+      return IssuePayload_Priority.PRIORITY_HIGH;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return IssuePayload_Priority.UNRECOGNIZED;
+  }
+}
+
+export function issuePayload_PriorityToJSON(object: IssuePayload_Priority): string {
+  switch (object) {
+    case IssuePayload_Priority.PRIORITY_UNSPECIFIED:
+      return "PRIORITY_UNSPECIFIED";
+    case IssuePayload_Priority.PRIORITY_LOW:
+      return "PRIORITY_LOW";
+    case IssuePayload_Priority.PRIORITY_NORMAL:
+      return "PRIORITY_NORMAL";
+    case IssuePayload_Priority.PRIORITY_HIGH:
+      return "PRIORITY_HIGH";
+    case IssuePayload_Priority.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function issuePayload_PriorityToNumber(object: IssuePayload_Priority): number {
+  switch (object) {
+    case IssuePayload_Priority.PRIORITY_UNSPECIFIED:
+      return 0;
+    case IssuePayload_Priority.PRIORITY_LOW:
+      return 1;
+    case IssuePayload_Priority.PRIORITY_NORMAL:
+      return 2;
+    case IssuePayload_Priority.PRIORITY_HIGH:
+      return 3;
+    case IssuePayload_Priority.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ExternalTicket {
+  /** The key of the ticket, e.g. PAY-123. */
+  key: string;
+  /** The URL to view the ticket. */
+  url: string;
+  /** The last synced status of the ticket. */
+  status: string;
 }
 
 export interface GrantRequest {
@@ -29,7 +123,17 @@ export interface GrantRequest {
 }
 
 function createBaseIssuePayload(): IssuePayload {
-  return { approval: undefined, grantRequest: undefined, labels: [] };
+  return {
+    approval: undefined,
+    grantRequest: undefined,
+    labels: [],
+    subscriberGroups: [],
+    promotedFromIssue: "",
+    promotedToIssues: [],
+    release: "",
+    priority: IssuePayload_Priority.PRIORITY_UNSPECIFIED,
+    externalTicket: undefined,
+  };
 }
 
 export const IssuePayload = {
@@ -43,6 +147,24 @@ export const IssuePayload = {
     for (const v of message.labels) {
       writer.uint32(26).string(v!);
     }
+    for (const v of message.subscriberGroups) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.promotedFromIssue !== "") {
+      writer.uint32(42).string(message.promotedFromIssue);
+    }
+    for (const v of message.promotedToIssues) {
+      writer.uint32(50).string(v!);
+    }
+    if (message.release !== "") {
+      writer.uint32(58).string(message.release);
+    }
+    if (message.priority !== IssuePayload_Priority.PRIORITY_UNSPECIFIED) {
+      writer.uint32(64).int32(issuePayload_PriorityToNumber(message.priority));
+    }
+    if (message.externalTicket !== undefined) {
+      ExternalTicket.encode(message.externalTicket, writer.uint32(74).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -74,6 +196,48 @@ export const IssuePayload = {
 
           message.labels.push(reader.string());
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.subscriberGroups.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.promotedFromIssue = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.promotedToIssues.push(reader.string());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.release = reader.string();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.priority = issuePayload_PriorityFromJSON(reader.int32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.externalTicket = ExternalTicket.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -88,6 +252,18 @@ export const IssuePayload = {
       approval: isSet(object.approval) ? IssuePayloadApproval.fromJSON(object.approval) : undefined,
       grantRequest: isSet(object.grantRequest) ? GrantRequest.fromJSON(object.grantRequest) : undefined,
       labels: globalThis.Array.isArray(object?.labels) ? object.labels.map((e: any) => globalThis.String(e)) : [],
+      subscriberGroups: globalThis.Array.isArray(object?.subscriberGroups)
+        ? object.subscriberGroups.map((e: any) => globalThis.String(e))
+        : [],
+      promotedFromIssue: isSet(object.promotedFromIssue) ? globalThis.String(object.promotedFromIssue) : "",
+      promotedToIssues: globalThis.Array.isArray(object?.promotedToIssues)
+        ? object.promotedToIssues.map((e: any) => globalThis.String(e))
+        : [],
+      release: isSet(object.release) ? globalThis.String(object.release) : "",
+      priority: isSet(object.priority)
+        ? issuePayload_PriorityFromJSON(object.priority)
+        : IssuePayload_Priority.PRIORITY_UNSPECIFIED,
+      externalTicket: isSet(object.externalTicket) ? ExternalTicket.fromJSON(object.externalTicket) : undefined,
     };
   },
 
@@ -102,6 +278,24 @@ export const IssuePayload = {
     if (message.labels?.length) {
       obj.labels = message.labels;
     }
+    if (message.subscriberGroups?.length) {
+      obj.subscriberGroups = message.subscriberGroups;
+    }
+    if (message.promotedFromIssue !== "") {
+      obj.promotedFromIssue = message.promotedFromIssue;
+    }
+    if (message.promotedToIssues?.length) {
+      obj.promotedToIssues = message.promotedToIssues;
+    }
+    if (message.release !== "") {
+      obj.release = message.release;
+    }
+    if (message.priority !== IssuePayload_Priority.PRIORITY_UNSPECIFIED) {
+      obj.priority = issuePayload_PriorityToJSON(message.priority);
+    }
+    if (message.externalTicket !== undefined) {
+      obj.externalTicket = ExternalTicket.toJSON(message.externalTicket);
+    }
     return obj;
   },
 
@@ -117,6 +311,103 @@ export const IssuePayload = {
       ? GrantRequest.fromPartial(object.grantRequest)
       : undefined;
     message.labels = object.labels?.map((e) => e) || [];
+    message.subscriberGroups = object.subscriberGroups?.map((e) => e) || [];
+    message.promotedFromIssue = object.promotedFromIssue ?? "";
+    message.promotedToIssues = object.promotedToIssues?.map((e) => e) || [];
+    message.release = object.release ?? "";
+    message.priority = object.priority ?? IssuePayload_Priority.PRIORITY_UNSPECIFIED;
+    message.externalTicket = (object.externalTicket !== undefined && object.externalTicket !== null)
+      ? ExternalTicket.fromPartial(object.externalTicket)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseExternalTicket(): ExternalTicket {
+  return { key: "", url: "", status: "" };
+}
+
+export const ExternalTicket = {
+  encode(message: ExternalTicket, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.url !== "") {
+      writer.uint32(18).string(message.url);
+    }
+    if (message.status !== "") {
+      writer.uint32(26).string(message.status);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExternalTicket {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExternalTicket();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.status = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExternalTicket {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      status: isSet(object.status) ? globalThis.String(object.status) : "",
+    };
+  },
+
+  toJSON(message: ExternalTicket): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    if (message.status !== "") {
+      obj.status = message.status;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExternalTicket>): ExternalTicket {
+    return ExternalTicket.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExternalTicket>): ExternalTicket {
+    const message = createBaseExternalTicket();
+    message.key = object.key ?? "";
+    message.url = object.url ?? "";
+    message.status = object.status ?? "";
     return message;
   },
 };
@@ -12,6 +12,7 @@ export interface IssueCommentPayload {
   stageEnd?: IssueCommentPayload_StageEnd | undefined;
   taskUpdate?: IssueCommentPayload_TaskUpdate | undefined;
   taskPriorBackup?: IssueCommentPayload_TaskPriorBackup | undefined;
+  stageApproval?: IssueCommentPayload_StageApproval | undefined;
 }
 
 export interface IssueCommentPayload_Approval {
@@ -271,6 +272,70 @@ export interface IssueCommentPayload_TaskPriorBackup_Table {
   table: string;
 }
 
+export interface IssueCommentPayload_StageApproval {
+  stage: string;
+  status: IssueCommentPayload_StageApproval_Status;
+}
+
+export enum IssueCommentPayload_StageApproval_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  APPROVED = "APPROVED",
+  REJECTED = "REJECTED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function issueCommentPayload_StageApproval_StatusFromJSON(
+  object: any,
+): IssueCommentPayload_StageApproval_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "APPROVED":
+      return IssueCommentPayload_StageApproval_Status.APPROVED;
+    case 2:
+    case "REJECTED":
+      return IssueCommentPayload_StageApproval_Status.REJECTED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return IssueCommentPayload_StageApproval_Status.UNRECOGNIZED;
+  }
+}
+
+export function issueCommentPayload_StageApproval_StatusToJSON(
+  object: IssueCommentPayload_StageApproval_Status,
+): string {
+  switch (object) {
+    case IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case IssueCommentPayload_StageApproval_Status.APPROVED:
+      return "APPROVED";
+    case IssueCommentPayload_StageApproval_Status.REJECTED:
+      return "REJECTED";
+    case IssueCommentPayload_StageApproval_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function issueCommentPayload_StageApproval_StatusToNumber(
+  object: IssueCommentPayload_StageApproval_Status,
+): number {
+  switch (object) {
+    case IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case IssueCommentPayload_StageApproval_Status.APPROVED:
+      return 1;
+    case IssueCommentPayload_StageApproval_Status.REJECTED:
+      return 2;
+    case IssueCommentPayload_StageApproval_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 function createBaseIssueCommentPayload(): IssueCommentPayload {
   return {
     comment: "",
@@ -279,6 +344,7 @@ function createBaseIssueCommentPayload(): IssueCommentPayload {
     stageEnd: undefined,
     taskUpdate: undefined,
     taskPriorBackup: undefined,
+    stageApproval: undefined,
   };
 }
 
@@ -302,6 +368,9 @@ export const IssueCommentPayload = {
     if (message.taskPriorBackup !== undefined) {
       IssueCommentPayload_TaskPriorBackup.encode(message.taskPriorBackup, writer.uint32(50).fork()).ldelim();
     }
+    if (message.stageApproval !== undefined) {
+      IssueCommentPayload_StageApproval.encode(message.stageApproval, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -354,6 +423,13 @@ export const IssueCommentPayload = {
 
           message.taskPriorBackup = IssueCommentPayload_TaskPriorBackup.decode(reader, reader.uint32());
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.stageApproval = IssueCommentPayload_StageApproval.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -373,6 +449,9 @@ export const IssueCommentPayload = {
       taskPriorBackup: isSet(object.taskPriorBackup)
         ? IssueCommentPayload_TaskPriorBackup.fromJSON(object.taskPriorBackup)
         : undefined,
+      stageApproval: isSet(object.stageApproval)
+        ? IssueCommentPayload_StageApproval.fromJSON(object.stageApproval)
+        : undefined,
     };
   },
 
@@ -396,6 +475,9 @@ export const IssueCommentPayload = {
     if (message.taskPriorBackup !== undefined) {
       obj.taskPriorBackup = IssueCommentPayload_TaskPriorBackup.toJSON(message.taskPriorBackup);
     }
+    if (message.stageApproval !== undefined) {
+      obj.stageApproval = IssueCommentPayload_StageApproval.toJSON(message.stageApproval);
+    }
     return obj;
   },
 
@@ -420,6 +502,9 @@ export const IssueCommentPayload = {
     message.taskPriorBackup = (object.taskPriorBackup !== undefined && object.taskPriorBackup !== null)
       ? IssueCommentPayload_TaskPriorBackup.fromPartial(object.taskPriorBackup)
       : undefined;
+    message.stageApproval = (object.stageApproval !== undefined && object.stageApproval !== null)
+      ? IssueCommentPayload_StageApproval.fromPartial(object.stageApproval)
+      : undefined;
     return message;
   },
 };
@@ -1048,6 +1133,82 @@ export const IssueCommentPayload_TaskPriorBackup_Table = {
   },
 };
 
+function createBaseIssueCommentPayload_StageApproval(): IssueCommentPayload_StageApproval {
+  return { stage: "", status: IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED };
+}
+
+export const IssueCommentPayload_StageApproval = {
+  encode(message: IssueCommentPayload_StageApproval, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.stage !== "") {
+      writer.uint32(10).string(message.stage);
+    }
+    if (message.status !== IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(16).int32(issueCommentPayload_StageApproval_StatusToNumber(message.status));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueCommentPayload_StageApproval {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueCommentPayload_StageApproval();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.stage = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = issueCommentPayload_StageApproval_StatusFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueCommentPayload_StageApproval {
+    return {
+      stage: isSet(object.stage) ? globalThis.String(object.stage) : "",
+      status: isSet(object.status)
+        ? issueCommentPayload_StageApproval_StatusFromJSON(object.status)
+        : IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: IssueCommentPayload_StageApproval): unknown {
+    const obj: any = {};
+    if (message.stage !== "") {
+      obj.stage = message.stage;
+    }
+    if (message.status !== IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED) {
+      obj.status = issueCommentPayload_StageApproval_StatusToJSON(message.status);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueCommentPayload_StageApproval>): IssueCommentPayload_StageApproval {
+    return IssueCommentPayload_StageApproval.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueCommentPayload_StageApproval>): IssueCommentPayload_StageApproval {
+    const message = createBaseIssueCommentPayload_StageApproval();
+    message.stage = object.stage ?? "";
+    message.status = object.status ?? IssueCommentPayload_StageApproval_Status.STATUS_UNSPECIFIED;
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -3,14 +3,23 @@ import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Timestamp } from "../google/protobuf/timestamp";
 import {
+  DataLoadFormat,
+  dataLoadFormatFromJSON,
+  dataLoadFormatToJSON,
+  dataLoadFormatToNumber,
   ExportFormat,
   exportFormatFromJSON,
   exportFormatToJSON,
   exportFormatToNumber,
+  TransactionMode,
+  transactionModeFromJSON,
+  transactionModeToJSON,
+  transactionModeToNumber,
   VCSType,
   vCSTypeFromJSON,
   vCSTypeToJSON,
   vCSTypeToNumber,
+  VerificationQuery,
 } from "./common";
 
 export const protobufPackage = "bytebase.store";
@@ -44,6 +53,8 @@ export interface PlanConfig_Spec {
   createDatabaseConfig?: PlanConfig_CreateDatabaseConfig | undefined;
   changeDatabaseConfig?: PlanConfig_ChangeDatabaseConfig | undefined;
   exportDataConfig?: PlanConfig_ExportDataConfig | undefined;
+  restoreDatabaseConfig?: PlanConfig_RestoreDatabaseConfig | undefined;
+  loadDataConfig?: PlanConfig_LoadDataConfig | undefined;
 }
 
 export interface PlanConfig_CreateDatabaseConfig {
@@ -79,6 +90,18 @@ export interface PlanConfig_CreateDatabaseConfig {
   environment: string;
   /** labels of the database. */
   labels: { [key: string]: string };
+  /**
+   * The template to create the baseline schema of the database from.
+   * Format: instances/{instance}/databases/{database}
+   * Format: projects/{project}/branches/{branch}
+   * If empty, the schema is copied from a peer tenant database if there is one.
+   */
+  template: string;
+  /**
+   * The title of the deployment in the project deployment config that the database belongs to.
+   * The labels required by the deployment are applied to the database if they are not set in labels.
+   */
+  deployment: string;
 }
 
 export interface PlanConfig_CreateDatabaseConfig_LabelsEntry {
@@ -106,7 +129,37 @@ export interface PlanConfig_ChangeDatabaseConfig {
   schemaVersion: string;
   ghostFlags: { [key: string]: string };
   /** If set, a backup of the modified data will be created automatically before any changes are applied. */
-  preUpdateBackupDetail?: PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail | undefined;
+  preUpdateBackupDetail?:
+    | PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail
+    | undefined;
+  /**
+   * If true, the destructive statements, e.g. dropping tables or columns, generated by the state-based migration are allowed.
+   * Only applicable to MIGRATE_SDL type.
+   */
+  allowDestructiveChanges: boolean;
+  /**
+   * The queries to verify the database after the change is applied.
+   * The task fails with the verification failure if any query doesn't return the expected result.
+   */
+  verificationQueries: VerificationQuery[];
+  /**
+   * If set, the change is simulated as a plan check against a scratch clone of the target database on the instance.
+   * The clone is restored from the latest backup of the target database, or created with CREATE DATABASE ... TEMPLATE
+   * for Postgres on the same instance. The clone is dropped after the simulation.
+   * Format: instances/{instance}
+   */
+  simulationInstance: string;
+  /**
+   * The transaction mode of the statements.
+   * Only applicable to MIGRATE and DATA types on MySQL, MariaDB, OceanBase and Postgres.
+   */
+  transactionMode: TransactionMode;
+  /**
+   * The session variables set before the statements run, e.g. statement_timeout and lock_timeout for Postgres,
+   * or lock_wait_timeout and sql_mode for MySQL.
+   * Only applicable to MIGRATE and DATA types on MySQL, MariaDB, OceanBase and Postgres.
+   */
+  sessionVariables: { [key: string]: string };
 }
 
 /** Type is the database change type. */
@@ -216,6 +269,13 @@ export interface PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail {
    * Format: instances/{instance}/databases/{database}
    */
   database: string;
+  /** If true, the entire tables affected by the change are backed up rather than only the affected rows. */
+  fullTable: boolean;
+}
+
+export interface PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry {
+  key: string;
+  value: string;
 }
 
 export interface PlanConfig_ExportDataConfig {
@@ -238,6 +298,51 @@ export interface PlanConfig_ExportDataConfig {
   password?: string | undefined;
 }
 
+export interface PlanConfig_LoadDataConfig {
+  /**
+   * The resource name of the database to load the data into.
+   * Format: instances/{instance-id}/databases/{database-name}
+   */
+  target: string;
+  /** The name of the existing table to load the data into. */
+  table: string;
+  /**
+   * The URI of the files in the object storage, e.g. s3://bucket/path/part-*.csv or gs://bucket/path/data.parquet.
+   * TiDB reads the files with IMPORT INTO, the wildcards and the credentials in the query parameters are supported.
+   * MySQL streams the single S3 object through Bytebase with LOAD DATA LOCAL INFILE, using the default credentials of the Bytebase server.
+   */
+  sourceUri: string;
+  /** The format of the files. PARQUET is only supported for TiDB. */
+  format: DataLoadFormat;
+  /** Whether the first line of the CSV files is the header and skipped. */
+  header: boolean;
+  /** The field delimiter of the CSV files. Default is ",". */
+  fieldDelimiter: string;
+}
+
+export interface PlanConfig_RestoreDatabaseConfig {
+  /**
+   * The resource name of the database whose backup is restored.
+   * Format: instances/{instance-id}/databases/{database-name}
+   */
+  target: string;
+  /**
+   * The resource name of the backup run to restore.
+   * Format: instances/{instance-id}/databases/{database-name}/backupRuns/{backup-run}
+   * Exactly one of backup_run and restore_time must be set.
+   */
+  backupRun: string;
+  /** The latest successful backup run of the target taken at or before the restore time is restored. */
+  restoreTime:
+    | Date
+    | undefined;
+  /**
+   * The name of the new database on the same instance to restore into.
+   * If empty, the target database is dropped and recreated from the backup.
+   */
+  targetDatabase: string;
+}
+
 export interface PlanConfig_VCSSource {
   vcsType: VCSType;
   /**
@@ -407,6 +512,8 @@ function createBasePlanConfig_Spec(): PlanConfig_Spec {
     createDatabaseConfig: undefined,
     changeDatabaseConfig: undefined,
     exportDataConfig: undefined,
+    restoreDatabaseConfig: undefined,
+    loadDataConfig: undefined,
   };
 }
 
@@ -430,6 +537,12 @@ export const PlanConfig_Spec = {
     if (message.exportDataConfig !== undefined) {
       PlanConfig_ExportDataConfig.encode(message.exportDataConfig, writer.uint32(58).fork()).ldelim();
     }
+    if (message.restoreDatabaseConfig !== undefined) {
+      PlanConfig_RestoreDatabaseConfig.encode(message.restoreDatabaseConfig, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.loadDataConfig !== undefined) {
+      PlanConfig_LoadDataConfig.encode(message.loadDataConfig, writer.uint32(74).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -482,6 +595,20 @@ export const PlanConfig_Spec = {
 
           message.exportDataConfig = PlanConfig_ExportDataConfig.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.restoreDatabaseConfig = PlanConfig_RestoreDatabaseConfig.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.loadDataConfig = PlanConfig_LoadDataConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -509,6 +636,12 @@ export const PlanConfig_Spec = {
       exportDataConfig: isSet(object.exportDataConfig)
         ? PlanConfig_ExportDataConfig.fromJSON(object.exportDataConfig)
         : undefined,
+      restoreDatabaseConfig: isSet(object.restoreDatabaseConfig)
+        ? PlanConfig_RestoreDatabaseConfig.fromJSON(object.restoreDatabaseConfig)
+        : undefined,
+      loadDataConfig: isSet(object.loadDataConfig)
+        ? PlanConfig_LoadDataConfig.fromJSON(object.loadDataConfig)
+        : undefined,
     };
   },
 
@@ -532,6 +665,12 @@ export const PlanConfig_Spec = {
     if (message.exportDataConfig !== undefined) {
       obj.exportDataConfig = PlanConfig_ExportDataConfig.toJSON(message.exportDataConfig);
     }
+    if (message.restoreDatabaseConfig !== undefined) {
+      obj.restoreDatabaseConfig = PlanConfig_RestoreDatabaseConfig.toJSON(message.restoreDatabaseConfig);
+    }
+    if (message.loadDataConfig !== undefined) {
+      obj.loadDataConfig = PlanConfig_LoadDataConfig.toJSON(message.loadDataConfig);
+    }
     return obj;
   },
 
@@ -552,6 +691,13 @@ export const PlanConfig_Spec = {
     message.exportDataConfig = (object.exportDataConfig !== undefined && object.exportDataConfig !== null)
       ? PlanConfig_ExportDataConfig.fromPartial(object.exportDataConfig)
       : undefined;
+    message.restoreDatabaseConfig =
+      (object.restoreDatabaseConfig !== undefined && object.restoreDatabaseConfig !== null)
+        ? PlanConfig_RestoreDatabaseConfig.fromPartial(object.restoreDatabaseConfig)
+        : undefined;
+    message.loadDataConfig = (object.loadDataConfig !== undefined && object.loadDataConfig !== null)
+      ? PlanConfig_LoadDataConfig.fromPartial(object.loadDataConfig)
+      : undefined;
     return message;
   },
 };
@@ -568,6 +714,8 @@ function createBasePlanConfig_CreateDatabaseConfig(): PlanConfig_CreateDatabaseC
     backup: "",
     environment: "",
     labels: {},
+    template: "",
+    deployment: "",
   };
 }
 
@@ -603,6 +751,12 @@ export const PlanConfig_CreateDatabaseConfig = {
     Object.entries(message.labels).forEach(([key, value]) => {
       PlanConfig_CreateDatabaseConfig_LabelsEntry.encode({ key: key as any, value }, writer.uint32(82).fork()).ldelim();
     });
+    if (message.template !== "") {
+      writer.uint32(90).string(message.template);
+    }
+    if (message.deployment !== "") {
+      writer.uint32(98).string(message.deployment);
+    }
     return writer;
   },
 
@@ -686,6 +840,20 @@ export const PlanConfig_CreateDatabaseConfig = {
             message.labels[entry10.key] = entry10.value;
           }
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.template = reader.string();
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.deployment = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -712,6 +880,8 @@ export const PlanConfig_CreateDatabaseConfig = {
           return acc;
         }, {})
         : {},
+      template: isSet(object.template) ? globalThis.String(object.template) : "",
+      deployment: isSet(object.deployment) ? globalThis.String(object.deployment) : "",
     };
   },
 
@@ -753,6 +923,12 @@ export const PlanConfig_CreateDatabaseConfig = {
         });
       }
     }
+    if (message.template !== "") {
+      obj.template = message.template;
+    }
+    if (message.deployment !== "") {
+      obj.deployment = message.deployment;
+    }
     return obj;
   },
 
@@ -776,6 +952,8 @@ export const PlanConfig_CreateDatabaseConfig = {
       }
       return acc;
     }, {});
+    message.template = object.template ?? "";
+    message.deployment = object.deployment ?? "";
     return message;
   },
 };
@@ -864,6 +1042,11 @@ function createBasePlanConfig_ChangeDatabaseConfig(): PlanConfig_ChangeDatabaseC
     schemaVersion: "",
     ghostFlags: {},
     preUpdateBackupDetail: undefined,
+    allowDestructiveChanges: false,
+    verificationQueries: [],
+    simulationInstance: "",
+    transactionMode: TransactionMode.TRANSACTION_MODE_UNSPECIFIED,
+    sessionVariables: {},
   };
 }
 
@@ -891,6 +1074,24 @@ export const PlanConfig_ChangeDatabaseConfig = {
         writer.uint32(66).fork(),
       ).ldelim();
     }
+    if (message.allowDestructiveChanges === true) {
+      writer.uint32(72).bool(message.allowDestructiveChanges);
+    }
+    for (const v of message.verificationQueries) {
+      VerificationQuery.encode(v!, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.simulationInstance !== "") {
+      writer.uint32(90).string(message.simulationInstance);
+    }
+    if (message.transactionMode !== TransactionMode.TRANSACTION_MODE_UNSPECIFIED) {
+      writer.uint32(96).int32(transactionModeToNumber(message.transactionMode));
+    }
+    Object.entries(message.sessionVariables).forEach(([key, value]) => {
+      PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry.encode(
+        { key: key as any, value },
+        writer.uint32(106).fork(),
+      ).ldelim();
+    });
     return writer;
   },
 
@@ -949,6 +1150,44 @@ export const PlanConfig_ChangeDatabaseConfig = {
             reader.uint32(),
           );
           continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.allowDestructiveChanges = reader.bool();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.verificationQueries.push(VerificationQuery.decode(reader, reader.uint32()));
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.simulationInstance = reader.string();
+          continue;
+        case 12:
+          if (tag !== 96) {
+            break;
+          }
+
+          message.transactionMode = transactionModeFromJSON(reader.int32());
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          const entry13 = PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry.decode(reader, reader.uint32());
+          if (entry13.value !== undefined) {
+            message.sessionVariables[entry13.key] = entry13.value;
+          }
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -975,6 +1214,22 @@ export const PlanConfig_ChangeDatabaseConfig = {
       preUpdateBackupDetail: isSet(object.preUpdateBackupDetail)
         ? PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail.fromJSON(object.preUpdateBackupDetail)
         : undefined,
+      allowDestructiveChanges: isSet(object.allowDestructiveChanges)
+        ? globalThis.Boolean(object.allowDestructiveChanges)
+        : false,
+      verificationQueries: globalThis.Array.isArray(object?.verificationQueries)
+        ? object.verificationQueries.map((e: any) => VerificationQuery.fromJSON(e))
+        : [],
+      simulationInstance: isSet(object.simulationInstance) ? globalThis.String(object.simulationInstance) : "",
+      transactionMode: isSet(object.transactionMode)
+        ? transactionModeFromJSON(object.transactionMode)
+        : TransactionMode.TRANSACTION_MODE_UNSPECIFIED,
+      sessionVariables: isObject(object.sessionVariables)
+        ? Object.entries(object.sessionVariables).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
@@ -1006,6 +1261,27 @@ export const PlanConfig_ChangeDatabaseConfig = {
         message.preUpdateBackupDetail,
       );
     }
+    if (message.allowDestructiveChanges === true) {
+      obj.allowDestructiveChanges = message.allowDestructiveChanges;
+    }
+    if (message.verificationQueries?.length) {
+      obj.verificationQueries = message.verificationQueries.map((e) => VerificationQuery.toJSON(e));
+    }
+    if (message.simulationInstance !== "") {
+      obj.simulationInstance = message.simulationInstance;
+    }
+    if (message.transactionMode !== TransactionMode.TRANSACTION_MODE_UNSPECIFIED) {
+      obj.transactionMode = transactionModeToJSON(message.transactionMode);
+    }
+    if (message.sessionVariables) {
+      const entries = Object.entries(message.sessionVariables);
+      if (entries.length > 0) {
+        obj.sessionVariables = {};
+        entries.forEach(([k, v]) => {
+          obj.sessionVariables[k] = v;
+        });
+      }
+    }
     return obj;
   },
 
@@ -1031,6 +1307,19 @@ export const PlanConfig_ChangeDatabaseConfig = {
       (object.preUpdateBackupDetail !== undefined && object.preUpdateBackupDetail !== null)
         ? PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail.fromPartial(object.preUpdateBackupDetail)
         : undefined;
+    message.allowDestructiveChanges = object.allowDestructiveChanges ?? false;
+    message.verificationQueries = object.verificationQueries?.map((e) => VerificationQuery.fromPartial(e)) || [];
+    message.simulationInstance = object.simulationInstance ?? "";
+    message.transactionMode = object.transactionMode ?? TransactionMode.TRANSACTION_MODE_UNSPECIFIED;
+    message.sessionVariables = Object.entries(object.sessionVariables ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
@@ -1117,7 +1406,7 @@ export const PlanConfig_ChangeDatabaseConfig_GhostFlagsEntry = {
 };
 
 function createBasePlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail(): PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail {
-  return { database: "" };
+  return { database: "", fullTable: false };
 }
 
 export const PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail = {
@@ -1128,6 +1417,9 @@ export const PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail = {
     if (message.database !== "") {
       writer.uint32(10).string(message.database);
     }
+    if (message.fullTable === true) {
+      writer.uint32(16).bool(message.fullTable);
+    }
     return writer;
   },
 
@@ -1145,6 +1437,13 @@ export const PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail = {
 
           message.database = reader.string();
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.fullTable = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1155,7 +1454,10 @@ export const PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail = {
   },
 
   fromJSON(object: any): PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail {
-    return { database: isSet(object.database) ? globalThis.String(object.database) : "" };
+    return {
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      fullTable: isSet(object.fullTable) ? globalThis.Boolean(object.fullTable) : false,
+    };
   },
 
   toJSON(message: PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail): unknown {
@@ -1163,6 +1465,9 @@ export const PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail = {
     if (message.database !== "") {
       obj.database = message.database;
     }
+    if (message.fullTable === true) {
+      obj.fullTable = message.fullTable;
+    }
     return obj;
   },
 
@@ -1176,6 +1481,88 @@ export const PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail = {
   ): PlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail {
     const message = createBasePlanConfig_ChangeDatabaseConfig_PreUpdateBackupDetail();
     message.database = object.database ?? "";
+    message.fullTable = object.fullTable ?? false;
+    return message;
+  },
+};
+
+function createBasePlanConfig_ChangeDatabaseConfig_SessionVariablesEntry(): PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry {
+  return { key: "", value: "" };
+}
+
+export const PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry = {
+  encode(
+    message: PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanConfig_ChangeDatabaseConfig_SessionVariablesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry>,
+  ): PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry {
+    return PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry>,
+  ): PlanConfig_ChangeDatabaseConfig_SessionVariablesEntry {
+    const message = createBasePlanConfig_ChangeDatabaseConfig_SessionVariablesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
@@ -1284,6 +1671,253 @@ export const PlanConfig_ExportDataConfig = {
   },
 };
 
+function createBasePlanConfig_LoadDataConfig(): PlanConfig_LoadDataConfig {
+  return {
+    target: "",
+    table: "",
+    sourceUri: "",
+    format: DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+    header: false,
+    fieldDelimiter: "",
+  };
+}
+
+export const PlanConfig_LoadDataConfig = {
+  encode(message: PlanConfig_LoadDataConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.target !== "") {
+      writer.uint32(10).string(message.target);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    if (message.sourceUri !== "") {
+      writer.uint32(26).string(message.sourceUri);
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      writer.uint32(32).int32(dataLoadFormatToNumber(message.format));
+    }
+    if (message.header === true) {
+      writer.uint32(40).bool(message.header);
+    }
+    if (message.fieldDelimiter !== "") {
+      writer.uint32(50).string(message.fieldDelimiter);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanConfig_LoadDataConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanConfig_LoadDataConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.sourceUri = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.format = dataLoadFormatFromJSON(reader.int32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.header = reader.bool();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.fieldDelimiter = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanConfig_LoadDataConfig {
+    return {
+      target: isSet(object.target) ? globalThis.String(object.target) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      sourceUri: isSet(object.sourceUri) ? globalThis.String(object.sourceUri) : "",
+      format: isSet(object.format)
+        ? dataLoadFormatFromJSON(object.format)
+        : DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+      header: isSet(object.header) ? globalThis.Boolean(object.header) : false,
+      fieldDelimiter: isSet(object.fieldDelimiter) ? globalThis.String(object.fieldDelimiter) : "",
+    };
+  },
+
+  toJSON(message: PlanConfig_LoadDataConfig): unknown {
+    const obj: any = {};
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.sourceUri !== "") {
+      obj.sourceUri = message.sourceUri;
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      obj.format = dataLoadFormatToJSON(message.format);
+    }
+    if (message.header === true) {
+      obj.header = message.header;
+    }
+    if (message.fieldDelimiter !== "") {
+      obj.fieldDelimiter = message.fieldDelimiter;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanConfig_LoadDataConfig>): PlanConfig_LoadDataConfig {
+    return PlanConfig_LoadDataConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanConfig_LoadDataConfig>): PlanConfig_LoadDataConfig {
+    const message = createBasePlanConfig_LoadDataConfig();
+    message.target = object.target ?? "";
+    message.table = object.table ?? "";
+    message.sourceUri = object.sourceUri ?? "";
+    message.format = object.format ?? DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED;
+    message.header = object.header ?? false;
+    message.fieldDelimiter = object.fieldDelimiter ?? "";
+    return message;
+  },
+};
+
+function createBasePlanConfig_RestoreDatabaseConfig(): PlanConfig_RestoreDatabaseConfig {
+  return { target: "", backupRun: "", restoreTime: undefined, targetDatabase: "" };
+}
+
+export const PlanConfig_RestoreDatabaseConfig = {
+  encode(message: PlanConfig_RestoreDatabaseConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.target !== "") {
+      writer.uint32(10).string(message.target);
+    }
+    if (message.backupRun !== "") {
+      writer.uint32(18).string(message.backupRun);
+    }
+    if (message.restoreTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.restoreTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.targetDatabase !== "") {
+      writer.uint32(34).string(message.targetDatabase);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanConfig_RestoreDatabaseConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanConfig_RestoreDatabaseConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.backupRun = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.restoreTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.targetDatabase = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanConfig_RestoreDatabaseConfig {
+    return {
+      target: isSet(object.target) ? globalThis.String(object.target) : "",
+      backupRun: isSet(object.backupRun) ? globalThis.String(object.backupRun) : "",
+      restoreTime: isSet(object.restoreTime) ? fromJsonTimestamp(object.restoreTime) : undefined,
+      targetDatabase: isSet(object.targetDatabase) ? globalThis.String(object.targetDatabase) : "",
+    };
+  },
+
+  toJSON(message: PlanConfig_RestoreDatabaseConfig): unknown {
+    const obj: any = {};
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.backupRun !== "") {
+      obj.backupRun = message.backupRun;
+    }
+    if (message.restoreTime !== undefined) {
+      obj.restoreTime = message.restoreTime.toISOString();
+    }
+    if (message.targetDatabase !== "") {
+      obj.targetDatabase = message.targetDatabase;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanConfig_RestoreDatabaseConfig>): PlanConfig_RestoreDatabaseConfig {
+    return PlanConfig_RestoreDatabaseConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanConfig_RestoreDatabaseConfig>): PlanConfig_RestoreDatabaseConfig {
+    const message = createBasePlanConfig_RestoreDatabaseConfig();
+    message.target = object.target ?? "";
+    message.backupRun = object.backupRun ?? "";
+    message.restoreTime = object.restoreTime ?? undefined;
+    message.targetDatabase = object.targetDatabase ?? "";
+    return message;
+  },
+};
+
 function createBasePlanConfig_VCSSource(): PlanConfig_VCSSource {
   return { vcsType: VCSType.VCS_TYPE_UNSPECIFIED, vcsConnector: "", pullRequestUrl: "" };
 }
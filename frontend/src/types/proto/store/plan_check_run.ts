@@ -12,6 +12,8 @@ export interface PreUpdateBackupDetail {
    * Format: instances/{instance}/databases/{database}
    */
   database: string;
+  /** If true, the entire tables affected by the change are backed up rather than only the affected rows. */
+  fullTable: boolean;
 }
 
 export interface PlanCheckRunConfig {
@@ -23,7 +25,19 @@ export interface PlanCheckRunConfig {
   databaseGroupUid?: Long | undefined;
   ghostFlags: { [key: string]: string };
   /** If set, a backup of the modified data will be created automatically before any changes are applied. */
-  preUpdateBackupDetail?: PreUpdateBackupDetail | undefined;
+  preUpdateBackupDetail?:
+    | PreUpdateBackupDetail
+    | undefined;
+  /**
+   * The plan of the plan check run.
+   * It is only set for the statement conflict check to exclude the issue of the plan itself.
+   */
+  planUid: Long;
+  /**
+   * The instance to simulate the change against a scratch clone of the database.
+   * It is only set for the statement simulation check.
+   */
+  simulationInstanceUid: number;
 }
 
 export enum PlanCheckRunConfig_ChangeDatabaseType {
@@ -112,6 +126,7 @@ export interface PlanCheckRunResult_Result {
   code: number;
   sqlSummaryReport?: PlanCheckRunResult_Result_SqlSummaryReport | undefined;
   sqlReviewReport?: PlanCheckRunResult_Result_SqlReviewReport | undefined;
+  conflictReport?: PlanCheckRunResult_Result_ConflictReport | undefined;
 }
 
 export enum PlanCheckRunResult_Result_Status {
@@ -197,8 +212,21 @@ export interface PlanCheckRunResult_Result_SqlReviewReport {
   endPosition: Position | undefined;
 }
 
+export interface PlanCheckRunResult_Result_ConflictReport {
+  /**
+   * The open issue changing the same tables.
+   * Format: projects/{project}/issues/{issue}
+   */
+  issue: string;
+  /**
+   * The tables changed by both issues.
+   * Format: schema.table or table if the schema is empty.
+   */
+  tables: string[];
+}
+
 function createBasePreUpdateBackupDetail(): PreUpdateBackupDetail {
-  return { database: "" };
+  return { database: "", fullTable: false };
 }
 
 export const PreUpdateBackupDetail = {
@@ -206,6 +234,9 @@ export const PreUpdateBackupDetail = {
     if (message.database !== "") {
       writer.uint32(10).string(message.database);
     }
+    if (message.fullTable === true) {
+      writer.uint32(16).bool(message.fullTable);
+    }
     return writer;
   },
 
@@ -223,6 +254,13 @@ export const PreUpdateBackupDetail = {
 
           message.database = reader.string();
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.fullTable = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -233,7 +271,10 @@ export const PreUpdateBackupDetail = {
   },
 
   fromJSON(object: any): PreUpdateBackupDetail {
-    return { database: isSet(object.database) ? globalThis.String(object.database) : "" };
+    return {
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      fullTable: isSet(object.fullTable) ? globalThis.Boolean(object.fullTable) : false,
+    };
   },
 
   toJSON(message: PreUpdateBackupDetail): unknown {
@@ -241,6 +282,9 @@ export const PreUpdateBackupDetail = {
     if (message.database !== "") {
       obj.database = message.database;
     }
+    if (message.fullTable === true) {
+      obj.fullTable = message.fullTable;
+    }
     return obj;
   },
 
@@ -250,6 +294,7 @@ export const PreUpdateBackupDetail = {
   fromPartial(object: DeepPartial<PreUpdateBackupDetail>): PreUpdateBackupDetail {
     const message = createBasePreUpdateBackupDetail();
     message.database = object.database ?? "";
+    message.fullTable = object.fullTable ?? false;
     return message;
   },
 };
@@ -263,6 +308,8 @@ function createBasePlanCheckRunConfig(): PlanCheckRunConfig {
     databaseGroupUid: undefined,
     ghostFlags: {},
     preUpdateBackupDetail: undefined,
+    planUid: Long.ZERO,
+    simulationInstanceUid: 0,
   };
 }
 
@@ -289,6 +336,12 @@ export const PlanCheckRunConfig = {
     if (message.preUpdateBackupDetail !== undefined) {
       PreUpdateBackupDetail.encode(message.preUpdateBackupDetail, writer.uint32(58).fork()).ldelim();
     }
+    if (!message.planUid.isZero()) {
+      writer.uint32(64).int64(message.planUid);
+    }
+    if (message.simulationInstanceUid !== 0) {
+      writer.uint32(72).int32(message.simulationInstanceUid);
+    }
     return writer;
   },
 
@@ -351,6 +404,20 @@ export const PlanCheckRunConfig = {
 
           message.preUpdateBackupDetail = PreUpdateBackupDetail.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.planUid = reader.int64() as Long;
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.simulationInstanceUid = reader.int32();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -378,6 +445,8 @@ export const PlanCheckRunConfig = {
       preUpdateBackupDetail: isSet(object.preUpdateBackupDetail)
         ? PreUpdateBackupDetail.fromJSON(object.preUpdateBackupDetail)
         : undefined,
+      planUid: isSet(object.planUid) ? Long.fromValue(object.planUid) : Long.ZERO,
+      simulationInstanceUid: isSet(object.simulationInstanceUid) ? globalThis.Number(object.simulationInstanceUid) : 0,
     };
   },
 
@@ -410,6 +479,12 @@ export const PlanCheckRunConfig = {
     if (message.preUpdateBackupDetail !== undefined) {
       obj.preUpdateBackupDetail = PreUpdateBackupDetail.toJSON(message.preUpdateBackupDetail);
     }
+    if (!message.planUid.isZero()) {
+      obj.planUid = (message.planUid || Long.ZERO).toString();
+    }
+    if (message.simulationInstanceUid !== 0) {
+      obj.simulationInstanceUid = Math.round(message.simulationInstanceUid);
+    }
     return obj;
   },
 
@@ -439,6 +514,10 @@ export const PlanCheckRunConfig = {
       (object.preUpdateBackupDetail !== undefined && object.preUpdateBackupDetail !== null)
         ? PreUpdateBackupDetail.fromPartial(object.preUpdateBackupDetail)
         : undefined;
+    message.planUid = (object.planUid !== undefined && object.planUid !== null)
+      ? Long.fromValue(object.planUid)
+      : Long.ZERO;
+    message.simulationInstanceUid = object.simulationInstanceUid ?? 0;
     return message;
   },
 };
@@ -601,6 +680,7 @@ function createBasePlanCheckRunResult_Result(): PlanCheckRunResult_Result {
     code: 0,
     sqlSummaryReport: undefined,
     sqlReviewReport: undefined,
+    conflictReport: undefined,
   };
 }
 
@@ -624,6 +704,9 @@ export const PlanCheckRunResult_Result = {
     if (message.sqlReviewReport !== undefined) {
       PlanCheckRunResult_Result_SqlReviewReport.encode(message.sqlReviewReport, writer.uint32(50).fork()).ldelim();
     }
+    if (message.conflictReport !== undefined) {
+      PlanCheckRunResult_Result_ConflictReport.encode(message.conflictReport, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -676,6 +759,13 @@ export const PlanCheckRunResult_Result = {
 
           message.sqlReviewReport = PlanCheckRunResult_Result_SqlReviewReport.decode(reader, reader.uint32());
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.conflictReport = PlanCheckRunResult_Result_ConflictReport.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -699,6 +789,9 @@ export const PlanCheckRunResult_Result = {
       sqlReviewReport: isSet(object.sqlReviewReport)
         ? PlanCheckRunResult_Result_SqlReviewReport.fromJSON(object.sqlReviewReport)
         : undefined,
+      conflictReport: isSet(object.conflictReport)
+        ? PlanCheckRunResult_Result_ConflictReport.fromJSON(object.conflictReport)
+        : undefined,
     };
   },
 
@@ -722,6 +815,9 @@ export const PlanCheckRunResult_Result = {
     if (message.sqlReviewReport !== undefined) {
       obj.sqlReviewReport = PlanCheckRunResult_Result_SqlReviewReport.toJSON(message.sqlReviewReport);
     }
+    if (message.conflictReport !== undefined) {
+      obj.conflictReport = PlanCheckRunResult_Result_ConflictReport.toJSON(message.conflictReport);
+    }
     return obj;
   },
 
@@ -740,6 +836,9 @@ export const PlanCheckRunResult_Result = {
     message.sqlReviewReport = (object.sqlReviewReport !== undefined && object.sqlReviewReport !== null)
       ? PlanCheckRunResult_Result_SqlReviewReport.fromPartial(object.sqlReviewReport)
       : undefined;
+    message.conflictReport = (object.conflictReport !== undefined && object.conflictReport !== null)
+      ? PlanCheckRunResult_Result_ConflictReport.fromPartial(object.conflictReport)
+      : undefined;
     return message;
   },
 };
@@ -994,6 +1093,80 @@ export const PlanCheckRunResult_Result_SqlReviewReport = {
   },
 };
 
+function createBasePlanCheckRunResult_Result_ConflictReport(): PlanCheckRunResult_Result_ConflictReport {
+  return { issue: "", tables: [] };
+}
+
+export const PlanCheckRunResult_Result_ConflictReport = {
+  encode(message: PlanCheckRunResult_Result_ConflictReport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.issue !== "") {
+      writer.uint32(10).string(message.issue);
+    }
+    for (const v of message.tables) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRunResult_Result_ConflictReport {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanCheckRunResult_Result_ConflictReport();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.issue = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tables.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanCheckRunResult_Result_ConflictReport {
+    return {
+      issue: isSet(object.issue) ? globalThis.String(object.issue) : "",
+      tables: globalThis.Array.isArray(object?.tables) ? object.tables.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: PlanCheckRunResult_Result_ConflictReport): unknown {
+    const obj: any = {};
+    if (message.issue !== "") {
+      obj.issue = message.issue;
+    }
+    if (message.tables?.length) {
+      obj.tables = message.tables;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanCheckRunResult_Result_ConflictReport>): PlanCheckRunResult_Result_ConflictReport {
+    return PlanCheckRunResult_Result_ConflictReport.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanCheckRunResult_Result_ConflictReport>): PlanCheckRunResult_Result_ConflictReport {
+    const message = createBasePlanCheckRunResult_Result_ConflictReport();
+    message.issue = object.issue ?? "";
+    message.tables = object.tables?.map((e) => e) || [];
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -85,6 +85,22 @@ export interface RolloutPolicy {
    * roles/CREATOR
    */
   issueRoles: string[];
+  /**
+   * The user groups whose members can roll out.
+   * Format: groups/{email}
+   */
+  groups: string[];
+  /**
+   * Each stage in the environment requires a manual approval before it starts,
+   * even if the issue is approved.
+   */
+  stageApprovalRequired: boolean;
+  /**
+   * The user groups whose members can approve or reject the stage.
+   * Users with the bb.taskRuns.create permission can approve or reject the stage if it's empty.
+   * Format: groups/{email}
+   */
+  stageApproverGroups: string[];
 }
 
 export interface MaskingPolicy {
@@ -315,6 +331,7 @@ export interface RestrictIssueCreationForSQLReviewPolicy {
 /** DataSourceQueryPolicy is the policy configuration for data source query. */
 export interface DataSourceQueryPolicy {
   adminDataSourceRestriction: DataSourceQueryPolicy_Restriction;
+  routing: DataSourceQueryPolicy_Routing;
 }
 
 export enum DataSourceQueryPolicy_Restriction {
@@ -372,8 +389,104 @@ export function dataSourceQueryPolicy_RestrictionToNumber(object: DataSourceQuer
   }
 }
 
+export enum DataSourceQueryPolicy_Routing {
+  /** ROUTING_UNSPECIFIED - Same as PREFER_READ_ONLY. */
+  ROUTING_UNSPECIFIED = "ROUTING_UNSPECIFIED",
+  /**
+   * PREFER_READ_ONLY - Route queries and exports to read-only data sources by default.
+   * Querying the admin data source explicitly requires the bb.databases.queryPrimary permission when read-only data sources exist.
+   */
+  PREFER_READ_ONLY = "PREFER_READ_ONLY",
+  /** PREFER_ADMIN - Route queries and exports to the admin data source by default. */
+  PREFER_ADMIN = "PREFER_ADMIN",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function dataSourceQueryPolicy_RoutingFromJSON(object: any): DataSourceQueryPolicy_Routing {
+  switch (object) {
+    case 0:
+    case "ROUTING_UNSPECIFIED":
+      return DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED;
+    case 1:
+    case "PREFER_READ_ONLY":
+      return DataSourceQueryPolicy_Routing.PREFER_READ_ONLY;
+    case 2:
+    case "PREFER_ADMIN":
+      return DataSourceQueryPolicy_Routing.PREFER_ADMIN;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DataSourceQueryPolicy_Routing.UNRECOGNIZED;
+  }
+}
+
+export function dataSourceQueryPolicy_RoutingToJSON(object: DataSourceQueryPolicy_Routing): string {
+  switch (object) {
+    case DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED:
+      return "ROUTING_UNSPECIFIED";
+    case DataSourceQueryPolicy_Routing.PREFER_READ_ONLY:
+      return "PREFER_READ_ONLY";
+    case DataSourceQueryPolicy_Routing.PREFER_ADMIN:
+      return "PREFER_ADMIN";
+    case DataSourceQueryPolicy_Routing.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function dataSourceQueryPolicy_RoutingToNumber(object: DataSourceQueryPolicy_Routing): number {
+  switch (object) {
+    case DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED:
+      return 0;
+    case DataSourceQueryPolicy_Routing.PREFER_READ_ONLY:
+      return 1;
+    case DataSourceQueryPolicy_Routing.PREFER_ADMIN:
+      return 2;
+    case DataSourceQueryPolicy_Routing.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** PIIDetectionPolicy is the policy configuration for detecting personally identifiable information during the schema sync. */
+export interface PIIDetectionPolicy {
+  active: boolean;
+  /** If true, the column values are sampled besides the column names. */
+  sampleData: boolean;
+  /** The number of rows sampled per table. Empty means 100. */
+  sampleSize: number;
+}
+
+/**
+ * RowAccessPolicy is the policy to filter the rows of the tables queried and exported in SQL Editor.
+ * It can only be set on databases. The views are not expanded, so the views selecting the filtered tables need their own rules.
+ */
+export interface RowAccessPolicy {
+  rules: RowAccessPolicy_Rule[];
+}
+
+export interface RowAccessPolicy_Rule {
+  schema: string;
+  table: string;
+  /**
+   * The CEL predicate over the column values and the requester attributes.
+   * The columns are referenced by their names, and the requester attributes are `request.user.email` and `request.user.groups`,
+   * e.g. `"support-eu@example.com" in request.user.groups && region == "EU"`.
+   * A row is visible if it matches any rule of the table.
+   */
+  condition: Expr | undefined;
+}
+
 function createBaseRolloutPolicy(): RolloutPolicy {
-  return { automatic: false, workspaceRoles: [], projectRoles: [], issueRoles: [] };
+  return {
+    automatic: false,
+    workspaceRoles: [],
+    projectRoles: [],
+    issueRoles: [],
+    groups: [],
+    stageApprovalRequired: false,
+    stageApproverGroups: [],
+  };
 }
 
 export const RolloutPolicy = {
@@ -390,6 +503,15 @@ export const RolloutPolicy = {
     for (const v of message.issueRoles) {
       writer.uint32(34).string(v!);
     }
+    for (const v of message.groups) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.stageApprovalRequired === true) {
+      writer.uint32(48).bool(message.stageApprovalRequired);
+    }
+    for (const v of message.stageApproverGroups) {
+      writer.uint32(58).string(v!);
+    }
     return writer;
   },
 
@@ -428,6 +550,27 @@ export const RolloutPolicy = {
 
           message.issueRoles.push(reader.string());
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.groups.push(reader.string());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.stageApprovalRequired = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.stageApproverGroups.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -449,6 +592,13 @@ export const RolloutPolicy = {
       issueRoles: globalThis.Array.isArray(object?.issueRoles)
         ? object.issueRoles.map((e: any) => globalThis.String(e))
         : [],
+      groups: globalThis.Array.isArray(object?.groups) ? object.groups.map((e: any) => globalThis.String(e)) : [],
+      stageApprovalRequired: isSet(object.stageApprovalRequired)
+        ? globalThis.Boolean(object.stageApprovalRequired)
+        : false,
+      stageApproverGroups: globalThis.Array.isArray(object?.stageApproverGroups)
+        ? object.stageApproverGroups.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
@@ -466,6 +616,15 @@ export const RolloutPolicy = {
     if (message.issueRoles?.length) {
       obj.issueRoles = message.issueRoles;
     }
+    if (message.groups?.length) {
+      obj.groups = message.groups;
+    }
+    if (message.stageApprovalRequired === true) {
+      obj.stageApprovalRequired = message.stageApprovalRequired;
+    }
+    if (message.stageApproverGroups?.length) {
+      obj.stageApproverGroups = message.stageApproverGroups;
+    }
     return obj;
   },
 
@@ -478,6 +637,9 @@ export const RolloutPolicy = {
     message.workspaceRoles = object.workspaceRoles?.map((e) => e) || [];
     message.projectRoles = object.projectRoles?.map((e) => e) || [];
     message.issueRoles = object.issueRoles?.map((e) => e) || [];
+    message.groups = object.groups?.map((e) => e) || [];
+    message.stageApprovalRequired = object.stageApprovalRequired ?? false;
+    message.stageApproverGroups = object.stageApproverGroups?.map((e) => e) || [];
     return message;
   },
 };
@@ -1680,7 +1842,10 @@ export const RestrictIssueCreationForSQLReviewPolicy = {
 };
 
 function createBaseDataSourceQueryPolicy(): DataSourceQueryPolicy {
-  return { adminDataSourceRestriction: DataSourceQueryPolicy_Restriction.RESTRICTION_UNSPECIFIED };
+  return {
+    adminDataSourceRestriction: DataSourceQueryPolicy_Restriction.RESTRICTION_UNSPECIFIED,
+    routing: DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED,
+  };
 }
 
 export const DataSourceQueryPolicy = {
@@ -1688,6 +1853,9 @@ export const DataSourceQueryPolicy = {
     if (message.adminDataSourceRestriction !== DataSourceQueryPolicy_Restriction.RESTRICTION_UNSPECIFIED) {
       writer.uint32(8).int32(dataSourceQueryPolicy_RestrictionToNumber(message.adminDataSourceRestriction));
     }
+    if (message.routing !== DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED) {
+      writer.uint32(16).int32(dataSourceQueryPolicy_RoutingToNumber(message.routing));
+    }
     return writer;
   },
 
@@ -1705,6 +1873,13 @@ export const DataSourceQueryPolicy = {
 
           message.adminDataSourceRestriction = dataSourceQueryPolicy_RestrictionFromJSON(reader.int32());
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.routing = dataSourceQueryPolicy_RoutingFromJSON(reader.int32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1719,6 +1894,9 @@ export const DataSourceQueryPolicy = {
       adminDataSourceRestriction: isSet(object.adminDataSourceRestriction)
         ? dataSourceQueryPolicy_RestrictionFromJSON(object.adminDataSourceRestriction)
         : DataSourceQueryPolicy_Restriction.RESTRICTION_UNSPECIFIED,
+      routing: isSet(object.routing)
+        ? dataSourceQueryPolicy_RoutingFromJSON(object.routing)
+        : DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED,
     };
   },
 
@@ -1727,6 +1905,9 @@ export const DataSourceQueryPolicy = {
     if (message.adminDataSourceRestriction !== DataSourceQueryPolicy_Restriction.RESTRICTION_UNSPECIFIED) {
       obj.adminDataSourceRestriction = dataSourceQueryPolicy_RestrictionToJSON(message.adminDataSourceRestriction);
     }
+    if (message.routing !== DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED) {
+      obj.routing = dataSourceQueryPolicy_RoutingToJSON(message.routing);
+    }
     return obj;
   },
 
@@ -1737,6 +1918,248 @@ export const DataSourceQueryPolicy = {
     const message = createBaseDataSourceQueryPolicy();
     message.adminDataSourceRestriction = object.adminDataSourceRestriction ??
       DataSourceQueryPolicy_Restriction.RESTRICTION_UNSPECIFIED;
+    message.routing = object.routing ?? DataSourceQueryPolicy_Routing.ROUTING_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBasePIIDetectionPolicy(): PIIDetectionPolicy {
+  return { active: false, sampleData: false, sampleSize: 0 };
+}
+
+export const PIIDetectionPolicy = {
+  encode(message: PIIDetectionPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.active === true) {
+      writer.uint32(8).bool(message.active);
+    }
+    if (message.sampleData === true) {
+      writer.uint32(16).bool(message.sampleData);
+    }
+    if (message.sampleSize !== 0) {
+      writer.uint32(24).int32(message.sampleSize);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PIIDetectionPolicy {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePIIDetectionPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.active = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.sampleData = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.sampleSize = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PIIDetectionPolicy {
+    return {
+      active: isSet(object.active) ? globalThis.Boolean(object.active) : false,
+      sampleData: isSet(object.sampleData) ? globalThis.Boolean(object.sampleData) : false,
+      sampleSize: isSet(object.sampleSize) ? globalThis.Number(object.sampleSize) : 0,
+    };
+  },
+
+  toJSON(message: PIIDetectionPolicy): unknown {
+    const obj: any = {};
+    if (message.active === true) {
+      obj.active = message.active;
+    }
+    if (message.sampleData === true) {
+      obj.sampleData = message.sampleData;
+    }
+    if (message.sampleSize !== 0) {
+      obj.sampleSize = Math.round(message.sampleSize);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PIIDetectionPolicy>): PIIDetectionPolicy {
+    return PIIDetectionPolicy.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PIIDetectionPolicy>): PIIDetectionPolicy {
+    const message = createBasePIIDetectionPolicy();
+    message.active = object.active ?? false;
+    message.sampleData = object.sampleData ?? false;
+    message.sampleSize = object.sampleSize ?? 0;
+    return message;
+  },
+};
+
+function createBaseRowAccessPolicy(): RowAccessPolicy {
+  return { rules: [] };
+}
+
+export const RowAccessPolicy = {
+  encode(message: RowAccessPolicy, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.rules) {
+      RowAccessPolicy_Rule.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowAccessPolicy {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowAccessPolicy();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rules.push(RowAccessPolicy_Rule.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowAccessPolicy {
+    return {
+      rules: globalThis.Array.isArray(object?.rules)
+        ? object.rules.map((e: any) => RowAccessPolicy_Rule.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RowAccessPolicy): unknown {
+    const obj: any = {};
+    if (message.rules?.length) {
+      obj.rules = message.rules.map((e) => RowAccessPolicy_Rule.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowAccessPolicy>): RowAccessPolicy {
+    return RowAccessPolicy.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RowAccessPolicy>): RowAccessPolicy {
+    const message = createBaseRowAccessPolicy();
+    message.rules = object.rules?.map((e) => RowAccessPolicy_Rule.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRowAccessPolicy_Rule(): RowAccessPolicy_Rule {
+  return { schema: "", table: "", condition: undefined };
+}
+
+export const RowAccessPolicy_Rule = {
+  encode(message: RowAccessPolicy_Rule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowAccessPolicy_Rule {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowAccessPolicy_Rule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowAccessPolicy_Rule {
+    return {
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+    };
+  },
+
+  toJSON(message: RowAccessPolicy_Rule): unknown {
+    const obj: any = {};
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.condition !== undefined) {
+      obj.condition = Expr.toJSON(message.condition);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowAccessPolicy_Rule>): RowAccessPolicy_Rule {
+    return RowAccessPolicy_Rule.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RowAccessPolicy_Rule>): RowAccessPolicy_Rule {
+    const message = createBaseRowAccessPolicy_Rule();
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
     return message;
   },
 };
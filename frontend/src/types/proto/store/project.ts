@@ -1,6 +1,7 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { DataSourceExternalSecret } from "./data_source";
 
 export const protobufPackage = "bytebase.store";
 
@@ -18,6 +19,118 @@ export interface Project {
   allowModifyStatement: boolean;
   /** Enable auto resolve issue. */
   autoResolveIssue: boolean;
+  /** The variables referenced as ${NAME} in the statements, substituted per database at task execution. */
+  statementVariables: StatementVariable[];
+  /** The issue tracker which the issues are synced to, unset means no sync. */
+  ticketSync: TicketSync | undefined;
+}
+
+/** TicketSync creates a linked ticket in the issue tracker when an issue is created, and syncs the status transitions both ways. */
+export interface TicketSync {
+  type: TicketSync_Type;
+  /**
+   * The URL of the Jira site, e.g. https://example.atlassian.net.
+   * Empty means https://api.linear.app for Linear.
+   */
+  url: string;
+  /** The email of the Jira account, it's unused for Linear. */
+  username: string;
+  /** The API token of Jira or the API key of Linear, it can be a {{URL}} reference to the secret. */
+  token: string;
+  /** The external secret manager storing the token, e.g. Vault. */
+  externalSecret:
+    | DataSourceExternalSecret
+    | undefined;
+  /** The Jira project key, or the Linear team id. */
+  project: string;
+  /** The Jira issue type of the tickets, e.g. Task. It's unused for Linear. */
+  issueType: string;
+  /**
+   * The ticket statuses of the issue statuses, e.g. "To Do", "Done" and "Won't Do".
+   * The ticket is moved to the status when the issue status changes,
+   * and the open issue is resolved or canceled when its ticket is moved to the done or canceled status.
+   * Empty means the issue status isn't synced.
+   */
+  openStatus: string;
+  doneStatus: string;
+  canceledStatus: string;
+}
+
+export enum TicketSync_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  JIRA = "JIRA",
+  LINEAR = "LINEAR",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function ticketSync_TypeFromJSON(object: any): TicketSync_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return TicketSync_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "JIRA":
+      return TicketSync_Type.JIRA;
+    case 2:
+    case "LINEAR":
+      return TicketSync_Type.LINEAR;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TicketSync_Type.UNRECOGNIZED;
+  }
+}
+
+export function ticketSync_TypeToJSON(object: TicketSync_Type): string {
+  switch (object) {
+    case TicketSync_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case TicketSync_Type.JIRA:
+      return "JIRA";
+    case TicketSync_Type.LINEAR:
+      return "LINEAR";
+    case TicketSync_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function ticketSync_TypeToNumber(object: TicketSync_Type): number {
+  switch (object) {
+    case TicketSync_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case TicketSync_Type.JIRA:
+      return 1;
+    case TicketSync_Type.LINEAR:
+      return 2;
+    case TicketSync_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface StatementVariable {
+  /** The name of the variable, e.g. RETENTION_DAYS. */
+  name: string;
+  /** The value used if neither the database nor its environment has a value. Empty means no default value. */
+  defaultValue: string;
+  /** The values keyed by the environment resource id. */
+  environmentValues: { [key: string]: string };
+  /**
+   * The values keyed by the database resource name, e.g. instances/{instance}/databases/{database}.
+   * The database value takes precedence over the environment value.
+   */
+  databaseValues: { [key: string]: string };
+}
+
+export interface StatementVariable_EnvironmentValuesEntry {
+  key: string;
+  value: string;
+}
+
+export interface StatementVariable_DatabaseValuesEntry {
+  key: string;
+  value: string;
 }
 
 function createBaseLabel(): Label {
@@ -110,7 +223,14 @@ export const Label = {
 };
 
 function createBaseProject(): Project {
-  return { issueLabels: [], forceIssueLabels: false, allowModifyStatement: false, autoResolveIssue: false };
+  return {
+    issueLabels: [],
+    forceIssueLabels: false,
+    allowModifyStatement: false,
+    autoResolveIssue: false,
+    statementVariables: [],
+    ticketSync: undefined,
+  };
 }
 
 export const Project = {
@@ -127,6 +247,12 @@ export const Project = {
     if (message.autoResolveIssue === true) {
       writer.uint32(40).bool(message.autoResolveIssue);
     }
+    for (const v of message.statementVariables) {
+      StatementVariable.encode(v!, writer.uint32(50).fork()).ldelim();
+    }
+    if (message.ticketSync !== undefined) {
+      TicketSync.encode(message.ticketSync, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -165,6 +291,20 @@ export const Project = {
 
           message.autoResolveIssue = reader.bool();
           continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.statementVariables.push(StatementVariable.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.ticketSync = TicketSync.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -184,6 +324,10 @@ export const Project = {
         ? globalThis.Boolean(object.allowModifyStatement)
         : false,
       autoResolveIssue: isSet(object.autoResolveIssue) ? globalThis.Boolean(object.autoResolveIssue) : false,
+      statementVariables: globalThis.Array.isArray(object?.statementVariables)
+        ? object.statementVariables.map((e: any) => StatementVariable.fromJSON(e))
+        : [],
+      ticketSync: isSet(object.ticketSync) ? TicketSync.fromJSON(object.ticketSync) : undefined,
     };
   },
 
@@ -201,6 +345,12 @@ export const Project = {
     if (message.autoResolveIssue === true) {
       obj.autoResolveIssue = message.autoResolveIssue;
     }
+    if (message.statementVariables?.length) {
+      obj.statementVariables = message.statementVariables.map((e) => StatementVariable.toJSON(e));
+    }
+    if (message.ticketSync !== undefined) {
+      obj.ticketSync = TicketSync.toJSON(message.ticketSync);
+    }
     return obj;
   },
 
@@ -213,6 +363,515 @@ export const Project = {
     message.forceIssueLabels = object.forceIssueLabels ?? false;
     message.allowModifyStatement = object.allowModifyStatement ?? false;
     message.autoResolveIssue = object.autoResolveIssue ?? false;
+    message.statementVariables = object.statementVariables?.map((e) => StatementVariable.fromPartial(e)) || [];
+    message.ticketSync = (object.ticketSync !== undefined && object.ticketSync !== null)
+      ? TicketSync.fromPartial(object.ticketSync)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTicketSync(): TicketSync {
+  return {
+    type: TicketSync_Type.TYPE_UNSPECIFIED,
+    url: "",
+    username: "",
+    token: "",
+    externalSecret: undefined,
+    project: "",
+    issueType: "",
+    openStatus: "",
+    doneStatus: "",
+    canceledStatus: "",
+  };
+}
+
+export const TicketSync = {
+  encode(message: TicketSync, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== TicketSync_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(ticketSync_TypeToNumber(message.type));
+    }
+    if (message.url !== "") {
+      writer.uint32(18).string(message.url);
+    }
+    if (message.username !== "") {
+      writer.uint32(26).string(message.username);
+    }
+    if (message.token !== "") {
+      writer.uint32(34).string(message.token);
+    }
+    if (message.externalSecret !== undefined) {
+      DataSourceExternalSecret.encode(message.externalSecret, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.project !== "") {
+      writer.uint32(50).string(message.project);
+    }
+    if (message.issueType !== "") {
+      writer.uint32(58).string(message.issueType);
+    }
+    if (message.openStatus !== "") {
+      writer.uint32(66).string(message.openStatus);
+    }
+    if (message.doneStatus !== "") {
+      writer.uint32(74).string(message.doneStatus);
+    }
+    if (message.canceledStatus !== "") {
+      writer.uint32(82).string(message.canceledStatus);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TicketSync {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTicketSync();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = ticketSync_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.externalSecret = DataSourceExternalSecret.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.issueType = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.openStatus = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.doneStatus = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.canceledStatus = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TicketSync {
+    return {
+      type: isSet(object.type) ? ticketSync_TypeFromJSON(object.type) : TicketSync_Type.TYPE_UNSPECIFIED,
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      username: isSet(object.username) ? globalThis.String(object.username) : "",
+      token: isSet(object.token) ? globalThis.String(object.token) : "",
+      externalSecret: isSet(object.externalSecret)
+        ? DataSourceExternalSecret.fromJSON(object.externalSecret)
+        : undefined,
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      issueType: isSet(object.issueType) ? globalThis.String(object.issueType) : "",
+      openStatus: isSet(object.openStatus) ? globalThis.String(object.openStatus) : "",
+      doneStatus: isSet(object.doneStatus) ? globalThis.String(object.doneStatus) : "",
+      canceledStatus: isSet(object.canceledStatus) ? globalThis.String(object.canceledStatus) : "",
+    };
+  },
+
+  toJSON(message: TicketSync): unknown {
+    const obj: any = {};
+    if (message.type !== TicketSync_Type.TYPE_UNSPECIFIED) {
+      obj.type = ticketSync_TypeToJSON(message.type);
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    if (message.username !== "") {
+      obj.username = message.username;
+    }
+    if (message.token !== "") {
+      obj.token = message.token;
+    }
+    if (message.externalSecret !== undefined) {
+      obj.externalSecret = DataSourceExternalSecret.toJSON(message.externalSecret);
+    }
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.issueType !== "") {
+      obj.issueType = message.issueType;
+    }
+    if (message.openStatus !== "") {
+      obj.openStatus = message.openStatus;
+    }
+    if (message.doneStatus !== "") {
+      obj.doneStatus = message.doneStatus;
+    }
+    if (message.canceledStatus !== "") {
+      obj.canceledStatus = message.canceledStatus;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<TicketSync>): TicketSync {
+    return TicketSync.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TicketSync>): TicketSync {
+    const message = createBaseTicketSync();
+    message.type = object.type ?? TicketSync_Type.TYPE_UNSPECIFIED;
+    message.url = object.url ?? "";
+    message.username = object.username ?? "";
+    message.token = object.token ?? "";
+    message.externalSecret = (object.externalSecret !== undefined && object.externalSecret !== null)
+      ? DataSourceExternalSecret.fromPartial(object.externalSecret)
+      : undefined;
+    message.project = object.project ?? "";
+    message.issueType = object.issueType ?? "";
+    message.openStatus = object.openStatus ?? "";
+    message.doneStatus = object.doneStatus ?? "";
+    message.canceledStatus = object.canceledStatus ?? "";
+    return message;
+  },
+};
+
+function createBaseStatementVariable(): StatementVariable {
+  return { name: "", defaultValue: "", environmentValues: {}, databaseValues: {} };
+}
+
+export const StatementVariable = {
+  encode(message: StatementVariable, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.defaultValue !== "") {
+      writer.uint32(18).string(message.defaultValue);
+    }
+    Object.entries(message.environmentValues).forEach(([key, value]) => {
+      StatementVariable_EnvironmentValuesEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).ldelim();
+    });
+    Object.entries(message.databaseValues).forEach(([key, value]) => {
+      StatementVariable_DatabaseValuesEntry.encode({ key: key as any, value }, writer.uint32(34).fork()).ldelim();
+    });
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatementVariable {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatementVariable();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.defaultValue = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          const entry3 = StatementVariable_EnvironmentValuesEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.environmentValues[entry3.key] = entry3.value;
+          }
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          const entry4 = StatementVariable_DatabaseValuesEntry.decode(reader, reader.uint32());
+          if (entry4.value !== undefined) {
+            message.databaseValues[entry4.key] = entry4.value;
+          }
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StatementVariable {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      defaultValue: isSet(object.defaultValue) ? globalThis.String(object.defaultValue) : "",
+      environmentValues: isObject(object.environmentValues)
+        ? Object.entries(object.environmentValues).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      databaseValues: isObject(object.databaseValues)
+        ? Object.entries(object.databaseValues).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+    };
+  },
+
+  toJSON(message: StatementVariable): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.defaultValue !== "") {
+      obj.defaultValue = message.defaultValue;
+    }
+    if (message.environmentValues) {
+      const entries = Object.entries(message.environmentValues);
+      if (entries.length > 0) {
+        obj.environmentValues = {};
+        entries.forEach(([k, v]) => {
+          obj.environmentValues[k] = v;
+        });
+      }
+    }
+    if (message.databaseValues) {
+      const entries = Object.entries(message.databaseValues);
+      if (entries.length > 0) {
+        obj.databaseValues = {};
+        entries.forEach(([k, v]) => {
+          obj.databaseValues[k] = v;
+        });
+      }
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<StatementVariable>): StatementVariable {
+    return StatementVariable.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<StatementVariable>): StatementVariable {
+    const message = createBaseStatementVariable();
+    message.name = object.name ?? "";
+    message.defaultValue = object.defaultValue ?? "";
+    message.environmentValues = Object.entries(object.environmentValues ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    message.databaseValues = Object.entries(object.databaseValues ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    return message;
+  },
+};
+
+function createBaseStatementVariable_EnvironmentValuesEntry(): StatementVariable_EnvironmentValuesEntry {
+  return { key: "", value: "" };
+}
+
+export const StatementVariable_EnvironmentValuesEntry = {
+  encode(message: StatementVariable_EnvironmentValuesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatementVariable_EnvironmentValuesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatementVariable_EnvironmentValuesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StatementVariable_EnvironmentValuesEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: StatementVariable_EnvironmentValuesEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<StatementVariable_EnvironmentValuesEntry>): StatementVariable_EnvironmentValuesEntry {
+    return StatementVariable_EnvironmentValuesEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<StatementVariable_EnvironmentValuesEntry>): StatementVariable_EnvironmentValuesEntry {
+    const message = createBaseStatementVariable_EnvironmentValuesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseStatementVariable_DatabaseValuesEntry(): StatementVariable_DatabaseValuesEntry {
+  return { key: "", value: "" };
+}
+
+export const StatementVariable_DatabaseValuesEntry = {
+  encode(message: StatementVariable_DatabaseValuesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatementVariable_DatabaseValuesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatementVariable_DatabaseValuesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StatementVariable_DatabaseValuesEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: StatementVariable_DatabaseValuesEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<StatementVariable_DatabaseValuesEntry>): StatementVariable_DatabaseValuesEntry {
+    return StatementVariable_DatabaseValuesEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<StatementVariable_DatabaseValuesEntry>): StatementVariable_DatabaseValuesEntry {
+    const message = createBaseStatementVariable_DatabaseValuesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
@@ -230,6 +889,10 @@ if (_m0.util.Long !== Long) {
   _m0.configure();
 }
 
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
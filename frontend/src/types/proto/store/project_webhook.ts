@@ -11,10 +11,15 @@ export interface ProjectWebhookPayload {
    * IM integration setting should be set for this function to work.
    */
   directMessage: boolean;
+  /**
+   * integration_key is the key to open the incidents for the incident webhooks,
+   * i.e. the routing key of PagerDuty and the API key of Opsgenie.
+   */
+  integrationKey: string;
 }
 
 function createBaseProjectWebhookPayload(): ProjectWebhookPayload {
-  return { directMessage: false };
+  return { directMessage: false, integrationKey: "" };
 }
 
 export const ProjectWebhookPayload = {
@@ -22,6 +27,9 @@ export const ProjectWebhookPayload = {
     if (message.directMessage === true) {
       writer.uint32(8).bool(message.directMessage);
     }
+    if (message.integrationKey !== "") {
+      writer.uint32(18).string(message.integrationKey);
+    }
     return writer;
   },
 
@@ -39,6 +47,13 @@ export const ProjectWebhookPayload = {
 
           message.directMessage = reader.bool();
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.integrationKey = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -49,7 +64,10 @@ export const ProjectWebhookPayload = {
   },
 
   fromJSON(object: any): ProjectWebhookPayload {
-    return { directMessage: isSet(object.directMessage) ? globalThis.Boolean(object.directMessage) : false };
+    return {
+      directMessage: isSet(object.directMessage) ? globalThis.Boolean(object.directMessage) : false,
+      integrationKey: isSet(object.integrationKey) ? globalThis.String(object.integrationKey) : "",
+    };
   },
 
   toJSON(message: ProjectWebhookPayload): unknown {
@@ -57,6 +75,9 @@ export const ProjectWebhookPayload = {
     if (message.directMessage === true) {
       obj.directMessage = message.directMessage;
     }
+    if (message.integrationKey !== "") {
+      obj.integrationKey = message.integrationKey;
+    }
     return obj;
   },
 
@@ -66,6 +87,7 @@ export const ProjectWebhookPayload = {
   fromPartial(object: DeepPartial<ProjectWebhookPayload>): ProjectWebhookPayload {
     const message = createBaseProjectWebhookPayload();
     message.directMessage = object.directMessage ?? false;
+    message.integrationKey = object.integrationKey ?? "";
     return message;
   },
 };
@@ -7,11 +7,15 @@ export const protobufPackage = "bytebase.store";
 
 export interface QueryHistoryPayload {
   error?: string | undefined;
-  duration: Duration | undefined;
+  duration:
+    | Duration
+    | undefined;
+  /** The number of rows returned by the query. */
+  rowCount: Long;
 }
 
 function createBaseQueryHistoryPayload(): QueryHistoryPayload {
-  return { error: undefined, duration: undefined };
+  return { error: undefined, duration: undefined, rowCount: Long.ZERO };
 }
 
 export const QueryHistoryPayload = {
@@ -22,6 +26,9 @@ export const QueryHistoryPayload = {
     if (message.duration !== undefined) {
       Duration.encode(message.duration, writer.uint32(18).fork()).ldelim();
     }
+    if (!message.rowCount.isZero()) {
+      writer.uint32(24).int64(message.rowCount);
+    }
     return writer;
   },
 
@@ -46,6 +53,13 @@ export const QueryHistoryPayload = {
 
           message.duration = Duration.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.rowCount = reader.int64() as Long;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -59,6 +73,7 @@ export const QueryHistoryPayload = {
     return {
       error: isSet(object.error) ? globalThis.String(object.error) : undefined,
       duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      rowCount: isSet(object.rowCount) ? Long.fromValue(object.rowCount) : Long.ZERO,
     };
   },
 
@@ -70,6 +85,9 @@ export const QueryHistoryPayload = {
     if (message.duration !== undefined) {
       obj.duration = Duration.toJSON(message.duration);
     }
+    if (!message.rowCount.isZero()) {
+      obj.rowCount = (message.rowCount || Long.ZERO).toString();
+    }
     return obj;
   },
 
@@ -82,6 +100,9 @@ export const QueryHistoryPayload = {
     message.duration = (object.duration !== undefined && object.duration !== null)
       ? Duration.fromPartial(object.duration)
       : undefined;
+    message.rowCount = (object.rowCount !== undefined && object.rowCount !== null)
+      ? Long.fromValue(object.rowCount)
+      : Long.ZERO;
     return message;
   },
 };
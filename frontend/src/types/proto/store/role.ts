@@ -6,10 +6,12 @@ export const protobufPackage = "bytebase.store";
 
 export interface RolePermissions {
   permissions: string[];
+  /** The permissions excluded from the role, they are kept to edit the role. */
+  excludedPermissions: string[];
 }
 
 function createBaseRolePermissions(): RolePermissions {
-  return { permissions: [] };
+  return { permissions: [], excludedPermissions: [] };
 }
 
 export const RolePermissions = {
@@ -17,6 +19,9 @@ export const RolePermissions = {
     for (const v of message.permissions) {
       writer.uint32(10).string(v!);
     }
+    for (const v of message.excludedPermissions) {
+      writer.uint32(18).string(v!);
+    }
     return writer;
   },
 
@@ -34,6 +39,13 @@ export const RolePermissions = {
 
           message.permissions.push(reader.string());
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.excludedPermissions.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -48,6 +60,9 @@ export const RolePermissions = {
       permissions: globalThis.Array.isArray(object?.permissions)
         ? object.permissions.map((e: any) => globalThis.String(e))
         : [],
+      excludedPermissions: globalThis.Array.isArray(object?.excludedPermissions)
+        ? object.excludedPermissions.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
@@ -56,6 +71,9 @@ export const RolePermissions = {
     if (message.permissions?.length) {
       obj.permissions = message.permissions;
     }
+    if (message.excludedPermissions?.length) {
+      obj.excludedPermissions = message.excludedPermissions;
+    }
     return obj;
   },
 
@@ -65,6 +83,7 @@ export const RolePermissions = {
   fromPartial(object: DeepPartial<RolePermissions>): RolePermissions {
     const message = createBaseRolePermissions();
     message.permissions = object.permissions?.map((e) => e) || [];
+    message.excludedPermissions = object.excludedPermissions?.map((e) => e) || [];
     return message;
   },
 };
@@ -1,6 +1,7 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { BackupStorage } from "./backup";
 import { Engine, engineFromJSON, engineToJSON, engineToNumber } from "./common";
 import { DatabaseConfig } from "./database";
 
@@ -19,6 +20,24 @@ export interface SheetPayload {
   engine: Engine;
   /** The start and end position of each command in the sheet statement. */
   commands: SheetCommand[];
+  /**
+   * The content of the large sheet stored in the object storage.
+   * If set, the statement in the metadata database only keeps the head of the content for displaying.
+   */
+  externalContent: SheetExternalContent | undefined;
+}
+
+export interface SheetExternalContent {
+  /** The storage is kept in the sheet so that the content can be read after the setting changes. */
+  storage:
+    | BackupStorage
+    | undefined;
+  /** The object key of the content. */
+  path: string;
+  /** The size of the content in bytes. */
+  size: Long;
+  /** The hex-encoded SHA-256 digest of the content. */
+  sha256: string;
 }
 
 export interface SheetCommand {
@@ -32,6 +51,7 @@ function createBaseSheetPayload(): SheetPayload {
     baselineDatabaseConfig: undefined,
     engine: Engine.ENGINE_UNSPECIFIED,
     commands: [],
+    externalContent: undefined,
   };
 }
 
@@ -49,6 +69,9 @@ export const SheetPayload = {
     for (const v of message.commands) {
       SheetCommand.encode(v!, writer.uint32(34).fork()).ldelim();
     }
+    if (message.externalContent !== undefined) {
+      SheetExternalContent.encode(message.externalContent, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -87,6 +110,13 @@ export const SheetPayload = {
 
           message.commands.push(SheetCommand.decode(reader, reader.uint32()));
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.externalContent = SheetExternalContent.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -106,6 +136,9 @@ export const SheetPayload = {
       commands: globalThis.Array.isArray(object?.commands)
         ? object.commands.map((e: any) => SheetCommand.fromJSON(e))
         : [],
+      externalContent: isSet(object.externalContent)
+        ? SheetExternalContent.fromJSON(object.externalContent)
+        : undefined,
     };
   },
 
@@ -123,6 +156,9 @@ export const SheetPayload = {
     if (message.commands?.length) {
       obj.commands = message.commands.map((e) => SheetCommand.toJSON(e));
     }
+    if (message.externalContent !== undefined) {
+      obj.externalContent = SheetExternalContent.toJSON(message.externalContent);
+    }
     return obj;
   },
 
@@ -140,6 +176,115 @@ export const SheetPayload = {
         : undefined;
     message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
     message.commands = object.commands?.map((e) => SheetCommand.fromPartial(e)) || [];
+    message.externalContent = (object.externalContent !== undefined && object.externalContent !== null)
+      ? SheetExternalContent.fromPartial(object.externalContent)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseSheetExternalContent(): SheetExternalContent {
+  return { storage: undefined, path: "", size: Long.ZERO, sha256: "" };
+}
+
+export const SheetExternalContent = {
+  encode(message: SheetExternalContent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.storage !== undefined) {
+      BackupStorage.encode(message.storage, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.path !== "") {
+      writer.uint32(18).string(message.path);
+    }
+    if (!message.size.isZero()) {
+      writer.uint32(24).int64(message.size);
+    }
+    if (message.sha256 !== "") {
+      writer.uint32(34).string(message.sha256);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SheetExternalContent {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSheetExternalContent();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.storage = BackupStorage.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.size = reader.int64() as Long;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.sha256 = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SheetExternalContent {
+    return {
+      storage: isSet(object.storage) ? BackupStorage.fromJSON(object.storage) : undefined,
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      size: isSet(object.size) ? Long.fromValue(object.size) : Long.ZERO,
+      sha256: isSet(object.sha256) ? globalThis.String(object.sha256) : "",
+    };
+  },
+
+  toJSON(message: SheetExternalContent): unknown {
+    const obj: any = {};
+    if (message.storage !== undefined) {
+      obj.storage = BackupStorage.toJSON(message.storage);
+    }
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (!message.size.isZero()) {
+      obj.size = (message.size || Long.ZERO).toString();
+    }
+    if (message.sha256 !== "") {
+      obj.sha256 = message.sha256;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SheetExternalContent>): SheetExternalContent {
+    return SheetExternalContent.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SheetExternalContent>): SheetExternalContent {
+    const message = createBaseSheetExternalContent();
+    message.storage = (object.storage !== undefined && object.storage !== null)
+      ? BackupStorage.fromPartial(object.storage)
+      : undefined;
+    message.path = object.path ?? "";
+    message.size = (object.size !== undefined && object.size !== null) ? Long.fromValue(object.size) : Long.ZERO;
+    message.sha256 = object.sha256 ?? "";
     return message;
   },
 };
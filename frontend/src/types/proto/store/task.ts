@@ -1,7 +1,21 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
-import { ExportFormat, exportFormatFromJSON, exportFormatToJSON, exportFormatToNumber } from "./common";
+import {
+  DataLoadFormat,
+  dataLoadFormatFromJSON,
+  dataLoadFormatToJSON,
+  dataLoadFormatToNumber,
+  ExportFormat,
+  exportFormatFromJSON,
+  exportFormatToJSON,
+  exportFormatToNumber,
+  TransactionMode,
+  transactionModeFromJSON,
+  transactionModeToJSON,
+  transactionModeToNumber,
+  VerificationQuery,
+} from "./common";
 import { PreUpdateBackupDetail } from "./plan_check_run";
 
 export const protobufPackage = "bytebase.store";
@@ -20,6 +34,11 @@ export interface TaskDatabaseCreatePayload {
   collation: string;
   environmentId: string;
   labels: string;
+  /**
+   * The template of the baseline schema.
+   * Format: instances/{instance}/databases/{database} or projects/{project}/branches/{branch}.
+   */
+  template: string;
 }
 
 /** TaskDatabaseDataUpdatePayload is the task payload for database data update (DML). */
@@ -35,6 +54,14 @@ export interface TaskDatabaseUpdatePayload {
     | undefined;
   /** flags is used for ghost sync */
   flags: { [key: string]: string };
+  /** allow_destructive_changes is used for state-based migration. */
+  allowDestructiveChanges: boolean;
+  /** verification_queries run after the change is applied. */
+  verificationQueries: VerificationQuery[];
+  /** transaction_mode is how the statements are run in transactions. */
+  transactionMode: TransactionMode;
+  /** session_variables are set on the session before the statements run. */
+  sessionVariables: { [key: string]: string };
 }
 
 export interface TaskDatabaseUpdatePayload_FlagsEntry {
@@ -42,6 +69,11 @@ export interface TaskDatabaseUpdatePayload_FlagsEntry {
   value: string;
 }
 
+export interface TaskDatabaseUpdatePayload_SessionVariablesEntry {
+  key: string;
+  value: string;
+}
+
 /** TaskDatabaseDataExportPayload is the task payload for database data export. */
 export interface TaskDatabaseDataExportPayload {
   /** common fields */
@@ -51,6 +83,29 @@ export interface TaskDatabaseDataExportPayload {
   format: ExportFormat;
 }
 
+/** TaskDatabaseDataLoadPayload is the task payload for loading data files into a table. */
+export interface TaskDatabaseDataLoadPayload {
+  /** common fields */
+  specId: string;
+  table: string;
+  sourceUri: string;
+  format: DataLoadFormat;
+  header: boolean;
+  fieldDelimiter: string;
+}
+
+/** TaskDatabaseRestorePayload is the task payload for database restore. */
+export interface TaskDatabaseRestorePayload {
+  /** common fields */
+  specId: string;
+  backupRunId: number;
+  /**
+   * The name of the new database on the same instance to restore into.
+   * Empty if the database is restored in place.
+   */
+  targetDatabase: string;
+}
+
 function createBaseTaskDatabaseCreatePayload(): TaskDatabaseCreatePayload {
   return {
     skipped: false,
@@ -64,6 +119,7 @@ function createBaseTaskDatabaseCreatePayload(): TaskDatabaseCreatePayload {
     collation: "",
     environmentId: "",
     labels: "",
+    template: "",
   };
 }
 
@@ -102,6 +158,9 @@ export const TaskDatabaseCreatePayload = {
     if (message.labels !== "") {
       writer.uint32(90).string(message.labels);
     }
+    if (message.template !== "") {
+      writer.uint32(98).string(message.template);
+    }
     return writer;
   },
 
@@ -189,6 +248,13 @@ export const TaskDatabaseCreatePayload = {
 
           message.labels = reader.string();
           continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.template = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -211,6 +277,7 @@ export const TaskDatabaseCreatePayload = {
       collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
       environmentId: isSet(object.environmentId) ? globalThis.String(object.environmentId) : "",
       labels: isSet(object.labels) ? globalThis.String(object.labels) : "",
+      template: isSet(object.template) ? globalThis.String(object.template) : "",
     };
   },
 
@@ -249,6 +316,9 @@ export const TaskDatabaseCreatePayload = {
     if (message.labels !== "") {
       obj.labels = message.labels;
     }
+    if (message.template !== "") {
+      obj.template = message.template;
+    }
     return obj;
   },
 
@@ -268,6 +338,7 @@ export const TaskDatabaseCreatePayload = {
     message.collation = object.collation ?? "";
     message.environmentId = object.environmentId ?? "";
     message.labels = object.labels ?? "";
+    message.template = object.template ?? "";
     return message;
   },
 };
@@ -281,6 +352,10 @@ function createBaseTaskDatabaseUpdatePayload(): TaskDatabaseUpdatePayload {
     sheetId: 0,
     preUpdateBackupDetail: undefined,
     flags: {},
+    allowDestructiveChanges: false,
+    verificationQueries: [],
+    transactionMode: TransactionMode.TRANSACTION_MODE_UNSPECIFIED,
+    sessionVariables: {},
   };
 }
 
@@ -307,6 +382,19 @@ export const TaskDatabaseUpdatePayload = {
     Object.entries(message.flags).forEach(([key, value]) => {
       TaskDatabaseUpdatePayload_FlagsEntry.encode({ key: key as any, value }, writer.uint32(58).fork()).ldelim();
     });
+    if (message.allowDestructiveChanges === true) {
+      writer.uint32(64).bool(message.allowDestructiveChanges);
+    }
+    for (const v of message.verificationQueries) {
+      VerificationQuery.encode(v!, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.transactionMode !== TransactionMode.TRANSACTION_MODE_UNSPECIFIED) {
+      writer.uint32(80).int32(transactionModeToNumber(message.transactionMode));
+    }
+    Object.entries(message.sessionVariables).forEach(([key, value]) => {
+      TaskDatabaseUpdatePayload_SessionVariablesEntry.encode({ key: key as any, value }, writer.uint32(90).fork())
+        .ldelim();
+    });
     return writer;
   },
 
@@ -369,6 +457,37 @@ export const TaskDatabaseUpdatePayload = {
             message.flags[entry7.key] = entry7.value;
           }
           continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.allowDestructiveChanges = reader.bool();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.verificationQueries.push(VerificationQuery.decode(reader, reader.uint32()));
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.transactionMode = transactionModeFromJSON(reader.int32());
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          const entry11 = TaskDatabaseUpdatePayload_SessionVariablesEntry.decode(reader, reader.uint32());
+          if (entry11.value !== undefined) {
+            message.sessionVariables[entry11.key] = entry11.value;
+          }
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -394,6 +513,21 @@ export const TaskDatabaseUpdatePayload = {
           return acc;
         }, {})
         : {},
+      allowDestructiveChanges: isSet(object.allowDestructiveChanges)
+        ? globalThis.Boolean(object.allowDestructiveChanges)
+        : false,
+      verificationQueries: globalThis.Array.isArray(object?.verificationQueries)
+        ? object.verificationQueries.map((e: any) => VerificationQuery.fromJSON(e))
+        : [],
+      transactionMode: isSet(object.transactionMode)
+        ? transactionModeFromJSON(object.transactionMode)
+        : TransactionMode.TRANSACTION_MODE_UNSPECIFIED,
+      sessionVariables: isObject(object.sessionVariables)
+        ? Object.entries(object.sessionVariables).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
@@ -426,6 +560,24 @@ export const TaskDatabaseUpdatePayload = {
         });
       }
     }
+    if (message.allowDestructiveChanges === true) {
+      obj.allowDestructiveChanges = message.allowDestructiveChanges;
+    }
+    if (message.verificationQueries?.length) {
+      obj.verificationQueries = message.verificationQueries.map((e) => VerificationQuery.toJSON(e));
+    }
+    if (message.transactionMode !== TransactionMode.TRANSACTION_MODE_UNSPECIFIED) {
+      obj.transactionMode = transactionModeToJSON(message.transactionMode);
+    }
+    if (message.sessionVariables) {
+      const entries = Object.entries(message.sessionVariables);
+      if (entries.length > 0) {
+        obj.sessionVariables = {};
+        entries.forEach(([k, v]) => {
+          obj.sessionVariables[k] = v;
+        });
+      }
+    }
     return obj;
   },
 
@@ -449,6 +601,18 @@ export const TaskDatabaseUpdatePayload = {
       }
       return acc;
     }, {});
+    message.allowDestructiveChanges = object.allowDestructiveChanges ?? false;
+    message.verificationQueries = object.verificationQueries?.map((e) => VerificationQuery.fromPartial(e)) || [];
+    message.transactionMode = object.transactionMode ?? TransactionMode.TRANSACTION_MODE_UNSPECIFIED;
+    message.sessionVariables = Object.entries(object.sessionVariables ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
@@ -527,6 +691,87 @@ export const TaskDatabaseUpdatePayload_FlagsEntry = {
   },
 };
 
+function createBaseTaskDatabaseUpdatePayload_SessionVariablesEntry(): TaskDatabaseUpdatePayload_SessionVariablesEntry {
+  return { key: "", value: "" };
+}
+
+export const TaskDatabaseUpdatePayload_SessionVariablesEntry = {
+  encode(
+    message: TaskDatabaseUpdatePayload_SessionVariablesEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskDatabaseUpdatePayload_SessionVariablesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskDatabaseUpdatePayload_SessionVariablesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskDatabaseUpdatePayload_SessionVariablesEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: TaskDatabaseUpdatePayload_SessionVariablesEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<TaskDatabaseUpdatePayload_SessionVariablesEntry>,
+  ): TaskDatabaseUpdatePayload_SessionVariablesEntry {
+    return TaskDatabaseUpdatePayload_SessionVariablesEntry.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<TaskDatabaseUpdatePayload_SessionVariablesEntry>,
+  ): TaskDatabaseUpdatePayload_SessionVariablesEntry {
+    const message = createBaseTaskDatabaseUpdatePayload_SessionVariablesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
 function createBaseTaskDatabaseDataExportPayload(): TaskDatabaseDataExportPayload {
   return { specId: "", sheetId: 0, password: "", format: ExportFormat.FORMAT_UNSPECIFIED };
 }
@@ -631,6 +876,238 @@ export const TaskDatabaseDataExportPayload = {
   },
 };
 
+function createBaseTaskDatabaseDataLoadPayload(): TaskDatabaseDataLoadPayload {
+  return {
+    specId: "",
+    table: "",
+    sourceUri: "",
+    format: DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+    header: false,
+    fieldDelimiter: "",
+  };
+}
+
+export const TaskDatabaseDataLoadPayload = {
+  encode(message: TaskDatabaseDataLoadPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.specId !== "") {
+      writer.uint32(10).string(message.specId);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    if (message.sourceUri !== "") {
+      writer.uint32(26).string(message.sourceUri);
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      writer.uint32(32).int32(dataLoadFormatToNumber(message.format));
+    }
+    if (message.header === true) {
+      writer.uint32(40).bool(message.header);
+    }
+    if (message.fieldDelimiter !== "") {
+      writer.uint32(50).string(message.fieldDelimiter);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskDatabaseDataLoadPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskDatabaseDataLoadPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.specId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.sourceUri = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.format = dataLoadFormatFromJSON(reader.int32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.header = reader.bool();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.fieldDelimiter = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskDatabaseDataLoadPayload {
+    return {
+      specId: isSet(object.specId) ? globalThis.String(object.specId) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      sourceUri: isSet(object.sourceUri) ? globalThis.String(object.sourceUri) : "",
+      format: isSet(object.format)
+        ? dataLoadFormatFromJSON(object.format)
+        : DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+      header: isSet(object.header) ? globalThis.Boolean(object.header) : false,
+      fieldDelimiter: isSet(object.fieldDelimiter) ? globalThis.String(object.fieldDelimiter) : "",
+    };
+  },
+
+  toJSON(message: TaskDatabaseDataLoadPayload): unknown {
+    const obj: any = {};
+    if (message.specId !== "") {
+      obj.specId = message.specId;
+    }
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.sourceUri !== "") {
+      obj.sourceUri = message.sourceUri;
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      obj.format = dataLoadFormatToJSON(message.format);
+    }
+    if (message.header === true) {
+      obj.header = message.header;
+    }
+    if (message.fieldDelimiter !== "") {
+      obj.fieldDelimiter = message.fieldDelimiter;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<TaskDatabaseDataLoadPayload>): TaskDatabaseDataLoadPayload {
+    return TaskDatabaseDataLoadPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TaskDatabaseDataLoadPayload>): TaskDatabaseDataLoadPayload {
+    const message = createBaseTaskDatabaseDataLoadPayload();
+    message.specId = object.specId ?? "";
+    message.table = object.table ?? "";
+    message.sourceUri = object.sourceUri ?? "";
+    message.format = object.format ?? DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED;
+    message.header = object.header ?? false;
+    message.fieldDelimiter = object.fieldDelimiter ?? "";
+    return message;
+  },
+};
+
+function createBaseTaskDatabaseRestorePayload(): TaskDatabaseRestorePayload {
+  return { specId: "", backupRunId: 0, targetDatabase: "" };
+}
+
+export const TaskDatabaseRestorePayload = {
+  encode(message: TaskDatabaseRestorePayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.specId !== "") {
+      writer.uint32(10).string(message.specId);
+    }
+    if (message.backupRunId !== 0) {
+      writer.uint32(16).int32(message.backupRunId);
+    }
+    if (message.targetDatabase !== "") {
+      writer.uint32(26).string(message.targetDatabase);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskDatabaseRestorePayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskDatabaseRestorePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.specId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.backupRunId = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.targetDatabase = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskDatabaseRestorePayload {
+    return {
+      specId: isSet(object.specId) ? globalThis.String(object.specId) : "",
+      backupRunId: isSet(object.backupRunId) ? globalThis.Number(object.backupRunId) : 0,
+      targetDatabase: isSet(object.targetDatabase) ? globalThis.String(object.targetDatabase) : "",
+    };
+  },
+
+  toJSON(message: TaskDatabaseRestorePayload): unknown {
+    const obj: any = {};
+    if (message.specId !== "") {
+      obj.specId = message.specId;
+    }
+    if (message.backupRunId !== 0) {
+      obj.backupRunId = Math.round(message.backupRunId);
+    }
+    if (message.targetDatabase !== "") {
+      obj.targetDatabase = message.targetDatabase;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<TaskDatabaseRestorePayload>): TaskDatabaseRestorePayload {
+    return TaskDatabaseRestorePayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TaskDatabaseRestorePayload>): TaskDatabaseRestorePayload {
+    const message = createBaseTaskDatabaseRestorePayload();
+    message.specId = object.specId ?? "";
+    message.backupRunId = object.backupRunId ?? 0;
+    message.targetDatabase = object.targetDatabase ?? "";
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -18,7 +18,11 @@ export interface TaskRunResult {
   /** The uid of the export archive. */
   exportArchiveUid: number;
   /** The prior backup detail that will be used to rollback the task run. */
-  priorBackupDetail: PriorBackupDetail | undefined;
+  priorBackupDetail:
+    | PriorBackupDetail
+    | undefined;
+  /** The results of the verification queries run after the change is applied. */
+  verificationResults: VerificationResult[];
 }
 
 /** The following fields are used for error reporting. */
@@ -27,6 +31,14 @@ export interface TaskRunResult_Position {
   column: number;
 }
 
+export interface VerificationResult {
+  title: string;
+  statement: string;
+  passed: boolean;
+  /** The detail of the result, e.g. the actual row count or the query error. */
+  detail: string;
+}
+
 export interface PriorBackupDetail {
   items: PriorBackupDetail_Item[];
 }
@@ -59,7 +71,11 @@ export interface SchedulerInfo {
 
 export interface SchedulerInfo_WaitingCause {
   connectionLimit?: boolean | undefined;
-  taskUid?: number | undefined;
+  taskUid?:
+    | number
+    | undefined;
+  /** The instance has reached the maximum concurrent tasks. */
+  taskLimit?: boolean | undefined;
 }
 
 function createBaseTaskRunResult(): TaskRunResult {
@@ -71,6 +87,7 @@ function createBaseTaskRunResult(): TaskRunResult {
     endPosition: undefined,
     exportArchiveUid: 0,
     priorBackupDetail: undefined,
+    verificationResults: [],
   };
 }
 
@@ -97,6 +114,9 @@ export const TaskRunResult = {
     if (message.priorBackupDetail !== undefined) {
       PriorBackupDetail.encode(message.priorBackupDetail, writer.uint32(58).fork()).ldelim();
     }
+    for (const v of message.verificationResults) {
+      VerificationResult.encode(v!, writer.uint32(66).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -156,6 +176,13 @@ export const TaskRunResult = {
 
           message.priorBackupDetail = PriorBackupDetail.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.verificationResults.push(VerificationResult.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -176,6 +203,9 @@ export const TaskRunResult = {
       priorBackupDetail: isSet(object.priorBackupDetail)
         ? PriorBackupDetail.fromJSON(object.priorBackupDetail)
         : undefined,
+      verificationResults: globalThis.Array.isArray(object?.verificationResults)
+        ? object.verificationResults.map((e: any) => VerificationResult.fromJSON(e))
+        : [],
     };
   },
 
@@ -202,6 +232,9 @@ export const TaskRunResult = {
     if (message.priorBackupDetail !== undefined) {
       obj.priorBackupDetail = PriorBackupDetail.toJSON(message.priorBackupDetail);
     }
+    if (message.verificationResults?.length) {
+      obj.verificationResults = message.verificationResults.map((e) => VerificationResult.toJSON(e));
+    }
     return obj;
   },
 
@@ -223,6 +256,7 @@ export const TaskRunResult = {
     message.priorBackupDetail = (object.priorBackupDetail !== undefined && object.priorBackupDetail !== null)
       ? PriorBackupDetail.fromPartial(object.priorBackupDetail)
       : undefined;
+    message.verificationResults = object.verificationResults?.map((e) => VerificationResult.fromPartial(e)) || [];
     return message;
   },
 };
@@ -301,6 +335,110 @@ export const TaskRunResult_Position = {
   },
 };
 
+function createBaseVerificationResult(): VerificationResult {
+  return { title: "", statement: "", passed: false, detail: "" };
+}
+
+export const VerificationResult = {
+  encode(message: VerificationResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.statement !== "") {
+      writer.uint32(18).string(message.statement);
+    }
+    if (message.passed === true) {
+      writer.uint32(24).bool(message.passed);
+    }
+    if (message.detail !== "") {
+      writer.uint32(34).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VerificationResult {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVerificationResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.passed = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VerificationResult {
+    return {
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      passed: isSet(object.passed) ? globalThis.Boolean(object.passed) : false,
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: VerificationResult): unknown {
+    const obj: any = {};
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.passed === true) {
+      obj.passed = message.passed;
+    }
+    if (message.detail !== "") {
+      obj.detail = message.detail;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<VerificationResult>): VerificationResult {
+    return VerificationResult.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<VerificationResult>): VerificationResult {
+    const message = createBaseVerificationResult();
+    message.title = object.title ?? "";
+    message.statement = object.statement ?? "";
+    message.passed = object.passed ?? false;
+    message.detail = object.detail ?? "";
+    return message;
+  },
+};
+
 function createBasePriorBackupDetail(): PriorBackupDetail {
   return { items: [] };
 }
@@ -640,7 +778,7 @@ export const SchedulerInfo = {
 };
 
 function createBaseSchedulerInfo_WaitingCause(): SchedulerInfo_WaitingCause {
-  return { connectionLimit: undefined, taskUid: undefined };
+  return { connectionLimit: undefined, taskUid: undefined, taskLimit: undefined };
 }
 
 export const SchedulerInfo_WaitingCause = {
@@ -651,6 +789,9 @@ export const SchedulerInfo_WaitingCause = {
     if (message.taskUid !== undefined) {
       writer.uint32(16).int32(message.taskUid);
     }
+    if (message.taskLimit !== undefined) {
+      writer.uint32(24).bool(message.taskLimit);
+    }
     return writer;
   },
 
@@ -675,6 +816,13 @@ export const SchedulerInfo_WaitingCause = {
 
           message.taskUid = reader.int32();
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.taskLimit = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -688,6 +836,7 @@ export const SchedulerInfo_WaitingCause = {
     return {
       connectionLimit: isSet(object.connectionLimit) ? globalThis.Boolean(object.connectionLimit) : undefined,
       taskUid: isSet(object.taskUid) ? globalThis.Number(object.taskUid) : undefined,
+      taskLimit: isSet(object.taskLimit) ? globalThis.Boolean(object.taskLimit) : undefined,
     };
   },
 
@@ -699,6 +848,9 @@ export const SchedulerInfo_WaitingCause = {
     if (message.taskUid !== undefined) {
       obj.taskUid = Math.round(message.taskUid);
     }
+    if (message.taskLimit !== undefined) {
+      obj.taskLimit = message.taskLimit;
+    }
     return obj;
   },
 
@@ -709,6 +861,7 @@ export const SchedulerInfo_WaitingCause = {
     const message = createBaseSchedulerInfo_WaitingCause();
     message.connectionLimit = object.connectionLimit ?? undefined;
     message.taskUid = object.taskUid ?? undefined;
+    message.taskLimit = object.taskLimit ?? undefined;
     return message;
   },
 };
@@ -1,6 +1,7 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../google/protobuf/timestamp";
 
 export const protobufPackage = "bytebase.store";
 
@@ -16,6 +17,196 @@ export interface MFAConfig {
   tempRecoveryCodes: string[];
 }
 
+/** ServiceAccountTokenPayload is the payload of a scoped service account token. */
+export interface ServiceAccountTokenPayload {
+  /** The scopes limit the permissions of the token, e.g. issues.read-only. */
+  scopes: string[];
+  /** The token cannot be used after the expire_time. Empty means never expire. */
+  expireTime:
+    | Date
+    | undefined;
+  /** The IP addresses which have used the token recently, the oldest ones are dropped first. */
+  knownIps: string[];
+}
+
+/** SignInState is the sign-in state of a user. */
+export interface SignInState {
+  /** The number of consecutive failed sign-in attempts since the last successful sign-in or lockout. */
+  failedAttempts: number;
+  /** The user cannot sign in until the locked_until. Empty means not locked. */
+  lockedUntil:
+    | Date
+    | undefined;
+  /** The devices which have signed in recently, the least recently used ones are dropped first. */
+  devices: SignInDevice[];
+}
+
+/** SignInDevice is a device that the user signed in from. */
+export interface SignInDevice {
+  /** The user agent identifies the device. */
+  userAgent: string;
+  /** The IP address of the last sign-in. */
+  ip: string;
+  lastSignInTime: Date | undefined;
+}
+
+/** NotificationSetting is the notification setting of a user. */
+export interface NotificationSetting {
+  /**
+   * The events to notify the user of by email.
+   * The user is not notified by email if it's empty.
+   */
+  emailEvents: NotificationSetting_Event[];
+  emailDelivery: NotificationSetting_EmailDelivery;
+  /**
+   * The language of the emails, e.g. en-US or zh-CN.
+   * English is used if it's empty or not supported.
+   */
+  emailLanguage: string;
+}
+
+/** Event is the issue event to notify the user of. */
+export enum NotificationSetting_Event {
+  EVENT_UNSPECIFIED = "EVENT_UNSPECIFIED",
+  /** ISSUE_CREATED - An issue is created in the projects which the user owns. */
+  ISSUE_CREATED = "ISSUE_CREATED",
+  /** ISSUE_APPROVAL_NEEDED - An issue is waiting for the user's approval. */
+  ISSUE_APPROVAL_NEEDED = "ISSUE_APPROVAL_NEEDED",
+  /** TASK_RUN_FAILED - A task run of the issues created by the user failed. */
+  TASK_RUN_FAILED = "TASK_RUN_FAILED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function notificationSetting_EventFromJSON(object: any): NotificationSetting_Event {
+  switch (object) {
+    case 0:
+    case "EVENT_UNSPECIFIED":
+      return NotificationSetting_Event.EVENT_UNSPECIFIED;
+    case 1:
+    case "ISSUE_CREATED":
+      return NotificationSetting_Event.ISSUE_CREATED;
+    case 2:
+    case "ISSUE_APPROVAL_NEEDED":
+      return NotificationSetting_Event.ISSUE_APPROVAL_NEEDED;
+    case 3:
+    case "TASK_RUN_FAILED":
+      return NotificationSetting_Event.TASK_RUN_FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return NotificationSetting_Event.UNRECOGNIZED;
+  }
+}
+
+export function notificationSetting_EventToJSON(object: NotificationSetting_Event): string {
+  switch (object) {
+    case NotificationSetting_Event.EVENT_UNSPECIFIED:
+      return "EVENT_UNSPECIFIED";
+    case NotificationSetting_Event.ISSUE_CREATED:
+      return "ISSUE_CREATED";
+    case NotificationSetting_Event.ISSUE_APPROVAL_NEEDED:
+      return "ISSUE_APPROVAL_NEEDED";
+    case NotificationSetting_Event.TASK_RUN_FAILED:
+      return "TASK_RUN_FAILED";
+    case NotificationSetting_Event.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function notificationSetting_EventToNumber(object: NotificationSetting_Event): number {
+  switch (object) {
+    case NotificationSetting_Event.EVENT_UNSPECIFIED:
+      return 0;
+    case NotificationSetting_Event.ISSUE_CREATED:
+      return 1;
+    case NotificationSetting_Event.ISSUE_APPROVAL_NEEDED:
+      return 2;
+    case NotificationSetting_Event.TASK_RUN_FAILED:
+      return 3;
+    case NotificationSetting_Event.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export enum NotificationSetting_EmailDelivery {
+  EMAIL_DELIVERY_UNSPECIFIED = "EMAIL_DELIVERY_UNSPECIFIED",
+  /** INSTANT - Send an email for each event. */
+  INSTANT = "INSTANT",
+  /** HOURLY_DIGEST - Batch the events into one email per hour. */
+  HOURLY_DIGEST = "HOURLY_DIGEST",
+  /** DAILY_DIGEST - Batch the events into one email per day. */
+  DAILY_DIGEST = "DAILY_DIGEST",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function notificationSetting_EmailDeliveryFromJSON(object: any): NotificationSetting_EmailDelivery {
+  switch (object) {
+    case 0:
+    case "EMAIL_DELIVERY_UNSPECIFIED":
+      return NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED;
+    case 1:
+    case "INSTANT":
+      return NotificationSetting_EmailDelivery.INSTANT;
+    case 2:
+    case "HOURLY_DIGEST":
+      return NotificationSetting_EmailDelivery.HOURLY_DIGEST;
+    case 3:
+    case "DAILY_DIGEST":
+      return NotificationSetting_EmailDelivery.DAILY_DIGEST;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return NotificationSetting_EmailDelivery.UNRECOGNIZED;
+  }
+}
+
+export function notificationSetting_EmailDeliveryToJSON(object: NotificationSetting_EmailDelivery): string {
+  switch (object) {
+    case NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED:
+      return "EMAIL_DELIVERY_UNSPECIFIED";
+    case NotificationSetting_EmailDelivery.INSTANT:
+      return "INSTANT";
+    case NotificationSetting_EmailDelivery.HOURLY_DIGEST:
+      return "HOURLY_DIGEST";
+    case NotificationSetting_EmailDelivery.DAILY_DIGEST:
+      return "DAILY_DIGEST";
+    case NotificationSetting_EmailDelivery.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function notificationSetting_EmailDeliveryToNumber(object: NotificationSetting_EmailDelivery): number {
+  switch (object) {
+    case NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED:
+      return 0;
+    case NotificationSetting_EmailDelivery.INSTANT:
+      return 1;
+    case NotificationSetting_EmailDelivery.HOURLY_DIGEST:
+      return 2;
+    case NotificationSetting_EmailDelivery.DAILY_DIGEST:
+      return 3;
+    case NotificationSetting_EmailDelivery.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** EmailNotification is an email notification of an issue event waiting to be sent. */
+export interface EmailNotification {
+  event: NotificationSetting_Event;
+  projectTitle: string;
+  issueTitle: string;
+  /** The link to the issue. */
+  link: string;
+  /** The name of the user who triggered the event. */
+  actor: string;
+  /** The detail of the event, e.g. the failed task and the error. */
+  detail: string;
+}
+
 function createBaseMFAConfig(): MFAConfig {
   return { otpSecret: "", tempOtpSecret: "", recoveryCodes: [], tempRecoveryCodes: [] };
 }
@@ -124,6 +315,527 @@ export const MFAConfig = {
   },
 };
 
+function createBaseServiceAccountTokenPayload(): ServiceAccountTokenPayload {
+  return { scopes: [], expireTime: undefined, knownIps: [] };
+}
+
+export const ServiceAccountTokenPayload = {
+  encode(message: ServiceAccountTokenPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.scopes) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.knownIps) {
+      writer.uint32(26).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ServiceAccountTokenPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseServiceAccountTokenPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.knownIps.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ServiceAccountTokenPayload {
+    return {
+      scopes: globalThis.Array.isArray(object?.scopes) ? object.scopes.map((e: any) => globalThis.String(e)) : [],
+      expireTime: isSet(object.expireTime) ? fromJsonTimestamp(object.expireTime) : undefined,
+      knownIps: globalThis.Array.isArray(object?.knownIps) ? object.knownIps.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: ServiceAccountTokenPayload): unknown {
+    const obj: any = {};
+    if (message.scopes?.length) {
+      obj.scopes = message.scopes;
+    }
+    if (message.expireTime !== undefined) {
+      obj.expireTime = message.expireTime.toISOString();
+    }
+    if (message.knownIps?.length) {
+      obj.knownIps = message.knownIps;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ServiceAccountTokenPayload>): ServiceAccountTokenPayload {
+    return ServiceAccountTokenPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ServiceAccountTokenPayload>): ServiceAccountTokenPayload {
+    const message = createBaseServiceAccountTokenPayload();
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.expireTime = object.expireTime ?? undefined;
+    message.knownIps = object.knownIps?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseSignInState(): SignInState {
+  return { failedAttempts: 0, lockedUntil: undefined, devices: [] };
+}
+
+export const SignInState = {
+  encode(message: SignInState, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.failedAttempts !== 0) {
+      writer.uint32(8).int32(message.failedAttempts);
+    }
+    if (message.lockedUntil !== undefined) {
+      Timestamp.encode(toTimestamp(message.lockedUntil), writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.devices) {
+      SignInDevice.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SignInState {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSignInState();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.failedAttempts = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.lockedUntil = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.devices.push(SignInDevice.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SignInState {
+    return {
+      failedAttempts: isSet(object.failedAttempts) ? globalThis.Number(object.failedAttempts) : 0,
+      lockedUntil: isSet(object.lockedUntil) ? fromJsonTimestamp(object.lockedUntil) : undefined,
+      devices: globalThis.Array.isArray(object?.devices)
+        ? object.devices.map((e: any) => SignInDevice.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SignInState): unknown {
+    const obj: any = {};
+    if (message.failedAttempts !== 0) {
+      obj.failedAttempts = Math.round(message.failedAttempts);
+    }
+    if (message.lockedUntil !== undefined) {
+      obj.lockedUntil = message.lockedUntil.toISOString();
+    }
+    if (message.devices?.length) {
+      obj.devices = message.devices.map((e) => SignInDevice.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SignInState>): SignInState {
+    return SignInState.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SignInState>): SignInState {
+    const message = createBaseSignInState();
+    message.failedAttempts = object.failedAttempts ?? 0;
+    message.lockedUntil = object.lockedUntil ?? undefined;
+    message.devices = object.devices?.map((e) => SignInDevice.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSignInDevice(): SignInDevice {
+  return { userAgent: "", ip: "", lastSignInTime: undefined };
+}
+
+export const SignInDevice = {
+  encode(message: SignInDevice, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.userAgent !== "") {
+      writer.uint32(10).string(message.userAgent);
+    }
+    if (message.ip !== "") {
+      writer.uint32(18).string(message.ip);
+    }
+    if (message.lastSignInTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastSignInTime), writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SignInDevice {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSignInDevice();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userAgent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ip = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.lastSignInTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SignInDevice {
+    return {
+      userAgent: isSet(object.userAgent) ? globalThis.String(object.userAgent) : "",
+      ip: isSet(object.ip) ? globalThis.String(object.ip) : "",
+      lastSignInTime: isSet(object.lastSignInTime) ? fromJsonTimestamp(object.lastSignInTime) : undefined,
+    };
+  },
+
+  toJSON(message: SignInDevice): unknown {
+    const obj: any = {};
+    if (message.userAgent !== "") {
+      obj.userAgent = message.userAgent;
+    }
+    if (message.ip !== "") {
+      obj.ip = message.ip;
+    }
+    if (message.lastSignInTime !== undefined) {
+      obj.lastSignInTime = message.lastSignInTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SignInDevice>): SignInDevice {
+    return SignInDevice.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SignInDevice>): SignInDevice {
+    const message = createBaseSignInDevice();
+    message.userAgent = object.userAgent ?? "";
+    message.ip = object.ip ?? "";
+    message.lastSignInTime = object.lastSignInTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseNotificationSetting(): NotificationSetting {
+  return {
+    emailEvents: [],
+    emailDelivery: NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED,
+    emailLanguage: "",
+  };
+}
+
+export const NotificationSetting = {
+  encode(message: NotificationSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+    for (const v of message.emailEvents) {
+      writer.int32(notificationSetting_EventToNumber(v));
+    }
+    writer.ldelim();
+    if (message.emailDelivery !== NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED) {
+      writer.uint32(16).int32(notificationSetting_EmailDeliveryToNumber(message.emailDelivery));
+    }
+    if (message.emailLanguage !== "") {
+      writer.uint32(26).string(message.emailLanguage);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NotificationSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNotificationSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag === 8) {
+            message.emailEvents.push(notificationSetting_EventFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.emailEvents.push(notificationSetting_EventFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.emailDelivery = notificationSetting_EmailDeliveryFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.emailLanguage = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NotificationSetting {
+    return {
+      emailEvents: globalThis.Array.isArray(object?.emailEvents)
+        ? object.emailEvents.map((e: any) => notificationSetting_EventFromJSON(e))
+        : [],
+      emailDelivery: isSet(object.emailDelivery)
+        ? notificationSetting_EmailDeliveryFromJSON(object.emailDelivery)
+        : NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED,
+      emailLanguage: isSet(object.emailLanguage) ? globalThis.String(object.emailLanguage) : "",
+    };
+  },
+
+  toJSON(message: NotificationSetting): unknown {
+    const obj: any = {};
+    if (message.emailEvents?.length) {
+      obj.emailEvents = message.emailEvents.map((e) => notificationSetting_EventToJSON(e));
+    }
+    if (message.emailDelivery !== NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED) {
+      obj.emailDelivery = notificationSetting_EmailDeliveryToJSON(message.emailDelivery);
+    }
+    if (message.emailLanguage !== "") {
+      obj.emailLanguage = message.emailLanguage;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<NotificationSetting>): NotificationSetting {
+    return NotificationSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<NotificationSetting>): NotificationSetting {
+    const message = createBaseNotificationSetting();
+    message.emailEvents = object.emailEvents?.map((e) => e) || [];
+    message.emailDelivery = object.emailDelivery ?? NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED;
+    message.emailLanguage = object.emailLanguage ?? "";
+    return message;
+  },
+};
+
+function createBaseEmailNotification(): EmailNotification {
+  return {
+    event: NotificationSetting_Event.EVENT_UNSPECIFIED,
+    projectTitle: "",
+    issueTitle: "",
+    link: "",
+    actor: "",
+    detail: "",
+  };
+}
+
+export const EmailNotification = {
+  encode(message: EmailNotification, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.event !== NotificationSetting_Event.EVENT_UNSPECIFIED) {
+      writer.uint32(8).int32(notificationSetting_EventToNumber(message.event));
+    }
+    if (message.projectTitle !== "") {
+      writer.uint32(18).string(message.projectTitle);
+    }
+    if (message.issueTitle !== "") {
+      writer.uint32(26).string(message.issueTitle);
+    }
+    if (message.link !== "") {
+      writer.uint32(34).string(message.link);
+    }
+    if (message.actor !== "") {
+      writer.uint32(42).string(message.actor);
+    }
+    if (message.detail !== "") {
+      writer.uint32(50).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EmailNotification {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEmailNotification();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.event = notificationSetting_EventFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.projectTitle = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.issueTitle = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.actor = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EmailNotification {
+    return {
+      event: isSet(object.event)
+        ? notificationSetting_EventFromJSON(object.event)
+        : NotificationSetting_Event.EVENT_UNSPECIFIED,
+      projectTitle: isSet(object.projectTitle) ? globalThis.String(object.projectTitle) : "",
+      issueTitle: isSet(object.issueTitle) ? globalThis.String(object.issueTitle) : "",
+      link: isSet(object.link) ? globalThis.String(object.link) : "",
+      actor: isSet(object.actor) ? globalThis.String(object.actor) : "",
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: EmailNotification): unknown {
+    const obj: any = {};
+    if (message.event !== NotificationSetting_Event.EVENT_UNSPECIFIED) {
+      obj.event = notificationSetting_EventToJSON(message.event);
+    }
+    if (message.projectTitle !== "") {
+      obj.projectTitle = message.projectTitle;
+    }
+    if (message.issueTitle !== "") {
+      obj.issueTitle = message.issueTitle;
+    }
+    if (message.link !== "") {
+      obj.link = message.link;
+    }
+    if (message.actor !== "") {
+      obj.actor = message.actor;
+    }
+    if (message.detail !== "") {
+      obj.detail = message.detail;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<EmailNotification>): EmailNotification {
+    return EmailNotification.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<EmailNotification>): EmailNotification {
+    const message = createBaseEmailNotification();
+    message.event = object.event ?? NotificationSetting_Event.EVENT_UNSPECIFIED;
+    message.projectTitle = object.projectTitle ?? "";
+    message.issueTitle = object.issueTitle ?? "";
+    message.link = object.link ?? "";
+    message.actor = object.actor ?? "";
+    message.detail = object.detail ?? "";
+    return message;
+  },
+};
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -132,6 +844,32 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
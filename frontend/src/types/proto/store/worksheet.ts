@@ -0,0 +1,106 @@
+/* eslint-disable */
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+
+export const protobufPackage = "bytebase.store";
+
+export interface WorksheetPayload {
+  /**
+   * The folder path of the worksheet in the library, separated by "/", e.g. "diagnostics/locks".
+   * The worksheet is in the root folder if empty.
+   */
+  folder: string;
+  /** The tags of the worksheet. */
+  tags: string[];
+}
+
+function createBaseWorksheetPayload(): WorksheetPayload {
+  return { folder: "", tags: [] };
+}
+
+export const WorksheetPayload = {
+  encode(message: WorksheetPayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.folder !== "") {
+      writer.uint32(10).string(message.folder);
+    }
+    for (const v of message.tags) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorksheetPayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorksheetPayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.folder = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WorksheetPayload {
+    return {
+      folder: isSet(object.folder) ? globalThis.String(object.folder) : "",
+      tags: globalThis.Array.isArray(object?.tags) ? object.tags.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: WorksheetPayload): unknown {
+    const obj: any = {};
+    if (message.folder !== "") {
+      obj.folder = message.folder;
+    }
+    if (message.tags?.length) {
+      obj.tags = message.tags;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<WorksheetPayload>): WorksheetPayload {
+    return WorksheetPayload.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorksheetPayload>): WorksheetPayload {
+    const message = createBaseWorksheetPayload();
+    message.folder = object.folder ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
+    return message;
+  },
+};
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Long ? string | number | Long : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
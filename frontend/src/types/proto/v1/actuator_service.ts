@@ -7,6 +7,27 @@ import { Timestamp } from "../google/protobuf/timestamp";
 
 export const protobufPackage = "bytebase.v1";
 
+export interface GetEncryptionKeyRequest {
+}
+
+export interface RotateEncryptionKeyRequest {
+}
+
+/** EncryptionKey is the status of the envelope encryption for secrets at rest. */
+export interface EncryptionKey {
+  /**
+   * The URI of the master key in the KMS, for example, awskms://alias/bytebase.
+   * Empty if the envelope encryption is not enabled.
+   */
+  masterKeyUri: string;
+  /** The id of the data encryption key used to encrypt new secrets. */
+  activeKeyId: string;
+  /** The number of data encryption keys, including the retired ones. */
+  keyCount: number;
+  /** The time when the active key was created. */
+  rotateTime: Date | undefined;
+}
+
 /** The request message for getting the theme resource. */
 export interface GetResourcePackageRequest {
 }
@@ -78,6 +99,229 @@ export interface ActuatorInfo {
   unlicensedFeatures: string[];
 }
 
+export interface CreateMetadataBackupRequest {
+}
+
+export interface ListMetadataBackupsRequest {
+}
+
+export interface ListMetadataBackupsResponse {
+  metadataBackups: MetadataBackup[];
+}
+
+export interface VerifyMetadataBackupRequest {
+  /** The name of the metadata backup, or "latest" for the latest backup. */
+  name: string;
+}
+
+/** MetadataBackup is the backup of the metadata database in the object storage. */
+export interface MetadataBackup {
+  /** The name of the backup, which is the creation time in 20060102T150405Z format. */
+  name: string;
+  /** The URI of the backup object, for example, s3://bucket/prefix/metadata/20240101T000000Z.sql.gz. */
+  uri: string;
+  createTime:
+    | Date
+    | undefined;
+  /** The size of the backup object in bytes. */
+  size: Long;
+  /** The hex encoded SHA-256 checksum of the backup object. */
+  sha256: string;
+  encrypted: boolean;
+  /** The version of the server taking the backup. */
+  version: string;
+}
+
+function createBaseGetEncryptionKeyRequest(): GetEncryptionKeyRequest {
+  return {};
+}
+
+export const GetEncryptionKeyRequest = {
+  encode(_: GetEncryptionKeyRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetEncryptionKeyRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetEncryptionKeyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): GetEncryptionKeyRequest {
+    return {};
+  },
+
+  toJSON(_: GetEncryptionKeyRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetEncryptionKeyRequest>): GetEncryptionKeyRequest {
+    return GetEncryptionKeyRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<GetEncryptionKeyRequest>): GetEncryptionKeyRequest {
+    const message = createBaseGetEncryptionKeyRequest();
+    return message;
+  },
+};
+
+function createBaseRotateEncryptionKeyRequest(): RotateEncryptionKeyRequest {
+  return {};
+}
+
+export const RotateEncryptionKeyRequest = {
+  encode(_: RotateEncryptionKeyRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RotateEncryptionKeyRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRotateEncryptionKeyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): RotateEncryptionKeyRequest {
+    return {};
+  },
+
+  toJSON(_: RotateEncryptionKeyRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<RotateEncryptionKeyRequest>): RotateEncryptionKeyRequest {
+    return RotateEncryptionKeyRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<RotateEncryptionKeyRequest>): RotateEncryptionKeyRequest {
+    const message = createBaseRotateEncryptionKeyRequest();
+    return message;
+  },
+};
+
+function createBaseEncryptionKey(): EncryptionKey {
+  return { masterKeyUri: "", activeKeyId: "", keyCount: 0, rotateTime: undefined };
+}
+
+export const EncryptionKey = {
+  encode(message: EncryptionKey, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.masterKeyUri !== "") {
+      writer.uint32(10).string(message.masterKeyUri);
+    }
+    if (message.activeKeyId !== "") {
+      writer.uint32(18).string(message.activeKeyId);
+    }
+    if (message.keyCount !== 0) {
+      writer.uint32(24).int32(message.keyCount);
+    }
+    if (message.rotateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.rotateTime), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EncryptionKey {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEncryptionKey();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.masterKeyUri = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.activeKeyId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.keyCount = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.rotateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EncryptionKey {
+    return {
+      masterKeyUri: isSet(object.masterKeyUri) ? globalThis.String(object.masterKeyUri) : "",
+      activeKeyId: isSet(object.activeKeyId) ? globalThis.String(object.activeKeyId) : "",
+      keyCount: isSet(object.keyCount) ? globalThis.Number(object.keyCount) : 0,
+      rotateTime: isSet(object.rotateTime) ? fromJsonTimestamp(object.rotateTime) : undefined,
+    };
+  },
+
+  toJSON(message: EncryptionKey): unknown {
+    const obj: any = {};
+    if (message.masterKeyUri !== "") {
+      obj.masterKeyUri = message.masterKeyUri;
+    }
+    if (message.activeKeyId !== "") {
+      obj.activeKeyId = message.activeKeyId;
+    }
+    if (message.keyCount !== 0) {
+      obj.keyCount = Math.round(message.keyCount);
+    }
+    if (message.rotateTime !== undefined) {
+      obj.rotateTime = message.rotateTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<EncryptionKey>): EncryptionKey {
+    return EncryptionKey.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<EncryptionKey>): EncryptionKey {
+    const message = createBaseEncryptionKey();
+    message.masterKeyUri = object.masterKeyUri ?? "";
+    message.activeKeyId = object.activeKeyId ?? "";
+    message.keyCount = object.keyCount ?? 0;
+    message.rotateTime = object.rotateTime ?? undefined;
+    return message;
+  },
+};
+
 function createBaseGetResourcePackageRequest(): GetResourcePackageRequest {
   return {};
 }
@@ -691,116 +935,469 @@ export const ActuatorInfo = {
   },
 };
 
-export type ActuatorServiceDefinition = typeof ActuatorServiceDefinition;
-export const ActuatorServiceDefinition = {
-  name: "ActuatorService",
-  fullName: "bytebase.v1.ActuatorService",
-  methods: {
-    getActuatorInfo: {
-      name: "GetActuatorInfo",
-      requestType: GetActuatorInfoRequest,
-      requestStream: false,
-      responseType: ActuatorInfo,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([0])],
-          800000: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([19, 18, 17, 47, 118, 49, 47, 97, 99, 116, 117, 97, 116, 111, 114, 47, 105, 110, 102, 111]),
-          ],
-        },
-      },
-    },
-    updateActuatorInfo: {
-      name: "UpdateActuatorInfo",
-      requestType: UpdateActuatorInfoRequest,
-      requestStream: false,
-      responseType: ActuatorInfo,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [
-            new Uint8Array([
-              20,
-              97,
-              99,
-              116,
-              117,
-              97,
-              116,
-              111,
-              114,
-              44,
-              117,
-              112,
-              100,
-              97,
-              116,
-              101,
-              95,
-              109,
-              97,
-              115,
-              107,
-            ]),
-          ],
-          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 115, 101, 116])],
-          800016: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([
-              29,
-              58,
-              8,
-              97,
-              99,
-              116,
-              117,
-              97,
-              116,
-              111,
-              114,
-              50,
-              17,
-              47,
-              118,
-              49,
-              47,
-              97,
-              99,
-              116,
-              117,
-              97,
-              116,
-              111,
-              114,
-              47,
-              105,
-              110,
-              102,
-              111,
-            ]),
-          ],
-        },
-      },
-    },
-    deleteCache: {
-      name: "DeleteCache",
-      requestType: DeleteCacheRequest,
-      requestStream: false,
-      responseType: Empty,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          800000: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([
-              20,
-              42,
-              18,
-              47,
-              118,
-              49,
+function createBaseCreateMetadataBackupRequest(): CreateMetadataBackupRequest {
+  return {};
+}
+
+export const CreateMetadataBackupRequest = {
+  encode(_: CreateMetadataBackupRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateMetadataBackupRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateMetadataBackupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): CreateMetadataBackupRequest {
+    return {};
+  },
+
+  toJSON(_: CreateMetadataBackupRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreateMetadataBackupRequest>): CreateMetadataBackupRequest {
+    return CreateMetadataBackupRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<CreateMetadataBackupRequest>): CreateMetadataBackupRequest {
+    const message = createBaseCreateMetadataBackupRequest();
+    return message;
+  },
+};
+
+function createBaseListMetadataBackupsRequest(): ListMetadataBackupsRequest {
+  return {};
+}
+
+export const ListMetadataBackupsRequest = {
+  encode(_: ListMetadataBackupsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListMetadataBackupsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListMetadataBackupsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListMetadataBackupsRequest {
+    return {};
+  },
+
+  toJSON(_: ListMetadataBackupsRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListMetadataBackupsRequest>): ListMetadataBackupsRequest {
+    return ListMetadataBackupsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListMetadataBackupsRequest>): ListMetadataBackupsRequest {
+    const message = createBaseListMetadataBackupsRequest();
+    return message;
+  },
+};
+
+function createBaseListMetadataBackupsResponse(): ListMetadataBackupsResponse {
+  return { metadataBackups: [] };
+}
+
+export const ListMetadataBackupsResponse = {
+  encode(message: ListMetadataBackupsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.metadataBackups) {
+      MetadataBackup.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListMetadataBackupsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListMetadataBackupsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.metadataBackups.push(MetadataBackup.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListMetadataBackupsResponse {
+    return {
+      metadataBackups: globalThis.Array.isArray(object?.metadataBackups)
+        ? object.metadataBackups.map((e: any) => MetadataBackup.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListMetadataBackupsResponse): unknown {
+    const obj: any = {};
+    if (message.metadataBackups?.length) {
+      obj.metadataBackups = message.metadataBackups.map((e) => MetadataBackup.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListMetadataBackupsResponse>): ListMetadataBackupsResponse {
+    return ListMetadataBackupsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListMetadataBackupsResponse>): ListMetadataBackupsResponse {
+    const message = createBaseListMetadataBackupsResponse();
+    message.metadataBackups = object.metadataBackups?.map((e) => MetadataBackup.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseVerifyMetadataBackupRequest(): VerifyMetadataBackupRequest {
+  return { name: "" };
+}
+
+export const VerifyMetadataBackupRequest = {
+  encode(message: VerifyMetadataBackupRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VerifyMetadataBackupRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVerifyMetadataBackupRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VerifyMetadataBackupRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: VerifyMetadataBackupRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<VerifyMetadataBackupRequest>): VerifyMetadataBackupRequest {
+    return VerifyMetadataBackupRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<VerifyMetadataBackupRequest>): VerifyMetadataBackupRequest {
+    const message = createBaseVerifyMetadataBackupRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseMetadataBackup(): MetadataBackup {
+  return { name: "", uri: "", createTime: undefined, size: Long.ZERO, sha256: "", encrypted: false, version: "" };
+}
+
+export const MetadataBackup = {
+  encode(message: MetadataBackup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.uri !== "") {
+      writer.uint32(18).string(message.uri);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (!message.size.isZero()) {
+      writer.uint32(32).int64(message.size);
+    }
+    if (message.sha256 !== "") {
+      writer.uint32(42).string(message.sha256);
+    }
+    if (message.encrypted === true) {
+      writer.uint32(48).bool(message.encrypted);
+    }
+    if (message.version !== "") {
+      writer.uint32(58).string(message.version);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MetadataBackup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMetadataBackup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.uri = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.size = reader.int64() as Long;
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sha256 = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.encrypted = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MetadataBackup {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      uri: isSet(object.uri) ? globalThis.String(object.uri) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      size: isSet(object.size) ? Long.fromValue(object.size) : Long.ZERO,
+      sha256: isSet(object.sha256) ? globalThis.String(object.sha256) : "",
+      encrypted: isSet(object.encrypted) ? globalThis.Boolean(object.encrypted) : false,
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+    };
+  },
+
+  toJSON(message: MetadataBackup): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.uri !== "") {
+      obj.uri = message.uri;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (!message.size.isZero()) {
+      obj.size = (message.size || Long.ZERO).toString();
+    }
+    if (message.sha256 !== "") {
+      obj.sha256 = message.sha256;
+    }
+    if (message.encrypted === true) {
+      obj.encrypted = message.encrypted;
+    }
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MetadataBackup>): MetadataBackup {
+    return MetadataBackup.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<MetadataBackup>): MetadataBackup {
+    const message = createBaseMetadataBackup();
+    message.name = object.name ?? "";
+    message.uri = object.uri ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.size = (object.size !== undefined && object.size !== null) ? Long.fromValue(object.size) : Long.ZERO;
+    message.sha256 = object.sha256 ?? "";
+    message.encrypted = object.encrypted ?? false;
+    message.version = object.version ?? "";
+    return message;
+  },
+};
+
+export type ActuatorServiceDefinition = typeof ActuatorServiceDefinition;
+export const ActuatorServiceDefinition = {
+  name: "ActuatorService",
+  fullName: "bytebase.v1.ActuatorService",
+  methods: {
+    getActuatorInfo: {
+      name: "GetActuatorInfo",
+      requestType: GetActuatorInfoRequest,
+      requestStream: false,
+      responseType: ActuatorInfo,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800000: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([19, 18, 17, 47, 118, 49, 47, 97, 99, 116, 117, 97, 116, 111, 114, 47, 105, 110, 102, 111]),
+          ],
+        },
+      },
+    },
+    updateActuatorInfo: {
+      name: "UpdateActuatorInfo",
+      requestType: UpdateActuatorInfoRequest,
+      requestStream: false,
+      responseType: ActuatorInfo,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              20,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 115, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              29,
+              58,
+              8,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              50,
+              17,
+              47,
+              118,
+              49,
+              47,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              47,
+              105,
+              110,
+              102,
+              111,
+            ]),
+          ],
+        },
+      },
+    },
+    deleteCache: {
+      name: "DeleteCache",
+      requestType: DeleteCacheRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800000: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              20,
+              42,
+              18,
+              47,
+              118,
+              49,
               47,
               97,
               99,
@@ -863,6 +1460,289 @@ export const ActuatorServiceDefinition = {
         },
       },
     },
+    getEncryptionKey: {
+      name: "GetEncryptionKey",
+      requestType: GetEncryptionKeyRequest,
+      requestStream: false,
+      responseType: EncryptionKey,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              29,
+              18,
+              27,
+              47,
+              118,
+              49,
+              47,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              47,
+              101,
+              110,
+              99,
+              114,
+              121,
+              112,
+              116,
+              105,
+              111,
+              110,
+              45,
+              107,
+              101,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
+    /** RotateEncryptionKey creates a new data encryption key and re-encrypts the data source credentials with it. */
+    rotateEncryptionKey: {
+      name: "RotateEncryptionKey",
+      requestType: RotateEncryptionKeyRequest,
+      requestStream: false,
+      responseType: EncryptionKey,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 115, 101, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              39,
+              58,
+              1,
+              42,
+              34,
+              34,
+              47,
+              118,
+              49,
+              47,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              47,
+              101,
+              110,
+              99,
+              114,
+              121,
+              112,
+              116,
+              105,
+              111,
+              110,
+              45,
+              107,
+              101,
+              121,
+              58,
+              114,
+              111,
+              116,
+              97,
+              116,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** CreateMetadataBackup takes a backup of the metadata database to the object storage of --metadata-backup-uri. */
+    createMetadataBackup: {
+      name: "CreateMetadataBackup",
+      requestType: CreateMetadataBackupRequest,
+      requestStream: false,
+      responseType: MetadataBackup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 115, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              34,
+              58,
+              1,
+              42,
+              34,
+              29,
+              47,
+              118,
+              49,
+              47,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              47,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              45,
+              98,
+              97,
+              99,
+              107,
+              117,
+              112,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListMetadataBackups lists the complete metadata backups, the latest backup comes first. */
+    listMetadataBackups: {
+      name: "ListMetadataBackups",
+      requestType: ListMetadataBackupsRequest,
+      requestStream: false,
+      responseType: ListMetadataBackupsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              31,
+              18,
+              29,
+              47,
+              118,
+              49,
+              47,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              47,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              45,
+              98,
+              97,
+              99,
+              107,
+              117,
+              112,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * VerifyMetadataBackup downloads the metadata backup and verifies its checksum and integrity.
+     * The backup is restored by starting Bytebase with --restore-metadata-backup.
+     */
+    verifyMetadataBackup: {
+      name: "VerifyMetadataBackup",
+      requestType: VerifyMetadataBackupRequest,
+      requestStream: false,
+      responseType: MetadataBackup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([15, 98, 98, 46, 115, 101, 116, 116, 105, 110, 103, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              48,
+              58,
+              1,
+              42,
+              34,
+              43,
+              47,
+              118,
+              49,
+              47,
+              97,
+              99,
+              116,
+              117,
+              97,
+              116,
+              111,
+              114,
+              47,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              45,
+              98,
+              97,
+              99,
+              107,
+              117,
+              112,
+              115,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              125,
+              58,
+              118,
+              101,
+              114,
+              105,
+              102,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
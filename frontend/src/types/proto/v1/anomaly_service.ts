@@ -1,6 +1,7 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 import { Timestamp } from "../google/protobuf/timestamp";
 
 export const protobufPackage = "bytebase.v1";
@@ -57,6 +58,10 @@ export interface Anomaly {
   instanceConnectionDetail?: Anomaly_InstanceConnectionDetail | undefined;
   databaseConnectionDetail?: Anomaly_DatabaseConnectionDetail | undefined;
   databaseSchemaDriftDetail?: Anomaly_DatabaseSchemaDriftDetail | undefined;
+  instanceConnectionBudgetDetail?: Anomaly_InstanceConnectionBudgetDetail | undefined;
+  instanceLongRunningQueryDetail?: Anomaly_InstanceLongRunningQueryDetail | undefined;
+  databaseBackupFailedDetail?: Anomaly_DatabaseBackupFailedDetail | undefined;
+  instanceFailoverDetail?: Anomaly_InstanceFailoverDetail | undefined;
   createTime: Date | undefined;
   updateTime: Date | undefined;
 }
@@ -73,6 +78,12 @@ export enum Anomaly_AnomalyType {
   INSTANCE_CONNECTION = "INSTANCE_CONNECTION",
   /** MIGRATION_SCHEMA - MIGRATION_SCHEMA is the anomaly type for migration schema, e.g. the migration schema in the instance is missing. */
   MIGRATION_SCHEMA = "MIGRATION_SCHEMA",
+  /** INSTANCE_CONNECTION_BUDGET - INSTANCE_CONNECTION_BUDGET is the anomaly type for instance connection budget, e.g. the connection requests exceed the maximum connections of the instance. */
+  INSTANCE_CONNECTION_BUDGET = "INSTANCE_CONNECTION_BUDGET",
+  /** INSTANCE_LONG_RUNNING_QUERY - INSTANCE_LONG_RUNNING_QUERY is the anomaly type for the queries running longer than the threshold or blocking other queries. */
+  INSTANCE_LONG_RUNNING_QUERY = "INSTANCE_LONG_RUNNING_QUERY",
+  /** INSTANCE_FAILOVER - INSTANCE_FAILOVER is the anomaly type for the changed primary replica of the availability group, e.g. SQL Server Always On failover. */
+  INSTANCE_FAILOVER = "INSTANCE_FAILOVER",
   /**
    * DATABASE_CONNECTION - Database level anomaly.
    *
@@ -84,6 +95,8 @@ export enum Anomaly_AnomalyType {
    * e.g. the database schema had been changed without bytebase migration.
    */
   DATABASE_SCHEMA_DRIFT = "DATABASE_SCHEMA_DRIFT",
+  /** DATABASE_BACKUP_FAILED - DATABASE_BACKUP_FAILED is the anomaly type for the failed scheduled or manual backup of the database. */
+  DATABASE_BACKUP_FAILED = "DATABASE_BACKUP_FAILED",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -98,12 +111,24 @@ export function anomaly_AnomalyTypeFromJSON(object: any): Anomaly_AnomalyType {
     case 2:
     case "MIGRATION_SCHEMA":
       return Anomaly_AnomalyType.MIGRATION_SCHEMA;
+    case 3:
+    case "INSTANCE_CONNECTION_BUDGET":
+      return Anomaly_AnomalyType.INSTANCE_CONNECTION_BUDGET;
+    case 4:
+    case "INSTANCE_LONG_RUNNING_QUERY":
+      return Anomaly_AnomalyType.INSTANCE_LONG_RUNNING_QUERY;
+    case 8:
+    case "INSTANCE_FAILOVER":
+      return Anomaly_AnomalyType.INSTANCE_FAILOVER;
     case 5:
     case "DATABASE_CONNECTION":
       return Anomaly_AnomalyType.DATABASE_CONNECTION;
     case 6:
     case "DATABASE_SCHEMA_DRIFT":
       return Anomaly_AnomalyType.DATABASE_SCHEMA_DRIFT;
+    case 7:
+    case "DATABASE_BACKUP_FAILED":
+      return Anomaly_AnomalyType.DATABASE_BACKUP_FAILED;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -119,10 +144,18 @@ export function anomaly_AnomalyTypeToJSON(object: Anomaly_AnomalyType): string {
       return "INSTANCE_CONNECTION";
     case Anomaly_AnomalyType.MIGRATION_SCHEMA:
       return "MIGRATION_SCHEMA";
+    case Anomaly_AnomalyType.INSTANCE_CONNECTION_BUDGET:
+      return "INSTANCE_CONNECTION_BUDGET";
+    case Anomaly_AnomalyType.INSTANCE_LONG_RUNNING_QUERY:
+      return "INSTANCE_LONG_RUNNING_QUERY";
+    case Anomaly_AnomalyType.INSTANCE_FAILOVER:
+      return "INSTANCE_FAILOVER";
     case Anomaly_AnomalyType.DATABASE_CONNECTION:
       return "DATABASE_CONNECTION";
     case Anomaly_AnomalyType.DATABASE_SCHEMA_DRIFT:
       return "DATABASE_SCHEMA_DRIFT";
+    case Anomaly_AnomalyType.DATABASE_BACKUP_FAILED:
+      return "DATABASE_BACKUP_FAILED";
     case Anomaly_AnomalyType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -137,10 +170,18 @@ export function anomaly_AnomalyTypeToNumber(object: Anomaly_AnomalyType): number
       return 1;
     case Anomaly_AnomalyType.MIGRATION_SCHEMA:
       return 2;
+    case Anomaly_AnomalyType.INSTANCE_CONNECTION_BUDGET:
+      return 3;
+    case Anomaly_AnomalyType.INSTANCE_LONG_RUNNING_QUERY:
+      return 4;
+    case Anomaly_AnomalyType.INSTANCE_FAILOVER:
+      return 8;
     case Anomaly_AnomalyType.DATABASE_CONNECTION:
       return 5;
     case Anomaly_AnomalyType.DATABASE_SCHEMA_DRIFT:
       return 6;
+    case Anomaly_AnomalyType.DATABASE_BACKUP_FAILED:
+      return 7;
     case Anomaly_AnomalyType.UNRECOGNIZED:
     default:
       return -1;
@@ -223,6 +264,48 @@ export interface Anomaly_InstanceConnectionDetail {
   detail: string;
 }
 
+/** InstanceConnectionBudgetDetail is the detail for instance connection budget anomaly. */
+export interface Anomaly_InstanceConnectionBudgetDetail {
+  /** maximum_connections is the maximum number of connections of the instance. */
+  maximumConnections: number;
+  /** rejected_count is the number of connection requests rejected since the last check. */
+  rejectedCount: number;
+}
+
+/** InstanceLongRunningQueryDetail is the detail for instance long-running query anomaly. */
+export interface Anomaly_InstanceLongRunningQueryDetail {
+  queries: Anomaly_InstanceLongRunningQueryDetail_Query[];
+  /** The threshold of the long-running queries. */
+  threshold: Duration | undefined;
+}
+
+export interface Anomaly_InstanceLongRunningQueryDetail_Query {
+  /** The connection id running the query, which can be used to kill the query. */
+  connectionId: string;
+  database: string;
+  user: string;
+  /** The state of the connection, e.g. active or Query. */
+  state: string;
+  statement: string;
+  duration:
+    | Duration
+    | undefined;
+  /** The connection ids blocking the query by the locks. */
+  blockedBy: string[];
+  /** The number of the queries waiting for the locks held by the query. */
+  blockingCount: number;
+}
+
+/** InstanceFailoverDetail is the detail for instance failover anomaly. */
+export interface Anomaly_InstanceFailoverDetail {
+  /** availability_group is the name of the availability group. */
+  availabilityGroup: string;
+  /** previous_primary is the server name of the primary replica before the failover. */
+  previousPrimary: string;
+  /** current_primary is the server name of the primary replica after the failover. */
+  currentPrimary: string;
+}
+
 /**
  * Database level anomaly detial.
  *
@@ -241,6 +324,22 @@ export interface Anomaly_DatabaseSchemaDriftDetail {
   expectedSchema: string;
   /** actual_schema is the actual schema in the database. */
   actualSchema: string;
+  /**
+   * diff is the DDL statements migrating the actual schema to the expected schema.
+   * It's empty if the diff is not supported for the database engine.
+   */
+  diff: string;
+}
+
+/** DatabaseBackupFailedDetail is the detail for database backup failure anomaly. */
+export interface Anomaly_DatabaseBackupFailedDetail {
+  /**
+   * The name of the failed backup run.
+   * Format: instances/{instance}/databases/{database}/backupRuns/{backup_run}
+   */
+  backupRun: string;
+  /** error is the error of the failed backup. */
+  error: string;
 }
 
 function createBaseSearchAnomaliesRequest(): SearchAnomaliesRequest {
@@ -416,6 +515,10 @@ function createBaseAnomaly(): Anomaly {
     instanceConnectionDetail: undefined,
     databaseConnectionDetail: undefined,
     databaseSchemaDriftDetail: undefined,
+    instanceConnectionBudgetDetail: undefined,
+    instanceLongRunningQueryDetail: undefined,
+    databaseBackupFailedDetail: undefined,
+    instanceFailoverDetail: undefined,
     createTime: undefined,
     updateTime: undefined,
   };
@@ -441,6 +544,20 @@ export const Anomaly = {
     if (message.databaseSchemaDriftDetail !== undefined) {
       Anomaly_DatabaseSchemaDriftDetail.encode(message.databaseSchemaDriftDetail, writer.uint32(66).fork()).ldelim();
     }
+    if (message.instanceConnectionBudgetDetail !== undefined) {
+      Anomaly_InstanceConnectionBudgetDetail.encode(message.instanceConnectionBudgetDetail, writer.uint32(90).fork())
+        .ldelim();
+    }
+    if (message.instanceLongRunningQueryDetail !== undefined) {
+      Anomaly_InstanceLongRunningQueryDetail.encode(message.instanceLongRunningQueryDetail, writer.uint32(98).fork())
+        .ldelim();
+    }
+    if (message.databaseBackupFailedDetail !== undefined) {
+      Anomaly_DatabaseBackupFailedDetail.encode(message.databaseBackupFailedDetail, writer.uint32(106).fork()).ldelim();
+    }
+    if (message.instanceFailoverDetail !== undefined) {
+      Anomaly_InstanceFailoverDetail.encode(message.instanceFailoverDetail, writer.uint32(114).fork()).ldelim();
+    }
     if (message.createTime !== undefined) {
       Timestamp.encode(toTimestamp(message.createTime), writer.uint32(74).fork()).ldelim();
     }
@@ -499,6 +616,40 @@ export const Anomaly = {
 
           message.databaseSchemaDriftDetail = Anomaly_DatabaseSchemaDriftDetail.decode(reader, reader.uint32());
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.instanceConnectionBudgetDetail = Anomaly_InstanceConnectionBudgetDetail.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.instanceLongRunningQueryDetail = Anomaly_InstanceLongRunningQueryDetail.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.databaseBackupFailedDetail = Anomaly_DatabaseBackupFailedDetail.decode(reader, reader.uint32());
+          continue;
+        case 14:
+          if (tag !== 114) {
+            break;
+          }
+
+          message.instanceFailoverDetail = Anomaly_InstanceFailoverDetail.decode(reader, reader.uint32());
+          continue;
         case 9:
           if (tag !== 74) {
             break;
@@ -540,6 +691,18 @@ export const Anomaly = {
       databaseSchemaDriftDetail: isSet(object.databaseSchemaDriftDetail)
         ? Anomaly_DatabaseSchemaDriftDetail.fromJSON(object.databaseSchemaDriftDetail)
         : undefined,
+      instanceConnectionBudgetDetail: isSet(object.instanceConnectionBudgetDetail)
+        ? Anomaly_InstanceConnectionBudgetDetail.fromJSON(object.instanceConnectionBudgetDetail)
+        : undefined,
+      instanceLongRunningQueryDetail: isSet(object.instanceLongRunningQueryDetail)
+        ? Anomaly_InstanceLongRunningQueryDetail.fromJSON(object.instanceLongRunningQueryDetail)
+        : undefined,
+      databaseBackupFailedDetail: isSet(object.databaseBackupFailedDetail)
+        ? Anomaly_DatabaseBackupFailedDetail.fromJSON(object.databaseBackupFailedDetail)
+        : undefined,
+      instanceFailoverDetail: isSet(object.instanceFailoverDetail)
+        ? Anomaly_InstanceFailoverDetail.fromJSON(object.instanceFailoverDetail)
+        : undefined,
       createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
       updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
     };
@@ -565,6 +728,22 @@ export const Anomaly = {
     if (message.databaseSchemaDriftDetail !== undefined) {
       obj.databaseSchemaDriftDetail = Anomaly_DatabaseSchemaDriftDetail.toJSON(message.databaseSchemaDriftDetail);
     }
+    if (message.instanceConnectionBudgetDetail !== undefined) {
+      obj.instanceConnectionBudgetDetail = Anomaly_InstanceConnectionBudgetDetail.toJSON(
+        message.instanceConnectionBudgetDetail,
+      );
+    }
+    if (message.instanceLongRunningQueryDetail !== undefined) {
+      obj.instanceLongRunningQueryDetail = Anomaly_InstanceLongRunningQueryDetail.toJSON(
+        message.instanceLongRunningQueryDetail,
+      );
+    }
+    if (message.databaseBackupFailedDetail !== undefined) {
+      obj.databaseBackupFailedDetail = Anomaly_DatabaseBackupFailedDetail.toJSON(message.databaseBackupFailedDetail);
+    }
+    if (message.instanceFailoverDetail !== undefined) {
+      obj.instanceFailoverDetail = Anomaly_InstanceFailoverDetail.toJSON(message.instanceFailoverDetail);
+    }
     if (message.createTime !== undefined) {
       obj.createTime = message.createTime.toISOString();
     }
@@ -594,6 +773,22 @@ export const Anomaly = {
       (object.databaseSchemaDriftDetail !== undefined && object.databaseSchemaDriftDetail !== null)
         ? Anomaly_DatabaseSchemaDriftDetail.fromPartial(object.databaseSchemaDriftDetail)
         : undefined;
+    message.instanceConnectionBudgetDetail =
+      (object.instanceConnectionBudgetDetail !== undefined && object.instanceConnectionBudgetDetail !== null)
+        ? Anomaly_InstanceConnectionBudgetDetail.fromPartial(object.instanceConnectionBudgetDetail)
+        : undefined;
+    message.instanceLongRunningQueryDetail =
+      (object.instanceLongRunningQueryDetail !== undefined && object.instanceLongRunningQueryDetail !== null)
+        ? Anomaly_InstanceLongRunningQueryDetail.fromPartial(object.instanceLongRunningQueryDetail)
+        : undefined;
+    message.databaseBackupFailedDetail =
+      (object.databaseBackupFailedDetail !== undefined && object.databaseBackupFailedDetail !== null)
+        ? Anomaly_DatabaseBackupFailedDetail.fromPartial(object.databaseBackupFailedDetail)
+        : undefined;
+    message.instanceFailoverDetail =
+      (object.instanceFailoverDetail !== undefined && object.instanceFailoverDetail !== null)
+        ? Anomaly_InstanceFailoverDetail.fromPartial(object.instanceFailoverDetail)
+        : undefined;
     message.createTime = object.createTime ?? undefined;
     message.updateTime = object.updateTime ?? undefined;
     return message;
@@ -657,6 +852,428 @@ export const Anomaly_InstanceConnectionDetail = {
   },
 };
 
+function createBaseAnomaly_InstanceConnectionBudgetDetail(): Anomaly_InstanceConnectionBudgetDetail {
+  return { maximumConnections: 0, rejectedCount: 0 };
+}
+
+export const Anomaly_InstanceConnectionBudgetDetail = {
+  encode(message: Anomaly_InstanceConnectionBudgetDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.maximumConnections !== 0) {
+      writer.uint32(8).int32(message.maximumConnections);
+    }
+    if (message.rejectedCount !== 0) {
+      writer.uint32(16).int32(message.rejectedCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Anomaly_InstanceConnectionBudgetDetail {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomaly_InstanceConnectionBudgetDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maximumConnections = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.rejectedCount = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Anomaly_InstanceConnectionBudgetDetail {
+    return {
+      maximumConnections: isSet(object.maximumConnections) ? globalThis.Number(object.maximumConnections) : 0,
+      rejectedCount: isSet(object.rejectedCount) ? globalThis.Number(object.rejectedCount) : 0,
+    };
+  },
+
+  toJSON(message: Anomaly_InstanceConnectionBudgetDetail): unknown {
+    const obj: any = {};
+    if (message.maximumConnections !== 0) {
+      obj.maximumConnections = Math.round(message.maximumConnections);
+    }
+    if (message.rejectedCount !== 0) {
+      obj.rejectedCount = Math.round(message.rejectedCount);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Anomaly_InstanceConnectionBudgetDetail>): Anomaly_InstanceConnectionBudgetDetail {
+    return Anomaly_InstanceConnectionBudgetDetail.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Anomaly_InstanceConnectionBudgetDetail>): Anomaly_InstanceConnectionBudgetDetail {
+    const message = createBaseAnomaly_InstanceConnectionBudgetDetail();
+    message.maximumConnections = object.maximumConnections ?? 0;
+    message.rejectedCount = object.rejectedCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseAnomaly_InstanceLongRunningQueryDetail(): Anomaly_InstanceLongRunningQueryDetail {
+  return { queries: [], threshold: undefined };
+}
+
+export const Anomaly_InstanceLongRunningQueryDetail = {
+  encode(message: Anomaly_InstanceLongRunningQueryDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.queries) {
+      Anomaly_InstanceLongRunningQueryDetail_Query.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.threshold !== undefined) {
+      Duration.encode(message.threshold, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Anomaly_InstanceLongRunningQueryDetail {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomaly_InstanceLongRunningQueryDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.queries.push(Anomaly_InstanceLongRunningQueryDetail_Query.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.threshold = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Anomaly_InstanceLongRunningQueryDetail {
+    return {
+      queries: globalThis.Array.isArray(object?.queries)
+        ? object.queries.map((e: any) => Anomaly_InstanceLongRunningQueryDetail_Query.fromJSON(e))
+        : [],
+      threshold: isSet(object.threshold) ? Duration.fromJSON(object.threshold) : undefined,
+    };
+  },
+
+  toJSON(message: Anomaly_InstanceLongRunningQueryDetail): unknown {
+    const obj: any = {};
+    if (message.queries?.length) {
+      obj.queries = message.queries.map((e) => Anomaly_InstanceLongRunningQueryDetail_Query.toJSON(e));
+    }
+    if (message.threshold !== undefined) {
+      obj.threshold = Duration.toJSON(message.threshold);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Anomaly_InstanceLongRunningQueryDetail>): Anomaly_InstanceLongRunningQueryDetail {
+    return Anomaly_InstanceLongRunningQueryDetail.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Anomaly_InstanceLongRunningQueryDetail>): Anomaly_InstanceLongRunningQueryDetail {
+    const message = createBaseAnomaly_InstanceLongRunningQueryDetail();
+    message.queries = object.queries?.map((e) => Anomaly_InstanceLongRunningQueryDetail_Query.fromPartial(e)) || [];
+    message.threshold = (object.threshold !== undefined && object.threshold !== null)
+      ? Duration.fromPartial(object.threshold)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAnomaly_InstanceLongRunningQueryDetail_Query(): Anomaly_InstanceLongRunningQueryDetail_Query {
+  return {
+    connectionId: "",
+    database: "",
+    user: "",
+    state: "",
+    statement: "",
+    duration: undefined,
+    blockedBy: [],
+    blockingCount: 0,
+  };
+}
+
+export const Anomaly_InstanceLongRunningQueryDetail_Query = {
+  encode(message: Anomaly_InstanceLongRunningQueryDetail_Query, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.connectionId !== "") {
+      writer.uint32(10).string(message.connectionId);
+    }
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
+    }
+    if (message.user !== "") {
+      writer.uint32(26).string(message.user);
+    }
+    if (message.state !== "") {
+      writer.uint32(34).string(message.state);
+    }
+    if (message.statement !== "") {
+      writer.uint32(42).string(message.statement);
+    }
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.blockedBy) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.blockingCount !== 0) {
+      writer.uint32(64).int32(message.blockingCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Anomaly_InstanceLongRunningQueryDetail_Query {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomaly_InstanceLongRunningQueryDetail_Query();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.connectionId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.state = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.blockedBy.push(reader.string());
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.blockingCount = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Anomaly_InstanceLongRunningQueryDetail_Query {
+    return {
+      connectionId: isSet(object.connectionId) ? globalThis.String(object.connectionId) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      user: isSet(object.user) ? globalThis.String(object.user) : "",
+      state: isSet(object.state) ? globalThis.String(object.state) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      blockedBy: globalThis.Array.isArray(object?.blockedBy)
+        ? object.blockedBy.map((e: any) => globalThis.String(e))
+        : [],
+      blockingCount: isSet(object.blockingCount) ? globalThis.Number(object.blockingCount) : 0,
+    };
+  },
+
+  toJSON(message: Anomaly_InstanceLongRunningQueryDetail_Query): unknown {
+    const obj: any = {};
+    if (message.connectionId !== "") {
+      obj.connectionId = message.connectionId;
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    if (message.user !== "") {
+      obj.user = message.user;
+    }
+    if (message.state !== "") {
+      obj.state = message.state;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.duration !== undefined) {
+      obj.duration = Duration.toJSON(message.duration);
+    }
+    if (message.blockedBy?.length) {
+      obj.blockedBy = message.blockedBy;
+    }
+    if (message.blockingCount !== 0) {
+      obj.blockingCount = Math.round(message.blockingCount);
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<Anomaly_InstanceLongRunningQueryDetail_Query>,
+  ): Anomaly_InstanceLongRunningQueryDetail_Query {
+    return Anomaly_InstanceLongRunningQueryDetail_Query.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<Anomaly_InstanceLongRunningQueryDetail_Query>,
+  ): Anomaly_InstanceLongRunningQueryDetail_Query {
+    const message = createBaseAnomaly_InstanceLongRunningQueryDetail_Query();
+    message.connectionId = object.connectionId ?? "";
+    message.database = object.database ?? "";
+    message.user = object.user ?? "";
+    message.state = object.state ?? "";
+    message.statement = object.statement ?? "";
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    message.blockedBy = object.blockedBy?.map((e) => e) || [];
+    message.blockingCount = object.blockingCount ?? 0;
+    return message;
+  },
+};
+
+function createBaseAnomaly_InstanceFailoverDetail(): Anomaly_InstanceFailoverDetail {
+  return { availabilityGroup: "", previousPrimary: "", currentPrimary: "" };
+}
+
+export const Anomaly_InstanceFailoverDetail = {
+  encode(message: Anomaly_InstanceFailoverDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.availabilityGroup !== "") {
+      writer.uint32(10).string(message.availabilityGroup);
+    }
+    if (message.previousPrimary !== "") {
+      writer.uint32(18).string(message.previousPrimary);
+    }
+    if (message.currentPrimary !== "") {
+      writer.uint32(26).string(message.currentPrimary);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Anomaly_InstanceFailoverDetail {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomaly_InstanceFailoverDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.availabilityGroup = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.previousPrimary = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.currentPrimary = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Anomaly_InstanceFailoverDetail {
+    return {
+      availabilityGroup: isSet(object.availabilityGroup) ? globalThis.String(object.availabilityGroup) : "",
+      previousPrimary: isSet(object.previousPrimary) ? globalThis.String(object.previousPrimary) : "",
+      currentPrimary: isSet(object.currentPrimary) ? globalThis.String(object.currentPrimary) : "",
+    };
+  },
+
+  toJSON(message: Anomaly_InstanceFailoverDetail): unknown {
+    const obj: any = {};
+    if (message.availabilityGroup !== "") {
+      obj.availabilityGroup = message.availabilityGroup;
+    }
+    if (message.previousPrimary !== "") {
+      obj.previousPrimary = message.previousPrimary;
+    }
+    if (message.currentPrimary !== "") {
+      obj.currentPrimary = message.currentPrimary;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Anomaly_InstanceFailoverDetail>): Anomaly_InstanceFailoverDetail {
+    return Anomaly_InstanceFailoverDetail.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Anomaly_InstanceFailoverDetail>): Anomaly_InstanceFailoverDetail {
+    const message = createBaseAnomaly_InstanceFailoverDetail();
+    message.availabilityGroup = object.availabilityGroup ?? "";
+    message.previousPrimary = object.previousPrimary ?? "";
+    message.currentPrimary = object.currentPrimary ?? "";
+    return message;
+  },
+};
+
 function createBaseAnomaly_DatabaseConnectionDetail(): Anomaly_DatabaseConnectionDetail {
   return { detail: "" };
 }
@@ -715,7 +1332,7 @@ export const Anomaly_DatabaseConnectionDetail = {
 };
 
 function createBaseAnomaly_DatabaseSchemaDriftDetail(): Anomaly_DatabaseSchemaDriftDetail {
-  return { recordVersion: "", expectedSchema: "", actualSchema: "" };
+  return { recordVersion: "", expectedSchema: "", actualSchema: "", diff: "" };
 }
 
 export const Anomaly_DatabaseSchemaDriftDetail = {
@@ -729,6 +1346,9 @@ export const Anomaly_DatabaseSchemaDriftDetail = {
     if (message.actualSchema !== "") {
       writer.uint32(26).string(message.actualSchema);
     }
+    if (message.diff !== "") {
+      writer.uint32(34).string(message.diff);
+    }
     return writer;
   },
 
@@ -760,6 +1380,13 @@ export const Anomaly_DatabaseSchemaDriftDetail = {
 
           message.actualSchema = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.diff = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -774,6 +1401,7 @@ export const Anomaly_DatabaseSchemaDriftDetail = {
       recordVersion: isSet(object.recordVersion) ? globalThis.String(object.recordVersion) : "",
       expectedSchema: isSet(object.expectedSchema) ? globalThis.String(object.expectedSchema) : "",
       actualSchema: isSet(object.actualSchema) ? globalThis.String(object.actualSchema) : "",
+      diff: isSet(object.diff) ? globalThis.String(object.diff) : "",
     };
   },
 
@@ -788,6 +1416,9 @@ export const Anomaly_DatabaseSchemaDriftDetail = {
     if (message.actualSchema !== "") {
       obj.actualSchema = message.actualSchema;
     }
+    if (message.diff !== "") {
+      obj.diff = message.diff;
+    }
     return obj;
   },
 
@@ -799,6 +1430,81 @@ export const Anomaly_DatabaseSchemaDriftDetail = {
     message.recordVersion = object.recordVersion ?? "";
     message.expectedSchema = object.expectedSchema ?? "";
     message.actualSchema = object.actualSchema ?? "";
+    message.diff = object.diff ?? "";
+    return message;
+  },
+};
+
+function createBaseAnomaly_DatabaseBackupFailedDetail(): Anomaly_DatabaseBackupFailedDetail {
+  return { backupRun: "", error: "" };
+}
+
+export const Anomaly_DatabaseBackupFailedDetail = {
+  encode(message: Anomaly_DatabaseBackupFailedDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.backupRun !== "") {
+      writer.uint32(10).string(message.backupRun);
+    }
+    if (message.error !== "") {
+      writer.uint32(18).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Anomaly_DatabaseBackupFailedDetail {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomaly_DatabaseBackupFailedDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.backupRun = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Anomaly_DatabaseBackupFailedDetail {
+    return {
+      backupRun: isSet(object.backupRun) ? globalThis.String(object.backupRun) : "",
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: Anomaly_DatabaseBackupFailedDetail): unknown {
+    const obj: any = {};
+    if (message.backupRun !== "") {
+      obj.backupRun = message.backupRun;
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Anomaly_DatabaseBackupFailedDetail>): Anomaly_DatabaseBackupFailedDetail {
+    return Anomaly_DatabaseBackupFailedDetail.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Anomaly_DatabaseBackupFailedDetail>): Anomaly_DatabaseBackupFailedDetail {
+    const message = createBaseAnomaly_DatabaseBackupFailedDetail();
+    message.backupRun = object.backupRun ?? "";
+    message.error = object.error ?? "";
     return message;
   },
 };
@@ -3,6 +3,7 @@ import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
 import { State, stateFromJSON, stateToJSON, stateToNumber } from "./common";
 
 export const protobufPackage = "bytebase.v1";
@@ -154,6 +155,235 @@ export interface UndeleteUserRequest {
   name: string;
 }
 
+export interface CreateServiceAccountTokenRequest {
+  /**
+   * The service account of the token.
+   * Format: users/{email}
+   */
+  parent: string;
+  token: ServiceAccountToken | undefined;
+}
+
+export interface ListServiceAccountTokensRequest {
+  /**
+   * The service account of the tokens.
+   * Format: users/{email}
+   */
+  parent: string;
+}
+
+export interface ListServiceAccountTokensResponse {
+  tokens: ServiceAccountToken[];
+}
+
+export interface DeleteServiceAccountTokenRequest {
+  /**
+   * The name of the token to revoke.
+   * Format: users/{email}/tokens/{token}
+   */
+  name: string;
+}
+
+/**
+ * ServiceAccountToken is a token of the service account limited by the scopes.
+ * The request authenticated by the token can only use the permissions both granted
+ * to the service account and included in the scopes.
+ */
+export interface ServiceAccountToken {
+  /** Format: users/{email}/tokens/{token} */
+  name: string;
+  title: string;
+  /**
+   * The scopes of the token. Supported scopes are:
+   * - {resource}.read-only, e.g. issues.read-only, grants the get and list permissions of the resource.
+   * - {resource}.read-write, e.g. issues.read-write, grants all permissions of the resource.
+   * - sql-review.check, grants checking the SQL against the SQL review rules.
+   */
+  scopes: string[];
+  /**
+   * The token cannot be used after the expire_time.
+   * Empty means the token never expires.
+   */
+  expireTime: Date | undefined;
+  createTime:
+    | Date
+    | undefined;
+  /** The plaintext token, only returned when the token is created. */
+  token: string;
+}
+
+export interface GetNotificationSettingRequest {
+  /**
+   * The name of the notification setting.
+   * Format: users/{email}/notificationSetting
+   */
+  name: string;
+}
+
+export interface UpdateNotificationSettingRequest {
+  /**
+   * The notification setting to update.
+   *
+   * The notification setting's `name` field is used to identify the notification setting to update.
+   * Format: users/{email}/notificationSetting
+   */
+  notificationSetting:
+    | NotificationSetting
+    | undefined;
+  /** The list of fields to update. */
+  updateMask: string[] | undefined;
+}
+
+/**
+ * NotificationSetting is the setting of the email notifications of issue events,
+ * which is an alternative to the IM webhooks.
+ * The emails are sent with the SMTP mail delivery setting of the workspace.
+ */
+export interface NotificationSetting {
+  /** Format: users/{email}/notificationSetting */
+  name: string;
+  /**
+   * The events to notify the user of by email.
+   * The user is not notified by email if it's empty.
+   */
+  emailEvents: NotificationSetting_Event[];
+  /** The delivery of the emails, INSTANT if unspecified. */
+  emailDelivery: NotificationSetting_EmailDelivery;
+  /**
+   * The language of the emails, e.g. en-US or zh-CN.
+   * English is used if it's empty or not supported.
+   */
+  emailLanguage: string;
+}
+
+export enum NotificationSetting_Event {
+  EVENT_UNSPECIFIED = "EVENT_UNSPECIFIED",
+  /** ISSUE_CREATED - An issue is created in the projects which the user owns. */
+  ISSUE_CREATED = "ISSUE_CREATED",
+  /** ISSUE_APPROVAL_NEEDED - An issue is waiting for the user's approval. */
+  ISSUE_APPROVAL_NEEDED = "ISSUE_APPROVAL_NEEDED",
+  /** TASK_RUN_FAILED - A task run of the issues created by the user failed. */
+  TASK_RUN_FAILED = "TASK_RUN_FAILED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function notificationSetting_EventFromJSON(object: any): NotificationSetting_Event {
+  switch (object) {
+    case 0:
+    case "EVENT_UNSPECIFIED":
+      return NotificationSetting_Event.EVENT_UNSPECIFIED;
+    case 1:
+    case "ISSUE_CREATED":
+      return NotificationSetting_Event.ISSUE_CREATED;
+    case 2:
+    case "ISSUE_APPROVAL_NEEDED":
+      return NotificationSetting_Event.ISSUE_APPROVAL_NEEDED;
+    case 3:
+    case "TASK_RUN_FAILED":
+      return NotificationSetting_Event.TASK_RUN_FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return NotificationSetting_Event.UNRECOGNIZED;
+  }
+}
+
+export function notificationSetting_EventToJSON(object: NotificationSetting_Event): string {
+  switch (object) {
+    case NotificationSetting_Event.EVENT_UNSPECIFIED:
+      return "EVENT_UNSPECIFIED";
+    case NotificationSetting_Event.ISSUE_CREATED:
+      return "ISSUE_CREATED";
+    case NotificationSetting_Event.ISSUE_APPROVAL_NEEDED:
+      return "ISSUE_APPROVAL_NEEDED";
+    case NotificationSetting_Event.TASK_RUN_FAILED:
+      return "TASK_RUN_FAILED";
+    case NotificationSetting_Event.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function notificationSetting_EventToNumber(object: NotificationSetting_Event): number {
+  switch (object) {
+    case NotificationSetting_Event.EVENT_UNSPECIFIED:
+      return 0;
+    case NotificationSetting_Event.ISSUE_CREATED:
+      return 1;
+    case NotificationSetting_Event.ISSUE_APPROVAL_NEEDED:
+      return 2;
+    case NotificationSetting_Event.TASK_RUN_FAILED:
+      return 3;
+    case NotificationSetting_Event.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export enum NotificationSetting_EmailDelivery {
+  EMAIL_DELIVERY_UNSPECIFIED = "EMAIL_DELIVERY_UNSPECIFIED",
+  /** INSTANT - Send an email for each event. */
+  INSTANT = "INSTANT",
+  /** HOURLY_DIGEST - Batch the events into one email per hour. */
+  HOURLY_DIGEST = "HOURLY_DIGEST",
+  /** DAILY_DIGEST - Batch the events into one email per day. */
+  DAILY_DIGEST = "DAILY_DIGEST",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function notificationSetting_EmailDeliveryFromJSON(object: any): NotificationSetting_EmailDelivery {
+  switch (object) {
+    case 0:
+    case "EMAIL_DELIVERY_UNSPECIFIED":
+      return NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED;
+    case 1:
+    case "INSTANT":
+      return NotificationSetting_EmailDelivery.INSTANT;
+    case 2:
+    case "HOURLY_DIGEST":
+      return NotificationSetting_EmailDelivery.HOURLY_DIGEST;
+    case 3:
+    case "DAILY_DIGEST":
+      return NotificationSetting_EmailDelivery.DAILY_DIGEST;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return NotificationSetting_EmailDelivery.UNRECOGNIZED;
+  }
+}
+
+export function notificationSetting_EmailDeliveryToJSON(object: NotificationSetting_EmailDelivery): string {
+  switch (object) {
+    case NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED:
+      return "EMAIL_DELIVERY_UNSPECIFIED";
+    case NotificationSetting_EmailDelivery.INSTANT:
+      return "INSTANT";
+    case NotificationSetting_EmailDelivery.HOURLY_DIGEST:
+      return "HOURLY_DIGEST";
+    case NotificationSetting_EmailDelivery.DAILY_DIGEST:
+      return "DAILY_DIGEST";
+    case NotificationSetting_EmailDelivery.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function notificationSetting_EmailDeliveryToNumber(object: NotificationSetting_EmailDelivery): number {
+  switch (object) {
+    case NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED:
+      return 0;
+    case NotificationSetting_EmailDelivery.INSTANT:
+      return 1;
+    case NotificationSetting_EmailDelivery.HOURLY_DIGEST:
+      return 2;
+    case NotificationSetting_EmailDelivery.DAILY_DIGEST:
+      return 3;
+    case NotificationSetting_EmailDelivery.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface LoginRequest {
   email: string;
   password: string;
@@ -745,52 +975,25 @@ export const UndeleteUserRequest = {
   },
 };
 
-function createBaseLoginRequest(): LoginRequest {
-  return {
-    email: "",
-    password: "",
-    web: false,
-    idpName: "",
-    idpContext: undefined,
-    otpCode: undefined,
-    recoveryCode: undefined,
-    mfaTempToken: undefined,
-  };
+function createBaseCreateServiceAccountTokenRequest(): CreateServiceAccountTokenRequest {
+  return { parent: "", token: undefined };
 }
 
-export const LoginRequest = {
-  encode(message: LoginRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.email !== "") {
-      writer.uint32(10).string(message.email);
-    }
-    if (message.password !== "") {
-      writer.uint32(18).string(message.password);
-    }
-    if (message.web === true) {
-      writer.uint32(24).bool(message.web);
-    }
-    if (message.idpName !== "") {
-      writer.uint32(34).string(message.idpName);
+export const CreateServiceAccountTokenRequest = {
+  encode(message: CreateServiceAccountTokenRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
-    if (message.idpContext !== undefined) {
-      IdentityProviderContext.encode(message.idpContext, writer.uint32(42).fork()).ldelim();
-    }
-    if (message.otpCode !== undefined) {
-      writer.uint32(50).string(message.otpCode);
-    }
-    if (message.recoveryCode !== undefined) {
-      writer.uint32(58).string(message.recoveryCode);
-    }
-    if (message.mfaTempToken !== undefined) {
-      writer.uint32(66).string(message.mfaTempToken);
+    if (message.token !== undefined) {
+      ServiceAccountToken.encode(message.token, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): LoginRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateServiceAccountTokenRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseLoginRequest();
+    const message = createBaseCreateServiceAccountTokenRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -799,56 +1002,14 @@ export const LoginRequest = {
             break;
           }
 
-          message.email = reader.string();
+          message.parent = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.password = reader.string();
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.web = reader.bool();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.idpName = reader.string();
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.idpContext = IdentityProviderContext.decode(reader, reader.uint32());
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.otpCode = reader.string();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.recoveryCode = reader.string();
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.mfaTempToken = reader.string();
+          message.token = ServiceAccountToken.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -859,86 +1020,53 @@ export const LoginRequest = {
     return message;
   },
 
-  fromJSON(object: any): LoginRequest {
+  fromJSON(object: any): CreateServiceAccountTokenRequest {
     return {
-      email: isSet(object.email) ? globalThis.String(object.email) : "",
-      password: isSet(object.password) ? globalThis.String(object.password) : "",
-      web: isSet(object.web) ? globalThis.Boolean(object.web) : false,
-      idpName: isSet(object.idpName) ? globalThis.String(object.idpName) : "",
-      idpContext: isSet(object.idpContext) ? IdentityProviderContext.fromJSON(object.idpContext) : undefined,
-      otpCode: isSet(object.otpCode) ? globalThis.String(object.otpCode) : undefined,
-      recoveryCode: isSet(object.recoveryCode) ? globalThis.String(object.recoveryCode) : undefined,
-      mfaTempToken: isSet(object.mfaTempToken) ? globalThis.String(object.mfaTempToken) : undefined,
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      token: isSet(object.token) ? ServiceAccountToken.fromJSON(object.token) : undefined,
     };
   },
 
-  toJSON(message: LoginRequest): unknown {
+  toJSON(message: CreateServiceAccountTokenRequest): unknown {
     const obj: any = {};
-    if (message.email !== "") {
-      obj.email = message.email;
-    }
-    if (message.password !== "") {
-      obj.password = message.password;
-    }
-    if (message.web === true) {
-      obj.web = message.web;
-    }
-    if (message.idpName !== "") {
-      obj.idpName = message.idpName;
-    }
-    if (message.idpContext !== undefined) {
-      obj.idpContext = IdentityProviderContext.toJSON(message.idpContext);
-    }
-    if (message.otpCode !== undefined) {
-      obj.otpCode = message.otpCode;
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
-    if (message.recoveryCode !== undefined) {
-      obj.recoveryCode = message.recoveryCode;
-    }
-    if (message.mfaTempToken !== undefined) {
-      obj.mfaTempToken = message.mfaTempToken;
+    if (message.token !== undefined) {
+      obj.token = ServiceAccountToken.toJSON(message.token);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<LoginRequest>): LoginRequest {
-    return LoginRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<CreateServiceAccountTokenRequest>): CreateServiceAccountTokenRequest {
+    return CreateServiceAccountTokenRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<LoginRequest>): LoginRequest {
-    const message = createBaseLoginRequest();
-    message.email = object.email ?? "";
-    message.password = object.password ?? "";
-    message.web = object.web ?? false;
-    message.idpName = object.idpName ?? "";
-    message.idpContext = (object.idpContext !== undefined && object.idpContext !== null)
-      ? IdentityProviderContext.fromPartial(object.idpContext)
+  fromPartial(object: DeepPartial<CreateServiceAccountTokenRequest>): CreateServiceAccountTokenRequest {
+    const message = createBaseCreateServiceAccountTokenRequest();
+    message.parent = object.parent ?? "";
+    message.token = (object.token !== undefined && object.token !== null)
+      ? ServiceAccountToken.fromPartial(object.token)
       : undefined;
-    message.otpCode = object.otpCode ?? undefined;
-    message.recoveryCode = object.recoveryCode ?? undefined;
-    message.mfaTempToken = object.mfaTempToken ?? undefined;
     return message;
   },
 };
 
-function createBaseIdentityProviderContext(): IdentityProviderContext {
-  return { oauth2Context: undefined, oidcContext: undefined };
+function createBaseListServiceAccountTokensRequest(): ListServiceAccountTokensRequest {
+  return { parent: "" };
 }
 
-export const IdentityProviderContext = {
-  encode(message: IdentityProviderContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.oauth2Context !== undefined) {
-      OAuth2IdentityProviderContext.encode(message.oauth2Context, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.oidcContext !== undefined) {
-      OIDCIdentityProviderContext.encode(message.oidcContext, writer.uint32(18).fork()).ldelim();
+export const ListServiceAccountTokensRequest = {
+  encode(message: ListServiceAccountTokensRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IdentityProviderContext {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListServiceAccountTokensRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIdentityProviderContext();
+    const message = createBaseListServiceAccountTokensRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -947,14 +1075,7 @@ export const IdentityProviderContext = {
             break;
           }
 
-          message.oauth2Context = OAuth2IdentityProviderContext.decode(reader, reader.uint32());
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.oidcContext = OIDCIdentityProviderContext.decode(reader, reader.uint32());
+          message.parent = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -965,13 +1086,767 @@ export const IdentityProviderContext = {
     return message;
   },
 
-  fromJSON(object: any): IdentityProviderContext {
-    return {
-      oauth2Context: isSet(object.oauth2Context)
-        ? OAuth2IdentityProviderContext.fromJSON(object.oauth2Context)
-        : undefined,
-      oidcContext: isSet(object.oidcContext) ? OIDCIdentityProviderContext.fromJSON(object.oidcContext) : undefined,
-    };
+  fromJSON(object: any): ListServiceAccountTokensRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: ListServiceAccountTokensRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListServiceAccountTokensRequest>): ListServiceAccountTokensRequest {
+    return ListServiceAccountTokensRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListServiceAccountTokensRequest>): ListServiceAccountTokensRequest {
+    const message = createBaseListServiceAccountTokensRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListServiceAccountTokensResponse(): ListServiceAccountTokensResponse {
+  return { tokens: [] };
+}
+
+export const ListServiceAccountTokensResponse = {
+  encode(message: ListServiceAccountTokensResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.tokens) {
+      ServiceAccountToken.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListServiceAccountTokensResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListServiceAccountTokensResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.tokens.push(ServiceAccountToken.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListServiceAccountTokensResponse {
+    return {
+      tokens: globalThis.Array.isArray(object?.tokens)
+        ? object.tokens.map((e: any) => ServiceAccountToken.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListServiceAccountTokensResponse): unknown {
+    const obj: any = {};
+    if (message.tokens?.length) {
+      obj.tokens = message.tokens.map((e) => ServiceAccountToken.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListServiceAccountTokensResponse>): ListServiceAccountTokensResponse {
+    return ListServiceAccountTokensResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListServiceAccountTokensResponse>): ListServiceAccountTokensResponse {
+    const message = createBaseListServiceAccountTokensResponse();
+    message.tokens = object.tokens?.map((e) => ServiceAccountToken.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDeleteServiceAccountTokenRequest(): DeleteServiceAccountTokenRequest {
+  return { name: "" };
+}
+
+export const DeleteServiceAccountTokenRequest = {
+  encode(message: DeleteServiceAccountTokenRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteServiceAccountTokenRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteServiceAccountTokenRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteServiceAccountTokenRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: DeleteServiceAccountTokenRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteServiceAccountTokenRequest>): DeleteServiceAccountTokenRequest {
+    return DeleteServiceAccountTokenRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteServiceAccountTokenRequest>): DeleteServiceAccountTokenRequest {
+    const message = createBaseDeleteServiceAccountTokenRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseServiceAccountToken(): ServiceAccountToken {
+  return { name: "", title: "", scopes: [], expireTime: undefined, createTime: undefined, token: "" };
+}
+
+export const ServiceAccountToken = {
+  encode(message: ServiceAccountToken, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    for (const v of message.scopes) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.token !== "") {
+      writer.uint32(50).string(message.token);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ServiceAccountToken {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseServiceAccountToken();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.scopes.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ServiceAccountToken {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      scopes: globalThis.Array.isArray(object?.scopes) ? object.scopes.map((e: any) => globalThis.String(e)) : [],
+      expireTime: isSet(object.expireTime) ? fromJsonTimestamp(object.expireTime) : undefined,
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      token: isSet(object.token) ? globalThis.String(object.token) : "",
+    };
+  },
+
+  toJSON(message: ServiceAccountToken): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.scopes?.length) {
+      obj.scopes = message.scopes;
+    }
+    if (message.expireTime !== undefined) {
+      obj.expireTime = message.expireTime.toISOString();
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.token !== "") {
+      obj.token = message.token;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ServiceAccountToken>): ServiceAccountToken {
+    return ServiceAccountToken.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ServiceAccountToken>): ServiceAccountToken {
+    const message = createBaseServiceAccountToken();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.scopes = object.scopes?.map((e) => e) || [];
+    message.expireTime = object.expireTime ?? undefined;
+    message.createTime = object.createTime ?? undefined;
+    message.token = object.token ?? "";
+    return message;
+  },
+};
+
+function createBaseGetNotificationSettingRequest(): GetNotificationSettingRequest {
+  return { name: "" };
+}
+
+export const GetNotificationSettingRequest = {
+  encode(message: GetNotificationSettingRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetNotificationSettingRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetNotificationSettingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetNotificationSettingRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: GetNotificationSettingRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetNotificationSettingRequest>): GetNotificationSettingRequest {
+    return GetNotificationSettingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetNotificationSettingRequest>): GetNotificationSettingRequest {
+    const message = createBaseGetNotificationSettingRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateNotificationSettingRequest(): UpdateNotificationSettingRequest {
+  return { notificationSetting: undefined, updateMask: undefined };
+}
+
+export const UpdateNotificationSettingRequest = {
+  encode(message: UpdateNotificationSettingRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.notificationSetting !== undefined) {
+      NotificationSetting.encode(message.notificationSetting, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateNotificationSettingRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateNotificationSettingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.notificationSetting = NotificationSetting.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateNotificationSettingRequest {
+    return {
+      notificationSetting: isSet(object.notificationSetting)
+        ? NotificationSetting.fromJSON(object.notificationSetting)
+        : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateNotificationSettingRequest): unknown {
+    const obj: any = {};
+    if (message.notificationSetting !== undefined) {
+      obj.notificationSetting = NotificationSetting.toJSON(message.notificationSetting);
+    }
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateNotificationSettingRequest>): UpdateNotificationSettingRequest {
+    return UpdateNotificationSettingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateNotificationSettingRequest>): UpdateNotificationSettingRequest {
+    const message = createBaseUpdateNotificationSettingRequest();
+    message.notificationSetting = (object.notificationSetting !== undefined && object.notificationSetting !== null)
+      ? NotificationSetting.fromPartial(object.notificationSetting)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseNotificationSetting(): NotificationSetting {
+  return {
+    name: "",
+    emailEvents: [],
+    emailDelivery: NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED,
+    emailLanguage: "",
+  };
+}
+
+export const NotificationSetting = {
+  encode(message: NotificationSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    writer.uint32(18).fork();
+    for (const v of message.emailEvents) {
+      writer.int32(notificationSetting_EventToNumber(v));
+    }
+    writer.ldelim();
+    if (message.emailDelivery !== NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED) {
+      writer.uint32(24).int32(notificationSetting_EmailDeliveryToNumber(message.emailDelivery));
+    }
+    if (message.emailLanguage !== "") {
+      writer.uint32(34).string(message.emailLanguage);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): NotificationSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseNotificationSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag === 16) {
+            message.emailEvents.push(notificationSetting_EventFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 18) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.emailEvents.push(notificationSetting_EventFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.emailDelivery = notificationSetting_EmailDeliveryFromJSON(reader.int32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.emailLanguage = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): NotificationSetting {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      emailEvents: globalThis.Array.isArray(object?.emailEvents)
+        ? object.emailEvents.map((e: any) => notificationSetting_EventFromJSON(e))
+        : [],
+      emailDelivery: isSet(object.emailDelivery)
+        ? notificationSetting_EmailDeliveryFromJSON(object.emailDelivery)
+        : NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED,
+      emailLanguage: isSet(object.emailLanguage) ? globalThis.String(object.emailLanguage) : "",
+    };
+  },
+
+  toJSON(message: NotificationSetting): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.emailEvents?.length) {
+      obj.emailEvents = message.emailEvents.map((e) => notificationSetting_EventToJSON(e));
+    }
+    if (message.emailDelivery !== NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED) {
+      obj.emailDelivery = notificationSetting_EmailDeliveryToJSON(message.emailDelivery);
+    }
+    if (message.emailLanguage !== "") {
+      obj.emailLanguage = message.emailLanguage;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<NotificationSetting>): NotificationSetting {
+    return NotificationSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<NotificationSetting>): NotificationSetting {
+    const message = createBaseNotificationSetting();
+    message.name = object.name ?? "";
+    message.emailEvents = object.emailEvents?.map((e) => e) || [];
+    message.emailDelivery = object.emailDelivery ?? NotificationSetting_EmailDelivery.EMAIL_DELIVERY_UNSPECIFIED;
+    message.emailLanguage = object.emailLanguage ?? "";
+    return message;
+  },
+};
+
+function createBaseLoginRequest(): LoginRequest {
+  return {
+    email: "",
+    password: "",
+    web: false,
+    idpName: "",
+    idpContext: undefined,
+    otpCode: undefined,
+    recoveryCode: undefined,
+    mfaTempToken: undefined,
+  };
+}
+
+export const LoginRequest = {
+  encode(message: LoginRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.email !== "") {
+      writer.uint32(10).string(message.email);
+    }
+    if (message.password !== "") {
+      writer.uint32(18).string(message.password);
+    }
+    if (message.web === true) {
+      writer.uint32(24).bool(message.web);
+    }
+    if (message.idpName !== "") {
+      writer.uint32(34).string(message.idpName);
+    }
+    if (message.idpContext !== undefined) {
+      IdentityProviderContext.encode(message.idpContext, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.otpCode !== undefined) {
+      writer.uint32(50).string(message.otpCode);
+    }
+    if (message.recoveryCode !== undefined) {
+      writer.uint32(58).string(message.recoveryCode);
+    }
+    if (message.mfaTempToken !== undefined) {
+      writer.uint32(66).string(message.mfaTempToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LoginRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLoginRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.email = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.web = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.idpName = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.idpContext = IdentityProviderContext.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.otpCode = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.recoveryCode = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.mfaTempToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LoginRequest {
+    return {
+      email: isSet(object.email) ? globalThis.String(object.email) : "",
+      password: isSet(object.password) ? globalThis.String(object.password) : "",
+      web: isSet(object.web) ? globalThis.Boolean(object.web) : false,
+      idpName: isSet(object.idpName) ? globalThis.String(object.idpName) : "",
+      idpContext: isSet(object.idpContext) ? IdentityProviderContext.fromJSON(object.idpContext) : undefined,
+      otpCode: isSet(object.otpCode) ? globalThis.String(object.otpCode) : undefined,
+      recoveryCode: isSet(object.recoveryCode) ? globalThis.String(object.recoveryCode) : undefined,
+      mfaTempToken: isSet(object.mfaTempToken) ? globalThis.String(object.mfaTempToken) : undefined,
+    };
+  },
+
+  toJSON(message: LoginRequest): unknown {
+    const obj: any = {};
+    if (message.email !== "") {
+      obj.email = message.email;
+    }
+    if (message.password !== "") {
+      obj.password = message.password;
+    }
+    if (message.web === true) {
+      obj.web = message.web;
+    }
+    if (message.idpName !== "") {
+      obj.idpName = message.idpName;
+    }
+    if (message.idpContext !== undefined) {
+      obj.idpContext = IdentityProviderContext.toJSON(message.idpContext);
+    }
+    if (message.otpCode !== undefined) {
+      obj.otpCode = message.otpCode;
+    }
+    if (message.recoveryCode !== undefined) {
+      obj.recoveryCode = message.recoveryCode;
+    }
+    if (message.mfaTempToken !== undefined) {
+      obj.mfaTempToken = message.mfaTempToken;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LoginRequest>): LoginRequest {
+    return LoginRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LoginRequest>): LoginRequest {
+    const message = createBaseLoginRequest();
+    message.email = object.email ?? "";
+    message.password = object.password ?? "";
+    message.web = object.web ?? false;
+    message.idpName = object.idpName ?? "";
+    message.idpContext = (object.idpContext !== undefined && object.idpContext !== null)
+      ? IdentityProviderContext.fromPartial(object.idpContext)
+      : undefined;
+    message.otpCode = object.otpCode ?? undefined;
+    message.recoveryCode = object.recoveryCode ?? undefined;
+    message.mfaTempToken = object.mfaTempToken ?? undefined;
+    return message;
+  },
+};
+
+function createBaseIdentityProviderContext(): IdentityProviderContext {
+  return { oauth2Context: undefined, oidcContext: undefined };
+}
+
+export const IdentityProviderContext = {
+  encode(message: IdentityProviderContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.oauth2Context !== undefined) {
+      OAuth2IdentityProviderContext.encode(message.oauth2Context, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.oidcContext !== undefined) {
+      OIDCIdentityProviderContext.encode(message.oidcContext, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IdentityProviderContext {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIdentityProviderContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.oauth2Context = OAuth2IdentityProviderContext.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.oidcContext = OIDCIdentityProviderContext.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IdentityProviderContext {
+    return {
+      oauth2Context: isSet(object.oauth2Context)
+        ? OAuth2IdentityProviderContext.fromJSON(object.oauth2Context)
+        : undefined,
+      oidcContext: isSet(object.oidcContext) ? OIDCIdentityProviderContext.fromJSON(object.oidcContext) : undefined,
+    };
   },
 
   toJSON(message: IdentityProviderContext): unknown {
@@ -1488,77 +2363,318 @@ export const AuthServiceDefinition = {
       },
     },
     /**
-     * List all users.
-     * Any authenticated user can list users.
+     * List all users.
+     * Any authenticated user can list users.
+     */
+    listUsers: {
+      name: "ListUsers",
+      requestType: ListUsersRequest,
+      requestStream: false,
+      responseType: ListUsersResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [new Uint8Array([11, 18, 9, 47, 118, 49, 47, 117, 115, 101, 114, 115])],
+        },
+      },
+    },
+    /**
+     * Create a user.
+     * When Disallow Signup is enabled, only the caller with bb.users.create on the workspace can create a user.
+     * Otherwise, any unauthenticated user can create a user.
+     */
+    createUser: {
+      name: "CreateUser",
+      requestType: CreateUserRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 117, 115, 101, 114])],
+          800000: [new Uint8Array([1])],
+          800016: [new Uint8Array([2])],
+          800024: [new Uint8Array([1])],
+          578365826: [new Uint8Array([17, 58, 4, 117, 115, 101, 114, 34, 9, 47, 118, 49, 47, 117, 115, 101, 114, 115])],
+        },
+      },
+    },
+    /** Only the user itself and the user with bb.users.update permission on the workspace can update the user. */
+    updateUser: {
+      name: "UpdateUser",
+      requestType: UpdateUserRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([16, 117, 115, 101, 114, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
+          800016: [new Uint8Array([2])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              31,
+              58,
+              4,
+              117,
+              115,
+              101,
+              114,
+              50,
+              23,
+              47,
+              118,
+              49,
+              47,
+              123,
+              117,
+              115,
+              101,
+              114,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * Only the user with bb.users.delete permission on the workspace can delete the user.
+     * The last remaining workspace admin cannot be deleted.
+     */
+    deleteUser: {
+      name: "DeleteUser",
+      requestType: DeleteUserRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              20,
+              42,
+              18,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** Only the user with bb.users.undelete permission on the workspace can undelete the user. */
+    undeleteUser: {
+      name: "UndeleteUser",
+      requestType: UndeleteUserRequest,
+      requestStream: false,
+      responseType: User,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              32,
+              58,
+              1,
+              42,
+              34,
+              27,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              42,
+              125,
+              58,
+              117,
+              110,
+              100,
+              101,
+              108,
+              101,
+              116,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * Create a scoped token for the service account.
+     * The plaintext token is only returned in the response of the creation.
      */
-    listUsers: {
-      name: "ListUsers",
-      requestType: ListUsersRequest,
+    createServiceAccountToken: {
+      name: "CreateServiceAccountToken",
+      requestType: CreateServiceAccountTokenRequest,
       requestStream: false,
-      responseType: ListUsersResponse,
+      responseType: ServiceAccountToken,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
-          800016: [new Uint8Array([2])],
-          578365826: [new Uint8Array([11, 18, 9, 47, 118, 49, 47, 117, 115, 101, 114, 115])],
+          8410: [new Uint8Array([12, 112, 97, 114, 101, 110, 116, 44, 116, 111, 107, 101, 110])],
+          800010: [new Uint8Array([15, 98, 98, 46, 117, 115, 101, 114, 115, 46, 117, 112, 100, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              36,
+              58,
+              5,
+              116,
+              111,
+              107,
+              101,
+              110,
+              34,
+              27,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              42,
+              125,
+              47,
+              116,
+              111,
+              107,
+              101,
+              110,
+              115,
+            ]),
+          ],
         },
       },
     },
-    /**
-     * Create a user.
-     * When Disallow Signup is enabled, only the caller with bb.users.create on the workspace can create a user.
-     * Otherwise, any unauthenticated user can create a user.
-     */
-    createUser: {
-      name: "CreateUser",
-      requestType: CreateUserRequest,
+    listServiceAccountTokens: {
+      name: "ListServiceAccountTokens",
+      requestType: ListServiceAccountTokensRequest,
       requestStream: false,
-      responseType: User,
+      responseType: ListServiceAccountTokensResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([4, 117, 115, 101, 114])],
-          800000: [new Uint8Array([1])],
-          800016: [new Uint8Array([2])],
-          800024: [new Uint8Array([1])],
-          578365826: [new Uint8Array([17, 58, 4, 117, 115, 101, 114, 34, 9, 47, 118, 49, 47, 117, 115, 101, 114, 115])],
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [new Uint8Array([15, 98, 98, 46, 117, 115, 101, 114, 115, 46, 117, 112, 100, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              29,
+              18,
+              27,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              117,
+              115,
+              101,
+              114,
+              115,
+              47,
+              42,
+              125,
+              47,
+              116,
+              111,
+              107,
+              101,
+              110,
+              115,
+            ]),
+          ],
         },
       },
     },
-    /** Only the user itself and the user with bb.users.update permission on the workspace can update the user. */
-    updateUser: {
-      name: "UpdateUser",
-      requestType: UpdateUserRequest,
+    /** Revoke a scoped token of the service account. */
+    deleteServiceAccountToken: {
+      name: "DeleteServiceAccountToken",
+      requestType: DeleteServiceAccountTokenRequest,
       requestStream: false,
-      responseType: User,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([16, 117, 115, 101, 114, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
-          800016: [new Uint8Array([2])],
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([15, 98, 98, 46, 117, 115, 101, 114, 115, 46, 117, 112, 100, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
           800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              31,
-              58,
-              4,
-              117,
-              115,
-              101,
-              114,
-              50,
-              23,
+              29,
+              42,
+              27,
               47,
               118,
               49,
               47,
               123,
-              117,
-              115,
-              101,
-              114,
-              46,
               110,
               97,
               109,
@@ -1571,6 +2687,15 @@ export const AuthServiceDefinition = {
               115,
               47,
               42,
+              47,
+              116,
+              111,
+              107,
+              101,
+              110,
+              115,
+              47,
+              42,
               125,
             ]),
           ],
@@ -1578,14 +2703,14 @@ export const AuthServiceDefinition = {
       },
     },
     /**
-     * Only the user with bb.users.delete permission on the workspace can delete the user.
-     * The last remaining workspace admin cannot be deleted.
+     * Get the notification setting of the user.
+     * Only the user itself can get the notification setting.
      */
-    deleteUser: {
-      name: "DeleteUser",
-      requestType: DeleteUserRequest,
+    getNotificationSetting: {
+      name: "GetNotificationSetting",
+      requestType: GetNotificationSettingRequest,
       requestStream: false,
-      responseType: Empty,
+      responseType: NotificationSetting,
       responseStream: false,
       options: {
         _unknownFields: {
@@ -1593,9 +2718,9 @@ export const AuthServiceDefinition = {
           800016: [new Uint8Array([2])],
           578365826: [
             new Uint8Array([
-              20,
-              42,
+              40,
               18,
+              38,
               47,
               118,
               49,
@@ -1613,36 +2738,137 @@ export const AuthServiceDefinition = {
               115,
               47,
               42,
+              47,
+              110,
+              111,
+              116,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              83,
+              101,
+              116,
+              116,
+              105,
+              110,
+              103,
               125,
             ]),
           ],
         },
       },
     },
-    /** Only the user with bb.users.undelete permission on the workspace can undelete the user. */
-    undeleteUser: {
-      name: "UndeleteUser",
-      requestType: UndeleteUserRequest,
+    /**
+     * Update the notification setting of the user.
+     * Only the user itself can update the notification setting.
+     */
+    updateNotificationSetting: {
+      name: "UpdateNotificationSetting",
+      requestType: UpdateNotificationSettingRequest,
       requestStream: false,
-      responseType: User,
+      responseType: NotificationSetting,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [
+            new Uint8Array([
+              32,
+              110,
+              111,
+              116,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              95,
+              115,
+              101,
+              116,
+              116,
+              105,
+              110,
+              103,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
           800016: [new Uint8Array([2])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              32,
+              83,
               58,
-              1,
-              42,
-              34,
-              27,
+              20,
+              110,
+              111,
+              116,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              95,
+              115,
+              101,
+              116,
+              116,
+              105,
+              110,
+              103,
+              50,
+              59,
               47,
               118,
               49,
               47,
               123,
               110,
+              111,
+              116,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              95,
+              115,
+              101,
+              116,
+              116,
+              105,
+              110,
+              103,
+              46,
+              110,
               97,
               109,
               101,
@@ -1654,16 +2880,27 @@ export const AuthServiceDefinition = {
               115,
               47,
               42,
-              125,
-              58,
-              117,
+              47,
               110,
-              100,
-              101,
-              108,
-              101,
+              111,
+              116,
+              105,
+              102,
+              105,
+              99,
+              97,
               116,
+              105,
+              111,
+              110,
+              83,
               101,
+              116,
+              116,
+              105,
+              110,
+              103,
+              125,
             ]),
           ],
         },
@@ -1733,6 +2970,32 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
@@ -6,6 +6,7 @@ import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 import { Engine, engineFromJSON, engineToJSON, engineToNumber } from "./common";
 import { DatabaseMetadata } from "./database_service";
+import { Issue } from "./issue_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -312,7 +313,59 @@ export interface DiffDatabaseResponse {
    * ====
    * >>>>> main
    */
-  conflictSchema?: string | undefined;
+  conflictSchema?:
+    | string
+    | undefined;
+  /** The conflicts at table and column granularity. */
+  conflicts: MergeConflict[];
+  /** The merged metadata if there is no conflict. */
+  mergedMetadata: DatabaseMetadata | undefined;
+}
+
+export interface MergeConflict {
+  /** The schema name. */
+  schema: string;
+  /**
+   * The name of the table, view, function or procedure.
+   * Empty for schema-level conflicts.
+   */
+  table: string;
+  /**
+   * The column name.
+   * Empty for table-level conflicts.
+   */
+  column: string;
+  /** The description of the conflict. */
+  description: string;
+}
+
+export interface MergeBranchToDatabaseRequest {
+  /**
+   * The name of branch.
+   * Format: projects/{project}/branches/{branch}
+   */
+  name: string;
+  /**
+   * The name of the database to merge the branch to.
+   * Format: instances/{instance}/databases/{database}
+   */
+  database: string;
+  /** The title of the created issue. If empty, it's generated from the branch. */
+  title: string;
+}
+
+export interface MergeBranchToDatabaseResponse {
+  /**
+   * The created issue applying the diff to the database.
+   * Not set if there are conflicts or the database is already up to date.
+   */
+  issue:
+    | Issue
+    | undefined;
+  /** The conflicts at table and column granularity. */
+  conflicts: MergeConflict[];
+  /** The DDL diff from the database schema to the merged schema. */
+  diff: string;
 }
 
 export interface DiffMetadataRequest {
@@ -1506,7 +1559,7 @@ export const DiffDatabaseRequest = {
 };
 
 function createBaseDiffDatabaseResponse(): DiffDatabaseResponse {
-  return { diff: "", schema: undefined, conflictSchema: undefined };
+  return { diff: "", schema: undefined, conflictSchema: undefined, conflicts: [], mergedMetadata: undefined };
 }
 
 export const DiffDatabaseResponse = {
@@ -1520,6 +1573,12 @@ export const DiffDatabaseResponse = {
     if (message.conflictSchema !== undefined) {
       writer.uint32(26).string(message.conflictSchema);
     }
+    for (const v of message.conflicts) {
+      MergeConflict.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.mergedMetadata !== undefined) {
+      DatabaseMetadata.encode(message.mergedMetadata, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1551,6 +1610,20 @@ export const DiffDatabaseResponse = {
 
           message.conflictSchema = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.conflicts.push(MergeConflict.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.mergedMetadata = DatabaseMetadata.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1565,6 +1638,10 @@ export const DiffDatabaseResponse = {
       diff: isSet(object.diff) ? globalThis.String(object.diff) : "",
       schema: isSet(object.schema) ? globalThis.String(object.schema) : undefined,
       conflictSchema: isSet(object.conflictSchema) ? globalThis.String(object.conflictSchema) : undefined,
+      conflicts: globalThis.Array.isArray(object?.conflicts)
+        ? object.conflicts.map((e: any) => MergeConflict.fromJSON(e))
+        : [],
+      mergedMetadata: isSet(object.mergedMetadata) ? DatabaseMetadata.fromJSON(object.mergedMetadata) : undefined,
     };
   },
 
@@ -1579,6 +1656,12 @@ export const DiffDatabaseResponse = {
     if (message.conflictSchema !== undefined) {
       obj.conflictSchema = message.conflictSchema;
     }
+    if (message.conflicts?.length) {
+      obj.conflicts = message.conflicts.map((e) => MergeConflict.toJSON(e));
+    }
+    if (message.mergedMetadata !== undefined) {
+      obj.mergedMetadata = DatabaseMetadata.toJSON(message.mergedMetadata);
+    }
     return obj;
   },
 
@@ -1590,6 +1673,294 @@ export const DiffDatabaseResponse = {
     message.diff = object.diff ?? "";
     message.schema = object.schema ?? undefined;
     message.conflictSchema = object.conflictSchema ?? undefined;
+    message.conflicts = object.conflicts?.map((e) => MergeConflict.fromPartial(e)) || [];
+    message.mergedMetadata = (object.mergedMetadata !== undefined && object.mergedMetadata !== null)
+      ? DatabaseMetadata.fromPartial(object.mergedMetadata)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseMergeConflict(): MergeConflict {
+  return { schema: "", table: "", column: "", description: "" };
+}
+
+export const MergeConflict = {
+  encode(message: MergeConflict, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    if (message.column !== "") {
+      writer.uint32(26).string(message.column);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MergeConflict {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMergeConflict();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.column = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MergeConflict {
+    return {
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      column: isSet(object.column) ? globalThis.String(object.column) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+    };
+  },
+
+  toJSON(message: MergeConflict): unknown {
+    const obj: any = {};
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.column !== "") {
+      obj.column = message.column;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MergeConflict>): MergeConflict {
+    return MergeConflict.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<MergeConflict>): MergeConflict {
+    const message = createBaseMergeConflict();
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.column = object.column ?? "";
+    message.description = object.description ?? "";
+    return message;
+  },
+};
+
+function createBaseMergeBranchToDatabaseRequest(): MergeBranchToDatabaseRequest {
+  return { name: "", database: "", title: "" };
+}
+
+export const MergeBranchToDatabaseRequest = {
+  encode(message: MergeBranchToDatabaseRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MergeBranchToDatabaseRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMergeBranchToDatabaseRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MergeBranchToDatabaseRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+    };
+  },
+
+  toJSON(message: MergeBranchToDatabaseRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MergeBranchToDatabaseRequest>): MergeBranchToDatabaseRequest {
+    return MergeBranchToDatabaseRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<MergeBranchToDatabaseRequest>): MergeBranchToDatabaseRequest {
+    const message = createBaseMergeBranchToDatabaseRequest();
+    message.name = object.name ?? "";
+    message.database = object.database ?? "";
+    message.title = object.title ?? "";
+    return message;
+  },
+};
+
+function createBaseMergeBranchToDatabaseResponse(): MergeBranchToDatabaseResponse {
+  return { issue: undefined, conflicts: [], diff: "" };
+}
+
+export const MergeBranchToDatabaseResponse = {
+  encode(message: MergeBranchToDatabaseResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.issue !== undefined) {
+      Issue.encode(message.issue, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.conflicts) {
+      MergeConflict.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.diff !== "") {
+      writer.uint32(26).string(message.diff);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MergeBranchToDatabaseResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMergeBranchToDatabaseResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.issue = Issue.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.conflicts.push(MergeConflict.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.diff = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MergeBranchToDatabaseResponse {
+    return {
+      issue: isSet(object.issue) ? Issue.fromJSON(object.issue) : undefined,
+      conflicts: globalThis.Array.isArray(object?.conflicts)
+        ? object.conflicts.map((e: any) => MergeConflict.fromJSON(e))
+        : [],
+      diff: isSet(object.diff) ? globalThis.String(object.diff) : "",
+    };
+  },
+
+  toJSON(message: MergeBranchToDatabaseResponse): unknown {
+    const obj: any = {};
+    if (message.issue !== undefined) {
+      obj.issue = Issue.toJSON(message.issue);
+    }
+    if (message.conflicts?.length) {
+      obj.conflicts = message.conflicts.map((e) => MergeConflict.toJSON(e));
+    }
+    if (message.diff !== "") {
+      obj.diff = message.diff;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MergeBranchToDatabaseResponse>): MergeBranchToDatabaseResponse {
+    return MergeBranchToDatabaseResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<MergeBranchToDatabaseResponse>): MergeBranchToDatabaseResponse {
+    const message = createBaseMergeBranchToDatabaseResponse();
+    message.issue = (object.issue !== undefined && object.issue !== null) ? Issue.fromPartial(object.issue) : undefined;
+    message.conflicts = object.conflicts?.map((e) => MergeConflict.fromPartial(e)) || [];
+    message.diff = object.diff ?? "";
     return message;
   },
 };
@@ -2264,6 +2635,83 @@ export const BranchServiceDefinition = {
         },
       },
     },
+    /**
+     * MergeBranchToDatabase merges the branch to a database in three ways between the baseline and head of the branch and
+     * the live schema of the database, and opens the issue applying the merged schema to the database.
+     * No issue is created if there are conflicts.
+     */
+    mergeBranchToDatabase: {
+      name: "MergeBranchToDatabase",
+      requestType: MergeBranchToDatabaseRequest,
+      requestStream: false,
+      responseType: MergeBranchToDatabaseResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([16, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 99, 114, 101, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              53,
+              58,
+              1,
+              42,
+              34,
+              48,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              98,
+              114,
+              97,
+              110,
+              99,
+              104,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              109,
+              101,
+              114,
+              103,
+              101,
+              84,
+              111,
+              68,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
     diffMetadata: {
       name: "DiffMetadata",
       requestType: DiffMetadataRequest,
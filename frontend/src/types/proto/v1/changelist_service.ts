@@ -4,6 +4,7 @@ import _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
+import { Issue } from "./issue_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -90,6 +91,54 @@ export interface DeleteChangelistRequest {
   name: string;
 }
 
+export interface PromoteChangelistRequest {
+  /**
+   * The name of the changelist to promote.
+   * Format: projects/{project}/changelists/{changelist}
+   */
+  name: string;
+  /**
+   * The issue which has rolled out the changelist successfully, e.g. to the staging environment.
+   * Format: projects/{project}/issues/{issue}
+   */
+  sourceIssue: string;
+  /**
+   * The targets to promote the changelist to.
+   * Format: instances/{instance-id}/databases/{database-name}.
+   * Format: projects/{project}/databaseGroups/{databaseGroup}.
+   */
+  targets: string[];
+  /** The title of the created issue. If empty, it's generated from the changelist. */
+  title: string;
+}
+
+export interface PromoteChangelistResponse {
+  /** The created issue. */
+  issue:
+    | Issue
+    | undefined;
+  /**
+   * The baseline diffs of the target databases whose schema differs from the source database before the source rollout.
+   * Database group targets are not compared.
+   */
+  baselineDiffs: PromoteChangelistResponse_BaselineDiff[];
+}
+
+export interface PromoteChangelistResponse_BaselineDiff {
+  /**
+   * The source database rolled out by the source issue.
+   * Format: instances/{instance}/databases/{database}
+   */
+  sourceDatabase: string;
+  /**
+   * The target database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  targetDatabase: string;
+  /** The schema diff from the source database schema before the source rollout to the target database schema. */
+  diff: string;
+}
+
 export interface Changelist {
   /**
    * The name of the changelist resource.
@@ -577,6 +626,276 @@ export const DeleteChangelistRequest = {
   },
 };
 
+function createBasePromoteChangelistRequest(): PromoteChangelistRequest {
+  return { name: "", sourceIssue: "", targets: [], title: "" };
+}
+
+export const PromoteChangelistRequest = {
+  encode(message: PromoteChangelistRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.sourceIssue !== "") {
+      writer.uint32(18).string(message.sourceIssue);
+    }
+    for (const v of message.targets) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.title !== "") {
+      writer.uint32(34).string(message.title);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PromoteChangelistRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePromoteChangelistRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.sourceIssue = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.targets.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PromoteChangelistRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      sourceIssue: isSet(object.sourceIssue) ? globalThis.String(object.sourceIssue) : "",
+      targets: globalThis.Array.isArray(object?.targets) ? object.targets.map((e: any) => globalThis.String(e)) : [],
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+    };
+  },
+
+  toJSON(message: PromoteChangelistRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.sourceIssue !== "") {
+      obj.sourceIssue = message.sourceIssue;
+    }
+    if (message.targets?.length) {
+      obj.targets = message.targets;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PromoteChangelistRequest>): PromoteChangelistRequest {
+    return PromoteChangelistRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PromoteChangelistRequest>): PromoteChangelistRequest {
+    const message = createBasePromoteChangelistRequest();
+    message.name = object.name ?? "";
+    message.sourceIssue = object.sourceIssue ?? "";
+    message.targets = object.targets?.map((e) => e) || [];
+    message.title = object.title ?? "";
+    return message;
+  },
+};
+
+function createBasePromoteChangelistResponse(): PromoteChangelistResponse {
+  return { issue: undefined, baselineDiffs: [] };
+}
+
+export const PromoteChangelistResponse = {
+  encode(message: PromoteChangelistResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.issue !== undefined) {
+      Issue.encode(message.issue, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.baselineDiffs) {
+      PromoteChangelistResponse_BaselineDiff.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PromoteChangelistResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePromoteChangelistResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.issue = Issue.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.baselineDiffs.push(PromoteChangelistResponse_BaselineDiff.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PromoteChangelistResponse {
+    return {
+      issue: isSet(object.issue) ? Issue.fromJSON(object.issue) : undefined,
+      baselineDiffs: globalThis.Array.isArray(object?.baselineDiffs)
+        ? object.baselineDiffs.map((e: any) => PromoteChangelistResponse_BaselineDiff.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: PromoteChangelistResponse): unknown {
+    const obj: any = {};
+    if (message.issue !== undefined) {
+      obj.issue = Issue.toJSON(message.issue);
+    }
+    if (message.baselineDiffs?.length) {
+      obj.baselineDiffs = message.baselineDiffs.map((e) => PromoteChangelistResponse_BaselineDiff.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PromoteChangelistResponse>): PromoteChangelistResponse {
+    return PromoteChangelistResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PromoteChangelistResponse>): PromoteChangelistResponse {
+    const message = createBasePromoteChangelistResponse();
+    message.issue = (object.issue !== undefined && object.issue !== null) ? Issue.fromPartial(object.issue) : undefined;
+    message.baselineDiffs = object.baselineDiffs?.map((e) => PromoteChangelistResponse_BaselineDiff.fromPartial(e)) ||
+      [];
+    return message;
+  },
+};
+
+function createBasePromoteChangelistResponse_BaselineDiff(): PromoteChangelistResponse_BaselineDiff {
+  return { sourceDatabase: "", targetDatabase: "", diff: "" };
+}
+
+export const PromoteChangelistResponse_BaselineDiff = {
+  encode(message: PromoteChangelistResponse_BaselineDiff, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.sourceDatabase !== "") {
+      writer.uint32(10).string(message.sourceDatabase);
+    }
+    if (message.targetDatabase !== "") {
+      writer.uint32(18).string(message.targetDatabase);
+    }
+    if (message.diff !== "") {
+      writer.uint32(26).string(message.diff);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PromoteChangelistResponse_BaselineDiff {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePromoteChangelistResponse_BaselineDiff();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.sourceDatabase = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.targetDatabase = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.diff = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PromoteChangelistResponse_BaselineDiff {
+    return {
+      sourceDatabase: isSet(object.sourceDatabase) ? globalThis.String(object.sourceDatabase) : "",
+      targetDatabase: isSet(object.targetDatabase) ? globalThis.String(object.targetDatabase) : "",
+      diff: isSet(object.diff) ? globalThis.String(object.diff) : "",
+    };
+  },
+
+  toJSON(message: PromoteChangelistResponse_BaselineDiff): unknown {
+    const obj: any = {};
+    if (message.sourceDatabase !== "") {
+      obj.sourceDatabase = message.sourceDatabase;
+    }
+    if (message.targetDatabase !== "") {
+      obj.targetDatabase = message.targetDatabase;
+    }
+    if (message.diff !== "") {
+      obj.diff = message.diff;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PromoteChangelistResponse_BaselineDiff>): PromoteChangelistResponse_BaselineDiff {
+    return PromoteChangelistResponse_BaselineDiff.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PromoteChangelistResponse_BaselineDiff>): PromoteChangelistResponse_BaselineDiff {
+    const message = createBasePromoteChangelistResponse_BaselineDiff();
+    message.sourceDatabase = object.sourceDatabase ?? "";
+    message.targetDatabase = object.targetDatabase ?? "";
+    message.diff = object.diff ?? "";
+    return message;
+  },
+};
+
 function createBaseChangelist(): Changelist {
   return {
     name: "",
@@ -1271,6 +1590,77 @@ export const ChangelistServiceDefinition = {
         },
       },
     },
+    /**
+     * PromoteChangelist creates the plan and issue rolling out the changelist to the targets
+     * with the exact sheets rolled out by the source issue.
+     */
+    promoteChangelist: {
+      name: "PromoteChangelist",
+      requestType: PromoteChangelistRequest,
+      requestStream: false,
+      responseType: PromoteChangelistResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([16, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 99, 114, 101, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              48,
+              58,
+              1,
+              42,
+              34,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              108,
+              105,
+              115,
+              116,
+              115,
+              47,
+              42,
+              125,
+              58,
+              112,
+              114,
+              111,
+              109,
+              111,
+              116,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -83,6 +83,7 @@ export enum Engine {
   BIGQUERY = "BIGQUERY",
   DYNAMODB = "DYNAMODB",
   DATABRICKS = "DATABRICKS",
+  DUCKDB = "DUCKDB",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -163,6 +164,9 @@ export function engineFromJSON(object: any): Engine {
     case 24:
     case "DATABRICKS":
       return Engine.DATABRICKS;
+    case 25:
+    case "DUCKDB":
+      return Engine.DUCKDB;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -222,6 +226,8 @@ export function engineToJSON(object: Engine): string {
       return "DYNAMODB";
     case Engine.DATABRICKS:
       return "DATABRICKS";
+    case Engine.DUCKDB:
+      return "DUCKDB";
     case Engine.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -280,6 +286,8 @@ export function engineToNumber(object: Engine): number {
       return 23;
     case Engine.DATABRICKS:
       return 24;
+    case Engine.DUCKDB:
+      return 25;
     case Engine.UNRECOGNIZED:
     default:
       return -1;
@@ -489,6 +497,126 @@ export function exportFormatToNumber(object: ExportFormat): number {
   }
 }
 
+/** DataLoadFormat is the format of the files loaded by the data load task. */
+export enum DataLoadFormat {
+  DATA_LOAD_FORMAT_UNSPECIFIED = "DATA_LOAD_FORMAT_UNSPECIFIED",
+  DATA_LOAD_FORMAT_CSV = "DATA_LOAD_FORMAT_CSV",
+  DATA_LOAD_FORMAT_PARQUET = "DATA_LOAD_FORMAT_PARQUET",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function dataLoadFormatFromJSON(object: any): DataLoadFormat {
+  switch (object) {
+    case 0:
+    case "DATA_LOAD_FORMAT_UNSPECIFIED":
+      return DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED;
+    case 1:
+    case "DATA_LOAD_FORMAT_CSV":
+      return DataLoadFormat.DATA_LOAD_FORMAT_CSV;
+    case 2:
+    case "DATA_LOAD_FORMAT_PARQUET":
+      return DataLoadFormat.DATA_LOAD_FORMAT_PARQUET;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DataLoadFormat.UNRECOGNIZED;
+  }
+}
+
+export function dataLoadFormatToJSON(object: DataLoadFormat): string {
+  switch (object) {
+    case DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED:
+      return "DATA_LOAD_FORMAT_UNSPECIFIED";
+    case DataLoadFormat.DATA_LOAD_FORMAT_CSV:
+      return "DATA_LOAD_FORMAT_CSV";
+    case DataLoadFormat.DATA_LOAD_FORMAT_PARQUET:
+      return "DATA_LOAD_FORMAT_PARQUET";
+    case DataLoadFormat.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function dataLoadFormatToNumber(object: DataLoadFormat): number {
+  switch (object) {
+    case DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED:
+      return 0;
+    case DataLoadFormat.DATA_LOAD_FORMAT_CSV:
+      return 1;
+    case DataLoadFormat.DATA_LOAD_FORMAT_PARQUET:
+      return 2;
+    case DataLoadFormat.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** TransactionMode is how the statements of a change task are run in transactions. */
+export enum TransactionMode {
+  /** TRANSACTION_MODE_UNSPECIFIED - The default behavior of the engine driver. */
+  TRANSACTION_MODE_UNSPECIFIED = "TRANSACTION_MODE_UNSPECIFIED",
+  /** TRANSACTION_MODE_SINGLE - All statements are run in a single transaction, and rolled back together on failure. */
+  TRANSACTION_MODE_SINGLE = "TRANSACTION_MODE_SINGLE",
+  /** TRANSACTION_MODE_AUTOCOMMIT - Each statement is committed on its own, the statements run before the failed one are kept. */
+  TRANSACTION_MODE_AUTOCOMMIT = "TRANSACTION_MODE_AUTOCOMMIT",
+  /** TRANSACTION_MODE_MANUAL - The statements are run as is, and the transactions are controlled by the BEGIN and COMMIT statements in the sheet. */
+  TRANSACTION_MODE_MANUAL = "TRANSACTION_MODE_MANUAL",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function transactionModeFromJSON(object: any): TransactionMode {
+  switch (object) {
+    case 0:
+    case "TRANSACTION_MODE_UNSPECIFIED":
+      return TransactionMode.TRANSACTION_MODE_UNSPECIFIED;
+    case 1:
+    case "TRANSACTION_MODE_SINGLE":
+      return TransactionMode.TRANSACTION_MODE_SINGLE;
+    case 2:
+    case "TRANSACTION_MODE_AUTOCOMMIT":
+      return TransactionMode.TRANSACTION_MODE_AUTOCOMMIT;
+    case 3:
+    case "TRANSACTION_MODE_MANUAL":
+      return TransactionMode.TRANSACTION_MODE_MANUAL;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TransactionMode.UNRECOGNIZED;
+  }
+}
+
+export function transactionModeToJSON(object: TransactionMode): string {
+  switch (object) {
+    case TransactionMode.TRANSACTION_MODE_UNSPECIFIED:
+      return "TRANSACTION_MODE_UNSPECIFIED";
+    case TransactionMode.TRANSACTION_MODE_SINGLE:
+      return "TRANSACTION_MODE_SINGLE";
+    case TransactionMode.TRANSACTION_MODE_AUTOCOMMIT:
+      return "TRANSACTION_MODE_AUTOCOMMIT";
+    case TransactionMode.TRANSACTION_MODE_MANUAL:
+      return "TRANSACTION_MODE_MANUAL";
+    case TransactionMode.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function transactionModeToNumber(object: TransactionMode): number {
+  switch (object) {
+    case TransactionMode.TRANSACTION_MODE_UNSPECIFIED:
+      return 0;
+    case TransactionMode.TRANSACTION_MODE_SINGLE:
+      return 1;
+    case TransactionMode.TRANSACTION_MODE_AUTOCOMMIT:
+      return 2;
+    case TransactionMode.TRANSACTION_MODE_MANUAL:
+      return 3;
+    case TransactionMode.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface Position {
   line: number;
   column: number;
@@ -3,6 +3,7 @@ import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
 import { Expr } from "../google/type/expr";
 
 export const protobufPackage = "bytebase.v1";
@@ -183,6 +184,60 @@ export interface DatabaseGroup_Database {
   name: string;
 }
 
+export interface PreviewMatchedDatabasesRequest {
+  /**
+   * The project of the databases.
+   * Format: projects/{project}
+   */
+  parent: string;
+  /** The database group condition to preview. */
+  databaseExpr: Expr | undefined;
+}
+
+export interface PreviewMatchedDatabasesResponse {
+  /** The list of databases that match the condition. */
+  matchedDatabases: DatabaseGroup_Database[];
+  /** The list of databases that don't match the condition. */
+  unmatchedDatabases: DatabaseGroup_Database[];
+  /** The database label keys referenced by the condition. */
+  labelKeys: string[];
+  /**
+   * The referenced label keys that are not set on any database in the project.
+   * The database group cannot be saved with unknown label keys.
+   */
+  unknownLabelKeys: string[];
+}
+
+export interface ListDatabaseGroupRevisionsRequest {
+  /**
+   * The parent database group.
+   * Format: projects/{project}/databaseGroups/{databaseGroup}
+   */
+  parent: string;
+}
+
+export interface ListDatabaseGroupRevisionsResponse {
+  /** The revisions of the database group, the newest first. */
+  revisions: DatabaseGroupRevision[];
+}
+
+export interface DatabaseGroupRevision {
+  /** Format: projects/{project}/databaseGroups/{databaseGroup}/revisions/{revision} */
+  name: string;
+  /** The database placeholder in the revision. */
+  databasePlaceholder: string;
+  /** The condition in the revision. */
+  databaseExpr: Expr | undefined;
+  multitenancy: boolean;
+  /**
+   * The author of the revision.
+   * Format: users/{email}
+   */
+  creator: string;
+  /** The time the revision was saved. */
+  createTime: Date | undefined;
+}
+
 function createBaseListDatabaseGroupsRequest(): ListDatabaseGroupsRequest {
   return { parent: "", pageSize: 0, pageToken: "" };
 }
@@ -867,235 +922,684 @@ export const DatabaseGroup_Database = {
   },
 };
 
-export type DatabaseGroupServiceDefinition = typeof DatabaseGroupServiceDefinition;
-export const DatabaseGroupServiceDefinition = {
-  name: "DatabaseGroupService",
-  fullName: "bytebase.v1.DatabaseGroupService",
-  methods: {
-    listDatabaseGroups: {
-      name: "ListDatabaseGroups",
-      requestType: ListDatabaseGroupsRequest,
-      requestStream: false,
-      responseType: ListDatabaseGroupsResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
-          800010: [new Uint8Array([15, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 103, 101, 116])],
-          800016: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([
-              40,
-              18,
-              38,
-              47,
-              118,
-              49,
-              47,
-              123,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              61,
-              112,
-              114,
-              111,
-              106,
-              101,
-              99,
-              116,
-              115,
-              47,
-              42,
-              125,
-              47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              71,
-              114,
-              111,
-              117,
-              112,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    getDatabaseGroup: {
-      name: "GetDatabaseGroup",
-      requestType: GetDatabaseGroupRequest,
-      requestStream: false,
-      responseType: DatabaseGroup,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([4, 110, 97, 109, 101])],
-          800010: [new Uint8Array([15, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 103, 101, 116])],
-          800016: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([
-              40,
-              18,
-              38,
-              47,
-              118,
-              49,
-              47,
-              123,
-              110,
-              97,
-              109,
-              101,
-              61,
-              112,
-              114,
-              111,
-              106,
-              101,
-              99,
-              116,
-              115,
-              47,
-              42,
-              47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              71,
-              114,
-              111,
-              117,
-              112,
-              115,
-              47,
-              42,
-              125,
-            ]),
-          ],
-        },
-      },
-    },
-    createDatabaseGroup: {
-      name: "CreateDatabaseGroup",
-      requestType: CreateDatabaseGroupRequest,
-      requestStream: false,
-      responseType: DatabaseGroup,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [
-            new Uint8Array([
-              20,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              44,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              71,
-              114,
-              111,
-              117,
-              112,
-            ]),
-          ],
-          800010: [
-            new Uint8Array([18, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 117, 112, 100, 97, 116, 101]),
-          ],
-          800016: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([
-              56,
-              58,
-              14,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              95,
-              103,
-              114,
-              111,
-              117,
-              112,
-              34,
-              38,
-              47,
-              118,
-              49,
-              47,
-              123,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              61,
-              112,
-              114,
-              111,
-              106,
-              101,
-              99,
-              116,
-              115,
-              47,
-              42,
-              125,
-              47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              71,
-              114,
-              111,
-              117,
-              112,
-              115,
-            ]),
-          ],
-        },
-      },
-    },
-    updateDatabaseGroup: {
-      name: "UpdateDatabaseGroup",
-      requestType: UpdateDatabaseGroupRequest,
-      requestStream: false,
-      responseType: DatabaseGroup,
+function createBasePreviewMatchedDatabasesRequest(): PreviewMatchedDatabasesRequest {
+  return { parent: "", databaseExpr: undefined };
+}
+
+export const PreviewMatchedDatabasesRequest = {
+  encode(message: PreviewMatchedDatabasesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.databaseExpr !== undefined) {
+      Expr.encode(message.databaseExpr, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PreviewMatchedDatabasesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePreviewMatchedDatabasesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.databaseExpr = Expr.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PreviewMatchedDatabasesRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      databaseExpr: isSet(object.databaseExpr) ? Expr.fromJSON(object.databaseExpr) : undefined,
+    };
+  },
+
+  toJSON(message: PreviewMatchedDatabasesRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.databaseExpr !== undefined) {
+      obj.databaseExpr = Expr.toJSON(message.databaseExpr);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PreviewMatchedDatabasesRequest>): PreviewMatchedDatabasesRequest {
+    return PreviewMatchedDatabasesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PreviewMatchedDatabasesRequest>): PreviewMatchedDatabasesRequest {
+    const message = createBasePreviewMatchedDatabasesRequest();
+    message.parent = object.parent ?? "";
+    message.databaseExpr = (object.databaseExpr !== undefined && object.databaseExpr !== null)
+      ? Expr.fromPartial(object.databaseExpr)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePreviewMatchedDatabasesResponse(): PreviewMatchedDatabasesResponse {
+  return { matchedDatabases: [], unmatchedDatabases: [], labelKeys: [], unknownLabelKeys: [] };
+}
+
+export const PreviewMatchedDatabasesResponse = {
+  encode(message: PreviewMatchedDatabasesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.matchedDatabases) {
+      DatabaseGroup_Database.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.unmatchedDatabases) {
+      DatabaseGroup_Database.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.labelKeys) {
+      writer.uint32(26).string(v!);
+    }
+    for (const v of message.unknownLabelKeys) {
+      writer.uint32(34).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PreviewMatchedDatabasesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePreviewMatchedDatabasesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.matchedDatabases.push(DatabaseGroup_Database.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.unmatchedDatabases.push(DatabaseGroup_Database.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.labelKeys.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.unknownLabelKeys.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PreviewMatchedDatabasesResponse {
+    return {
+      matchedDatabases: globalThis.Array.isArray(object?.matchedDatabases)
+        ? object.matchedDatabases.map((e: any) => DatabaseGroup_Database.fromJSON(e))
+        : [],
+      unmatchedDatabases: globalThis.Array.isArray(object?.unmatchedDatabases)
+        ? object.unmatchedDatabases.map((e: any) => DatabaseGroup_Database.fromJSON(e))
+        : [],
+      labelKeys: globalThis.Array.isArray(object?.labelKeys)
+        ? object.labelKeys.map((e: any) => globalThis.String(e))
+        : [],
+      unknownLabelKeys: globalThis.Array.isArray(object?.unknownLabelKeys)
+        ? object.unknownLabelKeys.map((e: any) => globalThis.String(e))
+        : [],
+    };
+  },
+
+  toJSON(message: PreviewMatchedDatabasesResponse): unknown {
+    const obj: any = {};
+    if (message.matchedDatabases?.length) {
+      obj.matchedDatabases = message.matchedDatabases.map((e) => DatabaseGroup_Database.toJSON(e));
+    }
+    if (message.unmatchedDatabases?.length) {
+      obj.unmatchedDatabases = message.unmatchedDatabases.map((e) => DatabaseGroup_Database.toJSON(e));
+    }
+    if (message.labelKeys?.length) {
+      obj.labelKeys = message.labelKeys;
+    }
+    if (message.unknownLabelKeys?.length) {
+      obj.unknownLabelKeys = message.unknownLabelKeys;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PreviewMatchedDatabasesResponse>): PreviewMatchedDatabasesResponse {
+    return PreviewMatchedDatabasesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PreviewMatchedDatabasesResponse>): PreviewMatchedDatabasesResponse {
+    const message = createBasePreviewMatchedDatabasesResponse();
+    message.matchedDatabases = object.matchedDatabases?.map((e) => DatabaseGroup_Database.fromPartial(e)) || [];
+    message.unmatchedDatabases = object.unmatchedDatabases?.map((e) => DatabaseGroup_Database.fromPartial(e)) || [];
+    message.labelKeys = object.labelKeys?.map((e) => e) || [];
+    message.unknownLabelKeys = object.unknownLabelKeys?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListDatabaseGroupRevisionsRequest(): ListDatabaseGroupRevisionsRequest {
+  return { parent: "" };
+}
+
+export const ListDatabaseGroupRevisionsRequest = {
+  encode(message: ListDatabaseGroupRevisionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListDatabaseGroupRevisionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListDatabaseGroupRevisionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListDatabaseGroupRevisionsRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: ListDatabaseGroupRevisionsRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListDatabaseGroupRevisionsRequest>): ListDatabaseGroupRevisionsRequest {
+    return ListDatabaseGroupRevisionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListDatabaseGroupRevisionsRequest>): ListDatabaseGroupRevisionsRequest {
+    const message = createBaseListDatabaseGroupRevisionsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListDatabaseGroupRevisionsResponse(): ListDatabaseGroupRevisionsResponse {
+  return { revisions: [] };
+}
+
+export const ListDatabaseGroupRevisionsResponse = {
+  encode(message: ListDatabaseGroupRevisionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.revisions) {
+      DatabaseGroupRevision.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListDatabaseGroupRevisionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListDatabaseGroupRevisionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.revisions.push(DatabaseGroupRevision.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListDatabaseGroupRevisionsResponse {
+    return {
+      revisions: globalThis.Array.isArray(object?.revisions)
+        ? object.revisions.map((e: any) => DatabaseGroupRevision.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListDatabaseGroupRevisionsResponse): unknown {
+    const obj: any = {};
+    if (message.revisions?.length) {
+      obj.revisions = message.revisions.map((e) => DatabaseGroupRevision.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListDatabaseGroupRevisionsResponse>): ListDatabaseGroupRevisionsResponse {
+    return ListDatabaseGroupRevisionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListDatabaseGroupRevisionsResponse>): ListDatabaseGroupRevisionsResponse {
+    const message = createBaseListDatabaseGroupRevisionsResponse();
+    message.revisions = object.revisions?.map((e) => DatabaseGroupRevision.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDatabaseGroupRevision(): DatabaseGroupRevision {
+  return {
+    name: "",
+    databasePlaceholder: "",
+    databaseExpr: undefined,
+    multitenancy: false,
+    creator: "",
+    createTime: undefined,
+  };
+}
+
+export const DatabaseGroupRevision = {
+  encode(message: DatabaseGroupRevision, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.databasePlaceholder !== "") {
+      writer.uint32(18).string(message.databasePlaceholder);
+    }
+    if (message.databaseExpr !== undefined) {
+      Expr.encode(message.databaseExpr, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.multitenancy === true) {
+      writer.uint32(32).bool(message.multitenancy);
+    }
+    if (message.creator !== "") {
+      writer.uint32(42).string(message.creator);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseGroupRevision {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDatabaseGroupRevision();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.databasePlaceholder = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.databaseExpr = Expr.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.multitenancy = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DatabaseGroupRevision {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      databasePlaceholder: isSet(object.databasePlaceholder) ? globalThis.String(object.databasePlaceholder) : "",
+      databaseExpr: isSet(object.databaseExpr) ? Expr.fromJSON(object.databaseExpr) : undefined,
+      multitenancy: isSet(object.multitenancy) ? globalThis.Boolean(object.multitenancy) : false,
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+    };
+  },
+
+  toJSON(message: DatabaseGroupRevision): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.databasePlaceholder !== "") {
+      obj.databasePlaceholder = message.databasePlaceholder;
+    }
+    if (message.databaseExpr !== undefined) {
+      obj.databaseExpr = Expr.toJSON(message.databaseExpr);
+    }
+    if (message.multitenancy === true) {
+      obj.multitenancy = message.multitenancy;
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DatabaseGroupRevision>): DatabaseGroupRevision {
+    return DatabaseGroupRevision.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DatabaseGroupRevision>): DatabaseGroupRevision {
+    const message = createBaseDatabaseGroupRevision();
+    message.name = object.name ?? "";
+    message.databasePlaceholder = object.databasePlaceholder ?? "";
+    message.databaseExpr = (object.databaseExpr !== undefined && object.databaseExpr !== null)
+      ? Expr.fromPartial(object.databaseExpr)
+      : undefined;
+    message.multitenancy = object.multitenancy ?? false;
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
+    return message;
+  },
+};
+
+export type DatabaseGroupServiceDefinition = typeof DatabaseGroupServiceDefinition;
+export const DatabaseGroupServiceDefinition = {
+  name: "DatabaseGroupService",
+  fullName: "bytebase.v1.DatabaseGroupService",
+  methods: {
+    listDatabaseGroups: {
+      name: "ListDatabaseGroups",
+      requestType: ListDatabaseGroupsRequest,
+      requestStream: false,
+      responseType: ListDatabaseGroupsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [new Uint8Array([15, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              40,
+              18,
+              38,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              71,
+              114,
+              111,
+              117,
+              112,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    getDatabaseGroup: {
+      name: "GetDatabaseGroup",
+      requestType: GetDatabaseGroupRequest,
+      requestStream: false,
+      responseType: DatabaseGroup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([15, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              40,
+              18,
+              38,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              71,
+              114,
+              111,
+              117,
+              112,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    createDatabaseGroup: {
+      name: "CreateDatabaseGroup",
+      requestType: CreateDatabaseGroupRequest,
+      requestStream: false,
+      responseType: DatabaseGroup,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              20,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              44,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              71,
+              114,
+              111,
+              117,
+              112,
+            ]),
+          ],
+          800010: [
+            new Uint8Array([18, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              56,
+              58,
+              14,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              95,
+              103,
+              114,
+              111,
+              117,
+              112,
+              34,
+              38,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              71,
+              114,
+              111,
+              117,
+              112,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    updateDatabaseGroup: {
+      name: "UpdateDatabaseGroup",
+      requestType: UpdateDatabaseGroupRequest,
+      requestStream: false,
+      responseType: DatabaseGroup,
       responseStream: false,
       options: {
         _unknownFields: {
@@ -1274,6 +1778,195 @@ export const DatabaseGroupServiceDefinition = {
         },
       },
     },
+    /**
+     * Previews the databases in the project matched by the database group expression,
+     * and validates the database labels referenced by the expression.
+     */
+    previewMatchedDatabases: {
+      name: "PreviewMatchedDatabases",
+      requestType: PreviewMatchedDatabasesRequest,
+      requestStream: false,
+      responseType: PreviewMatchedDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              20,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              44,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              95,
+              101,
+              120,
+              112,
+              114,
+            ]),
+          ],
+          800010: [new Uint8Array([15, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              67,
+              58,
+              1,
+              42,
+              34,
+              62,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              71,
+              114,
+              111,
+              117,
+              112,
+              115,
+              58,
+              112,
+              114,
+              101,
+              118,
+              105,
+              101,
+              119,
+              77,
+              97,
+              116,
+              99,
+              104,
+              101,
+              100,
+              68,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * List the revisions of a database group, the newest first.
+     * A revision is saved each time the database group is created or updated.
+     */
+    listDatabaseGroupRevisions: {
+      name: "ListDatabaseGroupRevisions",
+      requestType: ListDatabaseGroupRevisionsRequest,
+      requestStream: false,
+      responseType: ListDatabaseGroupRevisionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [new Uint8Array([15, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              52,
+              18,
+              50,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              71,
+              114,
+              111,
+              117,
+              112,
+              115,
+              47,
+              42,
+              125,
+              47,
+              114,
+              101,
+              118,
+              105,
+              115,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -1285,6 +1978,32 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
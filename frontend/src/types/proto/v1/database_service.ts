@@ -16,7 +16,7 @@ import {
   stateToJSON,
   stateToNumber,
 } from "./common";
-import { InstanceResource } from "./instance_service";
+import { DataSourceExternalSecret, InstanceResource } from "./instance_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -306,6 +306,260 @@ export interface SyncDatabaseRequest {
 export interface SyncDatabaseResponse {
 }
 
+export interface ColumnClassification {
+  /** The schema name, it's empty for databases without such concept such as MySQL. */
+  schema: string;
+  table: string;
+  column: string;
+  /**
+   * The classification id, e.g. 1-1-1, or the classification title in the classification config of the project.
+   * Empty means no classification.
+   */
+  classification: string;
+  /** The level id of the classification, the masking level of the column is derived from the level. */
+  level: string;
+}
+
+export interface ClassificationSuggestion {
+  /**
+   * The name of the suggestion.
+   * Format: instances/{instance}/databases/{database}/classificationSuggestions/{suggestion}
+   */
+  name: string;
+  /** The schema name, it's empty for databases without such concept such as MySQL. */
+  schema: string;
+  table: string;
+  column: string;
+  label: ClassificationSuggestion_Label;
+  /** The column name matches the label. */
+  nameMatched: boolean;
+  /** The ratio of the sampled values matching the label, from 0 to 1. */
+  dataMatchRatio: number;
+  createTime: Date | undefined;
+}
+
+export enum ClassificationSuggestion_Label {
+  LABEL_UNSPECIFIED = "LABEL_UNSPECIFIED",
+  EMAIL = "EMAIL",
+  PHONE = "PHONE",
+  NATIONAL_ID = "NATIONAL_ID",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function classificationSuggestion_LabelFromJSON(object: any): ClassificationSuggestion_Label {
+  switch (object) {
+    case 0:
+    case "LABEL_UNSPECIFIED":
+      return ClassificationSuggestion_Label.LABEL_UNSPECIFIED;
+    case 1:
+    case "EMAIL":
+      return ClassificationSuggestion_Label.EMAIL;
+    case 2:
+    case "PHONE":
+      return ClassificationSuggestion_Label.PHONE;
+    case 3:
+    case "NATIONAL_ID":
+      return ClassificationSuggestion_Label.NATIONAL_ID;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ClassificationSuggestion_Label.UNRECOGNIZED;
+  }
+}
+
+export function classificationSuggestion_LabelToJSON(object: ClassificationSuggestion_Label): string {
+  switch (object) {
+    case ClassificationSuggestion_Label.LABEL_UNSPECIFIED:
+      return "LABEL_UNSPECIFIED";
+    case ClassificationSuggestion_Label.EMAIL:
+      return "EMAIL";
+    case ClassificationSuggestion_Label.PHONE:
+      return "PHONE";
+    case ClassificationSuggestion_Label.NATIONAL_ID:
+      return "NATIONAL_ID";
+    case ClassificationSuggestion_Label.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function classificationSuggestion_LabelToNumber(object: ClassificationSuggestion_Label): number {
+  switch (object) {
+    case ClassificationSuggestion_Label.LABEL_UNSPECIFIED:
+      return 0;
+    case ClassificationSuggestion_Label.EMAIL:
+      return 1;
+    case ClassificationSuggestion_Label.PHONE:
+      return 2;
+    case ClassificationSuggestion_Label.NATIONAL_ID:
+      return 3;
+    case ClassificationSuggestion_Label.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ListClassificationSuggestionsRequest {
+  /**
+   * The parent database of the suggestions.
+   * Format: instances/{instance}/databases/{database}
+   */
+  parent: string;
+}
+
+export interface ListClassificationSuggestionsResponse {
+  suggestions: ClassificationSuggestion[];
+}
+
+export interface AcceptClassificationSuggestionRequest {
+  /**
+   * The name of the suggestion.
+   * Format: instances/{instance}/databases/{database}/classificationSuggestions/{suggestion}
+   */
+  name: string;
+  /** The masking level of the column. Empty means FULL. */
+  maskingLevel: MaskingLevel;
+}
+
+export interface ImportColumnClassificationsRequest {
+  /**
+   * The name of the database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  name: string;
+  classifications: ColumnClassification[];
+  /**
+   * The classifications in CSV format with the header "schema,table,column,classification".
+   * They are imported together with the classifications.
+   */
+  csv: string;
+  /**
+   * If true, the classifications of the columns absent from the import are cleared,
+   * so that the database is fully aligned with the data catalog.
+   */
+  replace: boolean;
+}
+
+export interface ImportColumnClassificationsResponse {
+  /** The classifications of the database after the import. */
+  classifications: ColumnClassification[];
+}
+
+export interface ExportColumnClassificationsRequest {
+  /**
+   * The name of the database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  name: string;
+}
+
+export interface ExportColumnClassificationsResponse {
+  classifications: ColumnClassification[];
+  /** The classifications in CSV format with the header "schema,table,column,classification,level". */
+  csv: string;
+}
+
+export interface ExportSchemaDiagramRequest {
+  /**
+   * The name of the database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  name: string;
+  /** The schema to export. If empty, all schemas are exported. */
+  schema: string;
+  /** The format of the diagram source. */
+  format: ExportSchemaDiagramRequest_Format;
+}
+
+export enum ExportSchemaDiagramRequest_Format {
+  /** FORMAT_UNSPECIFIED - Only the graph is returned. */
+  FORMAT_UNSPECIFIED = "FORMAT_UNSPECIFIED",
+  PLANTUML = "PLANTUML",
+  MERMAID = "MERMAID",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function exportSchemaDiagramRequest_FormatFromJSON(object: any): ExportSchemaDiagramRequest_Format {
+  switch (object) {
+    case 0:
+    case "FORMAT_UNSPECIFIED":
+      return ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED;
+    case 1:
+    case "PLANTUML":
+      return ExportSchemaDiagramRequest_Format.PLANTUML;
+    case 2:
+    case "MERMAID":
+      return ExportSchemaDiagramRequest_Format.MERMAID;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ExportSchemaDiagramRequest_Format.UNRECOGNIZED;
+  }
+}
+
+export function exportSchemaDiagramRequest_FormatToJSON(object: ExportSchemaDiagramRequest_Format): string {
+  switch (object) {
+    case ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED:
+      return "FORMAT_UNSPECIFIED";
+    case ExportSchemaDiagramRequest_Format.PLANTUML:
+      return "PLANTUML";
+    case ExportSchemaDiagramRequest_Format.MERMAID:
+      return "MERMAID";
+    case ExportSchemaDiagramRequest_Format.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function exportSchemaDiagramRequest_FormatToNumber(object: ExportSchemaDiagramRequest_Format): number {
+  switch (object) {
+    case ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED:
+      return 0;
+    case ExportSchemaDiagramRequest_Format.PLANTUML:
+      return 1;
+    case ExportSchemaDiagramRequest_Format.MERMAID:
+      return 2;
+    case ExportSchemaDiagramRequest_Format.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface SchemaDiagram {
+  /** The tables ordered by schema and name. */
+  tables: SchemaDiagram_Table[];
+  relations: SchemaDiagram_Relation[];
+  /** The PlantUML or Mermaid source of the diagram if requested. */
+  source: string;
+}
+
+export interface SchemaDiagram_Column {
+  name: string;
+  type: string;
+  nullable: boolean;
+  primaryKey: boolean;
+  comment: string;
+}
+
+export interface SchemaDiagram_Table {
+  schema: string;
+  name: string;
+  columns: SchemaDiagram_Column[];
+  comment: string;
+}
+
+/** The relation is a foreign key from the referencing table to the referenced table. */
+export interface SchemaDiagram_Relation {
+  /** The name of the foreign key. */
+  name: string;
+  schema: string;
+  table: string;
+  columns: string[];
+  referencedSchema: string;
+  referencedTable: string;
+  referencedColumns: string[];
+}
+
 export interface GetDatabaseMetadataRequest {
   /**
    * The name of the database to retrieve metadata.
@@ -378,6 +632,37 @@ export interface DiffSchemaResponse {
   diff: string;
 }
 
+export interface GetDatabaseSchemaAsOfRequest {
+  /**
+   * The name of the database to retrieve schema.
+   * Format: instances/{instance}/databases/{database}/schema
+   */
+  name: string;
+  /** The point in time to retrieve the schema as of. */
+  time:
+    | Date
+    | undefined;
+  /** Format the schema dump into SDL format. */
+  sdlFormat: boolean;
+}
+
+export interface DiffSchemaSnapshotsRequest {
+  /**
+   * The name of the database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  name: string;
+  /** The point in time of the source schema. */
+  sourceTime:
+    | Date
+    | undefined;
+  /**
+   * The point in time of the target schema.
+   * The current schema is used if it's not set.
+   */
+  targetTime: Date | undefined;
+}
+
 export interface Database {
   /**
    * The name of the database.
@@ -443,6 +728,10 @@ export interface DatabaseMetadata {
   extensions: ExtensionMetadata[];
   /** The schema_configs is the list of configs for schemas in a database. */
   schemaConfigs: SchemaConfig[];
+  /** The index_templates is the list of index templates. It's the Elasticsearch and OpenSearch specific concept. */
+  indexTemplates: IndexTemplateMetadata[];
+  /** The lifecycle_policies is the list of index lifecycle policies. It's the Elasticsearch and OpenSearch specific concept. */
+  lifecyclePolicies: LifecyclePolicyMetadata[];
 }
 
 /**
@@ -521,6 +810,31 @@ export interface TableMetadata {
   partitions: TablePartitionMetadata[];
   /** The check_constraints is the list of check constraints in a table. */
   checkConstraints: CheckConstraintMetadata[];
+  /** The validator is the document validator of a MongoDB collection in relaxed extended JSON. */
+  validator: string;
+  /** The shard_key is the shard key of a sharded MongoDB collection in relaxed extended JSON. */
+  shardKey: string;
+  /** The dynamic_partition is the dynamic partition of a Doris table. */
+  dynamicPartition: DynamicPartitionMetadata | undefined;
+}
+
+/** DynamicPartitionMetadata is the metadata for the dynamic partition of a Doris table. */
+export interface DynamicPartitionMetadata {
+  /** The enabled is whether the dynamic partition is enabled. */
+  enabled: boolean;
+  /** The time_unit is the unit of the dynamic partitions, such as DAY, WEEK and MONTH. */
+  timeUnit: string;
+  /**
+   * The start is the offset of the earliest partition to keep, the earlier partitions are dropped.
+   * It's the minimum int32 if the history partitions are never dropped.
+   */
+  start: number;
+  /** The end is the offset of the latest partition to create in advance. */
+  end: number;
+  /** The prefix is the name prefix of the dynamic partitions. */
+  prefix: string;
+  /** The buckets is the bucket number of the dynamic partitions. */
+  buckets: number;
 }
 
 /** CheckConstraintMetadata is the metadata for check constraints. */
@@ -1054,6 +1368,13 @@ export interface IndexMetadata {
   comment: string;
   /** The definition of an index. */
   definition: string;
+  /** The parameters are the storage parameters of an index, e.g. m and ef_construction of pgvector hnsw indexes, lists of ivfflat indexes. */
+  parameters: { [key: string]: string };
+}
+
+export interface IndexMetadata_ParametersEntry {
+  key: string;
+  value: string;
 }
 
 /** ExtensionMetadata is the metadata for extensions. */
@@ -1068,6 +1389,32 @@ export interface ExtensionMetadata {
   description: string;
 }
 
+/** IndexTemplateMetadata is the metadata for Elasticsearch and OpenSearch composable index templates. */
+export interface IndexTemplateMetadata {
+  /** The name is the name of an index template. */
+  name: string;
+  /** The index_patterns is the list of index name patterns the template applies to. */
+  indexPatterns: string[];
+  /** The priority decides the template applied when multiple templates match an index. */
+  priority: Long;
+  /** The composed_of is the list of component templates the template is composed of. */
+  composedOf: string[];
+  /** The mappings is the field mappings of the template in JSON. */
+  mappings: string;
+  /** The settings is the index settings of the template in JSON. */
+  settings: string;
+  /** The definition is the whole template body in JSON, which could be used in the PUT template API directly. */
+  definition: string;
+}
+
+/** LifecyclePolicyMetadata is the metadata for Elasticsearch ILM policies and OpenSearch ISM policies. */
+export interface LifecyclePolicyMetadata {
+  /** The name is the name of a lifecycle policy. */
+  name: string;
+  /** The definition is the policy body in JSON. */
+  definition: string;
+}
+
 /** ForeignKeyMetadata is the metadata for foreign keys. */
 export interface ForeignKeyMetadata {
   /** The name is the name of a foreign key. */
@@ -1387,6 +1734,11 @@ export interface Secret {
   value: string;
   /** The description of the secret. */
   description: string;
+  /**
+   * The external secret manager storing the value of the secret, e.g. Vault.
+   * If set, the value is fetched at execution time and the value field is ignored.
+   */
+  externalSecret: DataSourceExternalSecret | undefined;
 }
 
 /** AdviseIndexRequest is the request of advising index. */
@@ -1772,60 +2124,431 @@ export interface GetChangeHistoryRequest {
   concise: boolean;
 }
 
-function createBaseGetDatabaseRequest(): GetDatabaseRequest {
-  return { name: "" };
+export interface ExportChangeHistoriesRequest {
+  /**
+   * The parent of the change histories.
+   * Format: instances/{instance}/databases/{database}
+   */
+  parent: string;
+  /** The format of the exported changelog, JSON by default. */
+  format: ExportChangeHistoriesRequest_Format;
 }
 
-export const GetDatabaseRequest = {
-  encode(message: GetDatabaseRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    return writer;
-  },
+export enum ExportChangeHistoriesRequest_Format {
+  FORMAT_UNSPECIFIED = "FORMAT_UNSPECIFIED",
+  /** JSON - A JSON array of the changes. */
+  JSON = "JSON",
+  /** LIQUIBASE - A Liquibase JSON changelog with one changeset per change. */
+  LIQUIBASE = "LIQUIBASE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseRequest {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetDatabaseRequest();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
-            break;
-          }
+export function exportChangeHistoriesRequest_FormatFromJSON(object: any): ExportChangeHistoriesRequest_Format {
+  switch (object) {
+    case 0:
+    case "FORMAT_UNSPECIFIED":
+      return ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED;
+    case 1:
+    case "JSON":
+      return ExportChangeHistoriesRequest_Format.JSON;
+    case 2:
+    case "LIQUIBASE":
+      return ExportChangeHistoriesRequest_Format.LIQUIBASE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ExportChangeHistoriesRequest_Format.UNRECOGNIZED;
+  }
+}
 
-          message.name = reader.string();
-          continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+export function exportChangeHistoriesRequest_FormatToJSON(object: ExportChangeHistoriesRequest_Format): string {
+  switch (object) {
+    case ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED:
+      return "FORMAT_UNSPECIFIED";
+    case ExportChangeHistoriesRequest_Format.JSON:
+      return "JSON";
+    case ExportChangeHistoriesRequest_Format.LIQUIBASE:
+      return "LIQUIBASE";
+    case ExportChangeHistoriesRequest_Format.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
 
-  fromJSON(object: any): GetDatabaseRequest {
-    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
-  },
+export function exportChangeHistoriesRequest_FormatToNumber(object: ExportChangeHistoriesRequest_Format): number {
+  switch (object) {
+    case ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED:
+      return 0;
+    case ExportChangeHistoriesRequest_Format.JSON:
+      return 1;
+    case ExportChangeHistoriesRequest_Format.LIQUIBASE:
+      return 2;
+    case ExportChangeHistoriesRequest_Format.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
 
-  toJSON(message: GetDatabaseRequest): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    return obj;
-  },
+export interface ExportChangeHistoriesResponse {
+  content: Uint8Array;
+}
 
-  create(base?: DeepPartial<GetDatabaseRequest>): GetDatabaseRequest {
-    return GetDatabaseRequest.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<GetDatabaseRequest>): GetDatabaseRequest {
-    const message = createBaseGetDatabaseRequest();
-    message.name = object.name ?? "";
-    return message;
+export interface ImportChangeHistoriesRequest {
+  /**
+   * The parent of the change histories.
+   * Format: instances/{instance}/databases/{database}
+   */
+  parent: string;
+  /** The migration tool which applied the changes, e.g. FLYWAY or LIQUIBASE. */
+  tool: string;
+  /** The changes in the applied order. */
+  changes: ImportChangeHistoriesRequest_Change[];
+}
+
+export interface ImportChangeHistoriesRequest_Change {
+  /** The version of the change, which must be unique in the database. */
+  version: string;
+  description: string;
+  statement: string;
+  /** The checksum computed by the migration tool, which is kept as is. */
+  checksum: string;
+  /** The time when the change was applied by the migration tool. */
+  appliedTime:
+    | Date
+    | undefined;
+  /** MIGRATE by default. */
+  type: ChangeHistory_Type;
+}
+
+export interface ImportChangeHistoriesResponse {
+  changeHistories: ChangeHistory[];
+}
+
+export interface GetBackupSettingRequest {
+  /**
+   * The name of the backup setting.
+   * Format: instances/{instance}/databases/{database}/backupSetting
+   */
+  name: string;
+}
+
+export interface UpdateBackupSettingRequest {
+  /**
+   * The backup setting to update.
+   *
+   * The backup_setting's `name` field is used to identify the backup setting to update.
+   * Format: instances/{instance}/databases/{database}/backupSetting
+   */
+  backupSetting:
+    | BackupSetting
+    | undefined;
+  /** The list of fields to update. */
+  updateMask: string[] | undefined;
+}
+
+/**
+ * BackupSetting is the setting of the scheduled logical backup of the database.
+ * The backup is dumped by pg_dump or mysqldump, compressed by gzip, and uploaded to the object storage.
+ */
+export interface BackupSetting {
+  /**
+   * The name of the backup setting.
+   * Format: instances/{instance}/databases/{database}/backupSetting
+   */
+  name: string;
+  enabled: boolean;
+  /** The hour of the day in UTC to run the backup, from 0 to 23. */
+  hour: number;
+  /** The day of the week in UTC to run the backup, from 0 (Sunday) to 6. -1 means every day. */
+  dayOfWeek: number;
+  /** The backups older than the retention are purged. Unset means keeping the backups forever. */
+  retention: Duration | undefined;
+  storage:
+    | BackupStorage
+    | undefined;
+  /** The passphrase to encrypt the backups with AES-256-GCM. Updating it to empty disables the encryption. */
+  encryptionKey: string;
+  /** The backups are encrypted. */
+  encrypted: boolean;
+  updateTime: Date | undefined;
+}
+
+/** BackupStorage is the S3-compatible object storage to store the backups. */
+export interface BackupStorage {
+  type: BackupStorage_Type;
+  bucket: string;
+  /** The prefix of the object keys. */
+  prefix: string;
+  region: string;
+  /** The endpoint of the storage, required for MinIO. */
+  endpoint: string;
+  /** The access key id. The default credentials of the Bytebase server are used if it's empty. */
+  accessKeyId: string;
+  /** The secret access key. Empty means keeping the current secret access key. */
+  secretAccessKey: string;
+}
+
+export enum BackupStorage_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  S3 = "S3",
+  /** GCS - GCS is accessed by the XML API with the HMAC keys. */
+  GCS = "GCS",
+  MINIO = "MINIO",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function backupStorage_TypeFromJSON(object: any): BackupStorage_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return BackupStorage_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "S3":
+      return BackupStorage_Type.S3;
+    case 2:
+    case "GCS":
+      return BackupStorage_Type.GCS;
+    case 3:
+    case "MINIO":
+      return BackupStorage_Type.MINIO;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BackupStorage_Type.UNRECOGNIZED;
+  }
+}
+
+export function backupStorage_TypeToJSON(object: BackupStorage_Type): string {
+  switch (object) {
+    case BackupStorage_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case BackupStorage_Type.S3:
+      return "S3";
+    case BackupStorage_Type.GCS:
+      return "GCS";
+    case BackupStorage_Type.MINIO:
+      return "MINIO";
+    case BackupStorage_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function backupStorage_TypeToNumber(object: BackupStorage_Type): number {
+  switch (object) {
+    case BackupStorage_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case BackupStorage_Type.S3:
+      return 1;
+    case BackupStorage_Type.GCS:
+      return 2;
+    case BackupStorage_Type.MINIO:
+      return 3;
+    case BackupStorage_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ListBackupRunsRequest {
+  /**
+   * The parent database of the backup runs.
+   * Format: instances/{instance}/databases/{database}
+   */
+  parent: string;
+  /**
+   * The maximum number of backup runs to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 50 backup runs will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListBackupRuns` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListBackupRuns` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+}
+
+export interface ListBackupRunsResponse {
+  backupRuns: BackupRun[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface CreateBackupRunRequest {
+  /**
+   * The parent database of the backup run.
+   * Format: instances/{instance}/databases/{database}
+   */
+  parent: string;
+}
+
+export interface BackupRun {
+  /**
+   * The name of the backup run.
+   * Format: instances/{instance}/databases/{database}/backupRuns/{backup_run}
+   */
+  name: string;
+  status: BackupRun_Status;
+  /**
+   * The creator of the backup run, the system bot for the scheduled backups.
+   * Format: users/{email}
+   */
+  creator: string;
+  createTime: Date | undefined;
+  startTime: Date | undefined;
+  finishTime:
+    | Date
+    | undefined;
+  /** The URI of the backup object, e.g. s3://bucket/prefix/instance/database/20240101T000000Z.sql.gz. */
+  uri: string;
+  /** The size of the backup in bytes. */
+  size: Long;
+  error: string;
+  encrypted: boolean;
+  /** The backup is created manually rather than by the schedule. */
+  manual: boolean;
+}
+
+export enum BackupRun_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  PENDING = "PENDING",
+  RUNNING = "RUNNING",
+  DONE = "DONE",
+  FAILED = "FAILED",
+  /** DELETED - The backup is purged from the storage by the retention. */
+  DELETED = "DELETED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function backupRun_StatusFromJSON(object: any): BackupRun_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return BackupRun_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return BackupRun_Status.PENDING;
+    case 2:
+    case "RUNNING":
+      return BackupRun_Status.RUNNING;
+    case 3:
+    case "DONE":
+      return BackupRun_Status.DONE;
+    case 4:
+    case "FAILED":
+      return BackupRun_Status.FAILED;
+    case 5:
+    case "DELETED":
+      return BackupRun_Status.DELETED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return BackupRun_Status.UNRECOGNIZED;
+  }
+}
+
+export function backupRun_StatusToJSON(object: BackupRun_Status): string {
+  switch (object) {
+    case BackupRun_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case BackupRun_Status.PENDING:
+      return "PENDING";
+    case BackupRun_Status.RUNNING:
+      return "RUNNING";
+    case BackupRun_Status.DONE:
+      return "DONE";
+    case BackupRun_Status.FAILED:
+      return "FAILED";
+    case BackupRun_Status.DELETED:
+      return "DELETED";
+    case BackupRun_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function backupRun_StatusToNumber(object: BackupRun_Status): number {
+  switch (object) {
+    case BackupRun_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case BackupRun_Status.PENDING:
+      return 1;
+    case BackupRun_Status.RUNNING:
+      return 2;
+    case BackupRun_Status.DONE:
+      return 3;
+    case BackupRun_Status.FAILED:
+      return 4;
+    case BackupRun_Status.DELETED:
+      return 5;
+    case BackupRun_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+function createBaseGetDatabaseRequest(): GetDatabaseRequest {
+  return { name: "" };
+}
+
+export const GetDatabaseRequest = {
+  encode(message: GetDatabaseRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetDatabaseRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetDatabaseRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: GetDatabaseRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetDatabaseRequest>): GetDatabaseRequest {
+    return GetDatabaseRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetDatabaseRequest>): GetDatabaseRequest {
+    const message = createBaseGetDatabaseRequest();
+    message.name = object.name ?? "";
+    return message;
   },
 };
 
@@ -2652,28 +3375,34 @@ export const SyncDatabaseResponse = {
   },
 };
 
-function createBaseGetDatabaseMetadataRequest(): GetDatabaseMetadataRequest {
-  return { name: "", view: DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED, filter: "" };
+function createBaseColumnClassification(): ColumnClassification {
+  return { schema: "", table: "", column: "", classification: "", level: "" };
 }
 
-export const GetDatabaseMetadataRequest = {
-  encode(message: GetDatabaseMetadataRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const ColumnClassification = {
+  encode(message: ColumnClassification, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
     }
-    if (message.view !== DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED) {
-      writer.uint32(16).int32(databaseMetadataViewToNumber(message.view));
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
     }
-    if (message.filter !== "") {
-      writer.uint32(26).string(message.filter);
+    if (message.column !== "") {
+      writer.uint32(26).string(message.column);
+    }
+    if (message.classification !== "") {
+      writer.uint32(34).string(message.classification);
+    }
+    if (message.level !== "") {
+      writer.uint32(42).string(message.level);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseMetadataRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnClassification {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetDatabaseMetadataRequest();
+    const message = createBaseColumnClassification();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2682,102 +3411,35 @@ export const GetDatabaseMetadataRequest = {
             break;
           }
 
-          message.name = reader.string();
+          message.schema = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.view = databaseMetadataViewFromJSON(reader.int32());
+          message.table = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.filter = reader.string();
+          message.column = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): GetDatabaseMetadataRequest {
-    return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      view: isSet(object.view)
-        ? databaseMetadataViewFromJSON(object.view)
-        : DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED,
-      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
-    };
-  },
-
-  toJSON(message: GetDatabaseMetadataRequest): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.view !== DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED) {
-      obj.view = databaseMetadataViewToJSON(message.view);
-    }
-    if (message.filter !== "") {
-      obj.filter = message.filter;
-    }
-    return obj;
-  },
-
-  create(base?: DeepPartial<GetDatabaseMetadataRequest>): GetDatabaseMetadataRequest {
-    return GetDatabaseMetadataRequest.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<GetDatabaseMetadataRequest>): GetDatabaseMetadataRequest {
-    const message = createBaseGetDatabaseMetadataRequest();
-    message.name = object.name ?? "";
-    message.view = object.view ?? DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED;
-    message.filter = object.filter ?? "";
-    return message;
-  },
-};
-
-function createBaseUpdateDatabaseMetadataRequest(): UpdateDatabaseMetadataRequest {
-  return { databaseMetadata: undefined, updateMask: undefined };
-}
-
-export const UpdateDatabaseMetadataRequest = {
-  encode(message: UpdateDatabaseMetadataRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.databaseMetadata !== undefined) {
-      DatabaseMetadata.encode(message.databaseMetadata, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.updateMask !== undefined) {
-      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
-    }
-    return writer;
-  },
-
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateDatabaseMetadataRequest {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateDatabaseMetadataRequest();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.databaseMetadata = DatabaseMetadata.decode(reader, reader.uint32());
+          message.classification = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          message.level = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2788,59 +3450,96 @@ export const UpdateDatabaseMetadataRequest = {
     return message;
   },
 
-  fromJSON(object: any): UpdateDatabaseMetadataRequest {
+  fromJSON(object: any): ColumnClassification {
     return {
-      databaseMetadata: isSet(object.databaseMetadata) ? DatabaseMetadata.fromJSON(object.databaseMetadata) : undefined,
-      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      column: isSet(object.column) ? globalThis.String(object.column) : "",
+      classification: isSet(object.classification) ? globalThis.String(object.classification) : "",
+      level: isSet(object.level) ? globalThis.String(object.level) : "",
     };
   },
 
-  toJSON(message: UpdateDatabaseMetadataRequest): unknown {
+  toJSON(message: ColumnClassification): unknown {
     const obj: any = {};
-    if (message.databaseMetadata !== undefined) {
-      obj.databaseMetadata = DatabaseMetadata.toJSON(message.databaseMetadata);
+    if (message.schema !== "") {
+      obj.schema = message.schema;
     }
-    if (message.updateMask !== undefined) {
-      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.column !== "") {
+      obj.column = message.column;
+    }
+    if (message.classification !== "") {
+      obj.classification = message.classification;
+    }
+    if (message.level !== "") {
+      obj.level = message.level;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<UpdateDatabaseMetadataRequest>): UpdateDatabaseMetadataRequest {
-    return UpdateDatabaseMetadataRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ColumnClassification>): ColumnClassification {
+    return ColumnClassification.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdateDatabaseMetadataRequest>): UpdateDatabaseMetadataRequest {
-    const message = createBaseUpdateDatabaseMetadataRequest();
-    message.databaseMetadata = (object.databaseMetadata !== undefined && object.databaseMetadata !== null)
-      ? DatabaseMetadata.fromPartial(object.databaseMetadata)
-      : undefined;
-    message.updateMask = object.updateMask ?? undefined;
+  fromPartial(object: DeepPartial<ColumnClassification>): ColumnClassification {
+    const message = createBaseColumnClassification();
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.column = object.column ?? "";
+    message.classification = object.classification ?? "";
+    message.level = object.level ?? "";
     return message;
   },
 };
 
-function createBaseGetDatabaseSchemaRequest(): GetDatabaseSchemaRequest {
-  return { name: "", sdlFormat: false, concise: false };
+function createBaseClassificationSuggestion(): ClassificationSuggestion {
+  return {
+    name: "",
+    schema: "",
+    table: "",
+    column: "",
+    label: ClassificationSuggestion_Label.LABEL_UNSPECIFIED,
+    nameMatched: false,
+    dataMatchRatio: 0,
+    createTime: undefined,
+  };
 }
 
-export const GetDatabaseSchemaRequest = {
-  encode(message: GetDatabaseSchemaRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ClassificationSuggestion = {
+  encode(message: ClassificationSuggestion, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.sdlFormat === true) {
-      writer.uint32(16).bool(message.sdlFormat);
+    if (message.schema !== "") {
+      writer.uint32(18).string(message.schema);
     }
-    if (message.concise === true) {
-      writer.uint32(24).bool(message.concise);
+    if (message.table !== "") {
+      writer.uint32(26).string(message.table);
+    }
+    if (message.column !== "") {
+      writer.uint32(34).string(message.column);
+    }
+    if (message.label !== ClassificationSuggestion_Label.LABEL_UNSPECIFIED) {
+      writer.uint32(40).int32(classificationSuggestion_LabelToNumber(message.label));
+    }
+    if (message.nameMatched === true) {
+      writer.uint32(48).bool(message.nameMatched);
+    }
+    if (message.dataMatchRatio !== 0) {
+      writer.uint32(57).double(message.dataMatchRatio);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(66).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseSchemaRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ClassificationSuggestion {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetDatabaseSchemaRequest();
+    const message = createBaseClassificationSuggestion();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2852,18 +3551,53 @@ export const GetDatabaseSchemaRequest = {
           message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.sdlFormat = reader.bool();
+          message.schema = reader.string();
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.concise = reader.bool();
+          message.table = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.column = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.label = classificationSuggestion_LabelFromJSON(reader.int32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.nameMatched = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.dataMatchRatio = reader.double();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2874,65 +3608,83 @@ export const GetDatabaseSchemaRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetDatabaseSchemaRequest {
+  fromJSON(object: any): ClassificationSuggestion {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
-      concise: isSet(object.concise) ? globalThis.Boolean(object.concise) : false,
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      column: isSet(object.column) ? globalThis.String(object.column) : "",
+      label: isSet(object.label)
+        ? classificationSuggestion_LabelFromJSON(object.label)
+        : ClassificationSuggestion_Label.LABEL_UNSPECIFIED,
+      nameMatched: isSet(object.nameMatched) ? globalThis.Boolean(object.nameMatched) : false,
+      dataMatchRatio: isSet(object.dataMatchRatio) ? globalThis.Number(object.dataMatchRatio) : 0,
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
     };
   },
 
-  toJSON(message: GetDatabaseSchemaRequest): unknown {
+  toJSON(message: ClassificationSuggestion): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.sdlFormat === true) {
-      obj.sdlFormat = message.sdlFormat;
+    if (message.schema !== "") {
+      obj.schema = message.schema;
     }
-    if (message.concise === true) {
-      obj.concise = message.concise;
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.column !== "") {
+      obj.column = message.column;
+    }
+    if (message.label !== ClassificationSuggestion_Label.LABEL_UNSPECIFIED) {
+      obj.label = classificationSuggestion_LabelToJSON(message.label);
+    }
+    if (message.nameMatched === true) {
+      obj.nameMatched = message.nameMatched;
+    }
+    if (message.dataMatchRatio !== 0) {
+      obj.dataMatchRatio = message.dataMatchRatio;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<GetDatabaseSchemaRequest>): GetDatabaseSchemaRequest {
-    return GetDatabaseSchemaRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ClassificationSuggestion>): ClassificationSuggestion {
+    return ClassificationSuggestion.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetDatabaseSchemaRequest>): GetDatabaseSchemaRequest {
-    const message = createBaseGetDatabaseSchemaRequest();
+  fromPartial(object: DeepPartial<ClassificationSuggestion>): ClassificationSuggestion {
+    const message = createBaseClassificationSuggestion();
     message.name = object.name ?? "";
-    message.sdlFormat = object.sdlFormat ?? false;
-    message.concise = object.concise ?? false;
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.column = object.column ?? "";
+    message.label = object.label ?? ClassificationSuggestion_Label.LABEL_UNSPECIFIED;
+    message.nameMatched = object.nameMatched ?? false;
+    message.dataMatchRatio = object.dataMatchRatio ?? 0;
+    message.createTime = object.createTime ?? undefined;
     return message;
   },
 };
 
-function createBaseDiffSchemaRequest(): DiffSchemaRequest {
-  return { name: "", schema: undefined, changeHistory: undefined, sdlFormat: false };
+function createBaseListClassificationSuggestionsRequest(): ListClassificationSuggestionsRequest {
+  return { parent: "" };
 }
 
-export const DiffSchemaRequest = {
-  encode(message: DiffSchemaRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.schema !== undefined) {
-      writer.uint32(18).string(message.schema);
-    }
-    if (message.changeHistory !== undefined) {
-      writer.uint32(26).string(message.changeHistory);
-    }
-    if (message.sdlFormat === true) {
-      writer.uint32(32).bool(message.sdlFormat);
+export const ListClassificationSuggestionsRequest = {
+  encode(message: ListClassificationSuggestionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DiffSchemaRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListClassificationSuggestionsRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDiffSchemaRequest();
+    const message = createBaseListClassificationSuggestionsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2941,28 +3693,7 @@ export const DiffSchemaRequest = {
             break;
           }
 
-          message.name = reader.string();
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.schema = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.changeHistory = reader.string();
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.sdlFormat = reader.bool();
+          message.parent = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2973,61 +3704,44 @@ export const DiffSchemaRequest = {
     return message;
   },
 
-  fromJSON(object: any): DiffSchemaRequest {
-    return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      schema: isSet(object.schema) ? globalThis.String(object.schema) : undefined,
-      changeHistory: isSet(object.changeHistory) ? globalThis.String(object.changeHistory) : undefined,
-      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
-    };
+  fromJSON(object: any): ListClassificationSuggestionsRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
   },
 
-  toJSON(message: DiffSchemaRequest): unknown {
+  toJSON(message: ListClassificationSuggestionsRequest): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.schema !== undefined) {
-      obj.schema = message.schema;
-    }
-    if (message.changeHistory !== undefined) {
-      obj.changeHistory = message.changeHistory;
-    }
-    if (message.sdlFormat === true) {
-      obj.sdlFormat = message.sdlFormat;
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DiffSchemaRequest>): DiffSchemaRequest {
-    return DiffSchemaRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListClassificationSuggestionsRequest>): ListClassificationSuggestionsRequest {
+    return ListClassificationSuggestionsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DiffSchemaRequest>): DiffSchemaRequest {
-    const message = createBaseDiffSchemaRequest();
-    message.name = object.name ?? "";
-    message.schema = object.schema ?? undefined;
-    message.changeHistory = object.changeHistory ?? undefined;
-    message.sdlFormat = object.sdlFormat ?? false;
+  fromPartial(object: DeepPartial<ListClassificationSuggestionsRequest>): ListClassificationSuggestionsRequest {
+    const message = createBaseListClassificationSuggestionsRequest();
+    message.parent = object.parent ?? "";
     return message;
   },
 };
 
-function createBaseDiffSchemaResponse(): DiffSchemaResponse {
-  return { diff: "" };
+function createBaseListClassificationSuggestionsResponse(): ListClassificationSuggestionsResponse {
+  return { suggestions: [] };
 }
 
-export const DiffSchemaResponse = {
-  encode(message: DiffSchemaResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.diff !== "") {
-      writer.uint32(10).string(message.diff);
+export const ListClassificationSuggestionsResponse = {
+  encode(message: ListClassificationSuggestionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.suggestions) {
+      ClassificationSuggestion.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DiffSchemaResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListClassificationSuggestionsResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDiffSchemaResponse();
+    const message = createBaseListClassificationSuggestionsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3036,7 +3750,7 @@ export const DiffSchemaResponse = {
             break;
           }
 
-          message.diff = reader.string();
+          message.suggestions.push(ClassificationSuggestion.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3047,86 +3761,51 @@ export const DiffSchemaResponse = {
     return message;
   },
 
-  fromJSON(object: any): DiffSchemaResponse {
-    return { diff: isSet(object.diff) ? globalThis.String(object.diff) : "" };
+  fromJSON(object: any): ListClassificationSuggestionsResponse {
+    return {
+      suggestions: globalThis.Array.isArray(object?.suggestions)
+        ? object.suggestions.map((e: any) => ClassificationSuggestion.fromJSON(e))
+        : [],
+    };
   },
 
-  toJSON(message: DiffSchemaResponse): unknown {
+  toJSON(message: ListClassificationSuggestionsResponse): unknown {
     const obj: any = {};
-    if (message.diff !== "") {
-      obj.diff = message.diff;
+    if (message.suggestions?.length) {
+      obj.suggestions = message.suggestions.map((e) => ClassificationSuggestion.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DiffSchemaResponse>): DiffSchemaResponse {
-    return DiffSchemaResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListClassificationSuggestionsResponse>): ListClassificationSuggestionsResponse {
+    return ListClassificationSuggestionsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DiffSchemaResponse>): DiffSchemaResponse {
-    const message = createBaseDiffSchemaResponse();
-    message.diff = object.diff ?? "";
+  fromPartial(object: DeepPartial<ListClassificationSuggestionsResponse>): ListClassificationSuggestionsResponse {
+    const message = createBaseListClassificationSuggestionsResponse();
+    message.suggestions = object.suggestions?.map((e) => ClassificationSuggestion.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseDatabase(): Database {
-  return {
-    name: "",
-    uid: "",
-    syncState: State.STATE_UNSPECIFIED,
-    successfulSyncTime: undefined,
-    project: "",
-    schemaVersion: "",
-    environment: "",
-    effectiveEnvironment: "",
-    labels: {},
-    instanceResource: undefined,
-    backupAvailable: false,
-  };
+function createBaseAcceptClassificationSuggestionRequest(): AcceptClassificationSuggestionRequest {
+  return { name: "", maskingLevel: MaskingLevel.MASKING_LEVEL_UNSPECIFIED };
 }
 
-export const Database = {
-  encode(message: Database, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const AcceptClassificationSuggestionRequest = {
+  encode(message: AcceptClassificationSuggestionRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.uid !== "") {
-      writer.uint32(18).string(message.uid);
-    }
-    if (message.syncState !== State.STATE_UNSPECIFIED) {
-      writer.uint32(24).int32(stateToNumber(message.syncState));
-    }
-    if (message.successfulSyncTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.successfulSyncTime), writer.uint32(34).fork()).ldelim();
-    }
-    if (message.project !== "") {
-      writer.uint32(42).string(message.project);
-    }
-    if (message.schemaVersion !== "") {
-      writer.uint32(50).string(message.schemaVersion);
-    }
-    if (message.environment !== "") {
-      writer.uint32(58).string(message.environment);
-    }
-    if (message.effectiveEnvironment !== "") {
-      writer.uint32(66).string(message.effectiveEnvironment);
-    }
-    Object.entries(message.labels).forEach(([key, value]) => {
-      Database_LabelsEntry.encode({ key: key as any, value }, writer.uint32(74).fork()).ldelim();
-    });
-    if (message.instanceResource !== undefined) {
-      InstanceResource.encode(message.instanceResource, writer.uint32(82).fork()).ldelim();
-    }
-    if (message.backupAvailable === true) {
-      writer.uint32(88).bool(message.backupAvailable);
+    if (message.maskingLevel !== MaskingLevel.MASKING_LEVEL_UNSPECIFIED) {
+      writer.uint32(16).int32(maskingLevelToNumber(message.maskingLevel));
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Database {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AcceptClassificationSuggestionRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDatabase();
+    const message = createBaseAcceptClassificationSuggestionRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3138,77 +3817,11 @@ export const Database = {
           message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.uid = reader.string();
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.syncState = stateFromJSON(reader.int32());
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.successfulSyncTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.project = reader.string();
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.schemaVersion = reader.string();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.environment = reader.string();
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.effectiveEnvironment = reader.string();
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          const entry9 = Database_LabelsEntry.decode(reader, reader.uint32());
-          if (entry9.value !== undefined) {
-            message.labels[entry9.key] = entry9.value;
-          }
-          continue;
-        case 10:
-          if (tag !== 82) {
-            break;
-          }
-
-          message.instanceResource = InstanceResource.decode(reader, reader.uint32());
-          continue;
-        case 11:
-          if (tag !== 88) {
+          if (tag !== 16) {
             break;
           }
 
-          message.backupAvailable = reader.bool();
+          message.maskingLevel = maskingLevelFromJSON(reader.int32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3219,117 +3832,62 @@ export const Database = {
     return message;
   },
 
-  fromJSON(object: any): Database {
+  fromJSON(object: any): AcceptClassificationSuggestionRequest {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
-      syncState: isSet(object.syncState) ? stateFromJSON(object.syncState) : State.STATE_UNSPECIFIED,
-      successfulSyncTime: isSet(object.successfulSyncTime) ? fromJsonTimestamp(object.successfulSyncTime) : undefined,
-      project: isSet(object.project) ? globalThis.String(object.project) : "",
-      schemaVersion: isSet(object.schemaVersion) ? globalThis.String(object.schemaVersion) : "",
-      environment: isSet(object.environment) ? globalThis.String(object.environment) : "",
-      effectiveEnvironment: isSet(object.effectiveEnvironment) ? globalThis.String(object.effectiveEnvironment) : "",
-      labels: isObject(object.labels)
-        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-          acc[key] = String(value);
-          return acc;
-        }, {})
-        : {},
-      instanceResource: isSet(object.instanceResource) ? InstanceResource.fromJSON(object.instanceResource) : undefined,
-      backupAvailable: isSet(object.backupAvailable) ? globalThis.Boolean(object.backupAvailable) : false,
+      maskingLevel: isSet(object.maskingLevel)
+        ? maskingLevelFromJSON(object.maskingLevel)
+        : MaskingLevel.MASKING_LEVEL_UNSPECIFIED,
     };
   },
 
-  toJSON(message: Database): unknown {
+  toJSON(message: AcceptClassificationSuggestionRequest): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.uid !== "") {
-      obj.uid = message.uid;
-    }
-    if (message.syncState !== State.STATE_UNSPECIFIED) {
-      obj.syncState = stateToJSON(message.syncState);
-    }
-    if (message.successfulSyncTime !== undefined) {
-      obj.successfulSyncTime = message.successfulSyncTime.toISOString();
-    }
-    if (message.project !== "") {
-      obj.project = message.project;
-    }
-    if (message.schemaVersion !== "") {
-      obj.schemaVersion = message.schemaVersion;
-    }
-    if (message.environment !== "") {
-      obj.environment = message.environment;
-    }
-    if (message.effectiveEnvironment !== "") {
-      obj.effectiveEnvironment = message.effectiveEnvironment;
-    }
-    if (message.labels) {
-      const entries = Object.entries(message.labels);
-      if (entries.length > 0) {
-        obj.labels = {};
-        entries.forEach(([k, v]) => {
-          obj.labels[k] = v;
-        });
-      }
-    }
-    if (message.instanceResource !== undefined) {
-      obj.instanceResource = InstanceResource.toJSON(message.instanceResource);
-    }
-    if (message.backupAvailable === true) {
-      obj.backupAvailable = message.backupAvailable;
+    if (message.maskingLevel !== MaskingLevel.MASKING_LEVEL_UNSPECIFIED) {
+      obj.maskingLevel = maskingLevelToJSON(message.maskingLevel);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Database>): Database {
-    return Database.fromPartial(base ?? {});
+  create(base?: DeepPartial<AcceptClassificationSuggestionRequest>): AcceptClassificationSuggestionRequest {
+    return AcceptClassificationSuggestionRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Database>): Database {
-    const message = createBaseDatabase();
+  fromPartial(object: DeepPartial<AcceptClassificationSuggestionRequest>): AcceptClassificationSuggestionRequest {
+    const message = createBaseAcceptClassificationSuggestionRequest();
     message.name = object.name ?? "";
-    message.uid = object.uid ?? "";
-    message.syncState = object.syncState ?? State.STATE_UNSPECIFIED;
-    message.successfulSyncTime = object.successfulSyncTime ?? undefined;
-    message.project = object.project ?? "";
-    message.schemaVersion = object.schemaVersion ?? "";
-    message.environment = object.environment ?? "";
-    message.effectiveEnvironment = object.effectiveEnvironment ?? "";
-    message.labels = Object.entries(object.labels ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-      if (value !== undefined) {
-        acc[key] = globalThis.String(value);
-      }
-      return acc;
-    }, {});
-    message.instanceResource = (object.instanceResource !== undefined && object.instanceResource !== null)
-      ? InstanceResource.fromPartial(object.instanceResource)
-      : undefined;
-    message.backupAvailable = object.backupAvailable ?? false;
+    message.maskingLevel = object.maskingLevel ?? MaskingLevel.MASKING_LEVEL_UNSPECIFIED;
     return message;
   },
 };
 
-function createBaseDatabase_LabelsEntry(): Database_LabelsEntry {
-  return { key: "", value: "" };
+function createBaseImportColumnClassificationsRequest(): ImportColumnClassificationsRequest {
+  return { name: "", classifications: [], csv: "", replace: false };
 }
 
-export const Database_LabelsEntry = {
-  encode(message: Database_LabelsEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== "") {
-      writer.uint32(10).string(message.key);
+export const ImportColumnClassificationsRequest = {
+  encode(message: ImportColumnClassificationsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.value !== "") {
-      writer.uint32(18).string(message.value);
+    for (const v of message.classifications) {
+      ColumnClassification.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.csv !== "") {
+      writer.uint32(26).string(message.csv);
+    }
+    if (message.replace === true) {
+      writer.uint32(32).bool(message.replace);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Database_LabelsEntry {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportColumnClassificationsRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDatabase_LabelsEntry();
+    const message = createBaseImportColumnClassificationsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3338,14 +3896,28 @@ export const Database_LabelsEntry = {
             break;
           }
 
-          message.key = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.value = reader.string();
+          message.classifications.push(ColumnClassification.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.csv = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.replace = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3356,66 +3928,124 @@ export const Database_LabelsEntry = {
     return message;
   },
 
-  fromJSON(object: any): Database_LabelsEntry {
+  fromJSON(object: any): ImportColumnClassificationsRequest {
     return {
-      key: isSet(object.key) ? globalThis.String(object.key) : "",
-      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      classifications: globalThis.Array.isArray(object?.classifications)
+        ? object.classifications.map((e: any) => ColumnClassification.fromJSON(e))
+        : [],
+      csv: isSet(object.csv) ? globalThis.String(object.csv) : "",
+      replace: isSet(object.replace) ? globalThis.Boolean(object.replace) : false,
     };
   },
 
-  toJSON(message: Database_LabelsEntry): unknown {
+  toJSON(message: ImportColumnClassificationsRequest): unknown {
     const obj: any = {};
-    if (message.key !== "") {
-      obj.key = message.key;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.value !== "") {
-      obj.value = message.value;
+    if (message.classifications?.length) {
+      obj.classifications = message.classifications.map((e) => ColumnClassification.toJSON(e));
+    }
+    if (message.csv !== "") {
+      obj.csv = message.csv;
+    }
+    if (message.replace === true) {
+      obj.replace = message.replace;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Database_LabelsEntry>): Database_LabelsEntry {
-    return Database_LabelsEntry.fromPartial(base ?? {});
+  create(base?: DeepPartial<ImportColumnClassificationsRequest>): ImportColumnClassificationsRequest {
+    return ImportColumnClassificationsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Database_LabelsEntry>): Database_LabelsEntry {
-    const message = createBaseDatabase_LabelsEntry();
-    message.key = object.key ?? "";
-    message.value = object.value ?? "";
+  fromPartial(object: DeepPartial<ImportColumnClassificationsRequest>): ImportColumnClassificationsRequest {
+    const message = createBaseImportColumnClassificationsRequest();
+    message.name = object.name ?? "";
+    message.classifications = object.classifications?.map((e) => ColumnClassification.fromPartial(e)) || [];
+    message.csv = object.csv ?? "";
+    message.replace = object.replace ?? false;
     return message;
   },
 };
 
-function createBaseDatabaseMetadata(): DatabaseMetadata {
-  return { name: "", schemas: [], characterSet: "", collation: "", extensions: [], schemaConfigs: [] };
+function createBaseImportColumnClassificationsResponse(): ImportColumnClassificationsResponse {
+  return { classifications: [] };
 }
 
-export const DatabaseMetadata = {
-  encode(message: DatabaseMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    for (const v of message.schemas) {
-      SchemaMetadata.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.characterSet !== "") {
-      writer.uint32(26).string(message.characterSet);
+export const ImportColumnClassificationsResponse = {
+  encode(message: ImportColumnClassificationsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.classifications) {
+      ColumnClassification.encode(v!, writer.uint32(10).fork()).ldelim();
     }
-    if (message.collation !== "") {
-      writer.uint32(34).string(message.collation);
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportColumnClassificationsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportColumnClassificationsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.classifications.push(ColumnClassification.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
     }
-    for (const v of message.extensions) {
-      ExtensionMetadata.encode(v!, writer.uint32(42).fork()).ldelim();
+    return message;
+  },
+
+  fromJSON(object: any): ImportColumnClassificationsResponse {
+    return {
+      classifications: globalThis.Array.isArray(object?.classifications)
+        ? object.classifications.map((e: any) => ColumnClassification.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ImportColumnClassificationsResponse): unknown {
+    const obj: any = {};
+    if (message.classifications?.length) {
+      obj.classifications = message.classifications.map((e) => ColumnClassification.toJSON(e));
     }
-    for (const v of message.schemaConfigs) {
-      SchemaConfig.encode(v!, writer.uint32(50).fork()).ldelim();
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportColumnClassificationsResponse>): ImportColumnClassificationsResponse {
+    return ImportColumnClassificationsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportColumnClassificationsResponse>): ImportColumnClassificationsResponse {
+    const message = createBaseImportColumnClassificationsResponse();
+    message.classifications = object.classifications?.map((e) => ColumnClassification.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseExportColumnClassificationsRequest(): ExportColumnClassificationsRequest {
+  return { name: "" };
+}
+
+export const ExportColumnClassificationsRequest = {
+  encode(message: ExportColumnClassificationsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportColumnClassificationsRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDatabaseMetadata();
+    const message = createBaseExportColumnClassificationsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3426,40 +4056,72 @@ export const DatabaseMetadata = {
 
           message.name = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.schemas.push(SchemaMetadata.decode(reader, reader.uint32()));
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
+  fromJSON(object: any): ExportColumnClassificationsRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
 
-          message.characterSet = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
+  toJSON(message: ExportColumnClassificationsRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
 
-          message.collation = reader.string();
-          continue;
-        case 5:
-          if (tag !== 42) {
+  create(base?: DeepPartial<ExportColumnClassificationsRequest>): ExportColumnClassificationsRequest {
+    return ExportColumnClassificationsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExportColumnClassificationsRequest>): ExportColumnClassificationsRequest {
+    const message = createBaseExportColumnClassificationsRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseExportColumnClassificationsResponse(): ExportColumnClassificationsResponse {
+  return { classifications: [], csv: "" };
+}
+
+export const ExportColumnClassificationsResponse = {
+  encode(message: ExportColumnClassificationsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.classifications) {
+      ColumnClassification.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.csv !== "") {
+      writer.uint32(18).string(message.csv);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportColumnClassificationsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportColumnClassificationsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.extensions.push(ExtensionMetadata.decode(reader, reader.uint32()));
+          message.classifications.push(ColumnClassification.decode(reader, reader.uint32()));
           continue;
-        case 6:
-          if (tag !== 50) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.schemaConfigs.push(SchemaConfig.decode(reader, reader.uint32()));
+          message.csv = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3470,111 +4132,59 @@ export const DatabaseMetadata = {
     return message;
   },
 
-  fromJSON(object: any): DatabaseMetadata {
+  fromJSON(object: any): ExportColumnClassificationsResponse {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      schemas: globalThis.Array.isArray(object?.schemas)
-        ? object.schemas.map((e: any) => SchemaMetadata.fromJSON(e))
-        : [],
-      characterSet: isSet(object.characterSet) ? globalThis.String(object.characterSet) : "",
-      collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
-      extensions: globalThis.Array.isArray(object?.extensions)
-        ? object.extensions.map((e: any) => ExtensionMetadata.fromJSON(e))
-        : [],
-      schemaConfigs: globalThis.Array.isArray(object?.schemaConfigs)
-        ? object.schemaConfigs.map((e: any) => SchemaConfig.fromJSON(e))
+      classifications: globalThis.Array.isArray(object?.classifications)
+        ? object.classifications.map((e: any) => ColumnClassification.fromJSON(e))
         : [],
+      csv: isSet(object.csv) ? globalThis.String(object.csv) : "",
     };
   },
 
-  toJSON(message: DatabaseMetadata): unknown {
+  toJSON(message: ExportColumnClassificationsResponse): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.schemas?.length) {
-      obj.schemas = message.schemas.map((e) => SchemaMetadata.toJSON(e));
-    }
-    if (message.characterSet !== "") {
-      obj.characterSet = message.characterSet;
-    }
-    if (message.collation !== "") {
-      obj.collation = message.collation;
+    if (message.classifications?.length) {
+      obj.classifications = message.classifications.map((e) => ColumnClassification.toJSON(e));
     }
-    if (message.extensions?.length) {
-      obj.extensions = message.extensions.map((e) => ExtensionMetadata.toJSON(e));
-    }
-    if (message.schemaConfigs?.length) {
-      obj.schemaConfigs = message.schemaConfigs.map((e) => SchemaConfig.toJSON(e));
+    if (message.csv !== "") {
+      obj.csv = message.csv;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DatabaseMetadata>): DatabaseMetadata {
-    return DatabaseMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExportColumnClassificationsResponse>): ExportColumnClassificationsResponse {
+    return ExportColumnClassificationsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DatabaseMetadata>): DatabaseMetadata {
-    const message = createBaseDatabaseMetadata();
-    message.name = object.name ?? "";
-    message.schemas = object.schemas?.map((e) => SchemaMetadata.fromPartial(e)) || [];
-    message.characterSet = object.characterSet ?? "";
-    message.collation = object.collation ?? "";
-    message.extensions = object.extensions?.map((e) => ExtensionMetadata.fromPartial(e)) || [];
-    message.schemaConfigs = object.schemaConfigs?.map((e) => SchemaConfig.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<ExportColumnClassificationsResponse>): ExportColumnClassificationsResponse {
+    const message = createBaseExportColumnClassificationsResponse();
+    message.classifications = object.classifications?.map((e) => ColumnClassification.fromPartial(e)) || [];
+    message.csv = object.csv ?? "";
     return message;
   },
 };
 
-function createBaseSchemaMetadata(): SchemaMetadata {
-  return {
-    name: "",
-    tables: [],
-    externalTables: [],
-    views: [],
-    functions: [],
-    procedures: [],
-    streams: [],
-    tasks: [],
-    materializedViews: [],
-  };
+function createBaseExportSchemaDiagramRequest(): ExportSchemaDiagramRequest {
+  return { name: "", schema: "", format: ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED };
 }
 
-export const SchemaMetadata = {
-  encode(message: SchemaMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ExportSchemaDiagramRequest = {
+  encode(message: ExportSchemaDiagramRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    for (const v of message.tables) {
-      TableMetadata.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    for (const v of message.externalTables) {
-      ExternalTableMetadata.encode(v!, writer.uint32(26).fork()).ldelim();
-    }
-    for (const v of message.views) {
-      ViewMetadata.encode(v!, writer.uint32(34).fork()).ldelim();
-    }
-    for (const v of message.functions) {
-      FunctionMetadata.encode(v!, writer.uint32(42).fork()).ldelim();
-    }
-    for (const v of message.procedures) {
-      ProcedureMetadata.encode(v!, writer.uint32(50).fork()).ldelim();
-    }
-    for (const v of message.streams) {
-      StreamMetadata.encode(v!, writer.uint32(58).fork()).ldelim();
-    }
-    for (const v of message.tasks) {
-      TaskMetadata.encode(v!, writer.uint32(66).fork()).ldelim();
+    if (message.schema !== "") {
+      writer.uint32(18).string(message.schema);
     }
-    for (const v of message.materializedViews) {
-      MaterializedViewMetadata.encode(v!, writer.uint32(74).fork()).ldelim();
+    if (message.format !== ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED) {
+      writer.uint32(24).int32(exportSchemaDiagramRequest_FormatToNumber(message.format));
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportSchemaDiagramRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSchemaMetadata();
+    const message = createBaseExportSchemaDiagramRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3590,56 +4200,105 @@ export const SchemaMetadata = {
             break;
           }
 
-          message.tables.push(TableMetadata.decode(reader, reader.uint32()));
+          message.schema = reader.string();
           continue;
         case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.externalTables.push(ExternalTableMetadata.decode(reader, reader.uint32()));
-          continue;
-        case 4:
-          if (tag !== 34) {
+          if (tag !== 24) {
             break;
           }
 
-          message.views.push(ViewMetadata.decode(reader, reader.uint32()));
+          message.format = exportSchemaDiagramRequest_FormatFromJSON(reader.int32());
           continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.functions.push(FunctionMetadata.decode(reader, reader.uint32()));
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
+  fromJSON(object: any): ExportSchemaDiagramRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      format: isSet(object.format)
+        ? exportSchemaDiagramRequest_FormatFromJSON(object.format)
+        : ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED,
+    };
+  },
 
-          message.procedures.push(ProcedureMetadata.decode(reader, reader.uint32()));
-          continue;
-        case 7:
-          if (tag !== 58) {
+  toJSON(message: ExportSchemaDiagramRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (message.format !== ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED) {
+      obj.format = exportSchemaDiagramRequest_FormatToJSON(message.format);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExportSchemaDiagramRequest>): ExportSchemaDiagramRequest {
+    return ExportSchemaDiagramRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExportSchemaDiagramRequest>): ExportSchemaDiagramRequest {
+    const message = createBaseExportSchemaDiagramRequest();
+    message.name = object.name ?? "";
+    message.schema = object.schema ?? "";
+    message.format = object.format ?? ExportSchemaDiagramRequest_Format.FORMAT_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseSchemaDiagram(): SchemaDiagram {
+  return { tables: [], relations: [], source: "" };
+}
+
+export const SchemaDiagram = {
+  encode(message: SchemaDiagram, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.tables) {
+      SchemaDiagram_Table.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.relations) {
+      SchemaDiagram_Relation.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.source !== "") {
+      writer.uint32(26).string(message.source);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaDiagram {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSchemaDiagram();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.streams.push(StreamMetadata.decode(reader, reader.uint32()));
+          message.tables.push(SchemaDiagram_Table.decode(reader, reader.uint32()));
           continue;
-        case 8:
-          if (tag !== 66) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.tasks.push(TaskMetadata.decode(reader, reader.uint32()));
+          message.relations.push(SchemaDiagram_Relation.decode(reader, reader.uint32()));
           continue;
-        case 9:
-          if (tag !== 74) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.materializedViews.push(MaterializedViewMetadata.decode(reader, reader.uint32()));
+          message.source = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3650,105 +4309,72 @@ export const SchemaMetadata = {
     return message;
   },
 
-  fromJSON(object: any): SchemaMetadata {
+  fromJSON(object: any): SchemaDiagram {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      tables: globalThis.Array.isArray(object?.tables) ? object.tables.map((e: any) => TableMetadata.fromJSON(e)) : [],
-      externalTables: globalThis.Array.isArray(object?.externalTables)
-        ? object.externalTables.map((e: any) => ExternalTableMetadata.fromJSON(e))
-        : [],
-      views: globalThis.Array.isArray(object?.views) ? object.views.map((e: any) => ViewMetadata.fromJSON(e)) : [],
-      functions: globalThis.Array.isArray(object?.functions)
-        ? object.functions.map((e: any) => FunctionMetadata.fromJSON(e))
-        : [],
-      procedures: globalThis.Array.isArray(object?.procedures)
-        ? object.procedures.map((e: any) => ProcedureMetadata.fromJSON(e))
-        : [],
-      streams: globalThis.Array.isArray(object?.streams)
-        ? object.streams.map((e: any) => StreamMetadata.fromJSON(e))
+      tables: globalThis.Array.isArray(object?.tables)
+        ? object.tables.map((e: any) => SchemaDiagram_Table.fromJSON(e))
         : [],
-      tasks: globalThis.Array.isArray(object?.tasks) ? object.tasks.map((e: any) => TaskMetadata.fromJSON(e)) : [],
-      materializedViews: globalThis.Array.isArray(object?.materializedViews)
-        ? object.materializedViews.map((e: any) => MaterializedViewMetadata.fromJSON(e))
+      relations: globalThis.Array.isArray(object?.relations)
+        ? object.relations.map((e: any) => SchemaDiagram_Relation.fromJSON(e))
         : [],
+      source: isSet(object.source) ? globalThis.String(object.source) : "",
     };
   },
 
-  toJSON(message: SchemaMetadata): unknown {
+  toJSON(message: SchemaDiagram): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
     if (message.tables?.length) {
-      obj.tables = message.tables.map((e) => TableMetadata.toJSON(e));
-    }
-    if (message.externalTables?.length) {
-      obj.externalTables = message.externalTables.map((e) => ExternalTableMetadata.toJSON(e));
-    }
-    if (message.views?.length) {
-      obj.views = message.views.map((e) => ViewMetadata.toJSON(e));
-    }
-    if (message.functions?.length) {
-      obj.functions = message.functions.map((e) => FunctionMetadata.toJSON(e));
+      obj.tables = message.tables.map((e) => SchemaDiagram_Table.toJSON(e));
     }
-    if (message.procedures?.length) {
-      obj.procedures = message.procedures.map((e) => ProcedureMetadata.toJSON(e));
-    }
-    if (message.streams?.length) {
-      obj.streams = message.streams.map((e) => StreamMetadata.toJSON(e));
-    }
-    if (message.tasks?.length) {
-      obj.tasks = message.tasks.map((e) => TaskMetadata.toJSON(e));
+    if (message.relations?.length) {
+      obj.relations = message.relations.map((e) => SchemaDiagram_Relation.toJSON(e));
     }
-    if (message.materializedViews?.length) {
-      obj.materializedViews = message.materializedViews.map((e) => MaterializedViewMetadata.toJSON(e));
+    if (message.source !== "") {
+      obj.source = message.source;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SchemaMetadata>): SchemaMetadata {
-    return SchemaMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<SchemaDiagram>): SchemaDiagram {
+    return SchemaDiagram.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SchemaMetadata>): SchemaMetadata {
-    const message = createBaseSchemaMetadata();
-    message.name = object.name ?? "";
-    message.tables = object.tables?.map((e) => TableMetadata.fromPartial(e)) || [];
-    message.externalTables = object.externalTables?.map((e) => ExternalTableMetadata.fromPartial(e)) || [];
-    message.views = object.views?.map((e) => ViewMetadata.fromPartial(e)) || [];
-    message.functions = object.functions?.map((e) => FunctionMetadata.fromPartial(e)) || [];
-    message.procedures = object.procedures?.map((e) => ProcedureMetadata.fromPartial(e)) || [];
-    message.streams = object.streams?.map((e) => StreamMetadata.fromPartial(e)) || [];
-    message.tasks = object.tasks?.map((e) => TaskMetadata.fromPartial(e)) || [];
-    message.materializedViews = object.materializedViews?.map((e) => MaterializedViewMetadata.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<SchemaDiagram>): SchemaDiagram {
+    const message = createBaseSchemaDiagram();
+    message.tables = object.tables?.map((e) => SchemaDiagram_Table.fromPartial(e)) || [];
+    message.relations = object.relations?.map((e) => SchemaDiagram_Relation.fromPartial(e)) || [];
+    message.source = object.source ?? "";
     return message;
   },
 };
 
-function createBaseExternalTableMetadata(): ExternalTableMetadata {
-  return { name: "", externalServerName: "", externalDatabaseName: "", columns: [] };
+function createBaseSchemaDiagram_Column(): SchemaDiagram_Column {
+  return { name: "", type: "", nullable: false, primaryKey: false, comment: "" };
 }
 
-export const ExternalTableMetadata = {
-  encode(message: ExternalTableMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const SchemaDiagram_Column = {
+  encode(message: SchemaDiagram_Column, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.externalServerName !== "") {
-      writer.uint32(18).string(message.externalServerName);
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
     }
-    if (message.externalDatabaseName !== "") {
-      writer.uint32(26).string(message.externalDatabaseName);
+    if (message.nullable === true) {
+      writer.uint32(24).bool(message.nullable);
     }
-    for (const v of message.columns) {
-      ColumnMetadata.encode(v!, writer.uint32(34).fork()).ldelim();
+    if (message.primaryKey === true) {
+      writer.uint32(32).bool(message.primaryKey);
+    }
+    if (message.comment !== "") {
+      writer.uint32(42).string(message.comment);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ExternalTableMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaDiagram_Column {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExternalTableMetadata();
+    const message = createBaseSchemaDiagram_Column();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3764,21 +4390,28 @@ export const ExternalTableMetadata = {
             break;
           }
 
-          message.externalServerName = reader.string();
+          message.type = reader.string();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.externalDatabaseName = reader.string();
+          message.nullable = reader.bool();
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.columns.push(ColumnMetadata.decode(reader, reader.uint32()));
+          message.primaryKey = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.comment = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3789,125 +4422,75 @@ export const ExternalTableMetadata = {
     return message;
   },
 
-  fromJSON(object: any): ExternalTableMetadata {
+  fromJSON(object: any): SchemaDiagram_Column {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      externalServerName: isSet(object.externalServerName) ? globalThis.String(object.externalServerName) : "",
-      externalDatabaseName: isSet(object.externalDatabaseName) ? globalThis.String(object.externalDatabaseName) : "",
-      columns: globalThis.Array.isArray(object?.columns)
-        ? object.columns.map((e: any) => ColumnMetadata.fromJSON(e))
-        : [],
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      nullable: isSet(object.nullable) ? globalThis.Boolean(object.nullable) : false,
+      primaryKey: isSet(object.primaryKey) ? globalThis.Boolean(object.primaryKey) : false,
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
     };
   },
 
-  toJSON(message: ExternalTableMetadata): unknown {
+  toJSON(message: SchemaDiagram_Column): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.externalServerName !== "") {
-      obj.externalServerName = message.externalServerName;
+    if (message.type !== "") {
+      obj.type = message.type;
     }
-    if (message.externalDatabaseName !== "") {
-      obj.externalDatabaseName = message.externalDatabaseName;
+    if (message.nullable === true) {
+      obj.nullable = message.nullable;
     }
-    if (message.columns?.length) {
-      obj.columns = message.columns.map((e) => ColumnMetadata.toJSON(e));
+    if (message.primaryKey === true) {
+      obj.primaryKey = message.primaryKey;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ExternalTableMetadata>): ExternalTableMetadata {
-    return ExternalTableMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<SchemaDiagram_Column>): SchemaDiagram_Column {
+    return SchemaDiagram_Column.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ExternalTableMetadata>): ExternalTableMetadata {
-    const message = createBaseExternalTableMetadata();
+  fromPartial(object: DeepPartial<SchemaDiagram_Column>): SchemaDiagram_Column {
+    const message = createBaseSchemaDiagram_Column();
     message.name = object.name ?? "";
-    message.externalServerName = object.externalServerName ?? "";
-    message.externalDatabaseName = object.externalDatabaseName ?? "";
-    message.columns = object.columns?.map((e) => ColumnMetadata.fromPartial(e)) || [];
+    message.type = object.type ?? "";
+    message.nullable = object.nullable ?? false;
+    message.primaryKey = object.primaryKey ?? false;
+    message.comment = object.comment ?? "";
     return message;
   },
 };
 
-function createBaseTableMetadata(): TableMetadata {
-  return {
-    name: "",
-    columns: [],
-    indexes: [],
-    engine: "",
-    collation: "",
-    charset: "",
-    rowCount: Long.ZERO,
-    dataSize: Long.ZERO,
-    indexSize: Long.ZERO,
-    dataFree: Long.ZERO,
-    createOptions: "",
-    comment: "",
-    userComment: "",
-    foreignKeys: [],
-    partitions: [],
-    checkConstraints: [],
-  };
+function createBaseSchemaDiagram_Table(): SchemaDiagram_Table {
+  return { schema: "", name: "", columns: [], comment: "" };
 }
 
-export const TableMetadata = {
-  encode(message: TableMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const SchemaDiagram_Table = {
+  encode(message: SchemaDiagram_Table, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
+    }
     if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+      writer.uint32(18).string(message.name);
     }
     for (const v of message.columns) {
-      ColumnMetadata.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    for (const v of message.indexes) {
-      IndexMetadata.encode(v!, writer.uint32(26).fork()).ldelim();
-    }
-    if (message.engine !== "") {
-      writer.uint32(34).string(message.engine);
-    }
-    if (message.collation !== "") {
-      writer.uint32(42).string(message.collation);
-    }
-    if (message.charset !== "") {
-      writer.uint32(138).string(message.charset);
-    }
-    if (!message.rowCount.isZero()) {
-      writer.uint32(48).int64(message.rowCount);
-    }
-    if (!message.dataSize.isZero()) {
-      writer.uint32(56).int64(message.dataSize);
-    }
-    if (!message.indexSize.isZero()) {
-      writer.uint32(64).int64(message.indexSize);
-    }
-    if (!message.dataFree.isZero()) {
-      writer.uint32(72).int64(message.dataFree);
-    }
-    if (message.createOptions !== "") {
-      writer.uint32(82).string(message.createOptions);
+      SchemaDiagram_Column.encode(v!, writer.uint32(26).fork()).ldelim();
     }
     if (message.comment !== "") {
-      writer.uint32(90).string(message.comment);
-    }
-    if (message.userComment !== "") {
-      writer.uint32(114).string(message.userComment);
-    }
-    for (const v of message.foreignKeys) {
-      ForeignKeyMetadata.encode(v!, writer.uint32(98).fork()).ldelim();
-    }
-    for (const v of message.partitions) {
-      TablePartitionMetadata.encode(v!, writer.uint32(122).fork()).ldelim();
-    }
-    for (const v of message.checkConstraints) {
-      CheckConstraintMetadata.encode(v!, writer.uint32(130).fork()).ldelim();
+      writer.uint32(34).string(message.comment);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TableMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaDiagram_Table {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTableMetadata();
+    const message = createBaseSchemaDiagram_Table();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3916,112 +4499,172 @@ export const TableMetadata = {
             break;
           }
 
-          message.name = reader.string();
+          message.schema = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.columns.push(ColumnMetadata.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.indexes.push(IndexMetadata.decode(reader, reader.uint32()));
+          message.columns.push(SchemaDiagram_Column.decode(reader, reader.uint32()));
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.engine = reader.string();
+          message.comment = reader.string();
           continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.collation = reader.string();
-          continue;
-        case 17:
-          if (tag !== 138) {
-            break;
-          }
+  fromJSON(object: any): SchemaDiagram_Table {
+    return {
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      columns: globalThis.Array.isArray(object?.columns)
+        ? object.columns.map((e: any) => SchemaDiagram_Column.fromJSON(e))
+        : [],
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+    };
+  },
 
-          message.charset = reader.string();
-          continue;
-        case 6:
-          if (tag !== 48) {
-            break;
-          }
+  toJSON(message: SchemaDiagram_Table): unknown {
+    const obj: any = {};
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.columns?.length) {
+      obj.columns = message.columns.map((e) => SchemaDiagram_Column.toJSON(e));
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    return obj;
+  },
 
-          message.rowCount = reader.int64() as Long;
-          continue;
-        case 7:
-          if (tag !== 56) {
-            break;
-          }
+  create(base?: DeepPartial<SchemaDiagram_Table>): SchemaDiagram_Table {
+    return SchemaDiagram_Table.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SchemaDiagram_Table>): SchemaDiagram_Table {
+    const message = createBaseSchemaDiagram_Table();
+    message.schema = object.schema ?? "";
+    message.name = object.name ?? "";
+    message.columns = object.columns?.map((e) => SchemaDiagram_Column.fromPartial(e)) || [];
+    message.comment = object.comment ?? "";
+    return message;
+  },
+};
 
-          message.dataSize = reader.int64() as Long;
-          continue;
-        case 8:
-          if (tag !== 64) {
-            break;
-          }
+function createBaseSchemaDiagram_Relation(): SchemaDiagram_Relation {
+  return {
+    name: "",
+    schema: "",
+    table: "",
+    columns: [],
+    referencedSchema: "",
+    referencedTable: "",
+    referencedColumns: [],
+  };
+}
 
-          message.indexSize = reader.int64() as Long;
-          continue;
-        case 9:
-          if (tag !== 72) {
+export const SchemaDiagram_Relation = {
+  encode(message: SchemaDiagram_Relation, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.schema !== "") {
+      writer.uint32(18).string(message.schema);
+    }
+    if (message.table !== "") {
+      writer.uint32(26).string(message.table);
+    }
+    for (const v of message.columns) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.referencedSchema !== "") {
+      writer.uint32(42).string(message.referencedSchema);
+    }
+    if (message.referencedTable !== "") {
+      writer.uint32(50).string(message.referencedTable);
+    }
+    for (const v of message.referencedColumns) {
+      writer.uint32(58).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaDiagram_Relation {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSchemaDiagram_Relation();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.dataFree = reader.int64() as Long;
+          message.name = reader.string();
           continue;
-        case 10:
-          if (tag !== 82) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.createOptions = reader.string();
+          message.schema = reader.string();
           continue;
-        case 11:
-          if (tag !== 90) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.comment = reader.string();
+          message.table = reader.string();
           continue;
-        case 14:
-          if (tag !== 114) {
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.userComment = reader.string();
+          message.columns.push(reader.string());
           continue;
-        case 12:
-          if (tag !== 98) {
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.foreignKeys.push(ForeignKeyMetadata.decode(reader, reader.uint32()));
+          message.referencedSchema = reader.string();
           continue;
-        case 15:
-          if (tag !== 122) {
+        case 6:
+          if (tag !== 50) {
             break;
           }
 
-          message.partitions.push(TablePartitionMetadata.decode(reader, reader.uint32()));
+          message.referencedTable = reader.string();
           continue;
-        case 16:
-          if (tag !== 130) {
+        case 7:
+          if (tag !== 58) {
             break;
           }
 
-          message.checkConstraints.push(CheckConstraintMetadata.decode(reader, reader.uint32()));
+          message.referencedColumns.push(reader.string());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4032,142 +4675,84 @@ export const TableMetadata = {
     return message;
   },
 
-  fromJSON(object: any): TableMetadata {
+  fromJSON(object: any): SchemaDiagram_Relation {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      columns: globalThis.Array.isArray(object?.columns)
-        ? object.columns.map((e: any) => ColumnMetadata.fromJSON(e))
-        : [],
-      indexes: globalThis.Array.isArray(object?.indexes)
-        ? object.indexes.map((e: any) => IndexMetadata.fromJSON(e))
-        : [],
-      engine: isSet(object.engine) ? globalThis.String(object.engine) : "",
-      collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
-      charset: isSet(object.charset) ? globalThis.String(object.charset) : "",
-      rowCount: isSet(object.rowCount) ? Long.fromValue(object.rowCount) : Long.ZERO,
-      dataSize: isSet(object.dataSize) ? Long.fromValue(object.dataSize) : Long.ZERO,
-      indexSize: isSet(object.indexSize) ? Long.fromValue(object.indexSize) : Long.ZERO,
-      dataFree: isSet(object.dataFree) ? Long.fromValue(object.dataFree) : Long.ZERO,
-      createOptions: isSet(object.createOptions) ? globalThis.String(object.createOptions) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      userComment: isSet(object.userComment) ? globalThis.String(object.userComment) : "",
-      foreignKeys: globalThis.Array.isArray(object?.foreignKeys)
-        ? object.foreignKeys.map((e: any) => ForeignKeyMetadata.fromJSON(e))
-        : [],
-      partitions: globalThis.Array.isArray(object?.partitions)
-        ? object.partitions.map((e: any) => TablePartitionMetadata.fromJSON(e))
-        : [],
-      checkConstraints: globalThis.Array.isArray(object?.checkConstraints)
-        ? object.checkConstraints.map((e: any) => CheckConstraintMetadata.fromJSON(e))
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      columns: globalThis.Array.isArray(object?.columns) ? object.columns.map((e: any) => globalThis.String(e)) : [],
+      referencedSchema: isSet(object.referencedSchema) ? globalThis.String(object.referencedSchema) : "",
+      referencedTable: isSet(object.referencedTable) ? globalThis.String(object.referencedTable) : "",
+      referencedColumns: globalThis.Array.isArray(object?.referencedColumns)
+        ? object.referencedColumns.map((e: any) => globalThis.String(e))
         : [],
     };
   },
 
-  toJSON(message: TableMetadata): unknown {
+  toJSON(message: SchemaDiagram_Relation): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.columns?.length) {
-      obj.columns = message.columns.map((e) => ColumnMetadata.toJSON(e));
-    }
-    if (message.indexes?.length) {
-      obj.indexes = message.indexes.map((e) => IndexMetadata.toJSON(e));
-    }
-    if (message.engine !== "") {
-      obj.engine = message.engine;
-    }
-    if (message.collation !== "") {
-      obj.collation = message.collation;
-    }
-    if (message.charset !== "") {
-      obj.charset = message.charset;
-    }
-    if (!message.rowCount.isZero()) {
-      obj.rowCount = (message.rowCount || Long.ZERO).toString();
-    }
-    if (!message.dataSize.isZero()) {
-      obj.dataSize = (message.dataSize || Long.ZERO).toString();
-    }
-    if (!message.indexSize.isZero()) {
-      obj.indexSize = (message.indexSize || Long.ZERO).toString();
-    }
-    if (!message.dataFree.isZero()) {
-      obj.dataFree = (message.dataFree || Long.ZERO).toString();
-    }
-    if (message.createOptions !== "") {
-      obj.createOptions = message.createOptions;
+    if (message.schema !== "") {
+      obj.schema = message.schema;
     }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
+    if (message.table !== "") {
+      obj.table = message.table;
     }
-    if (message.userComment !== "") {
-      obj.userComment = message.userComment;
+    if (message.columns?.length) {
+      obj.columns = message.columns;
     }
-    if (message.foreignKeys?.length) {
-      obj.foreignKeys = message.foreignKeys.map((e) => ForeignKeyMetadata.toJSON(e));
+    if (message.referencedSchema !== "") {
+      obj.referencedSchema = message.referencedSchema;
     }
-    if (message.partitions?.length) {
-      obj.partitions = message.partitions.map((e) => TablePartitionMetadata.toJSON(e));
+    if (message.referencedTable !== "") {
+      obj.referencedTable = message.referencedTable;
     }
-    if (message.checkConstraints?.length) {
-      obj.checkConstraints = message.checkConstraints.map((e) => CheckConstraintMetadata.toJSON(e));
+    if (message.referencedColumns?.length) {
+      obj.referencedColumns = message.referencedColumns;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<TableMetadata>): TableMetadata {
-    return TableMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<SchemaDiagram_Relation>): SchemaDiagram_Relation {
+    return SchemaDiagram_Relation.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<TableMetadata>): TableMetadata {
-    const message = createBaseTableMetadata();
+  fromPartial(object: DeepPartial<SchemaDiagram_Relation>): SchemaDiagram_Relation {
+    const message = createBaseSchemaDiagram_Relation();
     message.name = object.name ?? "";
-    message.columns = object.columns?.map((e) => ColumnMetadata.fromPartial(e)) || [];
-    message.indexes = object.indexes?.map((e) => IndexMetadata.fromPartial(e)) || [];
-    message.engine = object.engine ?? "";
-    message.collation = object.collation ?? "";
-    message.charset = object.charset ?? "";
-    message.rowCount = (object.rowCount !== undefined && object.rowCount !== null)
-      ? Long.fromValue(object.rowCount)
-      : Long.ZERO;
-    message.dataSize = (object.dataSize !== undefined && object.dataSize !== null)
-      ? Long.fromValue(object.dataSize)
-      : Long.ZERO;
-    message.indexSize = (object.indexSize !== undefined && object.indexSize !== null)
-      ? Long.fromValue(object.indexSize)
-      : Long.ZERO;
-    message.dataFree = (object.dataFree !== undefined && object.dataFree !== null)
-      ? Long.fromValue(object.dataFree)
-      : Long.ZERO;
-    message.createOptions = object.createOptions ?? "";
-    message.comment = object.comment ?? "";
-    message.userComment = object.userComment ?? "";
-    message.foreignKeys = object.foreignKeys?.map((e) => ForeignKeyMetadata.fromPartial(e)) || [];
-    message.partitions = object.partitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
-    message.checkConstraints = object.checkConstraints?.map((e) => CheckConstraintMetadata.fromPartial(e)) || [];
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.columns = object.columns?.map((e) => e) || [];
+    message.referencedSchema = object.referencedSchema ?? "";
+    message.referencedTable = object.referencedTable ?? "";
+    message.referencedColumns = object.referencedColumns?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseCheckConstraintMetadata(): CheckConstraintMetadata {
-  return { name: "", expression: "" };
+function createBaseGetDatabaseMetadataRequest(): GetDatabaseMetadataRequest {
+  return { name: "", view: DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED, filter: "" };
 }
 
-export const CheckConstraintMetadata = {
-  encode(message: CheckConstraintMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const GetDatabaseMetadataRequest = {
+  encode(message: GetDatabaseMetadataRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.expression !== "") {
-      writer.uint32(18).string(message.expression);
+    if (message.view !== DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED) {
+      writer.uint32(16).int32(databaseMetadataViewToNumber(message.view));
+    }
+    if (message.filter !== "") {
+      writer.uint32(26).string(message.filter);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CheckConstraintMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseMetadataRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCheckConstraintMetadata();
+    const message = createBaseGetDatabaseMetadataRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4179,11 +4764,18 @@ export const CheckConstraintMetadata = {
           message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.expression = reader.string();
+          message.view = databaseMetadataViewFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.filter = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4194,73 +4786,61 @@ export const CheckConstraintMetadata = {
     return message;
   },
 
-  fromJSON(object: any): CheckConstraintMetadata {
+  fromJSON(object: any): GetDatabaseMetadataRequest {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
+      view: isSet(object.view)
+        ? databaseMetadataViewFromJSON(object.view)
+        : DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED,
+      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
     };
   },
 
-  toJSON(message: CheckConstraintMetadata): unknown {
+  toJSON(message: GetDatabaseMetadataRequest): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.expression !== "") {
-      obj.expression = message.expression;
+    if (message.view !== DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED) {
+      obj.view = databaseMetadataViewToJSON(message.view);
+    }
+    if (message.filter !== "") {
+      obj.filter = message.filter;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<CheckConstraintMetadata>): CheckConstraintMetadata {
-    return CheckConstraintMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetDatabaseMetadataRequest>): GetDatabaseMetadataRequest {
+    return GetDatabaseMetadataRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<CheckConstraintMetadata>): CheckConstraintMetadata {
-    const message = createBaseCheckConstraintMetadata();
+  fromPartial(object: DeepPartial<GetDatabaseMetadataRequest>): GetDatabaseMetadataRequest {
+    const message = createBaseGetDatabaseMetadataRequest();
     message.name = object.name ?? "";
-    message.expression = object.expression ?? "";
+    message.view = object.view ?? DatabaseMetadataView.DATABASE_METADATA_VIEW_UNSPECIFIED;
+    message.filter = object.filter ?? "";
     return message;
   },
 };
 
-function createBaseTablePartitionMetadata(): TablePartitionMetadata {
-  return {
-    name: "",
-    type: TablePartitionMetadata_Type.TYPE_UNSPECIFIED,
-    expression: "",
-    value: "",
-    useDefault: "",
-    subpartitions: [],
-  };
+function createBaseUpdateDatabaseMetadataRequest(): UpdateDatabaseMetadataRequest {
+  return { databaseMetadata: undefined, updateMask: undefined };
 }
 
-export const TablePartitionMetadata = {
-  encode(message: TablePartitionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.type !== TablePartitionMetadata_Type.TYPE_UNSPECIFIED) {
-      writer.uint32(16).int32(tablePartitionMetadata_TypeToNumber(message.type));
-    }
-    if (message.expression !== "") {
-      writer.uint32(26).string(message.expression);
-    }
-    if (message.value !== "") {
-      writer.uint32(34).string(message.value);
-    }
-    if (message.useDefault !== "") {
-      writer.uint32(42).string(message.useDefault);
+export const UpdateDatabaseMetadataRequest = {
+  encode(message: UpdateDatabaseMetadataRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.databaseMetadata !== undefined) {
+      DatabaseMetadata.encode(message.databaseMetadata, writer.uint32(10).fork()).ldelim();
     }
-    for (const v of message.subpartitions) {
-      TablePartitionMetadata.encode(v!, writer.uint32(50).fork()).ldelim();
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TablePartitionMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateDatabaseMetadataRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTablePartitionMetadata();
+    const message = createBaseUpdateDatabaseMetadataRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4269,42 +4849,14 @@ export const TablePartitionMetadata = {
             break;
           }
 
-          message.name = reader.string();
+          message.databaseMetadata = DatabaseMetadata.decode(reader, reader.uint32());
           continue;
         case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.type = tablePartitionMetadata_TypeFromJSON(reader.int32());
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.expression = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.value = reader.string();
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.useDefault = reader.string();
-          continue;
-        case 6:
-          if (tag !== 50) {
+          if (tag !== 18) {
             break;
           }
 
-          message.subpartitions.push(TablePartitionMetadata.decode(reader, reader.uint32()));
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4315,133 +4867,59 @@ export const TablePartitionMetadata = {
     return message;
   },
 
-  fromJSON(object: any): TablePartitionMetadata {
+  fromJSON(object: any): UpdateDatabaseMetadataRequest {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      type: isSet(object.type)
-        ? tablePartitionMetadata_TypeFromJSON(object.type)
-        : TablePartitionMetadata_Type.TYPE_UNSPECIFIED,
-      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
-      value: isSet(object.value) ? globalThis.String(object.value) : "",
-      useDefault: isSet(object.useDefault) ? globalThis.String(object.useDefault) : "",
-      subpartitions: globalThis.Array.isArray(object?.subpartitions)
-        ? object.subpartitions.map((e: any) => TablePartitionMetadata.fromJSON(e))
-        : [],
+      databaseMetadata: isSet(object.databaseMetadata) ? DatabaseMetadata.fromJSON(object.databaseMetadata) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
     };
   },
 
-  toJSON(message: TablePartitionMetadata): unknown {
+  toJSON(message: UpdateDatabaseMetadataRequest): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.type !== TablePartitionMetadata_Type.TYPE_UNSPECIFIED) {
-      obj.type = tablePartitionMetadata_TypeToJSON(message.type);
-    }
-    if (message.expression !== "") {
-      obj.expression = message.expression;
-    }
-    if (message.value !== "") {
-      obj.value = message.value;
-    }
-    if (message.useDefault !== "") {
-      obj.useDefault = message.useDefault;
+    if (message.databaseMetadata !== undefined) {
+      obj.databaseMetadata = DatabaseMetadata.toJSON(message.databaseMetadata);
     }
-    if (message.subpartitions?.length) {
-      obj.subpartitions = message.subpartitions.map((e) => TablePartitionMetadata.toJSON(e));
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<TablePartitionMetadata>): TablePartitionMetadata {
-    return TablePartitionMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<UpdateDatabaseMetadataRequest>): UpdateDatabaseMetadataRequest {
+    return UpdateDatabaseMetadataRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<TablePartitionMetadata>): TablePartitionMetadata {
-    const message = createBaseTablePartitionMetadata();
-    message.name = object.name ?? "";
-    message.type = object.type ?? TablePartitionMetadata_Type.TYPE_UNSPECIFIED;
-    message.expression = object.expression ?? "";
-    message.value = object.value ?? "";
-    message.useDefault = object.useDefault ?? "";
-    message.subpartitions = object.subpartitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<UpdateDatabaseMetadataRequest>): UpdateDatabaseMetadataRequest {
+    const message = createBaseUpdateDatabaseMetadataRequest();
+    message.databaseMetadata = (object.databaseMetadata !== undefined && object.databaseMetadata !== null)
+      ? DatabaseMetadata.fromPartial(object.databaseMetadata)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
     return message;
   },
 };
 
-function createBaseColumnMetadata(): ColumnMetadata {
-  return {
-    name: "",
-    position: 0,
-    hasDefault: false,
-    defaultNull: undefined,
-    defaultString: undefined,
-    defaultExpression: undefined,
-    onUpdate: "",
-    nullable: false,
-    type: "",
-    characterSet: "",
-    collation: "",
-    comment: "",
-    userComment: "",
-    effectiveMaskingLevel: MaskingLevel.MASKING_LEVEL_UNSPECIFIED,
-    generation: undefined,
-  };
+function createBaseGetDatabaseSchemaRequest(): GetDatabaseSchemaRequest {
+  return { name: "", sdlFormat: false, concise: false };
 }
 
-export const ColumnMetadata = {
-  encode(message: ColumnMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const GetDatabaseSchemaRequest = {
+  encode(message: GetDatabaseSchemaRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.position !== 0) {
-      writer.uint32(16).int32(message.position);
-    }
-    if (message.hasDefault === true) {
-      writer.uint32(24).bool(message.hasDefault);
-    }
-    if (message.defaultNull !== undefined) {
-      writer.uint32(32).bool(message.defaultNull);
-    }
-    if (message.defaultString !== undefined) {
-      writer.uint32(42).string(message.defaultString);
-    }
-    if (message.defaultExpression !== undefined) {
-      writer.uint32(50).string(message.defaultExpression);
-    }
-    if (message.onUpdate !== "") {
-      writer.uint32(122).string(message.onUpdate);
-    }
-    if (message.nullable === true) {
-      writer.uint32(56).bool(message.nullable);
-    }
-    if (message.type !== "") {
-      writer.uint32(66).string(message.type);
-    }
-    if (message.characterSet !== "") {
-      writer.uint32(74).string(message.characterSet);
-    }
-    if (message.collation !== "") {
-      writer.uint32(82).string(message.collation);
-    }
-    if (message.comment !== "") {
-      writer.uint32(90).string(message.comment);
-    }
-    if (message.userComment !== "") {
-      writer.uint32(106).string(message.userComment);
-    }
-    if (message.effectiveMaskingLevel !== MaskingLevel.MASKING_LEVEL_UNSPECIFIED) {
-      writer.uint32(112).int32(maskingLevelToNumber(message.effectiveMaskingLevel));
+    if (message.sdlFormat === true) {
+      writer.uint32(16).bool(message.sdlFormat);
     }
-    if (message.generation !== undefined) {
-      GenerationMetadata.encode(message.generation, writer.uint32(130).fork()).ldelim();
+    if (message.concise === true) {
+      writer.uint32(24).bool(message.concise);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseSchemaRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseColumnMetadata();
+    const message = createBaseGetDatabaseSchemaRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4457,98 +4935,14 @@ export const ColumnMetadata = {
             break;
           }
 
-          message.position = reader.int32();
+          message.sdlFormat = reader.bool();
           continue;
         case 3:
           if (tag !== 24) {
             break;
           }
 
-          message.hasDefault = reader.bool();
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.defaultNull = reader.bool();
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.defaultString = reader.string();
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.defaultExpression = reader.string();
-          continue;
-        case 15:
-          if (tag !== 122) {
-            break;
-          }
-
-          message.onUpdate = reader.string();
-          continue;
-        case 7:
-          if (tag !== 56) {
-            break;
-          }
-
-          message.nullable = reader.bool();
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.type = reader.string();
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.characterSet = reader.string();
-          continue;
-        case 10:
-          if (tag !== 82) {
-            break;
-          }
-
-          message.collation = reader.string();
-          continue;
-        case 11:
-          if (tag !== 90) {
-            break;
-          }
-
-          message.comment = reader.string();
-          continue;
-        case 13:
-          if (tag !== 106) {
-            break;
-          }
-
-          message.userComment = reader.string();
-          continue;
-        case 14:
-          if (tag !== 112) {
-            break;
-          }
-
-          message.effectiveMaskingLevel = maskingLevelFromJSON(reader.int32());
-          continue;
-        case 16:
-          if (tag !== 130) {
-            break;
-          }
-
-          message.generation = GenerationMetadata.decode(reader, reader.uint32());
+          message.concise = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4559,139 +4953,95 @@ export const ColumnMetadata = {
     return message;
   },
 
-  fromJSON(object: any): ColumnMetadata {
+  fromJSON(object: any): GetDatabaseSchemaRequest {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      position: isSet(object.position) ? globalThis.Number(object.position) : 0,
-      hasDefault: isSet(object.hasDefault) ? globalThis.Boolean(object.hasDefault) : false,
-      defaultNull: isSet(object.defaultNull) ? globalThis.Boolean(object.defaultNull) : undefined,
-      defaultString: isSet(object.defaultString) ? globalThis.String(object.defaultString) : undefined,
-      defaultExpression: isSet(object.defaultExpression) ? globalThis.String(object.defaultExpression) : undefined,
-      onUpdate: isSet(object.onUpdate) ? globalThis.String(object.onUpdate) : "",
-      nullable: isSet(object.nullable) ? globalThis.Boolean(object.nullable) : false,
-      type: isSet(object.type) ? globalThis.String(object.type) : "",
-      characterSet: isSet(object.characterSet) ? globalThis.String(object.characterSet) : "",
-      collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      userComment: isSet(object.userComment) ? globalThis.String(object.userComment) : "",
-      effectiveMaskingLevel: isSet(object.effectiveMaskingLevel)
-        ? maskingLevelFromJSON(object.effectiveMaskingLevel)
-        : MaskingLevel.MASKING_LEVEL_UNSPECIFIED,
-      generation: isSet(object.generation) ? GenerationMetadata.fromJSON(object.generation) : undefined,
+      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
+      concise: isSet(object.concise) ? globalThis.Boolean(object.concise) : false,
     };
   },
 
-  toJSON(message: ColumnMetadata): unknown {
+  toJSON(message: GetDatabaseSchemaRequest): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.position !== 0) {
-      obj.position = Math.round(message.position);
-    }
-    if (message.hasDefault === true) {
-      obj.hasDefault = message.hasDefault;
-    }
-    if (message.defaultNull !== undefined) {
-      obj.defaultNull = message.defaultNull;
-    }
-    if (message.defaultString !== undefined) {
-      obj.defaultString = message.defaultString;
-    }
-    if (message.defaultExpression !== undefined) {
-      obj.defaultExpression = message.defaultExpression;
-    }
-    if (message.onUpdate !== "") {
-      obj.onUpdate = message.onUpdate;
-    }
-    if (message.nullable === true) {
-      obj.nullable = message.nullable;
-    }
-    if (message.type !== "") {
-      obj.type = message.type;
-    }
-    if (message.characterSet !== "") {
-      obj.characterSet = message.characterSet;
-    }
-    if (message.collation !== "") {
-      obj.collation = message.collation;
-    }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
-    }
-    if (message.userComment !== "") {
-      obj.userComment = message.userComment;
-    }
-    if (message.effectiveMaskingLevel !== MaskingLevel.MASKING_LEVEL_UNSPECIFIED) {
-      obj.effectiveMaskingLevel = maskingLevelToJSON(message.effectiveMaskingLevel);
+    if (message.sdlFormat === true) {
+      obj.sdlFormat = message.sdlFormat;
     }
-    if (message.generation !== undefined) {
-      obj.generation = GenerationMetadata.toJSON(message.generation);
+    if (message.concise === true) {
+      obj.concise = message.concise;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ColumnMetadata>): ColumnMetadata {
-    return ColumnMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetDatabaseSchemaRequest>): GetDatabaseSchemaRequest {
+    return GetDatabaseSchemaRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ColumnMetadata>): ColumnMetadata {
-    const message = createBaseColumnMetadata();
+  fromPartial(object: DeepPartial<GetDatabaseSchemaRequest>): GetDatabaseSchemaRequest {
+    const message = createBaseGetDatabaseSchemaRequest();
     message.name = object.name ?? "";
-    message.position = object.position ?? 0;
-    message.hasDefault = object.hasDefault ?? false;
-    message.defaultNull = object.defaultNull ?? undefined;
-    message.defaultString = object.defaultString ?? undefined;
-    message.defaultExpression = object.defaultExpression ?? undefined;
-    message.onUpdate = object.onUpdate ?? "";
-    message.nullable = object.nullable ?? false;
-    message.type = object.type ?? "";
-    message.characterSet = object.characterSet ?? "";
-    message.collation = object.collation ?? "";
-    message.comment = object.comment ?? "";
-    message.userComment = object.userComment ?? "";
-    message.effectiveMaskingLevel = object.effectiveMaskingLevel ?? MaskingLevel.MASKING_LEVEL_UNSPECIFIED;
-    message.generation = (object.generation !== undefined && object.generation !== null)
-      ? GenerationMetadata.fromPartial(object.generation)
-      : undefined;
+    message.sdlFormat = object.sdlFormat ?? false;
+    message.concise = object.concise ?? false;
     return message;
   },
 };
 
-function createBaseGenerationMetadata(): GenerationMetadata {
-  return { type: GenerationMetadata_Type.TYPE_UNSPECIFIED, expression: "" };
+function createBaseDiffSchemaRequest(): DiffSchemaRequest {
+  return { name: "", schema: undefined, changeHistory: undefined, sdlFormat: false };
 }
 
-export const GenerationMetadata = {
-  encode(message: GenerationMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.type !== GenerationMetadata_Type.TYPE_UNSPECIFIED) {
-      writer.uint32(8).int32(generationMetadata_TypeToNumber(message.type));
+export const DiffSchemaRequest = {
+  encode(message: DiffSchemaRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.expression !== "") {
-      writer.uint32(18).string(message.expression);
+    if (message.schema !== undefined) {
+      writer.uint32(18).string(message.schema);
+    }
+    if (message.changeHistory !== undefined) {
+      writer.uint32(26).string(message.changeHistory);
+    }
+    if (message.sdlFormat === true) {
+      writer.uint32(32).bool(message.sdlFormat);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GenerationMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DiffSchemaRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGenerationMetadata();
+    const message = createBaseDiffSchemaRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.type = generationMetadata_TypeFromJSON(reader.int32());
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.expression = reader.string();
+          message.schema = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.changeHistory = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.sdlFormat = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4702,62 +5052,61 @@ export const GenerationMetadata = {
     return message;
   },
 
-  fromJSON(object: any): GenerationMetadata {
+  fromJSON(object: any): DiffSchemaRequest {
     return {
-      type: isSet(object.type)
-        ? generationMetadata_TypeFromJSON(object.type)
-        : GenerationMetadata_Type.TYPE_UNSPECIFIED,
-      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : undefined,
+      changeHistory: isSet(object.changeHistory) ? globalThis.String(object.changeHistory) : undefined,
+      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
     };
   },
 
-  toJSON(message: GenerationMetadata): unknown {
+  toJSON(message: DiffSchemaRequest): unknown {
     const obj: any = {};
-    if (message.type !== GenerationMetadata_Type.TYPE_UNSPECIFIED) {
-      obj.type = generationMetadata_TypeToJSON(message.type);
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.expression !== "") {
-      obj.expression = message.expression;
+    if (message.schema !== undefined) {
+      obj.schema = message.schema;
+    }
+    if (message.changeHistory !== undefined) {
+      obj.changeHistory = message.changeHistory;
+    }
+    if (message.sdlFormat === true) {
+      obj.sdlFormat = message.sdlFormat;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<GenerationMetadata>): GenerationMetadata {
-    return GenerationMetadata.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<GenerationMetadata>): GenerationMetadata {
-    const message = createBaseGenerationMetadata();
-    message.type = object.type ?? GenerationMetadata_Type.TYPE_UNSPECIFIED;
-    message.expression = object.expression ?? "";
-    return message;
+  create(base?: DeepPartial<DiffSchemaRequest>): DiffSchemaRequest {
+    return DiffSchemaRequest.fromPartial(base ?? {});
   },
-};
-
-function createBaseViewMetadata(): ViewMetadata {
-  return { name: "", definition: "", comment: "", dependentColumns: [] };
+  fromPartial(object: DeepPartial<DiffSchemaRequest>): DiffSchemaRequest {
+    const message = createBaseDiffSchemaRequest();
+    message.name = object.name ?? "";
+    message.schema = object.schema ?? undefined;
+    message.changeHistory = object.changeHistory ?? undefined;
+    message.sdlFormat = object.sdlFormat ?? false;
+    return message;
+  },
+};
+
+function createBaseDiffSchemaResponse(): DiffSchemaResponse {
+  return { diff: "" };
 }
 
-export const ViewMetadata = {
-  encode(message: ViewMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.definition !== "") {
-      writer.uint32(18).string(message.definition);
-    }
-    if (message.comment !== "") {
-      writer.uint32(26).string(message.comment);
-    }
-    for (const v of message.dependentColumns) {
-      DependentColumn.encode(v!, writer.uint32(34).fork()).ldelim();
+export const DiffSchemaResponse = {
+  encode(message: DiffSchemaResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.diff !== "") {
+      writer.uint32(10).string(message.diff);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ViewMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DiffSchemaResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseViewMetadata();
+    const message = createBaseDiffSchemaResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4766,28 +5115,7 @@ export const ViewMetadata = {
             break;
           }
 
-          message.name = reader.string();
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.definition = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.comment = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.dependentColumns.push(DependentColumn.decode(reader, reader.uint32()));
+          message.diff = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4798,69 +5126,50 @@ export const ViewMetadata = {
     return message;
   },
 
-  fromJSON(object: any): ViewMetadata {
-    return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      dependentColumns: globalThis.Array.isArray(object?.dependentColumns)
-        ? object.dependentColumns.map((e: any) => DependentColumn.fromJSON(e))
-        : [],
-    };
+  fromJSON(object: any): DiffSchemaResponse {
+    return { diff: isSet(object.diff) ? globalThis.String(object.diff) : "" };
   },
 
-  toJSON(message: ViewMetadata): unknown {
+  toJSON(message: DiffSchemaResponse): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
-    }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
-    }
-    if (message.dependentColumns?.length) {
-      obj.dependentColumns = message.dependentColumns.map((e) => DependentColumn.toJSON(e));
+    if (message.diff !== "") {
+      obj.diff = message.diff;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ViewMetadata>): ViewMetadata {
-    return ViewMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<DiffSchemaResponse>): DiffSchemaResponse {
+    return DiffSchemaResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ViewMetadata>): ViewMetadata {
-    const message = createBaseViewMetadata();
-    message.name = object.name ?? "";
-    message.definition = object.definition ?? "";
-    message.comment = object.comment ?? "";
-    message.dependentColumns = object.dependentColumns?.map((e) => DependentColumn.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<DiffSchemaResponse>): DiffSchemaResponse {
+    const message = createBaseDiffSchemaResponse();
+    message.diff = object.diff ?? "";
     return message;
   },
 };
 
-function createBaseDependentColumn(): DependentColumn {
-  return { schema: "", table: "", column: "" };
+function createBaseGetDatabaseSchemaAsOfRequest(): GetDatabaseSchemaAsOfRequest {
+  return { name: "", time: undefined, sdlFormat: false };
 }
 
-export const DependentColumn = {
-  encode(message: DependentColumn, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.schema !== "") {
-      writer.uint32(10).string(message.schema);
+export const GetDatabaseSchemaAsOfRequest = {
+  encode(message: GetDatabaseSchemaAsOfRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.table !== "") {
-      writer.uint32(18).string(message.table);
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(18).fork()).ldelim();
     }
-    if (message.column !== "") {
-      writer.uint32(26).string(message.column);
+    if (message.sdlFormat === true) {
+      writer.uint32(24).bool(message.sdlFormat);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DependentColumn {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetDatabaseSchemaAsOfRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDependentColumn();
+    const message = createBaseGetDatabaseSchemaAsOfRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4869,21 +5178,21 @@ export const DependentColumn = {
             break;
           }
 
-          message.schema = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.table = reader.string();
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.column = reader.string();
+          message.sdlFormat = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4894,65 +5203,62 @@ export const DependentColumn = {
     return message;
   },
 
-  fromJSON(object: any): DependentColumn {
+  fromJSON(object: any): GetDatabaseSchemaAsOfRequest {
     return {
-      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
-      table: isSet(object.table) ? globalThis.String(object.table) : "",
-      column: isSet(object.column) ? globalThis.String(object.column) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
     };
   },
 
-  toJSON(message: DependentColumn): unknown {
+  toJSON(message: GetDatabaseSchemaAsOfRequest): unknown {
     const obj: any = {};
-    if (message.schema !== "") {
-      obj.schema = message.schema;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.table !== "") {
-      obj.table = message.table;
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
     }
-    if (message.column !== "") {
-      obj.column = message.column;
+    if (message.sdlFormat === true) {
+      obj.sdlFormat = message.sdlFormat;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DependentColumn>): DependentColumn {
-    return DependentColumn.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetDatabaseSchemaAsOfRequest>): GetDatabaseSchemaAsOfRequest {
+    return GetDatabaseSchemaAsOfRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DependentColumn>): DependentColumn {
-    const message = createBaseDependentColumn();
-    message.schema = object.schema ?? "";
-    message.table = object.table ?? "";
-    message.column = object.column ?? "";
+  fromPartial(object: DeepPartial<GetDatabaseSchemaAsOfRequest>): GetDatabaseSchemaAsOfRequest {
+    const message = createBaseGetDatabaseSchemaAsOfRequest();
+    message.name = object.name ?? "";
+    message.time = object.time ?? undefined;
+    message.sdlFormat = object.sdlFormat ?? false;
     return message;
   },
 };
 
-function createBaseMaterializedViewMetadata(): MaterializedViewMetadata {
-  return { name: "", definition: "", comment: "", dependentColumns: [] };
+function createBaseDiffSchemaSnapshotsRequest(): DiffSchemaSnapshotsRequest {
+  return { name: "", sourceTime: undefined, targetTime: undefined };
 }
 
-export const MaterializedViewMetadata = {
-  encode(message: MaterializedViewMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const DiffSchemaSnapshotsRequest = {
+  encode(message: DiffSchemaSnapshotsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.definition !== "") {
-      writer.uint32(18).string(message.definition);
-    }
-    if (message.comment !== "") {
-      writer.uint32(26).string(message.comment);
+    if (message.sourceTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.sourceTime), writer.uint32(18).fork()).ldelim();
     }
-    for (const v of message.dependentColumns) {
-      DependentColumn.encode(v!, writer.uint32(34).fork()).ldelim();
+    if (message.targetTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.targetTime), writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): MaterializedViewMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DiffSchemaSnapshotsRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMaterializedViewMetadata();
+    const message = createBaseDiffSchemaSnapshotsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4968,21 +5274,14 @@ export const MaterializedViewMetadata = {
             break;
           }
 
-          message.definition = reader.string();
+          message.sourceTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.comment = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.dependentColumns.push(DependentColumn.decode(reader, reader.uint32()));
+          message.targetTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4993,66 +5292,98 @@ export const MaterializedViewMetadata = {
     return message;
   },
 
-  fromJSON(object: any): MaterializedViewMetadata {
+  fromJSON(object: any): DiffSchemaSnapshotsRequest {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      dependentColumns: globalThis.Array.isArray(object?.dependentColumns)
-        ? object.dependentColumns.map((e: any) => DependentColumn.fromJSON(e))
-        : [],
+      sourceTime: isSet(object.sourceTime) ? fromJsonTimestamp(object.sourceTime) : undefined,
+      targetTime: isSet(object.targetTime) ? fromJsonTimestamp(object.targetTime) : undefined,
     };
   },
 
-  toJSON(message: MaterializedViewMetadata): unknown {
+  toJSON(message: DiffSchemaSnapshotsRequest): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
-    }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
+    if (message.sourceTime !== undefined) {
+      obj.sourceTime = message.sourceTime.toISOString();
     }
-    if (message.dependentColumns?.length) {
-      obj.dependentColumns = message.dependentColumns.map((e) => DependentColumn.toJSON(e));
+    if (message.targetTime !== undefined) {
+      obj.targetTime = message.targetTime.toISOString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<MaterializedViewMetadata>): MaterializedViewMetadata {
-    return MaterializedViewMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<DiffSchemaSnapshotsRequest>): DiffSchemaSnapshotsRequest {
+    return DiffSchemaSnapshotsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<MaterializedViewMetadata>): MaterializedViewMetadata {
-    const message = createBaseMaterializedViewMetadata();
+  fromPartial(object: DeepPartial<DiffSchemaSnapshotsRequest>): DiffSchemaSnapshotsRequest {
+    const message = createBaseDiffSchemaSnapshotsRequest();
     message.name = object.name ?? "";
-    message.definition = object.definition ?? "";
-    message.comment = object.comment ?? "";
-    message.dependentColumns = object.dependentColumns?.map((e) => DependentColumn.fromPartial(e)) || [];
+    message.sourceTime = object.sourceTime ?? undefined;
+    message.targetTime = object.targetTime ?? undefined;
     return message;
   },
 };
 
-function createBaseFunctionMetadata(): FunctionMetadata {
-  return { name: "", definition: "" };
+function createBaseDatabase(): Database {
+  return {
+    name: "",
+    uid: "",
+    syncState: State.STATE_UNSPECIFIED,
+    successfulSyncTime: undefined,
+    project: "",
+    schemaVersion: "",
+    environment: "",
+    effectiveEnvironment: "",
+    labels: {},
+    instanceResource: undefined,
+    backupAvailable: false,
+  };
 }
 
-export const FunctionMetadata = {
-  encode(message: FunctionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const Database = {
+  encode(message: Database, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.definition !== "") {
-      writer.uint32(18).string(message.definition);
+    if (message.uid !== "") {
+      writer.uint32(18).string(message.uid);
+    }
+    if (message.syncState !== State.STATE_UNSPECIFIED) {
+      writer.uint32(24).int32(stateToNumber(message.syncState));
+    }
+    if (message.successfulSyncTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.successfulSyncTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.project !== "") {
+      writer.uint32(42).string(message.project);
+    }
+    if (message.schemaVersion !== "") {
+      writer.uint32(50).string(message.schemaVersion);
+    }
+    if (message.environment !== "") {
+      writer.uint32(58).string(message.environment);
+    }
+    if (message.effectiveEnvironment !== "") {
+      writer.uint32(66).string(message.effectiveEnvironment);
+    }
+    Object.entries(message.labels).forEach(([key, value]) => {
+      Database_LabelsEntry.encode({ key: key as any, value }, writer.uint32(74).fork()).ldelim();
+    });
+    if (message.instanceResource !== undefined) {
+      InstanceResource.encode(message.instanceResource, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.backupAvailable === true) {
+      writer.uint32(88).bool(message.backupAvailable);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FunctionMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Database {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFunctionMetadata();
+    const message = createBaseDatabase();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5068,65 +5399,194 @@ export const FunctionMetadata = {
             break;
           }
 
-          message.definition = reader.string();
+          message.uid = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): FunctionMetadata {
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.syncState = stateFromJSON(reader.int32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.successfulSyncTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.schemaVersion = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.environment = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.effectiveEnvironment = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          const entry9 = Database_LabelsEntry.decode(reader, reader.uint32());
+          if (entry9.value !== undefined) {
+            message.labels[entry9.key] = entry9.value;
+          }
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.instanceResource = InstanceResource.decode(reader, reader.uint32());
+          continue;
+        case 11:
+          if (tag !== 88) {
+            break;
+          }
+
+          message.backupAvailable = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Database {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
+      syncState: isSet(object.syncState) ? stateFromJSON(object.syncState) : State.STATE_UNSPECIFIED,
+      successfulSyncTime: isSet(object.successfulSyncTime) ? fromJsonTimestamp(object.successfulSyncTime) : undefined,
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      schemaVersion: isSet(object.schemaVersion) ? globalThis.String(object.schemaVersion) : "",
+      environment: isSet(object.environment) ? globalThis.String(object.environment) : "",
+      effectiveEnvironment: isSet(object.effectiveEnvironment) ? globalThis.String(object.effectiveEnvironment) : "",
+      labels: isObject(object.labels)
+        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      instanceResource: isSet(object.instanceResource) ? InstanceResource.fromJSON(object.instanceResource) : undefined,
+      backupAvailable: isSet(object.backupAvailable) ? globalThis.Boolean(object.backupAvailable) : false,
     };
   },
 
-  toJSON(message: FunctionMetadata): unknown {
+  toJSON(message: Database): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
+    if (message.uid !== "") {
+      obj.uid = message.uid;
+    }
+    if (message.syncState !== State.STATE_UNSPECIFIED) {
+      obj.syncState = stateToJSON(message.syncState);
+    }
+    if (message.successfulSyncTime !== undefined) {
+      obj.successfulSyncTime = message.successfulSyncTime.toISOString();
+    }
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.schemaVersion !== "") {
+      obj.schemaVersion = message.schemaVersion;
+    }
+    if (message.environment !== "") {
+      obj.environment = message.environment;
+    }
+    if (message.effectiveEnvironment !== "") {
+      obj.effectiveEnvironment = message.effectiveEnvironment;
+    }
+    if (message.labels) {
+      const entries = Object.entries(message.labels);
+      if (entries.length > 0) {
+        obj.labels = {};
+        entries.forEach(([k, v]) => {
+          obj.labels[k] = v;
+        });
+      }
+    }
+    if (message.instanceResource !== undefined) {
+      obj.instanceResource = InstanceResource.toJSON(message.instanceResource);
+    }
+    if (message.backupAvailable === true) {
+      obj.backupAvailable = message.backupAvailable;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<FunctionMetadata>): FunctionMetadata {
-    return FunctionMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<Database>): Database {
+    return Database.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<FunctionMetadata>): FunctionMetadata {
-    const message = createBaseFunctionMetadata();
+  fromPartial(object: DeepPartial<Database>): Database {
+    const message = createBaseDatabase();
     message.name = object.name ?? "";
-    message.definition = object.definition ?? "";
+    message.uid = object.uid ?? "";
+    message.syncState = object.syncState ?? State.STATE_UNSPECIFIED;
+    message.successfulSyncTime = object.successfulSyncTime ?? undefined;
+    message.project = object.project ?? "";
+    message.schemaVersion = object.schemaVersion ?? "";
+    message.environment = object.environment ?? "";
+    message.effectiveEnvironment = object.effectiveEnvironment ?? "";
+    message.labels = Object.entries(object.labels ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = globalThis.String(value);
+      }
+      return acc;
+    }, {});
+    message.instanceResource = (object.instanceResource !== undefined && object.instanceResource !== null)
+      ? InstanceResource.fromPartial(object.instanceResource)
+      : undefined;
+    message.backupAvailable = object.backupAvailable ?? false;
     return message;
   },
 };
 
-function createBaseProcedureMetadata(): ProcedureMetadata {
-  return { name: "", definition: "" };
+function createBaseDatabase_LabelsEntry(): Database_LabelsEntry {
+  return { key: "", value: "" };
 }
 
-export const ProcedureMetadata = {
-  encode(message: ProcedureMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const Database_LabelsEntry = {
+  encode(message: Database_LabelsEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.definition !== "") {
-      writer.uint32(18).string(message.definition);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ProcedureMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Database_LabelsEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcedureMetadata();
+    const message = createBaseDatabase_LabelsEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5135,14 +5595,14 @@ export const ProcedureMetadata = {
             break;
           }
 
-          message.name = reader.string();
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.definition = reader.string();
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5153,89 +5613,81 @@ export const ProcedureMetadata = {
     return message;
   },
 
-  fromJSON(object: any): ProcedureMetadata {
+  fromJSON(object: any): Database_LabelsEntry {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
     };
   },
 
-  toJSON(message: ProcedureMetadata): unknown {
+  toJSON(message: Database_LabelsEntry): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ProcedureMetadata>): ProcedureMetadata {
-    return ProcedureMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<Database_LabelsEntry>): Database_LabelsEntry {
+    return Database_LabelsEntry.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ProcedureMetadata>): ProcedureMetadata {
-    const message = createBaseProcedureMetadata();
-    message.name = object.name ?? "";
-    message.definition = object.definition ?? "";
+  fromPartial(object: DeepPartial<Database_LabelsEntry>): Database_LabelsEntry {
+    const message = createBaseDatabase_LabelsEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseTaskMetadata(): TaskMetadata {
+function createBaseDatabaseMetadata(): DatabaseMetadata {
   return {
     name: "",
-    id: "",
-    owner: "",
-    comment: "",
-    warehouse: "",
-    schedule: "",
-    predecessors: [],
-    state: TaskMetadata_State.STATE_UNSPECIFIED,
-    condition: "",
-    definition: "",
+    schemas: [],
+    characterSet: "",
+    collation: "",
+    extensions: [],
+    schemaConfigs: [],
+    indexTemplates: [],
+    lifecyclePolicies: [],
   };
 }
 
-export const TaskMetadata = {
-  encode(message: TaskMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const DatabaseMetadata = {
+  encode(message: DatabaseMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.id !== "") {
-      writer.uint32(18).string(message.id);
-    }
-    if (message.owner !== "") {
-      writer.uint32(26).string(message.owner);
-    }
-    if (message.comment !== "") {
-      writer.uint32(34).string(message.comment);
+    for (const v of message.schemas) {
+      SchemaMetadata.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    if (message.warehouse !== "") {
-      writer.uint32(42).string(message.warehouse);
+    if (message.characterSet !== "") {
+      writer.uint32(26).string(message.characterSet);
     }
-    if (message.schedule !== "") {
-      writer.uint32(50).string(message.schedule);
+    if (message.collation !== "") {
+      writer.uint32(34).string(message.collation);
     }
-    for (const v of message.predecessors) {
-      writer.uint32(58).string(v!);
+    for (const v of message.extensions) {
+      ExtensionMetadata.encode(v!, writer.uint32(42).fork()).ldelim();
     }
-    if (message.state !== TaskMetadata_State.STATE_UNSPECIFIED) {
-      writer.uint32(64).int32(taskMetadata_StateToNumber(message.state));
+    for (const v of message.schemaConfigs) {
+      SchemaConfig.encode(v!, writer.uint32(50).fork()).ldelim();
     }
-    if (message.condition !== "") {
-      writer.uint32(74).string(message.condition);
+    for (const v of message.indexTemplates) {
+      IndexTemplateMetadata.encode(v!, writer.uint32(58).fork()).ldelim();
     }
-    if (message.definition !== "") {
-      writer.uint32(82).string(message.definition);
+    for (const v of message.lifecyclePolicies) {
+      LifecyclePolicyMetadata.encode(v!, writer.uint32(66).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TaskMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTaskMetadata();
+    const message = createBaseDatabaseMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5251,63 +5703,49 @@ export const TaskMetadata = {
             break;
           }
 
-          message.id = reader.string();
+          message.schemas.push(SchemaMetadata.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.owner = reader.string();
+          message.characterSet = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.comment = reader.string();
+          message.collation = reader.string();
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.warehouse = reader.string();
+          message.extensions.push(ExtensionMetadata.decode(reader, reader.uint32()));
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.schedule = reader.string();
+          message.schemaConfigs.push(SchemaConfig.decode(reader, reader.uint32()));
           continue;
         case 7:
           if (tag !== 58) {
             break;
           }
 
-          message.predecessors.push(reader.string());
+          message.indexTemplates.push(IndexTemplateMetadata.decode(reader, reader.uint32()));
           continue;
         case 8:
-          if (tag !== 64) {
-            break;
-          }
-
-          message.state = taskMetadata_StateFromJSON(reader.int32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.condition = reader.string();
-          continue;
-        case 10:
-          if (tag !== 82) {
+          if (tag !== 66) {
             break;
           }
 
-          message.definition = reader.string();
+          message.lifecyclePolicies.push(LifecyclePolicyMetadata.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5318,123 +5756,125 @@ export const TaskMetadata = {
     return message;
   },
 
-  fromJSON(object: any): TaskMetadata {
+  fromJSON(object: any): DatabaseMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      id: isSet(object.id) ? globalThis.String(object.id) : "",
-      owner: isSet(object.owner) ? globalThis.String(object.owner) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      warehouse: isSet(object.warehouse) ? globalThis.String(object.warehouse) : "",
-      schedule: isSet(object.schedule) ? globalThis.String(object.schedule) : "",
-      predecessors: globalThis.Array.isArray(object?.predecessors)
-        ? object.predecessors.map((e: any) => globalThis.String(e))
+      schemas: globalThis.Array.isArray(object?.schemas)
+        ? object.schemas.map((e: any) => SchemaMetadata.fromJSON(e))
+        : [],
+      characterSet: isSet(object.characterSet) ? globalThis.String(object.characterSet) : "",
+      collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
+      extensions: globalThis.Array.isArray(object?.extensions)
+        ? object.extensions.map((e: any) => ExtensionMetadata.fromJSON(e))
+        : [],
+      schemaConfigs: globalThis.Array.isArray(object?.schemaConfigs)
+        ? object.schemaConfigs.map((e: any) => SchemaConfig.fromJSON(e))
+        : [],
+      indexTemplates: globalThis.Array.isArray(object?.indexTemplates)
+        ? object.indexTemplates.map((e: any) => IndexTemplateMetadata.fromJSON(e))
+        : [],
+      lifecyclePolicies: globalThis.Array.isArray(object?.lifecyclePolicies)
+        ? object.lifecyclePolicies.map((e: any) => LifecyclePolicyMetadata.fromJSON(e))
         : [],
-      state: isSet(object.state) ? taskMetadata_StateFromJSON(object.state) : TaskMetadata_State.STATE_UNSPECIFIED,
-      condition: isSet(object.condition) ? globalThis.String(object.condition) : "",
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
     };
   },
 
-  toJSON(message: TaskMetadata): unknown {
+  toJSON(message: DatabaseMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.id !== "") {
-      obj.id = message.id;
-    }
-    if (message.owner !== "") {
-      obj.owner = message.owner;
-    }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
+    if (message.schemas?.length) {
+      obj.schemas = message.schemas.map((e) => SchemaMetadata.toJSON(e));
     }
-    if (message.warehouse !== "") {
-      obj.warehouse = message.warehouse;
+    if (message.characterSet !== "") {
+      obj.characterSet = message.characterSet;
     }
-    if (message.schedule !== "") {
-      obj.schedule = message.schedule;
+    if (message.collation !== "") {
+      obj.collation = message.collation;
     }
-    if (message.predecessors?.length) {
-      obj.predecessors = message.predecessors;
+    if (message.extensions?.length) {
+      obj.extensions = message.extensions.map((e) => ExtensionMetadata.toJSON(e));
     }
-    if (message.state !== TaskMetadata_State.STATE_UNSPECIFIED) {
-      obj.state = taskMetadata_StateToJSON(message.state);
+    if (message.schemaConfigs?.length) {
+      obj.schemaConfigs = message.schemaConfigs.map((e) => SchemaConfig.toJSON(e));
     }
-    if (message.condition !== "") {
-      obj.condition = message.condition;
+    if (message.indexTemplates?.length) {
+      obj.indexTemplates = message.indexTemplates.map((e) => IndexTemplateMetadata.toJSON(e));
     }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
+    if (message.lifecyclePolicies?.length) {
+      obj.lifecyclePolicies = message.lifecyclePolicies.map((e) => LifecyclePolicyMetadata.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<TaskMetadata>): TaskMetadata {
-    return TaskMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<DatabaseMetadata>): DatabaseMetadata {
+    return DatabaseMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<TaskMetadata>): TaskMetadata {
-    const message = createBaseTaskMetadata();
+  fromPartial(object: DeepPartial<DatabaseMetadata>): DatabaseMetadata {
+    const message = createBaseDatabaseMetadata();
     message.name = object.name ?? "";
-    message.id = object.id ?? "";
-    message.owner = object.owner ?? "";
-    message.comment = object.comment ?? "";
-    message.warehouse = object.warehouse ?? "";
-    message.schedule = object.schedule ?? "";
-    message.predecessors = object.predecessors?.map((e) => e) || [];
-    message.state = object.state ?? TaskMetadata_State.STATE_UNSPECIFIED;
-    message.condition = object.condition ?? "";
-    message.definition = object.definition ?? "";
+    message.schemas = object.schemas?.map((e) => SchemaMetadata.fromPartial(e)) || [];
+    message.characterSet = object.characterSet ?? "";
+    message.collation = object.collation ?? "";
+    message.extensions = object.extensions?.map((e) => ExtensionMetadata.fromPartial(e)) || [];
+    message.schemaConfigs = object.schemaConfigs?.map((e) => SchemaConfig.fromPartial(e)) || [];
+    message.indexTemplates = object.indexTemplates?.map((e) => IndexTemplateMetadata.fromPartial(e)) || [];
+    message.lifecyclePolicies = object.lifecyclePolicies?.map((e) => LifecyclePolicyMetadata.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseStreamMetadata(): StreamMetadata {
+function createBaseSchemaMetadata(): SchemaMetadata {
   return {
     name: "",
-    tableName: "",
-    owner: "",
-    comment: "",
-    type: StreamMetadata_Type.TYPE_UNSPECIFIED,
-    stale: false,
-    mode: StreamMetadata_Mode.MODE_UNSPECIFIED,
-    definition: "",
+    tables: [],
+    externalTables: [],
+    views: [],
+    functions: [],
+    procedures: [],
+    streams: [],
+    tasks: [],
+    materializedViews: [],
   };
 }
 
-export const StreamMetadata = {
-  encode(message: StreamMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const SchemaMetadata = {
+  encode(message: SchemaMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.tableName !== "") {
-      writer.uint32(18).string(message.tableName);
+    for (const v of message.tables) {
+      TableMetadata.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    if (message.owner !== "") {
-      writer.uint32(26).string(message.owner);
+    for (const v of message.externalTables) {
+      ExternalTableMetadata.encode(v!, writer.uint32(26).fork()).ldelim();
     }
-    if (message.comment !== "") {
-      writer.uint32(34).string(message.comment);
+    for (const v of message.views) {
+      ViewMetadata.encode(v!, writer.uint32(34).fork()).ldelim();
     }
-    if (message.type !== StreamMetadata_Type.TYPE_UNSPECIFIED) {
-      writer.uint32(40).int32(streamMetadata_TypeToNumber(message.type));
+    for (const v of message.functions) {
+      FunctionMetadata.encode(v!, writer.uint32(42).fork()).ldelim();
     }
-    if (message.stale === true) {
-      writer.uint32(48).bool(message.stale);
+    for (const v of message.procedures) {
+      ProcedureMetadata.encode(v!, writer.uint32(50).fork()).ldelim();
     }
-    if (message.mode !== StreamMetadata_Mode.MODE_UNSPECIFIED) {
-      writer.uint32(56).int32(streamMetadata_ModeToNumber(message.mode));
+    for (const v of message.streams) {
+      StreamMetadata.encode(v!, writer.uint32(58).fork()).ldelim();
     }
-    if (message.definition !== "") {
-      writer.uint32(66).string(message.definition);
+    for (const v of message.tasks) {
+      TaskMetadata.encode(v!, writer.uint32(66).fork()).ldelim();
+    }
+    for (const v of message.materializedViews) {
+      MaterializedViewMetadata.encode(v!, writer.uint32(74).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): StreamMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStreamMetadata();
+    const message = createBaseSchemaMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5450,49 +5890,56 @@ export const StreamMetadata = {
             break;
           }
 
-          message.tableName = reader.string();
+          message.tables.push(TableMetadata.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.owner = reader.string();
+          message.externalTables.push(ExternalTableMetadata.decode(reader, reader.uint32()));
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.comment = reader.string();
+          message.views.push(ViewMetadata.decode(reader, reader.uint32()));
           continue;
         case 5:
-          if (tag !== 40) {
+          if (tag !== 42) {
             break;
           }
 
-          message.type = streamMetadata_TypeFromJSON(reader.int32());
+          message.functions.push(FunctionMetadata.decode(reader, reader.uint32()));
           continue;
         case 6:
-          if (tag !== 48) {
+          if (tag !== 50) {
             break;
           }
 
-          message.stale = reader.bool();
+          message.procedures.push(ProcedureMetadata.decode(reader, reader.uint32()));
           continue;
         case 7:
-          if (tag !== 56) {
+          if (tag !== 58) {
             break;
           }
 
-          message.mode = streamMetadata_ModeFromJSON(reader.int32());
+          message.streams.push(StreamMetadata.decode(reader, reader.uint32()));
           continue;
         case 8:
           if (tag !== 66) {
             break;
           }
 
-          message.definition = reader.string();
+          message.tasks.push(TaskMetadata.decode(reader, reader.uint32()));
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.materializedViews.push(MaterializedViewMetadata.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5503,123 +5950,105 @@ export const StreamMetadata = {
     return message;
   },
 
-  fromJSON(object: any): StreamMetadata {
+  fromJSON(object: any): SchemaMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      tableName: isSet(object.tableName) ? globalThis.String(object.tableName) : "",
-      owner: isSet(object.owner) ? globalThis.String(object.owner) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      type: isSet(object.type) ? streamMetadata_TypeFromJSON(object.type) : StreamMetadata_Type.TYPE_UNSPECIFIED,
-      stale: isSet(object.stale) ? globalThis.Boolean(object.stale) : false,
-      mode: isSet(object.mode) ? streamMetadata_ModeFromJSON(object.mode) : StreamMetadata_Mode.MODE_UNSPECIFIED,
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      tables: globalThis.Array.isArray(object?.tables) ? object.tables.map((e: any) => TableMetadata.fromJSON(e)) : [],
+      externalTables: globalThis.Array.isArray(object?.externalTables)
+        ? object.externalTables.map((e: any) => ExternalTableMetadata.fromJSON(e))
+        : [],
+      views: globalThis.Array.isArray(object?.views) ? object.views.map((e: any) => ViewMetadata.fromJSON(e)) : [],
+      functions: globalThis.Array.isArray(object?.functions)
+        ? object.functions.map((e: any) => FunctionMetadata.fromJSON(e))
+        : [],
+      procedures: globalThis.Array.isArray(object?.procedures)
+        ? object.procedures.map((e: any) => ProcedureMetadata.fromJSON(e))
+        : [],
+      streams: globalThis.Array.isArray(object?.streams)
+        ? object.streams.map((e: any) => StreamMetadata.fromJSON(e))
+        : [],
+      tasks: globalThis.Array.isArray(object?.tasks) ? object.tasks.map((e: any) => TaskMetadata.fromJSON(e)) : [],
+      materializedViews: globalThis.Array.isArray(object?.materializedViews)
+        ? object.materializedViews.map((e: any) => MaterializedViewMetadata.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: StreamMetadata): unknown {
+  toJSON(message: SchemaMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.tableName !== "") {
-      obj.tableName = message.tableName;
+    if (message.tables?.length) {
+      obj.tables = message.tables.map((e) => TableMetadata.toJSON(e));
     }
-    if (message.owner !== "") {
-      obj.owner = message.owner;
+    if (message.externalTables?.length) {
+      obj.externalTables = message.externalTables.map((e) => ExternalTableMetadata.toJSON(e));
     }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
+    if (message.views?.length) {
+      obj.views = message.views.map((e) => ViewMetadata.toJSON(e));
     }
-    if (message.type !== StreamMetadata_Type.TYPE_UNSPECIFIED) {
-      obj.type = streamMetadata_TypeToJSON(message.type);
+    if (message.functions?.length) {
+      obj.functions = message.functions.map((e) => FunctionMetadata.toJSON(e));
     }
-    if (message.stale === true) {
-      obj.stale = message.stale;
+    if (message.procedures?.length) {
+      obj.procedures = message.procedures.map((e) => ProcedureMetadata.toJSON(e));
     }
-    if (message.mode !== StreamMetadata_Mode.MODE_UNSPECIFIED) {
-      obj.mode = streamMetadata_ModeToJSON(message.mode);
+    if (message.streams?.length) {
+      obj.streams = message.streams.map((e) => StreamMetadata.toJSON(e));
     }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
+    if (message.tasks?.length) {
+      obj.tasks = message.tasks.map((e) => TaskMetadata.toJSON(e));
+    }
+    if (message.materializedViews?.length) {
+      obj.materializedViews = message.materializedViews.map((e) => MaterializedViewMetadata.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<StreamMetadata>): StreamMetadata {
-    return StreamMetadata.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<StreamMetadata>): StreamMetadata {
-    const message = createBaseStreamMetadata();
+  create(base?: DeepPartial<SchemaMetadata>): SchemaMetadata {
+    return SchemaMetadata.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SchemaMetadata>): SchemaMetadata {
+    const message = createBaseSchemaMetadata();
     message.name = object.name ?? "";
-    message.tableName = object.tableName ?? "";
-    message.owner = object.owner ?? "";
-    message.comment = object.comment ?? "";
-    message.type = object.type ?? StreamMetadata_Type.TYPE_UNSPECIFIED;
-    message.stale = object.stale ?? false;
-    message.mode = object.mode ?? StreamMetadata_Mode.MODE_UNSPECIFIED;
-    message.definition = object.definition ?? "";
+    message.tables = object.tables?.map((e) => TableMetadata.fromPartial(e)) || [];
+    message.externalTables = object.externalTables?.map((e) => ExternalTableMetadata.fromPartial(e)) || [];
+    message.views = object.views?.map((e) => ViewMetadata.fromPartial(e)) || [];
+    message.functions = object.functions?.map((e) => FunctionMetadata.fromPartial(e)) || [];
+    message.procedures = object.procedures?.map((e) => ProcedureMetadata.fromPartial(e)) || [];
+    message.streams = object.streams?.map((e) => StreamMetadata.fromPartial(e)) || [];
+    message.tasks = object.tasks?.map((e) => TaskMetadata.fromPartial(e)) || [];
+    message.materializedViews = object.materializedViews?.map((e) => MaterializedViewMetadata.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseIndexMetadata(): IndexMetadata {
-  return {
-    name: "",
-    expressions: [],
-    keyLength: [],
-    descending: [],
-    type: "",
-    unique: false,
-    primary: false,
-    visible: false,
-    comment: "",
-    definition: "",
-  };
+function createBaseExternalTableMetadata(): ExternalTableMetadata {
+  return { name: "", externalServerName: "", externalDatabaseName: "", columns: [] };
 }
 
-export const IndexMetadata = {
-  encode(message: IndexMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ExternalTableMetadata = {
+  encode(message: ExternalTableMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    for (const v of message.expressions) {
-      writer.uint32(18).string(v!);
-    }
-    writer.uint32(74).fork();
-    for (const v of message.keyLength) {
-      writer.int64(v);
-    }
-    writer.ldelim();
-    writer.uint32(82).fork();
-    for (const v of message.descending) {
-      writer.bool(v);
-    }
-    writer.ldelim();
-    if (message.type !== "") {
-      writer.uint32(26).string(message.type);
-    }
-    if (message.unique === true) {
-      writer.uint32(32).bool(message.unique);
-    }
-    if (message.primary === true) {
-      writer.uint32(40).bool(message.primary);
-    }
-    if (message.visible === true) {
-      writer.uint32(48).bool(message.visible);
+    if (message.externalServerName !== "") {
+      writer.uint32(18).string(message.externalServerName);
     }
-    if (message.comment !== "") {
-      writer.uint32(58).string(message.comment);
+    if (message.externalDatabaseName !== "") {
+      writer.uint32(26).string(message.externalDatabaseName);
     }
-    if (message.definition !== "") {
-      writer.uint32(66).string(message.definition);
+    for (const v of message.columns) {
+      ColumnMetadata.encode(v!, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IndexMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExternalTableMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIndexMetadata();
+    const message = createBaseExternalTableMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5635,83 +6064,21 @@ export const IndexMetadata = {
             break;
           }
 
-          message.expressions.push(reader.string());
+          message.externalServerName = reader.string();
           continue;
-        case 9:
-          if (tag === 72) {
-            message.keyLength.push(reader.int64() as Long);
-
-            continue;
-          }
-
-          if (tag === 74) {
-            const end2 = reader.uint32() + reader.pos;
-            while (reader.pos < end2) {
-              message.keyLength.push(reader.int64() as Long);
-            }
-
-            continue;
-          }
-
-          break;
-        case 10:
-          if (tag === 80) {
-            message.descending.push(reader.bool());
-
-            continue;
-          }
-
-          if (tag === 82) {
-            const end2 = reader.uint32() + reader.pos;
-            while (reader.pos < end2) {
-              message.descending.push(reader.bool());
-            }
-
-            continue;
-          }
-
-          break;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.type = reader.string();
+          message.externalDatabaseName = reader.string();
           continue;
         case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.unique = reader.bool();
-          continue;
-        case 5:
-          if (tag !== 40) {
-            break;
-          }
-
-          message.primary = reader.bool();
-          continue;
-        case 6:
-          if (tag !== 48) {
-            break;
-          }
-
-          message.visible = reader.bool();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.comment = reader.string();
-          continue;
-        case 8:
-          if (tag !== 66) {
+          if (tag !== 34) {
             break;
           }
 
-          message.definition = reader.string();
+          message.columns.push(ColumnMetadata.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -5722,104 +6089,137 @@ export const IndexMetadata = {
     return message;
   },
 
-  fromJSON(object: any): IndexMetadata {
+  fromJSON(object: any): ExternalTableMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      expressions: globalThis.Array.isArray(object?.expressions)
-        ? object.expressions.map((e: any) => globalThis.String(e))
-        : [],
-      keyLength: globalThis.Array.isArray(object?.keyLength) ? object.keyLength.map((e: any) => Long.fromValue(e)) : [],
-      descending: globalThis.Array.isArray(object?.descending)
-        ? object.descending.map((e: any) => globalThis.Boolean(e))
+      externalServerName: isSet(object.externalServerName) ? globalThis.String(object.externalServerName) : "",
+      externalDatabaseName: isSet(object.externalDatabaseName) ? globalThis.String(object.externalDatabaseName) : "",
+      columns: globalThis.Array.isArray(object?.columns)
+        ? object.columns.map((e: any) => ColumnMetadata.fromJSON(e))
         : [],
-      type: isSet(object.type) ? globalThis.String(object.type) : "",
-      unique: isSet(object.unique) ? globalThis.Boolean(object.unique) : false,
-      primary: isSet(object.primary) ? globalThis.Boolean(object.primary) : false,
-      visible: isSet(object.visible) ? globalThis.Boolean(object.visible) : false,
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
     };
   },
 
-  toJSON(message: IndexMetadata): unknown {
+  toJSON(message: ExternalTableMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.expressions?.length) {
-      obj.expressions = message.expressions;
-    }
-    if (message.keyLength?.length) {
-      obj.keyLength = message.keyLength.map((e) => (e || Long.ZERO).toString());
-    }
-    if (message.descending?.length) {
-      obj.descending = message.descending;
-    }
-    if (message.type !== "") {
-      obj.type = message.type;
-    }
-    if (message.unique === true) {
-      obj.unique = message.unique;
-    }
-    if (message.primary === true) {
-      obj.primary = message.primary;
-    }
-    if (message.visible === true) {
-      obj.visible = message.visible;
+    if (message.externalServerName !== "") {
+      obj.externalServerName = message.externalServerName;
     }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
+    if (message.externalDatabaseName !== "") {
+      obj.externalDatabaseName = message.externalDatabaseName;
     }
-    if (message.definition !== "") {
-      obj.definition = message.definition;
+    if (message.columns?.length) {
+      obj.columns = message.columns.map((e) => ColumnMetadata.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IndexMetadata>): IndexMetadata {
-    return IndexMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExternalTableMetadata>): ExternalTableMetadata {
+    return ExternalTableMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IndexMetadata>): IndexMetadata {
-    const message = createBaseIndexMetadata();
+  fromPartial(object: DeepPartial<ExternalTableMetadata>): ExternalTableMetadata {
+    const message = createBaseExternalTableMetadata();
     message.name = object.name ?? "";
-    message.expressions = object.expressions?.map((e) => e) || [];
-    message.keyLength = object.keyLength?.map((e) => Long.fromValue(e)) || [];
-    message.descending = object.descending?.map((e) => e) || [];
-    message.type = object.type ?? "";
-    message.unique = object.unique ?? false;
-    message.primary = object.primary ?? false;
-    message.visible = object.visible ?? false;
-    message.comment = object.comment ?? "";
-    message.definition = object.definition ?? "";
+    message.externalServerName = object.externalServerName ?? "";
+    message.externalDatabaseName = object.externalDatabaseName ?? "";
+    message.columns = object.columns?.map((e) => ColumnMetadata.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseExtensionMetadata(): ExtensionMetadata {
-  return { name: "", schema: "", version: "", description: "" };
+function createBaseTableMetadata(): TableMetadata {
+  return {
+    name: "",
+    columns: [],
+    indexes: [],
+    engine: "",
+    collation: "",
+    charset: "",
+    rowCount: Long.ZERO,
+    dataSize: Long.ZERO,
+    indexSize: Long.ZERO,
+    dataFree: Long.ZERO,
+    createOptions: "",
+    comment: "",
+    userComment: "",
+    foreignKeys: [],
+    partitions: [],
+    checkConstraints: [],
+    validator: "",
+    shardKey: "",
+    dynamicPartition: undefined,
+  };
 }
 
-export const ExtensionMetadata = {
-  encode(message: ExtensionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const TableMetadata = {
+  encode(message: TableMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.schema !== "") {
-      writer.uint32(18).string(message.schema);
+    for (const v of message.columns) {
+      ColumnMetadata.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    if (message.version !== "") {
-      writer.uint32(26).string(message.version);
+    for (const v of message.indexes) {
+      IndexMetadata.encode(v!, writer.uint32(26).fork()).ldelim();
     }
-    if (message.description !== "") {
-      writer.uint32(34).string(message.description);
+    if (message.engine !== "") {
+      writer.uint32(34).string(message.engine);
+    }
+    if (message.collation !== "") {
+      writer.uint32(42).string(message.collation);
+    }
+    if (message.charset !== "") {
+      writer.uint32(138).string(message.charset);
+    }
+    if (!message.rowCount.isZero()) {
+      writer.uint32(48).int64(message.rowCount);
+    }
+    if (!message.dataSize.isZero()) {
+      writer.uint32(56).int64(message.dataSize);
+    }
+    if (!message.indexSize.isZero()) {
+      writer.uint32(64).int64(message.indexSize);
+    }
+    if (!message.dataFree.isZero()) {
+      writer.uint32(72).int64(message.dataFree);
+    }
+    if (message.createOptions !== "") {
+      writer.uint32(82).string(message.createOptions);
+    }
+    if (message.comment !== "") {
+      writer.uint32(90).string(message.comment);
+    }
+    if (message.userComment !== "") {
+      writer.uint32(114).string(message.userComment);
+    }
+    for (const v of message.foreignKeys) {
+      ForeignKeyMetadata.encode(v!, writer.uint32(98).fork()).ldelim();
+    }
+    for (const v of message.partitions) {
+      TablePartitionMetadata.encode(v!, writer.uint32(122).fork()).ldelim();
+    }
+    for (const v of message.checkConstraints) {
+      CheckConstraintMetadata.encode(v!, writer.uint32(130).fork()).ldelim();
+    }
+    if (message.validator !== "") {
+      writer.uint32(146).string(message.validator);
+    }
+    if (message.shardKey !== "") {
+      writer.uint32(154).string(message.shardKey);
+    }
+    if (message.dynamicPartition !== undefined) {
+      DynamicPartitionMetadata.encode(message.dynamicPartition, writer.uint32(162).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ExtensionMetadata {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TableMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExtensionMetadata();
+    const message = createBaseTableMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -5835,174 +6235,126 @@ export const ExtensionMetadata = {
             break;
           }
 
-          message.schema = reader.string();
+          message.columns.push(ColumnMetadata.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.version = reader.string();
+          message.indexes.push(IndexMetadata.decode(reader, reader.uint32()));
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.description = reader.string();
+          message.engine = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
 
-  fromJSON(object: any): ExtensionMetadata {
-    return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
-    };
-  },
+          message.collation = reader.string();
+          continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
 
-  toJSON(message: ExtensionMetadata): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.schema !== "") {
-      obj.schema = message.schema;
-    }
-    if (message.version !== "") {
-      obj.version = message.version;
-    }
-    if (message.description !== "") {
-      obj.description = message.description;
-    }
-    return obj;
-  },
+          message.charset = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
 
-  create(base?: DeepPartial<ExtensionMetadata>): ExtensionMetadata {
-    return ExtensionMetadata.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<ExtensionMetadata>): ExtensionMetadata {
-    const message = createBaseExtensionMetadata();
-    message.name = object.name ?? "";
-    message.schema = object.schema ?? "";
-    message.version = object.version ?? "";
-    message.description = object.description ?? "";
-    return message;
-  },
-};
+          message.rowCount = reader.int64() as Long;
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
 
-function createBaseForeignKeyMetadata(): ForeignKeyMetadata {
-  return {
-    name: "",
-    columns: [],
-    referencedSchema: "",
-    referencedTable: "",
-    referencedColumns: [],
-    onDelete: "",
-    onUpdate: "",
-    matchType: "",
-  };
-}
+          message.dataSize = reader.int64() as Long;
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
 
-export const ForeignKeyMetadata = {
-  encode(message: ForeignKeyMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    for (const v of message.columns) {
-      writer.uint32(18).string(v!);
-    }
-    if (message.referencedSchema !== "") {
-      writer.uint32(26).string(message.referencedSchema);
-    }
-    if (message.referencedTable !== "") {
-      writer.uint32(34).string(message.referencedTable);
-    }
-    for (const v of message.referencedColumns) {
-      writer.uint32(42).string(v!);
-    }
-    if (message.onDelete !== "") {
-      writer.uint32(50).string(message.onDelete);
-    }
-    if (message.onUpdate !== "") {
-      writer.uint32(58).string(message.onUpdate);
-    }
-    if (message.matchType !== "") {
-      writer.uint32(66).string(message.matchType);
-    }
-    return writer;
-  },
+          message.indexSize = reader.int64() as Long;
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ForeignKeyMetadata {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseForeignKeyMetadata();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.dataFree = reader.int64() as Long;
+          continue;
+        case 10:
+          if (tag !== 82) {
             break;
           }
 
-          message.name = reader.string();
+          message.createOptions = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 11:
+          if (tag !== 90) {
             break;
           }
 
-          message.columns.push(reader.string());
+          message.comment = reader.string();
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 14:
+          if (tag !== 114) {
             break;
           }
 
-          message.referencedSchema = reader.string();
+          message.userComment = reader.string();
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 12:
+          if (tag !== 98) {
             break;
           }
 
-          message.referencedTable = reader.string();
+          message.foreignKeys.push(ForeignKeyMetadata.decode(reader, reader.uint32()));
           continue;
-        case 5:
-          if (tag !== 42) {
+        case 15:
+          if (tag !== 122) {
             break;
           }
 
-          message.referencedColumns.push(reader.string());
+          message.partitions.push(TablePartitionMetadata.decode(reader, reader.uint32()));
           continue;
-        case 6:
-          if (tag !== 50) {
+        case 16:
+          if (tag !== 130) {
             break;
           }
 
-          message.onDelete = reader.string();
+          message.checkConstraints.push(CheckConstraintMetadata.decode(reader, reader.uint32()));
           continue;
-        case 7:
-          if (tag !== 58) {
+        case 18:
+          if (tag !== 146) {
             break;
           }
 
-          message.onUpdate = reader.string();
+          message.validator = reader.string();
           continue;
-        case 8:
-          if (tag !== 66) {
+        case 19:
+          if (tag !== 154) {
             break;
           }
 
-          message.matchType = reader.string();
+          message.shardKey = reader.string();
+          continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.dynamicPartition = DynamicPartitionMetadata.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6013,102 +6365,217 @@ export const ForeignKeyMetadata = {
     return message;
   },
 
-  fromJSON(object: any): ForeignKeyMetadata {
+  fromJSON(object: any): TableMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      columns: globalThis.Array.isArray(object?.columns) ? object.columns.map((e: any) => globalThis.String(e)) : [],
-      referencedSchema: isSet(object.referencedSchema) ? globalThis.String(object.referencedSchema) : "",
-      referencedTable: isSet(object.referencedTable) ? globalThis.String(object.referencedTable) : "",
-      referencedColumns: globalThis.Array.isArray(object?.referencedColumns)
-        ? object.referencedColumns.map((e: any) => globalThis.String(e))
+      columns: globalThis.Array.isArray(object?.columns)
+        ? object.columns.map((e: any) => ColumnMetadata.fromJSON(e))
         : [],
-      onDelete: isSet(object.onDelete) ? globalThis.String(object.onDelete) : "",
-      onUpdate: isSet(object.onUpdate) ? globalThis.String(object.onUpdate) : "",
-      matchType: isSet(object.matchType) ? globalThis.String(object.matchType) : "",
-    };
-  },
-
-  toJSON(message: ForeignKeyMetadata): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.columns?.length) {
-      obj.columns = message.columns;
-    }
-    if (message.referencedSchema !== "") {
-      obj.referencedSchema = message.referencedSchema;
-    }
-    if (message.referencedTable !== "") {
-      obj.referencedTable = message.referencedTable;
-    }
-    if (message.referencedColumns?.length) {
-      obj.referencedColumns = message.referencedColumns;
+      indexes: globalThis.Array.isArray(object?.indexes)
+        ? object.indexes.map((e: any) => IndexMetadata.fromJSON(e))
+        : [],
+      engine: isSet(object.engine) ? globalThis.String(object.engine) : "",
+      collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
+      charset: isSet(object.charset) ? globalThis.String(object.charset) : "",
+      rowCount: isSet(object.rowCount) ? Long.fromValue(object.rowCount) : Long.ZERO,
+      dataSize: isSet(object.dataSize) ? Long.fromValue(object.dataSize) : Long.ZERO,
+      indexSize: isSet(object.indexSize) ? Long.fromValue(object.indexSize) : Long.ZERO,
+      dataFree: isSet(object.dataFree) ? Long.fromValue(object.dataFree) : Long.ZERO,
+      createOptions: isSet(object.createOptions) ? globalThis.String(object.createOptions) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      userComment: isSet(object.userComment) ? globalThis.String(object.userComment) : "",
+      foreignKeys: globalThis.Array.isArray(object?.foreignKeys)
+        ? object.foreignKeys.map((e: any) => ForeignKeyMetadata.fromJSON(e))
+        : [],
+      partitions: globalThis.Array.isArray(object?.partitions)
+        ? object.partitions.map((e: any) => TablePartitionMetadata.fromJSON(e))
+        : [],
+      checkConstraints: globalThis.Array.isArray(object?.checkConstraints)
+        ? object.checkConstraints.map((e: any) => CheckConstraintMetadata.fromJSON(e))
+        : [],
+      validator: isSet(object.validator) ? globalThis.String(object.validator) : "",
+      shardKey: isSet(object.shardKey) ? globalThis.String(object.shardKey) : "",
+      dynamicPartition: isSet(object.dynamicPartition)
+        ? DynamicPartitionMetadata.fromJSON(object.dynamicPartition)
+        : undefined,
+    };
+  },
+
+  toJSON(message: TableMetadata): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.onDelete !== "") {
-      obj.onDelete = message.onDelete;
+    if (message.columns?.length) {
+      obj.columns = message.columns.map((e) => ColumnMetadata.toJSON(e));
     }
-    if (message.onUpdate !== "") {
-      obj.onUpdate = message.onUpdate;
+    if (message.indexes?.length) {
+      obj.indexes = message.indexes.map((e) => IndexMetadata.toJSON(e));
     }
-    if (message.matchType !== "") {
-      obj.matchType = message.matchType;
+    if (message.engine !== "") {
+      obj.engine = message.engine;
+    }
+    if (message.collation !== "") {
+      obj.collation = message.collation;
+    }
+    if (message.charset !== "") {
+      obj.charset = message.charset;
+    }
+    if (!message.rowCount.isZero()) {
+      obj.rowCount = (message.rowCount || Long.ZERO).toString();
+    }
+    if (!message.dataSize.isZero()) {
+      obj.dataSize = (message.dataSize || Long.ZERO).toString();
+    }
+    if (!message.indexSize.isZero()) {
+      obj.indexSize = (message.indexSize || Long.ZERO).toString();
+    }
+    if (!message.dataFree.isZero()) {
+      obj.dataFree = (message.dataFree || Long.ZERO).toString();
+    }
+    if (message.createOptions !== "") {
+      obj.createOptions = message.createOptions;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.userComment !== "") {
+      obj.userComment = message.userComment;
+    }
+    if (message.foreignKeys?.length) {
+      obj.foreignKeys = message.foreignKeys.map((e) => ForeignKeyMetadata.toJSON(e));
+    }
+    if (message.partitions?.length) {
+      obj.partitions = message.partitions.map((e) => TablePartitionMetadata.toJSON(e));
+    }
+    if (message.checkConstraints?.length) {
+      obj.checkConstraints = message.checkConstraints.map((e) => CheckConstraintMetadata.toJSON(e));
+    }
+    if (message.validator !== "") {
+      obj.validator = message.validator;
+    }
+    if (message.shardKey !== "") {
+      obj.shardKey = message.shardKey;
+    }
+    if (message.dynamicPartition !== undefined) {
+      obj.dynamicPartition = DynamicPartitionMetadata.toJSON(message.dynamicPartition);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ForeignKeyMetadata>): ForeignKeyMetadata {
-    return ForeignKeyMetadata.fromPartial(base ?? {});
+  create(base?: DeepPartial<TableMetadata>): TableMetadata {
+    return TableMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ForeignKeyMetadata>): ForeignKeyMetadata {
-    const message = createBaseForeignKeyMetadata();
+  fromPartial(object: DeepPartial<TableMetadata>): TableMetadata {
+    const message = createBaseTableMetadata();
     message.name = object.name ?? "";
-    message.columns = object.columns?.map((e) => e) || [];
-    message.referencedSchema = object.referencedSchema ?? "";
-    message.referencedTable = object.referencedTable ?? "";
-    message.referencedColumns = object.referencedColumns?.map((e) => e) || [];
-    message.onDelete = object.onDelete ?? "";
-    message.onUpdate = object.onUpdate ?? "";
-    message.matchType = object.matchType ?? "";
+    message.columns = object.columns?.map((e) => ColumnMetadata.fromPartial(e)) || [];
+    message.indexes = object.indexes?.map((e) => IndexMetadata.fromPartial(e)) || [];
+    message.engine = object.engine ?? "";
+    message.collation = object.collation ?? "";
+    message.charset = object.charset ?? "";
+    message.rowCount = (object.rowCount !== undefined && object.rowCount !== null)
+      ? Long.fromValue(object.rowCount)
+      : Long.ZERO;
+    message.dataSize = (object.dataSize !== undefined && object.dataSize !== null)
+      ? Long.fromValue(object.dataSize)
+      : Long.ZERO;
+    message.indexSize = (object.indexSize !== undefined && object.indexSize !== null)
+      ? Long.fromValue(object.indexSize)
+      : Long.ZERO;
+    message.dataFree = (object.dataFree !== undefined && object.dataFree !== null)
+      ? Long.fromValue(object.dataFree)
+      : Long.ZERO;
+    message.createOptions = object.createOptions ?? "";
+    message.comment = object.comment ?? "";
+    message.userComment = object.userComment ?? "";
+    message.foreignKeys = object.foreignKeys?.map((e) => ForeignKeyMetadata.fromPartial(e)) || [];
+    message.partitions = object.partitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
+    message.checkConstraints = object.checkConstraints?.map((e) => CheckConstraintMetadata.fromPartial(e)) || [];
+    message.validator = object.validator ?? "";
+    message.shardKey = object.shardKey ?? "";
+    message.dynamicPartition = (object.dynamicPartition !== undefined && object.dynamicPartition !== null)
+      ? DynamicPartitionMetadata.fromPartial(object.dynamicPartition)
+      : undefined;
     return message;
   },
 };
 
-function createBaseDatabaseConfig(): DatabaseConfig {
-  return { name: "", schemaConfigs: [] };
+function createBaseDynamicPartitionMetadata(): DynamicPartitionMetadata {
+  return { enabled: false, timeUnit: "", start: 0, end: 0, prefix: "", buckets: 0 };
 }
 
-export const DatabaseConfig = {
-  encode(message: DatabaseConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const DynamicPartitionMetadata = {
+  encode(message: DynamicPartitionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
     }
-    for (const v of message.schemaConfigs) {
-      SchemaConfig.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.timeUnit !== "") {
+      writer.uint32(18).string(message.timeUnit);
+    }
+    if (message.start !== 0) {
+      writer.uint32(24).int32(message.start);
+    }
+    if (message.end !== 0) {
+      writer.uint32(32).int32(message.end);
+    }
+    if (message.prefix !== "") {
+      writer.uint32(42).string(message.prefix);
+    }
+    if (message.buckets !== 0) {
+      writer.uint32(48).int32(message.buckets);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DynamicPartitionMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDatabaseConfig();
+    const message = createBaseDynamicPartitionMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.enabled = reader.bool();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.schemaConfigs.push(SchemaConfig.decode(reader, reader.uint32()));
+          message.timeUnit = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.start = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.end = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.buckets = reader.int32();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6119,65 +6586,74 @@ export const DatabaseConfig = {
     return message;
   },
 
-  fromJSON(object: any): DatabaseConfig {
+  fromJSON(object: any): DynamicPartitionMetadata {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      schemaConfigs: globalThis.Array.isArray(object?.schemaConfigs)
-        ? object.schemaConfigs.map((e: any) => SchemaConfig.fromJSON(e))
-        : [],
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+      timeUnit: isSet(object.timeUnit) ? globalThis.String(object.timeUnit) : "",
+      start: isSet(object.start) ? globalThis.Number(object.start) : 0,
+      end: isSet(object.end) ? globalThis.Number(object.end) : 0,
+      prefix: isSet(object.prefix) ? globalThis.String(object.prefix) : "",
+      buckets: isSet(object.buckets) ? globalThis.Number(object.buckets) : 0,
     };
   },
 
-  toJSON(message: DatabaseConfig): unknown {
+  toJSON(message: DynamicPartitionMetadata): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
     }
-    if (message.schemaConfigs?.length) {
-      obj.schemaConfigs = message.schemaConfigs.map((e) => SchemaConfig.toJSON(e));
+    if (message.timeUnit !== "") {
+      obj.timeUnit = message.timeUnit;
+    }
+    if (message.start !== 0) {
+      obj.start = Math.round(message.start);
+    }
+    if (message.end !== 0) {
+      obj.end = Math.round(message.end);
+    }
+    if (message.prefix !== "") {
+      obj.prefix = message.prefix;
+    }
+    if (message.buckets !== 0) {
+      obj.buckets = Math.round(message.buckets);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DatabaseConfig>): DatabaseConfig {
-    return DatabaseConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<DynamicPartitionMetadata>): DynamicPartitionMetadata {
+    return DynamicPartitionMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DatabaseConfig>): DatabaseConfig {
-    const message = createBaseDatabaseConfig();
-    message.name = object.name ?? "";
-    message.schemaConfigs = object.schemaConfigs?.map((e) => SchemaConfig.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<DynamicPartitionMetadata>): DynamicPartitionMetadata {
+    const message = createBaseDynamicPartitionMetadata();
+    message.enabled = object.enabled ?? false;
+    message.timeUnit = object.timeUnit ?? "";
+    message.start = object.start ?? 0;
+    message.end = object.end ?? 0;
+    message.prefix = object.prefix ?? "";
+    message.buckets = object.buckets ?? 0;
     return message;
   },
 };
 
-function createBaseSchemaConfig(): SchemaConfig {
-  return { name: "", tableConfigs: [], functionConfigs: [], procedureConfigs: [], viewConfigs: [] };
+function createBaseCheckConstraintMetadata(): CheckConstraintMetadata {
+  return { name: "", expression: "" };
 }
 
-export const SchemaConfig = {
-  encode(message: SchemaConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const CheckConstraintMetadata = {
+  encode(message: CheckConstraintMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    for (const v of message.tableConfigs) {
-      TableConfig.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    for (const v of message.functionConfigs) {
-      FunctionConfig.encode(v!, writer.uint32(26).fork()).ldelim();
-    }
-    for (const v of message.procedureConfigs) {
-      ProcedureConfig.encode(v!, writer.uint32(34).fork()).ldelim();
-    }
-    for (const v of message.viewConfigs) {
-      ViewConfig.encode(v!, writer.uint32(42).fork()).ldelim();
+    if (message.expression !== "") {
+      writer.uint32(18).string(message.expression);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CheckConstraintMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSchemaConfig();
+    const message = createBaseCheckConstraintMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6193,28 +6669,7 @@ export const SchemaConfig = {
             break;
           }
 
-          message.tableConfigs.push(TableConfig.decode(reader, reader.uint32()));
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.functionConfigs.push(FunctionConfig.decode(reader, reader.uint32()));
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.procedureConfigs.push(ProcedureConfig.decode(reader, reader.uint32()));
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.viewConfigs.push(ViewConfig.decode(reader, reader.uint32()));
+          message.expression = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6225,89 +6680,73 @@ export const SchemaConfig = {
     return message;
   },
 
-  fromJSON(object: any): SchemaConfig {
+  fromJSON(object: any): CheckConstraintMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      tableConfigs: globalThis.Array.isArray(object?.tableConfigs)
-        ? object.tableConfigs.map((e: any) => TableConfig.fromJSON(e))
-        : [],
-      functionConfigs: globalThis.Array.isArray(object?.functionConfigs)
-        ? object.functionConfigs.map((e: any) => FunctionConfig.fromJSON(e))
-        : [],
-      procedureConfigs: globalThis.Array.isArray(object?.procedureConfigs)
-        ? object.procedureConfigs.map((e: any) => ProcedureConfig.fromJSON(e))
-        : [],
-      viewConfigs: globalThis.Array.isArray(object?.viewConfigs)
-        ? object.viewConfigs.map((e: any) => ViewConfig.fromJSON(e))
-        : [],
+      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
     };
   },
 
-  toJSON(message: SchemaConfig): unknown {
+  toJSON(message: CheckConstraintMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.tableConfigs?.length) {
-      obj.tableConfigs = message.tableConfigs.map((e) => TableConfig.toJSON(e));
-    }
-    if (message.functionConfigs?.length) {
-      obj.functionConfigs = message.functionConfigs.map((e) => FunctionConfig.toJSON(e));
-    }
-    if (message.procedureConfigs?.length) {
-      obj.procedureConfigs = message.procedureConfigs.map((e) => ProcedureConfig.toJSON(e));
-    }
-    if (message.viewConfigs?.length) {
-      obj.viewConfigs = message.viewConfigs.map((e) => ViewConfig.toJSON(e));
+    if (message.expression !== "") {
+      obj.expression = message.expression;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SchemaConfig>): SchemaConfig {
-    return SchemaConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<CheckConstraintMetadata>): CheckConstraintMetadata {
+    return CheckConstraintMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SchemaConfig>): SchemaConfig {
-    const message = createBaseSchemaConfig();
+  fromPartial(object: DeepPartial<CheckConstraintMetadata>): CheckConstraintMetadata {
+    const message = createBaseCheckConstraintMetadata();
     message.name = object.name ?? "";
-    message.tableConfigs = object.tableConfigs?.map((e) => TableConfig.fromPartial(e)) || [];
-    message.functionConfigs = object.functionConfigs?.map((e) => FunctionConfig.fromPartial(e)) || [];
-    message.procedureConfigs = object.procedureConfigs?.map((e) => ProcedureConfig.fromPartial(e)) || [];
-    message.viewConfigs = object.viewConfigs?.map((e) => ViewConfig.fromPartial(e)) || [];
+    message.expression = object.expression ?? "";
     return message;
   },
 };
 
-function createBaseTableConfig(): TableConfig {
-  return { name: "", columnConfigs: [], classificationId: "", updater: "", sourceBranch: "", updateTime: undefined };
+function createBaseTablePartitionMetadata(): TablePartitionMetadata {
+  return {
+    name: "",
+    type: TablePartitionMetadata_Type.TYPE_UNSPECIFIED,
+    expression: "",
+    value: "",
+    useDefault: "",
+    subpartitions: [],
+  };
 }
 
-export const TableConfig = {
-  encode(message: TableConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const TablePartitionMetadata = {
+  encode(message: TablePartitionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    for (const v of message.columnConfigs) {
-      ColumnConfig.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.type !== TablePartitionMetadata_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(16).int32(tablePartitionMetadata_TypeToNumber(message.type));
     }
-    if (message.classificationId !== "") {
-      writer.uint32(26).string(message.classificationId);
+    if (message.expression !== "") {
+      writer.uint32(26).string(message.expression);
     }
-    if (message.updater !== "") {
-      writer.uint32(34).string(message.updater);
+    if (message.value !== "") {
+      writer.uint32(34).string(message.value);
     }
-    if (message.sourceBranch !== "") {
-      writer.uint32(50).string(message.sourceBranch);
+    if (message.useDefault !== "") {
+      writer.uint32(42).string(message.useDefault);
     }
-    if (message.updateTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(42).fork()).ldelim();
+    for (const v of message.subpartitions) {
+      TablePartitionMetadata.encode(v!, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): TableConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TablePartitionMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseTableConfig();
+    const message = createBaseTablePartitionMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6319,39 +6758,39 @@ export const TableConfig = {
           message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.columnConfigs.push(ColumnConfig.decode(reader, reader.uint32()));
+          message.type = tablePartitionMetadata_TypeFromJSON(reader.int32());
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.classificationId = reader.string();
+          message.expression = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.updater = reader.string();
+          message.value = reader.string();
           continue;
-        case 6:
-          if (tag !== 50) {
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.sourceBranch = reader.string();
+          message.useDefault = reader.string();
           continue;
-        case 5:
-          if (tag !== 42) {
+        case 6:
+          if (tag !== 50) {
             break;
           }
 
-          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.subpartitions.push(TablePartitionMetadata.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6362,82 +6801,133 @@ export const TableConfig = {
     return message;
   },
 
-  fromJSON(object: any): TableConfig {
+  fromJSON(object: any): TablePartitionMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      columnConfigs: globalThis.Array.isArray(object?.columnConfigs)
-        ? object.columnConfigs.map((e: any) => ColumnConfig.fromJSON(e))
+      type: isSet(object.type)
+        ? tablePartitionMetadata_TypeFromJSON(object.type)
+        : TablePartitionMetadata_Type.TYPE_UNSPECIFIED,
+      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      useDefault: isSet(object.useDefault) ? globalThis.String(object.useDefault) : "",
+      subpartitions: globalThis.Array.isArray(object?.subpartitions)
+        ? object.subpartitions.map((e: any) => TablePartitionMetadata.fromJSON(e))
         : [],
-      classificationId: isSet(object.classificationId) ? globalThis.String(object.classificationId) : "",
-      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
-      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
-      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
     };
   },
 
-  toJSON(message: TableConfig): unknown {
+  toJSON(message: TablePartitionMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.columnConfigs?.length) {
-      obj.columnConfigs = message.columnConfigs.map((e) => ColumnConfig.toJSON(e));
+    if (message.type !== TablePartitionMetadata_Type.TYPE_UNSPECIFIED) {
+      obj.type = tablePartitionMetadata_TypeToJSON(message.type);
     }
-    if (message.classificationId !== "") {
-      obj.classificationId = message.classificationId;
+    if (message.expression !== "") {
+      obj.expression = message.expression;
     }
-    if (message.updater !== "") {
-      obj.updater = message.updater;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
-    if (message.sourceBranch !== "") {
-      obj.sourceBranch = message.sourceBranch;
+    if (message.useDefault !== "") {
+      obj.useDefault = message.useDefault;
     }
-    if (message.updateTime !== undefined) {
-      obj.updateTime = message.updateTime.toISOString();
+    if (message.subpartitions?.length) {
+      obj.subpartitions = message.subpartitions.map((e) => TablePartitionMetadata.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<TableConfig>): TableConfig {
-    return TableConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<TablePartitionMetadata>): TablePartitionMetadata {
+    return TablePartitionMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<TableConfig>): TableConfig {
-    const message = createBaseTableConfig();
+  fromPartial(object: DeepPartial<TablePartitionMetadata>): TablePartitionMetadata {
+    const message = createBaseTablePartitionMetadata();
     message.name = object.name ?? "";
-    message.columnConfigs = object.columnConfigs?.map((e) => ColumnConfig.fromPartial(e)) || [];
-    message.classificationId = object.classificationId ?? "";
-    message.updater = object.updater ?? "";
-    message.sourceBranch = object.sourceBranch ?? "";
-    message.updateTime = object.updateTime ?? undefined;
+    message.type = object.type ?? TablePartitionMetadata_Type.TYPE_UNSPECIFIED;
+    message.expression = object.expression ?? "";
+    message.value = object.value ?? "";
+    message.useDefault = object.useDefault ?? "";
+    message.subpartitions = object.subpartitions?.map((e) => TablePartitionMetadata.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseFunctionConfig(): FunctionConfig {
-  return { name: "", updater: "", sourceBranch: "", updateTime: undefined };
+function createBaseColumnMetadata(): ColumnMetadata {
+  return {
+    name: "",
+    position: 0,
+    hasDefault: false,
+    defaultNull: undefined,
+    defaultString: undefined,
+    defaultExpression: undefined,
+    onUpdate: "",
+    nullable: false,
+    type: "",
+    characterSet: "",
+    collation: "",
+    comment: "",
+    userComment: "",
+    effectiveMaskingLevel: MaskingLevel.MASKING_LEVEL_UNSPECIFIED,
+    generation: undefined,
+  };
 }
 
-export const FunctionConfig = {
-  encode(message: FunctionConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ColumnMetadata = {
+  encode(message: ColumnMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.updater !== "") {
-      writer.uint32(18).string(message.updater);
+    if (message.position !== 0) {
+      writer.uint32(16).int32(message.position);
     }
-    if (message.sourceBranch !== "") {
-      writer.uint32(34).string(message.sourceBranch);
+    if (message.hasDefault === true) {
+      writer.uint32(24).bool(message.hasDefault);
     }
-    if (message.updateTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(26).fork()).ldelim();
+    if (message.defaultNull !== undefined) {
+      writer.uint32(32).bool(message.defaultNull);
+    }
+    if (message.defaultString !== undefined) {
+      writer.uint32(42).string(message.defaultString);
+    }
+    if (message.defaultExpression !== undefined) {
+      writer.uint32(50).string(message.defaultExpression);
+    }
+    if (message.onUpdate !== "") {
+      writer.uint32(122).string(message.onUpdate);
+    }
+    if (message.nullable === true) {
+      writer.uint32(56).bool(message.nullable);
+    }
+    if (message.type !== "") {
+      writer.uint32(66).string(message.type);
+    }
+    if (message.characterSet !== "") {
+      writer.uint32(74).string(message.characterSet);
+    }
+    if (message.collation !== "") {
+      writer.uint32(82).string(message.collation);
+    }
+    if (message.comment !== "") {
+      writer.uint32(90).string(message.comment);
+    }
+    if (message.userComment !== "") {
+      writer.uint32(106).string(message.userComment);
+    }
+    if (message.effectiveMaskingLevel !== MaskingLevel.MASKING_LEVEL_UNSPECIFIED) {
+      writer.uint32(112).int32(maskingLevelToNumber(message.effectiveMaskingLevel));
+    }
+    if (message.generation !== undefined) {
+      GenerationMetadata.encode(message.generation, writer.uint32(130).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): FunctionConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseFunctionConfig();
+    const message = createBaseColumnMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6449,129 +6939,102 @@ export const FunctionConfig = {
           message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.updater = reader.string();
+          message.position = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.hasDefault = reader.bool();
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.sourceBranch = reader.string();
+          message.defaultNull = reader.bool();
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.defaultString = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
 
-  fromJSON(object: any): FunctionConfig {
-    return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
-      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
-      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
-    };
-  },
+          message.defaultExpression = reader.string();
+          continue;
+        case 15:
+          if (tag !== 122) {
+            break;
+          }
 
-  toJSON(message: FunctionConfig): unknown {
-    const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.updater !== "") {
-      obj.updater = message.updater;
-    }
-    if (message.sourceBranch !== "") {
-      obj.sourceBranch = message.sourceBranch;
-    }
-    if (message.updateTime !== undefined) {
-      obj.updateTime = message.updateTime.toISOString();
-    }
-    return obj;
-  },
+          message.onUpdate = reader.string();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
 
-  create(base?: DeepPartial<FunctionConfig>): FunctionConfig {
-    return FunctionConfig.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<FunctionConfig>): FunctionConfig {
-    const message = createBaseFunctionConfig();
-    message.name = object.name ?? "";
-    message.updater = object.updater ?? "";
-    message.sourceBranch = object.sourceBranch ?? "";
-    message.updateTime = object.updateTime ?? undefined;
-    return message;
-  },
-};
+          message.nullable = reader.bool();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
 
-function createBaseProcedureConfig(): ProcedureConfig {
-  return { name: "", updater: "", sourceBranch: "", updateTime: undefined };
-}
+          message.type = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
 
-export const ProcedureConfig = {
-  encode(message: ProcedureConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.updater !== "") {
-      writer.uint32(18).string(message.updater);
-    }
-    if (message.sourceBranch !== "") {
-      writer.uint32(34).string(message.sourceBranch);
-    }
-    if (message.updateTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(26).fork()).ldelim();
-    }
-    return writer;
-  },
+          message.characterSet = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ProcedureConfig {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProcedureConfig();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.collation = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
             break;
           }
 
-          message.name = reader.string();
+          message.comment = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 13:
+          if (tag !== 106) {
             break;
           }
 
-          message.updater = reader.string();
+          message.userComment = reader.string();
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 14:
+          if (tag !== 112) {
             break;
           }
 
-          message.sourceBranch = reader.string();
+          message.effectiveMaskingLevel = maskingLevelFromJSON(reader.int32());
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 16:
+          if (tag !== 130) {
             break;
           }
 
-          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.generation = GenerationMetadata.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6582,100 +7045,139 @@ export const ProcedureConfig = {
     return message;
   },
 
-  fromJSON(object: any): ProcedureConfig {
+  fromJSON(object: any): ColumnMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
-      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
-      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+      position: isSet(object.position) ? globalThis.Number(object.position) : 0,
+      hasDefault: isSet(object.hasDefault) ? globalThis.Boolean(object.hasDefault) : false,
+      defaultNull: isSet(object.defaultNull) ? globalThis.Boolean(object.defaultNull) : undefined,
+      defaultString: isSet(object.defaultString) ? globalThis.String(object.defaultString) : undefined,
+      defaultExpression: isSet(object.defaultExpression) ? globalThis.String(object.defaultExpression) : undefined,
+      onUpdate: isSet(object.onUpdate) ? globalThis.String(object.onUpdate) : "",
+      nullable: isSet(object.nullable) ? globalThis.Boolean(object.nullable) : false,
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      characterSet: isSet(object.characterSet) ? globalThis.String(object.characterSet) : "",
+      collation: isSet(object.collation) ? globalThis.String(object.collation) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      userComment: isSet(object.userComment) ? globalThis.String(object.userComment) : "",
+      effectiveMaskingLevel: isSet(object.effectiveMaskingLevel)
+        ? maskingLevelFromJSON(object.effectiveMaskingLevel)
+        : MaskingLevel.MASKING_LEVEL_UNSPECIFIED,
+      generation: isSet(object.generation) ? GenerationMetadata.fromJSON(object.generation) : undefined,
     };
   },
 
-  toJSON(message: ProcedureConfig): unknown {
+  toJSON(message: ColumnMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.updater !== "") {
-      obj.updater = message.updater;
+    if (message.position !== 0) {
+      obj.position = Math.round(message.position);
     }
-    if (message.sourceBranch !== "") {
-      obj.sourceBranch = message.sourceBranch;
+    if (message.hasDefault === true) {
+      obj.hasDefault = message.hasDefault;
     }
-    if (message.updateTime !== undefined) {
-      obj.updateTime = message.updateTime.toISOString();
+    if (message.defaultNull !== undefined) {
+      obj.defaultNull = message.defaultNull;
+    }
+    if (message.defaultString !== undefined) {
+      obj.defaultString = message.defaultString;
+    }
+    if (message.defaultExpression !== undefined) {
+      obj.defaultExpression = message.defaultExpression;
+    }
+    if (message.onUpdate !== "") {
+      obj.onUpdate = message.onUpdate;
+    }
+    if (message.nullable === true) {
+      obj.nullable = message.nullable;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.characterSet !== "") {
+      obj.characterSet = message.characterSet;
+    }
+    if (message.collation !== "") {
+      obj.collation = message.collation;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.userComment !== "") {
+      obj.userComment = message.userComment;
+    }
+    if (message.effectiveMaskingLevel !== MaskingLevel.MASKING_LEVEL_UNSPECIFIED) {
+      obj.effectiveMaskingLevel = maskingLevelToJSON(message.effectiveMaskingLevel);
+    }
+    if (message.generation !== undefined) {
+      obj.generation = GenerationMetadata.toJSON(message.generation);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ProcedureConfig>): ProcedureConfig {
-    return ProcedureConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<ColumnMetadata>): ColumnMetadata {
+    return ColumnMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ProcedureConfig>): ProcedureConfig {
-    const message = createBaseProcedureConfig();
+  fromPartial(object: DeepPartial<ColumnMetadata>): ColumnMetadata {
+    const message = createBaseColumnMetadata();
     message.name = object.name ?? "";
-    message.updater = object.updater ?? "";
-    message.sourceBranch = object.sourceBranch ?? "";
-    message.updateTime = object.updateTime ?? undefined;
+    message.position = object.position ?? 0;
+    message.hasDefault = object.hasDefault ?? false;
+    message.defaultNull = object.defaultNull ?? undefined;
+    message.defaultString = object.defaultString ?? undefined;
+    message.defaultExpression = object.defaultExpression ?? undefined;
+    message.onUpdate = object.onUpdate ?? "";
+    message.nullable = object.nullable ?? false;
+    message.type = object.type ?? "";
+    message.characterSet = object.characterSet ?? "";
+    message.collation = object.collation ?? "";
+    message.comment = object.comment ?? "";
+    message.userComment = object.userComment ?? "";
+    message.effectiveMaskingLevel = object.effectiveMaskingLevel ?? MaskingLevel.MASKING_LEVEL_UNSPECIFIED;
+    message.generation = (object.generation !== undefined && object.generation !== null)
+      ? GenerationMetadata.fromPartial(object.generation)
+      : undefined;
     return message;
   },
 };
 
-function createBaseViewConfig(): ViewConfig {
-  return { name: "", updater: "", sourceBranch: "", updateTime: undefined };
+function createBaseGenerationMetadata(): GenerationMetadata {
+  return { type: GenerationMetadata_Type.TYPE_UNSPECIFIED, expression: "" };
 }
 
-export const ViewConfig = {
-  encode(message: ViewConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.updater !== "") {
-      writer.uint32(18).string(message.updater);
-    }
-    if (message.sourceBranch !== "") {
-      writer.uint32(34).string(message.sourceBranch);
+export const GenerationMetadata = {
+  encode(message: GenerationMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== GenerationMetadata_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(generationMetadata_TypeToNumber(message.type));
     }
-    if (message.updateTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(26).fork()).ldelim();
+    if (message.expression !== "") {
+      writer.uint32(18).string(message.expression);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ViewConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerationMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseViewConfig();
+    const message = createBaseGenerationMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.type = generationMetadata_TypeFromJSON(reader.int32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.updater = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.sourceBranch = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.expression = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6686,70 +7188,62 @@ export const ViewConfig = {
     return message;
   },
 
-  fromJSON(object: any): ViewConfig {
+  fromJSON(object: any): GenerationMetadata {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
-      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
-      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+      type: isSet(object.type)
+        ? generationMetadata_TypeFromJSON(object.type)
+        : GenerationMetadata_Type.TYPE_UNSPECIFIED,
+      expression: isSet(object.expression) ? globalThis.String(object.expression) : "",
     };
   },
 
-  toJSON(message: ViewConfig): unknown {
+  toJSON(message: GenerationMetadata): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.updater !== "") {
-      obj.updater = message.updater;
-    }
-    if (message.sourceBranch !== "") {
-      obj.sourceBranch = message.sourceBranch;
+    if (message.type !== GenerationMetadata_Type.TYPE_UNSPECIFIED) {
+      obj.type = generationMetadata_TypeToJSON(message.type);
     }
-    if (message.updateTime !== undefined) {
-      obj.updateTime = message.updateTime.toISOString();
+    if (message.expression !== "") {
+      obj.expression = message.expression;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ViewConfig>): ViewConfig {
-    return ViewConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<GenerationMetadata>): GenerationMetadata {
+    return GenerationMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ViewConfig>): ViewConfig {
-    const message = createBaseViewConfig();
-    message.name = object.name ?? "";
-    message.updater = object.updater ?? "";
-    message.sourceBranch = object.sourceBranch ?? "";
-    message.updateTime = object.updateTime ?? undefined;
+  fromPartial(object: DeepPartial<GenerationMetadata>): GenerationMetadata {
+    const message = createBaseGenerationMetadata();
+    message.type = object.type ?? GenerationMetadata_Type.TYPE_UNSPECIFIED;
+    message.expression = object.expression ?? "";
     return message;
   },
 };
 
-function createBaseColumnConfig(): ColumnConfig {
-  return { name: "", semanticTypeId: "", labels: {}, classificationId: "" };
+function createBaseViewMetadata(): ViewMetadata {
+  return { name: "", definition: "", comment: "", dependentColumns: [] };
 }
 
-export const ColumnConfig = {
-  encode(message: ColumnConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ViewMetadata = {
+  encode(message: ViewMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.semanticTypeId !== "") {
-      writer.uint32(18).string(message.semanticTypeId);
+    if (message.definition !== "") {
+      writer.uint32(18).string(message.definition);
     }
-    Object.entries(message.labels).forEach(([key, value]) => {
-      ColumnConfig_LabelsEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).ldelim();
-    });
-    if (message.classificationId !== "") {
-      writer.uint32(34).string(message.classificationId);
+    if (message.comment !== "") {
+      writer.uint32(26).string(message.comment);
+    }
+    for (const v of message.dependentColumns) {
+      DependentColumn.encode(v!, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ViewMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseColumnConfig();
+    const message = createBaseViewMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6765,24 +7259,21 @@ export const ColumnConfig = {
             break;
           }
 
-          message.semanticTypeId = reader.string();
+          message.definition = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          const entry3 = ColumnConfig_LabelsEntry.decode(reader, reader.uint32());
-          if (entry3.value !== undefined) {
-            message.labels[entry3.key] = entry3.value;
-          }
+          message.comment = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.classificationId = reader.string();
+          message.dependentColumns.push(DependentColumn.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6793,80 +7284,69 @@ export const ColumnConfig = {
     return message;
   },
 
-  fromJSON(object: any): ColumnConfig {
+  fromJSON(object: any): ViewMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      semanticTypeId: isSet(object.semanticTypeId) ? globalThis.String(object.semanticTypeId) : "",
-      labels: isObject(object.labels)
-        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-          acc[key] = String(value);
-          return acc;
-        }, {})
-        : {},
-      classificationId: isSet(object.classificationId) ? globalThis.String(object.classificationId) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      dependentColumns: globalThis.Array.isArray(object?.dependentColumns)
+        ? object.dependentColumns.map((e: any) => DependentColumn.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: ColumnConfig): unknown {
+  toJSON(message: ViewMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.semanticTypeId !== "") {
-      obj.semanticTypeId = message.semanticTypeId;
+    if (message.definition !== "") {
+      obj.definition = message.definition;
     }
-    if (message.labels) {
-      const entries = Object.entries(message.labels);
-      if (entries.length > 0) {
-        obj.labels = {};
-        entries.forEach(([k, v]) => {
-          obj.labels[k] = v;
-        });
-      }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
     }
-    if (message.classificationId !== "") {
-      obj.classificationId = message.classificationId;
+    if (message.dependentColumns?.length) {
+      obj.dependentColumns = message.dependentColumns.map((e) => DependentColumn.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ColumnConfig>): ColumnConfig {
-    return ColumnConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<ViewMetadata>): ViewMetadata {
+    return ViewMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ColumnConfig>): ColumnConfig {
-    const message = createBaseColumnConfig();
+  fromPartial(object: DeepPartial<ViewMetadata>): ViewMetadata {
+    const message = createBaseViewMetadata();
     message.name = object.name ?? "";
-    message.semanticTypeId = object.semanticTypeId ?? "";
-    message.labels = Object.entries(object.labels ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
-      if (value !== undefined) {
-        acc[key] = globalThis.String(value);
-      }
-      return acc;
-    }, {});
-    message.classificationId = object.classificationId ?? "";
+    message.definition = object.definition ?? "";
+    message.comment = object.comment ?? "";
+    message.dependentColumns = object.dependentColumns?.map((e) => DependentColumn.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseColumnConfig_LabelsEntry(): ColumnConfig_LabelsEntry {
-  return { key: "", value: "" };
+function createBaseDependentColumn(): DependentColumn {
+  return { schema: "", table: "", column: "" };
 }
 
-export const ColumnConfig_LabelsEntry = {
-  encode(message: ColumnConfig_LabelsEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.key !== "") {
-      writer.uint32(10).string(message.key);
+export const DependentColumn = {
+  encode(message: DependentColumn, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
     }
-    if (message.value !== "") {
-      writer.uint32(18).string(message.value);
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    if (message.column !== "") {
+      writer.uint32(26).string(message.column);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnConfig_LabelsEntry {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DependentColumn {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseColumnConfig_LabelsEntry();
+    const message = createBaseDependentColumn();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6875,14 +7355,21 @@ export const ColumnConfig_LabelsEntry = {
             break;
           }
 
-          message.key = reader.string();
+          message.schema = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.value = reader.string();
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.column = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6893,51 +7380,65 @@ export const ColumnConfig_LabelsEntry = {
     return message;
   },
 
-  fromJSON(object: any): ColumnConfig_LabelsEntry {
+  fromJSON(object: any): DependentColumn {
     return {
-      key: isSet(object.key) ? globalThis.String(object.key) : "",
-      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      column: isSet(object.column) ? globalThis.String(object.column) : "",
     };
   },
 
-  toJSON(message: ColumnConfig_LabelsEntry): unknown {
+  toJSON(message: DependentColumn): unknown {
     const obj: any = {};
-    if (message.key !== "") {
-      obj.key = message.key;
+    if (message.schema !== "") {
+      obj.schema = message.schema;
     }
-    if (message.value !== "") {
-      obj.value = message.value;
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.column !== "") {
+      obj.column = message.column;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ColumnConfig_LabelsEntry>): ColumnConfig_LabelsEntry {
-    return ColumnConfig_LabelsEntry.fromPartial(base ?? {});
+  create(base?: DeepPartial<DependentColumn>): DependentColumn {
+    return DependentColumn.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ColumnConfig_LabelsEntry>): ColumnConfig_LabelsEntry {
-    const message = createBaseColumnConfig_LabelsEntry();
-    message.key = object.key ?? "";
-    message.value = object.value ?? "";
+  fromPartial(object: DeepPartial<DependentColumn>): DependentColumn {
+    const message = createBaseDependentColumn();
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    message.column = object.column ?? "";
     return message;
   },
 };
 
-function createBaseDatabaseSchema(): DatabaseSchema {
-  return { schema: "" };
+function createBaseMaterializedViewMetadata(): MaterializedViewMetadata {
+  return { name: "", definition: "", comment: "", dependentColumns: [] };
 }
 
-export const DatabaseSchema = {
-  encode(message: DatabaseSchema, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.schema !== "") {
-      writer.uint32(10).string(message.schema);
+export const MaterializedViewMetadata = {
+  encode(message: MaterializedViewMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.definition !== "") {
+      writer.uint32(18).string(message.definition);
+    }
+    if (message.comment !== "") {
+      writer.uint32(26).string(message.comment);
+    }
+    for (const v of message.dependentColumns) {
+      DependentColumn.encode(v!, writer.uint32(34).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseSchema {
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaterializedViewMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDatabaseSchema();
+    const message = createBaseMaterializedViewMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -6946,7 +7447,28 @@ export const DatabaseSchema = {
             break;
           }
 
-          message.schema = reader.string();
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.definition = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.dependentColumns.push(DependentColumn.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -6957,50 +7479,66 @@ export const DatabaseSchema = {
     return message;
   },
 
-  fromJSON(object: any): DatabaseSchema {
-    return { schema: isSet(object.schema) ? globalThis.String(object.schema) : "" };
+  fromJSON(object: any): MaterializedViewMetadata {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      dependentColumns: globalThis.Array.isArray(object?.dependentColumns)
+        ? object.dependentColumns.map((e: any) => DependentColumn.fromJSON(e))
+        : [],
+    };
   },
 
-  toJSON(message: DatabaseSchema): unknown {
+  toJSON(message: MaterializedViewMetadata): unknown {
     const obj: any = {};
-    if (message.schema !== "") {
-      obj.schema = message.schema;
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.dependentColumns?.length) {
+      obj.dependentColumns = message.dependentColumns.map((e) => DependentColumn.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DatabaseSchema>): DatabaseSchema {
-    return DatabaseSchema.fromPartial(base ?? {});
+  create(base?: DeepPartial<MaterializedViewMetadata>): MaterializedViewMetadata {
+    return MaterializedViewMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DatabaseSchema>): DatabaseSchema {
-    const message = createBaseDatabaseSchema();
-    message.schema = object.schema ?? "";
+  fromPartial(object: DeepPartial<MaterializedViewMetadata>): MaterializedViewMetadata {
+    const message = createBaseMaterializedViewMetadata();
+    message.name = object.name ?? "";
+    message.definition = object.definition ?? "";
+    message.comment = object.comment ?? "";
+    message.dependentColumns = object.dependentColumns?.map((e) => DependentColumn.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseListSlowQueriesRequest(): ListSlowQueriesRequest {
-  return { parent: "", filter: "", orderBy: "" };
+function createBaseFunctionMetadata(): FunctionMetadata {
+  return { name: "", definition: "" };
 }
 
-export const ListSlowQueriesRequest = {
-  encode(message: ListSlowQueriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
-    }
-    if (message.filter !== "") {
-      writer.uint32(18).string(message.filter);
+export const FunctionMetadata = {
+  encode(message: FunctionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.orderBy !== "") {
-      writer.uint32(26).string(message.orderBy);
+    if (message.definition !== "") {
+      writer.uint32(18).string(message.definition);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListSlowQueriesRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FunctionMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListSlowQueriesRequest();
+    const message = createBaseFunctionMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7009,21 +7547,14 @@ export const ListSlowQueriesRequest = {
             break;
           }
 
-          message.parent = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.filter = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.orderBy = reader.string();
+          message.definition = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7034,56 +7565,54 @@ export const ListSlowQueriesRequest = {
     return message;
   },
 
-  fromJSON(object: any): ListSlowQueriesRequest {
+  fromJSON(object: any): FunctionMetadata {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
-      orderBy: isSet(object.orderBy) ? globalThis.String(object.orderBy) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
     };
   },
 
-  toJSON(message: ListSlowQueriesRequest): unknown {
+  toJSON(message: FunctionMetadata): unknown {
     const obj: any = {};
-    if (message.parent !== "") {
-      obj.parent = message.parent;
-    }
-    if (message.filter !== "") {
-      obj.filter = message.filter;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.orderBy !== "") {
-      obj.orderBy = message.orderBy;
+    if (message.definition !== "") {
+      obj.definition = message.definition;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListSlowQueriesRequest>): ListSlowQueriesRequest {
-    return ListSlowQueriesRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<FunctionMetadata>): FunctionMetadata {
+    return FunctionMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListSlowQueriesRequest>): ListSlowQueriesRequest {
-    const message = createBaseListSlowQueriesRequest();
-    message.parent = object.parent ?? "";
-    message.filter = object.filter ?? "";
-    message.orderBy = object.orderBy ?? "";
+  fromPartial(object: DeepPartial<FunctionMetadata>): FunctionMetadata {
+    const message = createBaseFunctionMetadata();
+    message.name = object.name ?? "";
+    message.definition = object.definition ?? "";
     return message;
   },
 };
 
-function createBaseListSlowQueriesResponse(): ListSlowQueriesResponse {
-  return { slowQueryLogs: [] };
+function createBaseProcedureMetadata(): ProcedureMetadata {
+  return { name: "", definition: "" };
 }
 
-export const ListSlowQueriesResponse = {
-  encode(message: ListSlowQueriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.slowQueryLogs) {
-      SlowQueryLog.encode(v!, writer.uint32(10).fork()).ldelim();
+export const ProcedureMetadata = {
+  encode(message: ProcedureMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.definition !== "") {
+      writer.uint32(18).string(message.definition);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListSlowQueriesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProcedureMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListSlowQueriesResponse();
+    const message = createBaseProcedureMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7092,7 +7621,14 @@ export const ListSlowQueriesResponse = {
             break;
           }
 
-          message.slowQueryLogs.push(SlowQueryLog.decode(reader, reader.uint32()));
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.definition = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7103,54 +7639,89 @@ export const ListSlowQueriesResponse = {
     return message;
   },
 
-  fromJSON(object: any): ListSlowQueriesResponse {
+  fromJSON(object: any): ProcedureMetadata {
     return {
-      slowQueryLogs: globalThis.Array.isArray(object?.slowQueryLogs)
-        ? object.slowQueryLogs.map((e: any) => SlowQueryLog.fromJSON(e))
-        : [],
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
     };
   },
 
-  toJSON(message: ListSlowQueriesResponse): unknown {
+  toJSON(message: ProcedureMetadata): unknown {
     const obj: any = {};
-    if (message.slowQueryLogs?.length) {
-      obj.slowQueryLogs = message.slowQueryLogs.map((e) => SlowQueryLog.toJSON(e));
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListSlowQueriesResponse>): ListSlowQueriesResponse {
-    return ListSlowQueriesResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<ProcedureMetadata>): ProcedureMetadata {
+    return ProcedureMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListSlowQueriesResponse>): ListSlowQueriesResponse {
-    const message = createBaseListSlowQueriesResponse();
-    message.slowQueryLogs = object.slowQueryLogs?.map((e) => SlowQueryLog.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<ProcedureMetadata>): ProcedureMetadata {
+    const message = createBaseProcedureMetadata();
+    message.name = object.name ?? "";
+    message.definition = object.definition ?? "";
     return message;
   },
 };
 
-function createBaseSlowQueryLog(): SlowQueryLog {
-  return { resource: "", project: "", statistics: undefined };
+function createBaseTaskMetadata(): TaskMetadata {
+  return {
+    name: "",
+    id: "",
+    owner: "",
+    comment: "",
+    warehouse: "",
+    schedule: "",
+    predecessors: [],
+    state: TaskMetadata_State.STATE_UNSPECIFIED,
+    condition: "",
+    definition: "",
+  };
 }
 
-export const SlowQueryLog = {
-  encode(message: SlowQueryLog, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.resource !== "") {
-      writer.uint32(10).string(message.resource);
+export const TaskMetadata = {
+  encode(message: TaskMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.project !== "") {
-      writer.uint32(18).string(message.project);
+    if (message.id !== "") {
+      writer.uint32(18).string(message.id);
     }
-    if (message.statistics !== undefined) {
-      SlowQueryStatistics.encode(message.statistics, writer.uint32(26).fork()).ldelim();
+    if (message.owner !== "") {
+      writer.uint32(26).string(message.owner);
+    }
+    if (message.comment !== "") {
+      writer.uint32(34).string(message.comment);
+    }
+    if (message.warehouse !== "") {
+      writer.uint32(42).string(message.warehouse);
+    }
+    if (message.schedule !== "") {
+      writer.uint32(50).string(message.schedule);
+    }
+    for (const v of message.predecessors) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.state !== TaskMetadata_State.STATE_UNSPECIFIED) {
+      writer.uint32(64).int32(taskMetadata_StateToNumber(message.state));
+    }
+    if (message.condition !== "") {
+      writer.uint32(74).string(message.condition);
+    }
+    if (message.definition !== "") {
+      writer.uint32(82).string(message.definition);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SlowQueryLog {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSlowQueryLog();
+    const message = createBaseTaskMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7159,21 +7730,70 @@ export const SlowQueryLog = {
             break;
           }
 
-          message.resource = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.project = reader.string();
+          message.id = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.statistics = SlowQueryStatistics.decode(reader, reader.uint32());
+          message.owner = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.warehouse = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.schedule = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.predecessors.push(reader.string());
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.state = taskMetadata_StateFromJSON(reader.int32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.condition = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.definition = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7184,104 +7804,123 @@ export const SlowQueryLog = {
     return message;
   },
 
-  fromJSON(object: any): SlowQueryLog {
+  fromJSON(object: any): TaskMetadata {
     return {
-      resource: isSet(object.resource) ? globalThis.String(object.resource) : "",
-      project: isSet(object.project) ? globalThis.String(object.project) : "",
-      statistics: isSet(object.statistics) ? SlowQueryStatistics.fromJSON(object.statistics) : undefined,
-    };
-  },
-
-  toJSON(message: SlowQueryLog): unknown {
-    const obj: any = {};
-    if (message.resource !== "") {
-      obj.resource = message.resource;
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      id: isSet(object.id) ? globalThis.String(object.id) : "",
+      owner: isSet(object.owner) ? globalThis.String(object.owner) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      warehouse: isSet(object.warehouse) ? globalThis.String(object.warehouse) : "",
+      schedule: isSet(object.schedule) ? globalThis.String(object.schedule) : "",
+      predecessors: globalThis.Array.isArray(object?.predecessors)
+        ? object.predecessors.map((e: any) => globalThis.String(e))
+        : [],
+      state: isSet(object.state) ? taskMetadata_StateFromJSON(object.state) : TaskMetadata_State.STATE_UNSPECIFIED,
+      condition: isSet(object.condition) ? globalThis.String(object.condition) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+    };
+  },
+
+  toJSON(message: TaskMetadata): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.project !== "") {
-      obj.project = message.project;
+    if (message.id !== "") {
+      obj.id = message.id;
     }
-    if (message.statistics !== undefined) {
-      obj.statistics = SlowQueryStatistics.toJSON(message.statistics);
+    if (message.owner !== "") {
+      obj.owner = message.owner;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.warehouse !== "") {
+      obj.warehouse = message.warehouse;
+    }
+    if (message.schedule !== "") {
+      obj.schedule = message.schedule;
+    }
+    if (message.predecessors?.length) {
+      obj.predecessors = message.predecessors;
+    }
+    if (message.state !== TaskMetadata_State.STATE_UNSPECIFIED) {
+      obj.state = taskMetadata_StateToJSON(message.state);
+    }
+    if (message.condition !== "") {
+      obj.condition = message.condition;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SlowQueryLog>): SlowQueryLog {
-    return SlowQueryLog.fromPartial(base ?? {});
+  create(base?: DeepPartial<TaskMetadata>): TaskMetadata {
+    return TaskMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SlowQueryLog>): SlowQueryLog {
-    const message = createBaseSlowQueryLog();
-    message.resource = object.resource ?? "";
-    message.project = object.project ?? "";
-    message.statistics = (object.statistics !== undefined && object.statistics !== null)
-      ? SlowQueryStatistics.fromPartial(object.statistics)
-      : undefined;
+  fromPartial(object: DeepPartial<TaskMetadata>): TaskMetadata {
+    const message = createBaseTaskMetadata();
+    message.name = object.name ?? "";
+    message.id = object.id ?? "";
+    message.owner = object.owner ?? "";
+    message.comment = object.comment ?? "";
+    message.warehouse = object.warehouse ?? "";
+    message.schedule = object.schedule ?? "";
+    message.predecessors = object.predecessors?.map((e) => e) || [];
+    message.state = object.state ?? TaskMetadata_State.STATE_UNSPECIFIED;
+    message.condition = object.condition ?? "";
+    message.definition = object.definition ?? "";
     return message;
   },
 };
 
-function createBaseSlowQueryStatistics(): SlowQueryStatistics {
+function createBaseStreamMetadata(): StreamMetadata {
   return {
-    sqlFingerprint: "",
-    count: 0,
-    latestLogTime: undefined,
-    averageQueryTime: undefined,
-    maximumQueryTime: undefined,
-    averageRowsSent: 0,
-    maximumRowsSent: 0,
-    averageRowsExamined: 0,
-    maximumRowsExamined: 0,
-    queryTimePercent: 0,
-    countPercent: 0,
-    samples: [],
+    name: "",
+    tableName: "",
+    owner: "",
+    comment: "",
+    type: StreamMetadata_Type.TYPE_UNSPECIFIED,
+    stale: false,
+    mode: StreamMetadata_Mode.MODE_UNSPECIFIED,
+    definition: "",
   };
 }
 
-export const SlowQueryStatistics = {
-  encode(message: SlowQueryStatistics, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.sqlFingerprint !== "") {
-      writer.uint32(10).string(message.sqlFingerprint);
-    }
-    if (message.count !== 0) {
-      writer.uint32(16).int32(message.count);
-    }
-    if (message.latestLogTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.latestLogTime), writer.uint32(26).fork()).ldelim();
-    }
-    if (message.averageQueryTime !== undefined) {
-      Duration.encode(message.averageQueryTime, writer.uint32(34).fork()).ldelim();
-    }
-    if (message.maximumQueryTime !== undefined) {
-      Duration.encode(message.maximumQueryTime, writer.uint32(42).fork()).ldelim();
+export const StreamMetadata = {
+  encode(message: StreamMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.averageRowsSent !== 0) {
-      writer.uint32(48).int32(message.averageRowsSent);
+    if (message.tableName !== "") {
+      writer.uint32(18).string(message.tableName);
     }
-    if (message.maximumRowsSent !== 0) {
-      writer.uint32(56).int32(message.maximumRowsSent);
+    if (message.owner !== "") {
+      writer.uint32(26).string(message.owner);
     }
-    if (message.averageRowsExamined !== 0) {
-      writer.uint32(64).int32(message.averageRowsExamined);
+    if (message.comment !== "") {
+      writer.uint32(34).string(message.comment);
     }
-    if (message.maximumRowsExamined !== 0) {
-      writer.uint32(72).int32(message.maximumRowsExamined);
+    if (message.type !== StreamMetadata_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(40).int32(streamMetadata_TypeToNumber(message.type));
     }
-    if (message.queryTimePercent !== 0) {
-      writer.uint32(81).double(message.queryTimePercent);
+    if (message.stale === true) {
+      writer.uint32(48).bool(message.stale);
     }
-    if (message.countPercent !== 0) {
-      writer.uint32(89).double(message.countPercent);
+    if (message.mode !== StreamMetadata_Mode.MODE_UNSPECIFIED) {
+      writer.uint32(56).int32(streamMetadata_ModeToNumber(message.mode));
     }
-    for (const v of message.samples) {
-      SlowQueryDetails.encode(v!, writer.uint32(98).fork()).ldelim();
+    if (message.definition !== "") {
+      writer.uint32(66).string(message.definition);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SlowQueryStatistics {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StreamMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSlowQueryStatistics();
+    const message = createBaseStreamMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7290,84 +7929,56 @@ export const SlowQueryStatistics = {
             break;
           }
 
-          message.sqlFingerprint = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.count = reader.int32();
+          message.tableName = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.latestLogTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.owner = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.averageQueryTime = Duration.decode(reader, reader.uint32());
+          message.comment = reader.string();
           continue;
         case 5:
-          if (tag !== 42) {
+          if (tag !== 40) {
             break;
           }
 
-          message.maximumQueryTime = Duration.decode(reader, reader.uint32());
+          message.type = streamMetadata_TypeFromJSON(reader.int32());
           continue;
         case 6:
           if (tag !== 48) {
             break;
           }
 
-          message.averageRowsSent = reader.int32();
+          message.stale = reader.bool();
           continue;
         case 7:
           if (tag !== 56) {
             break;
           }
 
-          message.maximumRowsSent = reader.int32();
+          message.mode = streamMetadata_ModeFromJSON(reader.int32());
           continue;
         case 8:
-          if (tag !== 64) {
-            break;
-          }
-
-          message.averageRowsExamined = reader.int32();
-          continue;
-        case 9:
-          if (tag !== 72) {
-            break;
-          }
-
-          message.maximumRowsExamined = reader.int32();
-          continue;
-        case 10:
-          if (tag !== 81) {
-            break;
-          }
-
-          message.queryTimePercent = reader.double();
-          continue;
-        case 11:
-          if (tag !== 89) {
-            break;
-          }
-
-          message.countPercent = reader.double();
-          continue;
-        case 12:
-          if (tag !== 98) {
+          if (tag !== 66) {
             break;
           }
 
-          message.samples.push(SlowQueryDetails.decode(reader, reader.uint32()));
+          message.definition = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7378,122 +7989,127 @@ export const SlowQueryStatistics = {
     return message;
   },
 
-  fromJSON(object: any): SlowQueryStatistics {
+  fromJSON(object: any): StreamMetadata {
     return {
-      sqlFingerprint: isSet(object.sqlFingerprint) ? globalThis.String(object.sqlFingerprint) : "",
-      count: isSet(object.count) ? globalThis.Number(object.count) : 0,
-      latestLogTime: isSet(object.latestLogTime) ? fromJsonTimestamp(object.latestLogTime) : undefined,
-      averageQueryTime: isSet(object.averageQueryTime) ? Duration.fromJSON(object.averageQueryTime) : undefined,
-      maximumQueryTime: isSet(object.maximumQueryTime) ? Duration.fromJSON(object.maximumQueryTime) : undefined,
-      averageRowsSent: isSet(object.averageRowsSent) ? globalThis.Number(object.averageRowsSent) : 0,
-      maximumRowsSent: isSet(object.maximumRowsSent) ? globalThis.Number(object.maximumRowsSent) : 0,
-      averageRowsExamined: isSet(object.averageRowsExamined) ? globalThis.Number(object.averageRowsExamined) : 0,
-      maximumRowsExamined: isSet(object.maximumRowsExamined) ? globalThis.Number(object.maximumRowsExamined) : 0,
-      queryTimePercent: isSet(object.queryTimePercent) ? globalThis.Number(object.queryTimePercent) : 0,
-      countPercent: isSet(object.countPercent) ? globalThis.Number(object.countPercent) : 0,
-      samples: globalThis.Array.isArray(object?.samples)
-        ? object.samples.map((e: any) => SlowQueryDetails.fromJSON(e))
-        : [],
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      tableName: isSet(object.tableName) ? globalThis.String(object.tableName) : "",
+      owner: isSet(object.owner) ? globalThis.String(object.owner) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      type: isSet(object.type) ? streamMetadata_TypeFromJSON(object.type) : StreamMetadata_Type.TYPE_UNSPECIFIED,
+      stale: isSet(object.stale) ? globalThis.Boolean(object.stale) : false,
+      mode: isSet(object.mode) ? streamMetadata_ModeFromJSON(object.mode) : StreamMetadata_Mode.MODE_UNSPECIFIED,
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
     };
   },
 
-  toJSON(message: SlowQueryStatistics): unknown {
+  toJSON(message: StreamMetadata): unknown {
     const obj: any = {};
-    if (message.sqlFingerprint !== "") {
-      obj.sqlFingerprint = message.sqlFingerprint;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.count !== 0) {
-      obj.count = Math.round(message.count);
+    if (message.tableName !== "") {
+      obj.tableName = message.tableName;
     }
-    if (message.latestLogTime !== undefined) {
-      obj.latestLogTime = message.latestLogTime.toISOString();
+    if (message.owner !== "") {
+      obj.owner = message.owner;
     }
-    if (message.averageQueryTime !== undefined) {
-      obj.averageQueryTime = Duration.toJSON(message.averageQueryTime);
+    if (message.comment !== "") {
+      obj.comment = message.comment;
     }
-    if (message.maximumQueryTime !== undefined) {
-      obj.maximumQueryTime = Duration.toJSON(message.maximumQueryTime);
+    if (message.type !== StreamMetadata_Type.TYPE_UNSPECIFIED) {
+      obj.type = streamMetadata_TypeToJSON(message.type);
     }
-    if (message.averageRowsSent !== 0) {
-      obj.averageRowsSent = Math.round(message.averageRowsSent);
+    if (message.stale === true) {
+      obj.stale = message.stale;
     }
-    if (message.maximumRowsSent !== 0) {
-      obj.maximumRowsSent = Math.round(message.maximumRowsSent);
+    if (message.mode !== StreamMetadata_Mode.MODE_UNSPECIFIED) {
+      obj.mode = streamMetadata_ModeToJSON(message.mode);
     }
-    if (message.averageRowsExamined !== 0) {
-      obj.averageRowsExamined = Math.round(message.averageRowsExamined);
-    }
-    if (message.maximumRowsExamined !== 0) {
-      obj.maximumRowsExamined = Math.round(message.maximumRowsExamined);
-    }
-    if (message.queryTimePercent !== 0) {
-      obj.queryTimePercent = message.queryTimePercent;
-    }
-    if (message.countPercent !== 0) {
-      obj.countPercent = message.countPercent;
-    }
-    if (message.samples?.length) {
-      obj.samples = message.samples.map((e) => SlowQueryDetails.toJSON(e));
+    if (message.definition !== "") {
+      obj.definition = message.definition;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SlowQueryStatistics>): SlowQueryStatistics {
-    return SlowQueryStatistics.fromPartial(base ?? {});
+  create(base?: DeepPartial<StreamMetadata>): StreamMetadata {
+    return StreamMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SlowQueryStatistics>): SlowQueryStatistics {
-    const message = createBaseSlowQueryStatistics();
-    message.sqlFingerprint = object.sqlFingerprint ?? "";
-    message.count = object.count ?? 0;
-    message.latestLogTime = object.latestLogTime ?? undefined;
-    message.averageQueryTime = (object.averageQueryTime !== undefined && object.averageQueryTime !== null)
-      ? Duration.fromPartial(object.averageQueryTime)
-      : undefined;
-    message.maximumQueryTime = (object.maximumQueryTime !== undefined && object.maximumQueryTime !== null)
-      ? Duration.fromPartial(object.maximumQueryTime)
-      : undefined;
-    message.averageRowsSent = object.averageRowsSent ?? 0;
-    message.maximumRowsSent = object.maximumRowsSent ?? 0;
-    message.averageRowsExamined = object.averageRowsExamined ?? 0;
-    message.maximumRowsExamined = object.maximumRowsExamined ?? 0;
-    message.queryTimePercent = object.queryTimePercent ?? 0;
-    message.countPercent = object.countPercent ?? 0;
-    message.samples = object.samples?.map((e) => SlowQueryDetails.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<StreamMetadata>): StreamMetadata {
+    const message = createBaseStreamMetadata();
+    message.name = object.name ?? "";
+    message.tableName = object.tableName ?? "";
+    message.owner = object.owner ?? "";
+    message.comment = object.comment ?? "";
+    message.type = object.type ?? StreamMetadata_Type.TYPE_UNSPECIFIED;
+    message.stale = object.stale ?? false;
+    message.mode = object.mode ?? StreamMetadata_Mode.MODE_UNSPECIFIED;
+    message.definition = object.definition ?? "";
     return message;
   },
 };
 
-function createBaseSlowQueryDetails(): SlowQueryDetails {
-  return { startTime: undefined, queryTime: undefined, lockTime: undefined, rowsSent: 0, rowsExamined: 0, sqlText: "" };
+function createBaseIndexMetadata(): IndexMetadata {
+  return {
+    name: "",
+    expressions: [],
+    keyLength: [],
+    descending: [],
+    type: "",
+    unique: false,
+    primary: false,
+    visible: false,
+    comment: "",
+    definition: "",
+    parameters: {},
+  };
 }
 
-export const SlowQueryDetails = {
-  encode(message: SlowQueryDetails, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.startTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(10).fork()).ldelim();
+export const IndexMetadata = {
+  encode(message: IndexMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.queryTime !== undefined) {
-      Duration.encode(message.queryTime, writer.uint32(18).fork()).ldelim();
+    for (const v of message.expressions) {
+      writer.uint32(18).string(v!);
     }
-    if (message.lockTime !== undefined) {
-      Duration.encode(message.lockTime, writer.uint32(26).fork()).ldelim();
+    writer.uint32(74).fork();
+    for (const v of message.keyLength) {
+      writer.int64(v);
     }
-    if (message.rowsSent !== 0) {
-      writer.uint32(32).int32(message.rowsSent);
+    writer.ldelim();
+    writer.uint32(82).fork();
+    for (const v of message.descending) {
+      writer.bool(v);
     }
-    if (message.rowsExamined !== 0) {
-      writer.uint32(40).int32(message.rowsExamined);
+    writer.ldelim();
+    if (message.type !== "") {
+      writer.uint32(26).string(message.type);
     }
-    if (message.sqlText !== "") {
-      writer.uint32(50).string(message.sqlText);
+    if (message.unique === true) {
+      writer.uint32(32).bool(message.unique);
+    }
+    if (message.primary === true) {
+      writer.uint32(40).bool(message.primary);
+    }
+    if (message.visible === true) {
+      writer.uint32(48).bool(message.visible);
+    }
+    if (message.comment !== "") {
+      writer.uint32(58).string(message.comment);
+    }
+    if (message.definition !== "") {
+      writer.uint32(66).string(message.definition);
     }
+    Object.entries(message.parameters).forEach(([key, value]) => {
+      IndexMetadata_ParametersEntry.encode({ key: key as any, value }, writer.uint32(90).fork()).ldelim();
+    });
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SlowQueryDetails {
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSlowQueryDetails();
+    const message = createBaseIndexMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7502,42 +8118,100 @@ export const SlowQueryDetails = {
             break;
           }
 
-          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.queryTime = Duration.decode(reader, reader.uint32());
+          message.expressions.push(reader.string());
           continue;
+        case 9:
+          if (tag === 72) {
+            message.keyLength.push(reader.int64() as Long);
+
+            continue;
+          }
+
+          if (tag === 74) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.keyLength.push(reader.int64() as Long);
+            }
+
+            continue;
+          }
+
+          break;
+        case 10:
+          if (tag === 80) {
+            message.descending.push(reader.bool());
+
+            continue;
+          }
+
+          if (tag === 82) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.descending.push(reader.bool());
+            }
+
+            continue;
+          }
+
+          break;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.lockTime = Duration.decode(reader, reader.uint32());
+          message.type = reader.string();
           continue;
         case 4:
           if (tag !== 32) {
             break;
           }
 
-          message.rowsSent = reader.int32();
+          message.unique = reader.bool();
           continue;
         case 5:
           if (tag !== 40) {
             break;
           }
 
-          message.rowsExamined = reader.int32();
+          message.primary = reader.bool();
           continue;
         case 6:
-          if (tag !== 50) {
+          if (tag !== 48) {
             break;
           }
 
-          message.sqlText = reader.string();
+          message.visible = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.definition = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          const entry11 = IndexMetadata_ParametersEntry.decode(reader, reader.uint32());
+          if (entry11.value !== undefined) {
+            message.parameters[entry11.key] = entry11.value;
+          }
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7548,81 +8222,122 @@ export const SlowQueryDetails = {
     return message;
   },
 
-  fromJSON(object: any): SlowQueryDetails {
+  fromJSON(object: any): IndexMetadata {
     return {
-      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
-      queryTime: isSet(object.queryTime) ? Duration.fromJSON(object.queryTime) : undefined,
-      lockTime: isSet(object.lockTime) ? Duration.fromJSON(object.lockTime) : undefined,
-      rowsSent: isSet(object.rowsSent) ? globalThis.Number(object.rowsSent) : 0,
-      rowsExamined: isSet(object.rowsExamined) ? globalThis.Number(object.rowsExamined) : 0,
-      sqlText: isSet(object.sqlText) ? globalThis.String(object.sqlText) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      expressions: globalThis.Array.isArray(object?.expressions)
+        ? object.expressions.map((e: any) => globalThis.String(e))
+        : [],
+      keyLength: globalThis.Array.isArray(object?.keyLength) ? object.keyLength.map((e: any) => Long.fromValue(e)) : [],
+      descending: globalThis.Array.isArray(object?.descending)
+        ? object.descending.map((e: any) => globalThis.Boolean(e))
+        : [],
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      unique: isSet(object.unique) ? globalThis.Boolean(object.unique) : false,
+      primary: isSet(object.primary) ? globalThis.Boolean(object.primary) : false,
+      visible: isSet(object.visible) ? globalThis.Boolean(object.visible) : false,
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+      parameters: isObject(object.parameters)
+        ? Object.entries(object.parameters).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
-  toJSON(message: SlowQueryDetails): unknown {
+  toJSON(message: IndexMetadata): unknown {
     const obj: any = {};
-    if (message.startTime !== undefined) {
-      obj.startTime = message.startTime.toISOString();
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.queryTime !== undefined) {
-      obj.queryTime = Duration.toJSON(message.queryTime);
+    if (message.expressions?.length) {
+      obj.expressions = message.expressions;
     }
-    if (message.lockTime !== undefined) {
-      obj.lockTime = Duration.toJSON(message.lockTime);
+    if (message.keyLength?.length) {
+      obj.keyLength = message.keyLength.map((e) => (e || Long.ZERO).toString());
     }
-    if (message.rowsSent !== 0) {
-      obj.rowsSent = Math.round(message.rowsSent);
+    if (message.descending?.length) {
+      obj.descending = message.descending;
     }
-    if (message.rowsExamined !== 0) {
-      obj.rowsExamined = Math.round(message.rowsExamined);
+    if (message.type !== "") {
+      obj.type = message.type;
     }
-    if (message.sqlText !== "") {
-      obj.sqlText = message.sqlText;
+    if (message.unique === true) {
+      obj.unique = message.unique;
+    }
+    if (message.primary === true) {
+      obj.primary = message.primary;
+    }
+    if (message.visible === true) {
+      obj.visible = message.visible;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
+    }
+    if (message.parameters) {
+      const entries = Object.entries(message.parameters);
+      if (entries.length > 0) {
+        obj.parameters = {};
+        entries.forEach(([k, v]) => {
+          obj.parameters[k] = v;
+        });
+      }
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SlowQueryDetails>): SlowQueryDetails {
-    return SlowQueryDetails.fromPartial(base ?? {});
+  create(base?: DeepPartial<IndexMetadata>): IndexMetadata {
+    return IndexMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SlowQueryDetails>): SlowQueryDetails {
-    const message = createBaseSlowQueryDetails();
-    message.startTime = object.startTime ?? undefined;
-    message.queryTime = (object.queryTime !== undefined && object.queryTime !== null)
-      ? Duration.fromPartial(object.queryTime)
-      : undefined;
-    message.lockTime = (object.lockTime !== undefined && object.lockTime !== null)
-      ? Duration.fromPartial(object.lockTime)
-      : undefined;
-    message.rowsSent = object.rowsSent ?? 0;
-    message.rowsExamined = object.rowsExamined ?? 0;
-    message.sqlText = object.sqlText ?? "";
+  fromPartial(object: DeepPartial<IndexMetadata>): IndexMetadata {
+    const message = createBaseIndexMetadata();
+    message.name = object.name ?? "";
+    message.expressions = object.expressions?.map((e) => e) || [];
+    message.keyLength = object.keyLength?.map((e) => Long.fromValue(e)) || [];
+    message.descending = object.descending?.map((e) => e) || [];
+    message.type = object.type ?? "";
+    message.unique = object.unique ?? false;
+    message.primary = object.primary ?? false;
+    message.visible = object.visible ?? false;
+    message.comment = object.comment ?? "";
+    message.definition = object.definition ?? "";
+    message.parameters = Object.entries(object.parameters ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
 
-function createBaseListSecretsRequest(): ListSecretsRequest {
-  return { parent: "", pageSize: 0, pageToken: "" };
+function createBaseIndexMetadata_ParametersEntry(): IndexMetadata_ParametersEntry {
+  return { key: "", value: "" };
 }
 
-export const ListSecretsRequest = {
-  encode(message: ListSecretsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
-    }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
+export const IndexMetadata_ParametersEntry = {
+  encode(message: IndexMetadata_ParametersEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.pageToken !== "") {
-      writer.uint32(26).string(message.pageToken);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListSecretsRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexMetadata_ParametersEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListSecretsRequest();
+    const message = createBaseIndexMetadata_ParametersEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7631,21 +8346,14 @@ export const ListSecretsRequest = {
             break;
           }
 
-          message.parent = reader.string();
+          message.key = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.pageSize = reader.int32();
-          continue;
-        case 3:
-          if (tag !== 26) {
+          if (tag !== 18) {
             break;
           }
 
-          message.pageToken = reader.string();
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7656,59 +8364,60 @@ export const ListSecretsRequest = {
     return message;
   },
 
-  fromJSON(object: any): ListSecretsRequest {
+  fromJSON(object: any): IndexMetadata_ParametersEntry {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
-      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
     };
   },
 
-  toJSON(message: ListSecretsRequest): unknown {
+  toJSON(message: IndexMetadata_ParametersEntry): unknown {
     const obj: any = {};
-    if (message.parent !== "") {
-      obj.parent = message.parent;
-    }
-    if (message.pageSize !== 0) {
-      obj.pageSize = Math.round(message.pageSize);
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.pageToken !== "") {
-      obj.pageToken = message.pageToken;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListSecretsRequest>): ListSecretsRequest {
-    return ListSecretsRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<IndexMetadata_ParametersEntry>): IndexMetadata_ParametersEntry {
+    return IndexMetadata_ParametersEntry.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListSecretsRequest>): ListSecretsRequest {
-    const message = createBaseListSecretsRequest();
-    message.parent = object.parent ?? "";
-    message.pageSize = object.pageSize ?? 0;
-    message.pageToken = object.pageToken ?? "";
+  fromPartial(object: DeepPartial<IndexMetadata_ParametersEntry>): IndexMetadata_ParametersEntry {
+    const message = createBaseIndexMetadata_ParametersEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseListSecretsResponse(): ListSecretsResponse {
-  return { secrets: [], nextPageToken: "" };
+function createBaseExtensionMetadata(): ExtensionMetadata {
+  return { name: "", schema: "", version: "", description: "" };
 }
 
-export const ListSecretsResponse = {
-  encode(message: ListSecretsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.secrets) {
-      Secret.encode(v!, writer.uint32(10).fork()).ldelim();
+export const ExtensionMetadata = {
+  encode(message: ExtensionMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+    if (message.schema !== "") {
+      writer.uint32(18).string(message.schema);
+    }
+    if (message.version !== "") {
+      writer.uint32(26).string(message.version);
+    }
+    if (message.description !== "") {
+      writer.uint32(34).string(message.description);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListSecretsResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExtensionMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListSecretsResponse();
+    const message = createBaseExtensionMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7717,14 +8426,28 @@ export const ListSecretsResponse = {
             break;
           }
 
-          message.secrets.push(Secret.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.nextPageToken = reader.string();
+          message.schema = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.description = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7735,57 +8458,87 @@ export const ListSecretsResponse = {
     return message;
   },
 
-  fromJSON(object: any): ListSecretsResponse {
+  fromJSON(object: any): ExtensionMetadata {
     return {
-      secrets: globalThis.Array.isArray(object?.secrets) ? object.secrets.map((e: any) => Secret.fromJSON(e)) : [],
-      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
     };
   },
 
-  toJSON(message: ListSecretsResponse): unknown {
+  toJSON(message: ExtensionMetadata): unknown {
     const obj: any = {};
-    if (message.secrets?.length) {
-      obj.secrets = message.secrets.map((e) => Secret.toJSON(e));
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.nextPageToken !== "") {
-      obj.nextPageToken = message.nextPageToken;
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListSecretsResponse>): ListSecretsResponse {
-    return ListSecretsResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExtensionMetadata>): ExtensionMetadata {
+    return ExtensionMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListSecretsResponse>): ListSecretsResponse {
-    const message = createBaseListSecretsResponse();
-    message.secrets = object.secrets?.map((e) => Secret.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
+  fromPartial(object: DeepPartial<ExtensionMetadata>): ExtensionMetadata {
+    const message = createBaseExtensionMetadata();
+    message.name = object.name ?? "";
+    message.schema = object.schema ?? "";
+    message.version = object.version ?? "";
+    message.description = object.description ?? "";
     return message;
   },
 };
 
-function createBaseUpdateSecretRequest(): UpdateSecretRequest {
-  return { secret: undefined, updateMask: undefined, allowMissing: false };
+function createBaseIndexTemplateMetadata(): IndexTemplateMetadata {
+  return {
+    name: "",
+    indexPatterns: [],
+    priority: Long.ZERO,
+    composedOf: [],
+    mappings: "",
+    settings: "",
+    definition: "",
+  };
 }
 
-export const UpdateSecretRequest = {
-  encode(message: UpdateSecretRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.secret !== undefined) {
-      Secret.encode(message.secret, writer.uint32(10).fork()).ldelim();
+export const IndexTemplateMetadata = {
+  encode(message: IndexTemplateMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.updateMask !== undefined) {
-      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    for (const v of message.indexPatterns) {
+      writer.uint32(18).string(v!);
     }
-    if (message.allowMissing === true) {
-      writer.uint32(24).bool(message.allowMissing);
+    if (!message.priority.isZero()) {
+      writer.uint32(24).int64(message.priority);
+    }
+    for (const v of message.composedOf) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.mappings !== "") {
+      writer.uint32(42).string(message.mappings);
+    }
+    if (message.settings !== "") {
+      writer.uint32(50).string(message.settings);
+    }
+    if (message.definition !== "") {
+      writer.uint32(58).string(message.definition);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateSecretRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): IndexTemplateMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateSecretRequest();
+    const message = createBaseIndexTemplateMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7794,21 +8547,49 @@ export const UpdateSecretRequest = {
             break;
           }
 
-          message.secret = Secret.decode(reader, reader.uint32());
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          message.indexPatterns.push(reader.string());
           continue;
         case 3:
           if (tag !== 24) {
             break;
           }
 
-          message.allowMissing = reader.bool();
+          message.priority = reader.int64() as Long;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.composedOf.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.mappings = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.settings = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.definition = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7819,58 +8600,85 @@ export const UpdateSecretRequest = {
     return message;
   },
 
-  fromJSON(object: any): UpdateSecretRequest {
+  fromJSON(object: any): IndexTemplateMetadata {
     return {
-      secret: isSet(object.secret) ? Secret.fromJSON(object.secret) : undefined,
-      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
-      allowMissing: isSet(object.allowMissing) ? globalThis.Boolean(object.allowMissing) : false,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      indexPatterns: globalThis.Array.isArray(object?.indexPatterns)
+        ? object.indexPatterns.map((e: any) => globalThis.String(e))
+        : [],
+      priority: isSet(object.priority) ? Long.fromValue(object.priority) : Long.ZERO,
+      composedOf: globalThis.Array.isArray(object?.composedOf)
+        ? object.composedOf.map((e: any) => globalThis.String(e))
+        : [],
+      mappings: isSet(object.mappings) ? globalThis.String(object.mappings) : "",
+      settings: isSet(object.settings) ? globalThis.String(object.settings) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
     };
   },
 
-  toJSON(message: UpdateSecretRequest): unknown {
+  toJSON(message: IndexTemplateMetadata): unknown {
     const obj: any = {};
-    if (message.secret !== undefined) {
-      obj.secret = Secret.toJSON(message.secret);
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.updateMask !== undefined) {
-      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    if (message.indexPatterns?.length) {
+      obj.indexPatterns = message.indexPatterns;
     }
-    if (message.allowMissing === true) {
-      obj.allowMissing = message.allowMissing;
+    if (!message.priority.isZero()) {
+      obj.priority = (message.priority || Long.ZERO).toString();
+    }
+    if (message.composedOf?.length) {
+      obj.composedOf = message.composedOf;
+    }
+    if (message.mappings !== "") {
+      obj.mappings = message.mappings;
+    }
+    if (message.settings !== "") {
+      obj.settings = message.settings;
+    }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<UpdateSecretRequest>): UpdateSecretRequest {
-    return UpdateSecretRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<IndexTemplateMetadata>): IndexTemplateMetadata {
+    return IndexTemplateMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdateSecretRequest>): UpdateSecretRequest {
-    const message = createBaseUpdateSecretRequest();
-    message.secret = (object.secret !== undefined && object.secret !== null)
-      ? Secret.fromPartial(object.secret)
-      : undefined;
-    message.updateMask = object.updateMask ?? undefined;
-    message.allowMissing = object.allowMissing ?? false;
+  fromPartial(object: DeepPartial<IndexTemplateMetadata>): IndexTemplateMetadata {
+    const message = createBaseIndexTemplateMetadata();
+    message.name = object.name ?? "";
+    message.indexPatterns = object.indexPatterns?.map((e) => e) || [];
+    message.priority = (object.priority !== undefined && object.priority !== null)
+      ? Long.fromValue(object.priority)
+      : Long.ZERO;
+    message.composedOf = object.composedOf?.map((e) => e) || [];
+    message.mappings = object.mappings ?? "";
+    message.settings = object.settings ?? "";
+    message.definition = object.definition ?? "";
     return message;
   },
 };
 
-function createBaseDeleteSecretRequest(): DeleteSecretRequest {
-  return { name: "" };
+function createBaseLifecyclePolicyMetadata(): LifecyclePolicyMetadata {
+  return { name: "", definition: "" };
 }
 
-export const DeleteSecretRequest = {
-  encode(message: DeleteSecretRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const LifecyclePolicyMetadata = {
+  encode(message: LifecyclePolicyMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
+    if (message.definition !== "") {
+      writer.uint32(18).string(message.definition);
+    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteSecretRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): LifecyclePolicyMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDeleteSecretRequest();
+    const message = createBaseLifecyclePolicyMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7881,7 +8689,14 @@ export const DeleteSecretRequest = {
 
           message.name = reader.string();
           continue;
-      }
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.definition = reader.string();
+          continue;
+      }
       if ((tag & 7) === 4 || tag === 0) {
         break;
       }
@@ -7890,56 +8705,81 @@ export const DeleteSecretRequest = {
     return message;
   },
 
-  fromJSON(object: any): DeleteSecretRequest {
-    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  fromJSON(object: any): LifecyclePolicyMetadata {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      definition: isSet(object.definition) ? globalThis.String(object.definition) : "",
+    };
   },
 
-  toJSON(message: DeleteSecretRequest): unknown {
+  toJSON(message: LifecyclePolicyMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
+    if (message.definition !== "") {
+      obj.definition = message.definition;
+    }
     return obj;
   },
 
-  create(base?: DeepPartial<DeleteSecretRequest>): DeleteSecretRequest {
-    return DeleteSecretRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<LifecyclePolicyMetadata>): LifecyclePolicyMetadata {
+    return LifecyclePolicyMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DeleteSecretRequest>): DeleteSecretRequest {
-    const message = createBaseDeleteSecretRequest();
+  fromPartial(object: DeepPartial<LifecyclePolicyMetadata>): LifecyclePolicyMetadata {
+    const message = createBaseLifecyclePolicyMetadata();
     message.name = object.name ?? "";
+    message.definition = object.definition ?? "";
     return message;
   },
 };
 
-function createBaseSecret(): Secret {
-  return { name: "", createdTime: undefined, updatedTime: undefined, value: "", description: "" };
+function createBaseForeignKeyMetadata(): ForeignKeyMetadata {
+  return {
+    name: "",
+    columns: [],
+    referencedSchema: "",
+    referencedTable: "",
+    referencedColumns: [],
+    onDelete: "",
+    onUpdate: "",
+    matchType: "",
+  };
 }
 
-export const Secret = {
-  encode(message: Secret, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ForeignKeyMetadata = {
+  encode(message: ForeignKeyMetadata, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.createdTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(18).fork()).ldelim();
+    for (const v of message.columns) {
+      writer.uint32(18).string(v!);
     }
-    if (message.updatedTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updatedTime), writer.uint32(26).fork()).ldelim();
+    if (message.referencedSchema !== "") {
+      writer.uint32(26).string(message.referencedSchema);
     }
-    if (message.value !== "") {
-      writer.uint32(34).string(message.value);
+    if (message.referencedTable !== "") {
+      writer.uint32(34).string(message.referencedTable);
     }
-    if (message.description !== "") {
-      writer.uint32(42).string(message.description);
+    for (const v of message.referencedColumns) {
+      writer.uint32(42).string(v!);
+    }
+    if (message.onDelete !== "") {
+      writer.uint32(50).string(message.onDelete);
+    }
+    if (message.onUpdate !== "") {
+      writer.uint32(58).string(message.onUpdate);
+    }
+    if (message.matchType !== "") {
+      writer.uint32(66).string(message.matchType);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Secret {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ForeignKeyMetadata {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSecret();
+    const message = createBaseForeignKeyMetadata();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -7955,28 +8795,49 @@ export const Secret = {
             break;
           }
 
-          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.columns.push(reader.string());
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.updatedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.referencedSchema = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.value = reader.string();
+          message.referencedTable = reader.string();
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.description = reader.string();
+          message.referencedColumns.push(reader.string());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.onDelete = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.onUpdate = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.matchType = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -7987,69 +8848,86 @@ export const Secret = {
     return message;
   },
 
-  fromJSON(object: any): Secret {
+  fromJSON(object: any): ForeignKeyMetadata {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      createdTime: isSet(object.createdTime) ? fromJsonTimestamp(object.createdTime) : undefined,
-      updatedTime: isSet(object.updatedTime) ? fromJsonTimestamp(object.updatedTime) : undefined,
-      value: isSet(object.value) ? globalThis.String(object.value) : "",
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      columns: globalThis.Array.isArray(object?.columns) ? object.columns.map((e: any) => globalThis.String(e)) : [],
+      referencedSchema: isSet(object.referencedSchema) ? globalThis.String(object.referencedSchema) : "",
+      referencedTable: isSet(object.referencedTable) ? globalThis.String(object.referencedTable) : "",
+      referencedColumns: globalThis.Array.isArray(object?.referencedColumns)
+        ? object.referencedColumns.map((e: any) => globalThis.String(e))
+        : [],
+      onDelete: isSet(object.onDelete) ? globalThis.String(object.onDelete) : "",
+      onUpdate: isSet(object.onUpdate) ? globalThis.String(object.onUpdate) : "",
+      matchType: isSet(object.matchType) ? globalThis.String(object.matchType) : "",
     };
   },
 
-  toJSON(message: Secret): unknown {
+  toJSON(message: ForeignKeyMetadata): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.createdTime !== undefined) {
-      obj.createdTime = message.createdTime.toISOString();
+    if (message.columns?.length) {
+      obj.columns = message.columns;
     }
-    if (message.updatedTime !== undefined) {
-      obj.updatedTime = message.updatedTime.toISOString();
+    if (message.referencedSchema !== "") {
+      obj.referencedSchema = message.referencedSchema;
     }
-    if (message.value !== "") {
-      obj.value = message.value;
+    if (message.referencedTable !== "") {
+      obj.referencedTable = message.referencedTable;
     }
-    if (message.description !== "") {
-      obj.description = message.description;
+    if (message.referencedColumns?.length) {
+      obj.referencedColumns = message.referencedColumns;
+    }
+    if (message.onDelete !== "") {
+      obj.onDelete = message.onDelete;
+    }
+    if (message.onUpdate !== "") {
+      obj.onUpdate = message.onUpdate;
+    }
+    if (message.matchType !== "") {
+      obj.matchType = message.matchType;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Secret>): Secret {
-    return Secret.fromPartial(base ?? {});
+  create(base?: DeepPartial<ForeignKeyMetadata>): ForeignKeyMetadata {
+    return ForeignKeyMetadata.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Secret>): Secret {
-    const message = createBaseSecret();
+  fromPartial(object: DeepPartial<ForeignKeyMetadata>): ForeignKeyMetadata {
+    const message = createBaseForeignKeyMetadata();
     message.name = object.name ?? "";
-    message.createdTime = object.createdTime ?? undefined;
-    message.updatedTime = object.updatedTime ?? undefined;
-    message.value = object.value ?? "";
-    message.description = object.description ?? "";
+    message.columns = object.columns?.map((e) => e) || [];
+    message.referencedSchema = object.referencedSchema ?? "";
+    message.referencedTable = object.referencedTable ?? "";
+    message.referencedColumns = object.referencedColumns?.map((e) => e) || [];
+    message.onDelete = object.onDelete ?? "";
+    message.onUpdate = object.onUpdate ?? "";
+    message.matchType = object.matchType ?? "";
     return message;
   },
 };
 
-function createBaseAdviseIndexRequest(): AdviseIndexRequest {
-  return { parent: "", statement: "" };
+function createBaseDatabaseConfig(): DatabaseConfig {
+  return { name: "", schemaConfigs: [] };
 }
 
-export const AdviseIndexRequest = {
-  encode(message: AdviseIndexRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
+export const DatabaseConfig = {
+  encode(message: DatabaseConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.statement !== "") {
-      writer.uint32(18).string(message.statement);
+    for (const v of message.schemaConfigs) {
+      SchemaConfig.encode(v!, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AdviseIndexRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAdviseIndexRequest();
+    const message = createBaseDatabaseConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8058,14 +8936,14 @@ export const AdviseIndexRequest = {
             break;
           }
 
-          message.parent = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.statement = reader.string();
+          message.schemaConfigs.push(SchemaConfig.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8076,57 +8954,65 @@ export const AdviseIndexRequest = {
     return message;
   },
 
-  fromJSON(object: any): AdviseIndexRequest {
+  fromJSON(object: any): DatabaseConfig {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      schemaConfigs: globalThis.Array.isArray(object?.schemaConfigs)
+        ? object.schemaConfigs.map((e: any) => SchemaConfig.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: AdviseIndexRequest): unknown {
+  toJSON(message: DatabaseConfig): unknown {
     const obj: any = {};
-    if (message.parent !== "") {
-      obj.parent = message.parent;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.statement !== "") {
-      obj.statement = message.statement;
+    if (message.schemaConfigs?.length) {
+      obj.schemaConfigs = message.schemaConfigs.map((e) => SchemaConfig.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<AdviseIndexRequest>): AdviseIndexRequest {
-    return AdviseIndexRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<DatabaseConfig>): DatabaseConfig {
+    return DatabaseConfig.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<AdviseIndexRequest>): AdviseIndexRequest {
-    const message = createBaseAdviseIndexRequest();
-    message.parent = object.parent ?? "";
-    message.statement = object.statement ?? "";
+  fromPartial(object: DeepPartial<DatabaseConfig>): DatabaseConfig {
+    const message = createBaseDatabaseConfig();
+    message.name = object.name ?? "";
+    message.schemaConfigs = object.schemaConfigs?.map((e) => SchemaConfig.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseAdviseIndexResponse(): AdviseIndexResponse {
-  return { currentIndex: "", suggestion: "", createIndexStatement: "" };
+function createBaseSchemaConfig(): SchemaConfig {
+  return { name: "", tableConfigs: [], functionConfigs: [], procedureConfigs: [], viewConfigs: [] };
 }
 
-export const AdviseIndexResponse = {
-  encode(message: AdviseIndexResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.currentIndex !== "") {
-      writer.uint32(10).string(message.currentIndex);
+export const SchemaConfig = {
+  encode(message: SchemaConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.suggestion !== "") {
-      writer.uint32(18).string(message.suggestion);
+    for (const v of message.tableConfigs) {
+      TableConfig.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    if (message.createIndexStatement !== "") {
-      writer.uint32(26).string(message.createIndexStatement);
+    for (const v of message.functionConfigs) {
+      FunctionConfig.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    for (const v of message.procedureConfigs) {
+      ProcedureConfig.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    for (const v of message.viewConfigs) {
+      ViewConfig.encode(v!, writer.uint32(42).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): AdviseIndexResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAdviseIndexResponse();
+    const message = createBaseSchemaConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8135,167 +9021,128 @@ export const AdviseIndexResponse = {
             break;
           }
 
-          message.currentIndex = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.suggestion = reader.string();
+          message.tableConfigs.push(TableConfig.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.createIndexStatement = reader.string();
+          message.functionConfigs.push(FunctionConfig.decode(reader, reader.uint32()));
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
 
-  fromJSON(object: any): AdviseIndexResponse {
+          message.procedureConfigs.push(ProcedureConfig.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.viewConfigs.push(ViewConfig.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SchemaConfig {
     return {
-      currentIndex: isSet(object.currentIndex) ? globalThis.String(object.currentIndex) : "",
-      suggestion: isSet(object.suggestion) ? globalThis.String(object.suggestion) : "",
-      createIndexStatement: isSet(object.createIndexStatement) ? globalThis.String(object.createIndexStatement) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      tableConfigs: globalThis.Array.isArray(object?.tableConfigs)
+        ? object.tableConfigs.map((e: any) => TableConfig.fromJSON(e))
+        : [],
+      functionConfigs: globalThis.Array.isArray(object?.functionConfigs)
+        ? object.functionConfigs.map((e: any) => FunctionConfig.fromJSON(e))
+        : [],
+      procedureConfigs: globalThis.Array.isArray(object?.procedureConfigs)
+        ? object.procedureConfigs.map((e: any) => ProcedureConfig.fromJSON(e))
+        : [],
+      viewConfigs: globalThis.Array.isArray(object?.viewConfigs)
+        ? object.viewConfigs.map((e: any) => ViewConfig.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: AdviseIndexResponse): unknown {
+  toJSON(message: SchemaConfig): unknown {
     const obj: any = {};
-    if (message.currentIndex !== "") {
-      obj.currentIndex = message.currentIndex;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.suggestion !== "") {
-      obj.suggestion = message.suggestion;
+    if (message.tableConfigs?.length) {
+      obj.tableConfigs = message.tableConfigs.map((e) => TableConfig.toJSON(e));
     }
-    if (message.createIndexStatement !== "") {
-      obj.createIndexStatement = message.createIndexStatement;
+    if (message.functionConfigs?.length) {
+      obj.functionConfigs = message.functionConfigs.map((e) => FunctionConfig.toJSON(e));
+    }
+    if (message.procedureConfigs?.length) {
+      obj.procedureConfigs = message.procedureConfigs.map((e) => ProcedureConfig.toJSON(e));
+    }
+    if (message.viewConfigs?.length) {
+      obj.viewConfigs = message.viewConfigs.map((e) => ViewConfig.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<AdviseIndexResponse>): AdviseIndexResponse {
-    return AdviseIndexResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<SchemaConfig>): SchemaConfig {
+    return SchemaConfig.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<AdviseIndexResponse>): AdviseIndexResponse {
-    const message = createBaseAdviseIndexResponse();
-    message.currentIndex = object.currentIndex ?? "";
-    message.suggestion = object.suggestion ?? "";
-    message.createIndexStatement = object.createIndexStatement ?? "";
+  fromPartial(object: DeepPartial<SchemaConfig>): SchemaConfig {
+    const message = createBaseSchemaConfig();
+    message.name = object.name ?? "";
+    message.tableConfigs = object.tableConfigs?.map((e) => TableConfig.fromPartial(e)) || [];
+    message.functionConfigs = object.functionConfigs?.map((e) => FunctionConfig.fromPartial(e)) || [];
+    message.procedureConfigs = object.procedureConfigs?.map((e) => ProcedureConfig.fromPartial(e)) || [];
+    message.viewConfigs = object.viewConfigs?.map((e) => ViewConfig.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseChangeHistory(): ChangeHistory {
-  return {
-    name: "",
-    uid: "",
-    creator: "",
-    updater: "",
-    createTime: undefined,
-    updateTime: undefined,
-    releaseVersion: "",
-    source: ChangeHistory_Source.SOURCE_UNSPECIFIED,
-    type: ChangeHistory_Type.TYPE_UNSPECIFIED,
-    status: ChangeHistory_Status.STATUS_UNSPECIFIED,
-    version: "",
-    description: "",
-    statement: "",
-    statementSize: Long.ZERO,
-    statementSheet: "",
-    schema: "",
-    schemaSize: Long.ZERO,
-    prevSchema: "",
-    prevSchemaSize: Long.ZERO,
-    executionDuration: undefined,
-    issue: "",
-    changedResources: undefined,
-  };
+function createBaseTableConfig(): TableConfig {
+  return { name: "", columnConfigs: [], classificationId: "", updater: "", sourceBranch: "", updateTime: undefined };
 }
 
-export const ChangeHistory = {
-  encode(message: ChangeHistory, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const TableConfig = {
+  encode(message: TableConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.uid !== "") {
-      writer.uint32(18).string(message.uid);
+    for (const v of message.columnConfigs) {
+      ColumnConfig.encode(v!, writer.uint32(18).fork()).ldelim();
     }
-    if (message.creator !== "") {
-      writer.uint32(26).string(message.creator);
+    if (message.classificationId !== "") {
+      writer.uint32(26).string(message.classificationId);
     }
     if (message.updater !== "") {
       writer.uint32(34).string(message.updater);
     }
-    if (message.createTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(42).fork()).ldelim();
+    if (message.sourceBranch !== "") {
+      writer.uint32(50).string(message.sourceBranch);
     }
     if (message.updateTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(50).fork()).ldelim();
-    }
-    if (message.releaseVersion !== "") {
-      writer.uint32(58).string(message.releaseVersion);
-    }
-    if (message.source !== ChangeHistory_Source.SOURCE_UNSPECIFIED) {
-      writer.uint32(64).int32(changeHistory_SourceToNumber(message.source));
-    }
-    if (message.type !== ChangeHistory_Type.TYPE_UNSPECIFIED) {
-      writer.uint32(72).int32(changeHistory_TypeToNumber(message.type));
-    }
-    if (message.status !== ChangeHistory_Status.STATUS_UNSPECIFIED) {
-      writer.uint32(80).int32(changeHistory_StatusToNumber(message.status));
-    }
-    if (message.version !== "") {
-      writer.uint32(90).string(message.version);
-    }
-    if (message.description !== "") {
-      writer.uint32(98).string(message.description);
-    }
-    if (message.statement !== "") {
-      writer.uint32(106).string(message.statement);
-    }
-    if (!message.statementSize.isZero()) {
-      writer.uint32(168).int64(message.statementSize);
-    }
-    if (message.statementSheet !== "") {
-      writer.uint32(162).string(message.statementSheet);
-    }
-    if (message.schema !== "") {
-      writer.uint32(114).string(message.schema);
-    }
-    if (!message.schemaSize.isZero()) {
-      writer.uint32(176).int64(message.schemaSize);
-    }
-    if (message.prevSchema !== "") {
-      writer.uint32(122).string(message.prevSchema);
-    }
-    if (!message.prevSchemaSize.isZero()) {
-      writer.uint32(184).int64(message.prevSchemaSize);
-    }
-    if (message.executionDuration !== undefined) {
-      Duration.encode(message.executionDuration, writer.uint32(130).fork()).ldelim();
-    }
-    if (message.issue !== "") {
-      writer.uint32(138).string(message.issue);
-    }
-    if (message.changedResources !== undefined) {
-      ChangedResources.encode(message.changedResources, writer.uint32(154).fork()).ldelim();
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(42).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangeHistory {
+  decode(input: _m0.Reader | Uint8Array, length?: number): TableConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangeHistory();
+    const message = createBaseTableConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8311,14 +9158,14 @@ export const ChangeHistory = {
             break;
           }
 
-          message.uid = reader.string();
+          message.columnConfigs.push(ColumnConfig.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.creator = reader.string();
+          message.classificationId = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
@@ -8327,131 +9174,239 @@ export const ChangeHistory = {
 
           message.updater = reader.string();
           continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.sourceBranch = reader.string();
           continue;
-        case 7:
-          if (tag !== 58) {
+        case 5:
+          if (tag !== 42) {
             break;
           }
 
-          message.releaseVersion = reader.string();
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
-        case 8:
-          if (tag !== 64) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.source = changeHistory_SourceFromJSON(reader.int32());
-          continue;
-        case 9:
-          if (tag !== 72) {
-            break;
-          }
+  fromJSON(object: any): TableConfig {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      columnConfigs: globalThis.Array.isArray(object?.columnConfigs)
+        ? object.columnConfigs.map((e: any) => ColumnConfig.fromJSON(e))
+        : [],
+      classificationId: isSet(object.classificationId) ? globalThis.String(object.classificationId) : "",
+      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
+      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
 
-          message.type = changeHistory_TypeFromJSON(reader.int32());
-          continue;
-        case 10:
-          if (tag !== 80) {
-            break;
-          }
+  toJSON(message: TableConfig): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.columnConfigs?.length) {
+      obj.columnConfigs = message.columnConfigs.map((e) => ColumnConfig.toJSON(e));
+    }
+    if (message.classificationId !== "") {
+      obj.classificationId = message.classificationId;
+    }
+    if (message.updater !== "") {
+      obj.updater = message.updater;
+    }
+    if (message.sourceBranch !== "") {
+      obj.sourceBranch = message.sourceBranch;
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
+    }
+    return obj;
+  },
 
-          message.status = changeHistory_StatusFromJSON(reader.int32());
-          continue;
-        case 11:
-          if (tag !== 90) {
-            break;
-          }
+  create(base?: DeepPartial<TableConfig>): TableConfig {
+    return TableConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TableConfig>): TableConfig {
+    const message = createBaseTableConfig();
+    message.name = object.name ?? "";
+    message.columnConfigs = object.columnConfigs?.map((e) => ColumnConfig.fromPartial(e)) || [];
+    message.classificationId = object.classificationId ?? "";
+    message.updater = object.updater ?? "";
+    message.sourceBranch = object.sourceBranch ?? "";
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
 
-          message.version = reader.string();
-          continue;
-        case 12:
-          if (tag !== 98) {
-            break;
-          }
+function createBaseFunctionConfig(): FunctionConfig {
+  return { name: "", updater: "", sourceBranch: "", updateTime: undefined };
+}
 
-          message.description = reader.string();
-          continue;
-        case 13:
-          if (tag !== 106) {
-            break;
-          }
+export const FunctionConfig = {
+  encode(message: FunctionConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.updater !== "") {
+      writer.uint32(18).string(message.updater);
+    }
+    if (message.sourceBranch !== "") {
+      writer.uint32(34).string(message.sourceBranch);
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
 
-          message.statement = reader.string();
-          continue;
-        case 21:
-          if (tag !== 168) {
+  decode(input: _m0.Reader | Uint8Array, length?: number): FunctionConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseFunctionConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.statementSize = reader.int64() as Long;
+          message.name = reader.string();
           continue;
-        case 20:
-          if (tag !== 162) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.statementSheet = reader.string();
+          message.updater = reader.string();
           continue;
-        case 14:
-          if (tag !== 114) {
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.schema = reader.string();
+          message.sourceBranch = reader.string();
           continue;
-        case 22:
-          if (tag !== 176) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.schemaSize = reader.int64() as Long;
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
-        case 15:
-          if (tag !== 122) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.prevSchema = reader.string();
-          continue;
-        case 23:
-          if (tag !== 184) {
+  fromJSON(object: any): FunctionConfig {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
+      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
+
+  toJSON(message: FunctionConfig): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.updater !== "") {
+      obj.updater = message.updater;
+    }
+    if (message.sourceBranch !== "") {
+      obj.sourceBranch = message.sourceBranch;
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<FunctionConfig>): FunctionConfig {
+    return FunctionConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<FunctionConfig>): FunctionConfig {
+    const message = createBaseFunctionConfig();
+    message.name = object.name ?? "";
+    message.updater = object.updater ?? "";
+    message.sourceBranch = object.sourceBranch ?? "";
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseProcedureConfig(): ProcedureConfig {
+  return { name: "", updater: "", sourceBranch: "", updateTime: undefined };
+}
+
+export const ProcedureConfig = {
+  encode(message: ProcedureConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.updater !== "") {
+      writer.uint32(18).string(message.updater);
+    }
+    if (message.sourceBranch !== "") {
+      writer.uint32(34).string(message.sourceBranch);
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ProcedureConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProcedureConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.prevSchemaSize = reader.int64() as Long;
+          message.name = reader.string();
           continue;
-        case 16:
-          if (tag !== 130) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.executionDuration = Duration.decode(reader, reader.uint32());
+          message.updater = reader.string();
           continue;
-        case 17:
-          if (tag !== 138) {
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.issue = reader.string();
+          message.sourceBranch = reader.string();
           continue;
-        case 19:
-          if (tag !== 154) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.changedResources = ChangedResources.decode(reader, reader.uint32());
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8462,165 +9417,70 @@ export const ChangeHistory = {
     return message;
   },
 
-  fromJSON(object: any): ChangeHistory {
+  fromJSON(object: any): ProcedureConfig {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
-      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
       updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
-      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
       updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
-      releaseVersion: isSet(object.releaseVersion) ? globalThis.String(object.releaseVersion) : "",
-      source: isSet(object.source)
-        ? changeHistory_SourceFromJSON(object.source)
-        : ChangeHistory_Source.SOURCE_UNSPECIFIED,
-      type: isSet(object.type) ? changeHistory_TypeFromJSON(object.type) : ChangeHistory_Type.TYPE_UNSPECIFIED,
-      status: isSet(object.status)
-        ? changeHistory_StatusFromJSON(object.status)
-        : ChangeHistory_Status.STATUS_UNSPECIFIED,
-      version: isSet(object.version) ? globalThis.String(object.version) : "",
-      description: isSet(object.description) ? globalThis.String(object.description) : "",
-      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
-      statementSize: isSet(object.statementSize) ? Long.fromValue(object.statementSize) : Long.ZERO,
-      statementSheet: isSet(object.statementSheet) ? globalThis.String(object.statementSheet) : "",
-      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
-      schemaSize: isSet(object.schemaSize) ? Long.fromValue(object.schemaSize) : Long.ZERO,
-      prevSchema: isSet(object.prevSchema) ? globalThis.String(object.prevSchema) : "",
-      prevSchemaSize: isSet(object.prevSchemaSize) ? Long.fromValue(object.prevSchemaSize) : Long.ZERO,
-      executionDuration: isSet(object.executionDuration) ? Duration.fromJSON(object.executionDuration) : undefined,
-      issue: isSet(object.issue) ? globalThis.String(object.issue) : "",
-      changedResources: isSet(object.changedResources) ? ChangedResources.fromJSON(object.changedResources) : undefined,
     };
   },
 
-  toJSON(message: ChangeHistory): unknown {
+  toJSON(message: ProcedureConfig): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.uid !== "") {
-      obj.uid = message.uid;
-    }
-    if (message.creator !== "") {
-      obj.creator = message.creator;
-    }
     if (message.updater !== "") {
       obj.updater = message.updater;
     }
-    if (message.createTime !== undefined) {
-      obj.createTime = message.createTime.toISOString();
+    if (message.sourceBranch !== "") {
+      obj.sourceBranch = message.sourceBranch;
     }
     if (message.updateTime !== undefined) {
       obj.updateTime = message.updateTime.toISOString();
     }
-    if (message.releaseVersion !== "") {
-      obj.releaseVersion = message.releaseVersion;
+    return obj;
+  },
+
+  create(base?: DeepPartial<ProcedureConfig>): ProcedureConfig {
+    return ProcedureConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ProcedureConfig>): ProcedureConfig {
+    const message = createBaseProcedureConfig();
+    message.name = object.name ?? "";
+    message.updater = object.updater ?? "";
+    message.sourceBranch = object.sourceBranch ?? "";
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseViewConfig(): ViewConfig {
+  return { name: "", updater: "", sourceBranch: "", updateTime: undefined };
+}
+
+export const ViewConfig = {
+  encode(message: ViewConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.source !== ChangeHistory_Source.SOURCE_UNSPECIFIED) {
-      obj.source = changeHistory_SourceToJSON(message.source);
+    if (message.updater !== "") {
+      writer.uint32(18).string(message.updater);
     }
-    if (message.type !== ChangeHistory_Type.TYPE_UNSPECIFIED) {
-      obj.type = changeHistory_TypeToJSON(message.type);
+    if (message.sourceBranch !== "") {
+      writer.uint32(34).string(message.sourceBranch);
     }
-    if (message.status !== ChangeHistory_Status.STATUS_UNSPECIFIED) {
-      obj.status = changeHistory_StatusToJSON(message.status);
-    }
-    if (message.version !== "") {
-      obj.version = message.version;
-    }
-    if (message.description !== "") {
-      obj.description = message.description;
-    }
-    if (message.statement !== "") {
-      obj.statement = message.statement;
-    }
-    if (!message.statementSize.isZero()) {
-      obj.statementSize = (message.statementSize || Long.ZERO).toString();
-    }
-    if (message.statementSheet !== "") {
-      obj.statementSheet = message.statementSheet;
-    }
-    if (message.schema !== "") {
-      obj.schema = message.schema;
-    }
-    if (!message.schemaSize.isZero()) {
-      obj.schemaSize = (message.schemaSize || Long.ZERO).toString();
-    }
-    if (message.prevSchema !== "") {
-      obj.prevSchema = message.prevSchema;
-    }
-    if (!message.prevSchemaSize.isZero()) {
-      obj.prevSchemaSize = (message.prevSchemaSize || Long.ZERO).toString();
-    }
-    if (message.executionDuration !== undefined) {
-      obj.executionDuration = Duration.toJSON(message.executionDuration);
-    }
-    if (message.issue !== "") {
-      obj.issue = message.issue;
-    }
-    if (message.changedResources !== undefined) {
-      obj.changedResources = ChangedResources.toJSON(message.changedResources);
-    }
-    return obj;
-  },
-
-  create(base?: DeepPartial<ChangeHistory>): ChangeHistory {
-    return ChangeHistory.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<ChangeHistory>): ChangeHistory {
-    const message = createBaseChangeHistory();
-    message.name = object.name ?? "";
-    message.uid = object.uid ?? "";
-    message.creator = object.creator ?? "";
-    message.updater = object.updater ?? "";
-    message.createTime = object.createTime ?? undefined;
-    message.updateTime = object.updateTime ?? undefined;
-    message.releaseVersion = object.releaseVersion ?? "";
-    message.source = object.source ?? ChangeHistory_Source.SOURCE_UNSPECIFIED;
-    message.type = object.type ?? ChangeHistory_Type.TYPE_UNSPECIFIED;
-    message.status = object.status ?? ChangeHistory_Status.STATUS_UNSPECIFIED;
-    message.version = object.version ?? "";
-    message.description = object.description ?? "";
-    message.statement = object.statement ?? "";
-    message.statementSize = (object.statementSize !== undefined && object.statementSize !== null)
-      ? Long.fromValue(object.statementSize)
-      : Long.ZERO;
-    message.statementSheet = object.statementSheet ?? "";
-    message.schema = object.schema ?? "";
-    message.schemaSize = (object.schemaSize !== undefined && object.schemaSize !== null)
-      ? Long.fromValue(object.schemaSize)
-      : Long.ZERO;
-    message.prevSchema = object.prevSchema ?? "";
-    message.prevSchemaSize = (object.prevSchemaSize !== undefined && object.prevSchemaSize !== null)
-      ? Long.fromValue(object.prevSchemaSize)
-      : Long.ZERO;
-    message.executionDuration = (object.executionDuration !== undefined && object.executionDuration !== null)
-      ? Duration.fromPartial(object.executionDuration)
-      : undefined;
-    message.issue = object.issue ?? "";
-    message.changedResources = (object.changedResources !== undefined && object.changedResources !== null)
-      ? ChangedResources.fromPartial(object.changedResources)
-      : undefined;
-    return message;
-  },
-};
-
-function createBaseChangedResources(): ChangedResources {
-  return { databases: [] };
-}
-
-export const ChangedResources = {
-  encode(message: ChangedResources, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.databases) {
-      ChangedResourceDatabase.encode(v!, writer.uint32(10).fork()).ldelim();
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResources {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ViewConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResources();
+    const message = createBaseViewConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8629,78 +9489,28 @@ export const ChangedResources = {
             break;
           }
 
-          message.databases.push(ChangedResourceDatabase.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): ChangedResources {
-    return {
-      databases: globalThis.Array.isArray(object?.databases)
-        ? object.databases.map((e: any) => ChangedResourceDatabase.fromJSON(e))
-        : [],
-    };
-  },
-
-  toJSON(message: ChangedResources): unknown {
-    const obj: any = {};
-    if (message.databases?.length) {
-      obj.databases = message.databases.map((e) => ChangedResourceDatabase.toJSON(e));
-    }
-    return obj;
-  },
-
-  create(base?: DeepPartial<ChangedResources>): ChangedResources {
-    return ChangedResources.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<ChangedResources>): ChangedResources {
-    const message = createBaseChangedResources();
-    message.databases = object.databases?.map((e) => ChangedResourceDatabase.fromPartial(e)) || [];
-    return message;
-  },
-};
-
-function createBaseChangedResourceDatabase(): ChangedResourceDatabase {
-  return { name: "", schemas: [] };
-}
-
-export const ChangedResourceDatabase = {
-  encode(message: ChangedResourceDatabase, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    for (const v of message.schemas) {
-      ChangedResourceSchema.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    return writer;
-  },
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceDatabase {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResourceDatabase();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.updater = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
             break;
           }
 
-          message.name = reader.string();
+          message.sourceBranch = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.schemas.push(ChangedResourceSchema.decode(reader, reader.uint32()));
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8711,65 +9521,70 @@ export const ChangedResourceDatabase = {
     return message;
   },
 
-  fromJSON(object: any): ChangedResourceDatabase {
+  fromJSON(object: any): ViewConfig {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      schemas: globalThis.Array.isArray(object?.schemas)
-        ? object.schemas.map((e: any) => ChangedResourceSchema.fromJSON(e))
-        : [],
+      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
+      sourceBranch: isSet(object.sourceBranch) ? globalThis.String(object.sourceBranch) : "",
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
     };
   },
 
-  toJSON(message: ChangedResourceDatabase): unknown {
+  toJSON(message: ViewConfig): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.schemas?.length) {
-      obj.schemas = message.schemas.map((e) => ChangedResourceSchema.toJSON(e));
+    if (message.updater !== "") {
+      obj.updater = message.updater;
+    }
+    if (message.sourceBranch !== "") {
+      obj.sourceBranch = message.sourceBranch;
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ChangedResourceDatabase>): ChangedResourceDatabase {
-    return ChangedResourceDatabase.fromPartial(base ?? {});
+  create(base?: DeepPartial<ViewConfig>): ViewConfig {
+    return ViewConfig.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ChangedResourceDatabase>): ChangedResourceDatabase {
-    const message = createBaseChangedResourceDatabase();
+  fromPartial(object: DeepPartial<ViewConfig>): ViewConfig {
+    const message = createBaseViewConfig();
     message.name = object.name ?? "";
-    message.schemas = object.schemas?.map((e) => ChangedResourceSchema.fromPartial(e)) || [];
+    message.updater = object.updater ?? "";
+    message.sourceBranch = object.sourceBranch ?? "";
+    message.updateTime = object.updateTime ?? undefined;
     return message;
   },
 };
 
-function createBaseChangedResourceSchema(): ChangedResourceSchema {
-  return { name: "", tables: [], views: [], functions: [], procedures: [] };
+function createBaseColumnConfig(): ColumnConfig {
+  return { name: "", semanticTypeId: "", labels: {}, classificationId: "" };
 }
 
-export const ChangedResourceSchema = {
-  encode(message: ChangedResourceSchema, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ColumnConfig = {
+  encode(message: ColumnConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    for (const v of message.tables) {
-      ChangedResourceTable.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    for (const v of message.views) {
-      ChangedResourceView.encode(v!, writer.uint32(26).fork()).ldelim();
-    }
-    for (const v of message.functions) {
-      ChangedResourceFunction.encode(v!, writer.uint32(34).fork()).ldelim();
+    if (message.semanticTypeId !== "") {
+      writer.uint32(18).string(message.semanticTypeId);
     }
-    for (const v of message.procedures) {
-      ChangedResourceProcedure.encode(v!, writer.uint32(42).fork()).ldelim();
+    Object.entries(message.labels).forEach(([key, value]) => {
+      ColumnConfig_LabelsEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).ldelim();
+    });
+    if (message.classificationId !== "") {
+      writer.uint32(34).string(message.classificationId);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceSchema {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResourceSchema();
+    const message = createBaseColumnConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8785,28 +9600,24 @@ export const ChangedResourceSchema = {
             break;
           }
 
-          message.tables.push(ChangedResourceTable.decode(reader, reader.uint32()));
+          message.semanticTypeId = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.views.push(ChangedResourceView.decode(reader, reader.uint32()));
+          const entry3 = ColumnConfig_LabelsEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.labels[entry3.key] = entry3.value;
+          }
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.functions.push(ChangedResourceFunction.decode(reader, reader.uint32()));
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.procedures.push(ChangedResourceProcedure.decode(reader, reader.uint32()));
+          message.classificationId = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8817,77 +9628,80 @@ export const ChangedResourceSchema = {
     return message;
   },
 
-  fromJSON(object: any): ChangedResourceSchema {
+  fromJSON(object: any): ColumnConfig {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      tables: globalThis.Array.isArray(object?.tables)
-        ? object.tables.map((e: any) => ChangedResourceTable.fromJSON(e))
-        : [],
-      views: globalThis.Array.isArray(object?.views)
-        ? object.views.map((e: any) => ChangedResourceView.fromJSON(e))
-        : [],
-      functions: globalThis.Array.isArray(object?.functions)
-        ? object.functions.map((e: any) => ChangedResourceFunction.fromJSON(e))
-        : [],
-      procedures: globalThis.Array.isArray(object?.procedures)
-        ? object.procedures.map((e: any) => ChangedResourceProcedure.fromJSON(e))
-        : [],
+      semanticTypeId: isSet(object.semanticTypeId) ? globalThis.String(object.semanticTypeId) : "",
+      labels: isObject(object.labels)
+        ? Object.entries(object.labels).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      classificationId: isSet(object.classificationId) ? globalThis.String(object.classificationId) : "",
     };
   },
 
-  toJSON(message: ChangedResourceSchema): unknown {
+  toJSON(message: ColumnConfig): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.tables?.length) {
-      obj.tables = message.tables.map((e) => ChangedResourceTable.toJSON(e));
-    }
-    if (message.views?.length) {
-      obj.views = message.views.map((e) => ChangedResourceView.toJSON(e));
+    if (message.semanticTypeId !== "") {
+      obj.semanticTypeId = message.semanticTypeId;
     }
-    if (message.functions?.length) {
-      obj.functions = message.functions.map((e) => ChangedResourceFunction.toJSON(e));
+    if (message.labels) {
+      const entries = Object.entries(message.labels);
+      if (entries.length > 0) {
+        obj.labels = {};
+        entries.forEach(([k, v]) => {
+          obj.labels[k] = v;
+        });
+      }
     }
-    if (message.procedures?.length) {
-      obj.procedures = message.procedures.map((e) => ChangedResourceProcedure.toJSON(e));
+    if (message.classificationId !== "") {
+      obj.classificationId = message.classificationId;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ChangedResourceSchema>): ChangedResourceSchema {
-    return ChangedResourceSchema.fromPartial(base ?? {});
+  create(base?: DeepPartial<ColumnConfig>): ColumnConfig {
+    return ColumnConfig.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ChangedResourceSchema>): ChangedResourceSchema {
-    const message = createBaseChangedResourceSchema();
+  fromPartial(object: DeepPartial<ColumnConfig>): ColumnConfig {
+    const message = createBaseColumnConfig();
     message.name = object.name ?? "";
-    message.tables = object.tables?.map((e) => ChangedResourceTable.fromPartial(e)) || [];
-    message.views = object.views?.map((e) => ChangedResourceView.fromPartial(e)) || [];
-    message.functions = object.functions?.map((e) => ChangedResourceFunction.fromPartial(e)) || [];
-    message.procedures = object.procedures?.map((e) => ChangedResourceProcedure.fromPartial(e)) || [];
+    message.semanticTypeId = object.semanticTypeId ?? "";
+    message.labels = Object.entries(object.labels ?? {}).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+      if (value !== undefined) {
+        acc[key] = globalThis.String(value);
+      }
+      return acc;
+    }, {});
+    message.classificationId = object.classificationId ?? "";
     return message;
   },
 };
 
-function createBaseChangedResourceTable(): ChangedResourceTable {
-  return { name: "", ranges: [] };
+function createBaseColumnConfig_LabelsEntry(): ColumnConfig_LabelsEntry {
+  return { key: "", value: "" };
 }
 
-export const ChangedResourceTable = {
-  encode(message: ChangedResourceTable, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const ColumnConfig_LabelsEntry = {
+  encode(message: ColumnConfig_LabelsEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    for (const v of message.ranges) {
-      Range.encode(v!, writer.uint32(26).fork()).ldelim();
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceTable {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ColumnConfig_LabelsEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResourceTable();
+    const message = createBaseColumnConfig_LabelsEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8896,14 +9710,14 @@ export const ChangedResourceTable = {
             break;
           }
 
-          message.name = reader.string();
+          message.key = reader.string();
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 2:
+          if (tag !== 18) {
             break;
           }
 
-          message.ranges.push(Range.decode(reader, reader.uint32()));
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8914,54 +9728,51 @@ export const ChangedResourceTable = {
     return message;
   },
 
-  fromJSON(object: any): ChangedResourceTable {
+  fromJSON(object: any): ColumnConfig_LabelsEntry {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
     };
   },
 
-  toJSON(message: ChangedResourceTable): unknown {
+  toJSON(message: ColumnConfig_LabelsEntry): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.ranges?.length) {
-      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ChangedResourceTable>): ChangedResourceTable {
-    return ChangedResourceTable.fromPartial(base ?? {});
+  create(base?: DeepPartial<ColumnConfig_LabelsEntry>): ColumnConfig_LabelsEntry {
+    return ColumnConfig_LabelsEntry.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ChangedResourceTable>): ChangedResourceTable {
-    const message = createBaseChangedResourceTable();
-    message.name = object.name ?? "";
-    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<ColumnConfig_LabelsEntry>): ColumnConfig_LabelsEntry {
+    const message = createBaseColumnConfig_LabelsEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBaseChangedResourceView(): ChangedResourceView {
-  return { name: "", ranges: [] };
+function createBaseDatabaseSchema(): DatabaseSchema {
+  return { schema: "" };
 }
 
-export const ChangedResourceView = {
-  encode(message: ChangedResourceView, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    for (const v of message.ranges) {
-      Range.encode(v!, writer.uint32(18).fork()).ldelim();
+export const DatabaseSchema = {
+  encode(message: DatabaseSchema, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceView {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DatabaseSchema {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResourceView();
+    const message = createBaseDatabaseSchema();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -8970,14 +9781,7 @@ export const ChangedResourceView = {
             break;
           }
 
-          message.name = reader.string();
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.ranges.push(Range.decode(reader, reader.uint32()));
+          message.schema = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -8988,54 +9792,50 @@ export const ChangedResourceView = {
     return message;
   },
 
-  fromJSON(object: any): ChangedResourceView {
-    return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
-    };
+  fromJSON(object: any): DatabaseSchema {
+    return { schema: isSet(object.schema) ? globalThis.String(object.schema) : "" };
   },
 
-  toJSON(message: ChangedResourceView): unknown {
+  toJSON(message: DatabaseSchema): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.ranges?.length) {
-      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    if (message.schema !== "") {
+      obj.schema = message.schema;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ChangedResourceView>): ChangedResourceView {
-    return ChangedResourceView.fromPartial(base ?? {});
+  create(base?: DeepPartial<DatabaseSchema>): DatabaseSchema {
+    return DatabaseSchema.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ChangedResourceView>): ChangedResourceView {
-    const message = createBaseChangedResourceView();
-    message.name = object.name ?? "";
-    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<DatabaseSchema>): DatabaseSchema {
+    const message = createBaseDatabaseSchema();
+    message.schema = object.schema ?? "";
     return message;
   },
 };
 
-function createBaseChangedResourceFunction(): ChangedResourceFunction {
-  return { name: "", ranges: [] };
+function createBaseListSlowQueriesRequest(): ListSlowQueriesRequest {
+  return { parent: "", filter: "", orderBy: "" };
 }
 
-export const ChangedResourceFunction = {
-  encode(message: ChangedResourceFunction, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const ListSlowQueriesRequest = {
+  encode(message: ListSlowQueriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
-    for (const v of message.ranges) {
-      Range.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.filter !== "") {
+      writer.uint32(18).string(message.filter);
+    }
+    if (message.orderBy !== "") {
+      writer.uint32(26).string(message.orderBy);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceFunction {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListSlowQueriesRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResourceFunction();
+    const message = createBaseListSlowQueriesRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -9044,14 +9844,21 @@ export const ChangedResourceFunction = {
             break;
           }
 
-          message.name = reader.string();
+          message.parent = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.ranges.push(Range.decode(reader, reader.uint32()));
+          message.filter = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.orderBy = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -9062,54 +9869,56 @@ export const ChangedResourceFunction = {
     return message;
   },
 
-  fromJSON(object: any): ChangedResourceFunction {
+  fromJSON(object: any): ListSlowQueriesRequest {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+      orderBy: isSet(object.orderBy) ? globalThis.String(object.orderBy) : "",
     };
   },
 
-  toJSON(message: ChangedResourceFunction): unknown {
+  toJSON(message: ListSlowQueriesRequest): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
-    if (message.ranges?.length) {
-      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    if (message.filter !== "") {
+      obj.filter = message.filter;
+    }
+    if (message.orderBy !== "") {
+      obj.orderBy = message.orderBy;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ChangedResourceFunction>): ChangedResourceFunction {
-    return ChangedResourceFunction.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListSlowQueriesRequest>): ListSlowQueriesRequest {
+    return ListSlowQueriesRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ChangedResourceFunction>): ChangedResourceFunction {
-    const message = createBaseChangedResourceFunction();
-    message.name = object.name ?? "";
-    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<ListSlowQueriesRequest>): ListSlowQueriesRequest {
+    const message = createBaseListSlowQueriesRequest();
+    message.parent = object.parent ?? "";
+    message.filter = object.filter ?? "";
+    message.orderBy = object.orderBy ?? "";
     return message;
   },
 };
 
-function createBaseChangedResourceProcedure(): ChangedResourceProcedure {
-  return { name: "", ranges: [] };
+function createBaseListSlowQueriesResponse(): ListSlowQueriesResponse {
+  return { slowQueryLogs: [] };
 }
 
-export const ChangedResourceProcedure = {
-  encode(message: ChangedResourceProcedure, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    for (const v of message.ranges) {
-      Range.encode(v!, writer.uint32(18).fork()).ldelim();
+export const ListSlowQueriesResponse = {
+  encode(message: ListSlowQueriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.slowQueryLogs) {
+      SlowQueryLog.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceProcedure {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListSlowQueriesResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseChangedResourceProcedure();
+    const message = createBaseListSlowQueriesResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -9118,14 +9927,7 @@ export const ChangedResourceProcedure = {
             break;
           }
 
-          message.name = reader.string();
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.ranges.push(Range.decode(reader, reader.uint32()));
+          message.slowQueryLogs.push(SlowQueryLog.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -9136,69 +9938,54 @@ export const ChangedResourceProcedure = {
     return message;
   },
 
-  fromJSON(object: any): ChangedResourceProcedure {
+  fromJSON(object: any): ListSlowQueriesResponse {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+      slowQueryLogs: globalThis.Array.isArray(object?.slowQueryLogs)
+        ? object.slowQueryLogs.map((e: any) => SlowQueryLog.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: ChangedResourceProcedure): unknown {
+  toJSON(message: ListSlowQueriesResponse): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.ranges?.length) {
-      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    if (message.slowQueryLogs?.length) {
+      obj.slowQueryLogs = message.slowQueryLogs.map((e) => SlowQueryLog.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ChangedResourceProcedure>): ChangedResourceProcedure {
-    return ChangedResourceProcedure.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListSlowQueriesResponse>): ListSlowQueriesResponse {
+    return ListSlowQueriesResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ChangedResourceProcedure>): ChangedResourceProcedure {
-    const message = createBaseChangedResourceProcedure();
-    message.name = object.name ?? "";
-    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<ListSlowQueriesResponse>): ListSlowQueriesResponse {
+    const message = createBaseListSlowQueriesResponse();
+    message.slowQueryLogs = object.slowQueryLogs?.map((e) => SlowQueryLog.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseListChangeHistoriesRequest(): ListChangeHistoriesRequest {
-  return {
-    parent: "",
-    pageSize: 0,
-    pageToken: "",
-    view: ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED,
-    filter: "",
-  };
+function createBaseSlowQueryLog(): SlowQueryLog {
+  return { resource: "", project: "", statistics: undefined };
 }
 
-export const ListChangeHistoriesRequest = {
-  encode(message: ListChangeHistoriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
-    }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
-    }
-    if (message.pageToken !== "") {
-      writer.uint32(26).string(message.pageToken);
+export const SlowQueryLog = {
+  encode(message: SlowQueryLog, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resource !== "") {
+      writer.uint32(10).string(message.resource);
     }
-    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
-      writer.uint32(32).int32(changeHistoryViewToNumber(message.view));
+    if (message.project !== "") {
+      writer.uint32(18).string(message.project);
     }
-    if (message.filter !== "") {
-      writer.uint32(42).string(message.filter);
+    if (message.statistics !== undefined) {
+      SlowQueryStatistics.encode(message.statistics, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListChangeHistoriesRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SlowQueryLog {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListChangeHistoriesRequest();
+    const message = createBaseSlowQueryLog();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -9207,35 +9994,21 @@ export const ListChangeHistoriesRequest = {
             break;
           }
 
-          message.parent = reader.string();
+          message.resource = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.pageSize = reader.int32();
+          message.project = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.pageToken = reader.string();
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.view = changeHistoryViewFromJSON(reader.int32());
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.filter = reader.string();
+          message.statistics = SlowQueryStatistics.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -9246,71 +10019,104 @@ export const ListChangeHistoriesRequest = {
     return message;
   },
 
-  fromJSON(object: any): ListChangeHistoriesRequest {
+  fromJSON(object: any): SlowQueryLog {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
-      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
-      view: isSet(object.view)
-        ? changeHistoryViewFromJSON(object.view)
-        : ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED,
-      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+      resource: isSet(object.resource) ? globalThis.String(object.resource) : "",
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      statistics: isSet(object.statistics) ? SlowQueryStatistics.fromJSON(object.statistics) : undefined,
     };
   },
 
-  toJSON(message: ListChangeHistoriesRequest): unknown {
+  toJSON(message: SlowQueryLog): unknown {
     const obj: any = {};
-    if (message.parent !== "") {
-      obj.parent = message.parent;
-    }
-    if (message.pageSize !== 0) {
-      obj.pageSize = Math.round(message.pageSize);
-    }
-    if (message.pageToken !== "") {
-      obj.pageToken = message.pageToken;
+    if (message.resource !== "") {
+      obj.resource = message.resource;
     }
-    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
-      obj.view = changeHistoryViewToJSON(message.view);
+    if (message.project !== "") {
+      obj.project = message.project;
     }
-    if (message.filter !== "") {
-      obj.filter = message.filter;
+    if (message.statistics !== undefined) {
+      obj.statistics = SlowQueryStatistics.toJSON(message.statistics);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListChangeHistoriesRequest>): ListChangeHistoriesRequest {
-    return ListChangeHistoriesRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<SlowQueryLog>): SlowQueryLog {
+    return SlowQueryLog.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListChangeHistoriesRequest>): ListChangeHistoriesRequest {
-    const message = createBaseListChangeHistoriesRequest();
-    message.parent = object.parent ?? "";
-    message.pageSize = object.pageSize ?? 0;
-    message.pageToken = object.pageToken ?? "";
-    message.view = object.view ?? ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED;
-    message.filter = object.filter ?? "";
+  fromPartial(object: DeepPartial<SlowQueryLog>): SlowQueryLog {
+    const message = createBaseSlowQueryLog();
+    message.resource = object.resource ?? "";
+    message.project = object.project ?? "";
+    message.statistics = (object.statistics !== undefined && object.statistics !== null)
+      ? SlowQueryStatistics.fromPartial(object.statistics)
+      : undefined;
     return message;
   },
 };
 
-function createBaseListChangeHistoriesResponse(): ListChangeHistoriesResponse {
-  return { changeHistories: [], nextPageToken: "" };
+function createBaseSlowQueryStatistics(): SlowQueryStatistics {
+  return {
+    sqlFingerprint: "",
+    count: 0,
+    latestLogTime: undefined,
+    averageQueryTime: undefined,
+    maximumQueryTime: undefined,
+    averageRowsSent: 0,
+    maximumRowsSent: 0,
+    averageRowsExamined: 0,
+    maximumRowsExamined: 0,
+    queryTimePercent: 0,
+    countPercent: 0,
+    samples: [],
+  };
 }
 
-export const ListChangeHistoriesResponse = {
-  encode(message: ListChangeHistoriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.changeHistories) {
-      ChangeHistory.encode(v!, writer.uint32(10).fork()).ldelim();
+export const SlowQueryStatistics = {
+  encode(message: SlowQueryStatistics, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.sqlFingerprint !== "") {
+      writer.uint32(10).string(message.sqlFingerprint);
     }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+    if (message.count !== 0) {
+      writer.uint32(16).int32(message.count);
+    }
+    if (message.latestLogTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.latestLogTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.averageQueryTime !== undefined) {
+      Duration.encode(message.averageQueryTime, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.maximumQueryTime !== undefined) {
+      Duration.encode(message.maximumQueryTime, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.averageRowsSent !== 0) {
+      writer.uint32(48).int32(message.averageRowsSent);
+    }
+    if (message.maximumRowsSent !== 0) {
+      writer.uint32(56).int32(message.maximumRowsSent);
+    }
+    if (message.averageRowsExamined !== 0) {
+      writer.uint32(64).int32(message.averageRowsExamined);
+    }
+    if (message.maximumRowsExamined !== 0) {
+      writer.uint32(72).int32(message.maximumRowsExamined);
+    }
+    if (message.queryTimePercent !== 0) {
+      writer.uint32(81).double(message.queryTimePercent);
+    }
+    if (message.countPercent !== 0) {
+      writer.uint32(89).double(message.countPercent);
+    }
+    for (const v of message.samples) {
+      SlowQueryDetails.encode(v!, writer.uint32(98).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListChangeHistoriesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SlowQueryStatistics {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListChangeHistoriesResponse();
+    const message = createBaseSlowQueryStatistics();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -9319,80 +10125,210 @@ export const ListChangeHistoriesResponse = {
             break;
           }
 
-          message.changeHistories.push(ChangeHistory.decode(reader, reader.uint32()));
+          message.sqlFingerprint = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.nextPageToken = reader.string();
+          message.count = reader.int32();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
 
-  fromJSON(object: any): ListChangeHistoriesResponse {
-    return {
-      changeHistories: globalThis.Array.isArray(object?.changeHistories)
-        ? object.changeHistories.map((e: any) => ChangeHistory.fromJSON(e))
-        : [],
-      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
-    };
-  },
+          message.latestLogTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
 
-  toJSON(message: ListChangeHistoriesResponse): unknown {
-    const obj: any = {};
-    if (message.changeHistories?.length) {
-      obj.changeHistories = message.changeHistories.map((e) => ChangeHistory.toJSON(e));
-    }
-    if (message.nextPageToken !== "") {
-      obj.nextPageToken = message.nextPageToken;
-    }
-    return obj;
-  },
+          message.averageQueryTime = Duration.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
 
-  create(base?: DeepPartial<ListChangeHistoriesResponse>): ListChangeHistoriesResponse {
-    return ListChangeHistoriesResponse.fromPartial(base ?? {});
+          message.maximumQueryTime = Duration.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.averageRowsSent = reader.int32();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.maximumRowsSent = reader.int32();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.averageRowsExamined = reader.int32();
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.maximumRowsExamined = reader.int32();
+          continue;
+        case 10:
+          if (tag !== 81) {
+            break;
+          }
+
+          message.queryTimePercent = reader.double();
+          continue;
+        case 11:
+          if (tag !== 89) {
+            break;
+          }
+
+          message.countPercent = reader.double();
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.samples.push(SlowQueryDetails.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
   },
-  fromPartial(object: DeepPartial<ListChangeHistoriesResponse>): ListChangeHistoriesResponse {
-    const message = createBaseListChangeHistoriesResponse();
-    message.changeHistories = object.changeHistories?.map((e) => ChangeHistory.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
+
+  fromJSON(object: any): SlowQueryStatistics {
+    return {
+      sqlFingerprint: isSet(object.sqlFingerprint) ? globalThis.String(object.sqlFingerprint) : "",
+      count: isSet(object.count) ? globalThis.Number(object.count) : 0,
+      latestLogTime: isSet(object.latestLogTime) ? fromJsonTimestamp(object.latestLogTime) : undefined,
+      averageQueryTime: isSet(object.averageQueryTime) ? Duration.fromJSON(object.averageQueryTime) : undefined,
+      maximumQueryTime: isSet(object.maximumQueryTime) ? Duration.fromJSON(object.maximumQueryTime) : undefined,
+      averageRowsSent: isSet(object.averageRowsSent) ? globalThis.Number(object.averageRowsSent) : 0,
+      maximumRowsSent: isSet(object.maximumRowsSent) ? globalThis.Number(object.maximumRowsSent) : 0,
+      averageRowsExamined: isSet(object.averageRowsExamined) ? globalThis.Number(object.averageRowsExamined) : 0,
+      maximumRowsExamined: isSet(object.maximumRowsExamined) ? globalThis.Number(object.maximumRowsExamined) : 0,
+      queryTimePercent: isSet(object.queryTimePercent) ? globalThis.Number(object.queryTimePercent) : 0,
+      countPercent: isSet(object.countPercent) ? globalThis.Number(object.countPercent) : 0,
+      samples: globalThis.Array.isArray(object?.samples)
+        ? object.samples.map((e: any) => SlowQueryDetails.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SlowQueryStatistics): unknown {
+    const obj: any = {};
+    if (message.sqlFingerprint !== "") {
+      obj.sqlFingerprint = message.sqlFingerprint;
+    }
+    if (message.count !== 0) {
+      obj.count = Math.round(message.count);
+    }
+    if (message.latestLogTime !== undefined) {
+      obj.latestLogTime = message.latestLogTime.toISOString();
+    }
+    if (message.averageQueryTime !== undefined) {
+      obj.averageQueryTime = Duration.toJSON(message.averageQueryTime);
+    }
+    if (message.maximumQueryTime !== undefined) {
+      obj.maximumQueryTime = Duration.toJSON(message.maximumQueryTime);
+    }
+    if (message.averageRowsSent !== 0) {
+      obj.averageRowsSent = Math.round(message.averageRowsSent);
+    }
+    if (message.maximumRowsSent !== 0) {
+      obj.maximumRowsSent = Math.round(message.maximumRowsSent);
+    }
+    if (message.averageRowsExamined !== 0) {
+      obj.averageRowsExamined = Math.round(message.averageRowsExamined);
+    }
+    if (message.maximumRowsExamined !== 0) {
+      obj.maximumRowsExamined = Math.round(message.maximumRowsExamined);
+    }
+    if (message.queryTimePercent !== 0) {
+      obj.queryTimePercent = message.queryTimePercent;
+    }
+    if (message.countPercent !== 0) {
+      obj.countPercent = message.countPercent;
+    }
+    if (message.samples?.length) {
+      obj.samples = message.samples.map((e) => SlowQueryDetails.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SlowQueryStatistics>): SlowQueryStatistics {
+    return SlowQueryStatistics.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SlowQueryStatistics>): SlowQueryStatistics {
+    const message = createBaseSlowQueryStatistics();
+    message.sqlFingerprint = object.sqlFingerprint ?? "";
+    message.count = object.count ?? 0;
+    message.latestLogTime = object.latestLogTime ?? undefined;
+    message.averageQueryTime = (object.averageQueryTime !== undefined && object.averageQueryTime !== null)
+      ? Duration.fromPartial(object.averageQueryTime)
+      : undefined;
+    message.maximumQueryTime = (object.maximumQueryTime !== undefined && object.maximumQueryTime !== null)
+      ? Duration.fromPartial(object.maximumQueryTime)
+      : undefined;
+    message.averageRowsSent = object.averageRowsSent ?? 0;
+    message.maximumRowsSent = object.maximumRowsSent ?? 0;
+    message.averageRowsExamined = object.averageRowsExamined ?? 0;
+    message.maximumRowsExamined = object.maximumRowsExamined ?? 0;
+    message.queryTimePercent = object.queryTimePercent ?? 0;
+    message.countPercent = object.countPercent ?? 0;
+    message.samples = object.samples?.map((e) => SlowQueryDetails.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseGetChangeHistoryRequest(): GetChangeHistoryRequest {
-  return { name: "", view: ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED, sdlFormat: false, concise: false };
+function createBaseSlowQueryDetails(): SlowQueryDetails {
+  return { startTime: undefined, queryTime: undefined, lockTime: undefined, rowsSent: 0, rowsExamined: 0, sqlText: "" };
 }
 
-export const GetChangeHistoryRequest = {
-  encode(message: GetChangeHistoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const SlowQueryDetails = {
+  encode(message: SlowQueryDetails, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(10).fork()).ldelim();
     }
-    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
-      writer.uint32(16).int32(changeHistoryViewToNumber(message.view));
+    if (message.queryTime !== undefined) {
+      Duration.encode(message.queryTime, writer.uint32(18).fork()).ldelim();
     }
-    if (message.sdlFormat === true) {
-      writer.uint32(24).bool(message.sdlFormat);
+    if (message.lockTime !== undefined) {
+      Duration.encode(message.lockTime, writer.uint32(26).fork()).ldelim();
     }
-    if (message.concise === true) {
-      writer.uint32(32).bool(message.concise);
+    if (message.rowsSent !== 0) {
+      writer.uint32(32).int32(message.rowsSent);
+    }
+    if (message.rowsExamined !== 0) {
+      writer.uint32(40).int32(message.rowsExamined);
+    }
+    if (message.sqlText !== "") {
+      writer.uint32(50).string(message.sqlText);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetChangeHistoryRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SlowQueryDetails {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetChangeHistoryRequest();
+    const message = createBaseSlowQueryDetails();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -9401,28 +10337,42 @@ export const GetChangeHistoryRequest = {
             break;
           }
 
-          message.name = reader.string();
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.view = changeHistoryViewFromJSON(reader.int32());
+          message.queryTime = Duration.decode(reader, reader.uint32());
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.sdlFormat = reader.bool();
+          message.lockTime = Duration.decode(reader, reader.uint32());
           continue;
         case 4:
           if (tag !== 32) {
             break;
           }
 
-          message.concise = reader.bool();
+          message.rowsSent = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.rowsExamined = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.sqlText = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -9433,47 +10383,3310 @@ export const GetChangeHistoryRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetChangeHistoryRequest {
+  fromJSON(object: any): SlowQueryDetails {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      view: isSet(object.view)
-        ? changeHistoryViewFromJSON(object.view)
-        : ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED,
-      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
-      concise: isSet(object.concise) ? globalThis.Boolean(object.concise) : false,
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      queryTime: isSet(object.queryTime) ? Duration.fromJSON(object.queryTime) : undefined,
+      lockTime: isSet(object.lockTime) ? Duration.fromJSON(object.lockTime) : undefined,
+      rowsSent: isSet(object.rowsSent) ? globalThis.Number(object.rowsSent) : 0,
+      rowsExamined: isSet(object.rowsExamined) ? globalThis.Number(object.rowsExamined) : 0,
+      sqlText: isSet(object.sqlText) ? globalThis.String(object.sqlText) : "",
     };
   },
 
-  toJSON(message: GetChangeHistoryRequest): unknown {
+  toJSON(message: SlowQueryDetails): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
     }
-    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
-      obj.view = changeHistoryViewToJSON(message.view);
+    if (message.queryTime !== undefined) {
+      obj.queryTime = Duration.toJSON(message.queryTime);
     }
-    if (message.sdlFormat === true) {
-      obj.sdlFormat = message.sdlFormat;
+    if (message.lockTime !== undefined) {
+      obj.lockTime = Duration.toJSON(message.lockTime);
     }
-    if (message.concise === true) {
-      obj.concise = message.concise;
+    if (message.rowsSent !== 0) {
+      obj.rowsSent = Math.round(message.rowsSent);
+    }
+    if (message.rowsExamined !== 0) {
+      obj.rowsExamined = Math.round(message.rowsExamined);
+    }
+    if (message.sqlText !== "") {
+      obj.sqlText = message.sqlText;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<GetChangeHistoryRequest>): GetChangeHistoryRequest {
-    return GetChangeHistoryRequest.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<GetChangeHistoryRequest>): GetChangeHistoryRequest {
-    const message = createBaseGetChangeHistoryRequest();
-    message.name = object.name ?? "";
-    message.view = object.view ?? ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED;
-    message.sdlFormat = object.sdlFormat ?? false;
-    message.concise = object.concise ?? false;
-    return message;
+  create(base?: DeepPartial<SlowQueryDetails>): SlowQueryDetails {
+    return SlowQueryDetails.fromPartial(base ?? {});
   },
-};
-
+  fromPartial(object: DeepPartial<SlowQueryDetails>): SlowQueryDetails {
+    const message = createBaseSlowQueryDetails();
+    message.startTime = object.startTime ?? undefined;
+    message.queryTime = (object.queryTime !== undefined && object.queryTime !== null)
+      ? Duration.fromPartial(object.queryTime)
+      : undefined;
+    message.lockTime = (object.lockTime !== undefined && object.lockTime !== null)
+      ? Duration.fromPartial(object.lockTime)
+      : undefined;
+    message.rowsSent = object.rowsSent ?? 0;
+    message.rowsExamined = object.rowsExamined ?? 0;
+    message.sqlText = object.sqlText ?? "";
+    return message;
+  },
+};
+
+function createBaseListSecretsRequest(): ListSecretsRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const ListSecretsRequest = {
+  encode(message: ListSecretsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListSecretsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListSecretsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListSecretsRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListSecretsRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListSecretsRequest>): ListSecretsRequest {
+    return ListSecretsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListSecretsRequest>): ListSecretsRequest {
+    const message = createBaseListSecretsRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseListSecretsResponse(): ListSecretsResponse {
+  return { secrets: [], nextPageToken: "" };
+}
+
+export const ListSecretsResponse = {
+  encode(message: ListSecretsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.secrets) {
+      Secret.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListSecretsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListSecretsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.secrets.push(Secret.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListSecretsResponse {
+    return {
+      secrets: globalThis.Array.isArray(object?.secrets) ? object.secrets.map((e: any) => Secret.fromJSON(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListSecretsResponse): unknown {
+    const obj: any = {};
+    if (message.secrets?.length) {
+      obj.secrets = message.secrets.map((e) => Secret.toJSON(e));
+    }
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListSecretsResponse>): ListSecretsResponse {
+    return ListSecretsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListSecretsResponse>): ListSecretsResponse {
+    const message = createBaseListSecretsResponse();
+    message.secrets = object.secrets?.map((e) => Secret.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateSecretRequest(): UpdateSecretRequest {
+  return { secret: undefined, updateMask: undefined, allowMissing: false };
+}
+
+export const UpdateSecretRequest = {
+  encode(message: UpdateSecretRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.secret !== undefined) {
+      Secret.encode(message.secret, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.allowMissing === true) {
+      writer.uint32(24).bool(message.allowMissing);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateSecretRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateSecretRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.secret = Secret.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.allowMissing = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateSecretRequest {
+    return {
+      secret: isSet(object.secret) ? Secret.fromJSON(object.secret) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+      allowMissing: isSet(object.allowMissing) ? globalThis.Boolean(object.allowMissing) : false,
+    };
+  },
+
+  toJSON(message: UpdateSecretRequest): unknown {
+    const obj: any = {};
+    if (message.secret !== undefined) {
+      obj.secret = Secret.toJSON(message.secret);
+    }
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    }
+    if (message.allowMissing === true) {
+      obj.allowMissing = message.allowMissing;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateSecretRequest>): UpdateSecretRequest {
+    return UpdateSecretRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateSecretRequest>): UpdateSecretRequest {
+    const message = createBaseUpdateSecretRequest();
+    message.secret = (object.secret !== undefined && object.secret !== null)
+      ? Secret.fromPartial(object.secret)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    message.allowMissing = object.allowMissing ?? false;
+    return message;
+  },
+};
+
+function createBaseDeleteSecretRequest(): DeleteSecretRequest {
+  return { name: "" };
+}
+
+export const DeleteSecretRequest = {
+  encode(message: DeleteSecretRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteSecretRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteSecretRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteSecretRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: DeleteSecretRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteSecretRequest>): DeleteSecretRequest {
+    return DeleteSecretRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteSecretRequest>): DeleteSecretRequest {
+    const message = createBaseDeleteSecretRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseSecret(): Secret {
+  return {
+    name: "",
+    createdTime: undefined,
+    updatedTime: undefined,
+    value: "",
+    description: "",
+    externalSecret: undefined,
+  };
+}
+
+export const Secret = {
+  encode(message: Secret, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.createdTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createdTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.updatedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updatedTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.value !== "") {
+      writer.uint32(34).string(message.value);
+    }
+    if (message.description !== "") {
+      writer.uint32(42).string(message.description);
+    }
+    if (message.externalSecret !== undefined) {
+      DataSourceExternalSecret.encode(message.externalSecret, writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Secret {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSecret();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createdTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updatedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.externalSecret = DataSourceExternalSecret.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Secret {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      createdTime: isSet(object.createdTime) ? fromJsonTimestamp(object.createdTime) : undefined,
+      updatedTime: isSet(object.updatedTime) ? fromJsonTimestamp(object.updatedTime) : undefined,
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      externalSecret: isSet(object.externalSecret)
+        ? DataSourceExternalSecret.fromJSON(object.externalSecret)
+        : undefined,
+    };
+  },
+
+  toJSON(message: Secret): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.createdTime !== undefined) {
+      obj.createdTime = message.createdTime.toISOString();
+    }
+    if (message.updatedTime !== undefined) {
+      obj.updatedTime = message.updatedTime.toISOString();
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.externalSecret !== undefined) {
+      obj.externalSecret = DataSourceExternalSecret.toJSON(message.externalSecret);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Secret>): Secret {
+    return Secret.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Secret>): Secret {
+    const message = createBaseSecret();
+    message.name = object.name ?? "";
+    message.createdTime = object.createdTime ?? undefined;
+    message.updatedTime = object.updatedTime ?? undefined;
+    message.value = object.value ?? "";
+    message.description = object.description ?? "";
+    message.externalSecret = (object.externalSecret !== undefined && object.externalSecret !== null)
+      ? DataSourceExternalSecret.fromPartial(object.externalSecret)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseAdviseIndexRequest(): AdviseIndexRequest {
+  return { parent: "", statement: "" };
+}
+
+export const AdviseIndexRequest = {
+  encode(message: AdviseIndexRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.statement !== "") {
+      writer.uint32(18).string(message.statement);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AdviseIndexRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAdviseIndexRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AdviseIndexRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+    };
+  },
+
+  toJSON(message: AdviseIndexRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AdviseIndexRequest>): AdviseIndexRequest {
+    return AdviseIndexRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AdviseIndexRequest>): AdviseIndexRequest {
+    const message = createBaseAdviseIndexRequest();
+    message.parent = object.parent ?? "";
+    message.statement = object.statement ?? "";
+    return message;
+  },
+};
+
+function createBaseAdviseIndexResponse(): AdviseIndexResponse {
+  return { currentIndex: "", suggestion: "", createIndexStatement: "" };
+}
+
+export const AdviseIndexResponse = {
+  encode(message: AdviseIndexResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.currentIndex !== "") {
+      writer.uint32(10).string(message.currentIndex);
+    }
+    if (message.suggestion !== "") {
+      writer.uint32(18).string(message.suggestion);
+    }
+    if (message.createIndexStatement !== "") {
+      writer.uint32(26).string(message.createIndexStatement);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AdviseIndexResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAdviseIndexResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.currentIndex = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.suggestion = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createIndexStatement = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AdviseIndexResponse {
+    return {
+      currentIndex: isSet(object.currentIndex) ? globalThis.String(object.currentIndex) : "",
+      suggestion: isSet(object.suggestion) ? globalThis.String(object.suggestion) : "",
+      createIndexStatement: isSet(object.createIndexStatement) ? globalThis.String(object.createIndexStatement) : "",
+    };
+  },
+
+  toJSON(message: AdviseIndexResponse): unknown {
+    const obj: any = {};
+    if (message.currentIndex !== "") {
+      obj.currentIndex = message.currentIndex;
+    }
+    if (message.suggestion !== "") {
+      obj.suggestion = message.suggestion;
+    }
+    if (message.createIndexStatement !== "") {
+      obj.createIndexStatement = message.createIndexStatement;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AdviseIndexResponse>): AdviseIndexResponse {
+    return AdviseIndexResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AdviseIndexResponse>): AdviseIndexResponse {
+    const message = createBaseAdviseIndexResponse();
+    message.currentIndex = object.currentIndex ?? "";
+    message.suggestion = object.suggestion ?? "";
+    message.createIndexStatement = object.createIndexStatement ?? "";
+    return message;
+  },
+};
+
+function createBaseChangeHistory(): ChangeHistory {
+  return {
+    name: "",
+    uid: "",
+    creator: "",
+    updater: "",
+    createTime: undefined,
+    updateTime: undefined,
+    releaseVersion: "",
+    source: ChangeHistory_Source.SOURCE_UNSPECIFIED,
+    type: ChangeHistory_Type.TYPE_UNSPECIFIED,
+    status: ChangeHistory_Status.STATUS_UNSPECIFIED,
+    version: "",
+    description: "",
+    statement: "",
+    statementSize: Long.ZERO,
+    statementSheet: "",
+    schema: "",
+    schemaSize: Long.ZERO,
+    prevSchema: "",
+    prevSchemaSize: Long.ZERO,
+    executionDuration: undefined,
+    issue: "",
+    changedResources: undefined,
+  };
+}
+
+export const ChangeHistory = {
+  encode(message: ChangeHistory, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.uid !== "") {
+      writer.uint32(18).string(message.uid);
+    }
+    if (message.creator !== "") {
+      writer.uint32(26).string(message.creator);
+    }
+    if (message.updater !== "") {
+      writer.uint32(34).string(message.updater);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.releaseVersion !== "") {
+      writer.uint32(58).string(message.releaseVersion);
+    }
+    if (message.source !== ChangeHistory_Source.SOURCE_UNSPECIFIED) {
+      writer.uint32(64).int32(changeHistory_SourceToNumber(message.source));
+    }
+    if (message.type !== ChangeHistory_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(72).int32(changeHistory_TypeToNumber(message.type));
+    }
+    if (message.status !== ChangeHistory_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(80).int32(changeHistory_StatusToNumber(message.status));
+    }
+    if (message.version !== "") {
+      writer.uint32(90).string(message.version);
+    }
+    if (message.description !== "") {
+      writer.uint32(98).string(message.description);
+    }
+    if (message.statement !== "") {
+      writer.uint32(106).string(message.statement);
+    }
+    if (!message.statementSize.isZero()) {
+      writer.uint32(168).int64(message.statementSize);
+    }
+    if (message.statementSheet !== "") {
+      writer.uint32(162).string(message.statementSheet);
+    }
+    if (message.schema !== "") {
+      writer.uint32(114).string(message.schema);
+    }
+    if (!message.schemaSize.isZero()) {
+      writer.uint32(176).int64(message.schemaSize);
+    }
+    if (message.prevSchema !== "") {
+      writer.uint32(122).string(message.prevSchema);
+    }
+    if (!message.prevSchemaSize.isZero()) {
+      writer.uint32(184).int64(message.prevSchemaSize);
+    }
+    if (message.executionDuration !== undefined) {
+      Duration.encode(message.executionDuration, writer.uint32(130).fork()).ldelim();
+    }
+    if (message.issue !== "") {
+      writer.uint32(138).string(message.issue);
+    }
+    if (message.changedResources !== undefined) {
+      ChangedResources.encode(message.changedResources, writer.uint32(154).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangeHistory {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangeHistory();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.uid = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.updater = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.releaseVersion = reader.string();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.source = changeHistory_SourceFromJSON(reader.int32());
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.type = changeHistory_TypeFromJSON(reader.int32());
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.status = changeHistory_StatusFromJSON(reader.int32());
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 21:
+          if (tag !== 168) {
+            break;
+          }
+
+          message.statementSize = reader.int64() as Long;
+          continue;
+        case 20:
+          if (tag !== 162) {
+            break;
+          }
+
+          message.statementSheet = reader.string();
+          continue;
+        case 14:
+          if (tag !== 114) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+        case 22:
+          if (tag !== 176) {
+            break;
+          }
+
+          message.schemaSize = reader.int64() as Long;
+          continue;
+        case 15:
+          if (tag !== 122) {
+            break;
+          }
+
+          message.prevSchema = reader.string();
+          continue;
+        case 23:
+          if (tag !== 184) {
+            break;
+          }
+
+          message.prevSchemaSize = reader.int64() as Long;
+          continue;
+        case 16:
+          if (tag !== 130) {
+            break;
+          }
+
+          message.executionDuration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.issue = reader.string();
+          continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.changedResources = ChangedResources.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangeHistory {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      updater: isSet(object.updater) ? globalThis.String(object.updater) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+      releaseVersion: isSet(object.releaseVersion) ? globalThis.String(object.releaseVersion) : "",
+      source: isSet(object.source)
+        ? changeHistory_SourceFromJSON(object.source)
+        : ChangeHistory_Source.SOURCE_UNSPECIFIED,
+      type: isSet(object.type) ? changeHistory_TypeFromJSON(object.type) : ChangeHistory_Type.TYPE_UNSPECIFIED,
+      status: isSet(object.status)
+        ? changeHistory_StatusFromJSON(object.status)
+        : ChangeHistory_Status.STATUS_UNSPECIFIED,
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      statementSize: isSet(object.statementSize) ? Long.fromValue(object.statementSize) : Long.ZERO,
+      statementSheet: isSet(object.statementSheet) ? globalThis.String(object.statementSheet) : "",
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      schemaSize: isSet(object.schemaSize) ? Long.fromValue(object.schemaSize) : Long.ZERO,
+      prevSchema: isSet(object.prevSchema) ? globalThis.String(object.prevSchema) : "",
+      prevSchemaSize: isSet(object.prevSchemaSize) ? Long.fromValue(object.prevSchemaSize) : Long.ZERO,
+      executionDuration: isSet(object.executionDuration) ? Duration.fromJSON(object.executionDuration) : undefined,
+      issue: isSet(object.issue) ? globalThis.String(object.issue) : "",
+      changedResources: isSet(object.changedResources) ? ChangedResources.fromJSON(object.changedResources) : undefined,
+    };
+  },
+
+  toJSON(message: ChangeHistory): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.uid !== "") {
+      obj.uid = message.uid;
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.updater !== "") {
+      obj.updater = message.updater;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
+    }
+    if (message.releaseVersion !== "") {
+      obj.releaseVersion = message.releaseVersion;
+    }
+    if (message.source !== ChangeHistory_Source.SOURCE_UNSPECIFIED) {
+      obj.source = changeHistory_SourceToJSON(message.source);
+    }
+    if (message.type !== ChangeHistory_Type.TYPE_UNSPECIFIED) {
+      obj.type = changeHistory_TypeToJSON(message.type);
+    }
+    if (message.status !== ChangeHistory_Status.STATUS_UNSPECIFIED) {
+      obj.status = changeHistory_StatusToJSON(message.status);
+    }
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (!message.statementSize.isZero()) {
+      obj.statementSize = (message.statementSize || Long.ZERO).toString();
+    }
+    if (message.statementSheet !== "") {
+      obj.statementSheet = message.statementSheet;
+    }
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (!message.schemaSize.isZero()) {
+      obj.schemaSize = (message.schemaSize || Long.ZERO).toString();
+    }
+    if (message.prevSchema !== "") {
+      obj.prevSchema = message.prevSchema;
+    }
+    if (!message.prevSchemaSize.isZero()) {
+      obj.prevSchemaSize = (message.prevSchemaSize || Long.ZERO).toString();
+    }
+    if (message.executionDuration !== undefined) {
+      obj.executionDuration = Duration.toJSON(message.executionDuration);
+    }
+    if (message.issue !== "") {
+      obj.issue = message.issue;
+    }
+    if (message.changedResources !== undefined) {
+      obj.changedResources = ChangedResources.toJSON(message.changedResources);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangeHistory>): ChangeHistory {
+    return ChangeHistory.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangeHistory>): ChangeHistory {
+    const message = createBaseChangeHistory();
+    message.name = object.name ?? "";
+    message.uid = object.uid ?? "";
+    message.creator = object.creator ?? "";
+    message.updater = object.updater ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    message.releaseVersion = object.releaseVersion ?? "";
+    message.source = object.source ?? ChangeHistory_Source.SOURCE_UNSPECIFIED;
+    message.type = object.type ?? ChangeHistory_Type.TYPE_UNSPECIFIED;
+    message.status = object.status ?? ChangeHistory_Status.STATUS_UNSPECIFIED;
+    message.version = object.version ?? "";
+    message.description = object.description ?? "";
+    message.statement = object.statement ?? "";
+    message.statementSize = (object.statementSize !== undefined && object.statementSize !== null)
+      ? Long.fromValue(object.statementSize)
+      : Long.ZERO;
+    message.statementSheet = object.statementSheet ?? "";
+    message.schema = object.schema ?? "";
+    message.schemaSize = (object.schemaSize !== undefined && object.schemaSize !== null)
+      ? Long.fromValue(object.schemaSize)
+      : Long.ZERO;
+    message.prevSchema = object.prevSchema ?? "";
+    message.prevSchemaSize = (object.prevSchemaSize !== undefined && object.prevSchemaSize !== null)
+      ? Long.fromValue(object.prevSchemaSize)
+      : Long.ZERO;
+    message.executionDuration = (object.executionDuration !== undefined && object.executionDuration !== null)
+      ? Duration.fromPartial(object.executionDuration)
+      : undefined;
+    message.issue = object.issue ?? "";
+    message.changedResources = (object.changedResources !== undefined && object.changedResources !== null)
+      ? ChangedResources.fromPartial(object.changedResources)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseChangedResources(): ChangedResources {
+  return { databases: [] };
+}
+
+export const ChangedResources = {
+  encode(message: ChangedResources, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.databases) {
+      ChangedResourceDatabase.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResources {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResources();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.databases.push(ChangedResourceDatabase.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResources {
+    return {
+      databases: globalThis.Array.isArray(object?.databases)
+        ? object.databases.map((e: any) => ChangedResourceDatabase.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ChangedResources): unknown {
+    const obj: any = {};
+    if (message.databases?.length) {
+      obj.databases = message.databases.map((e) => ChangedResourceDatabase.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResources>): ChangedResources {
+    return ChangedResources.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResources>): ChangedResources {
+    const message = createBaseChangedResources();
+    message.databases = object.databases?.map((e) => ChangedResourceDatabase.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseChangedResourceDatabase(): ChangedResourceDatabase {
+  return { name: "", schemas: [] };
+}
+
+export const ChangedResourceDatabase = {
+  encode(message: ChangedResourceDatabase, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.schemas) {
+      ChangedResourceSchema.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceDatabase {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResourceDatabase();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.schemas.push(ChangedResourceSchema.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResourceDatabase {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      schemas: globalThis.Array.isArray(object?.schemas)
+        ? object.schemas.map((e: any) => ChangedResourceSchema.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ChangedResourceDatabase): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.schemas?.length) {
+      obj.schemas = message.schemas.map((e) => ChangedResourceSchema.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResourceDatabase>): ChangedResourceDatabase {
+    return ChangedResourceDatabase.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResourceDatabase>): ChangedResourceDatabase {
+    const message = createBaseChangedResourceDatabase();
+    message.name = object.name ?? "";
+    message.schemas = object.schemas?.map((e) => ChangedResourceSchema.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseChangedResourceSchema(): ChangedResourceSchema {
+  return { name: "", tables: [], views: [], functions: [], procedures: [] };
+}
+
+export const ChangedResourceSchema = {
+  encode(message: ChangedResourceSchema, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.tables) {
+      ChangedResourceTable.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.views) {
+      ChangedResourceView.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    for (const v of message.functions) {
+      ChangedResourceFunction.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    for (const v of message.procedures) {
+      ChangedResourceProcedure.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceSchema {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResourceSchema();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tables.push(ChangedResourceTable.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.views.push(ChangedResourceView.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.functions.push(ChangedResourceFunction.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.procedures.push(ChangedResourceProcedure.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResourceSchema {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      tables: globalThis.Array.isArray(object?.tables)
+        ? object.tables.map((e: any) => ChangedResourceTable.fromJSON(e))
+        : [],
+      views: globalThis.Array.isArray(object?.views)
+        ? object.views.map((e: any) => ChangedResourceView.fromJSON(e))
+        : [],
+      functions: globalThis.Array.isArray(object?.functions)
+        ? object.functions.map((e: any) => ChangedResourceFunction.fromJSON(e))
+        : [],
+      procedures: globalThis.Array.isArray(object?.procedures)
+        ? object.procedures.map((e: any) => ChangedResourceProcedure.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ChangedResourceSchema): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.tables?.length) {
+      obj.tables = message.tables.map((e) => ChangedResourceTable.toJSON(e));
+    }
+    if (message.views?.length) {
+      obj.views = message.views.map((e) => ChangedResourceView.toJSON(e));
+    }
+    if (message.functions?.length) {
+      obj.functions = message.functions.map((e) => ChangedResourceFunction.toJSON(e));
+    }
+    if (message.procedures?.length) {
+      obj.procedures = message.procedures.map((e) => ChangedResourceProcedure.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResourceSchema>): ChangedResourceSchema {
+    return ChangedResourceSchema.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResourceSchema>): ChangedResourceSchema {
+    const message = createBaseChangedResourceSchema();
+    message.name = object.name ?? "";
+    message.tables = object.tables?.map((e) => ChangedResourceTable.fromPartial(e)) || [];
+    message.views = object.views?.map((e) => ChangedResourceView.fromPartial(e)) || [];
+    message.functions = object.functions?.map((e) => ChangedResourceFunction.fromPartial(e)) || [];
+    message.procedures = object.procedures?.map((e) => ChangedResourceProcedure.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseChangedResourceTable(): ChangedResourceTable {
+  return { name: "", ranges: [] };
+}
+
+export const ChangedResourceTable = {
+  encode(message: ChangedResourceTable, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.ranges) {
+      Range.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceTable {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResourceTable();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.ranges.push(Range.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResourceTable {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ChangedResourceTable): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.ranges?.length) {
+      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResourceTable>): ChangedResourceTable {
+    return ChangedResourceTable.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResourceTable>): ChangedResourceTable {
+    const message = createBaseChangedResourceTable();
+    message.name = object.name ?? "";
+    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseChangedResourceView(): ChangedResourceView {
+  return { name: "", ranges: [] };
+}
+
+export const ChangedResourceView = {
+  encode(message: ChangedResourceView, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.ranges) {
+      Range.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceView {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResourceView();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ranges.push(Range.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResourceView {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ChangedResourceView): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.ranges?.length) {
+      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResourceView>): ChangedResourceView {
+    return ChangedResourceView.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResourceView>): ChangedResourceView {
+    const message = createBaseChangedResourceView();
+    message.name = object.name ?? "";
+    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseChangedResourceFunction(): ChangedResourceFunction {
+  return { name: "", ranges: [] };
+}
+
+export const ChangedResourceFunction = {
+  encode(message: ChangedResourceFunction, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.ranges) {
+      Range.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceFunction {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResourceFunction();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ranges.push(Range.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResourceFunction {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ChangedResourceFunction): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.ranges?.length) {
+      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResourceFunction>): ChangedResourceFunction {
+    return ChangedResourceFunction.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResourceFunction>): ChangedResourceFunction {
+    const message = createBaseChangedResourceFunction();
+    message.name = object.name ?? "";
+    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseChangedResourceProcedure(): ChangedResourceProcedure {
+  return { name: "", ranges: [] };
+}
+
+export const ChangedResourceProcedure = {
+  encode(message: ChangedResourceProcedure, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.ranges) {
+      Range.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangedResourceProcedure {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseChangedResourceProcedure();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ranges.push(Range.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ChangedResourceProcedure {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      ranges: globalThis.Array.isArray(object?.ranges) ? object.ranges.map((e: any) => Range.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: ChangedResourceProcedure): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.ranges?.length) {
+      obj.ranges = message.ranges.map((e) => Range.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ChangedResourceProcedure>): ChangedResourceProcedure {
+    return ChangedResourceProcedure.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ChangedResourceProcedure>): ChangedResourceProcedure {
+    const message = createBaseChangedResourceProcedure();
+    message.name = object.name ?? "";
+    message.ranges = object.ranges?.map((e) => Range.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseListChangeHistoriesRequest(): ListChangeHistoriesRequest {
+  return {
+    parent: "",
+    pageSize: 0,
+    pageToken: "",
+    view: ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED,
+    filter: "",
+  };
+}
+
+export const ListChangeHistoriesRequest = {
+  encode(message: ListChangeHistoriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
+      writer.uint32(32).int32(changeHistoryViewToNumber(message.view));
+    }
+    if (message.filter !== "") {
+      writer.uint32(42).string(message.filter);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListChangeHistoriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListChangeHistoriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.view = changeHistoryViewFromJSON(reader.int32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.filter = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListChangeHistoriesRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+      view: isSet(object.view)
+        ? changeHistoryViewFromJSON(object.view)
+        : ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED,
+      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+    };
+  },
+
+  toJSON(message: ListChangeHistoriesRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
+    }
+    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
+      obj.view = changeHistoryViewToJSON(message.view);
+    }
+    if (message.filter !== "") {
+      obj.filter = message.filter;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListChangeHistoriesRequest>): ListChangeHistoriesRequest {
+    return ListChangeHistoriesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListChangeHistoriesRequest>): ListChangeHistoriesRequest {
+    const message = createBaseListChangeHistoriesRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    message.view = object.view ?? ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED;
+    message.filter = object.filter ?? "";
+    return message;
+  },
+};
+
+function createBaseListChangeHistoriesResponse(): ListChangeHistoriesResponse {
+  return { changeHistories: [], nextPageToken: "" };
+}
+
+export const ListChangeHistoriesResponse = {
+  encode(message: ListChangeHistoriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.changeHistories) {
+      ChangeHistory.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListChangeHistoriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListChangeHistoriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.changeHistories.push(ChangeHistory.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListChangeHistoriesResponse {
+    return {
+      changeHistories: globalThis.Array.isArray(object?.changeHistories)
+        ? object.changeHistories.map((e: any) => ChangeHistory.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListChangeHistoriesResponse): unknown {
+    const obj: any = {};
+    if (message.changeHistories?.length) {
+      obj.changeHistories = message.changeHistories.map((e) => ChangeHistory.toJSON(e));
+    }
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListChangeHistoriesResponse>): ListChangeHistoriesResponse {
+    return ListChangeHistoriesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListChangeHistoriesResponse>): ListChangeHistoriesResponse {
+    const message = createBaseListChangeHistoriesResponse();
+    message.changeHistories = object.changeHistories?.map((e) => ChangeHistory.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseGetChangeHistoryRequest(): GetChangeHistoryRequest {
+  return { name: "", view: ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED, sdlFormat: false, concise: false };
+}
+
+export const GetChangeHistoryRequest = {
+  encode(message: GetChangeHistoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
+      writer.uint32(16).int32(changeHistoryViewToNumber(message.view));
+    }
+    if (message.sdlFormat === true) {
+      writer.uint32(24).bool(message.sdlFormat);
+    }
+    if (message.concise === true) {
+      writer.uint32(32).bool(message.concise);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetChangeHistoryRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetChangeHistoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.view = changeHistoryViewFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.sdlFormat = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.concise = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetChangeHistoryRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      view: isSet(object.view)
+        ? changeHistoryViewFromJSON(object.view)
+        : ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED,
+      sdlFormat: isSet(object.sdlFormat) ? globalThis.Boolean(object.sdlFormat) : false,
+      concise: isSet(object.concise) ? globalThis.Boolean(object.concise) : false,
+    };
+  },
+
+  toJSON(message: GetChangeHistoryRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.view !== ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED) {
+      obj.view = changeHistoryViewToJSON(message.view);
+    }
+    if (message.sdlFormat === true) {
+      obj.sdlFormat = message.sdlFormat;
+    }
+    if (message.concise === true) {
+      obj.concise = message.concise;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetChangeHistoryRequest>): GetChangeHistoryRequest {
+    return GetChangeHistoryRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetChangeHistoryRequest>): GetChangeHistoryRequest {
+    const message = createBaseGetChangeHistoryRequest();
+    message.name = object.name ?? "";
+    message.view = object.view ?? ChangeHistoryView.CHANGE_HISTORY_VIEW_UNSPECIFIED;
+    message.sdlFormat = object.sdlFormat ?? false;
+    message.concise = object.concise ?? false;
+    return message;
+  },
+};
+
+function createBaseExportChangeHistoriesRequest(): ExportChangeHistoriesRequest {
+  return { parent: "", format: ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED };
+}
+
+export const ExportChangeHistoriesRequest = {
+  encode(message: ExportChangeHistoriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.format !== ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED) {
+      writer.uint32(16).int32(exportChangeHistoriesRequest_FormatToNumber(message.format));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportChangeHistoriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportChangeHistoriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.format = exportChangeHistoriesRequest_FormatFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportChangeHistoriesRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      format: isSet(object.format)
+        ? exportChangeHistoriesRequest_FormatFromJSON(object.format)
+        : ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: ExportChangeHistoriesRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.format !== ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED) {
+      obj.format = exportChangeHistoriesRequest_FormatToJSON(message.format);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExportChangeHistoriesRequest>): ExportChangeHistoriesRequest {
+    return ExportChangeHistoriesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExportChangeHistoriesRequest>): ExportChangeHistoriesRequest {
+    const message = createBaseExportChangeHistoriesRequest();
+    message.parent = object.parent ?? "";
+    message.format = object.format ?? ExportChangeHistoriesRequest_Format.FORMAT_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseExportChangeHistoriesResponse(): ExportChangeHistoriesResponse {
+  return { content: new Uint8Array(0) };
+}
+
+export const ExportChangeHistoriesResponse = {
+  encode(message: ExportChangeHistoriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.content.length !== 0) {
+      writer.uint32(10).bytes(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportChangeHistoriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportChangeHistoriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.content = reader.bytes();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportChangeHistoriesResponse {
+    return { content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0) };
+  },
+
+  toJSON(message: ExportChangeHistoriesResponse): unknown {
+    const obj: any = {};
+    if (message.content.length !== 0) {
+      obj.content = base64FromBytes(message.content);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExportChangeHistoriesResponse>): ExportChangeHistoriesResponse {
+    return ExportChangeHistoriesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExportChangeHistoriesResponse>): ExportChangeHistoriesResponse {
+    const message = createBaseExportChangeHistoriesResponse();
+    message.content = object.content ?? new Uint8Array(0);
+    return message;
+  },
+};
+
+function createBaseImportChangeHistoriesRequest(): ImportChangeHistoriesRequest {
+  return { parent: "", tool: "", changes: [] };
+}
+
+export const ImportChangeHistoriesRequest = {
+  encode(message: ImportChangeHistoriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.tool !== "") {
+      writer.uint32(18).string(message.tool);
+    }
+    for (const v of message.changes) {
+      ImportChangeHistoriesRequest_Change.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportChangeHistoriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportChangeHistoriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tool = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.changes.push(ImportChangeHistoriesRequest_Change.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportChangeHistoriesRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      tool: isSet(object.tool) ? globalThis.String(object.tool) : "",
+      changes: globalThis.Array.isArray(object?.changes)
+        ? object.changes.map((e: any) => ImportChangeHistoriesRequest_Change.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ImportChangeHistoriesRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.tool !== "") {
+      obj.tool = message.tool;
+    }
+    if (message.changes?.length) {
+      obj.changes = message.changes.map((e) => ImportChangeHistoriesRequest_Change.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportChangeHistoriesRequest>): ImportChangeHistoriesRequest {
+    return ImportChangeHistoriesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportChangeHistoriesRequest>): ImportChangeHistoriesRequest {
+    const message = createBaseImportChangeHistoriesRequest();
+    message.parent = object.parent ?? "";
+    message.tool = object.tool ?? "";
+    message.changes = object.changes?.map((e) => ImportChangeHistoriesRequest_Change.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseImportChangeHistoriesRequest_Change(): ImportChangeHistoriesRequest_Change {
+  return {
+    version: "",
+    description: "",
+    statement: "",
+    checksum: "",
+    appliedTime: undefined,
+    type: ChangeHistory_Type.TYPE_UNSPECIFIED,
+  };
+}
+
+export const ImportChangeHistoriesRequest_Change = {
+  encode(message: ImportChangeHistoriesRequest_Change, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    if (message.statement !== "") {
+      writer.uint32(26).string(message.statement);
+    }
+    if (message.checksum !== "") {
+      writer.uint32(34).string(message.checksum);
+    }
+    if (message.appliedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.appliedTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.type !== ChangeHistory_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(48).int32(changeHistory_TypeToNumber(message.type));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportChangeHistoriesRequest_Change {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportChangeHistoriesRequest_Change();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.checksum = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.appliedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.type = changeHistory_TypeFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportChangeHistoriesRequest_Change {
+    return {
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      checksum: isSet(object.checksum) ? globalThis.String(object.checksum) : "",
+      appliedTime: isSet(object.appliedTime) ? fromJsonTimestamp(object.appliedTime) : undefined,
+      type: isSet(object.type) ? changeHistory_TypeFromJSON(object.type) : ChangeHistory_Type.TYPE_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: ImportChangeHistoriesRequest_Change): unknown {
+    const obj: any = {};
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.checksum !== "") {
+      obj.checksum = message.checksum;
+    }
+    if (message.appliedTime !== undefined) {
+      obj.appliedTime = message.appliedTime.toISOString();
+    }
+    if (message.type !== ChangeHistory_Type.TYPE_UNSPECIFIED) {
+      obj.type = changeHistory_TypeToJSON(message.type);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportChangeHistoriesRequest_Change>): ImportChangeHistoriesRequest_Change {
+    return ImportChangeHistoriesRequest_Change.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportChangeHistoriesRequest_Change>): ImportChangeHistoriesRequest_Change {
+    const message = createBaseImportChangeHistoriesRequest_Change();
+    message.version = object.version ?? "";
+    message.description = object.description ?? "";
+    message.statement = object.statement ?? "";
+    message.checksum = object.checksum ?? "";
+    message.appliedTime = object.appliedTime ?? undefined;
+    message.type = object.type ?? ChangeHistory_Type.TYPE_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseImportChangeHistoriesResponse(): ImportChangeHistoriesResponse {
+  return { changeHistories: [] };
+}
+
+export const ImportChangeHistoriesResponse = {
+  encode(message: ImportChangeHistoriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.changeHistories) {
+      ChangeHistory.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportChangeHistoriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportChangeHistoriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.changeHistories.push(ChangeHistory.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportChangeHistoriesResponse {
+    return {
+      changeHistories: globalThis.Array.isArray(object?.changeHistories)
+        ? object.changeHistories.map((e: any) => ChangeHistory.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ImportChangeHistoriesResponse): unknown {
+    const obj: any = {};
+    if (message.changeHistories?.length) {
+      obj.changeHistories = message.changeHistories.map((e) => ChangeHistory.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportChangeHistoriesResponse>): ImportChangeHistoriesResponse {
+    return ImportChangeHistoriesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportChangeHistoriesResponse>): ImportChangeHistoriesResponse {
+    const message = createBaseImportChangeHistoriesResponse();
+    message.changeHistories = object.changeHistories?.map((e) => ChangeHistory.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGetBackupSettingRequest(): GetBackupSettingRequest {
+  return { name: "" };
+}
+
+export const GetBackupSettingRequest = {
+  encode(message: GetBackupSettingRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetBackupSettingRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetBackupSettingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetBackupSettingRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: GetBackupSettingRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetBackupSettingRequest>): GetBackupSettingRequest {
+    return GetBackupSettingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetBackupSettingRequest>): GetBackupSettingRequest {
+    const message = createBaseGetBackupSettingRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateBackupSettingRequest(): UpdateBackupSettingRequest {
+  return { backupSetting: undefined, updateMask: undefined };
+}
+
+export const UpdateBackupSettingRequest = {
+  encode(message: UpdateBackupSettingRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.backupSetting !== undefined) {
+      BackupSetting.encode(message.backupSetting, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateBackupSettingRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateBackupSettingRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.backupSetting = BackupSetting.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateBackupSettingRequest {
+    return {
+      backupSetting: isSet(object.backupSetting) ? BackupSetting.fromJSON(object.backupSetting) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateBackupSettingRequest): unknown {
+    const obj: any = {};
+    if (message.backupSetting !== undefined) {
+      obj.backupSetting = BackupSetting.toJSON(message.backupSetting);
+    }
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateBackupSettingRequest>): UpdateBackupSettingRequest {
+    return UpdateBackupSettingRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateBackupSettingRequest>): UpdateBackupSettingRequest {
+    const message = createBaseUpdateBackupSettingRequest();
+    message.backupSetting = (object.backupSetting !== undefined && object.backupSetting !== null)
+      ? BackupSetting.fromPartial(object.backupSetting)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseBackupSetting(): BackupSetting {
+  return {
+    name: "",
+    enabled: false,
+    hour: 0,
+    dayOfWeek: 0,
+    retention: undefined,
+    storage: undefined,
+    encryptionKey: "",
+    encrypted: false,
+    updateTime: undefined,
+  };
+}
+
+export const BackupSetting = {
+  encode(message: BackupSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.enabled === true) {
+      writer.uint32(16).bool(message.enabled);
+    }
+    if (message.hour !== 0) {
+      writer.uint32(24).int32(message.hour);
+    }
+    if (message.dayOfWeek !== 0) {
+      writer.uint32(32).int32(message.dayOfWeek);
+    }
+    if (message.retention !== undefined) {
+      Duration.encode(message.retention, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.storage !== undefined) {
+      BackupStorage.encode(message.storage, writer.uint32(50).fork()).ldelim();
+    }
+    if (message.encryptionKey !== "") {
+      writer.uint32(58).string(message.encryptionKey);
+    }
+    if (message.encrypted === true) {
+      writer.uint32(64).bool(message.encrypted);
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BackupSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackupSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.hour = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.dayOfWeek = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.retention = Duration.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.storage = BackupStorage.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.encryptionKey = reader.string();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.encrypted = reader.bool();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BackupSetting {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+      hour: isSet(object.hour) ? globalThis.Number(object.hour) : 0,
+      dayOfWeek: isSet(object.dayOfWeek) ? globalThis.Number(object.dayOfWeek) : 0,
+      retention: isSet(object.retention) ? Duration.fromJSON(object.retention) : undefined,
+      storage: isSet(object.storage) ? BackupStorage.fromJSON(object.storage) : undefined,
+      encryptionKey: isSet(object.encryptionKey) ? globalThis.String(object.encryptionKey) : "",
+      encrypted: isSet(object.encrypted) ? globalThis.Boolean(object.encrypted) : false,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
+
+  toJSON(message: BackupSetting): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
+    }
+    if (message.hour !== 0) {
+      obj.hour = Math.round(message.hour);
+    }
+    if (message.dayOfWeek !== 0) {
+      obj.dayOfWeek = Math.round(message.dayOfWeek);
+    }
+    if (message.retention !== undefined) {
+      obj.retention = Duration.toJSON(message.retention);
+    }
+    if (message.storage !== undefined) {
+      obj.storage = BackupStorage.toJSON(message.storage);
+    }
+    if (message.encryptionKey !== "") {
+      obj.encryptionKey = message.encryptionKey;
+    }
+    if (message.encrypted === true) {
+      obj.encrypted = message.encrypted;
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<BackupSetting>): BackupSetting {
+    return BackupSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BackupSetting>): BackupSetting {
+    const message = createBaseBackupSetting();
+    message.name = object.name ?? "";
+    message.enabled = object.enabled ?? false;
+    message.hour = object.hour ?? 0;
+    message.dayOfWeek = object.dayOfWeek ?? 0;
+    message.retention = (object.retention !== undefined && object.retention !== null)
+      ? Duration.fromPartial(object.retention)
+      : undefined;
+    message.storage = (object.storage !== undefined && object.storage !== null)
+      ? BackupStorage.fromPartial(object.storage)
+      : undefined;
+    message.encryptionKey = object.encryptionKey ?? "";
+    message.encrypted = object.encrypted ?? false;
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseBackupStorage(): BackupStorage {
+  return {
+    type: BackupStorage_Type.TYPE_UNSPECIFIED,
+    bucket: "",
+    prefix: "",
+    region: "",
+    endpoint: "",
+    accessKeyId: "",
+    secretAccessKey: "",
+  };
+}
+
+export const BackupStorage = {
+  encode(message: BackupStorage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== BackupStorage_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(backupStorage_TypeToNumber(message.type));
+    }
+    if (message.bucket !== "") {
+      writer.uint32(18).string(message.bucket);
+    }
+    if (message.prefix !== "") {
+      writer.uint32(26).string(message.prefix);
+    }
+    if (message.region !== "") {
+      writer.uint32(34).string(message.region);
+    }
+    if (message.endpoint !== "") {
+      writer.uint32(42).string(message.endpoint);
+    }
+    if (message.accessKeyId !== "") {
+      writer.uint32(50).string(message.accessKeyId);
+    }
+    if (message.secretAccessKey !== "") {
+      writer.uint32(58).string(message.secretAccessKey);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BackupStorage {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackupStorage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = backupStorage_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.bucket = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.prefix = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.region = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.endpoint = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.accessKeyId = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.secretAccessKey = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BackupStorage {
+    return {
+      type: isSet(object.type) ? backupStorage_TypeFromJSON(object.type) : BackupStorage_Type.TYPE_UNSPECIFIED,
+      bucket: isSet(object.bucket) ? globalThis.String(object.bucket) : "",
+      prefix: isSet(object.prefix) ? globalThis.String(object.prefix) : "",
+      region: isSet(object.region) ? globalThis.String(object.region) : "",
+      endpoint: isSet(object.endpoint) ? globalThis.String(object.endpoint) : "",
+      accessKeyId: isSet(object.accessKeyId) ? globalThis.String(object.accessKeyId) : "",
+      secretAccessKey: isSet(object.secretAccessKey) ? globalThis.String(object.secretAccessKey) : "",
+    };
+  },
+
+  toJSON(message: BackupStorage): unknown {
+    const obj: any = {};
+    if (message.type !== BackupStorage_Type.TYPE_UNSPECIFIED) {
+      obj.type = backupStorage_TypeToJSON(message.type);
+    }
+    if (message.bucket !== "") {
+      obj.bucket = message.bucket;
+    }
+    if (message.prefix !== "") {
+      obj.prefix = message.prefix;
+    }
+    if (message.region !== "") {
+      obj.region = message.region;
+    }
+    if (message.endpoint !== "") {
+      obj.endpoint = message.endpoint;
+    }
+    if (message.accessKeyId !== "") {
+      obj.accessKeyId = message.accessKeyId;
+    }
+    if (message.secretAccessKey !== "") {
+      obj.secretAccessKey = message.secretAccessKey;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<BackupStorage>): BackupStorage {
+    return BackupStorage.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BackupStorage>): BackupStorage {
+    const message = createBaseBackupStorage();
+    message.type = object.type ?? BackupStorage_Type.TYPE_UNSPECIFIED;
+    message.bucket = object.bucket ?? "";
+    message.prefix = object.prefix ?? "";
+    message.region = object.region ?? "";
+    message.endpoint = object.endpoint ?? "";
+    message.accessKeyId = object.accessKeyId ?? "";
+    message.secretAccessKey = object.secretAccessKey ?? "";
+    return message;
+  },
+};
+
+function createBaseListBackupRunsRequest(): ListBackupRunsRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const ListBackupRunsRequest = {
+  encode(message: ListBackupRunsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListBackupRunsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListBackupRunsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListBackupRunsRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListBackupRunsRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListBackupRunsRequest>): ListBackupRunsRequest {
+    return ListBackupRunsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListBackupRunsRequest>): ListBackupRunsRequest {
+    const message = createBaseListBackupRunsRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseListBackupRunsResponse(): ListBackupRunsResponse {
+  return { backupRuns: [], nextPageToken: "" };
+}
+
+export const ListBackupRunsResponse = {
+  encode(message: ListBackupRunsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.backupRuns) {
+      BackupRun.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListBackupRunsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListBackupRunsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.backupRuns.push(BackupRun.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListBackupRunsResponse {
+    return {
+      backupRuns: globalThis.Array.isArray(object?.backupRuns)
+        ? object.backupRuns.map((e: any) => BackupRun.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListBackupRunsResponse): unknown {
+    const obj: any = {};
+    if (message.backupRuns?.length) {
+      obj.backupRuns = message.backupRuns.map((e) => BackupRun.toJSON(e));
+    }
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListBackupRunsResponse>): ListBackupRunsResponse {
+    return ListBackupRunsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListBackupRunsResponse>): ListBackupRunsResponse {
+    const message = createBaseListBackupRunsResponse();
+    message.backupRuns = object.backupRuns?.map((e) => BackupRun.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseCreateBackupRunRequest(): CreateBackupRunRequest {
+  return { parent: "" };
+}
+
+export const CreateBackupRunRequest = {
+  encode(message: CreateBackupRunRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateBackupRunRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateBackupRunRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateBackupRunRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: CreateBackupRunRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreateBackupRunRequest>): CreateBackupRunRequest {
+    return CreateBackupRunRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateBackupRunRequest>): CreateBackupRunRequest {
+    const message = createBaseCreateBackupRunRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseBackupRun(): BackupRun {
+  return {
+    name: "",
+    status: BackupRun_Status.STATUS_UNSPECIFIED,
+    creator: "",
+    createTime: undefined,
+    startTime: undefined,
+    finishTime: undefined,
+    uri: "",
+    size: Long.ZERO,
+    error: "",
+    encrypted: false,
+    manual: false,
+  };
+}
+
+export const BackupRun = {
+  encode(message: BackupRun, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.status !== BackupRun_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(16).int32(backupRun_StatusToNumber(message.status));
+    }
+    if (message.creator !== "") {
+      writer.uint32(26).string(message.creator);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.finishTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.finishTime), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.uri !== "") {
+      writer.uint32(58).string(message.uri);
+    }
+    if (!message.size.isZero()) {
+      writer.uint32(64).int64(message.size);
+    }
+    if (message.error !== "") {
+      writer.uint32(74).string(message.error);
+    }
+    if (message.encrypted === true) {
+      writer.uint32(80).bool(message.encrypted);
+    }
+    if (message.manual === true) {
+      writer.uint32(88).bool(message.manual);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): BackupRun {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackupRun();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = backupRun_StatusFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.finishTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.uri = reader.string();
+          continue;
+        case 8:
+          if (tag !== 64) {
+            break;
+          }
+
+          message.size = reader.int64() as Long;
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.encrypted = reader.bool();
+          continue;
+        case 11:
+          if (tag !== 88) {
+            break;
+          }
+
+          message.manual = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): BackupRun {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      status: isSet(object.status) ? backupRun_StatusFromJSON(object.status) : BackupRun_Status.STATUS_UNSPECIFIED,
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      finishTime: isSet(object.finishTime) ? fromJsonTimestamp(object.finishTime) : undefined,
+      uri: isSet(object.uri) ? globalThis.String(object.uri) : "",
+      size: isSet(object.size) ? Long.fromValue(object.size) : Long.ZERO,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+      encrypted: isSet(object.encrypted) ? globalThis.Boolean(object.encrypted) : false,
+      manual: isSet(object.manual) ? globalThis.Boolean(object.manual) : false,
+    };
+  },
+
+  toJSON(message: BackupRun): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.status !== BackupRun_Status.STATUS_UNSPECIFIED) {
+      obj.status = backupRun_StatusToJSON(message.status);
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
+    }
+    if (message.finishTime !== undefined) {
+      obj.finishTime = message.finishTime.toISOString();
+    }
+    if (message.uri !== "") {
+      obj.uri = message.uri;
+    }
+    if (!message.size.isZero()) {
+      obj.size = (message.size || Long.ZERO).toString();
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    if (message.encrypted === true) {
+      obj.encrypted = message.encrypted;
+    }
+    if (message.manual === true) {
+      obj.manual = message.manual;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<BackupRun>): BackupRun {
+    return BackupRun.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<BackupRun>): BackupRun {
+    const message = createBaseBackupRun();
+    message.name = object.name ?? "";
+    message.status = object.status ?? BackupRun_Status.STATUS_UNSPECIFIED;
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.startTime = object.startTime ?? undefined;
+    message.finishTime = object.finishTime ?? undefined;
+    message.uri = object.uri ?? "";
+    message.size = (object.size !== undefined && object.size !== null) ? Long.fromValue(object.size) : Long.ZERO;
+    message.error = object.error ?? "";
+    message.encrypted = object.encrypted ?? false;
+    message.manual = object.manual ?? false;
+    return message;
+  },
+};
+
 export type DatabaseServiceDefinition = typeof DatabaseServiceDefinition;
 export const DatabaseServiceDefinition = {
   name: "DatabaseService",
@@ -9483,18 +13696,1041 @@ export const DatabaseServiceDefinition = {
       name: "GetDatabase",
       requestType: GetDatabaseRequest,
       requestStream: false,
-      responseType: Database,
+      responseType: Database,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              36,
+              18,
+              34,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    listInstanceDatabases: {
+      name: "ListInstanceDatabases",
+      requestType: ListInstanceDatabasesRequest,
+      requestStream: false,
+      responseType: ListInstanceDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([16, 98, 98, 46, 105, 110, 115, 116, 97, 110, 99, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              36,
+              18,
+              34,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    listDatabases: {
+      name: "ListDatabases",
+      requestType: ListDatabasesRequest,
+      requestStream: false,
+      responseType: ListDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([17, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 108, 105, 115, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              74,
+              90,
+              37,
+              18,
+              35,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              119,
+              111,
+              114,
+              107,
+              115,
+              112,
+              97,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              18,
+              33,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * Deprecated. This will be removed in the next release.
+     * Search for databases that the caller has the bb.databases.get permission on, and also satisfy the specified query.
+     */
+    searchDatabases: {
+      name: "SearchDatabases",
+      requestType: SearchDatabasesRequest,
+      requestStream: false,
+      responseType: SearchDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              22,
+              18,
+              20,
+              47,
+              118,
+              49,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              58,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+            ]),
+          ],
+        },
+      },
+    },
+    updateDatabase: {
+      name: "UpdateDatabase",
+      requestType: UpdateDatabaseRequest,
+      requestStream: false,
+      responseType: Database,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              20,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              55,
+              58,
+              8,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              50,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    batchUpdateDatabases: {
+      name: "BatchUpdateDatabases",
+      requestType: BatchUpdateDatabasesRequest,
+      requestStream: false,
+      responseType: BatchUpdateDatabasesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              51,
+              58,
+              1,
+              42,
+              34,
+              46,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              58,
+              98,
+              97,
+              116,
+              99,
+              104,
+              85,
+              112,
+              100,
+              97,
+              116,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    syncDatabase: {
+      name: "SyncDatabase",
+      requestType: SyncDatabaseRequest,
+      requestStream: false,
+      responseType: SyncDatabaseResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([17, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 115, 121, 110, 99])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              44,
+              58,
+              1,
+              42,
+              34,
+              39,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              115,
+              121,
+              110,
+              99,
+            ]),
+          ],
+        },
+      },
+    },
+    getDatabaseMetadata: {
+      name: "GetDatabaseMetadata",
+      requestType: GetDatabaseMetadataRequest,
+      requestStream: false,
+      responseType: DatabaseMetadata,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              46,
+              103,
+              101,
+              116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              45,
+              18,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              47,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    updateDatabaseMetadata: {
+      name: "UpdateDatabaseMetadata",
+      requestType: UpdateDatabaseMetadataRequest,
+      requestStream: false,
+      responseType: DatabaseMetadata,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              82,
+              58,
+              17,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              95,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              50,
+              61,
+              47,
+              118,
+              49,
+              47,
+              123,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              95,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              47,
+              109,
+              101,
+              116,
+              97,
+              100,
+              97,
+              116,
+              97,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    getDatabaseSchema: {
+      name: "GetDatabaseSchema",
+      requestType: GetDatabaseSchemaRequest,
+      requestStream: false,
+      responseType: DatabaseSchema,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              46,
+              103,
+              101,
+              116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              43,
+              18,
+              41,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              47,
+              115,
+              99,
+              104,
+              101,
+              109,
+              97,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    diffSchema: {
+      name: "DiffSchema",
+      requestType: DiffSchemaRequest,
+      requestStream: false,
+      responseType: DiffSchemaResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              120,
+              58,
+              1,
+              42,
+              90,
+              68,
+              58,
+              1,
+              42,
+              34,
+              63,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              47,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              72,
+              105,
+              115,
+              116,
+              111,
+              114,
+              105,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              100,
+              105,
+              102,
+              102,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+              34,
+              45,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              100,
+              105,
+              102,
+              102,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
+        },
+      },
+    },
+    /** GetDatabaseSchemaAsOf returns the database schema from the latest schema snapshot taken at or before the given time. */
+    getDatabaseSchemaAsOf: {
+      name: "GetDatabaseSchemaAsOf",
+      requestType: GetDatabaseSchemaAsOfRequest,
+      requestStream: false,
+      responseType: DatabaseSchema,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([4, 110, 97, 109, 101])],
-          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          8410: [new Uint8Array([9, 110, 97, 109, 101, 44, 116, 105, 109, 101])],
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              46,
+              103,
+              101,
+              116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              36,
+              48,
               18,
+              46,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              47,
+              115,
+              99,
+              104,
+              101,
+              109,
+              97,
+              125,
+              58,
+              97,
+              115,
+              79,
+              102,
+            ]),
+          ],
+        },
+      },
+    },
+    /** DiffSchemaSnapshots diffs the database schemas at two points in time. */
+    diffSchemaSnapshots: {
+      name: "DiffSchemaSnapshots",
+      requestType: DiffSchemaSnapshotsRequest,
+      requestStream: false,
+      responseType: DiffSchemaResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              46,
+              103,
+              101,
+              116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              59,
+              58,
+              1,
+              42,
               34,
+              54,
               47,
               118,
               49,
@@ -9517,39 +14753,165 @@ export const DatabaseServiceDefinition = {
               47,
               42,
               47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              100,
+              105,
+              102,
+              102,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+              83,
+              110,
+              97,
+              112,
+              115,
+              104,
+              111,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    listSlowQueries: {
+      name: "ListSlowQueries",
+      requestType: ListSlowQueriesRequest,
+      requestStream: false,
+      responseType: ListSlowQueriesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [
+            new Uint8Array([
+              19,
+              98,
+              98,
+              46,
+              115,
+              108,
+              111,
+              119,
+              81,
+              117,
+              101,
+              114,
+              105,
+              101,
+              115,
+              46,
+              108,
+              105,
+              115,
+              116,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              37,
+              18,
+              35,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
               115,
+              108,
+              111,
+              119,
+              81,
+              117,
+              101,
+              114,
+              105,
               101,
               115,
-              47,
-              42,
-              125,
             ]),
           ],
         },
       },
     },
-    listInstanceDatabases: {
-      name: "ListInstanceDatabases",
-      requestType: ListInstanceDatabasesRequest,
+    listSecrets: {
+      name: "ListSecrets",
+      requestType: ListSecretsRequest,
       requestStream: false,
-      responseType: ListInstanceDatabasesResponse,
+      responseType: ListSecretsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([0])],
-          800010: [new Uint8Array([16, 98, 98, 46, 105, 110, 115, 116, 97, 110, 99, 101, 115, 46, 103, 101, 116])],
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [
+            new Uint8Array([
+              23,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              83,
+              101,
+              99,
+              114,
+              101,
+              116,
+              115,
+              46,
+              108,
+              105,
+              115,
+              116,
+            ]),
+          ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              36,
+              46,
               18,
-              34,
+              44,
               47,
               118,
               49,
@@ -9573,7 +14935,6 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
-              125,
               47,
               100,
               97,
@@ -9584,54 +14945,103 @@ export const DatabaseServiceDefinition = {
               115,
               101,
               115,
+              47,
+              42,
+              125,
+              47,
+              115,
+              101,
+              99,
+              114,
+              101,
+              116,
+              115,
             ]),
           ],
         },
       },
     },
-    listDatabases: {
-      name: "ListDatabases",
-      requestType: ListDatabasesRequest,
+    updateSecret: {
+      name: "UpdateSecret",
+      requestType: UpdateSecretRequest,
       requestStream: false,
-      responseType: ListDatabasesResponse,
+      responseType: Secret,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([0])],
-          800010: [new Uint8Array([17, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 108, 105, 115, 116])],
+          800010: [
+            new Uint8Array([
+              25,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              83,
+              101,
+              99,
+              114,
+              101,
+              116,
+              115,
+              46,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+            ]),
+          ],
           800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              74,
-              90,
-              37,
-              18,
-              35,
+              61,
+              58,
+              6,
+              115,
+              101,
+              99,
+              114,
+              101,
+              116,
+              50,
+              51,
               47,
               118,
               49,
               47,
               123,
-              112,
-              97,
+              115,
+              101,
+              99,
               114,
               101,
-              110,
               116,
+              46,
+              110,
+              97,
+              109,
+              101,
               61,
-              119,
-              111,
-              114,
-              107,
+              105,
+              110,
               115,
-              112,
+              116,
               97,
+              110,
               99,
               101,
               115,
               47,
               42,
-              125,
               47,
               100,
               97,
@@ -9642,70 +15052,38 @@ export const DatabaseServiceDefinition = {
               115,
               101,
               115,
-              18,
-              33,
               47,
-              118,
-              49,
+              42,
               47,
-              123,
-              112,
-              97,
-              114,
+              115,
               101,
-              110,
-              116,
-              61,
-              112,
+              99,
               114,
-              111,
-              106,
               101,
-              99,
               116,
               115,
               47,
               42,
               125,
-              47,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              115,
             ]),
           ],
         },
       },
     },
-    /**
-     * Deprecated. This will be removed in the next release.
-     * Search for databases that the caller has the bb.databases.get permission on, and also satisfy the specified query.
-     */
-    searchDatabases: {
-      name: "SearchDatabases",
-      requestType: SearchDatabasesRequest,
+    deleteSecret: {
+      name: "DeleteSecret",
+      requestType: DeleteSecretRequest,
       requestStream: false,
-      responseType: SearchDatabasesResponse,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([0])],
-          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
-          800016: [new Uint8Array([2])],
-          578365826: [
+          800010: [
             new Uint8Array([
-              22,
-              18,
-              20,
-              47,
-              118,
-              49,
-              47,
+              25,
+              98,
+              98,
+              46,
               100,
               97,
               116,
@@ -9714,86 +15092,34 @@ export const DatabaseServiceDefinition = {
               97,
               115,
               101,
-              115,
-              58,
-              115,
+              83,
               101,
-              97,
-              114,
               99,
-              104,
-            ]),
-          ],
-        },
-      },
-    },
-    updateDatabase: {
-      name: "UpdateDatabase",
-      requestType: UpdateDatabaseRequest,
-      requestStream: false,
-      responseType: Database,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [
-            new Uint8Array([
-              20,
-              100,
-              97,
+              114,
+              101,
               116,
-              97,
-              98,
-              97,
               115,
-              101,
-              44,
-              117,
-              112,
+              46,
               100,
-              97,
+              101,
+              108,
+              101,
               116,
               101,
-              95,
-              109,
-              97,
-              115,
-              107,
             ]),
           ],
-          800010: [
-            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
-          ],
           800016: [new Uint8Array([1])],
           800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              55,
-              58,
-              8,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              50,
-              43,
+              46,
+              42,
+              44,
               47,
               118,
               49,
               47,
               123,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              46,
               110,
               97,
               109,
@@ -9822,58 +15148,37 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
+              47,
+              115,
+              101,
+              99,
+              114,
+              101,
+              116,
+              115,
+              47,
+              42,
               125,
             ]),
           ],
         },
       },
     },
-    batchUpdateDatabases: {
-      name: "BatchUpdateDatabases",
-      requestType: BatchUpdateDatabasesRequest,
+    adviseIndex: {
+      name: "AdviseIndex",
+      requestType: AdviseIndexRequest,
       requestStream: false,
-      responseType: BatchUpdateDatabasesResponse,
+      responseType: AdviseIndexResponse,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
           800010: [
-            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
-          ],
-          800016: [new Uint8Array([1])],
-          800024: [new Uint8Array([1])],
-          578365826: [
             new Uint8Array([
-              51,
-              58,
-              1,
-              42,
-              34,
+              24,
+              98,
+              98,
               46,
-              47,
-              118,
-              49,
-              47,
-              123,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              61,
-              105,
-              110,
-              115,
-              116,
-              97,
-              110,
-              99,
-              101,
-              115,
-              47,
-              42,
-              125,
-              47,
               100,
               97,
               116,
@@ -9883,50 +15188,37 @@ export const DatabaseServiceDefinition = {
               115,
               101,
               115,
-              58,
-              98,
+              46,
               97,
-              116,
-              99,
-              104,
-              85,
-              112,
               100,
-              97,
-              116,
+              118,
+              105,
+              115,
+              101,
+              73,
+              110,
+              100,
               101,
+              120,
             ]),
           ],
-        },
-      },
-    },
-    syncDatabase: {
-      name: "SyncDatabase",
-      requestType: SyncDatabaseRequest,
-      requestStream: false,
-      responseType: SyncDatabaseResponse,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          800010: [new Uint8Array([17, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 115, 121, 110, 99])],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              44,
-              58,
-              1,
-              42,
+              50,
               34,
-              39,
+              48,
               47,
               118,
               49,
               47,
               123,
-              110,
+              112,
               97,
-              109,
+              114,
               101,
+              110,
+              116,
               61,
               105,
               110,
@@ -9953,65 +15245,76 @@ export const DatabaseServiceDefinition = {
               42,
               125,
               58,
+              97,
+              100,
+              118,
+              105,
               115,
-              121,
+              101,
+              73,
               110,
-              99,
+              100,
+              101,
+              120,
             ]),
           ],
         },
       },
     },
-    getDatabaseMetadata: {
-      name: "GetDatabaseMetadata",
-      requestType: GetDatabaseMetadataRequest,
+    listChangeHistories: {
+      name: "ListChangeHistories",
+      requestType: ListChangeHistoriesRequest,
       requestStream: false,
-      responseType: DatabaseMetadata,
+      responseType: ListChangeHistoriesResponse,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
           800010: [
             new Uint8Array([
-              22,
+              23,
               98,
               98,
               46,
-              100,
-              97,
-              116,
-              97,
-              98,
+              99,
+              104,
               97,
+              110,
+              103,
+              101,
+              72,
+              105,
               115,
+              116,
+              111,
+              114,
+              105,
               101,
               115,
               46,
-              103,
-              101,
+              108,
+              105,
+              115,
               116,
-              83,
-              99,
-              104,
-              101,
-              109,
-              97,
             ]),
           ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              45,
+              54,
               18,
-              43,
+              52,
               47,
               118,
               49,
               47,
               123,
-              110,
+              112,
               97,
-              109,
+              114,
               101,
+              110,
+              116,
               61,
               105,
               110,
@@ -10036,81 +15339,75 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
+              125,
               47,
-              109,
-              101,
-              116,
-              97,
-              100,
+              99,
+              104,
               97,
+              110,
+              103,
+              101,
+              72,
+              105,
+              115,
               116,
-              97,
-              125,
+              111,
+              114,
+              105,
+              101,
+              115,
             ]),
           ],
         },
       },
     },
-    updateDatabaseMetadata: {
-      name: "UpdateDatabaseMetadata",
-      requestType: UpdateDatabaseMetadataRequest,
+    getChangeHistory: {
+      name: "GetChangeHistory",
+      requestType: GetChangeHistoryRequest,
       requestStream: false,
-      responseType: DatabaseMetadata,
+      responseType: ChangeHistory,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
           800010: [
-            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
-          ],
-          800016: [new Uint8Array([1])],
-          800024: [new Uint8Array([1])],
-          578365826: [
             new Uint8Array([
-              82,
-              58,
-              17,
-              100,
-              97,
-              116,
-              97,
+              22,
+              98,
               98,
+              46,
+              99,
+              104,
               97,
+              110,
+              103,
+              101,
+              72,
+              105,
               115,
+              116,
+              111,
+              114,
+              105,
               101,
-              95,
-              109,
+              115,
+              46,
+              103,
               101,
               116,
-              97,
-              100,
-              97,
-              116,
-              97,
-              50,
-              61,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              54,
+              18,
+              52,
               47,
               118,
               49,
               47,
               123,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              95,
-              109,
-              101,
-              116,
-              97,
-              100,
-              97,
-              116,
-              97,
-              46,
               110,
               97,
               109,
@@ -10140,70 +15437,84 @@ export const DatabaseServiceDefinition = {
               47,
               42,
               47,
-              109,
-              101,
-              116,
-              97,
-              100,
+              99,
+              104,
               97,
+              110,
+              103,
+              101,
+              72,
+              105,
+              115,
               116,
-              97,
+              111,
+              114,
+              105,
+              101,
+              115,
+              47,
+              42,
               125,
             ]),
           ],
         },
       },
     },
-    getDatabaseSchema: {
-      name: "GetDatabaseSchema",
-      requestType: GetDatabaseSchemaRequest,
+    /** ExportChangeHistories exports the applied migration history of the database as a changelog. */
+    exportChangeHistories: {
+      name: "ExportChangeHistories",
+      requestType: ExportChangeHistoriesRequest,
       requestStream: false,
-      responseType: DatabaseSchema,
+      responseType: ExportChangeHistoriesResponse,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
           800010: [
             new Uint8Array([
-              22,
+              23,
               98,
               98,
               46,
-              100,
-              97,
-              116,
-              97,
-              98,
+              99,
+              104,
               97,
+              110,
+              103,
+              101,
+              72,
+              105,
               115,
+              116,
+              111,
+              114,
+              105,
               101,
               115,
               46,
-              103,
-              101,
+              108,
+              105,
+              115,
               116,
-              83,
-              99,
-              104,
-              101,
-              109,
-              97,
             ]),
           ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              43,
+              61,
               18,
-              41,
+              59,
               47,
               118,
               49,
               47,
               123,
-              110,
+              112,
               97,
-              109,
+              114,
               101,
+              110,
+              116,
               61,
               105,
               110,
@@ -10228,51 +15539,72 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
+              125,
               47,
-              115,
               99,
               104,
-              101,
-              109,
               97,
-              125,
+              110,
+              103,
+              101,
+              72,
+              105,
+              115,
+              116,
+              111,
+              114,
+              105,
+              101,
+              115,
+              58,
+              101,
+              120,
+              112,
+              111,
+              114,
+              116,
             ]),
           ],
         },
       },
     },
-    diffSchema: {
-      name: "DiffSchema",
-      requestType: DiffSchemaRequest,
+    /**
+     * ImportChangeHistories seeds the migration history of a database which is already managed by
+     * an external migration tool, e.g. Flyway or Liquibase. The checksums of the tool are preserved.
+     */
+    importChangeHistories: {
+      name: "ImportChangeHistories",
+      requestType: ImportChangeHistoriesRequest,
       requestStream: false,
-      responseType: DiffSchemaResponse,
+      responseType: ImportChangeHistoriesResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
           800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              120,
-              58,
-              1,
-              42,
-              90,
-              68,
+              64,
               58,
               1,
               42,
               34,
-              63,
+              59,
               47,
               118,
               49,
               47,
               123,
-              110,
+              112,
               97,
-              109,
+              114,
               101,
+              110,
+              116,
               61,
               105,
               110,
@@ -10297,6 +15629,7 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
+              125,
               47,
               99,
               104,
@@ -10313,22 +15646,43 @@ export const DatabaseServiceDefinition = {
               105,
               101,
               115,
-              47,
-              42,
-              125,
               58,
-              100,
               105,
-              102,
-              102,
-              83,
-              99,
-              104,
-              101,
               109,
-              97,
+              112,
+              111,
+              114,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ImportColumnClassifications imports the column classifications from an external data catalog,
+     * e.g. DataHub or Collibra, so that the masking policies follow the classifications of the governance tool.
+     */
+    importColumnClassifications: {
+      name: "ImportColumnClassifications",
+      requestType: ImportColumnClassificationsRequest,
+      requestStream: false,
+      responseType: ImportColumnClassificationsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              61,
+              58,
+              1,
+              42,
               34,
-              45,
+              56,
               47,
               118,
               49,
@@ -10364,112 +15718,149 @@ export const DatabaseServiceDefinition = {
               42,
               125,
               58,
-              100,
+              105,
+              109,
+              112,
+              111,
+              114,
+              116,
+              67,
+              108,
+              97,
+              115,
+              115,
               105,
               102,
-              102,
-              83,
+              105,
               99,
-              104,
-              101,
-              109,
               97,
+              116,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
         },
       },
     },
-    listSlowQueries: {
-      name: "ListSlowQueries",
-      requestType: ListSlowQueriesRequest,
+    /**
+     * ExportSchemaDiagram exports the ER diagram of the database or a schema as a graph of tables, columns and foreign keys,
+     * and optionally as PlantUML or Mermaid source.
+     */
+    exportSchemaDiagram: {
+      name: "ExportSchemaDiagram",
+      requestType: ExportSchemaDiagramRequest,
       requestStream: false,
-      responseType: ListSlowQueriesResponse,
+      responseType: SchemaDiagram,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
           800010: [
             new Uint8Array([
-              19,
+              22,
               98,
               98,
               46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
               115,
-              108,
-              111,
-              119,
-              81,
-              117,
-              101,
-              114,
-              105,
               101,
               115,
               46,
-              108,
-              105,
-              115,
+              103,
+              101,
               116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
             ]),
           ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              37,
+              56,
               18,
-              35,
+              54,
               47,
               118,
               49,
               47,
               123,
-              112,
+              110,
               97,
-              114,
+              109,
               101,
+              61,
+              105,
               110,
+              115,
               116,
-              61,
-              112,
-              114,
-              111,
-              106,
-              101,
+              97,
+              110,
               99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
               116,
+              97,
+              98,
+              97,
+              115,
+              101,
               115,
               47,
               42,
               125,
-              47,
-              115,
-              108,
-              111,
-              119,
-              81,
-              117,
+              58,
               101,
+              120,
+              112,
+              111,
               114,
-              105,
+              116,
+              83,
+              99,
+              104,
               101,
-              115,
+              109,
+              97,
+              68,
+              105,
+              97,
+              103,
+              114,
+              97,
+              109,
             ]),
           ],
         },
       },
     },
-    listSecrets: {
-      name: "ListSecrets",
-      requestType: ListSecretsRequest,
+    /** ListClassificationSuggestions lists the sensitive data classifications suggested by the PII detection of the schema sync. */
+    listClassificationSuggestions: {
+      name: "ListClassificationSuggestions",
+      requestType: ListClassificationSuggestionsRequest,
       requestStream: false,
-      responseType: ListSecretsResponse,
+      responseType: ListClassificationSuggestionsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
           800010: [
             new Uint8Array([
-              23,
+              22,
               98,
               98,
               46,
@@ -10481,26 +15872,25 @@ export const DatabaseServiceDefinition = {
               97,
               115,
               101,
-              83,
-              101,
-              99,
-              114,
-              101,
-              116,
               115,
               46,
-              108,
-              105,
-              115,
+              103,
+              101,
               116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
             ]),
           ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              46,
+              64,
               18,
-              44,
+              62,
               47,
               118,
               49,
@@ -10538,83 +15928,63 @@ export const DatabaseServiceDefinition = {
               42,
               125,
               47,
+              99,
+              108,
+              97,
               115,
-              101,
+              115,
+              105,
+              102,
+              105,
               99,
-              114,
+              97,
+              116,
+              105,
+              111,
+              110,
+              83,
+              117,
+              103,
+              103,
               101,
+              115,
               116,
+              105,
+              111,
+              110,
               115,
             ]),
           ],
         },
       },
     },
-    updateSecret: {
-      name: "UpdateSecret",
-      requestType: UpdateSecretRequest,
+    /** AcceptClassificationSuggestion masks the suggested column in the masking policy of the database. */
+    acceptClassificationSuggestion: {
+      name: "AcceptClassificationSuggestion",
+      requestType: AcceptClassificationSuggestionRequest,
       requestStream: false,
-      responseType: Secret,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
           800010: [
-            new Uint8Array([
-              25,
-              98,
-              98,
-              46,
-              100,
-              97,
-              116,
-              97,
-              98,
-              97,
-              115,
-              101,
-              83,
-              101,
-              99,
-              114,
-              101,
-              116,
-              115,
-              46,
-              117,
-              112,
-              100,
-              97,
-              116,
-              101,
-            ]),
+            new Uint8Array([18, 98, 98, 46, 112, 111, 108, 105, 99, 105, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
           ],
           800016: [new Uint8Array([1])],
           800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              61,
+              74,
               58,
-              6,
-              115,
-              101,
-              99,
-              114,
-              101,
-              116,
-              50,
-              51,
+              1,
+              42,
+              34,
+              69,
               47,
               118,
               49,
               47,
               123,
-              115,
-              101,
-              99,
-              114,
-              101,
-              116,
-              46,
               110,
               97,
               109,
@@ -10644,32 +16014,58 @@ export const DatabaseServiceDefinition = {
               47,
               42,
               47,
+              99,
+              108,
+              97,
               115,
-              101,
+              115,
+              105,
+              102,
+              105,
               99,
-              114,
+              97,
+              116,
+              105,
+              111,
+              110,
+              83,
+              117,
+              103,
+              103,
               101,
+              115,
               116,
+              105,
+              111,
+              110,
               115,
               47,
               42,
               125,
+              58,
+              97,
+              99,
+              99,
+              101,
+              112,
+              116,
             ]),
           ],
         },
       },
     },
-    deleteSecret: {
-      name: "DeleteSecret",
-      requestType: DeleteSecretRequest,
+    /** ExportColumnClassifications exports the column classifications to write them back to the external data catalog. */
+    exportColumnClassifications: {
+      name: "ExportColumnClassifications",
+      requestType: ExportColumnClassificationsRequest,
       requestStream: false,
-      responseType: Empty,
+      responseType: ExportColumnClassificationsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
           800010: [
             new Uint8Array([
-              25,
+              22,
               98,
               98,
               46,
@@ -10681,29 +16077,102 @@ export const DatabaseServiceDefinition = {
               97,
               115,
               101,
-              83,
+              115,
+              46,
+              103,
               101,
+              116,
+              83,
               99,
-              114,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              58,
+              18,
+              56,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
               101,
+              61,
+              105,
+              110,
+              115,
               116,
+              97,
+              110,
+              99,
+              101,
               115,
-              46,
+              47,
+              42,
+              47,
               100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
               101,
-              108,
+              115,
+              47,
+              42,
+              125,
+              58,
               101,
+              120,
+              112,
+              111,
+              114,
               116,
-              101,
+              67,
+              108,
+              97,
+              115,
+              115,
+              105,
+              102,
+              105,
+              99,
+              97,
+              116,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
+        },
+      },
+    },
+    getBackupSetting: {
+      name: "GetBackupSetting",
+      requestType: GetBackupSettingRequest,
+      requestStream: false,
+      responseType: BackupSetting,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
           800016: [new Uint8Array([1])],
-          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              46,
-              42,
-              44,
+              50,
+              18,
+              48,
               47,
               118,
               49,
@@ -10738,76 +16207,115 @@ export const DatabaseServiceDefinition = {
               47,
               42,
               47,
-              115,
-              101,
+              98,
+              97,
               99,
-              114,
+              107,
+              117,
+              112,
+              83,
               101,
               116,
-              115,
-              47,
-              42,
+              116,
+              105,
+              110,
+              103,
               125,
             ]),
           ],
         },
       },
     },
-    adviseIndex: {
-      name: "AdviseIndex",
-      requestType: AdviseIndexRequest,
+    /** UpdateBackupSetting updates the scheduled logical backup of the database. */
+    updateBackupSetting: {
+      name: "UpdateBackupSetting",
+      requestType: UpdateBackupSettingRequest,
       requestStream: false,
-      responseType: AdviseIndexResponse,
+      responseType: BackupSetting,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
-          800010: [
+          8410: [
             new Uint8Array([
-              24,
-              98,
-              98,
-              46,
-              100,
-              97,
-              116,
-              97,
+              26,
               98,
               97,
+              99,
+              107,
+              117,
+              112,
+              95,
               115,
               101,
-              115,
-              46,
-              97,
-              100,
-              118,
+              116,
+              116,
               105,
-              115,
-              101,
-              73,
               110,
+              103,
+              44,
+              117,
+              112,
               100,
+              97,
+              116,
               101,
-              120,
+              95,
+              109,
+              97,
+              115,
+              107,
             ]),
           ],
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
           800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
+              81,
+              58,
+              14,
+              98,
+              97,
+              99,
+              107,
+              117,
+              112,
+              95,
+              115,
+              101,
+              116,
+              116,
+              105,
+              110,
+              103,
               50,
-              34,
-              48,
+              63,
               47,
               118,
               49,
               47,
               123,
-              112,
+              98,
               97,
-              114,
+              99,
+              107,
+              117,
+              112,
+              95,
+              115,
               101,
-              110,
               116,
+              116,
+              105,
+              110,
+              103,
+              46,
+              110,
+              97,
+              109,
+              101,
               61,
               105,
               110,
@@ -10832,67 +16340,43 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
-              125,
-              58,
+              47,
+              98,
               97,
-              100,
-              118,
-              105,
-              115,
+              99,
+              107,
+              117,
+              112,
+              83,
               101,
-              73,
+              116,
+              116,
+              105,
               110,
-              100,
-              101,
-              120,
+              103,
+              125,
             ]),
           ],
         },
       },
     },
-    listChangeHistories: {
-      name: "ListChangeHistories",
-      requestType: ListChangeHistoriesRequest,
+    /** ListBackupRuns lists the backup runs of the database, the newest first. */
+    listBackupRuns: {
+      name: "ListBackupRuns",
+      requestType: ListBackupRunsRequest,
       requestStream: false,
-      responseType: ListChangeHistoriesResponse,
+      responseType: ListBackupRunsResponse,
       responseStream: false,
       options: {
         _unknownFields: {
           8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
-          800010: [
-            new Uint8Array([
-              23,
-              98,
-              98,
-              46,
-              99,
-              104,
-              97,
-              110,
-              103,
-              101,
-              72,
-              105,
-              115,
-              116,
-              111,
-              114,
-              105,
-              101,
-              115,
-              46,
-              108,
-              105,
-              115,
-              116,
-            ]),
-          ],
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              54,
+              49,
               18,
-              52,
+              47,
               47,
               118,
               49,
@@ -10930,77 +16414,58 @@ export const DatabaseServiceDefinition = {
               42,
               125,
               47,
-              99,
-              104,
+              98,
               97,
+              99,
+              107,
+              117,
+              112,
+              82,
+              117,
               110,
-              103,
-              101,
-              72,
-              105,
-              115,
-              116,
-              111,
-              114,
-              105,
-              101,
               115,
             ]),
           ],
         },
       },
     },
-    getChangeHistory: {
-      name: "GetChangeHistory",
-      requestType: GetChangeHistoryRequest,
+    /**
+     * CreateBackupRun creates a manual backup of the database to the storage in the backup setting.
+     * The backup runs in the background, and the status can be checked with ListBackupRuns.
+     */
+    createBackupRun: {
+      name: "CreateBackupRun",
+      requestType: CreateBackupRunRequest,
       requestStream: false,
-      responseType: ChangeHistory,
+      responseType: BackupRun,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
           800010: [
-            new Uint8Array([
-              22,
-              98,
-              98,
-              46,
-              99,
-              104,
-              97,
-              110,
-              103,
-              101,
-              72,
-              105,
-              115,
-              116,
-              111,
-              114,
-              105,
-              101,
-              115,
-              46,
-              103,
-              101,
-              116,
-            ]),
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
           ],
           800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              54,
-              18,
               52,
+              58,
+              1,
+              42,
+              34,
+              47,
               47,
               118,
               49,
               47,
               123,
-              110,
+              112,
               97,
-              109,
+              114,
               101,
+              110,
+              116,
               61,
               105,
               110,
@@ -11025,25 +16490,18 @@ export const DatabaseServiceDefinition = {
               115,
               47,
               42,
+              125,
               47,
-              99,
-              104,
+              98,
               97,
+              99,
+              107,
+              117,
+              112,
+              82,
+              117,
               110,
-              103,
-              101,
-              72,
-              105,
-              115,
-              116,
-              111,
-              114,
-              105,
-              101,
               115,
-              47,
-              42,
-              125,
             ]),
           ],
         },
@@ -11052,6 +16510,31 @@ export const DatabaseServiceDefinition = {
   },
 } as const;
 
+function bytesFromBase64(b64: string): Uint8Array {
+  if (globalThis.Buffer) {
+    return Uint8Array.from(globalThis.Buffer.from(b64, "base64"));
+  } else {
+    const bin = globalThis.atob(b64);
+    const arr = new Uint8Array(bin.length);
+    for (let i = 0; i < bin.length; ++i) {
+      arr[i] = bin.charCodeAt(i);
+    }
+    return arr;
+  }
+}
+
+function base64FromBytes(arr: Uint8Array): string {
+  if (globalThis.Buffer) {
+    return globalThis.Buffer.from(arr).toString("base64");
+  } else {
+    const bin: string[] = [];
+    arr.forEach((byte) => {
+      bin.push(globalThis.String.fromCharCode(byte));
+    });
+    return globalThis.btoa(bin.join(""));
+  }
+}
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
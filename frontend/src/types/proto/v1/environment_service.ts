@@ -4,6 +4,13 @@ import _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { State, stateFromJSON, stateToJSON, stateToNumber } from "./common";
+import { RolloutPolicy } from "./org_policy_service";
+import {
+  PlanCheckRun_Type,
+  planCheckRun_TypeFromJSON,
+  planCheckRun_TypeToJSON,
+  planCheckRun_TypeToNumber,
+} from "./plan_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -157,6 +164,49 @@ export interface Environment {
   tier: EnvironmentTier;
 }
 
+export interface GetEnvironmentPipelineRequest {
+  /**
+   * The name of the environment pipeline.
+   * Format: environmentPipeline
+   */
+  name: string;
+}
+
+export interface UpdateEnvironmentPipelineRequest {
+  /** The environment pipeline to update. */
+  pipeline: EnvironmentPipeline | undefined;
+}
+
+/**
+ * EnvironmentPipeline is the promotion pipeline of the environments, e.g. "dev -> staging -> prod".
+ * The rollouts of the plans generate the stages in the pipeline order,
+ * and a stage can only be rolled out after all the stages of the previous pipeline stages are done.
+ */
+export interface EnvironmentPipeline {
+  /**
+   * The name of the environment pipeline.
+   * Format: environmentPipeline
+   */
+  name: string;
+  /**
+   * The ordered stages of the pipeline.
+   * The environments that are not in any stage are rolled out after the stages in the environment order.
+   */
+  stages: EnvironmentPipeline_Stage[];
+}
+
+export interface EnvironmentPipeline_Stage {
+  /**
+   * The environments in the stage, which are rolled out in parallel.
+   * Format: environments/{environment}
+   */
+  environments: string[];
+  /** The plan checks that must succeed before rolling out to the stage. */
+  requiredChecks: PlanCheckRun_Type[];
+  /** The rollout policy of the stage overrides the rollout policy of the environments if set. */
+  rolloutPolicy: RolloutPolicy | undefined;
+}
+
 function createBaseGetEnvironmentRequest(): GetEnvironmentRequest {
   return { name: "" };
 }
@@ -786,6 +836,305 @@ export const Environment = {
   },
 };
 
+function createBaseGetEnvironmentPipelineRequest(): GetEnvironmentPipelineRequest {
+  return { name: "" };
+}
+
+export const GetEnvironmentPipelineRequest = {
+  encode(message: GetEnvironmentPipelineRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetEnvironmentPipelineRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetEnvironmentPipelineRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetEnvironmentPipelineRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: GetEnvironmentPipelineRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetEnvironmentPipelineRequest>): GetEnvironmentPipelineRequest {
+    return GetEnvironmentPipelineRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetEnvironmentPipelineRequest>): GetEnvironmentPipelineRequest {
+    const message = createBaseGetEnvironmentPipelineRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateEnvironmentPipelineRequest(): UpdateEnvironmentPipelineRequest {
+  return { pipeline: undefined };
+}
+
+export const UpdateEnvironmentPipelineRequest = {
+  encode(message: UpdateEnvironmentPipelineRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pipeline !== undefined) {
+      EnvironmentPipeline.encode(message.pipeline, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateEnvironmentPipelineRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateEnvironmentPipelineRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.pipeline = EnvironmentPipeline.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateEnvironmentPipelineRequest {
+    return { pipeline: isSet(object.pipeline) ? EnvironmentPipeline.fromJSON(object.pipeline) : undefined };
+  },
+
+  toJSON(message: UpdateEnvironmentPipelineRequest): unknown {
+    const obj: any = {};
+    if (message.pipeline !== undefined) {
+      obj.pipeline = EnvironmentPipeline.toJSON(message.pipeline);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateEnvironmentPipelineRequest>): UpdateEnvironmentPipelineRequest {
+    return UpdateEnvironmentPipelineRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateEnvironmentPipelineRequest>): UpdateEnvironmentPipelineRequest {
+    const message = createBaseUpdateEnvironmentPipelineRequest();
+    message.pipeline = (object.pipeline !== undefined && object.pipeline !== null)
+      ? EnvironmentPipeline.fromPartial(object.pipeline)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseEnvironmentPipeline(): EnvironmentPipeline {
+  return { name: "", stages: [] };
+}
+
+export const EnvironmentPipeline = {
+  encode(message: EnvironmentPipeline, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.stages) {
+      EnvironmentPipeline_Stage.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EnvironmentPipeline {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnvironmentPipeline();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.stages.push(EnvironmentPipeline_Stage.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnvironmentPipeline {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      stages: globalThis.Array.isArray(object?.stages)
+        ? object.stages.map((e: any) => EnvironmentPipeline_Stage.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: EnvironmentPipeline): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.stages?.length) {
+      obj.stages = message.stages.map((e) => EnvironmentPipeline_Stage.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<EnvironmentPipeline>): EnvironmentPipeline {
+    return EnvironmentPipeline.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<EnvironmentPipeline>): EnvironmentPipeline {
+    const message = createBaseEnvironmentPipeline();
+    message.name = object.name ?? "";
+    message.stages = object.stages?.map((e) => EnvironmentPipeline_Stage.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseEnvironmentPipeline_Stage(): EnvironmentPipeline_Stage {
+  return { environments: [], requiredChecks: [], rolloutPolicy: undefined };
+}
+
+export const EnvironmentPipeline_Stage = {
+  encode(message: EnvironmentPipeline_Stage, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.environments) {
+      writer.uint32(10).string(v!);
+    }
+    writer.uint32(18).fork();
+    for (const v of message.requiredChecks) {
+      writer.int32(planCheckRun_TypeToNumber(v));
+    }
+    writer.ldelim();
+    if (message.rolloutPolicy !== undefined) {
+      RolloutPolicy.encode(message.rolloutPolicy, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EnvironmentPipeline_Stage {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEnvironmentPipeline_Stage();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.environments.push(reader.string());
+          continue;
+        case 2:
+          if (tag === 16) {
+            message.requiredChecks.push(planCheckRun_TypeFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 18) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.requiredChecks.push(planCheckRun_TypeFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.rolloutPolicy = RolloutPolicy.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EnvironmentPipeline_Stage {
+    return {
+      environments: globalThis.Array.isArray(object?.environments)
+        ? object.environments.map((e: any) => globalThis.String(e))
+        : [],
+      requiredChecks: globalThis.Array.isArray(object?.requiredChecks)
+        ? object.requiredChecks.map((e: any) => planCheckRun_TypeFromJSON(e))
+        : [],
+      rolloutPolicy: isSet(object.rolloutPolicy) ? RolloutPolicy.fromJSON(object.rolloutPolicy) : undefined,
+    };
+  },
+
+  toJSON(message: EnvironmentPipeline_Stage): unknown {
+    const obj: any = {};
+    if (message.environments?.length) {
+      obj.environments = message.environments;
+    }
+    if (message.requiredChecks?.length) {
+      obj.requiredChecks = message.requiredChecks.map((e) => planCheckRun_TypeToJSON(e));
+    }
+    if (message.rolloutPolicy !== undefined) {
+      obj.rolloutPolicy = RolloutPolicy.toJSON(message.rolloutPolicy);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<EnvironmentPipeline_Stage>): EnvironmentPipeline_Stage {
+    return EnvironmentPipeline_Stage.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<EnvironmentPipeline_Stage>): EnvironmentPipeline_Stage {
+    const message = createBaseEnvironmentPipeline_Stage();
+    message.environments = object.environments?.map((e) => e) || [];
+    message.requiredChecks = object.requiredChecks?.map((e) => e) || [];
+    message.rolloutPolicy = (object.rolloutPolicy !== undefined && object.rolloutPolicy !== null)
+      ? RolloutPolicy.fromPartial(object.rolloutPolicy)
+      : undefined;
+    return message;
+  },
+};
+
 export type EnvironmentServiceDefinition = typeof EnvironmentServiceDefinition;
 export const EnvironmentServiceDefinition = {
   name: "EnvironmentService",
@@ -1262,6 +1611,183 @@ export const EnvironmentServiceDefinition = {
         },
       },
     },
+    /** Gets the promotion pipeline of the environments. */
+    getEnvironmentPipeline: {
+      name: "GetEnvironmentPipeline",
+      requestType: GetEnvironmentPipelineRequest,
+      requestStream: false,
+      responseType: EnvironmentPipeline,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [
+            new Uint8Array([
+              20,
+              98,
+              98,
+              46,
+              101,
+              110,
+              118,
+              105,
+              114,
+              111,
+              110,
+              109,
+              101,
+              110,
+              116,
+              115,
+              46,
+              108,
+              105,
+              115,
+              116,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              32,
+              18,
+              30,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              101,
+              110,
+              118,
+              105,
+              114,
+              111,
+              110,
+              109,
+              101,
+              110,
+              116,
+              80,
+              105,
+              112,
+              101,
+              108,
+              105,
+              110,
+              101,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * Updates the promotion pipeline of the environments.
+     * The stages are replaced as a whole, which reorders the environments and marks the environments in the same stage as parallel.
+     */
+    updateEnvironmentPipeline: {
+      name: "UpdateEnvironmentPipeline",
+      requestType: UpdateEnvironmentPipelineRequest,
+      requestStream: false,
+      responseType: EnvironmentPipeline,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([8, 112, 105, 112, 101, 108, 105, 110, 101])],
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              101,
+              110,
+              118,
+              105,
+              114,
+              111,
+              110,
+              109,
+              101,
+              110,
+              116,
+              115,
+              46,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              51,
+              58,
+              8,
+              112,
+              105,
+              112,
+              101,
+              108,
+              105,
+              110,
+              101,
+              50,
+              39,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              105,
+              112,
+              101,
+              108,
+              105,
+              110,
+              101,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              101,
+              110,
+              118,
+              105,
+              114,
+              111,
+              110,
+              109,
+              101,
+              110,
+              116,
+              80,
+              105,
+              112,
+              101,
+              108,
+              105,
+              110,
+              101,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -0,0 +1,560 @@
+/* eslint-disable */
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Timestamp } from "../google/protobuf/timestamp";
+
+export const protobufPackage = "bytebase.v1";
+
+export interface StreamEventsRequest {
+  /** The types of the events to stream, all types if empty. */
+  types: WorkspaceEvent_Type[];
+  /**
+   * The project of the events to stream, all projects and the workspace events if empty.
+   * Format: projects/{project}
+   */
+  project: string;
+}
+
+export interface WorkspaceEvent {
+  type: WorkspaceEvent_Type;
+  createTime:
+    | Date
+    | undefined;
+  /**
+   * The user who triggered the event, empty for the events detected by Bytebase.
+   * Format: users/{email}
+   */
+  actor: string;
+  /**
+   * The project of the event, empty for the workspace events.
+   * Format: projects/{project}
+   */
+  project: string;
+  /**
+   * The resource of the event, e.g.
+   * projects/{project}/issues/{issue} for the issue, stage and task run events,
+   * instances/{instance} or instances/{instance}/databases/{database} for the anomaly events,
+   * projects/{project} for the project member events, workspaces/{workspace} for the workspace member events,
+   * and users/{email} for the user events.
+   */
+  resource: string;
+  /** The title of the resource, e.g. the issue title. */
+  title: string;
+  /** The status of the resource after the event, e.g. the issue status or the task run status. */
+  status: string;
+  /** The detail of the event, e.g. the updated field of the issue, the task run result or the anomaly type. */
+  detail: string;
+}
+
+export enum WorkspaceEvent_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  ISSUE_CREATED = "ISSUE_CREATED",
+  ISSUE_UPDATED = "ISSUE_UPDATED",
+  ISSUE_STATUS_UPDATED = "ISSUE_STATUS_UPDATED",
+  ISSUE_COMMENT_CREATED = "ISSUE_COMMENT_CREATED",
+  ISSUE_APPROVAL_REQUESTED = "ISSUE_APPROVAL_REQUESTED",
+  ISSUE_APPROVED = "ISSUE_APPROVED",
+  ISSUE_ROLLOUT_READY = "ISSUE_ROLLOUT_READY",
+  STAGE_STATUS_UPDATED = "STAGE_STATUS_UPDATED",
+  TASK_RUN_STATUS_UPDATED = "TASK_RUN_STATUS_UPDATED",
+  ANOMALY_DETECTED = "ANOMALY_DETECTED",
+  PROJECT_MEMBERS_UPDATED = "PROJECT_MEMBERS_UPDATED",
+  WORKSPACE_MEMBERS_UPDATED = "WORKSPACE_MEMBERS_UPDATED",
+  USER_CREATED = "USER_CREATED",
+  USER_DELETED = "USER_DELETED",
+  USER_UNDELETED = "USER_UNDELETED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function workspaceEvent_TypeFromJSON(object: any): WorkspaceEvent_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return WorkspaceEvent_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "ISSUE_CREATED":
+      return WorkspaceEvent_Type.ISSUE_CREATED;
+    case 2:
+    case "ISSUE_UPDATED":
+      return WorkspaceEvent_Type.ISSUE_UPDATED;
+    case 3:
+    case "ISSUE_STATUS_UPDATED":
+      return WorkspaceEvent_Type.ISSUE_STATUS_UPDATED;
+    case 4:
+    case "ISSUE_COMMENT_CREATED":
+      return WorkspaceEvent_Type.ISSUE_COMMENT_CREATED;
+    case 5:
+    case "ISSUE_APPROVAL_REQUESTED":
+      return WorkspaceEvent_Type.ISSUE_APPROVAL_REQUESTED;
+    case 6:
+    case "ISSUE_APPROVED":
+      return WorkspaceEvent_Type.ISSUE_APPROVED;
+    case 7:
+    case "ISSUE_ROLLOUT_READY":
+      return WorkspaceEvent_Type.ISSUE_ROLLOUT_READY;
+    case 8:
+    case "STAGE_STATUS_UPDATED":
+      return WorkspaceEvent_Type.STAGE_STATUS_UPDATED;
+    case 9:
+    case "TASK_RUN_STATUS_UPDATED":
+      return WorkspaceEvent_Type.TASK_RUN_STATUS_UPDATED;
+    case 10:
+    case "ANOMALY_DETECTED":
+      return WorkspaceEvent_Type.ANOMALY_DETECTED;
+    case 11:
+    case "PROJECT_MEMBERS_UPDATED":
+      return WorkspaceEvent_Type.PROJECT_MEMBERS_UPDATED;
+    case 12:
+    case "WORKSPACE_MEMBERS_UPDATED":
+      return WorkspaceEvent_Type.WORKSPACE_MEMBERS_UPDATED;
+    case 13:
+    case "USER_CREATED":
+      return WorkspaceEvent_Type.USER_CREATED;
+    case 14:
+    case "USER_DELETED":
+      return WorkspaceEvent_Type.USER_DELETED;
+    case 15:
+    case "USER_UNDELETED":
+      return WorkspaceEvent_Type.USER_UNDELETED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WorkspaceEvent_Type.UNRECOGNIZED;
+  }
+}
+
+export function workspaceEvent_TypeToJSON(object: WorkspaceEvent_Type): string {
+  switch (object) {
+    case WorkspaceEvent_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case WorkspaceEvent_Type.ISSUE_CREATED:
+      return "ISSUE_CREATED";
+    case WorkspaceEvent_Type.ISSUE_UPDATED:
+      return "ISSUE_UPDATED";
+    case WorkspaceEvent_Type.ISSUE_STATUS_UPDATED:
+      return "ISSUE_STATUS_UPDATED";
+    case WorkspaceEvent_Type.ISSUE_COMMENT_CREATED:
+      return "ISSUE_COMMENT_CREATED";
+    case WorkspaceEvent_Type.ISSUE_APPROVAL_REQUESTED:
+      return "ISSUE_APPROVAL_REQUESTED";
+    case WorkspaceEvent_Type.ISSUE_APPROVED:
+      return "ISSUE_APPROVED";
+    case WorkspaceEvent_Type.ISSUE_ROLLOUT_READY:
+      return "ISSUE_ROLLOUT_READY";
+    case WorkspaceEvent_Type.STAGE_STATUS_UPDATED:
+      return "STAGE_STATUS_UPDATED";
+    case WorkspaceEvent_Type.TASK_RUN_STATUS_UPDATED:
+      return "TASK_RUN_STATUS_UPDATED";
+    case WorkspaceEvent_Type.ANOMALY_DETECTED:
+      return "ANOMALY_DETECTED";
+    case WorkspaceEvent_Type.PROJECT_MEMBERS_UPDATED:
+      return "PROJECT_MEMBERS_UPDATED";
+    case WorkspaceEvent_Type.WORKSPACE_MEMBERS_UPDATED:
+      return "WORKSPACE_MEMBERS_UPDATED";
+    case WorkspaceEvent_Type.USER_CREATED:
+      return "USER_CREATED";
+    case WorkspaceEvent_Type.USER_DELETED:
+      return "USER_DELETED";
+    case WorkspaceEvent_Type.USER_UNDELETED:
+      return "USER_UNDELETED";
+    case WorkspaceEvent_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function workspaceEvent_TypeToNumber(object: WorkspaceEvent_Type): number {
+  switch (object) {
+    case WorkspaceEvent_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case WorkspaceEvent_Type.ISSUE_CREATED:
+      return 1;
+    case WorkspaceEvent_Type.ISSUE_UPDATED:
+      return 2;
+    case WorkspaceEvent_Type.ISSUE_STATUS_UPDATED:
+      return 3;
+    case WorkspaceEvent_Type.ISSUE_COMMENT_CREATED:
+      return 4;
+    case WorkspaceEvent_Type.ISSUE_APPROVAL_REQUESTED:
+      return 5;
+    case WorkspaceEvent_Type.ISSUE_APPROVED:
+      return 6;
+    case WorkspaceEvent_Type.ISSUE_ROLLOUT_READY:
+      return 7;
+    case WorkspaceEvent_Type.STAGE_STATUS_UPDATED:
+      return 8;
+    case WorkspaceEvent_Type.TASK_RUN_STATUS_UPDATED:
+      return 9;
+    case WorkspaceEvent_Type.ANOMALY_DETECTED:
+      return 10;
+    case WorkspaceEvent_Type.PROJECT_MEMBERS_UPDATED:
+      return 11;
+    case WorkspaceEvent_Type.WORKSPACE_MEMBERS_UPDATED:
+      return 12;
+    case WorkspaceEvent_Type.USER_CREATED:
+      return 13;
+    case WorkspaceEvent_Type.USER_DELETED:
+      return 14;
+    case WorkspaceEvent_Type.USER_UNDELETED:
+      return 15;
+    case WorkspaceEvent_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+function createBaseStreamEventsRequest(): StreamEventsRequest {
+  return { types: [], project: "" };
+}
+
+export const StreamEventsRequest = {
+  encode(message: StreamEventsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+    for (const v of message.types) {
+      writer.int32(workspaceEvent_TypeToNumber(v));
+    }
+    writer.ldelim();
+    if (message.project !== "") {
+      writer.uint32(18).string(message.project);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StreamEventsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStreamEventsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag === 8) {
+            message.types.push(workspaceEvent_TypeFromJSON(reader.int32()));
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.types.push(workspaceEvent_TypeFromJSON(reader.int32()));
+            }
+
+            continue;
+          }
+
+          break;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StreamEventsRequest {
+    return {
+      types: globalThis.Array.isArray(object?.types)
+        ? object.types.map((e: any) => workspaceEvent_TypeFromJSON(e))
+        : [],
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+    };
+  },
+
+  toJSON(message: StreamEventsRequest): unknown {
+    const obj: any = {};
+    if (message.types?.length) {
+      obj.types = message.types.map((e) => workspaceEvent_TypeToJSON(e));
+    }
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<StreamEventsRequest>): StreamEventsRequest {
+    return StreamEventsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<StreamEventsRequest>): StreamEventsRequest {
+    const message = createBaseStreamEventsRequest();
+    message.types = object.types?.map((e) => e) || [];
+    message.project = object.project ?? "";
+    return message;
+  },
+};
+
+function createBaseWorkspaceEvent(): WorkspaceEvent {
+  return {
+    type: WorkspaceEvent_Type.TYPE_UNSPECIFIED,
+    createTime: undefined,
+    actor: "",
+    project: "",
+    resource: "",
+    title: "",
+    status: "",
+    detail: "",
+  };
+}
+
+export const WorkspaceEvent = {
+  encode(message: WorkspaceEvent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== WorkspaceEvent_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(workspaceEvent_TypeToNumber(message.type));
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.actor !== "") {
+      writer.uint32(26).string(message.actor);
+    }
+    if (message.project !== "") {
+      writer.uint32(34).string(message.project);
+    }
+    if (message.resource !== "") {
+      writer.uint32(42).string(message.resource);
+    }
+    if (message.title !== "") {
+      writer.uint32(50).string(message.title);
+    }
+    if (message.status !== "") {
+      writer.uint32(58).string(message.status);
+    }
+    if (message.detail !== "") {
+      writer.uint32(66).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorkspaceEvent {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorkspaceEvent();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = workspaceEvent_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.actor = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.resource = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.status = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WorkspaceEvent {
+    return {
+      type: isSet(object.type) ? workspaceEvent_TypeFromJSON(object.type) : WorkspaceEvent_Type.TYPE_UNSPECIFIED,
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      actor: isSet(object.actor) ? globalThis.String(object.actor) : "",
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      resource: isSet(object.resource) ? globalThis.String(object.resource) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      status: isSet(object.status) ? globalThis.String(object.status) : "",
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: WorkspaceEvent): unknown {
+    const obj: any = {};
+    if (message.type !== WorkspaceEvent_Type.TYPE_UNSPECIFIED) {
+      obj.type = workspaceEvent_TypeToJSON(message.type);
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.actor !== "") {
+      obj.actor = message.actor;
+    }
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.resource !== "") {
+      obj.resource = message.resource;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.status !== "") {
+      obj.status = message.status;
+    }
+    if (message.detail !== "") {
+      obj.detail = message.detail;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<WorkspaceEvent>): WorkspaceEvent {
+    return WorkspaceEvent.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorkspaceEvent>): WorkspaceEvent {
+    const message = createBaseWorkspaceEvent();
+    message.type = object.type ?? WorkspaceEvent_Type.TYPE_UNSPECIFIED;
+    message.createTime = object.createTime ?? undefined;
+    message.actor = object.actor ?? "";
+    message.project = object.project ?? "";
+    message.resource = object.resource ?? "";
+    message.title = object.title ?? "";
+    message.status = object.status ?? "";
+    message.detail = object.detail ?? "";
+    return message;
+  },
+};
+
+export type EventServiceDefinition = typeof EventServiceDefinition;
+export const EventServiceDefinition = {
+  name: "EventService",
+  fullName: "bytebase.v1.EventService",
+  methods: {
+    /**
+     * StreamEvents streams the events of the workspace as they happen.
+     * The caller only receives the events of the resources which the caller has the permission to get:
+     * - the issue, stage and task run events require bb.issues.get in the project.
+     * - the anomaly events require bb.databases.get in the project, or bb.instances.get for the instance anomalies.
+     * - the project member events require bb.projects.getIamPolicy in the project.
+     * - the workspace member and user events require bb.policies.get in the workspace.
+     */
+    streamEvents: {
+      name: "StreamEvents",
+      requestType: StreamEventsRequest,
+      requestStream: false,
+      responseType: WorkspaceEvent,
+      responseStream: true,
+      options: {
+        _unknownFields: {
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              19,
+              18,
+              17,
+              47,
+              118,
+              49,
+              47,
+              101,
+              118,
+              101,
+              110,
+              116,
+              115,
+              58,
+              115,
+              116,
+              114,
+              101,
+              97,
+              109,
+            ]),
+          ],
+        },
+      },
+    },
+  },
+} as const;
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Long ? string | number | Long : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
@@ -148,7 +148,14 @@ export interface Group {
   creator: string;
   members: GroupMember[];
   /** The timestamp when the group was created. */
-  createTime: Date | undefined;
+  createTime:
+    | Date
+    | undefined;
+  /**
+   * The source system where the group is provisioned from, for example, SCIM.
+   * The members of the provisioned group are managed by the source system.
+   */
+  source: string;
 }
 
 function createBaseGetGroupRequest(): GetGroupRequest {
@@ -619,7 +626,7 @@ export const GroupMember = {
 };
 
 function createBaseGroup(): Group {
-  return { name: "", title: "", description: "", creator: "", members: [], createTime: undefined };
+  return { name: "", title: "", description: "", creator: "", members: [], createTime: undefined, source: "" };
 }
 
 export const Group = {
@@ -642,6 +649,9 @@ export const Group = {
     if (message.createTime !== undefined) {
       Timestamp.encode(toTimestamp(message.createTime), writer.uint32(50).fork()).ldelim();
     }
+    if (message.source !== "") {
+      writer.uint32(58).string(message.source);
+    }
     return writer;
   },
 
@@ -694,6 +704,13 @@ export const Group = {
 
           message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.source = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -711,6 +728,7 @@ export const Group = {
       creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
       members: globalThis.Array.isArray(object?.members) ? object.members.map((e: any) => GroupMember.fromJSON(e)) : [],
       createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      source: isSet(object.source) ? globalThis.String(object.source) : "",
     };
   },
 
@@ -734,6 +752,9 @@ export const Group = {
     if (message.createTime !== undefined) {
       obj.createTime = message.createTime.toISOString();
     }
+    if (message.source !== "") {
+      obj.source = message.source;
+    }
     return obj;
   },
 
@@ -748,6 +769,7 @@ export const Group = {
     message.creator = object.creator ?? "";
     message.members = object.members?.map((e) => GroupMember.fromPartial(e)) || [];
     message.createTime = object.createTime ?? undefined;
+    message.source = object.source ?? "";
     return message;
   },
 };
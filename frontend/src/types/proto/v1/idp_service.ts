@@ -303,7 +303,37 @@ export interface LDAPIdentityProviderConfig {
    * FieldMapping is the mapping of the user attributes returned by the LDAP
    * server.
    */
-  fieldMapping: FieldMapping | undefined;
+  fieldMapping:
+    | FieldMapping
+    | undefined;
+  /**
+   * GroupBaseDN is the base DN to search for groups, e.g.
+   * "ou=groups,dc=example,dc=com". Group mapping is disabled when it's empty.
+   */
+  groupBaseDn: string;
+  /**
+   * GroupFilter is the filter to search for the groups of a member, the "%s"
+   * is replaced with the member DN, e.g. "(member=%s)".
+   */
+  groupFilter: string;
+  /**
+   * NestedGroup controls whether to resolve the groups that the user belongs to
+   * through other groups.
+   */
+  nestedGroup: boolean;
+  /** GroupMappings is the mapping from the LDAP groups to the workspace roles. */
+  groupMappings: LDAPGroupMapping[];
+}
+
+/** LDAPGroupMapping maps the members of an LDAP group to a workspace role. */
+export interface LDAPGroupMapping {
+  /** GroupDN is the DN of the LDAP group, e.g. "cn=dba,ou=groups,dc=example,dc=com". */
+  groupDn: string;
+  /**
+   * Role is the workspace role granted to the group members.
+   * Format: roles/{role}
+   */
+  role: string;
 }
 
 /**
@@ -1616,6 +1646,10 @@ function createBaseLDAPIdentityProviderConfig(): LDAPIdentityProviderConfig {
     userFilter: "",
     securityProtocol: "",
     fieldMapping: undefined,
+    groupBaseDn: "",
+    groupFilter: "",
+    nestedGroup: false,
+    groupMappings: [],
   };
 }
 
@@ -1648,6 +1682,18 @@ export const LDAPIdentityProviderConfig = {
     if (message.fieldMapping !== undefined) {
       FieldMapping.encode(message.fieldMapping, writer.uint32(74).fork()).ldelim();
     }
+    if (message.groupBaseDn !== "") {
+      writer.uint32(82).string(message.groupBaseDn);
+    }
+    if (message.groupFilter !== "") {
+      writer.uint32(90).string(message.groupFilter);
+    }
+    if (message.nestedGroup === true) {
+      writer.uint32(96).bool(message.nestedGroup);
+    }
+    for (const v of message.groupMappings) {
+      LDAPGroupMapping.encode(v!, writer.uint32(106).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1721,6 +1767,34 @@ export const LDAPIdentityProviderConfig = {
 
           message.fieldMapping = FieldMapping.decode(reader, reader.uint32());
           continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.groupBaseDn = reader.string();
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.groupFilter = reader.string();
+          continue;
+        case 12:
+          if (tag !== 96) {
+            break;
+          }
+
+          message.nestedGroup = reader.bool();
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.groupMappings.push(LDAPGroupMapping.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1741,6 +1815,12 @@ export const LDAPIdentityProviderConfig = {
       userFilter: isSet(object.userFilter) ? globalThis.String(object.userFilter) : "",
       securityProtocol: isSet(object.securityProtocol) ? globalThis.String(object.securityProtocol) : "",
       fieldMapping: isSet(object.fieldMapping) ? FieldMapping.fromJSON(object.fieldMapping) : undefined,
+      groupBaseDn: isSet(object.groupBaseDn) ? globalThis.String(object.groupBaseDn) : "",
+      groupFilter: isSet(object.groupFilter) ? globalThis.String(object.groupFilter) : "",
+      nestedGroup: isSet(object.nestedGroup) ? globalThis.Boolean(object.nestedGroup) : false,
+      groupMappings: globalThis.Array.isArray(object?.groupMappings)
+        ? object.groupMappings.map((e: any) => LDAPGroupMapping.fromJSON(e))
+        : [],
     };
   },
 
@@ -1773,6 +1853,18 @@ export const LDAPIdentityProviderConfig = {
     if (message.fieldMapping !== undefined) {
       obj.fieldMapping = FieldMapping.toJSON(message.fieldMapping);
     }
+    if (message.groupBaseDn !== "") {
+      obj.groupBaseDn = message.groupBaseDn;
+    }
+    if (message.groupFilter !== "") {
+      obj.groupFilter = message.groupFilter;
+    }
+    if (message.nestedGroup === true) {
+      obj.nestedGroup = message.nestedGroup;
+    }
+    if (message.groupMappings?.length) {
+      obj.groupMappings = message.groupMappings.map((e) => LDAPGroupMapping.toJSON(e));
+    }
     return obj;
   },
 
@@ -1792,6 +1884,84 @@ export const LDAPIdentityProviderConfig = {
     message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
       ? FieldMapping.fromPartial(object.fieldMapping)
       : undefined;
+    message.groupBaseDn = object.groupBaseDn ?? "";
+    message.groupFilter = object.groupFilter ?? "";
+    message.nestedGroup = object.nestedGroup ?? false;
+    message.groupMappings = object.groupMappings?.map((e) => LDAPGroupMapping.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseLDAPGroupMapping(): LDAPGroupMapping {
+  return { groupDn: "", role: "" };
+}
+
+export const LDAPGroupMapping = {
+  encode(message: LDAPGroupMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.groupDn !== "") {
+      writer.uint32(10).string(message.groupDn);
+    }
+    if (message.role !== "") {
+      writer.uint32(18).string(message.role);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LDAPGroupMapping {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLDAPGroupMapping();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.groupDn = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LDAPGroupMapping {
+    return {
+      groupDn: isSet(object.groupDn) ? globalThis.String(object.groupDn) : "",
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+    };
+  },
+
+  toJSON(message: LDAPGroupMapping): unknown {
+    const obj: any = {};
+    if (message.groupDn !== "") {
+      obj.groupDn = message.groupDn;
+    }
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LDAPGroupMapping>): LDAPGroupMapping {
+    return LDAPGroupMapping.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LDAPGroupMapping>): LDAPGroupMapping {
+    const message = createBaseLDAPGroupMapping();
+    message.groupDn = object.groupDn ?? "";
+    message.role = object.role ?? "";
     return message;
   },
 };
@@ -237,6 +237,16 @@ export interface SyncSlowQueriesRequest {
   parent: string;
 }
 
+export interface KillQueryRequest {
+  /**
+   * The name of the instance.
+   * Format: instances/{instance}
+   */
+  name: string;
+  /** The connection id running the query, i.e. the process id in PostgreSQL and the connection id in MySQL. */
+  connectionId: string;
+}
+
 /** InstanceOptions is the option for instances. */
 export interface InstanceOptions {
   /** How often the instance is synced. */
@@ -248,6 +258,40 @@ export interface InstanceOptions {
    * The default is 10 if the value is unset or zero.
    */
   maximumConnections: number;
+  /** The connection pool configuration of each driver connection. */
+  connectionPool:
+    | ConnectionPoolConfig
+    | undefined;
+  /**
+   * The maximum number of the tasks running concurrently on the instance.
+   * The tasks are only limited by the maximum connections if the value is unset or zero.
+   */
+  maxConcurrentTasks: number;
+  /**
+   * Oracle only. The pluggable databases (PDBs) of the container database are synced as the databases of the instance,
+   * rather than the schemas of the connected database. The admin data source must connect to the root container (CDB$ROOT)
+   * with a common user, and the PDBs are connected by their default services.
+   */
+  oraclePdbMode: boolean;
+}
+
+/** ConnectionPoolConfig is the connection pool configuration for instances. */
+export interface ConnectionPoolConfig {
+  /**
+   * The maximum number of open connections to the database.
+   * There is no limit if the value is unset or zero.
+   */
+  maxOpenConnections: number;
+  /**
+   * The maximum number of idle connections to the database.
+   * The driver default is used if the value is unset or zero.
+   */
+  maxIdleConnections: number;
+  /**
+   * The maximum amount of time a connection may be reused.
+   * Connections are reused forever if the value is unset or zero.
+   */
+  maxConnectionLifetime: Duration | undefined;
 }
 
 export interface Instance {
@@ -279,6 +323,7 @@ export interface DataSourceExternalSecret {
   url: string;
   authType: DataSourceExternalSecret_AuthType;
   appRole?: DataSourceExternalSecret_AppRoleAuthOption | undefined;
+  kubernetes?: DataSourceExternalSecret_KubernetesAuthOption | undefined;
   token?:
     | string
     | undefined;
@@ -288,6 +333,11 @@ export interface DataSourceExternalSecret {
   secretName: string;
   /** the key name for the password. */
   passwordKeyName: string;
+  /**
+   * How long the fetched secret is cached before it is fetched again.
+   * The default is 5 minutes if unset.
+   */
+  cacheTtl: Duration | undefined;
 }
 
 export enum DataSourceExternalSecret_SecretType {
@@ -360,6 +410,8 @@ export enum DataSourceExternalSecret_AuthType {
   TOKEN = "TOKEN",
   /** VAULT_APP_ROLE - ref: https://developer.hashicorp.com/vault/docs/auth/approle */
   VAULT_APP_ROLE = "VAULT_APP_ROLE",
+  /** VAULT_KUBERNETES - ref: https://developer.hashicorp.com/vault/docs/auth/kubernetes */
+  VAULT_KUBERNETES = "VAULT_KUBERNETES",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -374,6 +426,9 @@ export function dataSourceExternalSecret_AuthTypeFromJSON(object: any): DataSour
     case 2:
     case "VAULT_APP_ROLE":
       return DataSourceExternalSecret_AuthType.VAULT_APP_ROLE;
+    case 3:
+    case "VAULT_KUBERNETES":
+      return DataSourceExternalSecret_AuthType.VAULT_KUBERNETES;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -389,6 +444,8 @@ export function dataSourceExternalSecret_AuthTypeToJSON(object: DataSourceExtern
       return "TOKEN";
     case DataSourceExternalSecret_AuthType.VAULT_APP_ROLE:
       return "VAULT_APP_ROLE";
+    case DataSourceExternalSecret_AuthType.VAULT_KUBERNETES:
+      return "VAULT_KUBERNETES";
     case DataSourceExternalSecret_AuthType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -403,6 +460,8 @@ export function dataSourceExternalSecret_AuthTypeToNumber(object: DataSourceExte
       return 1;
     case DataSourceExternalSecret_AuthType.VAULT_APP_ROLE:
       return 2;
+    case DataSourceExternalSecret_AuthType.VAULT_KUBERNETES:
+      return 3;
     case DataSourceExternalSecret_AuthType.UNRECOGNIZED:
     default:
       return -1;
@@ -477,6 +536,21 @@ export function dataSourceExternalSecret_AppRoleAuthOption_SecretTypeToNumber(
   }
 }
 
+export interface DataSourceExternalSecret_KubernetesAuthOption {
+  /** The Vault role bound to the Bytebase service account. */
+  role: string;
+  /**
+   * The path to the service account token.
+   * The default is /var/run/secrets/kubernetes.io/serviceaccount/token.
+   */
+  serviceAccountTokenPath: string;
+  /**
+   * The path where the kubernetes auth method is mounted.
+   * The default is "kubernetes".
+   */
+  mountPath: string;
+}
+
 export interface DataSource {
   id: string;
   type: DataSourceType;
@@ -544,6 +618,11 @@ export interface DataSource {
   masterUsername: string;
   masterPassword: string;
   redisType: DataSource_RedisType;
+  /**
+   * aws_role_arn is the IAM role assumed to generate the authentication token for AWS RDS IAM authentication.
+   * The default AWS credentials of the Bytebase server are used if it's empty.
+   */
+  awsRoleArn: string;
 }
 
 export enum DataSource_AuthenticationType {
@@ -1760,8 +1839,88 @@ export const SyncSlowQueriesRequest = {
   },
 };
 
+function createBaseKillQueryRequest(): KillQueryRequest {
+  return { name: "", connectionId: "" };
+}
+
+export const KillQueryRequest = {
+  encode(message: KillQueryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.connectionId !== "") {
+      writer.uint32(18).string(message.connectionId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): KillQueryRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseKillQueryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.connectionId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): KillQueryRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      connectionId: isSet(object.connectionId) ? globalThis.String(object.connectionId) : "",
+    };
+  },
+
+  toJSON(message: KillQueryRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.connectionId !== "") {
+      obj.connectionId = message.connectionId;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<KillQueryRequest>): KillQueryRequest {
+    return KillQueryRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<KillQueryRequest>): KillQueryRequest {
+    const message = createBaseKillQueryRequest();
+    message.name = object.name ?? "";
+    message.connectionId = object.connectionId ?? "";
+    return message;
+  },
+};
+
 function createBaseInstanceOptions(): InstanceOptions {
-  return { syncInterval: undefined, maximumConnections: 0 };
+  return {
+    syncInterval: undefined,
+    maximumConnections: 0,
+    connectionPool: undefined,
+    maxConcurrentTasks: 0,
+    oraclePdbMode: false,
+  };
 }
 
 export const InstanceOptions = {
@@ -1772,6 +1931,15 @@ export const InstanceOptions = {
     if (message.maximumConnections !== 0) {
       writer.uint32(24).int32(message.maximumConnections);
     }
+    if (message.connectionPool !== undefined) {
+      ConnectionPoolConfig.encode(message.connectionPool, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.maxConcurrentTasks !== 0) {
+      writer.uint32(40).int32(message.maxConcurrentTasks);
+    }
+    if (message.oraclePdbMode === true) {
+      writer.uint32(48).bool(message.oraclePdbMode);
+    }
     return writer;
   },
 
@@ -1796,6 +1964,27 @@ export const InstanceOptions = {
 
           message.maximumConnections = reader.int32();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.connectionPool = ConnectionPoolConfig.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.maxConcurrentTasks = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.oraclePdbMode = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1809,6 +1998,9 @@ export const InstanceOptions = {
     return {
       syncInterval: isSet(object.syncInterval) ? Duration.fromJSON(object.syncInterval) : undefined,
       maximumConnections: isSet(object.maximumConnections) ? globalThis.Number(object.maximumConnections) : 0,
+      connectionPool: isSet(object.connectionPool) ? ConnectionPoolConfig.fromJSON(object.connectionPool) : undefined,
+      maxConcurrentTasks: isSet(object.maxConcurrentTasks) ? globalThis.Number(object.maxConcurrentTasks) : 0,
+      oraclePdbMode: isSet(object.oraclePdbMode) ? globalThis.Boolean(object.oraclePdbMode) : false,
     };
   },
 
@@ -1820,6 +2012,15 @@ export const InstanceOptions = {
     if (message.maximumConnections !== 0) {
       obj.maximumConnections = Math.round(message.maximumConnections);
     }
+    if (message.connectionPool !== undefined) {
+      obj.connectionPool = ConnectionPoolConfig.toJSON(message.connectionPool);
+    }
+    if (message.maxConcurrentTasks !== 0) {
+      obj.maxConcurrentTasks = Math.round(message.maxConcurrentTasks);
+    }
+    if (message.oraclePdbMode === true) {
+      obj.oraclePdbMode = message.oraclePdbMode;
+    }
     return obj;
   },
 
@@ -1832,6 +2033,105 @@ export const InstanceOptions = {
       ? Duration.fromPartial(object.syncInterval)
       : undefined;
     message.maximumConnections = object.maximumConnections ?? 0;
+    message.connectionPool = (object.connectionPool !== undefined && object.connectionPool !== null)
+      ? ConnectionPoolConfig.fromPartial(object.connectionPool)
+      : undefined;
+    message.maxConcurrentTasks = object.maxConcurrentTasks ?? 0;
+    message.oraclePdbMode = object.oraclePdbMode ?? false;
+    return message;
+  },
+};
+
+function createBaseConnectionPoolConfig(): ConnectionPoolConfig {
+  return { maxOpenConnections: 0, maxIdleConnections: 0, maxConnectionLifetime: undefined };
+}
+
+export const ConnectionPoolConfig = {
+  encode(message: ConnectionPoolConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.maxOpenConnections !== 0) {
+      writer.uint32(8).int32(message.maxOpenConnections);
+    }
+    if (message.maxIdleConnections !== 0) {
+      writer.uint32(16).int32(message.maxIdleConnections);
+    }
+    if (message.maxConnectionLifetime !== undefined) {
+      Duration.encode(message.maxConnectionLifetime, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ConnectionPoolConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseConnectionPoolConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxOpenConnections = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.maxIdleConnections = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.maxConnectionLifetime = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ConnectionPoolConfig {
+    return {
+      maxOpenConnections: isSet(object.maxOpenConnections) ? globalThis.Number(object.maxOpenConnections) : 0,
+      maxIdleConnections: isSet(object.maxIdleConnections) ? globalThis.Number(object.maxIdleConnections) : 0,
+      maxConnectionLifetime: isSet(object.maxConnectionLifetime)
+        ? Duration.fromJSON(object.maxConnectionLifetime)
+        : undefined,
+    };
+  },
+
+  toJSON(message: ConnectionPoolConfig): unknown {
+    const obj: any = {};
+    if (message.maxOpenConnections !== 0) {
+      obj.maxOpenConnections = Math.round(message.maxOpenConnections);
+    }
+    if (message.maxIdleConnections !== 0) {
+      obj.maxIdleConnections = Math.round(message.maxIdleConnections);
+    }
+    if (message.maxConnectionLifetime !== undefined) {
+      obj.maxConnectionLifetime = Duration.toJSON(message.maxConnectionLifetime);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ConnectionPoolConfig>): ConnectionPoolConfig {
+    return ConnectionPoolConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ConnectionPoolConfig>): ConnectionPoolConfig {
+    const message = createBaseConnectionPoolConfig();
+    message.maxOpenConnections = object.maxOpenConnections ?? 0;
+    message.maxIdleConnections = object.maxIdleConnections ?? 0;
+    message.maxConnectionLifetime =
+      (object.maxConnectionLifetime !== undefined && object.maxConnectionLifetime !== null)
+        ? Duration.fromPartial(object.maxConnectionLifetime)
+        : undefined;
     return message;
   },
 };
@@ -2083,10 +2383,12 @@ function createBaseDataSourceExternalSecret(): DataSourceExternalSecret {
     url: "",
     authType: DataSourceExternalSecret_AuthType.AUTH_TYPE_UNSPECIFIED,
     appRole: undefined,
+    kubernetes: undefined,
     token: undefined,
     engineName: "",
     secretName: "",
     passwordKeyName: "",
+    cacheTtl: undefined,
   };
 }
 
@@ -2104,6 +2406,9 @@ export const DataSourceExternalSecret = {
     if (message.appRole !== undefined) {
       DataSourceExternalSecret_AppRoleAuthOption.encode(message.appRole, writer.uint32(34).fork()).ldelim();
     }
+    if (message.kubernetes !== undefined) {
+      DataSourceExternalSecret_KubernetesAuthOption.encode(message.kubernetes, writer.uint32(74).fork()).ldelim();
+    }
     if (message.token !== undefined) {
       writer.uint32(42).string(message.token);
     }
@@ -2116,6 +2421,9 @@ export const DataSourceExternalSecret = {
     if (message.passwordKeyName !== "") {
       writer.uint32(66).string(message.passwordKeyName);
     }
+    if (message.cacheTtl !== undefined) {
+      Duration.encode(message.cacheTtl, writer.uint32(82).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2154,6 +2462,13 @@ export const DataSourceExternalSecret = {
 
           message.appRole = DataSourceExternalSecret_AppRoleAuthOption.decode(reader, reader.uint32());
           continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.kubernetes = DataSourceExternalSecret_KubernetesAuthOption.decode(reader, reader.uint32());
+          continue;
         case 5:
           if (tag !== 42) {
             break;
@@ -2182,6 +2497,13 @@ export const DataSourceExternalSecret = {
 
           message.passwordKeyName = reader.string();
           continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.cacheTtl = Duration.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2201,10 +2523,14 @@ export const DataSourceExternalSecret = {
         ? dataSourceExternalSecret_AuthTypeFromJSON(object.authType)
         : DataSourceExternalSecret_AuthType.AUTH_TYPE_UNSPECIFIED,
       appRole: isSet(object.appRole) ? DataSourceExternalSecret_AppRoleAuthOption.fromJSON(object.appRole) : undefined,
+      kubernetes: isSet(object.kubernetes)
+        ? DataSourceExternalSecret_KubernetesAuthOption.fromJSON(object.kubernetes)
+        : undefined,
       token: isSet(object.token) ? globalThis.String(object.token) : undefined,
       engineName: isSet(object.engineName) ? globalThis.String(object.engineName) : "",
       secretName: isSet(object.secretName) ? globalThis.String(object.secretName) : "",
       passwordKeyName: isSet(object.passwordKeyName) ? globalThis.String(object.passwordKeyName) : "",
+      cacheTtl: isSet(object.cacheTtl) ? Duration.fromJSON(object.cacheTtl) : undefined,
     };
   },
 
@@ -2222,6 +2548,9 @@ export const DataSourceExternalSecret = {
     if (message.appRole !== undefined) {
       obj.appRole = DataSourceExternalSecret_AppRoleAuthOption.toJSON(message.appRole);
     }
+    if (message.kubernetes !== undefined) {
+      obj.kubernetes = DataSourceExternalSecret_KubernetesAuthOption.toJSON(message.kubernetes);
+    }
     if (message.token !== undefined) {
       obj.token = message.token;
     }
@@ -2234,6 +2563,9 @@ export const DataSourceExternalSecret = {
     if (message.passwordKeyName !== "") {
       obj.passwordKeyName = message.passwordKeyName;
     }
+    if (message.cacheTtl !== undefined) {
+      obj.cacheTtl = Duration.toJSON(message.cacheTtl);
+    }
     return obj;
   },
 
@@ -2248,10 +2580,16 @@ export const DataSourceExternalSecret = {
     message.appRole = (object.appRole !== undefined && object.appRole !== null)
       ? DataSourceExternalSecret_AppRoleAuthOption.fromPartial(object.appRole)
       : undefined;
+    message.kubernetes = (object.kubernetes !== undefined && object.kubernetes !== null)
+      ? DataSourceExternalSecret_KubernetesAuthOption.fromPartial(object.kubernetes)
+      : undefined;
     message.token = object.token ?? undefined;
     message.engineName = object.engineName ?? "";
     message.secretName = object.secretName ?? "";
     message.passwordKeyName = object.passwordKeyName ?? "";
+    message.cacheTtl = (object.cacheTtl !== undefined && object.cacheTtl !== null)
+      ? Duration.fromPartial(object.cacheTtl)
+      : undefined;
     return message;
   },
 };
@@ -2369,6 +2707,101 @@ export const DataSourceExternalSecret_AppRoleAuthOption = {
   },
 };
 
+function createBaseDataSourceExternalSecret_KubernetesAuthOption(): DataSourceExternalSecret_KubernetesAuthOption {
+  return { role: "", serviceAccountTokenPath: "", mountPath: "" };
+}
+
+export const DataSourceExternalSecret_KubernetesAuthOption = {
+  encode(message: DataSourceExternalSecret_KubernetesAuthOption, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.role !== "") {
+      writer.uint32(10).string(message.role);
+    }
+    if (message.serviceAccountTokenPath !== "") {
+      writer.uint32(18).string(message.serviceAccountTokenPath);
+    }
+    if (message.mountPath !== "") {
+      writer.uint32(26).string(message.mountPath);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataSourceExternalSecret_KubernetesAuthOption {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDataSourceExternalSecret_KubernetesAuthOption();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.serviceAccountTokenPath = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.mountPath = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DataSourceExternalSecret_KubernetesAuthOption {
+    return {
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      serviceAccountTokenPath: isSet(object.serviceAccountTokenPath)
+        ? globalThis.String(object.serviceAccountTokenPath)
+        : "",
+      mountPath: isSet(object.mountPath) ? globalThis.String(object.mountPath) : "",
+    };
+  },
+
+  toJSON(message: DataSourceExternalSecret_KubernetesAuthOption): unknown {
+    const obj: any = {};
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.serviceAccountTokenPath !== "") {
+      obj.serviceAccountTokenPath = message.serviceAccountTokenPath;
+    }
+    if (message.mountPath !== "") {
+      obj.mountPath = message.mountPath;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<DataSourceExternalSecret_KubernetesAuthOption>,
+  ): DataSourceExternalSecret_KubernetesAuthOption {
+    return DataSourceExternalSecret_KubernetesAuthOption.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<DataSourceExternalSecret_KubernetesAuthOption>,
+  ): DataSourceExternalSecret_KubernetesAuthOption {
+    const message = createBaseDataSourceExternalSecret_KubernetesAuthOption();
+    message.role = object.role ?? "";
+    message.serviceAccountTokenPath = object.serviceAccountTokenPath ?? "";
+    message.mountPath = object.mountPath ?? "";
+    return message;
+  },
+};
+
 function createBaseDataSource(): DataSource {
   return {
     id: "",
@@ -2405,6 +2838,7 @@ function createBaseDataSource(): DataSource {
     masterUsername: "",
     masterPassword: "",
     redisType: DataSource_RedisType.REDIS_TYPE_UNSPECIFIED,
+    awsRoleArn: "",
   };
 }
 
@@ -2512,6 +2946,9 @@ export const DataSource = {
     if (message.redisType !== DataSource_RedisType.REDIS_TYPE_UNSPECIFIED) {
       writer.uint32(272).int32(dataSource_RedisTypeToNumber(message.redisType));
     }
+    if (message.awsRoleArn !== "") {
+      writer.uint32(282).string(message.awsRoleArn);
+    }
     return writer;
   },
 
@@ -2760,6 +3197,13 @@ export const DataSource = {
 
           message.redisType = dataSource_RedisTypeFromJSON(reader.int32());
           continue;
+        case 35:
+          if (tag !== 282) {
+            break;
+          }
+
+          message.awsRoleArn = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2817,6 +3261,7 @@ export const DataSource = {
       redisType: isSet(object.redisType)
         ? dataSource_RedisTypeFromJSON(object.redisType)
         : DataSource_RedisType.REDIS_TYPE_UNSPECIFIED,
+      awsRoleArn: isSet(object.awsRoleArn) ? globalThis.String(object.awsRoleArn) : "",
     };
   },
 
@@ -2924,6 +3369,9 @@ export const DataSource = {
     if (message.redisType !== DataSource_RedisType.REDIS_TYPE_UNSPECIFIED) {
       obj.redisType = dataSource_RedisTypeToJSON(message.redisType);
     }
+    if (message.awsRoleArn !== "") {
+      obj.awsRoleArn = message.awsRoleArn;
+    }
     return obj;
   },
 
@@ -2970,6 +3418,7 @@ export const DataSource = {
     message.masterUsername = object.masterUsername ?? "";
     message.masterPassword = object.masterPassword ?? "";
     message.redisType = object.redisType ?? DataSource_RedisType.REDIS_TYPE_UNSPECIFIED;
+    message.awsRoleArn = object.awsRoleArn ?? "";
     return message;
   },
 };
@@ -4266,6 +4715,89 @@ export const InstanceServiceDefinition = {
         },
       },
     },
+    /** KillQuery cancels the running query of the connection in the instance, e.g. the one reported by the long-running query anomaly. */
+    killQuery: {
+      name: "KillQuery",
+      requestType: KillQueryRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              46,
+              107,
+              105,
+              108,
+              108,
+              81,
+              117,
+              101,
+              114,
+              121,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              37,
+              58,
+              1,
+              42,
+              34,
+              32,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              107,
+              105,
+              108,
+              108,
+              81,
+              117,
+              101,
+              114,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
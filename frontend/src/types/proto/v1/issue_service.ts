@@ -1,10 +1,14 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { HttpBody } from "../google/api/httpbody";
 import { Duration } from "../google/protobuf/duration";
+import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 import { Expr } from "../google/type/expr";
+import { PlanCheckRun } from "./plan_service";
+import { TaskRun } from "./rollout_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -75,6 +79,10 @@ export interface GetIssueRequest {
    * Format: projects/{project}/issues/{issue}
    */
   name: string;
+  /**
+   * If true, the pending external approvals of the issue are queued to be checked in the background.
+   * The issue is returned without waiting for the check, see external_approvals for the staleness.
+   */
   force: boolean;
 }
 
@@ -113,6 +121,11 @@ export interface ListIssuesRequest {
   filter: string;
   /** Query is the query statement. */
   query: string;
+  /**
+   * The saved search whose filter is combined with the filter above.
+   * Format: savedSearches/{saved_search}
+   */
+  savedSearch: string;
 }
 
 export interface ListIssuesResponse {
@@ -198,6 +211,22 @@ export interface BatchUpdateIssuesStatusRequest {
 export interface BatchUpdateIssuesStatusResponse {
 }
 
+export interface RefreshExternalApprovalsRequest {
+  /**
+   * The project of the issues.
+   * Format: projects/{project}
+   */
+  parent: string;
+}
+
+export interface RefreshExternalApprovalsResponse {
+  /**
+   * The number of the external approvals queued to be checked.
+   * The external approvals which are already in the queue aren't counted.
+   */
+  queuedCount: number;
+}
+
 export interface ApproveIssueRequest {
   /**
    * The name of the issue to add an approver.
@@ -246,8 +275,10 @@ export interface Issue {
   approvalFindingDone: boolean;
   approvalFindingError: string;
   /**
-   * The subscribers.
-   * Format: users/hello@world.com
+   * The subscribers, could be users or groups.
+   * Format:
+   * - users/hello@world.com
+   * - groups/{email}
    */
   subscribers: string[];
   /** Format: users/hello@world.com */
@@ -282,6 +313,7 @@ export interface Issue {
    * - roles/projectOwner
    * - roles/projectReleaser
    * - users/{email}
+   * - groups/{email}
    */
   releasers: string[];
   riskLevel: Issue_RiskLevel;
@@ -298,6 +330,56 @@ export interface Issue {
    */
   taskStatusCount: { [key: string]: number };
   labels: string[];
+  /**
+   * The issue which the changelist of this issue is promoted from.
+   * Format: projects/{project}/issues/{issue}
+   */
+  promotedFromIssue: string;
+  /**
+   * The issues which the changelist of this issue is promoted to.
+   * Format: projects/{project}/issues/{issue}
+   */
+  promotedToIssues: string[];
+  /**
+   * The open issues which likely change the same target databases with the same statements.
+   * It is only set in the response of CreateIssue.
+   * Format: projects/{project}/issues/{issue}
+   */
+  possibleDuplicates: string[];
+  /**
+   * The open issues changing the same tables as this issue.
+   * It is collected from the latest statement conflict plan checks, so it is refreshed whenever the plan checks rerun.
+   * Format: projects/{project}/issues/{issue}
+   */
+  conflicts: string[];
+  /**
+   * The release which the issue is attached to.
+   * Format: projects/{project}/releases/{release}
+   */
+  release: string;
+  /**
+   * The human-friendly key of the issue, which is the project key followed by the sequential number of the issue in the project, e.g. PAY-142.
+   * The key can be used in place of the issue uid in the issue name.
+   */
+  key: string;
+  /**
+   * The priority of the task runs of the issue in the execution queue of the instance.
+   * The task runs of the PRIORITY_HIGH issues, e.g. hotfixes, are scheduled ahead of the others on the same instance.
+   * The unspecified priority is the same as PRIORITY_NORMAL.
+   */
+  priority: Issue_Priority;
+  /** The pending external approvals of the issue, it's only returned by GetIssue. */
+  externalApprovals: Issue_ExternalApproval[];
+  /** The linked ticket in the issue tracker of the project, see the ticket_sync of the project. */
+  externalTicket:
+    | Issue_ExternalTicket
+    | undefined;
+  /**
+   * The markdown description where the references to the plan specs and the sheets, e.g. {{spec:<spec id>}}
+   * and {{sheet:projects/{project}/sheets/{sheet}}}, are replaced by the collapsible SQL diff blocks
+   * against the current schema of the target databases. It's only returned by GetIssue.
+   */
+  renderedDescription: string;
 }
 
 export enum Issue_Type {
@@ -305,6 +387,10 @@ export enum Issue_Type {
   DATABASE_CHANGE = "DATABASE_CHANGE",
   GRANT_REQUEST = "GRANT_REQUEST",
   DATABASE_DATA_EXPORT = "DATABASE_DATA_EXPORT",
+  DATABASE_RESTORE = "DATABASE_RESTORE",
+  /** DATABASE_CREATE - The issue provisions new databases from the create database configs of the plan. */
+  DATABASE_CREATE = "DATABASE_CREATE",
+  DATABASE_DATA_LOAD = "DATABASE_DATA_LOAD",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -322,6 +408,15 @@ export function issue_TypeFromJSON(object: any): Issue_Type {
     case 3:
     case "DATABASE_DATA_EXPORT":
       return Issue_Type.DATABASE_DATA_EXPORT;
+    case 4:
+    case "DATABASE_RESTORE":
+      return Issue_Type.DATABASE_RESTORE;
+    case 5:
+    case "DATABASE_CREATE":
+      return Issue_Type.DATABASE_CREATE;
+    case 6:
+    case "DATABASE_DATA_LOAD":
+      return Issue_Type.DATABASE_DATA_LOAD;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -339,6 +434,12 @@ export function issue_TypeToJSON(object: Issue_Type): string {
       return "GRANT_REQUEST";
     case Issue_Type.DATABASE_DATA_EXPORT:
       return "DATABASE_DATA_EXPORT";
+    case Issue_Type.DATABASE_RESTORE:
+      return "DATABASE_RESTORE";
+    case Issue_Type.DATABASE_CREATE:
+      return "DATABASE_CREATE";
+    case Issue_Type.DATABASE_DATA_LOAD:
+      return "DATABASE_DATA_LOAD";
     case Issue_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -355,6 +456,12 @@ export function issue_TypeToNumber(object: Issue_Type): number {
       return 2;
     case Issue_Type.DATABASE_DATA_EXPORT:
       return 3;
+    case Issue_Type.DATABASE_RESTORE:
+      return 4;
+    case Issue_Type.DATABASE_CREATE:
+      return 5;
+    case Issue_Type.DATABASE_DATA_LOAD:
+      return 6;
     case Issue_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -422,6 +529,67 @@ export function issue_RiskLevelToNumber(object: Issue_RiskLevel): number {
   }
 }
 
+export enum Issue_Priority {
+  PRIORITY_UNSPECIFIED = "PRIORITY_UNSPECIFIED",
+  PRIORITY_LOW = "PRIORITY_LOW",
+  PRIORITY_NORMAL = "PRIORITY_NORMAL",
+  PRIORITY_HIGH = "PRIORITY_HIGH",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function issue_PriorityFromJSON(object: any): Issue_Priority {
+  switch (object) {
+    case 0:
+    case "PRIORITY_UNSPECIFIED":
+      return Issue_Priority.PRIORITY_UNSPECIFIED;
+    case 1:
+    case "PRIORITY_LOW":
+      return Issue_Priority.PRIORITY_LOW;
+    case 2:
+    case "PRIORITY_NORMAL":
+      return Issue_Priority.PRIORITY_NORMAL;
+    case 3:
+    case "PRIORITY_HIGH":
+      return Issue_Priority.PRIORITY_HIGH;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Issue_Priority.UNRECOGNIZED;
+  }
+}
+
+export function issue_PriorityToJSON(object: Issue_Priority): string {
+  switch (object) {
+    case Issue_Priority.PRIORITY_UNSPECIFIED:
+      return "PRIORITY_UNSPECIFIED";
+    case Issue_Priority.PRIORITY_LOW:
+      return "PRIORITY_LOW";
+    case Issue_Priority.PRIORITY_NORMAL:
+      return "PRIORITY_NORMAL";
+    case Issue_Priority.PRIORITY_HIGH:
+      return "PRIORITY_HIGH";
+    case Issue_Priority.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function issue_PriorityToNumber(object: Issue_Priority): number {
+  switch (object) {
+    case Issue_Priority.PRIORITY_UNSPECIFIED:
+      return 0;
+    case Issue_Priority.PRIORITY_LOW:
+      return 1;
+    case Issue_Priority.PRIORITY_NORMAL:
+      return 2;
+    case Issue_Priority.PRIORITY_HIGH:
+      return 3;
+    case Issue_Priority.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface Issue_Approver {
   /** The new status. */
   status: Issue_Approver_Status;
@@ -495,6 +663,28 @@ export interface Issue_TaskStatusCountEntry {
   value: number;
 }
 
+export interface Issue_ExternalApproval {
+  /** The id of the external approval node in the workspace external approval setting. */
+  nodeId: string;
+  /** The last time the external approval was checked, unset if it has never been checked. */
+  lastCheckTime:
+    | Date
+    | undefined;
+  /** The error of the last check, empty if it succeeded. */
+  lastCheckError: string;
+  /** The status is stale if it hasn't been checked successfully recently. */
+  stale: boolean;
+}
+
+export interface Issue_ExternalTicket {
+  /** The key of the ticket, e.g. PAY-123. */
+  key: string;
+  /** The URL to view the ticket. */
+  url: string;
+  /** The last synced status of the ticket. */
+  status: string;
+}
+
 export interface GrantRequest {
   /**
    * The requested role.
@@ -595,7 +785,14 @@ export interface ApprovalNode {
     | undefined;
   /** Format: roles/{role} */
   role?: string | undefined;
-  externalNodeId?: string | undefined;
+  externalNodeId?:
+    | string
+    | undefined;
+  /**
+   * The user group whose members can approve the node.
+   * Format: groups/{email}
+   */
+  group?: string | undefined;
 }
 
 /**
@@ -729,17 +926,17 @@ export interface ListIssueCommentsRequest {
   /** Format: projects/{projects}/issues/{issue} */
   parent: string;
   /**
-   * The maximum number of issues to return. The service may return fewer than
+   * The maximum number of issue comments to return. The service may return fewer than
    * this value.
-   * If unspecified, at most 50 issues will be returned.
+   * If unspecified, at most 10 issue comments will be returned.
    * The maximum value is 1000; values above 1000 will be coerced to 1000.
    */
   pageSize: number;
   /**
-   * A page token, received from a previous `ListIssues` call.
+   * A page token, received from a previous `ListIssueComments` call.
    * Provide this to retrieve the subsequent page.
    *
-   * When paginating, all other parameters provided to `ListIssues` must match
+   * When paginating, all other parameters provided to `ListIssueComments` must match
    * the call that provided the page token.
    */
   pageToken: string;
@@ -754,6 +951,300 @@ export interface ListIssueCommentsResponse {
   nextPageToken: string;
 }
 
+export interface ListChangeCalendarEventsRequest {
+  /**
+   * The parent, which owns this collection of events.
+   * Format: projects/{project}
+   * Use "projects/-" to list the events in all projects.
+   */
+  parent: string;
+  /** The start of the time range, inclusive. */
+  startTime:
+    | Date
+    | undefined;
+  /**
+   * The end of the time range, exclusive.
+   * The time range cannot exceed one year.
+   */
+  endTime: Date | undefined;
+}
+
+export interface ListChangeCalendarEventsResponse {
+  /** The events ordered by the start time. */
+  events: ChangeCalendarEvent[];
+}
+
+export interface ExportChangeCalendarRequest {
+  /**
+   * The parent, which owns this collection of events.
+   * Format: projects/{project}
+   * Use "projects/-" to export the events in all projects.
+   */
+  parent: string;
+  /** The start of the time range, inclusive. */
+  startTime:
+    | Date
+    | undefined;
+  /**
+   * The end of the time range, exclusive.
+   * The time range cannot exceed one year.
+   */
+  endTime: Date | undefined;
+}
+
+export interface ExportChangeCalendarResponse {
+  /** The iCalendar (RFC 5545) content. */
+  content: Uint8Array;
+}
+
+export interface PurgeIssuesRequest {
+  /**
+   * The parent of the issues to purge.
+   * Format: projects/{project}
+   * Use "projects/-" to purge the issues in all projects.
+   */
+  parent: string;
+  /** The done and canceled issues created before the time are purged. */
+  createTimeBefore:
+    | Date
+    | undefined;
+  /** If set to true, nothing is deleted, and the response reports what would be removed. */
+  dryRun: boolean;
+}
+
+export interface PurgeIssuesResponse {
+  /**
+   * The names of the purged issues.
+   * Format: projects/{project}/issues/{issue}
+   */
+  issues: string[];
+  issueCount: Long;
+  commentCount: Long;
+  taskRunCount: Long;
+  sheetCount: Long;
+}
+
+export interface ChangeCalendarEvent {
+  type: ChangeCalendarEvent_Type;
+  /** The title of the event. */
+  title: string;
+  /** Format: projects/{project}/issues/{issue} */
+  issue: string;
+  /** Format: environments/{environment} */
+  environment: string;
+  startTime: Date | undefined;
+  endTime: Date | undefined;
+}
+
+export enum ChangeCalendarEvent_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  /** SCHEDULED_ROLLOUT - The rollout of the stage is scheduled at the start time. */
+  SCHEDULED_ROLLOUT = "SCHEDULED_ROLLOUT",
+  /** COMPLETED_ROLLOUT - The rollout of the stage ran from the start time to the end time. */
+  COMPLETED_ROLLOUT = "COMPLETED_ROLLOUT",
+  /**
+   * APPROVAL_DUE - The approval of the issue is due at the start time, which is the scheduled rollout time.
+   * It's only used in the calendar feed.
+   */
+  APPROVAL_DUE = "APPROVAL_DUE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function changeCalendarEvent_TypeFromJSON(object: any): ChangeCalendarEvent_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return ChangeCalendarEvent_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "SCHEDULED_ROLLOUT":
+      return ChangeCalendarEvent_Type.SCHEDULED_ROLLOUT;
+    case 2:
+    case "COMPLETED_ROLLOUT":
+      return ChangeCalendarEvent_Type.COMPLETED_ROLLOUT;
+    case 3:
+    case "APPROVAL_DUE":
+      return ChangeCalendarEvent_Type.APPROVAL_DUE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ChangeCalendarEvent_Type.UNRECOGNIZED;
+  }
+}
+
+export function changeCalendarEvent_TypeToJSON(object: ChangeCalendarEvent_Type): string {
+  switch (object) {
+    case ChangeCalendarEvent_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case ChangeCalendarEvent_Type.SCHEDULED_ROLLOUT:
+      return "SCHEDULED_ROLLOUT";
+    case ChangeCalendarEvent_Type.COMPLETED_ROLLOUT:
+      return "COMPLETED_ROLLOUT";
+    case ChangeCalendarEvent_Type.APPROVAL_DUE:
+      return "APPROVAL_DUE";
+    case ChangeCalendarEvent_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function changeCalendarEvent_TypeToNumber(object: ChangeCalendarEvent_Type): number {
+  switch (object) {
+    case ChangeCalendarEvent_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case ChangeCalendarEvent_Type.SCHEDULED_ROLLOUT:
+      return 1;
+    case ChangeCalendarEvent_Type.COMPLETED_ROLLOUT:
+      return 2;
+    case ChangeCalendarEvent_Type.APPROVAL_DUE:
+      return 3;
+    case ChangeCalendarEvent_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface RegenerateCalendarFeedRequest {
+}
+
+export interface CalendarFeed {
+  /**
+   * The secret iCalendar feed URL.
+   * Format: {external_url}/v1/calendarFeeds/{token}.ics
+   */
+  url: string;
+}
+
+export interface GetCalendarFeedRequest {
+  /** The secret token in the feed URL, optionally with the ".ics" suffix. */
+  token: string;
+}
+
+export interface GetIssueTimelineRequest {
+  /** Format: projects/{projects}/issues/{issue} */
+  parent: string;
+  /**
+   * The maximum number of entries to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 10 entries will be returned.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `GetIssueTimeline` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `GetIssueTimeline` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+}
+
+export interface GetIssueTimelineResponse {
+  /** The entries ordered by time ascending. */
+  entries: IssueTimelineEntry[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface IssueTimelineEntry {
+  type: IssueTimelineEntry_Type;
+  /**
+   * The time of the entry.
+   * For task runs, it is the time of the latest state change.
+   */
+  time:
+    | Date
+    | undefined;
+  /** Set for COMMENT, ACTIVITY and APPROVAL entries. */
+  issueComment?: IssueComment | undefined;
+  planCheckRun?: PlanCheckRun | undefined;
+  taskRun?: TaskRun | undefined;
+}
+
+export enum IssueTimelineEntry_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  /** COMMENT - A comment written by a user. */
+  COMMENT = "COMMENT",
+  /** ACTIVITY - An activity on the issue, such as issue updates, stage ends and task updates. */
+  ACTIVITY = "ACTIVITY",
+  /** APPROVAL - An approval event. */
+  APPROVAL = "APPROVAL",
+  /** PLAN_CHECK_RUN - A plan check run. */
+  PLAN_CHECK_RUN = "PLAN_CHECK_RUN",
+  /** TASK_RUN - A task run state change. */
+  TASK_RUN = "TASK_RUN",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function issueTimelineEntry_TypeFromJSON(object: any): IssueTimelineEntry_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return IssueTimelineEntry_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "COMMENT":
+      return IssueTimelineEntry_Type.COMMENT;
+    case 2:
+    case "ACTIVITY":
+      return IssueTimelineEntry_Type.ACTIVITY;
+    case 3:
+    case "APPROVAL":
+      return IssueTimelineEntry_Type.APPROVAL;
+    case 4:
+    case "PLAN_CHECK_RUN":
+      return IssueTimelineEntry_Type.PLAN_CHECK_RUN;
+    case 5:
+    case "TASK_RUN":
+      return IssueTimelineEntry_Type.TASK_RUN;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return IssueTimelineEntry_Type.UNRECOGNIZED;
+  }
+}
+
+export function issueTimelineEntry_TypeToJSON(object: IssueTimelineEntry_Type): string {
+  switch (object) {
+    case IssueTimelineEntry_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case IssueTimelineEntry_Type.COMMENT:
+      return "COMMENT";
+    case IssueTimelineEntry_Type.ACTIVITY:
+      return "ACTIVITY";
+    case IssueTimelineEntry_Type.APPROVAL:
+      return "APPROVAL";
+    case IssueTimelineEntry_Type.PLAN_CHECK_RUN:
+      return "PLAN_CHECK_RUN";
+    case IssueTimelineEntry_Type.TASK_RUN:
+      return "TASK_RUN";
+    case IssueTimelineEntry_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function issueTimelineEntry_TypeToNumber(object: IssueTimelineEntry_Type): number {
+  switch (object) {
+    case IssueTimelineEntry_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case IssueTimelineEntry_Type.COMMENT:
+      return 1;
+    case IssueTimelineEntry_Type.ACTIVITY:
+      return 2;
+    case IssueTimelineEntry_Type.APPROVAL:
+      return 3;
+    case IssueTimelineEntry_Type.PLAN_CHECK_RUN:
+      return 4;
+    case IssueTimelineEntry_Type.TASK_RUN:
+      return 5;
+    case IssueTimelineEntry_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 export interface CreateIssueCommentRequest {
   /**
    * The issue name
@@ -776,6 +1267,38 @@ export interface UpdateIssueCommentRequest {
   updateMask: string[] | undefined;
 }
 
+export interface DeleteIssueCommentRequest {
+  /**
+   * The name of the issue comment to delete.
+   * Format: projects/{project}/issues/{issue}/issueComments/{issueComment-uid}
+   */
+  name: string;
+}
+
+export interface ListIssueCommentRevisionsRequest {
+  /**
+   * The name of the issue comment.
+   * Format: projects/{project}/issues/{issue}/issueComments/{issueComment-uid}
+   */
+  parent: string;
+}
+
+export interface ListIssueCommentRevisionsResponse {
+  revisions: IssueCommentRevision[];
+}
+
+/** IssueCommentRevision is a previous comment of the issue comment. */
+export interface IssueCommentRevision {
+  comment: string;
+  /**
+   * The updater of the comment before it's edited or deleted.
+   * Format: users/{email}
+   */
+  creator: string;
+  /** The update time of the comment before it's edited or deleted. */
+  createTime: Date | undefined;
+}
+
 export interface IssueComment {
   uid: string;
   comment: string;
@@ -794,6 +1317,13 @@ export interface IssueComment {
   stageEnd?: IssueComment_StageEnd | undefined;
   taskUpdate?: IssueComment_TaskUpdate | undefined;
   taskPriorBackup?: IssueComment_TaskPriorBackup | undefined;
+  stageApproval?:
+    | IssueComment_StageApproval
+    | undefined;
+  /** Edited is true if the comment has been edited or deleted, the previous comments are listed by ListIssueCommentRevisions. */
+  edited: boolean;
+  /** Deleted is true if the comment has been deleted, the comment of the tombstone is empty. */
+  deleted: boolean;
 }
 
 export interface IssueComment_Approval {
@@ -986,6 +1516,65 @@ export interface IssueComment_TaskPriorBackup_Table {
   table: string;
 }
 
+export interface IssueComment_StageApproval {
+  /** Format: projects/{project}/rollouts/{rollout}/stages/{stage} */
+  stage: string;
+  status: IssueComment_StageApproval_Status;
+}
+
+export enum IssueComment_StageApproval_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  APPROVED = "APPROVED",
+  REJECTED = "REJECTED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function issueComment_StageApproval_StatusFromJSON(object: any): IssueComment_StageApproval_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return IssueComment_StageApproval_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "APPROVED":
+      return IssueComment_StageApproval_Status.APPROVED;
+    case 2:
+    case "REJECTED":
+      return IssueComment_StageApproval_Status.REJECTED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return IssueComment_StageApproval_Status.UNRECOGNIZED;
+  }
+}
+
+export function issueComment_StageApproval_StatusToJSON(object: IssueComment_StageApproval_Status): string {
+  switch (object) {
+    case IssueComment_StageApproval_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case IssueComment_StageApproval_Status.APPROVED:
+      return "APPROVED";
+    case IssueComment_StageApproval_Status.REJECTED:
+      return "REJECTED";
+    case IssueComment_StageApproval_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function issueComment_StageApproval_StatusToNumber(object: IssueComment_StageApproval_Status): number {
+  switch (object) {
+    case IssueComment_StageApproval_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case IssueComment_StageApproval_Status.APPROVED:
+      return 1;
+    case IssueComment_StageApproval_Status.REJECTED:
+      return 2;
+    case IssueComment_StageApproval_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 function createBaseGetIssueRequest(): GetIssueRequest {
   return { name: "", force: false };
 }
@@ -1135,7 +1724,7 @@ export const CreateIssueRequest = {
 };
 
 function createBaseListIssuesRequest(): ListIssuesRequest {
-  return { parent: "", pageSize: 0, pageToken: "", filter: "", query: "" };
+  return { parent: "", pageSize: 0, pageToken: "", filter: "", query: "", savedSearch: "" };
 }
 
 export const ListIssuesRequest = {
@@ -1155,6 +1744,9 @@ export const ListIssuesRequest = {
     if (message.query !== "") {
       writer.uint32(42).string(message.query);
     }
+    if (message.savedSearch !== "") {
+      writer.uint32(50).string(message.savedSearch);
+    }
     return writer;
   },
 
@@ -1200,6 +1792,13 @@ export const ListIssuesRequest = {
 
           message.query = reader.string();
           continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.savedSearch = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1216,6 +1815,7 @@ export const ListIssuesRequest = {
       pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
       filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
       query: isSet(object.query) ? globalThis.String(object.query) : "",
+      savedSearch: isSet(object.savedSearch) ? globalThis.String(object.savedSearch) : "",
     };
   },
 
@@ -1236,6 +1836,9 @@ export const ListIssuesRequest = {
     if (message.query !== "") {
       obj.query = message.query;
     }
+    if (message.savedSearch !== "") {
+      obj.savedSearch = message.savedSearch;
+    }
     return obj;
   },
 
@@ -1249,6 +1852,7 @@ export const ListIssuesRequest = {
     message.pageToken = object.pageToken ?? "";
     message.filter = object.filter ?? "";
     message.query = object.query ?? "";
+    message.savedSearch = object.savedSearch ?? "";
     return message;
   },
 };
@@ -1741,6 +2345,120 @@ export const BatchUpdateIssuesStatusResponse = {
   },
 };
 
+function createBaseRefreshExternalApprovalsRequest(): RefreshExternalApprovalsRequest {
+  return { parent: "" };
+}
+
+export const RefreshExternalApprovalsRequest = {
+  encode(message: RefreshExternalApprovalsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RefreshExternalApprovalsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRefreshExternalApprovalsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RefreshExternalApprovalsRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: RefreshExternalApprovalsRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RefreshExternalApprovalsRequest>): RefreshExternalApprovalsRequest {
+    return RefreshExternalApprovalsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RefreshExternalApprovalsRequest>): RefreshExternalApprovalsRequest {
+    const message = createBaseRefreshExternalApprovalsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseRefreshExternalApprovalsResponse(): RefreshExternalApprovalsResponse {
+  return { queuedCount: 0 };
+}
+
+export const RefreshExternalApprovalsResponse = {
+  encode(message: RefreshExternalApprovalsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.queuedCount !== 0) {
+      writer.uint32(8).int32(message.queuedCount);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RefreshExternalApprovalsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRefreshExternalApprovalsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.queuedCount = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RefreshExternalApprovalsResponse {
+    return { queuedCount: isSet(object.queuedCount) ? globalThis.Number(object.queuedCount) : 0 };
+  },
+
+  toJSON(message: RefreshExternalApprovalsResponse): unknown {
+    const obj: any = {};
+    if (message.queuedCount !== 0) {
+      obj.queuedCount = Math.round(message.queuedCount);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RefreshExternalApprovalsResponse>): RefreshExternalApprovalsResponse {
+    return RefreshExternalApprovalsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RefreshExternalApprovalsResponse>): RefreshExternalApprovalsResponse {
+    const message = createBaseRefreshExternalApprovalsResponse();
+    message.queuedCount = object.queuedCount ?? 0;
+    return message;
+  },
+};
+
 function createBaseApproveIssueRequest(): ApproveIssueRequest {
   return { name: "", comment: "" };
 }
@@ -1986,6 +2704,16 @@ function createBaseIssue(): Issue {
     riskLevel: Issue_RiskLevel.RISK_LEVEL_UNSPECIFIED,
     taskStatusCount: {},
     labels: [],
+    promotedFromIssue: "",
+    promotedToIssues: [],
+    possibleDuplicates: [],
+    conflicts: [],
+    release: "",
+    key: "",
+    priority: Issue_Priority.PRIORITY_UNSPECIFIED,
+    externalApprovals: [],
+    externalTicket: undefined,
+    renderedDescription: "",
   };
 }
 
@@ -2054,6 +2782,36 @@ export const Issue = {
     for (const v of message.labels) {
       writer.uint32(186).string(v!);
     }
+    if (message.promotedFromIssue !== "") {
+      writer.uint32(194).string(message.promotedFromIssue);
+    }
+    for (const v of message.promotedToIssues) {
+      writer.uint32(202).string(v!);
+    }
+    for (const v of message.possibleDuplicates) {
+      writer.uint32(210).string(v!);
+    }
+    for (const v of message.conflicts) {
+      writer.uint32(218).string(v!);
+    }
+    if (message.release !== "") {
+      writer.uint32(226).string(message.release);
+    }
+    if (message.key !== "") {
+      writer.uint32(234).string(message.key);
+    }
+    if (message.priority !== Issue_Priority.PRIORITY_UNSPECIFIED) {
+      writer.uint32(240).int32(issue_PriorityToNumber(message.priority));
+    }
+    for (const v of message.externalApprovals) {
+      Issue_ExternalApproval.encode(v!, writer.uint32(250).fork()).ldelim();
+    }
+    if (message.externalTicket !== undefined) {
+      Issue_ExternalTicket.encode(message.externalTicket, writer.uint32(258).fork()).ldelim();
+    }
+    if (message.renderedDescription !== "") {
+      writer.uint32(266).string(message.renderedDescription);
+    }
     return writer;
   },
 
@@ -2214,6 +2972,76 @@ export const Issue = {
 
           message.labels.push(reader.string());
           continue;
+        case 24:
+          if (tag !== 194) {
+            break;
+          }
+
+          message.promotedFromIssue = reader.string();
+          continue;
+        case 25:
+          if (tag !== 202) {
+            break;
+          }
+
+          message.promotedToIssues.push(reader.string());
+          continue;
+        case 26:
+          if (tag !== 210) {
+            break;
+          }
+
+          message.possibleDuplicates.push(reader.string());
+          continue;
+        case 27:
+          if (tag !== 218) {
+            break;
+          }
+
+          message.conflicts.push(reader.string());
+          continue;
+        case 28:
+          if (tag !== 226) {
+            break;
+          }
+
+          message.release = reader.string();
+          continue;
+        case 29:
+          if (tag !== 234) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 30:
+          if (tag !== 240) {
+            break;
+          }
+
+          message.priority = issue_PriorityFromJSON(reader.int32());
+          continue;
+        case 31:
+          if (tag !== 250) {
+            break;
+          }
+
+          message.externalApprovals.push(Issue_ExternalApproval.decode(reader, reader.uint32()));
+          continue;
+        case 32:
+          if (tag !== 258) {
+            break;
+          }
+
+          message.externalTicket = Issue_ExternalTicket.decode(reader, reader.uint32());
+          continue;
+        case 33:
+          if (tag !== 266) {
+            break;
+          }
+
+          message.renderedDescription = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2260,9 +3088,25 @@ export const Issue = {
           return acc;
         }, {})
         : {},
-      labels: globalThis.Array.isArray(object?.labels)
-        ? object.labels.map((e: any) => globalThis.String(e))
+      labels: globalThis.Array.isArray(object?.labels) ? object.labels.map((e: any) => globalThis.String(e)) : [],
+      promotedFromIssue: isSet(object.promotedFromIssue) ? globalThis.String(object.promotedFromIssue) : "",
+      promotedToIssues: globalThis.Array.isArray(object?.promotedToIssues)
+        ? object.promotedToIssues.map((e: any) => globalThis.String(e))
+        : [],
+      possibleDuplicates: globalThis.Array.isArray(object?.possibleDuplicates)
+        ? object.possibleDuplicates.map((e: any) => globalThis.String(e))
         : [],
+      conflicts: globalThis.Array.isArray(object?.conflicts)
+        ? object.conflicts.map((e: any) => globalThis.String(e))
+        : [],
+      release: isSet(object.release) ? globalThis.String(object.release) : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      priority: isSet(object.priority) ? issue_PriorityFromJSON(object.priority) : Issue_Priority.PRIORITY_UNSPECIFIED,
+      externalApprovals: globalThis.Array.isArray(object?.externalApprovals)
+        ? object.externalApprovals.map((e: any) => Issue_ExternalApproval.fromJSON(e))
+        : [],
+      externalTicket: isSet(object.externalTicket) ? Issue_ExternalTicket.fromJSON(object.externalTicket) : undefined,
+      renderedDescription: isSet(object.renderedDescription) ? globalThis.String(object.renderedDescription) : "",
     };
   },
 
@@ -2337,6 +3181,36 @@ export const Issue = {
     if (message.labels?.length) {
       obj.labels = message.labels;
     }
+    if (message.promotedFromIssue !== "") {
+      obj.promotedFromIssue = message.promotedFromIssue;
+    }
+    if (message.promotedToIssues?.length) {
+      obj.promotedToIssues = message.promotedToIssues;
+    }
+    if (message.possibleDuplicates?.length) {
+      obj.possibleDuplicates = message.possibleDuplicates;
+    }
+    if (message.conflicts?.length) {
+      obj.conflicts = message.conflicts;
+    }
+    if (message.release !== "") {
+      obj.release = message.release;
+    }
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.priority !== Issue_Priority.PRIORITY_UNSPECIFIED) {
+      obj.priority = issue_PriorityToJSON(message.priority);
+    }
+    if (message.externalApprovals?.length) {
+      obj.externalApprovals = message.externalApprovals.map((e) => Issue_ExternalApproval.toJSON(e));
+    }
+    if (message.externalTicket !== undefined) {
+      obj.externalTicket = Issue_ExternalTicket.toJSON(message.externalTicket);
+    }
+    if (message.renderedDescription !== "") {
+      obj.renderedDescription = message.renderedDescription;
+    }
     return obj;
   },
 
@@ -2376,6 +3250,18 @@ export const Issue = {
       {},
     );
     message.labels = object.labels?.map((e) => e) || [];
+    message.promotedFromIssue = object.promotedFromIssue ?? "";
+    message.promotedToIssues = object.promotedToIssues?.map((e) => e) || [];
+    message.possibleDuplicates = object.possibleDuplicates?.map((e) => e) || [];
+    message.conflicts = object.conflicts?.map((e) => e) || [];
+    message.release = object.release ?? "";
+    message.key = object.key ?? "";
+    message.priority = object.priority ?? Issue_Priority.PRIORITY_UNSPECIFIED;
+    message.externalApprovals = object.externalApprovals?.map((e) => Issue_ExternalApproval.fromPartial(e)) || [];
+    message.externalTicket = (object.externalTicket !== undefined && object.externalTicket !== null)
+      ? Issue_ExternalTicket.fromPartial(object.externalTicket)
+      : undefined;
+    message.renderedDescription = object.renderedDescription ?? "";
     return message;
   },
 };
@@ -2530,31 +3416,31 @@ export const Issue_TaskStatusCountEntry = {
   },
 };
 
-function createBaseGrantRequest(): GrantRequest {
-  return { role: "", user: "", condition: undefined, expiration: undefined };
+function createBaseIssue_ExternalApproval(): Issue_ExternalApproval {
+  return { nodeId: "", lastCheckTime: undefined, lastCheckError: "", stale: false };
 }
 
-export const GrantRequest = {
-  encode(message: GrantRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.role !== "") {
-      writer.uint32(10).string(message.role);
+export const Issue_ExternalApproval = {
+  encode(message: Issue_ExternalApproval, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.nodeId !== "") {
+      writer.uint32(10).string(message.nodeId);
     }
-    if (message.user !== "") {
-      writer.uint32(18).string(message.user);
+    if (message.lastCheckTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.lastCheckTime), writer.uint32(18).fork()).ldelim();
     }
-    if (message.condition !== undefined) {
-      Expr.encode(message.condition, writer.uint32(26).fork()).ldelim();
+    if (message.lastCheckError !== "") {
+      writer.uint32(26).string(message.lastCheckError);
     }
-    if (message.expiration !== undefined) {
-      Duration.encode(message.expiration, writer.uint32(34).fork()).ldelim();
+    if (message.stale === true) {
+      writer.uint32(32).bool(message.stale);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GrantRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Issue_ExternalApproval {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGrantRequest();
+    const message = createBaseIssue_ExternalApproval();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2563,28 +3449,28 @@ export const GrantRequest = {
             break;
           }
 
-          message.role = reader.string();
+          message.nodeId = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.user = reader.string();
+          message.lastCheckTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.condition = Expr.decode(reader, reader.uint32());
+          message.lastCheckError = reader.string();
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.expiration = Duration.decode(reader, reader.uint32());
+          message.stale = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2595,60 +3481,253 @@ export const GrantRequest = {
     return message;
   },
 
-  fromJSON(object: any): GrantRequest {
+  fromJSON(object: any): Issue_ExternalApproval {
     return {
-      role: isSet(object.role) ? globalThis.String(object.role) : "",
-      user: isSet(object.user) ? globalThis.String(object.user) : "",
-      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
-      expiration: isSet(object.expiration) ? Duration.fromJSON(object.expiration) : undefined,
+      nodeId: isSet(object.nodeId) ? globalThis.String(object.nodeId) : "",
+      lastCheckTime: isSet(object.lastCheckTime) ? fromJsonTimestamp(object.lastCheckTime) : undefined,
+      lastCheckError: isSet(object.lastCheckError) ? globalThis.String(object.lastCheckError) : "",
+      stale: isSet(object.stale) ? globalThis.Boolean(object.stale) : false,
     };
   },
 
-  toJSON(message: GrantRequest): unknown {
+  toJSON(message: Issue_ExternalApproval): unknown {
     const obj: any = {};
-    if (message.role !== "") {
-      obj.role = message.role;
+    if (message.nodeId !== "") {
+      obj.nodeId = message.nodeId;
     }
-    if (message.user !== "") {
-      obj.user = message.user;
+    if (message.lastCheckTime !== undefined) {
+      obj.lastCheckTime = message.lastCheckTime.toISOString();
     }
-    if (message.condition !== undefined) {
-      obj.condition = Expr.toJSON(message.condition);
+    if (message.lastCheckError !== "") {
+      obj.lastCheckError = message.lastCheckError;
     }
-    if (message.expiration !== undefined) {
-      obj.expiration = Duration.toJSON(message.expiration);
+    if (message.stale === true) {
+      obj.stale = message.stale;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<GrantRequest>): GrantRequest {
-    return GrantRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<Issue_ExternalApproval>): Issue_ExternalApproval {
+    return Issue_ExternalApproval.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GrantRequest>): GrantRequest {
-    const message = createBaseGrantRequest();
-    message.role = object.role ?? "";
-    message.user = object.user ?? "";
-    message.condition = (object.condition !== undefined && object.condition !== null)
-      ? Expr.fromPartial(object.condition)
-      : undefined;
-    message.expiration = (object.expiration !== undefined && object.expiration !== null)
-      ? Duration.fromPartial(object.expiration)
-      : undefined;
+  fromPartial(object: DeepPartial<Issue_ExternalApproval>): Issue_ExternalApproval {
+    const message = createBaseIssue_ExternalApproval();
+    message.nodeId = object.nodeId ?? "";
+    message.lastCheckTime = object.lastCheckTime ?? undefined;
+    message.lastCheckError = object.lastCheckError ?? "";
+    message.stale = object.stale ?? false;
     return message;
   },
 };
 
-function createBaseApprovalTemplate(): ApprovalTemplate {
-  return { flow: undefined, title: "", description: "", creator: "" };
+function createBaseIssue_ExternalTicket(): Issue_ExternalTicket {
+  return { key: "", url: "", status: "" };
 }
 
-export const ApprovalTemplate = {
-  encode(message: ApprovalTemplate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.flow !== undefined) {
-      ApprovalFlow.encode(message.flow, writer.uint32(10).fork()).ldelim();
+export const Issue_ExternalTicket = {
+  encode(message: Issue_ExternalTicket, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.title !== "") {
-      writer.uint32(18).string(message.title);
+    if (message.url !== "") {
+      writer.uint32(18).string(message.url);
+    }
+    if (message.status !== "") {
+      writer.uint32(26).string(message.status);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Issue_ExternalTicket {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssue_ExternalTicket();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.status = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Issue_ExternalTicket {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      status: isSet(object.status) ? globalThis.String(object.status) : "",
+    };
+  },
+
+  toJSON(message: Issue_ExternalTicket): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    if (message.status !== "") {
+      obj.status = message.status;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Issue_ExternalTicket>): Issue_ExternalTicket {
+    return Issue_ExternalTicket.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Issue_ExternalTicket>): Issue_ExternalTicket {
+    const message = createBaseIssue_ExternalTicket();
+    message.key = object.key ?? "";
+    message.url = object.url ?? "";
+    message.status = object.status ?? "";
+    return message;
+  },
+};
+
+function createBaseGrantRequest(): GrantRequest {
+  return { role: "", user: "", condition: undefined, expiration: undefined };
+}
+
+export const GrantRequest = {
+  encode(message: GrantRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.role !== "") {
+      writer.uint32(10).string(message.role);
+    }
+    if (message.user !== "") {
+      writer.uint32(18).string(message.user);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.expiration !== undefined) {
+      Duration.encode(message.expiration, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GrantRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGrantRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expiration = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GrantRequest {
+    return {
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      user: isSet(object.user) ? globalThis.String(object.user) : "",
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+      expiration: isSet(object.expiration) ? Duration.fromJSON(object.expiration) : undefined,
+    };
+  },
+
+  toJSON(message: GrantRequest): unknown {
+    const obj: any = {};
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.user !== "") {
+      obj.user = message.user;
+    }
+    if (message.condition !== undefined) {
+      obj.condition = Expr.toJSON(message.condition);
+    }
+    if (message.expiration !== undefined) {
+      obj.expiration = Duration.toJSON(message.expiration);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GrantRequest>): GrantRequest {
+    return GrantRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GrantRequest>): GrantRequest {
+    const message = createBaseGrantRequest();
+    message.role = object.role ?? "";
+    message.user = object.user ?? "";
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
+    message.expiration = (object.expiration !== undefined && object.expiration !== null)
+      ? Duration.fromPartial(object.expiration)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseApprovalTemplate(): ApprovalTemplate {
+  return { flow: undefined, title: "", description: "", creator: "" };
+}
+
+export const ApprovalTemplate = {
+  encode(message: ApprovalTemplate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.flow !== undefined) {
+      ApprovalFlow.encode(message.flow, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
     }
     if (message.description !== "") {
       writer.uint32(26).string(message.description);
@@ -2883,6 +3962,7 @@ function createBaseApprovalNode(): ApprovalNode {
     groupValue: undefined,
     role: undefined,
     externalNodeId: undefined,
+    group: undefined,
   };
 }
 
@@ -2900,6 +3980,9 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       writer.uint32(34).string(message.externalNodeId);
     }
+    if (message.group !== undefined) {
+      writer.uint32(42).string(message.group);
+    }
     return writer;
   },
 
@@ -2938,6 +4021,13 @@ export const ApprovalNode = {
 
           message.externalNodeId = reader.string();
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.group = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2953,6 +4043,7 @@ export const ApprovalNode = {
       groupValue: isSet(object.groupValue) ? approvalNode_GroupValueFromJSON(object.groupValue) : undefined,
       role: isSet(object.role) ? globalThis.String(object.role) : undefined,
       externalNodeId: isSet(object.externalNodeId) ? globalThis.String(object.externalNodeId) : undefined,
+      group: isSet(object.group) ? globalThis.String(object.group) : undefined,
     };
   },
 
@@ -2970,6 +4061,9 @@ export const ApprovalNode = {
     if (message.externalNodeId !== undefined) {
       obj.externalNodeId = message.externalNodeId;
     }
+    if (message.group !== undefined) {
+      obj.group = message.group;
+    }
     return obj;
   },
 
@@ -2982,6 +4076,7 @@ export const ApprovalNode = {
     message.groupValue = object.groupValue ?? undefined;
     message.role = object.role ?? undefined;
     message.externalNodeId = object.externalNodeId ?? undefined;
+    message.group = object.group ?? undefined;
     return message;
   },
 };
@@ -3151,25 +4246,28 @@ export const ListIssueCommentsResponse = {
   },
 };
 
-function createBaseCreateIssueCommentRequest(): CreateIssueCommentRequest {
-  return { parent: "", issueComment: undefined };
+function createBaseListChangeCalendarEventsRequest(): ListChangeCalendarEventsRequest {
+  return { parent: "", startTime: undefined, endTime: undefined };
 }
 
-export const CreateIssueCommentRequest = {
-  encode(message: CreateIssueCommentRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ListChangeCalendarEventsRequest = {
+  encode(message: ListChangeCalendarEventsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.parent !== "") {
       writer.uint32(10).string(message.parent);
     }
-    if (message.issueComment !== undefined) {
-      IssueComment.encode(message.issueComment, writer.uint32(18).fork()).ldelim();
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.endTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.endTime), writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CreateIssueCommentRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListChangeCalendarEventsRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreateIssueCommentRequest();
+    const message = createBaseListChangeCalendarEventsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3185,7 +4283,14 @@ export const CreateIssueCommentRequest = {
             break;
           }
 
-          message.issueComment = IssueComment.decode(reader, reader.uint32());
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.endTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3196,59 +4301,56 @@ export const CreateIssueCommentRequest = {
     return message;
   },
 
-  fromJSON(object: any): CreateIssueCommentRequest {
+  fromJSON(object: any): ListChangeCalendarEventsRequest {
     return {
       parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      issueComment: isSet(object.issueComment) ? IssueComment.fromJSON(object.issueComment) : undefined,
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      endTime: isSet(object.endTime) ? fromJsonTimestamp(object.endTime) : undefined,
     };
   },
 
-  toJSON(message: CreateIssueCommentRequest): unknown {
+  toJSON(message: ListChangeCalendarEventsRequest): unknown {
     const obj: any = {};
     if (message.parent !== "") {
       obj.parent = message.parent;
     }
-    if (message.issueComment !== undefined) {
-      obj.issueComment = IssueComment.toJSON(message.issueComment);
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
+    }
+    if (message.endTime !== undefined) {
+      obj.endTime = message.endTime.toISOString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<CreateIssueCommentRequest>): CreateIssueCommentRequest {
-    return CreateIssueCommentRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListChangeCalendarEventsRequest>): ListChangeCalendarEventsRequest {
+    return ListChangeCalendarEventsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<CreateIssueCommentRequest>): CreateIssueCommentRequest {
-    const message = createBaseCreateIssueCommentRequest();
+  fromPartial(object: DeepPartial<ListChangeCalendarEventsRequest>): ListChangeCalendarEventsRequest {
+    const message = createBaseListChangeCalendarEventsRequest();
     message.parent = object.parent ?? "";
-    message.issueComment = (object.issueComment !== undefined && object.issueComment !== null)
-      ? IssueComment.fromPartial(object.issueComment)
-      : undefined;
+    message.startTime = object.startTime ?? undefined;
+    message.endTime = object.endTime ?? undefined;
     return message;
   },
 };
 
-function createBaseUpdateIssueCommentRequest(): UpdateIssueCommentRequest {
-  return { parent: "", issueComment: undefined, updateMask: undefined };
+function createBaseListChangeCalendarEventsResponse(): ListChangeCalendarEventsResponse {
+  return { events: [] };
 }
 
-export const UpdateIssueCommentRequest = {
-  encode(message: UpdateIssueCommentRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
-    }
-    if (message.issueComment !== undefined) {
-      IssueComment.encode(message.issueComment, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.updateMask !== undefined) {
-      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(26).fork()).ldelim();
+export const ListChangeCalendarEventsResponse = {
+  encode(message: ListChangeCalendarEventsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.events) {
+      ChangeCalendarEvent.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateIssueCommentRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListChangeCalendarEventsResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateIssueCommentRequest();
+    const message = createBaseListChangeCalendarEventsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3257,21 +4359,7 @@ export const UpdateIssueCommentRequest = {
             break;
           }
 
-          message.parent = reader.string();
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.issueComment = IssueComment.decode(reader, reader.uint32());
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          message.events.push(ChangeCalendarEvent.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3282,104 +4370,54 @@ export const UpdateIssueCommentRequest = {
     return message;
   },
 
-  fromJSON(object: any): UpdateIssueCommentRequest {
+  fromJSON(object: any): ListChangeCalendarEventsResponse {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      issueComment: isSet(object.issueComment) ? IssueComment.fromJSON(object.issueComment) : undefined,
-      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+      events: globalThis.Array.isArray(object?.events)
+        ? object.events.map((e: any) => ChangeCalendarEvent.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: UpdateIssueCommentRequest): unknown {
+  toJSON(message: ListChangeCalendarEventsResponse): unknown {
     const obj: any = {};
-    if (message.parent !== "") {
-      obj.parent = message.parent;
-    }
-    if (message.issueComment !== undefined) {
-      obj.issueComment = IssueComment.toJSON(message.issueComment);
-    }
-    if (message.updateMask !== undefined) {
-      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    if (message.events?.length) {
+      obj.events = message.events.map((e) => ChangeCalendarEvent.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<UpdateIssueCommentRequest>): UpdateIssueCommentRequest {
-    return UpdateIssueCommentRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListChangeCalendarEventsResponse>): ListChangeCalendarEventsResponse {
+    return ListChangeCalendarEventsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdateIssueCommentRequest>): UpdateIssueCommentRequest {
-    const message = createBaseUpdateIssueCommentRequest();
-    message.parent = object.parent ?? "";
-    message.issueComment = (object.issueComment !== undefined && object.issueComment !== null)
-      ? IssueComment.fromPartial(object.issueComment)
-      : undefined;
-    message.updateMask = object.updateMask ?? undefined;
+  fromPartial(object: DeepPartial<ListChangeCalendarEventsResponse>): ListChangeCalendarEventsResponse {
+    const message = createBaseListChangeCalendarEventsResponse();
+    message.events = object.events?.map((e) => ChangeCalendarEvent.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseIssueComment(): IssueComment {
-  return {
-    uid: "",
-    comment: "",
-    payload: "",
-    createTime: undefined,
-    updateTime: undefined,
-    name: "",
-    creator: "",
-    approval: undefined,
-    issueUpdate: undefined,
-    stageEnd: undefined,
-    taskUpdate: undefined,
-    taskPriorBackup: undefined,
-  };
+function createBaseExportChangeCalendarRequest(): ExportChangeCalendarRequest {
+  return { parent: "", startTime: undefined, endTime: undefined };
 }
 
-export const IssueComment = {
-  encode(message: IssueComment, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.uid !== "") {
-      writer.uint32(10).string(message.uid);
-    }
-    if (message.comment !== "") {
-      writer.uint32(18).string(message.comment);
-    }
-    if (message.payload !== "") {
-      writer.uint32(26).string(message.payload);
-    }
-    if (message.createTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(34).fork()).ldelim();
-    }
-    if (message.updateTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(42).fork()).ldelim();
-    }
-    if (message.name !== "") {
-      writer.uint32(50).string(message.name);
-    }
-    if (message.creator !== "") {
-      writer.uint32(58).string(message.creator);
-    }
-    if (message.approval !== undefined) {
-      IssueComment_Approval.encode(message.approval, writer.uint32(66).fork()).ldelim();
-    }
-    if (message.issueUpdate !== undefined) {
-      IssueComment_IssueUpdate.encode(message.issueUpdate, writer.uint32(74).fork()).ldelim();
-    }
-    if (message.stageEnd !== undefined) {
-      IssueComment_StageEnd.encode(message.stageEnd, writer.uint32(82).fork()).ldelim();
+export const ExportChangeCalendarRequest = {
+  encode(message: ExportChangeCalendarRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
-    if (message.taskUpdate !== undefined) {
-      IssueComment_TaskUpdate.encode(message.taskUpdate, writer.uint32(90).fork()).ldelim();
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(18).fork()).ldelim();
     }
-    if (message.taskPriorBackup !== undefined) {
-      IssueComment_TaskPriorBackup.encode(message.taskPriorBackup, writer.uint32(98).fork()).ldelim();
+    if (message.endTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.endTime), writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportChangeCalendarRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment();
+    const message = createBaseExportChangeCalendarRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3388,84 +4426,21 @@ export const IssueComment = {
             break;
           }
 
-          message.uid = reader.string();
+          message.parent = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.comment = reader.string();
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.payload = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.name = reader.string();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.creator = reader.string();
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.approval = IssueComment_Approval.decode(reader, reader.uint32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.issueUpdate = IssueComment_IssueUpdate.decode(reader, reader.uint32());
-          continue;
-        case 10:
-          if (tag !== 82) {
-            break;
-          }
-
-          message.stageEnd = IssueComment_StageEnd.decode(reader, reader.uint32());
-          continue;
-        case 11:
-          if (tag !== 90) {
-            break;
-          }
-
-          message.taskUpdate = IssueComment_TaskUpdate.decode(reader, reader.uint32());
-          continue;
-        case 12:
-          if (tag !== 98) {
-            break;
-          }
-
-          message.taskPriorBackup = IssueComment_TaskPriorBackup.decode(reader, reader.uint32());
+          message.endTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3476,122 +4451,65 @@ export const IssueComment = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment {
+  fromJSON(object: any): ExportChangeCalendarRequest {
     return {
-      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
-      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
-      payload: isSet(object.payload) ? globalThis.String(object.payload) : "",
-      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
-      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
-      approval: isSet(object.approval) ? IssueComment_Approval.fromJSON(object.approval) : undefined,
-      issueUpdate: isSet(object.issueUpdate) ? IssueComment_IssueUpdate.fromJSON(object.issueUpdate) : undefined,
-      stageEnd: isSet(object.stageEnd) ? IssueComment_StageEnd.fromJSON(object.stageEnd) : undefined,
-      taskUpdate: isSet(object.taskUpdate) ? IssueComment_TaskUpdate.fromJSON(object.taskUpdate) : undefined,
-      taskPriorBackup: isSet(object.taskPriorBackup)
-        ? IssueComment_TaskPriorBackup.fromJSON(object.taskPriorBackup)
-        : undefined,
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      endTime: isSet(object.endTime) ? fromJsonTimestamp(object.endTime) : undefined,
     };
   },
 
-  toJSON(message: IssueComment): unknown {
+  toJSON(message: ExportChangeCalendarRequest): unknown {
     const obj: any = {};
-    if (message.uid !== "") {
-      obj.uid = message.uid;
-    }
-    if (message.comment !== "") {
-      obj.comment = message.comment;
-    }
-    if (message.payload !== "") {
-      obj.payload = message.payload;
-    }
-    if (message.createTime !== undefined) {
-      obj.createTime = message.createTime.toISOString();
-    }
-    if (message.updateTime !== undefined) {
-      obj.updateTime = message.updateTime.toISOString();
-    }
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.creator !== "") {
-      obj.creator = message.creator;
-    }
-    if (message.approval !== undefined) {
-      obj.approval = IssueComment_Approval.toJSON(message.approval);
-    }
-    if (message.issueUpdate !== undefined) {
-      obj.issueUpdate = IssueComment_IssueUpdate.toJSON(message.issueUpdate);
-    }
-    if (message.stageEnd !== undefined) {
-      obj.stageEnd = IssueComment_StageEnd.toJSON(message.stageEnd);
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
-    if (message.taskUpdate !== undefined) {
-      obj.taskUpdate = IssueComment_TaskUpdate.toJSON(message.taskUpdate);
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
     }
-    if (message.taskPriorBackup !== undefined) {
-      obj.taskPriorBackup = IssueComment_TaskPriorBackup.toJSON(message.taskPriorBackup);
+    if (message.endTime !== undefined) {
+      obj.endTime = message.endTime.toISOString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment>): IssueComment {
-    return IssueComment.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExportChangeCalendarRequest>): ExportChangeCalendarRequest {
+    return ExportChangeCalendarRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment>): IssueComment {
-    const message = createBaseIssueComment();
-    message.uid = object.uid ?? "";
-    message.comment = object.comment ?? "";
-    message.payload = object.payload ?? "";
-    message.createTime = object.createTime ?? undefined;
-    message.updateTime = object.updateTime ?? undefined;
-    message.name = object.name ?? "";
-    message.creator = object.creator ?? "";
-    message.approval = (object.approval !== undefined && object.approval !== null)
-      ? IssueComment_Approval.fromPartial(object.approval)
-      : undefined;
-    message.issueUpdate = (object.issueUpdate !== undefined && object.issueUpdate !== null)
-      ? IssueComment_IssueUpdate.fromPartial(object.issueUpdate)
-      : undefined;
-    message.stageEnd = (object.stageEnd !== undefined && object.stageEnd !== null)
-      ? IssueComment_StageEnd.fromPartial(object.stageEnd)
-      : undefined;
-    message.taskUpdate = (object.taskUpdate !== undefined && object.taskUpdate !== null)
-      ? IssueComment_TaskUpdate.fromPartial(object.taskUpdate)
-      : undefined;
-    message.taskPriorBackup = (object.taskPriorBackup !== undefined && object.taskPriorBackup !== null)
-      ? IssueComment_TaskPriorBackup.fromPartial(object.taskPriorBackup)
-      : undefined;
+  fromPartial(object: DeepPartial<ExportChangeCalendarRequest>): ExportChangeCalendarRequest {
+    const message = createBaseExportChangeCalendarRequest();
+    message.parent = object.parent ?? "";
+    message.startTime = object.startTime ?? undefined;
+    message.endTime = object.endTime ?? undefined;
     return message;
   },
 };
 
-function createBaseIssueComment_Approval(): IssueComment_Approval {
-  return { status: IssueComment_Approval_Status.STATUS_UNSPECIFIED };
+function createBaseExportChangeCalendarResponse(): ExportChangeCalendarResponse {
+  return { content: new Uint8Array(0) };
 }
 
-export const IssueComment_Approval = {
-  encode(message: IssueComment_Approval, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.status !== IssueComment_Approval_Status.STATUS_UNSPECIFIED) {
-      writer.uint32(8).int32(issueComment_Approval_StatusToNumber(message.status));
+export const ExportChangeCalendarResponse = {
+  encode(message: ExportChangeCalendarResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.content.length !== 0) {
+      writer.uint32(10).bytes(message.content);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_Approval {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportChangeCalendarResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment_Approval();
+    const message = createBaseExportChangeCalendarResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.status = issueComment_Approval_StatusFromJSON(reader.int32());
+          message.content = reader.bytes();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3602,78 +4520,50 @@ export const IssueComment_Approval = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment_Approval {
-    return {
-      status: isSet(object.status)
-        ? issueComment_Approval_StatusFromJSON(object.status)
-        : IssueComment_Approval_Status.STATUS_UNSPECIFIED,
-    };
+  fromJSON(object: any): ExportChangeCalendarResponse {
+    return { content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0) };
   },
 
-  toJSON(message: IssueComment_Approval): unknown {
+  toJSON(message: ExportChangeCalendarResponse): unknown {
     const obj: any = {};
-    if (message.status !== IssueComment_Approval_Status.STATUS_UNSPECIFIED) {
-      obj.status = issueComment_Approval_StatusToJSON(message.status);
+    if (message.content.length !== 0) {
+      obj.content = base64FromBytes(message.content);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment_Approval>): IssueComment_Approval {
-    return IssueComment_Approval.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExportChangeCalendarResponse>): ExportChangeCalendarResponse {
+    return ExportChangeCalendarResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment_Approval>): IssueComment_Approval {
-    const message = createBaseIssueComment_Approval();
-    message.status = object.status ?? IssueComment_Approval_Status.STATUS_UNSPECIFIED;
+  fromPartial(object: DeepPartial<ExportChangeCalendarResponse>): ExportChangeCalendarResponse {
+    const message = createBaseExportChangeCalendarResponse();
+    message.content = object.content ?? new Uint8Array(0);
     return message;
   },
 };
 
-function createBaseIssueComment_IssueUpdate(): IssueComment_IssueUpdate {
-  return {
-    fromTitle: undefined,
-    toTitle: undefined,
-    fromDescription: undefined,
-    toDescription: undefined,
-    fromStatus: undefined,
-    toStatus: undefined,
-    fromLabels: [],
-    toLabels: [],
-  };
+function createBasePurgeIssuesRequest(): PurgeIssuesRequest {
+  return { parent: "", createTimeBefore: undefined, dryRun: false };
 }
 
-export const IssueComment_IssueUpdate = {
-  encode(message: IssueComment_IssueUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.fromTitle !== undefined) {
-      writer.uint32(10).string(message.fromTitle);
+export const PurgeIssuesRequest = {
+  encode(message: PurgeIssuesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
-    if (message.toTitle !== undefined) {
-      writer.uint32(18).string(message.toTitle);
+    if (message.createTimeBefore !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTimeBefore), writer.uint32(18).fork()).ldelim();
     }
-    if (message.fromDescription !== undefined) {
-      writer.uint32(26).string(message.fromDescription);
-    }
-    if (message.toDescription !== undefined) {
-      writer.uint32(34).string(message.toDescription);
-    }
-    if (message.fromStatus !== undefined) {
-      writer.uint32(40).int32(issueStatusToNumber(message.fromStatus));
-    }
-    if (message.toStatus !== undefined) {
-      writer.uint32(48).int32(issueStatusToNumber(message.toStatus));
-    }
-    for (const v of message.fromLabels) {
-      writer.uint32(74).string(v!);
-    }
-    for (const v of message.toLabels) {
-      writer.uint32(82).string(v!);
+    if (message.dryRun === true) {
+      writer.uint32(24).bool(message.dryRun);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_IssueUpdate {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PurgeIssuesRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment_IssueUpdate();
+    const message = createBasePurgeIssuesRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3682,56 +4572,21 @@ export const IssueComment_IssueUpdate = {
             break;
           }
 
-          message.fromTitle = reader.string();
+          message.parent = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.toTitle = reader.string();
+          message.createTimeBefore = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.fromDescription = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.toDescription = reader.string();
-          continue;
-        case 5:
-          if (tag !== 40) {
-            break;
-          }
-
-          message.fromStatus = issueStatusFromJSON(reader.int32());
-          continue;
-        case 6:
-          if (tag !== 48) {
-            break;
-          }
-
-          message.toStatus = issueStatusFromJSON(reader.int32());
-          continue;
-        case 9:
-          if (tag !== 74) {
-            break;
-          }
-
-          message.fromLabels.push(reader.string());
-          continue;
-        case 10:
-          if (tag !== 82) {
+          if (tag !== 24) {
             break;
           }
 
-          message.toLabels.push(reader.string());
+          message.dryRun = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3742,83 +4597,68 @@ export const IssueComment_IssueUpdate = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment_IssueUpdate {
+  fromJSON(object: any): PurgeIssuesRequest {
     return {
-      fromTitle: isSet(object.fromTitle) ? globalThis.String(object.fromTitle) : undefined,
-      toTitle: isSet(object.toTitle) ? globalThis.String(object.toTitle) : undefined,
-      fromDescription: isSet(object.fromDescription) ? globalThis.String(object.fromDescription) : undefined,
-      toDescription: isSet(object.toDescription) ? globalThis.String(object.toDescription) : undefined,
-      fromStatus: isSet(object.fromStatus) ? issueStatusFromJSON(object.fromStatus) : undefined,
-      toStatus: isSet(object.toStatus) ? issueStatusFromJSON(object.toStatus) : undefined,
-      fromLabels: globalThis.Array.isArray(object?.fromLabels)
-        ? object.fromLabels.map((e: any) => globalThis.String(e))
-        : [],
-      toLabels: globalThis.Array.isArray(object?.toLabels) ? object.toLabels.map((e: any) => globalThis.String(e)) : [],
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      createTimeBefore: isSet(object.createTimeBefore) ? fromJsonTimestamp(object.createTimeBefore) : undefined,
+      dryRun: isSet(object.dryRun) ? globalThis.Boolean(object.dryRun) : false,
     };
   },
 
-  toJSON(message: IssueComment_IssueUpdate): unknown {
+  toJSON(message: PurgeIssuesRequest): unknown {
     const obj: any = {};
-    if (message.fromTitle !== undefined) {
-      obj.fromTitle = message.fromTitle;
-    }
-    if (message.toTitle !== undefined) {
-      obj.toTitle = message.toTitle;
-    }
-    if (message.fromDescription !== undefined) {
-      obj.fromDescription = message.fromDescription;
-    }
-    if (message.toDescription !== undefined) {
-      obj.toDescription = message.toDescription;
-    }
-    if (message.fromStatus !== undefined) {
-      obj.fromStatus = issueStatusToJSON(message.fromStatus);
-    }
-    if (message.toStatus !== undefined) {
-      obj.toStatus = issueStatusToJSON(message.toStatus);
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
-    if (message.fromLabels?.length) {
-      obj.fromLabels = message.fromLabels;
+    if (message.createTimeBefore !== undefined) {
+      obj.createTimeBefore = message.createTimeBefore.toISOString();
     }
-    if (message.toLabels?.length) {
-      obj.toLabels = message.toLabels;
+    if (message.dryRun === true) {
+      obj.dryRun = message.dryRun;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment_IssueUpdate>): IssueComment_IssueUpdate {
-    return IssueComment_IssueUpdate.fromPartial(base ?? {});
+  create(base?: DeepPartial<PurgeIssuesRequest>): PurgeIssuesRequest {
+    return PurgeIssuesRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment_IssueUpdate>): IssueComment_IssueUpdate {
-    const message = createBaseIssueComment_IssueUpdate();
-    message.fromTitle = object.fromTitle ?? undefined;
-    message.toTitle = object.toTitle ?? undefined;
-    message.fromDescription = object.fromDescription ?? undefined;
-    message.toDescription = object.toDescription ?? undefined;
-    message.fromStatus = object.fromStatus ?? undefined;
-    message.toStatus = object.toStatus ?? undefined;
-    message.fromLabels = object.fromLabels?.map((e) => e) || [];
-    message.toLabels = object.toLabels?.map((e) => e) || [];
+  fromPartial(object: DeepPartial<PurgeIssuesRequest>): PurgeIssuesRequest {
+    const message = createBasePurgeIssuesRequest();
+    message.parent = object.parent ?? "";
+    message.createTimeBefore = object.createTimeBefore ?? undefined;
+    message.dryRun = object.dryRun ?? false;
     return message;
   },
 };
 
-function createBaseIssueComment_StageEnd(): IssueComment_StageEnd {
-  return { stage: "" };
+function createBasePurgeIssuesResponse(): PurgeIssuesResponse {
+  return { issues: [], issueCount: Long.ZERO, commentCount: Long.ZERO, taskRunCount: Long.ZERO, sheetCount: Long.ZERO };
 }
 
-export const IssueComment_StageEnd = {
-  encode(message: IssueComment_StageEnd, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.stage !== "") {
-      writer.uint32(10).string(message.stage);
+export const PurgeIssuesResponse = {
+  encode(message: PurgeIssuesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.issues) {
+      writer.uint32(10).string(v!);
+    }
+    if (!message.issueCount.isZero()) {
+      writer.uint32(16).int64(message.issueCount);
+    }
+    if (!message.commentCount.isZero()) {
+      writer.uint32(24).int64(message.commentCount);
+    }
+    if (!message.taskRunCount.isZero()) {
+      writer.uint32(32).int64(message.taskRunCount);
+    }
+    if (!message.sheetCount.isZero()) {
+      writer.uint32(40).int64(message.sheetCount);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_StageEnd {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PurgeIssuesResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment_StageEnd();
+    const message = createBasePurgeIssuesResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3827,7 +4667,35 @@ export const IssueComment_StageEnd = {
             break;
           }
 
-          message.stage = reader.string();
+          message.issues.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.issueCount = reader.int64() as Long;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.commentCount = reader.int64() as Long;
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.taskRunCount = reader.int64() as Long;
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.sheetCount = reader.int64() as Long;
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3838,110 +4706,140 @@ export const IssueComment_StageEnd = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment_StageEnd {
-    return { stage: isSet(object.stage) ? globalThis.String(object.stage) : "" };
+  fromJSON(object: any): PurgeIssuesResponse {
+    return {
+      issues: globalThis.Array.isArray(object?.issues) ? object.issues.map((e: any) => globalThis.String(e)) : [],
+      issueCount: isSet(object.issueCount) ? Long.fromValue(object.issueCount) : Long.ZERO,
+      commentCount: isSet(object.commentCount) ? Long.fromValue(object.commentCount) : Long.ZERO,
+      taskRunCount: isSet(object.taskRunCount) ? Long.fromValue(object.taskRunCount) : Long.ZERO,
+      sheetCount: isSet(object.sheetCount) ? Long.fromValue(object.sheetCount) : Long.ZERO,
+    };
   },
 
-  toJSON(message: IssueComment_StageEnd): unknown {
+  toJSON(message: PurgeIssuesResponse): unknown {
     const obj: any = {};
-    if (message.stage !== "") {
-      obj.stage = message.stage;
+    if (message.issues?.length) {
+      obj.issues = message.issues;
+    }
+    if (!message.issueCount.isZero()) {
+      obj.issueCount = (message.issueCount || Long.ZERO).toString();
+    }
+    if (!message.commentCount.isZero()) {
+      obj.commentCount = (message.commentCount || Long.ZERO).toString();
+    }
+    if (!message.taskRunCount.isZero()) {
+      obj.taskRunCount = (message.taskRunCount || Long.ZERO).toString();
+    }
+    if (!message.sheetCount.isZero()) {
+      obj.sheetCount = (message.sheetCount || Long.ZERO).toString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment_StageEnd>): IssueComment_StageEnd {
-    return IssueComment_StageEnd.fromPartial(base ?? {});
+  create(base?: DeepPartial<PurgeIssuesResponse>): PurgeIssuesResponse {
+    return PurgeIssuesResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment_StageEnd>): IssueComment_StageEnd {
-    const message = createBaseIssueComment_StageEnd();
-    message.stage = object.stage ?? "";
+  fromPartial(object: DeepPartial<PurgeIssuesResponse>): PurgeIssuesResponse {
+    const message = createBasePurgeIssuesResponse();
+    message.issues = object.issues?.map((e) => e) || [];
+    message.issueCount = (object.issueCount !== undefined && object.issueCount !== null)
+      ? Long.fromValue(object.issueCount)
+      : Long.ZERO;
+    message.commentCount = (object.commentCount !== undefined && object.commentCount !== null)
+      ? Long.fromValue(object.commentCount)
+      : Long.ZERO;
+    message.taskRunCount = (object.taskRunCount !== undefined && object.taskRunCount !== null)
+      ? Long.fromValue(object.taskRunCount)
+      : Long.ZERO;
+    message.sheetCount = (object.sheetCount !== undefined && object.sheetCount !== null)
+      ? Long.fromValue(object.sheetCount)
+      : Long.ZERO;
     return message;
   },
 };
 
-function createBaseIssueComment_TaskUpdate(): IssueComment_TaskUpdate {
+function createBaseChangeCalendarEvent(): ChangeCalendarEvent {
   return {
-    tasks: [],
-    fromSheet: undefined,
-    toSheet: undefined,
-    fromEarliestAllowedTime: undefined,
-    toEarliestAllowedTime: undefined,
-    toStatus: undefined,
+    type: ChangeCalendarEvent_Type.TYPE_UNSPECIFIED,
+    title: "",
+    issue: "",
+    environment: "",
+    startTime: undefined,
+    endTime: undefined,
   };
 }
 
-export const IssueComment_TaskUpdate = {
-  encode(message: IssueComment_TaskUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.tasks) {
-      writer.uint32(10).string(v!);
+export const ChangeCalendarEvent = {
+  encode(message: ChangeCalendarEvent, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== ChangeCalendarEvent_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(changeCalendarEvent_TypeToNumber(message.type));
     }
-    if (message.fromSheet !== undefined) {
-      writer.uint32(18).string(message.fromSheet);
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
     }
-    if (message.toSheet !== undefined) {
-      writer.uint32(26).string(message.toSheet);
+    if (message.issue !== "") {
+      writer.uint32(26).string(message.issue);
     }
-    if (message.fromEarliestAllowedTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.fromEarliestAllowedTime), writer.uint32(34).fork()).ldelim();
+    if (message.environment !== "") {
+      writer.uint32(34).string(message.environment);
     }
-    if (message.toEarliestAllowedTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.toEarliestAllowedTime), writer.uint32(42).fork()).ldelim();
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(42).fork()).ldelim();
     }
-    if (message.toStatus !== undefined) {
-      writer.uint32(48).int32(issueComment_TaskUpdate_StatusToNumber(message.toStatus));
+    if (message.endTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.endTime), writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_TaskUpdate {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ChangeCalendarEvent {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment_TaskUpdate();
+    const message = createBaseChangeCalendarEvent();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.tasks.push(reader.string());
+          message.type = changeCalendarEvent_TypeFromJSON(reader.int32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.fromSheet = reader.string();
+          message.title = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.toSheet = reader.string();
+          message.issue = reader.string();
           continue;
         case 4:
           if (tag !== 34) {
             break;
           }
 
-          message.fromEarliestAllowedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.environment = reader.string();
           continue;
         case 5:
           if (tag !== 42) {
             break;
           }
 
-          message.toEarliestAllowedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 6:
-          if (tag !== 48) {
+          if (tag !== 50) {
             break;
           }
 
-          message.toStatus = issueComment_TaskUpdate_StatusFromJSON(reader.int32());
+          message.endTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3952,84 +4850,116 @@ export const IssueComment_TaskUpdate = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment_TaskUpdate {
+  fromJSON(object: any): ChangeCalendarEvent {
     return {
-      tasks: globalThis.Array.isArray(object?.tasks) ? object.tasks.map((e: any) => globalThis.String(e)) : [],
-      fromSheet: isSet(object.fromSheet) ? globalThis.String(object.fromSheet) : undefined,
-      toSheet: isSet(object.toSheet) ? globalThis.String(object.toSheet) : undefined,
-      fromEarliestAllowedTime: isSet(object.fromEarliestAllowedTime)
-        ? fromJsonTimestamp(object.fromEarliestAllowedTime)
-        : undefined,
-      toEarliestAllowedTime: isSet(object.toEarliestAllowedTime)
-        ? fromJsonTimestamp(object.toEarliestAllowedTime)
-        : undefined,
-      toStatus: isSet(object.toStatus) ? issueComment_TaskUpdate_StatusFromJSON(object.toStatus) : undefined,
+      type: isSet(object.type)
+        ? changeCalendarEvent_TypeFromJSON(object.type)
+        : ChangeCalendarEvent_Type.TYPE_UNSPECIFIED,
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      issue: isSet(object.issue) ? globalThis.String(object.issue) : "",
+      environment: isSet(object.environment) ? globalThis.String(object.environment) : "",
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      endTime: isSet(object.endTime) ? fromJsonTimestamp(object.endTime) : undefined,
     };
   },
 
-  toJSON(message: IssueComment_TaskUpdate): unknown {
+  toJSON(message: ChangeCalendarEvent): unknown {
     const obj: any = {};
-    if (message.tasks?.length) {
-      obj.tasks = message.tasks;
+    if (message.type !== ChangeCalendarEvent_Type.TYPE_UNSPECIFIED) {
+      obj.type = changeCalendarEvent_TypeToJSON(message.type);
     }
-    if (message.fromSheet !== undefined) {
-      obj.fromSheet = message.fromSheet;
+    if (message.title !== "") {
+      obj.title = message.title;
     }
-    if (message.toSheet !== undefined) {
-      obj.toSheet = message.toSheet;
+    if (message.issue !== "") {
+      obj.issue = message.issue;
     }
-    if (message.fromEarliestAllowedTime !== undefined) {
-      obj.fromEarliestAllowedTime = message.fromEarliestAllowedTime.toISOString();
+    if (message.environment !== "") {
+      obj.environment = message.environment;
     }
-    if (message.toEarliestAllowedTime !== undefined) {
-      obj.toEarliestAllowedTime = message.toEarliestAllowedTime.toISOString();
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
     }
-    if (message.toStatus !== undefined) {
-      obj.toStatus = issueComment_TaskUpdate_StatusToJSON(message.toStatus);
+    if (message.endTime !== undefined) {
+      obj.endTime = message.endTime.toISOString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment_TaskUpdate>): IssueComment_TaskUpdate {
-    return IssueComment_TaskUpdate.fromPartial(base ?? {});
+  create(base?: DeepPartial<ChangeCalendarEvent>): ChangeCalendarEvent {
+    return ChangeCalendarEvent.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment_TaskUpdate>): IssueComment_TaskUpdate {
-    const message = createBaseIssueComment_TaskUpdate();
-    message.tasks = object.tasks?.map((e) => e) || [];
-    message.fromSheet = object.fromSheet ?? undefined;
-    message.toSheet = object.toSheet ?? undefined;
-    message.fromEarliestAllowedTime = object.fromEarliestAllowedTime ?? undefined;
-    message.toEarliestAllowedTime = object.toEarliestAllowedTime ?? undefined;
-    message.toStatus = object.toStatus ?? undefined;
+  fromPartial(object: DeepPartial<ChangeCalendarEvent>): ChangeCalendarEvent {
+    const message = createBaseChangeCalendarEvent();
+    message.type = object.type ?? ChangeCalendarEvent_Type.TYPE_UNSPECIFIED;
+    message.title = object.title ?? "";
+    message.issue = object.issue ?? "";
+    message.environment = object.environment ?? "";
+    message.startTime = object.startTime ?? undefined;
+    message.endTime = object.endTime ?? undefined;
     return message;
   },
 };
 
-function createBaseIssueComment_TaskPriorBackup(): IssueComment_TaskPriorBackup {
-  return { task: "", tables: [], originalLine: undefined, database: "" };
+function createBaseRegenerateCalendarFeedRequest(): RegenerateCalendarFeedRequest {
+  return {};
 }
 
-export const IssueComment_TaskPriorBackup = {
-  encode(message: IssueComment_TaskPriorBackup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.task !== "") {
-      writer.uint32(10).string(message.task);
-    }
-    for (const v of message.tables) {
-      IssueComment_TaskPriorBackup_Table.encode(v!, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.originalLine !== undefined) {
-      writer.uint32(24).int32(message.originalLine);
-    }
-    if (message.database !== "") {
-      writer.uint32(34).string(message.database);
+export const RegenerateCalendarFeedRequest = {
+  encode(_: RegenerateCalendarFeedRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RegenerateCalendarFeedRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRegenerateCalendarFeedRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): RegenerateCalendarFeedRequest {
+    return {};
+  },
+
+  toJSON(_: RegenerateCalendarFeedRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<RegenerateCalendarFeedRequest>): RegenerateCalendarFeedRequest {
+    return RegenerateCalendarFeedRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<RegenerateCalendarFeedRequest>): RegenerateCalendarFeedRequest {
+    const message = createBaseRegenerateCalendarFeedRequest();
+    return message;
+  },
+};
+
+function createBaseCalendarFeed(): CalendarFeed {
+  return { url: "" };
+}
+
+export const CalendarFeed = {
+  encode(message: CalendarFeed, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.url !== "") {
+      writer.uint32(10).string(message.url);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_TaskPriorBackup {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CalendarFeed {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment_TaskPriorBackup();
+    const message = createBaseCalendarFeed();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4038,28 +4968,141 @@ export const IssueComment_TaskPriorBackup = {
             break;
           }
 
-          message.task = reader.string();
+          message.url = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CalendarFeed {
+    return { url: isSet(object.url) ? globalThis.String(object.url) : "" };
+  },
+
+  toJSON(message: CalendarFeed): unknown {
+    const obj: any = {};
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CalendarFeed>): CalendarFeed {
+    return CalendarFeed.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CalendarFeed>): CalendarFeed {
+    const message = createBaseCalendarFeed();
+    message.url = object.url ?? "";
+    return message;
+  },
+};
+
+function createBaseGetCalendarFeedRequest(): GetCalendarFeedRequest {
+  return { token: "" };
+}
+
+export const GetCalendarFeedRequest = {
+  encode(message: GetCalendarFeedRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetCalendarFeedRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetCalendarFeedRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.tables.push(IssueComment_TaskPriorBackup_Table.decode(reader, reader.uint32()));
+          message.token = reader.string();
           continue;
-        case 3:
-          if (tag !== 24) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetCalendarFeedRequest {
+    return { token: isSet(object.token) ? globalThis.String(object.token) : "" };
+  },
+
+  toJSON(message: GetCalendarFeedRequest): unknown {
+    const obj: any = {};
+    if (message.token !== "") {
+      obj.token = message.token;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetCalendarFeedRequest>): GetCalendarFeedRequest {
+    return GetCalendarFeedRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetCalendarFeedRequest>): GetCalendarFeedRequest {
+    const message = createBaseGetCalendarFeedRequest();
+    message.token = object.token ?? "";
+    return message;
+  },
+};
+
+function createBaseGetIssueTimelineRequest(): GetIssueTimelineRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const GetIssueTimelineRequest = {
+  encode(message: GetIssueTimelineRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetIssueTimelineRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetIssueTimelineRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.originalLine = reader.int32();
+          message.parent = reader.string();
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 2:
+          if (tag !== 16) {
             break;
           }
 
-          message.database = reader.string();
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4070,66 +5113,59 @@ export const IssueComment_TaskPriorBackup = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment_TaskPriorBackup {
+  fromJSON(object: any): GetIssueTimelineRequest {
     return {
-      task: isSet(object.task) ? globalThis.String(object.task) : "",
-      tables: globalThis.Array.isArray(object?.tables)
-        ? object.tables.map((e: any) => IssueComment_TaskPriorBackup_Table.fromJSON(e))
-        : [],
-      originalLine: isSet(object.originalLine) ? globalThis.Number(object.originalLine) : undefined,
-      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
     };
   },
 
-  toJSON(message: IssueComment_TaskPriorBackup): unknown {
+  toJSON(message: GetIssueTimelineRequest): unknown {
     const obj: any = {};
-    if (message.task !== "") {
-      obj.task = message.task;
-    }
-    if (message.tables?.length) {
-      obj.tables = message.tables.map((e) => IssueComment_TaskPriorBackup_Table.toJSON(e));
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
-    if (message.originalLine !== undefined) {
-      obj.originalLine = Math.round(message.originalLine);
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
     }
-    if (message.database !== "") {
-      obj.database = message.database;
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment_TaskPriorBackup>): IssueComment_TaskPriorBackup {
-    return IssueComment_TaskPriorBackup.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetIssueTimelineRequest>): GetIssueTimelineRequest {
+    return GetIssueTimelineRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment_TaskPriorBackup>): IssueComment_TaskPriorBackup {
-    const message = createBaseIssueComment_TaskPriorBackup();
-    message.task = object.task ?? "";
-    message.tables = object.tables?.map((e) => IssueComment_TaskPriorBackup_Table.fromPartial(e)) || [];
-    message.originalLine = object.originalLine ?? undefined;
-    message.database = object.database ?? "";
+  fromPartial(object: DeepPartial<GetIssueTimelineRequest>): GetIssueTimelineRequest {
+    const message = createBaseGetIssueTimelineRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
     return message;
   },
 };
 
-function createBaseIssueComment_TaskPriorBackup_Table(): IssueComment_TaskPriorBackup_Table {
-  return { schema: "", table: "" };
+function createBaseGetIssueTimelineResponse(): GetIssueTimelineResponse {
+  return { entries: [], nextPageToken: "" };
 }
 
-export const IssueComment_TaskPriorBackup_Table = {
-  encode(message: IssueComment_TaskPriorBackup_Table, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.schema !== "") {
-      writer.uint32(10).string(message.schema);
+export const GetIssueTimelineResponse = {
+  encode(message: GetIssueTimelineResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.entries) {
+      IssueTimelineEntry.encode(v!, writer.uint32(10).fork()).ldelim();
     }
-    if (message.table !== "") {
-      writer.uint32(18).string(message.table);
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_TaskPriorBackup_Table {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetIssueTimelineResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseIssueComment_TaskPriorBackup_Table();
+    const message = createBaseGetIssueTimelineResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -4138,14 +5174,14 @@ export const IssueComment_TaskPriorBackup_Table = {
             break;
           }
 
-          message.schema = reader.string();
+          message.entries.push(IssueTimelineEntry.decode(reader, reader.uint32()));
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.table = reader.string();
+          message.nextPageToken = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4156,105 +5192,1836 @@ export const IssueComment_TaskPriorBackup_Table = {
     return message;
   },
 
-  fromJSON(object: any): IssueComment_TaskPriorBackup_Table {
+  fromJSON(object: any): GetIssueTimelineResponse {
     return {
-      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
-      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      entries: globalThis.Array.isArray(object?.entries)
+        ? object.entries.map((e: any) => IssueTimelineEntry.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
     };
   },
 
-  toJSON(message: IssueComment_TaskPriorBackup_Table): unknown {
+  toJSON(message: GetIssueTimelineResponse): unknown {
     const obj: any = {};
-    if (message.schema !== "") {
-      obj.schema = message.schema;
+    if (message.entries?.length) {
+      obj.entries = message.entries.map((e) => IssueTimelineEntry.toJSON(e));
     }
-    if (message.table !== "") {
-      obj.table = message.table;
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<IssueComment_TaskPriorBackup_Table>): IssueComment_TaskPriorBackup_Table {
-    return IssueComment_TaskPriorBackup_Table.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetIssueTimelineResponse>): GetIssueTimelineResponse {
+    return GetIssueTimelineResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<IssueComment_TaskPriorBackup_Table>): IssueComment_TaskPriorBackup_Table {
-    const message = createBaseIssueComment_TaskPriorBackup_Table();
-    message.schema = object.schema ?? "";
-    message.table = object.table ?? "";
+  fromPartial(object: DeepPartial<GetIssueTimelineResponse>): GetIssueTimelineResponse {
+    const message = createBaseGetIssueTimelineResponse();
+    message.entries = object.entries?.map((e) => IssueTimelineEntry.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-export type IssueServiceDefinition = typeof IssueServiceDefinition;
-export const IssueServiceDefinition = {
-  name: "IssueService",
-  fullName: "bytebase.v1.IssueService",
-  methods: {
-    getIssue: {
-      name: "GetIssue",
-      requestType: GetIssueRequest,
-      requestStream: false,
-      responseType: Issue,
-      responseStream: false,
-      options: {
-        _unknownFields: {
-          8410: [new Uint8Array([4, 110, 97, 109, 101])],
-          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
-          800016: [new Uint8Array([1])],
-          578365826: [
-            new Uint8Array([
-              32,
-              18,
-              30,
-              47,
-              118,
-              49,
-              47,
-              123,
-              110,
-              97,
-              109,
-              101,
-              61,
-              112,
-              114,
-              111,
-              106,
-              101,
-              99,
-              116,
-              115,
-              47,
-              42,
-              47,
-              105,
-              115,
-              115,
-              117,
-              101,
-              115,
-              47,
-              42,
-              125,
-            ]),
-          ],
+function createBaseIssueTimelineEntry(): IssueTimelineEntry {
+  return {
+    type: IssueTimelineEntry_Type.TYPE_UNSPECIFIED,
+    time: undefined,
+    issueComment: undefined,
+    planCheckRun: undefined,
+    taskRun: undefined,
+  };
+}
+
+export const IssueTimelineEntry = {
+  encode(message: IssueTimelineEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== IssueTimelineEntry_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(issueTimelineEntry_TypeToNumber(message.type));
+    }
+    if (message.time !== undefined) {
+      Timestamp.encode(toTimestamp(message.time), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.issueComment !== undefined) {
+      IssueComment.encode(message.issueComment, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.planCheckRun !== undefined) {
+      PlanCheckRun.encode(message.planCheckRun, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.taskRun !== undefined) {
+      TaskRun.encode(message.taskRun, writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueTimelineEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueTimelineEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = issueTimelineEntry_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.time = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.issueComment = IssueComment.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.planCheckRun = PlanCheckRun.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.taskRun = TaskRun.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueTimelineEntry {
+    return {
+      type: isSet(object.type)
+        ? issueTimelineEntry_TypeFromJSON(object.type)
+        : IssueTimelineEntry_Type.TYPE_UNSPECIFIED,
+      time: isSet(object.time) ? fromJsonTimestamp(object.time) : undefined,
+      issueComment: isSet(object.issueComment) ? IssueComment.fromJSON(object.issueComment) : undefined,
+      planCheckRun: isSet(object.planCheckRun) ? PlanCheckRun.fromJSON(object.planCheckRun) : undefined,
+      taskRun: isSet(object.taskRun) ? TaskRun.fromJSON(object.taskRun) : undefined,
+    };
+  },
+
+  toJSON(message: IssueTimelineEntry): unknown {
+    const obj: any = {};
+    if (message.type !== IssueTimelineEntry_Type.TYPE_UNSPECIFIED) {
+      obj.type = issueTimelineEntry_TypeToJSON(message.type);
+    }
+    if (message.time !== undefined) {
+      obj.time = message.time.toISOString();
+    }
+    if (message.issueComment !== undefined) {
+      obj.issueComment = IssueComment.toJSON(message.issueComment);
+    }
+    if (message.planCheckRun !== undefined) {
+      obj.planCheckRun = PlanCheckRun.toJSON(message.planCheckRun);
+    }
+    if (message.taskRun !== undefined) {
+      obj.taskRun = TaskRun.toJSON(message.taskRun);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueTimelineEntry>): IssueTimelineEntry {
+    return IssueTimelineEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueTimelineEntry>): IssueTimelineEntry {
+    const message = createBaseIssueTimelineEntry();
+    message.type = object.type ?? IssueTimelineEntry_Type.TYPE_UNSPECIFIED;
+    message.time = object.time ?? undefined;
+    message.issueComment = (object.issueComment !== undefined && object.issueComment !== null)
+      ? IssueComment.fromPartial(object.issueComment)
+      : undefined;
+    message.planCheckRun = (object.planCheckRun !== undefined && object.planCheckRun !== null)
+      ? PlanCheckRun.fromPartial(object.planCheckRun)
+      : undefined;
+    message.taskRun = (object.taskRun !== undefined && object.taskRun !== null)
+      ? TaskRun.fromPartial(object.taskRun)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseCreateIssueCommentRequest(): CreateIssueCommentRequest {
+  return { parent: "", issueComment: undefined };
+}
+
+export const CreateIssueCommentRequest = {
+  encode(message: CreateIssueCommentRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.issueComment !== undefined) {
+      IssueComment.encode(message.issueComment, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateIssueCommentRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateIssueCommentRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.issueComment = IssueComment.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateIssueCommentRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      issueComment: isSet(object.issueComment) ? IssueComment.fromJSON(object.issueComment) : undefined,
+    };
+  },
+
+  toJSON(message: CreateIssueCommentRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.issueComment !== undefined) {
+      obj.issueComment = IssueComment.toJSON(message.issueComment);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreateIssueCommentRequest>): CreateIssueCommentRequest {
+    return CreateIssueCommentRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateIssueCommentRequest>): CreateIssueCommentRequest {
+    const message = createBaseCreateIssueCommentRequest();
+    message.parent = object.parent ?? "";
+    message.issueComment = (object.issueComment !== undefined && object.issueComment !== null)
+      ? IssueComment.fromPartial(object.issueComment)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseUpdateIssueCommentRequest(): UpdateIssueCommentRequest {
+  return { parent: "", issueComment: undefined, updateMask: undefined };
+}
+
+export const UpdateIssueCommentRequest = {
+  encode(message: UpdateIssueCommentRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.issueComment !== undefined) {
+      IssueComment.encode(message.issueComment, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateIssueCommentRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateIssueCommentRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.issueComment = IssueComment.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateIssueCommentRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      issueComment: isSet(object.issueComment) ? IssueComment.fromJSON(object.issueComment) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateIssueCommentRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.issueComment !== undefined) {
+      obj.issueComment = IssueComment.toJSON(message.issueComment);
+    }
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateIssueCommentRequest>): UpdateIssueCommentRequest {
+    return UpdateIssueCommentRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateIssueCommentRequest>): UpdateIssueCommentRequest {
+    const message = createBaseUpdateIssueCommentRequest();
+    message.parent = object.parent ?? "";
+    message.issueComment = (object.issueComment !== undefined && object.issueComment !== null)
+      ? IssueComment.fromPartial(object.issueComment)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteIssueCommentRequest(): DeleteIssueCommentRequest {
+  return { name: "" };
+}
+
+export const DeleteIssueCommentRequest = {
+  encode(message: DeleteIssueCommentRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteIssueCommentRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteIssueCommentRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteIssueCommentRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: DeleteIssueCommentRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteIssueCommentRequest>): DeleteIssueCommentRequest {
+    return DeleteIssueCommentRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteIssueCommentRequest>): DeleteIssueCommentRequest {
+    const message = createBaseDeleteIssueCommentRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseListIssueCommentRevisionsRequest(): ListIssueCommentRevisionsRequest {
+  return { parent: "" };
+}
+
+export const ListIssueCommentRevisionsRequest = {
+  encode(message: ListIssueCommentRevisionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListIssueCommentRevisionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListIssueCommentRevisionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListIssueCommentRevisionsRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: ListIssueCommentRevisionsRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListIssueCommentRevisionsRequest>): ListIssueCommentRevisionsRequest {
+    return ListIssueCommentRevisionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListIssueCommentRevisionsRequest>): ListIssueCommentRevisionsRequest {
+    const message = createBaseListIssueCommentRevisionsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListIssueCommentRevisionsResponse(): ListIssueCommentRevisionsResponse {
+  return { revisions: [] };
+}
+
+export const ListIssueCommentRevisionsResponse = {
+  encode(message: ListIssueCommentRevisionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.revisions) {
+      IssueCommentRevision.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListIssueCommentRevisionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListIssueCommentRevisionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.revisions.push(IssueCommentRevision.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListIssueCommentRevisionsResponse {
+    return {
+      revisions: globalThis.Array.isArray(object?.revisions)
+        ? object.revisions.map((e: any) => IssueCommentRevision.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListIssueCommentRevisionsResponse): unknown {
+    const obj: any = {};
+    if (message.revisions?.length) {
+      obj.revisions = message.revisions.map((e) => IssueCommentRevision.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListIssueCommentRevisionsResponse>): ListIssueCommentRevisionsResponse {
+    return ListIssueCommentRevisionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListIssueCommentRevisionsResponse>): ListIssueCommentRevisionsResponse {
+    const message = createBaseListIssueCommentRevisionsResponse();
+    message.revisions = object.revisions?.map((e) => IssueCommentRevision.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseIssueCommentRevision(): IssueCommentRevision {
+  return { comment: "", creator: "", createTime: undefined };
+}
+
+export const IssueCommentRevision = {
+  encode(message: IssueCommentRevision, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.comment !== "") {
+      writer.uint32(10).string(message.comment);
+    }
+    if (message.creator !== "") {
+      writer.uint32(18).string(message.creator);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueCommentRevision {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueCommentRevision();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueCommentRevision {
+    return {
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+    };
+  },
+
+  toJSON(message: IssueCommentRevision): unknown {
+    const obj: any = {};
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueCommentRevision>): IssueCommentRevision {
+    return IssueCommentRevision.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueCommentRevision>): IssueCommentRevision {
+    const message = createBaseIssueCommentRevision();
+    message.comment = object.comment ?? "";
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseIssueComment(): IssueComment {
+  return {
+    uid: "",
+    comment: "",
+    payload: "",
+    createTime: undefined,
+    updateTime: undefined,
+    name: "",
+    creator: "",
+    approval: undefined,
+    issueUpdate: undefined,
+    stageEnd: undefined,
+    taskUpdate: undefined,
+    taskPriorBackup: undefined,
+    stageApproval: undefined,
+    edited: false,
+    deleted: false,
+  };
+}
+
+export const IssueComment = {
+  encode(message: IssueComment, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.uid !== "") {
+      writer.uint32(10).string(message.uid);
+    }
+    if (message.comment !== "") {
+      writer.uint32(18).string(message.comment);
+    }
+    if (message.payload !== "") {
+      writer.uint32(26).string(message.payload);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.name !== "") {
+      writer.uint32(50).string(message.name);
+    }
+    if (message.creator !== "") {
+      writer.uint32(58).string(message.creator);
+    }
+    if (message.approval !== undefined) {
+      IssueComment_Approval.encode(message.approval, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.issueUpdate !== undefined) {
+      IssueComment_IssueUpdate.encode(message.issueUpdate, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.stageEnd !== undefined) {
+      IssueComment_StageEnd.encode(message.stageEnd, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.taskUpdate !== undefined) {
+      IssueComment_TaskUpdate.encode(message.taskUpdate, writer.uint32(90).fork()).ldelim();
+    }
+    if (message.taskPriorBackup !== undefined) {
+      IssueComment_TaskPriorBackup.encode(message.taskPriorBackup, writer.uint32(98).fork()).ldelim();
+    }
+    if (message.stageApproval !== undefined) {
+      IssueComment_StageApproval.encode(message.stageApproval, writer.uint32(106).fork()).ldelim();
+    }
+    if (message.edited === true) {
+      writer.uint32(112).bool(message.edited);
+    }
+    if (message.deleted === true) {
+      writer.uint32(120).bool(message.deleted);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.uid = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.payload = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.approval = IssueComment_Approval.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.issueUpdate = IssueComment_IssueUpdate.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.stageEnd = IssueComment_StageEnd.decode(reader, reader.uint32());
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.taskUpdate = IssueComment_TaskUpdate.decode(reader, reader.uint32());
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.taskPriorBackup = IssueComment_TaskPriorBackup.decode(reader, reader.uint32());
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.stageApproval = IssueComment_StageApproval.decode(reader, reader.uint32());
+          continue;
+        case 14:
+          if (tag !== 112) {
+            break;
+          }
+
+          message.edited = reader.bool();
+          continue;
+        case 15:
+          if (tag !== 120) {
+            break;
+          }
+
+          message.deleted = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment {
+    return {
+      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+      payload: isSet(object.payload) ? globalThis.String(object.payload) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      approval: isSet(object.approval) ? IssueComment_Approval.fromJSON(object.approval) : undefined,
+      issueUpdate: isSet(object.issueUpdate) ? IssueComment_IssueUpdate.fromJSON(object.issueUpdate) : undefined,
+      stageEnd: isSet(object.stageEnd) ? IssueComment_StageEnd.fromJSON(object.stageEnd) : undefined,
+      taskUpdate: isSet(object.taskUpdate) ? IssueComment_TaskUpdate.fromJSON(object.taskUpdate) : undefined,
+      taskPriorBackup: isSet(object.taskPriorBackup)
+        ? IssueComment_TaskPriorBackup.fromJSON(object.taskPriorBackup)
+        : undefined,
+      stageApproval: isSet(object.stageApproval)
+        ? IssueComment_StageApproval.fromJSON(object.stageApproval)
+        : undefined,
+      edited: isSet(object.edited) ? globalThis.Boolean(object.edited) : false,
+      deleted: isSet(object.deleted) ? globalThis.Boolean(object.deleted) : false,
+    };
+  },
+
+  toJSON(message: IssueComment): unknown {
+    const obj: any = {};
+    if (message.uid !== "") {
+      obj.uid = message.uid;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    if (message.payload !== "") {
+      obj.payload = message.payload;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
+    }
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.approval !== undefined) {
+      obj.approval = IssueComment_Approval.toJSON(message.approval);
+    }
+    if (message.issueUpdate !== undefined) {
+      obj.issueUpdate = IssueComment_IssueUpdate.toJSON(message.issueUpdate);
+    }
+    if (message.stageEnd !== undefined) {
+      obj.stageEnd = IssueComment_StageEnd.toJSON(message.stageEnd);
+    }
+    if (message.taskUpdate !== undefined) {
+      obj.taskUpdate = IssueComment_TaskUpdate.toJSON(message.taskUpdate);
+    }
+    if (message.taskPriorBackup !== undefined) {
+      obj.taskPriorBackup = IssueComment_TaskPriorBackup.toJSON(message.taskPriorBackup);
+    }
+    if (message.stageApproval !== undefined) {
+      obj.stageApproval = IssueComment_StageApproval.toJSON(message.stageApproval);
+    }
+    if (message.edited === true) {
+      obj.edited = message.edited;
+    }
+    if (message.deleted === true) {
+      obj.deleted = message.deleted;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment>): IssueComment {
+    return IssueComment.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment>): IssueComment {
+    const message = createBaseIssueComment();
+    message.uid = object.uid ?? "";
+    message.comment = object.comment ?? "";
+    message.payload = object.payload ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    message.name = object.name ?? "";
+    message.creator = object.creator ?? "";
+    message.approval = (object.approval !== undefined && object.approval !== null)
+      ? IssueComment_Approval.fromPartial(object.approval)
+      : undefined;
+    message.issueUpdate = (object.issueUpdate !== undefined && object.issueUpdate !== null)
+      ? IssueComment_IssueUpdate.fromPartial(object.issueUpdate)
+      : undefined;
+    message.stageEnd = (object.stageEnd !== undefined && object.stageEnd !== null)
+      ? IssueComment_StageEnd.fromPartial(object.stageEnd)
+      : undefined;
+    message.taskUpdate = (object.taskUpdate !== undefined && object.taskUpdate !== null)
+      ? IssueComment_TaskUpdate.fromPartial(object.taskUpdate)
+      : undefined;
+    message.taskPriorBackup = (object.taskPriorBackup !== undefined && object.taskPriorBackup !== null)
+      ? IssueComment_TaskPriorBackup.fromPartial(object.taskPriorBackup)
+      : undefined;
+    message.stageApproval = (object.stageApproval !== undefined && object.stageApproval !== null)
+      ? IssueComment_StageApproval.fromPartial(object.stageApproval)
+      : undefined;
+    message.edited = object.edited ?? false;
+    message.deleted = object.deleted ?? false;
+    return message;
+  },
+};
+
+function createBaseIssueComment_Approval(): IssueComment_Approval {
+  return { status: IssueComment_Approval_Status.STATUS_UNSPECIFIED };
+}
+
+export const IssueComment_Approval = {
+  encode(message: IssueComment_Approval, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.status !== IssueComment_Approval_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(8).int32(issueComment_Approval_StatusToNumber(message.status));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_Approval {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_Approval();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = issueComment_Approval_StatusFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_Approval {
+    return {
+      status: isSet(object.status)
+        ? issueComment_Approval_StatusFromJSON(object.status)
+        : IssueComment_Approval_Status.STATUS_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: IssueComment_Approval): unknown {
+    const obj: any = {};
+    if (message.status !== IssueComment_Approval_Status.STATUS_UNSPECIFIED) {
+      obj.status = issueComment_Approval_StatusToJSON(message.status);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_Approval>): IssueComment_Approval {
+    return IssueComment_Approval.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_Approval>): IssueComment_Approval {
+    const message = createBaseIssueComment_Approval();
+    message.status = object.status ?? IssueComment_Approval_Status.STATUS_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseIssueComment_IssueUpdate(): IssueComment_IssueUpdate {
+  return {
+    fromTitle: undefined,
+    toTitle: undefined,
+    fromDescription: undefined,
+    toDescription: undefined,
+    fromStatus: undefined,
+    toStatus: undefined,
+    fromLabels: [],
+    toLabels: [],
+  };
+}
+
+export const IssueComment_IssueUpdate = {
+  encode(message: IssueComment_IssueUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.fromTitle !== undefined) {
+      writer.uint32(10).string(message.fromTitle);
+    }
+    if (message.toTitle !== undefined) {
+      writer.uint32(18).string(message.toTitle);
+    }
+    if (message.fromDescription !== undefined) {
+      writer.uint32(26).string(message.fromDescription);
+    }
+    if (message.toDescription !== undefined) {
+      writer.uint32(34).string(message.toDescription);
+    }
+    if (message.fromStatus !== undefined) {
+      writer.uint32(40).int32(issueStatusToNumber(message.fromStatus));
+    }
+    if (message.toStatus !== undefined) {
+      writer.uint32(48).int32(issueStatusToNumber(message.toStatus));
+    }
+    for (const v of message.fromLabels) {
+      writer.uint32(74).string(v!);
+    }
+    for (const v of message.toLabels) {
+      writer.uint32(82).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_IssueUpdate {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_IssueUpdate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fromTitle = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.toTitle = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.fromDescription = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.toDescription = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.fromStatus = issueStatusFromJSON(reader.int32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.toStatus = issueStatusFromJSON(reader.int32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.fromLabels.push(reader.string());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.toLabels.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_IssueUpdate {
+    return {
+      fromTitle: isSet(object.fromTitle) ? globalThis.String(object.fromTitle) : undefined,
+      toTitle: isSet(object.toTitle) ? globalThis.String(object.toTitle) : undefined,
+      fromDescription: isSet(object.fromDescription) ? globalThis.String(object.fromDescription) : undefined,
+      toDescription: isSet(object.toDescription) ? globalThis.String(object.toDescription) : undefined,
+      fromStatus: isSet(object.fromStatus) ? issueStatusFromJSON(object.fromStatus) : undefined,
+      toStatus: isSet(object.toStatus) ? issueStatusFromJSON(object.toStatus) : undefined,
+      fromLabels: globalThis.Array.isArray(object?.fromLabels)
+        ? object.fromLabels.map((e: any) => globalThis.String(e))
+        : [],
+      toLabels: globalThis.Array.isArray(object?.toLabels) ? object.toLabels.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: IssueComment_IssueUpdate): unknown {
+    const obj: any = {};
+    if (message.fromTitle !== undefined) {
+      obj.fromTitle = message.fromTitle;
+    }
+    if (message.toTitle !== undefined) {
+      obj.toTitle = message.toTitle;
+    }
+    if (message.fromDescription !== undefined) {
+      obj.fromDescription = message.fromDescription;
+    }
+    if (message.toDescription !== undefined) {
+      obj.toDescription = message.toDescription;
+    }
+    if (message.fromStatus !== undefined) {
+      obj.fromStatus = issueStatusToJSON(message.fromStatus);
+    }
+    if (message.toStatus !== undefined) {
+      obj.toStatus = issueStatusToJSON(message.toStatus);
+    }
+    if (message.fromLabels?.length) {
+      obj.fromLabels = message.fromLabels;
+    }
+    if (message.toLabels?.length) {
+      obj.toLabels = message.toLabels;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_IssueUpdate>): IssueComment_IssueUpdate {
+    return IssueComment_IssueUpdate.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_IssueUpdate>): IssueComment_IssueUpdate {
+    const message = createBaseIssueComment_IssueUpdate();
+    message.fromTitle = object.fromTitle ?? undefined;
+    message.toTitle = object.toTitle ?? undefined;
+    message.fromDescription = object.fromDescription ?? undefined;
+    message.toDescription = object.toDescription ?? undefined;
+    message.fromStatus = object.fromStatus ?? undefined;
+    message.toStatus = object.toStatus ?? undefined;
+    message.fromLabels = object.fromLabels?.map((e) => e) || [];
+    message.toLabels = object.toLabels?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseIssueComment_StageEnd(): IssueComment_StageEnd {
+  return { stage: "" };
+}
+
+export const IssueComment_StageEnd = {
+  encode(message: IssueComment_StageEnd, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.stage !== "") {
+      writer.uint32(10).string(message.stage);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_StageEnd {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_StageEnd();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.stage = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_StageEnd {
+    return { stage: isSet(object.stage) ? globalThis.String(object.stage) : "" };
+  },
+
+  toJSON(message: IssueComment_StageEnd): unknown {
+    const obj: any = {};
+    if (message.stage !== "") {
+      obj.stage = message.stage;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_StageEnd>): IssueComment_StageEnd {
+    return IssueComment_StageEnd.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_StageEnd>): IssueComment_StageEnd {
+    const message = createBaseIssueComment_StageEnd();
+    message.stage = object.stage ?? "";
+    return message;
+  },
+};
+
+function createBaseIssueComment_TaskUpdate(): IssueComment_TaskUpdate {
+  return {
+    tasks: [],
+    fromSheet: undefined,
+    toSheet: undefined,
+    fromEarliestAllowedTime: undefined,
+    toEarliestAllowedTime: undefined,
+    toStatus: undefined,
+  };
+}
+
+export const IssueComment_TaskUpdate = {
+  encode(message: IssueComment_TaskUpdate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.tasks) {
+      writer.uint32(10).string(v!);
+    }
+    if (message.fromSheet !== undefined) {
+      writer.uint32(18).string(message.fromSheet);
+    }
+    if (message.toSheet !== undefined) {
+      writer.uint32(26).string(message.toSheet);
+    }
+    if (message.fromEarliestAllowedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.fromEarliestAllowedTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.toEarliestAllowedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.toEarliestAllowedTime), writer.uint32(42).fork()).ldelim();
+    }
+    if (message.toStatus !== undefined) {
+      writer.uint32(48).int32(issueComment_TaskUpdate_StatusToNumber(message.toStatus));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_TaskUpdate {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_TaskUpdate();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.tasks.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.fromSheet = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.toSheet = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.fromEarliestAllowedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.toEarliestAllowedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.toStatus = issueComment_TaskUpdate_StatusFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_TaskUpdate {
+    return {
+      tasks: globalThis.Array.isArray(object?.tasks) ? object.tasks.map((e: any) => globalThis.String(e)) : [],
+      fromSheet: isSet(object.fromSheet) ? globalThis.String(object.fromSheet) : undefined,
+      toSheet: isSet(object.toSheet) ? globalThis.String(object.toSheet) : undefined,
+      fromEarliestAllowedTime: isSet(object.fromEarliestAllowedTime)
+        ? fromJsonTimestamp(object.fromEarliestAllowedTime)
+        : undefined,
+      toEarliestAllowedTime: isSet(object.toEarliestAllowedTime)
+        ? fromJsonTimestamp(object.toEarliestAllowedTime)
+        : undefined,
+      toStatus: isSet(object.toStatus) ? issueComment_TaskUpdate_StatusFromJSON(object.toStatus) : undefined,
+    };
+  },
+
+  toJSON(message: IssueComment_TaskUpdate): unknown {
+    const obj: any = {};
+    if (message.tasks?.length) {
+      obj.tasks = message.tasks;
+    }
+    if (message.fromSheet !== undefined) {
+      obj.fromSheet = message.fromSheet;
+    }
+    if (message.toSheet !== undefined) {
+      obj.toSheet = message.toSheet;
+    }
+    if (message.fromEarliestAllowedTime !== undefined) {
+      obj.fromEarliestAllowedTime = message.fromEarliestAllowedTime.toISOString();
+    }
+    if (message.toEarliestAllowedTime !== undefined) {
+      obj.toEarliestAllowedTime = message.toEarliestAllowedTime.toISOString();
+    }
+    if (message.toStatus !== undefined) {
+      obj.toStatus = issueComment_TaskUpdate_StatusToJSON(message.toStatus);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_TaskUpdate>): IssueComment_TaskUpdate {
+    return IssueComment_TaskUpdate.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_TaskUpdate>): IssueComment_TaskUpdate {
+    const message = createBaseIssueComment_TaskUpdate();
+    message.tasks = object.tasks?.map((e) => e) || [];
+    message.fromSheet = object.fromSheet ?? undefined;
+    message.toSheet = object.toSheet ?? undefined;
+    message.fromEarliestAllowedTime = object.fromEarliestAllowedTime ?? undefined;
+    message.toEarliestAllowedTime = object.toEarliestAllowedTime ?? undefined;
+    message.toStatus = object.toStatus ?? undefined;
+    return message;
+  },
+};
+
+function createBaseIssueComment_TaskPriorBackup(): IssueComment_TaskPriorBackup {
+  return { task: "", tables: [], originalLine: undefined, database: "" };
+}
+
+export const IssueComment_TaskPriorBackup = {
+  encode(message: IssueComment_TaskPriorBackup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.task !== "") {
+      writer.uint32(10).string(message.task);
+    }
+    for (const v of message.tables) {
+      IssueComment_TaskPriorBackup_Table.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.originalLine !== undefined) {
+      writer.uint32(24).int32(message.originalLine);
+    }
+    if (message.database !== "") {
+      writer.uint32(34).string(message.database);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_TaskPriorBackup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_TaskPriorBackup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.task = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tables.push(IssueComment_TaskPriorBackup_Table.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.originalLine = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_TaskPriorBackup {
+    return {
+      task: isSet(object.task) ? globalThis.String(object.task) : "",
+      tables: globalThis.Array.isArray(object?.tables)
+        ? object.tables.map((e: any) => IssueComment_TaskPriorBackup_Table.fromJSON(e))
+        : [],
+      originalLine: isSet(object.originalLine) ? globalThis.Number(object.originalLine) : undefined,
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+    };
+  },
+
+  toJSON(message: IssueComment_TaskPriorBackup): unknown {
+    const obj: any = {};
+    if (message.task !== "") {
+      obj.task = message.task;
+    }
+    if (message.tables?.length) {
+      obj.tables = message.tables.map((e) => IssueComment_TaskPriorBackup_Table.toJSON(e));
+    }
+    if (message.originalLine !== undefined) {
+      obj.originalLine = Math.round(message.originalLine);
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_TaskPriorBackup>): IssueComment_TaskPriorBackup {
+    return IssueComment_TaskPriorBackup.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_TaskPriorBackup>): IssueComment_TaskPriorBackup {
+    const message = createBaseIssueComment_TaskPriorBackup();
+    message.task = object.task ?? "";
+    message.tables = object.tables?.map((e) => IssueComment_TaskPriorBackup_Table.fromPartial(e)) || [];
+    message.originalLine = object.originalLine ?? undefined;
+    message.database = object.database ?? "";
+    return message;
+  },
+};
+
+function createBaseIssueComment_TaskPriorBackup_Table(): IssueComment_TaskPriorBackup_Table {
+  return { schema: "", table: "" };
+}
+
+export const IssueComment_TaskPriorBackup_Table = {
+  encode(message: IssueComment_TaskPriorBackup_Table, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_TaskPriorBackup_Table {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_TaskPriorBackup_Table();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_TaskPriorBackup_Table {
+    return {
+      schema: isSet(object.schema) ? globalThis.String(object.schema) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+    };
+  },
+
+  toJSON(message: IssueComment_TaskPriorBackup_Table): unknown {
+    const obj: any = {};
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_TaskPriorBackup_Table>): IssueComment_TaskPriorBackup_Table {
+    return IssueComment_TaskPriorBackup_Table.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_TaskPriorBackup_Table>): IssueComment_TaskPriorBackup_Table {
+    const message = createBaseIssueComment_TaskPriorBackup_Table();
+    message.schema = object.schema ?? "";
+    message.table = object.table ?? "";
+    return message;
+  },
+};
+
+function createBaseIssueComment_StageApproval(): IssueComment_StageApproval {
+  return { stage: "", status: IssueComment_StageApproval_Status.STATUS_UNSPECIFIED };
+}
+
+export const IssueComment_StageApproval = {
+  encode(message: IssueComment_StageApproval, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.stage !== "") {
+      writer.uint32(10).string(message.stage);
+    }
+    if (message.status !== IssueComment_StageApproval_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(16).int32(issueComment_StageApproval_StatusToNumber(message.status));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): IssueComment_StageApproval {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseIssueComment_StageApproval();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.stage = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.status = issueComment_StageApproval_StatusFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): IssueComment_StageApproval {
+    return {
+      stage: isSet(object.stage) ? globalThis.String(object.stage) : "",
+      status: isSet(object.status)
+        ? issueComment_StageApproval_StatusFromJSON(object.status)
+        : IssueComment_StageApproval_Status.STATUS_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: IssueComment_StageApproval): unknown {
+    const obj: any = {};
+    if (message.stage !== "") {
+      obj.stage = message.stage;
+    }
+    if (message.status !== IssueComment_StageApproval_Status.STATUS_UNSPECIFIED) {
+      obj.status = issueComment_StageApproval_StatusToJSON(message.status);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<IssueComment_StageApproval>): IssueComment_StageApproval {
+    return IssueComment_StageApproval.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<IssueComment_StageApproval>): IssueComment_StageApproval {
+    const message = createBaseIssueComment_StageApproval();
+    message.stage = object.stage ?? "";
+    message.status = object.status ?? IssueComment_StageApproval_Status.STATUS_UNSPECIFIED;
+    return message;
+  },
+};
+
+export type IssueServiceDefinition = typeof IssueServiceDefinition;
+export const IssueServiceDefinition = {
+  name: "IssueService",
+  fullName: "bytebase.v1.IssueService",
+  methods: {
+    getIssue: {
+      name: "GetIssue",
+      requestType: GetIssueRequest,
+      requestStream: false,
+      responseType: Issue,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              32,
+              18,
+              30,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    createIssue: {
+      name: "CreateIssue",
+      requestType: CreateIssueRequest,
+      requestStream: false,
+      responseType: Issue,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([12, 112, 97, 114, 101, 110, 116, 44, 105, 115, 115, 117, 101])],
+          800010: [new Uint8Array([16, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 99, 114, 101, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              39,
+              58,
+              5,
+              105,
+              115,
+              115,
+              117,
+              101,
+              34,
+              30,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    listIssues: {
+      name: "ListIssues",
+      requestType: ListIssuesRequest,
+      requestStream: false,
+      responseType: ListIssuesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [new Uint8Array([14, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 108, 105, 115, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              32,
+              18,
+              30,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /** Search for issues that the caller has the bb.issues.get permission on and also satisfy the specified filter & query. */
+    searchIssues: {
+      name: "SearchIssues",
+      requestType: SearchIssuesRequest,
+      requestStream: false,
+      responseType: SearchIssuesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              42,
+              58,
+              1,
+              42,
+              34,
+              37,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+              58,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+            ]),
+          ],
         },
       },
     },
-    createIssue: {
-      name: "CreateIssue",
-      requestType: CreateIssueRequest,
+    updateIssue: {
+      name: "UpdateIssue",
+      requestType: UpdateIssueRequest,
       requestStream: false,
       responseType: Issue,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([12, 112, 97, 114, 101, 110, 116, 44, 105, 115, 115, 117, 101])],
-          800010: [new Uint8Array([16, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 99, 114, 101, 97, 116, 101])],
+          8410: [new Uint8Array([17, 105, 115, 115, 117, 101, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
+          800010: [new Uint8Array([16, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 117, 112, 100, 97, 116, 101])],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              39,
+              45,
               58,
               5,
               105,
@@ -4262,8 +7029,256 @@ export const IssueServiceDefinition = {
               115,
               117,
               101,
-              34,
-              30,
+              50,
+              36,
+              47,
+              118,
+              49,
+              47,
+              123,
+              105,
+              115,
+              115,
+              117,
+              101,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ListIssueComments lists the comments of the issue in the order of creation.
+     * Use the page_size and page_token query parameters to paginate over HTTP.
+     */
+    listIssueComments: {
+      name: "ListIssueComments",
+      requestType: ListIssueCommentsRequest,
+      requestStream: false,
+      responseType: ListIssueCommentsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [
+            new Uint8Array([
+              21,
+              98,
+              98,
+              46,
+              105,
+              115,
+              115,
+              117,
+              101,
+              67,
+              111,
+              109,
+              109,
+              101,
+              110,
+              116,
+              115,
+              46,
+              108,
+              105,
+              115,
+              116,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              48,
+              18,
+              46,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              67,
+              111,
+              109,
+              109,
+              101,
+              110,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetIssueTimeline returns the comments, activities, approval events, plan check runs and task runs
+     * of the issue in one chronologically ordered feed.
+     */
+    getIssueTimeline: {
+      name: "GetIssueTimeline",
+      requestType: GetIssueTimelineRequest,
+      requestStream: false,
+      responseType: GetIssueTimelineResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [
+            new Uint8Array([
+              21,
+              98,
+              98,
+              46,
+              105,
+              115,
+              115,
+              117,
+              101,
+              67,
+              111,
+              109,
+              109,
+              101,
+              110,
+              116,
+              115,
+              46,
+              108,
+              105,
+              115,
+              116,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              43,
+              18,
+              41,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              116,
+              105,
+              109,
+              101,
+              108,
+              105,
+              110,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * ListChangeCalendarEvents lists the scheduled and completed rollouts in the time range.
+     * Use "projects/-" as the parent to list the events in all projects the caller can access.
+     */
+    listChangeCalendarEvents: {
+      name: "ListChangeCalendarEvents",
+      requestType: ListChangeCalendarEventsRequest,
+      requestStream: false,
+      responseType: ListChangeCalendarEventsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              46,
+              18,
+              44,
               47,
               118,
               49,
@@ -4288,33 +7303,48 @@ export const IssueServiceDefinition = {
               42,
               125,
               47,
-              105,
-              115,
-              115,
-              117,
+              99,
+              104,
+              97,
+              110,
+              103,
+              101,
+              67,
+              97,
+              108,
+              101,
+              110,
+              100,
+              97,
+              114,
+              69,
+              118,
               101,
+              110,
+              116,
               115,
             ]),
           ],
         },
       },
     },
-    listIssues: {
-      name: "ListIssues",
-      requestType: ListIssuesRequest,
+    /** ExportChangeCalendar exports the change calendar events in the time range as an iCalendar feed. */
+    exportChangeCalendar: {
+      name: "ExportChangeCalendar",
+      requestType: ExportChangeCalendarRequest,
       requestStream: false,
-      responseType: ListIssuesResponse,
+      responseType: ExportChangeCalendarResponse,
       responseStream: false,
       options: {
         _unknownFields: {
           8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
-          800010: [new Uint8Array([14, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 108, 105, 115, 116])],
-          800016: [new Uint8Array([1])],
+          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([2])],
           578365826: [
             new Uint8Array([
-              32,
+              53,
               18,
-              30,
+              51,
               47,
               118,
               49,
@@ -4339,36 +7369,62 @@ export const IssueServiceDefinition = {
               42,
               125,
               47,
-              105,
-              115,
-              115,
-              117,
+              99,
+              104,
+              97,
+              110,
+              103,
               101,
+              67,
+              97,
+              108,
+              101,
+              110,
+              100,
+              97,
+              114,
+              69,
+              118,
+              101,
+              110,
+              116,
               115,
+              58,
+              101,
+              120,
+              112,
+              111,
+              114,
+              116,
             ]),
           ],
         },
       },
     },
-    /** Search for issues that the caller has the bb.issues.get permission on and also satisfy the specified filter & query. */
-    searchIssues: {
-      name: "SearchIssues",
-      requestType: SearchIssuesRequest,
+    /**
+     * PurgeIssues hard deletes the done and canceled issues created before the time, including their comments,
+     * plans, rollouts and sheets. The open issues are never purged.
+     * Only the caller with bb.issues.purge on the workspace can purge issues.
+     */
+    purgeIssues: {
+      name: "PurgeIssues",
+      requestType: PurgeIssuesRequest,
       requestStream: false,
-      responseType: SearchIssuesResponse,
+      responseType: PurgeIssuesResponse,
       responseStream: false,
       options: {
         _unknownFields: {
-          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
+          800010: [new Uint8Array([15, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 112, 117, 114, 103, 101])],
           800016: [new Uint8Array([2])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              42,
+              41,
               58,
               1,
               42,
               34,
-              37,
+              36,
               47,
               118,
               49,
@@ -4400,93 +7456,157 @@ export const IssueServiceDefinition = {
               101,
               115,
               58,
-              115,
-              101,
-              97,
+              112,
+              117,
               114,
-              99,
-              104,
+              103,
+              101,
             ]),
           ],
         },
       },
     },
-    updateIssue: {
-      name: "UpdateIssue",
-      requestType: UpdateIssueRequest,
+    /**
+     * RegenerateCalendarFeed generates a new secret iCalendar feed URL of the caller's pending approvals and scheduled rollouts.
+     * The previous feed URL of the caller stops working.
+     */
+    regenerateCalendarFeed: {
+      name: "RegenerateCalendarFeed",
+      requestType: RegenerateCalendarFeedRequest,
       requestStream: false,
-      responseType: Issue,
+      responseType: CalendarFeed,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([17, 105, 115, 115, 117, 101, 44, 117, 112, 100, 97, 116, 101, 95, 109, 97, 115, 107])],
-          800010: [new Uint8Array([16, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 117, 112, 100, 97, 116, 101])],
-          800016: [new Uint8Array([1])],
+          800016: [new Uint8Array([2])],
           578365826: [
             new Uint8Array([
-              45,
+              32,
               58,
-              5,
-              105,
-              115,
-              115,
-              117,
-              101,
-              50,
-              36,
+              1,
+              42,
+              34,
+              27,
               47,
               118,
               49,
               47,
-              123,
-              105,
-              115,
-              115,
-              117,
+              99,
+              97,
+              108,
               101,
-              46,
               110,
+              100,
               97,
-              109,
+              114,
+              70,
               101,
-              61,
-              112,
+              101,
+              100,
+              58,
               114,
-              111,
-              106,
               101,
-              99,
+              103,
+              101,
+              110,
+              101,
+              114,
+              97,
               116,
-              115,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetCalendarFeed returns the iCalendar feed of the user who owns the token.
+     * It's authenticated by the secret token in the URL so that calendar apps can subscribe to it.
+     */
+    getCalendarFeed: {
+      name: "GetCalendarFeed",
+      requestType: GetCalendarFeedRequest,
+      requestStream: false,
+      responseType: HttpBody,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800000: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              27,
+              18,
+              25,
               47,
-              42,
+              118,
+              49,
               47,
-              105,
-              115,
-              115,
-              117,
+              99,
+              97,
+              108,
+              101,
+              110,
+              100,
+              97,
+              114,
+              70,
               101,
+              101,
+              100,
               115,
               47,
-              42,
+              123,
+              116,
+              111,
+              107,
+              101,
+              110,
               125,
             ]),
           ],
         },
       },
     },
-    listIssueComments: {
-      name: "ListIssueComments",
-      requestType: ListIssueCommentsRequest,
+    /**
+     * CreateIssueComment creates a comment on the issue.
+     * The HTTP request body is the issue comment, e.g. {"comment": "LGTM"}.
+     */
+    createIssueComment: {
+      name: "CreateIssueComment",
+      requestType: CreateIssueCommentRequest,
       requestStream: false,
-      responseType: ListIssueCommentsResponse,
+      responseType: IssueComment,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          8410: [
+            new Uint8Array([
+              20,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              44,
+              105,
+              115,
+              115,
+              117,
+              101,
+              95,
+              99,
+              111,
+              109,
+              109,
+              101,
+              110,
+              116,
+            ]),
+          ],
           800010: [
             new Uint8Array([
-              21,
+              23,
               98,
               98,
               46,
@@ -4504,18 +7624,35 @@ export const IssueServiceDefinition = {
               116,
               115,
               46,
-              108,
-              105,
-              115,
+              99,
+              114,
+              101,
+              97,
               116,
+              101,
             ]),
           ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              48,
-              18,
-              46,
+              57,
+              58,
+              13,
+              105,
+              115,
+              115,
+              117,
+              101,
+              95,
+              99,
+              111,
+              109,
+              109,
+              101,
+              110,
+              116,
+              34,
+              40,
               47,
               118,
               49,
@@ -4548,28 +7685,28 @@ export const IssueServiceDefinition = {
               47,
               42,
               125,
-              47,
-              105,
-              115,
-              115,
-              117,
-              101,
-              67,
+              58,
+              99,
               111,
               109,
               109,
               101,
               110,
               116,
-              115,
             ]),
           ],
         },
       },
     },
-    createIssueComment: {
-      name: "CreateIssueComment",
-      requestType: CreateIssueCommentRequest,
+    /**
+     * UpdateIssueComment updates the comment of the issue.
+     * The HTTP request body is the issue comment with the uid, e.g. {"uid": "101", "comment": "LGTM"}.
+     * The update mask is parsed from the update_mask query parameter, e.g. ?update_mask=comment,
+     * or inferred from the fields in the request body if it's not set.
+     */
+    updateIssueComment: {
+      name: "UpdateIssueComment",
+      requestType: UpdateIssueCommentRequest,
       requestStream: false,
       responseType: IssueComment,
       responseStream: false,
@@ -4577,7 +7714,7 @@ export const IssueServiceDefinition = {
         _unknownFields: {
           8410: [
             new Uint8Array([
-              20,
+              32,
               112,
               97,
               114,
@@ -4598,6 +7735,18 @@ export const IssueServiceDefinition = {
               101,
               110,
               116,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
             ]),
           ],
           800010: [
@@ -4620,9 +7769,9 @@ export const IssueServiceDefinition = {
               116,
               115,
               46,
-              99,
-              114,
-              101,
+              117,
+              112,
+              100,
               97,
               116,
               101,
@@ -4647,7 +7796,7 @@ export const IssueServiceDefinition = {
               101,
               110,
               116,
-              34,
+              50,
               40,
               47,
               118,
@@ -4694,54 +7843,118 @@ export const IssueServiceDefinition = {
         },
       },
     },
-    updateIssueComment: {
-      name: "UpdateIssueComment",
-      requestType: UpdateIssueCommentRequest,
+    /**
+     * DeleteIssueComment soft deletes the comment of the issue, a tombstone is left in place of the comment.
+     * Only the creator can delete the comment.
+     */
+    deleteIssueComment: {
+      name: "DeleteIssueComment",
+      requestType: DeleteIssueCommentRequest,
       requestStream: false,
-      responseType: IssueComment,
+      responseType: Empty,
       responseStream: false,
       options: {
         _unknownFields: {
-          8410: [
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [
             new Uint8Array([
-              32,
-              112,
-              97,
-              114,
-              101,
-              110,
-              116,
-              44,
+              23,
+              98,
+              98,
+              46,
               105,
               115,
               115,
               117,
               101,
-              95,
-              99,
+              67,
               111,
               109,
               109,
               101,
               110,
               116,
-              44,
-              117,
-              112,
+              115,
+              46,
               100,
+              101,
+              108,
+              101,
+              116,
+              101,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              48,
+              42,
+              46,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
               97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
               116,
+              115,
+              47,
+              42,
+              47,
+              105,
+              115,
+              115,
+              117,
               101,
-              95,
+              115,
+              47,
+              42,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              67,
+              111,
               109,
-              97,
+              109,
+              101,
+              110,
+              116,
               115,
-              107,
+              47,
+              42,
+              125,
             ]),
           ],
+        },
+      },
+    },
+    /** ListIssueCommentRevisions lists the previous comments of the edited or deleted comment, the latest first. */
+    listIssueCommentRevisions: {
+      name: "ListIssueCommentRevisions",
+      requestType: ListIssueCommentRevisionsRequest,
+      requestStream: false,
+      responseType: ListIssueCommentRevisionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
           800010: [
             new Uint8Array([
-              23,
+              30,
               98,
               98,
               46,
@@ -4759,35 +7972,27 @@ export const IssueServiceDefinition = {
               116,
               115,
               46,
-              117,
-              112,
-              100,
-              97,
+              108,
+              105,
+              115,
               116,
+              82,
               101,
+              118,
+              105,
+              115,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
           800016: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              57,
+              60,
+              18,
               58,
-              13,
-              105,
-              115,
-              115,
-              117,
-              101,
-              95,
-              99,
-              111,
-              109,
-              109,
-              101,
-              110,
-              116,
-              50,
-              40,
               47,
               118,
               49,
@@ -4819,15 +8024,33 @@ export const IssueServiceDefinition = {
               115,
               47,
               42,
-              125,
-              58,
-              99,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              67,
               111,
               109,
               109,
               101,
               110,
               116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              114,
+              101,
+              118,
+              105,
+              115,
+              105,
+              111,
+              110,
+              115,
             ]),
           ],
         },
@@ -4904,9 +8127,92 @@ export const IssueServiceDefinition = {
         },
       },
     },
+    /**
+     * RefreshExternalApprovals queues the pending external approvals of the issues in the project to be checked in the background.
+     * It returns immediately, the check results are reported in the external_approvals of the issues.
+     */
+    refreshExternalApprovals: {
+      name: "RefreshExternalApprovals",
+      requestType: RefreshExternalApprovalsRequest,
+      requestStream: false,
+      responseType: RefreshExternalApprovalsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([13, 98, 98, 46, 105, 115, 115, 117, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              60,
+              58,
+              1,
+              42,
+              34,
+              55,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              105,
+              115,
+              115,
+              117,
+              101,
+              115,
+              58,
+              114,
+              101,
+              102,
+              114,
+              101,
+              115,
+              104,
+              69,
+              120,
+              116,
+              101,
+              114,
+              110,
+              97,
+              108,
+              65,
+              112,
+              112,
+              114,
+              111,
+              118,
+              97,
+              108,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
     /**
      * ApproveIssue approves the issue.
      * The access is based on approval flow.
+     * The HTTP request body is optional, e.g. {"comment": "LGTM"}.
      */
     approveIssue: {
       name: "ApproveIssue",
@@ -4971,6 +8277,7 @@ export const IssueServiceDefinition = {
     /**
      * RejectIssue rejects the issue.
      * The access is based on approval flow.
+     * The HTTP request body is optional, e.g. {"comment": "Please add the rollback statements"}.
      */
     rejectIssue: {
       name: "RejectIssue",
@@ -5032,8 +8339,9 @@ export const IssueServiceDefinition = {
       },
     },
     /**
-     * RequestIssue requests the issue.
+     * RequestIssue re-requests the approval of the rejected issue.
      * The access is based on approval flow.
+     * The HTTP request body is optional, e.g. {"comment": "Rollback statements added"}.
      */
     requestIssue: {
       name: "RequestIssue",
@@ -5098,6 +8406,31 @@ export const IssueServiceDefinition = {
   },
 } as const;
 
+function bytesFromBase64(b64: string): Uint8Array {
+  if (globalThis.Buffer) {
+    return Uint8Array.from(globalThis.Buffer.from(b64, "base64"));
+  } else {
+    const bin = globalThis.atob(b64);
+    const arr = new Uint8Array(bin.length);
+    for (let i = 0; i < bin.length; ++i) {
+      arr[i] = bin.charCodeAt(i);
+    }
+    return arr;
+  }
+}
+
+function base64FromBytes(arr: Uint8Array): string {
+  if (globalThis.Buffer) {
+    return globalThis.Buffer.from(arr).toString("base64");
+  } else {
+    const bin: string[] = [];
+    arr.forEach((byte) => {
+      bin.push(globalThis.String.fromCharCode(byte));
+    });
+    return globalThis.btoa(bin.join(""));
+  }
+}
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -4,11 +4,19 @@ import _m0 from "protobufjs/minimal";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 import {
+  DataLoadFormat,
+  dataLoadFormatFromJSON,
+  dataLoadFormatToJSON,
+  dataLoadFormatToNumber,
   ExportFormat,
   exportFormatFromJSON,
   exportFormatToJSON,
   exportFormatToNumber,
   Position,
+  TransactionMode,
+  transactionModeFromJSON,
+  transactionModeToJSON,
+  transactionModeToNumber,
   VCSType,
   vCSTypeFromJSON,
   vCSTypeToJSON,
@@ -26,6 +34,37 @@ export interface GetPlanRequest {
   name: string;
 }
 
+export interface PreviewPlanStatementsRequest {
+  /**
+   * The name of the plan to preview.
+   * Format: projects/{project}/plans/{plan}
+   */
+  name: string;
+}
+
+export interface PreviewPlanStatementsResponse {
+  statements: PreviewPlanStatementsResponse_DatabaseStatement[];
+}
+
+export interface PreviewPlanStatementsResponse_DatabaseStatement {
+  /** The id of the spec. */
+  specId: string;
+  /**
+   * The target database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  database: string;
+  /**
+   * The statement to run on the database with the statement variables substituted.
+   * The secrets are never substituted in the preview.
+   */
+  statement: string;
+  /** The statement is truncated if it's too large to preview. */
+  truncated: boolean;
+  /** The referenced statement variables that have no value for the database. */
+  undefinedVariables: string[];
+}
+
 export interface ListPlansRequest {
   /**
    * The parent, which owns this collection of plans.
@@ -106,6 +145,14 @@ export interface CreatePlanRequest {
   plan: Plan | undefined;
 }
 
+export interface CreateSchemaDriftReconciliationPlanRequest {
+  /**
+   * The name of the drifted database.
+   * Format: instances/{instance}/databases/{database}
+   */
+  name: string;
+}
+
 export interface UpdatePlanRequest {
   /**
    * The plan to update.
@@ -154,6 +201,11 @@ export interface Plan {
    * - ERROR
    */
   planCheckRunStatusCount: { [key: string]: number };
+  /**
+   * The warnings raised on creating the plan, e.g. the sheets which are too large to review.
+   * It's only set in the response of creating the plan.
+   */
+  warnings: string[];
 }
 
 export interface Plan_Step {
@@ -176,6 +228,8 @@ export interface Plan_Spec {
   createDatabaseConfig?: Plan_CreateDatabaseConfig | undefined;
   changeDatabaseConfig?: Plan_ChangeDatabaseConfig | undefined;
   exportDataConfig?: Plan_ExportDataConfig | undefined;
+  restoreDatabaseConfig?: Plan_RestoreDatabaseConfig | undefined;
+  loadDataConfig?: Plan_LoadDataConfig | undefined;
 }
 
 export interface Plan_PlanCheckRunStatusCountEntry {
@@ -211,6 +265,18 @@ export interface Plan_CreateDatabaseConfig {
   environment: string;
   /** labels of the database. */
   labels: { [key: string]: string };
+  /**
+   * The template to create the baseline schema of the database from.
+   * Format: instances/{instance}/databases/{database}
+   * Format: projects/{project}/branches/{branch}
+   * If empty, the schema is copied from a peer tenant database if there is one.
+   */
+  template: string;
+  /**
+   * The title of the deployment in the project deployment config that the database belongs to.
+   * The labels required by the deployment are applied to the database if they are not set in labels.
+   */
+  deployment: string;
 }
 
 export interface Plan_CreateDatabaseConfig_LabelsEntry {
@@ -238,7 +304,37 @@ export interface Plan_ChangeDatabaseConfig {
   schemaVersion: string;
   ghostFlags: { [key: string]: string };
   /** If set, a backup of the modified data will be created automatically before any changes are applied. */
-  preUpdateBackupDetail?: Plan_ChangeDatabaseConfig_PreUpdateBackupDetail | undefined;
+  preUpdateBackupDetail?:
+    | Plan_ChangeDatabaseConfig_PreUpdateBackupDetail
+    | undefined;
+  /**
+   * If true, the destructive statements, e.g. dropping tables or columns, generated by the state-based migration are allowed.
+   * Only applicable to MIGRATE_SDL type.
+   */
+  allowDestructiveChanges: boolean;
+  /**
+   * The queries to verify the database after the change is applied.
+   * The task fails with the FAILED_VERIFICATION status if any query doesn't return the expected result.
+   */
+  verificationQueries: Plan_VerificationQuery[];
+  /**
+   * If set, the change is simulated as a plan check against a scratch clone of the target database on the instance.
+   * The clone is restored from the latest backup of the target database, or created with CREATE DATABASE ... TEMPLATE
+   * for Postgres on the same instance. The clone is dropped after the simulation.
+   * Format: instances/{instance}
+   */
+  simulationInstance: string;
+  /**
+   * The transaction mode of the statements.
+   * Only applicable to MIGRATE and DATA types on MySQL, MariaDB, OceanBase and Postgres.
+   */
+  transactionMode: TransactionMode;
+  /**
+   * The session variables set before the statements run, e.g. statement_timeout and lock_timeout for Postgres,
+   * or lock_wait_timeout and sql_mode for MySQL.
+   * Only applicable to MIGRATE and DATA types on MySQL, MariaDB, OceanBase and Postgres.
+   */
+  sessionVariables: { [key: string]: string };
 }
 
 /** Type is the database change type. */
@@ -339,6 +435,80 @@ export interface Plan_ChangeDatabaseConfig_PreUpdateBackupDetail {
    * Format: instances/{instance}/databases/{database}
    */
   database: string;
+  /** If true, the entire tables affected by the change are backed up rather than only the affected rows. */
+  fullTable: boolean;
+}
+
+export interface Plan_ChangeDatabaseConfig_SessionVariablesEntry {
+  key: string;
+  value: string;
+}
+
+export interface Plan_VerificationQuery {
+  /** The title of the verification. */
+  title: string;
+  /** The read-only statement to run. */
+  statement: string;
+  type: Plan_VerificationQuery_Type;
+  expectedRowCount: Long;
+  /** The bounds are inclusive, and an unset bound is unbounded. */
+  minValue?: number | undefined;
+  maxValue?: number | undefined;
+}
+
+export enum Plan_VerificationQuery_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  /** ROW_COUNT_EQUALS - The number of the returned rows equals expected_row_count. */
+  ROW_COUNT_EQUALS = "ROW_COUNT_EQUALS",
+  /** VALUE_IN_RANGE - The value of the first column in the first returned row is within [min_value, max_value]. */
+  VALUE_IN_RANGE = "VALUE_IN_RANGE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function plan_VerificationQuery_TypeFromJSON(object: any): Plan_VerificationQuery_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return Plan_VerificationQuery_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "ROW_COUNT_EQUALS":
+      return Plan_VerificationQuery_Type.ROW_COUNT_EQUALS;
+    case 2:
+    case "VALUE_IN_RANGE":
+      return Plan_VerificationQuery_Type.VALUE_IN_RANGE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Plan_VerificationQuery_Type.UNRECOGNIZED;
+  }
+}
+
+export function plan_VerificationQuery_TypeToJSON(object: Plan_VerificationQuery_Type): string {
+  switch (object) {
+    case Plan_VerificationQuery_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case Plan_VerificationQuery_Type.ROW_COUNT_EQUALS:
+      return "ROW_COUNT_EQUALS";
+    case Plan_VerificationQuery_Type.VALUE_IN_RANGE:
+      return "VALUE_IN_RANGE";
+    case Plan_VerificationQuery_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function plan_VerificationQuery_TypeToNumber(object: Plan_VerificationQuery_Type): number {
+  switch (object) {
+    case Plan_VerificationQuery_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case Plan_VerificationQuery_Type.ROW_COUNT_EQUALS:
+      return 1;
+    case Plan_VerificationQuery_Type.VALUE_IN_RANGE:
+      return 2;
+    case Plan_VerificationQuery_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
 }
 
 export interface Plan_ExportDataConfig {
@@ -361,6 +531,51 @@ export interface Plan_ExportDataConfig {
   password?: string | undefined;
 }
 
+export interface Plan_LoadDataConfig {
+  /**
+   * The resource name of the database to load the data into.
+   * Format: instances/{instance-id}/databases/{database-name}
+   */
+  target: string;
+  /** The name of the existing table to load the data into. */
+  table: string;
+  /**
+   * The URI of the files in the object storage, e.g. s3://bucket/path/part-*.csv or gs://bucket/path/data.parquet.
+   * TiDB reads the files with IMPORT INTO, the wildcards and the credentials in the query parameters are supported.
+   * MySQL streams the single S3 object through Bytebase with LOAD DATA LOCAL INFILE, using the default credentials of the Bytebase server.
+   */
+  sourceUri: string;
+  /** The format of the files. PARQUET is only supported for TiDB. */
+  format: DataLoadFormat;
+  /** Whether the first line of the CSV files is the header and skipped. */
+  header: boolean;
+  /** The field delimiter of the CSV files. Default is ",". */
+  fieldDelimiter: string;
+}
+
+export interface Plan_RestoreDatabaseConfig {
+  /**
+   * The resource name of the database whose backup is restored.
+   * Format: instances/{instance-id}/databases/{database-name}
+   */
+  target: string;
+  /**
+   * The resource name of the backup run to restore.
+   * Format: instances/{instance-id}/databases/{database-name}/backupRuns/{backup-run}
+   * Exactly one of backup_run and restore_time must be set.
+   */
+  backupRun: string;
+  /** The latest successful backup run of the target taken at or before the restore time is restored. */
+  restoreTime:
+    | Date
+    | undefined;
+  /**
+   * The name of the new database on the same instance to restore into.
+   * If empty, the target database is dropped and recreated from the backup.
+   */
+  targetDatabase: string;
+}
+
 export interface Plan_VCSSource {
   vcsType: VCSType;
   /**
@@ -459,6 +674,10 @@ export enum PlanCheckRun_Type {
   DATABASE_STATEMENT_SUMMARY_REPORT = "DATABASE_STATEMENT_SUMMARY_REPORT",
   DATABASE_CONNECT = "DATABASE_CONNECT",
   DATABASE_GHOST_SYNC = "DATABASE_GHOST_SYNC",
+  DATABASE_STATEMENT_CONFLICT = "DATABASE_STATEMENT_CONFLICT",
+  DATABASE_STATEMENT_SIMULATE = "DATABASE_STATEMENT_SIMULATE",
+  DATABASE_GRANT_PREVIEW = "DATABASE_GRANT_PREVIEW",
+  DATABASE_STATEMENT_DRY_RUN = "DATABASE_STATEMENT_DRY_RUN",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -482,6 +701,18 @@ export function planCheckRun_TypeFromJSON(object: any): PlanCheckRun_Type {
     case 7:
     case "DATABASE_GHOST_SYNC":
       return PlanCheckRun_Type.DATABASE_GHOST_SYNC;
+    case 8:
+    case "DATABASE_STATEMENT_CONFLICT":
+      return PlanCheckRun_Type.DATABASE_STATEMENT_CONFLICT;
+    case 9:
+    case "DATABASE_STATEMENT_SIMULATE":
+      return PlanCheckRun_Type.DATABASE_STATEMENT_SIMULATE;
+    case 10:
+    case "DATABASE_GRANT_PREVIEW":
+      return PlanCheckRun_Type.DATABASE_GRANT_PREVIEW;
+    case 11:
+    case "DATABASE_STATEMENT_DRY_RUN":
+      return PlanCheckRun_Type.DATABASE_STATEMENT_DRY_RUN;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -503,6 +734,14 @@ export function planCheckRun_TypeToJSON(object: PlanCheckRun_Type): string {
       return "DATABASE_CONNECT";
     case PlanCheckRun_Type.DATABASE_GHOST_SYNC:
       return "DATABASE_GHOST_SYNC";
+    case PlanCheckRun_Type.DATABASE_STATEMENT_CONFLICT:
+      return "DATABASE_STATEMENT_CONFLICT";
+    case PlanCheckRun_Type.DATABASE_STATEMENT_SIMULATE:
+      return "DATABASE_STATEMENT_SIMULATE";
+    case PlanCheckRun_Type.DATABASE_GRANT_PREVIEW:
+      return "DATABASE_GRANT_PREVIEW";
+    case PlanCheckRun_Type.DATABASE_STATEMENT_DRY_RUN:
+      return "DATABASE_STATEMENT_DRY_RUN";
     case PlanCheckRun_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -523,6 +762,14 @@ export function planCheckRun_TypeToNumber(object: PlanCheckRun_Type): number {
       return 6;
     case PlanCheckRun_Type.DATABASE_GHOST_SYNC:
       return 7;
+    case PlanCheckRun_Type.DATABASE_STATEMENT_CONFLICT:
+      return 8;
+    case PlanCheckRun_Type.DATABASE_STATEMENT_SIMULATE:
+      return 9;
+    case PlanCheckRun_Type.DATABASE_GRANT_PREVIEW:
+      return 10;
+    case PlanCheckRun_Type.DATABASE_STATEMENT_DRY_RUN:
+      return 11;
     case PlanCheckRun_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -605,6 +852,7 @@ export interface PlanCheckRun_Result {
   code: number;
   sqlSummaryReport?: PlanCheckRun_Result_SqlSummaryReport | undefined;
   sqlReviewReport?: PlanCheckRun_Result_SqlReviewReport | undefined;
+  conflictReport?: PlanCheckRun_Result_ConflictReport | undefined;
 }
 
 export enum PlanCheckRun_Result_Status {
@@ -690,6 +938,45 @@ export interface PlanCheckRun_Result_SqlReviewReport {
   endPosition: Position | undefined;
 }
 
+export interface PlanCheckRun_Result_ConflictReport {
+  /**
+   * The open issue changing the same tables.
+   * Format: projects/{project}/issues/{issue}
+   */
+  issue: string;
+  /**
+   * The tables changed by both issues.
+   * Format: schema.table or table if the schema is empty.
+   */
+  tables: string[];
+}
+
+export interface ListPlanCheckRunQueuesRequest {
+}
+
+export interface ListPlanCheckRunQueuesResponse {
+  queues: ListPlanCheckRunQueuesResponse_Queue[];
+  /**
+   * The maximum number of the plan checks running concurrently on an instance.
+   * It's 0 if the plan checks are only limited by the maximum connections of the instance.
+   */
+  maxConcurrentPerInstance: number;
+}
+
+export interface ListPlanCheckRunQueuesResponse_Queue {
+  /**
+   * The instance of the queue.
+   * Format: instances/{instance}
+   */
+  instance: string;
+  /** The number of the executing plan checks. */
+  running: number;
+  /** The number of the plan checks waiting to execute. */
+  queued: number;
+  /** The number of the queued plan checks belonging to the issues awaiting approval, which run first. */
+  boosted: number;
+}
+
 function createBaseGetPlanRequest(): GetPlanRequest {
   return { name: "" };
 }
@@ -747,28 +1034,22 @@ export const GetPlanRequest = {
   },
 };
 
-function createBaseListPlansRequest(): ListPlansRequest {
-  return { parent: "", pageSize: 0, pageToken: "" };
+function createBasePreviewPlanStatementsRequest(): PreviewPlanStatementsRequest {
+  return { name: "" };
 }
 
-export const ListPlansRequest = {
-  encode(message: ListPlansRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
-    }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
-    }
-    if (message.pageToken !== "") {
-      writer.uint32(26).string(message.pageToken);
+export const PreviewPlanStatementsRequest = {
+  encode(message: PreviewPlanStatementsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlansRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PreviewPlanStatementsRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListPlansRequest();
+    const message = createBasePreviewPlanStatementsRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -777,21 +1058,7 @@ export const ListPlansRequest = {
             break;
           }
 
-          message.parent = reader.string();
-          continue;
-        case 2:
-          if (tag !== 16) {
-            break;
-          }
-
-          message.pageSize = reader.int32();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.pageToken = reader.string();
+          message.name = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -802,59 +1069,44 @@ export const ListPlansRequest = {
     return message;
   },
 
-  fromJSON(object: any): ListPlansRequest {
-    return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
-      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
-    };
+  fromJSON(object: any): PreviewPlanStatementsRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
   },
 
-  toJSON(message: ListPlansRequest): unknown {
+  toJSON(message: PreviewPlanStatementsRequest): unknown {
     const obj: any = {};
-    if (message.parent !== "") {
-      obj.parent = message.parent;
-    }
-    if (message.pageSize !== 0) {
-      obj.pageSize = Math.round(message.pageSize);
-    }
-    if (message.pageToken !== "") {
-      obj.pageToken = message.pageToken;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListPlansRequest>): ListPlansRequest {
-    return ListPlansRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<PreviewPlanStatementsRequest>): PreviewPlanStatementsRequest {
+    return PreviewPlanStatementsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListPlansRequest>): ListPlansRequest {
-    const message = createBaseListPlansRequest();
-    message.parent = object.parent ?? "";
-    message.pageSize = object.pageSize ?? 0;
-    message.pageToken = object.pageToken ?? "";
+  fromPartial(object: DeepPartial<PreviewPlanStatementsRequest>): PreviewPlanStatementsRequest {
+    const message = createBasePreviewPlanStatementsRequest();
+    message.name = object.name ?? "";
     return message;
   },
 };
 
-function createBaseListPlansResponse(): ListPlansResponse {
-  return { plans: [], nextPageToken: "" };
+function createBasePreviewPlanStatementsResponse(): PreviewPlanStatementsResponse {
+  return { statements: [] };
 }
 
-export const ListPlansResponse = {
-  encode(message: ListPlansResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.plans) {
-      Plan.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+export const PreviewPlanStatementsResponse = {
+  encode(message: PreviewPlanStatementsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.statements) {
+      PreviewPlanStatementsResponse_DatabaseStatement.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlansResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PreviewPlanStatementsResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListPlansResponse();
+    const message = createBasePreviewPlanStatementsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -863,14 +1115,7 @@ export const ListPlansResponse = {
             break;
           }
 
-          message.plans.push(Plan.decode(reader, reader.uint32()));
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.nextPageToken = reader.string();
+          message.statements.push(PreviewPlanStatementsResponse_DatabaseStatement.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -881,60 +1126,64 @@ export const ListPlansResponse = {
     return message;
   },
 
-  fromJSON(object: any): ListPlansResponse {
+  fromJSON(object: any): PreviewPlanStatementsResponse {
     return {
-      plans: globalThis.Array.isArray(object?.plans) ? object.plans.map((e: any) => Plan.fromJSON(e)) : [],
-      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
+      statements: globalThis.Array.isArray(object?.statements)
+        ? object.statements.map((e: any) => PreviewPlanStatementsResponse_DatabaseStatement.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: ListPlansResponse): unknown {
+  toJSON(message: PreviewPlanStatementsResponse): unknown {
     const obj: any = {};
-    if (message.plans?.length) {
-      obj.plans = message.plans.map((e) => Plan.toJSON(e));
-    }
-    if (message.nextPageToken !== "") {
-      obj.nextPageToken = message.nextPageToken;
+    if (message.statements?.length) {
+      obj.statements = message.statements.map((e) => PreviewPlanStatementsResponse_DatabaseStatement.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ListPlansResponse>): ListPlansResponse {
-    return ListPlansResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<PreviewPlanStatementsResponse>): PreviewPlanStatementsResponse {
+    return PreviewPlanStatementsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ListPlansResponse>): ListPlansResponse {
-    const message = createBaseListPlansResponse();
-    message.plans = object.plans?.map((e) => Plan.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
+  fromPartial(object: DeepPartial<PreviewPlanStatementsResponse>): PreviewPlanStatementsResponse {
+    const message = createBasePreviewPlanStatementsResponse();
+    message.statements =
+      object.statements?.map((e) => PreviewPlanStatementsResponse_DatabaseStatement.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseSearchPlansRequest(): SearchPlansRequest {
-  return { parent: "", pageSize: 0, pageToken: "", filter: "" };
+function createBasePreviewPlanStatementsResponse_DatabaseStatement(): PreviewPlanStatementsResponse_DatabaseStatement {
+  return { specId: "", database: "", statement: "", truncated: false, undefinedVariables: [] };
 }
 
-export const SearchPlansRequest = {
-  encode(message: SearchPlansRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
+export const PreviewPlanStatementsResponse_DatabaseStatement = {
+  encode(
+    message: PreviewPlanStatementsResponse_DatabaseStatement,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.specId !== "") {
+      writer.uint32(10).string(message.specId);
     }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
     }
-    if (message.pageToken !== "") {
-      writer.uint32(26).string(message.pageToken);
+    if (message.statement !== "") {
+      writer.uint32(26).string(message.statement);
     }
-    if (message.filter !== "") {
-      writer.uint32(34).string(message.filter);
+    if (message.truncated === true) {
+      writer.uint32(32).bool(message.truncated);
+    }
+    for (const v of message.undefinedVariables) {
+      writer.uint32(42).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SearchPlansRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PreviewPlanStatementsResponse_DatabaseStatement {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSearchPlansRequest();
+    const message = createBasePreviewPlanStatementsResponse_DatabaseStatement();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -943,28 +1192,35 @@ export const SearchPlansRequest = {
             break;
           }
 
-          message.parent = reader.string();
+          message.specId = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.pageSize = reader.int32();
+          message.database = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.pageToken = reader.string();
+          message.statement = reader.string();
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.filter = reader.string();
+          message.truncated = reader.bool();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.undefinedVariables.push(reader.string());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -975,16 +1231,120 @@ export const SearchPlansRequest = {
     return message;
   },
 
-  fromJSON(object: any): SearchPlansRequest {
+  fromJSON(object: any): PreviewPlanStatementsResponse_DatabaseStatement {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
-      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
-      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+      specId: isSet(object.specId) ? globalThis.String(object.specId) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      truncated: isSet(object.truncated) ? globalThis.Boolean(object.truncated) : false,
+      undefinedVariables: globalThis.Array.isArray(object?.undefinedVariables)
+        ? object.undefinedVariables.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
-  toJSON(message: SearchPlansRequest): unknown {
+  toJSON(message: PreviewPlanStatementsResponse_DatabaseStatement): unknown {
+    const obj: any = {};
+    if (message.specId !== "") {
+      obj.specId = message.specId;
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.truncated === true) {
+      obj.truncated = message.truncated;
+    }
+    if (message.undefinedVariables?.length) {
+      obj.undefinedVariables = message.undefinedVariables;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<PreviewPlanStatementsResponse_DatabaseStatement>,
+  ): PreviewPlanStatementsResponse_DatabaseStatement {
+    return PreviewPlanStatementsResponse_DatabaseStatement.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<PreviewPlanStatementsResponse_DatabaseStatement>,
+  ): PreviewPlanStatementsResponse_DatabaseStatement {
+    const message = createBasePreviewPlanStatementsResponse_DatabaseStatement();
+    message.specId = object.specId ?? "";
+    message.database = object.database ?? "";
+    message.statement = object.statement ?? "";
+    message.truncated = object.truncated ?? false;
+    message.undefinedVariables = object.undefinedVariables?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListPlansRequest(): ListPlansRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const ListPlansRequest = {
+  encode(message: ListPlansRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlansRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPlansRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPlansRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListPlansRequest): unknown {
     const obj: any = {};
     if (message.parent !== "") {
       obj.parent = message.parent;
@@ -995,31 +1355,27 @@ export const SearchPlansRequest = {
     if (message.pageToken !== "") {
       obj.pageToken = message.pageToken;
     }
-    if (message.filter !== "") {
-      obj.filter = message.filter;
-    }
     return obj;
   },
 
-  create(base?: DeepPartial<SearchPlansRequest>): SearchPlansRequest {
-    return SearchPlansRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListPlansRequest>): ListPlansRequest {
+    return ListPlansRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SearchPlansRequest>): SearchPlansRequest {
-    const message = createBaseSearchPlansRequest();
+  fromPartial(object: DeepPartial<ListPlansRequest>): ListPlansRequest {
+    const message = createBaseListPlansRequest();
     message.parent = object.parent ?? "";
     message.pageSize = object.pageSize ?? 0;
     message.pageToken = object.pageToken ?? "";
-    message.filter = object.filter ?? "";
     return message;
   },
 };
 
-function createBaseSearchPlansResponse(): SearchPlansResponse {
+function createBaseListPlansResponse(): ListPlansResponse {
   return { plans: [], nextPageToken: "" };
 }
 
-export const SearchPlansResponse = {
-  encode(message: SearchPlansResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const ListPlansResponse = {
+  encode(message: ListPlansResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     for (const v of message.plans) {
       Plan.encode(v!, writer.uint32(10).fork()).ldelim();
     }
@@ -1029,10 +1385,10 @@ export const SearchPlansResponse = {
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SearchPlansResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlansResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSearchPlansResponse();
+    const message = createBaseListPlansResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1059,14 +1415,14 @@ export const SearchPlansResponse = {
     return message;
   },
 
-  fromJSON(object: any): SearchPlansResponse {
+  fromJSON(object: any): ListPlansResponse {
     return {
       plans: globalThis.Array.isArray(object?.plans) ? object.plans.map((e: any) => Plan.fromJSON(e)) : [],
       nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
     };
   },
 
-  toJSON(message: SearchPlansResponse): unknown {
+  toJSON(message: ListPlansResponse): unknown {
     const obj: any = {};
     if (message.plans?.length) {
       obj.plans = message.plans.map((e) => Plan.toJSON(e));
@@ -1077,36 +1433,42 @@ export const SearchPlansResponse = {
     return obj;
   },
 
-  create(base?: DeepPartial<SearchPlansResponse>): SearchPlansResponse {
-    return SearchPlansResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListPlansResponse>): ListPlansResponse {
+    return ListPlansResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SearchPlansResponse>): SearchPlansResponse {
-    const message = createBaseSearchPlansResponse();
+  fromPartial(object: DeepPartial<ListPlansResponse>): ListPlansResponse {
+    const message = createBaseListPlansResponse();
     message.plans = object.plans?.map((e) => Plan.fromPartial(e)) || [];
     message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-function createBaseCreatePlanRequest(): CreatePlanRequest {
-  return { parent: "", plan: undefined };
+function createBaseSearchPlansRequest(): SearchPlansRequest {
+  return { parent: "", pageSize: 0, pageToken: "", filter: "" };
 }
 
-export const CreatePlanRequest = {
-  encode(message: CreatePlanRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const SearchPlansRequest = {
+  encode(message: SearchPlansRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.parent !== "") {
       writer.uint32(10).string(message.parent);
     }
-    if (message.plan !== undefined) {
-      Plan.encode(message.plan, writer.uint32(18).fork()).ldelim();
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    if (message.filter !== "") {
+      writer.uint32(34).string(message.filter);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CreatePlanRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SearchPlansRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCreatePlanRequest();
+    const message = createBaseSearchPlansRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1118,11 +1480,25 @@ export const CreatePlanRequest = {
           message.parent = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.plan = Plan.decode(reader, reader.uint32());
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.filter = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1133,54 +1509,64 @@ export const CreatePlanRequest = {
     return message;
   },
 
-  fromJSON(object: any): CreatePlanRequest {
+  fromJSON(object: any): SearchPlansRequest {
     return {
       parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      plan: isSet(object.plan) ? Plan.fromJSON(object.plan) : undefined,
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
     };
   },
 
-  toJSON(message: CreatePlanRequest): unknown {
+  toJSON(message: SearchPlansRequest): unknown {
     const obj: any = {};
     if (message.parent !== "") {
       obj.parent = message.parent;
     }
-    if (message.plan !== undefined) {
-      obj.plan = Plan.toJSON(message.plan);
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
+    }
+    if (message.filter !== "") {
+      obj.filter = message.filter;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<CreatePlanRequest>): CreatePlanRequest {
-    return CreatePlanRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<SearchPlansRequest>): SearchPlansRequest {
+    return SearchPlansRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<CreatePlanRequest>): CreatePlanRequest {
-    const message = createBaseCreatePlanRequest();
+  fromPartial(object: DeepPartial<SearchPlansRequest>): SearchPlansRequest {
+    const message = createBaseSearchPlansRequest();
     message.parent = object.parent ?? "";
-    message.plan = (object.plan !== undefined && object.plan !== null) ? Plan.fromPartial(object.plan) : undefined;
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    message.filter = object.filter ?? "";
     return message;
   },
 };
 
-function createBaseUpdatePlanRequest(): UpdatePlanRequest {
-  return { plan: undefined, updateMask: undefined };
+function createBaseSearchPlansResponse(): SearchPlansResponse {
+  return { plans: [], nextPageToken: "" };
 }
 
-export const UpdatePlanRequest = {
-  encode(message: UpdatePlanRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.plan !== undefined) {
-      Plan.encode(message.plan, writer.uint32(10).fork()).ldelim();
+export const SearchPlansResponse = {
+  encode(message: SearchPlansResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.plans) {
+      Plan.encode(v!, writer.uint32(10).fork()).ldelim();
     }
-    if (message.updateMask !== undefined) {
-      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdatePlanRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SearchPlansResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdatePlanRequest();
+    const message = createBaseSearchPlansResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1189,14 +1575,14 @@ export const UpdatePlanRequest = {
             break;
           }
 
-          message.plan = Plan.decode(reader, reader.uint32());
+          message.plans.push(Plan.decode(reader, reader.uint32()));
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          message.nextPageToken = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1207,52 +1593,260 @@ export const UpdatePlanRequest = {
     return message;
   },
 
-  fromJSON(object: any): UpdatePlanRequest {
+  fromJSON(object: any): SearchPlansResponse {
     return {
-      plan: isSet(object.plan) ? Plan.fromJSON(object.plan) : undefined,
-      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+      plans: globalThis.Array.isArray(object?.plans) ? object.plans.map((e: any) => Plan.fromJSON(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
     };
   },
 
-  toJSON(message: UpdatePlanRequest): unknown {
+  toJSON(message: SearchPlansResponse): unknown {
     const obj: any = {};
-    if (message.plan !== undefined) {
-      obj.plan = Plan.toJSON(message.plan);
+    if (message.plans?.length) {
+      obj.plans = message.plans.map((e) => Plan.toJSON(e));
     }
-    if (message.updateMask !== undefined) {
-      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<UpdatePlanRequest>): UpdatePlanRequest {
-    return UpdatePlanRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<SearchPlansResponse>): SearchPlansResponse {
+    return SearchPlansResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdatePlanRequest>): UpdatePlanRequest {
-    const message = createBaseUpdatePlanRequest();
-    message.plan = (object.plan !== undefined && object.plan !== null) ? Plan.fromPartial(object.plan) : undefined;
-    message.updateMask = object.updateMask ?? undefined;
+  fromPartial(object: DeepPartial<SearchPlansResponse>): SearchPlansResponse {
+    const message = createBaseSearchPlansResponse();
+    message.plans = object.plans?.map((e) => Plan.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-function createBasePlan(): Plan {
-  return {
-    name: "",
-    uid: "",
-    issue: "",
-    title: "",
-    description: "",
-    steps: [],
-    vcsSource: undefined,
-    creator: "",
-    createTime: undefined,
-    updateTime: undefined,
-    planCheckRunStatusCount: {},
-  };
+function createBaseCreatePlanRequest(): CreatePlanRequest {
+  return { parent: "", plan: undefined };
 }
 
-export const Plan = {
+export const CreatePlanRequest = {
+  encode(message: CreatePlanRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.plan !== undefined) {
+      Plan.encode(message.plan, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreatePlanRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreatePlanRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.plan = Plan.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreatePlanRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      plan: isSet(object.plan) ? Plan.fromJSON(object.plan) : undefined,
+    };
+  },
+
+  toJSON(message: CreatePlanRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    if (message.plan !== undefined) {
+      obj.plan = Plan.toJSON(message.plan);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreatePlanRequest>): CreatePlanRequest {
+    return CreatePlanRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreatePlanRequest>): CreatePlanRequest {
+    const message = createBaseCreatePlanRequest();
+    message.parent = object.parent ?? "";
+    message.plan = (object.plan !== undefined && object.plan !== null) ? Plan.fromPartial(object.plan) : undefined;
+    return message;
+  },
+};
+
+function createBaseCreateSchemaDriftReconciliationPlanRequest(): CreateSchemaDriftReconciliationPlanRequest {
+  return { name: "" };
+}
+
+export const CreateSchemaDriftReconciliationPlanRequest = {
+  encode(message: CreateSchemaDriftReconciliationPlanRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateSchemaDriftReconciliationPlanRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateSchemaDriftReconciliationPlanRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateSchemaDriftReconciliationPlanRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: CreateSchemaDriftReconciliationPlanRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreateSchemaDriftReconciliationPlanRequest>): CreateSchemaDriftReconciliationPlanRequest {
+    return CreateSchemaDriftReconciliationPlanRequest.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<CreateSchemaDriftReconciliationPlanRequest>,
+  ): CreateSchemaDriftReconciliationPlanRequest {
+    const message = createBaseCreateSchemaDriftReconciliationPlanRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdatePlanRequest(): UpdatePlanRequest {
+  return { plan: undefined, updateMask: undefined };
+}
+
+export const UpdatePlanRequest = {
+  encode(message: UpdatePlanRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.plan !== undefined) {
+      Plan.encode(message.plan, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdatePlanRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdatePlanRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.plan = Plan.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdatePlanRequest {
+    return {
+      plan: isSet(object.plan) ? Plan.fromJSON(object.plan) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdatePlanRequest): unknown {
+    const obj: any = {};
+    if (message.plan !== undefined) {
+      obj.plan = Plan.toJSON(message.plan);
+    }
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdatePlanRequest>): UpdatePlanRequest {
+    return UpdatePlanRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdatePlanRequest>): UpdatePlanRequest {
+    const message = createBaseUpdatePlanRequest();
+    message.plan = (object.plan !== undefined && object.plan !== null) ? Plan.fromPartial(object.plan) : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBasePlan(): Plan {
+  return {
+    name: "",
+    uid: "",
+    issue: "",
+    title: "",
+    description: "",
+    steps: [],
+    vcsSource: undefined,
+    creator: "",
+    createTime: undefined,
+    updateTime: undefined,
+    planCheckRunStatusCount: {},
+    warnings: [],
+  };
+}
+
+export const Plan = {
   encode(message: Plan, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
@@ -1287,6 +1881,9 @@ export const Plan = {
     Object.entries(message.planCheckRunStatusCount).forEach(([key, value]) => {
       Plan_PlanCheckRunStatusCountEntry.encode({ key: key as any, value }, writer.uint32(90).fork()).ldelim();
     });
+    for (const v of message.warnings) {
+      writer.uint32(98).string(v!);
+    }
     return writer;
   },
 
@@ -1377,6 +1974,13 @@ export const Plan = {
             message.planCheckRunStatusCount[entry11.key] = entry11.value;
           }
           continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.warnings.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1404,6 +2008,7 @@ export const Plan = {
           return acc;
         }, {})
         : {},
+      warnings: globalThis.Array.isArray(object?.warnings) ? object.warnings.map((e: any) => globalThis.String(e)) : [],
     };
   },
 
@@ -1448,6 +2053,9 @@ export const Plan = {
         });
       }
     }
+    if (message.warnings?.length) {
+      obj.warnings = message.warnings;
+    }
     return obj;
   },
 
@@ -1476,6 +2084,7 @@ export const Plan = {
       }
       return acc;
     }, {});
+    message.warnings = object.warnings?.map((e) => e) || [];
     return message;
   },
 };
@@ -1562,6 +2171,8 @@ function createBasePlan_Spec(): Plan_Spec {
     createDatabaseConfig: undefined,
     changeDatabaseConfig: undefined,
     exportDataConfig: undefined,
+    restoreDatabaseConfig: undefined,
+    loadDataConfig: undefined,
   };
 }
 
@@ -1585,6 +2196,12 @@ export const Plan_Spec = {
     if (message.exportDataConfig !== undefined) {
       Plan_ExportDataConfig.encode(message.exportDataConfig, writer.uint32(58).fork()).ldelim();
     }
+    if (message.restoreDatabaseConfig !== undefined) {
+      Plan_RestoreDatabaseConfig.encode(message.restoreDatabaseConfig, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.loadDataConfig !== undefined) {
+      Plan_LoadDataConfig.encode(message.loadDataConfig, writer.uint32(74).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1637,6 +2254,20 @@ export const Plan_Spec = {
 
           message.exportDataConfig = Plan_ExportDataConfig.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.restoreDatabaseConfig = Plan_RestoreDatabaseConfig.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.loadDataConfig = Plan_LoadDataConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1664,6 +2295,10 @@ export const Plan_Spec = {
       exportDataConfig: isSet(object.exportDataConfig)
         ? Plan_ExportDataConfig.fromJSON(object.exportDataConfig)
         : undefined,
+      restoreDatabaseConfig: isSet(object.restoreDatabaseConfig)
+        ? Plan_RestoreDatabaseConfig.fromJSON(object.restoreDatabaseConfig)
+        : undefined,
+      loadDataConfig: isSet(object.loadDataConfig) ? Plan_LoadDataConfig.fromJSON(object.loadDataConfig) : undefined,
     };
   },
 
@@ -1687,6 +2322,12 @@ export const Plan_Spec = {
     if (message.exportDataConfig !== undefined) {
       obj.exportDataConfig = Plan_ExportDataConfig.toJSON(message.exportDataConfig);
     }
+    if (message.restoreDatabaseConfig !== undefined) {
+      obj.restoreDatabaseConfig = Plan_RestoreDatabaseConfig.toJSON(message.restoreDatabaseConfig);
+    }
+    if (message.loadDataConfig !== undefined) {
+      obj.loadDataConfig = Plan_LoadDataConfig.toJSON(message.loadDataConfig);
+    }
     return obj;
   },
 
@@ -1707,6 +2348,13 @@ export const Plan_Spec = {
     message.exportDataConfig = (object.exportDataConfig !== undefined && object.exportDataConfig !== null)
       ? Plan_ExportDataConfig.fromPartial(object.exportDataConfig)
       : undefined;
+    message.restoreDatabaseConfig =
+      (object.restoreDatabaseConfig !== undefined && object.restoreDatabaseConfig !== null)
+        ? Plan_RestoreDatabaseConfig.fromPartial(object.restoreDatabaseConfig)
+        : undefined;
+    message.loadDataConfig = (object.loadDataConfig !== undefined && object.loadDataConfig !== null)
+      ? Plan_LoadDataConfig.fromPartial(object.loadDataConfig)
+      : undefined;
     return message;
   },
 };
@@ -1796,6 +2444,8 @@ function createBasePlan_CreateDatabaseConfig(): Plan_CreateDatabaseConfig {
     owner: "",
     environment: "",
     labels: {},
+    template: "",
+    deployment: "",
   };
 }
 
@@ -1828,6 +2478,12 @@ export const Plan_CreateDatabaseConfig = {
     Object.entries(message.labels).forEach(([key, value]) => {
       Plan_CreateDatabaseConfig_LabelsEntry.encode({ key: key as any, value }, writer.uint32(82).fork()).ldelim();
     });
+    if (message.template !== "") {
+      writer.uint32(90).string(message.template);
+    }
+    if (message.deployment !== "") {
+      writer.uint32(98).string(message.deployment);
+    }
     return writer;
   },
 
@@ -1904,6 +2560,20 @@ export const Plan_CreateDatabaseConfig = {
             message.labels[entry10.key] = entry10.value;
           }
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.template = reader.string();
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.deployment = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1929,6 +2599,8 @@ export const Plan_CreateDatabaseConfig = {
           return acc;
         }, {})
         : {},
+      template: isSet(object.template) ? globalThis.String(object.template) : "",
+      deployment: isSet(object.deployment) ? globalThis.String(object.deployment) : "",
     };
   },
 
@@ -1967,6 +2639,12 @@ export const Plan_CreateDatabaseConfig = {
         });
       }
     }
+    if (message.template !== "") {
+      obj.template = message.template;
+    }
+    if (message.deployment !== "") {
+      obj.deployment = message.deployment;
+    }
     return obj;
   },
 
@@ -1989,6 +2667,8 @@ export const Plan_CreateDatabaseConfig = {
       }
       return acc;
     }, {});
+    message.template = object.template ?? "";
+    message.deployment = object.deployment ?? "";
     return message;
   },
 };
@@ -2075,6 +2755,11 @@ function createBasePlan_ChangeDatabaseConfig(): Plan_ChangeDatabaseConfig {
     schemaVersion: "",
     ghostFlags: {},
     preUpdateBackupDetail: undefined,
+    allowDestructiveChanges: false,
+    verificationQueries: [],
+    simulationInstance: "",
+    transactionMode: TransactionMode.TRANSACTION_MODE_UNSPECIFIED,
+    sessionVariables: {},
   };
 }
 
@@ -2099,6 +2784,22 @@ export const Plan_ChangeDatabaseConfig = {
       Plan_ChangeDatabaseConfig_PreUpdateBackupDetail.encode(message.preUpdateBackupDetail, writer.uint32(66).fork())
         .ldelim();
     }
+    if (message.allowDestructiveChanges === true) {
+      writer.uint32(72).bool(message.allowDestructiveChanges);
+    }
+    for (const v of message.verificationQueries) {
+      Plan_VerificationQuery.encode(v!, writer.uint32(82).fork()).ldelim();
+    }
+    if (message.simulationInstance !== "") {
+      writer.uint32(90).string(message.simulationInstance);
+    }
+    if (message.transactionMode !== TransactionMode.TRANSACTION_MODE_UNSPECIFIED) {
+      writer.uint32(96).int32(transactionModeToNumber(message.transactionMode));
+    }
+    Object.entries(message.sessionVariables).forEach(([key, value]) => {
+      Plan_ChangeDatabaseConfig_SessionVariablesEntry.encode({ key: key as any, value }, writer.uint32(106).fork())
+        .ldelim();
+    });
     return writer;
   },
 
@@ -2157,9 +2858,47 @@ export const Plan_ChangeDatabaseConfig = {
             reader.uint32(),
           );
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.allowDestructiveChanges = reader.bool();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.verificationQueries.push(Plan_VerificationQuery.decode(reader, reader.uint32()));
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.simulationInstance = reader.string();
+          continue;
+        case 12:
+          if (tag !== 96) {
+            break;
+          }
+
+          message.transactionMode = transactionModeFromJSON(reader.int32());
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          const entry13 = Plan_ChangeDatabaseConfig_SessionVariablesEntry.decode(reader, reader.uint32());
+          if (entry13.value !== undefined) {
+            message.sessionVariables[entry13.key] = entry13.value;
+          }
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
       }
       reader.skipType(tag & 7);
     }
@@ -2183,6 +2922,22 @@ export const Plan_ChangeDatabaseConfig = {
       preUpdateBackupDetail: isSet(object.preUpdateBackupDetail)
         ? Plan_ChangeDatabaseConfig_PreUpdateBackupDetail.fromJSON(object.preUpdateBackupDetail)
         : undefined,
+      allowDestructiveChanges: isSet(object.allowDestructiveChanges)
+        ? globalThis.Boolean(object.allowDestructiveChanges)
+        : false,
+      verificationQueries: globalThis.Array.isArray(object?.verificationQueries)
+        ? object.verificationQueries.map((e: any) => Plan_VerificationQuery.fromJSON(e))
+        : [],
+      simulationInstance: isSet(object.simulationInstance) ? globalThis.String(object.simulationInstance) : "",
+      transactionMode: isSet(object.transactionMode)
+        ? transactionModeFromJSON(object.transactionMode)
+        : TransactionMode.TRANSACTION_MODE_UNSPECIFIED,
+      sessionVariables: isObject(object.sessionVariables)
+        ? Object.entries(object.sessionVariables).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
     };
   },
 
@@ -2212,6 +2967,27 @@ export const Plan_ChangeDatabaseConfig = {
     if (message.preUpdateBackupDetail !== undefined) {
       obj.preUpdateBackupDetail = Plan_ChangeDatabaseConfig_PreUpdateBackupDetail.toJSON(message.preUpdateBackupDetail);
     }
+    if (message.allowDestructiveChanges === true) {
+      obj.allowDestructiveChanges = message.allowDestructiveChanges;
+    }
+    if (message.verificationQueries?.length) {
+      obj.verificationQueries = message.verificationQueries.map((e) => Plan_VerificationQuery.toJSON(e));
+    }
+    if (message.simulationInstance !== "") {
+      obj.simulationInstance = message.simulationInstance;
+    }
+    if (message.transactionMode !== TransactionMode.TRANSACTION_MODE_UNSPECIFIED) {
+      obj.transactionMode = transactionModeToJSON(message.transactionMode);
+    }
+    if (message.sessionVariables) {
+      const entries = Object.entries(message.sessionVariables);
+      if (entries.length > 0) {
+        obj.sessionVariables = {};
+        entries.forEach(([k, v]) => {
+          obj.sessionVariables[k] = v;
+        });
+      }
+    }
     return obj;
   },
 
@@ -2237,6 +3013,19 @@ export const Plan_ChangeDatabaseConfig = {
       (object.preUpdateBackupDetail !== undefined && object.preUpdateBackupDetail !== null)
         ? Plan_ChangeDatabaseConfig_PreUpdateBackupDetail.fromPartial(object.preUpdateBackupDetail)
         : undefined;
+    message.allowDestructiveChanges = object.allowDestructiveChanges ?? false;
+    message.verificationQueries = object.verificationQueries?.map((e) => Plan_VerificationQuery.fromPartial(e)) || [];
+    message.simulationInstance = object.simulationInstance ?? "";
+    message.transactionMode = object.transactionMode ?? TransactionMode.TRANSACTION_MODE_UNSPECIFIED;
+    message.sessionVariables = Object.entries(object.sessionVariables ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
     return message;
   },
 };
@@ -2318,7 +3107,7 @@ export const Plan_ChangeDatabaseConfig_GhostFlagsEntry = {
 };
 
 function createBasePlan_ChangeDatabaseConfig_PreUpdateBackupDetail(): Plan_ChangeDatabaseConfig_PreUpdateBackupDetail {
-  return { database: "" };
+  return { database: "", fullTable: false };
 }
 
 export const Plan_ChangeDatabaseConfig_PreUpdateBackupDetail = {
@@ -2329,6 +3118,9 @@ export const Plan_ChangeDatabaseConfig_PreUpdateBackupDetail = {
     if (message.database !== "") {
       writer.uint32(10).string(message.database);
     }
+    if (message.fullTable === true) {
+      writer.uint32(16).bool(message.fullTable);
+    }
     return writer;
   },
 
@@ -2346,6 +3138,13 @@ export const Plan_ChangeDatabaseConfig_PreUpdateBackupDetail = {
 
           message.database = reader.string();
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.fullTable = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2356,7 +3155,10 @@ export const Plan_ChangeDatabaseConfig_PreUpdateBackupDetail = {
   },
 
   fromJSON(object: any): Plan_ChangeDatabaseConfig_PreUpdateBackupDetail {
-    return { database: isSet(object.database) ? globalThis.String(object.database) : "" };
+    return {
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      fullTable: isSet(object.fullTable) ? globalThis.Boolean(object.fullTable) : false,
+    };
   },
 
   toJSON(message: Plan_ChangeDatabaseConfig_PreUpdateBackupDetail): unknown {
@@ -2364,6 +3166,9 @@ export const Plan_ChangeDatabaseConfig_PreUpdateBackupDetail = {
     if (message.database !== "") {
       obj.database = message.database;
     }
+    if (message.fullTable === true) {
+      obj.fullTable = message.fullTable;
+    }
     return obj;
   },
 
@@ -2377,35 +3182,33 @@ export const Plan_ChangeDatabaseConfig_PreUpdateBackupDetail = {
   ): Plan_ChangeDatabaseConfig_PreUpdateBackupDetail {
     const message = createBasePlan_ChangeDatabaseConfig_PreUpdateBackupDetail();
     message.database = object.database ?? "";
+    message.fullTable = object.fullTable ?? false;
     return message;
   },
 };
 
-function createBasePlan_ExportDataConfig(): Plan_ExportDataConfig {
-  return { target: "", sheet: "", format: ExportFormat.FORMAT_UNSPECIFIED, password: undefined };
+function createBasePlan_ChangeDatabaseConfig_SessionVariablesEntry(): Plan_ChangeDatabaseConfig_SessionVariablesEntry {
+  return { key: "", value: "" };
 }
 
-export const Plan_ExportDataConfig = {
-  encode(message: Plan_ExportDataConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.target !== "") {
-      writer.uint32(10).string(message.target);
-    }
-    if (message.sheet !== "") {
-      writer.uint32(18).string(message.sheet);
-    }
-    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
-      writer.uint32(24).int32(exportFormatToNumber(message.format));
+export const Plan_ChangeDatabaseConfig_SessionVariablesEntry = {
+  encode(
+    message: Plan_ChangeDatabaseConfig_SessionVariablesEntry,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.password !== undefined) {
-      writer.uint32(34).string(message.password);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_ExportDataConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_ChangeDatabaseConfig_SessionVariablesEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlan_ExportDataConfig();
+    const message = createBasePlan_ChangeDatabaseConfig_SessionVariablesEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2414,28 +3217,14 @@ export const Plan_ExportDataConfig = {
             break;
           }
 
-          message.target = reader.string();
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.sheet = reader.string();
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.format = exportFormatFromJSON(reader.int32());
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.password = reader.string();
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2446,90 +3235,121 @@ export const Plan_ExportDataConfig = {
     return message;
   },
 
-  fromJSON(object: any): Plan_ExportDataConfig {
+  fromJSON(object: any): Plan_ChangeDatabaseConfig_SessionVariablesEntry {
     return {
-      target: isSet(object.target) ? globalThis.String(object.target) : "",
-      sheet: isSet(object.sheet) ? globalThis.String(object.sheet) : "",
-      format: isSet(object.format) ? exportFormatFromJSON(object.format) : ExportFormat.FORMAT_UNSPECIFIED,
-      password: isSet(object.password) ? globalThis.String(object.password) : undefined,
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
     };
   },
 
-  toJSON(message: Plan_ExportDataConfig): unknown {
+  toJSON(message: Plan_ChangeDatabaseConfig_SessionVariablesEntry): unknown {
     const obj: any = {};
-    if (message.target !== "") {
-      obj.target = message.target;
-    }
-    if (message.sheet !== "") {
-      obj.sheet = message.sheet;
-    }
-    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
-      obj.format = exportFormatToJSON(message.format);
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.password !== undefined) {
-      obj.password = message.password;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Plan_ExportDataConfig>): Plan_ExportDataConfig {
-    return Plan_ExportDataConfig.fromPartial(base ?? {});
+  create(
+    base?: DeepPartial<Plan_ChangeDatabaseConfig_SessionVariablesEntry>,
+  ): Plan_ChangeDatabaseConfig_SessionVariablesEntry {
+    return Plan_ChangeDatabaseConfig_SessionVariablesEntry.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Plan_ExportDataConfig>): Plan_ExportDataConfig {
-    const message = createBasePlan_ExportDataConfig();
-    message.target = object.target ?? "";
-    message.sheet = object.sheet ?? "";
-    message.format = object.format ?? ExportFormat.FORMAT_UNSPECIFIED;
-    message.password = object.password ?? undefined;
+  fromPartial(
+    object: DeepPartial<Plan_ChangeDatabaseConfig_SessionVariablesEntry>,
+  ): Plan_ChangeDatabaseConfig_SessionVariablesEntry {
+    const message = createBasePlan_ChangeDatabaseConfig_SessionVariablesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
     return message;
   },
 };
 
-function createBasePlan_VCSSource(): Plan_VCSSource {
-  return { vcsType: VCSType.VCS_TYPE_UNSPECIFIED, vcsConnector: "", pullRequestUrl: "" };
+function createBasePlan_VerificationQuery(): Plan_VerificationQuery {
+  return {
+    title: "",
+    statement: "",
+    type: Plan_VerificationQuery_Type.TYPE_UNSPECIFIED,
+    expectedRowCount: Long.ZERO,
+    minValue: undefined,
+    maxValue: undefined,
+  };
 }
 
-export const Plan_VCSSource = {
-  encode(message: Plan_VCSSource, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.vcsType !== VCSType.VCS_TYPE_UNSPECIFIED) {
-      writer.uint32(8).int32(vCSTypeToNumber(message.vcsType));
+export const Plan_VerificationQuery = {
+  encode(message: Plan_VerificationQuery, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
     }
-    if (message.vcsConnector !== "") {
-      writer.uint32(18).string(message.vcsConnector);
+    if (message.statement !== "") {
+      writer.uint32(18).string(message.statement);
     }
-    if (message.pullRequestUrl !== "") {
-      writer.uint32(26).string(message.pullRequestUrl);
+    if (message.type !== Plan_VerificationQuery_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(24).int32(plan_VerificationQuery_TypeToNumber(message.type));
+    }
+    if (!message.expectedRowCount.isZero()) {
+      writer.uint32(32).int64(message.expectedRowCount);
+    }
+    if (message.minValue !== undefined) {
+      writer.uint32(41).double(message.minValue);
+    }
+    if (message.maxValue !== undefined) {
+      writer.uint32(49).double(message.maxValue);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_VCSSource {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_VerificationQuery {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlan_VCSSource();
+    const message = createBasePlan_VerificationQuery();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.vcsType = vCSTypeFromJSON(reader.int32());
+          message.title = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.vcsConnector = reader.string();
+          message.statement = reader.string();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.pullRequestUrl = reader.string();
+          message.type = plan_VerificationQuery_TypeFromJSON(reader.int32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.expectedRowCount = reader.int64() as Long;
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.minValue = reader.double();
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.maxValue = reader.double();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2540,65 +3360,84 @@ export const Plan_VCSSource = {
     return message;
   },
 
-  fromJSON(object: any): Plan_VCSSource {
+  fromJSON(object: any): Plan_VerificationQuery {
     return {
-      vcsType: isSet(object.vcsType) ? vCSTypeFromJSON(object.vcsType) : VCSType.VCS_TYPE_UNSPECIFIED,
-      vcsConnector: isSet(object.vcsConnector) ? globalThis.String(object.vcsConnector) : "",
-      pullRequestUrl: isSet(object.pullRequestUrl) ? globalThis.String(object.pullRequestUrl) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      type: isSet(object.type)
+        ? plan_VerificationQuery_TypeFromJSON(object.type)
+        : Plan_VerificationQuery_Type.TYPE_UNSPECIFIED,
+      expectedRowCount: isSet(object.expectedRowCount) ? Long.fromValue(object.expectedRowCount) : Long.ZERO,
+      minValue: isSet(object.minValue) ? globalThis.Number(object.minValue) : undefined,
+      maxValue: isSet(object.maxValue) ? globalThis.Number(object.maxValue) : undefined,
     };
   },
 
-  toJSON(message: Plan_VCSSource): unknown {
+  toJSON(message: Plan_VerificationQuery): unknown {
     const obj: any = {};
-    if (message.vcsType !== VCSType.VCS_TYPE_UNSPECIFIED) {
-      obj.vcsType = vCSTypeToJSON(message.vcsType);
+    if (message.title !== "") {
+      obj.title = message.title;
     }
-    if (message.vcsConnector !== "") {
-      obj.vcsConnector = message.vcsConnector;
+    if (message.statement !== "") {
+      obj.statement = message.statement;
     }
-    if (message.pullRequestUrl !== "") {
-      obj.pullRequestUrl = message.pullRequestUrl;
+    if (message.type !== Plan_VerificationQuery_Type.TYPE_UNSPECIFIED) {
+      obj.type = plan_VerificationQuery_TypeToJSON(message.type);
+    }
+    if (!message.expectedRowCount.isZero()) {
+      obj.expectedRowCount = (message.expectedRowCount || Long.ZERO).toString();
+    }
+    if (message.minValue !== undefined) {
+      obj.minValue = message.minValue;
+    }
+    if (message.maxValue !== undefined) {
+      obj.maxValue = message.maxValue;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Plan_VCSSource>): Plan_VCSSource {
-    return Plan_VCSSource.fromPartial(base ?? {});
+  create(base?: DeepPartial<Plan_VerificationQuery>): Plan_VerificationQuery {
+    return Plan_VerificationQuery.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Plan_VCSSource>): Plan_VCSSource {
-    const message = createBasePlan_VCSSource();
-    message.vcsType = object.vcsType ?? VCSType.VCS_TYPE_UNSPECIFIED;
-    message.vcsConnector = object.vcsConnector ?? "";
-    message.pullRequestUrl = object.pullRequestUrl ?? "";
+  fromPartial(object: DeepPartial<Plan_VerificationQuery>): Plan_VerificationQuery {
+    const message = createBasePlan_VerificationQuery();
+    message.title = object.title ?? "";
+    message.statement = object.statement ?? "";
+    message.type = object.type ?? Plan_VerificationQuery_Type.TYPE_UNSPECIFIED;
+    message.expectedRowCount = (object.expectedRowCount !== undefined && object.expectedRowCount !== null)
+      ? Long.fromValue(object.expectedRowCount)
+      : Long.ZERO;
+    message.minValue = object.minValue ?? undefined;
+    message.maxValue = object.maxValue ?? undefined;
     return message;
   },
 };
 
-function createBaseListPlanCheckRunsRequest(): ListPlanCheckRunsRequest {
-  return { parent: "", pageSize: 0, pageToken: "", latestOnly: false };
+function createBasePlan_ExportDataConfig(): Plan_ExportDataConfig {
+  return { target: "", sheet: "", format: ExportFormat.FORMAT_UNSPECIFIED, password: undefined };
 }
 
-export const ListPlanCheckRunsRequest = {
-  encode(message: ListPlanCheckRunsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.parent !== "") {
-      writer.uint32(10).string(message.parent);
+export const Plan_ExportDataConfig = {
+  encode(message: Plan_ExportDataConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.target !== "") {
+      writer.uint32(10).string(message.target);
     }
-    if (message.pageSize !== 0) {
-      writer.uint32(16).int32(message.pageSize);
+    if (message.sheet !== "") {
+      writer.uint32(18).string(message.sheet);
     }
-    if (message.pageToken !== "") {
-      writer.uint32(26).string(message.pageToken);
+    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
+      writer.uint32(24).int32(exportFormatToNumber(message.format));
     }
-    if (message.latestOnly === true) {
-      writer.uint32(32).bool(message.latestOnly);
+    if (message.password !== undefined) {
+      writer.uint32(34).string(message.password);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanCheckRunsRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_ExportDataConfig {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseListPlanCheckRunsRequest();
+    const message = createBasePlan_ExportDataConfig();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2607,28 +3446,28 @@ export const ListPlanCheckRunsRequest = {
             break;
           }
 
-          message.parent = reader.string();
+          message.target = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.pageSize = reader.int32();
+          message.sheet = reader.string();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.pageToken = reader.string();
+          message.format = exportFormatFromJSON(reader.int32());
           continue;
         case 4:
-          if (tag !== 32) {
+          if (tag !== 34) {
             break;
           }
 
-          message.latestOnly = reader.bool();
+          message.password = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2639,13 +3478,453 @@ export const ListPlanCheckRunsRequest = {
     return message;
   },
 
-  fromJSON(object: any): ListPlanCheckRunsRequest {
+  fromJSON(object: any): Plan_ExportDataConfig {
     return {
-      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
-      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
-      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
-      latestOnly: isSet(object.latestOnly) ? globalThis.Boolean(object.latestOnly) : false,
-    };
+      target: isSet(object.target) ? globalThis.String(object.target) : "",
+      sheet: isSet(object.sheet) ? globalThis.String(object.sheet) : "",
+      format: isSet(object.format) ? exportFormatFromJSON(object.format) : ExportFormat.FORMAT_UNSPECIFIED,
+      password: isSet(object.password) ? globalThis.String(object.password) : undefined,
+    };
+  },
+
+  toJSON(message: Plan_ExportDataConfig): unknown {
+    const obj: any = {};
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.sheet !== "") {
+      obj.sheet = message.sheet;
+    }
+    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
+      obj.format = exportFormatToJSON(message.format);
+    }
+    if (message.password !== undefined) {
+      obj.password = message.password;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_ExportDataConfig>): Plan_ExportDataConfig {
+    return Plan_ExportDataConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Plan_ExportDataConfig>): Plan_ExportDataConfig {
+    const message = createBasePlan_ExportDataConfig();
+    message.target = object.target ?? "";
+    message.sheet = object.sheet ?? "";
+    message.format = object.format ?? ExportFormat.FORMAT_UNSPECIFIED;
+    message.password = object.password ?? undefined;
+    return message;
+  },
+};
+
+function createBasePlan_LoadDataConfig(): Plan_LoadDataConfig {
+  return {
+    target: "",
+    table: "",
+    sourceUri: "",
+    format: DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+    header: false,
+    fieldDelimiter: "",
+  };
+}
+
+export const Plan_LoadDataConfig = {
+  encode(message: Plan_LoadDataConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.target !== "") {
+      writer.uint32(10).string(message.target);
+    }
+    if (message.table !== "") {
+      writer.uint32(18).string(message.table);
+    }
+    if (message.sourceUri !== "") {
+      writer.uint32(26).string(message.sourceUri);
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      writer.uint32(32).int32(dataLoadFormatToNumber(message.format));
+    }
+    if (message.header === true) {
+      writer.uint32(40).bool(message.header);
+    }
+    if (message.fieldDelimiter !== "") {
+      writer.uint32(50).string(message.fieldDelimiter);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_LoadDataConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_LoadDataConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.sourceUri = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.format = dataLoadFormatFromJSON(reader.int32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.header = reader.bool();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.fieldDelimiter = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_LoadDataConfig {
+    return {
+      target: isSet(object.target) ? globalThis.String(object.target) : "",
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      sourceUri: isSet(object.sourceUri) ? globalThis.String(object.sourceUri) : "",
+      format: isSet(object.format)
+        ? dataLoadFormatFromJSON(object.format)
+        : DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+      header: isSet(object.header) ? globalThis.Boolean(object.header) : false,
+      fieldDelimiter: isSet(object.fieldDelimiter) ? globalThis.String(object.fieldDelimiter) : "",
+    };
+  },
+
+  toJSON(message: Plan_LoadDataConfig): unknown {
+    const obj: any = {};
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.table !== "") {
+      obj.table = message.table;
+    }
+    if (message.sourceUri !== "") {
+      obj.sourceUri = message.sourceUri;
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      obj.format = dataLoadFormatToJSON(message.format);
+    }
+    if (message.header === true) {
+      obj.header = message.header;
+    }
+    if (message.fieldDelimiter !== "") {
+      obj.fieldDelimiter = message.fieldDelimiter;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_LoadDataConfig>): Plan_LoadDataConfig {
+    return Plan_LoadDataConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Plan_LoadDataConfig>): Plan_LoadDataConfig {
+    const message = createBasePlan_LoadDataConfig();
+    message.target = object.target ?? "";
+    message.table = object.table ?? "";
+    message.sourceUri = object.sourceUri ?? "";
+    message.format = object.format ?? DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED;
+    message.header = object.header ?? false;
+    message.fieldDelimiter = object.fieldDelimiter ?? "";
+    return message;
+  },
+};
+
+function createBasePlan_RestoreDatabaseConfig(): Plan_RestoreDatabaseConfig {
+  return { target: "", backupRun: "", restoreTime: undefined, targetDatabase: "" };
+}
+
+export const Plan_RestoreDatabaseConfig = {
+  encode(message: Plan_RestoreDatabaseConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.target !== "") {
+      writer.uint32(10).string(message.target);
+    }
+    if (message.backupRun !== "") {
+      writer.uint32(18).string(message.backupRun);
+    }
+    if (message.restoreTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.restoreTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.targetDatabase !== "") {
+      writer.uint32(34).string(message.targetDatabase);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_RestoreDatabaseConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_RestoreDatabaseConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.backupRun = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.restoreTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.targetDatabase = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_RestoreDatabaseConfig {
+    return {
+      target: isSet(object.target) ? globalThis.String(object.target) : "",
+      backupRun: isSet(object.backupRun) ? globalThis.String(object.backupRun) : "",
+      restoreTime: isSet(object.restoreTime) ? fromJsonTimestamp(object.restoreTime) : undefined,
+      targetDatabase: isSet(object.targetDatabase) ? globalThis.String(object.targetDatabase) : "",
+    };
+  },
+
+  toJSON(message: Plan_RestoreDatabaseConfig): unknown {
+    const obj: any = {};
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.backupRun !== "") {
+      obj.backupRun = message.backupRun;
+    }
+    if (message.restoreTime !== undefined) {
+      obj.restoreTime = message.restoreTime.toISOString();
+    }
+    if (message.targetDatabase !== "") {
+      obj.targetDatabase = message.targetDatabase;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_RestoreDatabaseConfig>): Plan_RestoreDatabaseConfig {
+    return Plan_RestoreDatabaseConfig.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Plan_RestoreDatabaseConfig>): Plan_RestoreDatabaseConfig {
+    const message = createBasePlan_RestoreDatabaseConfig();
+    message.target = object.target ?? "";
+    message.backupRun = object.backupRun ?? "";
+    message.restoreTime = object.restoreTime ?? undefined;
+    message.targetDatabase = object.targetDatabase ?? "";
+    return message;
+  },
+};
+
+function createBasePlan_VCSSource(): Plan_VCSSource {
+  return { vcsType: VCSType.VCS_TYPE_UNSPECIFIED, vcsConnector: "", pullRequestUrl: "" };
+}
+
+export const Plan_VCSSource = {
+  encode(message: Plan_VCSSource, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.vcsType !== VCSType.VCS_TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(vCSTypeToNumber(message.vcsType));
+    }
+    if (message.vcsConnector !== "") {
+      writer.uint32(18).string(message.vcsConnector);
+    }
+    if (message.pullRequestUrl !== "") {
+      writer.uint32(26).string(message.pullRequestUrl);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_VCSSource {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_VCSSource();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.vcsType = vCSTypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.vcsConnector = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pullRequestUrl = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_VCSSource {
+    return {
+      vcsType: isSet(object.vcsType) ? vCSTypeFromJSON(object.vcsType) : VCSType.VCS_TYPE_UNSPECIFIED,
+      vcsConnector: isSet(object.vcsConnector) ? globalThis.String(object.vcsConnector) : "",
+      pullRequestUrl: isSet(object.pullRequestUrl) ? globalThis.String(object.pullRequestUrl) : "",
+    };
+  },
+
+  toJSON(message: Plan_VCSSource): unknown {
+    const obj: any = {};
+    if (message.vcsType !== VCSType.VCS_TYPE_UNSPECIFIED) {
+      obj.vcsType = vCSTypeToJSON(message.vcsType);
+    }
+    if (message.vcsConnector !== "") {
+      obj.vcsConnector = message.vcsConnector;
+    }
+    if (message.pullRequestUrl !== "") {
+      obj.pullRequestUrl = message.pullRequestUrl;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_VCSSource>): Plan_VCSSource {
+    return Plan_VCSSource.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Plan_VCSSource>): Plan_VCSSource {
+    const message = createBasePlan_VCSSource();
+    message.vcsType = object.vcsType ?? VCSType.VCS_TYPE_UNSPECIFIED;
+    message.vcsConnector = object.vcsConnector ?? "";
+    message.pullRequestUrl = object.pullRequestUrl ?? "";
+    return message;
+  },
+};
+
+function createBaseListPlanCheckRunsRequest(): ListPlanCheckRunsRequest {
+  return { parent: "", pageSize: 0, pageToken: "", latestOnly: false };
+}
+
+export const ListPlanCheckRunsRequest = {
+  encode(message: ListPlanCheckRunsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    if (message.latestOnly === true) {
+      writer.uint32(32).bool(message.latestOnly);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanCheckRunsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPlanCheckRunsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.latestOnly = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPlanCheckRunsRequest {
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+      latestOnly: isSet(object.latestOnly) ? globalThis.Boolean(object.latestOnly) : false,
+    };
   },
 
   toJSON(message: ListPlanCheckRunsRequest): unknown {
@@ -3174,36 +4453,308 @@ function createBasePlanCheckRun_Result(): PlanCheckRun_Result {
     code: 0,
     sqlSummaryReport: undefined,
     sqlReviewReport: undefined,
+    conflictReport: undefined,
   };
 }
 
-export const PlanCheckRun_Result = {
-  encode(message: PlanCheckRun_Result, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.status !== PlanCheckRun_Result_Status.STATUS_UNSPECIFIED) {
-      writer.uint32(8).int32(planCheckRun_Result_StatusToNumber(message.status));
+export const PlanCheckRun_Result = {
+  encode(message: PlanCheckRun_Result, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.status !== PlanCheckRun_Result_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(8).int32(planCheckRun_Result_StatusToNumber(message.status));
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.content !== "") {
+      writer.uint32(26).string(message.content);
+    }
+    if (message.code !== 0) {
+      writer.uint32(32).int32(message.code);
+    }
+    if (message.sqlSummaryReport !== undefined) {
+      PlanCheckRun_Result_SqlSummaryReport.encode(message.sqlSummaryReport, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.sqlReviewReport !== undefined) {
+      PlanCheckRun_Result_SqlReviewReport.encode(message.sqlReviewReport, writer.uint32(50).fork()).ldelim();
+    }
+    if (message.conflictReport !== undefined) {
+      PlanCheckRun_Result_ConflictReport.encode(message.conflictReport, writer.uint32(58).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanCheckRun_Result();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = planCheckRun_Result_StatusFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.code = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.sqlSummaryReport = PlanCheckRun_Result_SqlSummaryReport.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.sqlReviewReport = PlanCheckRun_Result_SqlReviewReport.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.conflictReport = PlanCheckRun_Result_ConflictReport.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanCheckRun_Result {
+    return {
+      status: isSet(object.status)
+        ? planCheckRun_Result_StatusFromJSON(object.status)
+        : PlanCheckRun_Result_Status.STATUS_UNSPECIFIED,
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
+      code: isSet(object.code) ? globalThis.Number(object.code) : 0,
+      sqlSummaryReport: isSet(object.sqlSummaryReport)
+        ? PlanCheckRun_Result_SqlSummaryReport.fromJSON(object.sqlSummaryReport)
+        : undefined,
+      sqlReviewReport: isSet(object.sqlReviewReport)
+        ? PlanCheckRun_Result_SqlReviewReport.fromJSON(object.sqlReviewReport)
+        : undefined,
+      conflictReport: isSet(object.conflictReport)
+        ? PlanCheckRun_Result_ConflictReport.fromJSON(object.conflictReport)
+        : undefined,
+    };
+  },
+
+  toJSON(message: PlanCheckRun_Result): unknown {
+    const obj: any = {};
+    if (message.status !== PlanCheckRun_Result_Status.STATUS_UNSPECIFIED) {
+      obj.status = planCheckRun_Result_StatusToJSON(message.status);
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    if (message.code !== 0) {
+      obj.code = Math.round(message.code);
+    }
+    if (message.sqlSummaryReport !== undefined) {
+      obj.sqlSummaryReport = PlanCheckRun_Result_SqlSummaryReport.toJSON(message.sqlSummaryReport);
+    }
+    if (message.sqlReviewReport !== undefined) {
+      obj.sqlReviewReport = PlanCheckRun_Result_SqlReviewReport.toJSON(message.sqlReviewReport);
+    }
+    if (message.conflictReport !== undefined) {
+      obj.conflictReport = PlanCheckRun_Result_ConflictReport.toJSON(message.conflictReport);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanCheckRun_Result>): PlanCheckRun_Result {
+    return PlanCheckRun_Result.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanCheckRun_Result>): PlanCheckRun_Result {
+    const message = createBasePlanCheckRun_Result();
+    message.status = object.status ?? PlanCheckRun_Result_Status.STATUS_UNSPECIFIED;
+    message.title = object.title ?? "";
+    message.content = object.content ?? "";
+    message.code = object.code ?? 0;
+    message.sqlSummaryReport = (object.sqlSummaryReport !== undefined && object.sqlSummaryReport !== null)
+      ? PlanCheckRun_Result_SqlSummaryReport.fromPartial(object.sqlSummaryReport)
+      : undefined;
+    message.sqlReviewReport = (object.sqlReviewReport !== undefined && object.sqlReviewReport !== null)
+      ? PlanCheckRun_Result_SqlReviewReport.fromPartial(object.sqlReviewReport)
+      : undefined;
+    message.conflictReport = (object.conflictReport !== undefined && object.conflictReport !== null)
+      ? PlanCheckRun_Result_ConflictReport.fromPartial(object.conflictReport)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePlanCheckRun_Result_SqlSummaryReport(): PlanCheckRun_Result_SqlSummaryReport {
+  return { code: 0, statementTypes: [], affectedRows: 0, changedResources: undefined };
+}
+
+export const PlanCheckRun_Result_SqlSummaryReport = {
+  encode(message: PlanCheckRun_Result_SqlSummaryReport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.code !== 0) {
+      writer.uint32(8).int32(message.code);
+    }
+    for (const v of message.statementTypes) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.affectedRows !== 0) {
+      writer.uint32(24).int32(message.affectedRows);
+    }
+    if (message.changedResources !== undefined) {
+      ChangedResources.encode(message.changedResources, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result_SqlSummaryReport {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanCheckRun_Result_SqlSummaryReport();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.code = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.statementTypes.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.affectedRows = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.changedResources = ChangedResources.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanCheckRun_Result_SqlSummaryReport {
+    return {
+      code: isSet(object.code) ? globalThis.Number(object.code) : 0,
+      statementTypes: globalThis.Array.isArray(object?.statementTypes)
+        ? object.statementTypes.map((e: any) => globalThis.String(e))
+        : [],
+      affectedRows: isSet(object.affectedRows) ? globalThis.Number(object.affectedRows) : 0,
+      changedResources: isSet(object.changedResources) ? ChangedResources.fromJSON(object.changedResources) : undefined,
+    };
+  },
+
+  toJSON(message: PlanCheckRun_Result_SqlSummaryReport): unknown {
+    const obj: any = {};
+    if (message.code !== 0) {
+      obj.code = Math.round(message.code);
+    }
+    if (message.statementTypes?.length) {
+      obj.statementTypes = message.statementTypes;
+    }
+    if (message.affectedRows !== 0) {
+      obj.affectedRows = Math.round(message.affectedRows);
+    }
+    if (message.changedResources !== undefined) {
+      obj.changedResources = ChangedResources.toJSON(message.changedResources);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanCheckRun_Result_SqlSummaryReport>): PlanCheckRun_Result_SqlSummaryReport {
+    return PlanCheckRun_Result_SqlSummaryReport.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanCheckRun_Result_SqlSummaryReport>): PlanCheckRun_Result_SqlSummaryReport {
+    const message = createBasePlanCheckRun_Result_SqlSummaryReport();
+    message.code = object.code ?? 0;
+    message.statementTypes = object.statementTypes?.map((e) => e) || [];
+    message.affectedRows = object.affectedRows ?? 0;
+    message.changedResources = (object.changedResources !== undefined && object.changedResources !== null)
+      ? ChangedResources.fromPartial(object.changedResources)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePlanCheckRun_Result_SqlReviewReport(): PlanCheckRun_Result_SqlReviewReport {
+  return { line: 0, column: 0, detail: "", code: 0, startPosition: undefined, endPosition: undefined };
+}
+
+export const PlanCheckRun_Result_SqlReviewReport = {
+  encode(message: PlanCheckRun_Result_SqlReviewReport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.line !== 0) {
+      writer.uint32(8).int32(message.line);
     }
-    if (message.title !== "") {
-      writer.uint32(18).string(message.title);
+    if (message.column !== 0) {
+      writer.uint32(16).int32(message.column);
     }
-    if (message.content !== "") {
-      writer.uint32(26).string(message.content);
+    if (message.detail !== "") {
+      writer.uint32(26).string(message.detail);
     }
     if (message.code !== 0) {
       writer.uint32(32).int32(message.code);
     }
-    if (message.sqlSummaryReport !== undefined) {
-      PlanCheckRun_Result_SqlSummaryReport.encode(message.sqlSummaryReport, writer.uint32(42).fork()).ldelim();
+    if (message.startPosition !== undefined) {
+      Position.encode(message.startPosition, writer.uint32(42).fork()).ldelim();
     }
-    if (message.sqlReviewReport !== undefined) {
-      PlanCheckRun_Result_SqlReviewReport.encode(message.sqlReviewReport, writer.uint32(50).fork()).ldelim();
+    if (message.endPosition !== undefined) {
+      Position.encode(message.endPosition, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result_SqlReviewReport {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlanCheckRun_Result();
+    const message = createBasePlanCheckRun_Result_SqlReviewReport();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3212,21 +4763,21 @@ export const PlanCheckRun_Result = {
             break;
           }
 
-          message.status = planCheckRun_Result_StatusFromJSON(reader.int32());
+          message.line = reader.int32();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.title = reader.string();
+          message.column = reader.int32();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.content = reader.string();
+          message.detail = reader.string();
           continue;
         case 4:
           if (tag !== 32) {
@@ -3240,14 +4791,14 @@ export const PlanCheckRun_Result = {
             break;
           }
 
-          message.sqlSummaryReport = PlanCheckRun_Result_SqlSummaryReport.decode(reader, reader.uint32());
+          message.startPosition = Position.decode(reader, reader.uint32());
           continue;
         case 6:
           if (tag !== 50) {
             break;
           }
 
-          message.sqlReviewReport = PlanCheckRun_Result_SqlReviewReport.decode(reader, reader.uint32());
+          message.endPosition = Position.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3258,120 +4809,211 @@ export const PlanCheckRun_Result = {
     return message;
   },
 
-  fromJSON(object: any): PlanCheckRun_Result {
+  fromJSON(object: any): PlanCheckRun_Result_SqlReviewReport {
     return {
-      status: isSet(object.status)
-        ? planCheckRun_Result_StatusFromJSON(object.status)
-        : PlanCheckRun_Result_Status.STATUS_UNSPECIFIED,
-      title: isSet(object.title) ? globalThis.String(object.title) : "",
-      content: isSet(object.content) ? globalThis.String(object.content) : "",
+      line: isSet(object.line) ? globalThis.Number(object.line) : 0,
+      column: isSet(object.column) ? globalThis.Number(object.column) : 0,
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
       code: isSet(object.code) ? globalThis.Number(object.code) : 0,
-      sqlSummaryReport: isSet(object.sqlSummaryReport)
-        ? PlanCheckRun_Result_SqlSummaryReport.fromJSON(object.sqlSummaryReport)
-        : undefined,
-      sqlReviewReport: isSet(object.sqlReviewReport)
-        ? PlanCheckRun_Result_SqlReviewReport.fromJSON(object.sqlReviewReport)
-        : undefined,
+      startPosition: isSet(object.startPosition) ? Position.fromJSON(object.startPosition) : undefined,
+      endPosition: isSet(object.endPosition) ? Position.fromJSON(object.endPosition) : undefined,
     };
   },
 
-  toJSON(message: PlanCheckRun_Result): unknown {
+  toJSON(message: PlanCheckRun_Result_SqlReviewReport): unknown {
     const obj: any = {};
-    if (message.status !== PlanCheckRun_Result_Status.STATUS_UNSPECIFIED) {
-      obj.status = planCheckRun_Result_StatusToJSON(message.status);
+    if (message.line !== 0) {
+      obj.line = Math.round(message.line);
     }
-    if (message.title !== "") {
-      obj.title = message.title;
+    if (message.column !== 0) {
+      obj.column = Math.round(message.column);
     }
-    if (message.content !== "") {
-      obj.content = message.content;
+    if (message.detail !== "") {
+      obj.detail = message.detail;
     }
     if (message.code !== 0) {
       obj.code = Math.round(message.code);
     }
-    if (message.sqlSummaryReport !== undefined) {
-      obj.sqlSummaryReport = PlanCheckRun_Result_SqlSummaryReport.toJSON(message.sqlSummaryReport);
+    if (message.startPosition !== undefined) {
+      obj.startPosition = Position.toJSON(message.startPosition);
     }
-    if (message.sqlReviewReport !== undefined) {
-      obj.sqlReviewReport = PlanCheckRun_Result_SqlReviewReport.toJSON(message.sqlReviewReport);
+    if (message.endPosition !== undefined) {
+      obj.endPosition = Position.toJSON(message.endPosition);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<PlanCheckRun_Result>): PlanCheckRun_Result {
-    return PlanCheckRun_Result.fromPartial(base ?? {});
+  create(base?: DeepPartial<PlanCheckRun_Result_SqlReviewReport>): PlanCheckRun_Result_SqlReviewReport {
+    return PlanCheckRun_Result_SqlReviewReport.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<PlanCheckRun_Result>): PlanCheckRun_Result {
-    const message = createBasePlanCheckRun_Result();
-    message.status = object.status ?? PlanCheckRun_Result_Status.STATUS_UNSPECIFIED;
-    message.title = object.title ?? "";
-    message.content = object.content ?? "";
+  fromPartial(object: DeepPartial<PlanCheckRun_Result_SqlReviewReport>): PlanCheckRun_Result_SqlReviewReport {
+    const message = createBasePlanCheckRun_Result_SqlReviewReport();
+    message.line = object.line ?? 0;
+    message.column = object.column ?? 0;
+    message.detail = object.detail ?? "";
     message.code = object.code ?? 0;
-    message.sqlSummaryReport = (object.sqlSummaryReport !== undefined && object.sqlSummaryReport !== null)
-      ? PlanCheckRun_Result_SqlSummaryReport.fromPartial(object.sqlSummaryReport)
+    message.startPosition = (object.startPosition !== undefined && object.startPosition !== null)
+      ? Position.fromPartial(object.startPosition)
       : undefined;
-    message.sqlReviewReport = (object.sqlReviewReport !== undefined && object.sqlReviewReport !== null)
-      ? PlanCheckRun_Result_SqlReviewReport.fromPartial(object.sqlReviewReport)
+    message.endPosition = (object.endPosition !== undefined && object.endPosition !== null)
+      ? Position.fromPartial(object.endPosition)
       : undefined;
     return message;
   },
 };
 
-function createBasePlanCheckRun_Result_SqlSummaryReport(): PlanCheckRun_Result_SqlSummaryReport {
-  return { code: 0, statementTypes: [], affectedRows: 0, changedResources: undefined };
+function createBasePlanCheckRun_Result_ConflictReport(): PlanCheckRun_Result_ConflictReport {
+  return { issue: "", tables: [] };
 }
 
-export const PlanCheckRun_Result_SqlSummaryReport = {
-  encode(message: PlanCheckRun_Result_SqlSummaryReport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.code !== 0) {
-      writer.uint32(8).int32(message.code);
+export const PlanCheckRun_Result_ConflictReport = {
+  encode(message: PlanCheckRun_Result_ConflictReport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.issue !== "") {
+      writer.uint32(10).string(message.issue);
     }
-    for (const v of message.statementTypes) {
+    for (const v of message.tables) {
       writer.uint32(18).string(v!);
     }
-    if (message.affectedRows !== 0) {
-      writer.uint32(24).int32(message.affectedRows);
-    }
-    if (message.changedResources !== undefined) {
-      ChangedResources.encode(message.changedResources, writer.uint32(34).fork()).ldelim();
-    }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result_SqlSummaryReport {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result_ConflictReport {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlanCheckRun_Result_SqlSummaryReport();
+    const message = createBasePlanCheckRun_Result_ConflictReport();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.code = reader.int32();
+          message.issue = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.statementTypes.push(reader.string());
+          message.tables.push(reader.string());
           continue;
-        case 3:
-          if (tag !== 24) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanCheckRun_Result_ConflictReport {
+    return {
+      issue: isSet(object.issue) ? globalThis.String(object.issue) : "",
+      tables: globalThis.Array.isArray(object?.tables) ? object.tables.map((e: any) => globalThis.String(e)) : [],
+    };
+  },
+
+  toJSON(message: PlanCheckRun_Result_ConflictReport): unknown {
+    const obj: any = {};
+    if (message.issue !== "") {
+      obj.issue = message.issue;
+    }
+    if (message.tables?.length) {
+      obj.tables = message.tables;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanCheckRun_Result_ConflictReport>): PlanCheckRun_Result_ConflictReport {
+    return PlanCheckRun_Result_ConflictReport.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanCheckRun_Result_ConflictReport>): PlanCheckRun_Result_ConflictReport {
+    const message = createBasePlanCheckRun_Result_ConflictReport();
+    message.issue = object.issue ?? "";
+    message.tables = object.tables?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListPlanCheckRunQueuesRequest(): ListPlanCheckRunQueuesRequest {
+  return {};
+}
+
+export const ListPlanCheckRunQueuesRequest = {
+  encode(_: ListPlanCheckRunQueuesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanCheckRunQueuesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPlanCheckRunQueuesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListPlanCheckRunQueuesRequest {
+    return {};
+  },
+
+  toJSON(_: ListPlanCheckRunQueuesRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListPlanCheckRunQueuesRequest>): ListPlanCheckRunQueuesRequest {
+    return ListPlanCheckRunQueuesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListPlanCheckRunQueuesRequest>): ListPlanCheckRunQueuesRequest {
+    const message = createBaseListPlanCheckRunQueuesRequest();
+    return message;
+  },
+};
+
+function createBaseListPlanCheckRunQueuesResponse(): ListPlanCheckRunQueuesResponse {
+  return { queues: [], maxConcurrentPerInstance: 0 };
+}
+
+export const ListPlanCheckRunQueuesResponse = {
+  encode(message: ListPlanCheckRunQueuesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.queues) {
+      ListPlanCheckRunQueuesResponse_Queue.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.maxConcurrentPerInstance !== 0) {
+      writer.uint32(16).int32(message.maxConcurrentPerInstance);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanCheckRunQueuesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPlanCheckRunQueuesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.affectedRows = reader.int32();
+          message.queues.push(ListPlanCheckRunQueuesResponse_Queue.decode(reader, reader.uint32()));
           continue;
-        case 4:
-          if (tag !== 34) {
+        case 2:
+          if (tag !== 16) {
             break;
           }
 
-          message.changedResources = ChangedResources.decode(reader, reader.uint32());
+          message.maxConcurrentPerInstance = reader.int32();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3382,124 +5024,94 @@ export const PlanCheckRun_Result_SqlSummaryReport = {
     return message;
   },
 
-  fromJSON(object: any): PlanCheckRun_Result_SqlSummaryReport {
+  fromJSON(object: any): ListPlanCheckRunQueuesResponse {
     return {
-      code: isSet(object.code) ? globalThis.Number(object.code) : 0,
-      statementTypes: globalThis.Array.isArray(object?.statementTypes)
-        ? object.statementTypes.map((e: any) => globalThis.String(e))
+      queues: globalThis.Array.isArray(object?.queues)
+        ? object.queues.map((e: any) => ListPlanCheckRunQueuesResponse_Queue.fromJSON(e))
         : [],
-      affectedRows: isSet(object.affectedRows) ? globalThis.Number(object.affectedRows) : 0,
-      changedResources: isSet(object.changedResources) ? ChangedResources.fromJSON(object.changedResources) : undefined,
+      maxConcurrentPerInstance: isSet(object.maxConcurrentPerInstance)
+        ? globalThis.Number(object.maxConcurrentPerInstance)
+        : 0,
     };
   },
 
-  toJSON(message: PlanCheckRun_Result_SqlSummaryReport): unknown {
+  toJSON(message: ListPlanCheckRunQueuesResponse): unknown {
     const obj: any = {};
-    if (message.code !== 0) {
-      obj.code = Math.round(message.code);
-    }
-    if (message.statementTypes?.length) {
-      obj.statementTypes = message.statementTypes;
-    }
-    if (message.affectedRows !== 0) {
-      obj.affectedRows = Math.round(message.affectedRows);
+    if (message.queues?.length) {
+      obj.queues = message.queues.map((e) => ListPlanCheckRunQueuesResponse_Queue.toJSON(e));
     }
-    if (message.changedResources !== undefined) {
-      obj.changedResources = ChangedResources.toJSON(message.changedResources);
+    if (message.maxConcurrentPerInstance !== 0) {
+      obj.maxConcurrentPerInstance = Math.round(message.maxConcurrentPerInstance);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<PlanCheckRun_Result_SqlSummaryReport>): PlanCheckRun_Result_SqlSummaryReport {
-    return PlanCheckRun_Result_SqlSummaryReport.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListPlanCheckRunQueuesResponse>): ListPlanCheckRunQueuesResponse {
+    return ListPlanCheckRunQueuesResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<PlanCheckRun_Result_SqlSummaryReport>): PlanCheckRun_Result_SqlSummaryReport {
-    const message = createBasePlanCheckRun_Result_SqlSummaryReport();
-    message.code = object.code ?? 0;
-    message.statementTypes = object.statementTypes?.map((e) => e) || [];
-    message.affectedRows = object.affectedRows ?? 0;
-    message.changedResources = (object.changedResources !== undefined && object.changedResources !== null)
-      ? ChangedResources.fromPartial(object.changedResources)
-      : undefined;
+  fromPartial(object: DeepPartial<ListPlanCheckRunQueuesResponse>): ListPlanCheckRunQueuesResponse {
+    const message = createBaseListPlanCheckRunQueuesResponse();
+    message.queues = object.queues?.map((e) => ListPlanCheckRunQueuesResponse_Queue.fromPartial(e)) || [];
+    message.maxConcurrentPerInstance = object.maxConcurrentPerInstance ?? 0;
     return message;
   },
 };
 
-function createBasePlanCheckRun_Result_SqlReviewReport(): PlanCheckRun_Result_SqlReviewReport {
-  return { line: 0, column: 0, detail: "", code: 0, startPosition: undefined, endPosition: undefined };
+function createBaseListPlanCheckRunQueuesResponse_Queue(): ListPlanCheckRunQueuesResponse_Queue {
+  return { instance: "", running: 0, queued: 0, boosted: 0 };
 }
 
-export const PlanCheckRun_Result_SqlReviewReport = {
-  encode(message: PlanCheckRun_Result_SqlReviewReport, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.line !== 0) {
-      writer.uint32(8).int32(message.line);
-    }
-    if (message.column !== 0) {
-      writer.uint32(16).int32(message.column);
-    }
-    if (message.detail !== "") {
-      writer.uint32(26).string(message.detail);
+export const ListPlanCheckRunQueuesResponse_Queue = {
+  encode(message: ListPlanCheckRunQueuesResponse_Queue, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.instance !== "") {
+      writer.uint32(10).string(message.instance);
     }
-    if (message.code !== 0) {
-      writer.uint32(32).int32(message.code);
+    if (message.running !== 0) {
+      writer.uint32(16).int32(message.running);
     }
-    if (message.startPosition !== undefined) {
-      Position.encode(message.startPosition, writer.uint32(42).fork()).ldelim();
+    if (message.queued !== 0) {
+      writer.uint32(24).int32(message.queued);
     }
-    if (message.endPosition !== undefined) {
-      Position.encode(message.endPosition, writer.uint32(50).fork()).ldelim();
+    if (message.boosted !== 0) {
+      writer.uint32(32).int32(message.boosted);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckRun_Result_SqlReviewReport {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanCheckRunQueuesResponse_Queue {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlanCheckRun_Result_SqlReviewReport();
+    const message = createBaseListPlanCheckRunQueuesResponse_Queue();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.line = reader.int32();
+          message.instance = reader.string();
           continue;
         case 2:
           if (tag !== 16) {
             break;
           }
 
-          message.column = reader.int32();
+          message.running = reader.int32();
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.detail = reader.string();
+          message.queued = reader.int32();
           continue;
         case 4:
           if (tag !== 32) {
             break;
           }
 
-          message.code = reader.int32();
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.startPosition = Position.decode(reader, reader.uint32());
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.endPosition = Position.decode(reader, reader.uint32());
+          message.boosted = reader.int32();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3510,55 +5122,41 @@ export const PlanCheckRun_Result_SqlReviewReport = {
     return message;
   },
 
-  fromJSON(object: any): PlanCheckRun_Result_SqlReviewReport {
+  fromJSON(object: any): ListPlanCheckRunQueuesResponse_Queue {
     return {
-      line: isSet(object.line) ? globalThis.Number(object.line) : 0,
-      column: isSet(object.column) ? globalThis.Number(object.column) : 0,
-      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
-      code: isSet(object.code) ? globalThis.Number(object.code) : 0,
-      startPosition: isSet(object.startPosition) ? Position.fromJSON(object.startPosition) : undefined,
-      endPosition: isSet(object.endPosition) ? Position.fromJSON(object.endPosition) : undefined,
+      instance: isSet(object.instance) ? globalThis.String(object.instance) : "",
+      running: isSet(object.running) ? globalThis.Number(object.running) : 0,
+      queued: isSet(object.queued) ? globalThis.Number(object.queued) : 0,
+      boosted: isSet(object.boosted) ? globalThis.Number(object.boosted) : 0,
     };
   },
 
-  toJSON(message: PlanCheckRun_Result_SqlReviewReport): unknown {
+  toJSON(message: ListPlanCheckRunQueuesResponse_Queue): unknown {
     const obj: any = {};
-    if (message.line !== 0) {
-      obj.line = Math.round(message.line);
-    }
-    if (message.column !== 0) {
-      obj.column = Math.round(message.column);
-    }
-    if (message.detail !== "") {
-      obj.detail = message.detail;
+    if (message.instance !== "") {
+      obj.instance = message.instance;
     }
-    if (message.code !== 0) {
-      obj.code = Math.round(message.code);
+    if (message.running !== 0) {
+      obj.running = Math.round(message.running);
     }
-    if (message.startPosition !== undefined) {
-      obj.startPosition = Position.toJSON(message.startPosition);
+    if (message.queued !== 0) {
+      obj.queued = Math.round(message.queued);
     }
-    if (message.endPosition !== undefined) {
-      obj.endPosition = Position.toJSON(message.endPosition);
+    if (message.boosted !== 0) {
+      obj.boosted = Math.round(message.boosted);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<PlanCheckRun_Result_SqlReviewReport>): PlanCheckRun_Result_SqlReviewReport {
-    return PlanCheckRun_Result_SqlReviewReport.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListPlanCheckRunQueuesResponse_Queue>): ListPlanCheckRunQueuesResponse_Queue {
+    return ListPlanCheckRunQueuesResponse_Queue.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<PlanCheckRun_Result_SqlReviewReport>): PlanCheckRun_Result_SqlReviewReport {
-    const message = createBasePlanCheckRun_Result_SqlReviewReport();
-    message.line = object.line ?? 0;
-    message.column = object.column ?? 0;
-    message.detail = object.detail ?? "";
-    message.code = object.code ?? 0;
-    message.startPosition = (object.startPosition !== undefined && object.startPosition !== null)
-      ? Position.fromPartial(object.startPosition)
-      : undefined;
-    message.endPosition = (object.endPosition !== undefined && object.endPosition !== null)
-      ? Position.fromPartial(object.endPosition)
-      : undefined;
+  fromPartial(object: DeepPartial<ListPlanCheckRunQueuesResponse_Queue>): ListPlanCheckRunQueuesResponse_Queue {
+    const message = createBaseListPlanCheckRunQueuesResponse_Queue();
+    message.instance = object.instance ?? "";
+    message.running = object.running ?? 0;
+    message.queued = object.queued ?? 0;
+    message.boosted = object.boosted ?? 0;
     return message;
   },
 };
@@ -3618,6 +5216,78 @@ export const PlanServiceDefinition = {
         },
       },
     },
+    /**
+     * PreviewPlanStatements returns the statements to run on every target database of the plan,
+     * with the database group targets expanded and the statement variables substituted.
+     */
+    previewPlanStatements: {
+      name: "PreviewPlanStatements",
+      requestType: PreviewPlanStatementsRequest,
+      requestStream: false,
+      responseType: PreviewPlanStatementsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([12, 98, 98, 46, 112, 108, 97, 110, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              49,
+              18,
+              47,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              112,
+              108,
+              97,
+              110,
+              115,
+              47,
+              42,
+              125,
+              58,
+              112,
+              114,
+              101,
+              118,
+              105,
+              101,
+              119,
+              83,
+              116,
+              97,
+              116,
+              101,
+              109,
+              101,
+              110,
+              116,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
     listPlans: {
       name: "ListPlans",
       requestType: ListPlansRequest,
@@ -3785,6 +5455,89 @@ export const PlanServiceDefinition = {
         },
       },
     },
+    /**
+     * CreateSchemaDriftReconciliationPlan creates a plan migrating the drifted database schema
+     * back to the schema recorded by the latest migration.
+     */
+    createSchemaDriftReconciliationPlan: {
+      name: "CreateSchemaDriftReconciliationPlan",
+      requestType: CreateSchemaDriftReconciliationPlanRequest,
+      requestStream: false,
+      responseType: Plan,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [new Uint8Array([15, 98, 98, 46, 112, 108, 97, 110, 115, 46, 99, 114, 101, 97, 116, 101])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              60,
+              58,
+              1,
+              42,
+              34,
+              55,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              114,
+              101,
+              99,
+              111,
+              110,
+              99,
+              105,
+              108,
+              101,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+              68,
+              114,
+              105,
+              102,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
     /**
      * UpdatePlan updates the plan.
      * The plan creator and the user with bb.plans.update permission on the project can update the plan.
@@ -4137,6 +5890,75 @@ export const PlanServiceDefinition = {
         },
       },
     },
+    /** ListPlanCheckRunQueues lists the plan check queues of the instances which have running or queued plan checks. */
+    listPlanCheckRunQueues: {
+      name: "ListPlanCheckRunQueues",
+      requestType: ListPlanCheckRunQueuesRequest,
+      requestStream: false,
+      responseType: ListPlanCheckRunQueuesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([0])],
+          800010: [
+            new Uint8Array([
+              21,
+              98,
+              98,
+              46,
+              112,
+              108,
+              97,
+              110,
+              67,
+              104,
+              101,
+              99,
+              107,
+              82,
+              117,
+              110,
+              115,
+              46,
+              108,
+              105,
+              115,
+              116,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              24,
+              18,
+              22,
+              47,
+              118,
+              49,
+              47,
+              112,
+              108,
+              97,
+              110,
+              67,
+              104,
+              101,
+              99,
+              107,
+              82,
+              117,
+              110,
+              81,
+              117,
+              101,
+              117,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
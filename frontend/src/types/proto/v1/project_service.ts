@@ -1,10 +1,14 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
+import { Expr } from "../google/type/expr";
 import { State, stateFromJSON, stateToJSON, stateToNumber } from "./common";
 import { GetIamPolicyRequest, IamPolicy, SetIamPolicyRequest } from "./iam_policy";
+import { DataSourceExternalSecret } from "./instance_service";
 
 export const protobufPackage = "bytebase.v1";
 
@@ -221,6 +225,89 @@ export interface UndeleteProjectRequest {
   name: string;
 }
 
+export interface ArchiveProjectRequest {
+  /**
+   * The name of the project to archive.
+   * Format: projects/{project}
+   */
+  name: string;
+  openIssueAction: ArchiveProjectRequest_OpenIssueAction;
+  /**
+   * If set to true, the databases of the project will be moved to the default project.
+   * Otherwise, the project cannot be archived if it has any databases.
+   */
+  force: boolean;
+  /** The reason of canceling the open issues. */
+  reason: string;
+}
+
+export enum ArchiveProjectRequest_OpenIssueAction {
+  /** OPEN_ISSUE_ACTION_UNSPECIFIED - Same as FREEZE. */
+  OPEN_ISSUE_ACTION_UNSPECIFIED = "OPEN_ISSUE_ACTION_UNSPECIFIED",
+  /**
+   * CLOSE - Cancel the open issues and their pending task runs.
+   * The project cannot be archived if any task run is running.
+   */
+  CLOSE = "CLOSE",
+  /** FREEZE - Keep the open issues, their tasks are not scheduled until the project is restored. */
+  FREEZE = "FREEZE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function archiveProjectRequest_OpenIssueActionFromJSON(object: any): ArchiveProjectRequest_OpenIssueAction {
+  switch (object) {
+    case 0:
+    case "OPEN_ISSUE_ACTION_UNSPECIFIED":
+      return ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED;
+    case 1:
+    case "CLOSE":
+      return ArchiveProjectRequest_OpenIssueAction.CLOSE;
+    case 2:
+    case "FREEZE":
+      return ArchiveProjectRequest_OpenIssueAction.FREEZE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ArchiveProjectRequest_OpenIssueAction.UNRECOGNIZED;
+  }
+}
+
+export function archiveProjectRequest_OpenIssueActionToJSON(object: ArchiveProjectRequest_OpenIssueAction): string {
+  switch (object) {
+    case ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED:
+      return "OPEN_ISSUE_ACTION_UNSPECIFIED";
+    case ArchiveProjectRequest_OpenIssueAction.CLOSE:
+      return "CLOSE";
+    case ArchiveProjectRequest_OpenIssueAction.FREEZE:
+      return "FREEZE";
+    case ArchiveProjectRequest_OpenIssueAction.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function archiveProjectRequest_OpenIssueActionToNumber(object: ArchiveProjectRequest_OpenIssueAction): number {
+  switch (object) {
+    case ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED:
+      return 0;
+    case ArchiveProjectRequest_OpenIssueAction.CLOSE:
+      return 1;
+    case ArchiveProjectRequest_OpenIssueAction.FREEZE:
+      return 2;
+    case ArchiveProjectRequest_OpenIssueAction.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface RestoreProjectRequest {
+  /**
+   * The name of the archived project.
+   * Format: projects/{project}
+   */
+  name: string;
+}
+
 export interface BatchGetIamPolicyRequest {
   /** The scope of the batch get. Typically it's "projects/-". */
   scope: string;
@@ -236,6 +323,75 @@ export interface BatchGetIamPolicyResponse_PolicyResult {
   policy: IamPolicy | undefined;
 }
 
+export interface AddProjectMemberRequest {
+  /**
+   * The name of the project to add the members to.
+   * Format: projects/{project}
+   */
+  project: string;
+  /**
+   * The role to grant.
+   * Format: roles/{role}
+   */
+  role: string;
+  /**
+   * The members to add.
+   * For users, the member should be: user:{email}
+   * For groups, the member should be: group:{email}
+   */
+  members: string[];
+  /**
+   * The condition of the binding.
+   * The expression cannot be set together with expire_time, databases or row_limit.
+   * The title and description are kept in either case.
+   */
+  condition:
+    | Expr
+    | undefined;
+  /** The time when the binding expires. */
+  expireTime:
+    | Date
+    | undefined;
+  /**
+   * The databases that the binding applies to.
+   * Format: instances/{instance}/databases/{database}
+   */
+  databases: string[];
+  /** The maximum number of rows to export, only for the exporter role. */
+  rowLimit: Long;
+}
+
+export interface ListExpiringBindingsRequest {
+  /**
+   * The name of the project.
+   * Format: projects/{project}
+   */
+  project: string;
+  /**
+   * The bindings expire before now + within are returned.
+   * The default is 7 days.
+   */
+  within: Duration | undefined;
+}
+
+export interface ListExpiringBindingsResponse {
+  /** The bindings ordered by the expire time. */
+  bindings: ExpiringBinding[];
+}
+
+export interface ExpiringBinding {
+  /** Format: roles/{role} */
+  role: string;
+  /**
+   * The members of the binding.
+   * For users, the member should be: user:{email}
+   * For groups, the member should be: group:{email}
+   */
+  members: string[];
+  condition: Expr | undefined;
+  expireTime: Date | undefined;
+}
+
 export interface GetDeploymentConfigRequest {
   /**
    * The name of the resource.
@@ -277,6 +433,129 @@ export interface Project {
   allowModifyStatement: boolean;
   /** Enable auto resolve issue. */
   autoResolveIssue: boolean;
+  /**
+   * The variables referenced as ${NAME} in the plan statements.
+   * They're substituted per database at task execution, and the undefined ones are reported by the plan check.
+   */
+  statementVariables: StatementVariable[];
+  /** The issue tracker which the issues are synced to, unset means no sync. */
+  ticketSync: TicketSync | undefined;
+}
+
+/** TicketSync creates a linked ticket in the issue tracker when an issue is created, and syncs the status transitions both ways. */
+export interface TicketSync {
+  type: TicketSync_Type;
+  /**
+   * The URL of the Jira site, e.g. https://example.atlassian.net.
+   * Empty means https://api.linear.app for Linear.
+   */
+  url: string;
+  /** The email of the Jira account, it's unused for Linear. */
+  username: string;
+  /**
+   * The API token of Jira or the API key of Linear.
+   * It's never returned, the current token is kept if it's empty and the type, url and username are unchanged.
+   */
+  token: string;
+  /** The external secret manager storing the token, e.g. Vault. */
+  externalSecret:
+    | DataSourceExternalSecret
+    | undefined;
+  /** The Jira project key, or the Linear team id. */
+  project: string;
+  /** The Jira issue type of the tickets, e.g. Task. It's unused for Linear. */
+  issueType: string;
+  /**
+   * The ticket statuses of the issue statuses, e.g. "To Do", "Done" and "Won't Do".
+   * Empty means the issue status isn't synced.
+   */
+  openStatus: string;
+  doneStatus: string;
+  canceledStatus: string;
+}
+
+export enum TicketSync_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  JIRA = "JIRA",
+  LINEAR = "LINEAR",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function ticketSync_TypeFromJSON(object: any): TicketSync_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return TicketSync_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "JIRA":
+      return TicketSync_Type.JIRA;
+    case 2:
+    case "LINEAR":
+      return TicketSync_Type.LINEAR;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return TicketSync_Type.UNRECOGNIZED;
+  }
+}
+
+export function ticketSync_TypeToJSON(object: TicketSync_Type): string {
+  switch (object) {
+    case TicketSync_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case TicketSync_Type.JIRA:
+      return "JIRA";
+    case TicketSync_Type.LINEAR:
+      return "LINEAR";
+    case TicketSync_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function ticketSync_TypeToNumber(object: TicketSync_Type): number {
+  switch (object) {
+    case TicketSync_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case TicketSync_Type.JIRA:
+      return 1;
+    case TicketSync_Type.LINEAR:
+      return 2;
+    case TicketSync_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface StatementVariable {
+  /**
+   * The name of the variable, e.g. RETENTION_DAYS.
+   * It must start with an upper-case letter and only contain upper-case letters, digits and underscores.
+   */
+  name: string;
+  /** The value used if neither the database nor its environment has a value. Empty means no default value. */
+  defaultValue: string;
+  /**
+   * The values keyed by the environment resource name.
+   * Format: environments/{environment}
+   */
+  environmentValues: { [key: string]: string };
+  /**
+   * The values keyed by the database resource name.
+   * The database value takes precedence over the environment value.
+   * Format: instances/{instance}/databases/{database}
+   */
+  databaseValues: { [key: string]: string };
+}
+
+export interface StatementVariable_EnvironmentValuesEntry {
+  key: string;
+  value: string;
+}
+
+export interface StatementVariable_DatabaseValuesEntry {
+  key: string;
+  value: string;
 }
 
 export interface AddWebhookRequest {
@@ -348,6 +627,14 @@ export interface Webhook {
    * - TYPE_ISSUE_COMMENT_CREATE
    */
   notificationTypes: Activity_Type[];
+  /**
+   * integration_key is the key to open the incidents for TYPE_PAGERDUTY and TYPE_OPSGENIE,
+   * i.e. the routing key of the PagerDuty Events API v2 and the API key of Opsgenie.
+   * The url should be the Events API endpoint, e.g. https://events.pagerduty.com/v2/enqueue,
+   * or the Opsgenie API endpoint, e.g. https://api.opsgenie.com.
+   * The incidents are opened on the TYPE_ISSUE_PIPELINE_TASK_RUN_STATUS_UPDATE notification type.
+   */
+  integrationKey: string;
 }
 
 export enum Webhook_Type {
@@ -359,6 +646,13 @@ export enum Webhook_Type {
   TYPE_FEISHU = "TYPE_FEISHU",
   TYPE_WECOM = "TYPE_WECOM",
   TYPE_CUSTOM = "TYPE_CUSTOM",
+  /**
+   * TYPE_PAGERDUTY - TYPE_PAGERDUTY and TYPE_OPSGENIE open an incident when a task fails or a rollback is initiated
+   * in a production stage, and resolve it when the task succeeds.
+   * The incident is deduplicated by the issue.
+   */
+  TYPE_PAGERDUTY = "TYPE_PAGERDUTY",
+  TYPE_OPSGENIE = "TYPE_OPSGENIE",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -388,6 +682,12 @@ export function webhook_TypeFromJSON(object: any): Webhook_Type {
     case 7:
     case "TYPE_CUSTOM":
       return Webhook_Type.TYPE_CUSTOM;
+    case 8:
+    case "TYPE_PAGERDUTY":
+      return Webhook_Type.TYPE_PAGERDUTY;
+    case 9:
+    case "TYPE_OPSGENIE":
+      return Webhook_Type.TYPE_OPSGENIE;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -413,6 +713,10 @@ export function webhook_TypeToJSON(object: Webhook_Type): string {
       return "TYPE_WECOM";
     case Webhook_Type.TYPE_CUSTOM:
       return "TYPE_CUSTOM";
+    case Webhook_Type.TYPE_PAGERDUTY:
+      return "TYPE_PAGERDUTY";
+    case Webhook_Type.TYPE_OPSGENIE:
+      return "TYPE_OPSGENIE";
     case Webhook_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -437,6 +741,10 @@ export function webhook_TypeToNumber(object: Webhook_Type): number {
       return 6;
     case Webhook_Type.TYPE_CUSTOM:
       return 7;
+    case Webhook_Type.TYPE_PAGERDUTY:
+      return 8;
+    case Webhook_Type.TYPE_OPSGENIE:
+      return 9;
     case Webhook_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -492,6 +800,8 @@ export enum Activity_Type {
   TYPE_NOTIFY_ISSUE_APPROVED = "TYPE_NOTIFY_ISSUE_APPROVED",
   /** TYPE_NOTIFY_PIPELINE_ROLLOUT - TYPE_NOTIFY_PIPELINE_ROLLOUT represents the pipeline rollout notification. */
   TYPE_NOTIFY_PIPELINE_ROLLOUT = "TYPE_NOTIFY_PIPELINE_ROLLOUT",
+  /** TYPE_NOTIFY_RELEASE_COMPLETE - TYPE_NOTIFY_RELEASE_COMPLETE represents the notification when all issues of a release are done. */
+  TYPE_NOTIFY_RELEASE_COMPLETE = "TYPE_NOTIFY_RELEASE_COMPLETE",
   /**
    * TYPE_ISSUE_CREATE - Issue related activity types.
    *
@@ -559,6 +869,9 @@ export function activity_TypeFromJSON(object: any): Activity_Type {
     case 24:
     case "TYPE_NOTIFY_PIPELINE_ROLLOUT":
       return Activity_Type.TYPE_NOTIFY_PIPELINE_ROLLOUT;
+    case 25:
+    case "TYPE_NOTIFY_RELEASE_COMPLETE":
+      return Activity_Type.TYPE_NOTIFY_RELEASE_COMPLETE;
     case 1:
     case "TYPE_ISSUE_CREATE":
       return Activity_Type.TYPE_ISSUE_CREATE;
@@ -631,6 +944,8 @@ export function activity_TypeToJSON(object: Activity_Type): string {
       return "TYPE_NOTIFY_ISSUE_APPROVED";
     case Activity_Type.TYPE_NOTIFY_PIPELINE_ROLLOUT:
       return "TYPE_NOTIFY_PIPELINE_ROLLOUT";
+    case Activity_Type.TYPE_NOTIFY_RELEASE_COMPLETE:
+      return "TYPE_NOTIFY_RELEASE_COMPLETE";
     case Activity_Type.TYPE_ISSUE_CREATE:
       return "TYPE_ISSUE_CREATE";
     case Activity_Type.TYPE_ISSUE_COMMENT_CREATE:
@@ -683,6 +998,8 @@ export function activity_TypeToNumber(object: Activity_Type): number {
       return 23;
     case Activity_Type.TYPE_NOTIFY_PIPELINE_ROLLOUT:
       return 24;
+    case Activity_Type.TYPE_NOTIFY_RELEASE_COMPLETE:
+      return 25;
     case Activity_Type.TYPE_ISSUE_CREATE:
       return 1;
     case Activity_Type.TYPE_ISSUE_COMMENT_CREATE:
@@ -1346,25 +1663,36 @@ export const UndeleteProjectRequest = {
   },
 };
 
-function createBaseBatchGetIamPolicyRequest(): BatchGetIamPolicyRequest {
-  return { scope: "", names: [] };
+function createBaseArchiveProjectRequest(): ArchiveProjectRequest {
+  return {
+    name: "",
+    openIssueAction: ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED,
+    force: false,
+    reason: "",
+  };
 }
 
-export const BatchGetIamPolicyRequest = {
-  encode(message: BatchGetIamPolicyRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.scope !== "") {
-      writer.uint32(10).string(message.scope);
+export const ArchiveProjectRequest = {
+  encode(message: ArchiveProjectRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    for (const v of message.names) {
-      writer.uint32(18).string(v!);
+    if (message.openIssueAction !== ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED) {
+      writer.uint32(16).int32(archiveProjectRequest_OpenIssueActionToNumber(message.openIssueAction));
+    }
+    if (message.force === true) {
+      writer.uint32(24).bool(message.force);
+    }
+    if (message.reason !== "") {
+      writer.uint32(34).string(message.reason);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): BatchGetIamPolicyRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ArchiveProjectRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseBatchGetIamPolicyRequest();
+    const message = createBaseArchiveProjectRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1373,14 +1701,28 @@ export const BatchGetIamPolicyRequest = {
             break;
           }
 
-          message.scope = reader.string();
+          message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 18) {
+          if (tag !== 16) {
             break;
           }
 
-          message.names.push(reader.string());
+          message.openIssueAction = archiveProjectRequest_OpenIssueActionFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.force = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.reason = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1391,51 +1733,64 @@ export const BatchGetIamPolicyRequest = {
     return message;
   },
 
-  fromJSON(object: any): BatchGetIamPolicyRequest {
+  fromJSON(object: any): ArchiveProjectRequest {
     return {
-      scope: isSet(object.scope) ? globalThis.String(object.scope) : "",
-      names: globalThis.Array.isArray(object?.names) ? object.names.map((e: any) => globalThis.String(e)) : [],
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      openIssueAction: isSet(object.openIssueAction)
+        ? archiveProjectRequest_OpenIssueActionFromJSON(object.openIssueAction)
+        : ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED,
+      force: isSet(object.force) ? globalThis.Boolean(object.force) : false,
+      reason: isSet(object.reason) ? globalThis.String(object.reason) : "",
     };
   },
 
-  toJSON(message: BatchGetIamPolicyRequest): unknown {
+  toJSON(message: ArchiveProjectRequest): unknown {
     const obj: any = {};
-    if (message.scope !== "") {
-      obj.scope = message.scope;
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.names?.length) {
-      obj.names = message.names;
+    if (message.openIssueAction !== ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED) {
+      obj.openIssueAction = archiveProjectRequest_OpenIssueActionToJSON(message.openIssueAction);
+    }
+    if (message.force === true) {
+      obj.force = message.force;
+    }
+    if (message.reason !== "") {
+      obj.reason = message.reason;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<BatchGetIamPolicyRequest>): BatchGetIamPolicyRequest {
-    return BatchGetIamPolicyRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ArchiveProjectRequest>): ArchiveProjectRequest {
+    return ArchiveProjectRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<BatchGetIamPolicyRequest>): BatchGetIamPolicyRequest {
-    const message = createBaseBatchGetIamPolicyRequest();
-    message.scope = object.scope ?? "";
-    message.names = object.names?.map((e) => e) || [];
+  fromPartial(object: DeepPartial<ArchiveProjectRequest>): ArchiveProjectRequest {
+    const message = createBaseArchiveProjectRequest();
+    message.name = object.name ?? "";
+    message.openIssueAction = object.openIssueAction ??
+      ArchiveProjectRequest_OpenIssueAction.OPEN_ISSUE_ACTION_UNSPECIFIED;
+    message.force = object.force ?? false;
+    message.reason = object.reason ?? "";
     return message;
   },
 };
 
-function createBaseBatchGetIamPolicyResponse(): BatchGetIamPolicyResponse {
-  return { policyResults: [] };
+function createBaseRestoreProjectRequest(): RestoreProjectRequest {
+  return { name: "" };
 }
 
-export const BatchGetIamPolicyResponse = {
-  encode(message: BatchGetIamPolicyResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.policyResults) {
-      BatchGetIamPolicyResponse_PolicyResult.encode(v!, writer.uint32(10).fork()).ldelim();
+export const RestoreProjectRequest = {
+  encode(message: RestoreProjectRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): BatchGetIamPolicyResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): RestoreProjectRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseBatchGetIamPolicyResponse();
+    const message = createBaseRestoreProjectRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1444,7 +1799,7 @@ export const BatchGetIamPolicyResponse = {
             break;
           }
 
-          message.policyResults.push(BatchGetIamPolicyResponse_PolicyResult.decode(reader, reader.uint32()));
+          message.name = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1455,52 +1810,47 @@ export const BatchGetIamPolicyResponse = {
     return message;
   },
 
-  fromJSON(object: any): BatchGetIamPolicyResponse {
-    return {
-      policyResults: globalThis.Array.isArray(object?.policyResults)
-        ? object.policyResults.map((e: any) => BatchGetIamPolicyResponse_PolicyResult.fromJSON(e))
-        : [],
-    };
+  fromJSON(object: any): RestoreProjectRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
   },
 
-  toJSON(message: BatchGetIamPolicyResponse): unknown {
+  toJSON(message: RestoreProjectRequest): unknown {
     const obj: any = {};
-    if (message.policyResults?.length) {
-      obj.policyResults = message.policyResults.map((e) => BatchGetIamPolicyResponse_PolicyResult.toJSON(e));
+    if (message.name !== "") {
+      obj.name = message.name;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<BatchGetIamPolicyResponse>): BatchGetIamPolicyResponse {
-    return BatchGetIamPolicyResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<RestoreProjectRequest>): RestoreProjectRequest {
+    return RestoreProjectRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<BatchGetIamPolicyResponse>): BatchGetIamPolicyResponse {
-    const message = createBaseBatchGetIamPolicyResponse();
-    message.policyResults = object.policyResults?.map((e) => BatchGetIamPolicyResponse_PolicyResult.fromPartial(e)) ||
-      [];
+  fromPartial(object: DeepPartial<RestoreProjectRequest>): RestoreProjectRequest {
+    const message = createBaseRestoreProjectRequest();
+    message.name = object.name ?? "";
     return message;
   },
 };
 
-function createBaseBatchGetIamPolicyResponse_PolicyResult(): BatchGetIamPolicyResponse_PolicyResult {
-  return { project: "", policy: undefined };
+function createBaseBatchGetIamPolicyRequest(): BatchGetIamPolicyRequest {
+  return { scope: "", names: [] };
 }
 
-export const BatchGetIamPolicyResponse_PolicyResult = {
-  encode(message: BatchGetIamPolicyResponse_PolicyResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.project !== "") {
-      writer.uint32(10).string(message.project);
+export const BatchGetIamPolicyRequest = {
+  encode(message: BatchGetIamPolicyRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.scope !== "") {
+      writer.uint32(10).string(message.scope);
     }
-    if (message.policy !== undefined) {
-      IamPolicy.encode(message.policy, writer.uint32(18).fork()).ldelim();
+    for (const v of message.names) {
+      writer.uint32(18).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): BatchGetIamPolicyResponse_PolicyResult {
+  decode(input: _m0.Reader | Uint8Array, length?: number): BatchGetIamPolicyRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseBatchGetIamPolicyResponse_PolicyResult();
+    const message = createBaseBatchGetIamPolicyRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1509,14 +1859,14 @@ export const BatchGetIamPolicyResponse_PolicyResult = {
             break;
           }
 
-          message.project = reader.string();
+          message.scope = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.policy = IamPolicy.decode(reader, reader.uint32());
+          message.names.push(reader.string());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1527,53 +1877,51 @@ export const BatchGetIamPolicyResponse_PolicyResult = {
     return message;
   },
 
-  fromJSON(object: any): BatchGetIamPolicyResponse_PolicyResult {
+  fromJSON(object: any): BatchGetIamPolicyRequest {
     return {
-      project: isSet(object.project) ? globalThis.String(object.project) : "",
-      policy: isSet(object.policy) ? IamPolicy.fromJSON(object.policy) : undefined,
+      scope: isSet(object.scope) ? globalThis.String(object.scope) : "",
+      names: globalThis.Array.isArray(object?.names) ? object.names.map((e: any) => globalThis.String(e)) : [],
     };
   },
 
-  toJSON(message: BatchGetIamPolicyResponse_PolicyResult): unknown {
+  toJSON(message: BatchGetIamPolicyRequest): unknown {
     const obj: any = {};
-    if (message.project !== "") {
-      obj.project = message.project;
+    if (message.scope !== "") {
+      obj.scope = message.scope;
     }
-    if (message.policy !== undefined) {
-      obj.policy = IamPolicy.toJSON(message.policy);
+    if (message.names?.length) {
+      obj.names = message.names;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<BatchGetIamPolicyResponse_PolicyResult>): BatchGetIamPolicyResponse_PolicyResult {
-    return BatchGetIamPolicyResponse_PolicyResult.fromPartial(base ?? {});
+  create(base?: DeepPartial<BatchGetIamPolicyRequest>): BatchGetIamPolicyRequest {
+    return BatchGetIamPolicyRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<BatchGetIamPolicyResponse_PolicyResult>): BatchGetIamPolicyResponse_PolicyResult {
-    const message = createBaseBatchGetIamPolicyResponse_PolicyResult();
-    message.project = object.project ?? "";
-    message.policy = (object.policy !== undefined && object.policy !== null)
-      ? IamPolicy.fromPartial(object.policy)
-      : undefined;
+  fromPartial(object: DeepPartial<BatchGetIamPolicyRequest>): BatchGetIamPolicyRequest {
+    const message = createBaseBatchGetIamPolicyRequest();
+    message.scope = object.scope ?? "";
+    message.names = object.names?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseGetDeploymentConfigRequest(): GetDeploymentConfigRequest {
-  return { name: "" };
+function createBaseBatchGetIamPolicyResponse(): BatchGetIamPolicyResponse {
+  return { policyResults: [] };
 }
 
-export const GetDeploymentConfigRequest = {
-  encode(message: GetDeploymentConfigRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
+export const BatchGetIamPolicyResponse = {
+  encode(message: BatchGetIamPolicyResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.policyResults) {
+      BatchGetIamPolicyResponse_PolicyResult.encode(v!, writer.uint32(10).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GetDeploymentConfigRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): BatchGetIamPolicyResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGetDeploymentConfigRequest();
+    const message = createBaseBatchGetIamPolicyResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1582,7 +1930,7 @@ export const GetDeploymentConfigRequest = {
             break;
           }
 
-          message.name = reader.string();
+          message.policyResults.push(BatchGetIamPolicyResponse_PolicyResult.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1593,44 +1941,52 @@ export const GetDeploymentConfigRequest = {
     return message;
   },
 
-  fromJSON(object: any): GetDeploymentConfigRequest {
-    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  fromJSON(object: any): BatchGetIamPolicyResponse {
+    return {
+      policyResults: globalThis.Array.isArray(object?.policyResults)
+        ? object.policyResults.map((e: any) => BatchGetIamPolicyResponse_PolicyResult.fromJSON(e))
+        : [],
+    };
   },
 
-  toJSON(message: GetDeploymentConfigRequest): unknown {
+  toJSON(message: BatchGetIamPolicyResponse): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
+    if (message.policyResults?.length) {
+      obj.policyResults = message.policyResults.map((e) => BatchGetIamPolicyResponse_PolicyResult.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<GetDeploymentConfigRequest>): GetDeploymentConfigRequest {
-    return GetDeploymentConfigRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<BatchGetIamPolicyResponse>): BatchGetIamPolicyResponse {
+    return BatchGetIamPolicyResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GetDeploymentConfigRequest>): GetDeploymentConfigRequest {
-    const message = createBaseGetDeploymentConfigRequest();
-    message.name = object.name ?? "";
+  fromPartial(object: DeepPartial<BatchGetIamPolicyResponse>): BatchGetIamPolicyResponse {
+    const message = createBaseBatchGetIamPolicyResponse();
+    message.policyResults = object.policyResults?.map((e) => BatchGetIamPolicyResponse_PolicyResult.fromPartial(e)) ||
+      [];
     return message;
   },
 };
 
-function createBaseUpdateDeploymentConfigRequest(): UpdateDeploymentConfigRequest {
-  return { deploymentConfig: undefined };
+function createBaseBatchGetIamPolicyResponse_PolicyResult(): BatchGetIamPolicyResponse_PolicyResult {
+  return { project: "", policy: undefined };
 }
 
-export const UpdateDeploymentConfigRequest = {
-  encode(message: UpdateDeploymentConfigRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.deploymentConfig !== undefined) {
-      DeploymentConfig.encode(message.deploymentConfig, writer.uint32(10).fork()).ldelim();
+export const BatchGetIamPolicyResponse_PolicyResult = {
+  encode(message: BatchGetIamPolicyResponse_PolicyResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.project !== "") {
+      writer.uint32(10).string(message.project);
+    }
+    if (message.policy !== undefined) {
+      IamPolicy.encode(message.policy, writer.uint32(18).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateDeploymentConfigRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): BatchGetIamPolicyResponse_PolicyResult {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseUpdateDeploymentConfigRequest();
+    const message = createBaseBatchGetIamPolicyResponse_PolicyResult();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1639,7 +1995,14 @@ export const UpdateDeploymentConfigRequest = {
             break;
           }
 
-          message.deploymentConfig = DeploymentConfig.decode(reader, reader.uint32());
+          message.project = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.policy = IamPolicy.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1650,54 +2013,79 @@ export const UpdateDeploymentConfigRequest = {
     return message;
   },
 
-  fromJSON(object: any): UpdateDeploymentConfigRequest {
+  fromJSON(object: any): BatchGetIamPolicyResponse_PolicyResult {
     return {
-      deploymentConfig: isSet(object.deploymentConfig) ? DeploymentConfig.fromJSON(object.deploymentConfig) : undefined,
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      policy: isSet(object.policy) ? IamPolicy.fromJSON(object.policy) : undefined,
     };
   },
 
-  toJSON(message: UpdateDeploymentConfigRequest): unknown {
+  toJSON(message: BatchGetIamPolicyResponse_PolicyResult): unknown {
     const obj: any = {};
-    if (message.deploymentConfig !== undefined) {
-      obj.deploymentConfig = DeploymentConfig.toJSON(message.deploymentConfig);
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.policy !== undefined) {
+      obj.policy = IamPolicy.toJSON(message.policy);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<UpdateDeploymentConfigRequest>): UpdateDeploymentConfigRequest {
-    return UpdateDeploymentConfigRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<BatchGetIamPolicyResponse_PolicyResult>): BatchGetIamPolicyResponse_PolicyResult {
+    return BatchGetIamPolicyResponse_PolicyResult.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<UpdateDeploymentConfigRequest>): UpdateDeploymentConfigRequest {
-    const message = createBaseUpdateDeploymentConfigRequest();
-    message.deploymentConfig = (object.deploymentConfig !== undefined && object.deploymentConfig !== null)
-      ? DeploymentConfig.fromPartial(object.deploymentConfig)
+  fromPartial(object: DeepPartial<BatchGetIamPolicyResponse_PolicyResult>): BatchGetIamPolicyResponse_PolicyResult {
+    const message = createBaseBatchGetIamPolicyResponse_PolicyResult();
+    message.project = object.project ?? "";
+    message.policy = (object.policy !== undefined && object.policy !== null)
+      ? IamPolicy.fromPartial(object.policy)
       : undefined;
     return message;
   },
 };
 
-function createBaseLabel(): Label {
-  return { value: "", color: "", group: "" };
+function createBaseAddProjectMemberRequest(): AddProjectMemberRequest {
+  return {
+    project: "",
+    role: "",
+    members: [],
+    condition: undefined,
+    expireTime: undefined,
+    databases: [],
+    rowLimit: Long.ZERO,
+  };
 }
 
-export const Label = {
-  encode(message: Label, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.value !== "") {
-      writer.uint32(10).string(message.value);
+export const AddProjectMemberRequest = {
+  encode(message: AddProjectMemberRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.project !== "") {
+      writer.uint32(10).string(message.project);
     }
-    if (message.color !== "") {
-      writer.uint32(18).string(message.color);
+    if (message.role !== "") {
+      writer.uint32(18).string(message.role);
     }
-    if (message.group !== "") {
-      writer.uint32(26).string(message.group);
+    for (const v of message.members) {
+      writer.uint32(26).string(v!);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(42).fork()).ldelim();
+    }
+    for (const v of message.databases) {
+      writer.uint32(50).string(v!);
+    }
+    if (!message.rowLimit.isZero()) {
+      writer.uint32(56).int64(message.rowLimit);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Label {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AddProjectMemberRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseLabel();
+    const message = createBaseAddProjectMemberRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1706,21 +2094,49 @@ export const Label = {
             break;
           }
 
-          message.value = reader.string();
+          message.project = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.color = reader.string();
+          message.role = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.group = reader.string();
+          message.members.push(reader.string());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.databases.push(reader.string());
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.rowLimit = reader.int64() as Long;
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1731,102 +2147,1245 @@ export const Label = {
     return message;
   },
 
-  fromJSON(object: any): Label {
+  fromJSON(object: any): AddProjectMemberRequest {
     return {
-      value: isSet(object.value) ? globalThis.String(object.value) : "",
-      color: isSet(object.color) ? globalThis.String(object.color) : "",
-      group: isSet(object.group) ? globalThis.String(object.group) : "",
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      members: globalThis.Array.isArray(object?.members) ? object.members.map((e: any) => globalThis.String(e)) : [],
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+      expireTime: isSet(object.expireTime) ? fromJsonTimestamp(object.expireTime) : undefined,
+      databases: globalThis.Array.isArray(object?.databases)
+        ? object.databases.map((e: any) => globalThis.String(e))
+        : [],
+      rowLimit: isSet(object.rowLimit) ? Long.fromValue(object.rowLimit) : Long.ZERO,
     };
   },
 
-  toJSON(message: Label): unknown {
+  toJSON(message: AddProjectMemberRequest): unknown {
     const obj: any = {};
-    if (message.value !== "") {
-      obj.value = message.value;
+    if (message.project !== "") {
+      obj.project = message.project;
     }
-    if (message.color !== "") {
-      obj.color = message.color;
+    if (message.role !== "") {
+      obj.role = message.role;
     }
-    if (message.group !== "") {
-      obj.group = message.group;
+    if (message.members?.length) {
+      obj.members = message.members;
+    }
+    if (message.condition !== undefined) {
+      obj.condition = Expr.toJSON(message.condition);
+    }
+    if (message.expireTime !== undefined) {
+      obj.expireTime = message.expireTime.toISOString();
+    }
+    if (message.databases?.length) {
+      obj.databases = message.databases;
+    }
+    if (!message.rowLimit.isZero()) {
+      obj.rowLimit = (message.rowLimit || Long.ZERO).toString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Label>): Label {
-    return Label.fromPartial(base ?? {});
+  create(base?: DeepPartial<AddProjectMemberRequest>): AddProjectMemberRequest {
+    return AddProjectMemberRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Label>): Label {
-    const message = createBaseLabel();
-    message.value = object.value ?? "";
-    message.color = object.color ?? "";
-    message.group = object.group ?? "";
+  fromPartial(object: DeepPartial<AddProjectMemberRequest>): AddProjectMemberRequest {
+    const message = createBaseAddProjectMemberRequest();
+    message.project = object.project ?? "";
+    message.role = object.role ?? "";
+    message.members = object.members?.map((e) => e) || [];
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
+    message.expireTime = object.expireTime ?? undefined;
+    message.databases = object.databases?.map((e) => e) || [];
+    message.rowLimit = (object.rowLimit !== undefined && object.rowLimit !== null)
+      ? Long.fromValue(object.rowLimit)
+      : Long.ZERO;
     return message;
   },
 };
 
-function createBaseProject(): Project {
-  return {
-    name: "",
-    uid: "",
-    state: State.STATE_UNSPECIFIED,
-    title: "",
-    key: "",
-    workflow: Workflow.WORKFLOW_UNSPECIFIED,
-    webhooks: [],
-    dataClassificationConfigId: "",
-    issueLabels: [],
-    forceIssueLabels: false,
-    allowModifyStatement: false,
-    autoResolveIssue: false,
-  };
+function createBaseListExpiringBindingsRequest(): ListExpiringBindingsRequest {
+  return { project: "", within: undefined };
 }
 
-export const Project = {
-  encode(message: Project, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.uid !== "") {
-      writer.uint32(18).string(message.uid);
-    }
-    if (message.state !== State.STATE_UNSPECIFIED) {
-      writer.uint32(24).int32(stateToNumber(message.state));
-    }
-    if (message.title !== "") {
-      writer.uint32(34).string(message.title);
-    }
-    if (message.key !== "") {
-      writer.uint32(42).string(message.key);
-    }
-    if (message.workflow !== Workflow.WORKFLOW_UNSPECIFIED) {
-      writer.uint32(48).int32(workflowToNumber(message.workflow));
-    }
-    for (const v of message.webhooks) {
-      Webhook.encode(v!, writer.uint32(90).fork()).ldelim();
-    }
-    if (message.dataClassificationConfigId !== "") {
-      writer.uint32(98).string(message.dataClassificationConfigId);
-    }
-    for (const v of message.issueLabels) {
-      Label.encode(v!, writer.uint32(106).fork()).ldelim();
+export const ListExpiringBindingsRequest = {
+  encode(message: ListExpiringBindingsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.project !== "") {
+      writer.uint32(10).string(message.project);
     }
-    if (message.forceIssueLabels === true) {
-      writer.uint32(112).bool(message.forceIssueLabels);
+    if (message.within !== undefined) {
+      Duration.encode(message.within, writer.uint32(18).fork()).ldelim();
     }
-    if (message.allowModifyStatement === true) {
-      writer.uint32(120).bool(message.allowModifyStatement);
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListExpiringBindingsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListExpiringBindingsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.within = Duration.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListExpiringBindingsRequest {
+    return {
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      within: isSet(object.within) ? Duration.fromJSON(object.within) : undefined,
+    };
+  },
+
+  toJSON(message: ListExpiringBindingsRequest): unknown {
+    const obj: any = {};
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.within !== undefined) {
+      obj.within = Duration.toJSON(message.within);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListExpiringBindingsRequest>): ListExpiringBindingsRequest {
+    return ListExpiringBindingsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListExpiringBindingsRequest>): ListExpiringBindingsRequest {
+    const message = createBaseListExpiringBindingsRequest();
+    message.project = object.project ?? "";
+    message.within = (object.within !== undefined && object.within !== null)
+      ? Duration.fromPartial(object.within)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseListExpiringBindingsResponse(): ListExpiringBindingsResponse {
+  return { bindings: [] };
+}
+
+export const ListExpiringBindingsResponse = {
+  encode(message: ListExpiringBindingsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.bindings) {
+      ExpiringBinding.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListExpiringBindingsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListExpiringBindingsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.bindings.push(ExpiringBinding.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListExpiringBindingsResponse {
+    return {
+      bindings: globalThis.Array.isArray(object?.bindings)
+        ? object.bindings.map((e: any) => ExpiringBinding.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListExpiringBindingsResponse): unknown {
+    const obj: any = {};
+    if (message.bindings?.length) {
+      obj.bindings = message.bindings.map((e) => ExpiringBinding.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListExpiringBindingsResponse>): ListExpiringBindingsResponse {
+    return ListExpiringBindingsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListExpiringBindingsResponse>): ListExpiringBindingsResponse {
+    const message = createBaseListExpiringBindingsResponse();
+    message.bindings = object.bindings?.map((e) => ExpiringBinding.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseExpiringBinding(): ExpiringBinding {
+  return { role: "", members: [], condition: undefined, expireTime: undefined };
+}
+
+export const ExpiringBinding = {
+  encode(message: ExpiringBinding, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.role !== "") {
+      writer.uint32(10).string(message.role);
+    }
+    for (const v of message.members) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.condition !== undefined) {
+      Expr.encode(message.condition, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.expireTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.expireTime), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExpiringBinding {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExpiringBinding();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.members.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.condition = Expr.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.expireTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExpiringBinding {
+    return {
+      role: isSet(object.role) ? globalThis.String(object.role) : "",
+      members: globalThis.Array.isArray(object?.members) ? object.members.map((e: any) => globalThis.String(e)) : [],
+      condition: isSet(object.condition) ? Expr.fromJSON(object.condition) : undefined,
+      expireTime: isSet(object.expireTime) ? fromJsonTimestamp(object.expireTime) : undefined,
+    };
+  },
+
+  toJSON(message: ExpiringBinding): unknown {
+    const obj: any = {};
+    if (message.role !== "") {
+      obj.role = message.role;
+    }
+    if (message.members?.length) {
+      obj.members = message.members;
+    }
+    if (message.condition !== undefined) {
+      obj.condition = Expr.toJSON(message.condition);
+    }
+    if (message.expireTime !== undefined) {
+      obj.expireTime = message.expireTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExpiringBinding>): ExpiringBinding {
+    return ExpiringBinding.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExpiringBinding>): ExpiringBinding {
+    const message = createBaseExpiringBinding();
+    message.role = object.role ?? "";
+    message.members = object.members?.map((e) => e) || [];
+    message.condition = (object.condition !== undefined && object.condition !== null)
+      ? Expr.fromPartial(object.condition)
+      : undefined;
+    message.expireTime = object.expireTime ?? undefined;
+    return message;
+  },
+};
+
+function createBaseGetDeploymentConfigRequest(): GetDeploymentConfigRequest {
+  return { name: "" };
+}
+
+export const GetDeploymentConfigRequest = {
+  encode(message: GetDeploymentConfigRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetDeploymentConfigRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetDeploymentConfigRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetDeploymentConfigRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: GetDeploymentConfigRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetDeploymentConfigRequest>): GetDeploymentConfigRequest {
+    return GetDeploymentConfigRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetDeploymentConfigRequest>): GetDeploymentConfigRequest {
+    const message = createBaseGetDeploymentConfigRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseUpdateDeploymentConfigRequest(): UpdateDeploymentConfigRequest {
+  return { deploymentConfig: undefined };
+}
+
+export const UpdateDeploymentConfigRequest = {
+  encode(message: UpdateDeploymentConfigRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.deploymentConfig !== undefined) {
+      DeploymentConfig.encode(message.deploymentConfig, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateDeploymentConfigRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateDeploymentConfigRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deploymentConfig = DeploymentConfig.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateDeploymentConfigRequest {
+    return {
+      deploymentConfig: isSet(object.deploymentConfig) ? DeploymentConfig.fromJSON(object.deploymentConfig) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateDeploymentConfigRequest): unknown {
+    const obj: any = {};
+    if (message.deploymentConfig !== undefined) {
+      obj.deploymentConfig = DeploymentConfig.toJSON(message.deploymentConfig);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateDeploymentConfigRequest>): UpdateDeploymentConfigRequest {
+    return UpdateDeploymentConfigRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateDeploymentConfigRequest>): UpdateDeploymentConfigRequest {
+    const message = createBaseUpdateDeploymentConfigRequest();
+    message.deploymentConfig = (object.deploymentConfig !== undefined && object.deploymentConfig !== null)
+      ? DeploymentConfig.fromPartial(object.deploymentConfig)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseLabel(): Label {
+  return { value: "", color: "", group: "" };
+}
+
+export const Label = {
+  encode(message: Label, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.value !== "") {
+      writer.uint32(10).string(message.value);
+    }
+    if (message.color !== "") {
+      writer.uint32(18).string(message.color);
+    }
+    if (message.group !== "") {
+      writer.uint32(26).string(message.group);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Label {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLabel();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.color = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.group = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Label {
+    return {
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      color: isSet(object.color) ? globalThis.String(object.color) : "",
+      group: isSet(object.group) ? globalThis.String(object.group) : "",
+    };
+  },
+
+  toJSON(message: Label): unknown {
+    const obj: any = {};
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    if (message.color !== "") {
+      obj.color = message.color;
+    }
+    if (message.group !== "") {
+      obj.group = message.group;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Label>): Label {
+    return Label.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Label>): Label {
+    const message = createBaseLabel();
+    message.value = object.value ?? "";
+    message.color = object.color ?? "";
+    message.group = object.group ?? "";
+    return message;
+  },
+};
+
+function createBaseProject(): Project {
+  return {
+    name: "",
+    uid: "",
+    state: State.STATE_UNSPECIFIED,
+    title: "",
+    key: "",
+    workflow: Workflow.WORKFLOW_UNSPECIFIED,
+    webhooks: [],
+    dataClassificationConfigId: "",
+    issueLabels: [],
+    forceIssueLabels: false,
+    allowModifyStatement: false,
+    autoResolveIssue: false,
+    statementVariables: [],
+    ticketSync: undefined,
+  };
+}
+
+export const Project = {
+  encode(message: Project, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.uid !== "") {
+      writer.uint32(18).string(message.uid);
+    }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      writer.uint32(24).int32(stateToNumber(message.state));
+    }
+    if (message.title !== "") {
+      writer.uint32(34).string(message.title);
+    }
+    if (message.key !== "") {
+      writer.uint32(42).string(message.key);
+    }
+    if (message.workflow !== Workflow.WORKFLOW_UNSPECIFIED) {
+      writer.uint32(48).int32(workflowToNumber(message.workflow));
+    }
+    for (const v of message.webhooks) {
+      Webhook.encode(v!, writer.uint32(90).fork()).ldelim();
+    }
+    if (message.dataClassificationConfigId !== "") {
+      writer.uint32(98).string(message.dataClassificationConfigId);
+    }
+    for (const v of message.issueLabels) {
+      Label.encode(v!, writer.uint32(106).fork()).ldelim();
+    }
+    if (message.forceIssueLabels === true) {
+      writer.uint32(112).bool(message.forceIssueLabels);
+    }
+    if (message.allowModifyStatement === true) {
+      writer.uint32(120).bool(message.allowModifyStatement);
+    }
+    if (message.autoResolveIssue === true) {
+      writer.uint32(128).bool(message.autoResolveIssue);
+    }
+    for (const v of message.statementVariables) {
+      StatementVariable.encode(v!, writer.uint32(138).fork()).ldelim();
+    }
+    if (message.ticketSync !== undefined) {
+      TicketSync.encode(message.ticketSync, writer.uint32(146).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Project {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseProject();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.uid = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.state = stateFromJSON(reader.int32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.workflow = workflowFromJSON(reader.int32());
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.webhooks.push(Webhook.decode(reader, reader.uint32()));
+          continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.dataClassificationConfigId = reader.string();
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.issueLabels.push(Label.decode(reader, reader.uint32()));
+          continue;
+        case 14:
+          if (tag !== 112) {
+            break;
+          }
+
+          message.forceIssueLabels = reader.bool();
+          continue;
+        case 15:
+          if (tag !== 120) {
+            break;
+          }
+
+          message.allowModifyStatement = reader.bool();
+          continue;
+        case 16:
+          if (tag !== 128) {
+            break;
+          }
+
+          message.autoResolveIssue = reader.bool();
+          continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.statementVariables.push(StatementVariable.decode(reader, reader.uint32()));
+          continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.ticketSync = TicketSync.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Project {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
+      state: isSet(object.state) ? stateFromJSON(object.state) : State.STATE_UNSPECIFIED,
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      workflow: isSet(object.workflow) ? workflowFromJSON(object.workflow) : Workflow.WORKFLOW_UNSPECIFIED,
+      webhooks: globalThis.Array.isArray(object?.webhooks) ? object.webhooks.map((e: any) => Webhook.fromJSON(e)) : [],
+      dataClassificationConfigId: isSet(object.dataClassificationConfigId)
+        ? globalThis.String(object.dataClassificationConfigId)
+        : "",
+      issueLabels: globalThis.Array.isArray(object?.issueLabels)
+        ? object.issueLabels.map((e: any) => Label.fromJSON(e))
+        : [],
+      forceIssueLabels: isSet(object.forceIssueLabels) ? globalThis.Boolean(object.forceIssueLabels) : false,
+      allowModifyStatement: isSet(object.allowModifyStatement)
+        ? globalThis.Boolean(object.allowModifyStatement)
+        : false,
+      autoResolveIssue: isSet(object.autoResolveIssue) ? globalThis.Boolean(object.autoResolveIssue) : false,
+      statementVariables: globalThis.Array.isArray(object?.statementVariables)
+        ? object.statementVariables.map((e: any) => StatementVariable.fromJSON(e))
+        : [],
+      ticketSync: isSet(object.ticketSync) ? TicketSync.fromJSON(object.ticketSync) : undefined,
+    };
+  },
+
+  toJSON(message: Project): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.uid !== "") {
+      obj.uid = message.uid;
+    }
+    if (message.state !== State.STATE_UNSPECIFIED) {
+      obj.state = stateToJSON(message.state);
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.workflow !== Workflow.WORKFLOW_UNSPECIFIED) {
+      obj.workflow = workflowToJSON(message.workflow);
+    }
+    if (message.webhooks?.length) {
+      obj.webhooks = message.webhooks.map((e) => Webhook.toJSON(e));
+    }
+    if (message.dataClassificationConfigId !== "") {
+      obj.dataClassificationConfigId = message.dataClassificationConfigId;
+    }
+    if (message.issueLabels?.length) {
+      obj.issueLabels = message.issueLabels.map((e) => Label.toJSON(e));
+    }
+    if (message.forceIssueLabels === true) {
+      obj.forceIssueLabels = message.forceIssueLabels;
+    }
+    if (message.allowModifyStatement === true) {
+      obj.allowModifyStatement = message.allowModifyStatement;
+    }
+    if (message.autoResolveIssue === true) {
+      obj.autoResolveIssue = message.autoResolveIssue;
+    }
+    if (message.statementVariables?.length) {
+      obj.statementVariables = message.statementVariables.map((e) => StatementVariable.toJSON(e));
+    }
+    if (message.ticketSync !== undefined) {
+      obj.ticketSync = TicketSync.toJSON(message.ticketSync);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Project>): Project {
+    return Project.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Project>): Project {
+    const message = createBaseProject();
+    message.name = object.name ?? "";
+    message.uid = object.uid ?? "";
+    message.state = object.state ?? State.STATE_UNSPECIFIED;
+    message.title = object.title ?? "";
+    message.key = object.key ?? "";
+    message.workflow = object.workflow ?? Workflow.WORKFLOW_UNSPECIFIED;
+    message.webhooks = object.webhooks?.map((e) => Webhook.fromPartial(e)) || [];
+    message.dataClassificationConfigId = object.dataClassificationConfigId ?? "";
+    message.issueLabels = object.issueLabels?.map((e) => Label.fromPartial(e)) || [];
+    message.forceIssueLabels = object.forceIssueLabels ?? false;
+    message.allowModifyStatement = object.allowModifyStatement ?? false;
+    message.autoResolveIssue = object.autoResolveIssue ?? false;
+    message.statementVariables = object.statementVariables?.map((e) => StatementVariable.fromPartial(e)) || [];
+    message.ticketSync = (object.ticketSync !== undefined && object.ticketSync !== null)
+      ? TicketSync.fromPartial(object.ticketSync)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseTicketSync(): TicketSync {
+  return {
+    type: TicketSync_Type.TYPE_UNSPECIFIED,
+    url: "",
+    username: "",
+    token: "",
+    externalSecret: undefined,
+    project: "",
+    issueType: "",
+    openStatus: "",
+    doneStatus: "",
+    canceledStatus: "",
+  };
+}
+
+export const TicketSync = {
+  encode(message: TicketSync, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== TicketSync_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(ticketSync_TypeToNumber(message.type));
+    }
+    if (message.url !== "") {
+      writer.uint32(18).string(message.url);
+    }
+    if (message.username !== "") {
+      writer.uint32(26).string(message.username);
+    }
+    if (message.token !== "") {
+      writer.uint32(34).string(message.token);
+    }
+    if (message.externalSecret !== undefined) {
+      DataSourceExternalSecret.encode(message.externalSecret, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.project !== "") {
+      writer.uint32(50).string(message.project);
+    }
+    if (message.issueType !== "") {
+      writer.uint32(58).string(message.issueType);
+    }
+    if (message.openStatus !== "") {
+      writer.uint32(66).string(message.openStatus);
+    }
+    if (message.doneStatus !== "") {
+      writer.uint32(74).string(message.doneStatus);
+    }
+    if (message.canceledStatus !== "") {
+      writer.uint32(82).string(message.canceledStatus);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TicketSync {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTicketSync();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = ticketSync_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.externalSecret = DataSourceExternalSecret.decode(reader, reader.uint32());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.issueType = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.openStatus = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.doneStatus = reader.string();
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.canceledStatus = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TicketSync {
+    return {
+      type: isSet(object.type) ? ticketSync_TypeFromJSON(object.type) : TicketSync_Type.TYPE_UNSPECIFIED,
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+      username: isSet(object.username) ? globalThis.String(object.username) : "",
+      token: isSet(object.token) ? globalThis.String(object.token) : "",
+      externalSecret: isSet(object.externalSecret)
+        ? DataSourceExternalSecret.fromJSON(object.externalSecret)
+        : undefined,
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      issueType: isSet(object.issueType) ? globalThis.String(object.issueType) : "",
+      openStatus: isSet(object.openStatus) ? globalThis.String(object.openStatus) : "",
+      doneStatus: isSet(object.doneStatus) ? globalThis.String(object.doneStatus) : "",
+      canceledStatus: isSet(object.canceledStatus) ? globalThis.String(object.canceledStatus) : "",
+    };
+  },
+
+  toJSON(message: TicketSync): unknown {
+    const obj: any = {};
+    if (message.type !== TicketSync_Type.TYPE_UNSPECIFIED) {
+      obj.type = ticketSync_TypeToJSON(message.type);
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    if (message.username !== "") {
+      obj.username = message.username;
+    }
+    if (message.token !== "") {
+      obj.token = message.token;
+    }
+    if (message.externalSecret !== undefined) {
+      obj.externalSecret = DataSourceExternalSecret.toJSON(message.externalSecret);
+    }
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.issueType !== "") {
+      obj.issueType = message.issueType;
+    }
+    if (message.openStatus !== "") {
+      obj.openStatus = message.openStatus;
+    }
+    if (message.doneStatus !== "") {
+      obj.doneStatus = message.doneStatus;
+    }
+    if (message.canceledStatus !== "") {
+      obj.canceledStatus = message.canceledStatus;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<TicketSync>): TicketSync {
+    return TicketSync.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TicketSync>): TicketSync {
+    const message = createBaseTicketSync();
+    message.type = object.type ?? TicketSync_Type.TYPE_UNSPECIFIED;
+    message.url = object.url ?? "";
+    message.username = object.username ?? "";
+    message.token = object.token ?? "";
+    message.externalSecret = (object.externalSecret !== undefined && object.externalSecret !== null)
+      ? DataSourceExternalSecret.fromPartial(object.externalSecret)
+      : undefined;
+    message.project = object.project ?? "";
+    message.issueType = object.issueType ?? "";
+    message.openStatus = object.openStatus ?? "";
+    message.doneStatus = object.doneStatus ?? "";
+    message.canceledStatus = object.canceledStatus ?? "";
+    return message;
+  },
+};
+
+function createBaseStatementVariable(): StatementVariable {
+  return { name: "", defaultValue: "", environmentValues: {}, databaseValues: {} };
+}
+
+export const StatementVariable = {
+  encode(message: StatementVariable, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.defaultValue !== "") {
+      writer.uint32(18).string(message.defaultValue);
+    }
+    Object.entries(message.environmentValues).forEach(([key, value]) => {
+      StatementVariable_EnvironmentValuesEntry.encode({ key: key as any, value }, writer.uint32(26).fork()).ldelim();
+    });
+    Object.entries(message.databaseValues).forEach(([key, value]) => {
+      StatementVariable_DatabaseValuesEntry.encode({ key: key as any, value }, writer.uint32(34).fork()).ldelim();
+    });
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatementVariable {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatementVariable();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.defaultValue = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          const entry3 = StatementVariable_EnvironmentValuesEntry.decode(reader, reader.uint32());
+          if (entry3.value !== undefined) {
+            message.environmentValues[entry3.key] = entry3.value;
+          }
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          const entry4 = StatementVariable_DatabaseValuesEntry.decode(reader, reader.uint32());
+          if (entry4.value !== undefined) {
+            message.databaseValues[entry4.key] = entry4.value;
+          }
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StatementVariable {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      defaultValue: isSet(object.defaultValue) ? globalThis.String(object.defaultValue) : "",
+      environmentValues: isObject(object.environmentValues)
+        ? Object.entries(object.environmentValues).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+      databaseValues: isObject(object.databaseValues)
+        ? Object.entries(object.databaseValues).reduce<{ [key: string]: string }>((acc, [key, value]) => {
+          acc[key] = String(value);
+          return acc;
+        }, {})
+        : {},
+    };
+  },
+
+  toJSON(message: StatementVariable): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.defaultValue !== "") {
+      obj.defaultValue = message.defaultValue;
+    }
+    if (message.environmentValues) {
+      const entries = Object.entries(message.environmentValues);
+      if (entries.length > 0) {
+        obj.environmentValues = {};
+        entries.forEach(([k, v]) => {
+          obj.environmentValues[k] = v;
+        });
+      }
+    }
+    if (message.databaseValues) {
+      const entries = Object.entries(message.databaseValues);
+      if (entries.length > 0) {
+        obj.databaseValues = {};
+        entries.forEach(([k, v]) => {
+          obj.databaseValues[k] = v;
+        });
+      }
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<StatementVariable>): StatementVariable {
+    return StatementVariable.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<StatementVariable>): StatementVariable {
+    const message = createBaseStatementVariable();
+    message.name = object.name ?? "";
+    message.defaultValue = object.defaultValue ?? "";
+    message.environmentValues = Object.entries(object.environmentValues ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    message.databaseValues = Object.entries(object.databaseValues ?? {}).reduce<{ [key: string]: string }>(
+      (acc, [key, value]) => {
+        if (value !== undefined) {
+          acc[key] = globalThis.String(value);
+        }
+        return acc;
+      },
+      {},
+    );
+    return message;
+  },
+};
+
+function createBaseStatementVariable_EnvironmentValuesEntry(): StatementVariable_EnvironmentValuesEntry {
+  return { key: "", value: "" };
+}
+
+export const StatementVariable_EnvironmentValuesEntry = {
+  encode(message: StatementVariable_EnvironmentValuesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatementVariable_EnvironmentValuesEntry {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStatementVariable_EnvironmentValuesEntry();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.value = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): StatementVariable_EnvironmentValuesEntry {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+    };
+  },
+
+  toJSON(message: StatementVariable_EnvironmentValuesEntry): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.value !== "") {
+      obj.value = message.value;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<StatementVariable_EnvironmentValuesEntry>): StatementVariable_EnvironmentValuesEntry {
+    return StatementVariable_EnvironmentValuesEntry.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<StatementVariable_EnvironmentValuesEntry>): StatementVariable_EnvironmentValuesEntry {
+    const message = createBaseStatementVariable_EnvironmentValuesEntry();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    return message;
+  },
+};
+
+function createBaseStatementVariable_DatabaseValuesEntry(): StatementVariable_DatabaseValuesEntry {
+  return { key: "", value: "" };
+}
+
+export const StatementVariable_DatabaseValuesEntry = {
+  encode(message: StatementVariable_DatabaseValuesEntry, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    if (message.autoResolveIssue === true) {
-      writer.uint32(128).bool(message.autoResolveIssue);
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Project {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StatementVariable_DatabaseValuesEntry {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseProject();
+    const message = createBaseStatementVariable_DatabaseValuesEntry();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1835,84 +3394,14 @@ export const Project = {
             break;
           }
 
-          message.name = reader.string();
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.uid = reader.string();
-          continue;
-        case 3:
-          if (tag !== 24) {
-            break;
-          }
-
-          message.state = stateFromJSON(reader.int32());
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.title = reader.string();
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.key = reader.string();
-          continue;
-        case 6:
-          if (tag !== 48) {
-            break;
-          }
-
-          message.workflow = workflowFromJSON(reader.int32());
-          continue;
-        case 11:
-          if (tag !== 90) {
-            break;
-          }
-
-          message.webhooks.push(Webhook.decode(reader, reader.uint32()));
-          continue;
-        case 12:
-          if (tag !== 98) {
-            break;
-          }
-
-          message.dataClassificationConfigId = reader.string();
-          continue;
-        case 13:
-          if (tag !== 106) {
-            break;
-          }
-
-          message.issueLabels.push(Label.decode(reader, reader.uint32()));
-          continue;
-        case 14:
-          if (tag !== 112) {
-            break;
-          }
-
-          message.forceIssueLabels = reader.bool();
-          continue;
-        case 15:
-          if (tag !== 120) {
-            break;
-          }
-
-          message.allowModifyStatement = reader.bool();
-          continue;
-        case 16:
-          if (tag !== 128) {
-            break;
-          }
-
-          message.autoResolveIssue = reader.bool();
+          message.value = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1923,87 +3412,31 @@ export const Project = {
     return message;
   },
 
-  fromJSON(object: any): Project {
+  fromJSON(object: any): StatementVariable_DatabaseValuesEntry {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
-      state: isSet(object.state) ? stateFromJSON(object.state) : State.STATE_UNSPECIFIED,
-      title: isSet(object.title) ? globalThis.String(object.title) : "",
       key: isSet(object.key) ? globalThis.String(object.key) : "",
-      workflow: isSet(object.workflow) ? workflowFromJSON(object.workflow) : Workflow.WORKFLOW_UNSPECIFIED,
-      webhooks: globalThis.Array.isArray(object?.webhooks) ? object.webhooks.map((e: any) => Webhook.fromJSON(e)) : [],
-      dataClassificationConfigId: isSet(object.dataClassificationConfigId)
-        ? globalThis.String(object.dataClassificationConfigId)
-        : "",
-      issueLabels: globalThis.Array.isArray(object?.issueLabels)
-        ? object.issueLabels.map((e: any) => Label.fromJSON(e))
-        : [],
-      forceIssueLabels: isSet(object.forceIssueLabels) ? globalThis.Boolean(object.forceIssueLabels) : false,
-      allowModifyStatement: isSet(object.allowModifyStatement)
-        ? globalThis.Boolean(object.allowModifyStatement)
-        : false,
-      autoResolveIssue: isSet(object.autoResolveIssue) ? globalThis.Boolean(object.autoResolveIssue) : false,
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
     };
   },
 
-  toJSON(message: Project): unknown {
+  toJSON(message: StatementVariable_DatabaseValuesEntry): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.uid !== "") {
-      obj.uid = message.uid;
-    }
-    if (message.state !== State.STATE_UNSPECIFIED) {
-      obj.state = stateToJSON(message.state);
-    }
-    if (message.title !== "") {
-      obj.title = message.title;
-    }
     if (message.key !== "") {
       obj.key = message.key;
     }
-    if (message.workflow !== Workflow.WORKFLOW_UNSPECIFIED) {
-      obj.workflow = workflowToJSON(message.workflow);
-    }
-    if (message.webhooks?.length) {
-      obj.webhooks = message.webhooks.map((e) => Webhook.toJSON(e));
-    }
-    if (message.dataClassificationConfigId !== "") {
-      obj.dataClassificationConfigId = message.dataClassificationConfigId;
-    }
-    if (message.issueLabels?.length) {
-      obj.issueLabels = message.issueLabels.map((e) => Label.toJSON(e));
-    }
-    if (message.forceIssueLabels === true) {
-      obj.forceIssueLabels = message.forceIssueLabels;
-    }
-    if (message.allowModifyStatement === true) {
-      obj.allowModifyStatement = message.allowModifyStatement;
-    }
-    if (message.autoResolveIssue === true) {
-      obj.autoResolveIssue = message.autoResolveIssue;
+    if (message.value !== "") {
+      obj.value = message.value;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Project>): Project {
-    return Project.fromPartial(base ?? {});
+  create(base?: DeepPartial<StatementVariable_DatabaseValuesEntry>): StatementVariable_DatabaseValuesEntry {
+    return StatementVariable_DatabaseValuesEntry.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Project>): Project {
-    const message = createBaseProject();
-    message.name = object.name ?? "";
-    message.uid = object.uid ?? "";
-    message.state = object.state ?? State.STATE_UNSPECIFIED;
-    message.title = object.title ?? "";
+  fromPartial(object: DeepPartial<StatementVariable_DatabaseValuesEntry>): StatementVariable_DatabaseValuesEntry {
+    const message = createBaseStatementVariable_DatabaseValuesEntry();
     message.key = object.key ?? "";
-    message.workflow = object.workflow ?? Workflow.WORKFLOW_UNSPECIFIED;
-    message.webhooks = object.webhooks?.map((e) => Webhook.fromPartial(e)) || [];
-    message.dataClassificationConfigId = object.dataClassificationConfigId ?? "";
-    message.issueLabels = object.issueLabels?.map((e) => Label.fromPartial(e)) || [];
-    message.forceIssueLabels = object.forceIssueLabels ?? false;
-    message.allowModifyStatement = object.allowModifyStatement ?? false;
-    message.autoResolveIssue = object.autoResolveIssue ?? false;
+    message.value = object.value ?? "";
     return message;
   },
 };
@@ -2360,6 +3793,7 @@ function createBaseWebhook(): Webhook {
     url: "",
     directMessage: false,
     notificationTypes: [],
+    integrationKey: "",
   };
 }
 
@@ -2385,6 +3819,9 @@ export const Webhook = {
       writer.int32(activity_TypeToNumber(v));
     }
     writer.ldelim();
+    if (message.integrationKey !== "") {
+      writer.uint32(58).string(message.integrationKey);
+    }
     return writer;
   },
 
@@ -2447,6 +3884,13 @@ export const Webhook = {
           }
 
           break;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.integrationKey = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2466,6 +3910,7 @@ export const Webhook = {
       notificationTypes: globalThis.Array.isArray(object?.notificationTypes)
         ? object.notificationTypes.map((e: any) => activity_TypeFromJSON(e))
         : [],
+      integrationKey: isSet(object.integrationKey) ? globalThis.String(object.integrationKey) : "",
     };
   },
 
@@ -2489,6 +3934,9 @@ export const Webhook = {
     if (message.notificationTypes?.length) {
       obj.notificationTypes = message.notificationTypes.map((e) => activity_TypeToJSON(e));
     }
+    if (message.integrationKey !== "") {
+      obj.integrationKey = message.integrationKey;
+    }
     return obj;
   },
 
@@ -2503,6 +3951,7 @@ export const Webhook = {
     message.url = object.url ?? "";
     message.directMessage = object.directMessage ?? false;
     message.notificationTypes = object.notificationTypes?.map((e) => e) || [];
+    message.integrationKey = object.integrationKey ?? "";
     return message;
   },
 };
@@ -3183,21 +4632,133 @@ export const ProjectServiceDefinition = {
               101,
               99,
               116,
-              50,
-              29,
+              50,
+              29,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    deleteProject: {
+      name: "DeleteProject",
+      requestType: DeleteProjectRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [
+            new Uint8Array([18, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 100, 101, 108, 101, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              23,
+              42,
+              21,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    undeleteProject: {
+      name: "UndeleteProject",
+      requestType: UndeleteProjectRequest,
+      requestStream: false,
+      responseType: Project,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              20,
+              98,
+              98,
+              46,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              46,
+              117,
+              110,
+              100,
+              101,
+              108,
+              101,
+              116,
+              101,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              35,
+              58,
+              1,
+              42,
+              34,
+              30,
               47,
               118,
               49,
               47,
               123,
-              112,
-              114,
-              111,
-              106,
-              101,
-              99,
-              116,
-              46,
               110,
               97,
               109,
@@ -3214,16 +4775,30 @@ export const ProjectServiceDefinition = {
               47,
               42,
               125,
+              58,
+              117,
+              110,
+              100,
+              101,
+              108,
+              101,
+              116,
+              101,
             ]),
           ],
         },
       },
     },
-    deleteProject: {
-      name: "DeleteProject",
-      requestType: DeleteProjectRequest,
+    /**
+     * Archive the project. The archived project is hidden from the project list by default,
+     * and new issues cannot be created in it.
+     * The open issues are canceled or frozen by the open_issue_action.
+     */
+    archiveProject: {
+      name: "ArchiveProject",
+      requestType: ArchiveProjectRequest,
       requestStream: false,
-      responseType: Empty,
+      responseType: Project,
       responseStream: false,
       options: {
         _unknownFields: {
@@ -3232,11 +4807,15 @@ export const ProjectServiceDefinition = {
             new Uint8Array([18, 98, 98, 46, 112, 114, 111, 106, 101, 99, 116, 115, 46, 100, 101, 108, 101, 116, 101]),
           ],
           800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              23,
+              34,
+              58,
+              1,
               42,
-              21,
+              34,
+              29,
               47,
               118,
               49,
@@ -3258,19 +4837,29 @@ export const ProjectServiceDefinition = {
               47,
               42,
               125,
+              58,
+              97,
+              114,
+              99,
+              104,
+              105,
+              118,
+              101,
             ]),
           ],
         },
       },
     },
-    undeleteProject: {
-      name: "UndeleteProject",
-      requestType: UndeleteProjectRequest,
+    /** Restore the archived project, the frozen issues are resumed. */
+    restoreProject: {
+      name: "RestoreProject",
+      requestType: RestoreProjectRequest,
       requestStream: false,
       responseType: Project,
       responseStream: false,
       options: {
         _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
           800010: [
             new Uint8Array([
               20,
@@ -3297,14 +4886,15 @@ export const ProjectServiceDefinition = {
             ]),
           ],
           800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              35,
+              34,
               58,
               1,
               42,
               34,
-              30,
+              29,
               47,
               118,
               49,
@@ -3327,13 +4917,12 @@ export const ProjectServiceDefinition = {
               42,
               125,
               58,
-              117,
-              110,
-              100,
-              101,
-              108,
+              114,
               101,
+              115,
               116,
+              111,
+              114,
               101,
             ]),
           ],
@@ -3601,6 +5190,190 @@ export const ProjectServiceDefinition = {
         },
       },
     },
+    /**
+     * AddProjectMember adds the members to the project with the role.
+     * The binding condition is built in the same format as the grant request.
+     */
+    addProjectMember: {
+      name: "AddProjectMember",
+      requestType: AddProjectMemberRequest,
+      requestStream: false,
+      responseType: IamPolicy,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              24,
+              98,
+              98,
+              46,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              46,
+              115,
+              101,
+              116,
+              73,
+              97,
+              109,
+              80,
+              111,
+              108,
+              105,
+              99,
+              121,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              39,
+              58,
+              1,
+              42,
+              34,
+              34,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              58,
+              97,
+              100,
+              100,
+              77,
+              101,
+              109,
+              98,
+              101,
+              114,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListExpiringBindings lists the bindings in the project that expire within the given time. */
+    listExpiringBindings: {
+      name: "ListExpiringBindings",
+      requestType: ListExpiringBindingsRequest,
+      requestStream: false,
+      responseType: ListExpiringBindingsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              24,
+              98,
+              98,
+              46,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              46,
+              103,
+              101,
+              116,
+              73,
+              97,
+              109,
+              80,
+              111,
+              108,
+              105,
+              99,
+              121,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              47,
+              18,
+              45,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              125,
+              58,
+              108,
+              105,
+              115,
+              116,
+              69,
+              120,
+              112,
+              105,
+              114,
+              105,
+              110,
+              103,
+              66,
+              105,
+              110,
+              100,
+              105,
+              110,
+              103,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
     getDeploymentConfig: {
       name: "GetDeploymentConfig",
       requestType: GetDeploymentConfigRequest,
@@ -4076,11 +5849,41 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
 if (_m0.util.Long !== Long) {
   _m0.util.Long = Long as any;
   _m0.configure();
 }
 
+function isObject(value: any): boolean {
+  return typeof value === "object" && value !== null;
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
@@ -81,6 +81,8 @@ export enum Risk_Source {
   REQUEST_QUERY = "REQUEST_QUERY",
   REQUEST_EXPORT = "REQUEST_EXPORT",
   DATA_EXPORT = "DATA_EXPORT",
+  DATABASE_RESTORE = "DATABASE_RESTORE",
+  DATA_LOAD = "DATA_LOAD",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -107,6 +109,12 @@ export function risk_SourceFromJSON(object: any): Risk_Source {
     case 6:
     case "DATA_EXPORT":
       return Risk_Source.DATA_EXPORT;
+    case 7:
+    case "DATABASE_RESTORE":
+      return Risk_Source.DATABASE_RESTORE;
+    case 8:
+    case "DATA_LOAD":
+      return Risk_Source.DATA_LOAD;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -130,6 +138,10 @@ export function risk_SourceToJSON(object: Risk_Source): string {
       return "REQUEST_EXPORT";
     case Risk_Source.DATA_EXPORT:
       return "DATA_EXPORT";
+    case Risk_Source.DATABASE_RESTORE:
+      return "DATABASE_RESTORE";
+    case Risk_Source.DATA_LOAD:
+      return "DATA_LOAD";
     case Risk_Source.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -152,6 +164,10 @@ export function risk_SourceToNumber(object: Risk_Source): number {
       return 5;
     case Risk_Source.DATA_EXPORT:
       return 6;
+    case Risk_Source.DATABASE_RESTORE:
+      return 7;
+    case Risk_Source.DATA_LOAD:
+      return 8;
     case Risk_Source.UNRECOGNIZED:
     default:
       return -1;
@@ -62,7 +62,35 @@ export interface Role {
   name: string;
   title: string;
   description: string;
+  /**
+   * The effective permissions of the role.
+   * When creating or updating a role, the permission could be a wildcard on the resource, e.g. "bb.issues.*".
+   * The permissions implied by the granted permissions are added automatically.
+   */
   permissions: string[];
+  /** The permissions removed from the role after expanding the wildcards and the implied permissions. */
+  excludedPermissions: string[];
+}
+
+export interface ListPermissionsRequest {
+}
+
+export interface ListPermissionsResponse {
+  groups: PermissionGroup[];
+}
+
+/** PermissionGroup is the group of the permissions on the same resource. */
+export interface PermissionGroup {
+  /** The resource of the permissions, e.g. "databases". */
+  resource: string;
+  permissions: Permission[];
+}
+
+export interface Permission {
+  /** The permission name, e.g. "bb.databases.query". */
+  name: string;
+  /** The permissions granted together with this permission, e.g. "bb.databases.get" for "bb.databases.query". */
+  impliedPermissions: string[];
 }
 
 function createBaseListRolesRequest(): ListRolesRequest {
@@ -419,7 +447,7 @@ export const DeleteRoleRequest = {
 };
 
 function createBaseRole(): Role {
-  return { name: "", title: "", description: "", permissions: [] };
+  return { name: "", title: "", description: "", permissions: [], excludedPermissions: [] };
 }
 
 export const Role = {
@@ -436,6 +464,9 @@ export const Role = {
     for (const v of message.permissions) {
       writer.uint32(34).string(v!);
     }
+    for (const v of message.excludedPermissions) {
+      writer.uint32(42).string(v!);
+    }
     return writer;
   },
 
@@ -474,6 +505,13 @@ export const Role = {
 
           message.permissions.push(reader.string());
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.excludedPermissions.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -491,6 +529,9 @@ export const Role = {
       permissions: globalThis.Array.isArray(object?.permissions)
         ? object.permissions.map((e: any) => globalThis.String(e))
         : [],
+      excludedPermissions: globalThis.Array.isArray(object?.excludedPermissions)
+        ? object.excludedPermissions.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
@@ -508,6 +549,9 @@ export const Role = {
     if (message.permissions?.length) {
       obj.permissions = message.permissions;
     }
+    if (message.excludedPermissions?.length) {
+      obj.excludedPermissions = message.excludedPermissions;
+    }
     return obj;
   },
 
@@ -520,6 +564,263 @@ export const Role = {
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.permissions = object.permissions?.map((e) => e) || [];
+    message.excludedPermissions = object.excludedPermissions?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListPermissionsRequest(): ListPermissionsRequest {
+  return {};
+}
+
+export const ListPermissionsRequest = {
+  encode(_: ListPermissionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPermissionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPermissionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListPermissionsRequest {
+    return {};
+  },
+
+  toJSON(_: ListPermissionsRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListPermissionsRequest>): ListPermissionsRequest {
+    return ListPermissionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListPermissionsRequest>): ListPermissionsRequest {
+    const message = createBaseListPermissionsRequest();
+    return message;
+  },
+};
+
+function createBaseListPermissionsResponse(): ListPermissionsResponse {
+  return { groups: [] };
+}
+
+export const ListPermissionsResponse = {
+  encode(message: ListPermissionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.groups) {
+      PermissionGroup.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPermissionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPermissionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.groups.push(PermissionGroup.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPermissionsResponse {
+    return {
+      groups: globalThis.Array.isArray(object?.groups)
+        ? object.groups.map((e: any) => PermissionGroup.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListPermissionsResponse): unknown {
+    const obj: any = {};
+    if (message.groups?.length) {
+      obj.groups = message.groups.map((e) => PermissionGroup.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListPermissionsResponse>): ListPermissionsResponse {
+    return ListPermissionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListPermissionsResponse>): ListPermissionsResponse {
+    const message = createBaseListPermissionsResponse();
+    message.groups = object.groups?.map((e) => PermissionGroup.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBasePermissionGroup(): PermissionGroup {
+  return { resource: "", permissions: [] };
+}
+
+export const PermissionGroup = {
+  encode(message: PermissionGroup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.resource !== "") {
+      writer.uint32(10).string(message.resource);
+    }
+    for (const v of message.permissions) {
+      Permission.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PermissionGroup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePermissionGroup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.resource = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.permissions.push(Permission.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PermissionGroup {
+    return {
+      resource: isSet(object.resource) ? globalThis.String(object.resource) : "",
+      permissions: globalThis.Array.isArray(object?.permissions)
+        ? object.permissions.map((e: any) => Permission.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: PermissionGroup): unknown {
+    const obj: any = {};
+    if (message.resource !== "") {
+      obj.resource = message.resource;
+    }
+    if (message.permissions?.length) {
+      obj.permissions = message.permissions.map((e) => Permission.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PermissionGroup>): PermissionGroup {
+    return PermissionGroup.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PermissionGroup>): PermissionGroup {
+    const message = createBasePermissionGroup();
+    message.resource = object.resource ?? "";
+    message.permissions = object.permissions?.map((e) => Permission.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBasePermission(): Permission {
+  return { name: "", impliedPermissions: [] };
+}
+
+export const Permission = {
+  encode(message: Permission, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    for (const v of message.impliedPermissions) {
+      writer.uint32(18).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Permission {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePermission();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.impliedPermissions.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Permission {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      impliedPermissions: globalThis.Array.isArray(object?.impliedPermissions)
+        ? object.impliedPermissions.map((e: any) => globalThis.String(e))
+        : [],
+    };
+  },
+
+  toJSON(message: Permission): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.impliedPermissions?.length) {
+      obj.impliedPermissions = message.impliedPermissions;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Permission>): Permission {
+    return Permission.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Permission>): Permission {
+    const message = createBasePermission();
+    message.name = object.name ?? "";
+    message.impliedPermissions = object.impliedPermissions?.map((e) => e) || [];
     return message;
   },
 };
@@ -649,6 +950,23 @@ export const RoleServiceDefinition = {
         },
       },
     },
+    /** ListPermissions lists the permissions that can be granted by the roles. */
+    listPermissions: {
+      name: "ListPermissions",
+      requestType: ListPermissionsRequest,
+      requestStream: false,
+      responseType: ListPermissionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([13, 98, 98, 46, 114, 111, 108, 101, 115, 46, 108, 105, 115, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([17, 18, 15, 47, 118, 49, 47, 112, 101, 114, 109, 105, 115, 115, 105, 111, 110, 115]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -1,8 +1,19 @@
 /* eslint-disable */
 import Long from "long";
 import _m0 from "protobufjs/minimal";
+import { HttpBody } from "../google/api/httpbody";
 import { Timestamp } from "../google/protobuf/timestamp";
-import { ExportFormat, exportFormatFromJSON, exportFormatToJSON, exportFormatToNumber, Position } from "./common";
+import {
+  DataLoadFormat,
+  dataLoadFormatFromJSON,
+  dataLoadFormatToJSON,
+  dataLoadFormatToNumber,
+  ExportFormat,
+  exportFormatFromJSON,
+  exportFormatToJSON,
+  exportFormatToNumber,
+  Position,
+} from "./common";
 import { Plan } from "./plan_service";
 
 export const protobufPackage = "bytebase.v1";
@@ -41,6 +52,24 @@ export interface BatchSkipTasksRequest {
 export interface BatchSkipTasksResponse {
 }
 
+export interface ApproveStageRequest {
+  /**
+   * The name of the stage to approve.
+   * Format: projects/{project}/rollouts/{rollout}/stages/{stage}
+   */
+  name: string;
+  comment: string;
+}
+
+export interface RejectStageRequest {
+  /**
+   * The name of the stage to reject.
+   * Format: projects/{project}/rollouts/{rollout}/stages/{stage}
+   */
+  name: string;
+  comment: string;
+}
+
 export interface BatchCancelTaskRunsRequest {
   /**
    * The name of the parent of the taskRuns.
@@ -127,6 +156,18 @@ export interface GetTaskRunLogRequest {
    * TODO(d): check the resource_reference.
    */
   parent: string;
+  /** The byte offset of the full text log to return in the content. */
+  offset: Long;
+  /**
+   * The maximum number of bytes of the full text log to return in the content.
+   * The content is not returned if it's 0.
+   */
+  limit: Long;
+}
+
+export interface DownloadTaskRunLogRequest {
+  /** Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun} */
+  parent: string;
 }
 
 export interface Rollout {
@@ -154,6 +195,71 @@ export interface Stage {
   uid: string;
   title: string;
   tasks: Task[];
+  /** The stage requires a manual approval before it starts. */
+  approvalRequired: boolean;
+  /** The approval of the stage. It's unset if the stage is not approved or rejected yet. */
+  approval: Stage_Approval | undefined;
+}
+
+export interface Stage_Approval {
+  status: Stage_Approval_Status;
+  /** Format: users/{email} */
+  creator: string;
+  createTime: Date | undefined;
+  comment: string;
+}
+
+export enum Stage_Approval_Status {
+  STATUS_UNSPECIFIED = "STATUS_UNSPECIFIED",
+  APPROVED = "APPROVED",
+  REJECTED = "REJECTED",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function stage_Approval_StatusFromJSON(object: any): Stage_Approval_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return Stage_Approval_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "APPROVED":
+      return Stage_Approval_Status.APPROVED;
+    case 2:
+    case "REJECTED":
+      return Stage_Approval_Status.REJECTED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return Stage_Approval_Status.UNRECOGNIZED;
+  }
+}
+
+export function stage_Approval_StatusToJSON(object: Stage_Approval_Status): string {
+  switch (object) {
+    case Stage_Approval_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case Stage_Approval_Status.APPROVED:
+      return "APPROVED";
+    case Stage_Approval_Status.REJECTED:
+      return "REJECTED";
+    case Stage_Approval_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function stage_Approval_StatusToNumber(object: Stage_Approval_Status): number {
+  switch (object) {
+    case Stage_Approval_Status.STATUS_UNSPECIFIED:
+      return 0;
+    case Stage_Approval_Status.APPROVED:
+      return 1;
+    case Stage_Approval_Status.REJECTED:
+      return 2;
+    case Stage_Approval_Status.UNRECOGNIZED:
+    default:
+      return -1;
+  }
 }
 
 export interface Task {
@@ -183,6 +289,8 @@ export interface Task {
   databaseSchemaUpdate?: Task_DatabaseSchemaUpdate | undefined;
   databaseDataUpdate?: Task_DatabaseDataUpdate | undefined;
   databaseDataExport?: Task_DatabaseDataExport | undefined;
+  databaseRestore?: Task_DatabaseRestore | undefined;
+  databaseDataLoad?: Task_DatabaseDataLoad | undefined;
 }
 
 export enum Task_Status {
@@ -194,6 +302,8 @@ export enum Task_Status {
   FAILED = "FAILED",
   CANCELED = "CANCELED",
   SKIPPED = "SKIPPED",
+  /** FAILED_VERIFICATION - The change is applied but the verification queries failed. */
+  FAILED_VERIFICATION = "FAILED_VERIFICATION",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -223,6 +333,9 @@ export function task_StatusFromJSON(object: any): Task_Status {
     case 7:
     case "SKIPPED":
       return Task_Status.SKIPPED;
+    case 8:
+    case "FAILED_VERIFICATION":
+      return Task_Status.FAILED_VERIFICATION;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -248,6 +361,8 @@ export function task_StatusToJSON(object: Task_Status): string {
       return "CANCELED";
     case Task_Status.SKIPPED:
       return "SKIPPED";
+    case Task_Status.FAILED_VERIFICATION:
+      return "FAILED_VERIFICATION";
     case Task_Status.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -272,6 +387,8 @@ export function task_StatusToNumber(object: Task_Status): number {
       return 6;
     case Task_Status.SKIPPED:
       return 7;
+    case Task_Status.FAILED_VERIFICATION:
+      return 8;
     case Task_Status.UNRECOGNIZED:
     default:
       return -1;
@@ -297,6 +414,10 @@ export enum Task_Type {
   DATABASE_DATA_UPDATE = "DATABASE_DATA_UPDATE",
   /** DATABASE_DATA_EXPORT - use payload DatabaseDataExport */
   DATABASE_DATA_EXPORT = "DATABASE_DATA_EXPORT",
+  /** DATABASE_RESTORE - use payload DatabaseRestore */
+  DATABASE_RESTORE = "DATABASE_RESTORE",
+  /** DATABASE_DATA_LOAD - use payload DatabaseDataLoad */
+  DATABASE_DATA_LOAD = "DATABASE_DATA_LOAD",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -332,6 +453,12 @@ export function task_TypeFromJSON(object: any): Task_Type {
     case 12:
     case "DATABASE_DATA_EXPORT":
       return Task_Type.DATABASE_DATA_EXPORT;
+    case 13:
+    case "DATABASE_RESTORE":
+      return Task_Type.DATABASE_RESTORE;
+    case 14:
+    case "DATABASE_DATA_LOAD":
+      return Task_Type.DATABASE_DATA_LOAD;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -361,6 +488,10 @@ export function task_TypeToJSON(object: Task_Type): string {
       return "DATABASE_DATA_UPDATE";
     case Task_Type.DATABASE_DATA_EXPORT:
       return "DATABASE_DATA_EXPORT";
+    case Task_Type.DATABASE_RESTORE:
+      return "DATABASE_RESTORE";
+    case Task_Type.DATABASE_DATA_LOAD:
+      return "DATABASE_DATA_LOAD";
     case Task_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -389,6 +520,10 @@ export function task_TypeToNumber(object: Task_Type): number {
       return 8;
     case Task_Type.DATABASE_DATA_EXPORT:
       return 12;
+    case Task_Type.DATABASE_RESTORE:
+      return 13;
+    case Task_Type.DATABASE_DATA_LOAD:
+      return 14;
     case Task_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -454,6 +589,26 @@ export interface Task_DatabaseDataExport {
   password?: string | undefined;
 }
 
+export interface Task_DatabaseRestore {
+  /**
+   * The resource name of the backup run to restore.
+   * Format: instances/{instance}/databases/{database}/backupRuns/{backupRun}
+   */
+  backupRun: string;
+  /**
+   * The name of the new database to restore into.
+   * Empty if the database is restored in place.
+   */
+  targetDatabase: string;
+}
+
+export interface Task_DatabaseDataLoad {
+  table: string;
+  /** The URI of the files in the object storage, the credentials in the query parameters are redacted. */
+  sourceUri: string;
+  format: DataLoadFormat;
+}
+
 export interface TaskRun {
   /** Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun} */
   name: string;
@@ -481,7 +636,11 @@ export interface TaskRun {
   exportArchiveStatus: TaskRun_ExportArchiveStatus;
   /** The prior backup detail that will be used to rollback the task run. */
   priorBackupDetail: TaskRun_PriorBackupDetail | undefined;
-  schedulerInfo: TaskRun_SchedulerInfo | undefined;
+  schedulerInfo:
+    | TaskRun_SchedulerInfo
+    | undefined;
+  /** The results of the verification queries run after the change is applied. */
+  verificationResults: TaskRun_VerificationResult[];
 }
 
 export enum TaskRun_Status {
@@ -491,6 +650,8 @@ export enum TaskRun_Status {
   DONE = "DONE",
   FAILED = "FAILED",
   CANCELED = "CANCELED",
+  /** FAILED_VERIFICATION - The change is applied but the verification queries failed. */
+  FAILED_VERIFICATION = "FAILED_VERIFICATION",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -514,6 +675,9 @@ export function taskRun_StatusFromJSON(object: any): TaskRun_Status {
     case 5:
     case "CANCELED":
       return TaskRun_Status.CANCELED;
+    case 6:
+    case "FAILED_VERIFICATION":
+      return TaskRun_Status.FAILED_VERIFICATION;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -535,6 +699,8 @@ export function taskRun_StatusToJSON(object: TaskRun_Status): string {
       return "FAILED";
     case TaskRun_Status.CANCELED:
       return "CANCELED";
+    case TaskRun_Status.FAILED_VERIFICATION:
+      return "FAILED_VERIFICATION";
     case TaskRun_Status.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -555,6 +721,8 @@ export function taskRun_StatusToNumber(object: TaskRun_Status): number {
       return 4;
     case TaskRun_Status.CANCELED:
       return 5;
+    case TaskRun_Status.FAILED_VERIFICATION:
+      return 6;
     case TaskRun_Status.UNRECOGNIZED:
     default:
       return -1;
@@ -680,7 +848,14 @@ export interface TaskRun_ExecutionDetail {
   commandsTotal: number;
   commandsCompleted: number;
   commandStartPosition: TaskRun_ExecutionDetail_Position | undefined;
-  commandEndPosition: TaskRun_ExecutionDetail_Position | undefined;
+  commandEndPosition:
+    | TaskRun_ExecutionDetail_Position
+    | undefined;
+  /** The total and the processed bytes, only used by the database restore and the data load tasks. */
+  bytesTotal: Long;
+  bytesCompleted: Long;
+  /** The loaded rows, only used by the data load task. */
+  rowsCompleted: Long;
 }
 
 export interface TaskRun_ExecutionDetail_Position {
@@ -722,7 +897,11 @@ export interface TaskRun_SchedulerInfo {
 
 export interface TaskRun_SchedulerInfo_WaitingCause {
   connectionLimit?: boolean | undefined;
-  task?: TaskRun_SchedulerInfo_WaitingCause_Task | undefined;
+  task?:
+    | TaskRun_SchedulerInfo_WaitingCause_Task
+    | undefined;
+  /** The instance has reached the maximum concurrent tasks, and the task run is waiting for a slot. */
+  taskLimit?: boolean | undefined;
 }
 
 export interface TaskRun_SchedulerInfo_WaitingCause_Task {
@@ -732,10 +911,24 @@ export interface TaskRun_SchedulerInfo_WaitingCause_Task {
   issue: string;
 }
 
+export interface TaskRun_VerificationResult {
+  title: string;
+  statement: string;
+  passed: boolean;
+  /** The detail of the result, e.g. the actual row count or the query error. */
+  detail: string;
+}
+
 export interface TaskRunLog {
   /** Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}/log */
   name: string;
   entries: TaskRunLogEntry[];
+  /** The requested range of the full text log, which is persisted after the task run finishes. */
+  content: Uint8Array;
+  /** The total size of the full text log. It's 0 if the log is not persisted. */
+  contentSize: Long;
+  /** The full text log exceeds the size cap and the tail is dropped. */
+  contentTruncated: boolean;
 }
 
 export interface TaskRunLogEntry {
@@ -747,6 +940,7 @@ export interface TaskRunLogEntry {
   databaseSync: TaskRunLogEntry_DatabaseSync | undefined;
   taskRunStatusUpdate: TaskRunLogEntry_TaskRunStatusUpdate | undefined;
   transactionControl: TaskRunLogEntry_TransactionControl | undefined;
+  priorBackup: TaskRunLogEntry_PriorBackup | undefined;
 }
 
 export enum TaskRunLogEntry_Type {
@@ -756,6 +950,7 @@ export enum TaskRunLogEntry_Type {
   DATABASE_SYNC = "DATABASE_SYNC",
   TASK_RUN_STATUS_UPDATE = "TASK_RUN_STATUS_UPDATE",
   TRANSACTION_CONTROL = "TRANSACTION_CONTROL",
+  PRIOR_BACKUP = "PRIOR_BACKUP",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -779,6 +974,9 @@ export function taskRunLogEntry_TypeFromJSON(object: any): TaskRunLogEntry_Type
     case 5:
     case "TRANSACTION_CONTROL":
       return TaskRunLogEntry_Type.TRANSACTION_CONTROL;
+    case 6:
+    case "PRIOR_BACKUP":
+      return TaskRunLogEntry_Type.PRIOR_BACKUP;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -800,6 +998,8 @@ export function taskRunLogEntry_TypeToJSON(object: TaskRunLogEntry_Type): string
       return "TASK_RUN_STATUS_UPDATE";
     case TaskRunLogEntry_Type.TRANSACTION_CONTROL:
       return "TRANSACTION_CONTROL";
+    case TaskRunLogEntry_Type.PRIOR_BACKUP:
+      return "PRIOR_BACKUP";
     case TaskRunLogEntry_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -820,6 +1020,8 @@ export function taskRunLogEntry_TypeToNumber(object: TaskRunLogEntry_Type): numb
       return 4;
     case TaskRunLogEntry_Type.TRANSACTION_CONTROL:
       return 5;
+    case TaskRunLogEntry_Type.PRIOR_BACKUP:
+      return 6;
     case TaskRunLogEntry_Type.UNRECOGNIZED:
     default:
       return -1;
@@ -991,6 +1193,16 @@ export function taskRunLogEntry_TransactionControl_TypeToNumber(
   }
 }
 
+export interface TaskRunLogEntry_PriorBackup {
+  startTime: Date | undefined;
+  endTime:
+    | Date
+    | undefined;
+  /** The backup tables, it's kept even if the change fails after the backup. */
+  priorBackupDetail: TaskRun_PriorBackupDetail | undefined;
+  error: string;
+}
+
 export interface GetTaskRunSessionRequest {
   /** Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun} */
   parent: string;
@@ -1295,6 +1507,154 @@ export const BatchSkipTasksResponse = {
   },
 };
 
+function createBaseApproveStageRequest(): ApproveStageRequest {
+  return { name: "", comment: "" };
+}
+
+export const ApproveStageRequest = {
+  encode(message: ApproveStageRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.comment !== "") {
+      writer.uint32(18).string(message.comment);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ApproveStageRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseApproveStageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ApproveStageRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+    };
+  },
+
+  toJSON(message: ApproveStageRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ApproveStageRequest>): ApproveStageRequest {
+    return ApproveStageRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ApproveStageRequest>): ApproveStageRequest {
+    const message = createBaseApproveStageRequest();
+    message.name = object.name ?? "";
+    message.comment = object.comment ?? "";
+    return message;
+  },
+};
+
+function createBaseRejectStageRequest(): RejectStageRequest {
+  return { name: "", comment: "" };
+}
+
+export const RejectStageRequest = {
+  encode(message: RejectStageRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.comment !== "") {
+      writer.uint32(18).string(message.comment);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RejectStageRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRejectStageRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RejectStageRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+    };
+  },
+
+  toJSON(message: RejectStageRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RejectStageRequest>): RejectStageRequest {
+    return RejectStageRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RejectStageRequest>): RejectStageRequest {
+    const message = createBaseRejectStageRequest();
+    message.name = object.name ?? "";
+    message.comment = object.comment ?? "";
+    return message;
+  },
+};
+
 function createBaseBatchCancelTaskRunsRequest(): BatchCancelTaskRunsRequest {
   return { parent: "", taskRuns: [], reason: "" };
 }
@@ -1798,7 +2158,7 @@ export const ListTaskRunsResponse = {
 };
 
 function createBaseGetTaskRunLogRequest(): GetTaskRunLogRequest {
-  return { parent: "" };
+  return { parent: "", offset: Long.ZERO, limit: Long.ZERO };
 }
 
 export const GetTaskRunLogRequest = {
@@ -1806,6 +2166,12 @@ export const GetTaskRunLogRequest = {
     if (message.parent !== "") {
       writer.uint32(10).string(message.parent);
     }
+    if (!message.offset.isZero()) {
+      writer.uint32(16).int64(message.offset);
+    }
+    if (!message.limit.isZero()) {
+      writer.uint32(24).int64(message.limit);
+    }
     return writer;
   },
 
@@ -1823,6 +2189,20 @@ export const GetTaskRunLogRequest = {
 
           message.parent = reader.string();
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.offset = reader.int64() as Long;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.limit = reader.int64() as Long;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1833,7 +2213,11 @@ export const GetTaskRunLogRequest = {
   },
 
   fromJSON(object: any): GetTaskRunLogRequest {
-    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+    return {
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      offset: isSet(object.offset) ? Long.fromValue(object.offset) : Long.ZERO,
+      limit: isSet(object.limit) ? Long.fromValue(object.limit) : Long.ZERO,
+    };
   },
 
   toJSON(message: GetTaskRunLogRequest): unknown {
@@ -1841,6 +2225,12 @@ export const GetTaskRunLogRequest = {
     if (message.parent !== "") {
       obj.parent = message.parent;
     }
+    if (!message.offset.isZero()) {
+      obj.offset = (message.offset || Long.ZERO).toString();
+    }
+    if (!message.limit.isZero()) {
+      obj.limit = (message.limit || Long.ZERO).toString();
+    }
     return obj;
   },
 
@@ -1850,38 +2240,30 @@ export const GetTaskRunLogRequest = {
   fromPartial(object: DeepPartial<GetTaskRunLogRequest>): GetTaskRunLogRequest {
     const message = createBaseGetTaskRunLogRequest();
     message.parent = object.parent ?? "";
+    message.offset = (object.offset !== undefined && object.offset !== null)
+      ? Long.fromValue(object.offset)
+      : Long.ZERO;
+    message.limit = (object.limit !== undefined && object.limit !== null) ? Long.fromValue(object.limit) : Long.ZERO;
     return message;
   },
 };
 
-function createBaseRollout(): Rollout {
-  return { name: "", uid: "", plan: "", title: "", stages: [] };
+function createBaseDownloadTaskRunLogRequest(): DownloadTaskRunLogRequest {
+  return { parent: "" };
 }
 
-export const Rollout = {
-  encode(message: Rollout, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.uid !== "") {
-      writer.uint32(18).string(message.uid);
-    }
-    if (message.plan !== "") {
-      writer.uint32(26).string(message.plan);
-    }
-    if (message.title !== "") {
-      writer.uint32(34).string(message.title);
-    }
-    for (const v of message.stages) {
-      Stage.encode(v!, writer.uint32(42).fork()).ldelim();
+export const DownloadTaskRunLogRequest = {
+  encode(message: DownloadTaskRunLogRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Rollout {
+  decode(input: _m0.Reader | Uint8Array, length?: number): DownloadTaskRunLogRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseRollout();
+    const message = createBaseDownloadTaskRunLogRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1890,19 +2272,88 @@ export const Rollout = {
             break;
           }
 
-          message.name = reader.string();
+          message.parent = reader.string();
           continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-          message.uid = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
+  fromJSON(object: any): DownloadTaskRunLogRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: DownloadTaskRunLogRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DownloadTaskRunLogRequest>): DownloadTaskRunLogRequest {
+    return DownloadTaskRunLogRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DownloadTaskRunLogRequest>): DownloadTaskRunLogRequest {
+    const message = createBaseDownloadTaskRunLogRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseRollout(): Rollout {
+  return { name: "", uid: "", plan: "", title: "", stages: [] };
+}
+
+export const Rollout = {
+  encode(message: Rollout, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.uid !== "") {
+      writer.uint32(18).string(message.uid);
+    }
+    if (message.plan !== "") {
+      writer.uint32(26).string(message.plan);
+    }
+    if (message.title !== "") {
+      writer.uint32(34).string(message.title);
+    }
+    for (const v of message.stages) {
+      Stage.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Rollout {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRollout();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.uid = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
 
           message.plan = reader.string();
           continue;
@@ -1974,7 +2425,7 @@ export const Rollout = {
 };
 
 function createBaseStage(): Stage {
-  return { name: "", uid: "", title: "", tasks: [] };
+  return { name: "", uid: "", title: "", tasks: [], approvalRequired: false, approval: undefined };
 }
 
 export const Stage = {
@@ -1991,6 +2442,12 @@ export const Stage = {
     for (const v of message.tasks) {
       Task.encode(v!, writer.uint32(42).fork()).ldelim();
     }
+    if (message.approvalRequired === true) {
+      writer.uint32(48).bool(message.approvalRequired);
+    }
+    if (message.approval !== undefined) {
+      Stage_Approval.encode(message.approval, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2029,6 +2486,20 @@ export const Stage = {
 
           message.tasks.push(Task.decode(reader, reader.uint32()));
           continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.approvalRequired = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.approval = Stage_Approval.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2044,6 +2515,8 @@ export const Stage = {
       uid: isSet(object.uid) ? globalThis.String(object.uid) : "",
       title: isSet(object.title) ? globalThis.String(object.title) : "",
       tasks: globalThis.Array.isArray(object?.tasks) ? object.tasks.map((e: any) => Task.fromJSON(e)) : [],
+      approvalRequired: isSet(object.approvalRequired) ? globalThis.Boolean(object.approvalRequired) : false,
+      approval: isSet(object.approval) ? Stage_Approval.fromJSON(object.approval) : undefined,
     };
   },
 
@@ -2061,6 +2534,12 @@ export const Stage = {
     if (message.tasks?.length) {
       obj.tasks = message.tasks.map((e) => Task.toJSON(e));
     }
+    if (message.approvalRequired === true) {
+      obj.approvalRequired = message.approvalRequired;
+    }
+    if (message.approval !== undefined) {
+      obj.approval = Stage_Approval.toJSON(message.approval);
+    }
     return obj;
   },
 
@@ -2073,6 +2552,116 @@ export const Stage = {
     message.uid = object.uid ?? "";
     message.title = object.title ?? "";
     message.tasks = object.tasks?.map((e) => Task.fromPartial(e)) || [];
+    message.approvalRequired = object.approvalRequired ?? false;
+    message.approval = (object.approval !== undefined && object.approval !== null)
+      ? Stage_Approval.fromPartial(object.approval)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseStage_Approval(): Stage_Approval {
+  return { status: Stage_Approval_Status.STATUS_UNSPECIFIED, creator: "", createTime: undefined, comment: "" };
+}
+
+export const Stage_Approval = {
+  encode(message: Stage_Approval, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.status !== Stage_Approval_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(8).int32(stage_Approval_StatusToNumber(message.status));
+    }
+    if (message.creator !== "") {
+      writer.uint32(18).string(message.creator);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(26).fork()).ldelim();
+    }
+    if (message.comment !== "") {
+      writer.uint32(34).string(message.comment);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Stage_Approval {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseStage_Approval();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = stage_Approval_StatusFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.comment = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Stage_Approval {
+    return {
+      status: isSet(object.status)
+        ? stage_Approval_StatusFromJSON(object.status)
+        : Stage_Approval_Status.STATUS_UNSPECIFIED,
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      comment: isSet(object.comment) ? globalThis.String(object.comment) : "",
+    };
+  },
+
+  toJSON(message: Stage_Approval): unknown {
+    const obj: any = {};
+    if (message.status !== Stage_Approval_Status.STATUS_UNSPECIFIED) {
+      obj.status = stage_Approval_StatusToJSON(message.status);
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.comment !== "") {
+      obj.comment = message.comment;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Stage_Approval>): Stage_Approval {
+    return Stage_Approval.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Stage_Approval>): Stage_Approval {
+    const message = createBaseStage_Approval();
+    message.status = object.status ?? Stage_Approval_Status.STATUS_UNSPECIFIED;
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.comment = object.comment ?? "";
     return message;
   },
 };
@@ -2093,6 +2682,8 @@ function createBaseTask(): Task {
     databaseSchemaUpdate: undefined,
     databaseDataUpdate: undefined,
     databaseDataExport: undefined,
+    databaseRestore: undefined,
+    databaseDataLoad: undefined,
   };
 }
 
@@ -2140,6 +2731,12 @@ export const Task = {
     if (message.databaseDataExport !== undefined) {
       Task_DatabaseDataExport.encode(message.databaseDataExport, writer.uint32(130).fork()).ldelim();
     }
+    if (message.databaseRestore !== undefined) {
+      Task_DatabaseRestore.encode(message.databaseRestore, writer.uint32(138).fork()).ldelim();
+    }
+    if (message.databaseDataLoad !== undefined) {
+      Task_DatabaseDataLoad.encode(message.databaseDataLoad, writer.uint32(146).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2248,6 +2845,20 @@ export const Task = {
 
           message.databaseDataExport = Task_DatabaseDataExport.decode(reader, reader.uint32());
           continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.databaseRestore = Task_DatabaseRestore.decode(reader, reader.uint32());
+          continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.databaseDataLoad = Task_DatabaseDataLoad.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2283,6 +2894,12 @@ export const Task = {
       databaseDataExport: isSet(object.databaseDataExport)
         ? Task_DatabaseDataExport.fromJSON(object.databaseDataExport)
         : undefined,
+      databaseRestore: isSet(object.databaseRestore)
+        ? Task_DatabaseRestore.fromJSON(object.databaseRestore)
+        : undefined,
+      databaseDataLoad: isSet(object.databaseDataLoad)
+        ? Task_DatabaseDataLoad.fromJSON(object.databaseDataLoad)
+        : undefined,
     };
   },
 
@@ -2330,6 +2947,12 @@ export const Task = {
     if (message.databaseDataExport !== undefined) {
       obj.databaseDataExport = Task_DatabaseDataExport.toJSON(message.databaseDataExport);
     }
+    if (message.databaseRestore !== undefined) {
+      obj.databaseRestore = Task_DatabaseRestore.toJSON(message.databaseRestore);
+    }
+    if (message.databaseDataLoad !== undefined) {
+      obj.databaseDataLoad = Task_DatabaseDataLoad.toJSON(message.databaseDataLoad);
+    }
     return obj;
   },
 
@@ -2363,6 +2986,12 @@ export const Task = {
     message.databaseDataExport = (object.databaseDataExport !== undefined && object.databaseDataExport !== null)
       ? Task_DatabaseDataExport.fromPartial(object.databaseDataExport)
       : undefined;
+    message.databaseRestore = (object.databaseRestore !== undefined && object.databaseRestore !== null)
+      ? Task_DatabaseRestore.fromPartial(object.databaseRestore)
+      : undefined;
+    message.databaseDataLoad = (object.databaseDataLoad !== undefined && object.databaseDataLoad !== null)
+      ? Task_DatabaseDataLoad.fromPartial(object.databaseDataLoad)
+      : undefined;
     return message;
   },
 };
@@ -2914,30 +3543,195 @@ export const Task_DatabaseDataExport = {
 
   toJSON(message: Task_DatabaseDataExport): unknown {
     const obj: any = {};
-    if (message.target !== "") {
-      obj.target = message.target;
-    }
-    if (message.sheet !== "") {
-      obj.sheet = message.sheet;
+    if (message.target !== "") {
+      obj.target = message.target;
+    }
+    if (message.sheet !== "") {
+      obj.sheet = message.sheet;
+    }
+    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
+      obj.format = exportFormatToJSON(message.format);
+    }
+    if (message.password !== undefined) {
+      obj.password = message.password;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Task_DatabaseDataExport>): Task_DatabaseDataExport {
+    return Task_DatabaseDataExport.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Task_DatabaseDataExport>): Task_DatabaseDataExport {
+    const message = createBaseTask_DatabaseDataExport();
+    message.target = object.target ?? "";
+    message.sheet = object.sheet ?? "";
+    message.format = object.format ?? ExportFormat.FORMAT_UNSPECIFIED;
+    message.password = object.password ?? undefined;
+    return message;
+  },
+};
+
+function createBaseTask_DatabaseRestore(): Task_DatabaseRestore {
+  return { backupRun: "", targetDatabase: "" };
+}
+
+export const Task_DatabaseRestore = {
+  encode(message: Task_DatabaseRestore, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.backupRun !== "") {
+      writer.uint32(10).string(message.backupRun);
+    }
+    if (message.targetDatabase !== "") {
+      writer.uint32(18).string(message.targetDatabase);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Task_DatabaseRestore {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTask_DatabaseRestore();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.backupRun = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.targetDatabase = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Task_DatabaseRestore {
+    return {
+      backupRun: isSet(object.backupRun) ? globalThis.String(object.backupRun) : "",
+      targetDatabase: isSet(object.targetDatabase) ? globalThis.String(object.targetDatabase) : "",
+    };
+  },
+
+  toJSON(message: Task_DatabaseRestore): unknown {
+    const obj: any = {};
+    if (message.backupRun !== "") {
+      obj.backupRun = message.backupRun;
+    }
+    if (message.targetDatabase !== "") {
+      obj.targetDatabase = message.targetDatabase;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Task_DatabaseRestore>): Task_DatabaseRestore {
+    return Task_DatabaseRestore.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Task_DatabaseRestore>): Task_DatabaseRestore {
+    const message = createBaseTask_DatabaseRestore();
+    message.backupRun = object.backupRun ?? "";
+    message.targetDatabase = object.targetDatabase ?? "";
+    return message;
+  },
+};
+
+function createBaseTask_DatabaseDataLoad(): Task_DatabaseDataLoad {
+  return { table: "", sourceUri: "", format: DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED };
+}
+
+export const Task_DatabaseDataLoad = {
+  encode(message: Task_DatabaseDataLoad, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.table !== "") {
+      writer.uint32(10).string(message.table);
+    }
+    if (message.sourceUri !== "") {
+      writer.uint32(18).string(message.sourceUri);
+    }
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      writer.uint32(24).int32(dataLoadFormatToNumber(message.format));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Task_DatabaseDataLoad {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTask_DatabaseDataLoad();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.table = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.sourceUri = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.format = dataLoadFormatFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Task_DatabaseDataLoad {
+    return {
+      table: isSet(object.table) ? globalThis.String(object.table) : "",
+      sourceUri: isSet(object.sourceUri) ? globalThis.String(object.sourceUri) : "",
+      format: isSet(object.format)
+        ? dataLoadFormatFromJSON(object.format)
+        : DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: Task_DatabaseDataLoad): unknown {
+    const obj: any = {};
+    if (message.table !== "") {
+      obj.table = message.table;
     }
-    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
-      obj.format = exportFormatToJSON(message.format);
+    if (message.sourceUri !== "") {
+      obj.sourceUri = message.sourceUri;
     }
-    if (message.password !== undefined) {
-      obj.password = message.password;
+    if (message.format !== DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED) {
+      obj.format = dataLoadFormatToJSON(message.format);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Task_DatabaseDataExport>): Task_DatabaseDataExport {
-    return Task_DatabaseDataExport.fromPartial(base ?? {});
+  create(base?: DeepPartial<Task_DatabaseDataLoad>): Task_DatabaseDataLoad {
+    return Task_DatabaseDataLoad.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Task_DatabaseDataExport>): Task_DatabaseDataExport {
-    const message = createBaseTask_DatabaseDataExport();
-    message.target = object.target ?? "";
-    message.sheet = object.sheet ?? "";
-    message.format = object.format ?? ExportFormat.FORMAT_UNSPECIFIED;
-    message.password = object.password ?? undefined;
+  fromPartial(object: DeepPartial<Task_DatabaseDataLoad>): Task_DatabaseDataLoad {
+    const message = createBaseTask_DatabaseDataLoad();
+    message.table = object.table ?? "";
+    message.sourceUri = object.sourceUri ?? "";
+    message.format = object.format ?? DataLoadFormat.DATA_LOAD_FORMAT_UNSPECIFIED;
     return message;
   },
 };
@@ -2961,6 +3755,7 @@ function createBaseTaskRun(): TaskRun {
     exportArchiveStatus: TaskRun_ExportArchiveStatus.EXPORT_ARCHIVE_STATUS_UNSPECIFIED,
     priorBackupDetail: undefined,
     schedulerInfo: undefined,
+    verificationResults: [],
   };
 }
 
@@ -3017,6 +3812,9 @@ export const TaskRun = {
     if (message.schedulerInfo !== undefined) {
       TaskRun_SchedulerInfo.encode(message.schedulerInfo, writer.uint32(146).fork()).ldelim();
     }
+    for (const v of message.verificationResults) {
+      TaskRun_VerificationResult.encode(v!, writer.uint32(154).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -3146,6 +3944,13 @@ export const TaskRun = {
 
           message.schedulerInfo = TaskRun_SchedulerInfo.decode(reader, reader.uint32());
           continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.verificationResults.push(TaskRun_VerificationResult.decode(reader, reader.uint32()));
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3182,6 +3987,9 @@ export const TaskRun = {
         ? TaskRun_PriorBackupDetail.fromJSON(object.priorBackupDetail)
         : undefined,
       schedulerInfo: isSet(object.schedulerInfo) ? TaskRun_SchedulerInfo.fromJSON(object.schedulerInfo) : undefined,
+      verificationResults: globalThis.Array.isArray(object?.verificationResults)
+        ? object.verificationResults.map((e: any) => TaskRun_VerificationResult.fromJSON(e))
+        : [],
     };
   },
 
@@ -3238,6 +4046,9 @@ export const TaskRun = {
     if (message.schedulerInfo !== undefined) {
       obj.schedulerInfo = TaskRun_SchedulerInfo.toJSON(message.schedulerInfo);
     }
+    if (message.verificationResults?.length) {
+      obj.verificationResults = message.verificationResults.map((e) => TaskRun_VerificationResult.toJSON(e));
+    }
     return obj;
   },
 
@@ -3270,12 +4081,22 @@ export const TaskRun = {
     message.schedulerInfo = (object.schedulerInfo !== undefined && object.schedulerInfo !== null)
       ? TaskRun_SchedulerInfo.fromPartial(object.schedulerInfo)
       : undefined;
+    message.verificationResults = object.verificationResults?.map((e) => TaskRun_VerificationResult.fromPartial(e)) ||
+      [];
     return message;
   },
 };
 
 function createBaseTaskRun_ExecutionDetail(): TaskRun_ExecutionDetail {
-  return { commandsTotal: 0, commandsCompleted: 0, commandStartPosition: undefined, commandEndPosition: undefined };
+  return {
+    commandsTotal: 0,
+    commandsCompleted: 0,
+    commandStartPosition: undefined,
+    commandEndPosition: undefined,
+    bytesTotal: Long.ZERO,
+    bytesCompleted: Long.ZERO,
+    rowsCompleted: Long.ZERO,
+  };
 }
 
 export const TaskRun_ExecutionDetail = {
@@ -3292,6 +4113,15 @@ export const TaskRun_ExecutionDetail = {
     if (message.commandEndPosition !== undefined) {
       TaskRun_ExecutionDetail_Position.encode(message.commandEndPosition, writer.uint32(34).fork()).ldelim();
     }
+    if (!message.bytesTotal.isZero()) {
+      writer.uint32(40).int64(message.bytesTotal);
+    }
+    if (!message.bytesCompleted.isZero()) {
+      writer.uint32(48).int64(message.bytesCompleted);
+    }
+    if (!message.rowsCompleted.isZero()) {
+      writer.uint32(56).int64(message.rowsCompleted);
+    }
     return writer;
   },
 
@@ -3330,6 +4160,27 @@ export const TaskRun_ExecutionDetail = {
 
           message.commandEndPosition = TaskRun_ExecutionDetail_Position.decode(reader, reader.uint32());
           continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.bytesTotal = reader.int64() as Long;
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.bytesCompleted = reader.int64() as Long;
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.rowsCompleted = reader.int64() as Long;
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3349,6 +4200,9 @@ export const TaskRun_ExecutionDetail = {
       commandEndPosition: isSet(object.commandEndPosition)
         ? TaskRun_ExecutionDetail_Position.fromJSON(object.commandEndPosition)
         : undefined,
+      bytesTotal: isSet(object.bytesTotal) ? Long.fromValue(object.bytesTotal) : Long.ZERO,
+      bytesCompleted: isSet(object.bytesCompleted) ? Long.fromValue(object.bytesCompleted) : Long.ZERO,
+      rowsCompleted: isSet(object.rowsCompleted) ? Long.fromValue(object.rowsCompleted) : Long.ZERO,
     };
   },
 
@@ -3366,6 +4220,15 @@ export const TaskRun_ExecutionDetail = {
     if (message.commandEndPosition !== undefined) {
       obj.commandEndPosition = TaskRun_ExecutionDetail_Position.toJSON(message.commandEndPosition);
     }
+    if (!message.bytesTotal.isZero()) {
+      obj.bytesTotal = (message.bytesTotal || Long.ZERO).toString();
+    }
+    if (!message.bytesCompleted.isZero()) {
+      obj.bytesCompleted = (message.bytesCompleted || Long.ZERO).toString();
+    }
+    if (!message.rowsCompleted.isZero()) {
+      obj.rowsCompleted = (message.rowsCompleted || Long.ZERO).toString();
+    }
     return obj;
   },
 
@@ -3382,6 +4245,15 @@ export const TaskRun_ExecutionDetail = {
     message.commandEndPosition = (object.commandEndPosition !== undefined && object.commandEndPosition !== null)
       ? TaskRun_ExecutionDetail_Position.fromPartial(object.commandEndPosition)
       : undefined;
+    message.bytesTotal = (object.bytesTotal !== undefined && object.bytesTotal !== null)
+      ? Long.fromValue(object.bytesTotal)
+      : Long.ZERO;
+    message.bytesCompleted = (object.bytesCompleted !== undefined && object.bytesCompleted !== null)
+      ? Long.fromValue(object.bytesCompleted)
+      : Long.ZERO;
+    message.rowsCompleted = (object.rowsCompleted !== undefined && object.rowsCompleted !== null)
+      ? Long.fromValue(object.rowsCompleted)
+      : Long.ZERO;
     return message;
   },
 };
@@ -3805,7 +4677,7 @@ export const TaskRun_SchedulerInfo = {
 };
 
 function createBaseTaskRun_SchedulerInfo_WaitingCause(): TaskRun_SchedulerInfo_WaitingCause {
-  return { connectionLimit: undefined, task: undefined };
+  return { connectionLimit: undefined, task: undefined, taskLimit: undefined };
 }
 
 export const TaskRun_SchedulerInfo_WaitingCause = {
@@ -3816,6 +4688,9 @@ export const TaskRun_SchedulerInfo_WaitingCause = {
     if (message.task !== undefined) {
       TaskRun_SchedulerInfo_WaitingCause_Task.encode(message.task, writer.uint32(18).fork()).ldelim();
     }
+    if (message.taskLimit !== undefined) {
+      writer.uint32(24).bool(message.taskLimit);
+    }
     return writer;
   },
 
@@ -3840,6 +4715,13 @@ export const TaskRun_SchedulerInfo_WaitingCause = {
 
           message.task = TaskRun_SchedulerInfo_WaitingCause_Task.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.taskLimit = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3853,6 +4735,7 @@ export const TaskRun_SchedulerInfo_WaitingCause = {
     return {
       connectionLimit: isSet(object.connectionLimit) ? globalThis.Boolean(object.connectionLimit) : undefined,
       task: isSet(object.task) ? TaskRun_SchedulerInfo_WaitingCause_Task.fromJSON(object.task) : undefined,
+      taskLimit: isSet(object.taskLimit) ? globalThis.Boolean(object.taskLimit) : undefined,
     };
   },
 
@@ -3864,6 +4747,9 @@ export const TaskRun_SchedulerInfo_WaitingCause = {
     if (message.task !== undefined) {
       obj.task = TaskRun_SchedulerInfo_WaitingCause_Task.toJSON(message.task);
     }
+    if (message.taskLimit !== undefined) {
+      obj.taskLimit = message.taskLimit;
+    }
     return obj;
   },
 
@@ -3876,6 +4762,7 @@ export const TaskRun_SchedulerInfo_WaitingCause = {
     message.task = (object.task !== undefined && object.task !== null)
       ? TaskRun_SchedulerInfo_WaitingCause_Task.fromPartial(object.task)
       : undefined;
+    message.taskLimit = object.taskLimit ?? undefined;
     return message;
   },
 };
@@ -3954,8 +4841,112 @@ export const TaskRun_SchedulerInfo_WaitingCause_Task = {
   },
 };
 
+function createBaseTaskRun_VerificationResult(): TaskRun_VerificationResult {
+  return { title: "", statement: "", passed: false, detail: "" };
+}
+
+export const TaskRun_VerificationResult = {
+  encode(message: TaskRun_VerificationResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.title !== "") {
+      writer.uint32(10).string(message.title);
+    }
+    if (message.statement !== "") {
+      writer.uint32(18).string(message.statement);
+    }
+    if (message.passed === true) {
+      writer.uint32(24).bool(message.passed);
+    }
+    if (message.detail !== "") {
+      writer.uint32(34).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskRun_VerificationResult {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskRun_VerificationResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.passed = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskRun_VerificationResult {
+    return {
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      passed: isSet(object.passed) ? globalThis.Boolean(object.passed) : false,
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: TaskRun_VerificationResult): unknown {
+    const obj: any = {};
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.passed === true) {
+      obj.passed = message.passed;
+    }
+    if (message.detail !== "") {
+      obj.detail = message.detail;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<TaskRun_VerificationResult>): TaskRun_VerificationResult {
+    return TaskRun_VerificationResult.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TaskRun_VerificationResult>): TaskRun_VerificationResult {
+    const message = createBaseTaskRun_VerificationResult();
+    message.title = object.title ?? "";
+    message.statement = object.statement ?? "";
+    message.passed = object.passed ?? false;
+    message.detail = object.detail ?? "";
+    return message;
+  },
+};
+
 function createBaseTaskRunLog(): TaskRunLog {
-  return { name: "", entries: [] };
+  return { name: "", entries: [], content: new Uint8Array(0), contentSize: Long.ZERO, contentTruncated: false };
 }
 
 export const TaskRunLog = {
@@ -3966,6 +4957,15 @@ export const TaskRunLog = {
     for (const v of message.entries) {
       TaskRunLogEntry.encode(v!, writer.uint32(18).fork()).ldelim();
     }
+    if (message.content.length !== 0) {
+      writer.uint32(26).bytes(message.content);
+    }
+    if (!message.contentSize.isZero()) {
+      writer.uint32(32).int64(message.contentSize);
+    }
+    if (message.contentTruncated === true) {
+      writer.uint32(40).bool(message.contentTruncated);
+    }
     return writer;
   },
 
@@ -3990,6 +4990,27 @@ export const TaskRunLog = {
 
           message.entries.push(TaskRunLogEntry.decode(reader, reader.uint32()));
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.content = reader.bytes();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.contentSize = reader.int64() as Long;
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.contentTruncated = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4005,6 +5026,9 @@ export const TaskRunLog = {
       entries: globalThis.Array.isArray(object?.entries)
         ? object.entries.map((e: any) => TaskRunLogEntry.fromJSON(e))
         : [],
+      content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0),
+      contentSize: isSet(object.contentSize) ? Long.fromValue(object.contentSize) : Long.ZERO,
+      contentTruncated: isSet(object.contentTruncated) ? globalThis.Boolean(object.contentTruncated) : false,
     };
   },
 
@@ -4016,6 +5040,15 @@ export const TaskRunLog = {
     if (message.entries?.length) {
       obj.entries = message.entries.map((e) => TaskRunLogEntry.toJSON(e));
     }
+    if (message.content.length !== 0) {
+      obj.content = base64FromBytes(message.content);
+    }
+    if (!message.contentSize.isZero()) {
+      obj.contentSize = (message.contentSize || Long.ZERO).toString();
+    }
+    if (message.contentTruncated === true) {
+      obj.contentTruncated = message.contentTruncated;
+    }
     return obj;
   },
 
@@ -4026,6 +5059,11 @@ export const TaskRunLog = {
     const message = createBaseTaskRunLog();
     message.name = object.name ?? "";
     message.entries = object.entries?.map((e) => TaskRunLogEntry.fromPartial(e)) || [];
+    message.content = object.content ?? new Uint8Array(0);
+    message.contentSize = (object.contentSize !== undefined && object.contentSize !== null)
+      ? Long.fromValue(object.contentSize)
+      : Long.ZERO;
+    message.contentTruncated = object.contentTruncated ?? false;
     return message;
   },
 };
@@ -4040,6 +5078,7 @@ function createBaseTaskRunLogEntry(): TaskRunLogEntry {
     databaseSync: undefined,
     taskRunStatusUpdate: undefined,
     transactionControl: undefined,
+    priorBackup: undefined,
   };
 }
 
@@ -4069,6 +5108,9 @@ export const TaskRunLogEntry = {
     if (message.transactionControl !== undefined) {
       TaskRunLogEntry_TransactionControl.encode(message.transactionControl, writer.uint32(58).fork()).ldelim();
     }
+    if (message.priorBackup !== undefined) {
+      TaskRunLogEntry_PriorBackup.encode(message.priorBackup, writer.uint32(66).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -4135,6 +5177,13 @@ export const TaskRunLogEntry = {
 
           message.transactionControl = TaskRunLogEntry_TransactionControl.decode(reader, reader.uint32());
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.priorBackup = TaskRunLogEntry_PriorBackup.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4160,6 +5209,7 @@ export const TaskRunLogEntry = {
       transactionControl: isSet(object.transactionControl)
         ? TaskRunLogEntry_TransactionControl.fromJSON(object.transactionControl)
         : undefined,
+      priorBackup: isSet(object.priorBackup) ? TaskRunLogEntry_PriorBackup.fromJSON(object.priorBackup) : undefined,
     };
   },
 
@@ -4189,6 +5239,9 @@ export const TaskRunLogEntry = {
     if (message.transactionControl !== undefined) {
       obj.transactionControl = TaskRunLogEntry_TransactionControl.toJSON(message.transactionControl);
     }
+    if (message.priorBackup !== undefined) {
+      obj.priorBackup = TaskRunLogEntry_PriorBackup.toJSON(message.priorBackup);
+    }
     return obj;
   },
 
@@ -4215,6 +5268,9 @@ export const TaskRunLogEntry = {
     message.transactionControl = (object.transactionControl !== undefined && object.transactionControl !== null)
       ? TaskRunLogEntry_TransactionControl.fromPartial(object.transactionControl)
       : undefined;
+    message.priorBackup = (object.priorBackup !== undefined && object.priorBackup !== null)
+      ? TaskRunLogEntry_PriorBackup.fromPartial(object.priorBackup)
+      : undefined;
     return message;
   },
 };
@@ -4744,10 +5800,114 @@ export const TaskRunLogEntry_TransactionControl = {
     };
   },
 
-  toJSON(message: TaskRunLogEntry_TransactionControl): unknown {
+  toJSON(message: TaskRunLogEntry_TransactionControl): unknown {
+    const obj: any = {};
+    if (message.type !== TaskRunLogEntry_TransactionControl_Type.TYPE_UNSPECIFIED) {
+      obj.type = taskRunLogEntry_TransactionControl_TypeToJSON(message.type);
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<TaskRunLogEntry_TransactionControl>): TaskRunLogEntry_TransactionControl {
+    return TaskRunLogEntry_TransactionControl.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<TaskRunLogEntry_TransactionControl>): TaskRunLogEntry_TransactionControl {
+    const message = createBaseTaskRunLogEntry_TransactionControl();
+    message.type = object.type ?? TaskRunLogEntry_TransactionControl_Type.TYPE_UNSPECIFIED;
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBaseTaskRunLogEntry_PriorBackup(): TaskRunLogEntry_PriorBackup {
+  return { startTime: undefined, endTime: undefined, priorBackupDetail: undefined, error: "" };
+}
+
+export const TaskRunLogEntry_PriorBackup = {
+  encode(message: TaskRunLogEntry_PriorBackup, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(10).fork()).ldelim();
+    }
+    if (message.endTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.endTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.priorBackupDetail !== undefined) {
+      TaskRun_PriorBackupDetail.encode(message.priorBackupDetail, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.error !== "") {
+      writer.uint32(34).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): TaskRunLogEntry_PriorBackup {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseTaskRunLogEntry_PriorBackup();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.endTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.priorBackupDetail = TaskRun_PriorBackupDetail.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): TaskRunLogEntry_PriorBackup {
+    return {
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+      endTime: isSet(object.endTime) ? fromJsonTimestamp(object.endTime) : undefined,
+      priorBackupDetail: isSet(object.priorBackupDetail)
+        ? TaskRun_PriorBackupDetail.fromJSON(object.priorBackupDetail)
+        : undefined,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: TaskRunLogEntry_PriorBackup): unknown {
     const obj: any = {};
-    if (message.type !== TaskRunLogEntry_TransactionControl_Type.TYPE_UNSPECIFIED) {
-      obj.type = taskRunLogEntry_TransactionControl_TypeToJSON(message.type);
+    if (message.startTime !== undefined) {
+      obj.startTime = message.startTime.toISOString();
+    }
+    if (message.endTime !== undefined) {
+      obj.endTime = message.endTime.toISOString();
+    }
+    if (message.priorBackupDetail !== undefined) {
+      obj.priorBackupDetail = TaskRun_PriorBackupDetail.toJSON(message.priorBackupDetail);
     }
     if (message.error !== "") {
       obj.error = message.error;
@@ -4755,12 +5915,16 @@ export const TaskRunLogEntry_TransactionControl = {
     return obj;
   },
 
-  create(base?: DeepPartial<TaskRunLogEntry_TransactionControl>): TaskRunLogEntry_TransactionControl {
-    return TaskRunLogEntry_TransactionControl.fromPartial(base ?? {});
+  create(base?: DeepPartial<TaskRunLogEntry_PriorBackup>): TaskRunLogEntry_PriorBackup {
+    return TaskRunLogEntry_PriorBackup.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<TaskRunLogEntry_TransactionControl>): TaskRunLogEntry_TransactionControl {
-    const message = createBaseTaskRunLogEntry_TransactionControl();
-    message.type = object.type ?? TaskRunLogEntry_TransactionControl_Type.TYPE_UNSPECIFIED;
+  fromPartial(object: DeepPartial<TaskRunLogEntry_PriorBackup>): TaskRunLogEntry_PriorBackup {
+    const message = createBaseTaskRunLogEntry_PriorBackup();
+    message.startTime = object.startTime ?? undefined;
+    message.endTime = object.endTime ?? undefined;
+    message.priorBackupDetail = (object.priorBackupDetail !== undefined && object.priorBackupDetail !== null)
+      ? TaskRun_PriorBackupDetail.fromPartial(object.priorBackupDetail)
+      : undefined;
     message.error = object.error ?? "";
     return message;
   },
@@ -5642,6 +6806,103 @@ export const RolloutServiceDefinition = {
         },
       },
     },
+    /** DownloadTaskRunLog downloads the full text log of the finished task run. */
+    downloadTaskRunLog: {
+      name: "DownloadTaskRunLog",
+      requestType: DownloadTaskRunLogRequest,
+      requestStream: false,
+      responseType: HttpBody,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [new Uint8Array([16, 98, 98, 46, 116, 97, 115, 107, 82, 117, 110, 115, 46, 108, 105, 115, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              77,
+              18,
+              75,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              114,
+              111,
+              108,
+              108,
+              111,
+              117,
+              116,
+              115,
+              47,
+              42,
+              47,
+              115,
+              116,
+              97,
+              103,
+              101,
+              115,
+              47,
+              42,
+              47,
+              116,
+              97,
+              115,
+              107,
+              115,
+              47,
+              42,
+              47,
+              116,
+              97,
+              115,
+              107,
+              82,
+              117,
+              110,
+              115,
+              47,
+              42,
+              125,
+              47,
+              108,
+              111,
+              103,
+              58,
+              100,
+              111,
+              119,
+              110,
+              108,
+              111,
+              97,
+              100,
+            ]),
+          ],
+        },
+      },
+    },
     getTaskRunSession: {
       name: "GetTaskRunSession",
       requestType: GetTaskRunSessionRequest,
@@ -5906,6 +7167,156 @@ export const RolloutServiceDefinition = {
         },
       },
     },
+    /**
+     * ApproveStage approves the stage to start when the rollout policy of the stage environment requires stage approval.
+     * The stage approver groups of the rollout policy can approve the stage.
+     */
+    approveStage: {
+      name: "ApproveStage",
+      requestType: ApproveStageRequest,
+      requestStream: false,
+      responseType: Stage,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800016: [new Uint8Array([2])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              54,
+              58,
+              1,
+              42,
+              34,
+              49,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              114,
+              111,
+              108,
+              108,
+              111,
+              117,
+              116,
+              115,
+              47,
+              42,
+              47,
+              115,
+              116,
+              97,
+              103,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              97,
+              112,
+              112,
+              114,
+              111,
+              118,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    /** RejectStage rejects the stage to start. The access is the same as ApproveStage(). */
+    rejectStage: {
+      name: "RejectStage",
+      requestType: RejectStageRequest,
+      requestStream: false,
+      responseType: Stage,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800016: [new Uint8Array([2])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              53,
+              58,
+              1,
+              42,
+              34,
+              48,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              114,
+              111,
+              108,
+              108,
+              111,
+              117,
+              116,
+              115,
+              47,
+              42,
+              47,
+              115,
+              116,
+              97,
+              103,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              114,
+              101,
+              106,
+              101,
+              99,
+              116,
+            ]),
+          ],
+        },
+      },
+    },
     /**
      * BatchSkipTasks cancels the specified task runs in batch.
      * The access is the same as BatchRunTasks().
@@ -6008,6 +7419,31 @@ export const RolloutServiceDefinition = {
   },
 } as const;
 
+function bytesFromBase64(b64: string): Uint8Array {
+  if (globalThis.Buffer) {
+    return Uint8Array.from(globalThis.Buffer.from(b64, "base64"));
+  } else {
+    const bin = globalThis.atob(b64);
+    const arr = new Uint8Array(bin.length);
+    for (let i = 0; i < bin.length; ++i) {
+      arr[i] = bin.charCodeAt(i);
+    }
+    return arr;
+  }
+}
+
+function base64FromBytes(arr: Uint8Array): string {
+  if (globalThis.Buffer) {
+    return globalThis.Buffer.from(arr).toString("base64");
+  } else {
+    const bin: string[] = [];
+    arr.forEach((byte) => {
+      bin.push(globalThis.String.fromCharCode(byte));
+    });
+    return globalThis.btoa(bin.join(""));
+  }
+}
+
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
 
 export type DeepPartial<T> = T extends Builtin ? T
@@ -0,0 +1,913 @@
+/* eslint-disable */
+import Long from "long";
+import _m0 from "protobufjs/minimal";
+import { Empty } from "../google/protobuf/empty";
+import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
+
+export const protobufPackage = "bytebase.v1";
+
+export interface CreateSavedSearchRequest {
+  /** The saved search to create. */
+  savedSearch: SavedSearch | undefined;
+}
+
+export interface GetSavedSearchRequest {
+  /**
+   * The name of the saved search to retrieve.
+   * Format: savedSearches/{saved_search}
+   */
+  name: string;
+}
+
+export interface ListSavedSearchesRequest {
+}
+
+export interface ListSavedSearchesResponse {
+  /** The saved searches, the newest first. */
+  savedSearches: SavedSearch[];
+}
+
+export interface UpdateSavedSearchRequest {
+  /**
+   * The saved search to update.
+   *
+   * The saved search's `name` field is used to identify the saved search to update.
+   * Format: savedSearches/{saved_search}
+   */
+  savedSearch:
+    | SavedSearch
+    | undefined;
+  /**
+   * The list of fields to be updated.
+   * Only support update the following fields for now:
+   * - `title`
+   * - `filter`
+   * - `project`
+   */
+  updateMask: string[] | undefined;
+}
+
+export interface DeleteSavedSearchRequest {
+  /**
+   * The name of the saved search to delete.
+   * Format: savedSearches/{saved_search}
+   */
+  name: string;
+}
+
+export interface SavedSearch {
+  /**
+   * The name of the saved search.
+   * Format: savedSearches/{saved_search}
+   */
+  name: string;
+  /** The title of the saved search. */
+  title: string;
+  /**
+   * The issue filter of the saved search, which has the same syntax as the filter in ListIssuesRequest.
+   * For example, `status = "OPEN" && task_type = "DDL"`.
+   */
+  filter: string;
+  /**
+   * The owner of the saved search.
+   * Format: users/{email}
+   */
+  owner: string;
+  /**
+   * The project which the saved search is shared with, so that the project members can use it.
+   * The saved search is private to the owner if it's empty.
+   * Format: projects/{project}
+   */
+  project: string;
+  createTime: Date | undefined;
+  updateTime: Date | undefined;
+}
+
+function createBaseCreateSavedSearchRequest(): CreateSavedSearchRequest {
+  return { savedSearch: undefined };
+}
+
+export const CreateSavedSearchRequest = {
+  encode(message: CreateSavedSearchRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.savedSearch !== undefined) {
+      SavedSearch.encode(message.savedSearch, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CreateSavedSearchRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCreateSavedSearchRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.savedSearch = SavedSearch.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CreateSavedSearchRequest {
+    return { savedSearch: isSet(object.savedSearch) ? SavedSearch.fromJSON(object.savedSearch) : undefined };
+  },
+
+  toJSON(message: CreateSavedSearchRequest): unknown {
+    const obj: any = {};
+    if (message.savedSearch !== undefined) {
+      obj.savedSearch = SavedSearch.toJSON(message.savedSearch);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CreateSavedSearchRequest>): CreateSavedSearchRequest {
+    return CreateSavedSearchRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CreateSavedSearchRequest>): CreateSavedSearchRequest {
+    const message = createBaseCreateSavedSearchRequest();
+    message.savedSearch = (object.savedSearch !== undefined && object.savedSearch !== null)
+      ? SavedSearch.fromPartial(object.savedSearch)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseGetSavedSearchRequest(): GetSavedSearchRequest {
+  return { name: "" };
+}
+
+export const GetSavedSearchRequest = {
+  encode(message: GetSavedSearchRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetSavedSearchRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSavedSearchRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GetSavedSearchRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: GetSavedSearchRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetSavedSearchRequest>): GetSavedSearchRequest {
+    return GetSavedSearchRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<GetSavedSearchRequest>): GetSavedSearchRequest {
+    const message = createBaseGetSavedSearchRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseListSavedSearchesRequest(): ListSavedSearchesRequest {
+  return {};
+}
+
+export const ListSavedSearchesRequest = {
+  encode(_: ListSavedSearchesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListSavedSearchesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListSavedSearchesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): ListSavedSearchesRequest {
+    return {};
+  },
+
+  toJSON(_: ListSavedSearchesRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListSavedSearchesRequest>): ListSavedSearchesRequest {
+    return ListSavedSearchesRequest.fromPartial(base ?? {});
+  },
+  fromPartial(_: DeepPartial<ListSavedSearchesRequest>): ListSavedSearchesRequest {
+    const message = createBaseListSavedSearchesRequest();
+    return message;
+  },
+};
+
+function createBaseListSavedSearchesResponse(): ListSavedSearchesResponse {
+  return { savedSearches: [] };
+}
+
+export const ListSavedSearchesResponse = {
+  encode(message: ListSavedSearchesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.savedSearches) {
+      SavedSearch.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListSavedSearchesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListSavedSearchesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.savedSearches.push(SavedSearch.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListSavedSearchesResponse {
+    return {
+      savedSearches: globalThis.Array.isArray(object?.savedSearches)
+        ? object.savedSearches.map((e: any) => SavedSearch.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListSavedSearchesResponse): unknown {
+    const obj: any = {};
+    if (message.savedSearches?.length) {
+      obj.savedSearches = message.savedSearches.map((e) => SavedSearch.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListSavedSearchesResponse>): ListSavedSearchesResponse {
+    return ListSavedSearchesResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListSavedSearchesResponse>): ListSavedSearchesResponse {
+    const message = createBaseListSavedSearchesResponse();
+    message.savedSearches = object.savedSearches?.map((e) => SavedSearch.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseUpdateSavedSearchRequest(): UpdateSavedSearchRequest {
+  return { savedSearch: undefined, updateMask: undefined };
+}
+
+export const UpdateSavedSearchRequest = {
+  encode(message: UpdateSavedSearchRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.savedSearch !== undefined) {
+      SavedSearch.encode(message.savedSearch, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.updateMask !== undefined) {
+      FieldMask.encode(FieldMask.wrap(message.updateMask), writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): UpdateSavedSearchRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseUpdateSavedSearchRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.savedSearch = SavedSearch.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.updateMask = FieldMask.unwrap(FieldMask.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): UpdateSavedSearchRequest {
+    return {
+      savedSearch: isSet(object.savedSearch) ? SavedSearch.fromJSON(object.savedSearch) : undefined,
+      updateMask: isSet(object.updateMask) ? FieldMask.unwrap(FieldMask.fromJSON(object.updateMask)) : undefined,
+    };
+  },
+
+  toJSON(message: UpdateSavedSearchRequest): unknown {
+    const obj: any = {};
+    if (message.savedSearch !== undefined) {
+      obj.savedSearch = SavedSearch.toJSON(message.savedSearch);
+    }
+    if (message.updateMask !== undefined) {
+      obj.updateMask = FieldMask.toJSON(FieldMask.wrap(message.updateMask));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<UpdateSavedSearchRequest>): UpdateSavedSearchRequest {
+    return UpdateSavedSearchRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<UpdateSavedSearchRequest>): UpdateSavedSearchRequest {
+    const message = createBaseUpdateSavedSearchRequest();
+    message.savedSearch = (object.savedSearch !== undefined && object.savedSearch !== null)
+      ? SavedSearch.fromPartial(object.savedSearch)
+      : undefined;
+    message.updateMask = object.updateMask ?? undefined;
+    return message;
+  },
+};
+
+function createBaseDeleteSavedSearchRequest(): DeleteSavedSearchRequest {
+  return { name: "" };
+}
+
+export const DeleteSavedSearchRequest = {
+  encode(message: DeleteSavedSearchRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DeleteSavedSearchRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDeleteSavedSearchRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DeleteSavedSearchRequest {
+    return { name: isSet(object.name) ? globalThis.String(object.name) : "" };
+  },
+
+  toJSON(message: DeleteSavedSearchRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DeleteSavedSearchRequest>): DeleteSavedSearchRequest {
+    return DeleteSavedSearchRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DeleteSavedSearchRequest>): DeleteSavedSearchRequest {
+    const message = createBaseDeleteSavedSearchRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseSavedSearch(): SavedSearch {
+  return { name: "", title: "", filter: "", owner: "", project: "", createTime: undefined, updateTime: undefined };
+}
+
+export const SavedSearch = {
+  encode(message: SavedSearch, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.filter !== "") {
+      writer.uint32(26).string(message.filter);
+    }
+    if (message.owner !== "") {
+      writer.uint32(34).string(message.owner);
+    }
+    if (message.project !== "") {
+      writer.uint32(42).string(message.project);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(58).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SavedSearch {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSavedSearch();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.filter = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.owner = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SavedSearch {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+      owner: isSet(object.owner) ? globalThis.String(object.owner) : "",
+      project: isSet(object.project) ? globalThis.String(object.project) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
+
+  toJSON(message: SavedSearch): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.filter !== "") {
+      obj.filter = message.filter;
+    }
+    if (message.owner !== "") {
+      obj.owner = message.owner;
+    }
+    if (message.project !== "") {
+      obj.project = message.project;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.updateTime !== undefined) {
+      obj.updateTime = message.updateTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SavedSearch>): SavedSearch {
+    return SavedSearch.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SavedSearch>): SavedSearch {
+    const message = createBaseSavedSearch();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.filter = object.filter ?? "";
+    message.owner = object.owner ?? "";
+    message.project = object.project ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
+export type SavedSearchServiceDefinition = typeof SavedSearchServiceDefinition;
+export const SavedSearchServiceDefinition = {
+  name: "SavedSearchService",
+  fullName: "bytebase.v1.SavedSearchService",
+  methods: {
+    /** Create a saved issue search for the current user. */
+    createSavedSearch: {
+      name: "CreateSavedSearch",
+      requestType: CreateSavedSearchRequest,
+      requestStream: false,
+      responseType: SavedSearch,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([12, 115, 97, 118, 101, 100, 95, 115, 101, 97, 114, 99, 104])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              33,
+              58,
+              12,
+              115,
+              97,
+              118,
+              101,
+              100,
+              95,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+              34,
+              17,
+              47,
+              118,
+              49,
+              47,
+              115,
+              97,
+              118,
+              101,
+              100,
+              83,
+              101,
+              97,
+              114,
+              99,
+              104,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * Get a saved search by name.
+     * The users can access this method if,
+     * - they are the owner of the saved search;
+     * - the saved search is shared with the project, and they have bb.projects.get permission on the project.
+     */
+    getSavedSearch: {
+      name: "GetSavedSearch",
+      requestType: GetSavedSearchRequest,
+      requestStream: false,
+      responseType: SavedSearch,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              28,
+              18,
+              26,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              115,
+              97,
+              118,
+              101,
+              100,
+              83,
+              101,
+              97,
+              114,
+              99,
+              104,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /** List the saved searches owned by the current user or shared with the projects of the current user. */
+    listSavedSearches: {
+      name: "ListSavedSearches",
+      requestType: ListSavedSearchesRequest,
+      requestStream: false,
+      responseType: ListSavedSearchesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([19, 18, 17, 47, 118, 49, 47, 115, 97, 118, 101, 100, 83, 101, 97, 114, 99, 104, 101, 115]),
+          ],
+        },
+      },
+    },
+    /**
+     * Update a saved search.
+     * Only the owner of the saved search can update it.
+     */
+    updateSavedSearch: {
+      name: "UpdateSavedSearch",
+      requestType: UpdateSavedSearchRequest,
+      requestStream: false,
+      responseType: SavedSearch,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [
+            new Uint8Array([
+              24,
+              115,
+              97,
+              118,
+              101,
+              100,
+              95,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+              44,
+              117,
+              112,
+              100,
+              97,
+              116,
+              101,
+              95,
+              109,
+              97,
+              115,
+              107,
+            ]),
+          ],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              55,
+              58,
+              12,
+              115,
+              97,
+              118,
+              101,
+              100,
+              95,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+              50,
+              39,
+              47,
+              118,
+              49,
+              47,
+              123,
+              115,
+              97,
+              118,
+              101,
+              100,
+              95,
+              115,
+              101,
+              97,
+              114,
+              99,
+              104,
+              46,
+              110,
+              97,
+              109,
+              101,
+              61,
+              115,
+              97,
+              118,
+              101,
+              100,
+              83,
+              101,
+              97,
+              114,
+              99,
+              104,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * Delete a saved search.
+     * Only the owner of the saved search can delete it.
+     */
+    deleteSavedSearch: {
+      name: "DeleteSavedSearch",
+      requestType: DeleteSavedSearchRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              28,
+              42,
+              26,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              115,
+              97,
+              118,
+              101,
+              100,
+              83,
+              101,
+              97,
+              114,
+              99,
+              104,
+              101,
+              115,
+              47,
+              42,
+              125,
+            ]),
+          ],
+        },
+      },
+    },
+  },
+} as const;
+
+type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
+
+export type DeepPartial<T> = T extends Builtin ? T
+  : T extends Long ? string | number | Long : T extends globalThis.Array<infer U> ? globalThis.Array<DeepPartial<U>>
+  : T extends ReadonlyArray<infer U> ? ReadonlyArray<DeepPartial<U>>
+  : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
+  : Partial<T>;
+
+function toTimestamp(date: Date): Timestamp {
+  const seconds = numberToLong(date.getTime() / 1_000);
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds.toNumber() || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new globalThis.Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof globalThis.Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new globalThis.Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
+function numberToLong(number: number) {
+  return Long.fromNumber(number);
+}
+
+if (_m0.util.Long !== Long) {
+  _m0.util.Long = Long as any;
+  _m0.configure();
+}
+
+function isSet(value: any): boolean {
+  return value !== null && value !== undefined;
+}
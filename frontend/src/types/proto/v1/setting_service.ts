@@ -6,7 +6,8 @@ import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 import { Expr } from "../google/type/expr";
 import { Engine, engineFromJSON, engineToJSON, engineToNumber } from "./common";
-import { ColumnConfig, ColumnMetadata, TableConfig, TableMetadata } from "./database_service";
+import { BackupStorage, ColumnConfig, ColumnMetadata, TableConfig, TableMetadata } from "./database_service";
+import { DataSourceExternalSecret } from "./instance_service";
 import { ApprovalTemplate } from "./issue_service";
 import { PlanType, planTypeFromJSON, planTypeToJSON, planTypeToNumber } from "./subscription_service";
 
@@ -153,6 +154,12 @@ export interface Value {
   semanticTypeSettingValue?: SemanticTypeSetting | undefined;
   maskingAlgorithmSettingValue?: MaskingAlgorithmSetting | undefined;
   maximumSqlResultSizeSetting?: MaximumSQLResultSizeSetting | undefined;
+  sheetStorageSettingValue?: SheetStorageSetting | undefined;
+  planCheckSettingValue?: PlanCheckSetting | undefined;
+  eventBusSettingValue?: EventBusSetting | undefined;
+  rateLimitSettingValue?: RateLimitSetting | undefined;
+  readAuditSettingValue?: ReadAuditSetting | undefined;
+  aiSettingValue?: AISetting | undefined;
 }
 
 export interface SMTPMailDeliverySettingValue {
@@ -325,11 +332,17 @@ export interface AppIMSetting {
   slack: AppIMSetting_Slack | undefined;
   feishu: AppIMSetting_Feishu | undefined;
   wecom: AppIMSetting_Wecom | undefined;
+  teams: AppIMSetting_Teams | undefined;
 }
 
 export interface AppIMSetting_Slack {
   enabled: boolean;
   token: string;
+  /**
+   * signing_secret verifies the slash commands sent by the Slack app.
+   * The slash command request URL is {external_url}/hook/chatops/slack.
+   */
+  signingSecret: string;
 }
 
 export interface AppIMSetting_Feishu {
@@ -345,6 +358,23 @@ export interface AppIMSetting_Wecom {
   secret: string;
 }
 
+/**
+ * Teams is only used for the ChatOps commands sent by the Teams outgoing webhook.
+ * The outgoing webhook callback URL is {external_url}/hook/chatops/teams.
+ */
+export interface AppIMSetting_Teams {
+  enabled: boolean;
+  /** security_token is the base64 encoded HMAC key of the outgoing webhook. */
+  securityToken: string;
+  /**
+   * The Microsoft Entra application to look up the emails of the Teams users by Microsoft Graph.
+   * The application requires the User.Read.All application permission.
+   */
+  tenantId: string;
+  clientId: string;
+  clientSecret: string;
+}
+
 export interface AgentPluginSetting {
   /** The URL for the agent API. */
   url: string;
@@ -387,6 +417,191 @@ export interface WorkspaceProfileSetting {
   enforceIdentityDomain: boolean;
   /** The workspace database change mode. */
   databaseChangeMode: DatabaseChangeMode;
+  /**
+   * The retention of the database schema snapshots.
+   * The default retention is 90 days if it's not set.
+   */
+  schemaSnapshotRetention:
+    | Duration
+    | undefined;
+  /**
+   * The bearer token for the SCIM directory sync.
+   * The SCIM endpoint is {external_url}/scim/v2.
+   */
+  directorySyncToken: string;
+  /** The login security policy, the session lifetime is the token_duration. */
+  loginSecurity:
+    | LoginSecurity
+    | undefined;
+  /**
+   * The retention of the SQL Editor query histories.
+   * The query histories are kept forever if it's not set.
+   */
+  queryHistoryRetention:
+    | Duration
+    | undefined;
+  /** Require the justification to start an admin execute session in the SQL Editor admin mode. */
+  requireAdminExecuteJustification: boolean;
+  /**
+   * The queries running longer than the threshold are reported as the long-running query anomalies.
+   * The queries blocking other queries are reported regardless of the threshold.
+   * The detection is disabled if it's not set.
+   */
+  longRunningQueryThreshold:
+    | Duration
+    | undefined;
+  /**
+   * The retention of the issues. The done and canceled issues created earlier than the retention
+   * are hard deleted with their comments, plans, rollouts and sheets.
+   * The issues are kept forever if it's not set.
+   */
+  issueRetention:
+    | Duration
+    | undefined;
+  /**
+   * The retention of the task run logs. The logs of the task runs finished earlier than the retention are deleted.
+   * The logs are kept forever if it's not set.
+   */
+  taskRunLogRetention: Duration | undefined;
+}
+
+export interface LoginSecurity {
+  /**
+   * The IP addresses or CIDR ranges allowed to sign in, e.g. 10.0.0.0/8.
+   * Empty means all IP addresses are allowed.
+   */
+  ipAllowlist: string[];
+  /**
+   * The IP addresses or CIDR ranges denied to sign in.
+   * The denylist takes precedence over the allowlist.
+   */
+  ipDenylist: string[];
+  /**
+   * The maximum number of concurrent sessions of a user.
+   * The oldest sessions are signed out when a new session exceeds the limit.
+   * 0 means no limit.
+   */
+  maxConcurrentSessions: number;
+  /**
+   * The user must sign in again before calling the sensitive methods, e.g. approving issues
+   * and admin execute, if the user signed in earlier than the reauth interval.
+   * Empty means no re-authentication.
+   */
+  reauthInterval:
+    | Duration
+    | undefined;
+  /**
+   * The user is locked out for the lockout_duration after max_failed_attempts consecutive failed sign-in attempts.
+   * 0 means no lockout.
+   */
+  maxFailedAttempts: number;
+  /** Empty means 30 minutes. */
+  lockoutDuration:
+    | Duration
+    | undefined;
+  /**
+   * The webhooks to alert on failed sign-ins, lockouts, new device sign-ins and
+   * service account token usage from new IP addresses.
+   */
+  alertWebhooks: LoginSecurity_AlertWebhook[];
+}
+
+export interface LoginSecurity_AlertWebhook {
+  type: LoginSecurity_AlertWebhook_Type;
+  url: string;
+}
+
+export enum LoginSecurity_AlertWebhook_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  SLACK = "SLACK",
+  DISCORD = "DISCORD",
+  TEAMS = "TEAMS",
+  DINGTALK = "DINGTALK",
+  FEISHU = "FEISHU",
+  WECOM = "WECOM",
+  CUSTOM = "CUSTOM",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function loginSecurity_AlertWebhook_TypeFromJSON(object: any): LoginSecurity_AlertWebhook_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "SLACK":
+      return LoginSecurity_AlertWebhook_Type.SLACK;
+    case 2:
+    case "DISCORD":
+      return LoginSecurity_AlertWebhook_Type.DISCORD;
+    case 3:
+    case "TEAMS":
+      return LoginSecurity_AlertWebhook_Type.TEAMS;
+    case 4:
+    case "DINGTALK":
+      return LoginSecurity_AlertWebhook_Type.DINGTALK;
+    case 5:
+    case "FEISHU":
+      return LoginSecurity_AlertWebhook_Type.FEISHU;
+    case 6:
+    case "WECOM":
+      return LoginSecurity_AlertWebhook_Type.WECOM;
+    case 7:
+    case "CUSTOM":
+      return LoginSecurity_AlertWebhook_Type.CUSTOM;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return LoginSecurity_AlertWebhook_Type.UNRECOGNIZED;
+  }
+}
+
+export function loginSecurity_AlertWebhook_TypeToJSON(object: LoginSecurity_AlertWebhook_Type): string {
+  switch (object) {
+    case LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case LoginSecurity_AlertWebhook_Type.SLACK:
+      return "SLACK";
+    case LoginSecurity_AlertWebhook_Type.DISCORD:
+      return "DISCORD";
+    case LoginSecurity_AlertWebhook_Type.TEAMS:
+      return "TEAMS";
+    case LoginSecurity_AlertWebhook_Type.DINGTALK:
+      return "DINGTALK";
+    case LoginSecurity_AlertWebhook_Type.FEISHU:
+      return "FEISHU";
+    case LoginSecurity_AlertWebhook_Type.WECOM:
+      return "WECOM";
+    case LoginSecurity_AlertWebhook_Type.CUSTOM:
+      return "CUSTOM";
+    case LoginSecurity_AlertWebhook_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function loginSecurity_AlertWebhook_TypeToNumber(object: LoginSecurity_AlertWebhook_Type): number {
+  switch (object) {
+    case LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case LoginSecurity_AlertWebhook_Type.SLACK:
+      return 1;
+    case LoginSecurity_AlertWebhook_Type.DISCORD:
+      return 2;
+    case LoginSecurity_AlertWebhook_Type.TEAMS:
+      return 3;
+    case LoginSecurity_AlertWebhook_Type.DINGTALK:
+      return 4;
+    case LoginSecurity_AlertWebhook_Type.FEISHU:
+      return 5;
+    case LoginSecurity_AlertWebhook_Type.WECOM:
+      return 6;
+    case LoginSecurity_AlertWebhook_Type.CUSTOM:
+      return 7;
+    case LoginSecurity_AlertWebhook_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
 }
 
 export interface Announcement {
@@ -483,6 +698,29 @@ export interface ExternalApprovalSetting_Node {
   title: string;
   /** The external endpoint for the relay service, e.g. "http://hello:1234". */
   endpoint: string;
+  /**
+   * The type of the approval provider, e.g. "relay". Empty means the relay provider.
+   * The in-house providers are registered in the approval plugin.
+   */
+  provider: string;
+  /** The headers sent with the requests to the relay service. */
+  headers: ExternalApprovalSetting_Node_Header[];
+  /**
+   * The Go text/template rendering the body of the request creating the external approval, it must render a JSON object.
+   * Empty means the default payload.
+   */
+  payloadTemplate: string;
+}
+
+export interface ExternalApprovalSetting_Node_Header {
+  key: string;
+  /**
+   * The value of the header, e.g. the authorization token.
+   * It's never returned, the current value of the header with the same key is kept if it's empty.
+   */
+  value: string;
+  /** The external secret manager storing the value, e.g. Vault. */
+  externalSecret: DataSourceExternalSecret | undefined;
 }
 
 export interface SchemaTemplateSetting {
@@ -597,16 +835,20 @@ export interface MaskingAlgorithmSetting_Algorithm {
   /** description is the description for masking algorithm. */
   description: string;
   /**
-   * Category is the category for masking algorithm. Currently, it accepts 2 categories only: MASK and HASH.
+   * Category is the category for masking algorithm. Currently, it accepts 3 categories only: MASK, HASH and ENCRYPT.
    * The range of accepted Payload is decided by the category.
-   * MASK: FullMask, RangeMask
-   * HASH: MD5Mask
+   * MASK: FullMask, RangeMask, InnerOuterMask, DateShiftMask
+   * HASH: MD5Mask, HashMask
+   * ENCRYPT: FormatPreservingEncryptionMask
    */
   category: string;
   fullMask?: MaskingAlgorithmSetting_Algorithm_FullMask | undefined;
   rangeMask?: MaskingAlgorithmSetting_Algorithm_RangeMask | undefined;
   md5Mask?: MaskingAlgorithmSetting_Algorithm_MD5Mask | undefined;
   innerOuterMask?: MaskingAlgorithmSetting_Algorithm_InnerOuterMask | undefined;
+  hashMask?: MaskingAlgorithmSetting_Algorithm_HashMask | undefined;
+  formatPreservingEncryptionMask?: MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask | undefined;
+  dateShiftMask?: MaskingAlgorithmSetting_Algorithm_DateShiftMask | undefined;
 }
 
 export interface MaskingAlgorithmSetting_Algorithm_FullMask {
@@ -705,6 +947,38 @@ export function maskingAlgorithmSetting_Algorithm_InnerOuterMask_MaskTypeToNumbe
   }
 }
 
+/**
+ * HashMask replaces the value with its salted SHA-256 hash in hex.
+ * The same value always has the same hash, so the masked columns can still be joined.
+ */
+export interface MaskingAlgorithmSetting_Algorithm_HashMask {
+  /** salt is the secret mixed into the hash, the columns to join should use the same salt. */
+  salt: string;
+}
+
+/**
+ * FormatPreservingEncryptionMask encrypts the digits and the letters with a Feistel cipher keyed by the key.
+ * The length, the character classes and the positions of the other characters are preserved,
+ * e.g. "415-555-0134" may become "902-113-7751".
+ */
+export interface MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask {
+  /** key is the secret key, it must be at least 16 bytes. */
+  key: string;
+  /** tweak is the optional public value to derive a different cipher from the same key. */
+  tweak: string;
+}
+
+/**
+ * DateShiftMask shifts the dates and the timestamps by a fixed number of days derived from the salt.
+ * The intervals between the values of the column are preserved.
+ */
+export interface MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+  /** max_shift_days is the maximum number of days to shift in either direction, it must be positive. */
+  maxShiftDays: number;
+  /** salt is the secret to derive the number of days to shift. */
+  salt: string;
+}
+
 export interface MaximumSQLResultSizeSetting {
   /**
    * The limit is in bytes.
@@ -713,6 +987,319 @@ export interface MaximumSQLResultSizeSetting {
   limit: Long;
 }
 
+export interface SheetStorageSetting {
+  /** The object storage of the large sheets. The sheets are stored in the metadata database if it's unset. */
+  storage:
+    | BackupStorage
+    | undefined;
+  /**
+   * The sheets larger than the threshold in bytes are stored in the object storage.
+   * The default value is 8MB, we will use the default value if the threshold <= 0.
+   */
+  externalThreshold: Long;
+  /**
+   * The plans containing sheets larger than the size in bytes get a warning on creation.
+   * The default value is 1MB, we will use the default value if the size <= 0.
+   */
+  warningSize: Long;
+}
+
+export interface PlanCheckSetting {
+  /**
+   * The maximum number of the plan checks running concurrently on an instance.
+   * The plan checks are only limited by the maximum connections of the instance if it's <= 0.
+   */
+  maxConcurrentPerInstance: number;
+}
+
+/**
+ * EventBusSetting is the setting of mirroring the workspace events to the event buses.
+ * The events are published as the protobuf encoded bytebase.v1.WorkspaceEvent with at-least-once delivery.
+ */
+export interface EventBusSetting {
+  destinations: EventBusSetting_Destination[];
+}
+
+export interface EventBusSetting_Destination {
+  /** The unique id of the destination. */
+  id: string;
+  type: EventBusSetting_Destination_Type;
+  /**
+   * The comma separated bootstrap brokers for Kafka, e.g. host1:9092,host2:9092,
+   * the server URL for NATS, e.g. nats://host:4222,
+   * or the Google Cloud project id for Pub/Sub.
+   */
+  address: string;
+  /**
+   * The topic for Kafka and Pub/Sub, or the subject for NATS.
+   * The NATS subject must be bound to a JetStream stream for the acknowledged delivery.
+   */
+  topic: string;
+  /** The SASL/PLAIN username for Kafka, or the user for NATS. */
+  username: string;
+  /**
+   * The SASL/PLAIN password for Kafka, the password or the token for NATS,
+   * or the service account key JSON for Pub/Sub.
+   * It's input only, the password is kept if it's empty and the destination type, address and username are unchanged.
+   */
+  password: string;
+}
+
+export enum EventBusSetting_Destination_Type {
+  TYPE_UNSPECIFIED = "TYPE_UNSPECIFIED",
+  KAFKA = "KAFKA",
+  NATS = "NATS",
+  PUBSUB = "PUBSUB",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function eventBusSetting_Destination_TypeFromJSON(object: any): EventBusSetting_Destination_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return EventBusSetting_Destination_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "KAFKA":
+      return EventBusSetting_Destination_Type.KAFKA;
+    case 2:
+    case "NATS":
+      return EventBusSetting_Destination_Type.NATS;
+    case 3:
+    case "PUBSUB":
+      return EventBusSetting_Destination_Type.PUBSUB;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return EventBusSetting_Destination_Type.UNRECOGNIZED;
+  }
+}
+
+export function eventBusSetting_Destination_TypeToJSON(object: EventBusSetting_Destination_Type): string {
+  switch (object) {
+    case EventBusSetting_Destination_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case EventBusSetting_Destination_Type.KAFKA:
+      return "KAFKA";
+    case EventBusSetting_Destination_Type.NATS:
+      return "NATS";
+    case EventBusSetting_Destination_Type.PUBSUB:
+      return "PUBSUB";
+    case EventBusSetting_Destination_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function eventBusSetting_Destination_TypeToNumber(object: EventBusSetting_Destination_Type): number {
+  switch (object) {
+    case EventBusSetting_Destination_Type.TYPE_UNSPECIFIED:
+      return 0;
+    case EventBusSetting_Destination_Type.KAFKA:
+      return 1;
+    case EventBusSetting_Destination_Type.NATS:
+      return 2;
+    case EventBusSetting_Destination_Type.PUBSUB:
+      return 3;
+    case EventBusSetting_Destination_Type.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/**
+ * RateLimitSetting is the setting of limiting the API request rate of the principals.
+ * The requests exceeding the limits fail with RESOURCE_EXHAUSTED and the retry-after metadata in seconds,
+ * which is the Retry-After header for the HTTP requests.
+ */
+export interface RateLimitSetting {
+  /** The limits of the end users. The method classes without limits are unlimited. */
+  userLimits: RateLimitSetting_Limit[];
+  /** The limits of the service accounts. The method classes without limits are unlimited. */
+  serviceAccountLimits: RateLimitSetting_Limit[];
+  overrides: RateLimitSetting_Override[];
+}
+
+export enum RateLimitSetting_MethodClass {
+  METHOD_CLASS_UNSPECIFIED = "METHOD_CLASS_UNSPECIFIED",
+  /** READ - The methods reading the resources, e.g. GetIssue, ListIssues and SearchIssues. */
+  READ = "READ",
+  /** WRITE - The other methods. */
+  WRITE = "WRITE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function rateLimitSetting_MethodClassFromJSON(object: any): RateLimitSetting_MethodClass {
+  switch (object) {
+    case 0:
+    case "METHOD_CLASS_UNSPECIFIED":
+      return RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED;
+    case 1:
+    case "READ":
+      return RateLimitSetting_MethodClass.READ;
+    case 2:
+    case "WRITE":
+      return RateLimitSetting_MethodClass.WRITE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return RateLimitSetting_MethodClass.UNRECOGNIZED;
+  }
+}
+
+export function rateLimitSetting_MethodClassToJSON(object: RateLimitSetting_MethodClass): string {
+  switch (object) {
+    case RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED:
+      return "METHOD_CLASS_UNSPECIFIED";
+    case RateLimitSetting_MethodClass.READ:
+      return "READ";
+    case RateLimitSetting_MethodClass.WRITE:
+      return "WRITE";
+    case RateLimitSetting_MethodClass.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function rateLimitSetting_MethodClassToNumber(object: RateLimitSetting_MethodClass): number {
+  switch (object) {
+    case RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED:
+      return 0;
+    case RateLimitSetting_MethodClass.READ:
+      return 1;
+    case RateLimitSetting_MethodClass.WRITE:
+      return 2;
+    case RateLimitSetting_MethodClass.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+/** Limit is the token bucket limit of a principal for a method class. */
+export interface RateLimitSetting_Limit {
+  methodClass: RateLimitSetting_MethodClass;
+  /** The sustained number of the requests per second. */
+  rate: number;
+  /** The maximum number of the requests in a burst. The rate rounded up is used if it's <= 0. */
+  burst: number;
+}
+
+/** Override is the limits of a principal overriding the user and service account limits. */
+export interface RateLimitSetting_Override {
+  /** Format: users/{email} */
+  principal: string;
+  limits: RateLimitSetting_Limit[];
+}
+
+/**
+ * ReadAuditSetting is the setting of auditing the read requests to the sensitive projects.
+ * The audit logs of the read requests include the request, e.g. the filter or the statement, but not the response.
+ */
+export interface ReadAuditSetting {
+  /**
+   * The sensitive projects whose read requests are audited.
+   * Format: projects/{project}
+   */
+  projects: string[];
+  /**
+   * The full names of the audited read methods, e.g. /bytebase.v1.IssueService/GetIssue.
+   * The default methods are GetIssue, ListIssues, SearchIssues, Query and Export if it's empty.
+   */
+  methods: string[];
+  /**
+   * The fraction of the read requests audited, between 0 and 1.
+   * The default value is 1, we will use the default value if the rate <= 0.
+   */
+  sampleRate: number;
+}
+
+/** AISetting is the setting of the AI provider, which generates the SQL in the SQL Editor and reviews the plan statements. */
+export interface AISetting {
+  /** No data is sent to the provider if it's disabled. */
+  enabled: boolean;
+  provider: AISetting_Provider;
+  /**
+   * The endpoint of the provider, e.g. https://{resource}.openai.azure.com for AZURE_OPENAI.
+   * It's required for AZURE_OPENAI and OPENAI_COMPATIBLE.
+   */
+  endpoint: string;
+  /**
+   * The API key of the provider.
+   * It's input only, the key is kept if it's empty and the provider and endpoint are unchanged.
+   */
+  apiKey: string;
+  /** The model for OPENAI and OPENAI_COMPATIBLE, e.g. gpt-4o, or the deployment name for AZURE_OPENAI. */
+  model: string;
+  /** The API version for AZURE_OPENAI, e.g. 2024-02-01. */
+  apiVersion: string;
+  /**
+   * Whether to summarize the plan statements and flag the risky patterns when the approval finding completes.
+   * The review is attached to the issue as a comment.
+   */
+  reviewEnabled: boolean;
+}
+
+export enum AISetting_Provider {
+  PROVIDER_UNSPECIFIED = "PROVIDER_UNSPECIFIED",
+  OPENAI = "OPENAI",
+  AZURE_OPENAI = "AZURE_OPENAI",
+  /** OPENAI_COMPATIBLE - The self-hosted endpoint compatible with the OpenAI chat completions API. */
+  OPENAI_COMPATIBLE = "OPENAI_COMPATIBLE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function aISetting_ProviderFromJSON(object: any): AISetting_Provider {
+  switch (object) {
+    case 0:
+    case "PROVIDER_UNSPECIFIED":
+      return AISetting_Provider.PROVIDER_UNSPECIFIED;
+    case 1:
+    case "OPENAI":
+      return AISetting_Provider.OPENAI;
+    case 2:
+    case "AZURE_OPENAI":
+      return AISetting_Provider.AZURE_OPENAI;
+    case 3:
+    case "OPENAI_COMPATIBLE":
+      return AISetting_Provider.OPENAI_COMPATIBLE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return AISetting_Provider.UNRECOGNIZED;
+  }
+}
+
+export function aISetting_ProviderToJSON(object: AISetting_Provider): string {
+  switch (object) {
+    case AISetting_Provider.PROVIDER_UNSPECIFIED:
+      return "PROVIDER_UNSPECIFIED";
+    case AISetting_Provider.OPENAI:
+      return "OPENAI";
+    case AISetting_Provider.AZURE_OPENAI:
+      return "AZURE_OPENAI";
+    case AISetting_Provider.OPENAI_COMPATIBLE:
+      return "OPENAI_COMPATIBLE";
+    case AISetting_Provider.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function aISetting_ProviderToNumber(object: AISetting_Provider): number {
+  switch (object) {
+    case AISetting_Provider.PROVIDER_UNSPECIFIED:
+      return 0;
+    case AISetting_Provider.OPENAI:
+      return 1;
+    case AISetting_Provider.AZURE_OPENAI:
+      return 2;
+    case AISetting_Provider.OPENAI_COMPATIBLE:
+      return 3;
+    case AISetting_Provider.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
 function createBaseListSettingsRequest(): ListSettingsRequest {
   return { pageSize: 0, pageToken: "" };
 }
@@ -1172,6 +1759,12 @@ function createBaseValue(): Value {
     semanticTypeSettingValue: undefined,
     maskingAlgorithmSettingValue: undefined,
     maximumSqlResultSizeSetting: undefined,
+    sheetStorageSettingValue: undefined,
+    planCheckSettingValue: undefined,
+    eventBusSettingValue: undefined,
+    rateLimitSettingValue: undefined,
+    readAuditSettingValue: undefined,
+    aiSettingValue: undefined,
   };
 }
 
@@ -1216,6 +1809,24 @@ export const Value = {
     if (message.maximumSqlResultSizeSetting !== undefined) {
       MaximumSQLResultSizeSetting.encode(message.maximumSqlResultSizeSetting, writer.uint32(106).fork()).ldelim();
     }
+    if (message.sheetStorageSettingValue !== undefined) {
+      SheetStorageSetting.encode(message.sheetStorageSettingValue, writer.uint32(114).fork()).ldelim();
+    }
+    if (message.planCheckSettingValue !== undefined) {
+      PlanCheckSetting.encode(message.planCheckSettingValue, writer.uint32(122).fork()).ldelim();
+    }
+    if (message.eventBusSettingValue !== undefined) {
+      EventBusSetting.encode(message.eventBusSettingValue, writer.uint32(130).fork()).ldelim();
+    }
+    if (message.rateLimitSettingValue !== undefined) {
+      RateLimitSetting.encode(message.rateLimitSettingValue, writer.uint32(138).fork()).ldelim();
+    }
+    if (message.readAuditSettingValue !== undefined) {
+      ReadAuditSetting.encode(message.readAuditSettingValue, writer.uint32(146).fork()).ldelim();
+    }
+    if (message.aiSettingValue !== undefined) {
+      AISetting.encode(message.aiSettingValue, writer.uint32(154).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1317,21 +1928,63 @@ export const Value = {
 
           message.maximumSqlResultSizeSetting = MaximumSQLResultSizeSetting.decode(reader, reader.uint32());
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+        case 14:
+          if (tag !== 114) {
+            break;
+          }
 
-  fromJSON(object: any): Value {
-    return {
-      stringValue: isSet(object.stringValue) ? globalThis.String(object.stringValue) : undefined,
-      smtpMailDeliverySettingValue: isSet(object.smtpMailDeliverySettingValue)
-        ? SMTPMailDeliverySettingValue.fromJSON(object.smtpMailDeliverySettingValue)
-        : undefined,
+          message.sheetStorageSettingValue = SheetStorageSetting.decode(reader, reader.uint32());
+          continue;
+        case 15:
+          if (tag !== 122) {
+            break;
+          }
+
+          message.planCheckSettingValue = PlanCheckSetting.decode(reader, reader.uint32());
+          continue;
+        case 16:
+          if (tag !== 130) {
+            break;
+          }
+
+          message.eventBusSettingValue = EventBusSetting.decode(reader, reader.uint32());
+          continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.rateLimitSettingValue = RateLimitSetting.decode(reader, reader.uint32());
+          continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.readAuditSettingValue = ReadAuditSetting.decode(reader, reader.uint32());
+          continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.aiSettingValue = AISetting.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Value {
+    return {
+      stringValue: isSet(object.stringValue) ? globalThis.String(object.stringValue) : undefined,
+      smtpMailDeliverySettingValue: isSet(object.smtpMailDeliverySettingValue)
+        ? SMTPMailDeliverySettingValue.fromJSON(object.smtpMailDeliverySettingValue)
+        : undefined,
       appImSettingValue: isSet(object.appImSettingValue) ? AppIMSetting.fromJSON(object.appImSettingValue) : undefined,
       agentPluginSettingValue: isSet(object.agentPluginSettingValue)
         ? AgentPluginSetting.fromJSON(object.agentPluginSettingValue)
@@ -1363,6 +2016,22 @@ export const Value = {
       maximumSqlResultSizeSetting: isSet(object.maximumSqlResultSizeSetting)
         ? MaximumSQLResultSizeSetting.fromJSON(object.maximumSqlResultSizeSetting)
         : undefined,
+      sheetStorageSettingValue: isSet(object.sheetStorageSettingValue)
+        ? SheetStorageSetting.fromJSON(object.sheetStorageSettingValue)
+        : undefined,
+      planCheckSettingValue: isSet(object.planCheckSettingValue)
+        ? PlanCheckSetting.fromJSON(object.planCheckSettingValue)
+        : undefined,
+      eventBusSettingValue: isSet(object.eventBusSettingValue)
+        ? EventBusSetting.fromJSON(object.eventBusSettingValue)
+        : undefined,
+      rateLimitSettingValue: isSet(object.rateLimitSettingValue)
+        ? RateLimitSetting.fromJSON(object.rateLimitSettingValue)
+        : undefined,
+      readAuditSettingValue: isSet(object.readAuditSettingValue)
+        ? ReadAuditSetting.fromJSON(object.readAuditSettingValue)
+        : undefined,
+      aiSettingValue: isSet(object.aiSettingValue) ? AISetting.fromJSON(object.aiSettingValue) : undefined,
     };
   },
 
@@ -1407,6 +2076,24 @@ export const Value = {
     if (message.maximumSqlResultSizeSetting !== undefined) {
       obj.maximumSqlResultSizeSetting = MaximumSQLResultSizeSetting.toJSON(message.maximumSqlResultSizeSetting);
     }
+    if (message.sheetStorageSettingValue !== undefined) {
+      obj.sheetStorageSettingValue = SheetStorageSetting.toJSON(message.sheetStorageSettingValue);
+    }
+    if (message.planCheckSettingValue !== undefined) {
+      obj.planCheckSettingValue = PlanCheckSetting.toJSON(message.planCheckSettingValue);
+    }
+    if (message.eventBusSettingValue !== undefined) {
+      obj.eventBusSettingValue = EventBusSetting.toJSON(message.eventBusSettingValue);
+    }
+    if (message.rateLimitSettingValue !== undefined) {
+      obj.rateLimitSettingValue = RateLimitSetting.toJSON(message.rateLimitSettingValue);
+    }
+    if (message.readAuditSettingValue !== undefined) {
+      obj.readAuditSettingValue = ReadAuditSetting.toJSON(message.readAuditSettingValue);
+    }
+    if (message.aiSettingValue !== undefined) {
+      obj.aiSettingValue = AISetting.toJSON(message.aiSettingValue);
+    }
     return obj;
   },
 
@@ -1463,6 +2150,28 @@ export const Value = {
       (object.maximumSqlResultSizeSetting !== undefined && object.maximumSqlResultSizeSetting !== null)
         ? MaximumSQLResultSizeSetting.fromPartial(object.maximumSqlResultSizeSetting)
         : undefined;
+    message.sheetStorageSettingValue =
+      (object.sheetStorageSettingValue !== undefined && object.sheetStorageSettingValue !== null)
+        ? SheetStorageSetting.fromPartial(object.sheetStorageSettingValue)
+        : undefined;
+    message.planCheckSettingValue =
+      (object.planCheckSettingValue !== undefined && object.planCheckSettingValue !== null)
+        ? PlanCheckSetting.fromPartial(object.planCheckSettingValue)
+        : undefined;
+    message.eventBusSettingValue = (object.eventBusSettingValue !== undefined && object.eventBusSettingValue !== null)
+      ? EventBusSetting.fromPartial(object.eventBusSettingValue)
+      : undefined;
+    message.rateLimitSettingValue =
+      (object.rateLimitSettingValue !== undefined && object.rateLimitSettingValue !== null)
+        ? RateLimitSetting.fromPartial(object.rateLimitSettingValue)
+        : undefined;
+    message.readAuditSettingValue =
+      (object.readAuditSettingValue !== undefined && object.readAuditSettingValue !== null)
+        ? ReadAuditSetting.fromPartial(object.readAuditSettingValue)
+        : undefined;
+    message.aiSettingValue = (object.aiSettingValue !== undefined && object.aiSettingValue !== null)
+      ? AISetting.fromPartial(object.aiSettingValue)
+      : undefined;
     return message;
   },
 };
@@ -1694,7 +2403,7 @@ export const SMTPMailDeliverySettingValue = {
 };
 
 function createBaseAppIMSetting(): AppIMSetting {
-  return { slack: undefined, feishu: undefined, wecom: undefined };
+  return { slack: undefined, feishu: undefined, wecom: undefined, teams: undefined };
 }
 
 export const AppIMSetting = {
@@ -1708,6 +2417,9 @@ export const AppIMSetting = {
     if (message.wecom !== undefined) {
       AppIMSetting_Wecom.encode(message.wecom, writer.uint32(26).fork()).ldelim();
     }
+    if (message.teams !== undefined) {
+      AppIMSetting_Teams.encode(message.teams, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1739,6 +2451,13 @@ export const AppIMSetting = {
 
           message.wecom = AppIMSetting_Wecom.decode(reader, reader.uint32());
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.teams = AppIMSetting_Teams.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1753,6 +2472,7 @@ export const AppIMSetting = {
       slack: isSet(object.slack) ? AppIMSetting_Slack.fromJSON(object.slack) : undefined,
       feishu: isSet(object.feishu) ? AppIMSetting_Feishu.fromJSON(object.feishu) : undefined,
       wecom: isSet(object.wecom) ? AppIMSetting_Wecom.fromJSON(object.wecom) : undefined,
+      teams: isSet(object.teams) ? AppIMSetting_Teams.fromJSON(object.teams) : undefined,
     };
   },
 
@@ -1767,6 +2487,9 @@ export const AppIMSetting = {
     if (message.wecom !== undefined) {
       obj.wecom = AppIMSetting_Wecom.toJSON(message.wecom);
     }
+    if (message.teams !== undefined) {
+      obj.teams = AppIMSetting_Teams.toJSON(message.teams);
+    }
     return obj;
   },
 
@@ -1784,12 +2507,15 @@ export const AppIMSetting = {
     message.wecom = (object.wecom !== undefined && object.wecom !== null)
       ? AppIMSetting_Wecom.fromPartial(object.wecom)
       : undefined;
+    message.teams = (object.teams !== undefined && object.teams !== null)
+      ? AppIMSetting_Teams.fromPartial(object.teams)
+      : undefined;
     return message;
   },
 };
 
 function createBaseAppIMSetting_Slack(): AppIMSetting_Slack {
-  return { enabled: false, token: "" };
+  return { enabled: false, token: "", signingSecret: "" };
 }
 
 export const AppIMSetting_Slack = {
@@ -1800,6 +2526,9 @@ export const AppIMSetting_Slack = {
     if (message.token !== "") {
       writer.uint32(18).string(message.token);
     }
+    if (message.signingSecret !== "") {
+      writer.uint32(26).string(message.signingSecret);
+    }
     return writer;
   },
 
@@ -1824,6 +2553,13 @@ export const AppIMSetting_Slack = {
 
           message.token = reader.string();
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.signingSecret = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1837,6 +2573,7 @@ export const AppIMSetting_Slack = {
     return {
       enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
       token: isSet(object.token) ? globalThis.String(object.token) : "",
+      signingSecret: isSet(object.signingSecret) ? globalThis.String(object.signingSecret) : "",
     };
   },
 
@@ -1848,6 +2585,9 @@ export const AppIMSetting_Slack = {
     if (message.token !== "") {
       obj.token = message.token;
     }
+    if (message.signingSecret !== "") {
+      obj.signingSecret = message.signingSecret;
+    }
     return obj;
   },
 
@@ -1858,6 +2598,7 @@ export const AppIMSetting_Slack = {
     const message = createBaseAppIMSetting_Slack();
     message.enabled = object.enabled ?? false;
     message.token = object.token ?? "";
+    message.signingSecret = object.signingSecret ?? "";
     return message;
   },
 };
@@ -2055,6 +2796,125 @@ export const AppIMSetting_Wecom = {
   },
 };
 
+function createBaseAppIMSetting_Teams(): AppIMSetting_Teams {
+  return { enabled: false, securityToken: "", tenantId: "", clientId: "", clientSecret: "" };
+}
+
+export const AppIMSetting_Teams = {
+  encode(message: AppIMSetting_Teams, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.securityToken !== "") {
+      writer.uint32(18).string(message.securityToken);
+    }
+    if (message.tenantId !== "") {
+      writer.uint32(26).string(message.tenantId);
+    }
+    if (message.clientId !== "") {
+      writer.uint32(34).string(message.clientId);
+    }
+    if (message.clientSecret !== "") {
+      writer.uint32(42).string(message.clientSecret);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AppIMSetting_Teams {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAppIMSetting_Teams();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.securityToken = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.tenantId = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.clientId = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.clientSecret = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AppIMSetting_Teams {
+    return {
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+      securityToken: isSet(object.securityToken) ? globalThis.String(object.securityToken) : "",
+      tenantId: isSet(object.tenantId) ? globalThis.String(object.tenantId) : "",
+      clientId: isSet(object.clientId) ? globalThis.String(object.clientId) : "",
+      clientSecret: isSet(object.clientSecret) ? globalThis.String(object.clientSecret) : "",
+    };
+  },
+
+  toJSON(message: AppIMSetting_Teams): unknown {
+    const obj: any = {};
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
+    }
+    if (message.securityToken !== "") {
+      obj.securityToken = message.securityToken;
+    }
+    if (message.tenantId !== "") {
+      obj.tenantId = message.tenantId;
+    }
+    if (message.clientId !== "") {
+      obj.clientId = message.clientId;
+    }
+    if (message.clientSecret !== "") {
+      obj.clientSecret = message.clientSecret;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AppIMSetting_Teams>): AppIMSetting_Teams {
+    return AppIMSetting_Teams.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AppIMSetting_Teams>): AppIMSetting_Teams {
+    const message = createBaseAppIMSetting_Teams();
+    message.enabled = object.enabled ?? false;
+    message.securityToken = object.securityToken ?? "";
+    message.tenantId = object.tenantId ?? "";
+    message.clientId = object.clientId ?? "";
+    message.clientSecret = object.clientSecret ?? "";
+    return message;
+  },
+};
+
 function createBaseAgentPluginSetting(): AgentPluginSetting {
   return { url: "", token: "" };
 }
@@ -2142,6 +3002,14 @@ function createBaseWorkspaceProfileSetting(): WorkspaceProfileSetting {
     domains: [],
     enforceIdentityDomain: false,
     databaseChangeMode: DatabaseChangeMode.DATABASE_CHANGE_MODE_UNSPECIFIED,
+    schemaSnapshotRetention: undefined,
+    directorySyncToken: "",
+    loginSecurity: undefined,
+    queryHistoryRetention: undefined,
+    requireAdminExecuteJustification: false,
+    longRunningQueryThreshold: undefined,
+    issueRetention: undefined,
+    taskRunLogRetention: undefined,
   };
 }
 
@@ -2180,6 +3048,30 @@ export const WorkspaceProfileSetting = {
     if (message.databaseChangeMode !== DatabaseChangeMode.DATABASE_CHANGE_MODE_UNSPECIFIED) {
       writer.uint32(88).int32(databaseChangeModeToNumber(message.databaseChangeMode));
     }
+    if (message.schemaSnapshotRetention !== undefined) {
+      Duration.encode(message.schemaSnapshotRetention, writer.uint32(98).fork()).ldelim();
+    }
+    if (message.directorySyncToken !== "") {
+      writer.uint32(106).string(message.directorySyncToken);
+    }
+    if (message.loginSecurity !== undefined) {
+      LoginSecurity.encode(message.loginSecurity, writer.uint32(114).fork()).ldelim();
+    }
+    if (message.queryHistoryRetention !== undefined) {
+      Duration.encode(message.queryHistoryRetention, writer.uint32(122).fork()).ldelim();
+    }
+    if (message.requireAdminExecuteJustification === true) {
+      writer.uint32(128).bool(message.requireAdminExecuteJustification);
+    }
+    if (message.longRunningQueryThreshold !== undefined) {
+      Duration.encode(message.longRunningQueryThreshold, writer.uint32(138).fork()).ldelim();
+    }
+    if (message.issueRetention !== undefined) {
+      Duration.encode(message.issueRetention, writer.uint32(146).fork()).ldelim();
+    }
+    if (message.taskRunLogRetention !== undefined) {
+      Duration.encode(message.taskRunLogRetention, writer.uint32(154).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2267,6 +3159,62 @@ export const WorkspaceProfileSetting = {
 
           message.databaseChangeMode = databaseChangeModeFromJSON(reader.int32());
           continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.schemaSnapshotRetention = Duration.decode(reader, reader.uint32());
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.directorySyncToken = reader.string();
+          continue;
+        case 14:
+          if (tag !== 114) {
+            break;
+          }
+
+          message.loginSecurity = LoginSecurity.decode(reader, reader.uint32());
+          continue;
+        case 15:
+          if (tag !== 122) {
+            break;
+          }
+
+          message.queryHistoryRetention = Duration.decode(reader, reader.uint32());
+          continue;
+        case 16:
+          if (tag !== 128) {
+            break;
+          }
+
+          message.requireAdminExecuteJustification = reader.bool();
+          continue;
+        case 17:
+          if (tag !== 138) {
+            break;
+          }
+
+          message.longRunningQueryThreshold = Duration.decode(reader, reader.uint32());
+          continue;
+        case 18:
+          if (tag !== 146) {
+            break;
+          }
+
+          message.issueRetention = Duration.decode(reader, reader.uint32());
+          continue;
+        case 19:
+          if (tag !== 154) {
+            break;
+          }
+
+          message.taskRunLogRetention = Duration.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2297,6 +3245,24 @@ export const WorkspaceProfileSetting = {
       databaseChangeMode: isSet(object.databaseChangeMode)
         ? databaseChangeModeFromJSON(object.databaseChangeMode)
         : DatabaseChangeMode.DATABASE_CHANGE_MODE_UNSPECIFIED,
+      schemaSnapshotRetention: isSet(object.schemaSnapshotRetention)
+        ? Duration.fromJSON(object.schemaSnapshotRetention)
+        : undefined,
+      directorySyncToken: isSet(object.directorySyncToken) ? globalThis.String(object.directorySyncToken) : "",
+      loginSecurity: isSet(object.loginSecurity) ? LoginSecurity.fromJSON(object.loginSecurity) : undefined,
+      queryHistoryRetention: isSet(object.queryHistoryRetention)
+        ? Duration.fromJSON(object.queryHistoryRetention)
+        : undefined,
+      requireAdminExecuteJustification: isSet(object.requireAdminExecuteJustification)
+        ? globalThis.Boolean(object.requireAdminExecuteJustification)
+        : false,
+      longRunningQueryThreshold: isSet(object.longRunningQueryThreshold)
+        ? Duration.fromJSON(object.longRunningQueryThreshold)
+        : undefined,
+      issueRetention: isSet(object.issueRetention) ? Duration.fromJSON(object.issueRetention) : undefined,
+      taskRunLogRetention: isSet(object.taskRunLogRetention)
+        ? Duration.fromJSON(object.taskRunLogRetention)
+        : undefined,
     };
   },
 
@@ -2335,6 +3301,30 @@ export const WorkspaceProfileSetting = {
     if (message.databaseChangeMode !== DatabaseChangeMode.DATABASE_CHANGE_MODE_UNSPECIFIED) {
       obj.databaseChangeMode = databaseChangeModeToJSON(message.databaseChangeMode);
     }
+    if (message.schemaSnapshotRetention !== undefined) {
+      obj.schemaSnapshotRetention = Duration.toJSON(message.schemaSnapshotRetention);
+    }
+    if (message.directorySyncToken !== "") {
+      obj.directorySyncToken = message.directorySyncToken;
+    }
+    if (message.loginSecurity !== undefined) {
+      obj.loginSecurity = LoginSecurity.toJSON(message.loginSecurity);
+    }
+    if (message.queryHistoryRetention !== undefined) {
+      obj.queryHistoryRetention = Duration.toJSON(message.queryHistoryRetention);
+    }
+    if (message.requireAdminExecuteJustification === true) {
+      obj.requireAdminExecuteJustification = message.requireAdminExecuteJustification;
+    }
+    if (message.longRunningQueryThreshold !== undefined) {
+      obj.longRunningQueryThreshold = Duration.toJSON(message.longRunningQueryThreshold);
+    }
+    if (message.issueRetention !== undefined) {
+      obj.issueRetention = Duration.toJSON(message.issueRetention);
+    }
+    if (message.taskRunLogRetention !== undefined) {
+      obj.taskRunLogRetention = Duration.toJSON(message.taskRunLogRetention);
+    }
     return obj;
   },
 
@@ -2361,72 +3351,338 @@ export const WorkspaceProfileSetting = {
     message.domains = object.domains?.map((e) => e) || [];
     message.enforceIdentityDomain = object.enforceIdentityDomain ?? false;
     message.databaseChangeMode = object.databaseChangeMode ?? DatabaseChangeMode.DATABASE_CHANGE_MODE_UNSPECIFIED;
+    message.schemaSnapshotRetention =
+      (object.schemaSnapshotRetention !== undefined && object.schemaSnapshotRetention !== null)
+        ? Duration.fromPartial(object.schemaSnapshotRetention)
+        : undefined;
+    message.directorySyncToken = object.directorySyncToken ?? "";
+    message.loginSecurity = (object.loginSecurity !== undefined && object.loginSecurity !== null)
+      ? LoginSecurity.fromPartial(object.loginSecurity)
+      : undefined;
+    message.queryHistoryRetention =
+      (object.queryHistoryRetention !== undefined && object.queryHistoryRetention !== null)
+        ? Duration.fromPartial(object.queryHistoryRetention)
+        : undefined;
+    message.requireAdminExecuteJustification = object.requireAdminExecuteJustification ?? false;
+    message.longRunningQueryThreshold =
+      (object.longRunningQueryThreshold !== undefined && object.longRunningQueryThreshold !== null)
+        ? Duration.fromPartial(object.longRunningQueryThreshold)
+        : undefined;
+    message.issueRetention = (object.issueRetention !== undefined && object.issueRetention !== null)
+      ? Duration.fromPartial(object.issueRetention)
+      : undefined;
+    message.taskRunLogRetention = (object.taskRunLogRetention !== undefined && object.taskRunLogRetention !== null)
+      ? Duration.fromPartial(object.taskRunLogRetention)
+      : undefined;
     return message;
   },
 };
 
-function createBaseAnnouncement(): Announcement {
-  return { level: Announcement_AlertLevel.ALERT_LEVEL_UNSPECIFIED, text: "", link: "" };
+function createBaseLoginSecurity(): LoginSecurity {
+  return {
+    ipAllowlist: [],
+    ipDenylist: [],
+    maxConcurrentSessions: 0,
+    reauthInterval: undefined,
+    maxFailedAttempts: 0,
+    lockoutDuration: undefined,
+    alertWebhooks: [],
+  };
 }
 
-export const Announcement = {
-  encode(message: Announcement, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.level !== Announcement_AlertLevel.ALERT_LEVEL_UNSPECIFIED) {
-      writer.uint32(8).int32(announcement_AlertLevelToNumber(message.level));
+export const LoginSecurity = {
+  encode(message: LoginSecurity, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.ipAllowlist) {
+      writer.uint32(10).string(v!);
     }
-    if (message.text !== "") {
-      writer.uint32(18).string(message.text);
+    for (const v of message.ipDenylist) {
+      writer.uint32(18).string(v!);
     }
-    if (message.link !== "") {
-      writer.uint32(26).string(message.link);
+    if (message.maxConcurrentSessions !== 0) {
+      writer.uint32(24).int32(message.maxConcurrentSessions);
+    }
+    if (message.reauthInterval !== undefined) {
+      Duration.encode(message.reauthInterval, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.maxFailedAttempts !== 0) {
+      writer.uint32(40).int32(message.maxFailedAttempts);
+    }
+    if (message.lockoutDuration !== undefined) {
+      Duration.encode(message.lockoutDuration, writer.uint32(50).fork()).ldelim();
+    }
+    for (const v of message.alertWebhooks) {
+      LoginSecurity_AlertWebhook.encode(v!, writer.uint32(58).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Announcement {
+  decode(input: _m0.Reader | Uint8Array, length?: number): LoginSecurity {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAnnouncement();
+    const message = createBaseLoginSecurity();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.level = announcement_AlertLevelFromJSON(reader.int32());
+          message.ipAllowlist.push(reader.string());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.text = reader.string();
+          message.ipDenylist.push(reader.string());
           continue;
         case 3:
-          if (tag !== 26) {
+          if (tag !== 24) {
             break;
           }
 
-          message.link = reader.string();
+          message.maxConcurrentSessions = reader.int32();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
 
-  fromJSON(object: any): Announcement {
-    return {
-      level: isSet(object.level)
-        ? announcement_AlertLevelFromJSON(object.level)
-        : Announcement_AlertLevel.ALERT_LEVEL_UNSPECIFIED,
-      text: isSet(object.text) ? globalThis.String(object.text) : "",
-      link: isSet(object.link) ? globalThis.String(object.link) : "",
+          message.reauthInterval = Duration.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.maxFailedAttempts = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.lockoutDuration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.alertWebhooks.push(LoginSecurity_AlertWebhook.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LoginSecurity {
+    return {
+      ipAllowlist: globalThis.Array.isArray(object?.ipAllowlist)
+        ? object.ipAllowlist.map((e: any) => globalThis.String(e))
+        : [],
+      ipDenylist: globalThis.Array.isArray(object?.ipDenylist)
+        ? object.ipDenylist.map((e: any) => globalThis.String(e))
+        : [],
+      maxConcurrentSessions: isSet(object.maxConcurrentSessions) ? globalThis.Number(object.maxConcurrentSessions) : 0,
+      reauthInterval: isSet(object.reauthInterval) ? Duration.fromJSON(object.reauthInterval) : undefined,
+      maxFailedAttempts: isSet(object.maxFailedAttempts) ? globalThis.Number(object.maxFailedAttempts) : 0,
+      lockoutDuration: isSet(object.lockoutDuration) ? Duration.fromJSON(object.lockoutDuration) : undefined,
+      alertWebhooks: globalThis.Array.isArray(object?.alertWebhooks)
+        ? object.alertWebhooks.map((e: any) => LoginSecurity_AlertWebhook.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: LoginSecurity): unknown {
+    const obj: any = {};
+    if (message.ipAllowlist?.length) {
+      obj.ipAllowlist = message.ipAllowlist;
+    }
+    if (message.ipDenylist?.length) {
+      obj.ipDenylist = message.ipDenylist;
+    }
+    if (message.maxConcurrentSessions !== 0) {
+      obj.maxConcurrentSessions = Math.round(message.maxConcurrentSessions);
+    }
+    if (message.reauthInterval !== undefined) {
+      obj.reauthInterval = Duration.toJSON(message.reauthInterval);
+    }
+    if (message.maxFailedAttempts !== 0) {
+      obj.maxFailedAttempts = Math.round(message.maxFailedAttempts);
+    }
+    if (message.lockoutDuration !== undefined) {
+      obj.lockoutDuration = Duration.toJSON(message.lockoutDuration);
+    }
+    if (message.alertWebhooks?.length) {
+      obj.alertWebhooks = message.alertWebhooks.map((e) => LoginSecurity_AlertWebhook.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LoginSecurity>): LoginSecurity {
+    return LoginSecurity.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LoginSecurity>): LoginSecurity {
+    const message = createBaseLoginSecurity();
+    message.ipAllowlist = object.ipAllowlist?.map((e) => e) || [];
+    message.ipDenylist = object.ipDenylist?.map((e) => e) || [];
+    message.maxConcurrentSessions = object.maxConcurrentSessions ?? 0;
+    message.reauthInterval = (object.reauthInterval !== undefined && object.reauthInterval !== null)
+      ? Duration.fromPartial(object.reauthInterval)
+      : undefined;
+    message.maxFailedAttempts = object.maxFailedAttempts ?? 0;
+    message.lockoutDuration = (object.lockoutDuration !== undefined && object.lockoutDuration !== null)
+      ? Duration.fromPartial(object.lockoutDuration)
+      : undefined;
+    message.alertWebhooks = object.alertWebhooks?.map((e) => LoginSecurity_AlertWebhook.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseLoginSecurity_AlertWebhook(): LoginSecurity_AlertWebhook {
+  return { type: LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED, url: "" };
+}
+
+export const LoginSecurity_AlertWebhook = {
+  encode(message: LoginSecurity_AlertWebhook, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(8).int32(loginSecurity_AlertWebhook_TypeToNumber(message.type));
+    }
+    if (message.url !== "") {
+      writer.uint32(18).string(message.url);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): LoginSecurity_AlertWebhook {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseLoginSecurity_AlertWebhook();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = loginSecurity_AlertWebhook_TypeFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.url = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): LoginSecurity_AlertWebhook {
+    return {
+      type: isSet(object.type)
+        ? loginSecurity_AlertWebhook_TypeFromJSON(object.type)
+        : LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED,
+      url: isSet(object.url) ? globalThis.String(object.url) : "",
+    };
+  },
+
+  toJSON(message: LoginSecurity_AlertWebhook): unknown {
+    const obj: any = {};
+    if (message.type !== LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED) {
+      obj.type = loginSecurity_AlertWebhook_TypeToJSON(message.type);
+    }
+    if (message.url !== "") {
+      obj.url = message.url;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<LoginSecurity_AlertWebhook>): LoginSecurity_AlertWebhook {
+    return LoginSecurity_AlertWebhook.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<LoginSecurity_AlertWebhook>): LoginSecurity_AlertWebhook {
+    const message = createBaseLoginSecurity_AlertWebhook();
+    message.type = object.type ?? LoginSecurity_AlertWebhook_Type.TYPE_UNSPECIFIED;
+    message.url = object.url ?? "";
+    return message;
+  },
+};
+
+function createBaseAnnouncement(): Announcement {
+  return { level: Announcement_AlertLevel.ALERT_LEVEL_UNSPECIFIED, text: "", link: "" };
+}
+
+export const Announcement = {
+  encode(message: Announcement, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.level !== Announcement_AlertLevel.ALERT_LEVEL_UNSPECIFIED) {
+      writer.uint32(8).int32(announcement_AlertLevelToNumber(message.level));
+    }
+    if (message.text !== "") {
+      writer.uint32(18).string(message.text);
+    }
+    if (message.link !== "") {
+      writer.uint32(26).string(message.link);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Announcement {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnnouncement();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.level = announcement_AlertLevelFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.text = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.link = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Announcement {
+    return {
+      level: isSet(object.level)
+        ? announcement_AlertLevelFromJSON(object.level)
+        : Announcement_AlertLevel.ALERT_LEVEL_UNSPECIFIED,
+      text: isSet(object.text) ? globalThis.String(object.text) : "",
+      link: isSet(object.link) ? globalThis.String(object.link) : "",
     };
   },
 
@@ -2657,7 +3913,7 @@ export const ExternalApprovalSetting = {
 };
 
 function createBaseExternalApprovalSetting_Node(): ExternalApprovalSetting_Node {
-  return { id: "", title: "", endpoint: "" };
+  return { id: "", title: "", endpoint: "", provider: "", headers: [], payloadTemplate: "" };
 }
 
 export const ExternalApprovalSetting_Node = {
@@ -2671,6 +3927,15 @@ export const ExternalApprovalSetting_Node = {
     if (message.endpoint !== "") {
       writer.uint32(26).string(message.endpoint);
     }
+    if (message.provider !== "") {
+      writer.uint32(34).string(message.provider);
+    }
+    for (const v of message.headers) {
+      ExternalApprovalSetting_Node_Header.encode(v!, writer.uint32(42).fork()).ldelim();
+    }
+    if (message.payloadTemplate !== "") {
+      writer.uint32(50).string(message.payloadTemplate);
+    }
     return writer;
   },
 
@@ -2702,6 +3967,27 @@ export const ExternalApprovalSetting_Node = {
 
           message.endpoint = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.provider = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.headers.push(ExternalApprovalSetting_Node_Header.decode(reader, reader.uint32()));
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.payloadTemplate = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2716,6 +4002,11 @@ export const ExternalApprovalSetting_Node = {
       id: isSet(object.id) ? globalThis.String(object.id) : "",
       title: isSet(object.title) ? globalThis.String(object.title) : "",
       endpoint: isSet(object.endpoint) ? globalThis.String(object.endpoint) : "",
+      provider: isSet(object.provider) ? globalThis.String(object.provider) : "",
+      headers: globalThis.Array.isArray(object?.headers)
+        ? object.headers.map((e: any) => ExternalApprovalSetting_Node_Header.fromJSON(e))
+        : [],
+      payloadTemplate: isSet(object.payloadTemplate) ? globalThis.String(object.payloadTemplate) : "",
     };
   },
 
@@ -2730,6 +4021,15 @@ export const ExternalApprovalSetting_Node = {
     if (message.endpoint !== "") {
       obj.endpoint = message.endpoint;
     }
+    if (message.provider !== "") {
+      obj.provider = message.provider;
+    }
+    if (message.headers?.length) {
+      obj.headers = message.headers.map((e) => ExternalApprovalSetting_Node_Header.toJSON(e));
+    }
+    if (message.payloadTemplate !== "") {
+      obj.payloadTemplate = message.payloadTemplate;
+    }
     return obj;
   },
 
@@ -2741,32 +4041,35 @@ export const ExternalApprovalSetting_Node = {
     message.id = object.id ?? "";
     message.title = object.title ?? "";
     message.endpoint = object.endpoint ?? "";
+    message.provider = object.provider ?? "";
+    message.headers = object.headers?.map((e) => ExternalApprovalSetting_Node_Header.fromPartial(e)) || [];
+    message.payloadTemplate = object.payloadTemplate ?? "";
     return message;
   },
 };
 
-function createBaseSchemaTemplateSetting(): SchemaTemplateSetting {
-  return { fieldTemplates: [], columnTypes: [], tableTemplates: [] };
+function createBaseExternalApprovalSetting_Node_Header(): ExternalApprovalSetting_Node_Header {
+  return { key: "", value: "", externalSecret: undefined };
 }
 
-export const SchemaTemplateSetting = {
-  encode(message: SchemaTemplateSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.fieldTemplates) {
-      SchemaTemplateSetting_FieldTemplate.encode(v!, writer.uint32(10).fork()).ldelim();
+export const ExternalApprovalSetting_Node_Header = {
+  encode(message: ExternalApprovalSetting_Node_Header, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
     }
-    for (const v of message.columnTypes) {
-      SchemaTemplateSetting_ColumnType.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.value !== "") {
+      writer.uint32(18).string(message.value);
     }
-    for (const v of message.tableTemplates) {
-      SchemaTemplateSetting_TableTemplate.encode(v!, writer.uint32(26).fork()).ldelim();
+    if (message.externalSecret !== undefined) {
+      DataSourceExternalSecret.encode(message.externalSecret, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaTemplateSetting {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExternalApprovalSetting_Node_Header {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSchemaTemplateSetting();
+    const message = createBaseExternalApprovalSetting_Node_Header();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2775,21 +4078,21 @@ export const SchemaTemplateSetting = {
             break;
           }
 
-          message.fieldTemplates.push(SchemaTemplateSetting_FieldTemplate.decode(reader, reader.uint32()));
+          message.key = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.columnTypes.push(SchemaTemplateSetting_ColumnType.decode(reader, reader.uint32()));
+          message.value = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.tableTemplates.push(SchemaTemplateSetting_TableTemplate.decode(reader, reader.uint32()));
+          message.externalSecret = DataSourceExternalSecret.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2800,59 +4103,152 @@ export const SchemaTemplateSetting = {
     return message;
   },
 
-  fromJSON(object: any): SchemaTemplateSetting {
+  fromJSON(object: any): ExternalApprovalSetting_Node_Header {
     return {
-      fieldTemplates: globalThis.Array.isArray(object?.fieldTemplates)
-        ? object.fieldTemplates.map((e: any) => SchemaTemplateSetting_FieldTemplate.fromJSON(e))
-        : [],
-      columnTypes: globalThis.Array.isArray(object?.columnTypes)
-        ? object.columnTypes.map((e: any) => SchemaTemplateSetting_ColumnType.fromJSON(e))
-        : [],
-      tableTemplates: globalThis.Array.isArray(object?.tableTemplates)
-        ? object.tableTemplates.map((e: any) => SchemaTemplateSetting_TableTemplate.fromJSON(e))
-        : [],
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      value: isSet(object.value) ? globalThis.String(object.value) : "",
+      externalSecret: isSet(object.externalSecret)
+        ? DataSourceExternalSecret.fromJSON(object.externalSecret)
+        : undefined,
     };
   },
 
-  toJSON(message: SchemaTemplateSetting): unknown {
+  toJSON(message: ExternalApprovalSetting_Node_Header): unknown {
     const obj: any = {};
-    if (message.fieldTemplates?.length) {
-      obj.fieldTemplates = message.fieldTemplates.map((e) => SchemaTemplateSetting_FieldTemplate.toJSON(e));
+    if (message.key !== "") {
+      obj.key = message.key;
     }
-    if (message.columnTypes?.length) {
-      obj.columnTypes = message.columnTypes.map((e) => SchemaTemplateSetting_ColumnType.toJSON(e));
+    if (message.value !== "") {
+      obj.value = message.value;
     }
-    if (message.tableTemplates?.length) {
-      obj.tableTemplates = message.tableTemplates.map((e) => SchemaTemplateSetting_TableTemplate.toJSON(e));
+    if (message.externalSecret !== undefined) {
+      obj.externalSecret = DataSourceExternalSecret.toJSON(message.externalSecret);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SchemaTemplateSetting>): SchemaTemplateSetting {
-    return SchemaTemplateSetting.fromPartial(base ?? {});
+  create(base?: DeepPartial<ExternalApprovalSetting_Node_Header>): ExternalApprovalSetting_Node_Header {
+    return ExternalApprovalSetting_Node_Header.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SchemaTemplateSetting>): SchemaTemplateSetting {
-    const message = createBaseSchemaTemplateSetting();
-    message.fieldTemplates = object.fieldTemplates?.map((e) => SchemaTemplateSetting_FieldTemplate.fromPartial(e)) ||
-      [];
-    message.columnTypes = object.columnTypes?.map((e) => SchemaTemplateSetting_ColumnType.fromPartial(e)) || [];
-    message.tableTemplates = object.tableTemplates?.map((e) => SchemaTemplateSetting_TableTemplate.fromPartial(e)) ||
-      [];
+  fromPartial(object: DeepPartial<ExternalApprovalSetting_Node_Header>): ExternalApprovalSetting_Node_Header {
+    const message = createBaseExternalApprovalSetting_Node_Header();
+    message.key = object.key ?? "";
+    message.value = object.value ?? "";
+    message.externalSecret = (object.externalSecret !== undefined && object.externalSecret !== null)
+      ? DataSourceExternalSecret.fromPartial(object.externalSecret)
+      : undefined;
     return message;
   },
 };
 
-function createBaseSchemaTemplateSetting_FieldTemplate(): SchemaTemplateSetting_FieldTemplate {
-  return { id: "", engine: Engine.ENGINE_UNSPECIFIED, category: "", column: undefined, config: undefined };
+function createBaseSchemaTemplateSetting(): SchemaTemplateSetting {
+  return { fieldTemplates: [], columnTypes: [], tableTemplates: [] };
 }
 
-export const SchemaTemplateSetting_FieldTemplate = {
-  encode(message: SchemaTemplateSetting_FieldTemplate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.id !== "") {
-      writer.uint32(10).string(message.id);
-    }
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      writer.uint32(16).int32(engineToNumber(message.engine));
+export const SchemaTemplateSetting = {
+  encode(message: SchemaTemplateSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.fieldTemplates) {
+      SchemaTemplateSetting_FieldTemplate.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.columnTypes) {
+      SchemaTemplateSetting_ColumnType.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.tableTemplates) {
+      SchemaTemplateSetting_TableTemplate.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SchemaTemplateSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSchemaTemplateSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.fieldTemplates.push(SchemaTemplateSetting_FieldTemplate.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.columnTypes.push(SchemaTemplateSetting_ColumnType.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.tableTemplates.push(SchemaTemplateSetting_TableTemplate.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SchemaTemplateSetting {
+    return {
+      fieldTemplates: globalThis.Array.isArray(object?.fieldTemplates)
+        ? object.fieldTemplates.map((e: any) => SchemaTemplateSetting_FieldTemplate.fromJSON(e))
+        : [],
+      columnTypes: globalThis.Array.isArray(object?.columnTypes)
+        ? object.columnTypes.map((e: any) => SchemaTemplateSetting_ColumnType.fromJSON(e))
+        : [],
+      tableTemplates: globalThis.Array.isArray(object?.tableTemplates)
+        ? object.tableTemplates.map((e: any) => SchemaTemplateSetting_TableTemplate.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SchemaTemplateSetting): unknown {
+    const obj: any = {};
+    if (message.fieldTemplates?.length) {
+      obj.fieldTemplates = message.fieldTemplates.map((e) => SchemaTemplateSetting_FieldTemplate.toJSON(e));
+    }
+    if (message.columnTypes?.length) {
+      obj.columnTypes = message.columnTypes.map((e) => SchemaTemplateSetting_ColumnType.toJSON(e));
+    }
+    if (message.tableTemplates?.length) {
+      obj.tableTemplates = message.tableTemplates.map((e) => SchemaTemplateSetting_TableTemplate.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SchemaTemplateSetting>): SchemaTemplateSetting {
+    return SchemaTemplateSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SchemaTemplateSetting>): SchemaTemplateSetting {
+    const message = createBaseSchemaTemplateSetting();
+    message.fieldTemplates = object.fieldTemplates?.map((e) => SchemaTemplateSetting_FieldTemplate.fromPartial(e)) ||
+      [];
+    message.columnTypes = object.columnTypes?.map((e) => SchemaTemplateSetting_ColumnType.fromPartial(e)) || [];
+    message.tableTemplates = object.tableTemplates?.map((e) => SchemaTemplateSetting_TableTemplate.fromPartial(e)) ||
+      [];
+    return message;
+  },
+};
+
+function createBaseSchemaTemplateSetting_FieldTemplate(): SchemaTemplateSetting_FieldTemplate {
+  return { id: "", engine: Engine.ENGINE_UNSPECIFIED, category: "", column: undefined, config: undefined };
+}
+
+export const SchemaTemplateSetting_FieldTemplate = {
+  encode(message: SchemaTemplateSetting_FieldTemplate, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      writer.uint32(16).int32(engineToNumber(message.engine));
     }
     if (message.category !== "") {
       writer.uint32(26).string(message.category);
@@ -4097,6 +5493,9 @@ function createBaseMaskingAlgorithmSetting_Algorithm(): MaskingAlgorithmSetting_
     rangeMask: undefined,
     md5Mask: undefined,
     innerOuterMask: undefined,
+    hashMask: undefined,
+    formatPreservingEncryptionMask: undefined,
+    dateShiftMask: undefined,
   };
 }
 
@@ -4127,6 +5526,18 @@ export const MaskingAlgorithmSetting_Algorithm = {
       MaskingAlgorithmSetting_Algorithm_InnerOuterMask.encode(message.innerOuterMask, writer.uint32(66).fork())
         .ldelim();
     }
+    if (message.hashMask !== undefined) {
+      MaskingAlgorithmSetting_Algorithm_HashMask.encode(message.hashMask, writer.uint32(74).fork()).ldelim();
+    }
+    if (message.formatPreservingEncryptionMask !== undefined) {
+      MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask.encode(
+        message.formatPreservingEncryptionMask,
+        writer.uint32(82).fork(),
+      ).ldelim();
+    }
+    if (message.dateShiftMask !== undefined) {
+      MaskingAlgorithmSetting_Algorithm_DateShiftMask.encode(message.dateShiftMask, writer.uint32(90).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -4193,6 +5604,30 @@ export const MaskingAlgorithmSetting_Algorithm = {
 
           message.innerOuterMask = MaskingAlgorithmSetting_Algorithm_InnerOuterMask.decode(reader, reader.uint32());
           continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.hashMask = MaskingAlgorithmSetting_Algorithm_HashMask.decode(reader, reader.uint32());
+          continue;
+        case 10:
+          if (tag !== 82) {
+            break;
+          }
+
+          message.formatPreservingEncryptionMask = MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.dateShiftMask = MaskingAlgorithmSetting_Algorithm_DateShiftMask.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4218,6 +5653,15 @@ export const MaskingAlgorithmSetting_Algorithm = {
       innerOuterMask: isSet(object.innerOuterMask)
         ? MaskingAlgorithmSetting_Algorithm_InnerOuterMask.fromJSON(object.innerOuterMask)
         : undefined,
+      hashMask: isSet(object.hashMask)
+        ? MaskingAlgorithmSetting_Algorithm_HashMask.fromJSON(object.hashMask)
+        : undefined,
+      formatPreservingEncryptionMask: isSet(object.formatPreservingEncryptionMask)
+        ? MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask.fromJSON(object.formatPreservingEncryptionMask)
+        : undefined,
+      dateShiftMask: isSet(object.dateShiftMask)
+        ? MaskingAlgorithmSetting_Algorithm_DateShiftMask.fromJSON(object.dateShiftMask)
+        : undefined,
     };
   },
 
@@ -4247,6 +5691,17 @@ export const MaskingAlgorithmSetting_Algorithm = {
     if (message.innerOuterMask !== undefined) {
       obj.innerOuterMask = MaskingAlgorithmSetting_Algorithm_InnerOuterMask.toJSON(message.innerOuterMask);
     }
+    if (message.hashMask !== undefined) {
+      obj.hashMask = MaskingAlgorithmSetting_Algorithm_HashMask.toJSON(message.hashMask);
+    }
+    if (message.formatPreservingEncryptionMask !== undefined) {
+      obj.formatPreservingEncryptionMask = MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask.toJSON(
+        message.formatPreservingEncryptionMask,
+      );
+    }
+    if (message.dateShiftMask !== undefined) {
+      obj.dateShiftMask = MaskingAlgorithmSetting_Algorithm_DateShiftMask.toJSON(message.dateShiftMask);
+    }
     return obj;
   },
 
@@ -4271,6 +5726,16 @@ export const MaskingAlgorithmSetting_Algorithm = {
     message.innerOuterMask = (object.innerOuterMask !== undefined && object.innerOuterMask !== null)
       ? MaskingAlgorithmSetting_Algorithm_InnerOuterMask.fromPartial(object.innerOuterMask)
       : undefined;
+    message.hashMask = (object.hashMask !== undefined && object.hashMask !== null)
+      ? MaskingAlgorithmSetting_Algorithm_HashMask.fromPartial(object.hashMask)
+      : undefined;
+    message.formatPreservingEncryptionMask =
+      (object.formatPreservingEncryptionMask !== undefined && object.formatPreservingEncryptionMask !== null)
+        ? MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask.fromPartial(object.formatPreservingEncryptionMask)
+        : undefined;
+    message.dateShiftMask = (object.dateShiftMask !== undefined && object.dateShiftMask !== null)
+      ? MaskingAlgorithmSetting_Algorithm_DateShiftMask.fromPartial(object.dateShiftMask)
+      : undefined;
     return message;
   },
 };
@@ -4670,31 +6135,31 @@ export const MaskingAlgorithmSetting_Algorithm_InnerOuterMask = {
   },
 };
 
-function createBaseMaximumSQLResultSizeSetting(): MaximumSQLResultSizeSetting {
-  return { limit: Long.ZERO };
+function createBaseMaskingAlgorithmSetting_Algorithm_HashMask(): MaskingAlgorithmSetting_Algorithm_HashMask {
+  return { salt: "" };
 }
 
-export const MaximumSQLResultSizeSetting = {
-  encode(message: MaximumSQLResultSizeSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (!message.limit.isZero()) {
-      writer.uint32(8).int64(message.limit);
+export const MaskingAlgorithmSetting_Algorithm_HashMask = {
+  encode(message: MaskingAlgorithmSetting_Algorithm_HashMask, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.salt !== "") {
+      writer.uint32(10).string(message.salt);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): MaximumSQLResultSizeSetting {
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_Algorithm_HashMask {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseMaximumSQLResultSizeSetting();
+    const message = createBaseMaskingAlgorithmSetting_Algorithm_HashMask();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.limit = reader.int64() as Long;
+          message.salt = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -4705,24 +6170,1118 @@ export const MaximumSQLResultSizeSetting = {
     return message;
   },
 
-  fromJSON(object: any): MaximumSQLResultSizeSetting {
-    return { limit: isSet(object.limit) ? Long.fromValue(object.limit) : Long.ZERO };
+  fromJSON(object: any): MaskingAlgorithmSetting_Algorithm_HashMask {
+    return { salt: isSet(object.salt) ? globalThis.String(object.salt) : "" };
   },
 
-  toJSON(message: MaximumSQLResultSizeSetting): unknown {
+  toJSON(message: MaskingAlgorithmSetting_Algorithm_HashMask): unknown {
     const obj: any = {};
-    if (!message.limit.isZero()) {
-      obj.limit = (message.limit || Long.ZERO).toString();
+    if (message.salt !== "") {
+      obj.salt = message.salt;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<MaximumSQLResultSizeSetting>): MaximumSQLResultSizeSetting {
-    return MaximumSQLResultSizeSetting.fromPartial(base ?? {});
+  create(base?: DeepPartial<MaskingAlgorithmSetting_Algorithm_HashMask>): MaskingAlgorithmSetting_Algorithm_HashMask {
+    return MaskingAlgorithmSetting_Algorithm_HashMask.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<MaximumSQLResultSizeSetting>): MaximumSQLResultSizeSetting {
-    const message = createBaseMaximumSQLResultSizeSetting();
-    message.limit = (object.limit !== undefined && object.limit !== null) ? Long.fromValue(object.limit) : Long.ZERO;
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_Algorithm_HashMask>,
+  ): MaskingAlgorithmSetting_Algorithm_HashMask {
+    const message = createBaseMaskingAlgorithmSetting_Algorithm_HashMask();
+    message.salt = object.salt ?? "";
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask(): MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask {
+  return { key: "", tweak: "" };
+}
+
+export const MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask = {
+  encode(
+    message: MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.key !== "") {
+      writer.uint32(10).string(message.key);
+    }
+    if (message.tweak !== "") {
+      writer.uint32(18).string(message.tweak);
+    }
+    return writer;
+  },
+
+  decode(
+    input: _m0.Reader | Uint8Array,
+    length?: number,
+  ): MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.key = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.tweak = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask {
+    return {
+      key: isSet(object.key) ? globalThis.String(object.key) : "",
+      tweak: isSet(object.tweak) ? globalThis.String(object.tweak) : "",
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask): unknown {
+    const obj: any = {};
+    if (message.key !== "") {
+      obj.key = message.key;
+    }
+    if (message.tweak !== "") {
+      obj.tweak = message.tweak;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask>,
+  ): MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask {
+    return MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask>,
+  ): MaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask {
+    const message = createBaseMaskingAlgorithmSetting_Algorithm_FormatPreservingEncryptionMask();
+    message.key = object.key ?? "";
+    message.tweak = object.tweak ?? "";
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithmSetting_Algorithm_DateShiftMask(): MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+  return { maxShiftDays: 0, salt: "" };
+}
+
+export const MaskingAlgorithmSetting_Algorithm_DateShiftMask = {
+  encode(
+    message: MaskingAlgorithmSetting_Algorithm_DateShiftMask,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.maxShiftDays !== 0) {
+      writer.uint32(8).int32(message.maxShiftDays);
+    }
+    if (message.salt !== "") {
+      writer.uint32(18).string(message.salt);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithmSetting_Algorithm_DateShiftMask();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxShiftDays = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.salt = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+    return {
+      maxShiftDays: isSet(object.maxShiftDays) ? globalThis.Number(object.maxShiftDays) : 0,
+      salt: isSet(object.salt) ? globalThis.String(object.salt) : "",
+    };
+  },
+
+  toJSON(message: MaskingAlgorithmSetting_Algorithm_DateShiftMask): unknown {
+    const obj: any = {};
+    if (message.maxShiftDays !== 0) {
+      obj.maxShiftDays = Math.round(message.maxShiftDays);
+    }
+    if (message.salt !== "") {
+      obj.salt = message.salt;
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<MaskingAlgorithmSetting_Algorithm_DateShiftMask>,
+  ): MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+    return MaskingAlgorithmSetting_Algorithm_DateShiftMask.fromPartial(base ?? {});
+  },
+  fromPartial(
+    object: DeepPartial<MaskingAlgorithmSetting_Algorithm_DateShiftMask>,
+  ): MaskingAlgorithmSetting_Algorithm_DateShiftMask {
+    const message = createBaseMaskingAlgorithmSetting_Algorithm_DateShiftMask();
+    message.maxShiftDays = object.maxShiftDays ?? 0;
+    message.salt = object.salt ?? "";
+    return message;
+  },
+};
+
+function createBaseMaximumSQLResultSizeSetting(): MaximumSQLResultSizeSetting {
+  return { limit: Long.ZERO };
+}
+
+export const MaximumSQLResultSizeSetting = {
+  encode(message: MaximumSQLResultSizeSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (!message.limit.isZero()) {
+      writer.uint32(8).int64(message.limit);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaximumSQLResultSizeSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaximumSQLResultSizeSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.limit = reader.int64() as Long;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaximumSQLResultSizeSetting {
+    return { limit: isSet(object.limit) ? Long.fromValue(object.limit) : Long.ZERO };
+  },
+
+  toJSON(message: MaximumSQLResultSizeSetting): unknown {
+    const obj: any = {};
+    if (!message.limit.isZero()) {
+      obj.limit = (message.limit || Long.ZERO).toString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<MaximumSQLResultSizeSetting>): MaximumSQLResultSizeSetting {
+    return MaximumSQLResultSizeSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<MaximumSQLResultSizeSetting>): MaximumSQLResultSizeSetting {
+    const message = createBaseMaximumSQLResultSizeSetting();
+    message.limit = (object.limit !== undefined && object.limit !== null) ? Long.fromValue(object.limit) : Long.ZERO;
+    return message;
+  },
+};
+
+function createBaseSheetStorageSetting(): SheetStorageSetting {
+  return { storage: undefined, externalThreshold: Long.ZERO, warningSize: Long.ZERO };
+}
+
+export const SheetStorageSetting = {
+  encode(message: SheetStorageSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.storage !== undefined) {
+      BackupStorage.encode(message.storage, writer.uint32(10).fork()).ldelim();
+    }
+    if (!message.externalThreshold.isZero()) {
+      writer.uint32(16).int64(message.externalThreshold);
+    }
+    if (!message.warningSize.isZero()) {
+      writer.uint32(24).int64(message.warningSize);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SheetStorageSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSheetStorageSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.storage = BackupStorage.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.externalThreshold = reader.int64() as Long;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.warningSize = reader.int64() as Long;
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SheetStorageSetting {
+    return {
+      storage: isSet(object.storage) ? BackupStorage.fromJSON(object.storage) : undefined,
+      externalThreshold: isSet(object.externalThreshold) ? Long.fromValue(object.externalThreshold) : Long.ZERO,
+      warningSize: isSet(object.warningSize) ? Long.fromValue(object.warningSize) : Long.ZERO,
+    };
+  },
+
+  toJSON(message: SheetStorageSetting): unknown {
+    const obj: any = {};
+    if (message.storage !== undefined) {
+      obj.storage = BackupStorage.toJSON(message.storage);
+    }
+    if (!message.externalThreshold.isZero()) {
+      obj.externalThreshold = (message.externalThreshold || Long.ZERO).toString();
+    }
+    if (!message.warningSize.isZero()) {
+      obj.warningSize = (message.warningSize || Long.ZERO).toString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SheetStorageSetting>): SheetStorageSetting {
+    return SheetStorageSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<SheetStorageSetting>): SheetStorageSetting {
+    const message = createBaseSheetStorageSetting();
+    message.storage = (object.storage !== undefined && object.storage !== null)
+      ? BackupStorage.fromPartial(object.storage)
+      : undefined;
+    message.externalThreshold = (object.externalThreshold !== undefined && object.externalThreshold !== null)
+      ? Long.fromValue(object.externalThreshold)
+      : Long.ZERO;
+    message.warningSize = (object.warningSize !== undefined && object.warningSize !== null)
+      ? Long.fromValue(object.warningSize)
+      : Long.ZERO;
+    return message;
+  },
+};
+
+function createBasePlanCheckSetting(): PlanCheckSetting {
+  return { maxConcurrentPerInstance: 0 };
+}
+
+export const PlanCheckSetting = {
+  encode(message: PlanCheckSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.maxConcurrentPerInstance !== 0) {
+      writer.uint32(8).int32(message.maxConcurrentPerInstance);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanCheckSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanCheckSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.maxConcurrentPerInstance = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanCheckSetting {
+    return {
+      maxConcurrentPerInstance: isSet(object.maxConcurrentPerInstance)
+        ? globalThis.Number(object.maxConcurrentPerInstance)
+        : 0,
+    };
+  },
+
+  toJSON(message: PlanCheckSetting): unknown {
+    const obj: any = {};
+    if (message.maxConcurrentPerInstance !== 0) {
+      obj.maxConcurrentPerInstance = Math.round(message.maxConcurrentPerInstance);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanCheckSetting>): PlanCheckSetting {
+    return PlanCheckSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PlanCheckSetting>): PlanCheckSetting {
+    const message = createBasePlanCheckSetting();
+    message.maxConcurrentPerInstance = object.maxConcurrentPerInstance ?? 0;
+    return message;
+  },
+};
+
+function createBaseEventBusSetting(): EventBusSetting {
+  return { destinations: [] };
+}
+
+export const EventBusSetting = {
+  encode(message: EventBusSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.destinations) {
+      EventBusSetting_Destination.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EventBusSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEventBusSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.destinations.push(EventBusSetting_Destination.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EventBusSetting {
+    return {
+      destinations: globalThis.Array.isArray(object?.destinations)
+        ? object.destinations.map((e: any) => EventBusSetting_Destination.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: EventBusSetting): unknown {
+    const obj: any = {};
+    if (message.destinations?.length) {
+      obj.destinations = message.destinations.map((e) => EventBusSetting_Destination.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<EventBusSetting>): EventBusSetting {
+    return EventBusSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<EventBusSetting>): EventBusSetting {
+    const message = createBaseEventBusSetting();
+    message.destinations = object.destinations?.map((e) => EventBusSetting_Destination.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseEventBusSetting_Destination(): EventBusSetting_Destination {
+  return {
+    id: "",
+    type: EventBusSetting_Destination_Type.TYPE_UNSPECIFIED,
+    address: "",
+    topic: "",
+    username: "",
+    password: "",
+  };
+}
+
+export const EventBusSetting_Destination = {
+  encode(message: EventBusSetting_Destination, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.id !== "") {
+      writer.uint32(10).string(message.id);
+    }
+    if (message.type !== EventBusSetting_Destination_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(16).int32(eventBusSetting_Destination_TypeToNumber(message.type));
+    }
+    if (message.address !== "") {
+      writer.uint32(26).string(message.address);
+    }
+    if (message.topic !== "") {
+      writer.uint32(34).string(message.topic);
+    }
+    if (message.username !== "") {
+      writer.uint32(42).string(message.username);
+    }
+    if (message.password !== "") {
+      writer.uint32(50).string(message.password);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): EventBusSetting_Destination {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseEventBusSetting_Destination();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.id = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.type = eventBusSetting_Destination_TypeFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.address = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.topic = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.username = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): EventBusSetting_Destination {
+    return {
+      id: isSet(object.id) ? globalThis.String(object.id) : "",
+      type: isSet(object.type)
+        ? eventBusSetting_Destination_TypeFromJSON(object.type)
+        : EventBusSetting_Destination_Type.TYPE_UNSPECIFIED,
+      address: isSet(object.address) ? globalThis.String(object.address) : "",
+      topic: isSet(object.topic) ? globalThis.String(object.topic) : "",
+      username: isSet(object.username) ? globalThis.String(object.username) : "",
+      password: isSet(object.password) ? globalThis.String(object.password) : "",
+    };
+  },
+
+  toJSON(message: EventBusSetting_Destination): unknown {
+    const obj: any = {};
+    if (message.id !== "") {
+      obj.id = message.id;
+    }
+    if (message.type !== EventBusSetting_Destination_Type.TYPE_UNSPECIFIED) {
+      obj.type = eventBusSetting_Destination_TypeToJSON(message.type);
+    }
+    if (message.address !== "") {
+      obj.address = message.address;
+    }
+    if (message.topic !== "") {
+      obj.topic = message.topic;
+    }
+    if (message.username !== "") {
+      obj.username = message.username;
+    }
+    if (message.password !== "") {
+      obj.password = message.password;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<EventBusSetting_Destination>): EventBusSetting_Destination {
+    return EventBusSetting_Destination.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<EventBusSetting_Destination>): EventBusSetting_Destination {
+    const message = createBaseEventBusSetting_Destination();
+    message.id = object.id ?? "";
+    message.type = object.type ?? EventBusSetting_Destination_Type.TYPE_UNSPECIFIED;
+    message.address = object.address ?? "";
+    message.topic = object.topic ?? "";
+    message.username = object.username ?? "";
+    message.password = object.password ?? "";
+    return message;
+  },
+};
+
+function createBaseRateLimitSetting(): RateLimitSetting {
+  return { userLimits: [], serviceAccountLimits: [], overrides: [] };
+}
+
+export const RateLimitSetting = {
+  encode(message: RateLimitSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.userLimits) {
+      RateLimitSetting_Limit.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.serviceAccountLimits) {
+      RateLimitSetting_Limit.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    for (const v of message.overrides) {
+      RateLimitSetting_Override.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RateLimitSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRateLimitSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.userLimits.push(RateLimitSetting_Limit.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.serviceAccountLimits.push(RateLimitSetting_Limit.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.overrides.push(RateLimitSetting_Override.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RateLimitSetting {
+    return {
+      userLimits: globalThis.Array.isArray(object?.userLimits)
+        ? object.userLimits.map((e: any) => RateLimitSetting_Limit.fromJSON(e))
+        : [],
+      serviceAccountLimits: globalThis.Array.isArray(object?.serviceAccountLimits)
+        ? object.serviceAccountLimits.map((e: any) => RateLimitSetting_Limit.fromJSON(e))
+        : [],
+      overrides: globalThis.Array.isArray(object?.overrides)
+        ? object.overrides.map((e: any) => RateLimitSetting_Override.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RateLimitSetting): unknown {
+    const obj: any = {};
+    if (message.userLimits?.length) {
+      obj.userLimits = message.userLimits.map((e) => RateLimitSetting_Limit.toJSON(e));
+    }
+    if (message.serviceAccountLimits?.length) {
+      obj.serviceAccountLimits = message.serviceAccountLimits.map((e) => RateLimitSetting_Limit.toJSON(e));
+    }
+    if (message.overrides?.length) {
+      obj.overrides = message.overrides.map((e) => RateLimitSetting_Override.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RateLimitSetting>): RateLimitSetting {
+    return RateLimitSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RateLimitSetting>): RateLimitSetting {
+    const message = createBaseRateLimitSetting();
+    message.userLimits = object.userLimits?.map((e) => RateLimitSetting_Limit.fromPartial(e)) || [];
+    message.serviceAccountLimits = object.serviceAccountLimits?.map((e) => RateLimitSetting_Limit.fromPartial(e)) || [];
+    message.overrides = object.overrides?.map((e) => RateLimitSetting_Override.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRateLimitSetting_Limit(): RateLimitSetting_Limit {
+  return { methodClass: RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED, rate: 0, burst: 0 };
+}
+
+export const RateLimitSetting_Limit = {
+  encode(message: RateLimitSetting_Limit, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.methodClass !== RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED) {
+      writer.uint32(8).int32(rateLimitSetting_MethodClassToNumber(message.methodClass));
+    }
+    if (message.rate !== 0) {
+      writer.uint32(17).double(message.rate);
+    }
+    if (message.burst !== 0) {
+      writer.uint32(24).int32(message.burst);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RateLimitSetting_Limit {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRateLimitSetting_Limit();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.methodClass = rateLimitSetting_MethodClassFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 17) {
+            break;
+          }
+
+          message.rate = reader.double();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.burst = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RateLimitSetting_Limit {
+    return {
+      methodClass: isSet(object.methodClass)
+        ? rateLimitSetting_MethodClassFromJSON(object.methodClass)
+        : RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED,
+      rate: isSet(object.rate) ? globalThis.Number(object.rate) : 0,
+      burst: isSet(object.burst) ? globalThis.Number(object.burst) : 0,
+    };
+  },
+
+  toJSON(message: RateLimitSetting_Limit): unknown {
+    const obj: any = {};
+    if (message.methodClass !== RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED) {
+      obj.methodClass = rateLimitSetting_MethodClassToJSON(message.methodClass);
+    }
+    if (message.rate !== 0) {
+      obj.rate = message.rate;
+    }
+    if (message.burst !== 0) {
+      obj.burst = Math.round(message.burst);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RateLimitSetting_Limit>): RateLimitSetting_Limit {
+    return RateLimitSetting_Limit.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RateLimitSetting_Limit>): RateLimitSetting_Limit {
+    const message = createBaseRateLimitSetting_Limit();
+    message.methodClass = object.methodClass ?? RateLimitSetting_MethodClass.METHOD_CLASS_UNSPECIFIED;
+    message.rate = object.rate ?? 0;
+    message.burst = object.burst ?? 0;
+    return message;
+  },
+};
+
+function createBaseRateLimitSetting_Override(): RateLimitSetting_Override {
+  return { principal: "", limits: [] };
+}
+
+export const RateLimitSetting_Override = {
+  encode(message: RateLimitSetting_Override, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.principal !== "") {
+      writer.uint32(10).string(message.principal);
+    }
+    for (const v of message.limits) {
+      RateLimitSetting_Limit.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RateLimitSetting_Override {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRateLimitSetting_Override();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.principal = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.limits.push(RateLimitSetting_Limit.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RateLimitSetting_Override {
+    return {
+      principal: isSet(object.principal) ? globalThis.String(object.principal) : "",
+      limits: globalThis.Array.isArray(object?.limits)
+        ? object.limits.map((e: any) => RateLimitSetting_Limit.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: RateLimitSetting_Override): unknown {
+    const obj: any = {};
+    if (message.principal !== "") {
+      obj.principal = message.principal;
+    }
+    if (message.limits?.length) {
+      obj.limits = message.limits.map((e) => RateLimitSetting_Limit.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RateLimitSetting_Override>): RateLimitSetting_Override {
+    return RateLimitSetting_Override.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RateLimitSetting_Override>): RateLimitSetting_Override {
+    const message = createBaseRateLimitSetting_Override();
+    message.principal = object.principal ?? "";
+    message.limits = object.limits?.map((e) => RateLimitSetting_Limit.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseReadAuditSetting(): ReadAuditSetting {
+  return { projects: [], methods: [], sampleRate: 0 };
+}
+
+export const ReadAuditSetting = {
+  encode(message: ReadAuditSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.projects) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.methods) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.sampleRate !== 0) {
+      writer.uint32(25).double(message.sampleRate);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReadAuditSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReadAuditSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.projects.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.methods.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.sampleRate = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReadAuditSetting {
+    return {
+      projects: globalThis.Array.isArray(object?.projects) ? object.projects.map((e: any) => globalThis.String(e)) : [],
+      methods: globalThis.Array.isArray(object?.methods) ? object.methods.map((e: any) => globalThis.String(e)) : [],
+      sampleRate: isSet(object.sampleRate) ? globalThis.Number(object.sampleRate) : 0,
+    };
+  },
+
+  toJSON(message: ReadAuditSetting): unknown {
+    const obj: any = {};
+    if (message.projects?.length) {
+      obj.projects = message.projects;
+    }
+    if (message.methods?.length) {
+      obj.methods = message.methods;
+    }
+    if (message.sampleRate !== 0) {
+      obj.sampleRate = message.sampleRate;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ReadAuditSetting>): ReadAuditSetting {
+    return ReadAuditSetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ReadAuditSetting>): ReadAuditSetting {
+    const message = createBaseReadAuditSetting();
+    message.projects = object.projects?.map((e) => e) || [];
+    message.methods = object.methods?.map((e) => e) || [];
+    message.sampleRate = object.sampleRate ?? 0;
+    return message;
+  },
+};
+
+function createBaseAISetting(): AISetting {
+  return {
+    enabled: false,
+    provider: AISetting_Provider.PROVIDER_UNSPECIFIED,
+    endpoint: "",
+    apiKey: "",
+    model: "",
+    apiVersion: "",
+    reviewEnabled: false,
+  };
+}
+
+export const AISetting = {
+  encode(message: AISetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.enabled === true) {
+      writer.uint32(8).bool(message.enabled);
+    }
+    if (message.provider !== AISetting_Provider.PROVIDER_UNSPECIFIED) {
+      writer.uint32(16).int32(aISetting_ProviderToNumber(message.provider));
+    }
+    if (message.endpoint !== "") {
+      writer.uint32(26).string(message.endpoint);
+    }
+    if (message.apiKey !== "") {
+      writer.uint32(34).string(message.apiKey);
+    }
+    if (message.model !== "") {
+      writer.uint32(42).string(message.model);
+    }
+    if (message.apiVersion !== "") {
+      writer.uint32(50).string(message.apiVersion);
+    }
+    if (message.reviewEnabled === true) {
+      writer.uint32(56).bool(message.reviewEnabled);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): AISetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAISetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.enabled = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.provider = aISetting_ProviderFromJSON(reader.int32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.endpoint = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.apiKey = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.model = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.apiVersion = reader.string();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.reviewEnabled = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): AISetting {
+    return {
+      enabled: isSet(object.enabled) ? globalThis.Boolean(object.enabled) : false,
+      provider: isSet(object.provider)
+        ? aISetting_ProviderFromJSON(object.provider)
+        : AISetting_Provider.PROVIDER_UNSPECIFIED,
+      endpoint: isSet(object.endpoint) ? globalThis.String(object.endpoint) : "",
+      apiKey: isSet(object.apiKey) ? globalThis.String(object.apiKey) : "",
+      model: isSet(object.model) ? globalThis.String(object.model) : "",
+      apiVersion: isSet(object.apiVersion) ? globalThis.String(object.apiVersion) : "",
+      reviewEnabled: isSet(object.reviewEnabled) ? globalThis.Boolean(object.reviewEnabled) : false,
+    };
+  },
+
+  toJSON(message: AISetting): unknown {
+    const obj: any = {};
+    if (message.enabled === true) {
+      obj.enabled = message.enabled;
+    }
+    if (message.provider !== AISetting_Provider.PROVIDER_UNSPECIFIED) {
+      obj.provider = aISetting_ProviderToJSON(message.provider);
+    }
+    if (message.endpoint !== "") {
+      obj.endpoint = message.endpoint;
+    }
+    if (message.apiKey !== "") {
+      obj.apiKey = message.apiKey;
+    }
+    if (message.model !== "") {
+      obj.model = message.model;
+    }
+    if (message.apiVersion !== "") {
+      obj.apiVersion = message.apiVersion;
+    }
+    if (message.reviewEnabled === true) {
+      obj.reviewEnabled = message.reviewEnabled;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<AISetting>): AISetting {
+    return AISetting.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<AISetting>): AISetting {
+    const message = createBaseAISetting();
+    message.enabled = object.enabled ?? false;
+    message.provider = object.provider ?? AISetting_Provider.PROVIDER_UNSPECIFIED;
+    message.endpoint = object.endpoint ?? "";
+    message.apiKey = object.apiKey ?? "";
+    message.model = object.model ?? "";
+    message.apiVersion = object.apiVersion ?? "";
+    message.reviewEnabled = object.reviewEnabled ?? false;
     return message;
   },
 };
@@ -2,6 +2,7 @@
 import Long from "long";
 import _m0 from "protobufjs/minimal";
 import { Duration } from "../google/protobuf/duration";
+import { Empty } from "../google/protobuf/empty";
 import { NullValue, nullValueFromJSON, nullValueToJSON, nullValueToNumber, Value } from "../google/protobuf/struct";
 import { Timestamp } from "../google/protobuf/timestamp";
 import {
@@ -53,7 +54,14 @@ export interface AdminExecuteRequest {
   /** The maximum number of rows to return. */
   limit: number;
   /** The timeout for the request. */
-  timeout: Duration | undefined;
+  timeout:
+    | Duration
+    | undefined;
+  /**
+   * The reason to start the admin execute session, only the one in the first request of the session is recorded.
+   * It's required if the workspace requires the admin execute justification.
+   */
+  justification: string;
 }
 
 export interface AdminExecuteResponse {
@@ -85,6 +93,142 @@ export interface QueryRequest {
   dataSourceId: string;
   /** Explain the statement. */
   explain: boolean;
+  /** The client generated id of the query, used to cancel the query by CancelQuery. */
+  queryId: string;
+  /**
+   * The number of rows to skip, used to fetch more rows after the `limit` rows.
+   * The statement should have a stable order, e.g. ORDER BY, to fetch the rows consistently.
+   */
+  offset: number;
+}
+
+export interface QueryStreamResponse {
+  /**
+   * Header starts a query result, it's the result without the rows.
+   * The following rows frames belong to the result until the next header.
+   */
+  header?: QueryResult | undefined;
+  rows?: QueryStreamResponse_Rows | undefined;
+  footer?: QueryStreamResponse_Footer | undefined;
+}
+
+export interface QueryStreamResponse_Rows {
+  rows: QueryRow[];
+}
+
+/** Footer is the last frame. */
+export interface QueryStreamResponse_Footer {
+  /** The query advices. */
+  advices: Advice[];
+  /** The query is allowed to be exported or not. */
+  allowExport: boolean;
+}
+
+export interface ListAdminSessionsRequest {
+  /**
+   * The parent instance.
+   * Format: instances/{instance}
+   */
+  parent: string;
+  /**
+   * The maximum number of sessions to return.
+   * If unspecified, at most 50 sessions will be returned.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListAdminSessions` call.
+   * Provide this to retrieve the subsequent page.
+   */
+  pageToken: string;
+}
+
+export interface ListAdminSessionsResponse {
+  /** The sessions of the instance. */
+  sessions: AdminSession[];
+  /**
+   * A token to retrieve next page of sessions.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+/** AdminSession is the record of an admin execute session in the SQL Editor admin mode, it cannot be changed. */
+export interface AdminSession {
+  /** Format: instances/{instance}/adminSessions/{session} */
+  name: string;
+  /** The database name the session connected to. */
+  database: string;
+  /** Format: users/{email} */
+  creator: string;
+  createTime:
+    | Date
+    | undefined;
+  /** The reason to start the session. */
+  justification: string;
+  /** The statements executed in the session in order. */
+  statements: AdminSession_Statement[];
+}
+
+export interface AdminSession_Statement {
+  statement: string;
+  createTime: Date | undefined;
+  duration:
+    | Duration
+    | undefined;
+  /** The number of rows affected by the DML and DDL statements. */
+  affectedRows: Long;
+  /** The number of rows returned by the query statements. */
+  rowCount: Long;
+  error: string;
+}
+
+export interface GetQueryPlanRequest {
+  /**
+   * The name is the database to explain the query against.
+   * Format: instances/{instance}/databases/{databaseName}
+   */
+  name: string;
+  /** The single query statement to explain. */
+  statement: string;
+  /** The id of data source, the same as the QueryRequest. */
+  dataSourceId: string;
+}
+
+export interface QueryPlan {
+  /** The root node of the plan tree. */
+  root:
+    | QueryPlanNode
+    | undefined;
+  /** The raw plan returned by the database, e.g. the output of EXPLAIN (FORMAT JSON) in PostgreSQL. */
+  raw: string;
+}
+
+export interface QueryPlanNode {
+  /** The operation of the node, e.g. "Seq Scan" and "Hash Join" in PostgreSQL, "Full Table Scan" and "Nested Loop" in MySQL. */
+  operation: string;
+  /** The table scanned by the node. */
+  relation: string;
+  /** The index used by the node. */
+  index: string;
+  /** The details of the node, e.g. the filter and the join condition. */
+  details: string[];
+  /** The estimated cost before the first row is returned, it's 0 if the engine doesn't estimate it. */
+  startupCost: number;
+  /** The estimated total cost of the node including its children. */
+  totalCost: number;
+  /** The estimated number of rows returned by the node. */
+  estimatedRows: number;
+  children: QueryPlanNode[];
+}
+
+export interface CancelQueryRequest {
+  /**
+   * The name is the database the query is running against.
+   * Format: instances/{instance}/databases/{databaseName}
+   */
+  name: string;
+  /** The query_id in the QueryRequest. */
+  queryId: string;
 }
 
 export interface QueryResponse {
@@ -118,6 +262,8 @@ export interface QueryResult {
     | undefined;
   /** The query statement for the result. */
   statement: string;
+  /** There are more rows after the `limit` rows, fetch them by the `offset` in the QueryRequest. */
+  hasMore: boolean;
 }
 
 export interface QueryRow {
@@ -249,6 +395,12 @@ export interface ExportRequest {
   admin: boolean;
   /** The zip password provide by users. */
   password: string;
+  /**
+   * The id of data source.
+   * It is used for exporting from admin data source even if the instance has read-only data sources.
+   * Or it can be used to export from a specific read-only data source.
+   */
+  dataSourceId: string;
 }
 
 export interface ExportResponse {
@@ -409,6 +561,12 @@ export interface SearchQueryHistoriesRequest {
    *    instance = "instance/{instance}"
    * - type, for example:
    *    type = "QUERY"
+   * - create_time, the RFC 3339 timestamps, for example:
+   *    create_time >= "2024-01-01T00:00:00Z" && create_time <= "2024-01-08T00:00:00Z"
+   * - query, the statement contains the text case-insensitively, for example:
+   *    query = "orders"
+   * - creator, the histories of the other users require the bb.auditLogs.search permission, for example:
+   *    creator = "users/{email}"
    */
   filter: string;
 }
@@ -441,6 +599,8 @@ export interface QueryHistory {
   error?: string | undefined;
   duration: Duration | undefined;
   type: QueryHistory_Type;
+  /** The number of rows returned by the query. */
+  rowCount: Long;
 }
 
 export enum QueryHistory_Type {
@@ -522,6 +682,81 @@ export interface GenerateRestoreSQLResponse {
   statement: string;
 }
 
+export interface GenerateSQLRequest {
+  /**
+   * The database to generate the SQL statement for.
+   * Format: instances/{instance}/databases/{databaseName}
+   */
+  name: string;
+  /** The question in natural language, e.g. "Find the top 10 customers by order amount". */
+  prompt: string;
+  /** The previous messages of the conversation, the oldest first. */
+  history: GenerateSQLRequest_Message[];
+}
+
+export interface GenerateSQLRequest_Message {
+  role: GenerateSQLRequest_Message_Role;
+  content: string;
+}
+
+export enum GenerateSQLRequest_Message_Role {
+  ROLE_UNSPECIFIED = "ROLE_UNSPECIFIED",
+  USER = "USER",
+  ASSISTANT = "ASSISTANT",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function generateSQLRequest_Message_RoleFromJSON(object: any): GenerateSQLRequest_Message_Role {
+  switch (object) {
+    case 0:
+    case "ROLE_UNSPECIFIED":
+      return GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED;
+    case 1:
+    case "USER":
+      return GenerateSQLRequest_Message_Role.USER;
+    case 2:
+    case "ASSISTANT":
+      return GenerateSQLRequest_Message_Role.ASSISTANT;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return GenerateSQLRequest_Message_Role.UNRECOGNIZED;
+  }
+}
+
+export function generateSQLRequest_Message_RoleToJSON(object: GenerateSQLRequest_Message_Role): string {
+  switch (object) {
+    case GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED:
+      return "ROLE_UNSPECIFIED";
+    case GenerateSQLRequest_Message_Role.USER:
+      return "USER";
+    case GenerateSQLRequest_Message_Role.ASSISTANT:
+      return "ASSISTANT";
+    case GenerateSQLRequest_Message_Role.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function generateSQLRequest_Message_RoleToNumber(object: GenerateSQLRequest_Message_Role): number {
+  switch (object) {
+    case GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED:
+      return 0;
+    case GenerateSQLRequest_Message_Role.USER:
+      return 1;
+    case GenerateSQLRequest_Message_Role.ASSISTANT:
+      return 2;
+    case GenerateSQLRequest_Message_Role.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface GenerateSQLResponse {
+  /** The generated SQL statement. */
+  statement: string;
+}
+
 function createBaseExecuteRequest(): ExecuteRequest {
   return { name: "", statement: "", limit: 0, timeout: undefined };
 }
@@ -703,7 +938,7 @@ export const ExecuteResponse = {
 };
 
 function createBaseAdminExecuteRequest(): AdminExecuteRequest {
-  return { name: "", connectionDatabase: "", statement: "", limit: 0, timeout: undefined };
+  return { name: "", connectionDatabase: "", statement: "", limit: 0, timeout: undefined, justification: "" };
 }
 
 export const AdminExecuteRequest = {
@@ -723,6 +958,9 @@ export const AdminExecuteRequest = {
     if (message.timeout !== undefined) {
       Duration.encode(message.timeout, writer.uint32(42).fork()).ldelim();
     }
+    if (message.justification !== "") {
+      writer.uint32(50).string(message.justification);
+    }
     return writer;
   },
 
@@ -768,6 +1006,13 @@ export const AdminExecuteRequest = {
 
           message.timeout = Duration.decode(reader, reader.uint32());
           continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.justification = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -784,6 +1029,7 @@ export const AdminExecuteRequest = {
       statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
       limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
       timeout: isSet(object.timeout) ? Duration.fromJSON(object.timeout) : undefined,
+      justification: isSet(object.justification) ? globalThis.String(object.justification) : "",
     };
   },
 
@@ -804,6 +1050,9 @@ export const AdminExecuteRequest = {
     if (message.timeout !== undefined) {
       obj.timeout = Duration.toJSON(message.timeout);
     }
+    if (message.justification !== "") {
+      obj.justification = message.justification;
+    }
     return obj;
   },
 
@@ -819,6 +1068,7 @@ export const AdminExecuteRequest = {
     message.timeout = (object.timeout !== undefined && object.timeout !== null)
       ? Duration.fromPartial(object.timeout)
       : undefined;
+    message.justification = object.justification ?? "";
     return message;
   },
 };
@@ -891,6 +1141,8 @@ function createBaseQueryRequest(): QueryRequest {
     timeout: undefined,
     dataSourceId: "",
     explain: false,
+    queryId: "",
+    offset: 0,
   };
 }
 
@@ -917,6 +1169,12 @@ export const QueryRequest = {
     if (message.explain === true) {
       writer.uint32(56).bool(message.explain);
     }
+    if (message.queryId !== "") {
+      writer.uint32(66).string(message.queryId);
+    }
+    if (message.offset !== 0) {
+      writer.uint32(72).int32(message.offset);
+    }
     return writer;
   },
 
@@ -976,6 +1234,20 @@ export const QueryRequest = {
 
           message.explain = reader.bool();
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.queryId = reader.string();
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.offset = reader.int32();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -994,6 +1266,8 @@ export const QueryRequest = {
       timeout: isSet(object.timeout) ? Duration.fromJSON(object.timeout) : undefined,
       dataSourceId: isSet(object.dataSourceId) ? globalThis.String(object.dataSourceId) : "",
       explain: isSet(object.explain) ? globalThis.Boolean(object.explain) : false,
+      queryId: isSet(object.queryId) ? globalThis.String(object.queryId) : "",
+      offset: isSet(object.offset) ? globalThis.Number(object.offset) : 0,
     };
   },
 
@@ -1020,6 +1294,12 @@ export const QueryRequest = {
     if (message.explain === true) {
       obj.explain = message.explain;
     }
+    if (message.queryId !== "") {
+      obj.queryId = message.queryId;
+    }
+    if (message.offset !== 0) {
+      obj.offset = Math.round(message.offset);
+    }
     return obj;
   },
 
@@ -1037,32 +1317,34 @@ export const QueryRequest = {
       : undefined;
     message.dataSourceId = object.dataSourceId ?? "";
     message.explain = object.explain ?? false;
+    message.queryId = object.queryId ?? "";
+    message.offset = object.offset ?? 0;
     return message;
   },
 };
 
-function createBaseQueryResponse(): QueryResponse {
-  return { results: [], advices: [], allowExport: false };
+function createBaseQueryStreamResponse(): QueryStreamResponse {
+  return { header: undefined, rows: undefined, footer: undefined };
 }
 
-export const QueryResponse = {
-  encode(message: QueryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.results) {
-      QueryResult.encode(v!, writer.uint32(10).fork()).ldelim();
+export const QueryStreamResponse = {
+  encode(message: QueryStreamResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.header !== undefined) {
+      QueryResult.encode(message.header, writer.uint32(10).fork()).ldelim();
     }
-    for (const v of message.advices) {
-      Advice.encode(v!, writer.uint32(18).fork()).ldelim();
+    if (message.rows !== undefined) {
+      QueryStreamResponse_Rows.encode(message.rows, writer.uint32(18).fork()).ldelim();
     }
-    if (message.allowExport === true) {
-      writer.uint32(24).bool(message.allowExport);
+    if (message.footer !== undefined) {
+      QueryStreamResponse_Footer.encode(message.footer, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryStreamResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryResponse();
+    const message = createBaseQueryStreamResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1071,21 +1353,21 @@ export const QueryResponse = {
             break;
           }
 
-          message.results.push(QueryResult.decode(reader, reader.uint32()));
+          message.header = QueryResult.decode(reader, reader.uint32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.advices.push(Advice.decode(reader, reader.uint32()));
+          message.rows = QueryStreamResponse_Rows.decode(reader, reader.uint32());
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.allowExport = reader.bool();
+          message.footer = QueryStreamResponse_Footer.decode(reader, reader.uint32());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1096,90 +1378,122 @@ export const QueryResponse = {
     return message;
   },
 
-  fromJSON(object: any): QueryResponse {
+  fromJSON(object: any): QueryStreamResponse {
     return {
-      results: globalThis.Array.isArray(object?.results) ? object.results.map((e: any) => QueryResult.fromJSON(e)) : [],
-      advices: globalThis.Array.isArray(object?.advices) ? object.advices.map((e: any) => Advice.fromJSON(e)) : [],
-      allowExport: isSet(object.allowExport) ? globalThis.Boolean(object.allowExport) : false,
+      header: isSet(object.header) ? QueryResult.fromJSON(object.header) : undefined,
+      rows: isSet(object.rows) ? QueryStreamResponse_Rows.fromJSON(object.rows) : undefined,
+      footer: isSet(object.footer) ? QueryStreamResponse_Footer.fromJSON(object.footer) : undefined,
     };
   },
 
-  toJSON(message: QueryResponse): unknown {
+  toJSON(message: QueryStreamResponse): unknown {
     const obj: any = {};
-    if (message.results?.length) {
-      obj.results = message.results.map((e) => QueryResult.toJSON(e));
+    if (message.header !== undefined) {
+      obj.header = QueryResult.toJSON(message.header);
     }
-    if (message.advices?.length) {
-      obj.advices = message.advices.map((e) => Advice.toJSON(e));
+    if (message.rows !== undefined) {
+      obj.rows = QueryStreamResponse_Rows.toJSON(message.rows);
     }
-    if (message.allowExport === true) {
-      obj.allowExport = message.allowExport;
+    if (message.footer !== undefined) {
+      obj.footer = QueryStreamResponse_Footer.toJSON(message.footer);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<QueryResponse>): QueryResponse {
-    return QueryResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<QueryStreamResponse>): QueryStreamResponse {
+    return QueryStreamResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<QueryResponse>): QueryResponse {
-    const message = createBaseQueryResponse();
-    message.results = object.results?.map((e) => QueryResult.fromPartial(e)) || [];
-    message.advices = object.advices?.map((e) => Advice.fromPartial(e)) || [];
-    message.allowExport = object.allowExport ?? false;
+  fromPartial(object: DeepPartial<QueryStreamResponse>): QueryStreamResponse {
+    const message = createBaseQueryStreamResponse();
+    message.header = (object.header !== undefined && object.header !== null)
+      ? QueryResult.fromPartial(object.header)
+      : undefined;
+    message.rows = (object.rows !== undefined && object.rows !== null)
+      ? QueryStreamResponse_Rows.fromPartial(object.rows)
+      : undefined;
+    message.footer = (object.footer !== undefined && object.footer !== null)
+      ? QueryStreamResponse_Footer.fromPartial(object.footer)
+      : undefined;
     return message;
   },
 };
 
-function createBaseQueryResult(): QueryResult {
-  return {
-    columnNames: [],
-    columnTypeNames: [],
-    rows: [],
-    masked: [],
-    sensitive: [],
-    error: "",
-    latency: undefined,
-    statement: "",
-  };
+function createBaseQueryStreamResponse_Rows(): QueryStreamResponse_Rows {
+  return { rows: [] };
 }
 
-export const QueryResult = {
-  encode(message: QueryResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.columnNames) {
-      writer.uint32(10).string(v!);
-    }
-    for (const v of message.columnTypeNames) {
-      writer.uint32(18).string(v!);
-    }
+export const QueryStreamResponse_Rows = {
+  encode(message: QueryStreamResponse_Rows, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     for (const v of message.rows) {
-      QueryRow.encode(v!, writer.uint32(26).fork()).ldelim();
-    }
-    writer.uint32(34).fork();
-    for (const v of message.masked) {
-      writer.bool(v);
+      QueryRow.encode(v!, writer.uint32(10).fork()).ldelim();
     }
-    writer.ldelim();
-    writer.uint32(42).fork();
-    for (const v of message.sensitive) {
-      writer.bool(v);
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryStreamResponse_Rows {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryStreamResponse_Rows();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.rows.push(QueryRow.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
     }
-    writer.ldelim();
-    if (message.error !== "") {
-      writer.uint32(50).string(message.error);
+    return message;
+  },
+
+  fromJSON(object: any): QueryStreamResponse_Rows {
+    return { rows: globalThis.Array.isArray(object?.rows) ? object.rows.map((e: any) => QueryRow.fromJSON(e)) : [] };
+  },
+
+  toJSON(message: QueryStreamResponse_Rows): unknown {
+    const obj: any = {};
+    if (message.rows?.length) {
+      obj.rows = message.rows.map((e) => QueryRow.toJSON(e));
     }
-    if (message.latency !== undefined) {
-      Duration.encode(message.latency, writer.uint32(58).fork()).ldelim();
+    return obj;
+  },
+
+  create(base?: DeepPartial<QueryStreamResponse_Rows>): QueryStreamResponse_Rows {
+    return QueryStreamResponse_Rows.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<QueryStreamResponse_Rows>): QueryStreamResponse_Rows {
+    const message = createBaseQueryStreamResponse_Rows();
+    message.rows = object.rows?.map((e) => QueryRow.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseQueryStreamResponse_Footer(): QueryStreamResponse_Footer {
+  return { advices: [], allowExport: false };
+}
+
+export const QueryStreamResponse_Footer = {
+  encode(message: QueryStreamResponse_Footer, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.advices) {
+      Advice.encode(v!, writer.uint32(10).fork()).ldelim();
     }
-    if (message.statement !== "") {
-      writer.uint32(66).string(message.statement);
+    if (message.allowExport === true) {
+      writer.uint32(16).bool(message.allowExport);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryResult {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryStreamResponse_Footer {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryResult();
+    const message = createBaseQueryStreamResponse_Footer();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1188,76 +1502,98 @@ export const QueryResult = {
             break;
           }
 
-          message.columnNames.push(reader.string());
+          message.advices.push(Advice.decode(reader, reader.uint32()));
           continue;
         case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.columnTypeNames.push(reader.string());
-          continue;
-        case 3:
-          if (tag !== 26) {
+          if (tag !== 16) {
             break;
           }
 
-          message.rows.push(QueryRow.decode(reader, reader.uint32()));
+          message.allowExport = reader.bool();
           continue;
-        case 4:
-          if (tag === 32) {
-            message.masked.push(reader.bool());
-
-            continue;
-          }
-
-          if (tag === 34) {
-            const end2 = reader.uint32() + reader.pos;
-            while (reader.pos < end2) {
-              message.masked.push(reader.bool());
-            }
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
 
-            continue;
-          }
+  fromJSON(object: any): QueryStreamResponse_Footer {
+    return {
+      advices: globalThis.Array.isArray(object?.advices) ? object.advices.map((e: any) => Advice.fromJSON(e)) : [],
+      allowExport: isSet(object.allowExport) ? globalThis.Boolean(object.allowExport) : false,
+    };
+  },
 
-          break;
-        case 5:
-          if (tag === 40) {
-            message.sensitive.push(reader.bool());
+  toJSON(message: QueryStreamResponse_Footer): unknown {
+    const obj: any = {};
+    if (message.advices?.length) {
+      obj.advices = message.advices.map((e) => Advice.toJSON(e));
+    }
+    if (message.allowExport === true) {
+      obj.allowExport = message.allowExport;
+    }
+    return obj;
+  },
 
-            continue;
-          }
+  create(base?: DeepPartial<QueryStreamResponse_Footer>): QueryStreamResponse_Footer {
+    return QueryStreamResponse_Footer.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<QueryStreamResponse_Footer>): QueryStreamResponse_Footer {
+    const message = createBaseQueryStreamResponse_Footer();
+    message.advices = object.advices?.map((e) => Advice.fromPartial(e)) || [];
+    message.allowExport = object.allowExport ?? false;
+    return message;
+  },
+};
 
-          if (tag === 42) {
-            const end2 = reader.uint32() + reader.pos;
-            while (reader.pos < end2) {
-              message.sensitive.push(reader.bool());
-            }
+function createBaseListAdminSessionsRequest(): ListAdminSessionsRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
 
-            continue;
-          }
+export const ListAdminSessionsRequest = {
+  encode(message: ListAdminSessionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
 
-          break;
-        case 6:
-          if (tag !== 50) {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListAdminSessionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListAdminSessionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
             break;
           }
 
-          message.error = reader.string();
+          message.parent = reader.string();
           continue;
-        case 7:
-          if (tag !== 58) {
+        case 2:
+          if (tag !== 16) {
             break;
           }
 
-          message.latency = Duration.decode(reader, reader.uint32());
+          message.pageSize = reader.int32();
           continue;
-        case 8:
-          if (tag !== 66) {
+        case 3:
+          if (tag !== 26) {
             break;
           }
 
-          message.statement = reader.string();
+          message.pageToken = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1268,89 +1604,59 @@ export const QueryResult = {
     return message;
   },
 
-  fromJSON(object: any): QueryResult {
+  fromJSON(object: any): ListAdminSessionsRequest {
     return {
-      columnNames: globalThis.Array.isArray(object?.columnNames)
-        ? object.columnNames.map((e: any) => globalThis.String(e))
-        : [],
-      columnTypeNames: globalThis.Array.isArray(object?.columnTypeNames)
-        ? object.columnTypeNames.map((e: any) => globalThis.String(e))
-        : [],
-      rows: globalThis.Array.isArray(object?.rows) ? object.rows.map((e: any) => QueryRow.fromJSON(e)) : [],
-      masked: globalThis.Array.isArray(object?.masked) ? object.masked.map((e: any) => globalThis.Boolean(e)) : [],
-      sensitive: globalThis.Array.isArray(object?.sensitive)
-        ? object.sensitive.map((e: any) => globalThis.Boolean(e))
-        : [],
-      error: isSet(object.error) ? globalThis.String(object.error) : "",
-      latency: isSet(object.latency) ? Duration.fromJSON(object.latency) : undefined,
-      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      parent: isSet(object.parent) ? globalThis.String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
     };
   },
 
-  toJSON(message: QueryResult): unknown {
+  toJSON(message: ListAdminSessionsRequest): unknown {
     const obj: any = {};
-    if (message.columnNames?.length) {
-      obj.columnNames = message.columnNames;
-    }
-    if (message.columnTypeNames?.length) {
-      obj.columnTypeNames = message.columnTypeNames;
-    }
-    if (message.rows?.length) {
-      obj.rows = message.rows.map((e) => QueryRow.toJSON(e));
-    }
-    if (message.masked?.length) {
-      obj.masked = message.masked;
-    }
-    if (message.sensitive?.length) {
-      obj.sensitive = message.sensitive;
+    if (message.parent !== "") {
+      obj.parent = message.parent;
     }
-    if (message.error !== "") {
-      obj.error = message.error;
-    }
-    if (message.latency !== undefined) {
-      obj.latency = Duration.toJSON(message.latency);
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
     }
-    if (message.statement !== "") {
-      obj.statement = message.statement;
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<QueryResult>): QueryResult {
-    return QueryResult.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListAdminSessionsRequest>): ListAdminSessionsRequest {
+    return ListAdminSessionsRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<QueryResult>): QueryResult {
-    const message = createBaseQueryResult();
-    message.columnNames = object.columnNames?.map((e) => e) || [];
-    message.columnTypeNames = object.columnTypeNames?.map((e) => e) || [];
-    message.rows = object.rows?.map((e) => QueryRow.fromPartial(e)) || [];
-    message.masked = object.masked?.map((e) => e) || [];
-    message.sensitive = object.sensitive?.map((e) => e) || [];
-    message.error = object.error ?? "";
-    message.latency = (object.latency !== undefined && object.latency !== null)
-      ? Duration.fromPartial(object.latency)
-      : undefined;
-    message.statement = object.statement ?? "";
+  fromPartial(object: DeepPartial<ListAdminSessionsRequest>): ListAdminSessionsRequest {
+    const message = createBaseListAdminSessionsRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
     return message;
   },
 };
 
-function createBaseQueryRow(): QueryRow {
-  return { values: [] };
+function createBaseListAdminSessionsResponse(): ListAdminSessionsResponse {
+  return { sessions: [], nextPageToken: "" };
 }
 
-export const QueryRow = {
-  encode(message: QueryRow, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.values) {
-      RowValue.encode(v!, writer.uint32(10).fork()).ldelim();
+export const ListAdminSessionsResponse = {
+  encode(message: ListAdminSessionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.sessions) {
+      AdminSession.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRow {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListAdminSessionsResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryRow();
+    const message = createBaseListAdminSessionsResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1359,7 +1665,14 @@ export const QueryRow = {
             break;
           }
 
-          message.values.push(RowValue.decode(reader, reader.uint32()));
+          message.sessions.push(AdminSession.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1370,167 +1683,112 @@ export const QueryRow = {
     return message;
   },
 
-  fromJSON(object: any): QueryRow {
+  fromJSON(object: any): ListAdminSessionsResponse {
     return {
-      values: globalThis.Array.isArray(object?.values) ? object.values.map((e: any) => RowValue.fromJSON(e)) : [],
+      sessions: globalThis.Array.isArray(object?.sessions)
+        ? object.sessions.map((e: any) => AdminSession.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
     };
   },
 
-  toJSON(message: QueryRow): unknown {
+  toJSON(message: ListAdminSessionsResponse): unknown {
     const obj: any = {};
-    if (message.values?.length) {
-      obj.values = message.values.map((e) => RowValue.toJSON(e));
+    if (message.sessions?.length) {
+      obj.sessions = message.sessions.map((e) => AdminSession.toJSON(e));
+    }
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<QueryRow>): QueryRow {
-    return QueryRow.fromPartial(base ?? {});
+  create(base?: DeepPartial<ListAdminSessionsResponse>): ListAdminSessionsResponse {
+    return ListAdminSessionsResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<QueryRow>): QueryRow {
-    const message = createBaseQueryRow();
-    message.values = object.values?.map((e) => RowValue.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<ListAdminSessionsResponse>): ListAdminSessionsResponse {
+    const message = createBaseListAdminSessionsResponse();
+    message.sessions = object.sessions?.map((e) => AdminSession.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-function createBaseRowValue(): RowValue {
-  return {
-    nullValue: undefined,
-    boolValue: undefined,
-    bytesValue: undefined,
-    doubleValue: undefined,
-    floatValue: undefined,
-    int32Value: undefined,
-    int64Value: undefined,
-    stringValue: undefined,
-    uint32Value: undefined,
-    uint64Value: undefined,
-    valueValue: undefined,
-  };
+function createBaseAdminSession(): AdminSession {
+  return { name: "", database: "", creator: "", createTime: undefined, justification: "", statements: [] };
 }
 
-export const RowValue = {
-  encode(message: RowValue, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.nullValue !== undefined) {
-      writer.uint32(8).int32(nullValueToNumber(message.nullValue));
-    }
-    if (message.boolValue !== undefined) {
-      writer.uint32(16).bool(message.boolValue);
-    }
-    if (message.bytesValue !== undefined) {
-      writer.uint32(26).bytes(message.bytesValue);
-    }
-    if (message.doubleValue !== undefined) {
-      writer.uint32(33).double(message.doubleValue);
-    }
-    if (message.floatValue !== undefined) {
-      writer.uint32(45).float(message.floatValue);
-    }
-    if (message.int32Value !== undefined) {
-      writer.uint32(48).int32(message.int32Value);
+export const AdminSession = {
+  encode(message: AdminSession, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.int64Value !== undefined) {
-      writer.uint32(56).int64(message.int64Value);
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
     }
-    if (message.stringValue !== undefined) {
-      writer.uint32(66).string(message.stringValue);
+    if (message.creator !== "") {
+      writer.uint32(26).string(message.creator);
     }
-    if (message.uint32Value !== undefined) {
-      writer.uint32(72).uint32(message.uint32Value);
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(34).fork()).ldelim();
     }
-    if (message.uint64Value !== undefined) {
-      writer.uint32(80).uint64(message.uint64Value);
+    if (message.justification !== "") {
+      writer.uint32(42).string(message.justification);
     }
-    if (message.valueValue !== undefined) {
-      Value.encode(Value.wrap(message.valueValue), writer.uint32(90).fork()).ldelim();
+    for (const v of message.statements) {
+      AdminSession_Statement.encode(v!, writer.uint32(50).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): RowValue {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AdminSession {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseRowValue();
+    const message = createBaseAdminSession();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.nullValue = nullValueFromJSON(reader.int32());
+          message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.boolValue = reader.bool();
+          message.database = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.bytesValue = reader.bytes();
+          message.creator = reader.string();
           continue;
         case 4:
-          if (tag !== 33) {
+          if (tag !== 34) {
             break;
           }
 
-          message.doubleValue = reader.double();
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 5:
-          if (tag !== 45) {
+          if (tag !== 42) {
             break;
           }
 
-          message.floatValue = reader.float();
+          message.justification = reader.string();
           continue;
         case 6:
-          if (tag !== 48) {
-            break;
-          }
-
-          message.int32Value = reader.int32();
-          continue;
-        case 7:
-          if (tag !== 56) {
-            break;
-          }
-
-          message.int64Value = reader.int64() as Long;
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.stringValue = reader.string();
-          continue;
-        case 9:
-          if (tag !== 72) {
-            break;
-          }
-
-          message.uint32Value = reader.uint32();
-          continue;
-        case 10:
-          if (tag !== 80) {
-            break;
-          }
-
-          message.uint64Value = reader.uint64() as Long;
-          continue;
-        case 11:
-          if (tag !== 90) {
+          if (tag !== 50) {
             break;
           }
 
-          message.valueValue = Value.unwrap(Value.decode(reader, reader.uint32()));
+          message.statements.push(AdminSession_Statement.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1541,199 +1799,139 @@ export const RowValue = {
     return message;
   },
 
-  fromJSON(object: any): RowValue {
+  fromJSON(object: any): AdminSession {
     return {
-      nullValue: isSet(object.nullValue) ? nullValueFromJSON(object.nullValue) : undefined,
-      boolValue: isSet(object.boolValue) ? globalThis.Boolean(object.boolValue) : undefined,
-      bytesValue: isSet(object.bytesValue) ? bytesFromBase64(object.bytesValue) : undefined,
-      doubleValue: isSet(object.doubleValue) ? globalThis.Number(object.doubleValue) : undefined,
-      floatValue: isSet(object.floatValue) ? globalThis.Number(object.floatValue) : undefined,
-      int32Value: isSet(object.int32Value) ? globalThis.Number(object.int32Value) : undefined,
-      int64Value: isSet(object.int64Value) ? Long.fromValue(object.int64Value) : undefined,
-      stringValue: isSet(object.stringValue) ? globalThis.String(object.stringValue) : undefined,
-      uint32Value: isSet(object.uint32Value) ? globalThis.Number(object.uint32Value) : undefined,
-      uint64Value: isSet(object.uint64Value) ? Long.fromValue(object.uint64Value) : undefined,
-      valueValue: isSet(object?.valueValue) ? object.valueValue : undefined,
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      justification: isSet(object.justification) ? globalThis.String(object.justification) : "",
+      statements: globalThis.Array.isArray(object?.statements)
+        ? object.statements.map((e: any) => AdminSession_Statement.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: RowValue): unknown {
+  toJSON(message: AdminSession): unknown {
     const obj: any = {};
-    if (message.nullValue !== undefined) {
-      obj.nullValue = nullValueToJSON(message.nullValue);
-    }
-    if (message.boolValue !== undefined) {
-      obj.boolValue = message.boolValue;
-    }
-    if (message.bytesValue !== undefined) {
-      obj.bytesValue = base64FromBytes(message.bytesValue);
-    }
-    if (message.doubleValue !== undefined) {
-      obj.doubleValue = message.doubleValue;
-    }
-    if (message.floatValue !== undefined) {
-      obj.floatValue = message.floatValue;
-    }
-    if (message.int32Value !== undefined) {
-      obj.int32Value = Math.round(message.int32Value);
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.int64Value !== undefined) {
-      obj.int64Value = (message.int64Value || Long.ZERO).toString();
+    if (message.database !== "") {
+      obj.database = message.database;
     }
-    if (message.stringValue !== undefined) {
-      obj.stringValue = message.stringValue;
+    if (message.creator !== "") {
+      obj.creator = message.creator;
     }
-    if (message.uint32Value !== undefined) {
-      obj.uint32Value = Math.round(message.uint32Value);
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
     }
-    if (message.uint64Value !== undefined) {
-      obj.uint64Value = (message.uint64Value || Long.UZERO).toString();
+    if (message.justification !== "") {
+      obj.justification = message.justification;
     }
-    if (message.valueValue !== undefined) {
-      obj.valueValue = message.valueValue;
+    if (message.statements?.length) {
+      obj.statements = message.statements.map((e) => AdminSession_Statement.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<RowValue>): RowValue {
-    return RowValue.fromPartial(base ?? {});
+  create(base?: DeepPartial<AdminSession>): AdminSession {
+    return AdminSession.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<RowValue>): RowValue {
-    const message = createBaseRowValue();
-    message.nullValue = object.nullValue ?? undefined;
-    message.boolValue = object.boolValue ?? undefined;
-    message.bytesValue = object.bytesValue ?? undefined;
-    message.doubleValue = object.doubleValue ?? undefined;
-    message.floatValue = object.floatValue ?? undefined;
-    message.int32Value = object.int32Value ?? undefined;
-    message.int64Value = (object.int64Value !== undefined && object.int64Value !== null)
-      ? Long.fromValue(object.int64Value)
-      : undefined;
-    message.stringValue = object.stringValue ?? undefined;
-    message.uint32Value = object.uint32Value ?? undefined;
-    message.uint64Value = (object.uint64Value !== undefined && object.uint64Value !== null)
-      ? Long.fromValue(object.uint64Value)
-      : undefined;
-    message.valueValue = object.valueValue ?? undefined;
+  fromPartial(object: DeepPartial<AdminSession>): AdminSession {
+    const message = createBaseAdminSession();
+    message.name = object.name ?? "";
+    message.database = object.database ?? "";
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.justification = object.justification ?? "";
+    message.statements = object.statements?.map((e) => AdminSession_Statement.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseAdvice(): Advice {
+function createBaseAdminSession_Statement(): AdminSession_Statement {
   return {
-    status: Advice_Status.STATUS_UNSPECIFIED,
-    code: 0,
-    title: "",
-    content: "",
-    line: 0,
-    column: 0,
-    detail: "",
-    startPosition: undefined,
-    endPosition: undefined,
+    statement: "",
+    createTime: undefined,
+    duration: undefined,
+    affectedRows: Long.ZERO,
+    rowCount: Long.ZERO,
+    error: "",
   };
 }
 
-export const Advice = {
-  encode(message: Advice, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.status !== Advice_Status.STATUS_UNSPECIFIED) {
-      writer.uint32(8).int32(advice_StatusToNumber(message.status));
-    }
-    if (message.code !== 0) {
-      writer.uint32(16).int32(message.code);
-    }
-    if (message.title !== "") {
-      writer.uint32(26).string(message.title);
-    }
-    if (message.content !== "") {
-      writer.uint32(34).string(message.content);
+export const AdminSession_Statement = {
+  encode(message: AdminSession_Statement, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.statement !== "") {
+      writer.uint32(10).string(message.statement);
     }
-    if (message.line !== 0) {
-      writer.uint32(40).int32(message.line);
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(18).fork()).ldelim();
     }
-    if (message.column !== 0) {
-      writer.uint32(48).int32(message.column);
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(26).fork()).ldelim();
     }
-    if (message.detail !== "") {
-      writer.uint32(58).string(message.detail);
+    if (!message.affectedRows.isZero()) {
+      writer.uint32(32).int64(message.affectedRows);
     }
-    if (message.startPosition !== undefined) {
-      Position.encode(message.startPosition, writer.uint32(66).fork()).ldelim();
+    if (!message.rowCount.isZero()) {
+      writer.uint32(40).int64(message.rowCount);
     }
-    if (message.endPosition !== undefined) {
-      Position.encode(message.endPosition, writer.uint32(74).fork()).ldelim();
+    if (message.error !== "") {
+      writer.uint32(50).string(message.error);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Advice {
+  decode(input: _m0.Reader | Uint8Array, length?: number): AdminSession_Statement {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseAdvice();
+    const message = createBaseAdminSession_Statement();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.status = advice_StatusFromJSON(reader.int32());
+          message.statement = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.code = reader.int32();
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.title = reader.string();
+          message.duration = Duration.decode(reader, reader.uint32());
           continue;
         case 4:
-          if (tag !== 34) {
+          if (tag !== 32) {
             break;
           }
 
-          message.content = reader.string();
+          message.affectedRows = reader.int64() as Long;
           continue;
         case 5:
           if (tag !== 40) {
             break;
           }
 
-          message.line = reader.int32();
+          message.rowCount = reader.int64() as Long;
           continue;
         case 6:
-          if (tag !== 48) {
-            break;
-          }
-
-          message.column = reader.int32();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.detail = reader.string();
-          continue;
-        case 8:
-          if (tag !== 66) {
-            break;
-          }
-
-          message.startPosition = Position.decode(reader, reader.uint32());
-          continue;
-        case 9:
-          if (tag !== 74) {
+          if (tag !== 50) {
             break;
           }
 
-          message.endPosition = Position.decode(reader, reader.uint32());
+          message.error = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1744,116 +1942,83 @@ export const Advice = {
     return message;
   },
 
-  fromJSON(object: any): Advice {
+  fromJSON(object: any): AdminSession_Statement {
     return {
-      status: isSet(object.status) ? advice_StatusFromJSON(object.status) : Advice_Status.STATUS_UNSPECIFIED,
-      code: isSet(object.code) ? globalThis.Number(object.code) : 0,
-      title: isSet(object.title) ? globalThis.String(object.title) : "",
-      content: isSet(object.content) ? globalThis.String(object.content) : "",
-      line: isSet(object.line) ? globalThis.Number(object.line) : 0,
-      column: isSet(object.column) ? globalThis.Number(object.column) : 0,
-      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
-      startPosition: isSet(object.startPosition) ? Position.fromJSON(object.startPosition) : undefined,
-      endPosition: isSet(object.endPosition) ? Position.fromJSON(object.endPosition) : undefined,
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      affectedRows: isSet(object.affectedRows) ? Long.fromValue(object.affectedRows) : Long.ZERO,
+      rowCount: isSet(object.rowCount) ? Long.fromValue(object.rowCount) : Long.ZERO,
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
     };
   },
 
-  toJSON(message: Advice): unknown {
+  toJSON(message: AdminSession_Statement): unknown {
     const obj: any = {};
-    if (message.status !== Advice_Status.STATUS_UNSPECIFIED) {
-      obj.status = advice_StatusToJSON(message.status);
-    }
-    if (message.code !== 0) {
-      obj.code = Math.round(message.code);
-    }
-    if (message.title !== "") {
-      obj.title = message.title;
-    }
-    if (message.content !== "") {
-      obj.content = message.content;
+    if (message.statement !== "") {
+      obj.statement = message.statement;
     }
-    if (message.line !== 0) {
-      obj.line = Math.round(message.line);
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
     }
-    if (message.column !== 0) {
-      obj.column = Math.round(message.column);
+    if (message.duration !== undefined) {
+      obj.duration = Duration.toJSON(message.duration);
     }
-    if (message.detail !== "") {
-      obj.detail = message.detail;
+    if (!message.affectedRows.isZero()) {
+      obj.affectedRows = (message.affectedRows || Long.ZERO).toString();
     }
-    if (message.startPosition !== undefined) {
-      obj.startPosition = Position.toJSON(message.startPosition);
+    if (!message.rowCount.isZero()) {
+      obj.rowCount = (message.rowCount || Long.ZERO).toString();
     }
-    if (message.endPosition !== undefined) {
-      obj.endPosition = Position.toJSON(message.endPosition);
+    if (message.error !== "") {
+      obj.error = message.error;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<Advice>): Advice {
-    return Advice.fromPartial(base ?? {});
+  create(base?: DeepPartial<AdminSession_Statement>): AdminSession_Statement {
+    return AdminSession_Statement.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<Advice>): Advice {
-    const message = createBaseAdvice();
-    message.status = object.status ?? Advice_Status.STATUS_UNSPECIFIED;
-    message.code = object.code ?? 0;
-    message.title = object.title ?? "";
-    message.content = object.content ?? "";
-    message.line = object.line ?? 0;
-    message.column = object.column ?? 0;
-    message.detail = object.detail ?? "";
-    message.startPosition = (object.startPosition !== undefined && object.startPosition !== null)
-      ? Position.fromPartial(object.startPosition)
-      : undefined;
-    message.endPosition = (object.endPosition !== undefined && object.endPosition !== null)
-      ? Position.fromPartial(object.endPosition)
+  fromPartial(object: DeepPartial<AdminSession_Statement>): AdminSession_Statement {
+    const message = createBaseAdminSession_Statement();
+    message.statement = object.statement ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
       : undefined;
+    message.affectedRows = (object.affectedRows !== undefined && object.affectedRows !== null)
+      ? Long.fromValue(object.affectedRows)
+      : Long.ZERO;
+    message.rowCount = (object.rowCount !== undefined && object.rowCount !== null)
+      ? Long.fromValue(object.rowCount)
+      : Long.ZERO;
+    message.error = object.error ?? "";
     return message;
   },
 };
 
-function createBaseExportRequest(): ExportRequest {
-  return {
-    name: "",
-    connectionDatabase: "",
-    statement: "",
-    limit: 0,
-    format: ExportFormat.FORMAT_UNSPECIFIED,
-    admin: false,
-    password: "",
-  };
+function createBaseGetQueryPlanRequest(): GetQueryPlanRequest {
+  return { name: "", statement: "", dataSourceId: "" };
 }
 
-export const ExportRequest = {
-  encode(message: ExportRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const GetQueryPlanRequest = {
+  encode(message: GetQueryPlanRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.name !== "") {
       writer.uint32(10).string(message.name);
     }
-    if (message.connectionDatabase !== "") {
-      writer.uint32(18).string(message.connectionDatabase);
-    }
     if (message.statement !== "") {
-      writer.uint32(26).string(message.statement);
-    }
-    if (message.limit !== 0) {
-      writer.uint32(32).int32(message.limit);
-    }
-    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
-      writer.uint32(40).int32(exportFormatToNumber(message.format));
-    }
-    if (message.admin === true) {
-      writer.uint32(48).bool(message.admin);
+      writer.uint32(18).string(message.statement);
     }
-    if (message.password !== "") {
-      writer.uint32(58).string(message.password);
+    if (message.dataSourceId !== "") {
+      writer.uint32(26).string(message.dataSourceId);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ExportRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetQueryPlanRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExportRequest();
+    const message = createBaseGetQueryPlanRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1869,42 +2034,14 @@ export const ExportRequest = {
             break;
           }
 
-          message.connectionDatabase = reader.string();
+          message.statement = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.statement = reader.string();
-          continue;
-        case 4:
-          if (tag !== 32) {
-            break;
-          }
-
-          message.limit = reader.int32();
-          continue;
-        case 5:
-          if (tag !== 40) {
-            break;
-          }
-
-          message.format = exportFormatFromJSON(reader.int32());
-          continue;
-        case 6:
-          if (tag !== 48) {
-            break;
-          }
-
-          message.admin = reader.bool();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.password = reader.string();
+          message.dataSourceId = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -1915,76 +2052,59 @@ export const ExportRequest = {
     return message;
   },
 
-  fromJSON(object: any): ExportRequest {
+  fromJSON(object: any): GetQueryPlanRequest {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      connectionDatabase: isSet(object.connectionDatabase) ? globalThis.String(object.connectionDatabase) : "",
       statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
-      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
-      format: isSet(object.format) ? exportFormatFromJSON(object.format) : ExportFormat.FORMAT_UNSPECIFIED,
-      admin: isSet(object.admin) ? globalThis.Boolean(object.admin) : false,
-      password: isSet(object.password) ? globalThis.String(object.password) : "",
+      dataSourceId: isSet(object.dataSourceId) ? globalThis.String(object.dataSourceId) : "",
     };
   },
 
-  toJSON(message: ExportRequest): unknown {
+  toJSON(message: GetQueryPlanRequest): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.connectionDatabase !== "") {
-      obj.connectionDatabase = message.connectionDatabase;
-    }
     if (message.statement !== "") {
       obj.statement = message.statement;
     }
-    if (message.limit !== 0) {
-      obj.limit = Math.round(message.limit);
-    }
-    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
-      obj.format = exportFormatToJSON(message.format);
-    }
-    if (message.admin === true) {
-      obj.admin = message.admin;
-    }
-    if (message.password !== "") {
-      obj.password = message.password;
+    if (message.dataSourceId !== "") {
+      obj.dataSourceId = message.dataSourceId;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ExportRequest>): ExportRequest {
-    return ExportRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<GetQueryPlanRequest>): GetQueryPlanRequest {
+    return GetQueryPlanRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ExportRequest>): ExportRequest {
-    const message = createBaseExportRequest();
+  fromPartial(object: DeepPartial<GetQueryPlanRequest>): GetQueryPlanRequest {
+    const message = createBaseGetQueryPlanRequest();
     message.name = object.name ?? "";
-    message.connectionDatabase = object.connectionDatabase ?? "";
     message.statement = object.statement ?? "";
-    message.limit = object.limit ?? 0;
-    message.format = object.format ?? ExportFormat.FORMAT_UNSPECIFIED;
-    message.admin = object.admin ?? false;
-    message.password = object.password ?? "";
+    message.dataSourceId = object.dataSourceId ?? "";
     return message;
   },
 };
 
-function createBaseExportResponse(): ExportResponse {
-  return { content: new Uint8Array(0) };
+function createBaseQueryPlan(): QueryPlan {
+  return { root: undefined, raw: "" };
 }
 
-export const ExportResponse = {
-  encode(message: ExportResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.content.length !== 0) {
-      writer.uint32(10).bytes(message.content);
+export const QueryPlan = {
+  encode(message: QueryPlan, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.root !== undefined) {
+      QueryPlanNode.encode(message.root, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.raw !== "") {
+      writer.uint32(18).string(message.raw);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ExportResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPlan {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseExportResponse();
+    const message = createBaseQueryPlan();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -1993,7 +2113,14 @@ export const ExportResponse = {
             break;
           }
 
-          message.content = reader.bytes();
+          message.root = QueryPlanNode.decode(reader, reader.uint32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.raw = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2004,73 +2131,141 @@ export const ExportResponse = {
     return message;
   },
 
-  fromJSON(object: any): ExportResponse {
-    return { content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0) };
+  fromJSON(object: any): QueryPlan {
+    return {
+      root: isSet(object.root) ? QueryPlanNode.fromJSON(object.root) : undefined,
+      raw: isSet(object.raw) ? globalThis.String(object.raw) : "",
+    };
   },
 
-  toJSON(message: ExportResponse): unknown {
+  toJSON(message: QueryPlan): unknown {
     const obj: any = {};
-    if (message.content.length !== 0) {
-      obj.content = base64FromBytes(message.content);
+    if (message.root !== undefined) {
+      obj.root = QueryPlanNode.toJSON(message.root);
+    }
+    if (message.raw !== "") {
+      obj.raw = message.raw;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ExportResponse>): ExportResponse {
-    return ExportResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<QueryPlan>): QueryPlan {
+    return QueryPlan.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ExportResponse>): ExportResponse {
-    const message = createBaseExportResponse();
-    message.content = object.content ?? new Uint8Array(0);
+  fromPartial(object: DeepPartial<QueryPlan>): QueryPlan {
+    const message = createBaseQueryPlan();
+    message.root = (object.root !== undefined && object.root !== null)
+      ? QueryPlanNode.fromPartial(object.root)
+      : undefined;
+    message.raw = object.raw ?? "";
     return message;
   },
 };
 
-function createBaseDifferPreviewRequest(): DifferPreviewRequest {
-  return { engine: Engine.ENGINE_UNSPECIFIED, oldSchema: "", newMetadata: undefined };
+function createBaseQueryPlanNode(): QueryPlanNode {
+  return {
+    operation: "",
+    relation: "",
+    index: "",
+    details: [],
+    startupCost: 0,
+    totalCost: 0,
+    estimatedRows: 0,
+    children: [],
+  };
 }
 
-export const DifferPreviewRequest = {
-  encode(message: DifferPreviewRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      writer.uint32(8).int32(engineToNumber(message.engine));
+export const QueryPlanNode = {
+  encode(message: QueryPlanNode, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.operation !== "") {
+      writer.uint32(10).string(message.operation);
     }
-    if (message.oldSchema !== "") {
-      writer.uint32(18).string(message.oldSchema);
+    if (message.relation !== "") {
+      writer.uint32(18).string(message.relation);
     }
-    if (message.newMetadata !== undefined) {
-      DatabaseMetadata.encode(message.newMetadata, writer.uint32(26).fork()).ldelim();
+    if (message.index !== "") {
+      writer.uint32(26).string(message.index);
+    }
+    for (const v of message.details) {
+      writer.uint32(34).string(v!);
+    }
+    if (message.startupCost !== 0) {
+      writer.uint32(41).double(message.startupCost);
+    }
+    if (message.totalCost !== 0) {
+      writer.uint32(49).double(message.totalCost);
+    }
+    if (message.estimatedRows !== 0) {
+      writer.uint32(57).double(message.estimatedRows);
+    }
+    for (const v of message.children) {
+      QueryPlanNode.encode(v!, writer.uint32(66).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DifferPreviewRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryPlanNode {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDifferPreviewRequest();
+    const message = createBaseQueryPlanNode();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.engine = engineFromJSON(reader.int32());
+          message.operation = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.oldSchema = reader.string();
+          message.relation = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.newMetadata = DatabaseMetadata.decode(reader, reader.uint32());
+          message.index = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.details.push(reader.string());
+          continue;
+        case 5:
+          if (tag !== 41) {
+            break;
+          }
+
+          message.startupCost = reader.double();
+          continue;
+        case 6:
+          if (tag !== 49) {
+            break;
+          }
+
+          message.totalCost = reader.double();
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.estimatedRows = reader.double();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.children.push(QueryPlanNode.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2081,58 +2276,86 @@ export const DifferPreviewRequest = {
     return message;
   },
 
-  fromJSON(object: any): DifferPreviewRequest {
+  fromJSON(object: any): QueryPlanNode {
     return {
-      engine: isSet(object.engine) ? engineFromJSON(object.engine) : Engine.ENGINE_UNSPECIFIED,
-      oldSchema: isSet(object.oldSchema) ? globalThis.String(object.oldSchema) : "",
-      newMetadata: isSet(object.newMetadata) ? DatabaseMetadata.fromJSON(object.newMetadata) : undefined,
+      operation: isSet(object.operation) ? globalThis.String(object.operation) : "",
+      relation: isSet(object.relation) ? globalThis.String(object.relation) : "",
+      index: isSet(object.index) ? globalThis.String(object.index) : "",
+      details: globalThis.Array.isArray(object?.details) ? object.details.map((e: any) => globalThis.String(e)) : [],
+      startupCost: isSet(object.startupCost) ? globalThis.Number(object.startupCost) : 0,
+      totalCost: isSet(object.totalCost) ? globalThis.Number(object.totalCost) : 0,
+      estimatedRows: isSet(object.estimatedRows) ? globalThis.Number(object.estimatedRows) : 0,
+      children: globalThis.Array.isArray(object?.children)
+        ? object.children.map((e: any) => QueryPlanNode.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: DifferPreviewRequest): unknown {
+  toJSON(message: QueryPlanNode): unknown {
     const obj: any = {};
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      obj.engine = engineToJSON(message.engine);
+    if (message.operation !== "") {
+      obj.operation = message.operation;
     }
-    if (message.oldSchema !== "") {
-      obj.oldSchema = message.oldSchema;
+    if (message.relation !== "") {
+      obj.relation = message.relation;
     }
-    if (message.newMetadata !== undefined) {
-      obj.newMetadata = DatabaseMetadata.toJSON(message.newMetadata);
+    if (message.index !== "") {
+      obj.index = message.index;
+    }
+    if (message.details?.length) {
+      obj.details = message.details;
+    }
+    if (message.startupCost !== 0) {
+      obj.startupCost = message.startupCost;
+    }
+    if (message.totalCost !== 0) {
+      obj.totalCost = message.totalCost;
+    }
+    if (message.estimatedRows !== 0) {
+      obj.estimatedRows = message.estimatedRows;
+    }
+    if (message.children?.length) {
+      obj.children = message.children.map((e) => QueryPlanNode.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DifferPreviewRequest>): DifferPreviewRequest {
-    return DifferPreviewRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<QueryPlanNode>): QueryPlanNode {
+    return QueryPlanNode.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DifferPreviewRequest>): DifferPreviewRequest {
-    const message = createBaseDifferPreviewRequest();
-    message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
-    message.oldSchema = object.oldSchema ?? "";
-    message.newMetadata = (object.newMetadata !== undefined && object.newMetadata !== null)
-      ? DatabaseMetadata.fromPartial(object.newMetadata)
-      : undefined;
+  fromPartial(object: DeepPartial<QueryPlanNode>): QueryPlanNode {
+    const message = createBaseQueryPlanNode();
+    message.operation = object.operation ?? "";
+    message.relation = object.relation ?? "";
+    message.index = object.index ?? "";
+    message.details = object.details?.map((e) => e) || [];
+    message.startupCost = object.startupCost ?? 0;
+    message.totalCost = object.totalCost ?? 0;
+    message.estimatedRows = object.estimatedRows ?? 0;
+    message.children = object.children?.map((e) => QueryPlanNode.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseDifferPreviewResponse(): DifferPreviewResponse {
-  return { schema: "" };
+function createBaseCancelQueryRequest(): CancelQueryRequest {
+  return { name: "", queryId: "" };
 }
 
-export const DifferPreviewResponse = {
-  encode(message: DifferPreviewResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.schema !== "") {
-      writer.uint32(10).string(message.schema);
+export const CancelQueryRequest = {
+  encode(message: CancelQueryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.queryId !== "") {
+      writer.uint32(18).string(message.queryId);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): DifferPreviewResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): CancelQueryRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseDifferPreviewResponse();
+    const message = createBaseCancelQueryRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2141,7 +2364,14 @@ export const DifferPreviewResponse = {
             break;
           }
 
-          message.schema = reader.string();
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.queryId = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2152,73 +2382,1555 @@ export const DifferPreviewResponse = {
     return message;
   },
 
-  fromJSON(object: any): DifferPreviewResponse {
-    return { schema: isSet(object.schema) ? globalThis.String(object.schema) : "" };
+  fromJSON(object: any): CancelQueryRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      queryId: isSet(object.queryId) ? globalThis.String(object.queryId) : "",
+    };
   },
 
-  toJSON(message: DifferPreviewResponse): unknown {
+  toJSON(message: CancelQueryRequest): unknown {
     const obj: any = {};
-    if (message.schema !== "") {
-      obj.schema = message.schema;
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.queryId !== "") {
+      obj.queryId = message.queryId;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<DifferPreviewResponse>): DifferPreviewResponse {
-    return DifferPreviewResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<CancelQueryRequest>): CancelQueryRequest {
+    return CancelQueryRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<DifferPreviewResponse>): DifferPreviewResponse {
-    const message = createBaseDifferPreviewResponse();
-    message.schema = object.schema ?? "";
+  fromPartial(object: DeepPartial<CancelQueryRequest>): CancelQueryRequest {
+    const message = createBaseCancelQueryRequest();
+    message.name = object.name ?? "";
+    message.queryId = object.queryId ?? "";
     return message;
   },
 };
 
-function createBasePrettyRequest(): PrettyRequest {
-  return { engine: Engine.ENGINE_UNSPECIFIED, currentSchema: "", expectedSchema: "" };
+function createBaseQueryResponse(): QueryResponse {
+  return { results: [], advices: [], allowExport: false };
+}
+
+export const QueryResponse = {
+  encode(message: QueryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.results) {
+      QueryResult.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    for (const v of message.advices) {
+      Advice.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.allowExport === true) {
+      writer.uint32(24).bool(message.allowExport);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.results.push(QueryResult.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.advices.push(Advice.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.allowExport = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QueryResponse {
+    return {
+      results: globalThis.Array.isArray(object?.results) ? object.results.map((e: any) => QueryResult.fromJSON(e)) : [],
+      advices: globalThis.Array.isArray(object?.advices) ? object.advices.map((e: any) => Advice.fromJSON(e)) : [],
+      allowExport: isSet(object.allowExport) ? globalThis.Boolean(object.allowExport) : false,
+    };
+  },
+
+  toJSON(message: QueryResponse): unknown {
+    const obj: any = {};
+    if (message.results?.length) {
+      obj.results = message.results.map((e) => QueryResult.toJSON(e));
+    }
+    if (message.advices?.length) {
+      obj.advices = message.advices.map((e) => Advice.toJSON(e));
+    }
+    if (message.allowExport === true) {
+      obj.allowExport = message.allowExport;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<QueryResponse>): QueryResponse {
+    return QueryResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<QueryResponse>): QueryResponse {
+    const message = createBaseQueryResponse();
+    message.results = object.results?.map((e) => QueryResult.fromPartial(e)) || [];
+    message.advices = object.advices?.map((e) => Advice.fromPartial(e)) || [];
+    message.allowExport = object.allowExport ?? false;
+    return message;
+  },
+};
+
+function createBaseQueryResult(): QueryResult {
+  return {
+    columnNames: [],
+    columnTypeNames: [],
+    rows: [],
+    masked: [],
+    sensitive: [],
+    error: "",
+    latency: undefined,
+    statement: "",
+    hasMore: false,
+  };
+}
+
+export const QueryResult = {
+  encode(message: QueryResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.columnNames) {
+      writer.uint32(10).string(v!);
+    }
+    for (const v of message.columnTypeNames) {
+      writer.uint32(18).string(v!);
+    }
+    for (const v of message.rows) {
+      QueryRow.encode(v!, writer.uint32(26).fork()).ldelim();
+    }
+    writer.uint32(34).fork();
+    for (const v of message.masked) {
+      writer.bool(v);
+    }
+    writer.ldelim();
+    writer.uint32(42).fork();
+    for (const v of message.sensitive) {
+      writer.bool(v);
+    }
+    writer.ldelim();
+    if (message.error !== "") {
+      writer.uint32(50).string(message.error);
+    }
+    if (message.latency !== undefined) {
+      Duration.encode(message.latency, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.statement !== "") {
+      writer.uint32(66).string(message.statement);
+    }
+    if (message.hasMore === true) {
+      writer.uint32(72).bool(message.hasMore);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryResult {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.columnNames.push(reader.string());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.columnTypeNames.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.rows.push(QueryRow.decode(reader, reader.uint32()));
+          continue;
+        case 4:
+          if (tag === 32) {
+            message.masked.push(reader.bool());
+
+            continue;
+          }
+
+          if (tag === 34) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.masked.push(reader.bool());
+            }
+
+            continue;
+          }
+
+          break;
+        case 5:
+          if (tag === 40) {
+            message.sensitive.push(reader.bool());
+
+            continue;
+          }
+
+          if (tag === 42) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.sensitive.push(reader.bool());
+            }
+
+            continue;
+          }
+
+          break;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.latency = Duration.decode(reader, reader.uint32());
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.hasMore = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QueryResult {
+    return {
+      columnNames: globalThis.Array.isArray(object?.columnNames)
+        ? object.columnNames.map((e: any) => globalThis.String(e))
+        : [],
+      columnTypeNames: globalThis.Array.isArray(object?.columnTypeNames)
+        ? object.columnTypeNames.map((e: any) => globalThis.String(e))
+        : [],
+      rows: globalThis.Array.isArray(object?.rows) ? object.rows.map((e: any) => QueryRow.fromJSON(e)) : [],
+      masked: globalThis.Array.isArray(object?.masked) ? object.masked.map((e: any) => globalThis.Boolean(e)) : [],
+      sensitive: globalThis.Array.isArray(object?.sensitive)
+        ? object.sensitive.map((e: any) => globalThis.Boolean(e))
+        : [],
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+      latency: isSet(object.latency) ? Duration.fromJSON(object.latency) : undefined,
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      hasMore: isSet(object.hasMore) ? globalThis.Boolean(object.hasMore) : false,
+    };
+  },
+
+  toJSON(message: QueryResult): unknown {
+    const obj: any = {};
+    if (message.columnNames?.length) {
+      obj.columnNames = message.columnNames;
+    }
+    if (message.columnTypeNames?.length) {
+      obj.columnTypeNames = message.columnTypeNames;
+    }
+    if (message.rows?.length) {
+      obj.rows = message.rows.map((e) => QueryRow.toJSON(e));
+    }
+    if (message.masked?.length) {
+      obj.masked = message.masked;
+    }
+    if (message.sensitive?.length) {
+      obj.sensitive = message.sensitive;
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    if (message.latency !== undefined) {
+      obj.latency = Duration.toJSON(message.latency);
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.hasMore === true) {
+      obj.hasMore = message.hasMore;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<QueryResult>): QueryResult {
+    return QueryResult.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<QueryResult>): QueryResult {
+    const message = createBaseQueryResult();
+    message.columnNames = object.columnNames?.map((e) => e) || [];
+    message.columnTypeNames = object.columnTypeNames?.map((e) => e) || [];
+    message.rows = object.rows?.map((e) => QueryRow.fromPartial(e)) || [];
+    message.masked = object.masked?.map((e) => e) || [];
+    message.sensitive = object.sensitive?.map((e) => e) || [];
+    message.error = object.error ?? "";
+    message.latency = (object.latency !== undefined && object.latency !== null)
+      ? Duration.fromPartial(object.latency)
+      : undefined;
+    message.statement = object.statement ?? "";
+    message.hasMore = object.hasMore ?? false;
+    return message;
+  },
+};
+
+function createBaseQueryRow(): QueryRow {
+  return { values: [] };
+}
+
+export const QueryRow = {
+  encode(message: QueryRow, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.values) {
+      RowValue.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryRow {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseQueryRow();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.values.push(RowValue.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): QueryRow {
+    return {
+      values: globalThis.Array.isArray(object?.values) ? object.values.map((e: any) => RowValue.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: QueryRow): unknown {
+    const obj: any = {};
+    if (message.values?.length) {
+      obj.values = message.values.map((e) => RowValue.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<QueryRow>): QueryRow {
+    return QueryRow.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<QueryRow>): QueryRow {
+    const message = createBaseQueryRow();
+    message.values = object.values?.map((e) => RowValue.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseRowValue(): RowValue {
+  return {
+    nullValue: undefined,
+    boolValue: undefined,
+    bytesValue: undefined,
+    doubleValue: undefined,
+    floatValue: undefined,
+    int32Value: undefined,
+    int64Value: undefined,
+    stringValue: undefined,
+    uint32Value: undefined,
+    uint64Value: undefined,
+    valueValue: undefined,
+  };
+}
+
+export const RowValue = {
+  encode(message: RowValue, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.nullValue !== undefined) {
+      writer.uint32(8).int32(nullValueToNumber(message.nullValue));
+    }
+    if (message.boolValue !== undefined) {
+      writer.uint32(16).bool(message.boolValue);
+    }
+    if (message.bytesValue !== undefined) {
+      writer.uint32(26).bytes(message.bytesValue);
+    }
+    if (message.doubleValue !== undefined) {
+      writer.uint32(33).double(message.doubleValue);
+    }
+    if (message.floatValue !== undefined) {
+      writer.uint32(45).float(message.floatValue);
+    }
+    if (message.int32Value !== undefined) {
+      writer.uint32(48).int32(message.int32Value);
+    }
+    if (message.int64Value !== undefined) {
+      writer.uint32(56).int64(message.int64Value);
+    }
+    if (message.stringValue !== undefined) {
+      writer.uint32(66).string(message.stringValue);
+    }
+    if (message.uint32Value !== undefined) {
+      writer.uint32(72).uint32(message.uint32Value);
+    }
+    if (message.uint64Value !== undefined) {
+      writer.uint32(80).uint64(message.uint64Value);
+    }
+    if (message.valueValue !== undefined) {
+      Value.encode(Value.wrap(message.valueValue), writer.uint32(90).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): RowValue {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseRowValue();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.nullValue = nullValueFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.boolValue = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.bytesValue = reader.bytes();
+          continue;
+        case 4:
+          if (tag !== 33) {
+            break;
+          }
+
+          message.doubleValue = reader.double();
+          continue;
+        case 5:
+          if (tag !== 45) {
+            break;
+          }
+
+          message.floatValue = reader.float();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.int32Value = reader.int32();
+          continue;
+        case 7:
+          if (tag !== 56) {
+            break;
+          }
+
+          message.int64Value = reader.int64() as Long;
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.stringValue = reader.string();
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.uint32Value = reader.uint32();
+          continue;
+        case 10:
+          if (tag !== 80) {
+            break;
+          }
+
+          message.uint64Value = reader.uint64() as Long;
+          continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.valueValue = Value.unwrap(Value.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): RowValue {
+    return {
+      nullValue: isSet(object.nullValue) ? nullValueFromJSON(object.nullValue) : undefined,
+      boolValue: isSet(object.boolValue) ? globalThis.Boolean(object.boolValue) : undefined,
+      bytesValue: isSet(object.bytesValue) ? bytesFromBase64(object.bytesValue) : undefined,
+      doubleValue: isSet(object.doubleValue) ? globalThis.Number(object.doubleValue) : undefined,
+      floatValue: isSet(object.floatValue) ? globalThis.Number(object.floatValue) : undefined,
+      int32Value: isSet(object.int32Value) ? globalThis.Number(object.int32Value) : undefined,
+      int64Value: isSet(object.int64Value) ? Long.fromValue(object.int64Value) : undefined,
+      stringValue: isSet(object.stringValue) ? globalThis.String(object.stringValue) : undefined,
+      uint32Value: isSet(object.uint32Value) ? globalThis.Number(object.uint32Value) : undefined,
+      uint64Value: isSet(object.uint64Value) ? Long.fromValue(object.uint64Value) : undefined,
+      valueValue: isSet(object?.valueValue) ? object.valueValue : undefined,
+    };
+  },
+
+  toJSON(message: RowValue): unknown {
+    const obj: any = {};
+    if (message.nullValue !== undefined) {
+      obj.nullValue = nullValueToJSON(message.nullValue);
+    }
+    if (message.boolValue !== undefined) {
+      obj.boolValue = message.boolValue;
+    }
+    if (message.bytesValue !== undefined) {
+      obj.bytesValue = base64FromBytes(message.bytesValue);
+    }
+    if (message.doubleValue !== undefined) {
+      obj.doubleValue = message.doubleValue;
+    }
+    if (message.floatValue !== undefined) {
+      obj.floatValue = message.floatValue;
+    }
+    if (message.int32Value !== undefined) {
+      obj.int32Value = Math.round(message.int32Value);
+    }
+    if (message.int64Value !== undefined) {
+      obj.int64Value = (message.int64Value || Long.ZERO).toString();
+    }
+    if (message.stringValue !== undefined) {
+      obj.stringValue = message.stringValue;
+    }
+    if (message.uint32Value !== undefined) {
+      obj.uint32Value = Math.round(message.uint32Value);
+    }
+    if (message.uint64Value !== undefined) {
+      obj.uint64Value = (message.uint64Value || Long.UZERO).toString();
+    }
+    if (message.valueValue !== undefined) {
+      obj.valueValue = message.valueValue;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<RowValue>): RowValue {
+    return RowValue.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<RowValue>): RowValue {
+    const message = createBaseRowValue();
+    message.nullValue = object.nullValue ?? undefined;
+    message.boolValue = object.boolValue ?? undefined;
+    message.bytesValue = object.bytesValue ?? undefined;
+    message.doubleValue = object.doubleValue ?? undefined;
+    message.floatValue = object.floatValue ?? undefined;
+    message.int32Value = object.int32Value ?? undefined;
+    message.int64Value = (object.int64Value !== undefined && object.int64Value !== null)
+      ? Long.fromValue(object.int64Value)
+      : undefined;
+    message.stringValue = object.stringValue ?? undefined;
+    message.uint32Value = object.uint32Value ?? undefined;
+    message.uint64Value = (object.uint64Value !== undefined && object.uint64Value !== null)
+      ? Long.fromValue(object.uint64Value)
+      : undefined;
+    message.valueValue = object.valueValue ?? undefined;
+    return message;
+  },
+};
+
+function createBaseAdvice(): Advice {
+  return {
+    status: Advice_Status.STATUS_UNSPECIFIED,
+    code: 0,
+    title: "",
+    content: "",
+    line: 0,
+    column: 0,
+    detail: "",
+    startPosition: undefined,
+    endPosition: undefined,
+  };
+}
+
+export const Advice = {
+  encode(message: Advice, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.status !== Advice_Status.STATUS_UNSPECIFIED) {
+      writer.uint32(8).int32(advice_StatusToNumber(message.status));
+    }
+    if (message.code !== 0) {
+      writer.uint32(16).int32(message.code);
+    }
+    if (message.title !== "") {
+      writer.uint32(26).string(message.title);
+    }
+    if (message.content !== "") {
+      writer.uint32(34).string(message.content);
+    }
+    if (message.line !== 0) {
+      writer.uint32(40).int32(message.line);
+    }
+    if (message.column !== 0) {
+      writer.uint32(48).int32(message.column);
+    }
+    if (message.detail !== "") {
+      writer.uint32(58).string(message.detail);
+    }
+    if (message.startPosition !== undefined) {
+      Position.encode(message.startPosition, writer.uint32(66).fork()).ldelim();
+    }
+    if (message.endPosition !== undefined) {
+      Position.encode(message.endPosition, writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Advice {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAdvice();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.status = advice_StatusFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.code = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.content = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.line = reader.int32();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.column = reader.int32();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.startPosition = Position.decode(reader, reader.uint32());
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.endPosition = Position.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Advice {
+    return {
+      status: isSet(object.status) ? advice_StatusFromJSON(object.status) : Advice_Status.STATUS_UNSPECIFIED,
+      code: isSet(object.code) ? globalThis.Number(object.code) : 0,
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
+      line: isSet(object.line) ? globalThis.Number(object.line) : 0,
+      column: isSet(object.column) ? globalThis.Number(object.column) : 0,
+      detail: isSet(object.detail) ? globalThis.String(object.detail) : "",
+      startPosition: isSet(object.startPosition) ? Position.fromJSON(object.startPosition) : undefined,
+      endPosition: isSet(object.endPosition) ? Position.fromJSON(object.endPosition) : undefined,
+    };
+  },
+
+  toJSON(message: Advice): unknown {
+    const obj: any = {};
+    if (message.status !== Advice_Status.STATUS_UNSPECIFIED) {
+      obj.status = advice_StatusToJSON(message.status);
+    }
+    if (message.code !== 0) {
+      obj.code = Math.round(message.code);
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.content !== "") {
+      obj.content = message.content;
+    }
+    if (message.line !== 0) {
+      obj.line = Math.round(message.line);
+    }
+    if (message.column !== 0) {
+      obj.column = Math.round(message.column);
+    }
+    if (message.detail !== "") {
+      obj.detail = message.detail;
+    }
+    if (message.startPosition !== undefined) {
+      obj.startPosition = Position.toJSON(message.startPosition);
+    }
+    if (message.endPosition !== undefined) {
+      obj.endPosition = Position.toJSON(message.endPosition);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<Advice>): Advice {
+    return Advice.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<Advice>): Advice {
+    const message = createBaseAdvice();
+    message.status = object.status ?? Advice_Status.STATUS_UNSPECIFIED;
+    message.code = object.code ?? 0;
+    message.title = object.title ?? "";
+    message.content = object.content ?? "";
+    message.line = object.line ?? 0;
+    message.column = object.column ?? 0;
+    message.detail = object.detail ?? "";
+    message.startPosition = (object.startPosition !== undefined && object.startPosition !== null)
+      ? Position.fromPartial(object.startPosition)
+      : undefined;
+    message.endPosition = (object.endPosition !== undefined && object.endPosition !== null)
+      ? Position.fromPartial(object.endPosition)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseExportRequest(): ExportRequest {
+  return {
+    name: "",
+    connectionDatabase: "",
+    statement: "",
+    limit: 0,
+    format: ExportFormat.FORMAT_UNSPECIFIED,
+    admin: false,
+    password: "",
+    dataSourceId: "",
+  };
+}
+
+export const ExportRequest = {
+  encode(message: ExportRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.connectionDatabase !== "") {
+      writer.uint32(18).string(message.connectionDatabase);
+    }
+    if (message.statement !== "") {
+      writer.uint32(26).string(message.statement);
+    }
+    if (message.limit !== 0) {
+      writer.uint32(32).int32(message.limit);
+    }
+    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
+      writer.uint32(40).int32(exportFormatToNumber(message.format));
+    }
+    if (message.admin === true) {
+      writer.uint32(48).bool(message.admin);
+    }
+    if (message.password !== "") {
+      writer.uint32(58).string(message.password);
+    }
+    if (message.dataSourceId !== "") {
+      writer.uint32(66).string(message.dataSourceId);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.connectionDatabase = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.limit = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.format = exportFormatFromJSON(reader.int32());
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.admin = reader.bool();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.dataSourceId = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      connectionDatabase: isSet(object.connectionDatabase) ? globalThis.String(object.connectionDatabase) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      limit: isSet(object.limit) ? globalThis.Number(object.limit) : 0,
+      format: isSet(object.format) ? exportFormatFromJSON(object.format) : ExportFormat.FORMAT_UNSPECIFIED,
+      admin: isSet(object.admin) ? globalThis.Boolean(object.admin) : false,
+      password: isSet(object.password) ? globalThis.String(object.password) : "",
+      dataSourceId: isSet(object.dataSourceId) ? globalThis.String(object.dataSourceId) : "",
+    };
+  },
+
+  toJSON(message: ExportRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.connectionDatabase !== "") {
+      obj.connectionDatabase = message.connectionDatabase;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.limit !== 0) {
+      obj.limit = Math.round(message.limit);
+    }
+    if (message.format !== ExportFormat.FORMAT_UNSPECIFIED) {
+      obj.format = exportFormatToJSON(message.format);
+    }
+    if (message.admin === true) {
+      obj.admin = message.admin;
+    }
+    if (message.password !== "") {
+      obj.password = message.password;
+    }
+    if (message.dataSourceId !== "") {
+      obj.dataSourceId = message.dataSourceId;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExportRequest>): ExportRequest {
+    return ExportRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExportRequest>): ExportRequest {
+    const message = createBaseExportRequest();
+    message.name = object.name ?? "";
+    message.connectionDatabase = object.connectionDatabase ?? "";
+    message.statement = object.statement ?? "";
+    message.limit = object.limit ?? 0;
+    message.format = object.format ?? ExportFormat.FORMAT_UNSPECIFIED;
+    message.admin = object.admin ?? false;
+    message.password = object.password ?? "";
+    message.dataSourceId = object.dataSourceId ?? "";
+    return message;
+  },
+};
+
+function createBaseExportResponse(): ExportResponse {
+  return { content: new Uint8Array(0) };
+}
+
+export const ExportResponse = {
+  encode(message: ExportResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.content.length !== 0) {
+      writer.uint32(10).bytes(message.content);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ExportResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseExportResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.content = reader.bytes();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ExportResponse {
+    return { content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0) };
+  },
+
+  toJSON(message: ExportResponse): unknown {
+    const obj: any = {};
+    if (message.content.length !== 0) {
+      obj.content = base64FromBytes(message.content);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ExportResponse>): ExportResponse {
+    return ExportResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ExportResponse>): ExportResponse {
+    const message = createBaseExportResponse();
+    message.content = object.content ?? new Uint8Array(0);
+    return message;
+  },
+};
+
+function createBaseDifferPreviewRequest(): DifferPreviewRequest {
+  return { engine: Engine.ENGINE_UNSPECIFIED, oldSchema: "", newMetadata: undefined };
+}
+
+export const DifferPreviewRequest = {
+  encode(message: DifferPreviewRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      writer.uint32(8).int32(engineToNumber(message.engine));
+    }
+    if (message.oldSchema !== "") {
+      writer.uint32(18).string(message.oldSchema);
+    }
+    if (message.newMetadata !== undefined) {
+      DatabaseMetadata.encode(message.newMetadata, writer.uint32(26).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DifferPreviewRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDifferPreviewRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.engine = engineFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.oldSchema = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.newMetadata = DatabaseMetadata.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DifferPreviewRequest {
+    return {
+      engine: isSet(object.engine) ? engineFromJSON(object.engine) : Engine.ENGINE_UNSPECIFIED,
+      oldSchema: isSet(object.oldSchema) ? globalThis.String(object.oldSchema) : "",
+      newMetadata: isSet(object.newMetadata) ? DatabaseMetadata.fromJSON(object.newMetadata) : undefined,
+    };
+  },
+
+  toJSON(message: DifferPreviewRequest): unknown {
+    const obj: any = {};
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      obj.engine = engineToJSON(message.engine);
+    }
+    if (message.oldSchema !== "") {
+      obj.oldSchema = message.oldSchema;
+    }
+    if (message.newMetadata !== undefined) {
+      obj.newMetadata = DatabaseMetadata.toJSON(message.newMetadata);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DifferPreviewRequest>): DifferPreviewRequest {
+    return DifferPreviewRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DifferPreviewRequest>): DifferPreviewRequest {
+    const message = createBaseDifferPreviewRequest();
+    message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
+    message.oldSchema = object.oldSchema ?? "";
+    message.newMetadata = (object.newMetadata !== undefined && object.newMetadata !== null)
+      ? DatabaseMetadata.fromPartial(object.newMetadata)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseDifferPreviewResponse(): DifferPreviewResponse {
+  return { schema: "" };
+}
+
+export const DifferPreviewResponse = {
+  encode(message: DifferPreviewResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DifferPreviewResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDifferPreviewResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.schema = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DifferPreviewResponse {
+    return { schema: isSet(object.schema) ? globalThis.String(object.schema) : "" };
+  },
+
+  toJSON(message: DifferPreviewResponse): unknown {
+    const obj: any = {};
+    if (message.schema !== "") {
+      obj.schema = message.schema;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<DifferPreviewResponse>): DifferPreviewResponse {
+    return DifferPreviewResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<DifferPreviewResponse>): DifferPreviewResponse {
+    const message = createBaseDifferPreviewResponse();
+    message.schema = object.schema ?? "";
+    return message;
+  },
+};
+
+function createBasePrettyRequest(): PrettyRequest {
+  return { engine: Engine.ENGINE_UNSPECIFIED, currentSchema: "", expectedSchema: "" };
+}
+
+export const PrettyRequest = {
+  encode(message: PrettyRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      writer.uint32(8).int32(engineToNumber(message.engine));
+    }
+    if (message.currentSchema !== "") {
+      writer.uint32(18).string(message.currentSchema);
+    }
+    if (message.expectedSchema !== "") {
+      writer.uint32(26).string(message.expectedSchema);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PrettyRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePrettyRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.engine = engineFromJSON(reader.int32());
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.currentSchema = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.expectedSchema = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PrettyRequest {
+    return {
+      engine: isSet(object.engine) ? engineFromJSON(object.engine) : Engine.ENGINE_UNSPECIFIED,
+      currentSchema: isSet(object.currentSchema) ? globalThis.String(object.currentSchema) : "",
+      expectedSchema: isSet(object.expectedSchema) ? globalThis.String(object.expectedSchema) : "",
+    };
+  },
+
+  toJSON(message: PrettyRequest): unknown {
+    const obj: any = {};
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      obj.engine = engineToJSON(message.engine);
+    }
+    if (message.currentSchema !== "") {
+      obj.currentSchema = message.currentSchema;
+    }
+    if (message.expectedSchema !== "") {
+      obj.expectedSchema = message.expectedSchema;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PrettyRequest>): PrettyRequest {
+    return PrettyRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PrettyRequest>): PrettyRequest {
+    const message = createBasePrettyRequest();
+    message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
+    message.currentSchema = object.currentSchema ?? "";
+    message.expectedSchema = object.expectedSchema ?? "";
+    return message;
+  },
+};
+
+function createBasePrettyResponse(): PrettyResponse {
+  return { currentSchema: "", expectedSchema: "" };
+}
+
+export const PrettyResponse = {
+  encode(message: PrettyResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.currentSchema !== "") {
+      writer.uint32(10).string(message.currentSchema);
+    }
+    if (message.expectedSchema !== "") {
+      writer.uint32(18).string(message.expectedSchema);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PrettyResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePrettyResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.currentSchema = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.expectedSchema = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PrettyResponse {
+    return {
+      currentSchema: isSet(object.currentSchema) ? globalThis.String(object.currentSchema) : "",
+      expectedSchema: isSet(object.expectedSchema) ? globalThis.String(object.expectedSchema) : "",
+    };
+  },
+
+  toJSON(message: PrettyResponse): unknown {
+    const obj: any = {};
+    if (message.currentSchema !== "") {
+      obj.currentSchema = message.currentSchema;
+    }
+    if (message.expectedSchema !== "") {
+      obj.expectedSchema = message.expectedSchema;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<PrettyResponse>): PrettyResponse {
+    return PrettyResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<PrettyResponse>): PrettyResponse {
+    const message = createBasePrettyResponse();
+    message.currentSchema = object.currentSchema ?? "";
+    message.expectedSchema = object.expectedSchema ?? "";
+    return message;
+  },
+};
+
+function createBaseCheckRequest(): CheckRequest {
+  return { name: "", statement: "", metadata: undefined, changeType: CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED };
+}
+
+export const CheckRequest = {
+  encode(message: CheckRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(18).string(message.name);
+    }
+    if (message.statement !== "") {
+      writer.uint32(10).string(message.statement);
+    }
+    if (message.metadata !== undefined) {
+      DatabaseMetadata.encode(message.metadata, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.changeType !== CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED) {
+      writer.uint32(32).int32(checkRequest_ChangeTypeToNumber(message.changeType));
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CheckRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCheckRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.statement = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.metadata = DatabaseMetadata.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.changeType = checkRequest_ChangeTypeFromJSON(reader.int32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CheckRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      metadata: isSet(object.metadata) ? DatabaseMetadata.fromJSON(object.metadata) : undefined,
+      changeType: isSet(object.changeType)
+        ? checkRequest_ChangeTypeFromJSON(object.changeType)
+        : CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED,
+    };
+  },
+
+  toJSON(message: CheckRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.metadata !== undefined) {
+      obj.metadata = DatabaseMetadata.toJSON(message.metadata);
+    }
+    if (message.changeType !== CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED) {
+      obj.changeType = checkRequest_ChangeTypeToJSON(message.changeType);
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CheckRequest>): CheckRequest {
+    return CheckRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CheckRequest>): CheckRequest {
+    const message = createBaseCheckRequest();
+    message.name = object.name ?? "";
+    message.statement = object.statement ?? "";
+    message.metadata = (object.metadata !== undefined && object.metadata !== null)
+      ? DatabaseMetadata.fromPartial(object.metadata)
+      : undefined;
+    message.changeType = object.changeType ?? CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED;
+    return message;
+  },
+};
+
+function createBaseCheckResponse(): CheckResponse {
+  return { advices: [] };
+}
+
+export const CheckResponse = {
+  encode(message: CheckResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.advices) {
+      Advice.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): CheckResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseCheckResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.advices.push(Advice.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): CheckResponse {
+    return {
+      advices: globalThis.Array.isArray(object?.advices) ? object.advices.map((e: any) => Advice.fromJSON(e)) : [],
+    };
+  },
+
+  toJSON(message: CheckResponse): unknown {
+    const obj: any = {};
+    if (message.advices?.length) {
+      obj.advices = message.advices.map((e) => Advice.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<CheckResponse>): CheckResponse {
+    return CheckResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<CheckResponse>): CheckResponse {
+    const message = createBaseCheckResponse();
+    message.advices = object.advices?.map((e) => Advice.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseParseMyBatisMapperRequest(): ParseMyBatisMapperRequest {
+  return { content: new Uint8Array(0) };
 }
 
-export const PrettyRequest = {
-  encode(message: PrettyRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      writer.uint32(8).int32(engineToNumber(message.engine));
-    }
-    if (message.currentSchema !== "") {
-      writer.uint32(18).string(message.currentSchema);
-    }
-    if (message.expectedSchema !== "") {
-      writer.uint32(26).string(message.expectedSchema);
+export const ParseMyBatisMapperRequest = {
+  encode(message: ParseMyBatisMapperRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.content.length !== 0) {
+      writer.uint32(10).bytes(message.content);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PrettyRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ParseMyBatisMapperRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePrettyRequest();
+    const message = createBaseParseMyBatisMapperRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
-            break;
-          }
-
-          message.engine = engineFromJSON(reader.int32());
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.currentSchema = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
+          if (tag !== 10) {
             break;
           }
 
-          message.expectedSchema = reader.string();
+          message.content = reader.bytes();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2229,59 +3941,44 @@ export const PrettyRequest = {
     return message;
   },
 
-  fromJSON(object: any): PrettyRequest {
-    return {
-      engine: isSet(object.engine) ? engineFromJSON(object.engine) : Engine.ENGINE_UNSPECIFIED,
-      currentSchema: isSet(object.currentSchema) ? globalThis.String(object.currentSchema) : "",
-      expectedSchema: isSet(object.expectedSchema) ? globalThis.String(object.expectedSchema) : "",
-    };
+  fromJSON(object: any): ParseMyBatisMapperRequest {
+    return { content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0) };
   },
 
-  toJSON(message: PrettyRequest): unknown {
+  toJSON(message: ParseMyBatisMapperRequest): unknown {
     const obj: any = {};
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      obj.engine = engineToJSON(message.engine);
-    }
-    if (message.currentSchema !== "") {
-      obj.currentSchema = message.currentSchema;
-    }
-    if (message.expectedSchema !== "") {
-      obj.expectedSchema = message.expectedSchema;
+    if (message.content.length !== 0) {
+      obj.content = base64FromBytes(message.content);
     }
     return obj;
   },
 
-  create(base?: DeepPartial<PrettyRequest>): PrettyRequest {
-    return PrettyRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<ParseMyBatisMapperRequest>): ParseMyBatisMapperRequest {
+    return ParseMyBatisMapperRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<PrettyRequest>): PrettyRequest {
-    const message = createBasePrettyRequest();
-    message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
-    message.currentSchema = object.currentSchema ?? "";
-    message.expectedSchema = object.expectedSchema ?? "";
+  fromPartial(object: DeepPartial<ParseMyBatisMapperRequest>): ParseMyBatisMapperRequest {
+    const message = createBaseParseMyBatisMapperRequest();
+    message.content = object.content ?? new Uint8Array(0);
     return message;
   },
 };
 
-function createBasePrettyResponse(): PrettyResponse {
-  return { currentSchema: "", expectedSchema: "" };
+function createBaseParseMyBatisMapperResponse(): ParseMyBatisMapperResponse {
+  return { statements: [] };
 }
 
-export const PrettyResponse = {
-  encode(message: PrettyResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.currentSchema !== "") {
-      writer.uint32(10).string(message.currentSchema);
-    }
-    if (message.expectedSchema !== "") {
-      writer.uint32(18).string(message.expectedSchema);
+export const ParseMyBatisMapperResponse = {
+  encode(message: ParseMyBatisMapperResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.statements) {
+      writer.uint32(10).string(v!);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): PrettyResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): ParseMyBatisMapperResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePrettyResponse();
+    const message = createBaseParseMyBatisMapperResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2290,14 +3987,7 @@ export const PrettyResponse = {
             break;
           }
 
-          message.currentSchema = reader.string();
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.expectedSchema = reader.string();
+          message.statements.push(reader.string());
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2308,90 +3998,77 @@ export const PrettyResponse = {
     return message;
   },
 
-  fromJSON(object: any): PrettyResponse {
+  fromJSON(object: any): ParseMyBatisMapperResponse {
     return {
-      currentSchema: isSet(object.currentSchema) ? globalThis.String(object.currentSchema) : "",
-      expectedSchema: isSet(object.expectedSchema) ? globalThis.String(object.expectedSchema) : "",
+      statements: globalThis.Array.isArray(object?.statements)
+        ? object.statements.map((e: any) => globalThis.String(e))
+        : [],
     };
   },
 
-  toJSON(message: PrettyResponse): unknown {
+  toJSON(message: ParseMyBatisMapperResponse): unknown {
     const obj: any = {};
-    if (message.currentSchema !== "") {
-      obj.currentSchema = message.currentSchema;
-    }
-    if (message.expectedSchema !== "") {
-      obj.expectedSchema = message.expectedSchema;
+    if (message.statements?.length) {
+      obj.statements = message.statements;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<PrettyResponse>): PrettyResponse {
-    return PrettyResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<ParseMyBatisMapperResponse>): ParseMyBatisMapperResponse {
+    return ParseMyBatisMapperResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<PrettyResponse>): PrettyResponse {
-    const message = createBasePrettyResponse();
-    message.currentSchema = object.currentSchema ?? "";
-    message.expectedSchema = object.expectedSchema ?? "";
+  fromPartial(object: DeepPartial<ParseMyBatisMapperResponse>): ParseMyBatisMapperResponse {
+    const message = createBaseParseMyBatisMapperResponse();
+    message.statements = object.statements?.map((e) => e) || [];
     return message;
   },
 };
 
-function createBaseCheckRequest(): CheckRequest {
-  return { name: "", statement: "", metadata: undefined, changeType: CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED };
+function createBaseStringifyMetadataRequest(): StringifyMetadataRequest {
+  return { metadata: undefined, engine: Engine.ENGINE_UNSPECIFIED, classificationFromConfig: false };
 }
 
-export const CheckRequest = {
-  encode(message: CheckRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(18).string(message.name);
-    }
-    if (message.statement !== "") {
-      writer.uint32(10).string(message.statement);
-    }
+export const StringifyMetadataRequest = {
+  encode(message: StringifyMetadataRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.metadata !== undefined) {
-      DatabaseMetadata.encode(message.metadata, writer.uint32(26).fork()).ldelim();
+      DatabaseMetadata.encode(message.metadata, writer.uint32(10).fork()).ldelim();
     }
-    if (message.changeType !== CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED) {
-      writer.uint32(32).int32(checkRequest_ChangeTypeToNumber(message.changeType));
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      writer.uint32(16).int32(engineToNumber(message.engine));
+    }
+    if (message.classificationFromConfig === true) {
+      writer.uint32(24).bool(message.classificationFromConfig);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CheckRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StringifyMetadataRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCheckRequest();
+    const message = createBaseStringifyMetadataRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.name = reader.string();
-          continue;
         case 1:
           if (tag !== 10) {
             break;
           }
 
-          message.statement = reader.string();
+          message.metadata = DatabaseMetadata.decode(reader, reader.uint32());
           continue;
-        case 3:
-          if (tag !== 26) {
+        case 2:
+          if (tag !== 16) {
             break;
           }
 
-          message.metadata = DatabaseMetadata.decode(reader, reader.uint32());
+          message.engine = engineFromJSON(reader.int32());
           continue;
-        case 4:
-          if (tag !== 32) {
+        case 3:
+          if (tag !== 24) {
             break;
           }
 
-          message.changeType = checkRequest_ChangeTypeFromJSON(reader.int32());
+          message.classificationFromConfig = reader.bool();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2402,65 +4079,60 @@ export const CheckRequest = {
     return message;
   },
 
-  fromJSON(object: any): CheckRequest {
+  fromJSON(object: any): StringifyMetadataRequest {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
       metadata: isSet(object.metadata) ? DatabaseMetadata.fromJSON(object.metadata) : undefined,
-      changeType: isSet(object.changeType)
-        ? checkRequest_ChangeTypeFromJSON(object.changeType)
-        : CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED,
+      engine: isSet(object.engine) ? engineFromJSON(object.engine) : Engine.ENGINE_UNSPECIFIED,
+      classificationFromConfig: isSet(object.classificationFromConfig)
+        ? globalThis.Boolean(object.classificationFromConfig)
+        : false,
     };
   },
 
-  toJSON(message: CheckRequest): unknown {
+  toJSON(message: StringifyMetadataRequest): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.statement !== "") {
-      obj.statement = message.statement;
-    }
     if (message.metadata !== undefined) {
       obj.metadata = DatabaseMetadata.toJSON(message.metadata);
     }
-    if (message.changeType !== CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED) {
-      obj.changeType = checkRequest_ChangeTypeToJSON(message.changeType);
+    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
+      obj.engine = engineToJSON(message.engine);
+    }
+    if (message.classificationFromConfig === true) {
+      obj.classificationFromConfig = message.classificationFromConfig;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<CheckRequest>): CheckRequest {
-    return CheckRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<StringifyMetadataRequest>): StringifyMetadataRequest {
+    return StringifyMetadataRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<CheckRequest>): CheckRequest {
-    const message = createBaseCheckRequest();
-    message.name = object.name ?? "";
-    message.statement = object.statement ?? "";
+  fromPartial(object: DeepPartial<StringifyMetadataRequest>): StringifyMetadataRequest {
+    const message = createBaseStringifyMetadataRequest();
     message.metadata = (object.metadata !== undefined && object.metadata !== null)
       ? DatabaseMetadata.fromPartial(object.metadata)
       : undefined;
-    message.changeType = object.changeType ?? CheckRequest_ChangeType.CHANGE_TYPE_UNSPECIFIED;
+    message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
+    message.classificationFromConfig = object.classificationFromConfig ?? false;
     return message;
   },
 };
 
-function createBaseCheckResponse(): CheckResponse {
-  return { advices: [] };
+function createBaseStringifyMetadataResponse(): StringifyMetadataResponse {
+  return { schema: "" };
 }
 
-export const CheckResponse = {
-  encode(message: CheckResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.advices) {
-      Advice.encode(v!, writer.uint32(10).fork()).ldelim();
+export const StringifyMetadataResponse = {
+  encode(message: StringifyMetadataResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.schema !== "") {
+      writer.uint32(10).string(message.schema);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): CheckResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): StringifyMetadataResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseCheckResponse();
+    const message = createBaseStringifyMetadataResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2469,7 +4141,7 @@ export const CheckResponse = {
             break;
           }
 
-          message.advices.push(Advice.decode(reader, reader.uint32()));
+          message.schema = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2480,55 +4152,73 @@ export const CheckResponse = {
     return message;
   },
 
-  fromJSON(object: any): CheckResponse {
-    return {
-      advices: globalThis.Array.isArray(object?.advices) ? object.advices.map((e: any) => Advice.fromJSON(e)) : [],
-    };
+  fromJSON(object: any): StringifyMetadataResponse {
+    return { schema: isSet(object.schema) ? globalThis.String(object.schema) : "" };
   },
 
-  toJSON(message: CheckResponse): unknown {
+  toJSON(message: StringifyMetadataResponse): unknown {
     const obj: any = {};
-    if (message.advices?.length) {
-      obj.advices = message.advices.map((e) => Advice.toJSON(e));
+    if (message.schema !== "") {
+      obj.schema = message.schema;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<CheckResponse>): CheckResponse {
-    return CheckResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<StringifyMetadataResponse>): StringifyMetadataResponse {
+    return StringifyMetadataResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<CheckResponse>): CheckResponse {
-    const message = createBaseCheckResponse();
-    message.advices = object.advices?.map((e) => Advice.fromPartial(e)) || [];
+  fromPartial(object: DeepPartial<StringifyMetadataResponse>): StringifyMetadataResponse {
+    const message = createBaseStringifyMetadataResponse();
+    message.schema = object.schema ?? "";
     return message;
   },
 };
 
-function createBaseParseMyBatisMapperRequest(): ParseMyBatisMapperRequest {
-  return { content: new Uint8Array(0) };
+function createBaseSearchQueryHistoriesRequest(): SearchQueryHistoriesRequest {
+  return { pageSize: 0, pageToken: "", filter: "" };
 }
 
-export const ParseMyBatisMapperRequest = {
-  encode(message: ParseMyBatisMapperRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.content.length !== 0) {
-      writer.uint32(10).bytes(message.content);
+export const SearchQueryHistoriesRequest = {
+  encode(message: SearchQueryHistoriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.pageSize !== 0) {
+      writer.uint32(8).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(18).string(message.pageToken);
+    }
+    if (message.filter !== "") {
+      writer.uint32(26).string(message.filter);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ParseMyBatisMapperRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SearchQueryHistoriesRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseParseMyBatisMapperRequest();
+    const message = createBaseSearchQueryHistoriesRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.content = reader.bytes();
+          message.pageSize = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.filter = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2539,44 +4229,59 @@ export const ParseMyBatisMapperRequest = {
     return message;
   },
 
-  fromJSON(object: any): ParseMyBatisMapperRequest {
-    return { content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0) };
+  fromJSON(object: any): SearchQueryHistoriesRequest {
+    return {
+      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
+      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+    };
   },
 
-  toJSON(message: ParseMyBatisMapperRequest): unknown {
+  toJSON(message: SearchQueryHistoriesRequest): unknown {
     const obj: any = {};
-    if (message.content.length !== 0) {
-      obj.content = base64FromBytes(message.content);
+    if (message.pageSize !== 0) {
+      obj.pageSize = Math.round(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      obj.pageToken = message.pageToken;
+    }
+    if (message.filter !== "") {
+      obj.filter = message.filter;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ParseMyBatisMapperRequest>): ParseMyBatisMapperRequest {
-    return ParseMyBatisMapperRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<SearchQueryHistoriesRequest>): SearchQueryHistoriesRequest {
+    return SearchQueryHistoriesRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ParseMyBatisMapperRequest>): ParseMyBatisMapperRequest {
-    const message = createBaseParseMyBatisMapperRequest();
-    message.content = object.content ?? new Uint8Array(0);
+  fromPartial(object: DeepPartial<SearchQueryHistoriesRequest>): SearchQueryHistoriesRequest {
+    const message = createBaseSearchQueryHistoriesRequest();
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    message.filter = object.filter ?? "";
     return message;
   },
 };
 
-function createBaseParseMyBatisMapperResponse(): ParseMyBatisMapperResponse {
-  return { statements: [] };
+function createBaseSearchQueryHistoriesResponse(): SearchQueryHistoriesResponse {
+  return { queryHistories: [], nextPageToken: "" };
 }
 
-export const ParseMyBatisMapperResponse = {
-  encode(message: ParseMyBatisMapperResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.statements) {
-      writer.uint32(10).string(v!);
+export const SearchQueryHistoriesResponse = {
+  encode(message: SearchQueryHistoriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.queryHistories) {
+      QueryHistory.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): ParseMyBatisMapperResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): SearchQueryHistoriesResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseParseMyBatisMapperResponse();
+    const message = createBaseSearchQueryHistoriesResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2585,7 +4290,14 @@ export const ParseMyBatisMapperResponse = {
             break;
           }
 
-          message.statements.push(reader.string());
+          message.queryHistories.push(QueryHistory.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2596,54 +4308,87 @@ export const ParseMyBatisMapperResponse = {
     return message;
   },
 
-  fromJSON(object: any): ParseMyBatisMapperResponse {
+  fromJSON(object: any): SearchQueryHistoriesResponse {
     return {
-      statements: globalThis.Array.isArray(object?.statements)
-        ? object.statements.map((e: any) => globalThis.String(e))
+      queryHistories: globalThis.Array.isArray(object?.queryHistories)
+        ? object.queryHistories.map((e: any) => QueryHistory.fromJSON(e))
         : [],
+      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
     };
   },
 
-  toJSON(message: ParseMyBatisMapperResponse): unknown {
+  toJSON(message: SearchQueryHistoriesResponse): unknown {
     const obj: any = {};
-    if (message.statements?.length) {
-      obj.statements = message.statements;
+    if (message.queryHistories?.length) {
+      obj.queryHistories = message.queryHistories.map((e) => QueryHistory.toJSON(e));
+    }
+    if (message.nextPageToken !== "") {
+      obj.nextPageToken = message.nextPageToken;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<ParseMyBatisMapperResponse>): ParseMyBatisMapperResponse {
-    return ParseMyBatisMapperResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<SearchQueryHistoriesResponse>): SearchQueryHistoriesResponse {
+    return SearchQueryHistoriesResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<ParseMyBatisMapperResponse>): ParseMyBatisMapperResponse {
-    const message = createBaseParseMyBatisMapperResponse();
-    message.statements = object.statements?.map((e) => e) || [];
+  fromPartial(object: DeepPartial<SearchQueryHistoriesResponse>): SearchQueryHistoriesResponse {
+    const message = createBaseSearchQueryHistoriesResponse();
+    message.queryHistories = object.queryHistories?.map((e) => QueryHistory.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
     return message;
   },
 };
 
-function createBaseStringifyMetadataRequest(): StringifyMetadataRequest {
-  return { metadata: undefined, engine: Engine.ENGINE_UNSPECIFIED, classificationFromConfig: false };
+function createBaseQueryHistory(): QueryHistory {
+  return {
+    name: "",
+    database: "",
+    creator: "",
+    createTime: undefined,
+    statement: "",
+    error: undefined,
+    duration: undefined,
+    type: QueryHistory_Type.TYPE_UNSPECIFIED,
+    rowCount: Long.ZERO,
+  };
 }
 
-export const StringifyMetadataRequest = {
-  encode(message: StringifyMetadataRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.metadata !== undefined) {
-      DatabaseMetadata.encode(message.metadata, writer.uint32(10).fork()).ldelim();
+export const QueryHistory = {
+  encode(message: QueryHistory, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      writer.uint32(16).int32(engineToNumber(message.engine));
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
     }
-    if (message.classificationFromConfig === true) {
-      writer.uint32(24).bool(message.classificationFromConfig);
+    if (message.creator !== "") {
+      writer.uint32(26).string(message.creator);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.statement !== "") {
+      writer.uint32(42).string(message.statement);
+    }
+    if (message.error !== undefined) {
+      writer.uint32(50).string(message.error);
+    }
+    if (message.duration !== undefined) {
+      Duration.encode(message.duration, writer.uint32(58).fork()).ldelim();
+    }
+    if (message.type !== QueryHistory_Type.TYPE_UNSPECIFIED) {
+      writer.uint32(64).int32(queryHistory_TypeToNumber(message.type));
+    }
+    if (!message.rowCount.isZero()) {
+      writer.uint32(72).int64(message.rowCount);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): StringifyMetadataRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHistory {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStringifyMetadataRequest();
+    const message = createBaseQueryHistory();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2652,94 +4397,63 @@ export const StringifyMetadataRequest = {
             break;
           }
 
-          message.metadata = DatabaseMetadata.decode(reader, reader.uint32());
+          message.name = reader.string();
           continue;
         case 2:
-          if (tag !== 16) {
+          if (tag !== 18) {
             break;
           }
 
-          message.engine = engineFromJSON(reader.int32());
+          message.database = reader.string();
           continue;
         case 3:
-          if (tag !== 24) {
+          if (tag !== 26) {
             break;
           }
 
-          message.classificationFromConfig = reader.bool();
+          message.creator = reader.string();
           continue;
-      }
-      if ((tag & 7) === 4 || tag === 0) {
-        break;
-      }
-      reader.skipType(tag & 7);
-    }
-    return message;
-  },
-
-  fromJSON(object: any): StringifyMetadataRequest {
-    return {
-      metadata: isSet(object.metadata) ? DatabaseMetadata.fromJSON(object.metadata) : undefined,
-      engine: isSet(object.engine) ? engineFromJSON(object.engine) : Engine.ENGINE_UNSPECIFIED,
-      classificationFromConfig: isSet(object.classificationFromConfig)
-        ? globalThis.Boolean(object.classificationFromConfig)
-        : false,
-    };
-  },
-
-  toJSON(message: StringifyMetadataRequest): unknown {
-    const obj: any = {};
-    if (message.metadata !== undefined) {
-      obj.metadata = DatabaseMetadata.toJSON(message.metadata);
-    }
-    if (message.engine !== Engine.ENGINE_UNSPECIFIED) {
-      obj.engine = engineToJSON(message.engine);
-    }
-    if (message.classificationFromConfig === true) {
-      obj.classificationFromConfig = message.classificationFromConfig;
-    }
-    return obj;
-  },
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
 
-  create(base?: DeepPartial<StringifyMetadataRequest>): StringifyMetadataRequest {
-    return StringifyMetadataRequest.fromPartial(base ?? {});
-  },
-  fromPartial(object: DeepPartial<StringifyMetadataRequest>): StringifyMetadataRequest {
-    const message = createBaseStringifyMetadataRequest();
-    message.metadata = (object.metadata !== undefined && object.metadata !== null)
-      ? DatabaseMetadata.fromPartial(object.metadata)
-      : undefined;
-    message.engine = object.engine ?? Engine.ENGINE_UNSPECIFIED;
-    message.classificationFromConfig = object.classificationFromConfig ?? false;
-    return message;
-  },
-};
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
 
-function createBaseStringifyMetadataResponse(): StringifyMetadataResponse {
-  return { schema: "" };
-}
+          message.statement = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
 
-export const StringifyMetadataResponse = {
-  encode(message: StringifyMetadataResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.schema !== "") {
-      writer.uint32(10).string(message.schema);
-    }
-    return writer;
-  },
+          message.error = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): StringifyMetadataResponse {
-    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
-    let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseStringifyMetadataResponse();
-    while (reader.pos < end) {
-      const tag = reader.uint32();
-      switch (tag >>> 3) {
-        case 1:
-          if (tag !== 10) {
+          message.duration = Duration.decode(reader, reader.uint32());
+          continue;
+        case 8:
+          if (tag !== 64) {
             break;
           }
 
-          message.schema = reader.string();
+          message.type = queryHistory_TypeFromJSON(reader.int32());
+          continue;
+        case 9:
+          if (tag !== 72) {
+            break;
+          }
+
+          message.rowCount = reader.int64() as Long;
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2750,73 +4464,129 @@ export const StringifyMetadataResponse = {
     return message;
   },
 
-  fromJSON(object: any): StringifyMetadataResponse {
-    return { schema: isSet(object.schema) ? globalThis.String(object.schema) : "" };
+  fromJSON(object: any): QueryHistory {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
+      error: isSet(object.error) ? globalThis.String(object.error) : undefined,
+      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
+      type: isSet(object.type) ? queryHistory_TypeFromJSON(object.type) : QueryHistory_Type.TYPE_UNSPECIFIED,
+      rowCount: isSet(object.rowCount) ? Long.fromValue(object.rowCount) : Long.ZERO,
+    };
   },
 
-  toJSON(message: StringifyMetadataResponse): unknown {
+  toJSON(message: QueryHistory): unknown {
     const obj: any = {};
-    if (message.schema !== "") {
-      obj.schema = message.schema;
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    if (message.statement !== "") {
+      obj.statement = message.statement;
+    }
+    if (message.error !== undefined) {
+      obj.error = message.error;
+    }
+    if (message.duration !== undefined) {
+      obj.duration = Duration.toJSON(message.duration);
+    }
+    if (message.type !== QueryHistory_Type.TYPE_UNSPECIFIED) {
+      obj.type = queryHistory_TypeToJSON(message.type);
+    }
+    if (!message.rowCount.isZero()) {
+      obj.rowCount = (message.rowCount || Long.ZERO).toString();
     }
     return obj;
   },
 
-  create(base?: DeepPartial<StringifyMetadataResponse>): StringifyMetadataResponse {
-    return StringifyMetadataResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<QueryHistory>): QueryHistory {
+    return QueryHistory.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<StringifyMetadataResponse>): StringifyMetadataResponse {
-    const message = createBaseStringifyMetadataResponse();
-    message.schema = object.schema ?? "";
+  fromPartial(object: DeepPartial<QueryHistory>): QueryHistory {
+    const message = createBaseQueryHistory();
+    message.name = object.name ?? "";
+    message.database = object.database ?? "";
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.statement = object.statement ?? "";
+    message.error = object.error ?? undefined;
+    message.duration = (object.duration !== undefined && object.duration !== null)
+      ? Duration.fromPartial(object.duration)
+      : undefined;
+    message.type = object.type ?? QueryHistory_Type.TYPE_UNSPECIFIED;
+    message.rowCount = (object.rowCount !== undefined && object.rowCount !== null)
+      ? Long.fromValue(object.rowCount)
+      : Long.ZERO;
     return message;
   },
 };
 
-function createBaseSearchQueryHistoriesRequest(): SearchQueryHistoriesRequest {
-  return { pageSize: 0, pageToken: "", filter: "" };
+function createBaseGenerateRestoreSQLRequest(): GenerateRestoreSQLRequest {
+  return { name: "", sheet: "", backupDataSource: "", backupTable: "" };
 }
 
-export const SearchQueryHistoriesRequest = {
-  encode(message: SearchQueryHistoriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.pageSize !== 0) {
-      writer.uint32(8).int32(message.pageSize);
+export const GenerateRestoreSQLRequest = {
+  encode(message: GenerateRestoreSQLRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.pageToken !== "") {
-      writer.uint32(18).string(message.pageToken);
+    if (message.sheet !== "") {
+      writer.uint32(18).string(message.sheet);
     }
-    if (message.filter !== "") {
-      writer.uint32(26).string(message.filter);
+    if (message.backupDataSource !== "") {
+      writer.uint32(26).string(message.backupDataSource);
+    }
+    if (message.backupTable !== "") {
+      writer.uint32(34).string(message.backupTable);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SearchQueryHistoriesRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateRestoreSQLRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSearchQueryHistoriesRequest();
+    const message = createBaseGenerateRestoreSQLRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 8) {
+          if (tag !== 10) {
             break;
           }
 
-          message.pageSize = reader.int32();
+          message.name = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.pageToken = reader.string();
+          message.sheet = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.filter = reader.string();
+          message.backupDataSource = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.backupTable = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2827,59 +4597,61 @@ export const SearchQueryHistoriesRequest = {
     return message;
   },
 
-  fromJSON(object: any): SearchQueryHistoriesRequest {
+  fromJSON(object: any): GenerateRestoreSQLRequest {
     return {
-      pageSize: isSet(object.pageSize) ? globalThis.Number(object.pageSize) : 0,
-      pageToken: isSet(object.pageToken) ? globalThis.String(object.pageToken) : "",
-      filter: isSet(object.filter) ? globalThis.String(object.filter) : "",
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      sheet: isSet(object.sheet) ? globalThis.String(object.sheet) : "",
+      backupDataSource: isSet(object.backupDataSource) ? globalThis.String(object.backupDataSource) : "",
+      backupTable: isSet(object.backupTable) ? globalThis.String(object.backupTable) : "",
     };
   },
 
-  toJSON(message: SearchQueryHistoriesRequest): unknown {
+  toJSON(message: GenerateRestoreSQLRequest): unknown {
     const obj: any = {};
-    if (message.pageSize !== 0) {
-      obj.pageSize = Math.round(message.pageSize);
+    if (message.name !== "") {
+      obj.name = message.name;
     }
-    if (message.pageToken !== "") {
-      obj.pageToken = message.pageToken;
+    if (message.sheet !== "") {
+      obj.sheet = message.sheet;
     }
-    if (message.filter !== "") {
-      obj.filter = message.filter;
+    if (message.backupDataSource !== "") {
+      obj.backupDataSource = message.backupDataSource;
+    }
+    if (message.backupTable !== "") {
+      obj.backupTable = message.backupTable;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SearchQueryHistoriesRequest>): SearchQueryHistoriesRequest {
-    return SearchQueryHistoriesRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<GenerateRestoreSQLRequest>): GenerateRestoreSQLRequest {
+    return GenerateRestoreSQLRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SearchQueryHistoriesRequest>): SearchQueryHistoriesRequest {
-    const message = createBaseSearchQueryHistoriesRequest();
-    message.pageSize = object.pageSize ?? 0;
-    message.pageToken = object.pageToken ?? "";
-    message.filter = object.filter ?? "";
+  fromPartial(object: DeepPartial<GenerateRestoreSQLRequest>): GenerateRestoreSQLRequest {
+    const message = createBaseGenerateRestoreSQLRequest();
+    message.name = object.name ?? "";
+    message.sheet = object.sheet ?? "";
+    message.backupDataSource = object.backupDataSource ?? "";
+    message.backupTable = object.backupTable ?? "";
     return message;
   },
 };
 
-function createBaseSearchQueryHistoriesResponse(): SearchQueryHistoriesResponse {
-  return { queryHistories: [], nextPageToken: "" };
+function createBaseGenerateRestoreSQLResponse(): GenerateRestoreSQLResponse {
+  return { statement: "" };
 }
 
-export const SearchQueryHistoriesResponse = {
-  encode(message: SearchQueryHistoriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    for (const v of message.queryHistories) {
-      QueryHistory.encode(v!, writer.uint32(10).fork()).ldelim();
-    }
-    if (message.nextPageToken !== "") {
-      writer.uint32(18).string(message.nextPageToken);
+export const GenerateRestoreSQLResponse = {
+  encode(message: GenerateRestoreSQLResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.statement !== "") {
+      writer.uint32(10).string(message.statement);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): SearchQueryHistoriesResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateRestoreSQLResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseSearchQueryHistoriesResponse();
+    const message = createBaseGenerateRestoreSQLResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2888,14 +4660,7 @@ export const SearchQueryHistoriesResponse = {
             break;
           }
 
-          message.queryHistories.push(QueryHistory.decode(reader, reader.uint32()));
-          continue;
-        case 2:
-          if (tag !== 18) {
-            break;
-          }
-
-          message.nextPageToken = reader.string();
+          message.statement = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2906,83 +4671,50 @@ export const SearchQueryHistoriesResponse = {
     return message;
   },
 
-  fromJSON(object: any): SearchQueryHistoriesResponse {
-    return {
-      queryHistories: globalThis.Array.isArray(object?.queryHistories)
-        ? object.queryHistories.map((e: any) => QueryHistory.fromJSON(e))
-        : [],
-      nextPageToken: isSet(object.nextPageToken) ? globalThis.String(object.nextPageToken) : "",
-    };
+  fromJSON(object: any): GenerateRestoreSQLResponse {
+    return { statement: isSet(object.statement) ? globalThis.String(object.statement) : "" };
   },
 
-  toJSON(message: SearchQueryHistoriesResponse): unknown {
+  toJSON(message: GenerateRestoreSQLResponse): unknown {
     const obj: any = {};
-    if (message.queryHistories?.length) {
-      obj.queryHistories = message.queryHistories.map((e) => QueryHistory.toJSON(e));
-    }
-    if (message.nextPageToken !== "") {
-      obj.nextPageToken = message.nextPageToken;
+    if (message.statement !== "") {
+      obj.statement = message.statement;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<SearchQueryHistoriesResponse>): SearchQueryHistoriesResponse {
-    return SearchQueryHistoriesResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<GenerateRestoreSQLResponse>): GenerateRestoreSQLResponse {
+    return GenerateRestoreSQLResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<SearchQueryHistoriesResponse>): SearchQueryHistoriesResponse {
-    const message = createBaseSearchQueryHistoriesResponse();
-    message.queryHistories = object.queryHistories?.map((e) => QueryHistory.fromPartial(e)) || [];
-    message.nextPageToken = object.nextPageToken ?? "";
+  fromPartial(object: DeepPartial<GenerateRestoreSQLResponse>): GenerateRestoreSQLResponse {
+    const message = createBaseGenerateRestoreSQLResponse();
+    message.statement = object.statement ?? "";
     return message;
   },
 };
 
-function createBaseQueryHistory(): QueryHistory {
-  return {
-    name: "",
-    database: "",
-    creator: "",
-    createTime: undefined,
-    statement: "",
-    error: undefined,
-    duration: undefined,
-    type: QueryHistory_Type.TYPE_UNSPECIFIED,
-  };
+function createBaseGenerateSQLRequest(): GenerateSQLRequest {
+  return { name: "", prompt: "", history: [] };
 }
-
-export const QueryHistory = {
-  encode(message: QueryHistory, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.database !== "") {
-      writer.uint32(18).string(message.database);
-    }
-    if (message.creator !== "") {
-      writer.uint32(26).string(message.creator);
-    }
-    if (message.createTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(34).fork()).ldelim();
-    }
-    if (message.statement !== "") {
-      writer.uint32(42).string(message.statement);
-    }
-    if (message.error !== undefined) {
-      writer.uint32(50).string(message.error);
+
+export const GenerateSQLRequest = {
+  encode(message: GenerateSQLRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
     }
-    if (message.duration !== undefined) {
-      Duration.encode(message.duration, writer.uint32(58).fork()).ldelim();
+    if (message.prompt !== "") {
+      writer.uint32(18).string(message.prompt);
     }
-    if (message.type !== QueryHistory_Type.TYPE_UNSPECIFIED) {
-      writer.uint32(64).int32(queryHistory_TypeToNumber(message.type));
+    for (const v of message.history) {
+      GenerateSQLRequest_Message.encode(v!, writer.uint32(26).fork()).ldelim();
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): QueryHistory {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateSQLRequest {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseQueryHistory();
+    const message = createBaseGenerateSQLRequest();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2998,49 +4730,14 @@ export const QueryHistory = {
             break;
           }
 
-          message.database = reader.string();
+          message.prompt = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.creator = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
-          continue;
-        case 5:
-          if (tag !== 42) {
-            break;
-          }
-
-          message.statement = reader.string();
-          continue;
-        case 6:
-          if (tag !== 50) {
-            break;
-          }
-
-          message.error = reader.string();
-          continue;
-        case 7:
-          if (tag !== 58) {
-            break;
-          }
-
-          message.duration = Duration.decode(reader, reader.uint32());
-          continue;
-        case 8:
-          if (tag !== 64) {
-            break;
-          }
-
-          message.type = queryHistory_TypeFromJSON(reader.int32());
+          message.history.push(GenerateSQLRequest_Message.decode(reader, reader.uint32()));
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3051,122 +4748,77 @@ export const QueryHistory = {
     return message;
   },
 
-  fromJSON(object: any): QueryHistory {
+  fromJSON(object: any): GenerateSQLRequest {
     return {
       name: isSet(object.name) ? globalThis.String(object.name) : "",
-      database: isSet(object.database) ? globalThis.String(object.database) : "",
-      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
-      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
-      statement: isSet(object.statement) ? globalThis.String(object.statement) : "",
-      error: isSet(object.error) ? globalThis.String(object.error) : undefined,
-      duration: isSet(object.duration) ? Duration.fromJSON(object.duration) : undefined,
-      type: isSet(object.type) ? queryHistory_TypeFromJSON(object.type) : QueryHistory_Type.TYPE_UNSPECIFIED,
+      prompt: isSet(object.prompt) ? globalThis.String(object.prompt) : "",
+      history: globalThis.Array.isArray(object?.history)
+        ? object.history.map((e: any) => GenerateSQLRequest_Message.fromJSON(e))
+        : [],
     };
   },
 
-  toJSON(message: QueryHistory): unknown {
+  toJSON(message: GenerateSQLRequest): unknown {
     const obj: any = {};
     if (message.name !== "") {
       obj.name = message.name;
     }
-    if (message.database !== "") {
-      obj.database = message.database;
-    }
-    if (message.creator !== "") {
-      obj.creator = message.creator;
-    }
-    if (message.createTime !== undefined) {
-      obj.createTime = message.createTime.toISOString();
-    }
-    if (message.statement !== "") {
-      obj.statement = message.statement;
-    }
-    if (message.error !== undefined) {
-      obj.error = message.error;
-    }
-    if (message.duration !== undefined) {
-      obj.duration = Duration.toJSON(message.duration);
+    if (message.prompt !== "") {
+      obj.prompt = message.prompt;
     }
-    if (message.type !== QueryHistory_Type.TYPE_UNSPECIFIED) {
-      obj.type = queryHistory_TypeToJSON(message.type);
+    if (message.history?.length) {
+      obj.history = message.history.map((e) => GenerateSQLRequest_Message.toJSON(e));
     }
     return obj;
   },
 
-  create(base?: DeepPartial<QueryHistory>): QueryHistory {
-    return QueryHistory.fromPartial(base ?? {});
+  create(base?: DeepPartial<GenerateSQLRequest>): GenerateSQLRequest {
+    return GenerateSQLRequest.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<QueryHistory>): QueryHistory {
-    const message = createBaseQueryHistory();
+  fromPartial(object: DeepPartial<GenerateSQLRequest>): GenerateSQLRequest {
+    const message = createBaseGenerateSQLRequest();
     message.name = object.name ?? "";
-    message.database = object.database ?? "";
-    message.creator = object.creator ?? "";
-    message.createTime = object.createTime ?? undefined;
-    message.statement = object.statement ?? "";
-    message.error = object.error ?? undefined;
-    message.duration = (object.duration !== undefined && object.duration !== null)
-      ? Duration.fromPartial(object.duration)
-      : undefined;
-    message.type = object.type ?? QueryHistory_Type.TYPE_UNSPECIFIED;
+    message.prompt = object.prompt ?? "";
+    message.history = object.history?.map((e) => GenerateSQLRequest_Message.fromPartial(e)) || [];
     return message;
   },
 };
 
-function createBaseGenerateRestoreSQLRequest(): GenerateRestoreSQLRequest {
-  return { name: "", sheet: "", backupDataSource: "", backupTable: "" };
+function createBaseGenerateSQLRequest_Message(): GenerateSQLRequest_Message {
+  return { role: GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED, content: "" };
 }
 
-export const GenerateRestoreSQLRequest = {
-  encode(message: GenerateRestoreSQLRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.name !== "") {
-      writer.uint32(10).string(message.name);
-    }
-    if (message.sheet !== "") {
-      writer.uint32(18).string(message.sheet);
-    }
-    if (message.backupDataSource !== "") {
-      writer.uint32(26).string(message.backupDataSource);
+export const GenerateSQLRequest_Message = {
+  encode(message: GenerateSQLRequest_Message, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.role !== GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED) {
+      writer.uint32(8).int32(generateSQLRequest_Message_RoleToNumber(message.role));
     }
-    if (message.backupTable !== "") {
-      writer.uint32(34).string(message.backupTable);
+    if (message.content !== "") {
+      writer.uint32(18).string(message.content);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateRestoreSQLRequest {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateSQLRequest_Message {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGenerateRestoreSQLRequest();
+    const message = createBaseGenerateSQLRequest_Message();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
         case 1:
-          if (tag !== 10) {
+          if (tag !== 8) {
             break;
           }
 
-          message.name = reader.string();
+          message.role = generateSQLRequest_Message_RoleFromJSON(reader.int32());
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.sheet = reader.string();
-          continue;
-        case 3:
-          if (tag !== 26) {
-            break;
-          }
-
-          message.backupDataSource = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.backupTable = reader.string();
+          message.content = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -3177,61 +4829,53 @@ export const GenerateRestoreSQLRequest = {
     return message;
   },
 
-  fromJSON(object: any): GenerateRestoreSQLRequest {
+  fromJSON(object: any): GenerateSQLRequest_Message {
     return {
-      name: isSet(object.name) ? globalThis.String(object.name) : "",
-      sheet: isSet(object.sheet) ? globalThis.String(object.sheet) : "",
-      backupDataSource: isSet(object.backupDataSource) ? globalThis.String(object.backupDataSource) : "",
-      backupTable: isSet(object.backupTable) ? globalThis.String(object.backupTable) : "",
+      role: isSet(object.role)
+        ? generateSQLRequest_Message_RoleFromJSON(object.role)
+        : GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED,
+      content: isSet(object.content) ? globalThis.String(object.content) : "",
     };
   },
 
-  toJSON(message: GenerateRestoreSQLRequest): unknown {
+  toJSON(message: GenerateSQLRequest_Message): unknown {
     const obj: any = {};
-    if (message.name !== "") {
-      obj.name = message.name;
-    }
-    if (message.sheet !== "") {
-      obj.sheet = message.sheet;
+    if (message.role !== GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED) {
+      obj.role = generateSQLRequest_Message_RoleToJSON(message.role);
     }
-    if (message.backupDataSource !== "") {
-      obj.backupDataSource = message.backupDataSource;
-    }
-    if (message.backupTable !== "") {
-      obj.backupTable = message.backupTable;
+    if (message.content !== "") {
+      obj.content = message.content;
     }
     return obj;
   },
 
-  create(base?: DeepPartial<GenerateRestoreSQLRequest>): GenerateRestoreSQLRequest {
-    return GenerateRestoreSQLRequest.fromPartial(base ?? {});
+  create(base?: DeepPartial<GenerateSQLRequest_Message>): GenerateSQLRequest_Message {
+    return GenerateSQLRequest_Message.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GenerateRestoreSQLRequest>): GenerateRestoreSQLRequest {
-    const message = createBaseGenerateRestoreSQLRequest();
-    message.name = object.name ?? "";
-    message.sheet = object.sheet ?? "";
-    message.backupDataSource = object.backupDataSource ?? "";
-    message.backupTable = object.backupTable ?? "";
+  fromPartial(object: DeepPartial<GenerateSQLRequest_Message>): GenerateSQLRequest_Message {
+    const message = createBaseGenerateSQLRequest_Message();
+    message.role = object.role ?? GenerateSQLRequest_Message_Role.ROLE_UNSPECIFIED;
+    message.content = object.content ?? "";
     return message;
   },
 };
 
-function createBaseGenerateRestoreSQLResponse(): GenerateRestoreSQLResponse {
+function createBaseGenerateSQLResponse(): GenerateSQLResponse {
   return { statement: "" };
 }
 
-export const GenerateRestoreSQLResponse = {
-  encode(message: GenerateRestoreSQLResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+export const GenerateSQLResponse = {
+  encode(message: GenerateSQLResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
     if (message.statement !== "") {
       writer.uint32(10).string(message.statement);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateRestoreSQLResponse {
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateSQLResponse {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBaseGenerateRestoreSQLResponse();
+    const message = createBaseGenerateSQLResponse();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -3251,11 +4895,11 @@ export const GenerateRestoreSQLResponse = {
     return message;
   },
 
-  fromJSON(object: any): GenerateRestoreSQLResponse {
+  fromJSON(object: any): GenerateSQLResponse {
     return { statement: isSet(object.statement) ? globalThis.String(object.statement) : "" };
   },
 
-  toJSON(message: GenerateRestoreSQLResponse): unknown {
+  toJSON(message: GenerateSQLResponse): unknown {
     const obj: any = {};
     if (message.statement !== "") {
       obj.statement = message.statement;
@@ -3263,11 +4907,11 @@ export const GenerateRestoreSQLResponse = {
     return obj;
   },
 
-  create(base?: DeepPartial<GenerateRestoreSQLResponse>): GenerateRestoreSQLResponse {
-    return GenerateRestoreSQLResponse.fromPartial(base ?? {});
+  create(base?: DeepPartial<GenerateSQLResponse>): GenerateSQLResponse {
+    return GenerateSQLResponse.fromPartial(base ?? {});
   },
-  fromPartial(object: DeepPartial<GenerateRestoreSQLResponse>): GenerateRestoreSQLResponse {
-    const message = createBaseGenerateRestoreSQLResponse();
+  fromPartial(object: DeepPartial<GenerateSQLResponse>): GenerateSQLResponse {
+    const message = createBaseGenerateSQLResponse();
     message.statement = object.statement ?? "";
     return message;
   },
@@ -3282,8 +4926,173 @@ export const SQLServiceDefinition = {
       name: "Query",
       requestType: QueryRequest,
       requestStream: false,
-      responseType: QueryResponse,
+      responseType: QueryResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              80,
+              58,
+              1,
+              42,
+              90,
+              33,
+              58,
+              1,
+              42,
+              34,
+              28,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              113,
+              117,
+              101,
+              114,
+              121,
+              34,
+              40,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              113,
+              117,
+              101,
+              114,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
+    /** ListAdminSessions lists the recorded admin execute sessions of the instance, the newest first. */
+    listAdminSessions: {
+      name: "ListAdminSessions",
+      requestType: ListAdminSessionsRequest,
+      requestStream: false,
+      responseType: ListAdminSessionsResponse,
       responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 97, 117, 100, 105, 116, 76, 111, 103, 115, 46, 115, 101, 97, 114, 99, 104]),
+          ],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              40,
+              18,
+              38,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              125,
+              47,
+              97,
+              100,
+              109,
+              105,
+              110,
+              83,
+              101,
+              115,
+              115,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * QueryStream is the same as Query, but streams the results in frames so that the large results
+     * are not limited by the maximum message size.
+     */
+    queryStream: {
+      name: "QueryStream",
+      requestType: QueryRequest,
+      requestStream: false,
+      responseType: QueryStreamResponse,
+      responseStream: true,
       options: {
         _unknownFields: {
           800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
@@ -3291,17 +5100,85 @@ export const SQLServiceDefinition = {
           800024: [new Uint8Array([1])],
           578365826: [
             new Uint8Array([
-              80,
+              51,
               58,
               1,
               42,
-              90,
-              33,
+              34,
+              46,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              113,
+              117,
+              101,
+              114,
+              121,
+              83,
+              116,
+              114,
+              101,
+              97,
+              109,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * CancelQuery cancels the running query of the caller by the query_id in the QueryRequest.
+     * The query is also canceled in the database for PostgreSQL and MySQL compatible engines.
+     */
+    cancelQuery: {
+      name: "CancelQuery",
+      requestType: CancelQueryRequest,
+      requestStream: false,
+      responseType: Empty,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              51,
               58,
               1,
               42,
               34,
-              28,
+              46,
               47,
               118,
               49,
@@ -3323,15 +5200,58 @@ export const SQLServiceDefinition = {
               115,
               47,
               42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
               125,
               58,
-              113,
+              99,
+              97,
+              110,
+              99,
+              101,
+              108,
+              81,
               117,
               101,
               114,
               121,
+            ]),
+          ],
+        },
+      },
+    },
+    /**
+     * GetQueryPlan explains the query without executing it, the plan is normalized to a tree for the engines:
+     * PostgreSQL and MySQL.
+     */
+    getQueryPlan: {
+      name: "GetQueryPlan",
+      requestType: GetQueryPlanRequest,
+      requestStream: false,
+      responseType: QueryPlan,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [new Uint8Array([16, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 103, 101, 116])],
+          800016: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              49,
+              58,
+              1,
+              42,
               34,
-              40,
+              44,
               47,
               118,
               49,
@@ -3372,6 +5292,10 @@ export const SQLServiceDefinition = {
               101,
               114,
               121,
+              80,
+              108,
+              97,
+              110,
             ]),
           ],
         },
@@ -3767,6 +5691,106 @@ export const SQLServiceDefinition = {
         },
       },
     },
+    /**
+     * GenerateSQL generates the SQL statement from the natural language with the AI provider,
+     * the synced schema metadata of the database is sent as the grounding context.
+     */
+    generateSQL: {
+      name: "GenerateSQL",
+      requestType: GenerateSQLRequest,
+      requestStream: false,
+      responseType: GenerateSQLResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          800010: [
+            new Uint8Array([
+              22,
+              98,
+              98,
+              46,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              46,
+              103,
+              101,
+              116,
+              83,
+              99,
+              104,
+              101,
+              109,
+              97,
+            ]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              51,
+              58,
+              1,
+              42,
+              34,
+              46,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              105,
+              110,
+              115,
+              116,
+              97,
+              110,
+              99,
+              101,
+              115,
+              47,
+              42,
+              47,
+              100,
+              97,
+              116,
+              97,
+              98,
+              97,
+              115,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              103,
+              101,
+              110,
+              101,
+              114,
+              97,
+              116,
+              101,
+              83,
+              81,
+              76,
+            ]),
+          ],
+        },
+      },
+    },
     parseMyBatisMapper: {
       name: "ParseMyBatisMapper",
       requestType: ParseMyBatisMapperRequest,
@@ -90,6 +90,112 @@ export interface DeleteVCSConnectorRequest {
   name: string;
 }
 
+export interface ImportMigrationHistoryRequest {
+  /**
+   * The name of the vcsConnector to read the migrations from.
+   * Format: projects/{project}/vcsConnectors/{vcsConnector}
+   */
+  name: string;
+  /**
+   * The database which is migrated by the tool.
+   * Format: instances/{instance}/databases/{database}
+   */
+  database: string;
+  tool: ImportMigrationHistoryRequest_Tool;
+  /**
+   * The path relative to the repository root.
+   * For Flyway, it's the migration directory, and defaults to "sql".
+   * For Liquibase, it's the changelog file in the formatted SQL or JSON format.
+   */
+  path: string;
+  /**
+   * The name of the history table of the tool in the database.
+   * Defaults to "flyway_schema_history" for Flyway and "DATABASECHANGELOG" for Liquibase.
+   */
+  historyTable: string;
+  /** If true, only returns the mapped migrations without backfilling the migration history. */
+  validateOnly: boolean;
+}
+
+export enum ImportMigrationHistoryRequest_Tool {
+  TOOL_UNSPECIFIED = "TOOL_UNSPECIFIED",
+  FLYWAY = "FLYWAY",
+  LIQUIBASE = "LIQUIBASE",
+  UNRECOGNIZED = "UNRECOGNIZED",
+}
+
+export function importMigrationHistoryRequest_ToolFromJSON(object: any): ImportMigrationHistoryRequest_Tool {
+  switch (object) {
+    case 0:
+    case "TOOL_UNSPECIFIED":
+      return ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED;
+    case 1:
+    case "FLYWAY":
+      return ImportMigrationHistoryRequest_Tool.FLYWAY;
+    case 2:
+    case "LIQUIBASE":
+      return ImportMigrationHistoryRequest_Tool.LIQUIBASE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return ImportMigrationHistoryRequest_Tool.UNRECOGNIZED;
+  }
+}
+
+export function importMigrationHistoryRequest_ToolToJSON(object: ImportMigrationHistoryRequest_Tool): string {
+  switch (object) {
+    case ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED:
+      return "TOOL_UNSPECIFIED";
+    case ImportMigrationHistoryRequest_Tool.FLYWAY:
+      return "FLYWAY";
+    case ImportMigrationHistoryRequest_Tool.LIQUIBASE:
+      return "LIQUIBASE";
+    case ImportMigrationHistoryRequest_Tool.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export function importMigrationHistoryRequest_ToolToNumber(object: ImportMigrationHistoryRequest_Tool): number {
+  switch (object) {
+    case ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED:
+      return 0;
+    case ImportMigrationHistoryRequest_Tool.FLYWAY:
+      return 1;
+    case ImportMigrationHistoryRequest_Tool.LIQUIBASE:
+      return 2;
+    case ImportMigrationHistoryRequest_Tool.UNRECOGNIZED:
+    default:
+      return -1;
+  }
+}
+
+export interface ImportMigrationHistoryResponse {
+  migrations: ImportMigrationHistoryResponse_Migration[];
+}
+
+export interface ImportMigrationHistoryResponse_Migration {
+  /** The version of the migration. For Liquibase, it's the changeset id. */
+  version: string;
+  description: string;
+  /** The path of the migration file in the repository. */
+  path: string;
+  /** The checksum recorded by the tool. */
+  checksum: string;
+  /** Whether the migration is applied in the live database. */
+  applied: boolean;
+  appliedTime:
+    | Date
+    | undefined;
+  /**
+   * The backfilled change history.
+   * Format: instances/{instance}/databases/{database}/changeHistories/{changeHistory}
+   */
+  changeHistory: string;
+  /** The reason why the migration is not backfilled. */
+  skipReason: string;
+}
+
 export interface VCSConnector {
   /**
    * The name of the vcsConnector resource.
@@ -588,6 +694,383 @@ export const DeleteVCSConnectorRequest = {
   },
 };
 
+function createBaseImportMigrationHistoryRequest(): ImportMigrationHistoryRequest {
+  return {
+    name: "",
+    database: "",
+    tool: ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED,
+    path: "",
+    historyTable: "",
+    validateOnly: false,
+  };
+}
+
+export const ImportMigrationHistoryRequest = {
+  encode(message: ImportMigrationHistoryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.database !== "") {
+      writer.uint32(18).string(message.database);
+    }
+    if (message.tool !== ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED) {
+      writer.uint32(24).int32(importMigrationHistoryRequest_ToolToNumber(message.tool));
+    }
+    if (message.path !== "") {
+      writer.uint32(34).string(message.path);
+    }
+    if (message.historyTable !== "") {
+      writer.uint32(42).string(message.historyTable);
+    }
+    if (message.validateOnly === true) {
+      writer.uint32(48).bool(message.validateOnly);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportMigrationHistoryRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportMigrationHistoryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.tool = importMigrationHistoryRequest_ToolFromJSON(reader.int32());
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.historyTable = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.validateOnly = reader.bool();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportMigrationHistoryRequest {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      database: isSet(object.database) ? globalThis.String(object.database) : "",
+      tool: isSet(object.tool)
+        ? importMigrationHistoryRequest_ToolFromJSON(object.tool)
+        : ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED,
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      historyTable: isSet(object.historyTable) ? globalThis.String(object.historyTable) : "",
+      validateOnly: isSet(object.validateOnly) ? globalThis.Boolean(object.validateOnly) : false,
+    };
+  },
+
+  toJSON(message: ImportMigrationHistoryRequest): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.database !== "") {
+      obj.database = message.database;
+    }
+    if (message.tool !== ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED) {
+      obj.tool = importMigrationHistoryRequest_ToolToJSON(message.tool);
+    }
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (message.historyTable !== "") {
+      obj.historyTable = message.historyTable;
+    }
+    if (message.validateOnly === true) {
+      obj.validateOnly = message.validateOnly;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportMigrationHistoryRequest>): ImportMigrationHistoryRequest {
+    return ImportMigrationHistoryRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportMigrationHistoryRequest>): ImportMigrationHistoryRequest {
+    const message = createBaseImportMigrationHistoryRequest();
+    message.name = object.name ?? "";
+    message.database = object.database ?? "";
+    message.tool = object.tool ?? ImportMigrationHistoryRequest_Tool.TOOL_UNSPECIFIED;
+    message.path = object.path ?? "";
+    message.historyTable = object.historyTable ?? "";
+    message.validateOnly = object.validateOnly ?? false;
+    return message;
+  },
+};
+
+function createBaseImportMigrationHistoryResponse(): ImportMigrationHistoryResponse {
+  return { migrations: [] };
+}
+
+export const ImportMigrationHistoryResponse = {
+  encode(message: ImportMigrationHistoryResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.migrations) {
+      ImportMigrationHistoryResponse_Migration.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportMigrationHistoryResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportMigrationHistoryResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.migrations.push(ImportMigrationHistoryResponse_Migration.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportMigrationHistoryResponse {
+    return {
+      migrations: globalThis.Array.isArray(object?.migrations)
+        ? object.migrations.map((e: any) => ImportMigrationHistoryResponse_Migration.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ImportMigrationHistoryResponse): unknown {
+    const obj: any = {};
+    if (message.migrations?.length) {
+      obj.migrations = message.migrations.map((e) => ImportMigrationHistoryResponse_Migration.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportMigrationHistoryResponse>): ImportMigrationHistoryResponse {
+    return ImportMigrationHistoryResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportMigrationHistoryResponse>): ImportMigrationHistoryResponse {
+    const message = createBaseImportMigrationHistoryResponse();
+    message.migrations = object.migrations?.map((e) => ImportMigrationHistoryResponse_Migration.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseImportMigrationHistoryResponse_Migration(): ImportMigrationHistoryResponse_Migration {
+  return {
+    version: "",
+    description: "",
+    path: "",
+    checksum: "",
+    applied: false,
+    appliedTime: undefined,
+    changeHistory: "",
+    skipReason: "",
+  };
+}
+
+export const ImportMigrationHistoryResponse_Migration = {
+  encode(message: ImportMigrationHistoryResponse_Migration, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.version !== "") {
+      writer.uint32(10).string(message.version);
+    }
+    if (message.description !== "") {
+      writer.uint32(18).string(message.description);
+    }
+    if (message.path !== "") {
+      writer.uint32(26).string(message.path);
+    }
+    if (message.checksum !== "") {
+      writer.uint32(34).string(message.checksum);
+    }
+    if (message.applied === true) {
+      writer.uint32(40).bool(message.applied);
+    }
+    if (message.appliedTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.appliedTime), writer.uint32(50).fork()).ldelim();
+    }
+    if (message.changeHistory !== "") {
+      writer.uint32(58).string(message.changeHistory);
+    }
+    if (message.skipReason !== "") {
+      writer.uint32(66).string(message.skipReason);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ImportMigrationHistoryResponse_Migration {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseImportMigrationHistoryResponse_Migration();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.version = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.description = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.path = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.checksum = reader.string();
+          continue;
+        case 5:
+          if (tag !== 40) {
+            break;
+          }
+
+          message.applied = reader.bool();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.appliedTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.changeHistory = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.skipReason = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ImportMigrationHistoryResponse_Migration {
+    return {
+      version: isSet(object.version) ? globalThis.String(object.version) : "",
+      description: isSet(object.description) ? globalThis.String(object.description) : "",
+      path: isSet(object.path) ? globalThis.String(object.path) : "",
+      checksum: isSet(object.checksum) ? globalThis.String(object.checksum) : "",
+      applied: isSet(object.applied) ? globalThis.Boolean(object.applied) : false,
+      appliedTime: isSet(object.appliedTime) ? fromJsonTimestamp(object.appliedTime) : undefined,
+      changeHistory: isSet(object.changeHistory) ? globalThis.String(object.changeHistory) : "",
+      skipReason: isSet(object.skipReason) ? globalThis.String(object.skipReason) : "",
+    };
+  },
+
+  toJSON(message: ImportMigrationHistoryResponse_Migration): unknown {
+    const obj: any = {};
+    if (message.version !== "") {
+      obj.version = message.version;
+    }
+    if (message.description !== "") {
+      obj.description = message.description;
+    }
+    if (message.path !== "") {
+      obj.path = message.path;
+    }
+    if (message.checksum !== "") {
+      obj.checksum = message.checksum;
+    }
+    if (message.applied === true) {
+      obj.applied = message.applied;
+    }
+    if (message.appliedTime !== undefined) {
+      obj.appliedTime = message.appliedTime.toISOString();
+    }
+    if (message.changeHistory !== "") {
+      obj.changeHistory = message.changeHistory;
+    }
+    if (message.skipReason !== "") {
+      obj.skipReason = message.skipReason;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ImportMigrationHistoryResponse_Migration>): ImportMigrationHistoryResponse_Migration {
+    return ImportMigrationHistoryResponse_Migration.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ImportMigrationHistoryResponse_Migration>): ImportMigrationHistoryResponse_Migration {
+    const message = createBaseImportMigrationHistoryResponse_Migration();
+    message.version = object.version ?? "";
+    message.description = object.description ?? "";
+    message.path = object.path ?? "";
+    message.checksum = object.checksum ?? "";
+    message.applied = object.applied ?? false;
+    message.appliedTime = object.appliedTime ?? undefined;
+    message.changeHistory = object.changeHistory ?? "";
+    message.skipReason = object.skipReason ?? "";
+    return message;
+  },
+};
+
 function createBaseVCSConnector(): VCSConnector {
   return {
     name: "",
@@ -1362,6 +1845,98 @@ export const VCSConnectorServiceDefinition = {
         },
       },
     },
+    /**
+     * ImportMigrationHistory reads the Flyway migration directory or the Liquibase changelog in the repository,
+     * maps the migrations against the history table of the tool in the live database, and backfills the
+     * migration history and sheets of the applied migrations.
+     */
+    importMigrationHistory: {
+      name: "ImportMigrationHistory",
+      requestType: ImportMigrationHistoryRequest,
+      requestStream: false,
+      responseType: ImportMigrationHistoryResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          800010: [
+            new Uint8Array([19, 98, 98, 46, 100, 97, 116, 97, 98, 97, 115, 101, 115, 46, 117, 112, 100, 97, 116, 101]),
+          ],
+          800016: [new Uint8Array([1])],
+          800024: [new Uint8Array([1])],
+          578365826: [
+            new Uint8Array([
+              65,
+              58,
+              1,
+              42,
+              34,
+              60,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              118,
+              99,
+              115,
+              67,
+              111,
+              110,
+              110,
+              101,
+              99,
+              116,
+              111,
+              114,
+              115,
+              47,
+              42,
+              125,
+              58,
+              105,
+              109,
+              112,
+              111,
+              114,
+              116,
+              77,
+              105,
+              103,
+              114,
+              97,
+              116,
+              105,
+              111,
+              110,
+              72,
+              105,
+              115,
+              116,
+              111,
+              114,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -39,6 +39,8 @@ export interface UpdateWorksheetRequest {
    * - `statement`
    * - `starred`
    * - `visibility`
+   * - `folder`
+   * - `tags`
    */
   updateMask: string[] | undefined;
 }
@@ -87,6 +89,9 @@ export interface SearchWorksheetsRequest {
    * - `creator = users/{email}`, `creator != users/{email}`
    * - `starred = true`, `starred = false`.
    * - `visibility = "VISIBILITY_PRIVATE"`, `visibility = "VISIBILITY_PROJECT_READ | VISIBILITY_PROJECT_WRITE"`, etc.
+   * - `folder = "diagnostics"`, the worksheets in the folder and its subfolders.
+   * - `tag = "oncall"`, the worksheets with the tag. Multiple tags are combined with `&&`.
+   * - `query = "pg_locks"`, the worksheets whose title or content contains the text case-insensitively.
    * Not support empty filter for now.
    */
   filter: string;
@@ -158,9 +163,17 @@ export interface Worksheet {
   content: Uint8Array;
   /** content_size is the full size of the content, may not match the size of the `content` field. */
   contentSize: Long;
+  /** Only the worksheet OWNER and the users with bb.worksheets.manage permission can change the visibility. */
   visibility: Worksheet_Visibility;
   /** starred indicates whether the worksheet is starred by the current authenticated user. */
   starred: boolean;
+  /**
+   * The folder path of the worksheet in the library, separated by "/", e.g. "diagnostics/locks".
+   * The worksheet is in the root folder if empty.
+   */
+  folder: string;
+  /** The tags of the worksheet. */
+  tags: string[];
 }
 
 export enum Worksheet_Visibility {
@@ -171,6 +184,11 @@ export enum Worksheet_Visibility {
   VISIBILITY_PROJECT_WRITE = "VISIBILITY_PROJECT_WRITE",
   /** VISIBILITY_PRIVATE - Private, only worksheet OWNER can read/write. */
   VISIBILITY_PRIVATE = "VISIBILITY_PRIVATE",
+  /**
+   * VISIBILITY_WORKSPACE_READ - Read access in workspace scope, all workspace members can read, worksheet OWNER/DBA can read/write.
+   * Only the users with bb.worksheets.manage permission can share the worksheet to the workspace.
+   */
+  VISIBILITY_WORKSPACE_READ = "VISIBILITY_WORKSPACE_READ",
   UNRECOGNIZED = "UNRECOGNIZED",
 }
 
@@ -188,6 +206,9 @@ export function worksheet_VisibilityFromJSON(object: any): Worksheet_Visibility
     case 3:
     case "VISIBILITY_PRIVATE":
       return Worksheet_Visibility.VISIBILITY_PRIVATE;
+    case 4:
+    case "VISIBILITY_WORKSPACE_READ":
+      return Worksheet_Visibility.VISIBILITY_WORKSPACE_READ;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -205,6 +226,8 @@ export function worksheet_VisibilityToJSON(object: Worksheet_Visibility): string
       return "VISIBILITY_PROJECT_WRITE";
     case Worksheet_Visibility.VISIBILITY_PRIVATE:
       return "VISIBILITY_PRIVATE";
+    case Worksheet_Visibility.VISIBILITY_WORKSPACE_READ:
+      return "VISIBILITY_WORKSPACE_READ";
     case Worksheet_Visibility.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -221,12 +244,43 @@ export function worksheet_VisibilityToNumber(object: Worksheet_Visibility): numb
       return 2;
     case Worksheet_Visibility.VISIBILITY_PRIVATE:
       return 3;
+    case Worksheet_Visibility.VISIBILITY_WORKSPACE_READ:
+      return 4;
     case Worksheet_Visibility.UNRECOGNIZED:
     default:
       return -1;
   }
 }
 
+export interface ListWorksheetRevisionsRequest {
+  /**
+   * The parent worksheet.
+   * Format: worksheets/{worksheet}
+   */
+  parent: string;
+}
+
+export interface ListWorksheetRevisionsResponse {
+  /** The revisions of the worksheet, the newest first. */
+  revisions: WorksheetRevision[];
+}
+
+export interface WorksheetRevision {
+  /** Format: worksheets/{worksheet}/revisions/{revision} */
+  name: string;
+  /** The title of the worksheet in the revision. */
+  title: string;
+  /** The content of the worksheet in the revision. */
+  content: Uint8Array;
+  /**
+   * The author of the revision.
+   * Format: users/{email}
+   */
+  creator: string;
+  /** The time the revision was saved. */
+  createTime: Date | undefined;
+}
+
 function createBaseCreateWorksheetRequest(): CreateWorksheetRequest {
   return { worksheet: undefined };
 }
@@ -804,6 +858,8 @@ function createBaseWorksheet(): Worksheet {
     contentSize: Long.ZERO,
     visibility: Worksheet_Visibility.VISIBILITY_UNSPECIFIED,
     starred: false,
+    folder: "",
+    tags: [],
   };
 }
 
@@ -842,6 +898,12 @@ export const Worksheet = {
     if (message.starred === true) {
       writer.uint32(88).bool(message.starred);
     }
+    if (message.folder !== "") {
+      writer.uint32(98).string(message.folder);
+    }
+    for (const v of message.tags) {
+      writer.uint32(106).string(v!);
+    }
     return writer;
   },
 
@@ -929,6 +991,20 @@ export const Worksheet = {
 
           message.starred = reader.bool();
           continue;
+        case 12:
+          if (tag !== 98) {
+            break;
+          }
+
+          message.folder = reader.string();
+          continue;
+        case 13:
+          if (tag !== 106) {
+            break;
+          }
+
+          message.tags.push(reader.string());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -953,6 +1029,8 @@ export const Worksheet = {
         ? worksheet_VisibilityFromJSON(object.visibility)
         : Worksheet_Visibility.VISIBILITY_UNSPECIFIED,
       starred: isSet(object.starred) ? globalThis.Boolean(object.starred) : false,
+      folder: isSet(object.folder) ? globalThis.String(object.folder) : "",
+      tags: globalThis.Array.isArray(object?.tags) ? object.tags.map((e: any) => globalThis.String(e)) : [],
     };
   },
 
@@ -991,6 +1069,12 @@ export const Worksheet = {
     if (message.starred === true) {
       obj.starred = message.starred;
     }
+    if (message.folder !== "") {
+      obj.folder = message.folder;
+    }
+    if (message.tags?.length) {
+      obj.tags = message.tags;
+    }
     return obj;
   },
 
@@ -1012,6 +1096,245 @@ export const Worksheet = {
       : Long.ZERO;
     message.visibility = object.visibility ?? Worksheet_Visibility.VISIBILITY_UNSPECIFIED;
     message.starred = object.starred ?? false;
+    message.folder = object.folder ?? "";
+    message.tags = object.tags?.map((e) => e) || [];
+    return message;
+  },
+};
+
+function createBaseListWorksheetRevisionsRequest(): ListWorksheetRevisionsRequest {
+  return { parent: "" };
+}
+
+export const ListWorksheetRevisionsRequest = {
+  encode(message: ListWorksheetRevisionsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListWorksheetRevisionsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListWorksheetRevisionsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListWorksheetRevisionsRequest {
+    return { parent: isSet(object.parent) ? globalThis.String(object.parent) : "" };
+  },
+
+  toJSON(message: ListWorksheetRevisionsRequest): unknown {
+    const obj: any = {};
+    if (message.parent !== "") {
+      obj.parent = message.parent;
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListWorksheetRevisionsRequest>): ListWorksheetRevisionsRequest {
+    return ListWorksheetRevisionsRequest.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListWorksheetRevisionsRequest>): ListWorksheetRevisionsRequest {
+    const message = createBaseListWorksheetRevisionsRequest();
+    message.parent = object.parent ?? "";
+    return message;
+  },
+};
+
+function createBaseListWorksheetRevisionsResponse(): ListWorksheetRevisionsResponse {
+  return { revisions: [] };
+}
+
+export const ListWorksheetRevisionsResponse = {
+  encode(message: ListWorksheetRevisionsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.revisions) {
+      WorksheetRevision.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListWorksheetRevisionsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListWorksheetRevisionsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.revisions.push(WorksheetRevision.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListWorksheetRevisionsResponse {
+    return {
+      revisions: globalThis.Array.isArray(object?.revisions)
+        ? object.revisions.map((e: any) => WorksheetRevision.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: ListWorksheetRevisionsResponse): unknown {
+    const obj: any = {};
+    if (message.revisions?.length) {
+      obj.revisions = message.revisions.map((e) => WorksheetRevision.toJSON(e));
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListWorksheetRevisionsResponse>): ListWorksheetRevisionsResponse {
+    return ListWorksheetRevisionsResponse.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<ListWorksheetRevisionsResponse>): ListWorksheetRevisionsResponse {
+    const message = createBaseListWorksheetRevisionsResponse();
+    message.revisions = object.revisions?.map((e) => WorksheetRevision.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseWorksheetRevision(): WorksheetRevision {
+  return { name: "", title: "", content: new Uint8Array(0), creator: "", createTime: undefined };
+}
+
+export const WorksheetRevision = {
+  encode(message: WorksheetRevision, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.title !== "") {
+      writer.uint32(18).string(message.title);
+    }
+    if (message.content.length !== 0) {
+      writer.uint32(26).bytes(message.content);
+    }
+    if (message.creator !== "") {
+      writer.uint32(34).string(message.creator);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(42).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WorksheetRevision {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWorksheetRevision();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.title = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.content = reader.bytes();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.creator = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WorksheetRevision {
+    return {
+      name: isSet(object.name) ? globalThis.String(object.name) : "",
+      title: isSet(object.title) ? globalThis.String(object.title) : "",
+      content: isSet(object.content) ? bytesFromBase64(object.content) : new Uint8Array(0),
+      creator: isSet(object.creator) ? globalThis.String(object.creator) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+    };
+  },
+
+  toJSON(message: WorksheetRevision): unknown {
+    const obj: any = {};
+    if (message.name !== "") {
+      obj.name = message.name;
+    }
+    if (message.title !== "") {
+      obj.title = message.title;
+    }
+    if (message.content.length !== 0) {
+      obj.content = base64FromBytes(message.content);
+    }
+    if (message.creator !== "") {
+      obj.creator = message.creator;
+    }
+    if (message.createTime !== undefined) {
+      obj.createTime = message.createTime.toISOString();
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<WorksheetRevision>): WorksheetRevision {
+    return WorksheetRevision.fromPartial(base ?? {});
+  },
+  fromPartial(object: DeepPartial<WorksheetRevision>): WorksheetRevision {
+    const message = createBaseWorksheetRevision();
+    message.name = object.name ?? "";
+    message.title = object.title ?? "";
+    message.content = object.content ?? new Uint8Array(0);
+    message.creator = object.creator ?? "";
+    message.createTime = object.createTime ?? undefined;
     return message;
   },
 };
@@ -1416,6 +1739,65 @@ export const WorksheetServiceDefinition = {
         },
       },
     },
+    /**
+     * List the revisions of a worksheet, the newest first.
+     * A revision is saved each time the content of the worksheet is changed.
+     */
+    listWorksheetRevisions: {
+      name: "ListWorksheetRevisions",
+      requestType: ListWorksheetRevisionsRequest,
+      requestStream: false,
+      responseType: ListWorksheetRevisionsResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          800016: [new Uint8Array([2])],
+          578365826: [
+            new Uint8Array([
+              37,
+              18,
+              35,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              119,
+              111,
+              114,
+              107,
+              115,
+              104,
+              101,
+              101,
+              116,
+              115,
+              47,
+              42,
+              125,
+              47,
+              114,
+              101,
+              118,
+              105,
+              115,
+              105,
+              111,
+              110,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -13,6 +13,12 @@ export type SettingName =
   | "bb.workspace.data-classification"
   | "bb.workspace.semantic-types"
   | "bb.workspace.masking-algorithm"
-  | "bb.workspace.maximum-sql-result-size";
+  | "bb.workspace.maximum-sql-result-size"
+  | "bb.workspace.sheet-storage"
+  | "bb.workspace.plan-check"
+  | "bb.workspace.event-bus"
+  | "bb.workspace.rate-limit"
+  | "bb.workspace.read-audit"
+  | "bb.workspace.ai";
 
 export const defaultTokenDurationInHours = 7 * 24;
@@ -102,6 +102,15 @@ interface CasePayload {
   upper: boolean;
 }
 
+// The custom rule payload.
+// Used by the backend.
+interface CustomPayload {
+  title: string;
+  message: string;
+  pattern: string;
+  expression: string;
+}
+
 // The SchemaPolicyRule stores the rule configuration by users.
 // Used by the backend
 export interface SchemaPolicyRule {
@@ -114,7 +123,8 @@ export interface SchemaPolicyRule {
     | CommentFormatPayload
     | NumberValuePayload
     | StringValuePayload
-    | CasePayload;
+    | CasePayload
+    | CustomPayload;
   comment: string;
 }
 
@@ -663,6 +673,8 @@ const mergeIndividualConfigAsRule = (
     case "statement.maximum-limit-value":
     case "statement.maximum-join-table-count":
     case "statement.maximum-statements-in-transaction":
+    case "statement.mutation.row-limit":
+    case "engine.snowflake.warehouse-size-hint":
       if (!numberPayload) {
         throw new Error(`Invalid rule ${template.type}`);
       }
@@ -709,6 +721,19 @@ const convertRuleTemplateToPolicyRule = (
   return mergeIndividualConfigAsRule(base, rule);
 };
 
+// CUSTOM_RULE_TYPE is the type of the user-defined rules, which are not in the rule schema.
+export const CUSTOM_RULE_TYPE = "custom";
+
+// getCustomRuleList returns the user-defined rules in the policy.
+// They should be kept when the policy is updated by the rule editor.
+export const getCustomRuleList = (
+  policy: SQLReviewPolicy | undefined
+): SchemaPolicyRule[] => {
+  return (policy?.ruleList ?? []).filter(
+    (rule) => rule.type === CUSTOM_RULE_TYPE
+  );
+};
+
 export const getRuleLocalizationKey = (type: string): string => {
   return type.split(".").join("-");
 };
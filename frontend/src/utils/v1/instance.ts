@@ -184,6 +184,9 @@ export const instanceV1HasSSH = (
     Engine.OCEANBASE,
     Engine.POSTGRES,
     Engine.REDIS,
+    Engine.MSSQL,
+    Engine.ORACLE,
+    Engine.MONGODB,
   ].includes(engine);
 };
 
@@ -9,6 +9,7 @@ package store
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -68,6 +69,185 @@ func (x *AnomalyConnectionPayload) GetDetail() string {
 	return ""
 }
 
+type AnomalyConnectionBudgetPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of connections of the instance.
+	MaximumConnections int32 `protobuf:"varint,1,opt,name=maximum_connections,json=maximumConnections,proto3" json:"maximum_connections,omitempty"`
+	// The number of connection requests rejected since the last check.
+	RejectedCount int32 `protobuf:"varint,2,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+}
+
+func (x *AnomalyConnectionBudgetPayload) Reset() {
+	*x = AnomalyConnectionBudgetPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_anomaly_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyConnectionBudgetPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyConnectionBudgetPayload) ProtoMessage() {}
+
+func (x *AnomalyConnectionBudgetPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_anomaly_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyConnectionBudgetPayload.ProtoReflect.Descriptor instead.
+func (*AnomalyConnectionBudgetPayload) Descriptor() ([]byte, []int) {
+	return file_store_anomaly_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AnomalyConnectionBudgetPayload) GetMaximumConnections() int32 {
+	if x != nil {
+		return x.MaximumConnections
+	}
+	return 0
+}
+
+func (x *AnomalyConnectionBudgetPayload) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+type AnomalyLongRunningQueryPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*AnomalyLongRunningQueryPayload_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	// The threshold of the long-running queries.
+	Threshold *durationpb.Duration `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *AnomalyLongRunningQueryPayload) Reset() {
+	*x = AnomalyLongRunningQueryPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_anomaly_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyLongRunningQueryPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyLongRunningQueryPayload) ProtoMessage() {}
+
+func (x *AnomalyLongRunningQueryPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_anomaly_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyLongRunningQueryPayload.ProtoReflect.Descriptor instead.
+func (*AnomalyLongRunningQueryPayload) Descriptor() ([]byte, []int) {
+	return file_store_anomaly_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AnomalyLongRunningQueryPayload) GetQueries() []*AnomalyLongRunningQueryPayload_Query {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *AnomalyLongRunningQueryPayload) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+type AnomalyFailoverPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the availability group.
+	AvailabilityGroup string `protobuf:"bytes,1,opt,name=availability_group,json=availabilityGroup,proto3" json:"availability_group,omitempty"`
+	// The server name of the primary replica before the failover.
+	PreviousPrimary string `protobuf:"bytes,2,opt,name=previous_primary,json=previousPrimary,proto3" json:"previous_primary,omitempty"`
+	// The server name of the primary replica after the failover.
+	CurrentPrimary string `protobuf:"bytes,3,opt,name=current_primary,json=currentPrimary,proto3" json:"current_primary,omitempty"`
+}
+
+func (x *AnomalyFailoverPayload) Reset() {
+	*x = AnomalyFailoverPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_anomaly_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyFailoverPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyFailoverPayload) ProtoMessage() {}
+
+func (x *AnomalyFailoverPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_anomaly_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyFailoverPayload.ProtoReflect.Descriptor instead.
+func (*AnomalyFailoverPayload) Descriptor() ([]byte, []int) {
+	return file_store_anomaly_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AnomalyFailoverPayload) GetAvailabilityGroup() string {
+	if x != nil {
+		return x.AvailabilityGroup
+	}
+	return ""
+}
+
+func (x *AnomalyFailoverPayload) GetPreviousPrimary() string {
+	if x != nil {
+		return x.PreviousPrimary
+	}
+	return ""
+}
+
+func (x *AnomalyFailoverPayload) GetCurrentPrimary() string {
+	if x != nil {
+		return x.CurrentPrimary
+	}
+	return ""
+}
+
 type AnomalyDatabaseSchemaDriftPayload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -79,12 +259,14 @@ type AnomalyDatabaseSchemaDriftPayload struct {
 	Expect string `protobuf:"bytes,2,opt,name=expect,proto3" json:"expect,omitempty"`
 	// The actual schema dumped from the database
 	Actual string `protobuf:"bytes,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	// The DDL statements migrating the actual schema to the expected schema
+	Diff string `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
 func (x *AnomalyDatabaseSchemaDriftPayload) Reset() {
 	*x = AnomalyDatabaseSchemaDriftPayload{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_store_anomaly_proto_msgTypes[1]
+		mi := &file_store_anomaly_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -97,7 +279,7 @@ func (x *AnomalyDatabaseSchemaDriftPayload) String() string {
 func (*AnomalyDatabaseSchemaDriftPayload) ProtoMessage() {}
 
 func (x *AnomalyDatabaseSchemaDriftPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_store_anomaly_proto_msgTypes[1]
+	mi := &file_store_anomaly_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -110,7 +292,7 @@ func (x *AnomalyDatabaseSchemaDriftPayload) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AnomalyDatabaseSchemaDriftPayload.ProtoReflect.Descriptor instead.
 func (*AnomalyDatabaseSchemaDriftPayload) Descriptor() ([]byte, []int) {
-	return file_store_anomaly_proto_rawDescGZIP(), []int{1}
+	return file_store_anomaly_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AnomalyDatabaseSchemaDriftPayload) GetVersion() string {
@@ -134,24 +316,249 @@ func (x *AnomalyDatabaseSchemaDriftPayload) GetActual() string {
 	return ""
 }
 
+func (x *AnomalyDatabaseSchemaDriftPayload) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type AnomalyDatabaseBackupFailedPayload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The uid of the failed backup run.
+	BackupRunUid int32 `protobuf:"varint,1,opt,name=backup_run_uid,json=backupRunUid,proto3" json:"backup_run_uid,omitempty"`
+	// The error of the failed backup.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *AnomalyDatabaseBackupFailedPayload) Reset() {
+	*x = AnomalyDatabaseBackupFailedPayload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_anomaly_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyDatabaseBackupFailedPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyDatabaseBackupFailedPayload) ProtoMessage() {}
+
+func (x *AnomalyDatabaseBackupFailedPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_store_anomaly_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyDatabaseBackupFailedPayload.ProtoReflect.Descriptor instead.
+func (*AnomalyDatabaseBackupFailedPayload) Descriptor() ([]byte, []int) {
+	return file_store_anomaly_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AnomalyDatabaseBackupFailedPayload) GetBackupRunUid() int32 {
+	if x != nil {
+		return x.BackupRunUid
+	}
+	return 0
+}
+
+func (x *AnomalyDatabaseBackupFailedPayload) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AnomalyLongRunningQueryPayload_Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The connection id running the query.
+	ConnectionId string               `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Database     string               `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	User         string               `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	State        string               `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Statement    string               `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
+	Duration     *durationpb.Duration `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The connection ids blocking the query by the locks.
+	BlockedBy []string `protobuf:"bytes,7,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	// The number of the queries waiting for the locks held by the query.
+	BlockingCount int32 `protobuf:"varint,8,opt,name=blocking_count,json=blockingCount,proto3" json:"blocking_count,omitempty"`
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) Reset() {
+	*x = AnomalyLongRunningQueryPayload_Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_store_anomaly_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyLongRunningQueryPayload_Query) ProtoMessage() {}
+
+func (x *AnomalyLongRunningQueryPayload_Query) ProtoReflect() protoreflect.Message {
+	mi := &file_store_anomaly_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyLongRunningQueryPayload_Query.ProtoReflect.Descriptor instead.
+func (*AnomalyLongRunningQueryPayload_Query) Descriptor() ([]byte, []int) {
+	return file_store_anomaly_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetBlockedBy() []string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *AnomalyLongRunningQueryPayload_Query) GetBlockingCount() int32 {
+	if x != nil {
+		return x.BlockingCount
+	}
+	return 0
+}
+
 var File_store_anomaly_proto protoreflect.FileDescriptor
 
 var file_store_anomaly_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x32, 0x0a, 0x18, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x18, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
 	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
 	0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x6d, 0x0a, 0x21, 0x41, 0x6e, 0x6f,
-	0x6d, 0x61, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x78, 0x0a, 0x1e, 0x41, 0x6e, 0x6f,
+	0x6d, 0x61, 0x6c, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xb9, 0x03, 0x0a, 0x1e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x4c,
+	0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x4e, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x71,
+	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x1a,
+	0x8d, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x9b, 0x01, 0x0a, 0x16, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x6f,
+	0x76, 0x65, 0x72, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x81, 0x01,
+	0x0a, 0x21, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66,
+	0x66, 0x22, 0x60, 0x0a, 0x22, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x75, 0x6e, 0x55, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x14, 0x5a, 0x12, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x2d, 0x67, 0x6f, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -166,17 +573,26 @@ func file_store_anomaly_proto_rawDescGZIP() []byte {
 	return file_store_anomaly_proto_rawDescData
 }
 
-var file_store_anomaly_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_store_anomaly_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_store_anomaly_proto_goTypes = []any{
-	(*AnomalyConnectionPayload)(nil),          // 0: bytebase.store.AnomalyConnectionPayload
-	(*AnomalyDatabaseSchemaDriftPayload)(nil), // 1: bytebase.store.AnomalyDatabaseSchemaDriftPayload
+	(*AnomalyConnectionPayload)(nil),             // 0: bytebase.store.AnomalyConnectionPayload
+	(*AnomalyConnectionBudgetPayload)(nil),       // 1: bytebase.store.AnomalyConnectionBudgetPayload
+	(*AnomalyLongRunningQueryPayload)(nil),       // 2: bytebase.store.AnomalyLongRunningQueryPayload
+	(*AnomalyFailoverPayload)(nil),               // 3: bytebase.store.AnomalyFailoverPayload
+	(*AnomalyDatabaseSchemaDriftPayload)(nil),    // 4: bytebase.store.AnomalyDatabaseSchemaDriftPayload
+	(*AnomalyDatabaseBackupFailedPayload)(nil),   // 5: bytebase.store.AnomalyDatabaseBackupFailedPayload
+	(*AnomalyLongRunningQueryPayload_Query)(nil), // 6: bytebase.store.AnomalyLongRunningQueryPayload.Query
+	(*durationpb.Duration)(nil),                  // 7: google.protobuf.Duration
 }
 var file_store_anomaly_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: bytebase.store.AnomalyLongRunningQueryPayload.queries:type_name -> bytebase.store.AnomalyLongRunningQueryPayload.Query
+	7, // 1: bytebase.store.AnomalyLongRunningQueryPayload.threshold:type_name -> google.protobuf.Duration
+	7, // 2: bytebase.store.AnomalyLongRunningQueryPayload.Query.duration:type_name -> google.protobuf.Duration
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_store_anomaly_proto_init() }
@@ -198,6 +614,42 @@ func file_store_anomaly_proto_init() {
 			}
 		}
 		file_store_anomaly_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*AnomalyConnectionBudgetPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_anomaly_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AnomalyLongRunningQueryPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_anomaly_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*AnomalyFailoverPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_anomaly_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*AnomalyDatabaseSchemaDriftPayload); i {
 			case 0:
 				return &v.state
@@ -209,6 +661,30 @@ func file_store_anomaly_proto_init() {
 				return nil
 			}
 		}
+		file_store_anomaly_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*AnomalyDatabaseBackupFailedPayload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_store_anomaly_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*AnomalyLongRunningQueryPayload_Query); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -216,7 +692,7 @@ func file_store_anomaly_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_store_anomaly_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
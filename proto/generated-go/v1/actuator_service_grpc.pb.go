@@ -20,10 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ActuatorService_GetActuatorInfo_FullMethodName    = "/bytebase.v1.ActuatorService/GetActuatorInfo"
-	ActuatorService_UpdateActuatorInfo_FullMethodName = "/bytebase.v1.ActuatorService/UpdateActuatorInfo"
-	ActuatorService_DeleteCache_FullMethodName        = "/bytebase.v1.ActuatorService/DeleteCache"
-	ActuatorService_GetResourcePackage_FullMethodName = "/bytebase.v1.ActuatorService/GetResourcePackage"
+	ActuatorService_GetActuatorInfo_FullMethodName      = "/bytebase.v1.ActuatorService/GetActuatorInfo"
+	ActuatorService_UpdateActuatorInfo_FullMethodName   = "/bytebase.v1.ActuatorService/UpdateActuatorInfo"
+	ActuatorService_DeleteCache_FullMethodName          = "/bytebase.v1.ActuatorService/DeleteCache"
+	ActuatorService_GetResourcePackage_FullMethodName   = "/bytebase.v1.ActuatorService/GetResourcePackage"
+	ActuatorService_GetEncryptionKey_FullMethodName     = "/bytebase.v1.ActuatorService/GetEncryptionKey"
+	ActuatorService_RotateEncryptionKey_FullMethodName  = "/bytebase.v1.ActuatorService/RotateEncryptionKey"
+	ActuatorService_CreateMetadataBackup_FullMethodName = "/bytebase.v1.ActuatorService/CreateMetadataBackup"
+	ActuatorService_ListMetadataBackups_FullMethodName  = "/bytebase.v1.ActuatorService/ListMetadataBackups"
+	ActuatorService_VerifyMetadataBackup_FullMethodName = "/bytebase.v1.ActuatorService/VerifyMetadataBackup"
 )
 
 // ActuatorServiceClient is the client API for ActuatorService service.
@@ -34,6 +39,16 @@ type ActuatorServiceClient interface {
 	UpdateActuatorInfo(ctx context.Context, in *UpdateActuatorInfoRequest, opts ...grpc.CallOption) (*ActuatorInfo, error)
 	DeleteCache(ctx context.Context, in *DeleteCacheRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetResourcePackage(ctx context.Context, in *GetResourcePackageRequest, opts ...grpc.CallOption) (*ResourcePackage, error)
+	GetEncryptionKey(ctx context.Context, in *GetEncryptionKeyRequest, opts ...grpc.CallOption) (*EncryptionKey, error)
+	// RotateEncryptionKey creates a new data encryption key and re-encrypts the data source credentials with it.
+	RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*EncryptionKey, error)
+	// CreateMetadataBackup takes a backup of the metadata database to the object storage of --metadata-backup-uri.
+	CreateMetadataBackup(ctx context.Context, in *CreateMetadataBackupRequest, opts ...grpc.CallOption) (*MetadataBackup, error)
+	// ListMetadataBackups lists the complete metadata backups, the latest backup comes first.
+	ListMetadataBackups(ctx context.Context, in *ListMetadataBackupsRequest, opts ...grpc.CallOption) (*ListMetadataBackupsResponse, error)
+	// VerifyMetadataBackup downloads the metadata backup and verifies its checksum and integrity.
+	// The backup is restored by starting Bytebase with --restore-metadata-backup.
+	VerifyMetadataBackup(ctx context.Context, in *VerifyMetadataBackupRequest, opts ...grpc.CallOption) (*MetadataBackup, error)
 }
 
 type actuatorServiceClient struct {
@@ -84,6 +99,56 @@ func (c *actuatorServiceClient) GetResourcePackage(ctx context.Context, in *GetR
 	return out, nil
 }
 
+func (c *actuatorServiceClient) GetEncryptionKey(ctx context.Context, in *GetEncryptionKeyRequest, opts ...grpc.CallOption) (*EncryptionKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionKey)
+	err := c.cc.Invoke(ctx, ActuatorService_GetEncryptionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actuatorServiceClient) RotateEncryptionKey(ctx context.Context, in *RotateEncryptionKeyRequest, opts ...grpc.CallOption) (*EncryptionKey, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncryptionKey)
+	err := c.cc.Invoke(ctx, ActuatorService_RotateEncryptionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actuatorServiceClient) CreateMetadataBackup(ctx context.Context, in *CreateMetadataBackupRequest, opts ...grpc.CallOption) (*MetadataBackup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetadataBackup)
+	err := c.cc.Invoke(ctx, ActuatorService_CreateMetadataBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actuatorServiceClient) ListMetadataBackups(ctx context.Context, in *ListMetadataBackupsRequest, opts ...grpc.CallOption) (*ListMetadataBackupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMetadataBackupsResponse)
+	err := c.cc.Invoke(ctx, ActuatorService_ListMetadataBackups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *actuatorServiceClient) VerifyMetadataBackup(ctx context.Context, in *VerifyMetadataBackupRequest, opts ...grpc.CallOption) (*MetadataBackup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MetadataBackup)
+	err := c.cc.Invoke(ctx, ActuatorService_VerifyMetadataBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ActuatorServiceServer is the server API for ActuatorService service.
 // All implementations must embed UnimplementedActuatorServiceServer
 // for forward compatibility.
@@ -92,6 +157,16 @@ type ActuatorServiceServer interface {
 	UpdateActuatorInfo(context.Context, *UpdateActuatorInfoRequest) (*ActuatorInfo, error)
 	DeleteCache(context.Context, *DeleteCacheRequest) (*emptypb.Empty, error)
 	GetResourcePackage(context.Context, *GetResourcePackageRequest) (*ResourcePackage, error)
+	GetEncryptionKey(context.Context, *GetEncryptionKeyRequest) (*EncryptionKey, error)
+	// RotateEncryptionKey creates a new data encryption key and re-encrypts the data source credentials with it.
+	RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*EncryptionKey, error)
+	// CreateMetadataBackup takes a backup of the metadata database to the object storage of --metadata-backup-uri.
+	CreateMetadataBackup(context.Context, *CreateMetadataBackupRequest) (*MetadataBackup, error)
+	// ListMetadataBackups lists the complete metadata backups, the latest backup comes first.
+	ListMetadataBackups(context.Context, *ListMetadataBackupsRequest) (*ListMetadataBackupsResponse, error)
+	// VerifyMetadataBackup downloads the metadata backup and verifies its checksum and integrity.
+	// The backup is restored by starting Bytebase with --restore-metadata-backup.
+	VerifyMetadataBackup(context.Context, *VerifyMetadataBackupRequest) (*MetadataBackup, error)
 	mustEmbedUnimplementedActuatorServiceServer()
 }
 
@@ -114,6 +189,21 @@ func (UnimplementedActuatorServiceServer) DeleteCache(context.Context, *DeleteCa
 func (UnimplementedActuatorServiceServer) GetResourcePackage(context.Context, *GetResourcePackageRequest) (*ResourcePackage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourcePackage not implemented")
 }
+func (UnimplementedActuatorServiceServer) GetEncryptionKey(context.Context, *GetEncryptionKeyRequest) (*EncryptionKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEncryptionKey not implemented")
+}
+func (UnimplementedActuatorServiceServer) RotateEncryptionKey(context.Context, *RotateEncryptionKeyRequest) (*EncryptionKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateEncryptionKey not implemented")
+}
+func (UnimplementedActuatorServiceServer) CreateMetadataBackup(context.Context, *CreateMetadataBackupRequest) (*MetadataBackup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMetadataBackup not implemented")
+}
+func (UnimplementedActuatorServiceServer) ListMetadataBackups(context.Context, *ListMetadataBackupsRequest) (*ListMetadataBackupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMetadataBackups not implemented")
+}
+func (UnimplementedActuatorServiceServer) VerifyMetadataBackup(context.Context, *VerifyMetadataBackupRequest) (*MetadataBackup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMetadataBackup not implemented")
+}
 func (UnimplementedActuatorServiceServer) mustEmbedUnimplementedActuatorServiceServer() {}
 func (UnimplementedActuatorServiceServer) testEmbeddedByValue()                         {}
 
@@ -207,6 +297,96 @@ func _ActuatorService_GetResourcePackage_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ActuatorService_GetEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActuatorServiceServer).GetEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActuatorService_GetEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActuatorServiceServer).GetEncryptionKey(ctx, req.(*GetEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActuatorService_RotateEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActuatorServiceServer).RotateEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActuatorService_RotateEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActuatorServiceServer).RotateEncryptionKey(ctx, req.(*RotateEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActuatorService_CreateMetadataBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMetadataBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActuatorServiceServer).CreateMetadataBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActuatorService_CreateMetadataBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActuatorServiceServer).CreateMetadataBackup(ctx, req.(*CreateMetadataBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActuatorService_ListMetadataBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMetadataBackupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActuatorServiceServer).ListMetadataBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActuatorService_ListMetadataBackups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActuatorServiceServer).ListMetadataBackups(ctx, req.(*ListMetadataBackupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ActuatorService_VerifyMetadataBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMetadataBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ActuatorServiceServer).VerifyMetadataBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ActuatorService_VerifyMetadataBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ActuatorServiceServer).VerifyMetadataBackup(ctx, req.(*VerifyMetadataBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ActuatorService_ServiceDesc is the grpc.ServiceDesc for ActuatorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -230,6 +410,26 @@ var ActuatorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetResourcePackage",
 			Handler:    _ActuatorService_GetResourcePackage_Handler,
 		},
+		{
+			MethodName: "GetEncryptionKey",
+			Handler:    _ActuatorService_GetEncryptionKey_Handler,
+		},
+		{
+			MethodName: "RotateEncryptionKey",
+			Handler:    _ActuatorService_RotateEncryptionKey_Handler,
+		},
+		{
+			MethodName: "CreateMetadataBackup",
+			Handler:    _ActuatorService_CreateMetadataBackup_Handler,
+		},
+		{
+			MethodName: "ListMetadataBackups",
+			Handler:    _ActuatorService_ListMetadataBackups_Handler,
+		},
+		{
+			MethodName: "VerifyMetadataBackup",
+			Handler:    _ActuatorService_VerifyMetadataBackup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/actuator_service.proto",
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -34,6 +35,12 @@ const (
 	Anomaly_INSTANCE_CONNECTION Anomaly_AnomalyType = 1
 	// MIGRATION_SCHEMA is the anomaly type for migration schema, e.g. the migration schema in the instance is missing.
 	Anomaly_MIGRATION_SCHEMA Anomaly_AnomalyType = 2
+	// INSTANCE_CONNECTION_BUDGET is the anomaly type for instance connection budget, e.g. the connection requests exceed the maximum connections of the instance.
+	Anomaly_INSTANCE_CONNECTION_BUDGET Anomaly_AnomalyType = 3
+	// INSTANCE_LONG_RUNNING_QUERY is the anomaly type for the queries running longer than the threshold or blocking other queries.
+	Anomaly_INSTANCE_LONG_RUNNING_QUERY Anomaly_AnomalyType = 4
+	// INSTANCE_FAILOVER is the anomaly type for the changed primary replica of the availability group, e.g. SQL Server Always On failover.
+	Anomaly_INSTANCE_FAILOVER Anomaly_AnomalyType = 8
 	// Database level anomaly.
 	//
 	// DATABASE_CONNECTION is the anomaly type for database connection, e.g. the database had been deleted.
@@ -41,6 +48,8 @@ const (
 	// DATABASE_SCHEMA_DRIFT is the anomaly type for database schema drift,
 	// e.g. the database schema had been changed without bytebase migration.
 	Anomaly_DATABASE_SCHEMA_DRIFT Anomaly_AnomalyType = 6
+	// DATABASE_BACKUP_FAILED is the anomaly type for the failed scheduled or manual backup of the database.
+	Anomaly_DATABASE_BACKUP_FAILED Anomaly_AnomalyType = 7
 )
 
 // Enum value maps for Anomaly_AnomalyType.
@@ -49,15 +58,23 @@ var (
 		0: "ANOMALY_TYPE_UNSPECIFIED",
 		1: "INSTANCE_CONNECTION",
 		2: "MIGRATION_SCHEMA",
+		3: "INSTANCE_CONNECTION_BUDGET",
+		4: "INSTANCE_LONG_RUNNING_QUERY",
+		8: "INSTANCE_FAILOVER",
 		5: "DATABASE_CONNECTION",
 		6: "DATABASE_SCHEMA_DRIFT",
+		7: "DATABASE_BACKUP_FAILED",
 	}
 	Anomaly_AnomalyType_value = map[string]int32{
-		"ANOMALY_TYPE_UNSPECIFIED": 0,
-		"INSTANCE_CONNECTION":      1,
-		"MIGRATION_SCHEMA":         2,
-		"DATABASE_CONNECTION":      5,
-		"DATABASE_SCHEMA_DRIFT":    6,
+		"ANOMALY_TYPE_UNSPECIFIED":    0,
+		"INSTANCE_CONNECTION":         1,
+		"MIGRATION_SCHEMA":            2,
+		"INSTANCE_CONNECTION_BUDGET":  3,
+		"INSTANCE_LONG_RUNNING_QUERY": 4,
+		"INSTANCE_FAILOVER":           8,
+		"DATABASE_CONNECTION":         5,
+		"DATABASE_SCHEMA_DRIFT":       6,
+		"DATABASE_BACKUP_FAILED":      7,
 	}
 )
 
@@ -302,6 +319,10 @@ type Anomaly struct {
 	//	*Anomaly_InstanceConnectionDetail_
 	//	*Anomaly_DatabaseConnectionDetail_
 	//	*Anomaly_DatabaseSchemaDriftDetail_
+	//	*Anomaly_InstanceConnectionBudgetDetail_
+	//	*Anomaly_InstanceLongRunningQueryDetail_
+	//	*Anomaly_DatabaseBackupFailedDetail_
+	//	*Anomaly_InstanceFailoverDetail_
 	Detail     isAnomaly_Detail       `protobuf_oneof:"detail"`
 	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
@@ -388,6 +409,34 @@ func (x *Anomaly) GetDatabaseSchemaDriftDetail() *Anomaly_DatabaseSchemaDriftDet
 	return nil
 }
 
+func (x *Anomaly) GetInstanceConnectionBudgetDetail() *Anomaly_InstanceConnectionBudgetDetail {
+	if x, ok := x.GetDetail().(*Anomaly_InstanceConnectionBudgetDetail_); ok {
+		return x.InstanceConnectionBudgetDetail
+	}
+	return nil
+}
+
+func (x *Anomaly) GetInstanceLongRunningQueryDetail() *Anomaly_InstanceLongRunningQueryDetail {
+	if x, ok := x.GetDetail().(*Anomaly_InstanceLongRunningQueryDetail_); ok {
+		return x.InstanceLongRunningQueryDetail
+	}
+	return nil
+}
+
+func (x *Anomaly) GetDatabaseBackupFailedDetail() *Anomaly_DatabaseBackupFailedDetail {
+	if x, ok := x.GetDetail().(*Anomaly_DatabaseBackupFailedDetail_); ok {
+		return x.DatabaseBackupFailedDetail
+	}
+	return nil
+}
+
+func (x *Anomaly) GetInstanceFailoverDetail() *Anomaly_InstanceFailoverDetail {
+	if x, ok := x.GetDetail().(*Anomaly_InstanceFailoverDetail_); ok {
+		return x.InstanceFailoverDetail
+	}
+	return nil
+}
+
 func (x *Anomaly) GetCreateTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreateTime
@@ -418,12 +467,36 @@ type Anomaly_DatabaseSchemaDriftDetail_ struct {
 	DatabaseSchemaDriftDetail *Anomaly_DatabaseSchemaDriftDetail `protobuf:"bytes,8,opt,name=database_schema_drift_detail,json=databaseSchemaDriftDetail,proto3,oneof"`
 }
 
+type Anomaly_InstanceConnectionBudgetDetail_ struct {
+	InstanceConnectionBudgetDetail *Anomaly_InstanceConnectionBudgetDetail `protobuf:"bytes,11,opt,name=instance_connection_budget_detail,json=instanceConnectionBudgetDetail,proto3,oneof"`
+}
+
+type Anomaly_InstanceLongRunningQueryDetail_ struct {
+	InstanceLongRunningQueryDetail *Anomaly_InstanceLongRunningQueryDetail `protobuf:"bytes,12,opt,name=instance_long_running_query_detail,json=instanceLongRunningQueryDetail,proto3,oneof"`
+}
+
+type Anomaly_DatabaseBackupFailedDetail_ struct {
+	DatabaseBackupFailedDetail *Anomaly_DatabaseBackupFailedDetail `protobuf:"bytes,13,opt,name=database_backup_failed_detail,json=databaseBackupFailedDetail,proto3,oneof"`
+}
+
+type Anomaly_InstanceFailoverDetail_ struct {
+	InstanceFailoverDetail *Anomaly_InstanceFailoverDetail `protobuf:"bytes,14,opt,name=instance_failover_detail,json=instanceFailoverDetail,proto3,oneof"`
+}
+
 func (*Anomaly_InstanceConnectionDetail_) isAnomaly_Detail() {}
 
 func (*Anomaly_DatabaseConnectionDetail_) isAnomaly_Detail() {}
 
 func (*Anomaly_DatabaseSchemaDriftDetail_) isAnomaly_Detail() {}
 
+func (*Anomaly_InstanceConnectionBudgetDetail_) isAnomaly_Detail() {}
+
+func (*Anomaly_InstanceLongRunningQueryDetail_) isAnomaly_Detail() {}
+
+func (*Anomaly_DatabaseBackupFailedDetail_) isAnomaly_Detail() {}
+
+func (*Anomaly_InstanceFailoverDetail_) isAnomaly_Detail() {}
+
 // Instance level anomaly detail.
 //
 // InstanceConnectionDetail is the detail for instance connection anomaly.
@@ -475,6 +548,188 @@ func (x *Anomaly_InstanceConnectionDetail) GetDetail() string {
 	return ""
 }
 
+// InstanceConnectionBudgetDetail is the detail for instance connection budget anomaly.
+type Anomaly_InstanceConnectionBudgetDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// maximum_connections is the maximum number of connections of the instance.
+	MaximumConnections int32 `protobuf:"varint,1,opt,name=maximum_connections,json=maximumConnections,proto3" json:"maximum_connections,omitempty"`
+	// rejected_count is the number of connection requests rejected since the last check.
+	RejectedCount int32 `protobuf:"varint,2,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
+}
+
+func (x *Anomaly_InstanceConnectionBudgetDetail) Reset() {
+	*x = Anomaly_InstanceConnectionBudgetDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_InstanceConnectionBudgetDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_InstanceConnectionBudgetDetail) ProtoMessage() {}
+
+func (x *Anomaly_InstanceConnectionBudgetDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_InstanceConnectionBudgetDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_InstanceConnectionBudgetDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *Anomaly_InstanceConnectionBudgetDetail) GetMaximumConnections() int32 {
+	if x != nil {
+		return x.MaximumConnections
+	}
+	return 0
+}
+
+func (x *Anomaly_InstanceConnectionBudgetDetail) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+// InstanceLongRunningQueryDetail is the detail for instance long-running query anomaly.
+type Anomaly_InstanceLongRunningQueryDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Queries []*Anomaly_InstanceLongRunningQueryDetail_Query `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	// The threshold of the long-running queries.
+	Threshold *durationpb.Duration `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail) Reset() {
+	*x = Anomaly_InstanceLongRunningQueryDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_InstanceLongRunningQueryDetail) ProtoMessage() {}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_InstanceLongRunningQueryDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_InstanceLongRunningQueryDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail) GetQueries() []*Anomaly_InstanceLongRunningQueryDetail_Query {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail) GetThreshold() *durationpb.Duration {
+	if x != nil {
+		return x.Threshold
+	}
+	return nil
+}
+
+// InstanceFailoverDetail is the detail for instance failover anomaly.
+type Anomaly_InstanceFailoverDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// availability_group is the name of the availability group.
+	AvailabilityGroup string `protobuf:"bytes,1,opt,name=availability_group,json=availabilityGroup,proto3" json:"availability_group,omitempty"`
+	// previous_primary is the server name of the primary replica before the failover.
+	PreviousPrimary string `protobuf:"bytes,2,opt,name=previous_primary,json=previousPrimary,proto3" json:"previous_primary,omitempty"`
+	// current_primary is the server name of the primary replica after the failover.
+	CurrentPrimary string `protobuf:"bytes,3,opt,name=current_primary,json=currentPrimary,proto3" json:"current_primary,omitempty"`
+}
+
+func (x *Anomaly_InstanceFailoverDetail) Reset() {
+	*x = Anomaly_InstanceFailoverDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_InstanceFailoverDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_InstanceFailoverDetail) ProtoMessage() {}
+
+func (x *Anomaly_InstanceFailoverDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_InstanceFailoverDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_InstanceFailoverDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Anomaly_InstanceFailoverDetail) GetAvailabilityGroup() string {
+	if x != nil {
+		return x.AvailabilityGroup
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceFailoverDetail) GetPreviousPrimary() string {
+	if x != nil {
+		return x.PreviousPrimary
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceFailoverDetail) GetCurrentPrimary() string {
+	if x != nil {
+		return x.CurrentPrimary
+	}
+	return ""
+}
+
 // Database level anomaly detial.
 //
 // DatbaaseConnectionDetail is the detail for database connection anomaly.
@@ -490,7 +745,7 @@ type Anomaly_DatabaseConnectionDetail struct {
 func (x *Anomaly_DatabaseConnectionDetail) Reset() {
 	*x = Anomaly_DatabaseConnectionDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_anomaly_service_proto_msgTypes[4]
+		mi := &file_v1_anomaly_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -503,7 +758,7 @@ func (x *Anomaly_DatabaseConnectionDetail) String() string {
 func (*Anomaly_DatabaseConnectionDetail) ProtoMessage() {}
 
 func (x *Anomaly_DatabaseConnectionDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_anomaly_service_proto_msgTypes[4]
+	mi := &file_v1_anomaly_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -516,7 +771,7 @@ func (x *Anomaly_DatabaseConnectionDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Anomaly_DatabaseConnectionDetail.ProtoReflect.Descriptor instead.
 func (*Anomaly_DatabaseConnectionDetail) Descriptor() ([]byte, []int) {
-	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 1}
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 4}
 }
 
 func (x *Anomaly_DatabaseConnectionDetail) GetDetail() string {
@@ -538,12 +793,15 @@ type Anomaly_DatabaseSchemaDriftDetail struct {
 	ExpectedSchema string `protobuf:"bytes,2,opt,name=expected_schema,json=expectedSchema,proto3" json:"expected_schema,omitempty"`
 	// actual_schema is the actual schema in the database.
 	ActualSchema string `protobuf:"bytes,3,opt,name=actual_schema,json=actualSchema,proto3" json:"actual_schema,omitempty"`
+	// diff is the DDL statements migrating the actual schema to the expected schema.
+	// It's empty if the diff is not supported for the database engine.
+	Diff string `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
 func (x *Anomaly_DatabaseSchemaDriftDetail) Reset() {
 	*x = Anomaly_DatabaseSchemaDriftDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_anomaly_service_proto_msgTypes[5]
+		mi := &file_v1_anomaly_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -556,7 +814,7 @@ func (x *Anomaly_DatabaseSchemaDriftDetail) String() string {
 func (*Anomaly_DatabaseSchemaDriftDetail) ProtoMessage() {}
 
 func (x *Anomaly_DatabaseSchemaDriftDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_anomaly_service_proto_msgTypes[5]
+	mi := &file_v1_anomaly_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -569,7 +827,7 @@ func (x *Anomaly_DatabaseSchemaDriftDetail) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use Anomaly_DatabaseSchemaDriftDetail.ProtoReflect.Descriptor instead.
 func (*Anomaly_DatabaseSchemaDriftDetail) Descriptor() ([]byte, []int) {
-	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 2}
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 5}
 }
 
 func (x *Anomaly_DatabaseSchemaDriftDetail) GetRecordVersion() string {
@@ -593,6 +851,179 @@ func (x *Anomaly_DatabaseSchemaDriftDetail) GetActualSchema() string {
 	return ""
 }
 
+func (x *Anomaly_DatabaseSchemaDriftDetail) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+// DatabaseBackupFailedDetail is the detail for database backup failure anomaly.
+type Anomaly_DatabaseBackupFailedDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the failed backup run.
+	// Format: instances/{instance}/databases/{database}/backupRuns/{backup_run}
+	BackupRun string `protobuf:"bytes,1,opt,name=backup_run,json=backupRun,proto3" json:"backup_run,omitempty"`
+	// error is the error of the failed backup.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *Anomaly_DatabaseBackupFailedDetail) Reset() {
+	*x = Anomaly_DatabaseBackupFailedDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_DatabaseBackupFailedDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_DatabaseBackupFailedDetail) ProtoMessage() {}
+
+func (x *Anomaly_DatabaseBackupFailedDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_DatabaseBackupFailedDetail.ProtoReflect.Descriptor instead.
+func (*Anomaly_DatabaseBackupFailedDetail) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 6}
+}
+
+func (x *Anomaly_DatabaseBackupFailedDetail) GetBackupRun() string {
+	if x != nil {
+		return x.BackupRun
+	}
+	return ""
+}
+
+func (x *Anomaly_DatabaseBackupFailedDetail) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Anomaly_InstanceLongRunningQueryDetail_Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The connection id running the query, which can be used to kill the query.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	Database     string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	User         string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// The state of the connection, e.g. active or Query.
+	State     string               `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Statement string               `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
+	Duration  *durationpb.Duration `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	// The connection ids blocking the query by the locks.
+	BlockedBy []string `protobuf:"bytes,7,rep,name=blocked_by,json=blockedBy,proto3" json:"blocked_by,omitempty"`
+	// The number of the queries waiting for the locks held by the query.
+	BlockingCount int32 `protobuf:"varint,8,opt,name=blocking_count,json=blockingCount,proto3" json:"blocking_count,omitempty"`
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) Reset() {
+	*x = Anomaly_InstanceLongRunningQueryDetail_Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_anomaly_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Anomaly_InstanceLongRunningQueryDetail_Query) ProtoMessage() {}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_anomaly_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Anomaly_InstanceLongRunningQueryDetail_Query.ProtoReflect.Descriptor instead.
+func (*Anomaly_InstanceLongRunningQueryDetail_Query) Descriptor() ([]byte, []int) {
+	return file_v1_anomaly_service_proto_rawDescGZIP(), []int{2, 2, 0}
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetBlockedBy() []string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return nil
+}
+
+func (x *Anomaly_InstanceLongRunningQueryDetail_Query) GetBlockingCount() int32 {
+	if x != nil {
+		return x.BlockingCount
+	}
+	return 0
+}
+
 var File_v1_anomaly_service_proto protoreflect.FileDescriptor
 
 var file_v1_anomaly_service_proto_rawDesc = []byte{
@@ -602,6 +1033,8 @@ var file_v1_anomaly_service_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70,
 	0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
@@ -620,7 +1053,7 @@ var file_v1_anomaly_service_proto_rawDesc = []byte{
 	0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
 	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xe9, 0x08, 0x0a, 0x07, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x12, 0x20, 0x0a,
+	0x6e, 0x22, 0x83, 0x14, 0x0a, 0x07, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x12, 0x20, 0x0a,
 	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x04, 0xe2, 0x41, 0x01, 0x02, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
 	0x34, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e,
@@ -651,57 +1084,147 @@ var file_v1_anomaly_service_proto_rawDesc = []byte{
 	0x79, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
 	0x44, 0x72, 0x69, 0x66, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x19, 0x64,
 	0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69,
-	0x66, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x41, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x66, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x80, 0x01, 0x0a, 0x21, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x1e, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x81, 0x01, 0x0a, 0x22,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x5f, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52,
+	0x1e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x74, 0x0a, 0x1d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x1a, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x67, 0x0a, 0x18, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x41,
+	0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x1a, 0x32, 0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x78, 0x0a, 0x1e, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2f, 0x0a, 0x13, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x1a, 0xbe, 0x03, 0x0a, 0x1e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c,
+	0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x53, 0x0a, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x2e, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x6f, 0x6e, 0x67, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x07, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41, 0x01, 0x03, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0b, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0xe2, 0x41,
-	0x01, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0x32,
-	0x0a, 0x18, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x1a, 0x32, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16,
-	0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0x90, 0x01, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x62,
-	0x61, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x63, 0x6f, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74,
-	0x75, 0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x8e, 0x01, 0x0a, 0x0b, 0x41, 0x6e,
-	0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x4f,
-	0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x54, 0x41,
-	0x4e, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01,
-	0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43,
-	0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41,
-	0x53, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12,
-	0x19, 0x0a, 0x15, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45,
-	0x4d, 0x41, 0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x10, 0x06, 0x22, 0x57, 0x0a, 0x0f, 0x41, 0x6e,
-	0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a,
-	0x1c, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54,
-	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44, 0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48,
-	0x49, 0x47, 0x48, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41,
-	0x4c, 0x10, 0x03, 0x42, 0x08, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x32, 0x94, 0x01,
-	0x0a, 0x0e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x81, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61,
-	0x6c, 0x69, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e,
-	0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x23, 0x90, 0xea, 0x30, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14,
-	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x3a, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x1a, 0x8d, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x79, 0x12, 0x25, 0x0a, 0x0e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x9b, 0x01, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x2d,
+	0x0a, 0x12, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x29, 0x0a,
+	0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x1a, 0x32, 0x0a, 0x18, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x1a, 0xa4, 0x01, 0x0a, 0x19, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x44, 0x72, 0x69, 0x66, 0x74, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x75,
+	0x61, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x1a, 0x51, 0x0a, 0x1a,
+	0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x82, 0x02, 0x0a, 0x0b, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
+	0x13, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x49, 0x47, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x44, 0x47, 0x45, 0x54, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x5f, 0x52, 0x55,
+	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x10, 0x04, 0x12, 0x15, 0x0a,
+	0x11, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x4f, 0x56,
+	0x45, 0x52, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45,
+	0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x19, 0x0a,
+	0x15, 0x44, 0x41, 0x54, 0x41, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41,
+	0x5f, 0x44, 0x52, 0x49, 0x46, 0x54, 0x10, 0x06, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x41, 0x54, 0x41,
+	0x42, 0x41, 0x53, 0x45, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x07, 0x22, 0x57, 0x0a, 0x0f, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x53,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x4e, 0x4f, 0x4d, 0x41,
+	0x4c, 0x59, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x45, 0x44,
+	0x49, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x03, 0x42, 0x08, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x32, 0x94, 0x01, 0x0a, 0x0e, 0x41, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x81, 0x01, 0x0a, 0x0f, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x23,
+	0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x79, 0x74, 0x65, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x90, 0xea, 0x30, 0x02, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e,
+	0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x65, 0x73, 0x3a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x11,
+	0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -717,34 +1240,47 @@ func file_v1_anomaly_service_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_anomaly_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_anomaly_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_v1_anomaly_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_v1_anomaly_service_proto_goTypes = []any{
-	(Anomaly_AnomalyType)(0),                  // 0: bytebase.v1.Anomaly.AnomalyType
-	(Anomaly_AnomalySeverity)(0),              // 1: bytebase.v1.Anomaly.AnomalySeverity
-	(*SearchAnomaliesRequest)(nil),            // 2: bytebase.v1.SearchAnomaliesRequest
-	(*SearchAnomaliesResponse)(nil),           // 3: bytebase.v1.SearchAnomaliesResponse
-	(*Anomaly)(nil),                           // 4: bytebase.v1.Anomaly
-	(*Anomaly_InstanceConnectionDetail)(nil),  // 5: bytebase.v1.Anomaly.InstanceConnectionDetail
-	(*Anomaly_DatabaseConnectionDetail)(nil),  // 6: bytebase.v1.Anomaly.DatabaseConnectionDetail
-	(*Anomaly_DatabaseSchemaDriftDetail)(nil), // 7: bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
-	(*timestamppb.Timestamp)(nil),             // 8: google.protobuf.Timestamp
+	(Anomaly_AnomalyType)(0),                             // 0: bytebase.v1.Anomaly.AnomalyType
+	(Anomaly_AnomalySeverity)(0),                         // 1: bytebase.v1.Anomaly.AnomalySeverity
+	(*SearchAnomaliesRequest)(nil),                       // 2: bytebase.v1.SearchAnomaliesRequest
+	(*SearchAnomaliesResponse)(nil),                      // 3: bytebase.v1.SearchAnomaliesResponse
+	(*Anomaly)(nil),                                      // 4: bytebase.v1.Anomaly
+	(*Anomaly_InstanceConnectionDetail)(nil),             // 5: bytebase.v1.Anomaly.InstanceConnectionDetail
+	(*Anomaly_InstanceConnectionBudgetDetail)(nil),       // 6: bytebase.v1.Anomaly.InstanceConnectionBudgetDetail
+	(*Anomaly_InstanceLongRunningQueryDetail)(nil),       // 7: bytebase.v1.Anomaly.InstanceLongRunningQueryDetail
+	(*Anomaly_InstanceFailoverDetail)(nil),               // 8: bytebase.v1.Anomaly.InstanceFailoverDetail
+	(*Anomaly_DatabaseConnectionDetail)(nil),             // 9: bytebase.v1.Anomaly.DatabaseConnectionDetail
+	(*Anomaly_DatabaseSchemaDriftDetail)(nil),            // 10: bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
+	(*Anomaly_DatabaseBackupFailedDetail)(nil),           // 11: bytebase.v1.Anomaly.DatabaseBackupFailedDetail
+	(*Anomaly_InstanceLongRunningQueryDetail_Query)(nil), // 12: bytebase.v1.Anomaly.InstanceLongRunningQueryDetail.Query
+	(*timestamppb.Timestamp)(nil),                        // 13: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),                          // 14: google.protobuf.Duration
 }
 var file_v1_anomaly_service_proto_depIdxs = []int32{
-	4, // 0: bytebase.v1.SearchAnomaliesResponse.anomalies:type_name -> bytebase.v1.Anomaly
-	0, // 1: bytebase.v1.Anomaly.type:type_name -> bytebase.v1.Anomaly.AnomalyType
-	1, // 2: bytebase.v1.Anomaly.severity:type_name -> bytebase.v1.Anomaly.AnomalySeverity
-	5, // 3: bytebase.v1.Anomaly.instance_connection_detail:type_name -> bytebase.v1.Anomaly.InstanceConnectionDetail
-	6, // 4: bytebase.v1.Anomaly.database_connection_detail:type_name -> bytebase.v1.Anomaly.DatabaseConnectionDetail
-	7, // 5: bytebase.v1.Anomaly.database_schema_drift_detail:type_name -> bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
-	8, // 6: bytebase.v1.Anomaly.create_time:type_name -> google.protobuf.Timestamp
-	8, // 7: bytebase.v1.Anomaly.update_time:type_name -> google.protobuf.Timestamp
-	2, // 8: bytebase.v1.AnomalyService.SearchAnomalies:input_type -> bytebase.v1.SearchAnomaliesRequest
-	3, // 9: bytebase.v1.AnomalyService.SearchAnomalies:output_type -> bytebase.v1.SearchAnomaliesResponse
-	9, // [9:10] is the sub-list for method output_type
-	8, // [8:9] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	4,  // 0: bytebase.v1.SearchAnomaliesResponse.anomalies:type_name -> bytebase.v1.Anomaly
+	0,  // 1: bytebase.v1.Anomaly.type:type_name -> bytebase.v1.Anomaly.AnomalyType
+	1,  // 2: bytebase.v1.Anomaly.severity:type_name -> bytebase.v1.Anomaly.AnomalySeverity
+	5,  // 3: bytebase.v1.Anomaly.instance_connection_detail:type_name -> bytebase.v1.Anomaly.InstanceConnectionDetail
+	9,  // 4: bytebase.v1.Anomaly.database_connection_detail:type_name -> bytebase.v1.Anomaly.DatabaseConnectionDetail
+	10, // 5: bytebase.v1.Anomaly.database_schema_drift_detail:type_name -> bytebase.v1.Anomaly.DatabaseSchemaDriftDetail
+	6,  // 6: bytebase.v1.Anomaly.instance_connection_budget_detail:type_name -> bytebase.v1.Anomaly.InstanceConnectionBudgetDetail
+	7,  // 7: bytebase.v1.Anomaly.instance_long_running_query_detail:type_name -> bytebase.v1.Anomaly.InstanceLongRunningQueryDetail
+	11, // 8: bytebase.v1.Anomaly.database_backup_failed_detail:type_name -> bytebase.v1.Anomaly.DatabaseBackupFailedDetail
+	8,  // 9: bytebase.v1.Anomaly.instance_failover_detail:type_name -> bytebase.v1.Anomaly.InstanceFailoverDetail
+	13, // 10: bytebase.v1.Anomaly.create_time:type_name -> google.protobuf.Timestamp
+	13, // 11: bytebase.v1.Anomaly.update_time:type_name -> google.protobuf.Timestamp
+	12, // 12: bytebase.v1.Anomaly.InstanceLongRunningQueryDetail.queries:type_name -> bytebase.v1.Anomaly.InstanceLongRunningQueryDetail.Query
+	14, // 13: bytebase.v1.Anomaly.InstanceLongRunningQueryDetail.threshold:type_name -> google.protobuf.Duration
+	14, // 14: bytebase.v1.Anomaly.InstanceLongRunningQueryDetail.Query.duration:type_name -> google.protobuf.Duration
+	2,  // 15: bytebase.v1.AnomalyService.SearchAnomalies:input_type -> bytebase.v1.SearchAnomaliesRequest
+	3,  // 16: bytebase.v1.AnomalyService.SearchAnomalies:output_type -> bytebase.v1.SearchAnomaliesResponse
+	16, // [16:17] is the sub-list for method output_type
+	15, // [15:16] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_v1_anomaly_service_proto_init() }
@@ -803,7 +1339,7 @@ func file_v1_anomaly_service_proto_init() {
 			}
 		}
 		file_v1_anomaly_service_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*Anomaly_DatabaseConnectionDetail); i {
+			switch v := v.(*Anomaly_InstanceConnectionBudgetDetail); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -815,6 +1351,42 @@ func file_v1_anomaly_service_proto_init() {
 			}
 		}
 		file_v1_anomaly_service_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_InstanceLongRunningQueryDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_InstanceFailoverDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseConnectionDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*Anomaly_DatabaseSchemaDriftDetail); i {
 			case 0:
 				return &v.state
@@ -826,11 +1398,39 @@ func file_v1_anomaly_service_proto_init() {
 				return nil
 			}
 		}
+		file_v1_anomaly_service_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_DatabaseBackupFailedDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_anomaly_service_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*Anomaly_InstanceLongRunningQueryDetail_Query); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_v1_anomaly_service_proto_msgTypes[2].OneofWrappers = []any{
 		(*Anomaly_InstanceConnectionDetail_)(nil),
 		(*Anomaly_DatabaseConnectionDetail_)(nil),
 		(*Anomaly_DatabaseSchemaDriftDetail_)(nil),
+		(*Anomaly_InstanceConnectionBudgetDetail_)(nil),
+		(*Anomaly_InstanceLongRunningQueryDetail_)(nil),
+		(*Anomaly_DatabaseBackupFailedDetail_)(nil),
+		(*Anomaly_InstanceFailoverDetail_)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -838,7 +1438,7 @@ func file_v1_anomaly_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_anomaly_service_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   6,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,14 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthService_GetUser_FullMethodName      = "/bytebase.v1.AuthService/GetUser"
-	AuthService_ListUsers_FullMethodName    = "/bytebase.v1.AuthService/ListUsers"
-	AuthService_CreateUser_FullMethodName   = "/bytebase.v1.AuthService/CreateUser"
-	AuthService_UpdateUser_FullMethodName   = "/bytebase.v1.AuthService/UpdateUser"
-	AuthService_DeleteUser_FullMethodName   = "/bytebase.v1.AuthService/DeleteUser"
-	AuthService_UndeleteUser_FullMethodName = "/bytebase.v1.AuthService/UndeleteUser"
-	AuthService_Login_FullMethodName        = "/bytebase.v1.AuthService/Login"
-	AuthService_Logout_FullMethodName       = "/bytebase.v1.AuthService/Logout"
+	AuthService_GetUser_FullMethodName                   = "/bytebase.v1.AuthService/GetUser"
+	AuthService_ListUsers_FullMethodName                 = "/bytebase.v1.AuthService/ListUsers"
+	AuthService_CreateUser_FullMethodName                = "/bytebase.v1.AuthService/CreateUser"
+	AuthService_UpdateUser_FullMethodName                = "/bytebase.v1.AuthService/UpdateUser"
+	AuthService_DeleteUser_FullMethodName                = "/bytebase.v1.AuthService/DeleteUser"
+	AuthService_UndeleteUser_FullMethodName              = "/bytebase.v1.AuthService/UndeleteUser"
+	AuthService_CreateServiceAccountToken_FullMethodName = "/bytebase.v1.AuthService/CreateServiceAccountToken"
+	AuthService_ListServiceAccountTokens_FullMethodName  = "/bytebase.v1.AuthService/ListServiceAccountTokens"
+	AuthService_DeleteServiceAccountToken_FullMethodName = "/bytebase.v1.AuthService/DeleteServiceAccountToken"
+	AuthService_GetNotificationSetting_FullMethodName    = "/bytebase.v1.AuthService/GetNotificationSetting"
+	AuthService_UpdateNotificationSetting_FullMethodName = "/bytebase.v1.AuthService/UpdateNotificationSetting"
+	AuthService_Login_FullMethodName                     = "/bytebase.v1.AuthService/Login"
+	AuthService_Logout_FullMethodName                    = "/bytebase.v1.AuthService/Logout"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -51,6 +56,18 @@ type AuthServiceClient interface {
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	UndeleteUser(ctx context.Context, in *UndeleteUserRequest, opts ...grpc.CallOption) (*User, error)
+	// Create a scoped token for the service account.
+	// The plaintext token is only returned in the response of the creation.
+	CreateServiceAccountToken(ctx context.Context, in *CreateServiceAccountTokenRequest, opts ...grpc.CallOption) (*ServiceAccountToken, error)
+	ListServiceAccountTokens(ctx context.Context, in *ListServiceAccountTokensRequest, opts ...grpc.CallOption) (*ListServiceAccountTokensResponse, error)
+	// Revoke a scoped token of the service account.
+	DeleteServiceAccountToken(ctx context.Context, in *DeleteServiceAccountTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get the notification setting of the user.
+	// Only the user itself can get the notification setting.
+	GetNotificationSetting(ctx context.Context, in *GetNotificationSettingRequest, opts ...grpc.CallOption) (*NotificationSetting, error)
+	// Update the notification setting of the user.
+	// Only the user itself can update the notification setting.
+	UpdateNotificationSetting(ctx context.Context, in *UpdateNotificationSettingRequest, opts ...grpc.CallOption) (*NotificationSetting, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -123,6 +140,56 @@ func (c *authServiceClient) UndeleteUser(ctx context.Context, in *UndeleteUserRe
 	return out, nil
 }
 
+func (c *authServiceClient) CreateServiceAccountToken(ctx context.Context, in *CreateServiceAccountTokenRequest, opts ...grpc.CallOption) (*ServiceAccountToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceAccountToken)
+	err := c.cc.Invoke(ctx, AuthService_CreateServiceAccountToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) ListServiceAccountTokens(ctx context.Context, in *ListServiceAccountTokensRequest, opts ...grpc.CallOption) (*ListServiceAccountTokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListServiceAccountTokensResponse)
+	err := c.cc.Invoke(ctx, AuthService_ListServiceAccountTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) DeleteServiceAccountToken(ctx context.Context, in *DeleteServiceAccountTokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AuthService_DeleteServiceAccountToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) GetNotificationSetting(ctx context.Context, in *GetNotificationSettingRequest, opts ...grpc.CallOption) (*NotificationSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationSetting)
+	err := c.cc.Invoke(ctx, AuthService_GetNotificationSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authServiceClient) UpdateNotificationSetting(ctx context.Context, in *UpdateNotificationSettingRequest, opts ...grpc.CallOption) (*NotificationSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NotificationSetting)
+	err := c.cc.Invoke(ctx, AuthService_UpdateNotificationSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *authServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -164,6 +231,18 @@ type AuthServiceServer interface {
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
 	// Only the user with bb.users.undelete permission on the workspace can undelete the user.
 	UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error)
+	// Create a scoped token for the service account.
+	// The plaintext token is only returned in the response of the creation.
+	CreateServiceAccountToken(context.Context, *CreateServiceAccountTokenRequest) (*ServiceAccountToken, error)
+	ListServiceAccountTokens(context.Context, *ListServiceAccountTokensRequest) (*ListServiceAccountTokensResponse, error)
+	// Revoke a scoped token of the service account.
+	DeleteServiceAccountToken(context.Context, *DeleteServiceAccountTokenRequest) (*emptypb.Empty, error)
+	// Get the notification setting of the user.
+	// Only the user itself can get the notification setting.
+	GetNotificationSetting(context.Context, *GetNotificationSettingRequest) (*NotificationSetting, error)
+	// Update the notification setting of the user.
+	// Only the user itself can update the notification setting.
+	UpdateNotificationSetting(context.Context, *UpdateNotificationSettingRequest) (*NotificationSetting, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthServiceServer()
@@ -194,6 +273,21 @@ func (UnimplementedAuthServiceServer) DeleteUser(context.Context, *DeleteUserReq
 func (UnimplementedAuthServiceServer) UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndeleteUser not implemented")
 }
+func (UnimplementedAuthServiceServer) CreateServiceAccountToken(context.Context, *CreateServiceAccountTokenRequest) (*ServiceAccountToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccountToken not implemented")
+}
+func (UnimplementedAuthServiceServer) ListServiceAccountTokens(context.Context, *ListServiceAccountTokensRequest) (*ListServiceAccountTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccountTokens not implemented")
+}
+func (UnimplementedAuthServiceServer) DeleteServiceAccountToken(context.Context, *DeleteServiceAccountTokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccountToken not implemented")
+}
+func (UnimplementedAuthServiceServer) GetNotificationSetting(context.Context, *GetNotificationSettingRequest) (*NotificationSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationSetting not implemented")
+}
+func (UnimplementedAuthServiceServer) UpdateNotificationSetting(context.Context, *UpdateNotificationSettingRequest) (*NotificationSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationSetting not implemented")
+}
 func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
@@ -329,6 +423,96 @@ func _AuthService_UndeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_CreateServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).CreateServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_CreateServiceAccountToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).CreateServiceAccountToken(ctx, req.(*CreateServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_ListServiceAccountTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ListServiceAccountTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ListServiceAccountTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ListServiceAccountTokens(ctx, req.(*ListServiceAccountTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_DeleteServiceAccountToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).DeleteServiceAccountToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_DeleteServiceAccountToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).DeleteServiceAccountToken(ctx, req.(*DeleteServiceAccountTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_GetNotificationSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetNotificationSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetNotificationSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetNotificationSetting(ctx, req.(*GetNotificationSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthService_UpdateNotificationSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).UpdateNotificationSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_UpdateNotificationSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).UpdateNotificationSetting(ctx, req.(*UpdateNotificationSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuthService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -396,6 +580,26 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UndeleteUser",
 			Handler:    _AuthService_UndeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateServiceAccountToken",
+			Handler:    _AuthService_CreateServiceAccountToken_Handler,
+		},
+		{
+			MethodName: "ListServiceAccountTokens",
+			Handler:    _AuthService_ListServiceAccountTokens_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccountToken",
+			Handler:    _AuthService_DeleteServiceAccountToken_Handler,
+		},
+		{
+			MethodName: "GetNotificationSetting",
+			Handler:    _AuthService_GetNotificationSetting_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationSetting",
+			Handler:    _AuthService_UpdateNotificationSetting_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _AuthService_Login_Handler,
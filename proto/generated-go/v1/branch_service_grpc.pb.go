@@ -20,15 +20,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	BranchService_GetBranch_FullMethodName    = "/bytebase.v1.BranchService/GetBranch"
-	BranchService_ListBranches_FullMethodName = "/bytebase.v1.BranchService/ListBranches"
-	BranchService_CreateBranch_FullMethodName = "/bytebase.v1.BranchService/CreateBranch"
-	BranchService_UpdateBranch_FullMethodName = "/bytebase.v1.BranchService/UpdateBranch"
-	BranchService_MergeBranch_FullMethodName  = "/bytebase.v1.BranchService/MergeBranch"
-	BranchService_RebaseBranch_FullMethodName = "/bytebase.v1.BranchService/RebaseBranch"
-	BranchService_DeleteBranch_FullMethodName = "/bytebase.v1.BranchService/DeleteBranch"
-	BranchService_DiffDatabase_FullMethodName = "/bytebase.v1.BranchService/DiffDatabase"
-	BranchService_DiffMetadata_FullMethodName = "/bytebase.v1.BranchService/DiffMetadata"
+	BranchService_GetBranch_FullMethodName             = "/bytebase.v1.BranchService/GetBranch"
+	BranchService_ListBranches_FullMethodName          = "/bytebase.v1.BranchService/ListBranches"
+	BranchService_CreateBranch_FullMethodName          = "/bytebase.v1.BranchService/CreateBranch"
+	BranchService_UpdateBranch_FullMethodName          = "/bytebase.v1.BranchService/UpdateBranch"
+	BranchService_MergeBranch_FullMethodName           = "/bytebase.v1.BranchService/MergeBranch"
+	BranchService_RebaseBranch_FullMethodName          = "/bytebase.v1.BranchService/RebaseBranch"
+	BranchService_DeleteBranch_FullMethodName          = "/bytebase.v1.BranchService/DeleteBranch"
+	BranchService_DiffDatabase_FullMethodName          = "/bytebase.v1.BranchService/DiffDatabase"
+	BranchService_MergeBranchToDatabase_FullMethodName = "/bytebase.v1.BranchService/MergeBranchToDatabase"
+	BranchService_DiffMetadata_FullMethodName          = "/bytebase.v1.BranchService/DiffMetadata"
 )
 
 // BranchServiceClient is the client API for BranchService service.
@@ -49,6 +50,10 @@ type BranchServiceClient interface {
 	// 4) return the conflict schema if conflict needs to be resolved by user. Once resolved, user
 	// will call DiffSchema() in database service to get diff DDLs.
 	DiffDatabase(ctx context.Context, in *DiffDatabaseRequest, opts ...grpc.CallOption) (*DiffDatabaseResponse, error)
+	// MergeBranchToDatabase merges the branch to a database in three ways between the baseline and head of the branch and
+	// the live schema of the database, and opens the issue applying the merged schema to the database.
+	// No issue is created if there are conflicts.
+	MergeBranchToDatabase(ctx context.Context, in *MergeBranchToDatabaseRequest, opts ...grpc.CallOption) (*MergeBranchToDatabaseResponse, error)
 	DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error)
 }
 
@@ -140,6 +145,16 @@ func (c *branchServiceClient) DiffDatabase(ctx context.Context, in *DiffDatabase
 	return out, nil
 }
 
+func (c *branchServiceClient) MergeBranchToDatabase(ctx context.Context, in *MergeBranchToDatabaseRequest, opts ...grpc.CallOption) (*MergeBranchToDatabaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeBranchToDatabaseResponse)
+	err := c.cc.Invoke(ctx, BranchService_MergeBranchToDatabase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *branchServiceClient) DiffMetadata(ctx context.Context, in *DiffMetadataRequest, opts ...grpc.CallOption) (*DiffMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DiffMetadataResponse)
@@ -168,6 +183,10 @@ type BranchServiceServer interface {
 	// 4) return the conflict schema if conflict needs to be resolved by user. Once resolved, user
 	// will call DiffSchema() in database service to get diff DDLs.
 	DiffDatabase(context.Context, *DiffDatabaseRequest) (*DiffDatabaseResponse, error)
+	// MergeBranchToDatabase merges the branch to a database in three ways between the baseline and head of the branch and
+	// the live schema of the database, and opens the issue applying the merged schema to the database.
+	// No issue is created if there are conflicts.
+	MergeBranchToDatabase(context.Context, *MergeBranchToDatabaseRequest) (*MergeBranchToDatabaseResponse, error)
 	DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error)
 	mustEmbedUnimplementedBranchServiceServer()
 }
@@ -203,6 +222,9 @@ func (UnimplementedBranchServiceServer) DeleteBranch(context.Context, *DeleteBra
 func (UnimplementedBranchServiceServer) DiffDatabase(context.Context, *DiffDatabaseRequest) (*DiffDatabaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffDatabase not implemented")
 }
+func (UnimplementedBranchServiceServer) MergeBranchToDatabase(context.Context, *MergeBranchToDatabaseRequest) (*MergeBranchToDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBranchToDatabase not implemented")
+}
 func (UnimplementedBranchServiceServer) DiffMetadata(context.Context, *DiffMetadataRequest) (*DiffMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffMetadata not implemented")
 }
@@ -371,6 +393,24 @@ func _BranchService_DiffDatabase_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BranchService_MergeBranchToDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeBranchToDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BranchServiceServer).MergeBranchToDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BranchService_MergeBranchToDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BranchServiceServer).MergeBranchToDatabase(ctx, req.(*MergeBranchToDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BranchService_DiffMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DiffMetadataRequest)
 	if err := dec(in); err != nil {
@@ -428,6 +468,10 @@ var BranchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiffDatabase",
 			Handler:    _BranchService_DiffDatabase_Handler,
 		},
+		{
+			MethodName: "MergeBranchToDatabase",
+			Handler:    _BranchService_MergeBranchToDatabase_Handler,
+		},
 		{
 			MethodName: "DiffMetadata",
 			Handler:    _BranchService_DiffMetadata_Handler,
@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChangelistService_CreateChangelist_FullMethodName = "/bytebase.v1.ChangelistService/CreateChangelist"
-	ChangelistService_GetChangelist_FullMethodName    = "/bytebase.v1.ChangelistService/GetChangelist"
-	ChangelistService_ListChangelists_FullMethodName  = "/bytebase.v1.ChangelistService/ListChangelists"
-	ChangelistService_UpdateChangelist_FullMethodName = "/bytebase.v1.ChangelistService/UpdateChangelist"
-	ChangelistService_DeleteChangelist_FullMethodName = "/bytebase.v1.ChangelistService/DeleteChangelist"
+	ChangelistService_CreateChangelist_FullMethodName  = "/bytebase.v1.ChangelistService/CreateChangelist"
+	ChangelistService_GetChangelist_FullMethodName     = "/bytebase.v1.ChangelistService/GetChangelist"
+	ChangelistService_ListChangelists_FullMethodName   = "/bytebase.v1.ChangelistService/ListChangelists"
+	ChangelistService_UpdateChangelist_FullMethodName  = "/bytebase.v1.ChangelistService/UpdateChangelist"
+	ChangelistService_DeleteChangelist_FullMethodName  = "/bytebase.v1.ChangelistService/DeleteChangelist"
+	ChangelistService_PromoteChangelist_FullMethodName = "/bytebase.v1.ChangelistService/PromoteChangelist"
 )
 
 // ChangelistServiceClient is the client API for ChangelistService service.
@@ -36,6 +37,9 @@ type ChangelistServiceClient interface {
 	ListChangelists(ctx context.Context, in *ListChangelistsRequest, opts ...grpc.CallOption) (*ListChangelistsResponse, error)
 	UpdateChangelist(ctx context.Context, in *UpdateChangelistRequest, opts ...grpc.CallOption) (*Changelist, error)
 	DeleteChangelist(ctx context.Context, in *DeleteChangelistRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PromoteChangelist creates the plan and issue rolling out the changelist to the targets
+	// with the exact sheets rolled out by the source issue.
+	PromoteChangelist(ctx context.Context, in *PromoteChangelistRequest, opts ...grpc.CallOption) (*PromoteChangelistResponse, error)
 }
 
 type changelistServiceClient struct {
@@ -96,6 +100,16 @@ func (c *changelistServiceClient) DeleteChangelist(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *changelistServiceClient) PromoteChangelist(ctx context.Context, in *PromoteChangelistRequest, opts ...grpc.CallOption) (*PromoteChangelistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteChangelistResponse)
+	err := c.cc.Invoke(ctx, ChangelistService_PromoteChangelist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChangelistServiceServer is the server API for ChangelistService service.
 // All implementations must embed UnimplementedChangelistServiceServer
 // for forward compatibility.
@@ -105,6 +119,9 @@ type ChangelistServiceServer interface {
 	ListChangelists(context.Context, *ListChangelistsRequest) (*ListChangelistsResponse, error)
 	UpdateChangelist(context.Context, *UpdateChangelistRequest) (*Changelist, error)
 	DeleteChangelist(context.Context, *DeleteChangelistRequest) (*emptypb.Empty, error)
+	// PromoteChangelist creates the plan and issue rolling out the changelist to the targets
+	// with the exact sheets rolled out by the source issue.
+	PromoteChangelist(context.Context, *PromoteChangelistRequest) (*PromoteChangelistResponse, error)
 	mustEmbedUnimplementedChangelistServiceServer()
 }
 
@@ -130,6 +147,9 @@ func (UnimplementedChangelistServiceServer) UpdateChangelist(context.Context, *U
 func (UnimplementedChangelistServiceServer) DeleteChangelist(context.Context, *DeleteChangelistRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteChangelist not implemented")
 }
+func (UnimplementedChangelistServiceServer) PromoteChangelist(context.Context, *PromoteChangelistRequest) (*PromoteChangelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteChangelist not implemented")
+}
 func (UnimplementedChangelistServiceServer) mustEmbedUnimplementedChangelistServiceServer() {}
 func (UnimplementedChangelistServiceServer) testEmbeddedByValue()                           {}
 
@@ -241,6 +261,24 @@ func _ChangelistService_DeleteChangelist_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChangelistService_PromoteChangelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteChangelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChangelistServiceServer).PromoteChangelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChangelistService_PromoteChangelist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChangelistServiceServer).PromoteChangelist(ctx, req.(*PromoteChangelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChangelistService_ServiceDesc is the grpc.ServiceDesc for ChangelistService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +306,10 @@ var ChangelistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteChangelist",
 			Handler:    _ChangelistService_DeleteChangelist_Handler,
 		},
+		{
+			MethodName: "PromoteChangelist",
+			Handler:    _ChangelistService_PromoteChangelist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/changelist_service.proto",
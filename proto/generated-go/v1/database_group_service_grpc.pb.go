@@ -20,11 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DatabaseGroupService_ListDatabaseGroups_FullMethodName  = "/bytebase.v1.DatabaseGroupService/ListDatabaseGroups"
-	DatabaseGroupService_GetDatabaseGroup_FullMethodName    = "/bytebase.v1.DatabaseGroupService/GetDatabaseGroup"
-	DatabaseGroupService_CreateDatabaseGroup_FullMethodName = "/bytebase.v1.DatabaseGroupService/CreateDatabaseGroup"
-	DatabaseGroupService_UpdateDatabaseGroup_FullMethodName = "/bytebase.v1.DatabaseGroupService/UpdateDatabaseGroup"
-	DatabaseGroupService_DeleteDatabaseGroup_FullMethodName = "/bytebase.v1.DatabaseGroupService/DeleteDatabaseGroup"
+	DatabaseGroupService_ListDatabaseGroups_FullMethodName         = "/bytebase.v1.DatabaseGroupService/ListDatabaseGroups"
+	DatabaseGroupService_GetDatabaseGroup_FullMethodName           = "/bytebase.v1.DatabaseGroupService/GetDatabaseGroup"
+	DatabaseGroupService_CreateDatabaseGroup_FullMethodName        = "/bytebase.v1.DatabaseGroupService/CreateDatabaseGroup"
+	DatabaseGroupService_UpdateDatabaseGroup_FullMethodName        = "/bytebase.v1.DatabaseGroupService/UpdateDatabaseGroup"
+	DatabaseGroupService_DeleteDatabaseGroup_FullMethodName        = "/bytebase.v1.DatabaseGroupService/DeleteDatabaseGroup"
+	DatabaseGroupService_PreviewMatchedDatabases_FullMethodName    = "/bytebase.v1.DatabaseGroupService/PreviewMatchedDatabases"
+	DatabaseGroupService_ListDatabaseGroupRevisions_FullMethodName = "/bytebase.v1.DatabaseGroupService/ListDatabaseGroupRevisions"
 )
 
 // DatabaseGroupServiceClient is the client API for DatabaseGroupService service.
@@ -36,6 +38,12 @@ type DatabaseGroupServiceClient interface {
 	CreateDatabaseGroup(ctx context.Context, in *CreateDatabaseGroupRequest, opts ...grpc.CallOption) (*DatabaseGroup, error)
 	UpdateDatabaseGroup(ctx context.Context, in *UpdateDatabaseGroupRequest, opts ...grpc.CallOption) (*DatabaseGroup, error)
 	DeleteDatabaseGroup(ctx context.Context, in *DeleteDatabaseGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Previews the databases in the project matched by the database group expression,
+	// and validates the database labels referenced by the expression.
+	PreviewMatchedDatabases(ctx context.Context, in *PreviewMatchedDatabasesRequest, opts ...grpc.CallOption) (*PreviewMatchedDatabasesResponse, error)
+	// List the revisions of a database group, the newest first.
+	// A revision is saved each time the database group is created or updated.
+	ListDatabaseGroupRevisions(ctx context.Context, in *ListDatabaseGroupRevisionsRequest, opts ...grpc.CallOption) (*ListDatabaseGroupRevisionsResponse, error)
 }
 
 type databaseGroupServiceClient struct {
@@ -96,6 +104,26 @@ func (c *databaseGroupServiceClient) DeleteDatabaseGroup(ctx context.Context, in
 	return out, nil
 }
 
+func (c *databaseGroupServiceClient) PreviewMatchedDatabases(ctx context.Context, in *PreviewMatchedDatabasesRequest, opts ...grpc.CallOption) (*PreviewMatchedDatabasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewMatchedDatabasesResponse)
+	err := c.cc.Invoke(ctx, DatabaseGroupService_PreviewMatchedDatabases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseGroupServiceClient) ListDatabaseGroupRevisions(ctx context.Context, in *ListDatabaseGroupRevisionsRequest, opts ...grpc.CallOption) (*ListDatabaseGroupRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDatabaseGroupRevisionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseGroupService_ListDatabaseGroupRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseGroupServiceServer is the server API for DatabaseGroupService service.
 // All implementations must embed UnimplementedDatabaseGroupServiceServer
 // for forward compatibility.
@@ -105,6 +133,12 @@ type DatabaseGroupServiceServer interface {
 	CreateDatabaseGroup(context.Context, *CreateDatabaseGroupRequest) (*DatabaseGroup, error)
 	UpdateDatabaseGroup(context.Context, *UpdateDatabaseGroupRequest) (*DatabaseGroup, error)
 	DeleteDatabaseGroup(context.Context, *DeleteDatabaseGroupRequest) (*emptypb.Empty, error)
+	// Previews the databases in the project matched by the database group expression,
+	// and validates the database labels referenced by the expression.
+	PreviewMatchedDatabases(context.Context, *PreviewMatchedDatabasesRequest) (*PreviewMatchedDatabasesResponse, error)
+	// List the revisions of a database group, the newest first.
+	// A revision is saved each time the database group is created or updated.
+	ListDatabaseGroupRevisions(context.Context, *ListDatabaseGroupRevisionsRequest) (*ListDatabaseGroupRevisionsResponse, error)
 	mustEmbedUnimplementedDatabaseGroupServiceServer()
 }
 
@@ -130,6 +164,12 @@ func (UnimplementedDatabaseGroupServiceServer) UpdateDatabaseGroup(context.Conte
 func (UnimplementedDatabaseGroupServiceServer) DeleteDatabaseGroup(context.Context, *DeleteDatabaseGroupRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDatabaseGroup not implemented")
 }
+func (UnimplementedDatabaseGroupServiceServer) PreviewMatchedDatabases(context.Context, *PreviewMatchedDatabasesRequest) (*PreviewMatchedDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMatchedDatabases not implemented")
+}
+func (UnimplementedDatabaseGroupServiceServer) ListDatabaseGroupRevisions(context.Context, *ListDatabaseGroupRevisionsRequest) (*ListDatabaseGroupRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabaseGroupRevisions not implemented")
+}
 func (UnimplementedDatabaseGroupServiceServer) mustEmbedUnimplementedDatabaseGroupServiceServer() {}
 func (UnimplementedDatabaseGroupServiceServer) testEmbeddedByValue()                              {}
 
@@ -241,6 +281,42 @@ func _DatabaseGroupService_DeleteDatabaseGroup_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseGroupService_PreviewMatchedDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMatchedDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseGroupServiceServer).PreviewMatchedDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseGroupService_PreviewMatchedDatabases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseGroupServiceServer).PreviewMatchedDatabases(ctx, req.(*PreviewMatchedDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseGroupService_ListDatabaseGroupRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabaseGroupRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseGroupServiceServer).ListDatabaseGroupRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseGroupService_ListDatabaseGroupRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseGroupServiceServer).ListDatabaseGroupRevisions(ctx, req.(*ListDatabaseGroupRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseGroupService_ServiceDesc is the grpc.ServiceDesc for DatabaseGroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +344,14 @@ var DatabaseGroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDatabaseGroup",
 			Handler:    _DatabaseGroupService_DeleteDatabaseGroup_Handler,
 		},
+		{
+			MethodName: "PreviewMatchedDatabases",
+			Handler:    _DatabaseGroupService_PreviewMatchedDatabases_Handler,
+		},
+		{
+			MethodName: "ListDatabaseGroupRevisions",
+			Handler:    _DatabaseGroupService_ListDatabaseGroupRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_group_service.proto",
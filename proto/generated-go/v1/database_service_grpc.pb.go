@@ -20,24 +20,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DatabaseService_GetDatabase_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabase"
-	DatabaseService_ListInstanceDatabases_FullMethodName  = "/bytebase.v1.DatabaseService/ListInstanceDatabases"
-	DatabaseService_ListDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/ListDatabases"
-	DatabaseService_SearchDatabases_FullMethodName        = "/bytebase.v1.DatabaseService/SearchDatabases"
-	DatabaseService_UpdateDatabase_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabase"
-	DatabaseService_BatchUpdateDatabases_FullMethodName   = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
-	DatabaseService_SyncDatabase_FullMethodName           = "/bytebase.v1.DatabaseService/SyncDatabase"
-	DatabaseService_GetDatabaseMetadata_FullMethodName    = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
-	DatabaseService_UpdateDatabaseMetadata_FullMethodName = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
-	DatabaseService_GetDatabaseSchema_FullMethodName      = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
-	DatabaseService_DiffSchema_FullMethodName             = "/bytebase.v1.DatabaseService/DiffSchema"
-	DatabaseService_ListSlowQueries_FullMethodName        = "/bytebase.v1.DatabaseService/ListSlowQueries"
-	DatabaseService_ListSecrets_FullMethodName            = "/bytebase.v1.DatabaseService/ListSecrets"
-	DatabaseService_UpdateSecret_FullMethodName           = "/bytebase.v1.DatabaseService/UpdateSecret"
-	DatabaseService_DeleteSecret_FullMethodName           = "/bytebase.v1.DatabaseService/DeleteSecret"
-	DatabaseService_AdviseIndex_FullMethodName            = "/bytebase.v1.DatabaseService/AdviseIndex"
-	DatabaseService_ListChangeHistories_FullMethodName    = "/bytebase.v1.DatabaseService/ListChangeHistories"
-	DatabaseService_GetChangeHistory_FullMethodName       = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_GetDatabase_FullMethodName                    = "/bytebase.v1.DatabaseService/GetDatabase"
+	DatabaseService_ListInstanceDatabases_FullMethodName          = "/bytebase.v1.DatabaseService/ListInstanceDatabases"
+	DatabaseService_ListDatabases_FullMethodName                  = "/bytebase.v1.DatabaseService/ListDatabases"
+	DatabaseService_SearchDatabases_FullMethodName                = "/bytebase.v1.DatabaseService/SearchDatabases"
+	DatabaseService_UpdateDatabase_FullMethodName                 = "/bytebase.v1.DatabaseService/UpdateDatabase"
+	DatabaseService_BatchUpdateDatabases_FullMethodName           = "/bytebase.v1.DatabaseService/BatchUpdateDatabases"
+	DatabaseService_SyncDatabase_FullMethodName                   = "/bytebase.v1.DatabaseService/SyncDatabase"
+	DatabaseService_GetDatabaseMetadata_FullMethodName            = "/bytebase.v1.DatabaseService/GetDatabaseMetadata"
+	DatabaseService_UpdateDatabaseMetadata_FullMethodName         = "/bytebase.v1.DatabaseService/UpdateDatabaseMetadata"
+	DatabaseService_GetDatabaseSchema_FullMethodName              = "/bytebase.v1.DatabaseService/GetDatabaseSchema"
+	DatabaseService_DiffSchema_FullMethodName                     = "/bytebase.v1.DatabaseService/DiffSchema"
+	DatabaseService_GetDatabaseSchemaAsOf_FullMethodName          = "/bytebase.v1.DatabaseService/GetDatabaseSchemaAsOf"
+	DatabaseService_DiffSchemaSnapshots_FullMethodName            = "/bytebase.v1.DatabaseService/DiffSchemaSnapshots"
+	DatabaseService_ListSlowQueries_FullMethodName                = "/bytebase.v1.DatabaseService/ListSlowQueries"
+	DatabaseService_ListSecrets_FullMethodName                    = "/bytebase.v1.DatabaseService/ListSecrets"
+	DatabaseService_UpdateSecret_FullMethodName                   = "/bytebase.v1.DatabaseService/UpdateSecret"
+	DatabaseService_DeleteSecret_FullMethodName                   = "/bytebase.v1.DatabaseService/DeleteSecret"
+	DatabaseService_AdviseIndex_FullMethodName                    = "/bytebase.v1.DatabaseService/AdviseIndex"
+	DatabaseService_ListChangeHistories_FullMethodName            = "/bytebase.v1.DatabaseService/ListChangeHistories"
+	DatabaseService_GetChangeHistory_FullMethodName               = "/bytebase.v1.DatabaseService/GetChangeHistory"
+	DatabaseService_ExportChangeHistories_FullMethodName          = "/bytebase.v1.DatabaseService/ExportChangeHistories"
+	DatabaseService_ImportChangeHistories_FullMethodName          = "/bytebase.v1.DatabaseService/ImportChangeHistories"
+	DatabaseService_ImportColumnClassifications_FullMethodName    = "/bytebase.v1.DatabaseService/ImportColumnClassifications"
+	DatabaseService_ExportSchemaDiagram_FullMethodName            = "/bytebase.v1.DatabaseService/ExportSchemaDiagram"
+	DatabaseService_ListClassificationSuggestions_FullMethodName  = "/bytebase.v1.DatabaseService/ListClassificationSuggestions"
+	DatabaseService_AcceptClassificationSuggestion_FullMethodName = "/bytebase.v1.DatabaseService/AcceptClassificationSuggestion"
+	DatabaseService_ExportColumnClassifications_FullMethodName    = "/bytebase.v1.DatabaseService/ExportColumnClassifications"
+	DatabaseService_GetBackupSetting_FullMethodName               = "/bytebase.v1.DatabaseService/GetBackupSetting"
+	DatabaseService_UpdateBackupSetting_FullMethodName            = "/bytebase.v1.DatabaseService/UpdateBackupSetting"
+	DatabaseService_ListBackupRuns_FullMethodName                 = "/bytebase.v1.DatabaseService/ListBackupRuns"
+	DatabaseService_CreateBackupRun_FullMethodName                = "/bytebase.v1.DatabaseService/CreateBackupRun"
 )
 
 // DatabaseServiceClient is the client API for DatabaseService service.
@@ -57,6 +70,10 @@ type DatabaseServiceClient interface {
 	UpdateDatabaseMetadata(ctx context.Context, in *UpdateDatabaseMetadataRequest, opts ...grpc.CallOption) (*DatabaseMetadata, error)
 	GetDatabaseSchema(ctx context.Context, in *GetDatabaseSchemaRequest, opts ...grpc.CallOption) (*DatabaseSchema, error)
 	DiffSchema(ctx context.Context, in *DiffSchemaRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error)
+	// GetDatabaseSchemaAsOf returns the database schema from the latest schema snapshot taken at or before the given time.
+	GetDatabaseSchemaAsOf(ctx context.Context, in *GetDatabaseSchemaAsOfRequest, opts ...grpc.CallOption) (*DatabaseSchema, error)
+	// DiffSchemaSnapshots diffs the database schemas at two points in time.
+	DiffSchemaSnapshots(ctx context.Context, in *DiffSchemaSnapshotsRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error)
 	ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error)
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*Secret, error)
@@ -64,6 +81,31 @@ type DatabaseServiceClient interface {
 	AdviseIndex(ctx context.Context, in *AdviseIndexRequest, opts ...grpc.CallOption) (*AdviseIndexResponse, error)
 	ListChangeHistories(ctx context.Context, in *ListChangeHistoriesRequest, opts ...grpc.CallOption) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(ctx context.Context, in *GetChangeHistoryRequest, opts ...grpc.CallOption) (*ChangeHistory, error)
+	// ExportChangeHistories exports the applied migration history of the database as a changelog.
+	ExportChangeHistories(ctx context.Context, in *ExportChangeHistoriesRequest, opts ...grpc.CallOption) (*ExportChangeHistoriesResponse, error)
+	// ImportChangeHistories seeds the migration history of a database which is already managed by
+	// an external migration tool, e.g. Flyway or Liquibase. The checksums of the tool are preserved.
+	ImportChangeHistories(ctx context.Context, in *ImportChangeHistoriesRequest, opts ...grpc.CallOption) (*ImportChangeHistoriesResponse, error)
+	// ImportColumnClassifications imports the column classifications from an external data catalog,
+	// e.g. DataHub or Collibra, so that the masking policies follow the classifications of the governance tool.
+	ImportColumnClassifications(ctx context.Context, in *ImportColumnClassificationsRequest, opts ...grpc.CallOption) (*ImportColumnClassificationsResponse, error)
+	// ExportSchemaDiagram exports the ER diagram of the database or a schema as a graph of tables, columns and foreign keys,
+	// and optionally as PlantUML or Mermaid source.
+	ExportSchemaDiagram(ctx context.Context, in *ExportSchemaDiagramRequest, opts ...grpc.CallOption) (*SchemaDiagram, error)
+	// ListClassificationSuggestions lists the sensitive data classifications suggested by the PII detection of the schema sync.
+	ListClassificationSuggestions(ctx context.Context, in *ListClassificationSuggestionsRequest, opts ...grpc.CallOption) (*ListClassificationSuggestionsResponse, error)
+	// AcceptClassificationSuggestion masks the suggested column in the masking policy of the database.
+	AcceptClassificationSuggestion(ctx context.Context, in *AcceptClassificationSuggestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ExportColumnClassifications exports the column classifications to write them back to the external data catalog.
+	ExportColumnClassifications(ctx context.Context, in *ExportColumnClassificationsRequest, opts ...grpc.CallOption) (*ExportColumnClassificationsResponse, error)
+	GetBackupSetting(ctx context.Context, in *GetBackupSettingRequest, opts ...grpc.CallOption) (*BackupSetting, error)
+	// UpdateBackupSetting updates the scheduled logical backup of the database.
+	UpdateBackupSetting(ctx context.Context, in *UpdateBackupSettingRequest, opts ...grpc.CallOption) (*BackupSetting, error)
+	// ListBackupRuns lists the backup runs of the database, the newest first.
+	ListBackupRuns(ctx context.Context, in *ListBackupRunsRequest, opts ...grpc.CallOption) (*ListBackupRunsResponse, error)
+	// CreateBackupRun creates a manual backup of the database to the storage in the backup setting.
+	// The backup runs in the background, and the status can be checked with ListBackupRuns.
+	CreateBackupRun(ctx context.Context, in *CreateBackupRunRequest, opts ...grpc.CallOption) (*BackupRun, error)
 }
 
 type databaseServiceClient struct {
@@ -184,6 +226,26 @@ func (c *databaseServiceClient) DiffSchema(ctx context.Context, in *DiffSchemaRe
 	return out, nil
 }
 
+func (c *databaseServiceClient) GetDatabaseSchemaAsOf(ctx context.Context, in *GetDatabaseSchemaAsOfRequest, opts ...grpc.CallOption) (*DatabaseSchema, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatabaseSchema)
+	err := c.cc.Invoke(ctx, DatabaseService_GetDatabaseSchemaAsOf_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) DiffSchemaSnapshots(ctx context.Context, in *DiffSchemaSnapshotsRequest, opts ...grpc.CallOption) (*DiffSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffSchemaResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_DiffSchemaSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *databaseServiceClient) ListSlowQueries(ctx context.Context, in *ListSlowQueriesRequest, opts ...grpc.CallOption) (*ListSlowQueriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSlowQueriesResponse)
@@ -254,6 +316,116 @@ func (c *databaseServiceClient) GetChangeHistory(ctx context.Context, in *GetCha
 	return out, nil
 }
 
+func (c *databaseServiceClient) ExportChangeHistories(ctx context.Context, in *ExportChangeHistoriesRequest, opts ...grpc.CallOption) (*ExportChangeHistoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportChangeHistoriesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ExportChangeHistories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ImportChangeHistories(ctx context.Context, in *ImportChangeHistoriesRequest, opts ...grpc.CallOption) (*ImportChangeHistoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportChangeHistoriesResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ImportChangeHistories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ImportColumnClassifications(ctx context.Context, in *ImportColumnClassificationsRequest, opts ...grpc.CallOption) (*ImportColumnClassificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportColumnClassificationsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ImportColumnClassifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ExportSchemaDiagram(ctx context.Context, in *ExportSchemaDiagramRequest, opts ...grpc.CallOption) (*SchemaDiagram, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchemaDiagram)
+	err := c.cc.Invoke(ctx, DatabaseService_ExportSchemaDiagram_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListClassificationSuggestions(ctx context.Context, in *ListClassificationSuggestionsRequest, opts ...grpc.CallOption) (*ListClassificationSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListClassificationSuggestionsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListClassificationSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) AcceptClassificationSuggestion(ctx context.Context, in *AcceptClassificationSuggestionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DatabaseService_AcceptClassificationSuggestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ExportColumnClassifications(ctx context.Context, in *ExportColumnClassificationsRequest, opts ...grpc.CallOption) (*ExportColumnClassificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportColumnClassificationsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ExportColumnClassifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) GetBackupSetting(ctx context.Context, in *GetBackupSettingRequest, opts ...grpc.CallOption) (*BackupSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackupSetting)
+	err := c.cc.Invoke(ctx, DatabaseService_GetBackupSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) UpdateBackupSetting(ctx context.Context, in *UpdateBackupSettingRequest, opts ...grpc.CallOption) (*BackupSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackupSetting)
+	err := c.cc.Invoke(ctx, DatabaseService_UpdateBackupSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) ListBackupRuns(ctx context.Context, in *ListBackupRunsRequest, opts ...grpc.CallOption) (*ListBackupRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackupRunsResponse)
+	err := c.cc.Invoke(ctx, DatabaseService_ListBackupRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *databaseServiceClient) CreateBackupRun(ctx context.Context, in *CreateBackupRunRequest, opts ...grpc.CallOption) (*BackupRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackupRun)
+	err := c.cc.Invoke(ctx, DatabaseService_CreateBackupRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DatabaseServiceServer is the server API for DatabaseService service.
 // All implementations must embed UnimplementedDatabaseServiceServer
 // for forward compatibility.
@@ -271,6 +443,10 @@ type DatabaseServiceServer interface {
 	UpdateDatabaseMetadata(context.Context, *UpdateDatabaseMetadataRequest) (*DatabaseMetadata, error)
 	GetDatabaseSchema(context.Context, *GetDatabaseSchemaRequest) (*DatabaseSchema, error)
 	DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error)
+	// GetDatabaseSchemaAsOf returns the database schema from the latest schema snapshot taken at or before the given time.
+	GetDatabaseSchemaAsOf(context.Context, *GetDatabaseSchemaAsOfRequest) (*DatabaseSchema, error)
+	// DiffSchemaSnapshots diffs the database schemas at two points in time.
+	DiffSchemaSnapshots(context.Context, *DiffSchemaSnapshotsRequest) (*DiffSchemaResponse, error)
 	ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error)
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*Secret, error)
@@ -278,6 +454,31 @@ type DatabaseServiceServer interface {
 	AdviseIndex(context.Context, *AdviseIndexRequest) (*AdviseIndexResponse, error)
 	ListChangeHistories(context.Context, *ListChangeHistoriesRequest) (*ListChangeHistoriesResponse, error)
 	GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error)
+	// ExportChangeHistories exports the applied migration history of the database as a changelog.
+	ExportChangeHistories(context.Context, *ExportChangeHistoriesRequest) (*ExportChangeHistoriesResponse, error)
+	// ImportChangeHistories seeds the migration history of a database which is already managed by
+	// an external migration tool, e.g. Flyway or Liquibase. The checksums of the tool are preserved.
+	ImportChangeHistories(context.Context, *ImportChangeHistoriesRequest) (*ImportChangeHistoriesResponse, error)
+	// ImportColumnClassifications imports the column classifications from an external data catalog,
+	// e.g. DataHub or Collibra, so that the masking policies follow the classifications of the governance tool.
+	ImportColumnClassifications(context.Context, *ImportColumnClassificationsRequest) (*ImportColumnClassificationsResponse, error)
+	// ExportSchemaDiagram exports the ER diagram of the database or a schema as a graph of tables, columns and foreign keys,
+	// and optionally as PlantUML or Mermaid source.
+	ExportSchemaDiagram(context.Context, *ExportSchemaDiagramRequest) (*SchemaDiagram, error)
+	// ListClassificationSuggestions lists the sensitive data classifications suggested by the PII detection of the schema sync.
+	ListClassificationSuggestions(context.Context, *ListClassificationSuggestionsRequest) (*ListClassificationSuggestionsResponse, error)
+	// AcceptClassificationSuggestion masks the suggested column in the masking policy of the database.
+	AcceptClassificationSuggestion(context.Context, *AcceptClassificationSuggestionRequest) (*emptypb.Empty, error)
+	// ExportColumnClassifications exports the column classifications to write them back to the external data catalog.
+	ExportColumnClassifications(context.Context, *ExportColumnClassificationsRequest) (*ExportColumnClassificationsResponse, error)
+	GetBackupSetting(context.Context, *GetBackupSettingRequest) (*BackupSetting, error)
+	// UpdateBackupSetting updates the scheduled logical backup of the database.
+	UpdateBackupSetting(context.Context, *UpdateBackupSettingRequest) (*BackupSetting, error)
+	// ListBackupRuns lists the backup runs of the database, the newest first.
+	ListBackupRuns(context.Context, *ListBackupRunsRequest) (*ListBackupRunsResponse, error)
+	// CreateBackupRun creates a manual backup of the database to the storage in the backup setting.
+	// The backup runs in the background, and the status can be checked with ListBackupRuns.
+	CreateBackupRun(context.Context, *CreateBackupRunRequest) (*BackupRun, error)
 	mustEmbedUnimplementedDatabaseServiceServer()
 }
 
@@ -321,6 +522,12 @@ func (UnimplementedDatabaseServiceServer) GetDatabaseSchema(context.Context, *Ge
 func (UnimplementedDatabaseServiceServer) DiffSchema(context.Context, *DiffSchemaRequest) (*DiffSchemaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiffSchema not implemented")
 }
+func (UnimplementedDatabaseServiceServer) GetDatabaseSchemaAsOf(context.Context, *GetDatabaseSchemaAsOfRequest) (*DatabaseSchema, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabaseSchemaAsOf not implemented")
+}
+func (UnimplementedDatabaseServiceServer) DiffSchemaSnapshots(context.Context, *DiffSchemaSnapshotsRequest) (*DiffSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffSchemaSnapshots not implemented")
+}
 func (UnimplementedDatabaseServiceServer) ListSlowQueries(context.Context, *ListSlowQueriesRequest) (*ListSlowQueriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSlowQueries not implemented")
 }
@@ -342,6 +549,39 @@ func (UnimplementedDatabaseServiceServer) ListChangeHistories(context.Context, *
 func (UnimplementedDatabaseServiceServer) GetChangeHistory(context.Context, *GetChangeHistoryRequest) (*ChangeHistory, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChangeHistory not implemented")
 }
+func (UnimplementedDatabaseServiceServer) ExportChangeHistories(context.Context, *ExportChangeHistoriesRequest) (*ExportChangeHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChangeHistories not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ImportChangeHistories(context.Context, *ImportChangeHistoriesRequest) (*ImportChangeHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportChangeHistories not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ImportColumnClassifications(context.Context, *ImportColumnClassificationsRequest) (*ImportColumnClassificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportColumnClassifications not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ExportSchemaDiagram(context.Context, *ExportSchemaDiagramRequest) (*SchemaDiagram, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSchemaDiagram not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ListClassificationSuggestions(context.Context, *ListClassificationSuggestionsRequest) (*ListClassificationSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClassificationSuggestions not implemented")
+}
+func (UnimplementedDatabaseServiceServer) AcceptClassificationSuggestion(context.Context, *AcceptClassificationSuggestionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptClassificationSuggestion not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ExportColumnClassifications(context.Context, *ExportColumnClassificationsRequest) (*ExportColumnClassificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportColumnClassifications not implemented")
+}
+func (UnimplementedDatabaseServiceServer) GetBackupSetting(context.Context, *GetBackupSettingRequest) (*BackupSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBackupSetting not implemented")
+}
+func (UnimplementedDatabaseServiceServer) UpdateBackupSetting(context.Context, *UpdateBackupSettingRequest) (*BackupSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBackupSetting not implemented")
+}
+func (UnimplementedDatabaseServiceServer) ListBackupRuns(context.Context, *ListBackupRunsRequest) (*ListBackupRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackupRuns not implemented")
+}
+func (UnimplementedDatabaseServiceServer) CreateBackupRun(context.Context, *CreateBackupRunRequest) (*BackupRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBackupRun not implemented")
+}
 func (UnimplementedDatabaseServiceServer) mustEmbedUnimplementedDatabaseServiceServer() {}
 func (UnimplementedDatabaseServiceServer) testEmbeddedByValue()                         {}
 
@@ -561,6 +801,42 @@ func _DatabaseService_DiffSchema_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_GetDatabaseSchemaAsOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseSchemaAsOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetDatabaseSchemaAsOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetDatabaseSchemaAsOf_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetDatabaseSchemaAsOf(ctx, req.(*GetDatabaseSchemaAsOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_DiffSchemaSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffSchemaSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).DiffSchemaSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_DiffSchemaSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).DiffSchemaSnapshots(ctx, req.(*DiffSchemaSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DatabaseService_ListSlowQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSlowQueriesRequest)
 	if err := dec(in); err != nil {
@@ -687,6 +963,204 @@ func _DatabaseService_GetChangeHistory_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DatabaseService_ExportChangeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChangeHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ExportChangeHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ExportChangeHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ExportChangeHistories(ctx, req.(*ExportChangeHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ImportChangeHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportChangeHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ImportChangeHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ImportChangeHistories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ImportChangeHistories(ctx, req.(*ImportChangeHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ImportColumnClassifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportColumnClassificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ImportColumnClassifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ImportColumnClassifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ImportColumnClassifications(ctx, req.(*ImportColumnClassificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ExportSchemaDiagram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSchemaDiagramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ExportSchemaDiagram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ExportSchemaDiagram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ExportSchemaDiagram(ctx, req.(*ExportSchemaDiagramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListClassificationSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClassificationSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListClassificationSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListClassificationSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListClassificationSuggestions(ctx, req.(*ListClassificationSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_AcceptClassificationSuggestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptClassificationSuggestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).AcceptClassificationSuggestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_AcceptClassificationSuggestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).AcceptClassificationSuggestion(ctx, req.(*AcceptClassificationSuggestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ExportColumnClassifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportColumnClassificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ExportColumnClassifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ExportColumnClassifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ExportColumnClassifications(ctx, req.(*ExportColumnClassificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_GetBackupSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBackupSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).GetBackupSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_GetBackupSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).GetBackupSetting(ctx, req.(*GetBackupSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_UpdateBackupSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBackupSettingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).UpdateBackupSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_UpdateBackupSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).UpdateBackupSetting(ctx, req.(*UpdateBackupSettingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_ListBackupRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackupRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).ListBackupRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_ListBackupRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).ListBackupRuns(ctx, req.(*ListBackupRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DatabaseService_CreateBackupRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBackupRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DatabaseServiceServer).CreateBackupRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DatabaseService_CreateBackupRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DatabaseServiceServer).CreateBackupRun(ctx, req.(*CreateBackupRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DatabaseService_ServiceDesc is the grpc.ServiceDesc for DatabaseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -738,6 +1212,14 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiffSchema",
 			Handler:    _DatabaseService_DiffSchema_Handler,
 		},
+		{
+			MethodName: "GetDatabaseSchemaAsOf",
+			Handler:    _DatabaseService_GetDatabaseSchemaAsOf_Handler,
+		},
+		{
+			MethodName: "DiffSchemaSnapshots",
+			Handler:    _DatabaseService_DiffSchemaSnapshots_Handler,
+		},
 		{
 			MethodName: "ListSlowQueries",
 			Handler:    _DatabaseService_ListSlowQueries_Handler,
@@ -766,6 +1248,50 @@ var DatabaseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChangeHistory",
 			Handler:    _DatabaseService_GetChangeHistory_Handler,
 		},
+		{
+			MethodName: "ExportChangeHistories",
+			Handler:    _DatabaseService_ExportChangeHistories_Handler,
+		},
+		{
+			MethodName: "ImportChangeHistories",
+			Handler:    _DatabaseService_ImportChangeHistories_Handler,
+		},
+		{
+			MethodName: "ImportColumnClassifications",
+			Handler:    _DatabaseService_ImportColumnClassifications_Handler,
+		},
+		{
+			MethodName: "ExportSchemaDiagram",
+			Handler:    _DatabaseService_ExportSchemaDiagram_Handler,
+		},
+		{
+			MethodName: "ListClassificationSuggestions",
+			Handler:    _DatabaseService_ListClassificationSuggestions_Handler,
+		},
+		{
+			MethodName: "AcceptClassificationSuggestion",
+			Handler:    _DatabaseService_AcceptClassificationSuggestion_Handler,
+		},
+		{
+			MethodName: "ExportColumnClassifications",
+			Handler:    _DatabaseService_ExportColumnClassifications_Handler,
+		},
+		{
+			MethodName: "GetBackupSetting",
+			Handler:    _DatabaseService_GetBackupSetting_Handler,
+		},
+		{
+			MethodName: "UpdateBackupSetting",
+			Handler:    _DatabaseService_UpdateBackupSetting_Handler,
+		},
+		{
+			MethodName: "ListBackupRuns",
+			Handler:    _DatabaseService_ListBackupRuns_Handler,
+		},
+		{
+			MethodName: "CreateBackupRun",
+			Handler:    _DatabaseService_CreateBackupRun_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/database_service.proto",
@@ -20,12 +20,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EnvironmentService_GetEnvironment_FullMethodName      = "/bytebase.v1.EnvironmentService/GetEnvironment"
-	EnvironmentService_ListEnvironments_FullMethodName    = "/bytebase.v1.EnvironmentService/ListEnvironments"
-	EnvironmentService_CreateEnvironment_FullMethodName   = "/bytebase.v1.EnvironmentService/CreateEnvironment"
-	EnvironmentService_UpdateEnvironment_FullMethodName   = "/bytebase.v1.EnvironmentService/UpdateEnvironment"
-	EnvironmentService_DeleteEnvironment_FullMethodName   = "/bytebase.v1.EnvironmentService/DeleteEnvironment"
-	EnvironmentService_UndeleteEnvironment_FullMethodName = "/bytebase.v1.EnvironmentService/UndeleteEnvironment"
+	EnvironmentService_GetEnvironment_FullMethodName            = "/bytebase.v1.EnvironmentService/GetEnvironment"
+	EnvironmentService_ListEnvironments_FullMethodName          = "/bytebase.v1.EnvironmentService/ListEnvironments"
+	EnvironmentService_CreateEnvironment_FullMethodName         = "/bytebase.v1.EnvironmentService/CreateEnvironment"
+	EnvironmentService_UpdateEnvironment_FullMethodName         = "/bytebase.v1.EnvironmentService/UpdateEnvironment"
+	EnvironmentService_DeleteEnvironment_FullMethodName         = "/bytebase.v1.EnvironmentService/DeleteEnvironment"
+	EnvironmentService_UndeleteEnvironment_FullMethodName       = "/bytebase.v1.EnvironmentService/UndeleteEnvironment"
+	EnvironmentService_GetEnvironmentPipeline_FullMethodName    = "/bytebase.v1.EnvironmentService/GetEnvironmentPipeline"
+	EnvironmentService_UpdateEnvironmentPipeline_FullMethodName = "/bytebase.v1.EnvironmentService/UpdateEnvironmentPipeline"
 )
 
 // EnvironmentServiceClient is the client API for EnvironmentService service.
@@ -38,6 +40,11 @@ type EnvironmentServiceClient interface {
 	UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentRequest, opts ...grpc.CallOption) (*Environment, error)
 	DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UndeleteEnvironment(ctx context.Context, in *UndeleteEnvironmentRequest, opts ...grpc.CallOption) (*Environment, error)
+	// Gets the promotion pipeline of the environments.
+	GetEnvironmentPipeline(ctx context.Context, in *GetEnvironmentPipelineRequest, opts ...grpc.CallOption) (*EnvironmentPipeline, error)
+	// Updates the promotion pipeline of the environments.
+	// The stages are replaced as a whole, which reorders the environments and marks the environments in the same stage as parallel.
+	UpdateEnvironmentPipeline(ctx context.Context, in *UpdateEnvironmentPipelineRequest, opts ...grpc.CallOption) (*EnvironmentPipeline, error)
 }
 
 type environmentServiceClient struct {
@@ -108,6 +115,26 @@ func (c *environmentServiceClient) UndeleteEnvironment(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *environmentServiceClient) GetEnvironmentPipeline(ctx context.Context, in *GetEnvironmentPipelineRequest, opts ...grpc.CallOption) (*EnvironmentPipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvironmentPipeline)
+	err := c.cc.Invoke(ctx, EnvironmentService_GetEnvironmentPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *environmentServiceClient) UpdateEnvironmentPipeline(ctx context.Context, in *UpdateEnvironmentPipelineRequest, opts ...grpc.CallOption) (*EnvironmentPipeline, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnvironmentPipeline)
+	err := c.cc.Invoke(ctx, EnvironmentService_UpdateEnvironmentPipeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnvironmentServiceServer is the server API for EnvironmentService service.
 // All implementations must embed UnimplementedEnvironmentServiceServer
 // for forward compatibility.
@@ -118,6 +145,11 @@ type EnvironmentServiceServer interface {
 	UpdateEnvironment(context.Context, *UpdateEnvironmentRequest) (*Environment, error)
 	DeleteEnvironment(context.Context, *DeleteEnvironmentRequest) (*emptypb.Empty, error)
 	UndeleteEnvironment(context.Context, *UndeleteEnvironmentRequest) (*Environment, error)
+	// Gets the promotion pipeline of the environments.
+	GetEnvironmentPipeline(context.Context, *GetEnvironmentPipelineRequest) (*EnvironmentPipeline, error)
+	// Updates the promotion pipeline of the environments.
+	// The stages are replaced as a whole, which reorders the environments and marks the environments in the same stage as parallel.
+	UpdateEnvironmentPipeline(context.Context, *UpdateEnvironmentPipelineRequest) (*EnvironmentPipeline, error)
 	mustEmbedUnimplementedEnvironmentServiceServer()
 }
 
@@ -146,6 +178,12 @@ func (UnimplementedEnvironmentServiceServer) DeleteEnvironment(context.Context,
 func (UnimplementedEnvironmentServiceServer) UndeleteEnvironment(context.Context, *UndeleteEnvironmentRequest) (*Environment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndeleteEnvironment not implemented")
 }
+func (UnimplementedEnvironmentServiceServer) GetEnvironmentPipeline(context.Context, *GetEnvironmentPipelineRequest) (*EnvironmentPipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentPipeline not implemented")
+}
+func (UnimplementedEnvironmentServiceServer) UpdateEnvironmentPipeline(context.Context, *UpdateEnvironmentPipelineRequest) (*EnvironmentPipeline, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnvironmentPipeline not implemented")
+}
 func (UnimplementedEnvironmentServiceServer) mustEmbedUnimplementedEnvironmentServiceServer() {}
 func (UnimplementedEnvironmentServiceServer) testEmbeddedByValue()                            {}
 
@@ -275,6 +313,42 @@ func _EnvironmentService_UndeleteEnvironment_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnvironmentService_GetEnvironmentPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentServiceServer).GetEnvironmentPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvironmentService_GetEnvironmentPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentServiceServer).GetEnvironmentPipeline(ctx, req.(*GetEnvironmentPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvironmentService_UpdateEnvironmentPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnvironmentPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvironmentServiceServer).UpdateEnvironmentPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvironmentService_UpdateEnvironmentPipeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvironmentServiceServer).UpdateEnvironmentPipeline(ctx, req.(*UpdateEnvironmentPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnvironmentService_ServiceDesc is the grpc.ServiceDesc for EnvironmentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -306,6 +380,14 @@ var EnvironmentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UndeleteEnvironment",
 			Handler:    _EnvironmentService_UndeleteEnvironment_Handler,
 		},
+		{
+			MethodName: "GetEnvironmentPipeline",
+			Handler:    _EnvironmentService_GetEnvironmentPipeline_Handler,
+		},
+		{
+			MethodName: "UpdateEnvironmentPipeline",
+			Handler:    _EnvironmentService_UpdateEnvironmentPipeline_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/environment_service.proto",
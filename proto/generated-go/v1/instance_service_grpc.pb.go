@@ -32,6 +32,7 @@ const (
 	InstanceService_RemoveDataSource_FullMethodName   = "/bytebase.v1.InstanceService/RemoveDataSource"
 	InstanceService_UpdateDataSource_FullMethodName   = "/bytebase.v1.InstanceService/UpdateDataSource"
 	InstanceService_SyncSlowQueries_FullMethodName    = "/bytebase.v1.InstanceService/SyncSlowQueries"
+	InstanceService_KillQuery_FullMethodName          = "/bytebase.v1.InstanceService/KillQuery"
 )
 
 // InstanceServiceClient is the client API for InstanceService service.
@@ -50,6 +51,8 @@ type InstanceServiceClient interface {
 	RemoveDataSource(ctx context.Context, in *RemoveDataSourceRequest, opts ...grpc.CallOption) (*Instance, error)
 	UpdateDataSource(ctx context.Context, in *UpdateDataSourceRequest, opts ...grpc.CallOption) (*Instance, error)
 	SyncSlowQueries(ctx context.Context, in *SyncSlowQueriesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// KillQuery cancels the running query of the connection in the instance, e.g. the one reported by the long-running query anomaly.
+	KillQuery(ctx context.Context, in *KillQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type instanceServiceClient struct {
@@ -180,6 +183,16 @@ func (c *instanceServiceClient) SyncSlowQueries(ctx context.Context, in *SyncSlo
 	return out, nil
 }
 
+func (c *instanceServiceClient) KillQuery(ctx context.Context, in *KillQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InstanceService_KillQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InstanceServiceServer is the server API for InstanceService service.
 // All implementations must embed UnimplementedInstanceServiceServer
 // for forward compatibility.
@@ -196,6 +209,8 @@ type InstanceServiceServer interface {
 	RemoveDataSource(context.Context, *RemoveDataSourceRequest) (*Instance, error)
 	UpdateDataSource(context.Context, *UpdateDataSourceRequest) (*Instance, error)
 	SyncSlowQueries(context.Context, *SyncSlowQueriesRequest) (*emptypb.Empty, error)
+	// KillQuery cancels the running query of the connection in the instance, e.g. the one reported by the long-running query anomaly.
+	KillQuery(context.Context, *KillQueryRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedInstanceServiceServer()
 }
 
@@ -242,6 +257,9 @@ func (UnimplementedInstanceServiceServer) UpdateDataSource(context.Context, *Upd
 func (UnimplementedInstanceServiceServer) SyncSlowQueries(context.Context, *SyncSlowQueriesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncSlowQueries not implemented")
 }
+func (UnimplementedInstanceServiceServer) KillQuery(context.Context, *KillQueryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillQuery not implemented")
+}
 func (UnimplementedInstanceServiceServer) mustEmbedUnimplementedInstanceServiceServer() {}
 func (UnimplementedInstanceServiceServer) testEmbeddedByValue()                         {}
 
@@ -479,6 +497,24 @@ func _InstanceService_SyncSlowQueries_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InstanceService_KillQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstanceServiceServer).KillQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstanceService_KillQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstanceServiceServer).KillQuery(ctx, req.(*KillQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InstanceService_ServiceDesc is the grpc.ServiceDesc for InstanceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -534,6 +570,10 @@ var InstanceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncSlowQueries",
 			Handler:    _InstanceService_SyncSlowQueries_Handler,
 		},
+		{
+			MethodName: "KillQuery",
+			Handler:    _InstanceService_KillQuery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/instance_service.proto",
@@ -8,9 +8,11 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,18 +21,27 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IssueService_GetIssue_FullMethodName                = "/bytebase.v1.IssueService/GetIssue"
-	IssueService_CreateIssue_FullMethodName             = "/bytebase.v1.IssueService/CreateIssue"
-	IssueService_ListIssues_FullMethodName              = "/bytebase.v1.IssueService/ListIssues"
-	IssueService_SearchIssues_FullMethodName            = "/bytebase.v1.IssueService/SearchIssues"
-	IssueService_UpdateIssue_FullMethodName             = "/bytebase.v1.IssueService/UpdateIssue"
-	IssueService_ListIssueComments_FullMethodName       = "/bytebase.v1.IssueService/ListIssueComments"
-	IssueService_CreateIssueComment_FullMethodName      = "/bytebase.v1.IssueService/CreateIssueComment"
-	IssueService_UpdateIssueComment_FullMethodName      = "/bytebase.v1.IssueService/UpdateIssueComment"
-	IssueService_BatchUpdateIssuesStatus_FullMethodName = "/bytebase.v1.IssueService/BatchUpdateIssuesStatus"
-	IssueService_ApproveIssue_FullMethodName            = "/bytebase.v1.IssueService/ApproveIssue"
-	IssueService_RejectIssue_FullMethodName             = "/bytebase.v1.IssueService/RejectIssue"
-	IssueService_RequestIssue_FullMethodName            = "/bytebase.v1.IssueService/RequestIssue"
+	IssueService_GetIssue_FullMethodName                  = "/bytebase.v1.IssueService/GetIssue"
+	IssueService_CreateIssue_FullMethodName               = "/bytebase.v1.IssueService/CreateIssue"
+	IssueService_ListIssues_FullMethodName                = "/bytebase.v1.IssueService/ListIssues"
+	IssueService_SearchIssues_FullMethodName              = "/bytebase.v1.IssueService/SearchIssues"
+	IssueService_UpdateIssue_FullMethodName               = "/bytebase.v1.IssueService/UpdateIssue"
+	IssueService_ListIssueComments_FullMethodName         = "/bytebase.v1.IssueService/ListIssueComments"
+	IssueService_GetIssueTimeline_FullMethodName          = "/bytebase.v1.IssueService/GetIssueTimeline"
+	IssueService_ListChangeCalendarEvents_FullMethodName  = "/bytebase.v1.IssueService/ListChangeCalendarEvents"
+	IssueService_ExportChangeCalendar_FullMethodName      = "/bytebase.v1.IssueService/ExportChangeCalendar"
+	IssueService_PurgeIssues_FullMethodName               = "/bytebase.v1.IssueService/PurgeIssues"
+	IssueService_RegenerateCalendarFeed_FullMethodName    = "/bytebase.v1.IssueService/RegenerateCalendarFeed"
+	IssueService_GetCalendarFeed_FullMethodName           = "/bytebase.v1.IssueService/GetCalendarFeed"
+	IssueService_CreateIssueComment_FullMethodName        = "/bytebase.v1.IssueService/CreateIssueComment"
+	IssueService_UpdateIssueComment_FullMethodName        = "/bytebase.v1.IssueService/UpdateIssueComment"
+	IssueService_DeleteIssueComment_FullMethodName        = "/bytebase.v1.IssueService/DeleteIssueComment"
+	IssueService_ListIssueCommentRevisions_FullMethodName = "/bytebase.v1.IssueService/ListIssueCommentRevisions"
+	IssueService_BatchUpdateIssuesStatus_FullMethodName   = "/bytebase.v1.IssueService/BatchUpdateIssuesStatus"
+	IssueService_RefreshExternalApprovals_FullMethodName  = "/bytebase.v1.IssueService/RefreshExternalApprovals"
+	IssueService_ApproveIssue_FullMethodName              = "/bytebase.v1.IssueService/ApproveIssue"
+	IssueService_RejectIssue_FullMethodName               = "/bytebase.v1.IssueService/RejectIssue"
+	IssueService_RequestIssue_FullMethodName              = "/bytebase.v1.IssueService/RequestIssue"
 )
 
 // IssueServiceClient is the client API for IssueService service.
@@ -43,18 +54,55 @@ type IssueServiceClient interface {
 	// Search for issues that the caller has the bb.issues.get permission on and also satisfy the specified filter & query.
 	SearchIssues(ctx context.Context, in *SearchIssuesRequest, opts ...grpc.CallOption) (*SearchIssuesResponse, error)
 	UpdateIssue(ctx context.Context, in *UpdateIssueRequest, opts ...grpc.CallOption) (*Issue, error)
+	// ListIssueComments lists the comments of the issue in the order of creation.
+	// Use the page_size and page_token query parameters to paginate over HTTP.
 	ListIssueComments(ctx context.Context, in *ListIssueCommentsRequest, opts ...grpc.CallOption) (*ListIssueCommentsResponse, error)
+	// GetIssueTimeline returns the comments, activities, approval events, plan check runs and task runs
+	// of the issue in one chronologically ordered feed.
+	GetIssueTimeline(ctx context.Context, in *GetIssueTimelineRequest, opts ...grpc.CallOption) (*GetIssueTimelineResponse, error)
+	// ListChangeCalendarEvents lists the scheduled and completed rollouts in the time range.
+	// Use "projects/-" as the parent to list the events in all projects the caller can access.
+	ListChangeCalendarEvents(ctx context.Context, in *ListChangeCalendarEventsRequest, opts ...grpc.CallOption) (*ListChangeCalendarEventsResponse, error)
+	// ExportChangeCalendar exports the change calendar events in the time range as an iCalendar feed.
+	ExportChangeCalendar(ctx context.Context, in *ExportChangeCalendarRequest, opts ...grpc.CallOption) (*ExportChangeCalendarResponse, error)
+	// PurgeIssues hard deletes the done and canceled issues created before the time, including their comments,
+	// plans, rollouts and sheets. The open issues are never purged.
+	// Only the caller with bb.issues.purge on the workspace can purge issues.
+	PurgeIssues(ctx context.Context, in *PurgeIssuesRequest, opts ...grpc.CallOption) (*PurgeIssuesResponse, error)
+	// RegenerateCalendarFeed generates a new secret iCalendar feed URL of the caller's pending approvals and scheduled rollouts.
+	// The previous feed URL of the caller stops working.
+	RegenerateCalendarFeed(ctx context.Context, in *RegenerateCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeed, error)
+	// GetCalendarFeed returns the iCalendar feed of the user who owns the token.
+	// It's authenticated by the secret token in the URL so that calendar apps can subscribe to it.
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// CreateIssueComment creates a comment on the issue.
+	// The HTTP request body is the issue comment, e.g. {"comment": "LGTM"}.
 	CreateIssueComment(ctx context.Context, in *CreateIssueCommentRequest, opts ...grpc.CallOption) (*IssueComment, error)
+	// UpdateIssueComment updates the comment of the issue.
+	// The HTTP request body is the issue comment with the uid, e.g. {"uid": "101", "comment": "LGTM"}.
+	// The update mask is parsed from the update_mask query parameter, e.g. ?update_mask=comment,
+	// or inferred from the fields in the request body if it's not set.
 	UpdateIssueComment(ctx context.Context, in *UpdateIssueCommentRequest, opts ...grpc.CallOption) (*IssueComment, error)
+	// DeleteIssueComment soft deletes the comment of the issue, a tombstone is left in place of the comment.
+	// Only the creator can delete the comment.
+	DeleteIssueComment(ctx context.Context, in *DeleteIssueCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListIssueCommentRevisions lists the previous comments of the edited or deleted comment, the latest first.
+	ListIssueCommentRevisions(ctx context.Context, in *ListIssueCommentRevisionsRequest, opts ...grpc.CallOption) (*ListIssueCommentRevisionsResponse, error)
 	BatchUpdateIssuesStatus(ctx context.Context, in *BatchUpdateIssuesStatusRequest, opts ...grpc.CallOption) (*BatchUpdateIssuesStatusResponse, error)
+	// RefreshExternalApprovals queues the pending external approvals of the issues in the project to be checked in the background.
+	// It returns immediately, the check results are reported in the external_approvals of the issues.
+	RefreshExternalApprovals(ctx context.Context, in *RefreshExternalApprovalsRequest, opts ...grpc.CallOption) (*RefreshExternalApprovalsResponse, error)
 	// ApproveIssue approves the issue.
 	// The access is based on approval flow.
+	// The HTTP request body is optional, e.g. {"comment": "LGTM"}.
 	ApproveIssue(ctx context.Context, in *ApproveIssueRequest, opts ...grpc.CallOption) (*Issue, error)
 	// RejectIssue rejects the issue.
 	// The access is based on approval flow.
+	// The HTTP request body is optional, e.g. {"comment": "Please add the rollback statements"}.
 	RejectIssue(ctx context.Context, in *RejectIssueRequest, opts ...grpc.CallOption) (*Issue, error)
-	// RequestIssue requests the issue.
+	// RequestIssue re-requests the approval of the rejected issue.
 	// The access is based on approval flow.
+	// The HTTP request body is optional, e.g. {"comment": "Rollback statements added"}.
 	RequestIssue(ctx context.Context, in *RequestIssueRequest, opts ...grpc.CallOption) (*Issue, error)
 }
 
@@ -126,6 +174,66 @@ func (c *issueServiceClient) ListIssueComments(ctx context.Context, in *ListIssu
 	return out, nil
 }
 
+func (c *issueServiceClient) GetIssueTimeline(ctx context.Context, in *GetIssueTimelineRequest, opts ...grpc.CallOption) (*GetIssueTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetIssueTimelineResponse)
+	err := c.cc.Invoke(ctx, IssueService_GetIssueTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListChangeCalendarEvents(ctx context.Context, in *ListChangeCalendarEventsRequest, opts ...grpc.CallOption) (*ListChangeCalendarEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChangeCalendarEventsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListChangeCalendarEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ExportChangeCalendar(ctx context.Context, in *ExportChangeCalendarRequest, opts ...grpc.CallOption) (*ExportChangeCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportChangeCalendarResponse)
+	err := c.cc.Invoke(ctx, IssueService_ExportChangeCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) PurgeIssues(ctx context.Context, in *PurgeIssuesRequest, opts ...grpc.CallOption) (*PurgeIssuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeIssuesResponse)
+	err := c.cc.Invoke(ctx, IssueService_PurgeIssues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) RegenerateCalendarFeed(ctx context.Context, in *RegenerateCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, IssueService_RegenerateCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, IssueService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) CreateIssueComment(ctx context.Context, in *CreateIssueCommentRequest, opts ...grpc.CallOption) (*IssueComment, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(IssueComment)
@@ -146,6 +254,26 @@ func (c *issueServiceClient) UpdateIssueComment(ctx context.Context, in *UpdateI
 	return out, nil
 }
 
+func (c *issueServiceClient) DeleteIssueComment(ctx context.Context, in *DeleteIssueCommentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, IssueService_DeleteIssueComment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issueServiceClient) ListIssueCommentRevisions(ctx context.Context, in *ListIssueCommentRevisionsRequest, opts ...grpc.CallOption) (*ListIssueCommentRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIssueCommentRevisionsResponse)
+	err := c.cc.Invoke(ctx, IssueService_ListIssueCommentRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) BatchUpdateIssuesStatus(ctx context.Context, in *BatchUpdateIssuesStatusRequest, opts ...grpc.CallOption) (*BatchUpdateIssuesStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchUpdateIssuesStatusResponse)
@@ -156,6 +284,16 @@ func (c *issueServiceClient) BatchUpdateIssuesStatus(ctx context.Context, in *Ba
 	return out, nil
 }
 
+func (c *issueServiceClient) RefreshExternalApprovals(ctx context.Context, in *RefreshExternalApprovalsRequest, opts ...grpc.CallOption) (*RefreshExternalApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshExternalApprovalsResponse)
+	err := c.cc.Invoke(ctx, IssueService_RefreshExternalApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *issueServiceClient) ApproveIssue(ctx context.Context, in *ApproveIssueRequest, opts ...grpc.CallOption) (*Issue, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Issue)
@@ -196,18 +334,55 @@ type IssueServiceServer interface {
 	// Search for issues that the caller has the bb.issues.get permission on and also satisfy the specified filter & query.
 	SearchIssues(context.Context, *SearchIssuesRequest) (*SearchIssuesResponse, error)
 	UpdateIssue(context.Context, *UpdateIssueRequest) (*Issue, error)
+	// ListIssueComments lists the comments of the issue in the order of creation.
+	// Use the page_size and page_token query parameters to paginate over HTTP.
 	ListIssueComments(context.Context, *ListIssueCommentsRequest) (*ListIssueCommentsResponse, error)
+	// GetIssueTimeline returns the comments, activities, approval events, plan check runs and task runs
+	// of the issue in one chronologically ordered feed.
+	GetIssueTimeline(context.Context, *GetIssueTimelineRequest) (*GetIssueTimelineResponse, error)
+	// ListChangeCalendarEvents lists the scheduled and completed rollouts in the time range.
+	// Use "projects/-" as the parent to list the events in all projects the caller can access.
+	ListChangeCalendarEvents(context.Context, *ListChangeCalendarEventsRequest) (*ListChangeCalendarEventsResponse, error)
+	// ExportChangeCalendar exports the change calendar events in the time range as an iCalendar feed.
+	ExportChangeCalendar(context.Context, *ExportChangeCalendarRequest) (*ExportChangeCalendarResponse, error)
+	// PurgeIssues hard deletes the done and canceled issues created before the time, including their comments,
+	// plans, rollouts and sheets. The open issues are never purged.
+	// Only the caller with bb.issues.purge on the workspace can purge issues.
+	PurgeIssues(context.Context, *PurgeIssuesRequest) (*PurgeIssuesResponse, error)
+	// RegenerateCalendarFeed generates a new secret iCalendar feed URL of the caller's pending approvals and scheduled rollouts.
+	// The previous feed URL of the caller stops working.
+	RegenerateCalendarFeed(context.Context, *RegenerateCalendarFeedRequest) (*CalendarFeed, error)
+	// GetCalendarFeed returns the iCalendar feed of the user who owns the token.
+	// It's authenticated by the secret token in the URL so that calendar apps can subscribe to it.
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*httpbody.HttpBody, error)
+	// CreateIssueComment creates a comment on the issue.
+	// The HTTP request body is the issue comment, e.g. {"comment": "LGTM"}.
 	CreateIssueComment(context.Context, *CreateIssueCommentRequest) (*IssueComment, error)
+	// UpdateIssueComment updates the comment of the issue.
+	// The HTTP request body is the issue comment with the uid, e.g. {"uid": "101", "comment": "LGTM"}.
+	// The update mask is parsed from the update_mask query parameter, e.g. ?update_mask=comment,
+	// or inferred from the fields in the request body if it's not set.
 	UpdateIssueComment(context.Context, *UpdateIssueCommentRequest) (*IssueComment, error)
+	// DeleteIssueComment soft deletes the comment of the issue, a tombstone is left in place of the comment.
+	// Only the creator can delete the comment.
+	DeleteIssueComment(context.Context, *DeleteIssueCommentRequest) (*emptypb.Empty, error)
+	// ListIssueCommentRevisions lists the previous comments of the edited or deleted comment, the latest first.
+	ListIssueCommentRevisions(context.Context, *ListIssueCommentRevisionsRequest) (*ListIssueCommentRevisionsResponse, error)
 	BatchUpdateIssuesStatus(context.Context, *BatchUpdateIssuesStatusRequest) (*BatchUpdateIssuesStatusResponse, error)
+	// RefreshExternalApprovals queues the pending external approvals of the issues in the project to be checked in the background.
+	// It returns immediately, the check results are reported in the external_approvals of the issues.
+	RefreshExternalApprovals(context.Context, *RefreshExternalApprovalsRequest) (*RefreshExternalApprovalsResponse, error)
 	// ApproveIssue approves the issue.
 	// The access is based on approval flow.
+	// The HTTP request body is optional, e.g. {"comment": "LGTM"}.
 	ApproveIssue(context.Context, *ApproveIssueRequest) (*Issue, error)
 	// RejectIssue rejects the issue.
 	// The access is based on approval flow.
+	// The HTTP request body is optional, e.g. {"comment": "Please add the rollback statements"}.
 	RejectIssue(context.Context, *RejectIssueRequest) (*Issue, error)
-	// RequestIssue requests the issue.
+	// RequestIssue re-requests the approval of the rejected issue.
 	// The access is based on approval flow.
+	// The HTTP request body is optional, e.g. {"comment": "Rollback statements added"}.
 	RequestIssue(context.Context, *RequestIssueRequest) (*Issue, error)
 	mustEmbedUnimplementedIssueServiceServer()
 }
@@ -237,15 +412,42 @@ func (UnimplementedIssueServiceServer) UpdateIssue(context.Context, *UpdateIssue
 func (UnimplementedIssueServiceServer) ListIssueComments(context.Context, *ListIssueCommentsRequest) (*ListIssueCommentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListIssueComments not implemented")
 }
+func (UnimplementedIssueServiceServer) GetIssueTimeline(context.Context, *GetIssueTimelineRequest) (*GetIssueTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssueTimeline not implemented")
+}
+func (UnimplementedIssueServiceServer) ListChangeCalendarEvents(context.Context, *ListChangeCalendarEventsRequest) (*ListChangeCalendarEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChangeCalendarEvents not implemented")
+}
+func (UnimplementedIssueServiceServer) ExportChangeCalendar(context.Context, *ExportChangeCalendarRequest) (*ExportChangeCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChangeCalendar not implemented")
+}
+func (UnimplementedIssueServiceServer) PurgeIssues(context.Context, *PurgeIssuesRequest) (*PurgeIssuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeIssues not implemented")
+}
+func (UnimplementedIssueServiceServer) RegenerateCalendarFeed(context.Context, *RegenerateCalendarFeedRequest) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateCalendarFeed not implemented")
+}
+func (UnimplementedIssueServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
 func (UnimplementedIssueServiceServer) CreateIssueComment(context.Context, *CreateIssueCommentRequest) (*IssueComment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIssueComment not implemented")
 }
 func (UnimplementedIssueServiceServer) UpdateIssueComment(context.Context, *UpdateIssueCommentRequest) (*IssueComment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssueComment not implemented")
 }
+func (UnimplementedIssueServiceServer) DeleteIssueComment(context.Context, *DeleteIssueCommentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteIssueComment not implemented")
+}
+func (UnimplementedIssueServiceServer) ListIssueCommentRevisions(context.Context, *ListIssueCommentRevisionsRequest) (*ListIssueCommentRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIssueCommentRevisions not implemented")
+}
 func (UnimplementedIssueServiceServer) BatchUpdateIssuesStatus(context.Context, *BatchUpdateIssuesStatusRequest) (*BatchUpdateIssuesStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateIssuesStatus not implemented")
 }
+func (UnimplementedIssueServiceServer) RefreshExternalApprovals(context.Context, *RefreshExternalApprovalsRequest) (*RefreshExternalApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshExternalApprovals not implemented")
+}
 func (UnimplementedIssueServiceServer) ApproveIssue(context.Context, *ApproveIssueRequest) (*Issue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveIssue not implemented")
 }
@@ -384,6 +586,114 @@ func _IssueService_ListIssueComments_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_GetIssueTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssueTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetIssueTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetIssueTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetIssueTimeline(ctx, req.(*GetIssueTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListChangeCalendarEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangeCalendarEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListChangeCalendarEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListChangeCalendarEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListChangeCalendarEvents(ctx, req.(*ListChangeCalendarEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ExportChangeCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChangeCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ExportChangeCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ExportChangeCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ExportChangeCalendar(ctx, req.(*ExportChangeCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_PurgeIssues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeIssuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).PurgeIssues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_PurgeIssues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).PurgeIssues(ctx, req.(*PurgeIssuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_RegenerateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RegenerateCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RegenerateCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RegenerateCalendarFeed(ctx, req.(*RegenerateCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssueService_CreateIssueComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateIssueCommentRequest)
 	if err := dec(in); err != nil {
@@ -420,6 +730,42 @@ func _IssueService_UpdateIssueComment_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_DeleteIssueComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteIssueCommentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).DeleteIssueComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_DeleteIssueComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).DeleteIssueComment(ctx, req.(*DeleteIssueCommentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssueService_ListIssueCommentRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIssueCommentRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).ListIssueCommentRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_ListIssueCommentRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).ListIssueCommentRevisions(ctx, req.(*ListIssueCommentRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssueService_BatchUpdateIssuesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchUpdateIssuesStatusRequest)
 	if err := dec(in); err != nil {
@@ -438,6 +784,24 @@ func _IssueService_BatchUpdateIssuesStatus_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssueService_RefreshExternalApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshExternalApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssueServiceServer).RefreshExternalApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IssueService_RefreshExternalApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssueServiceServer).RefreshExternalApprovals(ctx, req.(*RefreshExternalApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IssueService_ApproveIssue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApproveIssueRequest)
 	if err := dec(in); err != nil {
@@ -523,6 +887,30 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListIssueComments",
 			Handler:    _IssueService_ListIssueComments_Handler,
 		},
+		{
+			MethodName: "GetIssueTimeline",
+			Handler:    _IssueService_GetIssueTimeline_Handler,
+		},
+		{
+			MethodName: "ListChangeCalendarEvents",
+			Handler:    _IssueService_ListChangeCalendarEvents_Handler,
+		},
+		{
+			MethodName: "ExportChangeCalendar",
+			Handler:    _IssueService_ExportChangeCalendar_Handler,
+		},
+		{
+			MethodName: "PurgeIssues",
+			Handler:    _IssueService_PurgeIssues_Handler,
+		},
+		{
+			MethodName: "RegenerateCalendarFeed",
+			Handler:    _IssueService_RegenerateCalendarFeed_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _IssueService_GetCalendarFeed_Handler,
+		},
 		{
 			MethodName: "CreateIssueComment",
 			Handler:    _IssueService_CreateIssueComment_Handler,
@@ -531,10 +919,22 @@ var IssueService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateIssueComment",
 			Handler:    _IssueService_UpdateIssueComment_Handler,
 		},
+		{
+			MethodName: "DeleteIssueComment",
+			Handler:    _IssueService_DeleteIssueComment_Handler,
+		},
+		{
+			MethodName: "ListIssueCommentRevisions",
+			Handler:    _IssueService_ListIssueCommentRevisions_Handler,
+		},
 		{
 			MethodName: "BatchUpdateIssuesStatus",
 			Handler:    _IssueService_BatchUpdateIssuesStatus_Handler,
 		},
+		{
+			MethodName: "RefreshExternalApprovals",
+			Handler:    _IssueService_RefreshExternalApprovals_Handler,
+		},
 		{
 			MethodName: "ApproveIssue",
 			Handler:    _IssueService_ApproveIssue_Handler,
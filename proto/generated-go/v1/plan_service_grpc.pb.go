@@ -19,14 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PlanService_GetPlan_FullMethodName                  = "/bytebase.v1.PlanService/GetPlan"
-	PlanService_ListPlans_FullMethodName                = "/bytebase.v1.PlanService/ListPlans"
-	PlanService_SearchPlans_FullMethodName              = "/bytebase.v1.PlanService/SearchPlans"
-	PlanService_CreatePlan_FullMethodName               = "/bytebase.v1.PlanService/CreatePlan"
-	PlanService_UpdatePlan_FullMethodName               = "/bytebase.v1.PlanService/UpdatePlan"
-	PlanService_ListPlanCheckRuns_FullMethodName        = "/bytebase.v1.PlanService/ListPlanCheckRuns"
-	PlanService_RunPlanChecks_FullMethodName            = "/bytebase.v1.PlanService/RunPlanChecks"
-	PlanService_BatchCancelPlanCheckRuns_FullMethodName = "/bytebase.v1.PlanService/BatchCancelPlanCheckRuns"
+	PlanService_GetPlan_FullMethodName                             = "/bytebase.v1.PlanService/GetPlan"
+	PlanService_PreviewPlanStatements_FullMethodName               = "/bytebase.v1.PlanService/PreviewPlanStatements"
+	PlanService_ListPlans_FullMethodName                           = "/bytebase.v1.PlanService/ListPlans"
+	PlanService_SearchPlans_FullMethodName                         = "/bytebase.v1.PlanService/SearchPlans"
+	PlanService_CreatePlan_FullMethodName                          = "/bytebase.v1.PlanService/CreatePlan"
+	PlanService_CreateSchemaDriftReconciliationPlan_FullMethodName = "/bytebase.v1.PlanService/CreateSchemaDriftReconciliationPlan"
+	PlanService_UpdatePlan_FullMethodName                          = "/bytebase.v1.PlanService/UpdatePlan"
+	PlanService_ListPlanCheckRuns_FullMethodName                   = "/bytebase.v1.PlanService/ListPlanCheckRuns"
+	PlanService_RunPlanChecks_FullMethodName                       = "/bytebase.v1.PlanService/RunPlanChecks"
+	PlanService_BatchCancelPlanCheckRuns_FullMethodName            = "/bytebase.v1.PlanService/BatchCancelPlanCheckRuns"
+	PlanService_ListPlanCheckRunQueues_FullMethodName              = "/bytebase.v1.PlanService/ListPlanCheckRunQueues"
 )
 
 // PlanServiceClient is the client API for PlanService service.
@@ -34,16 +37,24 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PlanServiceClient interface {
 	GetPlan(ctx context.Context, in *GetPlanRequest, opts ...grpc.CallOption) (*Plan, error)
+	// PreviewPlanStatements returns the statements to run on every target database of the plan,
+	// with the database group targets expanded and the statement variables substituted.
+	PreviewPlanStatements(ctx context.Context, in *PreviewPlanStatementsRequest, opts ...grpc.CallOption) (*PreviewPlanStatementsResponse, error)
 	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error)
 	// Search for plans that the caller has the bb.plans.get permission on and also satisfy the specified filter & query.
 	SearchPlans(ctx context.Context, in *SearchPlansRequest, opts ...grpc.CallOption) (*SearchPlansResponse, error)
 	CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*Plan, error)
+	// CreateSchemaDriftReconciliationPlan creates a plan migrating the drifted database schema
+	// back to the schema recorded by the latest migration.
+	CreateSchemaDriftReconciliationPlan(ctx context.Context, in *CreateSchemaDriftReconciliationPlanRequest, opts ...grpc.CallOption) (*Plan, error)
 	// UpdatePlan updates the plan.
 	// The plan creator and the user with bb.plans.update permission on the project can update the plan.
 	UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*Plan, error)
 	ListPlanCheckRuns(ctx context.Context, in *ListPlanCheckRunsRequest, opts ...grpc.CallOption) (*ListPlanCheckRunsResponse, error)
 	RunPlanChecks(ctx context.Context, in *RunPlanChecksRequest, opts ...grpc.CallOption) (*RunPlanChecksResponse, error)
 	BatchCancelPlanCheckRuns(ctx context.Context, in *BatchCancelPlanCheckRunsRequest, opts ...grpc.CallOption) (*BatchCancelPlanCheckRunsResponse, error)
+	// ListPlanCheckRunQueues lists the plan check queues of the instances which have running or queued plan checks.
+	ListPlanCheckRunQueues(ctx context.Context, in *ListPlanCheckRunQueuesRequest, opts ...grpc.CallOption) (*ListPlanCheckRunQueuesResponse, error)
 }
 
 type planServiceClient struct {
@@ -64,6 +75,16 @@ func (c *planServiceClient) GetPlan(ctx context.Context, in *GetPlanRequest, opt
 	return out, nil
 }
 
+func (c *planServiceClient) PreviewPlanStatements(ctx context.Context, in *PreviewPlanStatementsRequest, opts ...grpc.CallOption) (*PreviewPlanStatementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewPlanStatementsResponse)
+	err := c.cc.Invoke(ctx, PlanService_PreviewPlanStatements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *planServiceClient) ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPlansResponse)
@@ -94,6 +115,16 @@ func (c *planServiceClient) CreatePlan(ctx context.Context, in *CreatePlanReques
 	return out, nil
 }
 
+func (c *planServiceClient) CreateSchemaDriftReconciliationPlan(ctx context.Context, in *CreateSchemaDriftReconciliationPlanRequest, opts ...grpc.CallOption) (*Plan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Plan)
+	err := c.cc.Invoke(ctx, PlanService_CreateSchemaDriftReconciliationPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *planServiceClient) UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*Plan, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Plan)
@@ -134,21 +165,39 @@ func (c *planServiceClient) BatchCancelPlanCheckRuns(ctx context.Context, in *Ba
 	return out, nil
 }
 
+func (c *planServiceClient) ListPlanCheckRunQueues(ctx context.Context, in *ListPlanCheckRunQueuesRequest, opts ...grpc.CallOption) (*ListPlanCheckRunQueuesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlanCheckRunQueuesResponse)
+	err := c.cc.Invoke(ctx, PlanService_ListPlanCheckRunQueues_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlanServiceServer is the server API for PlanService service.
 // All implementations must embed UnimplementedPlanServiceServer
 // for forward compatibility.
 type PlanServiceServer interface {
 	GetPlan(context.Context, *GetPlanRequest) (*Plan, error)
+	// PreviewPlanStatements returns the statements to run on every target database of the plan,
+	// with the database group targets expanded and the statement variables substituted.
+	PreviewPlanStatements(context.Context, *PreviewPlanStatementsRequest) (*PreviewPlanStatementsResponse, error)
 	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
 	// Search for plans that the caller has the bb.plans.get permission on and also satisfy the specified filter & query.
 	SearchPlans(context.Context, *SearchPlansRequest) (*SearchPlansResponse, error)
 	CreatePlan(context.Context, *CreatePlanRequest) (*Plan, error)
+	// CreateSchemaDriftReconciliationPlan creates a plan migrating the drifted database schema
+	// back to the schema recorded by the latest migration.
+	CreateSchemaDriftReconciliationPlan(context.Context, *CreateSchemaDriftReconciliationPlanRequest) (*Plan, error)
 	// UpdatePlan updates the plan.
 	// The plan creator and the user with bb.plans.update permission on the project can update the plan.
 	UpdatePlan(context.Context, *UpdatePlanRequest) (*Plan, error)
 	ListPlanCheckRuns(context.Context, *ListPlanCheckRunsRequest) (*ListPlanCheckRunsResponse, error)
 	RunPlanChecks(context.Context, *RunPlanChecksRequest) (*RunPlanChecksResponse, error)
 	BatchCancelPlanCheckRuns(context.Context, *BatchCancelPlanCheckRunsRequest) (*BatchCancelPlanCheckRunsResponse, error)
+	// ListPlanCheckRunQueues lists the plan check queues of the instances which have running or queued plan checks.
+	ListPlanCheckRunQueues(context.Context, *ListPlanCheckRunQueuesRequest) (*ListPlanCheckRunQueuesResponse, error)
 	mustEmbedUnimplementedPlanServiceServer()
 }
 
@@ -162,6 +211,9 @@ type UnimplementedPlanServiceServer struct{}
 func (UnimplementedPlanServiceServer) GetPlan(context.Context, *GetPlanRequest) (*Plan, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlan not implemented")
 }
+func (UnimplementedPlanServiceServer) PreviewPlanStatements(context.Context, *PreviewPlanStatementsRequest) (*PreviewPlanStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewPlanStatements not implemented")
+}
 func (UnimplementedPlanServiceServer) ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPlans not implemented")
 }
@@ -171,6 +223,9 @@ func (UnimplementedPlanServiceServer) SearchPlans(context.Context, *SearchPlansR
 func (UnimplementedPlanServiceServer) CreatePlan(context.Context, *CreatePlanRequest) (*Plan, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePlan not implemented")
 }
+func (UnimplementedPlanServiceServer) CreateSchemaDriftReconciliationPlan(context.Context, *CreateSchemaDriftReconciliationPlanRequest) (*Plan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchemaDriftReconciliationPlan not implemented")
+}
 func (UnimplementedPlanServiceServer) UpdatePlan(context.Context, *UpdatePlanRequest) (*Plan, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlan not implemented")
 }
@@ -183,6 +238,9 @@ func (UnimplementedPlanServiceServer) RunPlanChecks(context.Context, *RunPlanChe
 func (UnimplementedPlanServiceServer) BatchCancelPlanCheckRuns(context.Context, *BatchCancelPlanCheckRunsRequest) (*BatchCancelPlanCheckRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelPlanCheckRuns not implemented")
 }
+func (UnimplementedPlanServiceServer) ListPlanCheckRunQueues(context.Context, *ListPlanCheckRunQueuesRequest) (*ListPlanCheckRunQueuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlanCheckRunQueues not implemented")
+}
 func (UnimplementedPlanServiceServer) mustEmbedUnimplementedPlanServiceServer() {}
 func (UnimplementedPlanServiceServer) testEmbeddedByValue()                     {}
 
@@ -222,6 +280,24 @@ func _PlanService_GetPlan_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlanService_PreviewPlanStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewPlanStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanServiceServer).PreviewPlanStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlanService_PreviewPlanStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanServiceServer).PreviewPlanStatements(ctx, req.(*PreviewPlanStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlanService_ListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPlansRequest)
 	if err := dec(in); err != nil {
@@ -276,6 +352,24 @@ func _PlanService_CreatePlan_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlanService_CreateSchemaDriftReconciliationPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSchemaDriftReconciliationPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanServiceServer).CreateSchemaDriftReconciliationPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlanService_CreateSchemaDriftReconciliationPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanServiceServer).CreateSchemaDriftReconciliationPlan(ctx, req.(*CreateSchemaDriftReconciliationPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlanService_UpdatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdatePlanRequest)
 	if err := dec(in); err != nil {
@@ -348,6 +442,24 @@ func _PlanService_BatchCancelPlanCheckRuns_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlanService_ListPlanCheckRunQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlanCheckRunQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanServiceServer).ListPlanCheckRunQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlanService_ListPlanCheckRunQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanServiceServer).ListPlanCheckRunQueues(ctx, req.(*ListPlanCheckRunQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlanService_ServiceDesc is the grpc.ServiceDesc for PlanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -359,6 +471,10 @@ var PlanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlan",
 			Handler:    _PlanService_GetPlan_Handler,
 		},
+		{
+			MethodName: "PreviewPlanStatements",
+			Handler:    _PlanService_PreviewPlanStatements_Handler,
+		},
 		{
 			MethodName: "ListPlans",
 			Handler:    _PlanService_ListPlans_Handler,
@@ -371,6 +487,10 @@ var PlanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePlan",
 			Handler:    _PlanService_CreatePlan_Handler,
 		},
+		{
+			MethodName: "CreateSchemaDriftReconciliationPlan",
+			Handler:    _PlanService_CreateSchemaDriftReconciliationPlan_Handler,
+		},
 		{
 			MethodName: "UpdatePlan",
 			Handler:    _PlanService_UpdatePlan_Handler,
@@ -387,6 +507,10 @@ var PlanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchCancelPlanCheckRuns",
 			Handler:    _PlanService_BatchCancelPlanCheckRuns_Handler,
 		},
+		{
+			MethodName: "ListPlanCheckRunQueues",
+			Handler:    _PlanService_ListPlanCheckRunQueues_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/plan_service.proto",
@@ -8,6 +8,7 @@ package v1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -24,9 +25,12 @@ const (
 	RolloutService_PreviewRollout_FullMethodName      = "/bytebase.v1.RolloutService/PreviewRollout"
 	RolloutService_ListTaskRuns_FullMethodName        = "/bytebase.v1.RolloutService/ListTaskRuns"
 	RolloutService_GetTaskRunLog_FullMethodName       = "/bytebase.v1.RolloutService/GetTaskRunLog"
+	RolloutService_DownloadTaskRunLog_FullMethodName  = "/bytebase.v1.RolloutService/DownloadTaskRunLog"
 	RolloutService_GetTaskRunSession_FullMethodName   = "/bytebase.v1.RolloutService/GetTaskRunSession"
 	RolloutService_BatchRunTasks_FullMethodName       = "/bytebase.v1.RolloutService/BatchRunTasks"
 	RolloutService_BatchSkipTasks_FullMethodName      = "/bytebase.v1.RolloutService/BatchSkipTasks"
+	RolloutService_ApproveStage_FullMethodName        = "/bytebase.v1.RolloutService/ApproveStage"
+	RolloutService_RejectStage_FullMethodName         = "/bytebase.v1.RolloutService/RejectStage"
 	RolloutService_BatchCancelTaskRuns_FullMethodName = "/bytebase.v1.RolloutService/BatchCancelTaskRuns"
 )
 
@@ -39,6 +43,8 @@ type RolloutServiceClient interface {
 	PreviewRollout(ctx context.Context, in *PreviewRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
 	ListTaskRuns(ctx context.Context, in *ListTaskRunsRequest, opts ...grpc.CallOption) (*ListTaskRunsResponse, error)
 	GetTaskRunLog(ctx context.Context, in *GetTaskRunLogRequest, opts ...grpc.CallOption) (*TaskRunLog, error)
+	// DownloadTaskRunLog downloads the full text log of the finished task run.
+	DownloadTaskRunLog(ctx context.Context, in *DownloadTaskRunLogRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	GetTaskRunSession(ctx context.Context, in *GetTaskRunSessionRequest, opts ...grpc.CallOption) (*TaskRunSession, error)
 	// BatchRunTasks creates task runs for the specified tasks.
 	// DataExport issue only allows the creator to run the task.
@@ -48,6 +54,11 @@ type RolloutServiceClient interface {
 	// BatchSkipTasks skips the specified tasks.
 	// The access is the same as BatchRunTasks().
 	BatchSkipTasks(ctx context.Context, in *BatchSkipTasksRequest, opts ...grpc.CallOption) (*BatchSkipTasksResponse, error)
+	// ApproveStage approves the stage to start when the rollout policy of the stage environment requires stage approval.
+	// The stage approver groups of the rollout policy can approve the stage.
+	ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*Stage, error)
+	// RejectStage rejects the stage to start. The access is the same as ApproveStage().
+	RejectStage(ctx context.Context, in *RejectStageRequest, opts ...grpc.CallOption) (*Stage, error)
 	// BatchSkipTasks cancels the specified task runs in batch.
 	// The access is the same as BatchRunTasks().
 	BatchCancelTaskRuns(ctx context.Context, in *BatchCancelTaskRunsRequest, opts ...grpc.CallOption) (*BatchCancelTaskRunsResponse, error)
@@ -111,6 +122,16 @@ func (c *rolloutServiceClient) GetTaskRunLog(ctx context.Context, in *GetTaskRun
 	return out, nil
 }
 
+func (c *rolloutServiceClient) DownloadTaskRunLog(ctx context.Context, in *DownloadTaskRunLogRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, RolloutService_DownloadTaskRunLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolloutServiceClient) GetTaskRunSession(ctx context.Context, in *GetTaskRunSessionRequest, opts ...grpc.CallOption) (*TaskRunSession, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(TaskRunSession)
@@ -141,6 +162,26 @@ func (c *rolloutServiceClient) BatchSkipTasks(ctx context.Context, in *BatchSkip
 	return out, nil
 }
 
+func (c *rolloutServiceClient) ApproveStage(ctx context.Context, in *ApproveStageRequest, opts ...grpc.CallOption) (*Stage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stage)
+	err := c.cc.Invoke(ctx, RolloutService_ApproveStage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) RejectStage(ctx context.Context, in *RejectStageRequest, opts ...grpc.CallOption) (*Stage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stage)
+	err := c.cc.Invoke(ctx, RolloutService_RejectStage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolloutServiceClient) BatchCancelTaskRuns(ctx context.Context, in *BatchCancelTaskRunsRequest, opts ...grpc.CallOption) (*BatchCancelTaskRunsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BatchCancelTaskRunsResponse)
@@ -160,6 +201,8 @@ type RolloutServiceServer interface {
 	PreviewRollout(context.Context, *PreviewRolloutRequest) (*Rollout, error)
 	ListTaskRuns(context.Context, *ListTaskRunsRequest) (*ListTaskRunsResponse, error)
 	GetTaskRunLog(context.Context, *GetTaskRunLogRequest) (*TaskRunLog, error)
+	// DownloadTaskRunLog downloads the full text log of the finished task run.
+	DownloadTaskRunLog(context.Context, *DownloadTaskRunLogRequest) (*httpbody.HttpBody, error)
 	GetTaskRunSession(context.Context, *GetTaskRunSessionRequest) (*TaskRunSession, error)
 	// BatchRunTasks creates task runs for the specified tasks.
 	// DataExport issue only allows the creator to run the task.
@@ -169,6 +212,11 @@ type RolloutServiceServer interface {
 	// BatchSkipTasks skips the specified tasks.
 	// The access is the same as BatchRunTasks().
 	BatchSkipTasks(context.Context, *BatchSkipTasksRequest) (*BatchSkipTasksResponse, error)
+	// ApproveStage approves the stage to start when the rollout policy of the stage environment requires stage approval.
+	// The stage approver groups of the rollout policy can approve the stage.
+	ApproveStage(context.Context, *ApproveStageRequest) (*Stage, error)
+	// RejectStage rejects the stage to start. The access is the same as ApproveStage().
+	RejectStage(context.Context, *RejectStageRequest) (*Stage, error)
 	// BatchSkipTasks cancels the specified task runs in batch.
 	// The access is the same as BatchRunTasks().
 	BatchCancelTaskRuns(context.Context, *BatchCancelTaskRunsRequest) (*BatchCancelTaskRunsResponse, error)
@@ -197,6 +245,9 @@ func (UnimplementedRolloutServiceServer) ListTaskRuns(context.Context, *ListTask
 func (UnimplementedRolloutServiceServer) GetTaskRunLog(context.Context, *GetTaskRunLogRequest) (*TaskRunLog, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRunLog not implemented")
 }
+func (UnimplementedRolloutServiceServer) DownloadTaskRunLog(context.Context, *DownloadTaskRunLogRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadTaskRunLog not implemented")
+}
 func (UnimplementedRolloutServiceServer) GetTaskRunSession(context.Context, *GetTaskRunSessionRequest) (*TaskRunSession, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRunSession not implemented")
 }
@@ -206,6 +257,12 @@ func (UnimplementedRolloutServiceServer) BatchRunTasks(context.Context, *BatchRu
 func (UnimplementedRolloutServiceServer) BatchSkipTasks(context.Context, *BatchSkipTasksRequest) (*BatchSkipTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchSkipTasks not implemented")
 }
+func (UnimplementedRolloutServiceServer) ApproveStage(context.Context, *ApproveStageRequest) (*Stage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStage not implemented")
+}
+func (UnimplementedRolloutServiceServer) RejectStage(context.Context, *RejectStageRequest) (*Stage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectStage not implemented")
+}
 func (UnimplementedRolloutServiceServer) BatchCancelTaskRuns(context.Context, *BatchCancelTaskRunsRequest) (*BatchCancelTaskRunsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchCancelTaskRuns not implemented")
 }
@@ -320,6 +377,24 @@ func _RolloutService_GetTaskRunLog_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_DownloadTaskRunLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadTaskRunLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).DownloadTaskRunLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_DownloadTaskRunLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).DownloadTaskRunLog(ctx, req.(*DownloadTaskRunLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RolloutService_GetTaskRunSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTaskRunSessionRequest)
 	if err := dec(in); err != nil {
@@ -374,6 +449,42 @@ func _RolloutService_BatchSkipTasks_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_ApproveStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ApproveStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_ApproveStage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ApproveStage(ctx, req.(*ApproveStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_RejectStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectStageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).RejectStage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_RejectStage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).RejectStage(ctx, req.(*RejectStageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RolloutService_BatchCancelTaskRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchCancelTaskRunsRequest)
 	if err := dec(in); err != nil {
@@ -419,6 +530,10 @@ var RolloutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTaskRunLog",
 			Handler:    _RolloutService_GetTaskRunLog_Handler,
 		},
+		{
+			MethodName: "DownloadTaskRunLog",
+			Handler:    _RolloutService_DownloadTaskRunLog_Handler,
+		},
 		{
 			MethodName: "GetTaskRunSession",
 			Handler:    _RolloutService_GetTaskRunSession_Handler,
@@ -431,6 +546,14 @@ var RolloutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchSkipTasks",
 			Handler:    _RolloutService_BatchSkipTasks_Handler,
 		},
+		{
+			MethodName: "ApproveStage",
+			Handler:    _RolloutService_ApproveStage_Handler,
+		},
+		{
+			MethodName: "RejectStage",
+			Handler:    _RolloutService_RejectStage_Handler,
+		},
 		{
 			MethodName: "BatchCancelTaskRuns",
 			Handler:    _RolloutService_BatchCancelTaskRuns_Handler,
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,12 +21,17 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SQLService_Query_FullMethodName                = "/bytebase.v1.SQLService/Query"
+	SQLService_ListAdminSessions_FullMethodName    = "/bytebase.v1.SQLService/ListAdminSessions"
+	SQLService_QueryStream_FullMethodName          = "/bytebase.v1.SQLService/QueryStream"
+	SQLService_CancelQuery_FullMethodName          = "/bytebase.v1.SQLService/CancelQuery"
+	SQLService_GetQueryPlan_FullMethodName         = "/bytebase.v1.SQLService/GetQueryPlan"
 	SQLService_Execute_FullMethodName              = "/bytebase.v1.SQLService/Execute"
 	SQLService_AdminExecute_FullMethodName         = "/bytebase.v1.SQLService/AdminExecute"
 	SQLService_SearchQueryHistories_FullMethodName = "/bytebase.v1.SQLService/SearchQueryHistories"
 	SQLService_Export_FullMethodName               = "/bytebase.v1.SQLService/Export"
 	SQLService_DifferPreview_FullMethodName        = "/bytebase.v1.SQLService/DifferPreview"
 	SQLService_Check_FullMethodName                = "/bytebase.v1.SQLService/Check"
+	SQLService_GenerateSQL_FullMethodName          = "/bytebase.v1.SQLService/GenerateSQL"
 	SQLService_ParseMyBatisMapper_FullMethodName   = "/bytebase.v1.SQLService/ParseMyBatisMapper"
 	SQLService_Pretty_FullMethodName               = "/bytebase.v1.SQLService/Pretty"
 	SQLService_StringifyMetadata_FullMethodName    = "/bytebase.v1.SQLService/StringifyMetadata"
@@ -37,6 +43,17 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SQLServiceClient interface {
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// ListAdminSessions lists the recorded admin execute sessions of the instance, the newest first.
+	ListAdminSessions(ctx context.Context, in *ListAdminSessionsRequest, opts ...grpc.CallOption) (*ListAdminSessionsResponse, error)
+	// QueryStream is the same as Query, but streams the results in frames so that the large results
+	// are not limited by the maximum message size.
+	QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryStreamResponse], error)
+	// CancelQuery cancels the running query of the caller by the query_id in the QueryRequest.
+	// The query is also canceled in the database for PostgreSQL and MySQL compatible engines.
+	CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetQueryPlan explains the query without executing it, the plan is normalized to a tree for the engines:
+	// PostgreSQL and MySQL.
+	GetQueryPlan(ctx context.Context, in *GetQueryPlanRequest, opts ...grpc.CallOption) (*QueryPlan, error)
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error)
 	// SearchQueryHistories searches query histories for the caller.
@@ -44,6 +61,9 @@ type SQLServiceClient interface {
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
 	Check(ctx context.Context, in *CheckRequest, opts ...grpc.CallOption) (*CheckResponse, error)
+	// GenerateSQL generates the SQL statement from the natural language with the AI provider,
+	// the synced schema metadata of the database is sent as the grounding context.
+	GenerateSQL(ctx context.Context, in *GenerateSQLRequest, opts ...grpc.CallOption) (*GenerateSQLResponse, error)
 	ParseMyBatisMapper(ctx context.Context, in *ParseMyBatisMapperRequest, opts ...grpc.CallOption) (*ParseMyBatisMapperResponse, error)
 	Pretty(ctx context.Context, in *PrettyRequest, opts ...grpc.CallOption) (*PrettyResponse, error)
 	StringifyMetadata(ctx context.Context, in *StringifyMetadataRequest, opts ...grpc.CallOption) (*StringifyMetadataResponse, error)
@@ -69,6 +89,55 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) ListAdminSessions(ctx context.Context, in *ListAdminSessionsRequest, opts ...grpc.CallOption) (*ListAdminSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminSessionsResponse)
+	err := c.cc.Invoke(ctx, SQLService_ListAdminSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[QueryStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_QueryStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[QueryRequest, QueryStreamResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_QueryStreamClient = grpc.ServerStreamingClient[QueryStreamResponse]
+
+func (c *sQLServiceClient) CancelQuery(ctx context.Context, in *CancelQueryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SQLService_CancelQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sQLServiceClient) GetQueryPlan(ctx context.Context, in *GetQueryPlanRequest, opts ...grpc.CallOption) (*QueryPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPlan)
+	err := c.cc.Invoke(ctx, SQLService_GetQueryPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (*ExecuteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteResponse)
@@ -81,7 +150,7 @@ func (c *sQLServiceClient) Execute(ctx context.Context, in *ExecuteRequest, opts
 
 func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[AdminExecuteRequest, AdminExecuteResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_AdminExecute_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_AdminExecute_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +201,16 @@ func (c *sQLServiceClient) Check(ctx context.Context, in *CheckRequest, opts ...
 	return out, nil
 }
 
+func (c *sQLServiceClient) GenerateSQL(ctx context.Context, in *GenerateSQLRequest, opts ...grpc.CallOption) (*GenerateSQLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSQLResponse)
+	err := c.cc.Invoke(ctx, SQLService_GenerateSQL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sQLServiceClient) ParseMyBatisMapper(ctx context.Context, in *ParseMyBatisMapperRequest, opts ...grpc.CallOption) (*ParseMyBatisMapperResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ParseMyBatisMapperResponse)
@@ -177,6 +256,17 @@ func (c *sQLServiceClient) GenerateRestoreSQL(ctx context.Context, in *GenerateR
 // for forward compatibility.
 type SQLServiceServer interface {
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// ListAdminSessions lists the recorded admin execute sessions of the instance, the newest first.
+	ListAdminSessions(context.Context, *ListAdminSessionsRequest) (*ListAdminSessionsResponse, error)
+	// QueryStream is the same as Query, but streams the results in frames so that the large results
+	// are not limited by the maximum message size.
+	QueryStream(*QueryRequest, grpc.ServerStreamingServer[QueryStreamResponse]) error
+	// CancelQuery cancels the running query of the caller by the query_id in the QueryRequest.
+	// The query is also canceled in the database for PostgreSQL and MySQL compatible engines.
+	CancelQuery(context.Context, *CancelQueryRequest) (*emptypb.Empty, error)
+	// GetQueryPlan explains the query without executing it, the plan is normalized to a tree for the engines:
+	// PostgreSQL and MySQL.
+	GetQueryPlan(context.Context, *GetQueryPlanRequest) (*QueryPlan, error)
 	Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error)
 	AdminExecute(grpc.BidiStreamingServer[AdminExecuteRequest, AdminExecuteResponse]) error
 	// SearchQueryHistories searches query histories for the caller.
@@ -184,6 +274,9 @@ type SQLServiceServer interface {
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
 	Check(context.Context, *CheckRequest) (*CheckResponse, error)
+	// GenerateSQL generates the SQL statement from the natural language with the AI provider,
+	// the synced schema metadata of the database is sent as the grounding context.
+	GenerateSQL(context.Context, *GenerateSQLRequest) (*GenerateSQLResponse, error)
 	ParseMyBatisMapper(context.Context, *ParseMyBatisMapperRequest) (*ParseMyBatisMapperResponse, error)
 	Pretty(context.Context, *PrettyRequest) (*PrettyResponse, error)
 	StringifyMetadata(context.Context, *StringifyMetadataRequest) (*StringifyMetadataResponse, error)
@@ -202,6 +295,18 @@ type UnimplementedSQLServiceServer struct{}
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (UnimplementedSQLServiceServer) ListAdminSessions(context.Context, *ListAdminSessionsRequest) (*ListAdminSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdminSessions not implemented")
+}
+func (UnimplementedSQLServiceServer) QueryStream(*QueryRequest, grpc.ServerStreamingServer[QueryStreamResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
+}
+func (UnimplementedSQLServiceServer) CancelQuery(context.Context, *CancelQueryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQuery not implemented")
+}
+func (UnimplementedSQLServiceServer) GetQueryPlan(context.Context, *GetQueryPlanRequest) (*QueryPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQueryPlan not implemented")
+}
 func (UnimplementedSQLServiceServer) Execute(context.Context, *ExecuteRequest) (*ExecuteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
 }
@@ -220,6 +325,9 @@ func (UnimplementedSQLServiceServer) DifferPreview(context.Context, *DifferPrevi
 func (UnimplementedSQLServiceServer) Check(context.Context, *CheckRequest) (*CheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedSQLServiceServer) GenerateSQL(context.Context, *GenerateSQLRequest) (*GenerateSQLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSQL not implemented")
+}
 func (UnimplementedSQLServiceServer) ParseMyBatisMapper(context.Context, *ParseMyBatisMapperRequest) (*ParseMyBatisMapperResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParseMyBatisMapper not implemented")
 }
@@ -271,6 +379,71 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_ListAdminSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).ListAdminSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_ListAdminSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).ListAdminSessions(ctx, req.(*ListAdminSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SQLServiceServer).QueryStream(m, &grpc.GenericServerStream[QueryRequest, QueryStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SQLService_QueryStreamServer = grpc.ServerStreamingServer[QueryStreamResponse]
+
+func _SQLService_CancelQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).CancelQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_CancelQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).CancelQuery(ctx, req.(*CancelQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SQLService_GetQueryPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueryPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GetQueryPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GetQueryPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GetQueryPlan(ctx, req.(*GetQueryPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ExecuteRequest)
 	if err := dec(in); err != nil {
@@ -368,6 +541,24 @@ func _SQLService_Check_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SQLService_GenerateSQL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSQLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SQLServiceServer).GenerateSQL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SQLService_GenerateSQL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SQLServiceServer).GenerateSQL(ctx, req.(*GenerateSQLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SQLService_ParseMyBatisMapper_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ParseMyBatisMapperRequest)
 	if err := dec(in); err != nil {
@@ -451,6 +642,18 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
+		{
+			MethodName: "ListAdminSessions",
+			Handler:    _SQLService_ListAdminSessions_Handler,
+		},
+		{
+			MethodName: "CancelQuery",
+			Handler:    _SQLService_CancelQuery_Handler,
+		},
+		{
+			MethodName: "GetQueryPlan",
+			Handler:    _SQLService_GetQueryPlan_Handler,
+		},
 		{
 			MethodName: "Execute",
 			Handler:    _SQLService_Execute_Handler,
@@ -471,6 +674,10 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Check",
 			Handler:    _SQLService_Check_Handler,
 		},
+		{
+			MethodName: "GenerateSQL",
+			Handler:    _SQLService_GenerateSQL_Handler,
+		},
 		{
 			MethodName: "ParseMyBatisMapper",
 			Handler:    _SQLService_ParseMyBatisMapper_Handler,
@@ -489,6 +696,11 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryStream",
+			Handler:       _SQLService_QueryStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AdminExecute",
 			Handler:       _SQLService_AdminExecute_Handler,
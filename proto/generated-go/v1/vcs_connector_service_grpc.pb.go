@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VCSConnectorService_CreateVCSConnector_FullMethodName = "/bytebase.v1.VCSConnectorService/CreateVCSConnector"
-	VCSConnectorService_GetVCSConnector_FullMethodName    = "/bytebase.v1.VCSConnectorService/GetVCSConnector"
-	VCSConnectorService_ListVCSConnectors_FullMethodName  = "/bytebase.v1.VCSConnectorService/ListVCSConnectors"
-	VCSConnectorService_UpdateVCSConnector_FullMethodName = "/bytebase.v1.VCSConnectorService/UpdateVCSConnector"
-	VCSConnectorService_DeleteVCSConnector_FullMethodName = "/bytebase.v1.VCSConnectorService/DeleteVCSConnector"
+	VCSConnectorService_CreateVCSConnector_FullMethodName     = "/bytebase.v1.VCSConnectorService/CreateVCSConnector"
+	VCSConnectorService_GetVCSConnector_FullMethodName        = "/bytebase.v1.VCSConnectorService/GetVCSConnector"
+	VCSConnectorService_ListVCSConnectors_FullMethodName      = "/bytebase.v1.VCSConnectorService/ListVCSConnectors"
+	VCSConnectorService_UpdateVCSConnector_FullMethodName     = "/bytebase.v1.VCSConnectorService/UpdateVCSConnector"
+	VCSConnectorService_DeleteVCSConnector_FullMethodName     = "/bytebase.v1.VCSConnectorService/DeleteVCSConnector"
+	VCSConnectorService_ImportMigrationHistory_FullMethodName = "/bytebase.v1.VCSConnectorService/ImportMigrationHistory"
 )
 
 // VCSConnectorServiceClient is the client API for VCSConnectorService service.
@@ -36,6 +37,10 @@ type VCSConnectorServiceClient interface {
 	ListVCSConnectors(ctx context.Context, in *ListVCSConnectorsRequest, opts ...grpc.CallOption) (*ListVCSConnectorsResponse, error)
 	UpdateVCSConnector(ctx context.Context, in *UpdateVCSConnectorRequest, opts ...grpc.CallOption) (*VCSConnector, error)
 	DeleteVCSConnector(ctx context.Context, in *DeleteVCSConnectorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImportMigrationHistory reads the Flyway migration directory or the Liquibase changelog in the repository,
+	// maps the migrations against the history table of the tool in the live database, and backfills the
+	// migration history and sheets of the applied migrations.
+	ImportMigrationHistory(ctx context.Context, in *ImportMigrationHistoryRequest, opts ...grpc.CallOption) (*ImportMigrationHistoryResponse, error)
 }
 
 type vCSConnectorServiceClient struct {
@@ -96,6 +101,16 @@ func (c *vCSConnectorServiceClient) DeleteVCSConnector(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *vCSConnectorServiceClient) ImportMigrationHistory(ctx context.Context, in *ImportMigrationHistoryRequest, opts ...grpc.CallOption) (*ImportMigrationHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMigrationHistoryResponse)
+	err := c.cc.Invoke(ctx, VCSConnectorService_ImportMigrationHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VCSConnectorServiceServer is the server API for VCSConnectorService service.
 // All implementations must embed UnimplementedVCSConnectorServiceServer
 // for forward compatibility.
@@ -105,6 +120,10 @@ type VCSConnectorServiceServer interface {
 	ListVCSConnectors(context.Context, *ListVCSConnectorsRequest) (*ListVCSConnectorsResponse, error)
 	UpdateVCSConnector(context.Context, *UpdateVCSConnectorRequest) (*VCSConnector, error)
 	DeleteVCSConnector(context.Context, *DeleteVCSConnectorRequest) (*emptypb.Empty, error)
+	// ImportMigrationHistory reads the Flyway migration directory or the Liquibase changelog in the repository,
+	// maps the migrations against the history table of the tool in the live database, and backfills the
+	// migration history and sheets of the applied migrations.
+	ImportMigrationHistory(context.Context, *ImportMigrationHistoryRequest) (*ImportMigrationHistoryResponse, error)
 	mustEmbedUnimplementedVCSConnectorServiceServer()
 }
 
@@ -130,6 +149,9 @@ func (UnimplementedVCSConnectorServiceServer) UpdateVCSConnector(context.Context
 func (UnimplementedVCSConnectorServiceServer) DeleteVCSConnector(context.Context, *DeleteVCSConnectorRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteVCSConnector not implemented")
 }
+func (UnimplementedVCSConnectorServiceServer) ImportMigrationHistory(context.Context, *ImportMigrationHistoryRequest) (*ImportMigrationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMigrationHistory not implemented")
+}
 func (UnimplementedVCSConnectorServiceServer) mustEmbedUnimplementedVCSConnectorServiceServer() {}
 func (UnimplementedVCSConnectorServiceServer) testEmbeddedByValue()                             {}
 
@@ -241,6 +263,24 @@ func _VCSConnectorService_DeleteVCSConnector_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VCSConnectorService_ImportMigrationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMigrationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VCSConnectorServiceServer).ImportMigrationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VCSConnectorService_ImportMigrationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VCSConnectorServiceServer).ImportMigrationHistory(ctx, req.(*ImportMigrationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VCSConnectorService_ServiceDesc is the grpc.ServiceDesc for VCSConnectorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -268,6 +308,10 @@ var VCSConnectorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVCSConnector",
 			Handler:    _VCSConnectorService_DeleteVCSConnector_Handler,
 		},
+		{
+			MethodName: "ImportMigrationHistory",
+			Handler:    _VCSConnectorService_ImportMigrationHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/vcs_connector_service.proto",
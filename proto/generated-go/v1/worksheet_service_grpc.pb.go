@@ -26,6 +26,7 @@ const (
 	WorksheetService_UpdateWorksheet_FullMethodName          = "/bytebase.v1.WorksheetService/UpdateWorksheet"
 	WorksheetService_UpdateWorksheetOrganizer_FullMethodName = "/bytebase.v1.WorksheetService/UpdateWorksheetOrganizer"
 	WorksheetService_DeleteWorksheet_FullMethodName          = "/bytebase.v1.WorksheetService/DeleteWorksheet"
+	WorksheetService_ListWorksheetRevisions_FullMethodName   = "/bytebase.v1.WorksheetService/ListWorksheetRevisions"
 )
 
 // WorksheetServiceClient is the client API for WorksheetService service.
@@ -56,6 +57,9 @@ type WorksheetServiceClient interface {
 	// Delete a worksheet.
 	// The access is the same as UpdateWorksheet method.
 	DeleteWorksheet(ctx context.Context, in *DeleteWorksheetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the revisions of a worksheet, the newest first.
+	// A revision is saved each time the content of the worksheet is changed.
+	ListWorksheetRevisions(ctx context.Context, in *ListWorksheetRevisionsRequest, opts ...grpc.CallOption) (*ListWorksheetRevisionsResponse, error)
 }
 
 type worksheetServiceClient struct {
@@ -126,6 +130,16 @@ func (c *worksheetServiceClient) DeleteWorksheet(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *worksheetServiceClient) ListWorksheetRevisions(ctx context.Context, in *ListWorksheetRevisionsRequest, opts ...grpc.CallOption) (*ListWorksheetRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWorksheetRevisionsResponse)
+	err := c.cc.Invoke(ctx, WorksheetService_ListWorksheetRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorksheetServiceServer is the server API for WorksheetService service.
 // All implementations must embed UnimplementedWorksheetServiceServer
 // for forward compatibility.
@@ -154,6 +168,9 @@ type WorksheetServiceServer interface {
 	// Delete a worksheet.
 	// The access is the same as UpdateWorksheet method.
 	DeleteWorksheet(context.Context, *DeleteWorksheetRequest) (*emptypb.Empty, error)
+	// List the revisions of a worksheet, the newest first.
+	// A revision is saved each time the content of the worksheet is changed.
+	ListWorksheetRevisions(context.Context, *ListWorksheetRevisionsRequest) (*ListWorksheetRevisionsResponse, error)
 	mustEmbedUnimplementedWorksheetServiceServer()
 }
 
@@ -182,6 +199,9 @@ func (UnimplementedWorksheetServiceServer) UpdateWorksheetOrganizer(context.Cont
 func (UnimplementedWorksheetServiceServer) DeleteWorksheet(context.Context, *DeleteWorksheetRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorksheet not implemented")
 }
+func (UnimplementedWorksheetServiceServer) ListWorksheetRevisions(context.Context, *ListWorksheetRevisionsRequest) (*ListWorksheetRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWorksheetRevisions not implemented")
+}
 func (UnimplementedWorksheetServiceServer) mustEmbedUnimplementedWorksheetServiceServer() {}
 func (UnimplementedWorksheetServiceServer) testEmbeddedByValue()                          {}
 
@@ -311,6 +331,24 @@ func _WorksheetService_DeleteWorksheet_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorksheetService_ListWorksheetRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWorksheetRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorksheetServiceServer).ListWorksheetRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorksheetService_ListWorksheetRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorksheetServiceServer).ListWorksheetRevisions(ctx, req.(*ListWorksheetRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WorksheetService_ServiceDesc is the grpc.ServiceDesc for WorksheetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -342,6 +380,10 @@ var WorksheetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteWorksheet",
 			Handler:    _WorksheetService_DeleteWorksheet_Handler,
 		},
+		{
+			MethodName: "ListWorksheetRevisions",
+			Handler:    _WorksheetService_ListWorksheetRevisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/worksheet_service.proto",